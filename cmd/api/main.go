@@ -2,28 +2,97 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"database/sql"
+	"encoding/hex"
 	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/docs"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/account"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/apikey"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/budget"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/cart"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/category"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/chaintx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/apidocs"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/fixtures"
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/handler"
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/config"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/credit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/delegatedsigner"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/depeg"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/deposit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/discount"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/dispute"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/dunning"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/fee"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/historical"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/invoice"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/kycdocument"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/ledger"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/legalhold"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/limits"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/me"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/order"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/ordersla"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/organization"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/otp"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/outbox"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/payment"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/payoutaddress"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/product"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/purchaseorder"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/quote"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/reconciliation"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/retention"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/rfq"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/risk"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/rma"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/rollout"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/runbook"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/settlement"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/statement"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/status"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/support"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/tax"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/token"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/usage"
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/user"
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/wallet"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/warehouse"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/webhook"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/cache"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
 	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/eip712"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/eventbus"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/hdwallet"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/idempotency"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/kyc"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/nonce"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/oracle"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/ratelimit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/screening"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/sms"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/storage"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/tracing"
 	"github.com/gin-gonic/gin"
 	_ "github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 	"go.uber.org/zap"
 )
 
@@ -65,6 +134,24 @@ func main() {
 		zap.String("addr", cfg.Server.Addr()),
 	)
 
+	// 2-1) 트레이싱 (OTLP 미설정 시 traceparent 전파만 수행)
+	shutdownTracing, err := tracing.Init(context.Background(), tracing.Config{
+		ServiceName:  cfg.Tracing.ServiceName,
+		Environment:  cfg.Server.Environment,
+		OTLPEndpoint: cfg.Tracing.OTLPEndpoint,
+		SampleRatio:  cfg.Tracing.SampleRatio,
+	}, logger)
+	if err != nil {
+		logger.Fatal("failed to init tracing", zap.Error(err))
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			logger.Warn("failed to flush traces", zap.Error(err))
+		}
+	}()
+
 	// 3) DB 초기화
 	db, err := initDB(cfg.Database)
 	if err != nil {
@@ -75,14 +162,42 @@ func main() {
 	// 4) Redis 초기화
 	rdb := initRedis(cfg.Redis)
 	defer rdb.Close()
+	if err := redisotel.InstrumentTracing(rdb); err != nil {
+		logger.Fatal("failed to instrument redis tracing", zap.Error(err))
+	}
 
 	// 5) 연결 테스트 (fail-fast)
 	if err := testConnections(db, rdb); err != nil {
 		logger.Fatal("failed to test connections", zap.Error(err))
 	}
 
+	// 5-1) 체인 클라이언트 (선택 - 미설정/실패 시 온체인 기능 비활성)
+	chainClient := initChain(cfg.Chain, rdb, logger)
+	if chainClient != nil {
+		defer chainClient.Close()
+	}
+
+	// 5-2) 이벤트 버스 (설정된 드라이버 연결 실패 시 fail-fast)
+	bus, err := initEventBus(cfg.EventBus, logger)
+	if err != nil {
+		logger.Fatal("failed to init event bus", zap.Error(err))
+	}
+	defer bus.Close()
+
+	// 5-3) 가격 피드 + 정산 토큰 디페그 감시 (피드 미설정 시 비활성)
+	priceFeeds := newPriceFeeds(cfg.Oracle, chainClient, logger)
+	depegMonitor := newDepegMonitor(cfg, priceFeeds, logger)
+
+	// 5-4) 생성 파일 저장소 (KYC 문서, 인보이스, 리포트/데이터 export)
+	artifacts := newArtifactStore(cfg, logger)
+
 	// 6) 라우터 구성
-	router := setupRouter(cfg, logger, db, rdb)
+	router := setupRouter(cfg, logger, db, rdb, chainClient, priceFeeds, depegMonitor, artifacts)
+
+	// 6-1) 백그라운드 워커 (webhook 전송, outbox relay 등)
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	startWorkers(workerCtx, cfg, logger, db, rdb, bus, chainClient, depegMonitor, artifacts)
 
 	// 7) HTTP 서버 생성
 	srv := &http.Server{
@@ -102,6 +217,7 @@ func main() {
 	logger.Info("server started",
 		zap.String("addr", cfg.Server.Addr()),
 		zap.String("swagger", fmt.Sprintf("http://localhost:%d/swagger/index.html", cfg.Server.Port)),
+		zap.String("swagger_admin", fmt.Sprintf("http://localhost:%d/swagger-admin/index.html", cfg.Server.Port)),
 	)
 
 	// 9) 종료 시그널 대기
@@ -111,6 +227,9 @@ func main() {
 
 	logger.Info("shutting down server...")
 
+	// 워커 먼저 중단 (진행 중 전송은 lease 만료 후 재시도)
+	stopWorkers()
+
 	// 10) Graceful shutdown
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -166,27 +285,710 @@ func testConnections(db *sql.DB, rdb *redis.Client) error {
 	return nil
 }
 
-func setupRouter(cfg *config.Config, logger *zap.Logger, db *sql.DB, rdb *redis.Client) *gin.Engine {
+// initChain dials the chain RPC node. Returns nil when the chain is not configured
+// or unreachable so that the API can still serve off-chain features.
+func initChain(cfg config.ChainConfig, rdb *redis.Client, logger *zap.Logger) *chain.EthClient {
+	if !cfg.Enabled() {
+		logger.Info("chain rpc not configured, on-chain features disabled")
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	client, err := chain.NewEthClient(ctx, chain.Config{
+		RPCURL:           cfg.RPCURL,
+		ChainID:          cfg.ChainID,
+		TokenAddress:     cfg.TokenAddress,
+		TokenDecimals:    cfg.TokenDecimals,
+		MinterPrivateKey: cfg.MinterPrivateKey,
+		TxTimeout:        cfg.TxTimeout,
+		Gas:              gasManagerConfig(cfg),
+		TxUrgency:        chain.Urgency(cfg.TxUrgency),
+		NonceStore:       newChainNonceStore(cfg, rdb, logger),
+		NonceGapTimeout:  cfg.NonceGapTimeout,
+	}, logger)
+	if err != nil {
+		logger.Warn("failed to init chain client, on-chain features disabled", zap.Error(err))
+		return nil
+	}
+	return client
+}
+
+// newChainNonceStore selects the platform signer nonce store (memory is for single-instance deployments only)
+func newChainNonceStore(cfg config.ChainConfig, rdb *redis.Client, logger *zap.Logger) chain.NonceStore {
+	switch cfg.NonceStore {
+	case chain.NonceBackendRedis:
+		return chain.NewRedisNonceStore(rdb)
+	case chain.NonceBackendMemory:
+		logger.Warn("using in-memory chain nonce store, concurrent broadcasts are coordinated per-instance only")
+		return chain.NewMemoryNonceStore()
+	default:
+		logger.Fatal("unknown chain nonce store", zap.String("store", cfg.NonceStore))
+		return nil
+	}
+}
+
+// gasManagerConfig maps config to EIP-1559 fee estimation settings
+func gasManagerConfig(cfg config.ChainConfig) chain.GasManagerConfig {
+	gas := chain.GasManagerConfig{
+		HistoryBlocks:   uint64(cfg.GasHistoryBlocks),
+		RefreshInterval: cfg.GasRefreshInterval,
+		MinTipCap:       chain.GweiToWei(cfg.GasMinTipGwei),
+	}
+	if cfg.GasMaxFeeGwei > 0 {
+		gas.MaxFeeCap = chain.GweiToWei(cfg.GasMaxFeeGwei)
+	}
+	return gas
+}
+
+func initEventBus(cfg config.EventBusConfig, logger *zap.Logger) (eventbus.Publisher, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	bus, err := eventbus.New(ctx, eventbus.Config{
+		Driver:       cfg.Driver,
+		KafkaRESTURL: cfg.KafkaRESTURL,
+		NATSURL:      cfg.NATSURL,
+		Timeout:      cfg.Timeout,
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("event bus initialized", zap.String("driver", cfg.Driver))
+	return bus, nil
+}
+
+// startWorkers launches background workers. They stop when ctx is canceled.
+func startWorkers(ctx context.Context, cfg *config.Config, logger *zap.Logger, db *sql.DB, rdb *redis.Client, bus eventbus.Publisher, ethClient *chain.EthClient, depegMonitor *depeg.Monitor, artifacts storage.Store) {
+	txRunner := pkgdb.NewTxRunner(db)
+
+	// Webhook delivery worker
+	dispatcher := webhook.NewDispatcher(txRunner, webhook.DispatcherConfig{
+		PollInterval: cfg.Webhook.PollInterval,
+		BatchSize:    cfg.Webhook.BatchSize,
+		Timeout:      cfg.Webhook.Timeout,
+	}, logger)
+	go dispatcher.Run(ctx)
+
+	// Outbox relay (committed domain events → webhook deliveries + event bus)
+	webhookService := webhook.NewService(txRunner, webhook.Config{
+		MaxAttempts:       cfg.Webhook.MaxAttempts,
+		AllowInsecureURLs: cfg.Webhook.AllowInsecureURLs,
+	}, logger)
+	relay := outbox.NewRelay(txRunner, outbox.RelayConfig{
+		PollInterval: cfg.Outbox.PollInterval,
+		BatchSize:    cfg.Outbox.BatchSize,
+	}, logger, webhookService, outbox.NewEventBusPublisher(bus, cfg.EventBus.TopicPrefix))
+	go relay.Run(ctx)
+
+	// Data retention purge job
+	retentionService := retention.NewService(txRunner, retentionConfig(cfg), logger)
+	go retentionService.Run(ctx)
+
+	// Unpaid order dunning job (reminders → cancel / credit hold)
+	dunningCfg := dunningConfig(cfg)
+	if err := dunningCfg.Schedule.Validate(); err != nil {
+		logger.Fatal("invalid dunning schedule", zap.Error(err))
+	}
+	dunningService := dunning.NewService(txRunner, dunningCfg, logger)
+	go dunningService.Run(ctx)
+
+	// Order SLA job (확인/출고 기한 위반 → 에스컬레이션 / 자동 취소·환불)
+	slaWorker := ordersla.NewWorker(txRunner, ordersla.WorkerConfig{
+		Defaults:  orderSLADefaults(cfg),
+		Interval:  cfg.OrderSLA.Interval,
+		BatchSize: cfg.OrderSLA.BatchSize,
+	}, logger)
+	go slaWorker.Run(ctx)
+
+	// Payment expiry job (승인 만료 결제 → EXPIRED + 주문 재고 예약 해제)
+	expiryWorker := payment.NewExpiryWorker(txRunner, payment.ExpiryWorkerConfig{
+		Interval:  cfg.Expiry.Interval,
+		BatchSize: cfg.Expiry.BatchSize,
+	}, logger)
+	go expiryWorker.Run(ctx)
+
+	// Reservation expiry job (결제 없이 예약 기한 경과 주문 → PAYMENT_EXPIRED + 재고 예약 해제)
+	reservationWorker := order.NewExpiryWorker(txRunner, order.ExpiryWorkerConfig{
+		Interval:  cfg.Reservation.Interval,
+		BatchSize: cfg.Reservation.BatchSize,
+	}, logger)
+	go reservationWorker.Run(ctx)
+
+	// Cart expiry job (TTL 경과 장바구니 → EXPIRED)
+	cartWorker := cart.NewWorker(txRunner, cart.WorkerConfig{
+		Interval:  cfg.Cart.Interval,
+		BatchSize: cfg.Cart.BatchSize,
+	}, logger)
+	go cartWorker.Run(ctx)
+
+	// Invoice job (만기 경과 청구서 → OVERDUE, 입금 → 구매자 미결제 청구서 자동 대사)
+	invoiceWorker := invoice.NewWorker(txRunner, invoice.WorkerConfig{
+		Interval:     cfg.Invoice.Interval,
+		BatchSize:    cfg.Invoice.BatchSize,
+		DepositToken: cfg.Chain.TokenSymbol,
+	}, logger)
+	go invoiceWorker.Run(ctx)
+
+	// Budget alert job (한도 근접/초과 알림, 기간당 1회)
+	budgetAlerts := budget.NewAlertWorker(txRunner, budget.AlertConfig{
+		Interval:  cfg.Budget.AlertInterval,
+		BatchSize: cfg.Budget.AlertBatchSize,
+	}, logger)
+	go budgetAlerts.Run(ctx)
+
+	// Platform fee job (확정 결제 수수료 부과 → fee ledger)
+	feeService := fee.NewService(txRunner, feeConfig(cfg, logger), logger)
+	feeWorker := fee.NewWorker(txRunner, feeService, fee.WorkerConfig{
+		Interval:  cfg.Fee.Interval,
+		BatchSize: cfg.Fee.BatchSize,
+	}, logger)
+	go feeWorker.Run(ctx)
+
+	// Payout scheduler (지급 일정 마감마다 지급 대기 정산 → 정산 배치, 수취 계정 출금 한도 적용)
+	payoutScheduler := settlement.NewScheduler(txRunner, settlement.NewService(txRunner, nil, limits.NewEnforcer(limitDefaults(cfg, logger)), settlementConfig(cfg, logger), logger), settlement.SchedulerConfig{
+		Interval:  cfg.Settlement.SchedulerInterval,
+		BatchSize: cfg.Settlement.SchedulerBatchSize,
+	}, logger)
+	go payoutScheduler.Run(ctx)
+
+	// Monthly statement job (마감 월의 계정별 명세서 생성, 생성 후 불변)
+	statementWorker := statement.NewWorker(txRunner, statement.NewService(txRunner, cfg.Statement.Grace, logger), statement.WorkerConfig{
+		Interval:  cfg.Statement.Interval,
+		BatchSize: cfg.Statement.BatchSize,
+	}, logger)
+	go statementWorker.Run(ctx)
+
+	// Ledger balance snapshot job (계정 잔액 체크포인트 → 잔액 조회 = 스냅샷 + 이후 항목)
+	snapshotWorker := ledger.NewSnapshotWorker(txRunner, ledger.SnapshotConfig{
+		Interval:   cfg.Snapshot.Interval,
+		BatchSize:  cfg.Snapshot.BatchSize,
+		MinEntries: int64(cfg.Snapshot.MinEntries),
+	}, logger)
+	go snapshotWorker.Run(ctx)
+
+	// API usage flush job (Redis 요청 카운터 → api_usage_hourly)
+	usageWorker := usage.NewWorker(txRunner, rdb, usage.WorkerConfig{
+		Interval: cfg.Usage.FlushInterval,
+	}, logger)
+	go usageWorker.Run(ctx)
+
+	// Depeg circuit breaker (정산 토큰 가격 이탈 시 신규 결제 승인 중단)
+	if depegMonitor != nil {
+		go depegMonitor.Run(ctx)
+	}
+
+	// Artifact lifecycle job (로컬 저장소만 - S3/GCS는 버킷 lifecycle 규칙으로 만료)
+	if local, ok := artifacts.(*storage.Local); ok {
+		go runArtifactLifecycle(ctx, local, cfg.Storage.LifecycleInterval, logger)
+	}
+
+	// Primary wallet consistency job (Primary 없음/미검증 Primary/계정 불일치 복구)
+	primaryRepairer := wallet.NewPrimaryRepairer(txRunner, primaryRepairConfig(cfg), logger)
+	go primaryRepairer.Run(ctx)
+
+	// ENS re-validation job (체인 클라이언트가 있을 때만)
+	if ethClient != nil {
+		revalidator := wallet.NewENSRevalidator(txRunner, ethClient, wallet.ENSRevalidatorConfig{
+			Interval:  cfg.ENS.RevalidateInterval,
+			MaxAge:    cfg.ENS.MaxAge,
+			BatchSize: cfg.ENS.BatchSize,
+		}, logger)
+		go revalidator.Run(ctx)
+
+		// Stuck transaction monitor (미확정 송금 수수료 인상 재전송 + 실제 포함된 tx 조정)
+		txMonitor, err := newChainTxMonitor(cfg.Chain, txRunner, ethClient, logger)
+		if err != nil {
+			logger.Fatal("invalid chain transaction monitor config", zap.Error(err))
+		}
+		go txMonitor.Run(ctx)
+
+		// Treasury reconciliation job (treasury 원장 잔액 ↔ on-chain 잔액 대사, 허용치 초과 시 알림)
+		if cfg.Reconcile.Enabled() {
+			reconciler := reconciliation.NewWorker(txRunner, ethClient, reconciliationConfig(cfg, logger), logger)
+			go reconciler.Run(ctx)
+		}
+	}
+}
+
+// runArtifactLifecycle deletes expired local artifacts periodically until ctx is canceled
+func runArtifactLifecycle(ctx context.Context, store *storage.Local, interval time.Duration, logger *zap.Logger) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := store.ApplyLifecycle(ctx); err != nil && ctx.Err() == nil {
+				logger.Error("artifact lifecycle run failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// newChainTxMonitor creates the stuck transaction monitor with the reconcilers of every transfer sender
+func newChainTxMonitor(cfg config.ChainConfig, txRunner *pkgdb.TxRunner, chainClient chain.Client, logger *zap.Logger) (*chaintx.Monitor, error) {
+	urgency, err := chain.ParseUrgency(cfg.TxReplacementUrgency)
+	if err != nil {
+		return nil, err
+	}
+
+	monitor := chaintx.NewMonitor(txRunner, chainClient, chaintx.MonitorConfig{
+		Interval:        cfg.TxMonitorInterval,
+		TxTimeout:       cfg.TxTimeout,
+		MaxReplacements: cfg.TxMaxReplacements,
+		Urgency:         urgency,
+		BatchSize:       cfg.TxMonitorBatchSize,
+	}, logger)
+	monitor.Handle(chaintx.ReferenceWalletMicroTransfer, wallet.ReconcileMicroTransferTx)
+	monitor.Handle(chaintx.ReferencePaymentRefund, payment.ReconcileRefundTx)
+	return monitor, nil
+}
+
+// dunningConfig maps config to dunning engine settings
+func dunningConfig(cfg *config.Config) dunning.Config {
+	return dunning.Config{
+		Schedule: dunning.Schedule{
+			ReminderDays: cfg.Dunning.ReminderDays,
+			FinalDays:    cfg.Dunning.FinalDays,
+			FinalAction:  cfg.Dunning.FinalAction,
+		},
+		Interval:  cfg.Dunning.Interval,
+		BatchSize: cfg.Dunning.BatchSize,
+	}
+}
+
+// orderSLADefaults maps config to the server-wide order SLA
+func orderSLADefaults(cfg *config.Config) ordersla.Defaults {
+	return ordersla.Defaults{
+		ConfirmHours: cfg.OrderSLA.ConfirmHours,
+		FulfillHours: cfg.OrderSLA.FulfillHours,
+		AutoCancel:   cfg.OrderSLA.AutoCancel,
+	}
+}
+
+// primaryRepairConfig maps config to primary wallet consistency job settings
+func primaryRepairConfig(cfg *config.Config) wallet.PrimaryRepairConfig {
+	return wallet.PrimaryRepairConfig{
+		Interval:  cfg.Primary.RepairInterval,
+		BatchSize: cfg.Primary.BatchSize,
+	}
+}
+
+// retentionConfig maps config to retention engine settings
+func retentionConfig(cfg *config.Config) retention.Config {
+	return retention.Config{
+		Policy: retention.Policy{
+			AuditLogs:         cfg.Retention.AuditLogs,
+			WebhookDeliveries: cfg.Retention.WebhookDeliveries,
+			OutboxEvents:      cfg.Retention.OutboxEvents,
+		},
+		Interval:  cfg.Retention.Interval,
+		BatchSize: cfg.Retention.BatchSize,
+	}
+}
+
+// settlementConfig maps config to payout policy and gas model; an invalid approval threshold is fatal
+func settlementConfig(cfg *config.Config, logger *zap.Logger) settlement.Config {
+	threshold, err := money.Parse(cfg.Settlement.ApprovalThreshold, "", 8)
+	if err != nil || threshold.Sign() < 0 {
+		logger.Fatal("invalid payout approval threshold",
+			zap.String("threshold", cfg.Settlement.ApprovalThreshold),
+			zap.Error(err),
+		)
+	}
+
+	return settlement.Config{
+		HoldPeriod:         cfg.Settlement.HoldPeriod,
+		BatchHour:          cfg.Settlement.BatchHour,
+		TransferGas:        uint64(cfg.Settlement.TransferGas),
+		BatchBaseGas:       uint64(cfg.Settlement.BatchBaseGas),
+		BatchTransferGas:   uint64(cfg.Settlement.BatchTransferGas),
+		BatchMaxRecipients: cfg.Settlement.BatchMaxRecipients,
+		NettingWindow:      cfg.Settlement.NettingWindow,
+		ApprovalThreshold:  threshold,
+	}
+}
+
+// riskRules maps config to counterparty scoring rules
+// feeConfig parses the fee schedule; an invalid schedule is fatal
+func feeConfig(cfg *config.Config, logger *zap.Logger) fee.Config {
+	schedule, err := fee.ParseSchedule(cfg.Fee.Tiers)
+	if err == nil {
+		err = schedule.Validate()
+	}
+	if err != nil {
+		logger.Fatal("invalid fee schedule", zap.Error(err))
+	}
+	return fee.Config{
+		Schedule:     schedule,
+		VolumeWindow: cfg.Fee.VolumeWindow,
+	}
+}
+
+// limitDefaults parses the default account limits by KYC status; invalid defaults are fatal
+func limitDefaults(cfg *config.Config, logger *zap.Logger) limits.Defaults {
+	defaults, err := limits.ParseDefaults(cfg.Limits.Defaults)
+	if err == nil {
+		err = defaults.Validate()
+	}
+	if err != nil {
+		logger.Fatal("invalid account limit defaults", zap.Error(err))
+	}
+	return defaults
+}
+
+// reconciliationConfig parses the reconciliation tolerance; an invalid tolerance is fatal
+func reconciliationConfig(cfg *config.Config, logger *zap.Logger) reconciliation.WorkerConfig {
+	tolerance, err := reconciliation.ParseTolerance(cfg.Reconcile.Tolerance)
+	if err != nil {
+		logger.Fatal("invalid reconciliation tolerance", zap.String("tolerance", cfg.Reconcile.Tolerance), zap.Error(err))
+	}
+	return reconciliation.WorkerConfig{
+		TreasuryAccountID: cfg.Reconcile.TreasuryAccountID,
+		TokenSymbol:       cfg.Chain.TokenSymbol,
+		Tolerance:         tolerance,
+		Interval:          cfg.Reconcile.Interval,
+	}
+}
+
+func riskRules(cfg *config.Config) risk.Rules {
+	return risk.Rules{
+		LatePaymentWeight: cfg.Risk.LatePaymentWeight,
+		DisputeWeight:     cfg.Risk.DisputeWeight,
+		ChargebackPenalty: cfg.Risk.ChargebackPenalty,
+		OverduePenalty:    cfg.Risk.OverduePenalty,
+		DunningPenalty:    cfg.Risk.DunningPenalty,
+		NetTermsMinScore:  cfg.Risk.NetTermsMinScore,
+		NetTermsMinOrders: cfg.Risk.NetTermsMinOrders,
+	}
+}
+
+// newScreener selects the wallet sanctions screener; invalid screening config is fatal
+func newScreener(cfg config.SanctionsConfig, logger *zap.Logger) screening.Screener {
+	screener, err := screening.New(screening.Config{
+		Provider:     cfg.Screener,
+		DenyList:     cfg.DenyList,
+		DenyListFile: cfg.DenyListFile,
+	})
+	if err != nil {
+		logger.Fatal("failed to initialize sanctions screener", zap.Error(err))
+	}
+	if list, ok := screener.(*screening.DenyList); ok {
+		logger.Info("sanctions screening enabled", zap.String("provider", cfg.Screener), zap.Int("entries", list.Len()))
+	}
+	return screener
+}
+
+// newTaxCalculator selects the tax calculator; invalid tax config is fatal
+func newTaxCalculator(cfg config.TaxConfig, logger *zap.Logger) tax.Calculator {
+	calculator, err := tax.New(tax.Config{
+		Provider:        cfg.Provider,
+		ExternalURL:     cfg.ExternalURL,
+		ExternalAPIKey:  cfg.ExternalAPIKey,
+		ExternalTimeout: cfg.ExternalTimeout,
+	}, logger)
+	if err != nil {
+		logger.Fatal("failed to initialize tax calculator", zap.Error(err))
+	}
+	logger.Info("tax calculation configured", zap.String("provider", calculator.Provider()))
+	return calculator
+}
+
+// newKycProvider selects the identity verification provider; invalid KYC config is fatal
+func newKycProvider(cfg config.KYCConfig, logger *zap.Logger) kyc.Provider {
+	provider, err := kyc.New(kyc.Config{
+		Provider:         cfg.Provider,
+		URL:              cfg.URL,
+		APIKey:           cfg.APIKey,
+		Timeout:          cfg.Timeout,
+		WebhookSecret:    cfg.WebhookSecret,
+		WebhookTolerance: cfg.WebhookTolerance,
+	}, logger)
+	if err != nil {
+		logger.Fatal("failed to initialize kyc provider", zap.Error(err))
+	}
+	logger.Info("kyc verification configured", zap.String("provider", provider.Name()))
+	return provider
+}
+
+// newSMSSender selects the SMS sender for verification codes; invalid SMS config is fatal
+func newSMSSender(cfg config.OTPConfig, logger *zap.Logger) sms.Sender {
+	sender, err := sms.New(sms.Config{
+		Provider: cfg.SMSProvider,
+		URL:      cfg.SMSURL,
+		APIKey:   cfg.SMSAPIKey,
+		Timeout:  cfg.SMSTimeout,
+	}, logger)
+	if err != nil {
+		logger.Fatal("failed to initialize sms sender", zap.Error(err))
+	}
+	logger.Info("sms delivery configured", zap.String("provider", sender.Provider()))
+	return sender
+}
+
+// newDepositKey parses the deposit xpub (nil = deposit addresses disabled); an invalid xpub is fatal
+func newDepositKey(cfg config.DepositConfig, logger *zap.Logger) *hdwallet.ExtendedPublicKey {
+	if cfg.XPub == "" {
+		logger.Info("deposit addresses disabled (DEPOSIT_XPUB not set)")
+		return nil
+	}
+	key, err := hdwallet.ParseExtendedPublicKey(cfg.XPub)
+	if err != nil {
+		logger.Fatal("failed to parse deposit xpub", zap.Error(err))
+	}
+	logger.Info("deposit addresses enabled", zap.String("key_id", key.ID()), zap.Uint8("depth", key.Depth()))
+	return key
+}
+
+// newNonceStore selects the nonce store backend (memory is for local development only)
+func newNonceStore(cfg config.NonceConfig, rdb *redis.Client, logger *zap.Logger) nonce.Store {
+	switch cfg.Store {
+	case nonce.BackendRedis:
+		return nonce.NewRedisStoreWithTTL(rdb, cfg.TTL, logger)
+	case nonce.BackendMemory:
+		logger.Warn("using in-memory nonce store, replay protection is per-instance only")
+		return nonce.NewMemoryStoreWithTTL(cfg.TTL, logger)
+	default:
+		logger.Fatal("unknown nonce store", zap.String("store", cfg.Store))
+		return nil
+	}
+}
+
+// newNetworkRegistry builds the supported chain registry; invalid network config is fatal
+func newNetworkRegistry(cfg config.EIP712Config, logger *zap.Logger) *chain.Registry {
+	supported := cfg.SupportedNetworks()
+	networks := make([]chain.Network, 0, len(supported))
+	for _, n := range supported {
+		networks = append(networks, chain.Network{
+			ChainID:           n.ChainID,
+			Name:              n.Name,
+			VerifyingContract: n.VerifyingContract,
+		})
+	}
+
+	registry, err := chain.NewRegistry(cfg.ChainID, networks)
+	if err != nil {
+		logger.Fatal("invalid network configuration", zap.Error(err))
+	}
+	return registry
+}
+
+// newTokenRegistry builds the settlement token registry; invalid token config is fatal
+func newTokenRegistry(cfg config.ChainConfig, logger *zap.Logger) *chain.TokenRegistry {
+	supported := cfg.SupportedTokens()
+	tokens := make([]chain.Token, 0, len(supported))
+	for _, t := range supported {
+		tokens = append(tokens, chain.Token{
+			Symbol:    t.Symbol,
+			Decimals:  t.Decimals,
+			Addresses: map[int64]string{t.ChainID: t.Address},
+		})
+	}
+
+	registry, err := chain.NewTokenRegistry(cfg.TokenSymbol, tokens)
+	if err != nil {
+		logger.Fatal("invalid token configuration", zap.Error(err))
+	}
+	return registry
+}
+
+// newPriceFeeds builds the Chainlink feed oracle (nil when the chain is disabled or no feed is configured);
+// invalid feed config is fatal
+func newPriceFeeds(cfg config.OracleConfig, ethClient *chain.EthClient, logger *zap.Logger) *oracle.Chainlink {
+	if ethClient == nil || len(cfg.Feeds) == 0 {
+		return nil
+	}
+	feeds, err := oracle.NewChainlink(ethClient, cfg.Feeds, cfg.MaxAge)
+	if err != nil {
+		logger.Fatal("invalid ORACLE_FEEDS", zap.Error(err))
+	}
+	logger.Info("chainlink exchange rate feeds enabled", zap.Int("feeds", feeds.Len()), zap.Duration("max_age", cfg.MaxAge))
+	return feeds
+}
+
+// newOracle builds the exchange rate oracle: Chainlink feeds (when enabled) with
+// static rates as fallback; invalid oracle config is fatal
+func newOracle(cfg config.OracleConfig, feeds *oracle.Chainlink, logger *zap.Logger) oracle.Oracle {
+	var sources []oracle.Oracle
+	if feeds != nil {
+		sources = append(sources, feeds)
+	}
+
+	static, err := oracle.NewStatic(cfg.StaticRates)
+	if err != nil {
+		logger.Fatal("invalid ORACLE_STATIC_RATES", zap.Error(err))
+	}
+	if static.Len() > 0 {
+		logger.Info("static exchange rates enabled", zap.Int("rates", static.Len()))
+	}
+	sources = append(sources, static)
+
+	return oracle.NewFallback(logger, sources...)
+}
+
+// newDepegMonitor creates the settlement token depeg monitor. Returns nil (breaker disabled)
+// without a Chainlink feed of the token: static rates would hide a depeg.
+func newDepegMonitor(cfg *config.Config, feeds *oracle.Chainlink, logger *zap.Logger) *depeg.Monitor {
+	monitor := depeg.NewMonitor(feeds, depeg.Config{
+		TokenSymbol:  cfg.Chain.TokenSymbol,
+		ThresholdBps: int64(cfg.Depeg.ThresholdBps),
+		Interval:     cfg.Depeg.CheckInterval,
+	}, logger)
+	if feeds == nil || !feeds.Has(monitor.Pair()) {
+		logger.Warn("depeg circuit breaker disabled (no chainlink feed for the settlement token)", zap.String("pair", monitor.Pair().String()))
+		return nil
+	}
+	return monitor
+}
+
+// newArtifactStore creates the artifact store and applies its lifecycle rules; invalid storage config is fatal
+func newArtifactStore(cfg *config.Config, logger *zap.Logger) storage.Store {
+	storageCfg := storage.Config{
+		Driver:          cfg.Storage.Driver,
+		LocalDir:        cfg.Storage.LocalDir,
+		PublicURL:       cfg.Storage.PublicURL,
+		SigningKey:      cfg.Storage.SigningKey,
+		Bucket:          cfg.Storage.Bucket,
+		Region:          cfg.Storage.Region,
+		Endpoint:        cfg.Storage.Endpoint,
+		AccessKeyID:     cfg.Storage.AccessKeyID,
+		SecretAccessKey: cfg.Storage.SecretAccessKey,
+	}
+	if cfg.Storage.ReportExportDays > 0 {
+		storageCfg.Lifecycle = append(storageCfg.Lifecycle, storage.Rule{Prefix: storage.PrefixReportExports, ExpireAfterDays: cfg.Storage.ReportExportDays})
+	}
+	if cfg.Storage.DataExportDays > 0 {
+		storageCfg.Lifecycle = append(storageCfg.Lifecycle, storage.Rule{Prefix: storage.PrefixDataExports, ExpireAfterDays: cfg.Storage.DataExportDays})
+	}
+	if storageCfg.Driver == "" || storageCfg.Driver == storage.DriverLocal {
+		if storageCfg.PublicURL == "" {
+			storageCfg.PublicURL = fmt.Sprintf("http://localhost:%d/files", cfg.Server.Port)
+		}
+		if storageCfg.SigningKey == "" {
+			logger.Warn("STORAGE_SIGNING_KEY not set, download links are valid for this process only")
+			storageCfg.SigningKey = uuid.NewString()
+		}
+	}
+
+	store, err := storage.New(storageCfg, logger)
+	if err != nil {
+		logger.Fatal("failed to initialize artifact storage", zap.Error(err))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := store.ApplyLifecycle(ctx); err != nil {
+		logger.Warn("failed to apply artifact lifecycle rules", zap.Error(err))
+	}
+	logger.Info("artifact storage initialized", zap.String("driver", cfg.Storage.Driver), zap.Int("lifecycle_rules", len(storageCfg.Lifecycle)))
+	return store
+}
+
+// newDocumentStore wraps the artifact store with encryption for KYC documents; an invalid key is fatal.
+// Without STORAGE_ENCRYPTION_KEY a random key is used outside production - documents are unreadable after a restart.
+func newDocumentStore(cfg *config.Config, artifacts storage.Store, logger *zap.Logger) storage.Store {
+	key, err := hex.DecodeString(cfg.Storage.EncryptionKey)
+	if err != nil {
+		logger.Fatal("STORAGE_ENCRYPTION_KEY must be hex encoded", zap.Error(err))
+	}
+	if len(key) == 0 {
+		if cfg.Server.Environment == "production" {
+			logger.Fatal("STORAGE_ENCRYPTION_KEY is required in production")
+		}
+		logger.Warn("STORAGE_ENCRYPTION_KEY not set, KYC documents are readable by this process only")
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			logger.Fatal("failed to generate storage encryption key", zap.Error(err))
+		}
+	}
+
+	store, err := storage.NewEncrypted(artifacts, key)
+	if err != nil {
+		logger.Fatal("failed to initialize encrypted document storage", zap.Error(err))
+	}
+	return store
+}
+
+// eip712Domains maps the supported networks to per-chain EIP-712 domains
+func eip712Domains(networks *chain.Registry) []eip712.Domain {
+	domains := make([]eip712.Domain, 0, len(networks.Networks()))
+	for _, n := range networks.Networks() {
+		domains = append(domains, eip712.Domain{ChainID: n.ChainID, VerifyingContract: n.VerifyingContract})
+	}
+	return domains
+}
+
+// featureFlags reports which optional features this deployment has enabled (GET /me)
+func featureFlags(cfg *config.Config, ethClient *chain.EthClient) map[string]bool {
+	return map[string]bool{
+		me.FeatureOnChainSettlement:  ethClient != nil,
+		me.FeatureMicroTransfer:      ethClient != nil && cfg.Chain.MinterPrivateKey != "",
+		me.FeatureEventStreaming:     cfg.EventBus.Driver != "" && cfg.EventBus.Driver != eventbus.DriverNoop,
+		me.FeatureDistributedTracing: cfg.Tracing.OTLPEndpoint != "",
+	}
+}
+
+// newFixtureRegistry collects the canonical DTO examples (Swagger) and mock operation responses
+func newFixtureRegistry() *fixtures.Registry {
+	registry := fixtures.NewRegistry()
+	user.RegisterFixtures(registry)
+	wallet.RegisterFixtures(registry)
+	settlement.RegisterFixtures(registry)
+	return registry
+}
+
+// traceFilter excludes probe, metrics and docs endpoints from tracing
+func traceFilter(r *http.Request) bool {
+	path := r.URL.Path
+	return path != "/health" && path != "/ready" && path != "/metrics" &&
+		!strings.HasPrefix(path, "/swagger/") && !strings.HasPrefix(path, "/swagger-admin/")
+}
+
+func setupRouter(cfg *config.Config, logger *zap.Logger, db *sql.DB, rdb *redis.Client, ethClient *chain.EthClient, priceFeeds *oracle.Chainlink, depegMonitor *depeg.Monitor, artifacts storage.Store) *gin.Engine {
 	if cfg.Server.Environment == "production" {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
 	router := gin.New()
+	// Why: gin은 기본적으로 모든 X-Forwarded-For를 신뢰 → 설정된 프록시만 신뢰 (ClientIP 기반 레이트 리밋/감사 IP)
+	if err := router.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		logger.Fatal("invalid trusted proxies", zap.Error(err))
+	}
 
 	// Global middleware
 	router.Use(gin.Recovery())
+	// Why: RequestID/Logger보다 먼저 span을 열어 incoming traceparent를 request context에 연결
+	router.Use(otelgin.Middleware(cfg.Tracing.ServiceName, otelgin.WithFilter(traceFilter)))
 	router.Use(middleware.RequestID())
 	router.Use(middleware.Logger(logger))
 
-	// Swagger 설정
+	// Swagger 설정 (공개 가맹점 API / 내부 관리자 API 문서 분리)
 	docs.SwaggerInfo.Host = fmt.Sprintf("localhost:%d", cfg.Server.Port)
-	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	apiFixtures := newFixtureRegistry()
+	if err := apidocs.Register(docs.SwaggerInfo, apiFixtures.Examples()); err != nil {
+		logger.Fatal("failed to split swagger document", zap.Error(err))
+	}
+	// Why: gin-swagger가 handler.Prefix를 요청마다 바꾸므로 라우트별로 별도 핸들러 사용
+	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.NewHandler(), ginSwagger.InstanceName(apidocs.InstancePublic)))
 
 	// Health endpoints
 	healthHandler := handler.NewHealthHandler(db, rdb)
 	router.GET("/health", healthHandler.Health)
 	router.GET("/ready", healthHandler.Ready)
 
+	// Signed artifact downloads (로컬 저장소만 - S3/GCS 서명 URL은 버킷으로 직접 연결)
+	if local, ok := artifacts.(*storage.Local); ok {
+		handler.NewFileHandler(local).RegisterRoutes(router)
+	}
+
+	// Prometheus metrics (outbound HTTP client metrics of external integrations)
+	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
+
 	// ============================================================================
 	// Dependencies Setup
 	// ============================================================================
@@ -195,49 +997,357 @@ func setupRouter(cfg *config.Config, logger *zap.Logger, db *sql.DB, rdb *redis.
 	txRunner := pkgdb.NewTxRunner(db)
 
 	// Nonce store for EIP-712 replay protection
-	nonceStore := nonce.NewRedisStore(rdb, logger)
+	nonceStore := newNonceStore(cfg.Nonce, rdb, logger)
+
+	// ERC-1271 contract wallet verification (nil interface when chain is disabled → EOA only)
+	var contractValidator eip712.ContractSignatureValidator
+	if ethClient != nil {
+		contractValidator = ethClient
+	}
+
+	// Supported networks (wallet chain_id + per-chain EIP-712 domains)
+	networks := newNetworkRegistry(cfg.EIP712, logger)
+
+	// Supported settlement tokens (order/payment token_symbol + per-token decimals)
+	tokens := newTokenRegistry(cfg.Chain, logger)
 
 	// EIP-712 verifier for wallet signature verification
 	verifier := eip712.NewEthVerifier(eip712.Config{
-		ChainID:            cfg.EIP712.ChainID,
-		VerifyingContract:  cfg.EIP712.VerifyingContract,
-		TimestampTolerance: cfg.EIP712.TimestampTolerance,
-	}, nonceStore, logger)
+		ChainID:              cfg.EIP712.ChainID,
+		VerifyingContract:    cfg.EIP712.VerifyingContract,
+		TimestampTolerance:   cfg.EIP712.TimestampTolerance,
+		AllowPersonalSign:    cfg.EIP712.AllowPersonalSign,
+		AllowLegacyTypedData: cfg.EIP712.AllowLegacyTypedData,
+		Domains:              eip712Domains(networks),
+	}, nonceStore, contractValidator, logger)
+
+	// Idempotency-Key response store
+	idempotencyStore := idempotency.NewRedisStore(rdb, cfg.Idempotency.TTL, cfg.Idempotency.LockTTL, logger)
+
+	// Rate limiter (token bucket)
+	rateLimiter := ratelimit.NewRedisLimiter(rdb, logger)
+	sensitiveLimit := ratelimit.Limit{
+		PerMinute: cfg.RateLimit.SensitivePerMinute,
+		Burst:     cfg.RateLimit.SensitiveBurst,
+	}
+	rateLimitPolicy := middleware.RateLimitPolicy{
+		PerIP:     ratelimit.Limit{PerMinute: cfg.RateLimit.IPPerMinute, Burst: cfg.RateLimit.IPBurst},
+		PerAPIKey: ratelimit.Limit{PerMinute: cfg.RateLimit.APIKeyPerMinute, Burst: cfg.RateLimit.APIKeyBurst},
+		// 민감 라우트 (brute-force / 스팸 대상)
+		Routes: map[string]ratelimit.Limit{
+			"POST /api/v1/users":                                              sensitiveLimit,
+			"POST /api/v1/users/:id/api-keys":                                 sensitiveLimit,
+			"POST /api/v1/users/:id/wallets/batch":                            sensitiveLimit,
+			"GET /api/v1/users/:id/wallets/:walletId/verification-challenge":  sensitiveLimit,
+			"POST /api/v1/users/:id/wallets/:walletId/verify":                 sensitiveLimit,
+			"POST /api/v1/users/:id/wallets/:walletId/micro-transfer":         sensitiveLimit,
+			"POST /api/v1/users/:id/wallets/:walletId/micro-transfer/confirm": sensitiveLimit,
+			"POST /api/v1/users/:id/phone/otp":                                sensitiveLimit,
+			"POST /api/v1/users/:id/phone/verify":                             sensitiveLimit,
+		},
+	}
+
+	// Chain client (nil interface when disabled - typed nil would pass nil checks)
+	var chainClient chain.Client
+	if ethClient != nil {
+		chainClient = ethClient
+	}
+
+	// Payment breaker (nil interface when the depeg monitor is disabled)
+	var paymentBreaker middleware.PaymentBreaker
+	if depegMonitor != nil {
+		paymentBreaker = depegMonitor
+	}
 
 	// ============================================================================
 	// Service & Handler Setup
 	// ============================================================================
 
+	// API key service & handler (also authenticates X-API-Key)
+	apiKeyService := apikey.NewService(txRunner, logger)
+	apiKeyHandler := apikey.NewHandler(apiKeyService)
+
 	// User service & handler
-	userService := user.NewService(txRunner, logger)
+	userService := user.NewService(txRunner, newKycProvider(cfg.KYC, logger), logger)
 	userHandler := user.NewHandler(userService)
 
+	// Phone verification (OTP by SMS; gates payout address changes and large payouts)
+	otpLimits := otp.Limits{
+		CodeTTL:     cfg.OTP.CodeTTL,
+		Cooldown:    cfg.OTP.Cooldown,
+		SendLimit:   cfg.OTP.SendLimit,
+		SendWindow:  cfg.OTP.SendWindow,
+		MaxAttempts: cfg.OTP.MaxAttempts,
+	}
+	otpService := otp.NewService(txRunner, otp.NewStore(rdb, otpLimits), newSMSSender(cfg.OTP, logger), otpLimits, logger)
+	otpHandler := otp.NewHandler(otpService)
+
+	// KYC documents (encrypted at rest, reviewed by admins before the KYC decision)
+	kycDocumentService := kycdocument.NewService(txRunner, newDocumentStore(cfg, artifacts, logger), kycdocument.Config{
+		MaxFileSize:         cfg.KYC.DocumentMaxSize,
+		MaxDocumentsPerUser: cfg.KYC.DocumentMaxPerUser,
+	}, logger)
+	kycDocumentHandler := kycdocument.NewHandler(kycDocumentService)
+
 	// Wallet service & handler
-	walletService := wallet.NewService(txRunner, verifier, logger)
+	screener := newScreener(cfg.Sanctions, logger)
+	walletService := wallet.NewService(txRunner, verifier, chainClient, networks, cache.NewRedisStore(rdb), screener, logger)
 	walletHandler := wallet.NewHandler(walletService)
 
+	// Primary wallet consistency report & repair (admin)
+	primaryRepairer := wallet.NewPrimaryRepairer(txRunner, primaryRepairConfig(cfg), logger)
+	primaryHandler := wallet.NewPrimaryHandler(primaryRepairer)
+
+	// Payout address whitelist service & handler
+	payoutAddressService := payoutaddress.NewService(txRunner, verifier, networks, screener, payoutaddress.Config{
+		ActivationDelay:     cfg.Payout.ActivationDelay,
+		PhoneChangeCooldown: cfg.Payout.PhoneChangeCooldown,
+	}, logger)
+	payoutAddressHandler := payoutaddress.NewHandler(payoutAddressService)
+
+	// Delegated signer service & handler (signing rights delegated by the primary wallet)
+	delegatedSignerService := delegatedsigner.NewService(txRunner, verifier, logger)
+	delegatedSignerHandler := delegatedsigner.NewHandler(delegatedSignerService)
+
+	tokenHandler := token.NewHandler(tokens, networks)
+
+	// Fiat price quotes (exchange rate oracle → stablecoin amount)
+	quoteService := quote.NewService(newOracle(cfg.Oracle, priceFeeds, logger), tokens, quote.Config{
+		TTL: cfg.Oracle.QuoteTTL,
+	}, logger)
+	quoteHandler := quote.NewHandler(quoteService)
+
+	// Tax (buyer's tax country → taxes calculated on order/invoice creation by TAX_PROVIDER)
+	taxEngine := tax.NewEngine(newTaxCalculator(cfg.Tax, logger))
+	taxHandler := tax.NewHandler(tax.NewService(txRunner, taxEngine, logger))
+
+	// Discounts (seller coupons + product volume tiers → applied when a purchase order is accepted)
+	discountHandler := discount.NewHandler(discount.NewService(txRunner, logger))
+
+	// Products (variants with their own SKU/price/stock + variant-level inventory)
+	productHandler := product.NewHandler(product.NewService(txRunner, logger))
+
+	// Categories (closure-table tree keyed by products.category → catalog filters, budgets)
+	categoryHandler := category.NewHandler(category.NewService(txRunner, logger))
+
+	// Warehouses (inventory locations with country + priority → checkout reservation strategies, transfers)
+	warehouseHandler := warehouse.NewHandler(warehouse.NewService(txRunner, logger))
+
+	// Purchase orders (buyer PO → seller accept/reject/counter → order at locked prices)
+	purchaseOrderHandler := purchaseorder.NewHandler(purchaseorder.NewService(txRunner, tokens, taxEngine, logger))
+
+	// Carts (buyer draft orders revalidated on each change → checkout as a PENDING order with reserved stock)
+	cartHandler := cart.NewHandler(cart.NewService(txRunner, tokens, taxEngine, cart.Config{
+		TTL:                 cfg.Cart.TTL,
+		ReservationStrategy: cfg.Cart.ReservationStrategy,
+		ReservationTTL:      cfg.Reservation.TTL,
+	}, logger))
+
+	// RFQs (buyer requests pricing → seller quotes with expiry + locked FX → accepted quote becomes an order)
+	rfqHandler := rfq.NewHandler(rfq.NewService(txRunner, tokens, quoteService, taxEngine, rfq.Config{
+		MaxQuoteValidity: cfg.RFQ.MaxQuoteValidity,
+	}, logger))
+
+	// Invoices (order-based or manual, DRAFT → ISSUED → PAID; deposits matched by the invoice job)
+	invoiceService := invoice.NewService(txRunner, tokens, taxEngine, logger)
+	invoiceHandler := invoice.NewHandler(invoiceService)
+
+	// Net terms (seller credit limit per buyer → orders on terms invoiced with due dates)
+	creditHandler := credit.NewHandler(credit.NewService(txRunner, tokens, invoiceService, logger))
+
+	orderSLAService := ordersla.NewService(txRunner, orderSLADefaults(cfg), logger)
+	orderSLAHandler := ordersla.NewHandler(orderSLAService)
+
+	budgetService := budget.NewService(txRunner, logger)
+	budgetHandler := budget.NewHandler(budgetService)
+
+	// Deposit address service & handler (HD-wallet derivation from DEPOSIT_XPUB)
+	depositService := deposit.NewService(txRunner, newDepositKey(cfg.Deposit, logger), logger)
+	depositHandler := deposit.NewHandler(depositService)
+
+	// Webhook service & handler
+	webhookService := webhook.NewService(txRunner, webhook.Config{
+		MaxAttempts:       cfg.Webhook.MaxAttempts,
+		AllowInsecureURLs: cfg.Webhook.AllowInsecureURLs,
+	}, logger)
+	webhookHandler := webhook.NewHandler(webhookService)
+
+	// Runbook service & handler (admin incident operations)
+	runbookService := runbook.NewService(txRunner, runbook.Config{
+		RequeueRatePerMinute: cfg.Webhook.RequeueRatePerMinute,
+	}, logger)
+	runbookHandler := runbook.NewHandler(runbookService)
+
+	// Account limits enforcer (KYC 상태별 기본 한도 + 관리자 계정별 override, 이체/순지급 출금 한도에 공유)
+	limitEnforcer := limits.NewEnforcer(limitDefaults(cfg, logger))
+
+	// Account handler (admin freeze/unfreeze, transfers)
+	accountHandler := account.NewHandler(account.NewService(txRunner, limitEnforcer, logger))
+
+	// Account limits service & handler
+	limitsHandler := limits.NewHandler(limits.NewService(txRunner, limitEnforcer, logger))
+
+	// Statement handler (월간 계정 명세서 조회, 미생성 마감 월은 조회 시 생성)
+	statementHandler := statement.NewHandler(statement.NewService(txRunner, cfg.Statement.Grace, logger))
+
+	// Organization handler (회사 프로필/구성원 초대·역할, 조직 단위 주문/정산 조회)
+	organizationHandler := organization.NewHandler(organization.NewService(txRunner, cfg.Org.InvitationTTL, logger))
+
+	// Reconciliation handler (admin treasury discrepancy history)
+	reconciliationHandler := reconciliation.NewHandler(reconciliation.NewService(txRunner, logger))
+
+	// Retention service & handler (admin purge + run history)
+	retentionService := retention.NewService(txRunner, retentionConfig(cfg), logger)
+	retentionHandler := retention.NewHandler(retentionService)
+
+	// Legal hold service & handler (compliance admins)
+	legalHoldService := legalhold.NewService(txRunner, logger)
+	legalHoldHandler := legalhold.NewHandler(legalHoldService)
+
+	// Support case service & handler (support tool integration)
+	supportService := support.NewService(txRunner, logger)
+	supportHandler := support.NewHandler(supportService)
+
+	// Settlement service & handler (payout forecast, payout gas preview)
+	settlementService := settlement.NewService(txRunner, chainClient, limitEnforcer, settlementConfig(cfg, logger), logger)
+	settlementHandler := settlement.NewHandler(settlementService)
+
+	// Fee schedule & payment service/handler (payment detail with applied fee breakdown, refunds)
+	feeService := fee.NewService(txRunner, feeConfig(cfg, logger), logger)
+	feeHandler := fee.NewHandler(feeService)
+	paymentService := payment.NewService(txRunner, feeService, chainClient, payment.Config{
+		SettlementToken: cfg.Chain.TokenSymbol,
+	}, logger)
+	paymentHandler := payment.NewHandler(paymentService)
+
+	// Dispute service & handler (buyer disputes, seller fund holds, admin resolution)
+	disputeService := dispute.NewService(txRunner, paymentService, dispute.Config{
+		Window: cfg.Dispute.Window,
+	}, logger)
+	disputeHandler := dispute.NewHandler(disputeService)
+
+	// Order service & handler (cancellation before fulfillment with reservation release and refund)
+	orderHandler := order.NewHandler(order.NewService(txRunner, paymentService, logger))
+
+	// Returns (RMA) service & handler (buyer return requests, seller approval with restock + linked refund)
+	rmaHandler := rma.NewHandler(rma.NewService(txRunner, paymentService, rma.Config{
+		Window: cfg.RMA.Window,
+	}, logger))
+
+	// Counterparty risk score service & handler (net-terms eligibility)
+	scoringRules := riskRules(cfg)
+	if err := scoringRules.Validate(); err != nil {
+		logger.Fatal("invalid risk scoring rules", zap.Error(err))
+	}
+	riskService := risk.NewService(txRunner, scoringRules, logger)
+	riskHandler := risk.NewHandler(riskService)
+
+	// Soft launch rollout service & handler (pilot allowlists, admin)
+	rolloutService := rollout.NewService(txRunner, logger)
+	rolloutHandler := rollout.NewHandler(rolloutService)
+
+	// Historical transfer import service & handler (migrated merchants, admin)
+	historicalService := historical.NewService(txRunner, chainClient, logger)
+	historicalHandler := historical.NewHandler(historicalService)
+
+	// Public status feed & incident posting (admin)
+	statusService := status.NewService(txRunner, db, rdb, chainClient, status.Config{
+		CacheTTL:        cfg.Status.CacheTTL,
+		DegradedLag:     cfg.Status.DegradedLag,
+		OutageLag:       cfg.Status.OutageLag,
+		ChainTxTimeout:  cfg.Chain.TxTimeout,
+		IncidentHistory: cfg.Status.IncidentHistory,
+	}, logger)
+	statusHandler := status.NewHandler(statusService)
+
+	// API usage dashboard service & handler (self-serve, per API key)
+	usageService := usage.NewService(txRunner, logger)
+	usageHandler := usage.NewHandler(usageService)
+
+	// Who-am-I service & handler (capability discovery)
+	meService := me.NewService(userService, featureFlags(cfg, ethClient), logger)
+	meHandler := me.NewHandler(meService)
+
 	// ============================================================================
 	// Route Registration
 	// ============================================================================
 
+	// Admin API docs (관리자 API 키로만 조회)
+	router.GET("/swagger-admin/*any",
+		middleware.APIKeyAuth(apiKeyService),
+		middleware.RequireRoles(middleware.RoleAdmin),
+		ginSwagger.WrapHandler(swaggerFiles.NewHandler(), ginSwagger.InstanceName(apidocs.InstanceAdmin)),
+	)
+
 	// API v1 group
 	v1 := router.Group("/api/v1")
+	v1.Use(middleware.APIKeyAuth(apiKeyService))
+	// Usage metrics: RateLimit 앞에 등록 → 429 거절도 키별 사용량에 집계
+	v1.Use(middleware.UsageMetrics(usage.NewRecorder(rdb, logger)))
+	v1.Use(middleware.RateLimit(rateLimiter, rateLimitPolicy, logger))
+	v1.Use(middleware.Idempotency(idempotencyStore, logger))
 	{
 		// Phase 1: User & Wallet
+		meHandler.RegisterRoutes(v1)
 		userHandler.RegisterRoutes(v1)
+		otpHandler.RegisterRoutes(v1)
+		kycDocumentHandler.RegisterRoutes(v1)
 		walletHandler.RegisterRoutes(v1)
+		primaryHandler.RegisterRoutes(v1)
+		payoutAddressHandler.RegisterRoutes(v1)
+		delegatedSignerHandler.RegisterRoutes(v1)
+		organizationHandler.RegisterRoutes(v1)
+		tokenHandler.RegisterRoutes(v1)
+		quoteHandler.RegisterRoutes(v1)
+		purchaseOrderHandler.RegisterRoutes(v1)
+		cartHandler.RegisterRoutes(v1)
+		rfqHandler.RegisterRoutes(v1)
+		invoiceHandler.RegisterRoutes(v1)
+		creditHandler.RegisterRoutes(v1)
+		taxHandler.RegisterRoutes(v1)
+		discountHandler.RegisterRoutes(v1)
+		productHandler.RegisterRoutes(v1)
+		categoryHandler.RegisterRoutes(v1)
+		warehouseHandler.RegisterRoutes(v1)
+		orderSLAHandler.RegisterRoutes(v1)
+		budgetHandler.RegisterRoutes(v1)
+		depositHandler.RegisterRoutes(v1)
+		apiKeyHandler.RegisterRoutes(v1)
+		webhookHandler.RegisterRoutes(v1)
+		usageHandler.RegisterRoutes(v1)
 
-		// Phase 2: Products & Inventory (TODO)
-		_ = v1.Group("/products")
-		_ = v1.Group("/inventory")
+		// Operations (admin)
+		runbookHandler.RegisterRoutes(v1)
+		accountHandler.RegisterRoutes(v1)
+		limitsHandler.RegisterRoutes(v1)
+		statementHandler.RegisterRoutes(v1)
+		reconciliationHandler.RegisterRoutes(v1)
+		retentionHandler.RegisterRoutes(v1)
+		legalHoldHandler.RegisterRoutes(v1)
+		supportHandler.RegisterRoutes(v1)
+		historicalHandler.RegisterRoutes(v1)
+		rolloutHandler.RegisterRoutes(v1)
+		statusHandler.RegisterRoutes(v1)
+
+		// Sandbox: fixture 기반 mock 응답 (백엔드 구현 전 프론트엔드 연동용)
+		if cfg.Server.MockAPIEnabled {
+			handler.NewMockHandler(apiFixtures).RegisterRoutes(v1)
+		}
 
-		// Phase 3: Orders (TODO)
-		_ = v1.Group("/orders")
+		// Phase 3: Orders
+		// 결제 완료 주문 취소는 결제 환불을 발행 → 디페그 중 503 (조회는 허용)
+		orderHandler.RegisterRoutes(v1.Group("", middleware.RequirePaymentsOpen(paymentBreaker)))
 
-		// Phase 4: Payments & Settlements (TODO)
-		_ = v1.Group("/payments")
-		_ = v1.Group("/settlements")
-		_ = v1.Group("/accounts")
+		// Phase 4: Payments & Settlements
+		// Depeg circuit breaker: 정산 토큰 디페그 중 신규 결제 승인/환불 503 (조회는 허용)
+		paymentHandler.RegisterRoutes(v1.Group("", middleware.RequirePaymentsOpen(paymentBreaker)))
+		disputeHandler.RegisterRoutes(v1)
+		// 반품 승인은 결제 환불을 발행 → 디페그 중 503 (조회는 허용)
+		rmaHandler.RegisterRoutes(v1.Group("", middleware.RequirePaymentsOpen(paymentBreaker)))
+		feeHandler.RegisterRoutes(v1)
+		// Soft launch: 파일럿 가맹점에만 공개 (rollout 허용 목록, GA 전환 시 전체 공개)
+		settlementHandler.RegisterRoutes(v1.Group("", middleware.RequireFeature(rolloutService, rollout.FeatureSettlements)))
+		riskHandler.RegisterRoutes(v1)
 	}
 
 	return router
@@ -0,0 +1,3 @@
+-- Merchant API Keys 롤백
+
+DROP TABLE IF EXISTS api_keys;
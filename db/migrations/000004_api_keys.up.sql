@@ -0,0 +1,23 @@
+-- ============================================================================
+-- Merchant API Keys
+-- ============================================================================
+-- 원문 키는 저장하지 않음 - SHA-256 해시만 저장 (발급 시 1회만 응답에 노출)
+-- key_prefix: 대시보드/로그에서 키 식별용 (예: sk_1a2b3c4d)
+-- revoked_at: 폐기 시각 (NULL = 활성)
+
+CREATE TABLE api_keys (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    user_id BIGINT UNSIGNED NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    key_prefix VARCHAR(16) NOT NULL,
+    key_hash CHAR(64) NOT NULL,
+    last_used_at TIMESTAMP NULL,
+    revoked_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_api_key_external_id (external_id),
+    UNIQUE KEY uk_api_key_hash (key_hash),
+    INDEX idx_api_keys_user_revoked (user_id, revoked_at),
+    FOREIGN KEY (user_id) REFERENCES users(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,4 @@
+-- Wallet Verification Level + Micro-transfer 롤백
+
+DROP TABLE IF EXISTS wallet_micro_transfers;
+ALTER TABLE wallets DROP COLUMN verification_level;
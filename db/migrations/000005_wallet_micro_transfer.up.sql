@@ -0,0 +1,31 @@
+-- ============================================================================
+-- Wallet Verification Level + Micro-transfer 검증
+-- ============================================================================
+-- verification_level: 지갑 소유 검증 강도 (리스크 정책에서 사용)
+--   NONE           - 미검증
+--   SIGNATURE      - EIP-712 서명 검증 완료
+--   MICRO_TRANSFER - 서명 + 소액 송금 금액 확인 완료 (대액 출금 허용 수준)
+
+ALTER TABLE wallets
+ADD COLUMN verification_level ENUM('NONE', 'SIGNATURE', 'MICRO_TRANSFER') NOT NULL DEFAULT 'NONE';
+
+-- 기존 서명 검증 지갑 backfill
+UPDATE wallets SET verification_level = 'SIGNATURE' WHERE is_verified = true;
+
+-- 소액 송금 챌린지 (지갑당 여러 건, 최신 1건만 유효)
+-- amount: 토큰 단위 금액 (응답에 노출 금지 - 사용자가 온체인에서 확인 후 입력)
+CREATE TABLE wallet_micro_transfers (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    wallet_id BIGINT UNSIGNED NOT NULL,
+    amount DECIMAL(18,8) NOT NULL,
+    tx_hash VARCHAR(66),
+    status ENUM('PENDING', 'SENT', 'CONFIRMED', 'FAILED') NOT NULL DEFAULT 'PENDING',
+    attempts INT UNSIGNED NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP NOT NULL,
+    confirmed_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_wallet_created (wallet_id, created_at),
+    FOREIGN KEY (wallet_id) REFERENCES wallets(id),
+    CONSTRAINT chk_micro_amount CHECK (amount > 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
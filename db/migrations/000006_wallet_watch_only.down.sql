@@ -0,0 +1,12 @@
+-- Watch-only 지갑 롤백
+
+ALTER TABLE wallets
+DROP COLUMN is_watch_only,
+DROP COLUMN attested_by,
+DROP COLUMN attested_at,
+DROP COLUMN receivable_after;
+
+UPDATE wallets SET verification_level = 'NONE' WHERE verification_level = 'ATTESTED';
+
+ALTER TABLE wallets
+MODIFY COLUMN verification_level ENUM('NONE', 'SIGNATURE', 'MICRO_TRANSFER') NOT NULL DEFAULT 'NONE';
@@ -0,0 +1,16 @@
+-- ============================================================================
+-- Watch-only 지갑 (수취 전용)
+-- ============================================================================
+-- 서명 검증이 불가능한 주소(거래소 입금 주소 등)를 계정 소유자가 직접 보증(attest)하여 등록
+--   verification_level = 'ATTESTED' - 소유 증명 없음, 소유자 보증만 존재 (SIGNATURE보다 낮은 신뢰 수준)
+--   is_watch_only      - 수취 전용 (Primary 설정 불가, 서명 검증 시 일반 지갑으로 승격)
+--   receivable_after   - cooling-off 종료 시각 (이전에는 지급 대상 불가)
+
+ALTER TABLE wallets
+MODIFY COLUMN verification_level ENUM('NONE', 'ATTESTED', 'SIGNATURE', 'MICRO_TRANSFER') NOT NULL DEFAULT 'NONE';
+
+ALTER TABLE wallets
+ADD COLUMN is_watch_only BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN attested_by BIGINT UNSIGNED NULL,
+ADD COLUMN attested_at TIMESTAMP NULL,
+ADD COLUMN receivable_after TIMESTAMP NULL;
@@ -0,0 +1,4 @@
+-- Webhooks 롤백
+
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_endpoints;
@@ -0,0 +1,48 @@
+-- ============================================================================
+-- Webhooks
+-- ============================================================================
+-- webhook_endpoints: 가맹점이 등록한 수신 URL + 구독 이벤트 타입
+--   secret: HMAC 서명 키 (서명 계산에 원문 필요 → 해시 저장 불가, 응답에는 생성 시 1회만 노출)
+--   event_types: JSON 배열 (예: ["payment.captured", "kyc.approved"])
+-- webhook_deliveries: 이벤트 1건 × 엔드포인트 1개 = 전송 1건 (재시도 상태 포함)
+--   status: PENDING → DELIVERING → SUCCEEDED | (실패 시 PENDING 재시도) → DEAD
+--   next_attempt_at: 다음 시도 시각 (DELIVERING 상태에서는 lease 만료 시각)
+
+CREATE TABLE webhook_endpoints (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    user_id BIGINT UNSIGNED NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    event_types JSON NOT NULL,
+    secret VARCHAR(128) NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL,
+    UNIQUE KEY uk_webhook_endpoint_external_id (external_id),
+    INDEX idx_webhook_endpoints_user (user_id, deleted_at),
+    FOREIGN KEY (user_id) REFERENCES users(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE webhook_deliveries (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    endpoint_id BIGINT UNSIGNED NOT NULL,
+    event_id VARCHAR(64) NOT NULL,
+    event_type VARCHAR(64) NOT NULL,
+    payload JSON NOT NULL,
+    status ENUM('PENDING', 'DELIVERING', 'SUCCEEDED', 'DEAD') NOT NULL DEFAULT 'PENDING',
+    attempts INT UNSIGNED NOT NULL DEFAULT 0,
+    max_attempts INT UNSIGNED NOT NULL,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_status_code INT NULL,
+    last_error VARCHAR(512) NULL,
+    delivered_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_webhook_delivery_external_id (external_id),
+    UNIQUE KEY uk_webhook_delivery_event_endpoint (event_id, endpoint_id),
+    INDEX idx_webhook_deliveries_due (status, next_attempt_at),
+    INDEX idx_webhook_deliveries_endpoint (endpoint_id, created_at),
+    FOREIGN KEY (endpoint_id) REFERENCES webhook_endpoints(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,6 @@
+-- Outbox event routing 롤백
+
+ALTER TABLE outbox
+    DROP INDEX uk_outbox_event_id,
+    DROP COLUMN recipient_user_id,
+    DROP COLUMN event_id;
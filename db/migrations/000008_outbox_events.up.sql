@@ -0,0 +1,14 @@
+-- ============================================================================
+-- Outbox event routing
+-- ============================================================================
+-- outbox 테이블(000001)은 상태 변경과 같은 트랜잭션에서 기록되는 도메인 이벤트
+--   event_id: 이벤트 고유 ID (웹훅 전송/이벤트 버스 중복 제거 키)
+--   recipient_user_id: 이벤트를 받을 사용자 (웹훅 fan-out 대상, 없으면 NULL)
+--   status: PENDING → PROCESSING → COMPLETED | (실패 시 FAILED 재시도) → DEAD_LETTER
+--   next_retry_at: 다음 시도 시각 (PROCESSING 상태에서는 lease 만료 시각)
+-- NOTE: 기존에 outbox를 기록하는 코드가 없었으므로 기존 행은 없다고 가정
+
+ALTER TABLE outbox
+    ADD COLUMN event_id VARCHAR(64) NOT NULL AFTER id,
+    ADD COLUMN recipient_user_id BIGINT UNSIGNED NULL AFTER aggregate_id,
+    ADD UNIQUE KEY uk_outbox_event_id (event_id);
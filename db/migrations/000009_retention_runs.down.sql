@@ -0,0 +1,3 @@
+-- Data retention runs 롤백
+
+DROP TABLE IF EXISTS retention_runs;
@@ -0,0 +1,26 @@
+-- ============================================================================
+-- Data retention runs
+-- ============================================================================
+-- 데이터 클래스별 보존 기한이 지난 행을 삭제한 기록 (컴플라이언스 증빙용, 삭제 금지)
+--   data_class: audit_logs | webhook_deliveries | outbox_events
+--   cutoff: 이 시각 이전에 생성된 행이 삭제 대상
+--   trigger_type: SCHEDULE (purge job) | MANUAL (관리자 실행)
+--   triggered_by: MANUAL 실행 시 관리자 user id
+
+CREATE TABLE retention_runs (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    data_class VARCHAR(32) NOT NULL,
+    retention_seconds BIGINT UNSIGNED NOT NULL,
+    cutoff TIMESTAMP NOT NULL,
+    deleted_count BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    status ENUM('SUCCEEDED', 'FAILED') NOT NULL,
+    error_message VARCHAR(512) NULL,
+    trigger_type ENUM('SCHEDULE', 'MANUAL') NOT NULL,
+    triggered_by BIGINT UNSIGNED NULL,
+    started_at TIMESTAMP NOT NULL,
+    finished_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_retention_run_external_id (external_id),
+    INDEX idx_retention_runs_class (data_class, started_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
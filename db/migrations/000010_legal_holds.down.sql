@@ -0,0 +1,6 @@
+-- Legal holds 롤백
+
+DROP TABLE IF EXISTS legal_holds;
+
+ALTER TABLE users
+    DROP COLUMN is_compliance_officer;
@@ -0,0 +1,38 @@
+-- ============================================================================
+-- Legal holds
+-- ============================================================================
+-- 법적 보존 명령(소송/수사 등) 대상 데이터를 보존 기한 삭제·익명화에서 제외
+--   subject_type/subject_id: 보존 대상 (USER → users.id, ORDER → orders.id)
+--   subject_ref: 외부 노출용 식별자 (users.external_id / orders.order_number)
+--   released_at IS NULL → 활성 hold (is_active, 대상당 활성 hold는 1건 - active_subject unique)
+-- users.is_compliance_officer: hold 설정/해제 권한 (ADMIN + compliance)
+--   NOTE: 권한 상승 방지를 위해 API로 부여하지 않음 (보안팀이 DB에서 직접 부여)
+
+ALTER TABLE users
+    ADD COLUMN is_compliance_officer BOOLEAN NOT NULL DEFAULT FALSE AFTER role;
+
+CREATE TABLE legal_holds (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    subject_type ENUM('USER', 'ORDER') NOT NULL,
+    subject_id BIGINT UNSIGNED NOT NULL,
+    subject_ref VARCHAR(64) NOT NULL,
+    reason VARCHAR(500) NOT NULL,
+    case_reference VARCHAR(100) NULL,
+    placed_by BIGINT UNSIGNED NOT NULL,
+    placed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    released_by BIGINT UNSIGNED NULL,
+    released_at TIMESTAMP NULL,
+    release_reason VARCHAR(500) NULL,
+    is_active BOOLEAN GENERATED ALWAYS AS (released_at IS NULL) STORED,
+    active_subject VARCHAR(80) GENERATED ALWAYS AS (
+        CASE WHEN released_at IS NULL THEN CONCAT(subject_type, ':', subject_id) ELSE NULL END
+    ) STORED,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_legal_hold_external_id (external_id),
+    UNIQUE KEY uk_legal_hold_active_subject (active_subject),
+    INDEX idx_legal_holds_subject (subject_type, subject_id, released_at),
+    FOREIGN KEY (placed_by) REFERENCES users(id),
+    FOREIGN KEY (released_by) REFERENCES users(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,7 @@
+-- Support cases 롤백
+
+DROP TABLE IF EXISTS support_cases;
+
+ALTER TABLE payments
+    DROP INDEX uk_payment_external_id,
+    DROP COLUMN external_id;
@@ -0,0 +1,28 @@
+-- ============================================================================
+-- Support cases
+-- ============================================================================
+-- 외부 고객지원 도구(Zendesk 등) 티켓을 주문/결제에 연결 (대시보드에 "Case #1234 open" 표시)
+--   subject_type/subject_id: 연결 대상 (ORDER → orders.id, PAYMENT → payments.id)
+--   subject_ref: 외부 노출용 식별자 (orders.order_number / payments.external_id)
+--   ticket_id + status: 지원 도구가 소유 (상태 변경 시 같은 ticket을 다시 attach → upsert)
+-- payments.external_id: 결제 외부 식별자 (기존 결제는 NULL - 신규 결제부터 발급)
+
+ALTER TABLE payments
+    ADD COLUMN external_id VARCHAR(64) NULL AFTER id,
+    ADD UNIQUE KEY uk_payment_external_id (external_id);
+
+CREATE TABLE support_cases (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    subject_type ENUM('ORDER', 'PAYMENT') NOT NULL,
+    subject_id BIGINT UNSIGNED NOT NULL,
+    subject_ref VARCHAR(64) NOT NULL,
+    ticket_id VARCHAR(64) NOT NULL,
+    status ENUM('OPEN', 'PENDING', 'RESOLVED', 'CLOSED') NOT NULL DEFAULT 'OPEN',
+    ticket_url VARCHAR(2048) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_support_case_external_id (external_id),
+    UNIQUE KEY uk_support_case_subject_ticket (subject_type, subject_id, ticket_id),
+    INDEX idx_support_cases_ticket (ticket_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,6 @@
+-- Outbox event search 롤백
+
+ALTER TABLE outbox
+    DROP INDEX idx_outbox_recipient_aggregate,
+    DROP INDEX idx_outbox_recipient_created,
+    DROP COLUMN aggregate_external_id;
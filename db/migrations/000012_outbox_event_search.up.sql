@@ -0,0 +1,12 @@
+-- ============================================================================
+-- Outbox event search
+-- ============================================================================
+-- 통합 고객이 "이 주문에 대해 order.paid를 보냈나?"를 직접 조회 (GET /users/:id/events)
+--   aggregate_external_id: 이벤트 대상의 외부 식별자 (resource 필터용, 기존 행은 NULL)
+--   idx_outbox_recipient_created: 수신자별 시간 범위 조회
+--   idx_outbox_recipient_aggregate: 수신자별 resource 조회
+
+ALTER TABLE outbox
+    ADD COLUMN aggregate_external_id VARCHAR(64) NULL AFTER aggregate_id,
+    ADD INDEX idx_outbox_recipient_created (recipient_user_id, created_at),
+    ADD INDEX idx_outbox_recipient_aggregate (recipient_user_id, aggregate_type, aggregate_external_id);
@@ -0,0 +1,7 @@
+-- Order dunning 롤백
+
+DROP TABLE IF EXISTS order_dunning_steps;
+
+ALTER TABLE orders
+    DROP INDEX idx_orders_status_due,
+    DROP COLUMN payment_due_at;
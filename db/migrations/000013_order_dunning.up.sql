@@ -0,0 +1,24 @@
+-- ============================================================================
+-- Order dunning
+-- ============================================================================
+-- 미결제(CONFIRMED) 주문에 대한 독촉 스케줄 (리마인더 → 최종 조치)
+--   orders.payment_due_at: 결제 기한 (NULL이면 주문 생성 시각 기준)
+--   order_dunning_steps: 주문별 실행된 독촉 단계 (단계당 1회, 재실행 방지)
+--     days_overdue: 기한 경과 일수 (스케줄상 단계 식별자)
+--     action: REMINDER | CANCEL | CREDIT_HOLD
+-- NOTE: late fee(연체료)는 원장 기록 경로가 생긴 뒤 별도 추가
+
+ALTER TABLE orders
+    ADD COLUMN payment_due_at TIMESTAMP NULL AFTER total_amount,
+    ADD INDEX idx_orders_status_due (status, payment_due_at);
+
+CREATE TABLE order_dunning_steps (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    order_id BIGINT UNSIGNED NOT NULL,
+    days_overdue INT UNSIGNED NOT NULL,
+    action ENUM('REMINDER', 'CANCEL', 'CREDIT_HOLD') NOT NULL,
+    event_id VARCHAR(64) NULL,
+    executed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_order_dunning_step (order_id, days_overdue),
+    FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,10 @@
+-- Multi-chain wallets 롤백
+-- NOTE: 같은 주소가 여러 체인에 등록된 경우 uk_wallet_address_active 복원 전 정리 필요
+
+ALTER TABLE wallets
+    DROP INDEX idx_wallets_user_chain,
+    DROP INDEX uk_wallet_address_chain_active,
+    ADD UNIQUE KEY uk_wallet_address_active (address_active);
+
+ALTER TABLE wallets
+    DROP COLUMN chain_id;
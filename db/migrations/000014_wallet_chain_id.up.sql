@@ -0,0 +1,17 @@
+-- ============================================================================
+-- Multi-chain wallets
+-- ============================================================================
+-- 지갑을 체인별로 등록 (같은 주소를 여러 네트워크에 등록 가능)
+--   chain_id: EIP-155 chain id (NETWORKS 레지스트리에 등록된 체인만 허용)
+--   uk_wallet_address_chain_active: 삭제되지 않은 지갑 기준 (주소, 체인) 유일
+-- NOTE: 기존 지갑은 chain_id=1(Ethereum mainnet)로 채워짐
+--       EIP712_CHAIN_ID가 1이 아닌 배포는 마이그레이션 직후 아래처럼 보정 필요
+--       UPDATE wallets SET chain_id = <EIP712_CHAIN_ID>;
+
+ALTER TABLE wallets
+    ADD COLUMN chain_id BIGINT UNSIGNED NOT NULL DEFAULT 1 AFTER address;
+
+ALTER TABLE wallets
+    DROP INDEX uk_wallet_address_active,
+    ADD UNIQUE KEY uk_wallet_address_chain_active (address_active, chain_id),
+    ADD INDEX idx_wallets_user_chain (user_id, chain_id);
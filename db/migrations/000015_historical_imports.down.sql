@@ -0,0 +1,4 @@
+-- Historical on-chain transfer import 롤백
+
+DROP TABLE IF EXISTS historical_ledger_entries;
+DROP TABLE IF EXISTS historical_imports;
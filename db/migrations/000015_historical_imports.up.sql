@@ -0,0 +1,51 @@
+-- ============================================================================
+-- Historical on-chain transfer import (마이그레이션 가맹점)
+-- ============================================================================
+-- 플랫폼 이전(migration) 전 가맹점 지갑의 스테이블코인 전송 이력을 원장과 별도로 기록
+--   historical_imports: 관리자 import 실행 1건 (tx hash 목록 또는 주소 블록 범위 스캔)
+--   historical_ledger_entries: 온체인 검증된 전송 1건 = 항목 1건
+--     entry_type: CREDIT(가맹점 지갑 수신) | DEBIT(가맹점 지갑 송신)
+--     uk_historical_entry: (계정, tx, log index) 유일 - 재실행해도 중복 기록 없음
+-- NOTE: ledger_entries(잔액 source of truth)와 분리 - 이력은 플랫폼 잔액(accounts.balance)에 반영되지 않음
+--       기초 잔액(opening balance)과 리포트는 두 테이블을 이어서 계산
+
+CREATE TABLE historical_imports (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    user_id BIGINT UNSIGNED NOT NULL,
+    account_id BIGINT UNSIGNED NOT NULL,
+    source ENUM('TX_HASHES', 'ADDRESS_SCAN') NOT NULL,
+    from_block BIGINT UNSIGNED NULL,
+    to_block BIGINT UNSIGNED NULL,
+    imported_count INT UNSIGNED NOT NULL DEFAULT 0,
+    duplicate_count INT UNSIGNED NOT NULL DEFAULT 0,
+    rejected_count INT UNSIGNED NOT NULL DEFAULT 0,
+    created_by BIGINT UNSIGNED NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_historical_import_external_id (external_id),
+    INDEX idx_historical_imports_user (user_id, created_at),
+    FOREIGN KEY (user_id) REFERENCES users(id),
+    FOREIGN KEY (account_id) REFERENCES accounts(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE historical_ledger_entries (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    import_id BIGINT UNSIGNED NOT NULL,
+    account_id BIGINT UNSIGNED NOT NULL,
+    entry_type ENUM('DEBIT', 'CREDIT') NOT NULL,
+    amount DECIMAL(18,8) NOT NULL,
+    chain_id BIGINT UNSIGNED NOT NULL,
+    tx_hash VARCHAR(66) NOT NULL,
+    log_index INT UNSIGNED NOT NULL,
+    block_number BIGINT UNSIGNED NOT NULL,
+    block_time TIMESTAMP NOT NULL,
+    wallet_address VARCHAR(42) NOT NULL,
+    counterparty_address VARCHAR(42) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_historical_entry (account_id, tx_hash, log_index),
+    INDEX idx_historical_entries_account_time (account_id, block_time),
+    INDEX idx_historical_entries_import (import_id),
+    FOREIGN KEY (import_id) REFERENCES historical_imports(id),
+    FOREIGN KEY (account_id) REFERENCES accounts(id),
+    CONSTRAINT chk_historical_amount CHECK (amount > 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,7 @@
+-- ENS names on wallets 롤백
+
+ALTER TABLE wallets
+    DROP INDEX idx_wallets_ens_checked,
+    DROP COLUMN ens_checked_at,
+    DROP COLUMN ens_status,
+    DROP COLUMN ens_name;
@@ -0,0 +1,15 @@
+-- ============================================================================
+-- ENS names on wallets
+-- ============================================================================
+-- 등록 시 ENS 이름으로 입력된 지갑은 해석된 주소(address)와 이름(ens_name)을 함께 저장
+--   ens_status: 마지막 재검증 결과
+--     RESOLVED   - 이름이 여전히 지갑 주소로 해석됨
+--     CHANGED    - 이름이 다른 주소로 해석됨 (지갑 주소는 변경하지 않음)
+--     UNRESOLVED - 이름에 주소 레코드가 없음 (만료/삭제)
+--   ens_checked_at: 마지막 해석 시각 (백그라운드 재검증 대상 선정)
+
+ALTER TABLE wallets
+    ADD COLUMN ens_name VARCHAR(255) NULL AFTER label,
+    ADD COLUMN ens_status ENUM('RESOLVED', 'CHANGED', 'UNRESOLVED') NULL AFTER ens_name,
+    ADD COLUMN ens_checked_at TIMESTAMP NULL AFTER ens_status,
+    ADD INDEX idx_wallets_ens_checked (ens_checked_at);
@@ -0,0 +1,7 @@
+-- Webhook payload versions 롤백
+
+ALTER TABLE webhook_deliveries
+    DROP COLUMN payload_version;
+
+ALTER TABLE webhook_endpoints
+    DROP COLUMN payload_version;
@@ -0,0 +1,12 @@
+-- ============================================================================
+-- Webhook payload versions
+-- ============================================================================
+-- 엔드포인트별 페이로드 스키마 버전 (v1, v2) - 같은 도메인 이벤트를 구독 버전으로 렌더링
+--   webhook_endpoints.payload_version: 신규 전송 건에 적용 (기존 엔드포인트는 v1 유지)
+--   webhook_deliveries.payload_version: 전송 건 생성 시 렌더링된 버전 (재시도 시 동일 페이로드)
+
+ALTER TABLE webhook_endpoints
+    ADD COLUMN payload_version VARCHAR(8) NOT NULL DEFAULT 'v1' AFTER event_types;
+
+ALTER TABLE webhook_deliveries
+    ADD COLUMN payload_version VARCHAR(8) NOT NULL DEFAULT 'v1' AFTER event_type;
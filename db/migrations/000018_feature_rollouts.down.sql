@@ -0,0 +1,4 @@
+-- Feature rollouts 롤백
+
+DROP TABLE IF EXISTS feature_allowlist;
+DROP TABLE IF EXISTS feature_rollouts;
@@ -0,0 +1,30 @@
+-- ============================================================================
+-- Feature rollouts (soft launch gating)
+-- ============================================================================
+-- 신규 라우트 그룹을 파일럿 가맹점에만 열고 단계적으로 전체 오픈
+--   feature_rollouts.status: PILOT → 허용 목록 사용자만 접근, GA → 전체 접근
+--     NOTE: 행이 없는 기능은 PILOT으로 간주 (배포 직후 의도치 않은 전체 오픈 방지)
+--   feature_allowlist: 기능별 파일럿 사용자 (기능당 사용자 1건 - uk_feature_allowlist_user)
+
+CREATE TABLE feature_rollouts (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    feature VARCHAR(64) NOT NULL,
+    status ENUM('PILOT', 'GA') NOT NULL DEFAULT 'PILOT',
+    updated_by BIGINT UNSIGNED NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_feature_rollout_feature (feature),
+    FOREIGN KEY (updated_by) REFERENCES users(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE feature_allowlist (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    feature VARCHAR(64) NOT NULL,
+    user_id BIGINT UNSIGNED NOT NULL,
+    added_by BIGINT UNSIGNED NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_feature_allowlist_user (feature, user_id),
+    INDEX idx_feature_allowlist_user (user_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (added_by) REFERENCES users(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
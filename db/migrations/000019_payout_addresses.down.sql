@@ -0,0 +1,3 @@
+-- Payout address whitelist 롤백
+
+DROP TABLE IF EXISTS payout_addresses;
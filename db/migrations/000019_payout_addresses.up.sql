@@ -0,0 +1,32 @@
+-- ============================================================================
+-- Payout address whitelist
+-- ============================================================================
+-- 사용자별 출금(지급) 주소 화이트리스트 - 계정 탈취 시 피해 범위 축소
+--   등록 → 검증된 Primary 지갑의 EIP-712 승인 서명(confirmed_at) → 활성화 대기(active_after) 후 지급 대상 가능
+--   approver_wallet_id: 승인 서명한 지갑
+--   revoked_at: 해제 시각 (이력 보존, 해제된 주소는 재등록 가능 - address_active)
+-- 상태: revoked_at → REVOKED, confirmed_at IS NULL → PENDING_CONFIRMATION,
+--       NOW() < active_after → PENDING_ACTIVATION, 그 외 ACTIVE
+
+CREATE TABLE payout_addresses (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    user_id BIGINT UNSIGNED NOT NULL,
+    address VARCHAR(42) NOT NULL,
+    chain_id BIGINT UNSIGNED NOT NULL,
+    label VARCHAR(50) NULL,
+    approver_wallet_id BIGINT UNSIGNED NULL,
+    confirmed_at TIMESTAMP NULL,
+    active_after TIMESTAMP NULL,
+    revoked_at TIMESTAMP NULL,
+    address_active VARCHAR(42) GENERATED ALWAYS AS (
+        CASE WHEN revoked_at IS NULL THEN address ELSE NULL END
+    ) STORED,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_payout_address_external_id (external_id),
+    UNIQUE KEY uk_payout_address_user_active (user_id, chain_id, address_active),
+    INDEX idx_payout_addresses_user (user_id, created_at),
+    FOREIGN KEY (user_id) REFERENCES users(id),
+    FOREIGN KEY (approver_wallet_id) REFERENCES wallets(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,4 @@
+-- HD-wallet deposit addresses 롤백
+
+DROP TABLE IF EXISTS deposit_addresses;
+DROP TABLE IF EXISTS hd_derivation_cursors;
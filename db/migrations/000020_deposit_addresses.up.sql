@@ -0,0 +1,28 @@
+-- ============================================================================
+-- HD-wallet deposit addresses
+-- ============================================================================
+-- 주문별 입금 주소를 xpub에서 파생 → 메모 없이 입금 건을 주문에 귀속
+--   hd_derivation_cursors: 키(xpub)별 다음 파생 인덱스 (FOR UPDATE로 할당 직렬화)
+--     key_id: xpub 식별자 (xpub 교체 시 새 인덱스 공간)
+--   deposit_addresses: 주문당 1개, 주소/(key_id, derivation_index) 재사용 금지
+--     NOTE: 파생 주소는 EVM 체인 공통 → chain_id 없이 주소 단위로 유일
+
+CREATE TABLE hd_derivation_cursors (
+    key_id VARCHAR(32) NOT NULL PRIMARY KEY,
+    next_index INT UNSIGNED NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE deposit_addresses (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    order_id BIGINT UNSIGNED NOT NULL,
+    address VARCHAR(42) NOT NULL,
+    key_id VARCHAR(32) NOT NULL,
+    derivation_index INT UNSIGNED NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_deposit_address_order (order_id),
+    UNIQUE KEY uk_deposit_address_address (address),
+    UNIQUE KEY uk_deposit_address_derivation (key_id, derivation_index),
+    FOREIGN KEY (order_id) REFERENCES orders(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,4 @@
+-- Primary wallet uniqueness 롤백
+
+ALTER TABLE wallets DROP INDEX uk_wallet_primary_user;
+ALTER TABLE wallets DROP COLUMN primary_user_id;
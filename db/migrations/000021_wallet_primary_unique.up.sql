@@ -0,0 +1,30 @@
+-- ============================================================================
+-- Primary wallet uniqueness
+-- ============================================================================
+-- 사용자당 Primary 지갑 1개를 애플리케이션 로직(ClearPrimaryWallet → SetWalletPrimary)이 아닌 DB 제약으로 보장
+--   primary_user_id: 삭제되지 않은 Primary 지갑만 user_id, 나머지는 NULL (MySQL partial index 대체)
+--   uk_wallet_primary_user: NULL은 중복 허용 → 사용자당 Primary 최대 1개
+-- NOTE: 제약 추가 전 기존 중복 Primary 정리
+--       계정(accounts.primary_wallet_id)이 가리키는 지갑 우선, 없으면 가장 최근 지갑(id 최대)만 유지
+--       Primary 0개/계정 불일치는 제약으로 막을 수 없으므로 wallet.PrimaryRepairer가 보고/복구
+
+UPDATE wallets w
+JOIN (
+    SELECT p.user_id,
+           COALESCE(MAX(CASE WHEN a.primary_wallet_id = p.id THEN p.id END), MAX(p.id)) AS keep_id
+    FROM wallets p
+    LEFT JOIN accounts a ON a.owner_id = p.user_id AND a.account_type = 'USER' AND a.status != 'CLOSED'
+    WHERE p.is_primary = true AND p.deleted_at IS NULL
+    GROUP BY p.user_id
+    HAVING COUNT(DISTINCT p.id) > 1
+) k ON k.user_id = w.user_id
+SET w.is_primary = false
+WHERE w.is_primary = true AND w.deleted_at IS NULL AND w.id <> k.keep_id;
+
+ALTER TABLE wallets
+ADD COLUMN primary_user_id BIGINT UNSIGNED GENERATED ALWAYS AS (
+    CASE WHEN is_primary = true AND deleted_at IS NULL THEN user_id ELSE NULL END
+) STORED;
+
+ALTER TABLE wallets
+ADD UNIQUE KEY uk_wallet_primary_user (primary_user_id);
@@ -0,0 +1,3 @@
+-- Delegated signers 롤백
+
+DROP TABLE IF EXISTS delegated_signers;
@@ -0,0 +1,35 @@
+-- ============================================================================
+-- Delegated signers
+-- ============================================================================
+-- 조직(사용자 계정) owner가 추가 서명 주소(예: CFO 지갑)에 범위(scope)를 지정해 서명 권한을 위임
+--   등록 → owner의 검증된 Primary 지갑이 EIP-712 SignerDelegation 서명(confirmed_at) → 위임 성립
+--   scopes: 콤마 구분, 정렬된 scope 목록 (PAYMENT_AUTHORIZATION, LOGIN) - 서명된 값과 동일하게 저장
+--   expires_at: 위임 만료 시각 (NULL = 해제 전까지 유효)
+--   approver_wallet_id: 위임 서명한 owner 지갑
+--   revoked_at: 해제 시각 (이력 보존, 해제된 주소는 재등록 가능 - address_active)
+-- 상태: revoked_at → REVOKED, confirmed_at IS NULL → PENDING_CONFIRMATION,
+--       expires_at <= NOW() → EXPIRED, 그 외 ACTIVE
+
+CREATE TABLE delegated_signers (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    user_id BIGINT UNSIGNED NOT NULL,
+    address VARCHAR(42) NOT NULL,
+    label VARCHAR(50) NULL,
+    scopes VARCHAR(100) NOT NULL,
+    expires_at TIMESTAMP NULL,
+    approver_wallet_id BIGINT UNSIGNED NULL,
+    confirmed_at TIMESTAMP NULL,
+    revoked_at TIMESTAMP NULL,
+    address_active VARCHAR(42) GENERATED ALWAYS AS (
+        CASE WHEN revoked_at IS NULL THEN address ELSE NULL END
+    ) STORED,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_delegated_signer_external_id (external_id),
+    UNIQUE KEY uk_delegated_signer_user_active (user_id, address_active),
+    INDEX idx_delegated_signers_user (user_id, created_at),
+    INDEX idx_delegated_signers_address (address_active),
+    FOREIGN KEY (user_id) REFERENCES users(id),
+    FOREIGN KEY (approver_wallet_id) REFERENCES wallets(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,4 @@
+-- Platform chain transactions 롤백
+
+DROP TABLE IF EXISTS chain_transaction_broadcasts;
+DROP TABLE IF EXISTS chain_transactions;
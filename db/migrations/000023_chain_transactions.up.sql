@@ -0,0 +1,44 @@
+-- ============================================================================
+-- Platform chain transactions (stuck transaction replacement)
+-- ============================================================================
+-- 플랫폼 서명 키로 브로드캐스트한 송금을 추적, CHAIN_TX_TIMEOUT 동안 미확정이면 같은 nonce로 수수료를 올려 재전송
+--   chain_transactions: 송금 1건 (nonce 단위) - reference_type/reference_id로 원 업무(마이크로 송금 등) 연결
+--     status: PENDING → CONFIRMED (성공 포함) | FAILED (revert) | DROPPED (알 수 없는 tx가 nonce 사용)
+--     landed_tx_hash: 실제로 포함된 브로드캐스트의 tx hash (재전송 시 원래 hash와 다를 수 있음)
+--   chain_transaction_broadcasts: 브로드캐스트 이력 (원본 + 교체분) - 수수료는 wei 10진 문자열
+-- NOTE: amount는 토큰 base unit 10진 문자열 (uint256 범위)
+
+CREATE TABLE chain_transactions (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    chain_id BIGINT UNSIGNED NOT NULL,
+    signer VARCHAR(42) NOT NULL,
+    nonce BIGINT UNSIGNED NOT NULL,
+    to_address VARCHAR(42) NOT NULL,
+    amount VARCHAR(78) NOT NULL,
+    gas_limit BIGINT UNSIGNED NOT NULL,
+    reference_type VARCHAR(30) NOT NULL,
+    reference_id BIGINT UNSIGNED NOT NULL,
+    status ENUM('PENDING', 'CONFIRMED', 'FAILED', 'DROPPED') NOT NULL DEFAULT 'PENDING',
+    replacements INT UNSIGNED NOT NULL DEFAULT 0,
+    last_broadcast_at TIMESTAMP NOT NULL,
+    landed_tx_hash VARCHAR(66) NULL,
+    block_number BIGINT UNSIGNED NULL,
+    resolved_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_chain_transactions_pending (status, last_broadcast_at),
+    INDEX idx_chain_transactions_reference (reference_type, reference_id),
+    INDEX idx_chain_transactions_nonce (chain_id, signer, nonce)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE chain_transaction_broadcasts (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    chain_transaction_id BIGINT UNSIGNED NOT NULL,
+    tx_hash VARCHAR(66) NOT NULL,
+    tip_cap VARCHAR(78) NOT NULL,
+    fee_cap VARCHAR(78) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_chain_transaction_broadcast_hash (tx_hash),
+    INDEX idx_chain_transaction_broadcasts_tx (chain_transaction_id, id),
+    FOREIGN KEY (chain_transaction_id) REFERENCES chain_transactions(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
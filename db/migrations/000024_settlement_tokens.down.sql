@@ -0,0 +1,7 @@
+-- Settlement tokens 롤백
+
+ALTER TABLE payments
+    DROP COLUMN token_symbol;
+
+ALTER TABLE orders
+    DROP COLUMN token_symbol;
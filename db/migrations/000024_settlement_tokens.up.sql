@@ -0,0 +1,14 @@
+-- ============================================================================
+-- Settlement tokens
+-- ============================================================================
+-- 주문/결제별 결제 토큰 (USDC, USDT, DAI 등)
+--   token_symbol: 토큰 레지스트리(TOKEN_SYMBOL + TOKENS 설정)의 심볼
+--     주소/decimals는 설정에서 관리 (체인별 주소가 달라 DB에 중복 저장하지 않음)
+-- NOTE: 기존 주문/결제는 단일 토큰(TOKEN_ADDRESS) 시절 데이터 → 기본값 USDC
+--       TOKEN_SYMBOL을 다른 값으로 운영 중이면 배포 전 UPDATE로 보정
+
+ALTER TABLE orders
+    ADD COLUMN token_symbol VARCHAR(10) NOT NULL DEFAULT 'USDC' AFTER total_amount;
+
+ALTER TABLE payments
+    ADD COLUMN token_symbol VARCHAR(10) NOT NULL DEFAULT 'USDC' AFTER amount;
@@ -0,0 +1,8 @@
+-- Order SLA timers 롤백
+
+DROP TABLE IF EXISTS order_sla_breaches;
+DROP TABLE IF EXISTS order_sla_policies;
+
+ALTER TABLE orders
+    DROP INDEX idx_orders_status_changed,
+    DROP COLUMN status_changed_at;
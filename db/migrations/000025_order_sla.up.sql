@@ -0,0 +1,48 @@
+-- ============================================================================
+-- Order SLA timers
+-- ============================================================================
+-- 주문 상태별 처리 기한 (판매자 확인/출고) 및 위반 시 자동 에스컬레이션
+--   orders.status_changed_at: 현재 상태 진입 시각 (기한 계산 기준)
+--   order_sla_policies: 판매자별 SLA (buyer_id NULL = 판매자 기본값, 지정 시 해당 구매자와의 거래 관계 전용)
+--     confirm_hours: PENDING → CONFIRMED 기한, fulfill_hours: PAID → SHIPPED 기한
+--     NULL 항목은 상위 설정 상속 (구매자별 → 판매자 기본값 → 서버 설정), 0 = 해당 단계 SLA 없음
+--     auto_cancel: 위반 시 자동 취소 (결제 완료 주문은 환불)
+--   order_sla_breaches: 주문별 단계 위반 처리 기록 (단계당 1회, 재실행 방지)
+--     action: ESCALATE (알림만) | CANCEL (자동 취소/환불)
+-- NOTE: 기존 주문의 상태 진입 시각은 알 수 없으므로 updated_at으로 초기화
+
+ALTER TABLE orders
+    ADD COLUMN status_changed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP AFTER status,
+    ADD INDEX idx_orders_status_changed (status, status_changed_at);
+
+UPDATE orders SET status_changed_at = updated_at, updated_at = updated_at;
+
+CREATE TABLE order_sla_policies (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(36) NOT NULL,
+    seller_id BIGINT UNSIGNED NOT NULL,
+    buyer_id BIGINT UNSIGNED NULL,
+    -- 판매자 기본값(buyer_id NULL)도 판매자당 1건으로 제한하기 위한 키
+    buyer_key BIGINT UNSIGNED AS (COALESCE(buyer_id, 0)) STORED,
+    confirm_hours INT UNSIGNED NULL,
+    fulfill_hours INT UNSIGNED NULL,
+    auto_cancel BOOLEAN NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_order_sla_policy_external_id (external_id),
+    UNIQUE KEY uk_order_sla_relationship (seller_id, buyer_key),
+    FOREIGN KEY (seller_id) REFERENCES users(id),
+    FOREIGN KEY (buyer_id) REFERENCES users(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE order_sla_breaches (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    order_id BIGINT UNSIGNED NOT NULL,
+    stage ENUM('CONFIRM', 'FULFILL') NOT NULL,
+    deadline_at TIMESTAMP NOT NULL,
+    action ENUM('ESCALATE', 'CANCEL') NOT NULL,
+    event_id VARCHAR(64) NULL,
+    executed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_order_sla_breach (order_id, stage),
+    FOREIGN KEY (order_id) REFERENCES orders(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
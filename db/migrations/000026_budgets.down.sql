@@ -0,0 +1,8 @@
+-- Buyer budgets 롤백
+
+DROP TABLE IF EXISTS budget_alerts;
+DROP TABLE IF EXISTS budgets;
+
+ALTER TABLE products
+    DROP INDEX idx_products_category,
+    DROP COLUMN category;
@@ -0,0 +1,48 @@
+-- ============================================================================
+-- Buyer budgets
+-- ============================================================================
+-- 구매 조직(구매자 계정)의 기간/카테고리별 예산 및 지출 분석
+--   products.category: 예산/분석용 상품 카테고리 (NULL = 미분류)
+--   budgets: 구매자별 예산 (category NULL = 전체 지출, 카테고리·기간당 1건)
+--     period: MONTHLY | QUARTERLY | YEARLY (UTC 달력 기준)
+--     alert_threshold_pct: 한도 근접 알림 기준 (%)
+--     hard_limit: TRUE면 주문 생성 시 잔여 예산 초과 주문 거부, FALSE면 알림만
+--   budget_alerts: 기간별 알림 발송 기록 (단계당 1회, 재발송 방지)
+--     level: NEAR_LIMIT (threshold 도달) | EXCEEDED (한도 초과)
+-- NOTE: 지출 = 취소/환불되지 않은 주문 금액 (토큰 구분 없이 합산 - 스테이블코인 1:1 가정)
+
+ALTER TABLE products
+    ADD COLUMN category VARCHAR(50) NULL AFTER name,
+    ADD INDEX idx_products_category (category);
+
+CREATE TABLE budgets (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(36) NOT NULL,
+    user_id BIGINT UNSIGNED NOT NULL,
+    category VARCHAR(50) NULL,
+    -- 전체 예산(category NULL)도 기간당 1건으로 제한하기 위한 키
+    category_key VARCHAR(50) AS (COALESCE(category, '')) STORED,
+    period ENUM('MONTHLY', 'QUARTERLY', 'YEARLY') NOT NULL,
+    amount DECIMAL(18,8) NOT NULL,
+    alert_threshold_pct TINYINT UNSIGNED NOT NULL DEFAULT 80,
+    hard_limit BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_budget_external_id (external_id),
+    UNIQUE KEY uk_budget_scope (user_id, category_key, period),
+    FOREIGN KEY (user_id) REFERENCES users(id),
+    CONSTRAINT chk_budget_amount CHECK (amount > 0),
+    CONSTRAINT chk_budget_threshold CHECK (alert_threshold_pct BETWEEN 1 AND 100)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE budget_alerts (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    budget_id BIGINT UNSIGNED NOT NULL,
+    period_start DATE NOT NULL,
+    level ENUM('NEAR_LIMIT', 'EXCEEDED') NOT NULL,
+    spent DECIMAL(18,8) NOT NULL,
+    event_id VARCHAR(64) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_budget_alert (budget_id, period_start, level),
+    FOREIGN KEY (budget_id) REFERENCES budgets(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,9 @@
+-- Order FX quotes 롤백
+
+ALTER TABLE orders
+    DROP COLUMN quoted_at,
+    DROP COLUMN fx_rate_at,
+    DROP COLUMN fx_rate_source,
+    DROP COLUMN fx_rate,
+    DROP COLUMN price_amount,
+    DROP COLUMN price_currency;
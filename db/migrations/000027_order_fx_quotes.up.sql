@@ -0,0 +1,17 @@
+-- ============================================================================
+-- Order FX quotes
+-- ============================================================================
+-- 법정화폐(KRW/USD) 가격 주문의 스테이블코인 환산 기록
+--   price_currency/price_amount: 판매자가 책정한 법정화폐 가격 (NULL = 토큰 금액으로 직접 책정)
+--   fx_rate: 견적 시점 환율 (price_currency 1단위의 USD 가격, 스테이블코인 1 = 1 USD 가정)
+--   fx_rate_source: chainlink | static | identity
+--   fx_rate_at: 환율 출처의 갱신 시각 / quoted_at: total_amount(토큰 금액) 산정 시각
+-- NOTE: 환율은 견적 시점에 고정 → 결제/환불/분쟁 시 재조회하지 않고 주문의 환율 사용
+
+ALTER TABLE orders
+    ADD COLUMN price_currency CHAR(3) NULL AFTER token_symbol,
+    ADD COLUMN price_amount DECIMAL(18,2) NULL AFTER price_currency,
+    ADD COLUMN fx_rate DECIMAL(36,18) NULL AFTER price_amount,
+    ADD COLUMN fx_rate_source VARCHAR(20) NULL AFTER fx_rate,
+    ADD COLUMN fx_rate_at TIMESTAMP NULL AFTER fx_rate_source,
+    ADD COLUMN quoted_at TIMESTAMP NULL AFTER fx_rate_at;
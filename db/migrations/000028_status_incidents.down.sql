@@ -0,0 +1,4 @@
+-- Status incidents 롤백
+
+DROP TABLE IF EXISTS status_incident_updates;
+DROP TABLE IF EXISTS status_incidents;
@@ -0,0 +1,36 @@
+-- ============================================================================
+-- Status incidents
+-- ============================================================================
+-- 공개 상태 페이지 피드에 게시하는 장애 공지 (운영자가 관리자 API로 작성)
+--   components: 영향받는 컴포넌트 키 (쉼표 구분, 예: "settlement,webhooks")
+--   impact: 게시 중 컴포넌트 상태에 반영 (MINOR → DEGRADED, MAJOR → PARTIAL_OUTAGE, CRITICAL → MAJOR_OUTAGE)
+--   status: INVESTIGATING → IDENTIFIED → MONITORING → RESOLVED (resolved_at 설정)
+-- status_incident_updates: 상태 변경/경과 공지 이력 (최초 게시 메시지 포함)
+
+CREATE TABLE status_incidents (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    title VARCHAR(200) NOT NULL,
+    impact ENUM('MINOR', 'MAJOR', 'CRITICAL') NOT NULL,
+    status ENUM('INVESTIGATING', 'IDENTIFIED', 'MONITORING', 'RESOLVED') NOT NULL DEFAULT 'INVESTIGATING',
+    components VARCHAR(255) NOT NULL,
+    created_by BIGINT UNSIGNED NOT NULL,
+    resolved_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_status_incident_external_id (external_id),
+    INDEX idx_status_incidents_resolved (resolved_at),
+    FOREIGN KEY (created_by) REFERENCES users(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE status_incident_updates (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    incident_id BIGINT UNSIGNED NOT NULL,
+    status ENUM('INVESTIGATING', 'IDENTIFIED', 'MONITORING', 'RESOLVED') NOT NULL,
+    message VARCHAR(2000) NOT NULL,
+    created_by BIGINT UNSIGNED NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_status_incident_updates_incident (incident_id, id),
+    FOREIGN KEY (incident_id) REFERENCES status_incidents(id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES users(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,3 @@
+-- Payment fees 롤백
+
+DROP TABLE IF EXISTS fee_ledger_entries;
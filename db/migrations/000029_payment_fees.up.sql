@@ -0,0 +1,31 @@
+-- ============================================================================
+-- Payment fees
+-- ============================================================================
+-- 결제별 플랫폼 수수료 원장 (불변)
+--   fee_ledger_entries: CHARGE = 확정(capture)된 결제의 수수료 부과, REVERSAL = 환불 등으로 취소
+--     merchant_id: 수수료를 부담하는 판매자 (orders.seller_id)
+--     tier/monthly_volume: 부과 시점의 판매자 등급과 등급 산정 기준 거래액 (직전 30일 확정 결제 합계)
+--     amount = percentage_fee + fixed_fee (결제 금액 초과 불가)
+--     uk_fee_ledger_entry: 결제당 유형별 1건 - 여러 인스턴스가 동시에 부과해도 중복 기록 없음
+-- NOTE: 수수료율은 설정(FEE_TIERS)에서 관리 - 요율 변경은 이후 부과분부터 적용, 기록된 항목은 변경하지 않음
+-- NOTE: 정산(settlements.fee_amount) 생성 시 결제의 CHARGE - REVERSAL을 차감
+
+CREATE TABLE fee_ledger_entries (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    payment_id BIGINT UNSIGNED NOT NULL,
+    merchant_id BIGINT UNSIGNED NOT NULL,
+    entry_type ENUM('CHARGE', 'REVERSAL') NOT NULL,
+    amount DECIMAL(18,8) NOT NULL,
+    token_symbol VARCHAR(10) NOT NULL,
+    tier VARCHAR(32) NOT NULL,
+    monthly_volume DECIMAL(18,8) NOT NULL,
+    percentage_bps INT UNSIGNED NOT NULL,
+    percentage_fee DECIMAL(18,8) NOT NULL,
+    fixed_fee DECIMAL(18,8) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_fee_ledger_entry (payment_id, entry_type),
+    INDEX idx_fee_ledger_merchant (merchant_id, created_at),
+    FOREIGN KEY (payment_id) REFERENCES payments(id),
+    FOREIGN KEY (merchant_id) REFERENCES users(id),
+    CONSTRAINT chk_fee_amounts CHECK (amount >= 0 AND percentage_fee >= 0 AND fixed_fee >= 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,8 @@
+-- Payment refunds 롤백
+
+ALTER TABLE fee_ledger_entries
+    DROP INDEX uk_fee_ledger_entry,
+    ADD UNIQUE KEY uk_fee_ledger_entry (payment_id, entry_type),
+    DROP COLUMN refund_id;
+
+DROP TABLE IF EXISTS payment_refunds;
@@ -0,0 +1,38 @@
+-- ============================================================================
+-- Payment refunds
+-- ============================================================================
+-- 확정(CAPTURED) 결제의 전액/부분 환불
+--   payment_refunds: 환불 1건 - 결제당 환불 합계 ≤ 결제 금액 (FAILED 제외, 결제 row-lock 하에서 검증)
+--     method: LEDGER = 판매자 계정 → 구매자 계정 반환 분개 (판매자 지급 전)
+--             ON_CHAIN = 판매자 지급(settlement COMPLETED) 이후 - 플랫폼 서명 키로 구매자 Primary 지갑에 반환 송금
+--     status: LEDGER는 즉시 SUCCEEDED
+--             ON_CHAIN은 PENDING(브로드캐스트) → SUCCEEDED(체인 확정) | FAILED(브로드캐스트 실패, 수수료 취소 없음)
+--                                          | RETURN_FAILED(revert/drop - 수수료 취소 완료, 운영자 재송금 필요)
+--   fee_ledger_entries.refund_id: REVERSAL 항목의 환불 (CHARGE = 0) - 부분 환불마다 수수료 비례 취소
+-- NOTE: 판매자 지급 이후 환불분의 판매자 회수는 별도 운영 절차 (ON_CHAIN 환불은 관리자만)
+
+CREATE TABLE payment_refunds (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    payment_id BIGINT UNSIGNED NOT NULL,
+    amount DECIMAL(18,8) NOT NULL,
+    reason VARCHAR(255) NULL,
+    method ENUM('LEDGER', 'ON_CHAIN') NOT NULL,
+    status ENUM('PENDING', 'SUCCEEDED', 'FAILED', 'RETURN_FAILED') NOT NULL,
+    to_address VARCHAR(42) NULL,
+    tx_hash VARCHAR(66) NULL,
+    created_by BIGINT UNSIGNED NOT NULL,
+    completed_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_payment_refund_external_id (external_id),
+    INDEX idx_payment_refunds_payment (payment_id, status),
+    FOREIGN KEY (payment_id) REFERENCES payments(id),
+    FOREIGN KEY (created_by) REFERENCES users(id),
+    CONSTRAINT chk_payment_refund_amount CHECK (amount > 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+ALTER TABLE fee_ledger_entries
+    ADD COLUMN refund_id BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    DROP INDEX uk_fee_ledger_entry,
+    ADD UNIQUE KEY uk_fee_ledger_entry (payment_id, entry_type, refund_id);
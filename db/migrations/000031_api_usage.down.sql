@@ -0,0 +1,3 @@
+-- API usage 롤백
+
+DROP TABLE IF EXISTS api_usage_hourly;
@@ -0,0 +1,24 @@
+-- ============================================================================
+-- API usage
+-- ============================================================================
+-- API 키별 시간 단위 요청 집계 (가맹점 셀프 서비스 사용량 대시보드)
+--   api_usage_hourly: (키, 시간, 라우트)당 1행 - 요청 시 Redis 카운터 증가, usage flush job이 주기적으로 합산 반영
+--     route: gin 라우트 패턴 (예: /api/v1/users/:id/wallets) - 경로 파라미터별로 행이 늘지 않도록
+--     client_errors: 4xx (rate_limited 포함), server_errors: 5xx, rate_limited: 429
+-- NOTE: Redis 장애/flush 실패 시 일부 카운트 유실 가능 (관측용 지표 - 과금 근거로 사용 금지)
+
+CREATE TABLE api_usage_hourly (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    api_key_id BIGINT UNSIGNED NOT NULL,
+    bucket_start TIMESTAMP NOT NULL,
+    method VARCHAR(10) NOT NULL,
+    route VARCHAR(255) NOT NULL,
+    requests BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    client_errors BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    server_errors BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    rate_limited BIGINT UNSIGNED NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_api_usage_bucket (api_key_id, bucket_start, method, route),
+    FOREIGN KEY (api_key_id) REFERENCES api_keys(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,4 @@
+-- Payment disputes 롤백
+
+DROP TABLE IF EXISTS dispute_evidence;
+DROP TABLE IF EXISTS disputes;
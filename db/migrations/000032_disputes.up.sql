@@ -0,0 +1,59 @@
+-- ============================================================================
+-- Payment disputes
+-- ============================================================================
+-- 구매자가 확정(CAPTURED) 결제에 제기하는 분쟁 (capture 후 DISPUTE_WINDOW 이내)
+--   disputes: 분쟁 1건 - 결제당 진행 중(OPEN) 분쟁 1건 (open_payment_id unique)
+--     amount: 분쟁 금액 (≤ 결제의 환불 가능 금액)
+--     held_amount: 판매자 계정에 동결(hold_balance)한 금액
+--                  판매자 가용 잔액 한도 내에서만 동결 (지급 이후 분쟁은 부족분 미동결 - 운영 회수 절차)
+--     status: OPEN → REFUNDED (관리자 환불 결정, refund_id = payment_refunds.id)
+--                  → RELEASED (관리자 판매자 승 결정, 동결 해제)
+--   dispute_evidence: 구매자/판매자/관리자가 OPEN 분쟁에 제출한 증빙 (수정/삭제 없음)
+-- NOTE: 상태 전이는 audit_logs + dispute.* 이벤트(구매자·판매자)로 기록
+
+CREATE TABLE disputes (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    payment_id BIGINT UNSIGNED NOT NULL,
+    seller_account_id BIGINT UNSIGNED NOT NULL,
+    opened_by BIGINT UNSIGNED NOT NULL,
+    reason ENUM('NOT_RECEIVED', 'NOT_AS_DESCRIBED', 'DUPLICATE', 'UNAUTHORIZED', 'OTHER') NOT NULL,
+    description VARCHAR(2000) NOT NULL,
+    amount DECIMAL(18,8) NOT NULL,
+    held_amount DECIMAL(18,8) NOT NULL DEFAULT 0,
+    status ENUM('OPEN', 'REFUNDED', 'RELEASED') NOT NULL DEFAULT 'OPEN',
+    refund_id BIGINT UNSIGNED NULL,
+    resolved_by BIGINT UNSIGNED NULL,
+    resolution_note VARCHAR(500) NULL,
+    resolved_at TIMESTAMP NULL,
+    open_payment_id BIGINT UNSIGNED GENERATED ALWAYS AS (
+        CASE WHEN status = 'OPEN' THEN payment_id ELSE NULL END
+    ) STORED,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_dispute_external_id (external_id),
+    UNIQUE KEY uk_dispute_open_payment (open_payment_id),
+    INDEX idx_disputes_payment (payment_id),
+    INDEX idx_disputes_status (status, created_at),
+    FOREIGN KEY (payment_id) REFERENCES payments(id),
+    FOREIGN KEY (seller_account_id) REFERENCES accounts(id),
+    FOREIGN KEY (opened_by) REFERENCES users(id),
+    FOREIGN KEY (refund_id) REFERENCES payment_refunds(id),
+    FOREIGN KEY (resolved_by) REFERENCES users(id),
+    CONSTRAINT chk_dispute_amount CHECK (amount > 0 AND held_amount >= 0 AND held_amount <= amount)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE dispute_evidence (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    dispute_id BIGINT UNSIGNED NOT NULL,
+    submitted_by BIGINT UNSIGNED NOT NULL,
+    party ENUM('BUYER', 'SELLER', 'ADMIN') NOT NULL,
+    description VARCHAR(2000) NOT NULL,
+    attachment_url VARCHAR(2048) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_dispute_evidence_external_id (external_id),
+    INDEX idx_dispute_evidence_dispute (dispute_id, created_at),
+    FOREIGN KEY (dispute_id) REFERENCES disputes(id),
+    FOREIGN KEY (submitted_by) REFERENCES users(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
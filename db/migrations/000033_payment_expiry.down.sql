@@ -0,0 +1,8 @@
+-- Payment authorization expiry 롤백
+-- NOTE: EXPIRED 결제는 VOIDED로 되돌림 (승인 해제와 같은 의미)
+
+UPDATE payments SET status = 'VOIDED' WHERE status = 'EXPIRED';
+
+ALTER TABLE payments
+DROP INDEX idx_status_expires,
+MODIFY COLUMN status ENUM('PENDING', 'AUTHORIZED', 'CAPTURED', 'VOIDED', 'REFUNDED', 'FAILED') NOT NULL DEFAULT 'PENDING';
@@ -0,0 +1,11 @@
+-- ============================================================================
+-- Payment authorization expiry
+-- ============================================================================
+-- 승인(AUTHORIZED) 후 expires_at까지 확정되지 않은 결제 → EXPIRED (만료 스윕 워커)
+--   만료 시 주문의 재고 예약 해제: inventory_logs RESERVE/RELEASE (reference_type = 'ORDER')의
+--   순 예약 수량만큼 reserved_quantity 차감 + RELEASE 이력 기록
+--   idx_status_expires: 만료 대상 조회 (status = 'AUTHORIZED' AND expires_at <= now)
+
+ALTER TABLE payments
+MODIFY COLUMN status ENUM('PENDING', 'AUTHORIZED', 'CAPTURED', 'VOIDED', 'REFUNDED', 'FAILED', 'EXPIRED') NOT NULL DEFAULT 'PENDING',
+ADD INDEX idx_status_expires (status, expires_at);
@@ -0,0 +1,8 @@
+-- Counterparty settlement netting 롤백
+
+ALTER TABLE settlements
+DROP FOREIGN KEY fk_settlements_net_payout,
+DROP INDEX idx_settlements_net_payout,
+DROP COLUMN net_payout_id;
+
+DROP TABLE IF EXISTS settlement_net_payouts;
@@ -0,0 +1,44 @@
+-- ============================================================================
+-- Counterparty settlement netting
+-- ============================================================================
+-- 양방향 거래가 있는 B2B 계정 쌍의 지급 대기(PENDING) 정산을 상계해 쌍당 1건의 순지급으로 대체
+--   settlement_net_payouts: 정산 창(window_start ≤ settlements.created_at < window_end) 내 계정 쌍 1건
+--     account_low_id < account_high_id (쌍 정규화 - 방향과 무관하게 같은 쌍)
+--     low_to_high_amount: low 계정 결제 → high 계정 수취 정산 합계 (net_amount 기준), high_to_low_amount: 반대 방향
+--     payee_account_id / net_amount: 차액을 받는 쪽과 순지급액 (완전 상계 시 NULL / 0)
+--     status: PENDING(지급 배치 대기) → PROCESSING → COMPLETED | FAILED
+--             OFFSET = 완전 상계 (on-chain 지급 없음, 묶인 정산은 즉시 COMPLETED)
+--   settlements.net_payout_id: 상계에 묶인 정산 → 개별 지급 배치에서 제외, 순지급 완료 시 함께 완료
+-- NOTE: 정산 1건은 순지급 1건에만 묶임 (net_payout_id IS NULL 조건 + 정산 row-lock)
+
+CREATE TABLE settlement_net_payouts (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    window_start TIMESTAMP NOT NULL,
+    window_end TIMESTAMP NOT NULL,
+    account_low_id BIGINT UNSIGNED NOT NULL,
+    account_high_id BIGINT UNSIGNED NOT NULL,
+    low_to_high_amount DECIMAL(18,8) NOT NULL,
+    high_to_low_amount DECIMAL(18,8) NOT NULL,
+    payee_account_id BIGINT UNSIGNED NULL,
+    net_amount DECIMAL(18,8) NOT NULL,
+    settlement_count INT UNSIGNED NOT NULL,
+    status ENUM('PENDING', 'PROCESSING', 'COMPLETED', 'FAILED', 'OFFSET') NOT NULL,
+    created_by BIGINT UNSIGNED NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_net_payout_external_id (external_id),
+    INDEX idx_net_payouts_status (status, created_at),
+    INDEX idx_net_payouts_payee (payee_account_id, status),
+    FOREIGN KEY (account_low_id) REFERENCES accounts(id),
+    FOREIGN KEY (account_high_id) REFERENCES accounts(id),
+    FOREIGN KEY (payee_account_id) REFERENCES accounts(id),
+    FOREIGN KEY (created_by) REFERENCES users(id),
+    CONSTRAINT chk_net_payout_pair CHECK (account_low_id < account_high_id),
+    CONSTRAINT chk_net_payout_amounts CHECK (low_to_high_amount >= 0 AND high_to_low_amount >= 0 AND net_amount >= 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+ALTER TABLE settlements
+ADD COLUMN net_payout_id BIGINT UNSIGNED NULL AFTER payee_account_id,
+ADD INDEX idx_settlements_net_payout (net_payout_id),
+ADD CONSTRAINT fk_settlements_net_payout FOREIGN KEY (net_payout_id) REFERENCES settlement_net_payouts(id);
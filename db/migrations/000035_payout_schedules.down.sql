@@ -0,0 +1,9 @@
+-- Payout schedules 롤백
+
+ALTER TABLE settlements
+DROP FOREIGN KEY fk_settlements_batch,
+DROP INDEX idx_settlements_batch,
+DROP COLUMN batch_id;
+
+DROP TABLE IF EXISTS settlement_batches;
+DROP TABLE IF EXISTS settlement_schedules;
@@ -0,0 +1,50 @@
+-- ============================================================================
+-- Payout schedules
+-- ============================================================================
+-- 판매자(수취 계정)별 지급 주기 + 마감 시각, 스케줄러가 마감마다 지급 대기 정산을 배치로 확정
+--   settlement_schedules: 계정당 1건 (없으면 서버 기본값 DAILY + SETTLEMENT_BATCH_HOUR)
+--     cadence: INSTANT (스케줄러 실행마다 마감) | DAILY (T+1, 매일 cutoff_hour) | WEEKLY (매주 cutoff_weekday의 cutoff_hour)
+--     cutoff_hour: 마감 시각 (UTC, 0-23), cutoff_weekday: WEEKLY 마감 요일 (0 = 일요일, 그 외 cadence는 무시)
+--   settlement_batches: 마감 1회 = 수취 계정당 배치 1건 (cutoff_at 이전 생성된 미배치 PENDING 정산)
+--     status: PENDING(지급 대기) → PROCESSING → COMPLETED | FAILED
+--   settlements.batch_id: 마감된 정산 → 지급 배치 대상 (NULL = 다음 마감 대기)
+-- NOTE: 상계에 묶인 정산(net_payout_id)은 순지급으로 지급되므로 배치 대상이 아니고, 배치된 정산은 상계 대상이 아님
+
+CREATE TABLE settlement_schedules (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    account_id BIGINT UNSIGNED NOT NULL,
+    cadence ENUM('INSTANT', 'DAILY', 'WEEKLY') NOT NULL,
+    cutoff_hour TINYINT UNSIGNED NOT NULL DEFAULT 0,
+    cutoff_weekday TINYINT UNSIGNED NOT NULL DEFAULT 0,
+    updated_by BIGINT UNSIGNED NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_settlement_schedule_account (account_id),
+    FOREIGN KEY (account_id) REFERENCES accounts(id),
+    FOREIGN KEY (updated_by) REFERENCES users(id),
+    CONSTRAINT chk_schedule_cutoff CHECK (cutoff_hour <= 23 AND cutoff_weekday <= 6)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE settlement_batches (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    payee_account_id BIGINT UNSIGNED NOT NULL,
+    cadence ENUM('INSTANT', 'DAILY', 'WEEKLY') NOT NULL,
+    cutoff_at TIMESTAMP NOT NULL,
+    settlement_count INT UNSIGNED NOT NULL,
+    total_amount DECIMAL(18,8) NOT NULL,
+    status ENUM('PENDING', 'PROCESSING', 'COMPLETED', 'FAILED') NOT NULL DEFAULT 'PENDING',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_settlement_batch_external_id (external_id),
+    -- 같은 마감은 한 번만 (여러 인스턴스 스케줄러 동시 실행 대비)
+    UNIQUE KEY uk_settlement_batch_cutoff (payee_account_id, cutoff_at),
+    INDEX idx_settlement_batches_status (status, cutoff_at),
+    FOREIGN KEY (payee_account_id) REFERENCES accounts(id),
+    CONSTRAINT chk_settlement_batch_amount CHECK (total_amount >= 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+ALTER TABLE settlements
+ADD COLUMN batch_id BIGINT UNSIGNED NULL AFTER net_payout_id,
+ADD INDEX idx_settlements_batch (batch_id),
+ADD CONSTRAINT fk_settlements_batch FOREIGN KEY (batch_id) REFERENCES settlement_batches(id);
@@ -0,0 +1,3 @@
+-- On-chain treasury reconciliation 롤백
+
+DROP TABLE IF EXISTS treasury_reconciliations;
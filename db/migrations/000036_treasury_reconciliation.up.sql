@@ -0,0 +1,28 @@
+-- ============================================================================
+-- On-chain treasury reconciliation
+-- ============================================================================
+-- 플랫폼 treasury 원장 계정 잔액(ledger_entries 합계)과 treasury 주소의 실제 on-chain 토큰 잔액을 주기적으로 비교
+--   불일치(drift ≠ 0)가 관측된 회차만 기록 (일치하는 회차는 로그만)
+--   drift = onchain_balance - ledger_balance (양수: 원장에 없는 입금, 음수: 원장보다 부족)
+--   exceeds_tolerance: |drift| > tolerance → 운영 알림 대상
+--   block_number: 비교 직전 최신 블록 (잔액 조회는 최신 블록 기준이므로 근사치)
+-- NOTE: 송금 브로드캐스트 ~ 원장 기록 사이의 일시적 차이는 tolerance로 흡수
+
+CREATE TABLE treasury_reconciliations (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    account_id BIGINT UNSIGNED NOT NULL,
+    address VARCHAR(42) NOT NULL,
+    token_symbol VARCHAR(10) NOT NULL,
+    ledger_balance DECIMAL(18,8) NOT NULL,
+    onchain_balance DECIMAL(18,8) NOT NULL,
+    drift DECIMAL(19,8) NOT NULL,
+    tolerance DECIMAL(18,8) NOT NULL,
+    exceeds_tolerance BOOLEAN NOT NULL,
+    block_number BIGINT UNSIGNED NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_treasury_reconciliation_external_id (external_id),
+    INDEX idx_treasury_reconciliations_created (created_at),
+    INDEX idx_treasury_reconciliations_exceeds (exceeds_tolerance, created_at),
+    FOREIGN KEY (account_id) REFERENCES accounts(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
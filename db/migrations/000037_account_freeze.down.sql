@@ -0,0 +1,7 @@
+-- Account freeze 롤백
+
+ALTER TABLE accounts
+DROP FOREIGN KEY fk_accounts_frozen_by,
+DROP COLUMN frozen_by,
+DROP COLUMN frozen_reason,
+DROP COLUMN frozen_at;
@@ -0,0 +1,15 @@
+-- ============================================================================
+-- Account freeze
+-- ============================================================================
+-- 관리자가 계정을 거래 동결(freeze) → 결제 승인/지급/내부 이체(원장 출금)를 ACCOUNT_FROZEN으로 거부, 조회는 허용
+--   frozen_at: 동결 시각 (NULL = 동결 아님)
+--   frozen_reason: 동결 사유 (감사 로그에도 기록)
+--   frozen_by: 동결한 관리자
+-- NOTE: status(SUSPENDED = 여신 보류 등 자동 상태 전이)와 독립 → 동결 해제가 정지 상태를 풀지 않고, 그 반대도 마찬가지
+-- NOTE: hold_balance(분쟁 금액 동결)와 다름 - 계정 전체의 자금 이동을 막음
+
+ALTER TABLE accounts
+ADD COLUMN frozen_at TIMESTAMP NULL AFTER status,
+ADD COLUMN frozen_reason VARCHAR(255) NULL AFTER frozen_at,
+ADD COLUMN frozen_by BIGINT UNSIGNED NULL AFTER frozen_reason,
+ADD CONSTRAINT fk_accounts_frozen_by FOREIGN KEY (frozen_by) REFERENCES users(id);
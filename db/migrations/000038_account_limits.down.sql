@@ -0,0 +1,4 @@
+-- Account spending / withdrawal limits 롤백
+
+DROP TABLE IF EXISTS account_limit_usage;
+DROP TABLE IF EXISTS account_limits;
@@ -0,0 +1,44 @@
+-- ============================================================================
+-- Account spending / withdrawal limits
+-- ============================================================================
+-- 계정별 일/월 한도 (결제 승인 = SPEND, 지급/출금 = WITHDRAWAL)
+--   account_limits: 관리자가 설정한 계정별 한도 (계정당 1건, NULL 컬럼 = 소유자 KYC 상태별 서버 기본값)
+--   account_limit_usage: 기간(UTC 일/월)별 사용액 카운터
+--     period_start: 기간 시작 (DAILY = 00:00 UTC, MONTHLY = 1일 00:00 UTC)
+--     결제/지급 트랜잭션에서 계정 row-lock 후 카운터 row-lock → 검증 → 증가 (동시 요청이 한도를 넘지 않음)
+-- NOTE: 소유자가 없는 시스템 계정(treasury/fee 등)은 한도 대상 아님
+
+CREATE TABLE account_limits (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    account_id BIGINT UNSIGNED NOT NULL,
+    daily_spend_limit DECIMAL(18,8) NULL,
+    monthly_spend_limit DECIMAL(18,8) NULL,
+    daily_withdrawal_limit DECIMAL(18,8) NULL,
+    monthly_withdrawal_limit DECIMAL(18,8) NULL,
+    updated_by BIGINT UNSIGNED NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_account_limit_account (account_id),
+    FOREIGN KEY (account_id) REFERENCES accounts(id),
+    FOREIGN KEY (updated_by) REFERENCES users(id),
+    CONSTRAINT chk_account_limit_amounts CHECK (
+        (daily_spend_limit IS NULL OR daily_spend_limit >= 0)
+        AND (monthly_spend_limit IS NULL OR monthly_spend_limit >= 0)
+        AND (daily_withdrawal_limit IS NULL OR daily_withdrawal_limit >= 0)
+        AND (monthly_withdrawal_limit IS NULL OR monthly_withdrawal_limit >= 0)
+    )
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE account_limit_usage (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    account_id BIGINT UNSIGNED NOT NULL,
+    kind ENUM('SPEND', 'WITHDRAWAL') NOT NULL,
+    period ENUM('DAILY', 'MONTHLY') NOT NULL,
+    period_start TIMESTAMP NOT NULL,
+    amount DECIMAL(18,8) NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_account_limit_usage_period (account_id, kind, period, period_start),
+    FOREIGN KEY (account_id) REFERENCES accounts(id),
+    CONSTRAINT chk_account_limit_usage_amount CHECK (amount >= 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,3 @@
+-- Internal account transfers 롤백
+
+DROP TABLE IF EXISTS account_transfers;
@@ -0,0 +1,28 @@
+-- ============================================================================
+-- Internal account transfers
+-- ============================================================================
+-- 플랫폼 계정 간 off-chain 이체 (예: 판매자 → 운영용 하위 계정), 원장 분개(DEBIT/CREDIT 한 쌍)와 한 트랜잭션
+--   ledger_tx_id: 이체 분개의 ledger_entries.tx_id (reference_type = 'ACCOUNT_TRANSFER', reference_id = id)
+--   idempotency_key: 클라이언트 Idempotency-Key (출금 계정 범위 유일) → 응답 캐시 만료 후 재시도도 같은 이체 반환
+-- NOTE: 이체 기록은 불변 (취소 = 반대 방향 이체)
+
+CREATE TABLE account_transfers (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    from_account_id BIGINT UNSIGNED NOT NULL,
+    to_account_id BIGINT UNSIGNED NOT NULL,
+    amount DECIMAL(18,8) NOT NULL,
+    memo VARCHAR(255) NULL,
+    idempotency_key VARCHAR(255) NULL,
+    ledger_tx_id VARCHAR(64) NULL,
+    created_by BIGINT UNSIGNED NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_account_transfer_external_id (external_id),
+    UNIQUE KEY uk_account_transfer_idempotency (from_account_id, idempotency_key),
+    INDEX idx_account_transfers_to (to_account_id, id),
+    FOREIGN KEY (from_account_id) REFERENCES accounts(id),
+    FOREIGN KEY (to_account_id) REFERENCES accounts(id),
+    FOREIGN KEY (created_by) REFERENCES users(id),
+    CONSTRAINT chk_account_transfer_amount CHECK (amount > 0),
+    CONSTRAINT chk_account_transfer_accounts CHECK (from_account_id <> to_account_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,4 @@
+-- Monthly account statements 롤백
+
+DROP TABLE IF EXISTS account_statement_lines;
+DROP TABLE IF EXISTS account_statements;
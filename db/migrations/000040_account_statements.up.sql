@@ -0,0 +1,38 @@
+-- ============================================================================
+-- Monthly account statements
+-- ============================================================================
+-- 계정별 월간 명세서 (UTC 월 [period_start, period_end))
+--   account_statements: 기초 잔액, 입출금 합계, 기말 잔액 (closing = opening + total_credits - total_debits)
+--   account_statement_lines: 원장 항목을 (reference_type, entry_type)별로 묶은 건수/합계
+--     reference_type이 없는 항목은 'OTHER'
+-- NOTE: 명세서는 생성 시점의 원장 스냅샷으로 불변 (UPDATE 쿼리 없음) → 이후 정정 분개는 정정 시점 월의 명세서에 반영
+-- NOTE: 월 마감 + 유예 시간(STATEMENT_GRACE) 이후에만 생성 → 마감 직전 시작된 트랜잭션 커밋 대기
+
+CREATE TABLE account_statements (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    account_id BIGINT UNSIGNED NOT NULL,
+    period_start DATE NOT NULL,
+    period_end DATE NOT NULL,
+    opening_balance DECIMAL(18,8) NOT NULL,
+    total_credits DECIMAL(18,8) NOT NULL,
+    total_debits DECIMAL(18,8) NOT NULL,
+    closing_balance DECIMAL(18,8) NOT NULL,
+    entry_count INT UNSIGNED NOT NULL,
+    generated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_account_statement_external_id (external_id),
+    UNIQUE KEY uk_account_statement_period (account_id, period_start),
+    FOREIGN KEY (account_id) REFERENCES accounts(id),
+    CONSTRAINT chk_account_statement_period CHECK (period_end > period_start)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE account_statement_lines (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    statement_id BIGINT UNSIGNED NOT NULL,
+    reference_type VARCHAR(30) NOT NULL,
+    entry_type ENUM('DEBIT', 'CREDIT') NOT NULL,
+    entry_count INT UNSIGNED NOT NULL,
+    total_amount DECIMAL(18,8) NOT NULL,
+    UNIQUE KEY uk_account_statement_line (statement_id, reference_type, entry_type),
+    FOREIGN KEY (statement_id) REFERENCES account_statements(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,4 @@
+-- Ledger balance snapshots 롤백
+
+ALTER TABLE ledger_entries DROP INDEX idx_ledger_entries_account_id;
+DROP TABLE IF EXISTS ledger_balance_snapshots;
@@ -0,0 +1,24 @@
+-- ============================================================================
+-- Ledger balance snapshots
+-- ============================================================================
+-- 계정별 원장 잔액 체크포인트 (현재 잔액 = 최신 스냅샷 + 이후 항목 합계)
+--   last_entry_id: 스냅샷에 포함된 마지막 ledger_entries.id (해당 계정 기준)
+--   balance: last_entry_id까지의 CREDIT 합계 - DEBIT 합계
+--   entry_count: last_entry_id까지의 누적 항목 수
+-- NOTE: 계정 항목은 ledger.Post의 계정 row-lock 하에서만 기록 → 계정 내 id 순서 = 커밋 순서
+--       (스냅샷 이후 커밋되는 항목은 항상 last_entry_id보다 큼)
+-- NOTE: 스냅샷은 원장에서 파생된 캐시 - 삭제해도 전체 합계로 재계산 가능
+
+CREATE TABLE ledger_balance_snapshots (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    account_id BIGINT UNSIGNED NOT NULL,
+    last_entry_id BIGINT UNSIGNED NOT NULL,
+    balance DECIMAL(18,8) NOT NULL,
+    entry_count BIGINT UNSIGNED NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_ledger_balance_snapshot (account_id, last_entry_id),
+    FOREIGN KEY (account_id) REFERENCES accounts(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- 스냅샷 이후 항목 조회 (account_id, id > last_entry_id)
+ALTER TABLE ledger_entries ADD INDEX idx_ledger_entries_account_id (account_id, id);
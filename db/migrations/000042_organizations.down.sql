@@ -0,0 +1,12 @@
+-- Organizations 롤백
+
+ALTER TABLE orders
+    DROP FOREIGN KEY fk_orders_buyer_organization,
+    DROP FOREIGN KEY fk_orders_seller_organization,
+    DROP INDEX idx_orders_buyer_organization,
+    DROP INDEX idx_orders_seller_organization,
+    DROP COLUMN buyer_organization_id,
+    DROP COLUMN seller_organization_id;
+
+DROP TABLE IF EXISTS organization_members;
+DROP TABLE IF EXISTS organizations;
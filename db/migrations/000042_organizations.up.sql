@@ -0,0 +1,49 @@
+-- ============================================================================
+-- Organizations (B2B companies)
+-- ============================================================================
+-- 회사(조직) 단위 거래 주체: 사용자는 조직의 구성원, 주문/정산은 조직에 귀속
+--   organizations: 회사 프로필 + 사업자등록번호(정규화: 공백/하이픈 제거, 대문자) + 청구 정보
+--     settlement_account_id: 조직 정산 계정 (MERCHANT, 생성 시 자동 개설) → 조직 판매 정산의 payee
+--   organization_members: 구성원 역할 OWNER(전체 관리) / ADMIN(프로필/구성원 관리) / MEMBER(조회)
+--   orders.buyer_organization_id / seller_organization_id: 주문 당사자 조직 (NULL = 개인 사용자 주문)
+-- NOTE: 조직당 OWNER 최소 1명 유지 (마지막 OWNER는 제거 불가)
+
+CREATE TABLE organizations (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    name VARCHAR(200) NOT NULL,
+    business_registration_number VARCHAR(32) NOT NULL,
+    billing_email VARCHAR(255) NULL,
+    billing_address VARCHAR(500) NULL,
+    billing_contact_name VARCHAR(100) NULL,
+    settlement_account_id BIGINT UNSIGNED NULL,
+    status ENUM('ACTIVE', 'SUSPENDED') NOT NULL DEFAULT 'ACTIVE',
+    created_by BIGINT UNSIGNED NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_organization_external_id (external_id),
+    UNIQUE KEY uk_organization_brn (business_registration_number),
+    UNIQUE KEY uk_organization_settlement_account (settlement_account_id),
+    FOREIGN KEY (settlement_account_id) REFERENCES accounts(id),
+    FOREIGN KEY (created_by) REFERENCES users(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE organization_members (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    organization_id BIGINT UNSIGNED NOT NULL,
+    user_id BIGINT UNSIGNED NOT NULL,
+    role ENUM('OWNER', 'ADMIN', 'MEMBER') NOT NULL DEFAULT 'MEMBER',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_organization_member (organization_id, user_id),
+    INDEX idx_organization_members_user (user_id),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id),
+    FOREIGN KEY (user_id) REFERENCES users(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+ALTER TABLE orders
+    ADD COLUMN buyer_organization_id BIGINT UNSIGNED NULL,
+    ADD COLUMN seller_organization_id BIGINT UNSIGNED NULL,
+    ADD CONSTRAINT fk_orders_buyer_organization FOREIGN KEY (buyer_organization_id) REFERENCES organizations(id),
+    ADD CONSTRAINT fk_orders_seller_organization FOREIGN KEY (seller_organization_id) REFERENCES organizations(id),
+    ADD INDEX idx_orders_buyer_organization (buyer_organization_id, id),
+    ADD INDEX idx_orders_seller_organization (seller_organization_id, id);
@@ -0,0 +1,12 @@
+-- Organization invitations 롤백
+
+DROP TABLE IF EXISTS organization_invitations;
+
+ALTER TABLE organization_members
+    MODIFY COLUMN role ENUM('OWNER', 'ADMIN', 'MEMBER', 'FINANCE', 'OPERATOR', 'VIEWER') NOT NULL DEFAULT 'MEMBER';
+
+UPDATE organization_members SET role = 'ADMIN' WHERE role IN ('FINANCE', 'OPERATOR');
+UPDATE organization_members SET role = 'MEMBER' WHERE role = 'VIEWER';
+
+ALTER TABLE organization_members
+    MODIFY COLUMN role ENUM('OWNER', 'ADMIN', 'MEMBER') NOT NULL DEFAULT 'MEMBER';
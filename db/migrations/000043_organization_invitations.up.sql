@@ -0,0 +1,45 @@
+-- ============================================================================
+-- Organization invitations & member roles
+-- ============================================================================
+-- 구성원 역할 재정의 (조직 리소스 권한은 RequireOrgRole 미들웨어가 검사)
+--   OWNER:    전체 관리 (초대/역할 변경/접근 회수 포함)
+--   FINANCE:  청구 정보 수정, 정산 조회
+--   OPERATOR: 주문 운영/조회
+--   VIEWER:   조회 전용
+-- 기존 역할 이전: ADMIN → OPERATOR, MEMBER → VIEWER
+--
+-- organization_invitations: 이메일 초대 (수락 시 해당 이메일의 사용자가 구성원으로 등록)
+--   token_hash: 초대 토큰 SHA-256 (원문은 생성 응답에서 1회만 노출)
+--   status: PENDING → ACCEPTED / REVOKED (expires_at 경과한 PENDING은 만료로 간주)
+
+ALTER TABLE organization_members
+    MODIFY COLUMN role ENUM('OWNER', 'ADMIN', 'MEMBER', 'FINANCE', 'OPERATOR', 'VIEWER') NOT NULL DEFAULT 'VIEWER';
+
+UPDATE organization_members SET role = 'OPERATOR' WHERE role = 'ADMIN';
+UPDATE organization_members SET role = 'VIEWER' WHERE role = 'MEMBER';
+
+ALTER TABLE organization_members
+    MODIFY COLUMN role ENUM('OWNER', 'FINANCE', 'OPERATOR', 'VIEWER') NOT NULL DEFAULT 'VIEWER';
+
+CREATE TABLE organization_invitations (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    organization_id BIGINT UNSIGNED NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    role ENUM('OWNER', 'FINANCE', 'OPERATOR', 'VIEWER') NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    status ENUM('PENDING', 'ACCEPTED', 'REVOKED') NOT NULL DEFAULT 'PENDING',
+    invited_by BIGINT UNSIGNED NOT NULL,
+    accepted_by BIGINT UNSIGNED NULL,
+    expires_at TIMESTAMP NOT NULL,
+    accepted_at TIMESTAMP NULL,
+    revoked_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_organization_invitation_external_id (external_id),
+    UNIQUE KEY uk_organization_invitation_token (token_hash),
+    INDEX idx_organization_invitations_org (organization_id, status, id),
+    FOREIGN KEY (organization_id) REFERENCES organizations(id),
+    FOREIGN KEY (invited_by) REFERENCES users(id),
+    FOREIGN KEY (accepted_by) REFERENCES users(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
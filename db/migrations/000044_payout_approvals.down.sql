@@ -0,0 +1,3 @@
+-- Payout approvals 롤백
+
+DROP TABLE IF EXISTS payout_approvals;
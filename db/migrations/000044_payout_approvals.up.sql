@@ -0,0 +1,34 @@
+-- ============================================================================
+-- Payout approvals (maker-checker)
+-- ============================================================================
+-- 기준 금액(SETTLEMENT_PAYOUT_APPROVAL_THRESHOLD) 이상 지급(정산 배치/순지급)은 서로 다른 두 사용자가 확인해야 지급 대상
+--   requested_by: 지급 생성자 = maker (상계 실행 관리자, 스케줄러가 마감한 배치는 NULL → 첫 승인자가 maker)
+--   first_approved_by: maker가 없는 지급의 첫 승인자
+--   decided_by: 최종 승인(checker, maker와 다른 사용자) 또는 거절한 사용자
+-- NOTE: 승인 레코드가 없는 지급 = 기준 금액 미만 (승인 불필요), 거절된 지급은 지급 대상에서 계속 제외
+
+CREATE TABLE payout_approvals (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    payout_type ENUM('SETTLEMENT_BATCH', 'NET_PAYOUT') NOT NULL,
+    payout_id BIGINT UNSIGNED NOT NULL,
+    payee_account_id BIGINT UNSIGNED NOT NULL,
+    amount DECIMAL(18,8) NOT NULL,
+    status ENUM('PENDING', 'APPROVED', 'REJECTED') NOT NULL DEFAULT 'PENDING',
+    requested_by BIGINT UNSIGNED NULL,
+    first_approved_by BIGINT UNSIGNED NULL,
+    first_approved_at TIMESTAMP NULL,
+    decided_by BIGINT UNSIGNED NULL,
+    decided_at TIMESTAMP NULL,
+    reject_reason VARCHAR(500) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_payout_approval_external_id (external_id),
+    UNIQUE KEY uk_payout_approval_payout (payout_type, payout_id),
+    INDEX idx_payout_approvals_status (status, id),
+    FOREIGN KEY (payee_account_id) REFERENCES accounts(id),
+    FOREIGN KEY (requested_by) REFERENCES users(id),
+    FOREIGN KEY (first_approved_by) REFERENCES users(id),
+    FOREIGN KEY (decided_by) REFERENCES users(id),
+    CONSTRAINT chk_payout_approval_amount CHECK (amount > 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,5 @@
+-- Purchase orders 롤백
+
+DROP TABLE IF EXISTS purchase_order_items;
+DROP TABLE IF EXISTS purchase_orders;
+DROP TABLE IF EXISTS document_sequences;
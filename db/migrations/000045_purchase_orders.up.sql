@@ -0,0 +1,59 @@
+-- ============================================================================
+-- Purchase orders
+-- ============================================================================
+-- 구매자가 카탈로그 상품(SKU)으로 발주 → 판매자가 수락/거절/역제안 → 수락 시 발주 가격 그대로 주문 생성
+--   status: SUBMITTED (판매자 응답 대기) | COUNTERED (구매자 응답 대기) | ACCEPTED (주문 전환, order_id) | REJECTED | CANCELLED
+--   revision: 조건 변경(역제안/재제안)마다 +1, 항목은 현재 조건만 보관 (이전 조건은 감사 로그)
+--   payment_terms_days: 주문 전환 시 결제 기한 = 수락 시각 + N일 (0 = 기한 없음)
+-- document_sequences: 접두어(PO, ORD)·일자별 문서 번호 채번 (PO-20260901-0001)
+-- NOTE: 주문 전환 후 상품 가격이 바뀌어도 주문 금액은 발주 가격으로 고정
+
+CREATE TABLE document_sequences (
+    prefix VARCHAR(10) NOT NULL,
+    seq_date DATE NOT NULL,
+    last_value INT UNSIGNED NOT NULL,
+    PRIMARY KEY (prefix, seq_date)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE purchase_orders (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(36) NOT NULL,
+    po_number VARCHAR(50) NOT NULL,
+    buyer_id BIGINT UNSIGNED NOT NULL,
+    seller_id BIGINT UNSIGNED NOT NULL,
+    status ENUM('SUBMITTED', 'COUNTERED', 'ACCEPTED', 'REJECTED', 'CANCELLED') NOT NULL DEFAULT 'SUBMITTED',
+    revision INT UNSIGNED NOT NULL DEFAULT 1,
+    token_symbol VARCHAR(10) NOT NULL,
+    total_amount DECIMAL(18,2) NOT NULL,
+    payment_terms_days INT UNSIGNED NOT NULL DEFAULT 0,
+    notes VARCHAR(1000) NULL,
+    reject_reason VARCHAR(500) NULL,
+    order_id BIGINT UNSIGNED NULL,
+    decided_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_purchase_order_external_id (external_id),
+    UNIQUE KEY uk_purchase_order_number (po_number),
+    UNIQUE KEY uk_purchase_order_order (order_id),
+    INDEX idx_purchase_orders_buyer (buyer_id, id),
+    INDEX idx_purchase_orders_seller (seller_id, id),
+    FOREIGN KEY (buyer_id) REFERENCES users(id),
+    FOREIGN KEY (seller_id) REFERENCES users(id),
+    FOREIGN KEY (order_id) REFERENCES orders(id),
+    CONSTRAINT chk_purchase_order_amount CHECK (total_amount > 0),
+    CONSTRAINT chk_purchase_order_parties CHECK (buyer_id <> seller_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE purchase_order_items (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    purchase_order_id BIGINT UNSIGNED NOT NULL,
+    line_no INT UNSIGNED NOT NULL,
+    product_id BIGINT UNSIGNED NOT NULL,
+    quantity INT UNSIGNED NOT NULL,
+    unit_price DECIMAL(18,2) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_purchase_order_line (purchase_order_id, line_no),
+    FOREIGN KEY (purchase_order_id) REFERENCES purchase_orders(id) ON DELETE CASCADE,
+    FOREIGN KEY (product_id) REFERENCES products(id),
+    CONSTRAINT chk_purchase_order_item CHECK (quantity > 0 AND unit_price >= 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
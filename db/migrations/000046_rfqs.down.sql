@@ -0,0 +1,7 @@
+-- Requests for quote 롤백
+
+DROP TABLE IF EXISTS rfq_quote_items;
+DROP TABLE IF EXISTS rfq_quotes;
+DROP TABLE IF EXISTS rfq_sellers;
+DROP TABLE IF EXISTS rfq_items;
+DROP TABLE IF EXISTS rfqs;
@@ -0,0 +1,90 @@
+-- ============================================================================
+-- Requests for quote (RFQ)
+-- ============================================================================
+-- 구매자가 카탈로그 상품(SKU)·수량으로 견적 요청 → 초대된 판매자들이 견적 제출 → 구매자가 견적 하나를 수락하면 주문 생성
+--   rfqs.status: OPEN (견적 접수/수락 가능) | AWARDED (견적 수락, order_id) | CANCELLED
+--   respond_by: 견적 제출 마감 (NULL = 마감 없음, 마감 후에도 기존 견적 수락 가능)
+--   rfq_quotes: 판매자당 1건 (재제출 시 같은 행 교체, revision +1)
+--     price_currency/price_amount: 법정화폐 가격 (NULL = 토큰 금액으로 직접 책정), 항목 단가도 같은 통화
+--     total_amount: 토큰 금액 (법정화폐 견적은 제출 시점 환율로 환산해 고정, expires_at까지 유효)
+--     status: ACTIVE | WITHDRAWN (판매자 철회) | ACCEPTED | DECLINED (다른 견적 수락/요청 취소)
+-- NOTE: 수락 시 주문 금액/환율은 견적 그대로 (orders.price_currency/fx_rate 등), 만료 여부는 expires_at으로 판단
+
+CREATE TABLE rfqs (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(36) NOT NULL,
+    rfq_number VARCHAR(50) NOT NULL,
+    buyer_id BIGINT UNSIGNED NOT NULL,
+    token_symbol VARCHAR(10) NOT NULL,
+    status ENUM('OPEN', 'AWARDED', 'CANCELLED') NOT NULL DEFAULT 'OPEN',
+    notes VARCHAR(1000) NULL,
+    respond_by TIMESTAMP NULL,
+    awarded_quote_id BIGINT UNSIGNED NULL,
+    order_id BIGINT UNSIGNED NULL,
+    closed_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_rfq_external_id (external_id),
+    UNIQUE KEY uk_rfq_number (rfq_number),
+    UNIQUE KEY uk_rfq_order (order_id),
+    INDEX idx_rfqs_buyer (buyer_id, id),
+    FOREIGN KEY (buyer_id) REFERENCES users(id),
+    FOREIGN KEY (order_id) REFERENCES orders(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE rfq_items (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    rfq_id BIGINT UNSIGNED NOT NULL,
+    line_no INT UNSIGNED NOT NULL,
+    product_id BIGINT UNSIGNED NOT NULL,
+    quantity INT UNSIGNED NOT NULL,
+    UNIQUE KEY uk_rfq_line (rfq_id, line_no),
+    FOREIGN KEY (rfq_id) REFERENCES rfqs(id) ON DELETE CASCADE,
+    FOREIGN KEY (product_id) REFERENCES products(id),
+    CONSTRAINT chk_rfq_item_quantity CHECK (quantity > 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE rfq_sellers (
+    rfq_id BIGINT UNSIGNED NOT NULL,
+    seller_id BIGINT UNSIGNED NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (rfq_id, seller_id),
+    INDEX idx_rfq_sellers_seller (seller_id, rfq_id),
+    FOREIGN KEY (rfq_id) REFERENCES rfqs(id) ON DELETE CASCADE,
+    FOREIGN KEY (seller_id) REFERENCES users(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE rfq_quotes (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(36) NOT NULL,
+    rfq_id BIGINT UNSIGNED NOT NULL,
+    seller_id BIGINT UNSIGNED NOT NULL,
+    status ENUM('ACTIVE', 'WITHDRAWN', 'ACCEPTED', 'DECLINED') NOT NULL DEFAULT 'ACTIVE',
+    revision INT UNSIGNED NOT NULL DEFAULT 1,
+    price_currency CHAR(3) NULL,
+    price_amount DECIMAL(18,2) NULL,
+    total_amount DECIMAL(18,2) NOT NULL,
+    fx_rate DECIMAL(36,18) NULL,
+    fx_rate_source VARCHAR(20) NULL,
+    fx_rate_at TIMESTAMP NULL,
+    quoted_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    notes VARCHAR(1000) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_rfq_quote_external_id (external_id),
+    UNIQUE KEY uk_rfq_quote_seller (rfq_id, seller_id),
+    FOREIGN KEY (rfq_id) REFERENCES rfqs(id),
+    FOREIGN KEY (seller_id) REFERENCES users(id),
+    CONSTRAINT chk_rfq_quote_amount CHECK (total_amount > 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE rfq_quote_items (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    quote_id BIGINT UNSIGNED NOT NULL,
+    line_no INT UNSIGNED NOT NULL,
+    unit_price DECIMAL(18,2) NOT NULL,
+    UNIQUE KEY uk_rfq_quote_line (quote_id, line_no),
+    FOREIGN KEY (quote_id) REFERENCES rfq_quotes(id) ON DELETE CASCADE,
+    CONSTRAINT chk_rfq_quote_item_price CHECK (unit_price >= 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,5 @@
+-- Net terms and buyer credit limits 롤백
+
+DROP TABLE IF EXISTS invoice_payments;
+DROP TABLE IF EXISTS invoices;
+DROP TABLE IF EXISTS credit_terms;
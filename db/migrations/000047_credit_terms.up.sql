@@ -0,0 +1,69 @@
+-- ============================================================================
+-- Net terms and buyer credit limits
+-- ============================================================================
+-- 판매자가 구매자에게 외상 조건(NET-30/60)과 신용 한도를 부여 → 구매자가 확정 주문을 외상으로 전환하면 청구서 발행
+--   credit_terms: 구매자-판매자 관계당 1건 (uk_credit_terms_parties), 한도는 token_symbol 기준
+--     outstanding_amount: 미결제 청구서 잔액 합계, 가용 한도 = credit_limit - outstanding_amount
+--     status: ACTIVE | SUSPENDED (신규 청구만 차단, 기존 청구서 결제는 계속)
+--   invoices: 주문당 1건 (uk_invoice_order), due_at = 발행 시각 + 조건 일수 → orders.payment_due_at (독촉 기준)
+--     status: OPEN | PAID (전액 결제, 주문 PAID) | VOID (주문 취소, 잔액만큼 한도 복원)
+--   invoice_payments: 청구서 결제 기록 (결제액만큼 outstanding_amount 감소 → 가용 한도 복원)
+-- NOTE: 한도 확인/증감은 credit_terms row-lock 하에서만, 잠금 순서 orders → credit_terms → invoices
+
+CREATE TABLE credit_terms (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(36) NOT NULL,
+    buyer_id BIGINT UNSIGNED NOT NULL,
+    seller_id BIGINT UNSIGNED NOT NULL,
+    token_symbol VARCHAR(10) NOT NULL,
+    terms ENUM('NET_30', 'NET_60') NOT NULL,
+    credit_limit DECIMAL(18,2) NOT NULL,
+    outstanding_amount DECIMAL(18,2) NOT NULL DEFAULT 0,
+    status ENUM('ACTIVE', 'SUSPENDED') NOT NULL DEFAULT 'ACTIVE',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_credit_terms_external_id (external_id),
+    UNIQUE KEY uk_credit_terms_parties (buyer_id, seller_id),
+    INDEX idx_credit_terms_seller (seller_id, id),
+    FOREIGN KEY (buyer_id) REFERENCES users(id),
+    FOREIGN KEY (seller_id) REFERENCES users(id),
+    CONSTRAINT chk_credit_terms_amounts CHECK (credit_limit >= 0 AND outstanding_amount >= 0),
+    CONSTRAINT chk_credit_terms_parties CHECK (buyer_id <> seller_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE invoices (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(36) NOT NULL,
+    invoice_number VARCHAR(50) NOT NULL,
+    credit_terms_id BIGINT UNSIGNED NOT NULL,
+    order_id BIGINT UNSIGNED NOT NULL,
+    amount DECIMAL(18,2) NOT NULL,
+    paid_amount DECIMAL(18,2) NOT NULL DEFAULT 0,
+    status ENUM('OPEN', 'PAID', 'VOID') NOT NULL DEFAULT 'OPEN',
+    issued_at TIMESTAMP NOT NULL,
+    due_at TIMESTAMP NOT NULL,
+    closed_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_invoice_external_id (external_id),
+    UNIQUE KEY uk_invoice_number (invoice_number),
+    UNIQUE KEY uk_invoice_order (order_id),
+    INDEX idx_invoices_credit_terms (credit_terms_id, status),
+    INDEX idx_invoices_status_due (status, due_at),
+    FOREIGN KEY (credit_terms_id) REFERENCES credit_terms(id),
+    FOREIGN KEY (order_id) REFERENCES orders(id),
+    CONSTRAINT chk_invoice_amounts CHECK (amount > 0 AND paid_amount >= 0 AND paid_amount <= amount)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE invoice_payments (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(36) NOT NULL,
+    invoice_id BIGINT UNSIGNED NOT NULL,
+    amount DECIMAL(18,2) NOT NULL,
+    reference VARCHAR(100) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_invoice_payment_external_id (external_id),
+    INDEX idx_invoice_payments_invoice (invoice_id, id),
+    FOREIGN KEY (invoice_id) REFERENCES invoices(id),
+    CONSTRAINT chk_invoice_payment_amount CHECK (amount > 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
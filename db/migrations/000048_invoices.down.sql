@@ -0,0 +1,40 @@
+-- Invoice module 롤백
+-- NOTE: 수동 청구서(외상 조건 없음)와 DRAFT 청구서는 삭제, 외상 청구서는 OPEN으로 복원
+
+ALTER TABLE deposits
+    DROP INDEX idx_deposits_invoice_match,
+    DROP COLUMN invoice_matched_at;
+
+ALTER TABLE invoice_payments
+    DROP FOREIGN KEY fk_invoice_payments_deposit,
+    DROP INDEX uk_invoice_payment_deposit,
+    DROP COLUMN deposit_id;
+
+DROP TABLE IF EXISTS invoice_items;
+
+DELETE p FROM invoice_payments p
+JOIN invoices i ON i.id = p.invoice_id
+WHERE i.credit_terms_id IS NULL OR i.status = 'DRAFT';
+DELETE FROM invoices WHERE credit_terms_id IS NULL OR status = 'DRAFT';
+
+ALTER TABLE invoices
+    MODIFY COLUMN status ENUM('OPEN', 'ISSUED', 'PARTIALLY_PAID', 'PAID', 'OVERDUE', 'VOID') NOT NULL DEFAULT 'OPEN';
+
+UPDATE invoices SET status = 'OPEN' WHERE status IN ('ISSUED', 'PARTIALLY_PAID', 'OVERDUE');
+
+ALTER TABLE invoices
+    DROP FOREIGN KEY fk_invoices_buyer,
+    DROP FOREIGN KEY fk_invoices_seller,
+    DROP INDEX idx_invoices_buyer,
+    DROP INDEX idx_invoices_seller,
+    DROP COLUMN buyer_id,
+    DROP COLUMN seller_id,
+    DROP COLUMN token_symbol,
+    DROP COLUMN payment_terms_days,
+    DROP COLUMN notes,
+    MODIFY COLUMN status ENUM('OPEN', 'PAID', 'VOID') NOT NULL DEFAULT 'OPEN',
+    MODIFY COLUMN invoice_number VARCHAR(50) NOT NULL,
+    MODIFY COLUMN credit_terms_id BIGINT UNSIGNED NOT NULL,
+    MODIFY COLUMN order_id BIGINT UNSIGNED NOT NULL,
+    MODIFY COLUMN issued_at TIMESTAMP NOT NULL,
+    MODIFY COLUMN due_at TIMESTAMP NOT NULL;
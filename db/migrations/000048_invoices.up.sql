@@ -0,0 +1,78 @@
+-- ============================================================================
+-- Invoice module
+-- ============================================================================
+-- 주문 기반 또는 수동 청구서 (항목, 결제 기한, 상태) + 입금 자동 대사
+--   invoices: 외상 조건(credit_terms_id)/주문(order_id) 없는 수동 청구서 허용 → 당사자/토큰 직접 보관
+--     status: DRAFT (번호 미발행, 구매자 비공개) → ISSUED → PARTIALLY_PAID → PAID
+--             ISSUED/PARTIALLY_PAID + 기한 경과 → OVERDUE (대사 작업이 전환), 미결제 취소 → VOID
+--     payment_terms_days: 발행 시 due_at = issued_at + N일 (외상 청구서는 NET 일수)
+--   invoice_items: 청구 항목 (주문 기반이면 주문 상품에서 복사)
+--   invoice_payments.deposit_id: 자동 대사된 입금 (NULL = 판매자 수동 기록), 입금 1건이 여러 청구서에 나뉠 수 있음
+--   deposits.invoice_matched_at: 대사 처리 시각 (배정 0건이어도 기록 → 재처리 방지)
+-- 기존 외상 청구서: OPEN → 결제액 유무에 따라 ISSUED / PARTIALLY_PAID, 항목은 주문 상품에서 복사
+-- 기존 입금은 대사 완료로 표시 (배포 직후 과거 입금이 미결제 청구서에 배정되는 것 방지)
+
+ALTER TABLE invoices
+    MODIFY COLUMN invoice_number VARCHAR(50) NULL,
+    MODIFY COLUMN credit_terms_id BIGINT UNSIGNED NULL,
+    MODIFY COLUMN order_id BIGINT UNSIGNED NULL,
+    MODIFY COLUMN status ENUM('OPEN', 'DRAFT', 'ISSUED', 'PARTIALLY_PAID', 'PAID', 'OVERDUE', 'VOID') NOT NULL DEFAULT 'DRAFT',
+    MODIFY COLUMN issued_at TIMESTAMP NULL,
+    MODIFY COLUMN due_at TIMESTAMP NULL,
+    ADD COLUMN buyer_id BIGINT UNSIGNED NULL,
+    ADD COLUMN seller_id BIGINT UNSIGNED NULL,
+    ADD COLUMN token_symbol VARCHAR(10) NULL,
+    ADD COLUMN payment_terms_days INT UNSIGNED NOT NULL DEFAULT 30,
+    ADD COLUMN notes VARCHAR(1000) NULL;
+
+UPDATE invoices i
+JOIN credit_terms ct ON ct.id = i.credit_terms_id
+SET i.buyer_id = ct.buyer_id,
+    i.seller_id = ct.seller_id,
+    i.token_symbol = ct.token_symbol,
+    i.payment_terms_days = IF(ct.terms = 'NET_60', 60, 30),
+    i.status = CASE WHEN i.status <> 'OPEN' THEN i.status WHEN i.paid_amount > 0 THEN 'PARTIALLY_PAID' ELSE 'ISSUED' END;
+
+ALTER TABLE invoices
+    MODIFY COLUMN status ENUM('DRAFT', 'ISSUED', 'PARTIALLY_PAID', 'PAID', 'OVERDUE', 'VOID') NOT NULL DEFAULT 'DRAFT',
+    MODIFY COLUMN buyer_id BIGINT UNSIGNED NOT NULL,
+    MODIFY COLUMN seller_id BIGINT UNSIGNED NOT NULL,
+    MODIFY COLUMN token_symbol VARCHAR(10) NOT NULL,
+    ADD INDEX idx_invoices_buyer (buyer_id, status),
+    ADD INDEX idx_invoices_seller (seller_id, id),
+    ADD CONSTRAINT fk_invoices_buyer FOREIGN KEY (buyer_id) REFERENCES users(id),
+    ADD CONSTRAINT fk_invoices_seller FOREIGN KEY (seller_id) REFERENCES users(id);
+
+CREATE TABLE invoice_items (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    invoice_id BIGINT UNSIGNED NOT NULL,
+    line_no INT UNSIGNED NOT NULL,
+    product_id BIGINT UNSIGNED NULL,
+    description VARCHAR(255) NOT NULL,
+    quantity INT UNSIGNED NOT NULL,
+    unit_price DECIMAL(18,2) NOT NULL,
+    amount DECIMAL(18,2) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_invoice_line (invoice_id, line_no),
+    FOREIGN KEY (invoice_id) REFERENCES invoices(id) ON DELETE CASCADE,
+    FOREIGN KEY (product_id) REFERENCES products(id),
+    CONSTRAINT chk_invoice_item CHECK (quantity > 0 AND unit_price >= 0 AND amount >= 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+INSERT INTO invoice_items (invoice_id, line_no, product_id, description, quantity, unit_price, amount)
+SELECT i.id, ROW_NUMBER() OVER (PARTITION BY i.id ORDER BY oi.id), oi.product_id, p.name,
+       oi.quantity, oi.unit_price, oi.quantity * oi.unit_price
+FROM invoices i
+JOIN order_items oi ON oi.order_id = i.order_id
+JOIN products p ON p.id = oi.product_id;
+
+ALTER TABLE invoice_payments
+    ADD COLUMN deposit_id BIGINT UNSIGNED NULL,
+    ADD UNIQUE KEY uk_invoice_payment_deposit (invoice_id, deposit_id),
+    ADD CONSTRAINT fk_invoice_payments_deposit FOREIGN KEY (deposit_id) REFERENCES deposits(id);
+
+ALTER TABLE deposits
+    ADD COLUMN invoice_matched_at TIMESTAMP NULL,
+    ADD INDEX idx_deposits_invoice_match (status, invoice_matched_at);
+
+UPDATE deposits SET invoice_matched_at = NOW() WHERE status IN ('CREDITED', 'COMPLETED');
@@ -0,0 +1,10 @@
+-- Credit notes 롤백
+-- NOTE: 대변 메모로 조정된 청구서 잔액/외상 한도와 반환 분개는 되돌리지 않음
+
+DROP TABLE IF EXISTS credit_note_lines;
+DROP TABLE IF EXISTS credit_notes;
+
+ALTER TABLE invoices
+    DROP CHECK chk_invoice_credited,
+    DROP COLUMN credited_amount,
+    DROP COLUMN refunded_amount;
@@ -0,0 +1,48 @@
+-- ============================================================================
+-- Credit notes
+-- ============================================================================
+-- 발행된 청구서에 대한 정정/반품 대변 메모 (청구서 금액·항목은 불변, 대변 메모로만 조정)
+--   credit_notes: 청구서 전액(남은 대변 가능 금액) 또는 항목별 수량 단위, 번호 CN-YYYYMMDD-NNNN
+--     applied_amount: 미결제 잔액 차감분 (외상 청구서는 outstanding_amount 감소 → 가용 한도 복원)
+--     refunded_amount: 이미 결제된 금액 초과분 → 판매자 계정 DEBIT / 구매자 계정 CREDIT 분개 (ledger_tx_id)
+--   credit_note_lines: 항목별 대변 수량 (항목당 누적 수량 ≤ 청구 수량)
+--   invoices.credited_amount / refunded_amount: 대변 누적 / 반환 누적
+--     잔액 = amount - credited_amount - (paid_amount - refunded_amount), credited_amount ≤ amount
+
+ALTER TABLE invoices
+    ADD COLUMN credited_amount DECIMAL(18,2) NOT NULL DEFAULT 0,
+    ADD COLUMN refunded_amount DECIMAL(18,2) NOT NULL DEFAULT 0,
+    ADD CONSTRAINT chk_invoice_credited CHECK (credited_amount >= 0 AND credited_amount <= amount AND refunded_amount >= 0 AND refunded_amount <= paid_amount);
+
+CREATE TABLE credit_notes (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(36) NOT NULL,
+    credit_note_number VARCHAR(50) NOT NULL,
+    invoice_id BIGINT UNSIGNED NOT NULL,
+    reason ENUM('CORRECTION', 'RETURN') NOT NULL,
+    amount DECIMAL(18,2) NOT NULL,
+    applied_amount DECIMAL(18,2) NOT NULL,
+    refunded_amount DECIMAL(18,2) NOT NULL,
+    memo VARCHAR(1000) NULL,
+    ledger_tx_id VARCHAR(36) NULL,
+    created_by BIGINT UNSIGNED NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_credit_note_external_id (external_id),
+    UNIQUE KEY uk_credit_note_number (credit_note_number),
+    INDEX idx_credit_notes_invoice (invoice_id, id),
+    FOREIGN KEY (invoice_id) REFERENCES invoices(id),
+    CONSTRAINT chk_credit_note_amounts CHECK (amount > 0 AND applied_amount >= 0 AND refunded_amount >= 0 AND applied_amount + refunded_amount = amount)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE credit_note_lines (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    credit_note_id BIGINT UNSIGNED NOT NULL,
+    invoice_line_no INT UNSIGNED NOT NULL,
+    quantity INT UNSIGNED NOT NULL,
+    unit_price DECIMAL(18,2) NOT NULL,
+    amount DECIMAL(18,2) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_credit_note_line (credit_note_id, invoice_line_no),
+    FOREIGN KEY (credit_note_id) REFERENCES credit_notes(id) ON DELETE CASCADE,
+    CONSTRAINT chk_credit_note_line CHECK (quantity > 0 AND unit_price >= 0 AND amount >= 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
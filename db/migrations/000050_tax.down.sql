@@ -0,0 +1,10 @@
+-- Tax calculation 롤백
+-- NOTE: 세금이 포함된 주문/청구서 총액은 되돌리지 않음
+
+ALTER TABLE credit_note_lines DROP COLUMN tax_amount;
+ALTER TABLE invoices DROP COLUMN tax_amount;
+ALTER TABLE orders DROP COLUMN tax_amount;
+
+DROP TABLE IF EXISTS tax_lines;
+DROP TABLE IF EXISTS tax_rates;
+DROP TABLE IF EXISTS tax_profiles;
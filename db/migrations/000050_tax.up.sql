@@ -0,0 +1,53 @@
+-- ============================================================================
+-- Tax calculation
+-- ============================================================================
+-- 주문 생성(발주 수락/견적 수락)·수동 청구서 작성 시 세금 산정 (tax.Calculator: none | table | external)
+--   tax_profiles: 사용자 과세 국가 (구매자 국가 기준 과세, 프로필 없는 구매자는 비과세)
+--   tax_rates: 내부 세율표 (국가 + 상품 카테고리, category NULL = 국가 기본 세율), rate = 백분율
+--   tax_lines: 문서 항목별 세금 내역 (주문/청구서, 항목당 여러 세목 가능 - 외부 API)
+--   orders/invoices.tax_amount: 세금 합계 (total_amount/amount에 포함, 항목 합계 + 세금 = 총액)
+--   credit_note_lines.tax_amount: 항목 대변에 포함된 세금 (수량 비례)
+
+CREATE TABLE tax_profiles (
+    user_id BIGINT UNSIGNED PRIMARY KEY,
+    country CHAR(2) NOT NULL,
+    tax_id VARCHAR(50) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE tax_rates (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(36) NOT NULL,
+    country CHAR(2) NOT NULL,
+    category VARCHAR(50) NULL,
+    -- 국가 기본 세율(category NULL)도 국가당 1건으로 제한하기 위한 키
+    category_key VARCHAR(50) AS (COALESCE(category, '')) STORED,
+    name VARCHAR(50) NOT NULL,
+    rate DECIMAL(7,4) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_tax_rate_external_id (external_id),
+    UNIQUE KEY uk_tax_rate_scope (country, category_key),
+    CONSTRAINT chk_tax_rate CHECK (rate >= 0 AND rate <= 100)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE tax_lines (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    document_type ENUM('ORDER', 'INVOICE') NOT NULL,
+    document_id BIGINT UNSIGNED NOT NULL,
+    line_no INT UNSIGNED NOT NULL,
+    jurisdiction VARCHAR(20) NOT NULL,
+    tax_name VARCHAR(50) NOT NULL,
+    rate DECIMAL(7,4) NOT NULL,
+    taxable_amount DECIMAL(18,2) NOT NULL,
+    tax_amount DECIMAL(18,2) NOT NULL,
+    provider VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_tax_lines_document (document_type, document_id, line_no)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+ALTER TABLE orders ADD COLUMN tax_amount DECIMAL(18,2) NOT NULL DEFAULT 0;
+ALTER TABLE invoices ADD COLUMN tax_amount DECIMAL(18,2) NOT NULL DEFAULT 0;
+ALTER TABLE credit_note_lines ADD COLUMN tax_amount DECIMAL(18,2) NOT NULL DEFAULT 0;
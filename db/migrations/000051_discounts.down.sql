@@ -0,0 +1,9 @@
+-- Discounts 롤백
+-- NOTE: 할인이 반영된 주문 총액은 되돌리지 않음
+
+ALTER TABLE purchase_orders DROP COLUMN coupon_code;
+
+DROP TABLE IF EXISTS order_discounts;
+DROP TABLE IF EXISTS coupon_redemptions;
+DROP TABLE IF EXISTS coupons;
+DROP TABLE IF EXISTS volume_tiers;
@@ -0,0 +1,76 @@
+-- ============================================================================
+-- Discounts
+-- ============================================================================
+-- 주문 생성(발주 수락) 시 할인 적용: 항목별 수량 구간 할인 → 쿠폰 할인 (할인 후 금액에 세금 산정)
+--   volume_tiers: 상품별 수량 구간 할인 (min_quantity 이상 주문 시 discount_percent %, 가장 높은 구간 1개 적용)
+--   coupons: 판매자 쿠폰 (판매자별 코드, PERCENT = 백분율 / FIXED = 정액, 최소 주문 금액·사용 한도·만료)
+--     max_redemptions: 전체 사용 한도 (NULL = 무제한), max_per_buyer: 구매자별 사용 한도 (NULL = 무제한)
+--     redemption_count: 사용 횟수 (쿠폰 row-lock 후 증가)
+--   coupon_redemptions: 쿠폰 사용 내역 (주문당 쿠폰 1개)
+--   order_discounts: 주문 할인 내역 (VOLUME = 항목 할인(line_no), COUPON = 주문 할인(line_no NULL))
+--   purchase_orders.coupon_code: 구매자가 발주 시 제출한 쿠폰 (만료는 발주 시점 기준, 사용 한도는 수락 시점 기준)
+-- NOTE: orders.total_amount = 항목 합계 - 할인 + 세금
+
+CREATE TABLE volume_tiers (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    product_id BIGINT UNSIGNED NOT NULL,
+    min_quantity INT UNSIGNED NOT NULL,
+    discount_percent DECIMAL(5,2) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_volume_tier (product_id, min_quantity),
+    FOREIGN KEY (product_id) REFERENCES products(id),
+    CONSTRAINT chk_volume_tier CHECK (min_quantity > 1 AND discount_percent > 0 AND discount_percent < 100)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE coupons (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(36) NOT NULL,
+    seller_id BIGINT UNSIGNED NOT NULL,
+    code VARCHAR(32) NOT NULL,
+    discount_type ENUM('PERCENT', 'FIXED') NOT NULL,
+    discount_value DECIMAL(18,2) NOT NULL,
+    min_order_amount DECIMAL(18,2) NOT NULL DEFAULT 0,
+    max_redemptions INT UNSIGNED NULL,
+    max_per_buyer INT UNSIGNED NULL,
+    redemption_count INT UNSIGNED NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP NULL,
+    status ENUM('ACTIVE', 'DISABLED') NOT NULL DEFAULT 'ACTIVE',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_coupon_external_id (external_id),
+    UNIQUE KEY uk_coupon_code (seller_id, code),
+    FOREIGN KEY (seller_id) REFERENCES users(id),
+    CONSTRAINT chk_coupon_value CHECK (discount_value > 0 AND (discount_type = 'FIXED' OR discount_value < 100)),
+    CONSTRAINT chk_coupon_min_order CHECK (min_order_amount >= 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE coupon_redemptions (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    coupon_id BIGINT UNSIGNED NOT NULL,
+    buyer_id BIGINT UNSIGNED NOT NULL,
+    order_id BIGINT UNSIGNED NOT NULL,
+    amount DECIMAL(18,2) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_coupon_redemption_order (order_id),
+    INDEX idx_coupon_redemptions_buyer (coupon_id, buyer_id),
+    FOREIGN KEY (coupon_id) REFERENCES coupons(id),
+    FOREIGN KEY (buyer_id) REFERENCES users(id),
+    FOREIGN KEY (order_id) REFERENCES orders(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE order_discounts (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    order_id BIGINT UNSIGNED NOT NULL,
+    line_no INT UNSIGNED NULL,
+    kind ENUM('VOLUME', 'COUPON') NOT NULL,
+    coupon_id BIGINT UNSIGNED NULL,
+    description VARCHAR(100) NOT NULL,
+    amount DECIMAL(18,2) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_order_discounts_order (order_id, id),
+    FOREIGN KEY (order_id) REFERENCES orders(id),
+    FOREIGN KEY (coupon_id) REFERENCES coupons(id),
+    CONSTRAINT chk_order_discount_amount CHECK (amount > 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+ALTER TABLE purchase_orders ADD COLUMN coupon_code VARCHAR(32) NULL;
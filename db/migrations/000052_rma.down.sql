@@ -0,0 +1,5 @@
+-- Returns (RMA) 롤백
+-- NOTE: 승인된 반품의 환불·재입고는 되돌리지 않음
+
+DROP TABLE IF EXISTS rma_lines;
+DROP TABLE IF EXISTS rmas;
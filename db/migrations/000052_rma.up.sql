@@ -0,0 +1,56 @@
+-- ============================================================================
+-- Returns (RMA)
+-- ============================================================================
+-- 배송된 주문 항목의 반품 승인(RMA) → 재입고 + 원 결제 부분 환불
+--   rmas: 반품 요청, 번호 RMA-YYYYMMDD-NNNN
+--     status: REQUESTED → APPROVED | REJECTED | CANCELLED (구매자 철회)
+--     refund_amount: 주문 총액 × (반품 항목 금액 / 주문 항목 합계) → 할인·세금 비례 반영
+--     payment_id / refund_id: 승인 시 원 결제(CAPTURED)의 부분 환불 (payment_refunds), 같은 트랜잭션에서 연결
+--     restocked: 승인 시 재입고 여부 (inventory_logs INBOUND, reference_type = 'RMA')
+--   rma_lines: 반품 항목 (주문 항목 순서 line_no 기준, 항목당 누적 반품 수량 ≤ 주문 수량 - REQUESTED/APPROVED 기준)
+-- NOTE: 배송 상태(SHIPPED/COMPLETED)는 판매자 시스템에서 관리 → 반품 기한은 주문 상태 변경 시각 기준
+
+CREATE TABLE rmas (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(36) NOT NULL,
+    rma_number VARCHAR(50) NOT NULL,
+    order_id BIGINT UNSIGNED NOT NULL,
+    buyer_id BIGINT UNSIGNED NOT NULL,
+    seller_id BIGINT UNSIGNED NOT NULL,
+    status ENUM('REQUESTED', 'APPROVED', 'REJECTED', 'CANCELLED') NOT NULL DEFAULT 'REQUESTED',
+    reason VARCHAR(1000) NOT NULL,
+    refund_amount DECIMAL(18,2) NOT NULL,
+    payment_id BIGINT UNSIGNED NULL,
+    refund_id BIGINT UNSIGNED NULL,
+    restocked BOOLEAN NOT NULL DEFAULT FALSE,
+    decision_note VARCHAR(1000) NULL,
+    decided_by BIGINT UNSIGNED NULL,
+    decided_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_rma_external_id (external_id),
+    UNIQUE KEY uk_rma_number (rma_number),
+    INDEX idx_rmas_order (order_id, status),
+    INDEX idx_rmas_buyer (buyer_id, created_at),
+    INDEX idx_rmas_seller (seller_id, created_at),
+    FOREIGN KEY (order_id) REFERENCES orders(id),
+    FOREIGN KEY (buyer_id) REFERENCES users(id),
+    FOREIGN KEY (seller_id) REFERENCES users(id),
+    FOREIGN KEY (payment_id) REFERENCES payments(id),
+    FOREIGN KEY (refund_id) REFERENCES payment_refunds(id),
+    CONSTRAINT chk_rma_refund_amount CHECK (refund_amount >= 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE rma_lines (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    rma_id BIGINT UNSIGNED NOT NULL,
+    line_no INT UNSIGNED NOT NULL,
+    product_id BIGINT UNSIGNED NOT NULL,
+    quantity INT UNSIGNED NOT NULL,
+    unit_price DECIMAL(18,2) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_rma_line (rma_id, line_no),
+    FOREIGN KEY (rma_id) REFERENCES rmas(id) ON DELETE CASCADE,
+    FOREIGN KEY (product_id) REFERENCES products(id),
+    CONSTRAINT chk_rma_line CHECK (quantity > 0 AND unit_price >= 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
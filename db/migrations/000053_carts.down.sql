@@ -0,0 +1,5 @@
+-- Carts 롤백
+-- NOTE: 장바구니에서 전환된 주문은 그대로 유지
+
+DROP TABLE IF EXISTS cart_items;
+DROP TABLE IF EXISTS carts;
@@ -0,0 +1,43 @@
+-- ============================================================================
+-- Carts (draft orders)
+-- ============================================================================
+-- 구매자가 판매자별로 상품을 담아 두었다가 한 번에 주문으로 전환하는 장바구니
+--   carts: 구매자·판매자·결제 토큰 단위
+--     status: ACTIVE → CHECKED_OUT (주문 전환, order_id) | EXPIRED (만료 워커)
+--     expires_at: 마지막 변경 시각 + CART_TTL (변경마다 연장)
+--   cart_items: 상품별 수량 + 마지막 검증 시점의 카탈로그 단가 (주문 전환 시 가격 변동 확인)
+-- NOTE: 장바구니는 재고를 예약하지 않음 → 주문 전환 시 재고 예약 (inventory_logs RESERVE, reference_type = 'ORDER')
+
+CREATE TABLE carts (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(36) NOT NULL,
+    buyer_id BIGINT UNSIGNED NOT NULL,
+    seller_id BIGINT UNSIGNED NOT NULL,
+    token_symbol VARCHAR(10) NOT NULL,
+    status ENUM('ACTIVE', 'CHECKED_OUT', 'EXPIRED') NOT NULL DEFAULT 'ACTIVE',
+    order_id BIGINT UNSIGNED NULL,
+    expires_at TIMESTAMP NOT NULL,
+    checked_out_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_cart_external_id (external_id),
+    INDEX idx_carts_buyer (buyer_id, status, created_at),
+    INDEX idx_carts_expiry (status, expires_at),
+    FOREIGN KEY (buyer_id) REFERENCES users(id),
+    FOREIGN KEY (seller_id) REFERENCES users(id),
+    FOREIGN KEY (order_id) REFERENCES orders(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE cart_items (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    cart_id BIGINT UNSIGNED NOT NULL,
+    product_id BIGINT UNSIGNED NOT NULL,
+    quantity INT UNSIGNED NOT NULL,
+    unit_price DECIMAL(18,2) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_cart_item (cart_id, product_id),
+    FOREIGN KEY (cart_id) REFERENCES carts(id) ON DELETE CASCADE,
+    FOREIGN KEY (product_id) REFERENCES products(id),
+    CONSTRAINT chk_cart_item CHECK (quantity > 0 AND unit_price >= 0)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,11 @@
+-- Product variants 롤백
+-- NOTE: 변형 상품 행은 단일 상품으로 남음 (주문/재고 이력 보존)
+
+ALTER TABLE products DROP FOREIGN KEY fk_products_parent;
+
+ALTER TABLE products
+    DROP INDEX uk_products_parent_variant,
+    DROP COLUMN variant_packaging,
+    DROP COLUMN variant_grade,
+    DROP COLUMN variant_size,
+    DROP COLUMN parent_id;
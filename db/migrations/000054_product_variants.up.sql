@@ -0,0 +1,17 @@
+-- ============================================================================
+-- Product variants
+-- ============================================================================
+-- 상품(모델) 아래 규격/등급/포장 단위별 변형 상품 - 변형마다 고유 SKU·단가·재고
+--   products.parent_id: 변형 상품의 기준 상품 (NULL = 기준/단일 상품, 1단계만 허용)
+--   products.variant_size / variant_grade / variant_packaging: 변형 속성 ('' = 해당 없음)
+--     기준 상품별 속성 조합 1건 (uk_products_parent_variant)
+-- NOTE: 변형 상품은 일반 products 행 → 주문/장바구니/재고(inventories)는 기존처럼 product_id(변형) 단위
+-- NOTE: 변형이 있는 기준 상품은 직접 주문·재고 관리 불가 (변형 SKU로 주문)
+
+ALTER TABLE products
+    ADD COLUMN parent_id BIGINT UNSIGNED NULL AFTER id,
+    ADD COLUMN variant_size VARCHAR(50) NOT NULL DEFAULT '' AFTER category,
+    ADD COLUMN variant_grade VARCHAR(50) NOT NULL DEFAULT '' AFTER variant_size,
+    ADD COLUMN variant_packaging VARCHAR(50) NOT NULL DEFAULT '' AFTER variant_grade,
+    ADD CONSTRAINT fk_products_parent FOREIGN KEY (parent_id) REFERENCES products(id),
+    ADD UNIQUE KEY uk_products_parent_variant (parent_id, variant_size, variant_grade, variant_packaging);
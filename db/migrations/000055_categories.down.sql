@@ -0,0 +1,5 @@
+-- Product categories 롤백
+-- NOTE: products.category 값은 그대로 유지
+
+DROP TABLE IF EXISTS category_paths;
+DROP TABLE IF EXISTS categories;
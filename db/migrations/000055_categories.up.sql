@@ -0,0 +1,38 @@
+-- ============================================================================
+-- Product categories
+-- ============================================================================
+-- 대규모 B2B 카탈로그 탐색용 계층형 카테고리 (closure table)
+--   categories: code = products.category 값 (예산/지출 분석의 카테고리와 동일 키)
+--     parent_id: 상위 카테고리 (NULL = 최상위)
+--   category_paths: 조상-자손 쌍 전체 (자기 자신 depth 0 포함)
+--     하위 카테고리 포함 필터 = ancestor_id 기준 descendant 조회 1회
+-- NOTE: 상품은 카테고리 code로 연결 (products.category) → 기존 예산/분석 쿼리 변경 없음
+-- NOTE: 기존 상품 카테고리 값은 최상위 카테고리로 등록
+
+CREATE TABLE categories (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    code VARCHAR(50) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    parent_id BIGINT UNSIGNED NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_categories_code (code),
+    INDEX idx_categories_parent (parent_id),
+    FOREIGN KEY (parent_id) REFERENCES categories(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE category_paths (
+    ancestor_id BIGINT UNSIGNED NOT NULL,
+    descendant_id BIGINT UNSIGNED NOT NULL,
+    depth INT UNSIGNED NOT NULL,
+    PRIMARY KEY (ancestor_id, descendant_id),
+    INDEX idx_category_paths_descendant (descendant_id, depth),
+    FOREIGN KEY (ancestor_id) REFERENCES categories(id),
+    FOREIGN KEY (descendant_id) REFERENCES categories(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+INSERT INTO categories (code, name)
+SELECT DISTINCT category, category FROM products WHERE category IS NOT NULL AND category <> '';
+
+INSERT INTO category_paths (ancestor_id, descendant_id, depth)
+SELECT id, id, 0 FROM categories;
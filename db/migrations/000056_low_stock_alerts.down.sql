@@ -0,0 +1,5 @@
+-- Low-stock alerts 롤백
+
+ALTER TABLE products DROP CHECK chk_products_reorder_threshold;
+
+ALTER TABLE products DROP COLUMN reorder_threshold;
@@ -0,0 +1,10 @@
+-- ============================================================================
+-- Low-stock alerts
+-- ============================================================================
+-- 판매 SKU(변형 또는 변형 없는 상품)별 재주문 기준 수량
+--   products.reorder_threshold: 가용 재고(수량 - 예약, 전체 위치 합계)가 이 값 미만으로 내려가면 inventory.low_stock 이벤트 (NULL = 알림 없음)
+-- NOTE: 기준선을 넘어 내려가는 순간에만 이벤트 발행 (이미 미만인 상태의 추가 예약/출고는 재알림 없음)
+
+ALTER TABLE products
+    ADD COLUMN reorder_threshold BIGINT NULL AFTER variant_packaging,
+    ADD CONSTRAINT chk_products_reorder_threshold CHECK (reorder_threshold IS NULL OR reorder_threshold > 0);
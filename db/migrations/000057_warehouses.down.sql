@@ -0,0 +1,15 @@
+-- Warehouses 롤백
+-- NOTE: 이동 이력(TRANSFER_OUT/TRANSFER_IN)이 있으면 ENUM 축소 전에 ADJUST로 변환 (수량 변경 이력 보존)
+
+DROP TABLE IF EXISTS inventory_transfers;
+
+UPDATE inventory_logs SET event_type = 'ADJUST' WHERE event_type IN ('TRANSFER_OUT', 'TRANSFER_IN');
+
+ALTER TABLE inventory_logs
+    MODIFY COLUMN event_type ENUM('INBOUND', 'OUTBOUND', 'RESERVE', 'RELEASE', 'ADJUST') NOT NULL;
+
+ALTER TABLE inventories DROP FOREIGN KEY fk_inventories_warehouse;
+
+ALTER TABLE inventories DROP INDEX fk_inventories_warehouse;
+
+DROP TABLE IF EXISTS warehouses;
@@ -0,0 +1,51 @@
+-- ============================================================================
+-- Warehouses
+-- ============================================================================
+-- 재고 위치(inventories.location)를 창고로 관리 - 위치별 재고, 창고 간 이동, 주문 예약 전략
+--   warehouses: 창고 (code = inventories.location, country = 출고 국가, priority = 같은 조건에서 먼저 출고, 낮을수록 우선)
+--     INACTIVE 창고는 재고 조회만 가능 (주문 예약·이동 입고 대상에서 제외)
+--   inventory_transfers: 창고 간 재고 이동 (출고/입고 재고 이력은 inventory_logs TRANSFER_OUT/TRANSFER_IN, reference_type = TRANSFER)
+-- NOTE: 기존 재고 위치는 같은 code의 창고로 등록 (국가 미지정), default 창고는 항상 존재 (위치 없는 재고 조정/반품 재입고)
+
+CREATE TABLE warehouses (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    code VARCHAR(50) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    country CHAR(2) NULL,
+    priority INT UNSIGNED NOT NULL DEFAULT 100,
+    status ENUM('ACTIVE', 'INACTIVE') NOT NULL DEFAULT 'ACTIVE',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_warehouses_code (code)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+INSERT INTO warehouses (code, name) VALUES ('default', 'Default warehouse');
+
+INSERT INTO warehouses (code, name)
+SELECT DISTINCT i.location, i.location
+FROM inventories i
+WHERE i.location <> 'default';
+
+ALTER TABLE inventories
+    ADD CONSTRAINT fk_inventories_warehouse FOREIGN KEY (location) REFERENCES warehouses(code);
+
+ALTER TABLE inventory_logs
+    MODIFY COLUMN event_type ENUM('INBOUND', 'OUTBOUND', 'RESERVE', 'RELEASE', 'ADJUST', 'TRANSFER_OUT', 'TRANSFER_IN') NOT NULL;
+
+CREATE TABLE inventory_transfers (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(36) NOT NULL,
+    product_id BIGINT UNSIGNED NOT NULL,
+    from_warehouse_id BIGINT UNSIGNED NOT NULL,
+    to_warehouse_id BIGINT UNSIGNED NOT NULL,
+    quantity BIGINT NOT NULL,
+    reason VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_inventory_transfers_external_id (external_id),
+    INDEX idx_inventory_transfers_product (product_id, created_at),
+    FOREIGN KEY (product_id) REFERENCES products(id),
+    FOREIGN KEY (from_warehouse_id) REFERENCES warehouses(id),
+    FOREIGN KEY (to_warehouse_id) REFERENCES warehouses(id),
+    CONSTRAINT chk_inventory_transfers_quantity CHECK (quantity > 0),
+    CONSTRAINT chk_inventory_transfers_warehouses CHECK (from_warehouse_id <> to_warehouse_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,3 @@
+-- Stock Movements 롤백
+
+DROP TABLE IF EXISTS stock_movements;
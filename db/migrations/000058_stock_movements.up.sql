@@ -0,0 +1,35 @@
+-- ============================================================================
+-- Stock Movements
+-- ============================================================================
+-- 재고 변동 감사 이력 (불변, UPDATE/DELETE 쿼리 없음) - 분쟁·감사 시 SKU별 재고 변동 근거 조회
+--   reason: RESERVATION(주문 예약) | RELEASE(예약 해제 - 취소/결제 만료) | ADJUSTMENT(운영자 입고/출고/정정)
+--           FULFILLMENT(주문 출고 - 예약 소진) | RETURN(반품 재입고) | TRANSFER_OUT/TRANSFER_IN(창고 간 이동)
+--   quantity_delta / reserved_delta: 보유 수량·예약 수량 변화 (가용 재고 변화 = quantity_delta - reserved_delta)
+--   actor_type/actor_id: 변경 주체 (audit_logs와 동일, 결제 만료 등 배치 = SYSTEM)
+--   reference_type/reference_id: ORDER | RMA | TRANSFER (운영자 조정은 NULL)
+-- NOTE: inventory_logs(재고 행 기준 이력, 주문 예약 잔량 계산)와 같은 트랜잭션에서 함께 기록
+-- NOTE: 이 마이그레이션 이전 변동은 inventory_logs에만 있음 (변경 주체 미상 → 이관하지 않음)
+
+CREATE TABLE stock_movements (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    product_id BIGINT UNSIGNED NOT NULL,
+    inventory_id BIGINT UNSIGNED NOT NULL,
+    location VARCHAR(50) NOT NULL,
+    reason ENUM('RESERVATION', 'RELEASE', 'ADJUSTMENT', 'FULFILLMENT', 'RETURN', 'TRANSFER_OUT', 'TRANSFER_IN') NOT NULL,
+    quantity_delta BIGINT NOT NULL,
+    reserved_delta BIGINT NOT NULL,
+    quantity_after BIGINT NOT NULL,
+    reserved_after BIGINT NOT NULL,
+    actor_type VARCHAR(20) NOT NULL,
+    actor_id BIGINT UNSIGNED NULL,
+    reference_type VARCHAR(20) NULL,
+    reference_id BIGINT UNSIGNED NULL,
+    note VARCHAR(255) NULL,
+    request_id VARCHAR(64) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_stock_movements_product (product_id, id),
+    INDEX idx_stock_movements_reference (reference_type, reference_id),
+    INDEX idx_stock_movements_actor (actor_type, actor_id, created_at),
+    FOREIGN KEY (product_id) REFERENCES products(id),
+    FOREIGN KEY (inventory_id) REFERENCES inventories(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,9 @@
+-- Inventory reservation expiry 롤백
+-- NOTE: PAYMENT_EXPIRED 주문은 CANCELLED로 되돌림 (예약은 이미 해제됨)
+
+UPDATE orders SET status = 'CANCELLED' WHERE status = 'PAYMENT_EXPIRED';
+
+ALTER TABLE orders
+    DROP INDEX idx_orders_status_reservation,
+    DROP COLUMN reservation_expires_at,
+    MODIFY COLUMN status ENUM('PENDING', 'CONFIRMED', 'PAID', 'SHIPPED', 'COMPLETED', 'CANCELLED', 'REFUNDED') NOT NULL DEFAULT 'PENDING';
@@ -0,0 +1,14 @@
+-- ============================================================================
+-- Inventory reservation expiry
+-- ============================================================================
+-- 주문 재고 예약 만료 - 결제가 도착하지 않은 주문의 예약이 남아 재고가 묶이지 않도록
+--   orders.reservation_expires_at: 주문 전환 시 예약 만료 시각 (예약 없음/TTL 미설정이면 NULL)
+--   orders.status PAYMENT_EXPIRED: 만료 시각까지 결제(AUTHORIZED/CAPTURED)·발행 청구서가 없어 예약이 해제된 주문 (종료 상태)
+--   예약 해제는 inventory_logs RELEASE + stock_movements RELEASE (reference_type = 'ORDER', 변경 주체 SYSTEM)
+-- NOTE: 승인된 결제의 만료(payments.expires_at)는 결제 만료 스윕이 처리 → 이 만료는 결제가 시작되지 않은 주문만 대상
+--   idx_orders_status_reservation: 만료 대상 조회 (status IN (PENDING, CONFIRMED) AND reservation_expires_at <= now)
+
+ALTER TABLE orders
+    MODIFY COLUMN status ENUM('PENDING', 'CONFIRMED', 'PAID', 'SHIPPED', 'COMPLETED', 'CANCELLED', 'REFUNDED', 'PAYMENT_EXPIRED') NOT NULL DEFAULT 'PENDING',
+    ADD COLUMN reservation_expires_at TIMESTAMP NULL,
+    ADD INDEX idx_orders_status_reservation (status, reservation_expires_at);
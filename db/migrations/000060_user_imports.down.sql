@@ -0,0 +1,4 @@
+-- Bulk user import 롤백
+
+DROP TABLE IF EXISTS user_import_rows;
+DROP TABLE IF EXISTS user_imports;
@@ -0,0 +1,46 @@
+-- ============================================================================
+-- Bulk user import (마이그레이션 가맹점 사용자 일괄 등록)
+-- ============================================================================
+-- 이전 플랫폼의 사용자 목록(JSON 또는 CSV)을 작업 단위로 받아 사용자 + 계정을 생성
+--   user_imports: 관리자 import 작업 1건
+--     status: IN_PROGRESS(미처리 행 있음) → COMPLETED
+--     *_count: 행 처리 결과 집계 (청크 처리마다 갱신)
+--   user_import_rows: 업로드된 사용자 1명 = 행 1건 (행별 결과 리포트)
+--     status: PENDING → CREATED | DUPLICATE(이미 등록된 이메일 / 같은 작업 내 중복) | FAILED(검증 실패)
+-- NOTE: 청크 단위 트랜잭션으로 처리 - 중단돼도 PENDING 행이 남아 재개(resume) 시 이어서 처리
+
+CREATE TABLE user_imports (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    source ENUM('JSON', 'CSV') NOT NULL,
+    status ENUM('IN_PROGRESS', 'COMPLETED') NOT NULL DEFAULT 'IN_PROGRESS',
+    total_count INT UNSIGNED NOT NULL DEFAULT 0,
+    created_count INT UNSIGNED NOT NULL DEFAULT 0,
+    duplicate_count INT UNSIGNED NOT NULL DEFAULT 0,
+    failed_count INT UNSIGNED NOT NULL DEFAULT 0,
+    created_by BIGINT UNSIGNED NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    completed_at TIMESTAMP NULL,
+    UNIQUE KEY uk_user_import_external_id (external_id),
+    INDEX idx_user_imports_created (created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+CREATE TABLE user_import_rows (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    import_id BIGINT UNSIGNED NOT NULL,
+    row_index INT UNSIGNED NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    phone VARCHAR(20) NULL,
+    role VARCHAR(20) NOT NULL,
+    status ENUM('PENDING', 'CREATED', 'DUPLICATE', 'FAILED') NOT NULL DEFAULT 'PENDING',
+    user_id BIGINT UNSIGNED NULL,
+    error VARCHAR(255) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_user_import_row (import_id, row_index),
+    INDEX idx_user_import_rows_status (import_id, status, row_index),
+    FOREIGN KEY (import_id) REFERENCES user_imports(id),
+    FOREIGN KEY (user_id) REFERENCES users(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
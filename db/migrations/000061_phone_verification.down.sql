@@ -0,0 +1,4 @@
+-- Phone OTP verification 롤백
+
+ALTER TABLE users
+    DROP COLUMN phone_verified_at;
@@ -0,0 +1,10 @@
+-- ============================================================================
+-- Phone OTP verification
+-- ============================================================================
+-- users.phone_verified_at: 등록된 전화번호로 받은 OTP 코드 검증 시각 (NULL = 미인증)
+--   전화번호 변경 시 NULL로 초기화 → 새 번호로 다시 인증
+-- NOTE: OTP 코드/발송 횟수는 Redis에만 저장 (짧은 TTL, 영속 불필요)
+--       지급 주소 변경과 승인 대상 고액 지급은 인증된 전화번호 필요
+
+ALTER TABLE users
+    ADD COLUMN phone_verified_at TIMESTAMP NULL;
@@ -0,0 +1,3 @@
+-- External KYC provider applicants 롤백
+
+DROP TABLE IF EXISTS kyc_applicants;
@@ -0,0 +1,24 @@
+-- ============================================================================
+-- External KYC provider applicants
+-- ============================================================================
+-- KYC 요청 시 외부 KYC 제공자(Sumsub/Persona 등)에 생성한 applicant 1건
+--   provider + applicant_id: 제공자 측 식별자 (결정 webhook 매칭)
+--   status: PENDING → APPROVED | REJECTED (제공자 결정 수신 시, 사용자 kyc_status 전이와 같은 트랜잭션)
+--   reject_reason: 제공자가 보낸 거절 사유
+-- NOTE: 거절 후 재요청 시 제공자가 같은 applicant를 돌려주면 PENDING으로 재사용
+--       KYC_PROVIDER=manual이면 applicant 없이 관리자가 승인/거절 (기존 방식)
+
+CREATE TABLE kyc_applicants (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    user_id BIGINT UNSIGNED NOT NULL,
+    provider VARCHAR(30) NOT NULL,
+    applicant_id VARCHAR(100) NOT NULL,
+    status ENUM('PENDING', 'APPROVED', 'REJECTED') NOT NULL DEFAULT 'PENDING',
+    reject_reason VARCHAR(500) NULL,
+    decided_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_kyc_applicant (provider, applicant_id),
+    INDEX idx_kyc_applicants_user (user_id, created_at),
+    FOREIGN KEY (user_id) REFERENCES users(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
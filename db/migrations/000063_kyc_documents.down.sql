@@ -0,0 +1,3 @@
+-- KYC documents 롤백
+
+DROP TABLE IF EXISTS kyc_documents;
@@ -0,0 +1,31 @@
+-- ============================================================================
+-- KYC documents
+-- ============================================================================
+-- 사용자가 KYC 심사용으로 업로드한 신분/사업자 서류 1건
+--   storage_key: 아티팩트 저장소 객체 키 (kyc-documents/, AES-256-GCM 암호화 저장)
+--   sha256: 업로드 원본(평문) 해시 - 동일 파일 재업로드 식별, 무결성 확인
+--   status: SUBMITTED → ACCEPTED | REJECTED (관리자 심사, review_note = 거절 사유/메모)
+-- NOTE: 파일은 서명 URL 없이 관리자 API로만 복호화 조회 (조회도 감사 로그 기록)
+
+CREATE TABLE kyc_documents (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    external_id VARCHAR(64) NOT NULL,
+    user_id BIGINT UNSIGNED NOT NULL,
+    document_type ENUM('PASSPORT', 'NATIONAL_ID', 'DRIVERS_LICENSE', 'PROOF_OF_ADDRESS', 'BUSINESS_REGISTRATION', 'ARTICLES_OF_INCORPORATION', 'OTHER') NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT UNSIGNED NOT NULL,
+    sha256 CHAR(64) NOT NULL,
+    storage_key VARCHAR(512) NOT NULL,
+    status ENUM('SUBMITTED', 'ACCEPTED', 'REJECTED') NOT NULL DEFAULT 'SUBMITTED',
+    review_note VARCHAR(500) NULL,
+    reviewed_by BIGINT UNSIGNED NULL,
+    reviewed_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_kyc_document_external_id (external_id),
+    INDEX idx_kyc_documents_user (user_id, created_at),
+    INDEX idx_kyc_documents_status (status, created_at),
+    FOREIGN KEY (user_id) REFERENCES users(id),
+    FOREIGN KEY (reviewed_by) REFERENCES users(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,3 @@
+-- KYC provider webhook events 롤백
+
+DROP TABLE IF EXISTS kyc_webhook_events;
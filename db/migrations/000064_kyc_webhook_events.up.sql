@@ -0,0 +1,29 @@
+-- ============================================================================
+-- KYC provider webhook events
+-- ============================================================================
+-- 서명 검증된 KYC 제공자 결정 webhook 원문 (감사용, 사용자 kyc_status 전이와 같은 트랜잭션에 기록)
+--   payload: 제공자가 보낸 원문 JSON 그대로 (서명 대상)
+--   verdict: 제공자 원문 판정 (GREEN/RED/declined 등), outcome: 매핑된 결과
+--     APPROVED → VERIFIED, REJECTED → REJECTED, PENDING → 상태 변경 없음 (검토 중/재제출 요청 등)
+--   applied: 사용자 KYC 상태에 반영 여부 (재전송/이전 applicant/관리자 선결정이면 FALSE)
+--   payload_sha256: 같은 원문 재전송은 1행 (delivery_count 증가)
+-- NOTE: 서명이 틀린 요청은 기록하지 않음 (인증 안 된 본문으로 저장소 채우기 방지, 로그만)
+
+CREATE TABLE kyc_webhook_events (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    provider VARCHAR(30) NOT NULL,
+    applicant_id VARCHAR(100) NOT NULL,
+    user_id BIGINT UNSIGNED NULL,
+    verdict VARCHAR(50) NOT NULL,
+    outcome ENUM('APPROVED', 'REJECTED', 'PENDING') NOT NULL,
+    applied BOOLEAN NOT NULL DEFAULT FALSE,
+    payload JSON NOT NULL,
+    payload_sha256 CHAR(64) NOT NULL,
+    delivery_count INT UNSIGNED NOT NULL DEFAULT 1,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_received_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uk_kyc_webhook_event (provider, payload_sha256),
+    INDEX idx_kyc_webhook_events_applicant (provider, applicant_id, created_at),
+    INDEX idx_kyc_webhook_events_user (user_id, created_at),
+    FOREIGN KEY (user_id) REFERENCES users(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,3 @@
+-- API key bootstrap tokens 롤백
+
+DROP TABLE IF EXISTS api_key_bootstrap_tokens;
@@ -0,0 +1,15 @@
+-- ============================================================================
+-- API key bootstrap tokens
+-- ============================================================================
+-- 사용자 생성 응답으로 1회 전달되는 첫 API 키 발급 토큰 (인증 없는 발급은 이 토큰으로만)
+--   token_hash: SHA-256(hex), 원문은 저장하지 않음
+--   expires_at: 만료 후 사용 불가 (관리자가 대신 발급)
+-- NOTE: 첫 키 발급 시 삭제 (1회용), ADMIN 사용자는 토큰이 있어도 인증 없이 발급 불가
+
+CREATE TABLE api_key_bootstrap_tokens (
+    user_id BIGINT UNSIGNED PRIMARY KEY,
+    token_hash CHAR(64) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
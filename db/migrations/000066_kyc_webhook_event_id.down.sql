@@ -0,0 +1,5 @@
+-- KYC webhook event ID 롤백
+
+ALTER TABLE kyc_webhook_events DROP INDEX uk_kyc_webhook_event_id;
+ALTER TABLE kyc_webhook_events ADD UNIQUE KEY uk_kyc_webhook_event (provider, payload_sha256);
+ALTER TABLE kyc_webhook_events DROP COLUMN event_id;
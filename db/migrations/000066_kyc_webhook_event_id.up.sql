@@ -0,0 +1,12 @@
+-- KYC webhook event ID 중복 제거
+-- 제공자 이벤트 ID로 재전송 판별 (서명 타임스탬프가 달라도 같은 이벤트면 1행, delivery_count 증가)
+--   event_id: 기존 행은 NULL (MySQL UNIQUE는 NULL 중복 허용)
+-- NOTE: payload_sha256은 감사용 해시로만 유지 (uk_kyc_webhook_event 대체)
+
+ALTER TABLE kyc_webhook_events
+ADD COLUMN event_id VARCHAR(100) NULL AFTER provider;
+
+ALTER TABLE kyc_webhook_events DROP INDEX uk_kyc_webhook_event;
+
+ALTER TABLE kyc_webhook_events
+ADD UNIQUE KEY uk_kyc_webhook_event_id (provider, event_id);
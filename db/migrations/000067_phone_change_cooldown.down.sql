@@ -0,0 +1,3 @@
+-- Phone change cooldown 롤백
+
+ALTER TABLE users DROP COLUMN phone_changed_at;
@@ -0,0 +1,9 @@
+-- ============================================================================
+-- Phone change cooldown
+-- ============================================================================
+-- users.phone_changed_at: 인증된 전화번호가 다른 번호로 바뀐 시각 (NULL = 변경 이력 없음)
+--   미인증 번호의 변경/최초 등록은 기록하지 않음 (보호할 인증 번호가 없음)
+-- NOTE: 계정 탈취자가 번호를 자기 번호로 바꿔 재인증해도 쿨다운 동안 지급 주소 추가/확정 불가
+
+ALTER TABLE users
+    ADD COLUMN phone_changed_at TIMESTAMP NULL;
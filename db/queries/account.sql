@@ -74,6 +74,18 @@ UPDATE accounts
 SET status = 'CLOSED', updated_at = NOW()
 WHERE id = ? AND status != 'CLOSED';
 
+-- name: FreezeAccount :execresult
+-- 계정 거래 동결 (관리자, 이미 동결된 계정은 영향 없음 - status와 독립)
+UPDATE accounts
+SET frozen_at = NOW(), frozen_reason = ?, frozen_by = ?, updated_at = NOW()
+WHERE id = ? AND frozen_at IS NULL AND status != 'CLOSED';
+
+-- name: UnfreezeAccount :execresult
+-- 계정 거래 동결 해제 (동결되지 않은 계정은 영향 없음)
+UPDATE accounts
+SET frozen_at = NULL, frozen_reason = NULL, frozen_by = NULL, updated_at = NOW()
+WHERE id = ? AND frozen_at IS NOT NULL;
+
 -- ============================================================================
 -- 잔액 조회 (Phase 3+에서 사용, Phase 1에서는 미사용)
 -- ============================================================================
@@ -84,6 +96,24 @@ SELECT id, balance, hold_balance, version
 FROM accounts
 WHERE id = ? AND status != 'CLOSED';
 
+-- name: UpdateAccountBalance :exec
+-- 원장 기록 후 잔액 캐시 갱신 (GetAccountForUpdate row-lock 하에서만)
+UPDATE accounts
+SET balance = ?, version = version + 1, updated_at = NOW()
+WHERE id = ?;
+
+-- name: UpdateAccountHoldBalance :exec
+-- 동결 금액 갱신 (분쟁 동결/해제 - GetAccountForUpdate row-lock 하에서만)
+UPDATE accounts
+SET hold_balance = ?, version = version + 1, updated_at = NOW()
+WHERE id = ?;
+
+-- name: ResyncAccountBalance :execresult
+-- 원장 기준 잔액 재동기화 (운영 runbook 전용, 낙관적 락)
+UPDATE accounts
+SET balance = ?, version = version + 1, updated_at = NOW()
+WHERE id = ? AND version = ? AND status != 'CLOSED';
+
 -- ============================================================================
 -- 목록 조회
 -- ============================================================================
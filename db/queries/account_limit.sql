@@ -0,0 +1,45 @@
+-- ============================================================================
+-- Account Limit Queries
+-- ============================================================================
+-- NOTE: 사용액 반영 = 계정 row-lock → EnsureAccountLimitUsage → GetAccountLimitUsageForUpdate → AddAccountLimitUsage (한 트랜잭션)
+
+-- name: GetAccountLimit :one
+-- 계정별 한도 설정 (없으면 KYC 상태별 기본값)
+SELECT * FROM account_limits WHERE account_id = ?;
+
+-- name: CreateOrUpdateAccountLimit :exec
+-- 계정별 한도 upsert (uk_account_limit_account, NULL = 기본값)
+INSERT INTO account_limits (
+    account_id, daily_spend_limit, monthly_spend_limit, daily_withdrawal_limit, monthly_withdrawal_limit, updated_by
+) VALUES (?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    daily_spend_limit = VALUES(daily_spend_limit),
+    monthly_spend_limit = VALUES(monthly_spend_limit),
+    daily_withdrawal_limit = VALUES(daily_withdrawal_limit),
+    monthly_withdrawal_limit = VALUES(monthly_withdrawal_limit),
+    updated_by = VALUES(updated_by),
+    updated_at = NOW();
+
+-- name: EnsureAccountLimitUsage :exec
+-- 기간 사용액 카운터 생성 (이미 있으면 무시)
+INSERT IGNORE INTO account_limit_usage (account_id, kind, period, period_start)
+VALUES (?, ?, ?, ?);
+
+-- name: GetAccountLimitUsageForUpdate :one
+-- 기간 사용액 카운터 row-lock (같은 계정의 동시 결제/지급 직렬화)
+SELECT * FROM account_limit_usage
+WHERE account_id = ? AND kind = ? AND period = ? AND period_start = ?
+FOR UPDATE;
+
+-- name: AddAccountLimitUsage :exec
+-- 기간 사용액 증가 (GetAccountLimitUsageForUpdate row-lock 하에서만)
+UPDATE account_limit_usage
+SET amount = amount + ?, updated_at = NOW()
+WHERE id = ?;
+
+-- name: ListAccountLimitUsage :many
+-- 현재 일/월 기간의 사용액 (카운터가 없는 기간은 사용액 0)
+SELECT * FROM account_limit_usage
+WHERE account_id = sqlc.arg('account_id')
+  AND ((period = 'DAILY' AND period_start = sqlc.arg('day_start'))
+    OR (period = 'MONTHLY' AND period_start = sqlc.arg('month_start')));
@@ -0,0 +1,43 @@
+-- ============================================================================
+-- Account Statement Queries
+-- ============================================================================
+-- NOTE: 명세서는 불변 - 생성(INSERT)만 있고 수정 쿼리 없음 (uk_account_statement_period로 월당 1건)
+
+-- name: CreateAccountStatement :execresult
+-- 월간 명세서 생성 (uk_account_statement_period → 동시 생성 시 중복 키 오류)
+INSERT INTO account_statements (
+    external_id, account_id, period_start, period_end,
+    opening_balance, total_credits, total_debits, closing_balance, entry_count
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: CreateAccountStatementLine :exec
+-- 명세서 항목 (reference_type, entry_type별 합계)
+INSERT INTO account_statement_lines (statement_id, reference_type, entry_type, entry_count, total_amount)
+VALUES (?, ?, ?, ?, ?);
+
+-- name: GetAccountStatementByPeriod :one
+-- 계정의 월간 명세서 조회
+SELECT * FROM account_statements WHERE account_id = ? AND period_start = ?;
+
+-- name: ListAccountStatementLines :many
+-- 명세서 항목 목록
+SELECT * FROM account_statement_lines WHERE statement_id = ? ORDER BY reference_type, entry_type;
+
+-- name: ListAccountStatements :many
+-- 계정의 명세서 목록 (최신 월 먼저)
+SELECT * FROM account_statements
+WHERE account_id = ?
+ORDER BY period_start DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountAccountStatements :one
+-- 계정의 명세서 수
+SELECT COUNT(*) FROM account_statements WHERE account_id = ?;
+
+-- name: ListAccountsWithoutStatement :many
+-- 해당 월 명세서가 없는 계정 (월 마감 전 생성된 계정만, id 순 페이지)
+SELECT a.id, a.external_id FROM accounts a
+LEFT JOIN account_statements s ON s.account_id = a.id AND s.period_start = sqlc.arg('period_start')
+WHERE s.id IS NULL AND a.created_at < sqlc.arg('period_end') AND a.id > sqlc.arg('after_id')
+ORDER BY a.id
+LIMIT ?;
@@ -0,0 +1,37 @@
+-- ============================================================================
+-- Account Transfer Queries
+-- ============================================================================
+-- NOTE: 이체 = CreateAccountTransfer → ledger.Post(ACCOUNT_TRANSFER) → SetAccountTransferLedgerTx (한 트랜잭션)
+
+-- name: CreateAccountTransfer :execresult
+-- 계정 간 이체 기록 (uk_account_transfer_idempotency → 같은 Idempotency-Key 재시도는 중복 키 오류)
+INSERT INTO account_transfers (
+    external_id, from_account_id, to_account_id, amount, memo, idempotency_key, created_by
+) VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: SetAccountTransferLedgerTx :exec
+-- 이체 분개의 원장 tx_id 연결
+UPDATE account_transfers SET ledger_tx_id = ? WHERE id = ?;
+
+-- name: GetAccountTransferByID :one
+-- ID로 이체 조회
+SELECT * FROM account_transfers WHERE id = ?;
+
+-- name: GetAccountTransferByIdempotencyKey :one
+-- 출금 계정의 Idempotency-Key로 이체 조회 (재시도 시 기존 이체 반환)
+SELECT * FROM account_transfers WHERE from_account_id = ? AND idempotency_key = ?;
+
+-- name: ListAccountTransfersByAccount :many
+-- 계정의 입출금 이체 목록 (최신순) + 양쪽 계정 외부 ID
+SELECT t.*, fa.external_id AS from_account_external_id, ta.external_id AS to_account_external_id
+FROM account_transfers t
+JOIN accounts fa ON fa.id = t.from_account_id
+JOIN accounts ta ON ta.id = t.to_account_id
+WHERE t.from_account_id = sqlc.arg('account_id') OR t.to_account_id = sqlc.arg('account_id')
+ORDER BY t.id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountAccountTransfersByAccount :one
+-- 계정의 입출금 이체 수
+SELECT COUNT(*) FROM account_transfers
+WHERE from_account_id = sqlc.arg('account_id') OR to_account_id = sqlc.arg('account_id');
@@ -0,0 +1,61 @@
+-- ============================================================================
+-- API Key Queries
+-- ============================================================================
+-- NOTE: key_hash는 서비스 레이어에서 SHA-256(hex) 계산 후 전달
+-- NOTE: revoked_at IS NULL = 활성 키
+
+-- name: CreateAPIKey :execresult
+-- API 키 발급 (external_id는 서비스 레이어에서 UUID 생성 후 전달)
+INSERT INTO api_keys (external_id, user_id, name, key_prefix, key_hash)
+VALUES (?, ?, ?, ?, ?);
+
+-- name: GetAPIKeyByID :one
+-- ID로 API 키 조회 (내부 전용, 폐기 포함)
+SELECT * FROM api_keys WHERE id = ?;
+
+-- name: GetAPIKeyByExternalIDAndUser :one
+-- 외부 식별자 + 사용자 소유권 검증 조회 (폐기 포함 - 멱등성 체크용)
+SELECT k.* FROM api_keys k
+JOIN users u ON k.user_id = u.id
+WHERE k.external_id = ? AND u.external_id = ?;
+
+-- name: GetAPIKeyForUpdate :one
+-- 트랜잭션 내 row-lock (키 교체 시 동시 교체 방지)
+SELECT * FROM api_keys
+WHERE id = ? AND user_id = ?
+FOR UPDATE;
+
+-- name: GetActiveAPIKeyPrincipal :one
+-- 인증용 조회: 활성 키 + ACTIVE 사용자만 (해시로 조회)
+SELECT k.id, k.external_id, k.user_id, u.external_id AS user_external_id, u.role, u.is_compliance_officer
+FROM api_keys k
+JOIN users u ON k.user_id = u.id
+WHERE k.key_hash = ? AND k.revoked_at IS NULL AND u.status = 'ACTIVE';
+
+-- name: ListAPIKeysByUserExternalID :many
+-- 사용자의 API 키 목록 (폐기 포함, 최신순)
+SELECT k.* FROM api_keys k
+JOIN users u ON k.user_id = u.id
+WHERE u.external_id = ?
+ORDER BY k.created_at DESC, k.id DESC;
+
+-- name: CountActiveAPIKeysByUser :one
+-- 사용자의 활성 API 키 수
+SELECT COUNT(*) as total FROM api_keys
+WHERE user_id = ? AND revoked_at IS NULL;
+
+-- ============================================================================
+-- API 키 상태 업데이트
+-- ============================================================================
+
+-- name: RevokeAPIKey :execresult
+-- API 키 폐기 (활성 키만, RowsAffected로 멱등성 판단)
+UPDATE api_keys
+SET revoked_at = NOW(), updated_at = NOW()
+WHERE id = ? AND user_id = ? AND revoked_at IS NULL;
+
+-- name: TouchAPIKeyLastUsed :exec
+-- 마지막 사용 시각 갱신 (인증 성공 시)
+UPDATE api_keys
+SET last_used_at = NOW()
+WHERE id = ?;
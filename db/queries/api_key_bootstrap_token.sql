@@ -0,0 +1,21 @@
+-- ============================================================================
+-- API Key Bootstrap Token Queries
+-- ============================================================================
+-- NOTE: token_hash는 서비스 레이어에서 SHA-256(hex) 계산 후 전달
+
+-- name: CreateAPIKeyBootstrapToken :exec
+-- 사용자 생성 시 발급 (재발급 시 이전 토큰 대체)
+INSERT INTO api_key_bootstrap_tokens (user_id, token_hash, expires_at)
+VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    token_hash = VALUES(token_hash),
+    expires_at = VALUES(expires_at),
+    created_at = NOW();
+
+-- name: GetAPIKeyBootstrapTokenForUpdate :one
+SELECT * FROM api_key_bootstrap_tokens
+WHERE user_id = ?
+FOR UPDATE;
+
+-- name: DeleteAPIKeyBootstrapToken :exec
+DELETE FROM api_key_bootstrap_tokens WHERE user_id = ?;
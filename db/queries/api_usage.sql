@@ -0,0 +1,43 @@
+-- ============================================================================
+-- API Usage Queries
+-- ============================================================================
+-- NOTE: 요청 카운터는 Redis에 누적 → usage flush job이 AddAPIUsage로 MySQL에 합산
+-- NOTE: bucket_start는 UTC 정시 (서비스 레이어에서 truncate 후 전달)
+
+-- name: AddAPIUsage :exec
+-- 시간 단위 사용량 합산 (같은 키/시간/라우트 행이 있으면 증가분 누적)
+INSERT INTO api_usage_hourly (api_key_id, bucket_start, method, route, requests, client_errors, server_errors, rate_limited)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    requests = requests + VALUES(requests),
+    client_errors = client_errors + VALUES(client_errors),
+    server_errors = server_errors + VALUES(server_errors),
+    rate_limited = rate_limited + VALUES(rate_limited),
+    updated_at = NOW();
+
+-- name: GetAPIKeyIDByExternalID :one
+-- flush 대상 키의 내부 ID (폐기 포함 - 폐기 직전 요청도 집계)
+SELECT id FROM api_keys WHERE external_id = ?;
+
+-- name: ListAPIUsageByUser :many
+-- 사용자 API 키들의 기간 내 사용량 (키 → 시간 → 라우트 순)
+SELECT k.external_id AS api_key_external_id, k.name AS api_key_name,
+       u.bucket_start, u.method, u.route, u.requests, u.client_errors, u.server_errors, u.rate_limited
+FROM api_usage_hourly u
+JOIN api_keys k ON k.id = u.api_key_id
+WHERE k.user_id = sqlc.arg('user_id')
+  AND u.bucket_start >= sqlc.arg('bucket_from') AND u.bucket_start < sqlc.arg('bucket_to')
+ORDER BY k.id ASC, u.bucket_start ASC, u.method ASC, u.route ASC;
+
+-- name: ListWebhookDeliveryStatsByUser :many
+-- 사용자 엔드포인트(삭제 포함)의 시간별 전송 결과 (생성 시각 기준, hour_bucket = UNIX 시각 / 3600)
+SELECT CAST(FLOOR(UNIX_TIMESTAMP(d.created_at) / 3600) AS SIGNED) AS hour_bucket,
+       CAST(SUM(d.status = 'SUCCEEDED') AS SIGNED) AS succeeded,
+       CAST(SUM(d.status = 'DEAD') AS SIGNED) AS dead,
+       CAST(SUM(d.status IN ('PENDING', 'DELIVERING')) AS SIGNED) AS pending
+FROM webhook_deliveries d
+JOIN webhook_endpoints e ON e.id = d.endpoint_id
+WHERE e.user_id = sqlc.arg('user_id')
+  AND d.created_at >= sqlc.arg('created_from') AND d.created_at < sqlc.arg('created_to')
+GROUP BY hour_bucket
+ORDER BY hour_bucket ASC;
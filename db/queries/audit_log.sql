@@ -0,0 +1,20 @@
+-- ============================================================================
+-- Audit Log Queries
+-- ============================================================================
+-- NOTE: audit_logs는 불변 (INSERT/SELECT만 허용, UPDATE/DELETE 쿼리 정의 금지)
+-- NOTE: 보존 기한 경과분 삭제는 retention.sql의 PurgeAuditLogsBefore로만 허용
+
+-- name: CreateAuditLog :exec
+-- 감사 로그 기록 (상태 변경과 같은 트랜잭션에서 호출)
+INSERT INTO audit_logs (
+    actor_type, actor_id, action, resource_type, resource_id,
+    old_value, new_value, ip_address, user_agent, request_id
+)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListAuditLogsByResource :many
+-- 리소스별 감사 로그 조회 (최신순)
+SELECT * FROM audit_logs
+WHERE resource_type = ? AND resource_id = ?
+ORDER BY created_at DESC, id DESC
+LIMIT ? OFFSET ?;
@@ -0,0 +1,118 @@
+-- ============================================================================
+-- Budget Queries
+-- ============================================================================
+-- NOTE: 지출 집계 대상 = 취소/환불되지 않은 주문 (PENDING 포함 - 승인 대기 주문도 예산 점유)
+
+-- name: CreateOrUpdateBudget :exec
+-- 카테고리·기간별 예산 upsert (uk_budget_scope)
+INSERT INTO budgets (external_id, user_id, category, period, amount, alert_threshold_pct, hard_limit)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    amount = VALUES(amount),
+    alert_threshold_pct = VALUES(alert_threshold_pct),
+    hard_limit = VALUES(hard_limit),
+    updated_at = NOW();
+
+-- name: GetBudgetByScope :one
+SELECT * FROM budgets
+WHERE user_id = ? AND category_key = ? AND period = ?;
+
+-- name: GetBudgetByExternalIDAndUser :one
+-- 소유권 확인 포함 조회
+SELECT b.* FROM budgets b
+JOIN users u ON u.id = b.user_id
+WHERE b.external_id = ? AND u.external_id = ?;
+
+-- name: ListBudgetsByUser :many
+-- 구매자 예산 목록 (전체 예산 먼저)
+SELECT * FROM budgets
+WHERE user_id = ?
+ORDER BY category_key ASC, period ASC;
+
+-- name: ListBudgetsAfterID :many
+-- 알림 작업용 전체 예산 순회 (id 커서)
+SELECT * FROM budgets
+WHERE id > ?
+ORDER BY id ASC
+LIMIT ?;
+
+-- name: DeleteBudget :exec
+DELETE FROM budgets WHERE id = ?;
+
+-- name: GetBuyerSpend :one
+-- 기간 내 구매자 전체 지출
+SELECT CAST(COALESCE(SUM(o.total_amount), 0) AS DECIMAL(18,8)) AS spent
+FROM orders o
+WHERE o.buyer_id = sqlc.arg('buyer_id')
+  AND o.status NOT IN ('CANCELLED', 'REFUNDED')
+  AND o.created_at >= sqlc.arg('from')
+  AND o.created_at < sqlc.arg('to');
+
+-- name: GetBuyerCategorySpend :one
+-- 기간 내 구매자 카테고리 지출 (주문 상품 금액 합계)
+SELECT CAST(COALESCE(SUM(oi.quantity * oi.unit_price), 0) AS DECIMAL(18,8)) AS spent
+FROM order_items oi
+JOIN orders o ON o.id = oi.order_id
+JOIN products p ON p.id = oi.product_id
+WHERE o.buyer_id = sqlc.arg('buyer_id')
+  AND p.category = sqlc.arg('category')
+  AND o.status NOT IN ('CANCELLED', 'REFUNDED')
+  AND o.created_at >= sqlc.arg('from')
+  AND o.created_at < sqlc.arg('to');
+
+-- name: CreateBudgetAlert :exec
+-- 알림 발송 기록 (uk_budget_alert → 동시 실행 시 한쪽만 성공)
+INSERT INTO budget_alerts (budget_id, period_start, level, spent, event_id)
+VALUES (?, ?, ?, ?, ?);
+
+-- name: ExistsBudgetAlert :one
+SELECT EXISTS (
+    SELECT 1 FROM budget_alerts
+    WHERE budget_id = ? AND period_start = ? AND level = ?
+) AS sent;
+
+-- ============================================================================
+-- Spend analytics
+-- ============================================================================
+
+-- name: ListSpendBySeller :many
+-- 판매자별 지출
+SELECT u.external_id AS seller_external_id, u.name AS seller_name,
+       CAST(COUNT(*) AS SIGNED) AS order_count,
+       CAST(COALESCE(SUM(o.total_amount), 0) AS DECIMAL(18,8)) AS amount
+FROM orders o
+JOIN users u ON u.id = o.seller_id
+WHERE o.buyer_id = sqlc.arg('buyer_id')
+  AND o.status NOT IN ('CANCELLED', 'REFUNDED')
+  AND o.created_at >= sqlc.arg('from')
+  AND o.created_at < sqlc.arg('to')
+GROUP BY u.id, u.external_id, u.name
+ORDER BY SUM(o.total_amount) DESC;
+
+-- name: ListSpendByCategory :many
+-- 카테고리별 지출 (미분류 = 빈 문자열)
+SELECT COALESCE(p.category, '') AS category,
+       CAST(COUNT(DISTINCT o.id) AS SIGNED) AS order_count,
+       CAST(COALESCE(SUM(oi.quantity * oi.unit_price), 0) AS DECIMAL(18,8)) AS amount
+FROM order_items oi
+JOIN orders o ON o.id = oi.order_id
+JOIN products p ON p.id = oi.product_id
+WHERE o.buyer_id = sqlc.arg('buyer_id')
+  AND o.status NOT IN ('CANCELLED', 'REFUNDED')
+  AND o.created_at >= sqlc.arg('from')
+  AND o.created_at < sqlc.arg('to')
+GROUP BY COALESCE(p.category, '')
+ORDER BY SUM(oi.quantity * oi.unit_price) DESC;
+
+-- name: ListSpendByMonth :many
+-- 월별 지출 (UTC, YYYY-MM)
+SELECT DATE_FORMAT(o.created_at, '%Y-%m') AS month,
+       CAST(COUNT(*) AS SIGNED) AS order_count,
+       CAST(COALESCE(SUM(o.total_amount), 0) AS DECIMAL(18,8)) AS amount
+FROM orders o
+WHERE o.buyer_id = sqlc.arg('buyer_id')
+  AND o.status NOT IN ('CANCELLED', 'REFUNDED')
+  AND o.created_at >= sqlc.arg('from')
+  AND o.created_at < sqlc.arg('to')
+GROUP BY DATE_FORMAT(o.created_at, '%Y-%m')
+ORDER BY month ASC;
@@ -0,0 +1,82 @@
+-- ============================================================================
+-- Cart Queries
+-- ============================================================================
+
+-- name: CreateCart :execresult
+-- 장바구니 생성 (expires_at = 생성 시각 + TTL)
+INSERT INTO carts (external_id, buyer_id, seller_id, token_symbol, expires_at)
+VALUES (?, ?, ?, ?, ?);
+
+-- name: GetCartDetail :one
+-- 장바구니 상세 (구매자/판매자 외부 식별자 + 전환된 주문 번호)
+SELECT c.*, b.external_id AS buyer_external_id, s.external_id AS seller_external_id,
+       o.order_number
+FROM carts c
+JOIN users b ON b.id = c.buyer_id
+JOIN users s ON s.id = c.seller_id
+LEFT JOIN orders o ON o.id = c.order_id
+WHERE c.external_id = ?;
+
+-- name: ListCartsByBuyer :many
+-- 구매자의 장바구니 목록 (상태 필터 선택, 최신순)
+SELECT c.*, b.external_id AS buyer_external_id, s.external_id AS seller_external_id,
+       o.order_number
+FROM carts c
+JOIN users b ON b.id = c.buyer_id
+JOIN users s ON s.id = c.seller_id
+LEFT JOIN orders o ON o.id = c.order_id
+WHERE c.buyer_id = sqlc.arg('buyer_id')
+  AND (sqlc.narg('status') IS NULL OR c.status = sqlc.narg('status'))
+ORDER BY c.id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountCartsByBuyer :one
+SELECT COUNT(*) FROM carts
+WHERE buyer_id = sqlc.arg('buyer_id')
+  AND (sqlc.narg('status') IS NULL OR status = sqlc.narg('status'));
+
+-- name: GetCartForUpdate :one
+-- 트랜잭션 내 row-lock (항목 변경/주문 전환 직렬화)
+SELECT * FROM carts WHERE id = ? FOR UPDATE;
+
+-- name: ListCartItems :many
+-- 장바구니 항목 + 현재 카탈로그 단가/상태 (담은 순서)
+SELECT ci.*, p.sku, p.name AS product_name, p.price AS catalog_price, p.status AS product_status
+FROM cart_items ci
+JOIN products p ON p.id = ci.product_id
+WHERE ci.cart_id = ?
+ORDER BY ci.id ASC;
+
+-- name: UpsertCartItem :exec
+-- 항목 추가 또는 수량 변경 (상품당 1행)
+INSERT INTO cart_items (cart_id, product_id, quantity, unit_price)
+VALUES (?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE quantity = VALUES(quantity), unit_price = VALUES(unit_price), updated_at = NOW();
+
+-- name: DeleteCartItem :execrows
+DELETE FROM cart_items WHERE cart_id = ? AND product_id = ?;
+
+-- name: UpdateCartItemPrice :exec
+-- 검증 시점의 카탈로그 단가로 갱신
+UPDATE cart_items SET unit_price = ?, updated_at = NOW() WHERE id = ?;
+
+-- name: TouchCart :exec
+-- 변경 시 만료 연장
+UPDATE carts SET expires_at = ?, updated_at = NOW() WHERE id = ?;
+
+-- name: CheckoutCart :execrows
+-- 주문 전환 (ACTIVE → CHECKED_OUT)
+UPDATE carts
+SET status = 'CHECKED_OUT', order_id = ?, checked_out_at = NOW(), updated_at = NOW()
+WHERE id = ? AND status = 'ACTIVE';
+
+-- name: ExpireCarts :execrows
+-- 만료 경과 장바구니 일괄 만료 (ACTIVE → EXPIRED, 배치 크기 제한)
+UPDATE carts
+SET status = 'EXPIRED', updated_at = NOW()
+WHERE status = 'ACTIVE' AND expires_at <= sqlc.arg('now')
+LIMIT ?;
+
+-- name: ListInventoriesByProduct :many
+-- 상품의 위치별 재고 (가용 수량 = quantity - reserved_quantity)
+SELECT * FROM inventories WHERE product_id = ? ORDER BY id ASC;
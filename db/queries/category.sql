@@ -0,0 +1,49 @@
+-- ============================================================================
+-- Category Queries
+-- ============================================================================
+
+-- name: CreateCategory :execresult
+INSERT INTO categories (code, name, parent_id)
+VALUES (?, ?, ?);
+
+-- name: CreateCategoryPaths :exec
+-- 새 카테고리의 경로 = 상위 카테고리의 조상 경로 (depth + 1) + 자기 자신 (depth 0)
+INSERT INTO category_paths (ancestor_id, descendant_id, depth)
+SELECT ancestor_id, sqlc.arg('id'), depth + 1 FROM category_paths WHERE descendant_id = sqlc.narg('parent_id')
+UNION ALL
+SELECT sqlc.arg('id'), sqlc.arg('id'), 0;
+
+-- name: GetCategoryByCode :one
+SELECT c.*, pc.code AS parent_code
+FROM categories c
+LEFT JOIN categories pc ON pc.id = c.parent_id
+WHERE c.code = ? LIMIT 1;
+
+-- name: ListCategories :many
+-- 전체 카테고리 + 직접 연결된 상품 수 (변형 제외, 트리는 애플리케이션에서 구성)
+SELECT c.*, pc.code AS parent_code,
+       (SELECT COUNT(*) FROM products p WHERE p.category = c.code AND p.parent_id IS NULL) AS product_count
+FROM categories c
+LEFT JOIN categories pc ON pc.id = c.parent_id
+ORDER BY c.name ASC, c.id ASC;
+
+-- name: ListCategoryAncestors :many
+-- 최상위부터 자기 자신까지의 경로 (breadcrumb)
+SELECT c.code, c.name, cp.depth
+FROM category_paths cp
+JOIN categories c ON c.id = cp.ancestor_id
+WHERE cp.descendant_id = ?
+ORDER BY cp.depth DESC;
+
+-- name: GetCategoryDepth :one
+-- 카테고리 깊이 (최상위 = 0)
+SELECT CAST(COALESCE(MAX(depth), 0) AS SIGNED) FROM category_paths WHERE descendant_id = ?;
+
+-- name: UpdateCategoryName :exec
+UPDATE categories SET name = ?, updated_at = NOW() WHERE id = ?;
+
+-- name: AssignProductCategory :execrows
+-- 상품 카테고리 지정 (변형 상품은 기준 상품의 카테고리 상속 → 함께 변경)
+UPDATE products
+SET category = sqlc.narg('category'), updated_at = NOW()
+WHERE id = sqlc.arg('id') OR parent_id = sqlc.arg('id');
@@ -0,0 +1,47 @@
+-- ============================================================================
+-- Platform Chain Transaction Queries
+-- ============================================================================
+-- NOTE: 송금 1건(nonce) = chain_transactions 1행, 브로드캐스트(원본 + 교체)마다 chain_transaction_broadcasts 1행
+
+-- name: CreateChainTransaction :execresult
+-- 브로드캐스트된 송금 등록 (PENDING)
+INSERT INTO chain_transactions (
+    chain_id, signer, nonce, to_address, amount, gas_limit,
+    reference_type, reference_id, last_broadcast_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: CreateChainTransactionBroadcast :exec
+-- 브로드캐스트 이력 기록
+INSERT INTO chain_transaction_broadcasts (chain_transaction_id, tx_hash, tip_cap, fee_cap)
+VALUES (?, ?, ?, ?);
+
+-- name: ListStuckChainTransactions :many
+-- 마지막 브로드캐스트 후 timeout이 지난 미확정 송금 (오래된 순)
+SELECT * FROM chain_transactions
+WHERE status = 'PENDING' AND last_broadcast_at < sqlc.arg('broadcast_before')
+ORDER BY last_broadcast_at, id
+LIMIT ?;
+
+-- name: ListChainTransactionBroadcasts :many
+-- 송금의 브로드캐스트 이력 (최신순)
+SELECT * FROM chain_transaction_broadcasts
+WHERE chain_transaction_id = ?
+ORDER BY id DESC;
+
+-- name: RecordChainTransactionReplacement :execrows
+-- 교체 브로드캐스트 반영 (미확정 건만)
+UPDATE chain_transactions
+SET replacements = replacements + 1, last_broadcast_at = ?, updated_at = NOW()
+WHERE id = ? AND status = 'PENDING';
+
+-- name: TouchChainTransaction :exec
+-- 교체하지 않은 채 다음 점검까지 대기 (교체 한도 도달/수수료 상한 초과)
+UPDATE chain_transactions
+SET last_broadcast_at = ?, updated_at = NOW()
+WHERE id = ? AND status = 'PENDING';
+
+-- name: ResolveChainTransaction :execrows
+-- 최종 결과 반영 (PENDING → CONFIRMED/FAILED/DROPPED)
+UPDATE chain_transactions
+SET status = ?, landed_tx_hash = ?, block_number = ?, resolved_at = ?, updated_at = NOW()
+WHERE id = ? AND status = 'PENDING';
@@ -0,0 +1,55 @@
+-- ============================================================================
+-- Credit Terms Queries
+-- ============================================================================
+-- NOTE: outstanding_amount 증감은 GetCreditTermsForUpdate row-lock 하에서만 (잠금 순서 orders → credit_terms → invoices)
+-- NOTE: 외상 청구서는 invoice.sql (credit_terms_id가 있는 청구서)
+
+-- name: CreateCreditTerms :execresult
+-- 구매자-판매자 외상 조건 생성 (uk_credit_terms_parties → 관계당 1건)
+INSERT INTO credit_terms (external_id, buyer_id, seller_id, token_symbol, terms, credit_limit)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: GetCreditTermsDetail :one
+-- 외상 조건 + 구매자/판매자 (조회 권한 확인용)
+SELECT ct.*, b.external_id AS buyer_external_id, b.name AS buyer_name,
+       s.external_id AS seller_external_id, s.name AS seller_name
+FROM credit_terms ct
+JOIN users b ON b.id = ct.buyer_id
+JOIN users s ON s.id = ct.seller_id
+WHERE ct.external_id = ?;
+
+-- name: GetCreditTermsByParties :one
+-- 주문 당사자의 외상 조건 (없으면 외상 불가)
+SELECT * FROM credit_terms WHERE buyer_id = ? AND seller_id = ?;
+
+-- name: GetCreditTermsForUpdate :one
+-- 외상 조건 row-lock (같은 관계의 동시 청구/결제/한도 변경 직렬화)
+SELECT * FROM credit_terms WHERE id = ? FOR UPDATE;
+
+-- name: ListCreditTermsByUser :many
+-- 사용자가 구매자 또는 판매자인 외상 조건 (최신순)
+SELECT ct.*, b.external_id AS buyer_external_id, b.name AS buyer_name,
+       s.external_id AS seller_external_id, s.name AS seller_name
+FROM credit_terms ct
+JOIN users b ON b.id = ct.buyer_id
+JOIN users s ON s.id = ct.seller_id
+WHERE ct.buyer_id = sqlc.arg('user_id') OR ct.seller_id = sqlc.arg('user_id')
+ORDER BY ct.id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountCreditTermsByUser :one
+-- 사용자가 구매자 또는 판매자인 외상 조건 수
+SELECT COUNT(*) FROM credit_terms
+WHERE buyer_id = sqlc.arg('user_id') OR seller_id = sqlc.arg('user_id');
+
+-- name: UpdateCreditTerms :exec
+-- 조건/한도/상태 변경 (GetCreditTermsForUpdate row-lock 하에서만)
+UPDATE credit_terms
+SET terms = ?, credit_limit = ?, status = ?, updated_at = NOW()
+WHERE id = ?;
+
+-- name: AddCreditTermsOutstanding :exec
+-- 미결제 잔액 증감 (청구 = 양수, 결제/취소 = 음수, GetCreditTermsForUpdate row-lock 하에서만)
+UPDATE credit_terms
+SET outstanding_amount = outstanding_amount + ?, updated_at = NOW()
+WHERE id = ?;
@@ -0,0 +1,37 @@
+-- ============================================================================
+-- Credit Note Queries
+-- ============================================================================
+-- NOTE: 청구서 금액/항목은 불변 → 대변 메모 누적액(credited/refunded_amount)만 갱신
+-- NOTE: 생성/누적 갱신은 GetInvoiceForUpdate row-lock 하에서만 (잠금 순서 orders → credit_terms → invoices)
+
+-- name: CreateCreditNote :execresult
+-- 대변 메모 생성 (applied = 잔액 차감분, refunded = 결제액 반환분)
+INSERT INTO credit_notes (external_id, credit_note_number, invoice_id, reason, amount, applied_amount, refunded_amount, memo, created_by)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: CreateCreditNoteLine :exec
+-- 대변 메모 항목 (청구 항목 번호별 수량, amount = 단가 × 수량 + 세금)
+INSERT INTO credit_note_lines (credit_note_id, invoice_line_no, quantity, unit_price, amount, tax_amount)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: SetCreditNoteLedgerTx :exec
+-- 반환분 원장 분개 tx 기록
+UPDATE credit_notes SET ledger_tx_id = ? WHERE id = ?;
+
+-- name: ListCreditNotesByInvoice :many
+-- 청구서의 대변 메모 (생성순)
+SELECT * FROM credit_notes WHERE invoice_id = ? ORDER BY id;
+
+-- name: ListCreditNoteLinesByInvoice :many
+-- 청구서의 대변 메모 항목 (항목별 누적 대변 수량 계산용)
+SELECT cnl.*
+FROM credit_note_lines cnl
+JOIN credit_notes cn ON cn.id = cnl.credit_note_id
+WHERE cn.invoice_id = ?
+ORDER BY cnl.credit_note_id, cnl.invoice_line_no;
+
+-- name: AddInvoiceCredit :exec
+-- 청구서 대변/반환 누적 (GetInvoiceForUpdate row-lock 하에서만)
+UPDATE invoices
+SET credited_amount = credited_amount + ?, refunded_amount = refunded_amount + ?, updated_at = NOW()
+WHERE id = ?;
@@ -0,0 +1,59 @@
+-- ============================================================================
+-- Delegated Signer Queries
+-- ============================================================================
+-- NOTE: address는 lower-case로 저장/조회
+-- NOTE: 유효한 위임 = 해제되지 않음 + owner 서명됨 + 만료 전
+
+-- name: CreateDelegatedSigner :execresult
+-- 위임 서명자 등록 (owner 서명 대기, 사용자별 활성 주소 1건 - uk_delegated_signer_user_active)
+INSERT INTO delegated_signers (external_id, user_id, address, label, scopes, expires_at)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: GetDelegatedSignerByID :one
+-- ID로 조회 (내부 전용)
+SELECT * FROM delegated_signers WHERE id = ?;
+
+-- name: GetDelegatedSignerByExternalIDAndUser :one
+-- 외부 식별자 + 사용자 소유권 검증 조회
+SELECT d.* FROM delegated_signers d
+JOIN users u ON d.user_id = u.id
+WHERE d.external_id = ? AND u.external_id = ?;
+
+-- name: ListDelegatedSignersByUser :many
+-- 사용자의 위임 서명자 (해제 제외, 최신순)
+SELECT * FROM delegated_signers
+WHERE user_id = ? AND revoked_at IS NULL
+ORDER BY created_at DESC, id DESC;
+
+-- name: ConfirmDelegatedSigner :execrows
+-- owner 위임 서명 반영 (서명 대기 + 미해제 건만)
+UPDATE delegated_signers
+SET approver_wallet_id = ?, confirmed_at = ?, updated_at = NOW()
+WHERE id = ? AND confirmed_at IS NULL AND revoked_at IS NULL;
+
+-- name: RevokeDelegatedSigner :execrows
+-- 위임 해제 (미해제 건만)
+UPDATE delegated_signers
+SET revoked_at = NOW(), updated_at = NOW()
+WHERE id = ? AND revoked_at IS NULL;
+
+-- name: ExistsActiveDelegation :one
+-- 주소가 사용자를 대신해 scope 권한으로 서명 가능한지 (결제 승인/로그인 전 검사)
+SELECT EXISTS(
+    SELECT 1 FROM delegated_signers
+    WHERE user_id = ? AND address_active = ?
+      AND confirmed_at IS NOT NULL
+      AND (expires_at IS NULL OR expires_at > sqlc.arg('now'))
+      AND FIND_IN_SET(sqlc.arg('scope'), scopes) > 0
+) AS active;
+
+-- name: ListActiveDelegationsByAddress :many
+-- 주소에 scope 권한을 위임한 사용자 목록 (위임 지갑 로그인 시 대상 계정 조회)
+SELECT d.*, u.external_id AS user_external_id FROM delegated_signers d
+JOIN users u ON d.user_id = u.id
+WHERE d.address_active = sqlc.arg('address')
+  AND d.confirmed_at IS NOT NULL
+  AND (d.expires_at IS NULL OR d.expires_at > sqlc.arg('now'))
+  AND FIND_IN_SET(sqlc.arg('scope'), d.scopes) > 0
+  AND u.status = 'ACTIVE'
+ORDER BY d.id;
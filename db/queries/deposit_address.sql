@@ -0,0 +1,30 @@
+-- ============================================================================
+-- Deposit Address Queries
+-- ============================================================================
+-- NOTE: address는 lower-case로 저장/조회
+-- NOTE: 인덱스 할당 = EnsureHDDerivationCursor → GetHDDerivationCursorForUpdate → AdvanceHDDerivationCursor (한 트랜잭션)
+
+-- name: EnsureHDDerivationCursor :exec
+-- 키의 커서 생성 (이미 있으면 무시)
+INSERT IGNORE INTO hd_derivation_cursors (key_id) VALUES (?);
+
+-- name: GetHDDerivationCursorForUpdate :one
+-- 다음 파생 인덱스 row-lock 조회 (동시 할당 직렬화)
+SELECT * FROM hd_derivation_cursors WHERE key_id = ? FOR UPDATE;
+
+-- name: AdvanceHDDerivationCursor :exec
+-- 다음 파생 인덱스로 이동 (사용 여부와 무관하게 인덱스는 재사용하지 않음)
+UPDATE hd_derivation_cursors SET next_index = next_index + 1, updated_at = NOW() WHERE key_id = ?;
+
+-- name: CreateDepositAddress :execresult
+-- 주문 입금 주소 기록 (uk_deposit_address_* 위반 시 중복)
+INSERT INTO deposit_addresses (order_id, address, key_id, derivation_index)
+VALUES (?, ?, ?, ?);
+
+-- name: GetDepositAddressByOrder :one
+-- 주문의 입금 주소 (주문당 1개)
+SELECT * FROM deposit_addresses WHERE order_id = ?;
+
+-- name: GetDepositAddressByAddress :one
+-- 입금 주소로 주문 귀속 (입금 감지 시)
+SELECT * FROM deposit_addresses WHERE address = ?;
@@ -0,0 +1,86 @@
+-- ============================================================================
+-- Volume Tier Queries
+-- ============================================================================
+
+-- name: ListVolumeTiersByProduct :many
+-- 상품 수량 구간 할인 (최소 수량순)
+SELECT * FROM volume_tiers WHERE product_id = ? ORDER BY min_quantity ASC;
+
+-- name: GetApplicableVolumeTier :one
+-- 주문 수량에 해당하는 가장 높은 구간
+SELECT * FROM volume_tiers
+WHERE product_id = ? AND min_quantity <= ?
+ORDER BY min_quantity DESC
+LIMIT 1;
+
+-- name: DeleteVolumeTiers :exec
+-- 구간 교체 시 기존 구간 삭제
+DELETE FROM volume_tiers WHERE product_id = ?;
+
+-- name: CreateVolumeTier :exec
+INSERT INTO volume_tiers (product_id, min_quantity, discount_percent)
+VALUES (?, ?, ?);
+
+-- ============================================================================
+-- Coupon Queries
+-- ============================================================================
+
+-- name: CreateCoupon :execresult
+INSERT INTO coupons (
+    external_id, seller_id, code, discount_type, discount_value, min_order_amount, max_redemptions, max_per_buyer, expires_at
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetCouponByCode :one
+-- 판매자 쿠폰 코드 조회 (코드는 대문자로 저장)
+SELECT * FROM coupons WHERE seller_id = ? AND code = ?;
+
+-- name: GetCouponForUpdate :one
+-- 쿠폰 row-lock (사용 한도 확인 → 사용 기록 직렬화)
+SELECT * FROM coupons WHERE id = ? FOR UPDATE;
+
+-- name: GetCouponDetail :one
+-- 쿠폰 + 판매자 external ID (권한 확인용)
+SELECT c.*, s.external_id AS seller_external_id
+FROM coupons c
+JOIN users s ON s.id = c.seller_id
+WHERE c.external_id = ?;
+
+-- name: ListCouponsBySeller :many
+-- 판매자 쿠폰 (최신순)
+SELECT * FROM coupons
+WHERE seller_id = ?
+ORDER BY id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountCouponsBySeller :one
+SELECT COUNT(*) FROM coupons WHERE seller_id = ?;
+
+-- name: DisableCoupon :execrows
+-- 쿠폰 비활성화 (이미 제출된 발주도 수락 시 적용 불가)
+UPDATE coupons SET status = 'DISABLED', updated_at = NOW()
+WHERE id = ? AND status = 'ACTIVE';
+
+-- name: CountCouponRedemptionsByBuyer :one
+-- 구매자별 쿠폰 사용 횟수
+SELECT COUNT(*) FROM coupon_redemptions WHERE coupon_id = ? AND buyer_id = ?;
+
+-- name: CreateCouponRedemption :exec
+INSERT INTO coupon_redemptions (coupon_id, buyer_id, order_id, amount)
+VALUES (?, ?, ?, ?);
+
+-- name: IncrementCouponRedemptions :exec
+-- 사용 횟수 증가 (쿠폰 row-lock 상태에서만)
+UPDATE coupons SET redemption_count = redemption_count + 1, updated_at = NOW() WHERE id = ?;
+
+-- ============================================================================
+-- Order Discount Queries
+-- ============================================================================
+
+-- name: CreateOrderDiscount :exec
+-- 주문 할인 내역 (주문 생성 트랜잭션에서만)
+INSERT INTO order_discounts (order_id, line_no, kind, coupon_id, description, amount)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: ListOrderDiscounts :many
+-- 주문 할인 내역 (항목 할인 → 쿠폰 할인 순)
+SELECT * FROM order_discounts WHERE order_id = ? ORDER BY id ASC;
@@ -0,0 +1,71 @@
+-- ============================================================================
+-- Dispute Queries
+-- ============================================================================
+-- NOTE: 진행 중 분쟁 = status 'OPEN' (결제당 1건 - uk_dispute_open_payment)
+-- NOTE: 동결/해제(hold_balance)는 판매자 계정 row-lock 하에서 ledger.Hold/Release로 처리
+
+-- name: CreateDispute :execresult
+-- 분쟁 생성 (결제 row-lock 하에서 진행 중 분쟁 없음 확인 후)
+INSERT INTO disputes (external_id, payment_id, seller_account_id, opened_by, reason, description, amount, held_amount)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetDisputeForUpdate :one
+-- 트랜잭션 내 row-lock (증빙 제출/해결 동시성 제어)
+SELECT * FROM disputes WHERE id = ? FOR UPDATE;
+
+-- name: GetDisputeDetailByExternalID :one
+-- 분쟁 상세 (결제/주문 + 구매자/판매자 외부 식별자 - 조회 권한 확인용)
+SELECT d.*, p.external_id AS payment_external_id, p.token_symbol, o.order_number, o.buyer_id, o.seller_id,
+       b.external_id AS buyer_external_id, s.external_id AS seller_external_id, r.external_id AS refund_external_id
+FROM disputes d
+JOIN payments p ON p.id = d.payment_id
+JOIN orders o ON o.id = p.order_id
+JOIN users b ON b.id = o.buyer_id
+JOIN users s ON s.id = o.seller_id
+LEFT JOIN payment_refunds r ON r.id = d.refund_id
+WHERE d.external_id = ?;
+
+-- name: ListDisputes :many
+-- 분쟁 목록 (관리자 검토 큐, 상태 필터 옵션, 오래된 순)
+SELECT d.*, p.external_id AS payment_external_id, p.token_symbol, o.order_number, o.buyer_id, o.seller_id,
+       b.external_id AS buyer_external_id, s.external_id AS seller_external_id, r.external_id AS refund_external_id
+FROM disputes d
+JOIN payments p ON p.id = d.payment_id
+JOIN orders o ON o.id = p.order_id
+JOIN users b ON b.id = o.buyer_id
+JOIN users s ON s.id = o.seller_id
+LEFT JOIN payment_refunds r ON r.id = d.refund_id
+WHERE (sqlc.narg('status') IS NULL OR d.status = sqlc.narg('status'))
+ORDER BY d.created_at ASC, d.id ASC
+LIMIT ? OFFSET ?;
+
+-- name: CountDisputes :one
+-- 분쟁 수 (페이징용)
+SELECT COUNT(*) as total FROM disputes
+WHERE (sqlc.narg('status') IS NULL OR status = sqlc.narg('status'));
+
+-- name: ExistsOpenDisputeByPayment :one
+-- 결제의 진행 중 분쟁 여부
+SELECT EXISTS(
+    SELECT 1 FROM disputes WHERE payment_id = ? AND status = 'OPEN'
+) AS open;
+
+-- name: ResolveDispute :execresult
+-- 분쟁 해결 (OPEN → REFUNDED | RELEASED, 진행 중 분쟁만)
+UPDATE disputes
+SET status = ?, refund_id = ?, resolved_by = ?, resolution_note = ?, resolved_at = NOW(), updated_at = NOW()
+WHERE id = ? AND status = 'OPEN';
+
+-- name: CreateDisputeEvidence :execresult
+-- 증빙 제출 (OPEN 분쟁만 - 서비스에서 분쟁 row-lock 하에서 확인)
+INSERT INTO dispute_evidence (external_id, dispute_id, submitted_by, party, description, attachment_url)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: ListDisputeEvidence :many
+-- 분쟁의 증빙 목록 (제출 순) + 제출자 외부 식별자
+SELECT e.external_id, e.party, e.description, e.attachment_url, e.created_at,
+       u.external_id AS submitted_by_external_id
+FROM dispute_evidence e
+JOIN users u ON u.id = e.submitted_by
+WHERE e.dispute_id = ?
+ORDER BY e.created_at ASC, e.id ASC;
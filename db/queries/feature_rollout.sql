@@ -0,0 +1,48 @@
+-- ============================================================================
+-- Feature Rollout Queries
+-- ============================================================================
+-- NOTE: 행이 없는 기능 = PILOT (허용 목록 사용자만 접근)
+
+-- name: GetFeatureRollout :one
+-- 기능 롤아웃 상태 조회
+SELECT * FROM feature_rollouts WHERE feature = ?;
+
+-- name: ListFeatureRollouts :many
+-- 기능 롤아웃 상태 목록
+SELECT * FROM feature_rollouts ORDER BY feature;
+
+-- name: UpsertFeatureRollout :exec
+-- 기능 롤아웃 상태 설정 (행이 없으면 생성)
+INSERT INTO feature_rollouts (feature, status, updated_by)
+VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE status = VALUES(status), updated_by = VALUES(updated_by), updated_at = NOW();
+
+-- name: ExistsFeatureAllowlistEntry :one
+-- 사용자의 파일럿 허용 여부 (게이팅 미들웨어)
+SELECT EXISTS(
+    SELECT 1 FROM feature_allowlist
+    WHERE feature = ? AND user_id = ?
+) AS allowed;
+
+-- name: CreateFeatureAllowlistEntry :execresult
+-- 허용 목록 추가 (uk_feature_allowlist_user 위반 시 중복)
+INSERT INTO feature_allowlist (feature, user_id, added_by)
+VALUES (?, ?, ?);
+
+-- name: DeleteFeatureAllowlistEntry :execrows
+-- 허용 목록 제거
+DELETE FROM feature_allowlist WHERE feature = ? AND user_id = ?;
+
+-- name: ListFeatureAllowlist :many
+-- 기능의 허용 목록 (사용자 external ID 포함, 추가순)
+SELECT
+    a.id,
+    a.feature,
+    a.user_id,
+    u.external_id AS user_external_id,
+    u.email AS user_email,
+    a.created_at
+FROM feature_allowlist a
+JOIN users u ON u.id = a.user_id
+WHERE a.feature = ?
+ORDER BY a.created_at, a.id;
@@ -0,0 +1,39 @@
+-- ============================================================================
+-- Fee Queries
+-- ============================================================================
+-- NOTE: fee_ledger_entries는 불변 (INSERT/SELECT만 허용)
+-- NOTE: 판매자 거래액 = 확정(CAPTURED) 결제 합계 (환불/취소 결제 제외, 토큰 구분 없이 합산 - 모두 USD 페그)
+
+-- name: InsertFeeLedgerEntry :execresult
+-- 수수료 원장 기록 (이미 기록된 (결제, 유형, 환불)이면 무시 → RowsAffected = 0)
+INSERT IGNORE INTO fee_ledger_entries (
+    payment_id, merchant_id, entry_type, amount, token_symbol, tier,
+    monthly_volume, percentage_bps, percentage_fee, fixed_fee, refund_id
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListFeeLedgerEntriesByPayment :many
+-- 결제의 수수료 원장 항목 (부과 → 취소 순)
+SELECT * FROM fee_ledger_entries
+WHERE payment_id = ?
+ORDER BY id ASC;
+
+-- name: ListUnassessedCapturedPayments :many
+-- 수수료 미부과 확정 결제 (확정 순, 수수료 부과 job 배치)
+SELECT p.id, p.amount, p.token_symbol, p.captured_at, o.seller_id
+FROM payments p
+JOIN orders o ON o.id = p.order_id
+WHERE p.status = 'CAPTURED' AND p.captured_at IS NOT NULL
+  AND NOT EXISTS (
+      SELECT 1 FROM fee_ledger_entries f
+      WHERE f.payment_id = p.id AND f.entry_type = 'CHARGE'
+  )
+ORDER BY p.captured_at ASC, p.id ASC
+LIMIT ?;
+
+-- name: GetSellerCapturedVolume :one
+-- 판매자의 기간 내 확정 결제 합계 (수수료 등급 산정), DECIMAL 문자열로 반환
+SELECT CAST(COALESCE(SUM(p.amount), 0) AS DECIMAL(18,8)) AS volume
+FROM payments p
+JOIN orders o ON o.id = p.order_id
+WHERE o.seller_id = sqlc.arg('seller_id') AND p.status = 'CAPTURED'
+  AND p.captured_at >= sqlc.arg('captured_from') AND p.captured_at < sqlc.arg('captured_to');
@@ -0,0 +1,34 @@
+-- ============================================================================
+-- Historical Import Queries
+-- ============================================================================
+-- NOTE: historical_ledger_entries는 불변 - 재실행 시 INSERT IGNORE로 중복 건 건너뜀 (uk_historical_entry)
+
+-- name: CreateHistoricalImport :execresult
+-- import 실행 기록 (건수는 항목 기록 후 갱신)
+INSERT INTO historical_imports (external_id, user_id, account_id, source, from_block, to_block, created_by)
+VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: UpdateHistoricalImportCounts :exec
+-- import 결과 건수 갱신
+UPDATE historical_imports
+SET imported_count = ?, duplicate_count = ?, rejected_count = ?
+WHERE id = ?;
+
+-- name: ListHistoricalImportsByUser :many
+-- 가맹점의 import 이력 (최신순)
+SELECT * FROM historical_imports
+WHERE user_id = ?
+ORDER BY created_at DESC, id DESC;
+
+-- name: InsertHistoricalLedgerEntry :execresult
+-- 검증된 전송 기록 (이미 기록된 (계정, tx, log index)면 무시 → RowsAffected = 0)
+INSERT IGNORE INTO historical_ledger_entries (
+    import_id, account_id, entry_type, amount, chain_id, tx_hash, log_index,
+    block_number, block_time, wallet_address, counterparty_address
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListHistoricalLedgerEntriesByAccount :many
+-- 계정의 이력 항목 (기초 잔액 계산용, 시간순)
+SELECT * FROM historical_ledger_entries
+WHERE account_id = ?
+ORDER BY block_time ASC, id ASC;
@@ -0,0 +1,133 @@
+-- ============================================================================
+-- Inventory Queries
+-- ============================================================================
+
+-- name: ListOrderInventoryReservations :many
+-- 주문의 재고별 순 예약 수량 (RESERVE - RELEASE - 주문 출고(OUTBOUND) 이력 합계, 예약이 남은 재고만)
+SELECT l.inventory_id, CAST(SUM(l.quantity_change) AS SIGNED) AS reserved
+FROM inventory_logs l
+WHERE l.reference_type = 'ORDER' AND l.reference_id = ?
+  AND l.event_type IN ('RESERVE', 'RELEASE', 'OUTBOUND')
+GROUP BY l.inventory_id
+HAVING reserved > 0
+ORDER BY l.inventory_id ASC;
+
+-- name: GetInventoryForUpdate :one
+-- 트랜잭션 내 row-lock (예약 수량 변경 전)
+SELECT * FROM inventories WHERE id = ? FOR UPDATE;
+
+-- name: UpdateInventoryReservedQuantity :exec
+-- 예약 수량 갱신 (row-lock 하에서 계산한 값)
+UPDATE inventories
+SET reserved_quantity = ?, version = version + 1, updated_at = NOW()
+WHERE id = ?;
+
+-- name: CreateInventoryLog :exec
+-- 재고 이력 기록 (불변)
+INSERT INTO inventory_logs (inventory_id, event_type, quantity_change, quantity_after, reserved_after, reference_type, reference_id, reason)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetInventoryByProductForUpdate :one
+-- 상품·위치별 재고 row-lock (반품 재입고)
+SELECT * FROM inventories WHERE product_id = ? AND location = ? FOR UPDATE;
+
+-- name: UpdateInventoryQuantity :exec
+-- 재고 수량 갱신 (row-lock 하에서 계산한 값)
+UPDATE inventories
+SET quantity = ?, version = version + 1, updated_at = NOW()
+WHERE id = ?;
+
+-- name: EnsureInventory :exec
+-- 상품·위치별 재고 행 생성 (이미 있으면 유지)
+INSERT INTO inventories (product_id, location)
+VALUES (?, ?)
+ON DUPLICATE KEY UPDATE id = id;
+
+-- name: GetProductAvailableStock :one
+-- 상품의 가용 재고 합계 (전체 위치, 트랜잭션 내 변경 반영)
+SELECT CAST(COALESCE(SUM(quantity - reserved_quantity), 0) AS SIGNED) AS available
+FROM inventories
+WHERE product_id = ?;
+
+-- ============================================================================
+-- Inventory Levels
+-- ============================================================================
+
+-- name: ListInventoryLevels :many
+-- 판매 SKU(변형 또는 변형 없는 상품)별 재고 합계
+-- low_stock = TRUE: 재주문 기준 수량이 설정되고 가용 재고가 그 미만인 SKU만
+SELECT p.id, p.sku, p.name, p.status, p.reorder_threshold,
+       parent.sku AS parent_sku,
+       CAST(COALESCE(SUM(i.quantity), 0) AS SIGNED) AS quantity,
+       CAST(COALESCE(SUM(i.reserved_quantity), 0) AS SIGNED) AS reserved,
+       COUNT(i.id) AS locations
+FROM products p
+LEFT JOIN products parent ON parent.id = p.parent_id
+LEFT JOIN inventories i ON i.product_id = p.id
+WHERE NOT EXISTS (SELECT 1 FROM products v WHERE v.parent_id = p.id)
+GROUP BY p.id, parent.sku
+HAVING sqlc.arg('low_stock') = FALSE
+    OR (p.reorder_threshold IS NOT NULL AND COALESCE(SUM(i.quantity - i.reserved_quantity), 0) < p.reorder_threshold)
+ORDER BY p.sku ASC
+LIMIT ? OFFSET ?;
+
+-- name: CountInventoryLevels :one
+SELECT COUNT(*)
+FROM products p
+WHERE NOT EXISTS (SELECT 1 FROM products v WHERE v.parent_id = p.id)
+  AND (sqlc.arg('low_stock') = FALSE OR (
+      p.reorder_threshold IS NOT NULL
+      AND (SELECT COALESCE(SUM(i.quantity - i.reserved_quantity), 0) FROM inventories i WHERE i.product_id = p.id) < p.reorder_threshold
+  ));
+
+-- ============================================================================
+-- Warehouse Locations
+-- ============================================================================
+
+-- name: ListInventoryLocations :many
+-- 상품의 창고별 재고 (출고 우선순위 순, INACTIVE 창고 포함 - 주문 예약은 ACTIVE 창고만)
+SELECT i.*, w.id AS warehouse_id, w.name AS warehouse_name, w.country AS warehouse_country,
+       w.priority AS warehouse_priority, w.status AS warehouse_status
+FROM inventories i
+JOIN warehouses w ON w.code = i.location
+WHERE i.product_id = ?
+ORDER BY w.priority ASC, i.location ASC;
+
+-- name: CreateInventoryTransfer :execresult
+-- 창고 간 재고 이동 기록 (재고 이력은 inventory_logs TRANSFER_OUT/TRANSFER_IN)
+INSERT INTO inventory_transfers (external_id, product_id, from_warehouse_id, to_warehouse_id, quantity, reason)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- ============================================================================
+-- Stock Movements
+-- ============================================================================
+
+-- name: CreateStockMovement :exec
+-- 재고 변동 감사 이력 기록 (불변)
+INSERT INTO stock_movements (product_id, inventory_id, location, reason, quantity_delta, reserved_delta, quantity_after, reserved_after,
+                             actor_type, actor_id, reference_type, reference_id, note, request_id)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListStockMovementsByProduct :many
+-- 상품의 재고 변동 이력 + 변경 주체·참조 외부 식별자 (사유/위치/시간 범위 필터 옵션, 최신순, 페이징)
+SELECT m.*, u.external_id AS actor_external_id, o.order_number, r.rma_number, t.external_id AS transfer_external_id
+FROM stock_movements m
+LEFT JOIN users u ON u.id = m.actor_id
+LEFT JOIN orders o ON m.reference_type = 'ORDER' AND o.id = m.reference_id
+LEFT JOIN rmas r ON m.reference_type = 'RMA' AND r.id = m.reference_id
+LEFT JOIN inventory_transfers t ON m.reference_type = 'TRANSFER' AND t.id = m.reference_id
+WHERE m.product_id = sqlc.arg('product_id')
+  AND (sqlc.narg('reason') IS NULL OR m.reason = sqlc.narg('reason'))
+  AND (sqlc.narg('location') IS NULL OR m.location = sqlc.narg('location'))
+  AND (sqlc.narg('created_from') IS NULL OR m.created_at >= sqlc.narg('created_from'))
+  AND (sqlc.narg('created_to') IS NULL OR m.created_at < sqlc.narg('created_to'))
+ORDER BY m.id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountStockMovementsByProduct :one
+SELECT COUNT(*) FROM stock_movements
+WHERE product_id = sqlc.arg('product_id')
+  AND (sqlc.narg('reason') IS NULL OR reason = sqlc.narg('reason'))
+  AND (sqlc.narg('location') IS NULL OR location = sqlc.narg('location'))
+  AND (sqlc.narg('created_from') IS NULL OR created_at >= sqlc.narg('created_from'))
+  AND (sqlc.narg('created_to') IS NULL OR created_at < sqlc.narg('created_to'));
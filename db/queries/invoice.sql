@@ -0,0 +1,141 @@
+-- ============================================================================
+-- Invoice Queries
+-- ============================================================================
+-- NOTE: 구매자에게 DRAFT 비공개 (목록/상세 모두), 결제액/상태 변경은 GetInvoiceForUpdate row-lock 하에서만
+-- NOTE: 잠금 순서 orders → credit_terms → invoices (독촉 취소/결제 기록/입금 대사 공통)
+
+-- name: CreateInvoice :execresult
+-- 청구서 작성 (DRAFT, 번호/기한은 발행 시, uk_invoice_order → 주문당 1건)
+INSERT INTO invoices (external_id, buyer_id, seller_id, token_symbol, credit_terms_id, order_id, amount, payment_terms_days, notes)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: CreateInvoiceItem :exec
+-- 청구 항목 (수동 청구서)
+INSERT INTO invoice_items (invoice_id, line_no, product_id, description, quantity, unit_price, amount)
+VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: CopyOrderItemsToInvoice :exec
+-- 주문 상품을 청구 항목으로 복사 (주문 단가 고정, 항목 번호 = 주문 상품 순서)
+INSERT INTO invoice_items (invoice_id, line_no, product_id, description, quantity, unit_price, amount)
+SELECT sqlc.arg('invoice_id'), ROW_NUMBER() OVER (ORDER BY oi.id), oi.product_id, p.name,
+       oi.quantity, oi.unit_price, oi.quantity * oi.unit_price
+FROM order_items oi
+JOIN products p ON p.id = oi.product_id
+WHERE oi.order_id = sqlc.arg('order_id');
+
+-- name: ListInvoiceItems :many
+-- 청구 항목 (항목 번호순)
+SELECT * FROM invoice_items WHERE invoice_id = ? ORDER BY line_no;
+
+-- name: GetInvoiceDetail :one
+-- 청구서 + 구매자/판매자 + 주문 번호/외상 조건 (조회 권한 확인용, 수동 청구서는 주문/조건 NULL)
+SELECT i.*, b.external_id AS buyer_external_id, b.name AS buyer_name,
+       s.external_id AS seller_external_id, s.name AS seller_name,
+       o.order_number, ct.terms
+FROM invoices i
+JOIN users b ON b.id = i.buyer_id
+JOIN users s ON s.id = i.seller_id
+LEFT JOIN orders o ON o.id = i.order_id
+LEFT JOIN credit_terms ct ON ct.id = i.credit_terms_id
+WHERE i.external_id = ?;
+
+-- name: GetInvoiceByOrder :one
+-- 주문의 청구서 (주문당 1건)
+SELECT * FROM invoices WHERE order_id = ?;
+
+-- name: GetInvoiceForUpdate :one
+-- 청구서 row-lock (주문/외상 조건 잠금 후)
+SELECT * FROM invoices WHERE id = ? FOR UPDATE;
+
+-- name: ListInvoicesByUser :many
+-- 사용자가 발행했거나 받은 청구서 (받은 청구서는 DRAFT 제외, 상태 필터, 최신순)
+SELECT i.*, b.external_id AS buyer_external_id, b.name AS buyer_name,
+       s.external_id AS seller_external_id, s.name AS seller_name,
+       o.order_number, ct.terms
+FROM invoices i
+JOIN users b ON b.id = i.buyer_id
+JOIN users s ON s.id = i.seller_id
+LEFT JOIN orders o ON o.id = i.order_id
+LEFT JOIN credit_terms ct ON ct.id = i.credit_terms_id
+WHERE (i.seller_id = sqlc.arg('user_id') OR (i.buyer_id = sqlc.arg('user_id') AND i.status <> 'DRAFT'))
+  AND (sqlc.narg('status') IS NULL OR i.status = sqlc.narg('status'))
+ORDER BY i.id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountInvoicesByUser :one
+-- 사용자가 발행했거나 받은 청구서 수 (받은 청구서는 DRAFT 제외, 상태 필터)
+SELECT COUNT(*) FROM invoices i
+WHERE (i.seller_id = sqlc.arg('user_id') OR (i.buyer_id = sqlc.arg('user_id') AND i.status <> 'DRAFT'))
+  AND (sqlc.narg('status') IS NULL OR i.status = sqlc.narg('status'));
+
+-- name: ListOpenInvoicesByBuyer :many
+-- 구매자의 미결제 청구서 (입금 대사 후보, 만기 빠른 순)
+SELECT * FROM invoices
+WHERE buyer_id = ? AND token_symbol = ? AND status IN ('ISSUED', 'PARTIALLY_PAID', 'OVERDUE')
+ORDER BY due_at, id;
+
+-- name: IssueInvoice :execresult
+-- 청구서 발행 (DRAFT → ISSUED, 번호/발행일/만기 확정)
+UPDATE invoices
+SET status = 'ISSUED', invoice_number = ?, issued_at = ?, due_at = ?, updated_at = NOW()
+WHERE id = ? AND status = 'DRAFT';
+
+-- name: DeleteDraftInvoice :execresult
+-- 미발행 청구서 폐기 (DRAFT만, 항목은 CASCADE)
+DELETE FROM invoices WHERE id = ? AND status = 'DRAFT';
+
+-- name: AddInvoicePaidAmount :exec
+-- 결제액 누적 (GetInvoiceForUpdate row-lock 하에서만)
+UPDATE invoices
+SET paid_amount = paid_amount + ?, updated_at = NOW()
+WHERE id = ?;
+
+-- name: UpdateInvoiceStatus :exec
+-- 미결제 청구서 상태 변경 (ISSUED → PARTIALLY_PAID, GetInvoiceForUpdate row-lock 하에서만)
+UPDATE invoices
+SET status = ?, updated_at = NOW()
+WHERE id = ?;
+
+-- name: CloseInvoice :exec
+-- 청구서 종료 (PAID = 전액 결제, VOID = 취소)
+UPDATE invoices
+SET status = ?, closed_at = NOW(), updated_at = NOW()
+WHERE id = ? AND status IN ('ISSUED', 'PARTIALLY_PAID', 'OVERDUE');
+
+-- name: MarkInvoicesOverdue :execrows
+-- 만기 경과 청구서 연체 전환 (ISSUED/PARTIALLY_PAID → OVERDUE)
+UPDATE invoices
+SET status = 'OVERDUE', updated_at = NOW()
+WHERE status IN ('ISSUED', 'PARTIALLY_PAID') AND due_at < ?;
+
+-- name: CreateInvoicePayment :exec
+-- 청구서 결제 기록 (deposit_id = 자동 대사된 입금, NULL = 판매자 수동 기록)
+INSERT INTO invoice_payments (external_id, invoice_id, amount, reference, deposit_id)
+VALUES (?, ?, ?, ?, ?);
+
+-- name: ListInvoicePayments :many
+-- 청구서 결제 내역 + 대사된 입금 tx (기록순)
+SELECT ip.*, d.tx_hash AS deposit_tx_hash
+FROM invoice_payments ip
+LEFT JOIN deposits d ON d.id = ip.deposit_id
+WHERE ip.invoice_id = ?
+ORDER BY ip.id;
+
+-- ============================================================================
+-- Deposit Matching Queries
+-- ============================================================================
+
+-- name: ListDepositsForInvoiceMatching :many
+-- 대사 대기 입금 (입금 반영 완료, 미처리, 오래된 순)
+SELECT * FROM deposits
+WHERE status IN ('CREDITED', 'COMPLETED') AND invoice_matched_at IS NULL
+ORDER BY id
+LIMIT ?;
+
+-- name: GetDepositForUpdate :one
+-- 입금 row-lock (다중 인스턴스 대사 직렬화)
+SELECT * FROM deposits WHERE id = ? FOR UPDATE;
+
+-- name: MarkDepositInvoiceMatched :exec
+-- 입금 대사 처리 완료 (배정 0건이어도 기록 → 재처리 방지)
+UPDATE deposits SET invoice_matched_at = NOW() WHERE id = ?;
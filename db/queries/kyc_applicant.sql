@@ -0,0 +1,30 @@
+-- ============================================================================
+-- KYC Applicant Queries
+-- ============================================================================
+-- NOTE: 제공자 결정은 applicant row-lock 후 처리 (webhook 재전송/동시 수신 시 1회만 반영)
+
+-- name: UpsertKycApplicant :exec
+-- KYC 요청 시 applicant 기록 (같은 applicant 재사용 시 PENDING으로 초기화)
+INSERT INTO kyc_applicants (user_id, provider, applicant_id)
+VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    status = 'PENDING',
+    reject_reason = NULL,
+    decided_at = NULL;
+
+-- name: GetKycApplicantForUpdate :one
+SELECT * FROM kyc_applicants
+WHERE provider = ? AND applicant_id = ?
+FOR UPDATE;
+
+-- name: DecideKycApplicant :exec
+UPDATE kyc_applicants
+SET status = ?, reject_reason = ?, decided_at = NOW()
+WHERE id = ?;
+
+-- name: GetLatestKycApplicantByUser :one
+-- 사용자의 최근 applicant (KYC 상태 조회용)
+SELECT * FROM kyc_applicants
+WHERE user_id = ?
+ORDER BY created_at DESC, id DESC
+LIMIT 1;
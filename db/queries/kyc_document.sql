@@ -0,0 +1,45 @@
+-- ============================================================================
+-- KYC Document Queries
+-- ============================================================================
+-- NOTE: 파일 본문은 아티팩트 저장소(암호화)에, 메타데이터만 DB에 기록
+
+-- name: CreateKycDocument :execresult
+INSERT INTO kyc_documents (external_id, user_id, document_type, file_name, content_type, size_bytes, sha256, storage_key)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetKycDocumentByExternalID :one
+SELECT d.*, u.external_id AS user_external_id
+FROM kyc_documents d
+JOIN users u ON u.id = d.user_id
+WHERE d.external_id = ?;
+
+-- name: GetKycDocumentForUpdate :one
+-- 심사 직렬화 (동시 심사 시 한 건만 반영)
+SELECT * FROM kyc_documents WHERE id = ? FOR UPDATE;
+
+-- name: ListKycDocumentsByUser :many
+-- 사용자의 KYC 서류 (최신순)
+SELECT * FROM kyc_documents
+WHERE user_id = ?
+ORDER BY created_at DESC, id DESC;
+
+-- name: CountKycDocumentsByUser :one
+SELECT COUNT(*) FROM kyc_documents WHERE user_id = ?;
+
+-- name: ListKycDocuments :many
+-- 관리자 심사 목록 (status 필터 선택, 오래된 순 = 심사 대기열)
+SELECT d.*, u.external_id AS user_external_id
+FROM kyc_documents d
+JOIN users u ON u.id = d.user_id
+WHERE (sqlc.narg('status') IS NULL OR d.status = sqlc.narg('status'))
+ORDER BY d.created_at ASC, d.id ASC
+LIMIT ? OFFSET ?;
+
+-- name: CountKycDocuments :one
+SELECT COUNT(*) FROM kyc_documents
+WHERE (sqlc.narg('status') IS NULL OR status = sqlc.narg('status'));
+
+-- name: ReviewKycDocument :exec
+UPDATE kyc_documents
+SET status = ?, review_note = ?, reviewed_by = ?, reviewed_at = NOW()
+WHERE id = ?;
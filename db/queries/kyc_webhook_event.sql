@@ -0,0 +1,31 @@
+-- ============================================================================
+-- KYC Webhook Event Queries
+-- ============================================================================
+-- NOTE: 결정 처리와 같은 트랜잭션에서 기록 (applied가 실제 반영 여부와 일치)
+
+-- name: ExistsKycWebhookEvent :one
+-- 같은 제공자 이벤트의 재전송 여부 (applicant 잠금 후 확인 → 1회만 반영)
+SELECT EXISTS(
+    SELECT 1 FROM kyc_webhook_events WHERE provider = ? AND event_id = ?
+) AS received;
+
+-- name: RecordKycWebhookEvent :exec
+-- 같은 이벤트 재전송은 delivery_count만 증가 (최초 수신 시 applied 유지)
+INSERT INTO kyc_webhook_events (provider, event_id, applicant_id, user_id, verdict, outcome, applied, payload, payload_sha256)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    delivery_count = delivery_count + 1,
+    last_received_at = NOW();
+
+-- name: ListKycWebhookEvents :many
+-- 관리자 감사 조회 (applicant 필터 선택, 최신 순)
+SELECT e.*, u.external_id AS user_external_id
+FROM kyc_webhook_events e
+LEFT JOIN users u ON u.id = e.user_id
+WHERE (sqlc.narg('applicant_id') IS NULL OR e.applicant_id = sqlc.narg('applicant_id'))
+ORDER BY e.created_at DESC, e.id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountKycWebhookEvents :one
+SELECT COUNT(*) FROM kyc_webhook_events
+WHERE (sqlc.narg('applicant_id') IS NULL OR applicant_id = sqlc.narg('applicant_id'));
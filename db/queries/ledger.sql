@@ -0,0 +1,43 @@
+-- ============================================================================
+-- Ledger Queries
+-- ============================================================================
+-- NOTE: ledger_entries는 불변 (Double-entry) - 잔액의 source of truth
+-- NOTE: accounts.balance는 ledger 합계의 캐시
+
+-- name: GetLedgerBalance :one
+-- 원장 기준 잔액 (CREDIT 합계 - DEBIT 합계), DECIMAL 문자열로 반환
+SELECT
+    CAST(COALESCE(SUM(CASE WHEN entry_type = 'CREDIT' THEN amount ELSE -amount END), 0) AS DECIMAL(18,8)) AS balance,
+    COUNT(*) AS entry_count
+FROM ledger_entries
+WHERE account_id = ?;
+
+-- name: GetLatestLedgerEntry :one
+-- 계정의 최신 원장 항목 (balance_after 비교용)
+SELECT * FROM ledger_entries
+WHERE account_id = ?
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: CreateLedgerEntry :exec
+-- 원장 항목 기록 (같은 tx_id의 DEBIT 합계 = CREDIT 합계, 계정 row-lock 하에서 balance_after 계산)
+INSERT INTO ledger_entries (tx_id, account_id, entry_type, amount, balance_after, reference_type, reference_id, description)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetLedgerBalanceBefore :one
+-- 시점 이전 원장 잔액 (명세서 기초 잔액)
+SELECT CAST(COALESCE(SUM(CASE WHEN entry_type = 'CREDIT' THEN amount ELSE -amount END), 0) AS DECIMAL(18,8)) AS balance
+FROM ledger_entries
+WHERE account_id = ? AND created_at < ?;
+
+-- name: SummarizeLedgerEntries :many
+-- 기간 내 원장 항목을 (reference_type, entry_type)별 건수/합계로 집계 (명세서 항목)
+SELECT
+    COALESCE(reference_type, 'OTHER') AS reference_type,
+    entry_type,
+    COUNT(*) AS entry_count,
+    CAST(SUM(amount) AS DECIMAL(18,8)) AS total_amount
+FROM ledger_entries
+WHERE account_id = ? AND created_at >= sqlc.arg('from') AND created_at < sqlc.arg('to')
+GROUP BY COALESCE(reference_type, 'OTHER'), entry_type
+ORDER BY reference_type, entry_type;
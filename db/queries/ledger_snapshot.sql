@@ -0,0 +1,29 @@
+-- ============================================================================
+-- Ledger Balance Snapshot Queries
+-- ============================================================================
+-- NOTE: 현재 잔액 = GetLatestLedgerBalanceSnapshot + GetLedgerBalanceSince(last_entry_id)
+
+-- name: CreateLedgerBalanceSnapshot :exec
+-- 잔액 체크포인트 기록 (uk_ledger_balance_snapshot → 같은 지점 중복 스냅샷 방지)
+INSERT INTO ledger_balance_snapshots (account_id, last_entry_id, balance, entry_count)
+VALUES (?, ?, ?, ?);
+
+-- name: GetLatestLedgerBalanceSnapshot :one
+-- 계정의 최신 잔액 스냅샷
+SELECT * FROM ledger_balance_snapshots
+WHERE account_id = ?
+ORDER BY last_entry_id DESC
+LIMIT 1;
+
+-- name: GetLedgerBalanceSince :one
+-- 항목 id 이후의 잔액 변동 (CREDIT 합계 - DEBIT 합계) + 항목 수 + 마지막 항목 id (한 번의 일관된 읽기)
+SELECT
+    CAST(COALESCE(SUM(CASE WHEN entry_type = 'CREDIT' THEN amount ELSE -amount END), 0) AS DECIMAL(18,8)) AS balance,
+    COUNT(*) AS entry_count,
+    CAST(COALESCE(MAX(id), 0) AS SIGNED) AS last_entry_id
+FROM ledger_entries
+WHERE account_id = ? AND id > ?;
+
+-- name: ListAccountIDsAfter :many
+-- 스냅샷 대상 계정 (id 순 페이지)
+SELECT id FROM accounts WHERE id > ? ORDER BY id LIMIT ?;
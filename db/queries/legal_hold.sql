@@ -0,0 +1,49 @@
+-- ============================================================================
+-- Legal Hold Queries
+-- ============================================================================
+-- NOTE: 활성 hold = released_at IS NULL (해제된 hold도 이력으로 보존, 삭제 금지)
+-- NOTE: 보존 기한 삭제 쿼리(retention.sql)는 활성 hold 대상 데이터를 제외
+
+-- name: CreateLegalHold :execresult
+-- hold 설정 (대상당 활성 hold 1건 - uk_legal_hold_active_subject 위반 시 중복)
+INSERT INTO legal_holds (external_id, subject_type, subject_id, subject_ref, reason, case_reference, placed_by)
+VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetLegalHoldByID :one
+-- ID로 hold 조회 (내부 전용)
+SELECT * FROM legal_holds WHERE id = ?;
+
+-- name: GetLegalHoldByExternalID :one
+-- 외부 식별자로 hold 조회
+SELECT * FROM legal_holds WHERE external_id = ?;
+
+-- name: GetLegalHoldByExternalIDForUpdate :one
+-- 트랜잭션 내 row-lock (해제 동시성 제어)
+SELECT * FROM legal_holds WHERE external_id = ? FOR UPDATE;
+
+-- name: ListLegalHolds :many
+-- hold 목록 (활성 여부/대상 타입 필터 옵션, 최신순)
+SELECT * FROM legal_holds
+WHERE (sqlc.narg('is_active') IS NULL OR is_active = sqlc.narg('is_active'))
+  AND (sqlc.narg('subject_type') IS NULL OR subject_type = sqlc.narg('subject_type'))
+ORDER BY placed_at DESC, id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountLegalHolds :one
+-- hold 수 (페이징용)
+SELECT COUNT(*) as total FROM legal_holds
+WHERE (sqlc.narg('is_active') IS NULL OR is_active = sqlc.narg('is_active'))
+  AND (sqlc.narg('subject_type') IS NULL OR subject_type = sqlc.narg('subject_type'));
+
+-- name: ReleaseLegalHold :execresult
+-- hold 해제 (활성 hold만)
+UPDATE legal_holds
+SET released_by = ?, released_at = NOW(), release_reason = ?, updated_at = NOW()
+WHERE id = ? AND released_at IS NULL;
+
+-- name: ExistsActiveLegalHold :one
+-- 대상의 활성 hold 여부 (삭제/익명화 전 검사)
+SELECT EXISTS(
+    SELECT 1 FROM legal_holds
+    WHERE subject_type = ? AND subject_id = ? AND released_at IS NULL
+) AS held;
@@ -0,0 +1,131 @@
+-- ============================================================================
+-- Order Queries
+-- ============================================================================
+
+-- name: GetOrderByOrderNumber :one
+-- 주문번호로 주문 조회 (외부 API 식별자)
+SELECT * FROM orders WHERE order_number = ?;
+
+-- name: GetOrderByIDForUpdate :one
+-- 트랜잭션 내 row-lock (상태 전이 전 재확인)
+SELECT * FROM orders WHERE id = ? FOR UPDATE;
+
+-- name: CancelConfirmedOrder :execresult
+-- 미결제 주문 취소 (CONFIRMED → CANCELLED, 이미 결제/취소된 주문은 영향 없음)
+UPDATE orders
+SET status = 'CANCELLED', status_changed_at = NOW(), updated_at = NOW()
+WHERE id = ? AND status = 'CONFIRMED';
+
+-- name: CancelOpenOrder :execresult
+-- 미출고 주문 취소 요청 (PENDING/CONFIRMED → CANCELLED, 결제 완료 주문은 환불 경로)
+UPDATE orders
+SET status = 'CANCELLED', status_changed_at = NOW(), updated_at = NOW()
+WHERE id = ? AND status IN ('PENDING', 'CONFIRMED');
+
+-- name: SetOrderPaymentDue :execresult
+-- 외상 주문 결제 기한 = 청구서 만기 (CONFIRMED 주문만, 독촉 기준)
+UPDATE orders
+SET payment_due_at = ?, updated_at = NOW()
+WHERE id = ? AND status = 'CONFIRMED';
+
+-- name: MarkOrderPaid :execresult
+-- 청구서 전액 결제 (CONFIRMED → PAID)
+UPDATE orders
+SET status = 'PAID', status_changed_at = NOW(), updated_at = NOW()
+WHERE id = ? AND status = 'CONFIRMED';
+
+-- name: SetOrderFXQuote :execresult
+-- 법정화폐 가격 주문의 견적 기록 (토큰 금액 + 환율 고정, PENDING 주문만)
+UPDATE orders
+SET total_amount = ?, token_symbol = ?, price_currency = ?, price_amount = ?,
+    fx_rate = ?, fx_rate_source = ?, fx_rate_at = ?, quoted_at = ?, updated_at = NOW()
+WHERE id = ? AND status = 'PENDING';
+
+-- ============================================================================
+-- Reservation Expiry
+-- ============================================================================
+
+-- name: SetOrderReservationExpiry :exec
+-- 주문 재고 예약 만료 시각 (주문 전환 트랜잭션에서 예약 후 기록)
+UPDATE orders
+SET reservation_expires_at = ?, updated_at = NOW()
+WHERE id = ?;
+
+-- name: ListOrdersWithExpiredReservations :many
+-- 예약 만료 주문 (PENDING/CONFIRMED + 만료 시각 경과 + 진행 중 결제·발행 청구서 없음, 만료 순 - 만료 스윕 배치)
+SELECT o.* FROM orders o
+WHERE o.status IN ('PENDING', 'CONFIRMED')
+  AND o.reservation_expires_at <= sqlc.arg('now')
+  AND NOT EXISTS (SELECT 1 FROM payments p WHERE p.order_id = o.id AND p.status IN ('AUTHORIZED', 'CAPTURED'))
+  AND NOT EXISTS (SELECT 1 FROM invoices i WHERE i.order_id = o.id AND i.status NOT IN ('DRAFT', 'VOID'))
+ORDER BY o.reservation_expires_at ASC, o.id ASC
+LIMIT ?;
+
+-- name: ExpireOrderReservation :execresult
+-- 예약 만료 (PENDING/CONFIRMED → PAYMENT_EXPIRED, 그 사이 결제 시작·청구서 발행·취소된 주문은 영향 없음)
+UPDATE orders o
+SET o.status = 'PAYMENT_EXPIRED', o.status_changed_at = NOW(), o.updated_at = NOW()
+WHERE o.id = ? AND o.status IN ('PENDING', 'CONFIRMED')
+  AND NOT EXISTS (SELECT 1 FROM payments p WHERE p.order_id = o.id AND p.status IN ('AUTHORIZED', 'CAPTURED'))
+  AND NOT EXISTS (SELECT 1 FROM invoices i WHERE i.order_id = o.id AND i.status NOT IN ('DRAFT', 'VOID'));
+
+-- ============================================================================
+-- Dunning
+-- ============================================================================
+
+-- name: ListOrdersDueForDunning :many
+-- 독촉 단계 대상 (CONFIRMED + 기한 경과 + 같은/이후 단계 미실행)
+-- 이후 단계가 이미 실행된 주문은 앞 단계를 건너뜀 (배포 직후 오래된 주문에 리마인더 연속 발송 방지)
+SELECT o.* FROM orders o
+WHERE o.status = 'CONFIRMED'
+  AND COALESCE(o.payment_due_at, o.created_at) <= sqlc.arg('due_before')
+  AND NOT EXISTS (
+      SELECT 1 FROM order_dunning_steps s
+      WHERE s.order_id = o.id AND s.days_overdue >= sqlc.arg('days_overdue')
+  )
+ORDER BY o.id ASC
+LIMIT ?;
+
+-- name: CreateOrderDunningStep :exec
+-- 독촉 단계 실행 기록 (uk_order_dunning_step → 동시 실행 시 한쪽만 성공)
+INSERT INTO order_dunning_steps (order_id, days_overdue, action, event_id)
+VALUES (?, ?, ?, ?);
+
+-- name: ListOrdersByOrganization :many
+-- 조직이 구매자 또는 판매자인 주문 (최신순)
+SELECT * FROM orders
+WHERE buyer_organization_id = sqlc.arg('organization_id') OR seller_organization_id = sqlc.arg('organization_id')
+ORDER BY id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountOrdersByOrganization :one
+-- 조직이 구매자 또는 판매자인 주문 수
+SELECT COUNT(*) FROM orders
+WHERE buyer_organization_id = sqlc.arg('organization_id') OR seller_organization_id = sqlc.arg('organization_id');
+
+-- ============================================================================
+-- Order Creation
+-- ============================================================================
+
+-- name: CreateOrder :execresult
+-- 주문 생성 (발주 수락 시 발주 가격/결제 기한으로 전환)
+INSERT INTO orders (
+    order_number, buyer_id, seller_id, status, total_amount, token_symbol, payment_due_at
+) VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: ConfirmOrder :execresult
+-- 견적 기록 후 주문 확정 (PENDING → CONFIRMED)
+UPDATE orders
+SET status = 'CONFIRMED', status_changed_at = NOW(), updated_at = NOW()
+WHERE id = ? AND status = 'PENDING';
+
+-- name: CreateOrderItem :exec
+-- 주문 상품 (단가 고정)
+INSERT INTO order_items (order_id, product_id, quantity, unit_price)
+VALUES (?, ?, ?, ?);
+
+-- name: NextDocumentSequence :execresult
+-- 접두어·일자별 문서 번호 채번 (LastInsertId = 새 번호, 행 잠금으로 같은 일자 채번 직렬화)
+INSERT INTO document_sequences (prefix, seq_date, last_value)
+VALUES (?, ?, LAST_INSERT_ID(1))
+ON DUPLICATE KEY UPDATE last_value = LAST_INSERT_ID(last_value + 1);
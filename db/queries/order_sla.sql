@@ -0,0 +1,89 @@
+-- ============================================================================
+-- Order SLA Queries
+-- ============================================================================
+-- NOTE: 적용 SLA = 구매자별 정책 → 판매자 기본 정책 → 서버 설정 (항목별 COALESCE)
+
+-- name: CreateOrUpdateOrderSLAPolicy :exec
+-- 거래 관계별 정책 upsert (uk_order_sla_relationship)
+INSERT INTO order_sla_policies (external_id, seller_id, buyer_id, confirm_hours, fulfill_hours, auto_cancel)
+VALUES (?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    confirm_hours = VALUES(confirm_hours),
+    fulfill_hours = VALUES(fulfill_hours),
+    auto_cancel = VALUES(auto_cancel),
+    updated_at = NOW();
+
+-- name: GetOrderSLAPolicyByRelationship :one
+-- buyer_key 0 = 판매자 기본 정책
+SELECT * FROM order_sla_policies
+WHERE seller_id = ? AND buyer_key = ?;
+
+-- name: GetOrderSLAPolicyByExternalIDAndSeller :one
+-- 소유권 확인 포함 조회
+SELECT p.* FROM order_sla_policies p
+JOIN users s ON s.id = p.seller_id
+WHERE p.external_id = ? AND s.external_id = ?;
+
+-- name: ListOrderSLAPoliciesBySeller :many
+-- 판매자의 정책 목록 (기본 정책 먼저)
+SELECT p.*, b.external_id AS buyer_external_id
+FROM order_sla_policies p
+LEFT JOIN users b ON b.id = p.buyer_id
+WHERE p.seller_id = ?
+ORDER BY p.buyer_key ASC;
+
+-- name: DeleteOrderSLAPolicy :exec
+DELETE FROM order_sla_policies WHERE id = ?;
+
+-- name: ListOrdersBreachingConfirmSLA :many
+-- 확인 기한 초과 주문 (PENDING + 기한 경과 + 위반 처리 이력 없음)
+SELECT o.*,
+       CAST(COALESCE(pr.confirm_hours, pd.confirm_hours, CAST(sqlc.arg('default_hours') AS UNSIGNED)) AS UNSIGNED) AS sla_hours,
+       CAST(COALESCE(pr.auto_cancel, pd.auto_cancel, CAST(sqlc.arg('default_auto_cancel') AS UNSIGNED)) AS UNSIGNED) AS auto_cancel
+FROM orders o
+LEFT JOIN order_sla_policies pr ON pr.seller_id = o.seller_id AND pr.buyer_id = o.buyer_id
+LEFT JOIN order_sla_policies pd ON pd.seller_id = o.seller_id AND pd.buyer_id IS NULL
+WHERE o.status = 'PENDING'
+  AND COALESCE(pr.confirm_hours, pd.confirm_hours, CAST(sqlc.arg('default_hours') AS UNSIGNED)) > 0
+  AND o.status_changed_at <= DATE_SUB(sqlc.arg('now'), INTERVAL COALESCE(pr.confirm_hours, pd.confirm_hours, CAST(sqlc.arg('default_hours') AS UNSIGNED)) HOUR)
+  AND NOT EXISTS (
+      SELECT 1 FROM order_sla_breaches b
+      WHERE b.order_id = o.id AND b.stage = 'CONFIRM'
+  )
+ORDER BY o.id ASC
+LIMIT ?;
+
+-- name: ListOrdersBreachingFulfillSLA :many
+-- 출고 기한 초과 주문 (PAID + 기한 경과 + 위반 처리 이력 없음)
+SELECT o.*,
+       CAST(COALESCE(pr.fulfill_hours, pd.fulfill_hours, CAST(sqlc.arg('default_hours') AS UNSIGNED)) AS UNSIGNED) AS sla_hours,
+       CAST(COALESCE(pr.auto_cancel, pd.auto_cancel, CAST(sqlc.arg('default_auto_cancel') AS UNSIGNED)) AS UNSIGNED) AS auto_cancel
+FROM orders o
+LEFT JOIN order_sla_policies pr ON pr.seller_id = o.seller_id AND pr.buyer_id = o.buyer_id
+LEFT JOIN order_sla_policies pd ON pd.seller_id = o.seller_id AND pd.buyer_id IS NULL
+WHERE o.status = 'PAID'
+  AND COALESCE(pr.fulfill_hours, pd.fulfill_hours, CAST(sqlc.arg('default_hours') AS UNSIGNED)) > 0
+  AND o.status_changed_at <= DATE_SUB(sqlc.arg('now'), INTERVAL COALESCE(pr.fulfill_hours, pd.fulfill_hours, CAST(sqlc.arg('default_hours') AS UNSIGNED)) HOUR)
+  AND NOT EXISTS (
+      SELECT 1 FROM order_sla_breaches b
+      WHERE b.order_id = o.id AND b.stage = 'FULFILL'
+  )
+ORDER BY o.id ASC
+LIMIT ?;
+
+-- name: CreateOrderSLABreach :exec
+-- 위반 처리 기록 (uk_order_sla_breach → 동시 실행 시 한쪽만 성공)
+INSERT INTO order_sla_breaches (order_id, stage, deadline_at, action, event_id)
+VALUES (?, ?, ?, ?, ?);
+
+-- name: CancelPendingOrder :execresult
+-- 미확인 주문 취소 (PENDING → CANCELLED)
+UPDATE orders
+SET status = 'CANCELLED', status_changed_at = NOW(), updated_at = NOW()
+WHERE id = ? AND status = 'PENDING';
+
+-- name: RefundPaidOrder :execresult
+-- 미출고 결제 완료 주문 환불 처리 (PAID → REFUNDED)
+UPDATE orders
+SET status = 'REFUNDED', status_changed_at = NOW(), updated_at = NOW()
+WHERE id = ? AND status = 'PAID';
@@ -0,0 +1,75 @@
+-- ============================================================================
+-- Organization Queries
+-- ============================================================================
+-- NOTE: business_registration_number는 서비스 레이어에서 정규화 후 전달 (uk_organization_brn)
+
+-- name: CreateOrganization :execresult
+-- 조직 생성 (생성자는 OWNER 구성원으로 함께 등록)
+INSERT INTO organizations (
+    external_id, name, business_registration_number,
+    billing_email, billing_address, billing_contact_name, settlement_account_id, created_by
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetOrganizationByID :one
+-- ID로 조직 조회
+SELECT * FROM organizations WHERE id = ?;
+
+-- name: GetOrganizationByExternalID :one
+-- 외부 식별자로 조직 조회 (API 노출용)
+SELECT * FROM organizations WHERE external_id = ?;
+
+-- name: GetOrganizationForUpdate :one
+-- 조직 row-lock (구성원 변경 직렬화 - 마지막 OWNER 보호)
+SELECT * FROM organizations WHERE id = ? FOR UPDATE;
+
+-- name: UpdateOrganizationProfile :exec
+-- 회사명/청구 정보 수정 (사업자등록번호는 변경 불가)
+UPDATE organizations
+SET name = ?, billing_email = ?, billing_address = ?, billing_contact_name = ?
+WHERE id = ?;
+
+-- name: ListOrganizationsByUser :many
+-- 사용자가 구성원인 조직 목록 + 구성원 역할
+SELECT o.*, m.role AS member_role
+FROM organization_members m
+JOIN organizations o ON o.id = m.organization_id
+WHERE m.user_id = ?
+ORDER BY o.id;
+
+-- name: CreateOrganizationMember :exec
+-- 구성원 추가 (uk_organization_member → 중복 추가 시 중복 키 오류)
+INSERT INTO organization_members (organization_id, user_id, role)
+VALUES (?, ?, ?);
+
+-- name: GetOrganizationMember :one
+-- 조직의 구성원 조회 (접근 권한 확인)
+SELECT * FROM organization_members WHERE organization_id = ? AND user_id = ?;
+
+-- name: ListOrganizationMembers :many
+-- 조직 구성원 목록 + 사용자 정보
+SELECT m.id, m.organization_id, m.user_id, m.role, m.created_at,
+       u.external_id AS user_external_id, u.email AS user_email, u.name AS user_name
+FROM organization_members m
+JOIN users u ON u.id = m.user_id
+WHERE m.organization_id = ?
+ORDER BY m.id;
+
+-- name: ExistsOrganizationMemberByEmail :one
+-- 해당 이메일의 사용자가 이미 구성원인지 (초대 중복 방지)
+SELECT EXISTS(
+    SELECT 1 FROM organization_members m
+    JOIN users u ON u.id = m.user_id
+    WHERE m.organization_id = ? AND u.email = ?
+) AS member;
+
+-- name: UpdateOrganizationMemberRole :exec
+-- 구성원 역할 변경 (OWNER 전용)
+UPDATE organization_members SET role = ? WHERE organization_id = ? AND user_id = ?;
+
+-- name: DeleteOrganizationMember :execresult
+-- 구성원 제거
+DELETE FROM organization_members WHERE organization_id = ? AND user_id = ?;
+
+-- name: CountOrganizationOwners :one
+-- 조직의 OWNER 수 (마지막 OWNER 제거 방지, locking read → 트랜잭션 스냅샷이 아닌 최신 커밋 기준)
+SELECT COUNT(*) FROM organization_members WHERE organization_id = ? AND role = 'OWNER' FOR UPDATE;
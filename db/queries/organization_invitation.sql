@@ -0,0 +1,52 @@
+-- ============================================================================
+-- Organization Invitation Queries
+-- ============================================================================
+-- NOTE: token_hash는 초대 토큰의 SHA-256 (원문 미저장), 만료는 expires_at으로 판정 (상태 전이 없음)
+
+-- name: CreateOrganizationInvitation :execresult
+-- 이메일 초대 생성
+INSERT INTO organization_invitations (
+    external_id, organization_id, email, role, token_hash, invited_by, expires_at
+) VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetOrganizationInvitationByID :one
+-- ID로 초대 조회
+SELECT * FROM organization_invitations WHERE id = ?;
+
+-- name: GetOrganizationInvitation :one
+-- 조직의 초대 조회 (외부 식별자)
+SELECT * FROM organization_invitations WHERE organization_id = ? AND external_id = ?;
+
+-- name: GetOrganizationInvitationByTokenForUpdate :one
+-- 토큰으로 초대 조회 + row-lock (동시 수락/회수 직렬화)
+SELECT * FROM organization_invitations WHERE token_hash = ? FOR UPDATE;
+
+-- name: ExistsPendingOrganizationInvitation :one
+-- 같은 이메일로 유효한 대기 초대가 있는지
+SELECT EXISTS(
+    SELECT 1 FROM organization_invitations
+    WHERE organization_id = ? AND email = ? AND status = 'PENDING' AND expires_at > ?
+) AS pending;
+
+-- name: ListOrganizationInvitations :many
+-- 조직의 초대 목록 (최신순)
+SELECT * FROM organization_invitations
+WHERE organization_id = ?
+ORDER BY id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountOrganizationInvitations :one
+-- 조직의 초대 수
+SELECT COUNT(*) FROM organization_invitations WHERE organization_id = ?;
+
+-- name: AcceptOrganizationInvitation :execresult
+-- 초대 수락 (PENDING만)
+UPDATE organization_invitations
+SET status = 'ACCEPTED', accepted_by = ?, accepted_at = NOW()
+WHERE id = ? AND status = 'PENDING';
+
+-- name: RevokeOrganizationInvitation :execresult
+-- 초대 회수 (PENDING만)
+UPDATE organization_invitations
+SET status = 'REVOKED', revoked_at = NOW()
+WHERE id = ? AND status = 'PENDING';
@@ -0,0 +1,65 @@
+-- ============================================================================
+-- Outbox Queries
+-- ============================================================================
+-- NOTE: 이벤트 기록은 반드시 상태 변경과 같은 트랜잭션(tx-bound Queries)에서 호출
+-- NOTE: claim은 FOR UPDATE SKIP LOCKED → 여러 인스턴스의 relay가 동시에 실행 가능
+
+-- name: CreateOutboxEvent :exec
+-- 도메인 이벤트 기록 (payload는 이벤트 envelope JSON)
+INSERT INTO outbox (event_id, event_type, aggregate_type, aggregate_id, aggregate_external_id, recipient_user_id, payload)
+VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListDueOutboxEventsForUpdate :many
+-- 발행 대상 claim (PENDING/FAILED 또는 lease 만료된 PROCESSING, 기록 순서대로)
+SELECT * FROM outbox
+WHERE status IN ('PENDING', 'PROCESSING', 'FAILED')
+  AND (next_retry_at IS NULL OR next_retry_at <= NOW())
+ORDER BY id ASC
+LIMIT ?
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkOutboxEventProcessing :exec
+-- 발행 시작 (lease 설정 - 프로세스 중단 시 lease 만료 후 재claim)
+UPDATE outbox
+SET status = 'PROCESSING', next_retry_at = ?, updated_at = NOW()
+WHERE id = ?;
+
+-- name: MarkOutboxEventCompleted :exec
+-- 발행 완료
+UPDATE outbox
+SET status = 'COMPLETED', error_message = NULL, updated_at = NOW()
+WHERE id = ?;
+
+-- name: MarkOutboxEventFailed :exec
+-- 발행 실패 - 재시도 예약 (backoff은 relay에서 계산)
+UPDATE outbox
+SET status = 'FAILED', retry_count = retry_count + 1, next_retry_at = ?, error_message = ?, updated_at = NOW()
+WHERE id = ?;
+
+-- name: MarkOutboxEventDeadLetter :exec
+-- 재시도 한도 초과 → DEAD_LETTER (수동 확인 필요)
+UPDATE outbox
+SET status = 'DEAD_LETTER', retry_count = retry_count + 1, error_message = ?, updated_at = NOW()
+WHERE id = ?;
+
+-- name: SearchOutboxEventsByRecipient :many
+-- 수신자의 이벤트 로그 검색 (type/resource/시간 범위 필터 옵션, 최신순, 페이징)
+SELECT * FROM outbox
+WHERE recipient_user_id = sqlc.arg('recipient_user_id')
+  AND (sqlc.narg('event_type') IS NULL OR event_type = sqlc.narg('event_type'))
+  AND (sqlc.narg('aggregate_type') IS NULL OR aggregate_type = sqlc.narg('aggregate_type'))
+  AND (sqlc.narg('aggregate_external_id') IS NULL OR aggregate_external_id = sqlc.narg('aggregate_external_id'))
+  AND (sqlc.narg('created_from') IS NULL OR created_at >= sqlc.narg('created_from'))
+  AND (sqlc.narg('created_to') IS NULL OR created_at < sqlc.narg('created_to'))
+ORDER BY created_at DESC, id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountOutboxEventsByRecipient :one
+-- 수신자의 이벤트 수 (페이징용)
+SELECT COUNT(*) as total FROM outbox
+WHERE recipient_user_id = sqlc.arg('recipient_user_id')
+  AND (sqlc.narg('event_type') IS NULL OR event_type = sqlc.narg('event_type'))
+  AND (sqlc.narg('aggregate_type') IS NULL OR aggregate_type = sqlc.narg('aggregate_type'))
+  AND (sqlc.narg('aggregate_external_id') IS NULL OR aggregate_external_id = sqlc.narg('aggregate_external_id'))
+  AND (sqlc.narg('created_from') IS NULL OR created_at >= sqlc.narg('created_from'))
+  AND (sqlc.narg('created_to') IS NULL OR created_at < sqlc.narg('created_to'));
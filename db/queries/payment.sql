@@ -0,0 +1,85 @@
+-- ============================================================================
+-- Payment Queries
+-- ============================================================================
+
+-- name: GetPaymentByExternalID :one
+-- 외부 식별자로 결제 조회
+SELECT * FROM payments WHERE external_id = ?;
+
+-- name: CountSettledPaymentsByOrder :one
+-- 정산 레코드가 생성된 결제 수 (판매자 지급 이후 환불은 회수 절차 필요)
+SELECT COUNT(*) FROM payments p
+WHERE p.order_id = ?
+  AND EXISTS (SELECT 1 FROM settlements s WHERE s.payment_id = p.id);
+
+-- name: VoidAuthorizedPaymentsByOrder :execresult
+-- 주문 취소 시 미확정 결제 승인 해제 (AUTHORIZED → VOIDED)
+UPDATE payments
+SET status = 'VOIDED', updated_at = NOW()
+WHERE order_id = ? AND status = 'AUTHORIZED';
+
+-- name: RefundCapturedPaymentsByOrder :execresult
+-- 주문 환불 시 확정 결제 환불 처리 (CAPTURED → REFUNDED)
+UPDATE payments
+SET status = 'REFUNDED', updated_at = NOW()
+WHERE order_id = ? AND status = 'CAPTURED';
+
+-- name: GetPaymentDetailByExternalID :one
+-- 결제 상세 (주문 번호 + 구매자/판매자 외부 식별자 - 조회 권한 확인용)
+SELECT p.*, o.order_number,
+       b.external_id AS buyer_external_id, s.external_id AS seller_external_id
+FROM payments p
+JOIN orders o ON o.id = p.order_id
+JOIN users b ON b.id = o.buyer_id
+JOIN users s ON s.id = o.seller_id
+WHERE p.external_id = ?;
+
+-- name: GetPaymentForUpdate :one
+-- 트랜잭션 내 row-lock (환불 가능 잔액 검증 동시성 제어)
+SELECT * FROM payments WHERE id = ? FOR UPDATE;
+
+-- name: MarkPaymentRefunded :exec
+-- 전액 환불 완료 (CAPTURED → REFUNDED)
+UPDATE payments
+SET status = 'REFUNDED', updated_at = NOW()
+WHERE id = ? AND status = 'CAPTURED';
+
+-- name: ListExpiredAuthorizedPayments :many
+-- 승인 만료 결제 (AUTHORIZED + expires_at 경과, 만료 순 - 만료 스윕 배치)
+SELECT p.id, p.external_id, p.order_id, p.amount, p.token_symbol, p.expires_at,
+       o.order_number, o.buyer_id, o.seller_id
+FROM payments p
+JOIN orders o ON o.id = p.order_id
+WHERE p.status = 'AUTHORIZED' AND p.expires_at <= sqlc.arg('now')
+ORDER BY p.expires_at ASC, p.id ASC
+LIMIT ?;
+
+-- name: ExpireAuthorizedPayment :execresult
+-- 승인 만료 (AUTHORIZED → EXPIRED, 그 사이 확정/해제된 결제는 영향 없음)
+UPDATE payments
+SET status = 'EXPIRED', updated_at = NOW()
+WHERE id = ? AND status = 'AUTHORIZED';
+
+-- name: ListPaymentsForExport :many
+-- 결제 내보내기 (구매자/판매자로 참여한 결제, 생성 시각 [from, to), id 커서 청크)
+-- 환불 합계는 FAILED 제외, 수수료는 부과 - 취소 합계
+SELECT p.id, p.external_id, o.order_number, p.status, p.amount, p.token_symbol,
+       CAST(COALESCE((
+           SELECT SUM(r.amount) FROM payment_refunds r
+           WHERE r.payment_id = p.id AND r.status != 'FAILED'
+       ), 0) AS DECIMAL(18,8)) AS refunded_amount,
+       CAST(COALESCE((
+           SELECT SUM(CASE WHEN f.entry_type = 'CHARGE' THEN f.amount ELSE -f.amount END) FROM fee_ledger_entries f
+           WHERE f.payment_id = p.id
+       ), 0) AS DECIMAL(18,8)) AS fee_amount,
+       p.authorized_at, p.captured_at, p.created_at,
+       b.external_id AS buyer_external_id, s.external_id AS seller_external_id
+FROM payments p
+JOIN orders o ON o.id = p.order_id
+JOIN users b ON b.id = o.buyer_id
+JOIN users s ON s.id = o.seller_id
+WHERE (o.buyer_id = sqlc.arg('user_id') OR o.seller_id = sqlc.arg('user_id'))
+  AND p.created_at >= sqlc.arg('from') AND p.created_at < sqlc.arg('to')
+  AND p.id > sqlc.arg('after_id')
+ORDER BY p.id ASC
+LIMIT ?;
@@ -0,0 +1,46 @@
+-- ============================================================================
+-- Payout Address Whitelist Queries
+-- ============================================================================
+-- NOTE: address는 lower-case로 저장/조회
+-- NOTE: 지급 가능 = 해제되지 않음 + 승인됨 + active_after 경과
+
+-- name: CreatePayoutAddress :execresult
+-- 화이트리스트 주소 등록 (승인 대기, 사용자/체인별 활성 주소 1건 - uk_payout_address_user_active)
+INSERT INTO payout_addresses (external_id, user_id, address, chain_id, label)
+VALUES (?, ?, ?, ?, ?);
+
+-- name: GetPayoutAddressByID :one
+-- ID로 조회 (내부 전용)
+SELECT * FROM payout_addresses WHERE id = ?;
+
+-- name: GetPayoutAddressByExternalIDAndUser :one
+-- 외부 식별자 + 사용자 소유권 검증 조회
+SELECT p.* FROM payout_addresses p
+JOIN users u ON p.user_id = u.id
+WHERE p.external_id = ? AND u.external_id = ?;
+
+-- name: ListPayoutAddressesByUser :many
+-- 사용자의 화이트리스트 (해제 제외, 최신순)
+SELECT * FROM payout_addresses
+WHERE user_id = ? AND revoked_at IS NULL
+ORDER BY created_at DESC, id DESC;
+
+-- name: ConfirmPayoutAddress :execrows
+-- 승인 서명 반영 + 활성화 대기 시작 (승인 대기 + 미해제 건만)
+UPDATE payout_addresses
+SET approver_wallet_id = ?, confirmed_at = ?, active_after = ?, updated_at = NOW()
+WHERE id = ? AND confirmed_at IS NULL AND revoked_at IS NULL;
+
+-- name: RevokePayoutAddress :execrows
+-- 화이트리스트 해제 (미해제 건만)
+UPDATE payout_addresses
+SET revoked_at = NOW(), updated_at = NOW()
+WHERE id = ? AND revoked_at IS NULL;
+
+-- name: ExistsActivePayoutAddress :one
+-- 지급 대상 가능 여부 (지급 실행 전 검사)
+SELECT EXISTS(
+    SELECT 1 FROM payout_addresses
+    WHERE user_id = ? AND chain_id = ? AND address = ?
+      AND revoked_at IS NULL AND confirmed_at IS NOT NULL AND active_after <= sqlc.arg('now')
+) AS active;
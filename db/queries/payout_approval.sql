@@ -0,0 +1,63 @@
+-- ============================================================================
+-- Payout Approval Queries
+-- ============================================================================
+-- NOTE: 승인 레코드는 기준 금액 이상 지급(정산 배치/순지급)에만 생성, 지급 대상 조회는 APPROVED가 아닌 승인이 있으면 제외
+
+-- name: CreatePayoutApproval :execresult
+-- 고액 지급 승인 요청 생성 (지급 생성 트랜잭션 내)
+INSERT INTO payout_approvals (
+    external_id, payout_type, payout_id, payee_account_id, amount, requested_by
+) VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: GetPayoutApprovalForUpdate :one
+-- 승인 요청 row-lock (동시 승인/거절 직렬화)
+SELECT * FROM payout_approvals WHERE external_id = ? FOR UPDATE;
+
+-- name: GetPayoutApprovalDetail :one
+-- 승인 요청 상세 (지급/수취 계정/승인자 외부 식별자)
+SELECT pa.*, a.external_id AS payee_account_external_id,
+       b.external_id AS batch_external_id, n.external_id AS net_payout_external_id,
+       ru.external_id AS requested_by_external_id, fu.external_id AS first_approved_by_external_id,
+       du.external_id AS decided_by_external_id
+FROM payout_approvals pa
+JOIN accounts a ON a.id = pa.payee_account_id
+LEFT JOIN settlement_batches b ON pa.payout_type = 'SETTLEMENT_BATCH' AND b.id = pa.payout_id
+LEFT JOIN settlement_net_payouts n ON pa.payout_type = 'NET_PAYOUT' AND n.id = pa.payout_id
+LEFT JOIN users ru ON ru.id = pa.requested_by
+LEFT JOIN users fu ON fu.id = pa.first_approved_by
+LEFT JOIN users du ON du.id = pa.decided_by
+WHERE pa.id = ?;
+
+-- name: ListPayoutApprovals :many
+-- 승인 요청 목록 (상태 필터, 최신순)
+SELECT pa.*, a.external_id AS payee_account_external_id,
+       b.external_id AS batch_external_id, n.external_id AS net_payout_external_id,
+       ru.external_id AS requested_by_external_id, fu.external_id AS first_approved_by_external_id,
+       du.external_id AS decided_by_external_id
+FROM payout_approvals pa
+JOIN accounts a ON a.id = pa.payee_account_id
+LEFT JOIN settlement_batches b ON pa.payout_type = 'SETTLEMENT_BATCH' AND b.id = pa.payout_id
+LEFT JOIN settlement_net_payouts n ON pa.payout_type = 'NET_PAYOUT' AND n.id = pa.payout_id
+LEFT JOIN users ru ON ru.id = pa.requested_by
+LEFT JOIN users fu ON fu.id = pa.first_approved_by
+LEFT JOIN users du ON du.id = pa.decided_by
+WHERE (sqlc.narg('status') IS NULL OR pa.status = sqlc.narg('status'))
+ORDER BY pa.id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountPayoutApprovals :one
+-- 승인 요청 수 (상태 필터)
+SELECT COUNT(*) FROM payout_approvals
+WHERE (sqlc.narg('status') IS NULL OR status = sqlc.narg('status'));
+
+-- name: RecordPayoutFirstApproval :exec
+-- maker가 없는 지급의 첫 승인 기록 (최종 승인은 다른 사용자)
+UPDATE payout_approvals
+SET first_approved_by = ?, first_approved_at = NOW(), updated_at = NOW()
+WHERE id = ? AND status = 'PENDING' AND first_approved_by IS NULL;
+
+-- name: DecidePayoutApproval :exec
+-- 최종 승인/거절 (PENDING만)
+UPDATE payout_approvals
+SET status = ?, decided_by = ?, decided_at = NOW(), reject_reason = ?, updated_at = NOW()
+WHERE id = ? AND status = 'PENDING';
@@ -21,3 +21,71 @@ WHERE id = ?;
 
 -- name: DeleteProduct :exec
 UPDATE products SET status = 'INACTIVE' WHERE id = ?;
+
+-- ============================================================================
+-- Product Variants
+-- ============================================================================
+
+-- name: CreateProductVariant :execresult
+-- 변형 상품 생성 (기준 상품의 카테고리 상속)
+INSERT INTO products (parent_id, sku, name, category, price, status, variant_size, variant_grade, variant_packaging)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: CountProductVariants :one
+-- 변형 수 (0보다 크면 기준 상품은 직접 주문·재고 관리 불가)
+SELECT COUNT(*) FROM products WHERE parent_id = ?;
+
+-- name: ListProductVariantMatrix :many
+-- 기준 상품의 변형별 재고 합계 (재고 행 없음 = 재고 미관리, locations = 0)
+SELECT p.*,
+       CAST(COALESCE(SUM(i.quantity), 0) AS SIGNED) AS stock_quantity,
+       CAST(COALESCE(SUM(i.reserved_quantity), 0) AS SIGNED) AS stock_reserved,
+       COUNT(i.id) AS stock_locations
+FROM products p
+LEFT JOIN inventories i ON i.product_id = p.id
+WHERE p.parent_id = ?
+GROUP BY p.id
+ORDER BY p.variant_size ASC, p.variant_grade ASC, p.variant_packaging ASC, p.id ASC;
+
+-- ============================================================================
+-- Catalog Browsing
+-- ============================================================================
+
+-- name: ListCatalogProducts :many
+-- 카탈로그 상품 목록 (변형 제외, 변형 수 포함)
+-- category_id 지정 시 해당 카테고리 + max_depth 단계 이내 하위 카테고리의 상품
+SELECT p.*,
+       (SELECT COUNT(*) FROM products v WHERE v.parent_id = p.id) AS variant_count
+FROM products p
+WHERE p.parent_id IS NULL
+  AND p.status = COALESCE(sqlc.narg('status'), p.status)
+  AND (sqlc.narg('category_id') IS NULL OR p.category IN (
+      SELECT c.code
+      FROM category_paths cp
+      JOIN categories c ON c.id = cp.descendant_id
+      WHERE cp.ancestor_id = sqlc.narg('category_id') AND cp.depth <= sqlc.arg('max_depth')
+  ))
+ORDER BY p.name ASC, p.id ASC
+LIMIT ? OFFSET ?;
+
+-- name: CountCatalogProducts :one
+SELECT COUNT(*)
+FROM products p
+WHERE p.parent_id IS NULL
+  AND p.status = COALESCE(sqlc.narg('status'), p.status)
+  AND (sqlc.narg('category_id') IS NULL OR p.category IN (
+      SELECT c.code
+      FROM category_paths cp
+      JOIN categories c ON c.id = cp.descendant_id
+      WHERE cp.ancestor_id = sqlc.narg('category_id') AND cp.depth <= sqlc.arg('max_depth')
+  ));
+
+-- ============================================================================
+-- Reorder Thresholds
+-- ============================================================================
+
+-- name: UpdateProductReorderThreshold :exec
+-- 재주문 기준 수량 설정 (NULL = 알림 해제)
+UPDATE products
+SET reorder_threshold = ?, updated_at = NOW()
+WHERE id = ?;
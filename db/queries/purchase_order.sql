@@ -0,0 +1,74 @@
+-- ============================================================================
+-- Purchase Order Queries
+-- ============================================================================
+-- NOTE: 항목은 현재 조건만 보관 (역제안/재제안 시 삭제 후 재생성, 이전 조건은 감사 로그)
+
+-- name: CreatePurchaseOrder :execresult
+-- 발주 생성 (SUBMITTED, 판매자 응답 대기)
+INSERT INTO purchase_orders (
+    external_id, po_number, buyer_id, seller_id, token_symbol, total_amount, payment_terms_days, notes, coupon_code
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: CreatePurchaseOrderItem :exec
+-- 발주 항목 (현재 조건)
+INSERT INTO purchase_order_items (purchase_order_id, line_no, product_id, quantity, unit_price)
+VALUES (?, ?, ?, ?, ?);
+
+-- name: DeletePurchaseOrderItems :exec
+-- 조건 변경 시 기존 항목 삭제 (새 조건으로 교체)
+DELETE FROM purchase_order_items WHERE purchase_order_id = ?;
+
+-- name: GetPurchaseOrderForUpdate :one
+-- 발주 row-lock (응답/취소 동시 요청 직렬화)
+SELECT * FROM purchase_orders WHERE external_id = ? FOR UPDATE;
+
+-- name: GetPurchaseOrderDetail :one
+-- 발주 상세 + 구매자/판매자 + 전환된 주문 번호 (조회 권한 확인용)
+SELECT po.*, b.external_id AS buyer_external_id, b.name AS buyer_name, b.email AS buyer_email,
+       s.external_id AS seller_external_id, s.name AS seller_name, s.email AS seller_email,
+       o.order_number
+FROM purchase_orders po
+JOIN users b ON b.id = po.buyer_id
+JOIN users s ON s.id = po.seller_id
+LEFT JOIN orders o ON o.id = po.order_id
+WHERE po.external_id = ?;
+
+-- name: ListPurchaseOrdersByUser :many
+-- 사용자가 구매자 또는 판매자인 발주 (상태 필터, 최신순)
+SELECT po.*, b.external_id AS buyer_external_id, b.name AS buyer_name, b.email AS buyer_email,
+       s.external_id AS seller_external_id, s.name AS seller_name, s.email AS seller_email,
+       o.order_number
+FROM purchase_orders po
+JOIN users b ON b.id = po.buyer_id
+JOIN users s ON s.id = po.seller_id
+LEFT JOIN orders o ON o.id = po.order_id
+WHERE (po.buyer_id = sqlc.arg('user_id') OR po.seller_id = sqlc.arg('user_id'))
+  AND (sqlc.narg('status') IS NULL OR po.status = sqlc.narg('status'))
+ORDER BY po.id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountPurchaseOrdersByUser :one
+-- 사용자가 구매자 또는 판매자인 발주 수 (상태 필터)
+SELECT COUNT(*) FROM purchase_orders
+WHERE (buyer_id = sqlc.arg('user_id') OR seller_id = sqlc.arg('user_id'))
+  AND (sqlc.narg('status') IS NULL OR status = sqlc.narg('status'));
+
+-- name: ListPurchaseOrderItems :many
+-- 발주 항목 + 상품 SKU/이름 (라인 순)
+SELECT i.*, p.sku, p.name AS product_name
+FROM purchase_order_items i
+JOIN products p ON p.id = i.product_id
+WHERE i.purchase_order_id = ?
+ORDER BY i.line_no ASC;
+
+-- name: UpdatePurchaseOrderTerms :exec
+-- 역제안/재제안: 조건 교체 + 응답 대기 측 전환 (revision +1)
+UPDATE purchase_orders
+SET status = ?, revision = revision + 1, total_amount = ?, payment_terms_days = ?, updated_at = NOW()
+WHERE id = ?;
+
+-- name: DecidePurchaseOrder :exec
+-- 수락(주문 전환)/거절/취소 기록
+UPDATE purchase_orders
+SET status = ?, order_id = ?, reject_reason = ?, decided_at = NOW(), updated_at = NOW()
+WHERE id = ?;
@@ -0,0 +1,30 @@
+-- ============================================================================
+-- Treasury Reconciliation Queries
+-- ============================================================================
+-- NOTE: treasury_reconciliations는 불일치가 관측된 회차만 기록 (append-only)
+
+-- name: GetActiveSystemWalletByType :one
+-- 유형별 활성 시스템 지갑 (TREASURY 주소 조회)
+SELECT * FROM system_wallets
+WHERE wallet_type = ? AND is_active = TRUE;
+
+-- name: CreateTreasuryReconciliation :exec
+-- 대사 불일치 기록
+INSERT INTO treasury_reconciliations (
+    external_id, account_id, address, token_symbol, ledger_balance, onchain_balance,
+    drift, tolerance, exceeds_tolerance, block_number
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListTreasuryReconciliations :many
+-- 대사 불일치 목록 (허용치 초과 필터, 최신순)
+SELECT r.*, a.external_id AS account_external_id
+FROM treasury_reconciliations r
+JOIN accounts a ON a.id = r.account_id
+WHERE (sqlc.narg('exceeds_tolerance') IS NULL OR r.exceeds_tolerance = sqlc.narg('exceeds_tolerance'))
+ORDER BY r.id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountTreasuryReconciliations :one
+-- 대사 불일치 수 (허용치 초과 필터)
+SELECT COUNT(*) FROM treasury_reconciliations
+WHERE (sqlc.narg('exceeds_tolerance') IS NULL OR exceeds_tolerance = sqlc.narg('exceeds_tolerance'));
@@ -0,0 +1,44 @@
+-- ============================================================================
+-- Payment Refund Queries
+-- ============================================================================
+-- NOTE: 환불 가능 잔액 = 결제 금액 - 환불 합계 (FAILED 제외) - 결제 row-lock(GetPaymentForUpdate) 하에서 계산
+
+-- name: CreatePaymentRefund :execresult
+-- 환불 기록
+INSERT INTO payment_refunds (external_id, payment_id, amount, reason, method, status, to_address, created_by, completed_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetPaymentRefundByID :one
+-- ID로 환불 조회 (내부 전용)
+SELECT * FROM payment_refunds WHERE id = ?;
+
+-- name: ListPaymentRefundsByPayment :many
+-- 결제의 환불 목록 (요청순)
+SELECT * FROM payment_refunds
+WHERE payment_id = ?
+ORDER BY id ASC;
+
+-- name: GetLatestPaymentRefundUpdatedAt :one
+-- 결제 환불의 최근 변경 시각 (결제 조회 ETag/Last-Modified, 환불 없으면 no rows)
+SELECT updated_at FROM payment_refunds
+WHERE payment_id = ?
+ORDER BY updated_at DESC
+LIMIT 1;
+
+-- name: GetPaymentRefundedAmount :one
+-- 결제의 환불 합계 (FAILED 제외), DECIMAL 문자열로 반환
+SELECT CAST(COALESCE(SUM(amount), 0) AS DECIMAL(18,8)) AS refunded
+FROM payment_refunds
+WHERE payment_id = ? AND status != 'FAILED';
+
+-- name: UpdatePaymentRefundTxHash :exec
+-- 반환 송금 tx hash 기록 (브로드캐스트 후, 교체 송금이 포함되면 갱신)
+UPDATE payment_refunds
+SET tx_hash = ?, updated_at = NOW()
+WHERE id = ?;
+
+-- name: CompletePaymentRefund :execrows
+-- 반환 송금 결과 반영 (PENDING → SUCCEEDED/FAILED/RETURN_FAILED)
+UPDATE payment_refunds
+SET status = ?, completed_at = ?, updated_at = NOW()
+WHERE id = ? AND status = 'PENDING';
@@ -0,0 +1,98 @@
+-- ============================================================================
+-- Data Retention Queries
+-- ============================================================================
+-- NOTE: Purge 쿼리는 LIMIT 단위로 반복 호출 (대량 DELETE로 인한 장시간 락 방지)
+-- NOTE: 진행 중인 행(PENDING/DELIVERING, PENDING/PROCESSING/FAILED)은 보존 기한과 무관하게 삭제 금지
+-- NOTE: 활성 legal hold 대상(USER/ORDER)과 연결된 행은 hold 해제 전까지 삭제 금지
+
+-- name: PurgeAuditLogsBefore :execresult
+-- 보존 기한이 지난 감사 로그 삭제 (hold 대상 리소스/행위자 제외)
+DELETE FROM audit_logs
+WHERE audit_logs.created_at < ?
+  AND NOT EXISTS (
+    SELECT 1 FROM legal_holds h
+    WHERE h.released_at IS NULL
+      AND ((h.subject_type = audit_logs.resource_type AND h.subject_id = audit_logs.resource_id)
+        OR (h.subject_type = 'USER' AND audit_logs.actor_type = 'USER' AND h.subject_id = audit_logs.actor_id))
+  )
+ORDER BY id ASC
+LIMIT ?;
+
+-- name: CountAuditLogsBefore :one
+-- 삭제 대상 감사 로그 수 (dry-run)
+SELECT COUNT(*) as total FROM audit_logs
+WHERE audit_logs.created_at < ?
+  AND NOT EXISTS (
+    SELECT 1 FROM legal_holds h
+    WHERE h.released_at IS NULL
+      AND ((h.subject_type = audit_logs.resource_type AND h.subject_id = audit_logs.resource_id)
+        OR (h.subject_type = 'USER' AND audit_logs.actor_type = 'USER' AND h.subject_id = audit_logs.actor_id))
+  );
+
+-- name: PurgeWebhookDeliveriesBefore :execresult
+-- 보존 기한이 지난 웹훅 전송 건(payload 포함) 삭제 - 종료 상태만
+DELETE FROM webhook_deliveries
+WHERE webhook_deliveries.status IN ('SUCCEEDED', 'DEAD') AND webhook_deliveries.created_at < ?
+  AND NOT EXISTS (
+    SELECT 1 FROM webhook_endpoints e
+    JOIN legal_holds h ON h.subject_type = 'USER' AND h.subject_id = e.user_id AND h.released_at IS NULL
+    WHERE e.id = webhook_deliveries.endpoint_id
+  )
+ORDER BY id ASC
+LIMIT ?;
+
+-- name: CountWebhookDeliveriesBefore :one
+-- 삭제 대상 웹훅 전송 건 수 (dry-run)
+SELECT COUNT(*) as total FROM webhook_deliveries
+WHERE webhook_deliveries.status IN ('SUCCEEDED', 'DEAD') AND webhook_deliveries.created_at < ?
+  AND NOT EXISTS (
+    SELECT 1 FROM webhook_endpoints e
+    JOIN legal_holds h ON h.subject_type = 'USER' AND h.subject_id = e.user_id AND h.released_at IS NULL
+    WHERE e.id = webhook_deliveries.endpoint_id
+  );
+
+-- name: PurgeOutboxEventsBefore :execresult
+-- 보존 기한이 지난 outbox 이벤트 삭제 - 종료 상태만
+DELETE FROM outbox
+WHERE outbox.status IN ('COMPLETED', 'DEAD_LETTER') AND outbox.created_at < ?
+  AND NOT EXISTS (
+    SELECT 1 FROM legal_holds h
+    WHERE h.released_at IS NULL
+      AND ((h.subject_type = outbox.aggregate_type AND h.subject_id = outbox.aggregate_id)
+        OR (h.subject_type = 'USER' AND h.subject_id = outbox.recipient_user_id))
+  )
+ORDER BY id ASC
+LIMIT ?;
+
+-- name: CountOutboxEventsBefore :one
+-- 삭제 대상 outbox 이벤트 수 (dry-run)
+SELECT COUNT(*) as total FROM outbox
+WHERE outbox.status IN ('COMPLETED', 'DEAD_LETTER') AND outbox.created_at < ?
+  AND NOT EXISTS (
+    SELECT 1 FROM legal_holds h
+    WHERE h.released_at IS NULL
+      AND ((h.subject_type = outbox.aggregate_type AND h.subject_id = outbox.aggregate_id)
+        OR (h.subject_type = 'USER' AND h.subject_id = outbox.recipient_user_id))
+  );
+
+-- ============================================================================
+-- Retention Runs
+-- ============================================================================
+
+-- name: CreateRetentionRun :exec
+-- 실행 기록 (external_id는 서비스 레이어에서 생성)
+INSERT INTO retention_runs (
+    external_id, data_class, retention_seconds, cutoff, deleted_count,
+    status, error_message, trigger_type, triggered_by, started_at, finished_at
+)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListRetentionRuns :many
+-- 최근 실행 기록 (최신순)
+SELECT * FROM retention_runs
+ORDER BY started_at DESC, id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountRetentionRuns :one
+-- 실행 기록 수 (페이지네이션)
+SELECT COUNT(*) as total FROM retention_runs;
@@ -0,0 +1,126 @@
+-- ============================================================================
+-- RFQ Queries
+-- ============================================================================
+-- NOTE: 판매자는 초대된(rfq_sellers) 요청만 조회/견적 제출 가능
+
+-- name: CreateRFQ :execresult
+-- 견적 요청 생성 (OPEN)
+INSERT INTO rfqs (external_id, rfq_number, buyer_id, token_symbol, notes, respond_by)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: CreateRFQItem :exec
+-- 견적 요청 항목 (상품·수량)
+INSERT INTO rfq_items (rfq_id, line_no, product_id, quantity)
+VALUES (?, ?, ?, ?);
+
+-- name: AddRFQSeller :exec
+-- 견적 요청 대상 판매자
+INSERT INTO rfq_sellers (rfq_id, seller_id) VALUES (?, ?);
+
+-- name: GetRFQDetail :one
+-- 견적 요청 상세 + 구매자 + 전환된 주문 번호
+SELECT r.*, b.external_id AS buyer_external_id, b.name AS buyer_name, o.order_number
+FROM rfqs r
+JOIN users b ON b.id = r.buyer_id
+LEFT JOIN orders o ON o.id = r.order_id
+WHERE r.external_id = ?;
+
+-- name: GetRFQForUpdate :one
+-- 견적 요청 row-lock (견적 제출/수락/취소 직렬화)
+SELECT * FROM rfqs WHERE id = ? FOR UPDATE;
+
+-- name: ListRFQItems :many
+-- 견적 요청 항목 + 상품 SKU/이름 (라인 순)
+SELECT i.*, p.sku, p.name AS product_name
+FROM rfq_items i
+JOIN products p ON p.id = i.product_id
+WHERE i.rfq_id = ?
+ORDER BY i.line_no ASC;
+
+-- name: ListRFQSellers :many
+-- 견적 요청 대상 판매자
+SELECT s.seller_id, u.external_id AS seller_external_id, u.name AS seller_name
+FROM rfq_sellers s
+JOIN users u ON u.id = s.seller_id
+WHERE s.rfq_id = ?
+ORDER BY s.seller_id ASC;
+
+-- name: ListRFQsByUser :many
+-- 사용자가 요청했거나 대상 판매자인 견적 요청 (상태 필터, 최신순)
+SELECT r.*, b.external_id AS buyer_external_id, b.name AS buyer_name, o.order_number
+FROM rfqs r
+JOIN users b ON b.id = r.buyer_id
+LEFT JOIN orders o ON o.id = r.order_id
+WHERE (r.buyer_id = sqlc.arg('user_id')
+       OR EXISTS (SELECT 1 FROM rfq_sellers s WHERE s.rfq_id = r.id AND s.seller_id = sqlc.arg('user_id')))
+  AND (sqlc.narg('status') IS NULL OR r.status = sqlc.narg('status'))
+ORDER BY r.id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountRFQsByUser :one
+-- 사용자가 요청했거나 대상 판매자인 견적 요청 수 (상태 필터)
+SELECT COUNT(*) FROM rfqs r
+WHERE (r.buyer_id = sqlc.arg('user_id')
+       OR EXISTS (SELECT 1 FROM rfq_sellers s WHERE s.rfq_id = r.id AND s.seller_id = sqlc.arg('user_id')))
+  AND (sqlc.narg('status') IS NULL OR r.status = sqlc.narg('status'));
+
+-- name: CloseRFQ :exec
+-- 견적 수락(AWARDED, 주문 전환) 또는 취소
+UPDATE rfqs
+SET status = ?, awarded_quote_id = ?, order_id = ?, closed_at = NOW(), updated_at = NOW()
+WHERE id = ?;
+
+-- ============================================================================
+-- RFQ Quotes
+-- ============================================================================
+
+-- name: CreateRFQQuote :execresult
+-- 판매자 견적 제출 (ACTIVE)
+INSERT INTO rfq_quotes (
+    external_id, rfq_id, seller_id, price_currency, price_amount, total_amount,
+    fx_rate, fx_rate_source, fx_rate_at, quoted_at, expires_at, notes
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: ReviseRFQQuote :exec
+-- 견적 재제출: 가격/환율/유효기간 교체 (revision +1, 철회된 견적도 다시 ACTIVE)
+UPDATE rfq_quotes
+SET status = 'ACTIVE', revision = revision + 1, price_currency = ?, price_amount = ?, total_amount = ?,
+    fx_rate = ?, fx_rate_source = ?, fx_rate_at = ?, quoted_at = ?, expires_at = ?, notes = ?, updated_at = NOW()
+WHERE id = ?;
+
+-- name: CreateRFQQuoteItem :exec
+-- 견적 항목 단가 (견적 통화 기준)
+INSERT INTO rfq_quote_items (quote_id, line_no, unit_price) VALUES (?, ?, ?);
+
+-- name: DeleteRFQQuoteItems :exec
+-- 견적 재제출 시 기존 단가 삭제
+DELETE FROM rfq_quote_items WHERE quote_id = ?;
+
+-- name: GetRFQQuoteBySeller :one
+-- 판매자의 견적 (요청당 1건)
+SELECT * FROM rfq_quotes WHERE rfq_id = ? AND seller_id = ?;
+
+-- name: GetRFQQuoteForUpdate :one
+-- 견적 row-lock (수락/철회)
+SELECT * FROM rfq_quotes WHERE external_id = ? FOR UPDATE;
+
+-- name: ListRFQQuotes :many
+-- 견적 요청의 견적 + 판매자 (토큰 금액 낮은 순)
+SELECT q.*, u.external_id AS seller_external_id, u.name AS seller_name
+FROM rfq_quotes q
+JOIN users u ON u.id = q.seller_id
+WHERE q.rfq_id = ?
+ORDER BY q.total_amount ASC, q.id ASC;
+
+-- name: ListRFQQuoteItems :many
+-- 견적 항목 단가 (라인 순)
+SELECT * FROM rfq_quote_items WHERE quote_id = ? ORDER BY line_no ASC;
+
+-- name: SetRFQQuoteStatus :exec
+-- 견적 상태 변경 (수락/철회)
+UPDATE rfq_quotes SET status = ?, updated_at = NOW() WHERE id = ?;
+
+-- name: DeclineActiveRFQQuotes :exec
+-- 남은 ACTIVE 견적 일괄 거절 (다른 견적 수락/요청 취소 시)
+UPDATE rfq_quotes SET status = 'DECLINED', updated_at = NOW()
+WHERE rfq_id = ? AND status = 'ACTIVE';
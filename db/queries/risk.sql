@@ -0,0 +1,33 @@
+-- ============================================================================
+-- Counterparty Risk Queries
+-- ============================================================================
+
+-- name: GetCounterpartyPaymentStats :one
+-- 구매자(거래 상대방) 결제 이력 집계 - 결제 대상 주문만 (PENDING/CANCELLED 제외)
+-- paid_late: 납기(payment_due_at) 이후 capture, overdue_unpaid: 납기 경과 + 미결제
+-- disputed: 주문/결제에 지원 케이스가 연결된 주문, charged_back: 환불된 결제가 있는 주문
+SELECT
+    CAST(COUNT(*) AS SIGNED) AS total_orders,
+    CAST(COUNT(o.payment_due_at) AS SIGNED) AS orders_with_due_date,
+    CAST(COALESCE(SUM(CASE WHEN o.payment_due_at IS NOT NULL AND EXISTS (
+        SELECT 1 FROM payments p WHERE p.order_id = o.id AND p.captured_at > o.payment_due_at
+    ) THEN 1 ELSE 0 END), 0) AS SIGNED) AS paid_late,
+    CAST(COALESCE(SUM(CASE WHEN o.status = 'CONFIRMED' AND o.payment_due_at < sqlc.arg('now') THEN 1 ELSE 0 END), 0) AS SIGNED) AS overdue_unpaid,
+    CAST(COALESCE(SUM(CASE WHEN EXISTS (
+        SELECT 1 FROM support_cases sc
+        WHERE (sc.subject_type = 'ORDER' AND sc.subject_id = o.id)
+           OR (sc.subject_type = 'PAYMENT' AND sc.subject_id IN (SELECT p.id FROM payments p WHERE p.order_id = o.id))
+    ) THEN 1 ELSE 0 END), 0) AS SIGNED) AS disputed,
+    CAST(COALESCE(SUM(CASE WHEN EXISTS (
+        SELECT 1 FROM payments p WHERE p.order_id = o.id AND p.status = 'REFUNDED'
+    ) THEN 1 ELSE 0 END), 0) AS SIGNED) AS charged_back,
+    CAST(COALESCE(SUM((SELECT COUNT(*) FROM order_dunning_steps d WHERE d.order_id = o.id)), 0) AS SIGNED) AS dunning_steps
+FROM orders o
+WHERE o.buyer_id = sqlc.arg('buyer_id')
+  AND o.status IN ('CONFIRMED', 'PAID', 'SHIPPED', 'COMPLETED', 'REFUNDED');
+
+-- name: HasTradedWith :one
+-- 판매자-구매자 간 주문 이력 존재 여부 (점수 조회 권한 확인)
+SELECT EXISTS (
+    SELECT 1 FROM orders WHERE buyer_id = ? AND seller_id = ?
+) AS traded;
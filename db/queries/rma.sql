@@ -0,0 +1,96 @@
+-- ============================================================================
+-- Returns (RMA) Queries
+-- ============================================================================
+
+-- name: ListOrderLinesForReturn :many
+-- 주문 항목 + 상품 (항목 번호 = 주문 상품 순서, 청구서 항목 번호와 동일)
+SELECT CAST(ROW_NUMBER() OVER (ORDER BY oi.id) AS SIGNED) AS line_no,
+       oi.product_id, p.sku, p.name AS product_name, oi.quantity, oi.unit_price
+FROM order_items oi
+JOIN products p ON p.id = oi.product_id
+WHERE oi.order_id = ?
+ORDER BY oi.id;
+
+-- name: ListReturnedQuantities :many
+-- 주문 항목별 반품 수량 (진행 중 + 승인된 반품, 주문 row-lock 하에서 조회)
+SELECT l.line_no, CAST(SUM(l.quantity) AS SIGNED) AS quantity
+FROM rma_lines l
+JOIN rmas r ON r.id = l.rma_id
+WHERE r.order_id = ? AND r.status IN ('REQUESTED', 'APPROVED')
+GROUP BY l.line_no
+ORDER BY l.line_no;
+
+-- name: CreateRMA :execresult
+INSERT INTO rmas (external_id, rma_number, order_id, buyer_id, seller_id, reason, refund_amount)
+VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: CreateRMALine :exec
+INSERT INTO rma_lines (rma_id, line_no, product_id, quantity, unit_price)
+VALUES (?, ?, ?, ?, ?);
+
+-- name: GetRMADetail :one
+-- 반품 + 주문 번호 + 구매자/판매자 + 환불 식별자 (조회 권한 확인용)
+SELECT r.*, o.order_number, o.token_symbol,
+       b.external_id AS buyer_external_id, s.external_id AS seller_external_id,
+       p.external_id AS payment_external_id, pr.external_id AS refund_external_id
+FROM rmas r
+JOIN orders o ON o.id = r.order_id
+JOIN users b ON b.id = r.buyer_id
+JOIN users s ON s.id = r.seller_id
+LEFT JOIN payments p ON p.id = r.payment_id
+LEFT JOIN payment_refunds pr ON pr.id = r.refund_id
+WHERE r.external_id = ?;
+
+-- name: ListRMAsByUser :many
+-- 사용자가 요청했거나 받은 반품 (상태 필터, 최신순)
+SELECT r.*, o.order_number, o.token_symbol,
+       b.external_id AS buyer_external_id, s.external_id AS seller_external_id,
+       p.external_id AS payment_external_id, pr.external_id AS refund_external_id
+FROM rmas r
+JOIN orders o ON o.id = r.order_id
+JOIN users b ON b.id = r.buyer_id
+JOIN users s ON s.id = r.seller_id
+LEFT JOIN payments p ON p.id = r.payment_id
+LEFT JOIN payment_refunds pr ON pr.id = r.refund_id
+WHERE (r.buyer_id = sqlc.arg('user_id') OR r.seller_id = sqlc.arg('user_id'))
+  AND (sqlc.narg('status') IS NULL OR r.status = sqlc.narg('status'))
+ORDER BY r.id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountRMAsByUser :one
+-- 사용자가 요청했거나 받은 반품 수 (상태 필터)
+SELECT COUNT(*) FROM rmas r
+WHERE (r.buyer_id = sqlc.arg('user_id') OR r.seller_id = sqlc.arg('user_id'))
+  AND (sqlc.narg('status') IS NULL OR r.status = sqlc.narg('status'));
+
+-- name: ListRMALines :many
+-- 반품 항목 + 상품 (항목 번호순)
+SELECT l.*, p.sku, p.name AS product_name
+FROM rma_lines l
+JOIN products p ON p.id = l.product_id
+WHERE l.rma_id = ?
+ORDER BY l.line_no;
+
+-- name: GetRMAForUpdate :one
+-- 트랜잭션 내 row-lock (승인/거절/철회 동시성 제어)
+SELECT * FROM rmas WHERE id = ? FOR UPDATE;
+
+-- name: ApproveRMA :execrows
+-- 반품 승인 (REQUESTED → APPROVED, 환불·재입고 결과 기록)
+UPDATE rmas
+SET status = 'APPROVED', payment_id = ?, refund_id = ?, restocked = ?,
+    decision_note = ?, decided_by = ?, decided_at = NOW(), updated_at = NOW()
+WHERE id = ? AND status = 'REQUESTED';
+
+-- name: CloseRMA :execrows
+-- 반품 거절/철회 (REQUESTED → REJECTED | CANCELLED)
+UPDATE rmas
+SET status = ?, decision_note = ?, decided_by = ?, decided_at = NOW(), updated_at = NOW()
+WHERE id = ? AND status = 'REQUESTED';
+
+-- name: GetCapturedPaymentByOrder :one
+-- 주문의 확정 결제 (반품 환불 대상, 최신 1건)
+SELECT * FROM payments
+WHERE order_id = ? AND status = 'CAPTURED'
+ORDER BY id DESC
+LIMIT 1;
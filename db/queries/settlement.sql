@@ -0,0 +1,218 @@
+-- ============================================================================
+-- Settlement Queries
+-- ============================================================================
+
+-- name: ListSettlementForecastPayments :many
+-- 판매자의 정산 전 결제 (정산 레코드 미생성)
+-- AUTHORIZED: 확정(capture) 대기, CAPTURED: 다음 배치 정산 대상
+SELECT p.id, p.external_id, p.amount, p.token_symbol, p.status, p.authorized_at, p.captured_at, p.created_at,
+       o.order_number
+FROM payments p
+JOIN orders o ON o.id = p.order_id
+WHERE o.seller_id = ?
+  AND p.status IN ('AUTHORIZED', 'CAPTURED')
+  AND o.status NOT IN ('CANCELLED', 'REFUNDED')
+  AND NOT EXISTS (SELECT 1 FROM settlements s WHERE s.payment_id = p.id)
+ORDER BY p.id ASC;
+
+-- name: ListOpenSettlementsByPayee :many
+-- 지급 대기/처리 중 정산 (PENDING, PROCESSING, 상계된 정산은 순지급으로 대체)
+SELECT id, payment_id, net_amount, status, created_at
+FROM settlements
+WHERE payee_account_id = ? AND status IN ('PENDING', 'PROCESSING') AND net_payout_id IS NULL
+ORDER BY id ASC;
+
+-- name: ListPendingSettlementPayouts :many
+-- 지급 대기 정산 배치 (PENDING + 마감된 배치, 상계 제외) + 수취 계정의 Primary 지갑/동결 여부 + 고액 지급 승인 상태 (서비스에서 계정별 1건 지급으로 집계)
+SELECT s.id, s.payee_account_id, a.external_id AS payee_account_external_id, s.net_amount, w.address AS wallet_address, a.frozen_at AS payee_frozen_at,
+       pa.status AS approval_status
+FROM settlements s
+JOIN accounts a ON a.id = s.payee_account_id
+LEFT JOIN wallets w ON w.id = a.primary_wallet_id AND w.deleted_at IS NULL
+LEFT JOIN payout_approvals pa ON pa.payout_type = 'SETTLEMENT_BATCH' AND pa.payout_id = s.batch_id
+WHERE s.status = 'PENDING' AND s.batch_id IS NOT NULL AND s.net_payout_id IS NULL
+ORDER BY s.payee_account_id ASC, s.id ASC;
+
+-- name: ExistsCompletedSettlementByPayment :one
+-- 결제 대금이 판매자에게 지급 완료(on-chain)되었는지 여부 (환불 방식 결정)
+SELECT EXISTS(
+    SELECT 1 FROM settlements WHERE payment_id = ? AND status = 'COMPLETED'
+) AS settled;
+
+-- ============================================================================
+-- Netting
+-- ============================================================================
+
+-- name: ListNettableSettlementsForUpdate :many
+-- 상계 대상 정산 row-lock (창 내 PENDING + 미상계 + 미마감 + 같은 창에 반대 방향 정산이 있는 계정 쌍)
+SELECT s.id, p.payer_account_id, s.payee_account_id, s.net_amount
+FROM settlements s
+JOIN payments p ON p.id = s.payment_id
+WHERE s.status = 'PENDING' AND s.net_payout_id IS NULL AND s.batch_id IS NULL
+  AND p.payer_account_id <> s.payee_account_id
+  AND s.created_at >= sqlc.arg('window_start') AND s.created_at < sqlc.arg('window_end')
+  AND EXISTS (
+      SELECT 1 FROM settlements r
+      JOIN payments rp ON rp.id = r.payment_id
+      WHERE r.status = 'PENDING' AND r.net_payout_id IS NULL AND r.batch_id IS NULL
+        AND r.created_at >= sqlc.arg('window_start') AND r.created_at < sqlc.arg('window_end')
+        AND r.payee_account_id = p.payer_account_id AND rp.payer_account_id = s.payee_account_id
+  )
+ORDER BY s.id ASC
+FOR UPDATE OF s;
+
+-- name: CreateSettlementNetPayout :execresult
+-- 계정 쌍 순지급 생성 (완전 상계 시 payee NULL + OFFSET)
+INSERT INTO settlement_net_payouts (
+    external_id, window_start, window_end, account_low_id, account_high_id,
+    low_to_high_amount, high_to_low_amount, payee_account_id, net_amount, settlement_count, status, created_by
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: AssignSettlementNetPayout :exec
+-- 정산을 순지급에 묶음 (미상계 PENDING 정산만 - 개별 지급 배치에서 제외)
+UPDATE settlements
+SET net_payout_id = ?, updated_at = NOW()
+WHERE id = ? AND status = 'PENDING' AND net_payout_id IS NULL;
+
+-- name: CompleteOffsetSettlements :exec
+-- 완전 상계된 순지급의 정산 완료 (on-chain 지급 없음)
+UPDATE settlements
+SET status = 'COMPLETED', settled_at = NOW(), updated_at = NOW()
+WHERE net_payout_id = ? AND status = 'PENDING';
+
+-- name: GetSettlementNetPayoutDetail :one
+-- 순지급 상세 (계정 쌍/수취 계정 외부 식별자)
+SELECT n.*, l.external_id AS account_low_external_id, h.external_id AS account_high_external_id,
+       pa.external_id AS payee_account_external_id
+FROM settlement_net_payouts n
+JOIN accounts l ON l.id = n.account_low_id
+JOIN accounts h ON h.id = n.account_high_id
+LEFT JOIN accounts pa ON pa.id = n.payee_account_id
+WHERE n.id = ?;
+
+-- name: ListSettlementNetPayouts :many
+-- 순지급 목록 (상태 필터, 최신순)
+SELECT n.*, l.external_id AS account_low_external_id, h.external_id AS account_high_external_id,
+       pa.external_id AS payee_account_external_id
+FROM settlement_net_payouts n
+JOIN accounts l ON l.id = n.account_low_id
+JOIN accounts h ON h.id = n.account_high_id
+LEFT JOIN accounts pa ON pa.id = n.payee_account_id
+WHERE (sqlc.narg('status') IS NULL OR n.status = sqlc.narg('status'))
+ORDER BY n.id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountSettlementNetPayouts :one
+-- 순지급 수 (상태 필터)
+SELECT COUNT(*) FROM settlement_net_payouts
+WHERE (sqlc.narg('status') IS NULL OR status = sqlc.narg('status'));
+
+-- name: ListPendingNetPayouts :many
+-- 지급 대기 순지급 (PENDING) + 수취 계정의 Primary 지갑/동결 여부 + 고액 지급 승인 상태 (정산 배치와 함께 계정별 집계)
+SELECT n.id, n.payee_account_id, a.external_id AS payee_account_external_id, n.net_amount, w.address AS wallet_address, a.frozen_at AS payee_frozen_at,
+       pa.status AS approval_status
+FROM settlement_net_payouts n
+JOIN accounts a ON a.id = n.payee_account_id
+LEFT JOIN wallets w ON w.id = a.primary_wallet_id AND w.deleted_at IS NULL
+LEFT JOIN payout_approvals pa ON pa.payout_type = 'NET_PAYOUT' AND pa.payout_id = n.id
+WHERE n.status = 'PENDING'
+ORDER BY n.payee_account_id ASC, n.id ASC;
+
+-- name: ListOpenNetPayoutsByPayee :many
+-- 수취 계정의 지급 대기/처리 중 순지급 (PENDING, PROCESSING)
+SELECT id, net_amount, status, created_at
+FROM settlement_net_payouts
+WHERE payee_account_id = ? AND status IN ('PENDING', 'PROCESSING')
+ORDER BY id ASC;
+
+-- ============================================================================
+-- Payout Schedules
+-- ============================================================================
+
+-- name: GetSettlementScheduleByAccount :one
+-- 계정의 지급 일정 (없으면 서버 기본값)
+SELECT * FROM settlement_schedules WHERE account_id = ?;
+
+-- name: CreateOrUpdateSettlementSchedule :exec
+-- 계정별 지급 일정 upsert (uk_settlement_schedule_account)
+INSERT INTO settlement_schedules (account_id, cadence, cutoff_hour, cutoff_weekday, updated_by)
+VALUES (?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    cadence = VALUES(cadence),
+    cutoff_hour = VALUES(cutoff_hour),
+    cutoff_weekday = VALUES(cutoff_weekday),
+    updated_by = VALUES(updated_by),
+    updated_at = NOW();
+
+-- name: ListUnbatchedSettlementPayees :many
+-- 마감 대기 정산이 있는 수취 계정 (PENDING + 미마감 + 미상계, 계정 ID 커서 페이지)
+SELECT DISTINCT payee_account_id
+FROM settlements
+WHERE status = 'PENDING' AND batch_id IS NULL AND net_payout_id IS NULL
+  AND payee_account_id > sqlc.arg('after_account_id')
+ORDER BY payee_account_id ASC
+LIMIT ?;
+
+-- name: ListBatchableSettlementsForUpdate :many
+-- 마감 대상 정산 row-lock (수취 계정의 cutoff 이전 생성 PENDING + 미마감 + 미상계)
+SELECT id, net_amount
+FROM settlements
+WHERE payee_account_id = ? AND status = 'PENDING' AND batch_id IS NULL AND net_payout_id IS NULL
+  AND created_at <= sqlc.arg('cutoff')
+ORDER BY id ASC
+FOR UPDATE;
+
+-- name: CreateSettlementBatch :execresult
+-- 마감 배치 생성 (수취 계정 + 마감 시각당 1건)
+INSERT INTO settlement_batches (external_id, payee_account_id, cadence, cutoff_at, settlement_count, total_amount)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: AssignSettlementBatch :exec
+-- 정산을 마감 배치에 묶음 (미마감 + 미상계 PENDING 정산만)
+UPDATE settlements
+SET batch_id = ?, updated_at = NOW()
+WHERE id = ? AND status = 'PENDING' AND batch_id IS NULL AND net_payout_id IS NULL;
+
+-- name: GetSettlementBatchByExternalID :one
+-- 배치 조회 + 수취 계정/소유자 외부 식별자 (조회 권한 확인용)
+SELECT b.*, a.external_id AS payee_account_external_id, u.external_id AS owner_external_id
+FROM settlement_batches b
+JOIN accounts a ON a.id = b.payee_account_id
+LEFT JOIN users u ON u.id = a.owner_id
+WHERE b.external_id = ?;
+
+-- name: ListSettlementBatchesByAccount :many
+-- 수취 계정의 마감 배치 (최신순)
+SELECT * FROM settlement_batches
+WHERE payee_account_id = ?
+ORDER BY cutoff_at DESC, id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountSettlementBatchesByAccount :one
+-- 수취 계정의 마감 배치 수
+SELECT COUNT(*) FROM settlement_batches WHERE payee_account_id = ?;
+
+-- name: ListSettlementBatchItemsForExport :many
+-- 배치 정산 내보내기 (결제/주문 정보 포함, 정산 id 커서 청크)
+SELECT s.id, p.external_id AS payment_external_id, o.order_number, s.amount, s.fee_amount, s.net_amount,
+       p.token_symbol, s.status, s.settled_at, s.created_at
+FROM settlements s
+JOIN payments p ON p.id = s.payment_id
+JOIN orders o ON o.id = p.order_id
+WHERE s.batch_id = sqlc.arg('batch_id') AND s.id > sqlc.arg('after_id')
+ORDER BY s.id ASC
+LIMIT ?;
+
+-- name: ListSettlementsByPayeeAccount :many
+-- 수취 계정의 정산 + 결제/주문 식별자 (최신순)
+SELECT s.*, p.external_id AS payment_external_id, o.order_number
+FROM settlements s
+JOIN payments p ON p.id = s.payment_id
+JOIN orders o ON o.id = p.order_id
+WHERE s.payee_account_id = ?
+ORDER BY s.id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountSettlementsByPayeeAccount :one
+-- 수취 계정의 정산 수
+SELECT COUNT(*) FROM settlements WHERE payee_account_id = ?;
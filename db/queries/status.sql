@@ -0,0 +1,64 @@
+-- ============================================================================
+-- Status Feed Queries
+-- ============================================================================
+-- NOTE: 공개 피드는 서비스에서 캐시 (비인증 엔드포인트 → 요청마다 DB 조회 금지)
+
+-- name: CreateStatusIncident :execresult
+-- 장애 공지 게시
+INSERT INTO status_incidents (external_id, title, impact, status, components, created_by)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: GetStatusIncidentByID :one
+-- ID로 공지 조회 (내부 전용)
+SELECT * FROM status_incidents WHERE id = ?;
+
+-- name: GetStatusIncidentByExternalIDForUpdate :one
+-- 트랜잭션 내 row-lock (상태 변경 동시성 제어)
+SELECT * FROM status_incidents WHERE external_id = ? FOR UPDATE;
+
+-- name: UpdateStatusIncident :exec
+-- 공지 상태/영향도 변경 (RESOLVED 전환 시 resolved_at 설정)
+UPDATE status_incidents
+SET status = ?, impact = ?, resolved_at = ?, updated_at = NOW()
+WHERE id = ?;
+
+-- name: CreateStatusIncidentUpdate :exec
+-- 공지 경과 기록
+INSERT INTO status_incident_updates (incident_id, status, message, created_by)
+VALUES (?, ?, ?, ?);
+
+-- name: ListStatusIncidentsForFeed :many
+-- 피드 공지 (진행 중 + 기준 시각 이후 해결, 최신순)
+SELECT * FROM status_incidents
+WHERE resolved_at IS NULL OR resolved_at >= sqlc.arg('resolved_since')
+ORDER BY created_at DESC, id DESC
+LIMIT ?;
+
+-- name: ListStatusIncidentUpdatesByIncidentIDs :many
+-- 공지별 경과 (최신순)
+SELECT * FROM status_incident_updates
+WHERE incident_id IN (sqlc.slice('incident_ids'))
+ORDER BY incident_id, id DESC;
+
+-- ============================================================================
+-- Pipeline Lag
+-- ============================================================================
+
+-- name: GetOldestPendingOutboxEventTime :one
+-- 발행 대기 중 가장 오래된 이벤트의 기록 시각 (DEAD_LETTER 제외, 없으면 no rows)
+SELECT created_at FROM outbox
+WHERE status IN ('PENDING', 'PROCESSING', 'FAILED')
+ORDER BY id ASC
+LIMIT 1;
+
+-- name: GetOldestDueWebhookDeliveryTime :one
+-- 전송 기한이 지난 웹훅 중 가장 오래된 전송 예정 시각 (backoff 대기 중인 재시도 제외, 없으면 no rows)
+SELECT next_attempt_at FROM webhook_deliveries
+WHERE status IN ('PENDING', 'DELIVERING') AND next_attempt_at <= NOW()
+ORDER BY next_attempt_at ASC
+LIMIT 1;
+
+-- name: CountStuckChainTransactions :one
+-- 마지막 브로드캐스트 후 timeout이 지난 미확정 송금 수
+SELECT COUNT(*) FROM chain_transactions
+WHERE status = 'PENDING' AND last_broadcast_at < sqlc.arg('broadcast_before');
@@ -0,0 +1,24 @@
+-- ============================================================================
+-- Support Case Queries
+-- ============================================================================
+-- NOTE: 티켓 상태의 원천은 외부 지원 도구 → attach는 upsert (같은 대상+티켓이면 상태 갱신)
+
+-- name: UpsertSupportCase :exec
+-- 티켓 연결 또는 상태 갱신 (external_id는 최초 연결 시에만 사용)
+INSERT INTO support_cases (external_id, subject_type, subject_id, subject_ref, ticket_id, status, ticket_url)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE
+    status = VALUES(status),
+    ticket_url = COALESCE(VALUES(ticket_url), ticket_url),
+    updated_at = NOW();
+
+-- name: GetSupportCaseBySubjectAndTicket :one
+-- 대상 + 티켓으로 조회 (upsert 결과 반환용)
+SELECT * FROM support_cases
+WHERE subject_type = ? AND subject_id = ? AND ticket_id = ?;
+
+-- name: ListSupportCasesBySubject :many
+-- 대상에 연결된 티켓 목록 (최근 갱신순)
+SELECT * FROM support_cases
+WHERE subject_type = ? AND subject_id = ?
+ORDER BY updated_at DESC, id DESC;
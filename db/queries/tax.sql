@@ -0,0 +1,71 @@
+-- ============================================================================
+-- Tax Profile / Rate Queries
+-- ============================================================================
+
+-- name: GetTaxProfile :one
+-- 사용자 과세 프로필 (없음 = 비과세)
+SELECT * FROM tax_profiles WHERE user_id = ?;
+
+-- name: UpsertTaxProfile :exec
+-- 과세 프로필 등록/변경 (사용자당 1건)
+INSERT INTO tax_profiles (user_id, country, tax_id)
+VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE country = VALUES(country), tax_id = VALUES(tax_id), updated_at = NOW();
+
+-- name: ListTaxRates :many
+-- 세율표 (국가 필터, 국가·카테고리순, 기본 세율 먼저)
+SELECT * FROM tax_rates
+WHERE (sqlc.narg('country') IS NULL OR country = sqlc.narg('country'))
+ORDER BY country, category_key;
+
+-- name: GetApplicableTaxRate :one
+-- 국가·카테고리 세율 (카테고리 세율 우선, 없으면 국가 기본 세율)
+SELECT * FROM tax_rates
+WHERE country = sqlc.arg('country') AND category_key IN (sqlc.arg('category'), '')
+ORDER BY category_key DESC
+LIMIT 1;
+
+-- name: UpsertTaxRate :exec
+-- 세율 등록/변경 (국가·카테고리당 1건, 기존 행은 external_id 유지)
+INSERT INTO tax_rates (external_id, country, category, name, rate)
+VALUES (?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE name = VALUES(name), rate = VALUES(rate), updated_at = NOW();
+
+-- name: GetTaxRateByScope :one
+-- 국가·카테고리의 세율 (category_key '' = 국가 기본 세율)
+SELECT * FROM tax_rates WHERE country = ? AND category_key = ?;
+
+-- name: DeleteTaxRate :execresult
+-- 세율 삭제 (기존 문서 세금 내역은 유지)
+DELETE FROM tax_rates WHERE external_id = ?;
+
+-- ============================================================================
+-- Tax Line Queries
+-- ============================================================================
+-- NOTE: 세금 내역은 문서 생성 트랜잭션에서만 기록 (이후 세율 변경과 무관하게 고정)
+
+-- name: CreateTaxLine :exec
+-- 문서 항목 세금 내역
+INSERT INTO tax_lines (document_type, document_id, line_no, jurisdiction, tax_name, rate, taxable_amount, tax_amount, provider)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListTaxLines :many
+-- 문서의 세금 내역 (항목 번호순)
+SELECT * FROM tax_lines
+WHERE document_type = ? AND document_id = ?
+ORDER BY line_no, id;
+
+-- name: CopyTaxLines :exec
+-- 주문 세금 내역을 청구서로 복사 (주문 청구서 항목 번호 = 주문 상품 순서)
+INSERT INTO tax_lines (document_type, document_id, line_no, jurisdiction, tax_name, rate, taxable_amount, tax_amount, provider)
+SELECT 'INVOICE', sqlc.arg('invoice_id'), line_no, jurisdiction, tax_name, rate, taxable_amount, tax_amount, provider
+FROM tax_lines
+WHERE document_type = 'ORDER' AND document_id = sqlc.arg('order_id');
+
+-- name: SetOrderTax :exec
+-- 주문 세금 합계 (total_amount에 포함된 금액, 주문 생성 트랜잭션에서만)
+UPDATE orders SET tax_amount = ? WHERE id = ?;
+
+-- name: SetInvoiceTax :exec
+-- 청구서 세금 합계 (amount에 포함된 금액, 작성 트랜잭션에서만)
+UPDATE invoices SET tax_amount = ? WHERE id = ?;
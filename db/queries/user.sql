@@ -36,9 +36,20 @@ FOR UPDATE;
 
 -- name: UpdateUserProfile :exec
 -- 프로필 정보 업데이트 (이름, 전화번호)
+-- NOTE: 전화번호가 바뀌면 인증 초기화 + 인증된 번호였으면 변경 시각 기록 (phone_changed_at → phone_verified_at → phone 순서로 평가)
 UPDATE users
-SET name = ?, phone = ?, updated_at = NOW()
-WHERE id = ? AND status != 'DELETED';
+SET name = sqlc.arg('name'),
+    phone_changed_at = IF(phone <=> sqlc.arg('phone') OR phone_verified_at IS NULL, phone_changed_at, NOW()),
+    phone_verified_at = IF(phone <=> sqlc.arg('phone'), phone_verified_at, NULL),
+    phone = sqlc.arg('phone'),
+    updated_at = NOW()
+WHERE id = sqlc.arg('id') AND status != 'DELETED';
+
+-- name: MarkUserPhoneVerified :execrows
+-- OTP 검증 완료 (코드 발송 후 번호가 바뀌었으면 0 rows)
+UPDATE users
+SET phone_verified_at = NOW(), updated_at = NOW()
+WHERE id = ? AND phone = ? AND status != 'DELETED';
 
 -- name: UpdateUserRole :exec
 -- 역할 변경 (BUYER, SELLER, BOTH, ADMIN)
@@ -112,6 +123,12 @@ WHERE status != 'DELETED'
   AND (sqlc.narg('role') IS NULL OR role = sqlc.narg('role'))
   AND (sqlc.narg('kyc_status') IS NULL OR kyc_status = sqlc.narg('kyc_status'));
 
+-- name: ListActiveAdminUserIDs :many
+-- 운영 알림 수신자 (활성 ADMIN 사용자)
+SELECT id FROM users
+WHERE role = 'ADMIN' AND status = 'ACTIVE'
+ORDER BY id ASC;
+
 -- name: ExistsUserByEmail :one
 -- 이메일 중복 체크
 SELECT EXISTS(
@@ -0,0 +1,71 @@
+-- ============================================================================
+-- User Import Queries
+-- ============================================================================
+-- NOTE: 행은 청크 단위로 처리 (작업 row-lock 후 PENDING 행 처리 → 집계 갱신)
+
+-- name: CreateUserImport :execresult
+-- import 작업 생성 (행은 같은 트랜잭션에서 기록)
+INSERT INTO user_imports (external_id, source, total_count, created_by)
+VALUES (?, ?, ?, ?);
+
+-- name: CreateUserImportRow :exec
+-- 업로드된 사용자 1명 기록 (검증 실패/작업 내 중복은 처리 전 결과로 기록)
+INSERT INTO user_import_rows (import_id, row_index, email, name, phone, role, status, error)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: GetUserImportByExternalID :one
+SELECT * FROM user_imports WHERE external_id = ?;
+
+-- name: GetUserImportForUpdate :one
+-- 청크 처리 직렬화 (동시 재개 시 같은 행 중복 처리 방지)
+SELECT * FROM user_imports WHERE id = ? FOR UPDATE;
+
+-- name: ListUserImports :many
+-- import 작업 목록 (최신순)
+SELECT * FROM user_imports
+ORDER BY created_at DESC, id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountUserImports :one
+SELECT COUNT(*) FROM user_imports;
+
+-- name: ListPendingUserImportRows :many
+-- 다음 청크 (행 순서대로)
+SELECT * FROM user_import_rows
+WHERE import_id = ? AND status = 'PENDING'
+ORDER BY row_index ASC
+LIMIT ?;
+
+-- name: UpdateUserImportRowResult :exec
+-- 행 처리 결과 기록
+UPDATE user_import_rows
+SET status = ?, user_id = ?, error = ?
+WHERE id = ?;
+
+-- name: CountUserImportRowsByStatus :many
+-- 작업의 행 상태별 건수 (집계 갱신용)
+SELECT status, COUNT(*) AS row_count
+FROM user_import_rows
+WHERE import_id = ?
+GROUP BY status;
+
+-- name: UpdateUserImportProgress :exec
+-- 집계 갱신 (미처리 행이 없으면 COMPLETED)
+UPDATE user_imports
+SET status = ?, created_count = ?, duplicate_count = ?, failed_count = ?, completed_at = ?
+WHERE id = ?;
+
+-- name: ListUserImportRows :many
+-- 행별 결과 리포트 (status 필터 선택)
+SELECT r.*, u.external_id AS user_external_id
+FROM user_import_rows r
+LEFT JOIN users u ON u.id = r.user_id
+WHERE r.import_id = sqlc.arg('import_id')
+  AND (sqlc.narg('status') IS NULL OR r.status = sqlc.narg('status'))
+ORDER BY r.row_index ASC
+LIMIT ? OFFSET ?;
+
+-- name: CountUserImportRows :one
+SELECT COUNT(*) FROM user_import_rows
+WHERE import_id = sqlc.arg('import_id')
+  AND (sqlc.narg('status') IS NULL OR status = sqlc.narg('status'));
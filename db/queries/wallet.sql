@@ -8,8 +8,21 @@
 -- name: CreateWallet :execresult
 -- 지갑 등록 (address는 서비스에서 lower-case 변환 후 전달)
 -- is_verified=false, is_primary=false 기본값
-INSERT INTO wallets (external_id, user_id, address, label, is_primary, is_verified)
-VALUES (?, ?, ?, ?, false, false);
+-- ENS 이름으로 등록한 경우 ens_name/ens_status=RESOLVED/ens_checked_at 함께 저장 (아니면 NULL)
+INSERT INTO wallets (
+    external_id, user_id, address, chain_id, label, is_primary, is_verified,
+    ens_name, ens_status, ens_checked_at
+)
+VALUES (?, ?, ?, ?, ?, false, false, ?, ?, ?);
+
+-- name: CreateWatchOnlyWallet :execresult
+-- 수취 전용 지갑 등록 (서명 검증 없이 계정 소유자 보증)
+-- is_verified=false 유지 → Primary 설정/서명 기반 기능 불가
+INSERT INTO wallets (
+    external_id, user_id, address, chain_id, label, is_primary, is_verified,
+    verification_level, is_watch_only, attested_by, attested_at, receivable_after
+)
+VALUES (?, ?, ?, ?, ?, false, false, 'ATTESTED', true, ?, NOW(), ?);
 
 -- name: GetWalletByID :one
 -- ID로 지갑 조회 (내부 전용 - 삭제된 지갑 제외)
@@ -41,8 +54,8 @@ SELECT * FROM wallets
 WHERE id = ? AND user_id = ? AND deleted_at IS NULL;
 
 -- name: GetWalletByAddress :one
--- 주소로 지갑 조회 (address는 lower-case로 전달, 삭제 제외)
-SELECT * FROM wallets WHERE address = ? AND deleted_at IS NULL;
+-- 주소 + 체인으로 지갑 조회 (address는 lower-case로 전달, 삭제 제외)
+SELECT * FROM wallets WHERE address = ? AND chain_id = ? AND deleted_at IS NULL;
 
 -- name: GetWalletForUpdate :one
 -- 트랜잭션 내 row-lock (검증, Primary 설정 등)
@@ -62,11 +75,37 @@ SELECT * FROM wallets
 WHERE user_id = ? AND deleted_at IS NULL
 ORDER BY is_primary DESC, created_at ASC;
 
+-- name: ListWalletsPageByUserExternalID :many
+-- 지갑 목록 cursor 페이지 (created_at, id 오름차순, 삭제 제외)
+-- after_created_at/after_id: 이전 페이지 마지막 지갑 (NULL = 첫 페이지)
+-- NOTE: 다음 페이지 여부 확인을 위해 서비스에서 limit + 1 조회
+SELECT w.* FROM wallets w
+JOIN users u ON w.user_id = u.id
+WHERE u.external_id = sqlc.arg('user_external_id') AND w.deleted_at IS NULL
+  AND (sqlc.narg('chain_id') IS NULL OR w.chain_id = sqlc.narg('chain_id'))
+  AND (sqlc.narg('is_verified') IS NULL OR w.is_verified = sqlc.narg('is_verified'))
+  AND (sqlc.narg('is_primary') IS NULL OR w.is_primary = sqlc.narg('is_primary'))
+  AND (sqlc.narg('after_created_at') IS NULL
+       OR w.created_at > sqlc.narg('after_created_at')
+       OR (w.created_at = sqlc.narg('after_created_at') AND w.id > sqlc.arg('after_id')))
+ORDER BY w.created_at ASC, w.id ASC
+LIMIT ?;
+
+-- name: CountWalletsByUserExternalID :one
+-- 지갑 목록 필터 기준 전체 수 (cursor 무관)
+SELECT COUNT(*) FROM wallets w
+JOIN users u ON w.user_id = u.id
+WHERE u.external_id = sqlc.arg('user_external_id') AND w.deleted_at IS NULL
+  AND (sqlc.narg('chain_id') IS NULL OR w.chain_id = sqlc.narg('chain_id'))
+  AND (sqlc.narg('is_verified') IS NULL OR w.is_verified = sqlc.narg('is_verified'))
+  AND (sqlc.narg('is_primary') IS NULL OR w.is_primary = sqlc.narg('is_primary'));
+
 -- name: ListWalletsByUserExternalID :many
--- 사용자 external_id로 지갑 목록 조회 (외부 API용, 삭제 제외)
+-- 사용자 external_id로 지갑 목록 조회 (외부 API용, 삭제 제외, 체인 필터 옵션)
 SELECT w.* FROM wallets w
 JOIN users u ON w.user_id = u.id
-WHERE u.external_id = ? AND w.deleted_at IS NULL
+WHERE u.external_id = sqlc.arg('user_external_id') AND w.deleted_at IS NULL
+  AND (sqlc.narg('chain_id') IS NULL OR w.chain_id = sqlc.narg('chain_id'))
 ORDER BY w.is_primary DESC, w.created_at ASC;
 
 -- name: CountWalletsByUser :one
@@ -80,10 +119,17 @@ WHERE user_id = ? AND deleted_at IS NULL;
 
 -- name: UpdateWalletVerified :execresult
 -- EIP-712 서명 검증 완료 (삭제되지 않은 지갑만)
+-- watch-only 지갑도 서명 검증 시 일반 지갑으로 승격
 UPDATE wallets
-SET is_verified = true, updated_at = NOW()
+SET is_verified = true, verification_level = 'SIGNATURE', is_watch_only = false, updated_at = NOW()
 WHERE id = ? AND user_id = ? AND is_verified = false AND deleted_at IS NULL;
 
+-- name: UpdateWalletVerificationLevelMicroTransfer :execresult
+-- 소액 송금 검증 완료 (SIGNATURE → MICRO_TRANSFER, 서명 검증 지갑만)
+UPDATE wallets
+SET verification_level = 'MICRO_TRANSFER', updated_at = NOW()
+WHERE id = ? AND user_id = ? AND is_verified = true AND deleted_at IS NULL;
+
 -- name: UpdateWalletLabel :execresult
 -- 지갑 라벨 변경 (삭제되지 않은 지갑만)
 UPDATE wallets
@@ -107,6 +153,34 @@ UPDATE wallets
 SET is_primary = true, updated_at = NOW()
 WHERE id = ? AND user_id = ? AND is_verified = true AND deleted_at IS NULL;
 
+-- ============================================================================
+-- Primary 지갑 정합성 (wallet.PrimaryRepairer)
+-- ============================================================================
+-- NOTE: Primary 중복은 uk_wallet_primary_user 제약으로 불가능
+--       남는 위반: 검증 지갑이 있는데 Primary 없음 / 미검증 Primary / 계정 primary_wallet_id 불일치
+
+-- name: ListPrimaryWalletViolations :many
+-- Primary 지갑 불변식 위반 사용자 (user id 오름차순 cursor 페이지)
+-- after_user_id: 이전 페이지 마지막 사용자 (0 = 첫 페이지)
+SELECT u.id AS user_id, u.external_id AS user_external_id,
+       pw.id AS primary_wallet_id, pw.external_id AS primary_wallet_external_id, pw.is_verified AS primary_verified,
+       a.id AS account_id, aw.external_id AS account_wallet_external_id,
+       CAST((SELECT COUNT(*) FROM wallets v
+             WHERE v.user_id = u.id AND v.is_verified = true AND v.deleted_at IS NULL) AS SIGNED) AS verified_wallets
+FROM users u
+LEFT JOIN wallets pw ON pw.primary_user_id = u.id
+LEFT JOIN accounts a ON a.owner_id = u.id AND a.account_type = 'USER' AND a.status != 'CLOSED'
+LEFT JOIN wallets aw ON aw.id = a.primary_wallet_id
+WHERE u.id > sqlc.arg('after_user_id')
+  AND (
+      (pw.id IS NULL AND EXISTS (
+          SELECT 1 FROM wallets v WHERE v.user_id = u.id AND v.is_verified = true AND v.deleted_at IS NULL))
+      OR pw.is_verified = false
+      OR (a.id IS NOT NULL AND NOT (a.primary_wallet_id <=> pw.id))
+  )
+ORDER BY u.id ASC
+LIMIT ?;
+
 -- ============================================================================
 -- 지갑 삭제 (Soft Delete)
 -- ============================================================================
@@ -118,6 +192,13 @@ UPDATE wallets
 SET deleted_at = NOW(), is_primary = false, updated_at = NOW()
 WHERE id = ? AND user_id = ? AND is_primary = false AND deleted_at IS NULL;
 
+-- name: RestoreWallet :execresult
+-- Soft Delete 복구 - deleted_at 해제 (Primary는 복구하지 않음)
+-- 같은 (주소, 체인)의 활성 지갑이 있으면 uk_wallet_address_chain_active 위반
+UPDATE wallets
+SET deleted_at = NULL, updated_at = NOW()
+WHERE id = ? AND user_id = ? AND deleted_at IS NOT NULL;
+
 -- ============================================================================
 -- 지갑 존재 여부 체크
 -- ============================================================================
@@ -133,3 +214,53 @@ SELECT EXISTS(
 SELECT EXISTS(
     SELECT 1 FROM wallets WHERE user_id = ? AND is_verified = true AND deleted_at IS NULL
 ) as exists_flag;
+
+-- ============================================================================
+-- Wallet Activity Queries
+-- ============================================================================
+-- NOTE: 인덱서가 기록한 토큰 전송 (플랫폼 체인 기준, 지갑 관점 방향)
+--   OUT - deposits: 지갑 → 플랫폼 입금
+--   IN  - withdrawals: 플랫폼 → 지갑 출금, wallet_micro_transfers: 소액 송금 검증
+-- 방향 필터: 제외할 쪽의 out_user_id / in_user_id / in_wallet_id 에 0 전달 (ID는 1부터)
+
+-- name: ListWalletTransfers :many
+-- 지갑 관련 토큰 전송 (최신순)
+SELECT 'DEPOSIT' AS kind, 'OUT' AS direction, d.id, d.tx_hash, d.amount, d.block_number, d.status, d.created_at
+FROM deposits d
+WHERE d.user_id = sqlc.arg('out_user_id') AND d.from_address = sqlc.arg('address')
+UNION ALL
+SELECT 'WITHDRAWAL', 'IN', w.id, w.tx_hash, w.amount, NULL, w.status, w.created_at
+FROM withdrawals w
+WHERE w.user_id = sqlc.arg('in_user_id') AND w.to_address = sqlc.arg('address') AND w.tx_hash IS NOT NULL
+UNION ALL
+SELECT 'MICRO_TRANSFER', 'IN', m.id, m.tx_hash, m.amount, NULL, m.status, m.created_at
+FROM wallet_micro_transfers m
+WHERE m.wallet_id = sqlc.arg('in_wallet_id') AND m.tx_hash IS NOT NULL
+ORDER BY created_at DESC, kind ASC, id DESC
+LIMIT ? OFFSET ?;
+
+-- name: CountWalletTransfers :one
+-- 지갑 관련 토큰 전송 수 (페이지네이션)
+SELECT CAST(
+    (SELECT COUNT(*) FROM deposits d
+     WHERE d.user_id = sqlc.arg('out_user_id') AND d.from_address = sqlc.arg('address'))
+  + (SELECT COUNT(*) FROM withdrawals w
+     WHERE w.user_id = sqlc.arg('in_user_id') AND w.to_address = sqlc.arg('address') AND w.tx_hash IS NOT NULL)
+  + (SELECT COUNT(*) FROM wallet_micro_transfers m
+     WHERE m.wallet_id = sqlc.arg('in_wallet_id') AND m.tx_hash IS NOT NULL)
+  AS SIGNED) AS total;
+
+-- name: ListWalletsDueForENSCheck :many
+-- ENS 재검증 대상 (마지막 해석이 checked_before 이전, 오래된 순, 삭제 제외)
+SELECT * FROM wallets
+WHERE ens_name IS NOT NULL AND deleted_at IS NULL
+  AND ens_checked_at < sqlc.arg('checked_before')
+ORDER BY ens_checked_at ASC
+LIMIT ?;
+
+-- name: UpdateWalletENSStatus :execrows
+-- ENS 재검증 결과 기록
+-- previous_checked_at 조건: 다른 인스턴스가 먼저 기록했으면 0 rows (이벤트 중복 방지)
+UPDATE wallets
+SET ens_status = sqlc.arg('ens_status'), ens_checked_at = sqlc.arg('checked_at')
+WHERE id = sqlc.arg('id') AND ens_checked_at = sqlc.arg('previous_checked_at') AND deleted_at IS NULL;
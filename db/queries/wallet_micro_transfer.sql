@@ -0,0 +1,73 @@
+-- ============================================================================
+-- Wallet Micro-transfer Queries
+-- ============================================================================
+-- NOTE: 지갑당 최신 챌린지 1건만 유효 (id DESC 기준)
+
+-- name: CreateWalletMicroTransfer :execresult
+-- 챌린지 생성 (송금 전 PENDING 상태로 기록 → 송금 결과로 SENT/FAILED 전이)
+INSERT INTO wallet_micro_transfers (wallet_id, amount, status, expires_at)
+VALUES (?, ?, 'PENDING', ?);
+
+-- name: CountWalletMicroTransfersSent :one
+-- 지갑에 실제 송금된 챌린지 수 (브로드캐스트 전 실패는 tx_hash 없음 → 제외), 지갑당 발급 상한
+SELECT COUNT(*) FROM wallet_micro_transfers
+WHERE wallet_id = ? AND tx_hash IS NOT NULL;
+
+-- name: GetWalletMicroTransferByID :one
+-- ID로 챌린지 조회
+SELECT * FROM wallet_micro_transfers WHERE id = ?;
+
+-- name: GetLatestWalletMicroTransfer :one
+-- 지갑의 최신 챌린지 조회
+SELECT * FROM wallet_micro_transfers
+WHERE wallet_id = ?
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: GetLatestWalletMicroTransferForUpdate :one
+-- 트랜잭션 내 row-lock (금액 확인 시 attempts 동시 증가 방지)
+SELECT * FROM wallet_micro_transfers
+WHERE wallet_id = ?
+ORDER BY id DESC
+LIMIT 1
+FOR UPDATE;
+
+-- ============================================================================
+-- 챌린지 상태 업데이트
+-- ============================================================================
+
+-- name: UpdateWalletMicroTransferSent :exec
+-- 송금 브로드캐스트 성공 (PENDING → SENT)
+UPDATE wallet_micro_transfers
+SET status = 'SENT', tx_hash = ?, updated_at = NOW()
+WHERE id = ? AND status = 'PENDING';
+
+-- name: UpdateWalletMicroTransferFailed :exec
+-- 송금 실패 (PENDING → FAILED)
+UPDATE wallet_micro_transfers
+SET status = 'FAILED', updated_at = NOW()
+WHERE id = ? AND status = 'PENDING';
+
+-- name: IncrementWalletMicroTransferAttempts :exec
+-- 금액 불일치 시 시도 횟수 증가
+UPDATE wallet_micro_transfers
+SET attempts = attempts + 1, updated_at = NOW()
+WHERE id = ?;
+
+-- name: UpdateWalletMicroTransferConfirmed :execresult
+-- 금액 확인 완료 (SENT → CONFIRMED)
+UPDATE wallet_micro_transfers
+SET status = 'CONFIRMED', confirmed_at = NOW(), updated_at = NOW()
+WHERE id = ? AND status = 'SENT';
+
+-- name: UpdateWalletMicroTransferTxHash :exec
+-- 교체 송금(같은 nonce, 수수료 인상)이 포함된 경우 실제 포함된 tx hash로 갱신
+UPDATE wallet_micro_transfers
+SET tx_hash = ?, updated_at = NOW()
+WHERE id = ? AND status IN ('SENT', 'CONFIRMED');
+
+-- name: UpdateWalletMicroTransferUndelivered :exec
+-- 송금이 revert/유실되어 도달하지 않음 (SENT → FAILED, 새 챌린지 발급 가능)
+UPDATE wallet_micro_transfers
+SET status = 'FAILED', updated_at = NOW()
+WHERE id = ? AND status = 'SENT';
@@ -0,0 +1,25 @@
+-- ============================================================================
+-- Warehouse Queries
+-- ============================================================================
+
+-- name: CreateWarehouse :execresult
+INSERT INTO warehouses (code, name, country, priority, status)
+VALUES (?, ?, ?, ?, ?);
+
+-- name: GetWarehouseByCode :one
+SELECT * FROM warehouses WHERE code = ? LIMIT 1;
+
+-- name: ListWarehouses :many
+-- 창고 목록 + 재고 보유 SKU 수·수량 합계 (출고 우선순위 순)
+SELECT w.*,
+       (SELECT COUNT(*) FROM inventories i WHERE i.location = w.code AND i.quantity > 0) AS sku_count,
+       CAST(COALESCE((SELECT SUM(i.quantity) FROM inventories i WHERE i.location = w.code), 0) AS SIGNED) AS quantity,
+       CAST(COALESCE((SELECT SUM(i.reserved_quantity) FROM inventories i WHERE i.location = w.code), 0) AS SIGNED) AS reserved
+FROM warehouses w
+WHERE w.status = COALESCE(sqlc.narg('status'), w.status)
+ORDER BY w.priority ASC, w.code ASC;
+
+-- name: UpdateWarehouse :exec
+UPDATE warehouses
+SET name = ?, country = ?, priority = ?, status = ?, updated_at = NOW()
+WHERE id = ?;
@@ -0,0 +1,124 @@
+-- ============================================================================
+-- Webhook Queries
+-- ============================================================================
+-- NOTE: Soft Delete 적용 - 엔드포인트 조회 시 deleted_at IS NULL 조건 필수
+-- NOTE: 전송 건 claim은 FOR UPDATE SKIP LOCKED → 여러 인스턴스의 dispatcher가 동시에 실행 가능
+
+-- name: CreateWebhookEndpoint :execresult
+-- 엔드포인트 등록 (external_id, secret은 서비스 레이어에서 생성)
+INSERT INTO webhook_endpoints (external_id, user_id, url, event_types, payload_version, secret)
+VALUES (?, ?, ?, ?, ?, ?);
+
+-- name: GetWebhookEndpointByID :one
+-- ID로 엔드포인트 조회 (내부 전용 - 삭제 제외)
+SELECT * FROM webhook_endpoints WHERE id = ? AND deleted_at IS NULL;
+
+-- name: GetWebhookEndpointByExternalIDAndUser :one
+-- 외부 식별자 + 사용자 소유권 검증 조회 (삭제 제외)
+SELECT e.* FROM webhook_endpoints e
+JOIN users u ON e.user_id = u.id
+WHERE e.external_id = ? AND u.external_id = ? AND e.deleted_at IS NULL;
+
+-- name: ListWebhookEndpointsByUserExternalID :many
+-- 사용자의 엔드포인트 목록 (외부 API용, 삭제 제외)
+SELECT e.* FROM webhook_endpoints e
+JOIN users u ON e.user_id = u.id
+WHERE u.external_id = ? AND e.deleted_at IS NULL
+ORDER BY e.created_at ASC;
+
+-- name: ListActiveWebhookEndpointsByUser :many
+-- 이벤트 fan-out 대상 (활성 + 삭제 제외, 구독 타입 필터는 서비스 레이어)
+SELECT * FROM webhook_endpoints
+WHERE user_id = ? AND is_active = true AND deleted_at IS NULL;
+
+-- name: CountWebhookEndpointsByUser :one
+-- 사용자의 엔드포인트 수 (등록 한도 체크, 삭제 제외)
+SELECT COUNT(*) as total FROM webhook_endpoints
+WHERE user_id = ? AND deleted_at IS NULL;
+
+-- name: UpdateWebhookEndpointPayloadVersion :execresult
+-- 페이로드 버전 변경 (이후 생성되는 전송 건부터 적용)
+UPDATE webhook_endpoints
+SET payload_version = ?, updated_at = NOW()
+WHERE id = ? AND user_id = ? AND deleted_at IS NULL;
+
+-- name: SoftDeleteWebhookEndpoint :execresult
+-- 엔드포인트 삭제 (대기 중인 전송은 dispatcher가 DEAD 처리)
+UPDATE webhook_endpoints
+SET deleted_at = NOW(), is_active = false, updated_at = NOW()
+WHERE id = ? AND user_id = ? AND deleted_at IS NULL;
+
+-- ============================================================================
+-- Deliveries
+-- ============================================================================
+
+-- name: CreateWebhookDelivery :exec
+-- 전송 건 생성 (이벤트 발행 트랜잭션 내 호출, (event_id, endpoint_id) 중복 방지)
+INSERT INTO webhook_deliveries (external_id, endpoint_id, event_id, event_type, payload_version, payload, max_attempts)
+VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: ListDueWebhookDeliveriesForUpdate :many
+-- 전송 대상 claim (PENDING 또는 lease 만료된 DELIVERING)
+SELECT * FROM webhook_deliveries
+WHERE status IN ('PENDING', 'DELIVERING') AND next_attempt_at <= NOW()
+ORDER BY next_attempt_at ASC
+LIMIT ?
+FOR UPDATE SKIP LOCKED;
+
+-- name: MarkWebhookDeliveryDelivering :exec
+-- 전송 시작 (lease 설정 - 프로세스 중단 시 lease 만료 후 재claim)
+UPDATE webhook_deliveries
+SET status = 'DELIVERING', next_attempt_at = ?, updated_at = NOW()
+WHERE id = ?;
+
+-- name: MarkWebhookDeliverySucceeded :exec
+-- 전송 성공 (2xx)
+UPDATE webhook_deliveries
+SET status = 'SUCCEEDED', attempts = attempts + 1, last_status_code = ?, last_error = NULL,
+    delivered_at = NOW(), updated_at = NOW()
+WHERE id = ?;
+
+-- name: MarkWebhookDeliveryRetry :exec
+-- 전송 실패 - 재시도 예약 (exponential backoff은 서비스 레이어에서 계산)
+UPDATE webhook_deliveries
+SET status = 'PENDING', attempts = attempts + 1, next_attempt_at = ?,
+    last_status_code = ?, last_error = ?, updated_at = NOW()
+WHERE id = ?;
+
+-- name: MarkWebhookDeliveryDead :exec
+-- 재시도 한도 초과 / 엔드포인트 삭제 → DEAD
+UPDATE webhook_deliveries
+SET status = 'DEAD', attempts = attempts + 1, last_status_code = ?, last_error = ?, updated_at = NOW()
+WHERE id = ?;
+
+-- name: ListWebhookDeliveriesByEventIDs :many
+-- 이벤트별 전송 내역 (이벤트 로그 검색 응답에 첨부)
+SELECT * FROM webhook_deliveries
+WHERE event_id IN (sqlc.slice('event_ids'))
+ORDER BY created_at ASC, id ASC;
+
+-- name: ListWebhookDeliveriesByEndpoint :many
+-- 엔드포인트의 최근 전송 내역 (대시보드용)
+SELECT * FROM webhook_deliveries
+WHERE endpoint_id = ?
+ORDER BY created_at DESC, id DESC
+LIMIT ?;
+
+-- name: ListRequeueableWebhookDeliveriesForUpdate :many
+-- 대량 재전송 대상 (관리자 runbook): 가맹점 엔드포인트의 실패 건 (DEAD 또는 backoff 중인 재시도)
+-- NOTE: 삭제/비활성 엔드포인트 제외 - 재전송해도 바로 DEAD 처리됨
+SELECT d.id, d.endpoint_id, d.status FROM webhook_deliveries d
+JOIN webhook_endpoints e ON d.endpoint_id = e.id
+WHERE e.user_id = sqlc.arg('user_id') AND e.is_active = true AND e.deleted_at IS NULL
+  AND (sqlc.narg('endpoint_id') IS NULL OR d.endpoint_id = sqlc.narg('endpoint_id'))
+  AND (d.status = 'DEAD' OR (d.status = 'PENDING' AND d.attempts > 0))
+  AND d.created_at >= sqlc.arg('created_from') AND d.created_at < sqlc.arg('created_to')
+ORDER BY d.created_at ASC, d.id ASC
+LIMIT ?
+FOR UPDATE;
+
+-- name: RequeueWebhookDeliveries :execresult
+-- 재전송 예약 (attempts 초기화 → 재시도 한도 전체 재적용, last_error는 이력으로 유지)
+UPDATE webhook_deliveries
+SET status = 'PENDING', attempts = 0, next_attempt_at = ?, updated_at = NOW()
+WHERE id IN (sqlc.slice('ids')) AND status IN ('DEAD', 'PENDING');
@@ -0,0 +1,96 @@
+package account
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// FreezeAccountRequest represents the request body for freezing an account
+type FreezeAccountRequest struct {
+	Reason string `json:"reason" binding:"required,min=1,max=255" example:"Suspected account takeover - case FRAUD-2026-031"`
+}
+
+// UnfreezeAccountRequest represents the request body for unfreezing an account
+type UnfreezeAccountRequest struct {
+	Reason string `json:"reason" binding:"required,min=1,max=255" example:"Ownership verified"`
+}
+
+// CreateTransferRequest represents the request body for an internal transfer between platform accounts
+type CreateTransferRequest struct {
+	ToAccountID string `json:"to_account_id" binding:"required,uuid" example:"660e8400-e29b-41d4-a716-446655440000"`
+	Amount      string `json:"amount" binding:"required" example:"250.00"`
+	Memo        string `json:"memo" binding:"max=255" example:"Move to operating account"`
+}
+
+// ListTransfersRequest represents query parameters for listing the transfers of an account
+type ListTransfersRequest struct {
+	Page     int `form:"page,default=1" binding:"min=1"`
+	PageSize int `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// FreezeResponse represents the freeze state of an account
+// NOTE: frozen=true이면 결제 승인/지급/원장 출금이 ACCOUNT_FROZEN으로 거부됨 (조회는 허용)
+type FreezeResponse struct {
+	AccountID    string     `json:"account_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Status       string     `json:"status" example:"ACTIVE"`
+	Frozen       bool       `json:"frozen" example:"true"`
+	FrozenAt     *time.Time `json:"frozen_at,omitempty"`
+	FrozenReason string     `json:"frozen_reason,omitempty" example:"Suspected account takeover - case FRAUD-2026-031"`
+}
+
+// ToFreezeResponse converts an account to its freeze state response
+func ToFreezeResponse(account *db.Account) *FreezeResponse {
+	response := &FreezeResponse{
+		AccountID:    account.ExternalID.String,
+		Status:       string(account.Status),
+		Frozen:       account.FrozenAt.Valid,
+		FrozenReason: account.FrozenReason.String,
+	}
+	if account.FrozenAt.Valid {
+		frozenAt := account.FrozenAt.Time
+		response.FrozenAt = &frozenAt
+	}
+	return response
+}
+
+// TransferResponse represents an internal transfer between platform accounts
+type TransferResponse struct {
+	TransferID    string    `json:"transfer_id" example:"770e8400-e29b-41d4-a716-446655440000"`
+	FromAccountID string    `json:"from_account_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ToAccountID   string    `json:"to_account_id" example:"660e8400-e29b-41d4-a716-446655440000"`
+	Amount        string    `json:"amount" example:"250.00000000" swaggertype:"string"`
+	Memo          string    `json:"memo,omitempty" example:"Move to operating account"`
+	LedgerTxID    string    `json:"ledger_tx_id,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// ListTransfersResponse represents paginated transfer list of an account
+type ListTransfersResponse struct {
+	Transfers  []TransferResponse `json:"transfers"`
+	Total      int64              `json:"total" example:"3"`
+	Page       int                `json:"page" example:"1"`
+	PageSize   int                `json:"page_size" example:"20"`
+	TotalPages int                `json:"total_pages" example:"1"`
+}
+
+// ToTransferResponse converts a transfer to its response, given the external IDs of both accounts
+func ToTransferResponse(transfer *db.AccountTransfer, fromAccountID, toAccountID string) *TransferResponse {
+	return &TransferResponse{
+		TransferID:    transfer.ExternalID,
+		FromAccountID: fromAccountID,
+		ToAccountID:   toAccountID,
+		Amount:        transfer.Amount,
+		Memo:          transfer.Memo.String,
+		LedgerTxID:    transfer.LedgerTxID.String,
+		CreatedAt:     transfer.CreatedAt,
+	}
+}
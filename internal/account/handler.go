@@ -0,0 +1,236 @@
+package account
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for account administration and internal transfers
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new account handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers account routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	transfers := rg.Group("/accounts/:id/transfers", middleware.RequireAuth())
+	{
+		transfers.POST("", h.CreateTransfer)
+		transfers.GET("", h.ListTransfers)
+	}
+
+	accounts := rg.Group("/admin/accounts", middleware.RequireRoles(middleware.RoleAdmin))
+	{
+		accounts.GET("/:accountId/freeze", h.GetFreeze)
+		accounts.POST("/:accountId/freeze", h.Freeze)
+		accounts.POST("/:accountId/unfreeze", h.Unfreeze)
+	}
+}
+
+// extractAndValidateAccountID extracts and validates accountId from path
+func extractAndValidateAccountID(c *gin.Context) (string, error) {
+	accountID := c.Param("accountId")
+	if _, err := uuid.Parse(accountID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+	return accountID, nil
+}
+
+// CreateTransfer godoc
+// @Summary Transfer funds between platform accounts
+// @Description Move funds off-chain from an account to another platform account (e.g. seller → their operating sub-account)
+// @Description as balanced ledger entries in one transaction. Callers may only transfer between accounts they own (admins: any user/merchant account).
+// @Description A retry with the same Idempotency-Key returns the original transfer; a different payload under the same key returns 409.
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Param id path string true "Source account external ID (UUID)"
+// @Param Idempotency-Key header string false "Client idempotency key (scoped to the source account)"
+// @Param request body CreateTransferRequest true "Transfer"
+// @Success 201 {object} middleware.SuccessResponse{data=TransferResponse} "Transfer created"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input, insufficient balance or withdrawal limit exceeded"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Source account is frozen"
+// @Failure 404 {object} middleware.ErrorResponse "Account not found"
+// @Failure 409 {object} middleware.ErrorResponse "Idempotency key reused with a different payload, or source account not active"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/accounts/{id}/transfers [post]
+func (h *Handler) CreateTransfer(c *gin.Context) {
+	accountID := c.Param("id")
+	if _, err := uuid.Parse(accountID); err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Invalid UUID format"))
+		return
+	}
+
+	var req CreateTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	principal, _ := middleware.GetPrincipal(c)
+	result, err := h.service.CreateTransfer(c.Request.Context(), accountID, &req,
+		c.GetHeader(middleware.IdempotencyKeyHeader), audit.ActorFromContext(c), principal.IsAdmin())
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// ListTransfers godoc
+// @Summary List account transfers
+// @Description List incoming and outgoing internal transfers of an account (newest first)
+// @Tags accounts
+// @Produce json
+// @Param id path string true "Account external ID (UUID)"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} middleware.SuccessResponse{data=ListTransfersResponse} "Transfers"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Account not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/accounts/{id}/transfers [get]
+func (h *Handler) ListTransfers(c *gin.Context) {
+	accountID := c.Param("id")
+	if _, err := uuid.Parse(accountID); err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Invalid UUID format"))
+		return
+	}
+
+	var req ListTransfersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	principal, _ := middleware.GetPrincipal(c)
+	result, err := h.service.ListTransfers(c.Request.Context(), accountID, &req, audit.ActorFromContext(c), principal.IsAdmin())
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// GetFreeze godoc
+// @Summary Get account freeze state
+// @Description Get whether an account is frozen, with the freeze reason - Admin only
+// @Tags accounts
+// @Produce json
+// @Param accountId path string true "Account external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=FreezeResponse} "Freeze state"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Account not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/accounts/{accountId}/freeze [get]
+func (h *Handler) GetFreeze(c *gin.Context) {
+	accountID, err := extractAndValidateAccountID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	result, err := h.service.GetFreeze(c.Request.Context(), accountID)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// Freeze godoc
+// @Summary Freeze an account
+// @Description Freeze an account - Admin only. Payment authorizations, payouts and internal transfers (ledger debits)
+// @Description of a frozen account are rejected with ACCOUNT_FROZEN; reads and incoming credits stay available. Audited with the reason.
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Param accountId path string true "Account external ID (UUID)"
+// @Param request body FreezeAccountRequest true "Freeze reason"
+// @Success 200 {object} middleware.SuccessResponse{data=FreezeResponse} "Account frozen"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Account not found"
+// @Failure 409 {object} middleware.ErrorResponse "Account is already frozen"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/accounts/{accountId}/freeze [post]
+func (h *Handler) Freeze(c *gin.Context) {
+	accountID, err := extractAndValidateAccountID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req FreezeAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.Freeze(c.Request.Context(), accountID, &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// Unfreeze godoc
+// @Summary Unfreeze an account
+// @Description Lift the freeze of an account - Admin only. Audited with the reason.
+// @Tags accounts
+// @Accept json
+// @Produce json
+// @Param accountId path string true "Account external ID (UUID)"
+// @Param request body UnfreezeAccountRequest true "Unfreeze reason"
+// @Success 200 {object} middleware.SuccessResponse{data=FreezeResponse} "Account unfrozen"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Account not found"
+// @Failure 409 {object} middleware.ErrorResponse "Account is not frozen"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/accounts/{accountId}/unfreeze [post]
+func (h *Handler) Unfreeze(c *gin.Context) {
+	accountID, err := extractAndValidateAccountID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req UnfreezeAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.Unfreeze(c.Request.Context(), accountID, &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
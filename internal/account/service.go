@@ -0,0 +1,173 @@
+package account
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/limits"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"go.uber.org/zap"
+)
+
+// Audit log identifiers
+const (
+	actionFreeze    = "ACCOUNT_FROZEN"
+	actionUnfreeze  = "ACCOUNT_UNFROZEN"
+	resourceAccount = "ACCOUNT"
+)
+
+// Service manages account freezes (admins only) and internal transfers between platform accounts
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	enforcer *limits.Enforcer
+	logger   *zap.Logger
+}
+
+// NewService creates a new account service
+func NewService(txRunner *pkgdb.TxRunner, enforcer *limits.Enforcer, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		enforcer: enforcer,
+		logger:   logger,
+	}
+}
+
+// GetFreeze returns the freeze state of an account
+func (s *Service) GetFreeze(ctx context.Context, accountExternalID string) (*FreezeResponse, error) {
+	account, err := s.getAccount(ctx, s.txRunner.Queries(), accountExternalID)
+	if err != nil {
+		return nil, err
+	}
+	return ToFreezeResponse(account), nil
+}
+
+// Freeze freezes an account: payment authorizations, payouts and ledger debits (internal transfers)
+// of the account are rejected with ACCOUNT_FROZEN until it is unfrozen. Reads stay available.
+//
+// Why:
+//   - 계정 row-lock 하에서 동결 → 진행 중인 원장 분개(ledger.Post)와 직렬화, 동결 이후 커밋되는 출금 없음
+//   - status(SUSPENDED)와 독립 → 여신 보류 해제 등 자동 상태 전이가 관리자 동결을 풀지 않음
+func (s *Service) Freeze(ctx context.Context, accountExternalID string, req *FreezeAccountRequest, actor audit.Actor) (*FreezeResponse, error) {
+	return pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*FreezeResponse, error) {
+		// 1. Lock account row
+		locked, err := s.lockAccount(ctx, q, accountExternalID)
+		if err != nil {
+			return nil, err
+		}
+		if locked.FrozenAt.Valid {
+			return nil, errors.Conflict("Account is already frozen")
+		}
+
+		// 2. Freeze
+		if _, err := q.FreezeAccount(ctx, db.FreezeAccountParams{
+			FrozenReason: sql.NullString{String: req.Reason, Valid: true},
+			FrozenBy:     sql.NullInt64{Int64: int64(actor.ID), Valid: actor.ID != 0},
+			ID:           locked.ID,
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to freeze account", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		// 3. Audit (same transaction)
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionFreeze,
+			ResourceType: resourceAccount,
+			ResourceID:   locked.ID,
+			OldValue:     map[string]any{"frozen": false},
+			NewValue:     map[string]any{"frozen": true, "reason": req.Reason},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		frozen, err := q.GetAccountByID(ctx, locked.ID)
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+
+		logctx.From(ctx, s.logger).Info("account frozen",
+			zap.String("account_external_id", accountExternalID),
+			zap.String("request_id", actor.RequestID),
+		)
+
+		return ToFreezeResponse(&frozen), nil
+	})
+}
+
+// Unfreeze lifts the freeze of an account
+func (s *Service) Unfreeze(ctx context.Context, accountExternalID string, req *UnfreezeAccountRequest, actor audit.Actor) (*FreezeResponse, error) {
+	return pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*FreezeResponse, error) {
+		// 1. Lock account row
+		locked, err := s.lockAccount(ctx, q, accountExternalID)
+		if err != nil {
+			return nil, err
+		}
+		if !locked.FrozenAt.Valid {
+			return nil, errors.Conflict("Account is not frozen")
+		}
+
+		// 2. Unfreeze
+		if _, err := q.UnfreezeAccount(ctx, locked.ID); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to unfreeze account", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		// 3. Audit (same transaction) - 동결 사유도 함께 남겨 해제 시점에 맥락 보존
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionUnfreeze,
+			ResourceType: resourceAccount,
+			ResourceID:   locked.ID,
+			OldValue:     map[string]any{"frozen": true, "reason": locked.FrozenReason.String},
+			NewValue:     map[string]any{"frozen": false, "reason": req.Reason},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		unfrozen, err := q.GetAccountByID(ctx, locked.ID)
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+
+		logctx.From(ctx, s.logger).Info("account unfrozen",
+			zap.String("account_external_id", accountExternalID),
+			zap.String("request_id", actor.RequestID),
+		)
+
+		return ToFreezeResponse(&unfrozen), nil
+	})
+}
+
+// getAccount resolves an open account by external ID
+func (s *Service) getAccount(ctx context.Context, q *db.Queries, accountExternalID string) (*db.Account, error) {
+	account, err := q.GetAccountByExternalID(ctx, sql.NullString{String: accountExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Account")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get account", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &account, nil
+}
+
+// lockAccount resolves an open account by external ID and locks its row
+func (s *Service) lockAccount(ctx context.Context, q *db.Queries, accountExternalID string) (*db.Account, error) {
+	account, err := s.getAccount(ctx, q, accountExternalID)
+	if err != nil {
+		return nil, err
+	}
+	locked, err := q.GetAccountForUpdate(ctx, account.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Account")
+		}
+		logctx.From(ctx, s.logger).Error("failed to lock account row", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &locked, nil
+}
@@ -0,0 +1,275 @@
+package account
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/ledger"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/limits"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// amountScale is the scale of transfer amounts (DECIMAL(18,8))
+	amountScale = 8
+
+	// mysqlErrDuplicateEntry is the MySQL error number for unique key violations
+	mysqlErrDuplicateEntry = 1062
+
+	actionTransfer   = "ACCOUNT_TRANSFER_CREATED"
+	resourceTransfer = "ACCOUNT_TRANSFER"
+)
+
+// CreateTransfer moves funds off-chain from one platform account to another as a balanced
+// ledger posting (DEBIT source, CREDIT destination) in one transaction.
+// Non-admin callers may only move funds between accounts they own; admins between any
+// user/merchant accounts. A retried idempotencyKey returns the original transfer.
+// The amount counts against the source account's withdrawal limit.
+//
+// Why:
+//   - 이체 기록 + 원장 분개 + ledger_tx_id 연결 + 감사 로그가 한 트랜잭션 → 부분 이체 없음
+//   - Idempotency-Key를 DB(출금 계정 범위 unique)에도 저장 → Redis 응답 캐시 만료 후 재시도도 이중 이체 없음
+//   - 잔액/동결 검사는 ledger.Post의 계정 row-lock 하에서 → 동시 이체/지급과 경합해도 잔액 음수 없음
+//   - 출금 한도는 분개 후 같은 트랜잭션에서 누적 → 계정 잠금 순서는 ledger.Post(ID 오름차순) 그대로, 실패 시 이체와 함께 롤백
+//   - 타인 소유 계정은 존재 여부를 숨기기 위해 404
+func (s *Service) CreateTransfer(ctx context.Context, fromAccountExternalID string, req *CreateTransferRequest, idempotencyKey string, actor audit.Actor, admin bool) (*TransferResponse, error) {
+	amount, err := money.Parse(req.Amount, "", amountScale)
+	if err != nil || amount.Sign() <= 0 {
+		return nil, errors.InvalidInput("amount must be a positive decimal with at most 8 decimals")
+	}
+
+	return pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*TransferResponse, error) {
+		// 1. Resolve both accounts (ownership)
+		from, err := s.getOwnedAccount(ctx, q, fromAccountExternalID, actor, admin)
+		if err != nil {
+			return nil, err
+		}
+		to, err := s.getOwnedAccount(ctx, q, req.ToAccountID, actor, admin)
+		if err != nil {
+			return nil, err
+		}
+
+		// 2. Replay a transfer already made with the same key
+		key := sql.NullString{String: idempotencyKey, Valid: idempotencyKey != ""}
+		if key.Valid {
+			existing, err := q.GetAccountTransferByIdempotencyKey(ctx, db.GetAccountTransferByIdempotencyKeyParams{
+				FromAccountID:  from.ID,
+				IdempotencyKey: key,
+			})
+			switch {
+			case err == nil:
+				if !sameTransfer(&existing, to.ID, amount, req.Memo) {
+					return nil, errors.IdempotencyConflict()
+				}
+				return ToTransferResponse(&existing, from.ExternalID.String, to.ExternalID.String), nil
+			case err != sql.ErrNoRows:
+				logctx.From(ctx, s.logger).Error("failed to get transfer by idempotency key", zap.Error(err))
+				return nil, errors.DBError(err)
+			}
+		}
+
+		// 3. Validate accounts
+		if from.ID == to.ID {
+			return nil, errors.InvalidInput("Source and destination accounts must differ")
+		}
+		if !isTransferable(from) || !isTransferable(to) {
+			return nil, errors.InvalidInput("Transfers are only allowed between user and merchant accounts")
+		}
+		if from.Status != db.AccountsStatusACTIVE {
+			return nil, errors.Conflict("Source account is " + string(from.Status))
+		}
+		if to.Status == db.AccountsStatusCLOSED {
+			return nil, errors.InvalidInput("Destination account is closed")
+		}
+
+		// 4. Record transfer
+		result, err := q.CreateAccountTransfer(ctx, db.CreateAccountTransferParams{
+			ExternalID:     uuid.New().String(),
+			FromAccountID:  from.ID,
+			ToAccountID:    to.ID,
+			Amount:         amount.String(),
+			Memo:           sql.NullString{String: req.Memo, Valid: req.Memo != ""},
+			IdempotencyKey: key,
+			CreatedBy:      sql.NullInt64{Int64: int64(actor.ID), Valid: actor.ID != 0},
+		})
+		if err != nil {
+			// NOTE: 같은 키의 동시 요청이 먼저 커밋됨 → 재시도 시 위 2단계에서 기존 이체 반환
+			if isDuplicateKeyError(err) {
+				return nil, errors.IdempotencyConflict()
+			}
+			logctx.From(ctx, s.logger).Error("failed to create account transfer", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		transferID, err := result.LastInsertId()
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+
+		// 5. Post balanced ledger entries
+		txID, err := ledger.Post(ctx, q, ledger.Posting{
+			ReferenceType: ledger.ReferenceAccountTransfer,
+			ReferenceID:   uint64(transferID),
+			Description:   "Transfer to account " + to.ExternalID.String,
+			Legs: []ledger.Leg{
+				{AccountID: from.ID, EntryType: db.LedgerEntriesEntryTypeDEBIT, Amount: amount},
+				{AccountID: to.ID, EntryType: db.LedgerEntriesEntryTypeCREDIT, Amount: amount},
+			},
+		})
+		if err != nil {
+			var insufficient *ledger.InsufficientBalanceError
+			if stderrors.As(err, &insufficient) {
+				return nil, errors.InsufficientBalance(insufficient.Available.String(), insufficient.Requested.String())
+			}
+			if stderrors.Is(err, ledger.ErrAccountFrozen) {
+				return nil, errors.AccountFrozen(from.ExternalID.String)
+			}
+			logctx.From(ctx, s.logger).Error("failed to post transfer ledger entries", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		if err := s.enforcer.Reserve(ctx, q, from.ID, limits.KindWithdrawal, amount, time.Now().UTC()); err != nil {
+			return nil, err
+		}
+		if err := q.SetAccountTransferLedgerTx(ctx, db.SetAccountTransferLedgerTxParams{
+			LedgerTxID: sql.NullString{String: txID, Valid: true},
+			ID:         uint64(transferID),
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to link transfer ledger tx", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		// 6. Audit (same transaction)
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionTransfer,
+			ResourceType: resourceTransfer,
+			ResourceID:   uint64(transferID),
+			NewValue: map[string]any{
+				"from_account_id": from.ExternalID.String,
+				"to_account_id":   to.ExternalID.String,
+				"amount":          amount.String(),
+				"ledger_tx_id":    txID,
+			},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		transfer, err := q.GetAccountTransferByID(ctx, uint64(transferID))
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+
+		logctx.From(ctx, s.logger).Info("account transfer created",
+			zap.String("transfer_id", transfer.ExternalID),
+			zap.String("from_account_external_id", from.ExternalID.String),
+			zap.String("to_account_external_id", to.ExternalID.String),
+			zap.String("amount", transfer.Amount),
+			zap.String("request_id", actor.RequestID),
+		)
+
+		return ToTransferResponse(&transfer, from.ExternalID.String, to.ExternalID.String), nil
+	})
+}
+
+// ListTransfers lists incoming and outgoing transfers of an account (newest first)
+func (s *Service) ListTransfers(ctx context.Context, accountExternalID string, req *ListTransfersRequest, actor audit.Actor, admin bool) (*ListTransfersResponse, error) {
+	q := s.txRunner.Queries()
+
+	account, err := s.getOwnedAccount(ctx, q, accountExternalID, actor, admin)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	rows, err := q.ListAccountTransfersByAccount(ctx, db.ListAccountTransfersByAccountParams{
+		AccountID: account.ID,
+		Limit:     int32(req.PageSize),
+		Offset:    int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list account transfers", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := q.CountAccountTransfersByAccount(ctx, account.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count account transfers", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	transfers := make([]TransferResponse, 0, len(rows))
+	for _, row := range rows {
+		transfer := db.AccountTransfer{
+			ID:             row.ID,
+			ExternalID:     row.ExternalID,
+			FromAccountID:  row.FromAccountID,
+			ToAccountID:    row.ToAccountID,
+			Amount:         row.Amount,
+			Memo:           row.Memo,
+			IdempotencyKey: row.IdempotencyKey,
+			LedgerTxID:     row.LedgerTxID,
+			CreatedBy:      row.CreatedBy,
+			CreatedAt:      row.CreatedAt,
+		}
+		transfers = append(transfers, *ToTransferResponse(&transfer, row.FromAccountExternalID.String, row.ToAccountExternalID.String))
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListTransfersResponse{
+		Transfers:  transfers,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// getOwnedAccount resolves an account the actor may operate on (owner or admin)
+func (s *Service) getOwnedAccount(ctx context.Context, q *db.Queries, accountExternalID string, actor audit.Actor, admin bool) (*db.Account, error) {
+	account, err := s.getAccount(ctx, q, accountExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if !admin && (!account.OwnerID.Valid || uint64(account.OwnerID.Int64) != actor.ID) {
+		return nil, errors.NotFound("Account")
+	}
+	return account, nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// isTransferable reports whether funds may be moved into or out of the account by transfer
+// NOTE: ESCROW/SYSTEM 계정은 결제/정산 흐름 전용 → 임의 이체 대상 아님
+func isTransferable(account *db.Account) bool {
+	return account.AccountType == db.AccountsAccountTypeUSER || account.AccountType == db.AccountsAccountTypeMERCHANT
+}
+
+// sameTransfer reports whether a stored transfer matches the parameters of a retried request
+func sameTransfer(transfer *db.AccountTransfer, toAccountID uint64, amount money.Money, memo string) bool {
+	stored, err := money.Parse(transfer.Amount, "", amountScale)
+	if err != nil {
+		return false
+	}
+	return transfer.ToAccountID == toAccountID && stored.Cmp(amount) == 0 && transfer.Memo.String == memo
+}
+
+// isDuplicateKeyError checks if the error is a MySQL duplicate key error
+func isDuplicateKeyError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return stderrors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
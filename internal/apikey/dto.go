@@ -0,0 +1,92 @@
+package apikey
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// CreateAPIKeyRequest represents the request body for API key creation
+type CreateAPIKeyRequest struct {
+	Name string `json:"name" binding:"required,min=1,max=100" example:"Production server"`
+	// BootstrapToken authorizes issuing the first key without an API key (returned once on user creation)
+	BootstrapToken string `json:"bootstrap_token,omitempty" binding:"omitempty,max=100" example:"bt_9f86d081884c7d65..."`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// APIKeyResponse represents the API key metadata in API responses
+// NOTE: 원문 키는 발급/교체 응답(CreatedAPIKeyResponse)에서만 노출
+type APIKeyResponse struct {
+	ID         string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name       string     `json:"name" example:"Production server"`
+	Prefix     string     `json:"prefix" example:"sk_1a2b3c4d"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// CreatedAPIKeyResponse represents a newly issued API key including the raw key
+type CreatedAPIKeyResponse struct {
+	APIKeyResponse
+	Key string `json:"key" example:"sk_1a2b3c4d5e6f..."`
+}
+
+// ListAPIKeysResponse represents the API key list response
+type ListAPIKeysResponse struct {
+	APIKeys []APIKeyResponse `json:"api_keys"`
+	Total   int64            `json:"total"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToAPIKeyResponse converts db.ApiKey to APIKeyResponse
+func ToAPIKeyResponse(key *db.ApiKey) *APIKeyResponse {
+	if key == nil {
+		return nil
+	}
+
+	response := &APIKeyResponse{
+		ID:        key.ExternalID,
+		Name:      key.Name,
+		Prefix:    key.KeyPrefix,
+		CreatedAt: key.CreatedAt,
+	}
+
+	if key.LastUsedAt.Valid {
+		response.LastUsedAt = &key.LastUsedAt.Time
+	}
+	if key.RevokedAt.Valid {
+		response.RevokedAt = &key.RevokedAt.Time
+	}
+
+	return response
+}
+
+// ToCreatedAPIKeyResponse converts an issued key to CreatedAPIKeyResponse
+func ToCreatedAPIKeyResponse(issued *IssuedAPIKey) *CreatedAPIKeyResponse {
+	if issued == nil {
+		return nil
+	}
+	return &CreatedAPIKeyResponse{
+		APIKeyResponse: *ToAPIKeyResponse(issued.Key),
+		Key:            issued.RawKey,
+	}
+}
+
+// ToAPIKeyResponseList converts []db.ApiKey to []APIKeyResponse
+func ToAPIKeyResponseList(keys []db.ApiKey) []APIKeyResponse {
+	responses := make([]APIKeyResponse, 0, len(keys))
+	for _, key := range keys {
+		responses = append(responses, *ToAPIKeyResponse(&key))
+	}
+	return responses
+}
@@ -0,0 +1,216 @@
+package apikey
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for API key operations
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new API key handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers API key routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	// API key routes under /users/:id/api-keys (uses :id to match user handler pattern)
+	keys := rg.Group("/users/:id/api-keys")
+	{
+		keys.POST("", h.CreateAPIKey)
+		keys.GET("", middleware.RequireAuth(), h.ListAPIKeys)
+		keys.POST("/:keyId/rotate", middleware.RequireAuth(), h.RotateAPIKey)
+		keys.DELETE("/:keyId", middleware.RequireAuth(), h.RevokeAPIKey)
+	}
+}
+
+// validateUUID validates UUID format
+func validateUUID(id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return errors.InvalidInput("Invalid UUID format")
+	}
+	return nil
+}
+
+// extractAndAuthorizeUserID extracts user id from path and checks the principal may act on it
+func extractAndAuthorizeUserID(c *gin.Context) (string, error) {
+	userID := c.Param("id")
+	if err := validateUUID(userID); err != nil {
+		return "", err
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		return "", errors.Unauthorized("API key required")
+	}
+	if !principal.CanActAs(userID) {
+		return "", errors.Forbidden("Cannot manage API keys of another user")
+	}
+	return userID, nil
+}
+
+// extractAndValidateKeyID extracts and validates keyId from path
+func extractAndValidateKeyID(c *gin.Context) (string, error) {
+	keyID := c.Param("keyId")
+	if err := validateUUID(keyID); err != nil {
+		return "", err
+	}
+	return keyID, nil
+}
+
+// CreateAPIKey godoc
+// @Summary Create an API key
+// @Description Issue a new API key for the user. The raw key is returned only once.
+// @Description The first key can be issued without authentication by sending the bootstrap_token returned once on user creation
+// @Description (valid for 24 hours, not for ADMIN users); additional keys require an existing key.
+// @Tags api-keys
+// @Accept json
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param request body CreateAPIKeyRequest true "API key data"
+// @Success 201 {object} middleware.SuccessResponse{data=CreatedAPIKeyResponse} "API key created"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key or valid bootstrap token required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot manage API keys of another user"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/users/{id}/api-keys [post]
+func (h *Handler) CreateAPIKey(c *gin.Context) {
+	userExternalID := c.Param("id")
+	if err := validateUUID(userExternalID); err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	// Authenticated callers must own the user; unauthenticated callers bootstrap the first key with the user's token
+	principal, authenticated := middleware.GetPrincipal(c)
+	if authenticated && !principal.CanActAs(userExternalID) {
+		middleware.RespondError(c, errors.Forbidden("Cannot manage API keys of another user"))
+		return
+	}
+
+	var req CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+	if !authenticated && req.BootstrapToken == "" {
+		middleware.RespondError(c, errors.Unauthorized("API key or bootstrap token required"))
+		return
+	}
+
+	issued, err := h.service.CreateAPIKey(c.Request.Context(), userExternalID, &req, !authenticated)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, ToCreatedAPIKeyResponse(issued))
+}
+
+// ListAPIKeys godoc
+// @Summary List API keys
+// @Description Get all API keys (including revoked) for the user. Raw keys are never returned.
+// @Description Send Accept: text/csv to receive the same results (same filters) as CSV.
+// @Tags api-keys
+// @Produce json,text/csv
+// @Param id path string true "User external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=ListAPIKeysResponse} "API key list"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot manage API keys of another user"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/users/{id}/api-keys [get]
+func (h *Handler) ListAPIKeys(c *gin.Context) {
+	userExternalID, err := extractAndAuthorizeUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	result, err := h.service.ListAPIKeys(c.Request.Context(), userExternalID)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOKNegotiated(c, "api-keys", result, result.APIKeys)
+}
+
+// RotateAPIKey godoc
+// @Summary Rotate an API key
+// @Description Revoke the given key and issue a replacement with the same name. The new raw key is returned only once.
+// @Tags api-keys
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param keyId path string true "API key external ID (UUID)"
+// @Success 201 {object} middleware.SuccessResponse{data=CreatedAPIKeyResponse} "Replacement API key"
+// @Failure 400 {object} middleware.ErrorResponse "API key already revoked"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot manage API keys of another user"
+// @Failure 404 {object} middleware.ErrorResponse "API key not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/users/{id}/api-keys/{keyId}/rotate [post]
+func (h *Handler) RotateAPIKey(c *gin.Context) {
+	userExternalID, err := extractAndAuthorizeUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	keyExternalID, err := extractAndValidateKeyID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	issued, err := h.service.RotateAPIKey(c.Request.Context(), userExternalID, keyExternalID)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, ToCreatedAPIKeyResponse(issued))
+}
+
+// RevokeAPIKey godoc
+// @Summary Revoke an API key
+// @Description Revoke an API key (idempotent)
+// @Tags api-keys
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param keyId path string true "API key external ID (UUID)"
+// @Success 204 "API key revoked"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot manage API keys of another user"
+// @Failure 404 {object} middleware.ErrorResponse "API key not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/users/{id}/api-keys/{keyId} [delete]
+func (h *Handler) RevokeAPIKey(c *gin.Context) {
+	userExternalID, err := extractAndAuthorizeUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	keyExternalID, err := extractAndValidateKeyID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	if err := h.service.RevokeAPIKey(c.Request.Context(), userExternalID, keyExternalID); err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondNoContent(c)
+}
@@ -0,0 +1,350 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// keyPrefix is prepended to every raw API key
+	keyPrefix = "sk_"
+	// keyRandomBytes is the number of random bytes in a raw API key
+	keyRandomBytes = 32
+	// displayPrefixLen is the number of raw key chars stored for identification
+	displayPrefixLen = len(keyPrefix) + 8
+	// bootstrapTokenPrefix is prepended to every raw bootstrap token
+	bootstrapTokenPrefix = "bt_"
+
+	// BootstrapTokenTTL is how long the bootstrap token returned on user creation can issue the first key
+	BootstrapTokenTTL = 24 * time.Hour
+)
+
+// IssuedAPIKey holds a newly created key together with its raw value.
+// The raw value is never persisted and must be returned to the caller once.
+type IssuedAPIKey struct {
+	Key    *db.ApiKey
+	RawKey string
+}
+
+// Service handles API key business logic
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	logger   *zap.Logger
+}
+
+// Compile-time interface compliance check
+var _ middleware.APIKeyAuthenticator = (*Service)(nil)
+
+// NewService creates a new API key service
+func NewService(txRunner *pkgdb.TxRunner, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		logger:   logger,
+	}
+}
+
+// CreateAPIKey issues a new API key for a user.
+// If bootstrap is true the caller is unauthenticated, which is only allowed with the user's one-time
+// bootstrap token (returned on user creation) while the user has no active keys, and never for ADMIN users.
+//
+// Why:
+//   - 사용자 external_id는 공개 식별자 → 토큰 없이 "첫 키" 발급을 허용하면 제3자가 키 탈취 가능
+//   - ADMIN 키는 인증된 관리자만 발급 (역할 변경 전 남은 토큰으로 관리자 키 발급 방지)
+func (s *Service) CreateAPIKey(ctx context.Context, userExternalID string, req *CreateAPIKeyRequest, bootstrap bool) (*IssuedAPIKey, error) {
+	user, err := s.txRunner.Queries().GetUserByExternalID(ctx, sql.NullString{String: userExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("User")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*IssuedAPIKey, error) {
+		// 1. Lock user row (serializes bootstrap checks)
+		locked, err := q.GetUserForUpdate(ctx, user.ID)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to lock user row", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		// 2. Unauthenticated issuance only for the first key, with the bootstrap token
+		if bootstrap {
+			if err := s.consumeBootstrapToken(ctx, q, &locked, req.BootstrapToken); err != nil {
+				return nil, err
+			}
+		}
+
+		// 3. Insert new key
+		issued, err := s.insertKey(ctx, q, user.ID, req.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		logctx.From(ctx, s.logger).Info("api key created",
+			zap.String("api_key_external_id", issued.Key.ExternalID),
+			zap.String("user_external_id", userExternalID),
+			zap.Bool("bootstrap", bootstrap),
+		)
+
+		return issued, nil
+	})
+}
+
+// IssueBootstrapToken creates the one-time token that lets a new user issue the first API key without
+// authentication (replaces any previous token). Must be called within the user creation transaction.
+func IssueBootstrapToken(ctx context.Context, q *db.Queries, userID uint64) (string, time.Time, error) {
+	raw, err := generateToken(bootstrapTokenPrefix)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	expiresAt := time.Now().Add(BootstrapTokenTTL).UTC().Truncate(time.Second)
+	if err := q.CreateAPIKeyBootstrapToken(ctx, db.CreateAPIKeyBootstrapTokenParams{
+		UserID:    userID,
+		TokenHash: hashKey(raw),
+		ExpiresAt: expiresAt,
+	}); err != nil {
+		return "", time.Time{}, fmt.Errorf("create bootstrap token: %w", err)
+	}
+	return raw, expiresAt, nil
+}
+
+// GetAPIKey retrieves an API key by external ID with ownership verification
+func (s *Service) GetAPIKey(ctx context.Context, userExternalID, keyExternalID string) (*db.ApiKey, error) {
+	key, err := s.txRunner.Queries().GetAPIKeyByExternalIDAndUser(ctx, db.GetAPIKeyByExternalIDAndUserParams{
+		ExternalID:   keyExternalID,
+		ExternalID_2: sql.NullString{String: userExternalID, Valid: true},
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("API key")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get api key", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &key, nil
+}
+
+// ListAPIKeys retrieves all API keys (including revoked) for a user
+func (s *Service) ListAPIKeys(ctx context.Context, userExternalID string) (*ListAPIKeysResponse, error) {
+	keys, err := s.txRunner.Queries().ListAPIKeysByUserExternalID(ctx, sql.NullString{String: userExternalID, Valid: true})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list api keys", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return &ListAPIKeysResponse{
+		APIKeys: ToAPIKeyResponseList(keys),
+		Total:   int64(len(keys)),
+	}, nil
+}
+
+// RotateAPIKey revokes an active key and issues a replacement with the same name
+func (s *Service) RotateAPIKey(ctx context.Context, userExternalID, keyExternalID string) (*IssuedAPIKey, error) {
+	key, err := s.GetAPIKey(ctx, userExternalID, keyExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.RevokedAt.Valid {
+		return nil, errors.InvalidInput("Cannot rotate a revoked API key")
+	}
+
+	return pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*IssuedAPIKey, error) {
+		// 1. Lock key row
+		if _, err := q.GetAPIKeyForUpdate(ctx, db.GetAPIKeyForUpdateParams{
+			ID:     key.ID,
+			UserID: key.UserID,
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to lock api key row", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		// 2. Revoke old key
+		result, err := q.RevokeAPIKey(ctx, db.RevokeAPIKeyParams{
+			ID:     key.ID,
+			UserID: key.UserID,
+		})
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to revoke api key", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		affected, _ := result.RowsAffected()
+		if affected == 0 {
+			// Revoked by another request between read and lock
+			return nil, errors.InvalidInput("Cannot rotate a revoked API key")
+		}
+
+		// 3. Issue replacement
+		issued, err := s.insertKey(ctx, q, key.UserID, key.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		logctx.From(ctx, s.logger).Info("api key rotated",
+			zap.String("old_api_key_external_id", keyExternalID),
+			zap.String("new_api_key_external_id", issued.Key.ExternalID),
+		)
+
+		return issued, nil
+	})
+}
+
+// RevokeAPIKey revokes an API key (idempotent)
+func (s *Service) RevokeAPIKey(ctx context.Context, userExternalID, keyExternalID string) error {
+	key, err := s.GetAPIKey(ctx, userExternalID, keyExternalID)
+	if err != nil {
+		return err
+	}
+
+	// Already revoked - idempotent success
+	if key.RevokedAt.Valid {
+		return nil
+	}
+
+	if _, err := s.txRunner.Queries().RevokeAPIKey(ctx, db.RevokeAPIKeyParams{
+		ID:     key.ID,
+		UserID: key.UserID,
+	}); err != nil {
+		logctx.From(ctx, s.logger).Error("failed to revoke api key", zap.Error(err))
+		return errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("api key revoked",
+		zap.String("api_key_external_id", keyExternalID),
+	)
+
+	return nil
+}
+
+// AuthenticateAPIKey resolves a raw API key into the owning principal
+func (s *Service) AuthenticateAPIKey(ctx context.Context, rawKey string) (*middleware.Principal, error) {
+	row, err := s.txRunner.Queries().GetActiveAPIKeyPrincipal(ctx, hashKey(rawKey))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.Unauthorized("Invalid API key")
+		}
+		logctx.From(ctx, s.logger).Error("failed to authenticate api key", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	// last_used_at 갱신 실패는 인증 결과에 영향 없음
+	if err := s.txRunner.Queries().TouchAPIKeyLastUsed(ctx, row.ID); err != nil {
+		logctx.From(ctx, s.logger).Warn("failed to touch api key last_used_at", zap.Error(err))
+	}
+
+	return &middleware.Principal{
+		UserID:            row.UserID,
+		UserExternalID:    row.UserExternalID.String,
+		Role:              string(row.Role),
+		APIKeyID:          row.ExternalID,
+		ComplianceOfficer: row.IsComplianceOfficer,
+	}, nil
+}
+
+// insertKey generates a raw key and stores its hash (must be called within a transaction)
+func (s *Service) insertKey(ctx context.Context, q *db.Queries, userID uint64, name string) (*IssuedAPIKey, error) {
+	rawKey, err := generateKey()
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to generate api key", zap.Error(err))
+		return nil, errors.Internal("Failed to generate API key")
+	}
+
+	result, err := q.CreateAPIKey(ctx, db.CreateAPIKeyParams{
+		ExternalID: uuid.New().String(),
+		UserID:     userID,
+		Name:       name,
+		KeyPrefix:  rawKey[:displayPrefixLen],
+		KeyHash:    hashKey(rawKey),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to create api key", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	keyID, err := result.LastInsertId()
+	if err != nil {
+		return nil, errors.DBError(err)
+	}
+
+	key, err := q.GetAPIKeyByID(ctx, uint64(keyID))
+	if err != nil {
+		return nil, errors.DBError(err)
+	}
+
+	return &IssuedAPIKey{Key: &key, RawKey: rawKey}, nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// consumeBootstrapToken checks the bootstrap token of an unauthenticated first-key request and deletes it
+// (must be called within a transaction holding the user lock)
+func (s *Service) consumeBootstrapToken(ctx context.Context, q *db.Queries, user *db.User, rawToken string) error {
+	if user.Role == db.UsersRoleADMIN {
+		return errors.Unauthorized("API key required to issue keys for admin users")
+	}
+
+	active, err := q.CountActiveAPIKeysByUser(ctx, user.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count api keys", zap.Error(err))
+		return errors.DBError(err)
+	}
+	if active > 0 {
+		return errors.Unauthorized("API key required to issue additional keys")
+	}
+
+	token, err := q.GetAPIKeyBootstrapTokenForUpdate(ctx, user.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return errors.Unauthorized("Invalid or expired bootstrap token")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get bootstrap token", zap.Error(err))
+		return errors.DBError(err)
+	}
+	if subtle.ConstantTimeCompare([]byte(hashKey(rawToken)), []byte(token.TokenHash)) != 1 || time.Now().After(token.ExpiresAt) {
+		return errors.Unauthorized("Invalid or expired bootstrap token")
+	}
+
+	if err := q.DeleteAPIKeyBootstrapToken(ctx, user.ID); err != nil {
+		logctx.From(ctx, s.logger).Error("failed to delete bootstrap token", zap.Error(err))
+		return errors.DBError(err)
+	}
+	return nil
+}
+
+// generateKey creates a new random raw API key (sk_ + 64 hex chars)
+func generateKey() (string, error) {
+	return generateToken(keyPrefix)
+}
+
+// generateToken creates a random secret with the given prefix (prefix + 64 hex chars)
+func generateToken(prefix string) (string, error) {
+	buf := make([]byte, keyRandomBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("read random bytes: %w", err)
+	}
+	return prefix + hex.EncodeToString(buf), nil
+}
+
+// hashKey returns the hex-encoded SHA-256 hash of a raw API key
+func hashKey(rawKey string) string {
+	sum := sha256.Sum256([]byte(rawKey))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,98 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/gin-gonic/gin"
+)
+
+// Actor types
+const (
+	ActorTypeUser   = "USER"
+	ActorTypeSystem = "SYSTEM"
+)
+
+// maxUserAgentLen matches audit_logs.user_agent column size
+const maxUserAgentLen = 255
+
+// Actor identifies who performed an audited action
+type Actor struct {
+	Type      string
+	ID        uint64
+	IPAddress string
+	UserAgent string
+	RequestID string
+}
+
+// Entry describes a single audited change
+type Entry struct {
+	Action       string
+	ResourceType string
+	ResourceID   uint64
+	OldValue     any
+	NewValue     any
+}
+
+// ActorFromContext builds an Actor from the authenticated principal and request metadata
+func ActorFromContext(c *gin.Context) Actor {
+	actor := Actor{
+		Type:      ActorTypeUser,
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		RequestID: middleware.GetRequestID(c),
+	}
+	if principal, ok := middleware.GetPrincipal(c); ok {
+		actor.ID = principal.UserID
+	}
+	if len(actor.UserAgent) > maxUserAgentLen {
+		actor.UserAgent = actor.UserAgent[:maxUserAgentLen]
+	}
+	return actor
+}
+
+// Record writes an audit log row.
+// Must be called with tx-bound Queries so the log commits atomically with the change.
+func Record(ctx context.Context, q *db.Queries, actor Actor, entry Entry) error {
+	oldValue, err := marshalValue(entry.OldValue)
+	if err != nil {
+		return fmt.Errorf("marshal old value: %w", err)
+	}
+	newValue, err := marshalValue(entry.NewValue)
+	if err != nil {
+		return fmt.Errorf("marshal new value: %w", err)
+	}
+
+	return q.CreateAuditLog(ctx, db.CreateAuditLogParams{
+		ActorType:    actor.Type,
+		ActorID:      nullInt64(actor.ID),
+		Action:       entry.Action,
+		ResourceType: entry.ResourceType,
+		ResourceID:   nullInt64(entry.ResourceID),
+		OldValue:     oldValue,
+		NewValue:     newValue,
+		IpAddress:    nullString(actor.IPAddress),
+		UserAgent:    nullString(actor.UserAgent),
+		RequestID:    nullString(actor.RequestID),
+	})
+}
+
+// marshalValue encodes a value as JSON (nil → SQL NULL)
+func marshalValue(v any) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+func nullInt64(v uint64) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(v), Valid: v != 0}
+}
+
+func nullString(v string) sql.NullString {
+	return sql.NullString{String: v, Valid: v != ""}
+}
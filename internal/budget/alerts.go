@@ -0,0 +1,236 @@
+package budget
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/outbox"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/webhook"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/go-sql-driver/mysql"
+	"go.uber.org/zap"
+)
+
+// mysqlErrDuplicateEntry is the MySQL error number for duplicate key violations
+const mysqlErrDuplicateEntry = 1062
+
+// AlertConfig holds budget alert job settings
+type AlertConfig struct {
+	// Interval is the period of the alert job
+	Interval time.Duration
+	// BatchSize is the number of budgets read per query
+	BatchSize int
+}
+
+// AlertReport summarizes one alert run
+type AlertReport struct {
+	Checked   int
+	NearLimit int
+	Exceeded  int
+	Failed    int
+}
+
+// AlertWorker notifies buyers whose spend reaches a budget's alert threshold or limit.
+// Each level is sent once per budget period as an outbox event (finance teams subscribe via webhooks).
+type AlertWorker struct {
+	txRunner *pkgdb.TxRunner
+	config   AlertConfig
+	logger   *zap.Logger
+}
+
+// NewAlertWorker creates a new budget alert worker
+func NewAlertWorker(txRunner *pkgdb.TxRunner, config AlertConfig, logger *zap.Logger) *AlertWorker {
+	return &AlertWorker{
+		txRunner: txRunner,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Run executes the alert job periodically until ctx is canceled
+func (w *AlertWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	logctx.From(ctx, w.logger).Info("budget alert job started", zap.Duration("interval", w.config.Interval))
+
+	for {
+		select {
+		case <-ctx.Done():
+			logctx.From(ctx, w.logger).Info("budget alert job stopped")
+			return
+		case <-ticker.C:
+			report, err := w.RunOnce(ctx, time.Now().UTC())
+			if err != nil {
+				logctx.From(ctx, w.logger).Error("budget alert run failed", zap.Error(err))
+				continue
+			}
+			if report.NearLimit+report.Exceeded+report.Failed > 0 {
+				logctx.From(ctx, w.logger).Info("budget alert run completed",
+					zap.Int("checked", report.Checked),
+					zap.Int("near_limit", report.NearLimit),
+					zap.Int("exceeded", report.Exceeded),
+					zap.Int("failed", report.Failed),
+				)
+			}
+		}
+	}
+}
+
+// RunOnce checks every budget against the spend of its current period.
+// A failing budget is logged and counted; it is retried on the next run.
+func (w *AlertWorker) RunOnce(ctx context.Context, now time.Time) (*AlertReport, error) {
+	report := &AlertReport{}
+
+	var afterID uint64
+	for {
+		budgets, err := w.txRunner.Queries().ListBudgetsAfterID(ctx, db.ListBudgetsAfterIDParams{
+			ID:    afterID,
+			Limit: int32(w.config.BatchSize),
+		})
+		if err != nil {
+			return report, fmt.Errorf("list budgets: %w", err)
+		}
+
+		for i := range budgets {
+			if ctx.Err() != nil {
+				return report, nil
+			}
+			level, err := w.check(ctx, &budgets[i], now)
+			if err != nil {
+				report.Failed++
+				logctx.From(ctx, w.logger).Error("budget alert check failed",
+					zap.String("budget_external_id", budgets[i].ExternalID),
+					zap.Error(err),
+				)
+				continue
+			}
+			report.Checked++
+
+			switch level {
+			case db.BudgetAlertsLevelNEARLIMIT:
+				report.NearLimit++
+			case db.BudgetAlertsLevelEXCEEDED:
+				report.Exceeded++
+			}
+		}
+
+		if len(budgets) < w.config.BatchSize {
+			return report, nil
+		}
+		afterID = budgets[len(budgets)-1].ID
+	}
+}
+
+// check sends the highest level reached by the budget in its current period (if not sent yet)
+func (w *AlertWorker) check(ctx context.Context, b *db.Budget, now time.Time) (db.BudgetAlertsLevel, error) {
+	q := w.txRunner.Queries()
+
+	spent, err := Spent(ctx, q, b, now)
+	if err != nil {
+		return "", err
+	}
+	limit, err := parseAmount(b.Amount)
+	if err != nil {
+		return "", fmt.Errorf("invalid budget amount %q: %w", b.Amount, err)
+	}
+
+	// threshold = limit x pct / 100
+	threshold := limit.MulFrac(int64(b.AlertThresholdPct), 100, money.RoundDown)
+
+	var level db.BudgetAlertsLevel
+	switch {
+	case spent.Cmp(limit) > 0:
+		level = db.BudgetAlertsLevelEXCEEDED
+	case spent.Cmp(threshold) >= 0:
+		level = db.BudgetAlertsLevelNEARLIMIT
+	default:
+		return "", nil
+	}
+
+	periodStart, _ := PeriodBounds(b.Period, now)
+	sent, err := q.ExistsBudgetAlert(ctx, db.ExistsBudgetAlertParams{
+		BudgetID:    b.ID,
+		PeriodStart: periodStart,
+		Level:       level,
+	})
+	if err != nil {
+		return "", err
+	}
+	if sent {
+		return "", nil
+	}
+
+	err = w.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		eventType := webhook.EventBudgetNearLimit
+		if level == db.BudgetAlertsLevelEXCEEDED {
+			eventType = webhook.EventBudgetExceeded
+		}
+		eventID, err := outbox.Write(ctx, q, outbox.Message{
+			EventType:           eventType,
+			AggregateType:       outbox.AggregateBudget,
+			AggregateID:         b.ID,
+			AggregateExternalID: b.ExternalID,
+			RecipientUserID:     b.UserID,
+			Data:                alertEventData(b, spent, periodStart, now),
+		})
+		if err != nil {
+			return fmt.Errorf("write %s event: %w", eventType, err)
+		}
+
+		// uk_budget_alert → 동시 실행 시 트랜잭션 전체 롤백
+		return q.CreateBudgetAlert(ctx, db.CreateBudgetAlertParams{
+			BudgetID:    b.ID,
+			PeriodStart: periodStart,
+			Level:       level,
+			Spent:       spent.String(),
+			EventID:     sql.NullString{String: eventID, Valid: true},
+		})
+	})
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return level, nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// alertEventData builds the public event body of a budget alert (no internal IDs)
+func alertEventData(b *db.Budget, spent money.Money, periodStart, now time.Time) map[string]any {
+	_, periodEnd := PeriodBounds(b.Period, now)
+	return map[string]any{
+		"budget_id":           b.ExternalID,
+		"category":            b.Category.String,
+		"period":              string(b.Period),
+		"period_start":        periodStart,
+		"period_end":          periodEnd,
+		"amount":              b.Amount,
+		"spent":               spent,
+		"alert_threshold_pct": b.AlertThresholdPct,
+		"hard_limit":          b.HardLimit,
+	}
+}
+
+// isDuplicateKeyError checks if the error is a MySQL duplicate key error
+func isDuplicateKeyError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var mysqlErr *mysql.MySQLError
+	if stderrors.As(err, &mysqlErr) {
+		return mysqlErr.Number == mysqlErrDuplicateEntry
+	}
+	return strings.Contains(err.Error(), "Duplicate entry")
+}
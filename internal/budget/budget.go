@@ -0,0 +1,133 @@
+package budget
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// amountScale is the number of decimals of stored order/budget amounts (DECIMAL(18,8))
+const amountScale = 8
+
+// scopeAll is the budget scope name of budgets without a category
+const scopeAll = "total"
+
+// Line is the spend an order adds to a category (empty category = uncategorized)
+type Line struct {
+	Category string
+	Amount   string
+}
+
+// PeriodBounds returns the UTC calendar period [start, end) containing now
+func PeriodBounds(period db.BudgetsPeriod, now time.Time) (time.Time, time.Time) {
+	now = now.UTC()
+	switch period {
+	case db.BudgetsPeriodYEARLY:
+		start := time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(1, 0, 0)
+	case db.BudgetsPeriodQUARTERLY:
+		month := time.Month((int(now.Month())-1)/3*3 + 1)
+		start := time.Date(now.Year(), month, 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 3, 0)
+	default:
+		start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return start, start.AddDate(0, 1, 0)
+	}
+}
+
+// CheckOrder rejects an order that would exceed a hard-limit budget of the buyer
+// (the total budget checks the order total, category budgets the lines of their category).
+// Order creation must call it within the transaction that inserts the order.
+func CheckOrder(ctx context.Context, q *db.Queries, buyerID uint64, lines []Line, now time.Time) error {
+	budgets, err := q.ListBudgetsByUser(ctx, buyerID)
+	if err != nil {
+		return errors.DBError(err)
+	}
+	if len(budgets) == 0 {
+		return nil
+	}
+
+	total := money.Zero("", amountScale)
+	byCategory := make(map[string]money.Money)
+	for _, line := range lines {
+		amount, err := parseAmount(line.Amount)
+		if err != nil {
+			return errors.InvalidInput("Invalid order amount")
+		}
+		total = total.Add(amount)
+		byCategory[line.Category] = byCategory[line.Category].Add(amount)
+	}
+
+	for i := range budgets {
+		b := &budgets[i]
+		if !b.HardLimit {
+			continue
+		}
+
+		requested, scope := total, scopeAll
+		if b.Category.Valid {
+			var ok bool
+			requested, ok = byCategory[b.Category.String]
+			if !ok {
+				continue
+			}
+			scope = b.Category.String
+		}
+
+		spent, err := Spent(ctx, q, b, now)
+		if err != nil {
+			return errors.DBError(err)
+		}
+		limit, err := parseAmount(b.Amount)
+		if err != nil {
+			return errors.Internal("Invalid budget amount")
+		}
+
+		remaining := limit.Sub(spent)
+		if remaining.Cmp(requested) < 0 {
+			return errors.BudgetExceeded(scope, remaining.NonNegative().String(), requested.String())
+		}
+	}
+	return nil
+}
+
+// Spent returns the buyer's spend in the budget's current period
+func Spent(ctx context.Context, q *db.Queries, b *db.Budget, now time.Time) (money.Money, error) {
+	from, to := PeriodBounds(b.Period, now)
+
+	var spent string
+	var err error
+	if b.Category.Valid {
+		spent, err = q.GetBuyerCategorySpend(ctx, db.GetBuyerCategorySpendParams{
+			BuyerID:  b.UserID,
+			Category: sql.NullString{String: b.Category.String, Valid: true},
+			From:     from,
+			To:       to,
+		})
+	} else {
+		spent, err = q.GetBuyerSpend(ctx, db.GetBuyerSpendParams{
+			BuyerID: b.UserID,
+			From:    from,
+			To:      to,
+		})
+	}
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	amount, err := parseAmount(spent)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("invalid spend %q: %w", spent, err)
+	}
+	return amount, nil
+}
+
+// parseAmount parses a stored order/budget amount (DECIMAL(18,8), in the orders' token)
+func parseAmount(value string) (money.Money, error) {
+	return money.Parse(value, "", amountScale)
+}
@@ -0,0 +1,106 @@
+package budget
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// SetBudgetRequest represents a budget of a category and period
+type SetBudgetRequest struct {
+	// Category limits the budget to products of the category (omit for total spend)
+	Category string `json:"category,omitempty" binding:"omitempty,max=50" example:"office-supplies"`
+	Period   string `json:"period" binding:"required,oneof=MONTHLY QUARTERLY YEARLY" example:"MONTHLY"`
+	Amount   string `json:"amount" binding:"required" example:"50000.00"`
+	// AlertThresholdPct is the spend (% of amount) that triggers budget.near_limit (default 80)
+	AlertThresholdPct *int `json:"alert_threshold_pct,omitempty" binding:"omitempty,min=1,max=100" example:"80"`
+	// HardLimit rejects orders exceeding the remaining budget (default true); false only alerts
+	HardLimit *bool `json:"hard_limit,omitempty" example:"true"`
+}
+
+// AnalyticsQuery represents the spend analytics window (default: last 12 months)
+type AnalyticsQuery struct {
+	From time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To   time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// BudgetResponse represents a budget with the spend of its current period
+type BudgetResponse struct {
+	ID                string      `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Category          string      `json:"category,omitempty" example:"office-supplies"`
+	Period            string      `json:"period" example:"MONTHLY"`
+	Amount            money.Money `json:"amount" swaggertype:"string" example:"50000.00000000"`
+	AlertThresholdPct uint8       `json:"alert_threshold_pct" example:"80"`
+	HardLimit         bool        `json:"hard_limit" example:"true"`
+	PeriodStart       time.Time   `json:"period_start"`
+	PeriodEnd         time.Time   `json:"period_end"`
+	Spent             money.Money `json:"spent" swaggertype:"string" example:"41250.00000000"`
+	Remaining         money.Money `json:"remaining" swaggertype:"string" example:"8750.00000000"`
+	CreatedAt         time.Time   `json:"created_at"`
+	UpdatedAt         time.Time   `json:"updated_at"`
+}
+
+// ListBudgetsResponse represents the budgets of a user
+type ListBudgetsResponse struct {
+	Budgets []BudgetResponse `json:"budgets"`
+}
+
+// SellerSpend represents the spend with one seller
+type SellerSpend struct {
+	SellerID   string      `json:"seller_id" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	SellerName string      `json:"seller_name" example:"Acme Supplies"`
+	OrderCount int64       `json:"order_count" example:"12"`
+	Amount     money.Money `json:"amount" swaggertype:"string" example:"18400.00000000"`
+}
+
+// CategorySpend represents the spend on one product category (empty = uncategorized)
+type CategorySpend struct {
+	Category   string      `json:"category" example:"office-supplies"`
+	OrderCount int64       `json:"order_count" example:"9"`
+	Amount     money.Money `json:"amount" swaggertype:"string" example:"12100.00000000"`
+}
+
+// MonthSpend represents the spend of one calendar month (UTC)
+type MonthSpend struct {
+	Month      string      `json:"month" example:"2026-01"`
+	OrderCount int64       `json:"order_count" example:"5"`
+	Amount     money.Money `json:"amount" swaggertype:"string" example:"7300.00000000"`
+}
+
+// AnalyticsResponse represents the spend breakdown of a buyer.
+// Spend counts orders that are not cancelled or refunded; category amounts are order line totals.
+type AnalyticsResponse struct {
+	From        time.Time       `json:"from"`
+	To          time.Time       `json:"to"`
+	TotalAmount money.Money     `json:"total_amount" swaggertype:"string" example:"41250.00000000"`
+	BySeller    []SellerSpend   `json:"by_seller"`
+	ByCategory  []CategorySpend `json:"by_category"`
+	ByMonth     []MonthSpend    `json:"by_month"`
+}
+
+// ToBudgetResponse converts db.Budget (limit = parsed b.Amount) and its period spend to BudgetResponse
+func ToBudgetResponse(b *db.Budget, limit money.Money, periodStart, periodEnd time.Time, spent, remaining money.Money) *BudgetResponse {
+	return &BudgetResponse{
+		ID:                b.ExternalID,
+		Category:          b.Category.String,
+		Period:            string(b.Period),
+		Amount:            limit,
+		AlertThresholdPct: b.AlertThresholdPct,
+		HardLimit:         b.HardLimit,
+		PeriodStart:       periodStart,
+		PeriodEnd:         periodEnd,
+		Spent:             spent,
+		Remaining:         remaining,
+		CreatedAt:         b.CreatedAt,
+		UpdatedAt:         b.UpdatedAt,
+	}
+}
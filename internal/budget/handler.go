@@ -0,0 +1,189 @@
+package budget
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for buyer budgets and spend analytics
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new budget handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers budget and spend analytics routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	users := rg.Group("/users/:id", middleware.RequireAuth())
+	{
+		users.PUT("/budgets", h.SetBudget)
+		users.GET("/budgets", h.ListBudgets)
+		users.DELETE("/budgets/:budgetId", h.DeleteBudget)
+		users.GET("/spend-analytics", h.GetAnalytics)
+	}
+}
+
+// extractUserID extracts the user id from path and checks the caller may act as the user
+func extractUserID(c *gin.Context) (string, error) {
+	userID := c.Param("id")
+	if _, err := uuid.Parse(userID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+
+	principal, _ := middleware.GetPrincipal(c)
+	if !principal.CanActAs(userID) {
+		return "", errors.Forbidden("Cannot access budgets of another user")
+	}
+	return userID, nil
+}
+
+// SetBudget godoc
+// @Summary Set a budget
+// @Description Create or replace the budget of a period and category (omit category for total spend).
+// @Description Hard-limit budgets reject orders exceeding the remaining budget (BUDGET_EXCEEDED);
+// @Description budget.near_limit and budget.exceeded events are sent once per period.
+// @Tags budgets
+// @Accept json
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param request body SetBudgetRequest true "Budget"
+// @Success 200 {object} middleware.SuccessResponse{data=BudgetResponse} "Budget set"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid request"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/budgets [put]
+func (h *Handler) SetBudget(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req SetBudgetRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.SetBudget(c.Request.Context(), userExternalID, &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// ListBudgets godoc
+// @Summary List budgets
+// @Description List the user's budgets with spend and remaining amount of their current period
+// @Tags budgets
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=ListBudgetsResponse} "Budgets"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid user ID"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/budgets [get]
+func (h *Handler) ListBudgets(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	budgets, err := h.service.ListBudgets(c.Request.Context(), userExternalID)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, ListBudgetsResponse{Budgets: budgets})
+}
+
+// DeleteBudget godoc
+// @Summary Delete a budget
+// @Description Remove a budget; orders of its scope are no longer limited
+// @Tags budgets
+// @Param id path string true "User external ID (UUID)"
+// @Param budgetId path string true "Budget external ID (UUID)"
+// @Success 204 "Budget deleted"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid ID"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "Budget not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/budgets/{budgetId} [delete]
+func (h *Handler) DeleteBudget(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	budgetID := c.Param("budgetId")
+	if _, err := uuid.Parse(budgetID); err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Invalid UUID format"))
+		return
+	}
+
+	if err := h.service.DeleteBudget(c.Request.Context(), userExternalID, budgetID, audit.ActorFromContext(c)); err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondNoContent(c)
+}
+
+// GetAnalytics godoc
+// @Summary Get spend analytics
+// @Description Break down the user's spend by seller, product category and month (UTC).
+// @Description Spend counts orders that are not cancelled or refunded. Defaults to the last 12 months; at most 3 years.
+// @Tags budgets
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param from query string false "Created at or after (RFC 3339)" example(2026-01-01T00:00:00Z)
+// @Param to query string false "Created before (RFC 3339)" example(2026-07-01T00:00:00Z)
+// @Success 200 {object} middleware.SuccessResponse{data=AnalyticsResponse} "Spend analytics"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid range"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/spend-analytics [get]
+func (h *Handler) GetAnalytics(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var query AnalyticsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+	to := query.To
+	if to.IsZero() {
+		to = time.Now().UTC()
+	}
+	from := query.From
+	if from.IsZero() {
+		from = to.AddDate(-1, 0, 0)
+	}
+
+	result, err := h.service.GetAnalytics(c.Request.Context(), userExternalID, from, to)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
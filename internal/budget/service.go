@@ -0,0 +1,290 @@
+package budget
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultAlertThresholdPct is the near-limit alert threshold when the request omits it
+	defaultAlertThresholdPct = 80
+	// maxAnalyticsRange bounds the spend analytics window
+	maxAnalyticsRange = 3 * 366 * 24 * time.Hour
+)
+
+// Audit log identifiers
+const (
+	actionBudgetSet     = "BUDGET_SET"
+	actionBudgetDeleted = "BUDGET_DELETED"
+	resourceBudget      = "BUDGET"
+)
+
+// Service handles buyer budgets and spend analytics.
+// The organization is the buyer account; budgets cap its spend per period and (optionally) category.
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	logger   *zap.Logger
+}
+
+// NewService creates a new budget service
+func NewService(txRunner *pkgdb.TxRunner, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		logger:   logger,
+	}
+}
+
+// SetBudget creates or replaces the budget of a category and period (category omitted = total spend)
+func (s *Service) SetBudget(ctx context.Context, userExternalID string, req *SetBudgetRequest, actor audit.Actor) (*BudgetResponse, error) {
+	// 1. Validate
+	amount, err := parseAmount(req.Amount)
+	if err != nil || amount.Sign() <= 0 {
+		return nil, errors.InvalidInput("amount must be a positive decimal with at most 8 decimals")
+	}
+	threshold := defaultAlertThresholdPct
+	if req.AlertThresholdPct != nil {
+		threshold = *req.AlertThresholdPct
+	}
+	hardLimit := true
+	if req.HardLimit != nil {
+		hardLimit = *req.HardLimit
+	}
+	category := sql.NullString{}
+	if c := strings.TrimSpace(req.Category); c != "" {
+		category = sql.NullString{String: c, Valid: true}
+	}
+
+	user, err := s.getUser(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Upsert (uk_budget_scope)
+	budget, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*db.Budget, error) {
+		if err := q.CreateOrUpdateBudget(ctx, db.CreateOrUpdateBudgetParams{
+			ExternalID:        uuid.New().String(),
+			UserID:            user.ID,
+			Category:          category,
+			Period:            db.BudgetsPeriod(req.Period),
+			Amount:            amount.String(),
+			AlertThresholdPct: uint8(threshold),
+			HardLimit:         hardLimit,
+		}); err != nil {
+			return nil, err
+		}
+		budget, err := q.GetBudgetByScope(ctx, db.GetBudgetByScopeParams{
+			UserID:      user.ID,
+			CategoryKey: sql.NullString{String: category.String, Valid: true},
+			Period:      db.BudgetsPeriod(req.Period),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionBudgetSet,
+			ResourceType: resourceBudget,
+			ResourceID:   budget.ID,
+			NewValue: map[string]any{
+				"category":            budget.Category.String,
+				"period":              string(budget.Period),
+				"amount":              budget.Amount,
+				"alert_threshold_pct": budget.AlertThresholdPct,
+				"hard_limit":          budget.HardLimit,
+			},
+		}); err != nil {
+			return nil, err
+		}
+		return &budget, nil
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to set budget", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("budget set",
+		zap.String("budget_external_id", budget.ExternalID),
+		zap.String("category", budget.Category.String),
+		zap.String("period", string(budget.Period)),
+	)
+	return s.toBudgetResponse(ctx, budget, time.Now())
+}
+
+// ListBudgets lists the user's budgets with the spend of their current period
+func (s *Service) ListBudgets(ctx context.Context, userExternalID string) ([]BudgetResponse, error) {
+	user, err := s.getUser(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	budgets, err := s.txRunner.Queries().ListBudgetsByUser(ctx, user.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list budgets", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	now := time.Now()
+	responses := make([]BudgetResponse, 0, len(budgets))
+	for i := range budgets {
+		response, err := s.toBudgetResponse(ctx, &budgets[i], now)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, *response)
+	}
+	return responses, nil
+}
+
+// DeleteBudget removes a budget (orders of its scope are no longer limited)
+func (s *Service) DeleteBudget(ctx context.Context, userExternalID, budgetExternalID string, actor audit.Actor) error {
+	budget, err := s.txRunner.Queries().GetBudgetByExternalIDAndUser(ctx, db.GetBudgetByExternalIDAndUserParams{
+		ExternalID:   budgetExternalID,
+		ExternalID_2: sql.NullString{String: userExternalID, Valid: true},
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return errors.NotFound("Budget")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get budget", zap.Error(err))
+		return errors.DBError(err)
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		if err := q.DeleteBudget(ctx, budget.ID); err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionBudgetDeleted,
+			ResourceType: resourceBudget,
+			ResourceID:   budget.ID,
+			OldValue: map[string]any{
+				"category": budget.Category.String,
+				"period":   string(budget.Period),
+				"amount":   budget.Amount,
+			},
+		})
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to delete budget", zap.Error(err))
+		return errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("budget deleted", zap.String("budget_external_id", budgetExternalID))
+	return nil
+}
+
+// GetAnalytics breaks down the user's spend by seller, category and month within [from, to)
+func (s *Service) GetAnalytics(ctx context.Context, userExternalID string, from, to time.Time) (*AnalyticsResponse, error) {
+	if !from.Before(to) {
+		return nil, errors.InvalidInput("from must be before to")
+	}
+	if to.Sub(from) > maxAnalyticsRange {
+		return nil, errors.InvalidInput("Analytics range must not exceed 3 years")
+	}
+
+	user, err := s.getUser(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	q := s.txRunner.Queries()
+
+	bySeller, err := q.ListSpendBySeller(ctx, db.ListSpendBySellerParams{BuyerID: user.ID, From: from, To: to})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list spend by seller", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	byCategory, err := q.ListSpendByCategory(ctx, db.ListSpendByCategoryParams{BuyerID: user.ID, From: from, To: to})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list spend by category", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	byMonth, err := q.ListSpendByMonth(ctx, db.ListSpendByMonthParams{BuyerID: user.ID, From: from, To: to})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list spend by month", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	response := &AnalyticsResponse{
+		From:       from.UTC(),
+		To:         to.UTC(),
+		BySeller:   make([]SellerSpend, 0, len(bySeller)),
+		ByCategory: make([]CategorySpend, 0, len(byCategory)),
+		ByMonth:    make([]MonthSpend, 0, len(byMonth)),
+	}
+
+	// 월별 합계 = 전체 지출 (카테고리 합계는 주문 상품 기준이라 할인/배송비 등으로 다를 수 있음)
+	total := money.Zero("", amountScale)
+	for _, r := range byMonth {
+		amount, err := parseAmount(r.Amount)
+		if err != nil {
+			return nil, errors.Internal("Invalid spend amount")
+		}
+		total = total.Add(amount)
+		response.ByMonth = append(response.ByMonth, MonthSpend{Month: r.Month, OrderCount: r.OrderCount, Amount: amount})
+	}
+	for _, r := range bySeller {
+		amount, err := parseAmount(r.Amount)
+		if err != nil {
+			return nil, errors.Internal("Invalid spend amount")
+		}
+		response.BySeller = append(response.BySeller, SellerSpend{
+			SellerID:   r.SellerExternalID.String,
+			SellerName: r.SellerName,
+			OrderCount: r.OrderCount,
+			Amount:     amount,
+		})
+	}
+	for _, r := range byCategory {
+		amount, err := parseAmount(r.Amount)
+		if err != nil {
+			return nil, errors.Internal("Invalid spend amount")
+		}
+		response.ByCategory = append(response.ByCategory, CategorySpend{Category: r.Category, OrderCount: r.OrderCount, Amount: amount})
+	}
+	response.TotalAmount = total
+	return response, nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// getUser retrieves a user by external ID
+func (s *Service) getUser(ctx context.Context, userExternalID string) (*db.User, error) {
+	user, err := s.txRunner.Queries().GetUserByExternalID(ctx, sql.NullString{String: userExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("User")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &user, nil
+}
+
+// toBudgetResponse adds the spend of the budget's current period
+func (s *Service) toBudgetResponse(ctx context.Context, b *db.Budget, now time.Time) (*BudgetResponse, error) {
+	spent, err := Spent(ctx, s.txRunner.Queries(), b, now)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to get budget spend", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	limit, err := parseAmount(b.Amount)
+	if err != nil {
+		return nil, errors.Internal("Invalid budget amount")
+	}
+
+	from, to := PeriodBounds(b.Period, now)
+	return ToBudgetResponse(b, limit, from, to, spent, limit.Sub(spent).NonNegative()), nil
+}
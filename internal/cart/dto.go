@@ -0,0 +1,151 @@
+package cart
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// Issues of a cart item, reported on read and blocking checkout
+const (
+	IssueProductInactive   = "PRODUCT_INACTIVE"
+	IssueInsufficientStock = "INSUFFICIENT_STOCK"
+	IssuePriceChanged      = "PRICE_CHANGED"
+)
+
+// Stock reservation strategies of a checkout
+const (
+	// ReservationNearest fills each item from the warehouses in the ship-to country first, then by priority (may split)
+	ReservationNearest = "NEAREST"
+	// ReservationSingleSource reserves the whole order at the nearest warehouse that can fulfil every item
+	ReservationSingleSource = "SINGLE_SOURCE"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// CreateCartRequest represents the request body for creating a cart
+type CreateCartRequest struct {
+	BuyerID     string `json:"buyer_id,omitempty" binding:"omitempty,uuid" example:"550e8400-e29b-41d4-a716-446655440000"` // omitted = caller
+	SellerID    string `json:"seller_id" binding:"required,uuid" example:"550e8400-e29b-41d4-a716-446655440001"`
+	TokenSymbol string `json:"token_symbol" binding:"required,max=10" example:"USDC"`
+}
+
+// SetItemRequest represents the request body for adding a product to a cart or changing its quantity
+type SetItemRequest struct {
+	Quantity uint32 `json:"quantity" binding:"required,min=1,max=1000000" example:"10"`
+}
+
+// CheckoutRequest represents the request body for converting a cart into an order
+type CheckoutRequest struct {
+	CouponCode          string `json:"coupon_code,omitempty" binding:"max=32" example:"SPRING10"`
+	ReservationStrategy string `json:"reservation_strategy,omitempty" binding:"omitempty,oneof=NEAREST SINGLE_SOURCE" example:"SINGLE_SOURCE"` // omitted = server default
+	ShipToCountry       string `json:"ship_to_country,omitempty" binding:"omitempty,len=2,alpha" example:"DE"`                                 // omitted = buyer's tax country
+}
+
+// ListCartsRequest represents query parameters for listing the caller's carts
+type ListCartsRequest struct {
+	Status   string `form:"status" binding:"omitempty,oneof=ACTIVE CHECKED_OUT EXPIRED"`
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// CartItemResponse represents a product in a cart.
+// unit_price is the catalog price when the cart was last changed; issue reports what blocks checkout.
+type CartItemResponse struct {
+	SKU               string      `json:"sku" example:"SKU-WIDGET-001"`
+	ProductName       string      `json:"product_name" example:"Industrial widget"`
+	Quantity          uint32      `json:"quantity" example:"10"`
+	UnitPrice         money.Money `json:"unit_price" swaggertype:"string" example:"125.00"`
+	LineTotal         money.Money `json:"line_total" swaggertype:"string" example:"1250.00"`
+	AvailableQuantity *int64      `json:"available_quantity,omitempty" example:"480"` // omitted = stock not tracked (or list view)
+	Issue             string      `json:"issue,omitempty" example:"PRICE_CHANGED" enums:"PRODUCT_INACTIVE,INSUFFICIENT_STOCK,PRICE_CHANGED"`
+}
+
+// CartResponse represents a cart
+type CartResponse struct {
+	CartID       string             `json:"cart_id" example:"aa1e8400-e29b-41d4-a716-446655440000"`
+	BuyerID      string             `json:"buyer_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	SellerID     string             `json:"seller_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	TokenSymbol  string             `json:"token_symbol" example:"USDC"`
+	Status       string             `json:"status" example:"ACTIVE" enums:"ACTIVE,CHECKED_OUT,EXPIRED"`
+	Items        []CartItemResponse `json:"items"`
+	Subtotal     money.Money        `json:"subtotal" swaggertype:"string" example:"1250.00"`
+	OrderNumber  string             `json:"order_number,omitempty" example:"ORD-20260902-0001"` // CHECKED_OUT only
+	ExpiresAt    time.Time          `json:"expires_at"`
+	CheckedOutAt *time.Time         `json:"checked_out_at,omitempty"`
+	CreatedAt    time.Time          `json:"created_at"`
+	UpdatedAt    time.Time          `json:"updated_at"`
+}
+
+// ListCartsResponse represents paginated cart list
+type ListCartsResponse struct {
+	Carts      []CartResponse `json:"carts"`
+	Total      int64          `json:"total" example:"2"`
+	Page       int            `json:"page" example:"1"`
+	PageSize   int            `json:"page_size" example:"20"`
+	TotalPages int            `json:"total_pages" example:"1"`
+}
+
+// CheckoutResponse represents the order created from a cart.
+// total_amount = subtotal - discount_amount + tax_amount.
+type CheckoutResponse struct {
+	OrderNumber      string      `json:"order_number" example:"ORD-20260902-0001"`
+	OrderStatus      string      `json:"order_status" example:"PENDING"`
+	Subtotal         money.Money `json:"subtotal" swaggertype:"string" example:"1250.00"`
+	DiscountAmount   money.Money `json:"discount_amount" swaggertype:"string" example:"125.00"`
+	TaxAmount        money.Money `json:"tax_amount" swaggertype:"string" example:"112.50"`
+	TotalAmount      money.Money `json:"total_amount" swaggertype:"string" example:"1237.50"`
+	ReservedQuantity int64       `json:"reserved_quantity" example:"10"` // inventory reserved for the order
+	// ReservationStrategy is the strategy the stock was reserved by
+	ReservationStrategy string `json:"reservation_strategy" example:"NEAREST" enums:"NEAREST,SINGLE_SOURCE"`
+	// ReservationExpiresAt is when the reservation is released and the order becomes PAYMENT_EXPIRED unless paid
+	ReservationExpiresAt *time.Time           `json:"reservation_expires_at,omitempty"`
+	Allocations          []AllocationResponse `json:"allocations"` // reserved quantities per SKU and warehouse
+	Cart                 *CartResponse        `json:"cart"`
+}
+
+// AllocationResponse represents a quantity of an ordered SKU reserved at a warehouse
+type AllocationResponse struct {
+	SKU      string `json:"sku" example:"SKU-WIDGET-001"`
+	Location string `json:"location" example:"fra-1"` // warehouse code
+	Quantity int64  `json:"quantity" example:"10"`
+}
+
+// PriceChange is a cart item whose catalog price changed since the cart was last changed
+type PriceChange struct {
+	SKU       string `json:"sku"`
+	CartPrice string `json:"cart_price"`
+	Price     string `json:"price"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToCartResponse converts a cart with its parties to its response
+func ToCartResponse(c *db.GetCartDetailRow, items []CartItemResponse, subtotal money.Money) *CartResponse {
+	response := &CartResponse{
+		CartID:      c.ExternalID,
+		BuyerID:     c.BuyerExternalID.String,
+		SellerID:    c.SellerExternalID.String,
+		TokenSymbol: c.TokenSymbol,
+		Status:      string(c.Status),
+		Items:       items,
+		Subtotal:    subtotal,
+		OrderNumber: c.OrderNumber.String,
+		ExpiresAt:   c.ExpiresAt,
+		CreatedAt:   c.CreatedAt,
+		UpdatedAt:   c.UpdatedAt,
+	}
+	if c.CheckedOutAt.Valid {
+		response.CheckedOutAt = &c.CheckedOutAt.Time
+	}
+	return response
+}
@@ -0,0 +1,303 @@
+package cart
+
+import (
+	"io"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for carts
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new cart handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers cart routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	carts := rg.Group("/carts", middleware.RequireAuth())
+	{
+		carts.POST("", h.CreateCart)
+		carts.GET("", h.ListCarts)
+		carts.GET("/:cartId", h.GetCart)
+		carts.PUT("/:cartId/items/:sku", h.SetItem)
+		carts.DELETE("/:cartId/items/:sku", h.RemoveItem)
+		carts.POST("/:cartId/checkout", h.Checkout)
+	}
+}
+
+// cartAccess returns the caller's cart access
+func cartAccess(principal *middleware.Principal) Access {
+	return Access{
+		Admin:    principal.IsAdmin(),
+		CanActAs: principal.CanActAs,
+	}
+}
+
+// extractCartID extracts and validates the cart id from path
+func extractCartID(c *gin.Context) (string, error) {
+	cartID := c.Param("cartId")
+	if _, err := uuid.Parse(cartID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+	return cartID, nil
+}
+
+// CreateCart godoc
+// @Summary Create cart
+// @Description Create an empty cart of the buyer (default: caller) for one seller's products, paid in token_symbol.
+// @Description A cart expires after the cart TTL without changes; every change extends it.
+// @Tags carts
+// @Accept json
+// @Produce json
+// @Param request body CreateCartRequest true "Cart"
+// @Success 201 {object} middleware.SuccessResponse{data=CartResponse} "Cart created"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input, inactive seller or unsupported token"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot create carts for another user"
+// @Failure 404 {object} middleware.ErrorResponse "Buyer or seller not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/carts [post]
+func (h *Handler) CreateCart(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req CreateCartRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+	if req.BuyerID == "" {
+		req.BuyerID = principal.UserExternalID
+	}
+
+	result, err := h.service.CreateCart(c.Request.Context(), &req, cartAccess(principal))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// ListCarts godoc
+// @Summary List carts
+// @Description List the caller's carts, newest first, with an optional status filter (stock is not checked in the list)
+// @Tags carts
+// @Produce json
+// @Param status query string false "Status filter" Enums(ACTIVE, CHECKED_OUT, EXPIRED)
+// @Param page query int false "Page number" default(1) minimum(1)
+// @Param page_size query int false "Page size" default(20) minimum(1) maximum(100)
+// @Success 200 {object} middleware.SuccessResponse{data=ListCartsResponse} "Cart list"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "No user bound to the credential"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/carts [get]
+func (h *Handler) ListCarts(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req ListCartsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	if principal.UserID == 0 {
+		middleware.RespondError(c, errors.Forbidden("No user bound to the credential"))
+		return
+	}
+
+	result, err := h.service.ListCarts(c.Request.Context(), principal.UserID, &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// GetCart godoc
+// @Summary Get cart
+// @Description Get a cart with its items (buyer or admin). Items of an active cart report the available stock
+// @Description and an issue that blocks checkout (inactive product, insufficient stock, changed price).
+// @Tags carts
+// @Produce json
+// @Param cartId path string true "Cart external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=CartResponse} "Cart"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid cart ID"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Cart not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/carts/{cartId} [get]
+func (h *Handler) GetCart(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	cartID, err := extractCartID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	result, err := h.service.GetCart(c.Request.Context(), cartID, cartAccess(principal))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// SetItem godoc
+// @Summary Set cart item
+// @Description Add a product to an active cart or change its quantity (buyer or admin). The product must be active
+// @Description and the quantity within its available stock; all items are repriced to the current catalog price.
+// @Tags carts
+// @Accept json
+// @Produce json
+// @Param cartId path string true "Cart external ID (UUID)"
+// @Param sku path string true "Product SKU"
+// @Param request body SetItemRequest true "Quantity"
+// @Success 200 {object} middleware.SuccessResponse{data=CartResponse} "Cart updated"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input, inactive product, insufficient stock or too many items"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Cart or product not found"
+// @Failure 409 {object} middleware.ErrorResponse "Cart is checked out or expired"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/carts/{cartId}/items/{sku} [put]
+func (h *Handler) SetItem(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	cartID, err := extractCartID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req SetItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.SetItem(c.Request.Context(), cartID, c.Param("sku"), &req, cartAccess(principal))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// RemoveItem godoc
+// @Summary Remove cart item
+// @Description Remove a product from an active cart (buyer or admin); the remaining items are repriced to the current catalog price
+// @Tags carts
+// @Produce json
+// @Param cartId path string true "Cart external ID (UUID)"
+// @Param sku path string true "Product SKU"
+// @Success 200 {object} middleware.SuccessResponse{data=CartResponse} "Cart updated"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid cart ID"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Cart or cart item not found"
+// @Failure 409 {object} middleware.ErrorResponse "Cart is checked out or expired"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/carts/{cartId}/items/{sku} [delete]
+func (h *Handler) RemoveItem(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	cartID, err := extractCartID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	result, err := h.service.RemoveItem(c.Request.Context(), cartID, c.Param("sku"), cartAccess(principal))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// Checkout godoc
+// @Summary Check out cart
+// @Description Convert an active cart into a PENDING order for the seller to confirm (buyer or admin), in one transaction.
+// @Description The order takes the cart's prices with volume discounts, the optional coupon and taxes on the discounted amounts;
+// @Description the ordered quantities are reserved at ACTIVE warehouses. NEAREST fills each item from warehouses in the ship-to country first,
+// @Description then by warehouse priority (may split); SINGLE_SOURCE reserves the whole order at the nearest warehouse that can fulfil it.
+// @Description The reservation is held until reservation_expires_at; an order still unpaid then becomes PAYMENT_EXPIRED and its stock is released.
+// @Description If catalog prices changed since the cart was last changed, the cart is repriced and 409 lists the changes - review the cart and check out again. Audited.
+// @Tags carts
+// @Accept json
+// @Produce json
+// @Param cartId path string true "Cart external ID (UUID)"
+// @Param request body CheckoutRequest false "Checkout"
+// @Success 201 {object} middleware.SuccessResponse{data=CheckoutResponse} "Order created"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input, empty cart or invalid coupon"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Cart not found"
+// @Failure 409 {object} middleware.ErrorResponse "Cart is checked out or expired, prices changed, product inactive, insufficient stock or no single warehouse can fulfil the order"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/carts/{cartId}/checkout [post]
+func (h *Handler) Checkout(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	cartID, err := extractCartID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req CheckoutRequest
+	// NOTE: 본문 생략 시 쿠폰 없이 주문
+	if err := c.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.Checkout(c.Request.Context(), cartID, &req, cartAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
@@ -0,0 +1,946 @@
+package cart
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/catalog"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/docnumber"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/discount"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/tax"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// amountScale is the scale of cart and order amounts (DECIMAL(18,2))
+const amountScale = 2
+
+// maxItems is the maximum number of products in a cart (same as a purchase order)
+const maxItems = 100
+
+// Inventory log reference of order reservations
+const (
+	referenceOrder    = "ORDER"
+	reasonOrderPlaced = "ORDER_PLACED"
+)
+
+// Audit log identifiers
+const (
+	actionCheckedOut = "CART_CHECKED_OUT"
+	resourceCart     = "CART"
+)
+
+// Config holds cart settings
+type Config struct {
+	// TTL is how long a cart stays active after its last change
+	TTL time.Duration
+	// ReservationStrategy is the stock reservation strategy of checkouts that name none (NEAREST, SINGLE_SOURCE)
+	ReservationStrategy string
+	// ReservationTTL is how long a checkout's stock reservation is held without payment (0 = until released)
+	ReservationTTL time.Duration
+}
+
+// Access is the caller's access to carts
+type Access struct {
+	// Admin may view and change any cart
+	Admin bool
+	// CanActAs reports whether the caller may operate on the user's resources (buyer external ID)
+	CanActAs func(userExternalID string) bool
+}
+
+// Service handles carts (draft orders).
+// The buyer collects catalog products of one seller; every change revalidates the cart against the catalog
+// (prices, product status, stock) and checkout converts it into a PENDING order in one transaction.
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	tokens   *chain.TokenRegistry
+	taxes    *tax.Engine
+	config   Config
+	logger   *zap.Logger
+}
+
+// NewService creates a new cart service
+func NewService(txRunner *pkgdb.TxRunner, tokens *chain.TokenRegistry, taxes *tax.Engine, config Config, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		tokens:   tokens,
+		taxes:    taxes,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// CreateCart creates an empty cart of the buyer for the seller's products (buyer or admin)
+func (s *Service) CreateCart(ctx context.Context, req *CreateCartRequest, access Access) (*CartResponse, error) {
+	if !access.CanActAs(req.BuyerID) {
+		return nil, errors.Forbidden("Cannot create carts for another user")
+	}
+	if req.BuyerID == req.SellerID {
+		return nil, errors.InvalidInput("seller_id must differ from the buyer")
+	}
+	token, err := s.tokens.Resolve(req.TokenSymbol, 0)
+	if err != nil {
+		return nil, errors.InvalidInput("unsupported token_symbol")
+	}
+
+	q := s.txRunner.Queries()
+	buyer, err := s.getUser(ctx, q, req.BuyerID, "Buyer")
+	if err != nil {
+		return nil, err
+	}
+	seller, err := s.getUser(ctx, q, req.SellerID, "Seller")
+	if err != nil {
+		return nil, err
+	}
+	if seller.Status != db.UsersStatusACTIVE {
+		return nil, errors.InvalidInput("seller is not active")
+	}
+
+	externalID := uuid.New().String()
+	if _, err := q.CreateCart(ctx, db.CreateCartParams{
+		ExternalID:  externalID,
+		BuyerID:     buyer.ID,
+		SellerID:    seller.ID,
+		TokenSymbol: token.Symbol,
+		ExpiresAt:   time.Now().UTC().Add(s.config.TTL),
+	}); err != nil {
+		logctx.From(ctx, s.logger).Error("failed to create cart", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetCart(ctx, externalID, access)
+}
+
+// GetCart returns a cart with its items and their current issues (buyer or admin)
+func (s *Service) GetCart(ctx context.Context, externalID string, access Access) (*CartResponse, error) {
+	q := s.txRunner.Queries()
+	detail, err := s.getDetail(ctx, q, externalID, access)
+	if err != nil {
+		return nil, err
+	}
+	return s.toResponse(ctx, q, detail, detail.Status == db.CartsStatusACTIVE)
+}
+
+// ListCarts returns the buyer's carts, newest first (stock is not checked in the list)
+func (s *Service) ListCarts(ctx context.Context, buyerID uint64, req *ListCartsRequest) (*ListCartsResponse, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	var status db.NullCartsStatus
+	if req.Status != "" {
+		status = db.NullCartsStatus{CartsStatus: db.CartsStatus(req.Status), Valid: true}
+	}
+
+	q := s.txRunner.Queries()
+	rows, err := q.ListCartsByBuyer(ctx, db.ListCartsByBuyerParams{
+		BuyerID: buyerID,
+		Status:  status,
+		Limit:   int32(req.PageSize),
+		Offset:  int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list carts", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := q.CountCartsByBuyer(ctx, db.CountCartsByBuyerParams{
+		BuyerID: buyerID,
+		Status:  status,
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count carts", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	carts := make([]CartResponse, 0, len(rows))
+	for i := range rows {
+		detail := db.GetCartDetailRow(rows[i])
+		response, err := s.toResponse(ctx, q, &detail, false)
+		if err != nil {
+			return nil, err
+		}
+		carts = append(carts, *response)
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListCartsResponse{
+		Carts:      carts,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// SetItem adds a product to the cart or changes its quantity (buyer or admin).
+// The product must be active with enough available stock; all items are repriced to the current catalog
+// price and the cart's expiry is extended.
+func (s *Service) SetItem(ctx context.Context, externalID, sku string, req *SetItemRequest, access Access) (*CartResponse, error) {
+	detail, err := s.getDetail(ctx, s.txRunner.Queries(), externalID, access)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		cart, err := s.lockActive(ctx, q, detail.ID)
+		if err != nil {
+			return err
+		}
+		product, err := q.GetProductBySKU(ctx, sku)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return errors.NotFound("Product")
+			}
+			return err
+		}
+		if product.Status != db.ProductsStatusACTIVE {
+			return errors.InvalidInput("product is not active: " + sku)
+		}
+		if err := catalog.CheckSellable(ctx, q, &product); err != nil {
+			return err
+		}
+		price, err := s.parseStoredAmount(ctx, product.Price)
+		if err != nil {
+			return err
+		}
+
+		items, err := q.ListCartItems(ctx, cart.ID)
+		if err != nil {
+			return err
+		}
+		exists := slices.ContainsFunc(items, func(item db.ListCartItemsRow) bool { return item.ProductID == product.ID })
+		if !exists && len(items) >= maxItems {
+			return errors.InvalidInput("cart already holds the maximum number of products").
+				WithDetails(map[string]any{"max_items": maxItems})
+		}
+
+		available, err := availableQuantity(ctx, q, product.ID)
+		if err != nil {
+			return err
+		}
+		if available != nil && int64(req.Quantity) > *available {
+			return errors.InvalidInput("quantity exceeds the available stock of " + sku).
+				WithDetails(map[string]any{"sku": sku, "available_quantity": *available})
+		}
+
+		if err := q.UpsertCartItem(ctx, db.UpsertCartItemParams{
+			CartID:    cart.ID,
+			ProductID: product.ID,
+			Quantity:  req.Quantity,
+			UnitPrice: price.String(),
+		}); err != nil {
+			return err
+		}
+		if _, err := s.reprice(ctx, q, items); err != nil {
+			return err
+		}
+		return q.TouchCart(ctx, db.TouchCartParams{ExpiresAt: time.Now().UTC().Add(s.config.TTL), ID: cart.ID})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to set cart item", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetCart(ctx, externalID, access)
+}
+
+// RemoveItem removes a product from the cart (buyer or admin); the remaining items are repriced
+func (s *Service) RemoveItem(ctx context.Context, externalID, sku string, access Access) (*CartResponse, error) {
+	detail, err := s.getDetail(ctx, s.txRunner.Queries(), externalID, access)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		cart, err := s.lockActive(ctx, q, detail.ID)
+		if err != nil {
+			return err
+		}
+		product, err := q.GetProductBySKU(ctx, sku)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return errors.NotFound("Cart item")
+			}
+			return err
+		}
+		n, err := q.DeleteCartItem(ctx, db.DeleteCartItemParams{CartID: cart.ID, ProductID: product.ID})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return errors.NotFound("Cart item")
+		}
+
+		items, err := q.ListCartItems(ctx, cart.ID)
+		if err != nil {
+			return err
+		}
+		if _, err := s.reprice(ctx, q, items); err != nil {
+			return err
+		}
+		return q.TouchCart(ctx, db.TouchCartParams{ExpiresAt: time.Now().UTC().Add(s.config.TTL), ID: cart.ID})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to remove cart item", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetCart(ctx, externalID, access)
+}
+
+// checkoutResult is the outcome of the checkout transaction
+type checkoutResult struct {
+	changed     []PriceChange
+	order       string
+	subtotal    money.Money
+	discounts   money.Money
+	taxes       money.Money
+	total       money.Money
+	reserved    int64
+	strategy    string
+	expiresAt   *time.Time
+	allocations []allocation
+}
+
+// Checkout converts the cart into a PENDING order for the seller to confirm (buyer or admin).
+// The order takes the cart's prices, volume discounts and the coupon, and taxes on the discounted amounts;
+// the ordered quantities are reserved in inventory by the reservation strategy (ship-to country = request, else buyer's tax country).
+//
+// Why:
+//   - 장바구니 row-lock 후 상태 확인 → 동시 전환/변경이 겹쳐도 주문은 한 번만 생성
+//   - 마지막 변경 이후 카탈로그 가격이 바뀌었으면 새 가격으로 갱신만 커밋하고 409 → 구매자가 확인한 가격으로만 주문
+//   - 재고는 재고 id 순으로 row-lock 후 예약 (결제 만료/주문 취소의 예약 해제와 같은 순서, deadlock 방지)
+func (s *Service) Checkout(ctx context.Context, externalID string, req *CheckoutRequest, access Access, actor audit.Actor) (*CheckoutResponse, error) {
+	detail, err := s.getDetail(ctx, s.txRunner.Queries(), externalID, access)
+	if err != nil {
+		return nil, err
+	}
+	couponCode := discount.NormalizeCode(req.CouponCode)
+	strategy := req.ReservationStrategy
+	if strategy == "" {
+		strategy = s.config.ReservationStrategy
+	}
+
+	res, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*checkoutResult, error) {
+		cart, err := s.lockActive(ctx, q, detail.ID)
+		if err != nil {
+			return nil, err
+		}
+		items, err := q.ListCartItems(ctx, cart.ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(items) == 0 {
+			return nil, errors.InvalidInput("cart is empty")
+		}
+		for _, item := range items {
+			if item.ProductStatus != db.ProductsStatusACTIVE {
+				return nil, errors.Conflict("product is no longer active: " + item.Sku).
+					WithDetails(map[string]any{"sku": item.Sku})
+			}
+		}
+		changed, err := s.reprice(ctx, q, items)
+		if err != nil {
+			return nil, err
+		}
+		if len(changed) > 0 {
+			return &checkoutResult{changed: changed}, nil
+		}
+
+		// 1. Price (cart prices = catalog prices), discounts, taxes
+		lines := make([]discount.Line, 0, len(items))
+		subtotal := money.Zero("", amountScale)
+		for i, item := range items {
+			unitPrice, err := s.parseStoredAmount(ctx, item.UnitPrice)
+			if err != nil {
+				return nil, err
+			}
+			amount := lineTotal(unitPrice, item.Quantity)
+			lines = append(lines, discount.Line{
+				LineNo:    uint32(i + 1),
+				ProductID: item.ProductID,
+				Quantity:  item.Quantity,
+				Amount:    amount,
+			})
+			subtotal = subtotal.Add(amount)
+		}
+		if subtotal.Sign() <= 0 {
+			return nil, errors.InvalidInput("order total must be positive")
+		}
+		now := time.Now().UTC()
+		discounts, err := discount.Calculate(ctx, q, cart.SellerID, cart.BuyerID, lines, couponCode, now)
+		if err != nil {
+			return nil, couponError(err, errors.InvalidInput)
+		}
+		taxLines := make([]tax.Line, 0, len(lines))
+		for _, l := range lines {
+			taxLines = append(taxLines, tax.Line{
+				LineNo:    l.LineNo,
+				ProductID: l.ProductID,
+				Amount:    discounts.Net(l.LineNo),
+			})
+		}
+		taxes, err := s.taxes.Calculate(ctx, q, cart.BuyerID, cart.TokenSymbol, taxLines)
+		if err != nil {
+			return nil, err
+		}
+		total := subtotal.Sub(discounts.Total).Add(taxes.Total)
+
+		// 2. Order + items + discounts + taxes
+		orderNumber, err := docnumber.Next(ctx, q, docnumber.PrefixOrder, now)
+		if err != nil {
+			return nil, err
+		}
+		result, err := q.CreateOrder(ctx, db.CreateOrderParams{
+			OrderNumber: orderNumber,
+			BuyerID:     cart.BuyerID,
+			SellerID:    cart.SellerID,
+			Status:      db.OrdersStatusPENDING,
+			TotalAmount: total.String(),
+			TokenSymbol: cart.TokenSymbol,
+		})
+		if err != nil {
+			return nil, err
+		}
+		orderID, err := result.LastInsertId()
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			if err := q.CreateOrderItem(ctx, db.CreateOrderItemParams{
+				OrderID:   uint64(orderID),
+				ProductID: item.ProductID,
+				Quantity:  item.Quantity,
+				UnitPrice: item.UnitPrice,
+			}); err != nil {
+				return nil, err
+			}
+		}
+		if err := discount.Apply(ctx, q, uint64(orderID), cart.BuyerID, discounts); err != nil {
+			return nil, couponError(err, errors.Conflict)
+		}
+		if err := tax.ApplyToOrder(ctx, q, uint64(orderID), taxes); err != nil {
+			return nil, err
+		}
+
+		// 3. Reserve stock, close cart
+		country, err := shipToCountry(ctx, q, cart.BuyerID, req.ShipToCountry)
+		if err != nil {
+			return nil, err
+		}
+		allocations, err := reserveStock(ctx, q, uint64(orderID), items, strategy, country, actor)
+		if err != nil {
+			return nil, err
+		}
+		var reserved int64
+		for _, a := range allocations {
+			reserved += a.quantity
+		}
+		// NOTE: 결제가 오지 않은 주문의 예약은 만료 작업이 해제 (order.ExpiryWorker)
+		var expiresAt *time.Time
+		if reserved > 0 && s.config.ReservationTTL > 0 {
+			t := time.Now().UTC().Add(s.config.ReservationTTL)
+			expiresAt = &t
+			if err := q.SetOrderReservationExpiry(ctx, db.SetOrderReservationExpiryParams{
+				ReservationExpiresAt: sql.NullTime{Time: t, Valid: true},
+				ID:                   uint64(orderID),
+			}); err != nil {
+				return nil, err
+			}
+		}
+		n, err := q.CheckoutCart(ctx, db.CheckoutCartParams{
+			OrderID: sql.NullInt64{Int64: orderID, Valid: true},
+			ID:      cart.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return nil, errors.Conflict("Cart is no longer active")
+		}
+
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionCheckedOut,
+			ResourceType: resourceCart,
+			ResourceID:   cart.ID,
+			OldValue:     map[string]any{"status": string(cart.Status)},
+			NewValue: map[string]any{
+				"status":               string(db.CartsStatusCHECKEDOUT),
+				"order_number":         orderNumber,
+				"total_amount":         total.String(),
+				"discount_amount":      discounts.Total.String(),
+				"tax_amount":           taxes.Total.String(),
+				"reserved_quantity":    reserved,
+				"reservation_strategy": strategy,
+			},
+		}); err != nil {
+			return nil, err
+		}
+		return &checkoutResult{
+			order:       orderNumber,
+			subtotal:    subtotal,
+			discounts:   discounts.Total,
+			taxes:       taxes.Total,
+			total:       total,
+			reserved:    reserved,
+			strategy:    strategy,
+			expiresAt:   expiresAt,
+			allocations: allocations,
+		}, nil
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to check out cart", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if len(res.changed) > 0 {
+		return nil, errors.Conflict("Cart prices changed - review the cart and check out again").
+			WithDetails(map[string]any{"items": res.changed})
+	}
+
+	logctx.From(ctx, s.logger).Info("cart checked out",
+		zap.String("cart_external_id", externalID),
+		zap.String("order_number", res.order),
+		zap.Stringer("total_amount", res.total),
+	)
+	cart, err := s.GetCart(ctx, externalID, access)
+	if err != nil {
+		return nil, err
+	}
+	allocations := make([]AllocationResponse, 0, len(res.allocations))
+	for _, a := range res.allocations {
+		allocations = append(allocations, AllocationResponse{SKU: a.sku, Location: a.location, Quantity: a.quantity})
+	}
+	return &CheckoutResponse{
+		OrderNumber:          res.order,
+		OrderStatus:          string(db.OrdersStatusPENDING),
+		Subtotal:             res.subtotal,
+		DiscountAmount:       res.discounts,
+		TaxAmount:            res.taxes,
+		TotalAmount:          res.total,
+		ReservedQuantity:     res.reserved,
+		ReservationStrategy:  res.strategy,
+		ReservationExpiresAt: res.expiresAt,
+		Allocations:          allocations,
+		Cart:                 cart,
+	}, nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// lockActive locks a cart that can still be changed
+func (s *Service) lockActive(ctx context.Context, q *db.Queries, cartID uint64) (*db.Cart, error) {
+	cart, err := q.GetCartForUpdate(ctx, cartID)
+	if err != nil {
+		return nil, err
+	}
+	if cart.Status != db.CartsStatusACTIVE {
+		return nil, errors.Conflict("Cart is " + string(cart.Status))
+	}
+	// NOTE: 만료 워커 실행 전이라도 만료 시각이 지났으면 변경 불가
+	if !time.Now().Before(cart.ExpiresAt) {
+		return nil, errors.Conflict("Cart has expired")
+	}
+	return &cart, nil
+}
+
+// reprice updates the items of active products to their current catalog price and returns the changes
+func (s *Service) reprice(ctx context.Context, q *db.Queries, items []db.ListCartItemsRow) ([]PriceChange, error) {
+	var changed []PriceChange
+	for i := range items {
+		item := &items[i]
+		if item.ProductStatus != db.ProductsStatusACTIVE {
+			continue
+		}
+		cartPrice, err := s.parseStoredAmount(ctx, item.UnitPrice)
+		if err != nil {
+			return nil, err
+		}
+		price, err := s.parseStoredAmount(ctx, item.CatalogPrice)
+		if err != nil {
+			return nil, err
+		}
+		if cartPrice.Cmp(price) == 0 {
+			continue
+		}
+		if err := q.UpdateCartItemPrice(ctx, db.UpdateCartItemPriceParams{UnitPrice: price.String(), ID: item.ID}); err != nil {
+			return nil, err
+		}
+		changed = append(changed, PriceChange{SKU: item.Sku, CartPrice: cartPrice.String(), Price: price.String()})
+		item.UnitPrice = price.String()
+	}
+	return changed, nil
+}
+
+// getUser retrieves a party of a cart by external ID
+func (s *Service) getUser(ctx context.Context, q *db.Queries, externalID, resource string) (*db.User, error) {
+	user, err := q.GetUserByExternalID(ctx, sql.NullString{String: externalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound(resource)
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &user, nil
+}
+
+// getDetail retrieves a cart with its parties if the caller is its buyer (or an admin)
+func (s *Service) getDetail(ctx context.Context, q *db.Queries, externalID string, access Access) (*db.GetCartDetailRow, error) {
+	detail, err := q.GetCartDetail(ctx, externalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Cart")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get cart", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if !access.Admin && !access.CanActAs(detail.BuyerExternalID.String) {
+		return nil, errors.NotFound("Cart")
+	}
+	return &detail, nil
+}
+
+// toResponse loads the cart's items and converts the cart; checkIssues reports each item's
+// current issue (catalog price, product status and available stock)
+func (s *Service) toResponse(ctx context.Context, q *db.Queries, detail *db.GetCartDetailRow, checkIssues bool) (*CartResponse, error) {
+	rows, err := q.ListCartItems(ctx, detail.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list cart items", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	items := make([]CartItemResponse, 0, len(rows))
+	subtotal := money.Zero("", amountScale)
+	for _, row := range rows {
+		unitPrice, err := s.parseStoredAmount(ctx, row.UnitPrice)
+		if err != nil {
+			return nil, err
+		}
+		item := CartItemResponse{
+			SKU:         row.Sku,
+			ProductName: row.ProductName,
+			Quantity:    row.Quantity,
+			UnitPrice:   unitPrice,
+			LineTotal:   lineTotal(unitPrice, row.Quantity),
+		}
+		if checkIssues {
+			if err := s.checkItem(ctx, q, &row, &item); err != nil {
+				return nil, err
+			}
+		}
+		items = append(items, item)
+		subtotal = subtotal.Add(item.LineTotal)
+	}
+	return ToCartResponse(detail, items, subtotal), nil
+}
+
+// checkItem fills the item's available stock and its issue, if any
+func (s *Service) checkItem(ctx context.Context, q *db.Queries, row *db.ListCartItemsRow, item *CartItemResponse) error {
+	if row.ProductStatus != db.ProductsStatusACTIVE {
+		item.Issue = IssueProductInactive
+		return nil
+	}
+	available, err := availableQuantity(ctx, q, row.ProductID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to get product stock", zap.Error(err))
+		return errors.DBError(err)
+	}
+	item.AvailableQuantity = available
+	if available != nil && int64(row.Quantity) > *available {
+		item.Issue = IssueInsufficientStock
+		return nil
+	}
+	price, err := s.parseStoredAmount(ctx, row.CatalogPrice)
+	if err != nil {
+		return err
+	}
+	if price.Cmp(item.UnitPrice) != 0 {
+		item.Issue = IssuePriceChanged
+	}
+	return nil
+}
+
+// parseStoredAmount parses a DECIMAL(18,2) column
+func (s *Service) parseStoredAmount(ctx context.Context, value string) (money.Money, error) {
+	amount, err := money.Parse(value, "", amountScale)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("invalid stored amount", zap.String("amount", value), zap.Error(err))
+		return money.Money{}, errors.Internal("Invalid stored amount")
+	}
+	return amount, nil
+}
+
+// availableQuantity returns the unreserved stock of a product at ACTIVE warehouses (nil = stock not tracked)
+func availableQuantity(ctx context.Context, q *db.Queries, productID uint64) (*int64, error) {
+	inventories, err := q.ListInventoryLocations(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if len(inventories) == 0 {
+		return nil, nil
+	}
+	var available int64
+	for _, inv := range inventories {
+		if inv.WarehouseStatus == db.WarehousesStatusACTIVE {
+			available += max(inv.Quantity-inv.ReservedQuantity, 0)
+		}
+	}
+	return &available, nil
+}
+
+// shipToCountry returns the country an order ships to: the requested one, else the buyer's tax country ("" = unknown)
+func shipToCountry(ctx context.Context, q *db.Queries, buyerID uint64, requested string) (string, error) {
+	if requested != "" {
+		return strings.ToUpper(requested), nil
+	}
+	profile, err := q.GetTaxProfile(ctx, buyerID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+		return "", err
+	}
+	return profile.Country, nil
+}
+
+// allocation is a quantity of an ordered product reserved at an inventory location
+type allocation struct {
+	inventoryID uint64
+	sku         string
+	location    string
+	quantity    int64
+}
+
+// reserveStock reserves the ordered quantities at ACTIVE warehouses by the reservation strategy and records
+// RESERVE logs and stock movements for the order (and low-stock events for products crossing their reorder threshold);
+// returns the allocations per product and location. Products without inventory rows are not stock-tracked
+// and skipped. Must be called within a transaction.
+//
+// Why:
+//   - NEAREST: 배송 국가 창고 → 우선순위 순으로 채움 (여러 창고 분할 가능)
+//   - SINGLE_SOURCE: 모든 항목을 채울 수 있는 가장 가까운 창고 1곳에서만 예약 (분할 출고 없음, 없으면 409)
+//   - 국가 미지정(배송 국가/창고 국가 없음)이면 우선순위 순 → 창고 추가 전 동작(재고 id 순)과 같은 결과
+func reserveStock(ctx context.Context, q *db.Queries, orderID uint64, items []db.ListCartItemsRow, strategy, country string, actor audit.Actor) ([]allocation, error) {
+	// 1. Lock the products' inventories at ACTIVE warehouses in id order
+	sources := make(map[uint64][]db.ListInventoryLocationsRow, len(items))
+	tracked := make(map[uint64]bool, len(items))
+	var ids []uint64
+	for _, item := range items {
+		inventories, err := q.ListInventoryLocations(ctx, item.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		tracked[item.ProductID] = len(inventories) > 0
+		for _, inv := range inventories {
+			if inv.WarehouseStatus != db.WarehousesStatusACTIVE {
+				continue
+			}
+			sources[item.ProductID] = append(sources[item.ProductID], inv)
+			ids = append(ids, inv.ID)
+		}
+	}
+	slices.Sort(ids)
+	locked := make(map[uint64]db.Inventory, len(ids))
+	for _, id := range ids {
+		inv, err := q.GetInventoryForUpdate(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		locked[id] = inv
+	}
+	available := func(id uint64) int64 {
+		return max(locked[id].Quantity-locked[id].ReservedQuantity, 0)
+	}
+
+	// 2. Rank warehouses: ship-to country first, then priority (sources are listed in priority order)
+	distance := func(inv *db.ListInventoryLocationsRow) int {
+		if country != "" && inv.WarehouseCountry.String == country {
+			return 0
+		}
+		return 1
+	}
+	for productID := range sources {
+		slices.SortStableFunc(sources[productID], func(a, b db.ListInventoryLocationsRow) int {
+			return cmp.Compare(distance(&a), distance(&b))
+		})
+	}
+
+	// 3. Allocate by strategy
+	var allocations []allocation
+	switch strategy {
+	case ReservationSingleSource:
+		warehouse, ok := pickSingleSource(items, sources, tracked, available, distance)
+		if !ok {
+			return nil, errors.Conflict("no single warehouse can fulfil the order").
+				WithDetails(map[string]any{"reservation_strategy": ReservationSingleSource})
+		}
+		for _, item := range items {
+			for _, inv := range sources[item.ProductID] {
+				if inv.WarehouseID == warehouse {
+					allocations = append(allocations, allocation{
+						inventoryID: inv.ID,
+						sku:         item.Sku,
+						location:    inv.Location,
+						quantity:    int64(item.Quantity),
+					})
+				}
+			}
+		}
+	default:
+		for _, item := range items {
+			if !tracked[item.ProductID] {
+				continue
+			}
+			var total int64
+			for _, inv := range sources[item.ProductID] {
+				total += available(inv.ID)
+			}
+			remaining := int64(item.Quantity)
+			if remaining > total {
+				return nil, errors.Conflict("insufficient stock for " + item.Sku).
+					WithDetails(map[string]any{"sku": item.Sku, "available_quantity": total})
+			}
+			for _, inv := range sources[item.ProductID] {
+				if remaining == 0 {
+					break
+				}
+				take := min(available(inv.ID), remaining)
+				if take == 0 {
+					continue
+				}
+				allocations = append(allocations, allocation{
+					inventoryID: inv.ID,
+					sku:         item.Sku,
+					location:    inv.Location,
+					quantity:    take,
+				})
+				remaining -= take
+			}
+		}
+	}
+
+	// 4. Reserve + logs, then low-stock alerts per product
+	for _, a := range allocations {
+		inv := locked[a.inventoryID]
+		reserved := inv.ReservedQuantity + a.quantity
+		if err := q.UpdateInventoryReservedQuantity(ctx, db.UpdateInventoryReservedQuantityParams{
+			ReservedQuantity: reserved,
+			ID:               inv.ID,
+		}); err != nil {
+			return nil, err
+		}
+		if err := catalog.RecordMovement(ctx, q, actor, catalog.Movement{
+			Inventory:     &inv,
+			EventType:     db.InventoryLogsEventTypeRESERVE,
+			Reason:        db.StockMovementsReasonRESERVATION,
+			ReservedDelta: a.quantity,
+			ReferenceType: referenceOrder,
+			ReferenceID:   orderID,
+			Note:          reasonOrderPlaced,
+		}); err != nil {
+			return nil, err
+		}
+		inv.ReservedQuantity = reserved
+		locked[a.inventoryID] = inv
+	}
+	for _, item := range items {
+		if !tracked[item.ProductID] {
+			continue
+		}
+		if err := catalog.NotifyLowStock(ctx, q, item.ProductID, int64(item.Quantity), db.InventoryLogsEventTypeRESERVE); err != nil {
+			return nil, err
+		}
+	}
+	return allocations, nil
+}
+
+// pickSingleSource returns the nearest warehouse (then by priority) whose available stock covers every
+// stock-tracked item; ok is false when no warehouse can. Orders without stock-tracked items need no warehouse.
+func pickSingleSource(items []db.ListCartItemsRow, sources map[uint64][]db.ListInventoryLocationsRow, tracked map[uint64]bool,
+	available func(id uint64) int64, distance func(inv *db.ListInventoryLocationsRow) int) (warehouse uint64, ok bool) {
+	var candidates []db.ListInventoryLocationsRow
+	seen := make(map[uint64]bool)
+	needed := false
+	for _, item := range items {
+		if !tracked[item.ProductID] {
+			continue
+		}
+		needed = true
+		for _, inv := range sources[item.ProductID] {
+			if !seen[inv.WarehouseID] {
+				seen[inv.WarehouseID] = true
+				candidates = append(candidates, inv)
+			}
+		}
+	}
+	if !needed {
+		return 0, true
+	}
+	slices.SortStableFunc(candidates, func(a, b db.ListInventoryLocationsRow) int {
+		return cmp.Or(
+			cmp.Compare(distance(&a), distance(&b)),
+			cmp.Compare(a.WarehousePriority, b.WarehousePriority),
+			cmp.Compare(a.Location, b.Location),
+		)
+	})
+
+	for _, candidate := range candidates {
+		fulfils := true
+		for _, item := range items {
+			if !tracked[item.ProductID] {
+				continue
+			}
+			idx := slices.IndexFunc(sources[item.ProductID], func(inv db.ListInventoryLocationsRow) bool {
+				return inv.WarehouseID == candidate.WarehouseID
+			})
+			if idx < 0 || available(sources[item.ProductID][idx].ID) < int64(item.Quantity) {
+				fulfils = false
+				break
+			}
+		}
+		if fulfils {
+			return candidate.WarehouseID, true
+		}
+	}
+	return 0, false
+}
+
+// couponError maps a coupon problem to the caller's error code; other errors pass through
+func couponError(err error, toAppError func(message string) *errors.AppError) error {
+	var couponErr *discount.CouponError
+	if stderrors.As(err, &couponErr) {
+		return toAppError(couponErr.Reason)
+	}
+	return err
+}
+
+// lineTotal returns unit price × quantity
+func lineTotal(unitPrice money.Money, quantity uint32) money.Money {
+	return unitPrice.MulFrac(int64(quantity), 1, money.RoundDown)
+}
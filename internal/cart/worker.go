@@ -0,0 +1,85 @@
+package cart
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"go.uber.org/zap"
+)
+
+// WorkerConfig holds cart expiry sweep settings
+type WorkerConfig struct {
+	// Interval is the period of the expiry sweep
+	Interval time.Duration
+	// BatchSize is the maximum number of carts expired per statement
+	BatchSize int
+}
+
+// Worker expires ACTIVE carts past their expires_at (ACTIVE → EXPIRED).
+// Carts hold no reservations, so expiring one only closes it for changes and checkout.
+type Worker struct {
+	txRunner *pkgdb.TxRunner
+	config   WorkerConfig
+	logger   *zap.Logger
+}
+
+// NewWorker creates a new cart expiry worker
+func NewWorker(txRunner *pkgdb.TxRunner, config WorkerConfig, logger *zap.Logger) *Worker {
+	return &Worker{
+		txRunner: txRunner,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Run executes the expiry sweep periodically until ctx is canceled
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	logctx.From(ctx, w.logger).Info("cart expiry job started",
+		zap.Duration("interval", w.config.Interval),
+		zap.Int("batch_size", w.config.BatchSize),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logctx.From(ctx, w.logger).Info("cart expiry job stopped")
+			return
+		case <-ticker.C:
+			expired, err := w.RunOnce(ctx, time.Now().UTC())
+			if err != nil {
+				logctx.From(ctx, w.logger).Error("cart expiry run failed", zap.Error(err))
+				continue
+			}
+			if expired > 0 {
+				logctx.From(ctx, w.logger).Info("cart expiry run completed", zap.Int64("expired", expired))
+			}
+		}
+	}
+}
+
+// RunOnce expires carts past expires_at in batches until none are left; returns the number expired.
+// NOTE: 조건부 UPDATE(status = 'ACTIVE') → 동시 결제 전환과 경합 시 한쪽만 반영
+func (w *Worker) RunOnce(ctx context.Context, now time.Time) (int64, error) {
+	var total int64
+	for ctx.Err() == nil {
+		n, err := w.txRunner.Queries().ExpireCarts(ctx, db.ExpireCartsParams{
+			Now:   now,
+			Limit: int32(w.config.BatchSize),
+		})
+		if err != nil {
+			return total, fmt.Errorf("expire carts: %w", err)
+		}
+		total += n
+		if n < int64(w.config.BatchSize) {
+			break
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,84 @@
+package category
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// CreateCategoryRequest represents the request body for creating a category.
+// code is the key products are assigned by (products.category, also used by budgets).
+type CreateCategoryRequest struct {
+	Code       string `json:"code" binding:"required,max=50" example:"fasteners"`
+	Name       string `json:"name" binding:"required,max=100" example:"Fasteners"`
+	ParentCode string `json:"parent_code,omitempty" binding:"max=50" example:"hardware"` // omitted = top level
+}
+
+// UpdateCategoryRequest represents the request body for renaming a category
+type UpdateCategoryRequest struct {
+	Name string `json:"name" binding:"required,max=100" example:"Fasteners & fixings"`
+}
+
+// AssignCategoryRequest represents the request body for assigning a product to a category
+type AssignCategoryRequest struct {
+	CategoryCode string `json:"category_code" binding:"max=50" example:"fasteners"` // empty = uncategorized
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// CategoryRef represents a category in a path or child list
+type CategoryRef struct {
+	Code string `json:"code" example:"hardware"`
+	Name string `json:"name" example:"Hardware"`
+}
+
+// CategoryNode represents a category in the category tree
+type CategoryNode struct {
+	Code         string         `json:"code" example:"fasteners"`
+	Name         string         `json:"name" example:"Fasteners"`
+	ProductCount int64          `json:"product_count" example:"42"` // products assigned directly (variants excluded)
+	Children     []CategoryNode `json:"children"`
+}
+
+// CategoryTreeResponse represents all categories as a tree, siblings ordered by name
+type CategoryTreeResponse struct {
+	Categories []CategoryNode `json:"categories"`
+}
+
+// CategoryResponse represents a category with its path from the top level and its subcategories
+type CategoryResponse struct {
+	Code       string        `json:"code" example:"fasteners"`
+	Name       string        `json:"name" example:"Fasteners"`
+	ParentCode string        `json:"parent_code,omitempty" example:"hardware"`
+	Path       []CategoryRef `json:"path"` // top level → this category
+	Children   []CategoryRef `json:"children"`
+	CreatedAt  time.Time     `json:"created_at"`
+	UpdatedAt  time.Time     `json:"updated_at"`
+}
+
+// AssignCategoryResponse represents a product's category assignment
+type AssignCategoryResponse struct {
+	SKU             string        `json:"sku" example:"SKU-WIDGET-001"`
+	CategoryCode    string        `json:"category_code,omitempty" example:"fasteners"`
+	Path            []CategoryRef `json:"path"`
+	UpdatedProducts int64         `json:"updated_products" example:"4"` // the product and its variants
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToCategoryRefs converts a category path to its response
+func ToCategoryRefs(rows []db.ListCategoryAncestorsRow) []CategoryRef {
+	refs := make([]CategoryRef, 0, len(rows))
+	for _, r := range rows {
+		refs = append(refs, CategoryRef{Code: r.Code, Name: r.Name})
+	}
+	return refs
+}
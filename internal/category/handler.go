@@ -0,0 +1,177 @@
+package category
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles HTTP requests for product categories
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new category handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers category routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	categories := rg.Group("/categories", middleware.RequireAuth())
+	{
+		categories.GET("", h.ListCategories)
+		categories.GET("/:code", h.GetCategory)
+	}
+
+	admin := rg.Group("/admin", middleware.RequireRoles(middleware.RoleAdmin))
+	{
+		admin.POST("/categories", h.CreateCategory)
+		admin.PATCH("/categories/:code", h.UpdateCategory)
+		admin.PUT("/products/:sku/category", h.AssignProduct)
+	}
+}
+
+// ListCategories godoc
+// @Summary List categories
+// @Description Get the category tree, siblings ordered by name, with the number of products assigned to each category directly
+// @Tags categories
+// @Produce json
+// @Success 200 {object} middleware.SuccessResponse{data=CategoryTreeResponse} "Category tree"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/categories [get]
+func (h *Handler) ListCategories(c *gin.Context) {
+	result, err := h.service.ListCategories(c.Request.Context())
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// GetCategory godoc
+// @Summary Get category
+// @Description Get a category with its path from the top level and its subcategories
+// @Tags categories
+// @Produce json
+// @Param code path string true "Category code"
+// @Success 200 {object} middleware.SuccessResponse{data=CategoryResponse} "Category"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Category not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/categories/{code} [get]
+func (h *Handler) GetCategory(c *gin.Context) {
+	result, err := h.service.GetCategory(c.Request.Context(), c.Param("code"))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// CreateCategory godoc
+// @Summary Create category
+// @Description Create a category at the top level or under parent_code - Admin only. The tree is limited to 5 levels.
+// @Description The code is the key products are assigned by and budgets are set for; it cannot be changed. Audited.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param request body CreateCategoryRequest true "Category"
+// @Success 201 {object} middleware.SuccessResponse{data=CategoryResponse} "Category created"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input or tree too deep"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Parent category not found"
+// @Failure 409 {object} middleware.ErrorResponse "Category code already exists"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/categories [post]
+func (h *Handler) CreateCategory(c *gin.Context) {
+	var req CreateCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.CreateCategory(c.Request.Context(), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// UpdateCategory godoc
+// @Summary Rename category
+// @Description Rename a category - Admin only. Audited.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param code path string true "Category code"
+// @Param request body UpdateCategoryRequest true "Name"
+// @Success 200 {object} middleware.SuccessResponse{data=CategoryResponse} "Category renamed"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Category not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/categories/{code} [patch]
+func (h *Handler) UpdateCategory(c *gin.Context) {
+	var req UpdateCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.UpdateCategory(c.Request.Context(), c.Param("code"), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// AssignProduct godoc
+// @Summary Assign product category
+// @Description Assign a product and its variants to a category, or clear it with an empty category_code - Admin only.
+// @Description Category budgets apply to orders placed afterwards. Audited.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param sku path string true "Product SKU (not a variant)"
+// @Param request body AssignCategoryRequest true "Category"
+// @Success 200 {object} middleware.SuccessResponse{data=AssignCategoryResponse} "Category assigned"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input or product is a variant"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Product or category not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/products/{sku}/category [put]
+func (h *Handler) AssignProduct(c *gin.Context) {
+	var req AssignCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.AssignProduct(c.Request.Context(), c.Param("sku"), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
@@ -0,0 +1,296 @@
+package category
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"strings"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/go-sql-driver/mysql"
+	"go.uber.org/zap"
+)
+
+const (
+	mysqlErrDuplicateEntry = 1062
+
+	// maxLevels is the maximum depth of the category tree (top level = 1)
+	maxLevels = 5
+)
+
+// Audit log identifiers
+const (
+	actionCreated         = "CATEGORY_CREATED"
+	actionRenamed         = "CATEGORY_RENAMED"
+	actionProductAssigned = "PRODUCT_CATEGORY_ASSIGNED"
+	resourceCategory      = "CATEGORY"
+	resourceProduct       = "PRODUCT"
+)
+
+// Service handles the product category tree and product assignments.
+// Products reference their category by code (products.category), which budgets and spend analytics key on as well.
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	logger   *zap.Logger
+}
+
+// NewService creates a new category service
+func NewService(txRunner *pkgdb.TxRunner, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		logger:   logger,
+	}
+}
+
+// ListCategories returns all categories as a tree
+func (s *Service) ListCategories(ctx context.Context) (*CategoryTreeResponse, error) {
+	rows, err := s.txRunner.Queries().ListCategories(ctx)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list categories", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	// NOTE: 이름순 정렬된 목록을 상위 카테고리별로 묶어 재귀 구성 (형제 순서 유지)
+	children := make(map[uint64][]*db.ListCategoriesRow, len(rows))
+	var roots []*db.ListCategoriesRow
+	for i := range rows {
+		if rows[i].ParentID.Valid {
+			parentID := uint64(rows[i].ParentID.Int64)
+			children[parentID] = append(children[parentID], &rows[i])
+		} else {
+			roots = append(roots, &rows[i])
+		}
+	}
+	var build func(nodes []*db.ListCategoriesRow) []CategoryNode
+	build = func(nodes []*db.ListCategoriesRow) []CategoryNode {
+		result := make([]CategoryNode, 0, len(nodes))
+		for _, n := range nodes {
+			result = append(result, CategoryNode{
+				Code:         n.Code,
+				Name:         n.Name,
+				ProductCount: n.ProductCount,
+				Children:     build(children[n.ID]),
+			})
+		}
+		return result
+	}
+	return &CategoryTreeResponse{Categories: build(roots)}, nil
+}
+
+// GetCategory returns a category with its path and subcategories
+func (s *Service) GetCategory(ctx context.Context, code string) (*CategoryResponse, error) {
+	q := s.txRunner.Queries()
+	category, err := s.getCategory(ctx, q, code)
+	if err != nil {
+		return nil, err
+	}
+	path, err := q.ListCategoryAncestors(ctx, category.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list category path", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	rows, err := q.ListCategories(ctx)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list categories", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	response := &CategoryResponse{
+		Code:       category.Code,
+		Name:       category.Name,
+		ParentCode: category.ParentCode.String,
+		Path:       ToCategoryRefs(path),
+		Children:   []CategoryRef{},
+		CreatedAt:  category.CreatedAt,
+		UpdatedAt:  category.UpdatedAt,
+	}
+	for _, r := range rows {
+		if r.ParentID.Valid && uint64(r.ParentID.Int64) == category.ID {
+			response.Children = append(response.Children, CategoryRef{Code: r.Code, Name: r.Name})
+		}
+	}
+	return response, nil
+}
+
+// CreateCategory creates a category, at the top level or under a parent (admin)
+func (s *Service) CreateCategory(ctx context.Context, req *CreateCategoryRequest, actor audit.Actor) (*CategoryResponse, error) {
+	code := strings.TrimSpace(req.Code)
+	name := strings.TrimSpace(req.Name)
+	if code == "" || name == "" {
+		return nil, errors.InvalidInput("code and name must not be blank")
+	}
+
+	q := s.txRunner.Queries()
+	var parentID sql.NullInt64
+	if parentCode := strings.TrimSpace(req.ParentCode); parentCode != "" {
+		parent, err := s.getCategory(ctx, q, parentCode)
+		if err != nil {
+			return nil, err
+		}
+		depth, err := q.GetCategoryDepth(ctx, parent.ID)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to get category depth", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		if depth+2 > maxLevels {
+			return nil, errors.InvalidInput("category tree is limited to 5 levels").
+				WithDetails(map[string]any{"max_levels": maxLevels})
+		}
+		parentID = sql.NullInt64{Int64: int64(parent.ID), Valid: true}
+	}
+
+	err := s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		result, err := q.CreateCategory(ctx, db.CreateCategoryParams{
+			Code:     code,
+			Name:     name,
+			ParentID: parentID,
+		})
+		if err != nil {
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		// 경로는 같은 트랜잭션에서 기록 (카테고리 이동 없음 → 경로 불변)
+		if err := q.CreateCategoryPaths(ctx, db.CreateCategoryPathsParams{ID: uint64(id), ParentID: parentID}); err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionCreated,
+			ResourceType: resourceCategory,
+			ResourceID:   uint64(id),
+			NewValue:     map[string]any{"code": code, "name": name, "parent_code": strings.TrimSpace(req.ParentCode)},
+		})
+	})
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, errors.Conflict("Category code already exists")
+		}
+		logctx.From(ctx, s.logger).Error("failed to create category", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("category created", zap.String("code", code))
+	return s.GetCategory(ctx, code)
+}
+
+// UpdateCategory renames a category (admin); its code is fixed as products and budgets reference it
+func (s *Service) UpdateCategory(ctx context.Context, code string, req *UpdateCategoryRequest, actor audit.Actor) (*CategoryResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, errors.InvalidInput("name must not be blank")
+	}
+
+	category, err := s.getCategory(ctx, s.txRunner.Queries(), code)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		if err := q.UpdateCategoryName(ctx, db.UpdateCategoryNameParams{Name: name, ID: category.ID}); err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionRenamed,
+			ResourceType: resourceCategory,
+			ResourceID:   category.ID,
+			OldValue:     map[string]any{"name": category.Name},
+			NewValue:     map[string]any{"name": name},
+		})
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to rename category", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetCategory(ctx, code)
+}
+
+// AssignProduct assigns a product, together with its variants, to a category or clears it (admin).
+// Budgets of the category apply to orders placed afterwards.
+func (s *Service) AssignProduct(ctx context.Context, sku string, req *AssignCategoryRequest, actor audit.Actor) (*AssignCategoryResponse, error) {
+	q := s.txRunner.Queries()
+	product, err := q.GetProductBySKU(ctx, sku)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Product")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get product", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if product.ParentID.Valid {
+		return nil, errors.InvalidInput("variants inherit their product's category: " + sku)
+	}
+
+	response := &AssignCategoryResponse{SKU: product.Sku, Path: []CategoryRef{}}
+	var category sql.NullString
+	if code := strings.TrimSpace(req.CategoryCode); code != "" {
+		c, err := s.getCategory(ctx, q, code)
+		if err != nil {
+			return nil, err
+		}
+		path, err := q.ListCategoryAncestors(ctx, c.ID)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to list category path", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		category = sql.NullString{String: c.Code, Valid: true}
+		response.CategoryCode = c.Code
+		response.Path = ToCategoryRefs(path)
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		n, err := q.AssignProductCategory(ctx, db.AssignProductCategoryParams{Category: category, ID: product.ID})
+		if err != nil {
+			return err
+		}
+		response.UpdatedProducts = n
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionProductAssigned,
+			ResourceType: resourceProduct,
+			ResourceID:   product.ID,
+			OldValue:     map[string]any{"category": product.Category.String},
+			NewValue:     map[string]any{"category": category.String, "updated_products": n},
+		})
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to assign product category", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return response, nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// getCategory retrieves a category by code
+func (s *Service) getCategory(ctx context.Context, q *db.Queries, code string) (*db.GetCategoryByCodeRow, error) {
+	category, err := q.GetCategoryByCode(ctx, code)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Category")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get category", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &category, nil
+}
+
+// isDuplicateKeyError checks if the error is a MySQL duplicate key error
+func isDuplicateKeyError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var mysqlErr *mysql.MySQLError
+	if stderrors.As(err, &mysqlErr) {
+		return mysqlErr.Number == mysqlErrDuplicateEntry
+	}
+	return strings.Contains(err.Error(), "Duplicate entry")
+}
@@ -0,0 +1,61 @@
+package chaintx
+
+import (
+	"context"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+)
+
+// Reference types of tracked transfers (the business record that sent the transfer)
+const (
+	ReferenceWalletMicroTransfer = "WALLET_MICRO_TRANSFER"
+	ReferencePaymentRefund       = "PAYMENT_REFUND"
+)
+
+// Outcome is the final result of a tracked transfer
+type Outcome struct {
+	// Status is CONFIRMED, FAILED (reverted) or DROPPED (nonce used by an unknown transaction)
+	Status db.ChainTransactionsStatus
+	// TxHash is the broadcast that was mined (empty when DROPPED); it differs from the
+	// original hash when a replacement landed
+	TxHash string
+}
+
+// Reconciler applies the outcome of a transfer to its business record (called within the transaction)
+type Reconciler func(ctx context.Context, q *db.Queries, referenceID uint64, outcome Outcome) error
+
+// Record starts tracking a broadcast transfer (must be called within the transaction
+// that stores the tx hash on the business record)
+func Record(ctx context.Context, q *db.Queries, chainID int64, sent *chain.SentTx, referenceType string, referenceID uint64, now time.Time) error {
+	result, err := q.CreateChainTransaction(ctx, db.CreateChainTransactionParams{
+		ChainID:         uint64(chainID),
+		Signer:          sent.From,
+		Nonce:           sent.Nonce,
+		ToAddress:       sent.To,
+		Amount:          sent.Amount.String(),
+		GasLimit:        sent.Gas,
+		ReferenceType:   referenceType,
+		ReferenceID:     referenceID,
+		LastBroadcastAt: now,
+	})
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	return recordBroadcast(ctx, q, uint64(id), sent)
+}
+
+// recordBroadcast stores one broadcast of a tracked transfer
+func recordBroadcast(ctx context.Context, q *db.Queries, chainTxID uint64, sent *chain.SentTx) error {
+	return q.CreateChainTransactionBroadcast(ctx, db.CreateChainTransactionBroadcastParams{
+		ChainTransactionID: chainTxID,
+		TxHash:             sent.Hash,
+		TipCap:             sent.TipCap.String(),
+		FeeCap:             sent.FeeCap.String(),
+	})
+}
@@ -0,0 +1,311 @@
+package chaintx
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"go.uber.org/zap"
+)
+
+// MonitorConfig holds stuck transaction monitor settings
+type MonitorConfig struct {
+	// Interval is the period of the job
+	Interval time.Duration
+	// TxTimeout is how long a broadcast may stay unconfirmed before it is replaced
+	TxTimeout time.Duration
+	// MaxReplacements bounds fee bumps per transfer (then it is only watched)
+	MaxReplacements int
+	// Urgency prices replacements (the fee is at least +12.5% over the previous broadcast either way)
+	Urgency chain.Urgency
+	// BatchSize is the number of transfers checked per run
+	BatchSize int
+}
+
+// MonitorReport summarizes one monitor run
+type MonitorReport struct {
+	Checked  int
+	Landed   int
+	Replaced int
+	Dropped  int
+	Failed   int
+}
+
+// Monitor re-checks transfers unconfirmed past TxTimeout: a mined broadcast (original or
+// replacement) resolves the transfer and is reconciled into its business record; otherwise
+// the transfer is re-broadcast with the same nonce and bumped fees.
+type Monitor struct {
+	txRunner    *pkgdb.TxRunner
+	chainClient chain.Client
+	config      MonitorConfig
+	reconcilers map[string]Reconciler
+	logger      *zap.Logger
+}
+
+// NewMonitor creates a new stuck transaction monitor
+func NewMonitor(txRunner *pkgdb.TxRunner, chainClient chain.Client, config MonitorConfig, logger *zap.Logger) *Monitor {
+	if config.TxTimeout <= 0 {
+		config.TxTimeout = chain.DefaultTxTimeout
+	}
+	if config.Urgency == "" {
+		config.Urgency = chain.UrgencyHigh
+	}
+	return &Monitor{
+		txRunner:    txRunner,
+		chainClient: chainClient,
+		config:      config,
+		reconcilers: make(map[string]Reconciler),
+		logger:      logger,
+	}
+}
+
+// Handle registers the reconciler of a reference type (must be called before Run)
+func (m *Monitor) Handle(referenceType string, reconciler Reconciler) {
+	m.reconcilers[referenceType] = reconciler
+}
+
+// Run checks stuck transfers periodically until ctx is canceled
+func (m *Monitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.config.Interval)
+	defer ticker.Stop()
+
+	m.logger.Info("chain transaction monitor started",
+		zap.Duration("interval", m.config.Interval),
+		zap.Duration("tx_timeout", m.config.TxTimeout),
+		zap.Int("max_replacements", m.config.MaxReplacements),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			m.logger.Info("chain transaction monitor stopped")
+			return
+		case <-ticker.C:
+			report, err := m.RunOnce(ctx, time.Now().UTC())
+			if err != nil {
+				m.logger.Error("chain transaction monitor run failed", zap.Error(err))
+				continue
+			}
+			if report.Landed+report.Replaced+report.Dropped+report.Failed > 0 {
+				m.logger.Info("chain transaction monitor run completed",
+					zap.Int("checked", report.Checked),
+					zap.Int("landed", report.Landed),
+					zap.Int("replaced", report.Replaced),
+					zap.Int("dropped", report.Dropped),
+					zap.Int("failed", report.Failed),
+				)
+			}
+		}
+	}
+}
+
+// RunOnce checks one batch of transfers whose last broadcast is older than TxTimeout.
+// A transfer that cannot be checked (RPC error) is left as is and retried on the next run.
+func (m *Monitor) RunOnce(ctx context.Context, now time.Time) (*MonitorReport, error) {
+	report := &MonitorReport{}
+
+	stuck, err := m.txRunner.Queries().ListStuckChainTransactions(ctx, db.ListStuckChainTransactionsParams{
+		BroadcastBefore: now.Add(-m.config.TxTimeout),
+		Limit:           int32(m.config.BatchSize),
+	})
+	if err != nil {
+		return report, fmt.Errorf("list stuck chain transactions: %w", err)
+	}
+
+	for i := range stuck {
+		if ctx.Err() != nil {
+			return report, nil
+		}
+
+		if err := m.check(ctx, &stuck[i], now, report); err != nil {
+			report.Failed++
+			m.logger.Warn("chain transaction check failed",
+				zap.Uint64("chain_transaction_id", stuck[i].ID),
+				zap.Uint64("nonce", stuck[i].Nonce),
+				zap.Error(err),
+			)
+			continue
+		}
+		report.Checked++
+	}
+	return report, nil
+}
+
+// check reconciles a mined broadcast of the transfer, or replaces the latest one
+func (m *Monitor) check(ctx context.Context, tx *db.ChainTransaction, now time.Time, report *MonitorReport) error {
+	broadcasts, err := m.txRunner.Queries().ListChainTransactionBroadcasts(ctx, tx.ID)
+	if err != nil {
+		return fmt.Errorf("list broadcasts: %w", err)
+	}
+	if len(broadcasts) == 0 {
+		return fmt.Errorf("chain transaction %d has no broadcasts", tx.ID)
+	}
+
+	// 1. Any broadcast mined? (같은 nonce이므로 최대 1건만 포함됨)
+	for i := range broadcasts {
+		receipt, err := m.chainClient.TxReceipt(ctx, broadcasts[i].TxHash)
+		if stderrors.Is(err, chain.ErrTxNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		status := db.ChainTransactionsStatusCONFIRMED
+		if !receipt.Success {
+			status = db.ChainTransactionsStatusFAILED
+		}
+		if err := m.resolve(ctx, tx, Outcome{Status: status, TxHash: broadcasts[i].TxHash}, receipt.BlockNumber, now); err != nil {
+			return err
+		}
+		report.Landed++
+
+		m.logger.Info("chain transaction landed",
+			zap.Uint64("chain_transaction_id", tx.ID),
+			zap.String("tx_hash", broadcasts[i].TxHash),
+			zap.Bool("replacement", i < len(broadcasts)-1),
+			zap.String("status", string(status)),
+		)
+		return nil
+	}
+
+	// 2. Nonce mined but none of our broadcasts → 다른 트랜잭션이 nonce를 사용 (지급 미도달)
+	confirmed, err := m.chainClient.ConfirmedNonce(ctx, tx.Signer)
+	if err != nil {
+		return err
+	}
+	if confirmed > tx.Nonce {
+		if err := m.resolve(ctx, tx, Outcome{Status: db.ChainTransactionsStatusDROPPED}, 0, now); err != nil {
+			return err
+		}
+		report.Dropped++
+
+		m.logger.Error("chain transaction dropped, nonce used by an unknown transaction",
+			zap.Uint64("chain_transaction_id", tx.ID),
+			zap.String("signer", tx.Signer),
+			zap.Uint64("nonce", tx.Nonce),
+		)
+		return nil
+	}
+
+	// 3. Still pending → replace with bumped fees (한도 도달 시 다음 timeout까지 관찰만)
+	if int(tx.Replacements) >= m.config.MaxReplacements {
+		m.logger.Warn("chain transaction still pending, replacement limit reached",
+			zap.Uint64("chain_transaction_id", tx.ID),
+			zap.Uint64("nonce", tx.Nonce),
+			zap.Uint32("replacements", tx.Replacements),
+		)
+		return m.touch(ctx, tx, now)
+	}
+
+	previous, err := toSentTx(tx, &broadcasts[0])
+	if err != nil {
+		return err
+	}
+	sent, err := m.chainClient.ReplaceTransfer(ctx, previous, m.config.Urgency)
+	switch {
+	case stderrors.Is(err, chain.ErrNonceConsumed):
+		// 조회 직후 포함됨 - 다음 실행에서 receipt로 조정
+		return nil
+	case stderrors.Is(err, chain.ErrFeeCapExceeded), stderrors.Is(err, chain.ErrSignerMismatch):
+		m.logger.Warn("chain transaction cannot be replaced",
+			zap.Uint64("chain_transaction_id", tx.ID),
+			zap.Uint64("nonce", tx.Nonce),
+			zap.Error(err),
+		)
+		return m.touch(ctx, tx, now)
+	case err != nil:
+		return err
+	}
+
+	err = m.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		if err := recordBroadcast(ctx, q, tx.ID, sent); err != nil {
+			return err
+		}
+		_, err := q.RecordChainTransactionReplacement(ctx, db.RecordChainTransactionReplacementParams{
+			LastBroadcastAt: now,
+			ID:              tx.ID,
+		})
+		return err
+	})
+	if err != nil {
+		// 브로드캐스트는 이미 됨 - 기록 실패 시 다음 실행에서 이전 hash로 재교체(수수료 재인상)
+		return fmt.Errorf("record replacement %s: %w", sent.Hash, err)
+	}
+	report.Replaced++
+	return nil
+}
+
+// resolve records the final outcome and reconciles the business record in one transaction
+func (m *Monitor) resolve(ctx context.Context, tx *db.ChainTransaction, outcome Outcome, blockNumber uint64, now time.Time) error {
+	return m.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		params := db.ResolveChainTransactionParams{
+			Status:     outcome.Status,
+			ResolvedAt: sql.NullTime{Time: now, Valid: true},
+			ID:         tx.ID,
+		}
+		if outcome.TxHash != "" {
+			params.LandedTxHash = sql.NullString{String: outcome.TxHash, Valid: true}
+			params.BlockNumber = sql.NullInt64{Int64: int64(blockNumber), Valid: true}
+		}
+		affected, err := q.ResolveChainTransaction(ctx, params)
+		if err != nil {
+			return err
+		}
+		// Resolved concurrently
+		if affected == 0 {
+			return nil
+		}
+
+		reconcile, ok := m.reconcilers[tx.ReferenceType]
+		if !ok {
+			m.logger.Warn("no reconciler for chain transaction reference type",
+				zap.Uint64("chain_transaction_id", tx.ID),
+				zap.String("reference_type", tx.ReferenceType),
+			)
+			return nil
+		}
+		return reconcile(ctx, q, tx.ReferenceID, outcome)
+	})
+}
+
+// touch postpones the next check of a transfer by TxTimeout
+func (m *Monitor) touch(ctx context.Context, tx *db.ChainTransaction, now time.Time) error {
+	return m.txRunner.Queries().TouchChainTransaction(ctx, db.TouchChainTransactionParams{
+		LastBroadcastAt: now,
+		ID:              tx.ID,
+	})
+}
+
+// toSentTx rebuilds the latest broadcast of a transfer
+func toSentTx(tx *db.ChainTransaction, latest *db.ChainTransactionBroadcast) (*chain.SentTx, error) {
+	amount, ok := new(big.Int).SetString(tx.Amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount %q", tx.Amount)
+	}
+	tipCap, ok := new(big.Int).SetString(latest.TipCap, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid tip cap %q", latest.TipCap)
+	}
+	feeCap, ok := new(big.Int).SetString(latest.FeeCap, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid fee cap %q", latest.FeeCap)
+	}
+
+	return &chain.SentTx{
+		Hash:   latest.TxHash,
+		From:   tx.Signer,
+		Nonce:  tx.Nonce,
+		To:     tx.ToAddress,
+		Amount: amount,
+		Gas:    tx.GasLimit,
+		TipCap: tipCap,
+		FeeCap: feeCap,
+	}, nil
+}
@@ -0,0 +1,212 @@
+package apidocs
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/swaggo/swag"
+)
+
+// Swagger instance names of the split documents
+const (
+	InstancePublic = "public"
+	InstanceAdmin  = "admin"
+)
+
+const (
+	// audienceExtension is the operation extension set by `// @x-audience "admin"` in handler godoc
+	audienceExtension = "x-audience"
+	// audienceAdmin marks operations restricted to admins
+	audienceAdmin = "admin"
+	// adminPathPrefix: /admin 하위 라우트는 표기가 빠져도 관리자 문서로 분류 (fail-safe)
+	adminPathPrefix = "/api/v1/admin/"
+	// definitionRefPrefix prefixes schema references to definitions
+	definitionRefPrefix = "#/definitions/"
+)
+
+// staticDoc serves a pre-rendered document as a swag instance
+type staticDoc string
+
+// ReadDoc returns the document
+func (d staticDoc) ReadDoc() string {
+	return string(d)
+}
+
+// Register splits the generated document into the public merchant API and the
+// internal admin API and registers them as the InstancePublic / InstanceAdmin instances.
+// examples (fixtures.Registry.Examples) are embedded into the matching definitions.
+// Call after the generated SwaggerInfo is configured (host etc.).
+func Register(full swag.Swagger, examples map[string]any) error {
+	spec, err := parse([]byte(full.ReadDoc()))
+	if err != nil {
+		return err
+	}
+	addExamples(spec, examples)
+
+	public, admin, err := split(spec)
+	if err != nil {
+		return err
+	}
+	swag.Register(InstancePublic, staticDoc(public))
+	swag.Register(InstanceAdmin, staticDoc(admin))
+	return nil
+}
+
+// Split separates a Swagger 2.0 document by operation audience.
+// Operations marked `x-audience: admin` (or under /api/v1/admin/) go to the admin document,
+// all others to the public one. Each document keeps only the definitions it references,
+// so admin-only schemas don't appear in the public document.
+func Split(doc []byte) (public, admin []byte, err error) {
+	spec, err := parse(doc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return split(spec)
+}
+
+// parse decodes a Swagger 2.0 document
+func parse(doc []byte) (map[string]any, error) {
+	var spec map[string]any
+	if err := json.Unmarshal(doc, &spec); err != nil {
+		return nil, fmt.Errorf("parse swagger document: %w", err)
+	}
+	return spec, nil
+}
+
+// split separates a parsed document by operation audience (see Split)
+func split(spec map[string]any) (public, admin []byte, err error) {
+	paths, _ := spec["paths"].(map[string]any)
+	publicPaths := make(map[string]any)
+	adminPaths := make(map[string]any)
+	for path, rawItem := range paths {
+		item, ok := rawItem.(map[string]any)
+		if !ok {
+			continue
+		}
+		for method, rawOp := range item {
+			target := publicPaths
+			if isAdminOperation(path, rawOp) {
+				target = adminPaths
+			}
+			if target[path] == nil {
+				target[path] = make(map[string]any)
+			}
+			target[path].(map[string]any)[method] = rawOp
+		}
+	}
+
+	definitions, _ := spec["definitions"].(map[string]any)
+	if public, err = render(spec, publicPaths, definitions, ""); err != nil {
+		return nil, nil, err
+	}
+	if admin, err = render(spec, adminPaths, definitions, "Admin API"); err != nil {
+		return nil, nil, err
+	}
+	return public, admin, nil
+}
+
+// addExamples sets the example of every definition that has a registered fixture.
+// swag names definitions after the package path relative to the module ("internal_wallet.WalletResponse")
+// or, on name collisions, the full import path - both are suffixes of the fixture's full name.
+func addExamples(spec map[string]any, examples map[string]any) {
+	definitions, _ := spec["definitions"].(map[string]any)
+	for typeKey, example := range examples {
+		full := definitionName(typeKey)
+		for name, rawDefinition := range definitions {
+			definition, ok := rawDefinition.(map[string]any)
+			if !ok || (name != full && !strings.HasSuffix(full, "_"+name)) {
+				continue
+			}
+			definition["example"] = example
+		}
+	}
+}
+
+// definitionName converts "<import path>.<type>" into swag's full definition name
+// (e.g. "github_com_acme_app_internal_wallet.WalletResponse")
+func definitionName(typeKey string) string {
+	dot := strings.LastIndex(typeKey, ".")
+	if dot < 0 {
+		return typeKey
+	}
+	pkg := strings.NewReplacer("/", "_", ".", "_").Replace(typeKey[:dot])
+	return pkg + typeKey[dot:]
+}
+
+// isAdminOperation reports whether an operation belongs to the admin document
+func isAdminOperation(path string, rawOp any) bool {
+	if strings.HasPrefix(path, adminPathPrefix) {
+		return true
+	}
+	op, ok := rawOp.(map[string]any)
+	return ok && op[audienceExtension] == audienceAdmin
+}
+
+// render builds one document from the shared top-level fields, its paths and the
+// definitions reachable from them. titleSuffix is appended to info.title when set.
+func render(spec map[string]any, paths map[string]any, definitions map[string]any, titleSuffix string) ([]byte, error) {
+	out := make(map[string]any, len(spec))
+	for key, value := range spec {
+		out[key] = value
+	}
+	out["paths"] = paths
+	out["definitions"] = reachableDefinitions(paths, definitions)
+
+	if titleSuffix != "" {
+		if info, ok := spec["info"].(map[string]any); ok {
+			copied := make(map[string]any, len(info))
+			for key, value := range info {
+				copied[key] = value
+			}
+			copied["title"] = fmt.Sprintf("%v - %s", info["title"], titleSuffix)
+			out["info"] = copied
+		}
+	}
+
+	doc, err := json.MarshalIndent(out, "", "    ")
+	if err != nil {
+		return nil, fmt.Errorf("marshal swagger document: %w", err)
+	}
+	return doc, nil
+}
+
+// reachableDefinitions returns the definitions referenced (transitively) from paths
+func reachableDefinitions(paths map[string]any, definitions map[string]any) map[string]any {
+	reachable := make(map[string]any)
+	var queue []string
+	collectRefs(paths, &queue)
+
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if _, seen := reachable[name]; seen {
+			continue
+		}
+		definition, ok := definitions[name]
+		if !ok {
+			continue
+		}
+		reachable[name] = definition
+		collectRefs(definition, &queue)
+	}
+	return reachable
+}
+
+// collectRefs appends the definition names of every $ref found in node
+func collectRefs(node any, refs *[]string) {
+	switch value := node.(type) {
+	case map[string]any:
+		for key, child := range value {
+			if ref, ok := child.(string); ok && key == "$ref" && strings.HasPrefix(ref, definitionRefPrefix) {
+				*refs = append(*refs, strings.TrimPrefix(ref, definitionRefPrefix))
+				continue
+			}
+			collectRefs(child, refs)
+		}
+	case []any:
+		for _, child := range value {
+			collectRefs(child, refs)
+		}
+	}
+}
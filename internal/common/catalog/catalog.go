@@ -0,0 +1,26 @@
+package catalog
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// CheckSellable rejects a product that has variants - it is ordered and stocked by its variant SKUs.
+// Callers check the product's status themselves.
+func CheckSellable(ctx context.Context, q *db.Queries, product *db.Product) error {
+	// 변형 상품(parent_id 있음)은 변형을 가질 수 없으므로 조회 생략
+	if product.ParentID.Valid {
+		return nil
+	}
+	variants, err := q.CountProductVariants(ctx, sql.NullInt64{Int64: int64(product.ID), Valid: true})
+	if err != nil {
+		return err
+	}
+	if variants > 0 {
+		return errors.InvalidInput("product has variants - use a variant SKU: " + product.Sku)
+	}
+	return nil
+}
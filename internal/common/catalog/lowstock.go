@@ -0,0 +1,64 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/outbox"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/webhook"
+)
+
+// NotifyLowStock records an inventory.low_stock event for each active admin when a stock movement that
+// lowered a product's available stock by decrease units crossed its reorder threshold.
+// It must be called with the tx-bound Queries after the movement; cause is the inventory log event type.
+//
+// Why:
+//   - 기준선을 넘어 내려가는 순간에만 발행 → 이미 기준 미만인 SKU의 추가 예약/출고마다 알림이 쌓이지 않음
+//   - 변경과 같은 트랜잭션에서 outbox 기록 → 롤백된 예약/조정의 알림은 나가지 않음
+func NotifyLowStock(ctx context.Context, q *db.Queries, productID uint64, decrease int64, cause db.InventoryLogsEventType) error {
+	if decrease <= 0 {
+		return nil
+	}
+	product, err := q.GetProduct(ctx, productID)
+	if err != nil {
+		return err
+	}
+	if !product.ReorderThreshold.Valid {
+		return nil
+	}
+
+	available, err := q.GetProductAvailableStock(ctx, product.ID)
+	if err != nil {
+		return err
+	}
+	threshold := product.ReorderThreshold.Int64
+	if available >= threshold || available+decrease < threshold {
+		return nil
+	}
+
+	// NOTE: 상품에는 판매자가 없음 → 재고를 관리하는 운영자(ADMIN) 전원에게 개별 이벤트
+	recipients, err := q.ListActiveAdminUserIDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, recipient := range recipients {
+		if _, err := outbox.Write(ctx, q, outbox.Message{
+			EventType:           webhook.EventInventoryLowStock,
+			AggregateType:       outbox.AggregateProduct,
+			AggregateID:         product.ID,
+			AggregateExternalID: product.Sku,
+			RecipientUserID:     recipient,
+			Data: map[string]any{
+				"sku":                product.Sku,
+				"name":               product.Name,
+				"available_quantity": available,
+				"reorder_threshold":  threshold,
+				"cause":              string(cause),
+			},
+		}); err != nil {
+			return fmt.Errorf("write %s event: %w", webhook.EventInventoryLowStock, err)
+		}
+	}
+	return nil
+}
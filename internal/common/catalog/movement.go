@@ -0,0 +1,75 @@
+package catalog
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// Movement describes a change to an inventory row's on-hand or reserved quantity
+type Movement struct {
+	Inventory     *db.Inventory // the locked row before the movement
+	EventType     db.InventoryLogsEventType
+	Reason        db.StockMovementsReason
+	QuantityDelta int64 // on-hand change
+	ReservedDelta int64 // reserved change
+	ReferenceType string
+	ReferenceID   uint64
+	Note          string
+}
+
+// RecordMovement appends the movement to the inventory's log and to the stock movement audit trail with the actor.
+// It must be called with the tx-bound Queries after the inventory row was updated.
+//
+// Why:
+//   - inventory_logs는 재고 행 기준 이력(주문 예약 잔량 계산), stock_movements는 사유·변경 주체 기준 감사 이력
+//     → 한 곳에서 함께 기록해 두 이력이 어긋나지 않음
+func RecordMovement(ctx context.Context, q *db.Queries, actor audit.Actor, m Movement) error {
+	inv := m.Inventory
+	quantityAfter := inv.Quantity + m.QuantityDelta
+	reservedAfter := inv.ReservedQuantity + m.ReservedDelta
+	referenceType := sql.NullString{String: m.ReferenceType, Valid: m.ReferenceType != ""}
+	referenceID := sql.NullInt64{Int64: int64(m.ReferenceID), Valid: m.ReferenceID != 0}
+	note := sql.NullString{String: m.Note, Valid: m.Note != ""}
+
+	// NOTE: 예약/해제 이력의 quantity_change는 예약 수량 변화 (기존 inventory_logs 규칙)
+	change := m.QuantityDelta
+	if m.EventType == db.InventoryLogsEventTypeRESERVE || m.EventType == db.InventoryLogsEventTypeRELEASE {
+		change = m.ReservedDelta
+	}
+	if err := q.CreateInventoryLog(ctx, db.CreateInventoryLogParams{
+		InventoryID:    inv.ID,
+		EventType:      m.EventType,
+		QuantityChange: change,
+		QuantityAfter:  quantityAfter,
+		ReservedAfter:  reservedAfter,
+		ReferenceType:  referenceType,
+		ReferenceID:    referenceID,
+		Reason:         note,
+	}); err != nil {
+		return fmt.Errorf("record inventory %d log: %w", inv.ID, err)
+	}
+
+	if err := q.CreateStockMovement(ctx, db.CreateStockMovementParams{
+		ProductID:     inv.ProductID,
+		InventoryID:   inv.ID,
+		Location:      inv.Location,
+		Reason:        m.Reason,
+		QuantityDelta: m.QuantityDelta,
+		ReservedDelta: m.ReservedDelta,
+		QuantityAfter: quantityAfter,
+		ReservedAfter: reservedAfter,
+		ActorType:     actor.Type,
+		ActorID:       sql.NullInt64{Int64: int64(actor.ID), Valid: actor.ID != 0},
+		ReferenceType: referenceType,
+		ReferenceID:   referenceID,
+		Note:          note,
+		RequestID:     sql.NullString{String: actor.RequestID, Valid: actor.RequestID != ""},
+	}); err != nil {
+		return fmt.Errorf("record inventory %d movement: %w", inv.ID, err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package docnumber
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// Document number prefixes (PO-20260901-0001)
+const (
+	PrefixPurchaseOrder = "PO"
+	PrefixOrder         = "ORD"
+	PrefixRFQ           = "RFQ"
+	PrefixInvoice       = "INV"
+	PrefixCreditNote    = "CN"
+	PrefixRMA           = "RMA"
+)
+
+// Next allocates the next daily document number of the prefix (e.g. ORD-20260901-0001).
+// Must be called within the transaction that creates the document.
+//
+// Why:
+//   - document_sequences 행 잠금으로 같은 접두어·일자 채번 직렬화 → 동시 생성에도 번호 중복 없음
+//   - 롤백된 트랜잭션의 번호는 재사용 (커밋된 문서 번호만 연속)
+func Next(ctx context.Context, q *db.Queries, prefix string, now time.Time) (string, error) {
+	now = now.UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	result, err := q.NextDocumentSequence(ctx, db.NextDocumentSequenceParams{
+		Prefix:  prefix,
+		SeqDate: day,
+	})
+	if err != nil {
+		return "", err
+	}
+	seq, err := result.LastInsertId()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%s-%04d", prefix, day.Format("20060102"), seq), nil
+}
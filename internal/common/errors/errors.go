@@ -1,6 +1,7 @@
 package errors
 
 import (
+	stderrors "errors"
 	"fmt"
 	"net/http"
 )
@@ -17,13 +18,25 @@ const (
 	CodeInvalidState        = "INVALID_STATE_TRANSITION"
 	CodeUnauthorized        = "UNAUTHORIZED"
 	CodeForbidden           = "FORBIDDEN"
+	CodeRateLimited         = "RATE_LIMITED"
+	CodeComplianceBlocked   = "COMPLIANCE_BLOCKED"
+	CodeFeatureNotEnabled   = "FEATURE_NOT_ENABLED"
+	CodeBudgetExceeded      = "BUDGET_EXCEEDED"
+	CodeAccountFrozen       = "ACCOUNT_FROZEN"
+	CodeLimitExceeded       = "LIMIT_EXCEEDED"
+	CodePhoneNotVerified    = "PHONE_NOT_VERIFIED"
+	CodePhoneChanged        = "PHONE_RECENTLY_CHANGED"
 
 	// 5xx Server Errors
-	CodeInternal     = "INTERNAL_ERROR"
-	CodeDBError      = "DB_ERROR"
-	CodeLockFailed   = "LOCK_FAILED"
-	CodeChainError   = "CHAIN_ERROR"
-	CodeChainTimeout = "CHAIN_TIMEOUT"
+	CodeInternal        = "INTERNAL_ERROR"
+	CodeDBError         = "DB_ERROR"
+	CodeLockFailed      = "LOCK_FAILED"
+	CodeChainError      = "CHAIN_ERROR"
+	CodeChainTimeout    = "CHAIN_TIMEOUT"
+	CodeRateUnavailable = "RATE_UNAVAILABLE"
+	CodePaymentsPaused  = "PAYMENTS_PAUSED"
+	CodeTaxUnavailable  = "TAX_UNAVAILABLE"
+	CodeKycUnavailable  = "KYC_UNAVAILABLE"
 )
 
 // AppError represents a structured application error
@@ -114,6 +127,32 @@ func InsufficientStock(available, requested int64) *AppError {
 	}
 }
 
+func BudgetExceeded(scope, remaining, requested string) *AppError {
+	return &AppError{
+		Code:       CodeBudgetExceeded,
+		Message:    fmt.Sprintf("Remaining %s budget %s is less than requested %s", scope, remaining, requested),
+		StatusCode: http.StatusBadRequest,
+		Details: map[string]any{
+			"scope":     scope,
+			"remaining": remaining,
+			"requested": requested,
+		},
+	}
+}
+
+func LimitExceeded(limit, remaining, requested string) *AppError {
+	return &AppError{
+		Code:       CodeLimitExceeded,
+		Message:    fmt.Sprintf("Remaining %s limit %s is less than requested %s", limit, remaining, requested),
+		StatusCode: http.StatusBadRequest,
+		Details: map[string]any{
+			"limit":     limit,
+			"remaining": remaining,
+			"requested": requested,
+		},
+	}
+}
+
 func InvalidStateTransition(from, to string) *AppError {
 	return &AppError{
 		Code:       CodeInvalidState,
@@ -142,6 +181,69 @@ func Forbidden(message string) *AppError {
 	}
 }
 
+func ComplianceBlocked(category string) *AppError {
+	return &AppError{
+		Code:       CodeComplianceBlocked,
+		Message:    "Address is blocked by compliance screening",
+		StatusCode: http.StatusForbidden,
+		Details: map[string]any{
+			"category": category,
+		},
+	}
+}
+
+func AccountFrozen(accountID string) *AppError {
+	return &AppError{
+		Code:       CodeAccountFrozen,
+		Message:    "Account is frozen",
+		StatusCode: http.StatusForbidden,
+		Details: map[string]any{
+			"account_id": accountID,
+		},
+	}
+}
+
+func PhoneNotVerified() *AppError {
+	return &AppError{
+		Code:       CodePhoneNotVerified,
+		Message:    "A verified phone number is required for this operation",
+		StatusCode: http.StatusForbidden,
+	}
+}
+
+func PhoneRecentlyChanged(availableAt string) *AppError {
+	return &AppError{
+		Code:       CodePhoneChanged,
+		Message:    "This operation is unavailable for a while after a phone number change",
+		StatusCode: http.StatusForbidden,
+		Details: map[string]any{
+			"available_at": availableAt,
+		},
+	}
+}
+
+func FeatureNotEnabled(feature string) *AppError {
+	return &AppError{
+		Code:       CodeFeatureNotEnabled,
+		Message:    "This feature is not enabled for your account yet",
+		StatusCode: http.StatusForbidden,
+		Details: map[string]any{
+			"feature": feature,
+		},
+	}
+}
+
+func RateLimited(retryAfterSeconds int) *AppError {
+	return &AppError{
+		Code:       CodeRateLimited,
+		Message:    "Too many requests",
+		StatusCode: http.StatusTooManyRequests,
+		Details: map[string]any{
+			"retry_after_seconds": retryAfterSeconds,
+		},
+	}
+}
+
 func Internal(message string) *AppError {
 	return &AppError{
 		Code:       CodeInternal,
@@ -174,3 +276,60 @@ func ChainError(message string) *AppError {
 		StatusCode: http.StatusServiceUnavailable,
 	}
 }
+
+func RateUnavailable(pair string) *AppError {
+	return &AppError{
+		Code:       CodeRateUnavailable,
+		Message:    "Exchange rate is temporarily unavailable",
+		StatusCode: http.StatusServiceUnavailable,
+		Details: map[string]any{
+			"pair": pair,
+		},
+	}
+}
+
+func PaymentsPaused(tokenSymbol, price string, deviationBps int64) *AppError {
+	return &AppError{
+		Code:       CodePaymentsPaused,
+		Message:    fmt.Sprintf("New payments are paused while %s trades off its peg", tokenSymbol),
+		StatusCode: http.StatusServiceUnavailable,
+		Details: map[string]any{
+			"token_symbol":  tokenSymbol,
+			"price":         price,
+			"deviation_bps": deviationBps,
+		},
+	}
+}
+
+func TaxUnavailable(provider string) *AppError {
+	return &AppError{
+		Code:       CodeTaxUnavailable,
+		Message:    "Tax calculation is temporarily unavailable",
+		StatusCode: http.StatusServiceUnavailable,
+		Details: map[string]any{
+			"provider": provider,
+		},
+	}
+}
+
+func KycUnavailable(provider string) *AppError {
+	return &AppError{
+		Code:       CodeKycUnavailable,
+		Message:    "Identity verification is temporarily unavailable",
+		StatusCode: http.StatusServiceUnavailable,
+		Details: map[string]any{
+			"provider": provider,
+		},
+	}
+}
+
+// From extracts the *AppError from err's chain (errors.As semantics), so
+// AppErrors wrapped with %w (e.g. by TxRunner rollback) keep their code/status.
+// Unknown errors become a generic internal error - raw messages never reach clients.
+func From(err error) *AppError {
+	var appErr *AppError
+	if stderrors.As(err, &appErr) {
+		return appErr
+	}
+	return Internal("An unexpected error occurred")
+}
@@ -0,0 +1,93 @@
+package fixtures
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+)
+
+// Operation is the canned response of one API operation (sandbox mock responder)
+type Operation struct {
+	Name   string
+	Status int
+	Body   any
+}
+
+// Registry holds canonical DTO examples and canned operation responses.
+// Each domain registers its fixtures at startup (RegisterFixtures); the examples are
+// embedded into the Swagger documents and the operations served by the mock responder.
+// NOTE: 시작 시에만 등록 → 이후 읽기 전용 (동기화 불필요)
+type Registry struct {
+	examples   map[string]any
+	operations map[string]Operation
+}
+
+// NewRegistry creates an empty fixture registry
+func NewRegistry() *Registry {
+	return &Registry{
+		examples:   make(map[string]any),
+		operations: make(map[string]Operation),
+	}
+}
+
+// Example registers the canonical example of a DTO, keyed by its type (see TypeKey).
+// Registering the same type twice keeps the first example.
+func (r *Registry) Example(value any) {
+	key := TypeKey(value)
+	if key == "" {
+		panic(fmt.Sprintf("fixtures: example must be a named struct, got %T", value))
+	}
+	if _, exists := r.examples[key]; !exists {
+		r.examples[key] = value
+	}
+}
+
+// Operation registers the canned response of an operation (status 204 has no body).
+// A non-nil body is also registered as the canonical example of its type.
+func (r *Registry) Operation(name string, status int, body any) {
+	if _, exists := r.operations[name]; exists {
+		panic(fmt.Sprintf("fixtures: operation %q registered twice", name))
+	}
+	if status == http.StatusNoContent {
+		body = nil
+	}
+	if body != nil {
+		r.Example(body)
+	}
+	r.operations[name] = Operation{Name: name, Status: status, Body: body}
+}
+
+// Examples returns the registered examples keyed by TypeKey
+func (r *Registry) Examples() map[string]any {
+	return r.examples
+}
+
+// Lookup returns the canned response of an operation
+func (r *Registry) Lookup(name string) (Operation, bool) {
+	op, ok := r.operations[name]
+	return op, ok
+}
+
+// Operations lists the registered operations ordered by name
+func (r *Registry) Operations() []Operation {
+	ops := make([]Operation, 0, len(r.operations))
+	for _, op := range r.operations {
+		ops = append(ops, op)
+	}
+	sort.Slice(ops, func(i, j int) bool { return ops[i].Name < ops[j].Name })
+	return ops
+}
+
+// TypeKey identifies the DTO type of value as "<import path>.<type name>" (pointers dereferenced).
+// Returns "" for unnamed types.
+func TypeKey(value any) string {
+	t := reflect.TypeOf(value)
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Name() == "" || t.PkgPath() == "" {
+		return ""
+	}
+	return t.PkgPath() + "." + t.Name()
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	stderrors "errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/storage"
+	"github.com/gin-gonic/gin"
+)
+
+// FileHandler serves signed download URLs of the local artifact store.
+// S3/GCS signed URLs point at the bucket directly and never reach the API.
+type FileHandler struct {
+	store *storage.Local
+}
+
+// NewFileHandler creates a new FileHandler
+func NewFileHandler(store *storage.Local) *FileHandler {
+	return &FileHandler{store: store}
+}
+
+// RegisterRoutes registers the download route (no API key - the signature authorizes the request)
+func (h *FileHandler) RegisterRoutes(router *gin.Engine) {
+	router.GET("/files/*key", h.Download)
+}
+
+// Download streams the object when the URL signature is valid and unexpired
+func (h *FileHandler) Download(c *gin.Context) {
+	key := strings.TrimPrefix(c.Param("key"), "/")
+	if err := h.store.Verify(key, c.Query(storage.ParamExpires), c.Query(storage.ParamSignature)); err != nil {
+		middleware.RespondError(c, errors.Forbidden("Download link is invalid or has expired"))
+		return
+	}
+
+	body, object, err := h.store.Get(c.Request.Context(), key)
+	if stderrors.Is(err, storage.ErrNotFound) {
+		middleware.RespondError(c, errors.NotFound("File"))
+		return
+	}
+	if err != nil {
+		middleware.RespondError(c, errors.Internal("Failed to read file").WithError(err))
+		return
+	}
+	defer body.Close()
+
+	c.Header("Cache-Control", "private, no-store")
+	c.Header("Content-Type", object.ContentType)
+	if seeker, ok := body.(io.ReadSeeker); ok {
+		http.ServeContent(c.Writer, c.Request, "", object.LastModified, seeker)
+		return
+	}
+	c.DataFromReader(http.StatusOK, object.Size, object.ContentType, body, nil)
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/fixtures"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// MockResponseHeader marks responses served from fixtures
+const MockResponseHeader = "X-Mock-Response"
+
+// MockHandler serves canned fixture responses so clients can build against
+// realistic payloads before the backing endpoints exist (sandbox only)
+type MockHandler struct {
+	registry *fixtures.Registry
+}
+
+// NewMockHandler creates a new MockHandler
+func NewMockHandler(registry *fixtures.Registry) *MockHandler {
+	return &MockHandler{registry: registry}
+}
+
+// MockOperation describes an operation served by the mock responder
+type MockOperation struct {
+	Operation string `json:"operation" example:"GetWallet"`
+	Status    int    `json:"status" example:"200"`
+}
+
+// ListMockOperationsResponse represents the operations served by the mock responder
+type ListMockOperationsResponse struct {
+	Operations []MockOperation `json:"operations"`
+}
+
+// RegisterRoutes registers mock responder routes on the router group
+func (h *MockHandler) RegisterRoutes(rg *gin.RouterGroup) {
+	mock := rg.Group("/_mock")
+	{
+		mock.GET("", h.ListOperations)
+		mock.POST("/:operation", h.Respond)
+	}
+}
+
+// ListOperations godoc
+// @Summary List mock operations
+// @Description List the operations the sandbox mock responder serves (sandbox deployments only)
+// @Tags mock
+// @Produce json
+// @Success 200 {object} middleware.SuccessResponse{data=ListMockOperationsResponse} "Mock operations"
+// @Router /api/v1/_mock [get]
+func (h *MockHandler) ListOperations(c *gin.Context) {
+	ops := h.registry.Operations()
+	response := ListMockOperationsResponse{Operations: make([]MockOperation, 0, len(ops))}
+	for _, op := range ops {
+		response.Operations = append(response.Operations, MockOperation{Operation: op.Name, Status: op.Status})
+	}
+	middleware.RespondOK(c, response)
+}
+
+// Respond godoc
+// @Summary Mock an operation
+// @Description Return the canned fixture response of an operation, with the operation's status code and the
+// @Description standard response envelope (sandbox deployments only). The request body is ignored.
+// @Description Responses carry the X-Mock-Response: true header.
+// @Tags mock
+// @Produce json
+// @Param operation path string true "Operation name (see GET /api/v1/_mock)"
+// @Success 200 {object} middleware.SuccessResponse "Fixture response (status varies by operation)"
+// @Failure 404 {object} middleware.ErrorResponse "Mock operation not found"
+// @Router /api/v1/_mock/{operation} [post]
+func (h *MockHandler) Respond(c *gin.Context) {
+	op, ok := h.registry.Lookup(c.Param("operation"))
+	if !ok {
+		middleware.RespondError(c, errors.NotFound("Mock operation"))
+		return
+	}
+
+	c.Header(MockResponseHeader, "true")
+	if op.Status == http.StatusNoContent {
+		middleware.RespondNoContent(c)
+		return
+	}
+	middleware.RespondSuccess(c, op.Status, op.Body)
+}
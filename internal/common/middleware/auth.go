@@ -0,0 +1,157 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// APIKeyHeader is the header name for merchant API keys
+	APIKeyHeader = "X-API-Key"
+	// PrincipalKey is the context key for the authenticated principal
+	PrincipalKey = "principal"
+
+	// RoleAdmin is the role allowed to act on behalf of any user
+	RoleAdmin = "ADMIN"
+	// RoleSeller, RoleBuyer, RoleBoth mirror users.role
+	RoleSeller = "SELLER"
+	RoleBuyer  = "BUYER"
+	RoleBoth   = "BOTH"
+)
+
+// Principal represents the authenticated caller of a request
+type Principal struct {
+	UserID         uint64
+	UserExternalID string
+	Role           string
+	APIKeyID       string
+
+	// ComplianceOfficer mirrors users.is_compliance_officer (legal hold management)
+	ComplianceOfficer bool
+}
+
+// IsAdmin reports whether the principal has the ADMIN role
+func (p *Principal) IsAdmin() bool {
+	return p.Role == RoleAdmin
+}
+
+// IsCompliance reports whether the principal is an admin with the compliance role
+func (p *Principal) IsCompliance() bool {
+	return p.IsAdmin() && p.ComplianceOfficer
+}
+
+// CanActAs reports whether the principal may operate on the given user's resources
+func (p *Principal) CanActAs(userExternalID string) bool {
+	return p.IsAdmin() || p.UserExternalID == userExternalID
+}
+
+// HasRole reports whether the principal holds any of the given roles.
+// BOTH satisfies BUYER and SELLER.
+func (p *Principal) HasRole(roles ...string) bool {
+	for _, role := range roles {
+		if p.Role == role {
+			return true
+		}
+		if p.Role == RoleBoth && (role == RoleBuyer || role == RoleSeller) {
+			return true
+		}
+	}
+	return false
+}
+
+// APIKeyAuthenticator resolves a raw API key into a Principal.
+// Implemented by the apikey service; kept as an interface to avoid import cycles.
+type APIKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, rawKey string) (*Principal, error)
+}
+
+// APIKeyAuth middleware authenticates the X-API-Key header and attaches the
+// owning user as the request principal.
+//
+// Why:
+// - 헤더가 없으면 통과 (공개 엔드포인트 유지) → 보호가 필요한 라우트는 RequireAuth로 강제
+// - 헤더가 있는데 유효하지 않으면 즉시 401 → 잘못된 키로 익명 요청이 되는 것 방지
+func APIKeyAuth(authenticator APIKeyAuthenticator) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rawKey := c.GetHeader(APIKeyHeader)
+		if rawKey == "" {
+			c.Next()
+			return
+		}
+
+		principal, err := authenticator.AuthenticateAPIKey(c.Request.Context(), rawKey)
+		if err != nil {
+			RespondError(c, err)
+			c.Abort()
+			return
+		}
+
+		c.Set(PrincipalKey, principal)
+		c.Next()
+	}
+}
+
+// RequireAuth middleware rejects requests without an authenticated principal
+func RequireAuth() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := GetPrincipal(c); !ok {
+			RespondError(c, errors.Unauthorized("API key required"))
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireRoles middleware rejects requests whose principal holds none of the given roles.
+// Declared per route, e.g. users.POST("/:id/kyc/approve", middleware.RequireRoles(middleware.RoleAdmin), h.ApproveKyc)
+//
+// Why:
+// - 미인증 → 401, 인증됐지만 권한 없음 → 403 (클라이언트가 재인증 vs 권한 요청을 구분)
+func RequireRoles(roles ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := GetPrincipal(c)
+		if !ok {
+			RespondError(c, errors.Unauthorized("API key required"))
+			c.Abort()
+			return
+		}
+		if !principal.HasRole(roles...) {
+			RespondError(c, errors.Forbidden("Insufficient role"))
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
+
+// GetPrincipal extracts the authenticated principal from gin context
+func GetPrincipal(c *gin.Context) (*Principal, bool) {
+	if p, exists := c.Get(PrincipalKey); exists {
+		if principal, ok := p.(*Principal); ok {
+			return principal, true
+		}
+	}
+	return nil, false
+}
+
+// RequireCompliance middleware requires an admin principal with the compliance role.
+// Must be used after APIKeyAuth.
+func RequireCompliance() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := GetPrincipal(c)
+		if !ok {
+			RespondError(c, errors.Unauthorized("API key required"))
+			c.Abort()
+			return
+		}
+		if !principal.IsCompliance() {
+			RespondError(c, errors.Forbidden("Compliance role required"))
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// ETagHeader carries the resource version validator
+	ETagHeader = "ETag"
+	// LastModifiedHeader carries the resource updated_at (HTTP-date)
+	LastModifiedHeader = "Last-Modified"
+	// IfNoneMatchHeader is sent by clients holding a cached ETag
+	IfNoneMatchHeader = "If-None-Match"
+	// IfModifiedSinceHeader is sent by clients holding a cached Last-Modified
+	IfModifiedSinceHeader = "If-Modified-Since"
+)
+
+// RespondOKConditional sends a 200 OK response with ETag/Last-Modified derived
+// from the resource's updated_at, or 304 Not Modified when the client's cached
+// copy is still current.
+//
+// Why:
+// - 주문 상태를 폴링하는 대시보드의 대역폭 절감 (변경 없으면 본문 없이 304)
+// - updated_at은 초 단위(TIMESTAMP)이므로 weak ETag(W/) 사용 → 같은 초 내 연속 변경은 구분 못 함
+// - If-None-Match가 있으면 If-Modified-Since는 무시 (RFC 9110 13.1.3)
+//
+// NOTE: 사용처는 사용자/지갑/결제 상세 조회. 결제는 환불·수수료 변경까지 포함한 시각을 전달
+// (payment.Service.GetPayment). 주문은 상세 조회 엔드포인트가 없어 적용 보류 → 추가 시 같은 helper 사용
+func RespondOKConditional(c *gin.Context, resourceID string, updatedAt time.Time, data any) {
+	etag := weakETag(resourceID, updatedAt)
+	lastModified := updatedAt.UTC().Truncate(time.Second)
+
+	c.Header(ETagHeader, etag)
+	c.Header(LastModifiedHeader, lastModified.Format(http.TimeFormat))
+
+	if notModified(c, etag, lastModified) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	RespondOK(c, data)
+}
+
+// weakETag builds W/"<hash>" from the resource id and its updated_at
+func weakETag(resourceID string, updatedAt time.Time) string {
+	sum := sha256.Sum256([]byte(resourceID + "|" + strconv.FormatInt(updatedAt.UnixNano(), 10)))
+	return `W/"` + hex.EncodeToString(sum[:12]) + `"`
+}
+
+// notModified evaluates the request's conditional headers
+func notModified(c *gin.Context, etag string, lastModified time.Time) bool {
+	if inm := c.GetHeader(IfNoneMatchHeader); inm != "" {
+		for _, candidate := range strings.Split(inm, ",") {
+			candidate = strings.TrimSpace(candidate)
+			// Weak comparison: W/ prefix is ignored on both sides
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ims := c.GetHeader(IfModifiedSinceHeader); ims != "" {
+		since, err := http.ParseTime(ims)
+		if err != nil {
+			return false
+		}
+		return !lastModified.After(since)
+	}
+
+	return false
+}
@@ -0,0 +1,322 @@
+package middleware
+
+import (
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MIMECSV is the media type negotiated via Accept for CSV list responses
+const MIMECSV = "text/csv"
+
+// csvChunkWriteTimeout is the write deadline granted to each streamed CSV chunk
+const csvChunkWriteTimeout = 30 * time.Second
+
+// RespondOKNegotiated sends a list response as JSON (default) or, when the client
+// sends Accept: text/csv, streams rows as a CSV attachment named <name>.csv.
+// rows must be a slice of structs; columns follow the json tags of the element type.
+//
+// Why:
+// - 재무팀이 별도 export 작업 없이 같은 필터/페이지 파라미터로 스프레드시트에 바로 로드
+// - 컬럼명은 JSON 필드명과 동일 → JSON/CSV 간 매핑 문서 불필요
+func RespondOKNegotiated(c *gin.Context, name string, data any, rows any) {
+	if c.NegotiateFormat(gin.MIMEJSON, MIMECSV) != MIMECSV {
+		RespondOK(c, data)
+		return
+	}
+
+	c.Header("Content-Type", MIMECSV+"; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.csv"`, name))
+	c.Status(http.StatusOK)
+
+	if err := WriteCSV(c.Writer, rows); err != nil {
+		// Headers already sent - surface via gin errors (logged by Logger middleware)
+		_ = c.Error(err)
+	}
+}
+
+// WriteCSV encodes a slice of structs as CSV with a header row.
+// Embedded structs are flattened; nil pointers become empty cells,
+// times are RFC 3339, and nested slices/maps/structs are JSON-encoded.
+func WriteCSV(w io.Writer, rows any) error {
+	v := reflect.ValueOf(rows)
+	if v.Kind() != reflect.Slice {
+		return fmt.Errorf("csv: rows must be a slice, got %s", v.Kind())
+	}
+
+	columns, err := rowColumns(v.Type().Elem())
+	if err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(csvHeader(columns)); err != nil {
+		return err
+	}
+	if err := writeCSVRows(cw, columns, v); err != nil {
+		return err
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// CSVStream streams a large CSV attachment in chunks, flushing each chunk to the client.
+// Nothing is sent until the first Write (or Close), so a handler can still respond
+// with a JSON error when the export fails before producing any rows.
+//
+// Why:
+// - 대용량 기간 export를 한 번에 메모리에 올리지 않도록 청크 단위로 조회 → 쓰기 → flush
+// - 서버 WriteTimeout은 응답 전체에 적용 → 청크마다 write deadline 연장해 긴 다운로드가 끊기지 않게 함
+type CSVStream struct {
+	c       *gin.Context
+	name    string
+	columns []csvColumn
+	cw      *csv.Writer
+	rc      *http.ResponseController
+}
+
+// NewCSVStream prepares a CSV attachment named <name>.csv.
+// row is a struct value (or pointer) whose json tags define the columns, as in WriteCSV.
+func NewCSVStream(c *gin.Context, name string, row any) (*CSVStream, error) {
+	columns, err := rowColumns(reflect.TypeOf(row))
+	if err != nil {
+		return nil, err
+	}
+	return &CSVStream{c: c, name: name, columns: columns}, nil
+}
+
+// Started reports whether the response headers (and CSV header row) were sent
+func (s *CSVStream) Started() bool {
+	return s.cw != nil
+}
+
+// Write appends a chunk of rows (a slice of the row type) and flushes it to the client
+func (s *CSVStream) Write(rows any) error {
+	v := reflect.ValueOf(rows)
+	if v.Kind() != reflect.Slice {
+		return fmt.Errorf("csv: rows must be a slice, got %s", v.Kind())
+	}
+	if err := s.start(); err != nil {
+		return err
+	}
+	s.extendDeadline()
+	if err := writeCSVRows(s.cw, s.columns, v); err != nil {
+		return err
+	}
+	return s.flush()
+}
+
+// Close finishes the attachment (a header-only file when no rows were written)
+func (s *CSVStream) Close() error {
+	if err := s.start(); err != nil {
+		return err
+	}
+	return s.flush()
+}
+
+// RespondCSVStream streams the rows produced by export as a CSV attachment named <name>.csv.
+// export passes each chunk (a slice of row's type) to write. An export error before the first chunk
+// is sent as a JSON error response; after that the headers are gone, so the error is surfaced via gin
+// errors (logged by Logger middleware) and the attachment ends at the last complete chunk.
+func RespondCSVStream(c *gin.Context, name string, row any, export func(write func(rows any) error) error) {
+	stream, err := NewCSVStream(c, name, row)
+	if err != nil {
+		RespondError(c, err)
+		return
+	}
+
+	if err := export(stream.Write); err != nil {
+		if !stream.Started() {
+			RespondError(c, err)
+			return
+		}
+		_ = c.Error(err)
+		return
+	}
+	if err := stream.Close(); err != nil {
+		_ = c.Error(err)
+	}
+}
+
+// start sends the attachment headers and the CSV header row once
+func (s *CSVStream) start() error {
+	if s.cw != nil {
+		return nil
+	}
+	s.c.Header("Content-Type", MIMECSV+"; charset=utf-8")
+	s.c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.csv"`, s.name))
+	s.c.Status(http.StatusOK)
+
+	s.rc = http.NewResponseController(s.c.Writer)
+	s.extendDeadline()
+	s.cw = csv.NewWriter(s.c.Writer)
+	return s.cw.Write(csvHeader(s.columns))
+}
+
+// extendDeadline grants the next chunk a fresh write deadline.
+// NOTE: deadline 미지원 writer(httptest 등)는 ErrNotSupported → 무시
+func (s *CSVStream) extendDeadline() {
+	_ = s.rc.SetWriteDeadline(time.Now().Add(csvChunkWriteTimeout))
+}
+
+// flush pushes buffered rows to the client (chunked transfer)
+func (s *CSVStream) flush() error {
+	s.cw.Flush()
+	if err := s.cw.Error(); err != nil {
+		return err
+	}
+	s.c.Writer.Flush()
+	return nil
+}
+
+// rowColumns resolves the CSV columns of a row type (struct or pointer to struct)
+func rowColumns(t reflect.Type) ([]csvColumn, error) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("csv: row type must be a struct, got %s", t.Kind())
+	}
+	return csvColumns(t, nil), nil
+}
+
+// csvHeader returns the header row of the columns
+func csvHeader(columns []csvColumn) []string {
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		header[i] = col.name
+	}
+	return header
+}
+
+// writeCSVRows writes each element of the rows slice as one record
+func writeCSVRows(cw *csv.Writer, columns []csvColumn, rows reflect.Value) error {
+	record := make([]string, len(columns))
+	for i := 0; i < rows.Len(); i++ {
+		row := reflect.Indirect(rows.Index(i))
+		for j, col := range columns {
+			field, err := row.FieldByIndexErr(col.index)
+			if err != nil {
+				// nil embedded pointer
+				record[j] = ""
+				continue
+			}
+			record[j] = csvValue(field)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// csvColumn maps a CSV column to a (possibly nested) struct field
+type csvColumn struct {
+	name  string
+	index []int
+}
+
+// csvColumns lists exported fields in declaration order using json tag names
+func csvColumns(t reflect.Type, prefix []int) []csvColumn {
+	var columns []csvColumn
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		index := append(append([]int{}, prefix...), i)
+
+		// Untagged embedded struct: promote its fields like encoding/json does
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			columns = append(columns, csvColumns(fieldType, index)...)
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		columns = append(columns, csvColumn{name: name, index: index})
+	}
+	return columns
+}
+
+// csvValue formats a single cell
+func csvValue(v reflect.Value) string {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	if t, ok := v.Interface().(time.Time); ok {
+		return t.Format(time.RFC3339)
+	}
+	// Text/String forms before the JSON fallback (money.Money 등은 JSON 문자열로 인코딩되어 따옴표가 중복됨)
+	switch tv := v.Interface().(type) {
+	case encoding.TextMarshaler:
+		b, err := tv.MarshalText()
+		if err != nil {
+			return ""
+		}
+		return escapeNonNumeric(string(b))
+	case fmt.Stringer:
+		return escapeNonNumeric(tv.String())
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return escapeFormula(v.String())
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	default:
+		b, err := json.Marshal(v.Interface())
+		if err != nil {
+			return ""
+		}
+		return escapeFormula(string(b))
+	}
+}
+
+// escapeNonNumeric escapes s unless it is a plain number (negative amounts such as "-12.50" are not formulas)
+func escapeNonNumeric(s string) string {
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return s
+	}
+	return escapeFormula(s)
+}
+
+// escapeFormula neutralizes spreadsheet formula injection (CWE-1236).
+// Why: 사용자 입력(이름, 라벨 등)이 =, +, -, @로 시작하면 엑셀이 수식으로 실행
+func escapeFormula(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"strconv"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// DryRunQueryParam is the query parameter enabling dry-run mode
+	DryRunQueryParam = "dry_run"
+	// DryRunHeader marks responses produced in dry-run mode
+	DryRunHeader = "X-Dry-Run"
+	// DryRunKey is the context key for the dry-run flag
+	DryRunKey = "dry_run"
+)
+
+// DryRun middleware parses the ?dry_run=true flag for high-impact admin endpoints.
+// Handlers read it via IsDryRun and run the operation in a rollback-only transaction.
+//
+// Why:
+// - 모든 검증/쓰기를 실제로 실행한 뒤 롤백 → dry-run 결과와 실제 실행 결과가 일치
+// - 응답 헤더로 dry-run 여부 표시 → 운영자가 실제 반영으로 오인하지 않도록
+func DryRun() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		raw := c.Query(DryRunQueryParam)
+		if raw == "" {
+			c.Next()
+			return
+		}
+
+		dryRun, err := strconv.ParseBool(raw)
+		if err != nil {
+			RespondError(c, errors.InvalidInput("Invalid dry_run value"))
+			c.Abort()
+			return
+		}
+
+		c.Set(DryRunKey, dryRun)
+		if dryRun {
+			c.Header(DryRunHeader, "true")
+		}
+		c.Next()
+	}
+}
+
+// IsDryRun reports whether the request runs in dry-run mode
+func IsDryRun(c *gin.Context) bool {
+	return c.GetBool(DryRunKey)
+}
@@ -0,0 +1,192 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	stderrors "errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/idempotency"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	// IdempotencyKeyHeader is the header name for client-supplied idempotency keys
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks responses replayed from the store
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	// maxIdempotencyKeyLen bounds the client-supplied key length
+	maxIdempotencyKeyLen = 255
+)
+
+// Idempotency middleware stores the response of mutating requests that carry
+// an Idempotency-Key header and replays it on retries.
+//
+// Why:
+// - 네트워크 재시도로 인한 중복 처리 방지 (지갑 등록, 결제 등)
+// - 키는 (호출자, 메서드, 경로) 범위로 격리 → 다른 사용자/엔드포인트와 충돌 없음
+// - 같은 키로 다른 payload → 409 (클라이언트 버그를 조용히 덮지 않음)
+// - 5xx 응답은 저장하지 않음 → 재시도 허용
+// - Lock 후 저장소 재조회 → Get 미스와 Lock 사이에 첫 요청이 저장을 마친 경우에도 핸들러 중복 실행 없음
+// NOTE: lock TTL(IDEMPOTENCY_LOCK_TTL)은 가장 느린 핸들러보다 길어야 함 (만료 시 동시 재시도가 핸들러 실행)
+func Idempotency(store idempotency.Store, logger *zap.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		rawKey := c.GetHeader(IdempotencyKeyHeader)
+		if rawKey == "" || !isMutatingMethod(c.Request.Method) {
+			c.Next()
+			return
+		}
+		if len(rawKey) > maxIdempotencyKeyLen {
+			RespondError(c, errors.InvalidInput("Idempotency-Key too long"))
+			c.Abort()
+			return
+		}
+
+		// Read body for fingerprint, then restore it for the handler
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			RespondError(c, errors.InvalidInput("Failed to read request body"))
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := c.Request.Context()
+		key := scopedIdempotencyKey(c, rawKey)
+		fingerprint := hashBytes(body)
+
+		// 1. Replay stored response
+		stored, err := store.Get(ctx, key)
+		switch {
+		case err == nil:
+			replayResponse(c, stored, fingerprint)
+			return
+		case !stderrors.Is(err, idempotency.ErrNotFound):
+			RespondError(c, errors.Internal("Idempotency store unavailable").WithError(err))
+			c.Abort()
+			return
+		}
+
+		// 2. Lock key (concurrent retry while first request is in flight)
+		if err := store.Lock(ctx, key); err != nil {
+			if stderrors.Is(err, idempotency.ErrInProgress) {
+				RespondError(c, errors.IdempotencyConflict().WithDetails(map[string]any{
+					"reason": "in_progress",
+				}))
+			} else {
+				RespondError(c, errors.Internal("Idempotency store unavailable").WithError(err))
+			}
+			c.Abort()
+			return
+		}
+
+		// 3. Re-check after locking: the first request may have saved (and unlocked) between Get and Lock
+		stored, err = store.Get(ctx, key)
+		if err == nil || !stderrors.Is(err, idempotency.ErrNotFound) {
+			if unlockErr := store.Unlock(context.WithoutCancel(ctx), key); unlockErr != nil {
+				logctx.From(ctx, logger).Warn("failed to release idempotency key", zap.Error(unlockErr))
+			}
+			if err == nil {
+				replayResponse(c, stored, fingerprint)
+			} else {
+				RespondError(c, errors.Internal("Idempotency store unavailable").WithError(err))
+				c.Abort()
+			}
+			return
+		}
+
+		// 4. Capture response
+		writer := &bodyCaptureWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		c.Next()
+
+		// Request context may be canceled after the handler returns
+		storeCtx := context.WithoutCancel(ctx)
+
+		if writer.Status() >= http.StatusInternalServerError {
+			if err := store.Unlock(storeCtx, key); err != nil {
+				logctx.From(c.Request.Context(), logger).Warn("failed to release idempotency key", zap.Error(err))
+			}
+			return
+		}
+
+		if err := store.Save(storeCtx, key, &idempotency.Response{
+			StatusCode:  writer.Status(),
+			ContentType: writer.Header().Get("Content-Type"),
+			Body:        writer.body.Bytes(),
+			Fingerprint: fingerprint,
+		}); err != nil {
+			logctx.From(c.Request.Context(), logger).Error("failed to store idempotent response",
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+// replayResponse writes a stored response, rejecting payload mismatches
+func replayResponse(c *gin.Context, stored *idempotency.Response, fingerprint string) {
+	if stored.Fingerprint != fingerprint {
+		RespondError(c, errors.IdempotencyConflict().WithDetails(map[string]any{
+			"reason": "payload_mismatch",
+		}))
+		c.Abort()
+		return
+	}
+
+	c.Header(IdempotentReplayedHeader, "true")
+	if len(stored.Body) == 0 {
+		c.Status(stored.StatusCode)
+	} else {
+		c.Data(stored.StatusCode, stored.ContentType, stored.Body)
+	}
+	c.Abort()
+}
+
+// scopedIdempotencyKey isolates the client key by caller, method and path
+func scopedIdempotencyKey(c *gin.Context, rawKey string) string {
+	caller := "ip:" + c.ClientIP()
+	if principal, ok := GetPrincipal(c); ok {
+		caller = "user:" + principal.UserExternalID
+	}
+	return hashBytes([]byte(strings.Join([]string{caller, c.Request.Method, c.Request.URL.Path, rawKey}, "|")))
+}
+
+// isMutatingMethod reports whether the HTTP method changes state
+func isMutatingMethod(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// hashBytes returns the hex-encoded SHA-256 hash
+func hashBytes(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// bodyCaptureWriter tees the response body into a buffer
+type bodyCaptureWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *bodyCaptureWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *bodyCaptureWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -3,7 +3,9 @@ package middleware
 import (
 	"time"
 
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -14,12 +16,18 @@ import (
 // - request_id 포함 → 요청 추적
 // - latency, status, path 포함 → 성능 모니터링
 // - 에러 시 추가 컨텍스트 로깅
+// - request_id(+trace_id)가 붙은 logger를 request context에 주입 → 서비스 로그도 같은 요청으로 묶임
+//
+// NOTE: RequestID 미들웨어 다음에 등록해야 request_id가 채워짐
 func Logger(logger *zap.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		start := time.Now()
 		path := c.Request.URL.Path
 		query := c.Request.URL.RawQuery
 
+		// Request-scoped logger for services (logctx.From)
+		c.Request = c.Request.WithContext(logctx.With(c.Request.Context(), requestLogger(c, logger)))
+
 		// Process request
 		c.Next()
 
@@ -55,3 +63,12 @@ func Logger(logger *zap.Logger) gin.HandlerFunc {
 		}
 	}
 }
+
+// requestLogger derives a logger carrying the request's correlation ids
+func requestLogger(c *gin.Context, logger *zap.Logger) *zap.Logger {
+	fields := []zap.Field{zap.String("request_id", GetRequestID(c))}
+	if sc := trace.SpanContextFromContext(c.Request.Context()); sc.IsValid() {
+		fields = append(fields, zap.String("trace_id", sc.TraceID().String()))
+	}
+	return logger.With(fields...)
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PaymentBreaker decides whether new payment authorizations are accepted.
+// Implemented by the depeg monitor; kept as an interface to avoid import cycles.
+type PaymentBreaker interface {
+	// PaymentsPaused returns a PAYMENTS_PAUSED AppError while the breaker is open, nil otherwise
+	PaymentsPaused() error
+}
+
+// RequirePaymentsOpen middleware rejects new payment authorizations while the breaker is open.
+// Applied per group, e.g. v1.Group("/payments", middleware.RequirePaymentsOpen(breaker))
+//
+// Why:
+// - 정산 토큰 디페그 중 승인된 결제는 가맹점이 실제 가치보다 적게 받게 됨 → 신규 승인만 503으로 중단
+// - 조회(GET/HEAD)는 계속 허용 → 기존 결제 상태 확인은 차단하지 않음
+// - breaker가 nil이면 (가격 피드 미설정) 통과
+func RequirePaymentsOpen(breaker PaymentBreaker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if breaker == nil || c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+			c.Next()
+			return
+		}
+		if err := breaker.PaymentsPaused(); err != nil {
+			RespondError(c, err)
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
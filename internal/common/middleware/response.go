@@ -35,14 +35,8 @@ func RespondSuccess(c *gin.Context, statusCode int, data any) {
 func RespondError(c *gin.Context, err error) {
 	requestID := GetRequestID(c)
 
-	var appErr *errors.AppError
-	switch e := err.(type) {
-	case *errors.AppError:
-		appErr = e
-	default:
-		// Wrap unknown errors as internal error
-		appErr = errors.Internal("An unexpected error occurred")
-	}
+	// Wrapped AppErrors are unwrapped; unknown errors become internal errors
+	appErr := errors.From(err)
 
 	c.JSON(appErr.StatusCode, ErrorResponse{
 		Error: ErrorBody{
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UnmatchedRoute is the route recorded for requests that matched no route (404)
+const UnmatchedRoute = "unmatched"
+
+// UsageRequest is one completed API request of an API key
+type UsageRequest struct {
+	APIKeyID string
+	Method   string
+	Route    string
+	Status   int
+	At       time.Time
+}
+
+// UsageRecorder counts API requests per API key.
+// Implemented by the usage package; kept as an interface to avoid import cycles.
+type UsageRecorder interface {
+	// RecordRequest counts a completed request; failures are handled (logged) by the recorder
+	RecordRequest(ctx context.Context, req UsageRequest)
+}
+
+// UsageMetrics middleware counts authenticated requests per API key, route and status class.
+// Registered after APIKeyAuth and before RateLimit so that rejected (429) requests are counted too.
+//
+// Why:
+// - route는 gin 라우트 패턴 (FullPath) → 경로 파라미터별로 카운터가 늘지 않음
+// - 미인증 요청은 집계하지 않음 (소유 가맹점이 없어 셀프 서비스 대시보드에 노출할 곳이 없음)
+// - 응답 완료 후 집계 → 핸들러/레이트 리미터가 결정한 최종 status 기준
+func UsageMetrics(recorder UsageRecorder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if recorder == nil {
+			return
+		}
+		principal, ok := GetPrincipal(c)
+		if !ok || principal.APIKeyID == "" {
+			return
+		}
+
+		route := c.FullPath()
+		if route == "" {
+			route = UnmatchedRoute
+		}
+		recorder.RecordRequest(c.Request.Context(), UsageRequest{
+			APIKeyID: principal.APIKeyID,
+			Method:   c.Request.Method,
+			Route:    route,
+			Status:   c.Writer.Status(),
+			At:       time.Now(),
+		})
+	}
+}
@@ -4,20 +4,502 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Redis    RedisConfig
-	EIP712   EIP712Config
+	Server      ServerConfig
+	Database    DatabaseConfig
+	Redis       RedisConfig
+	EIP712      EIP712Config
+	Chain       ChainConfig
+	Idempotency IdempotencyConfig
+	RateLimit   RateLimitConfig
+	Usage       UsageConfig
+	Webhook     WebhookConfig
+	Outbox      OutboxConfig
+	Retention   RetentionConfig
+	EventBus    EventBusConfig
+	Tracing     TracingConfig
+	Nonce       NonceConfig
+	Dunning     DunningConfig
+	OrderSLA    OrderSLAConfig
+	Budget      BudgetConfig
+	Oracle      OracleConfig
+	Depeg       DepegConfig
+	Status      StatusConfig
+	Settlement  SettlementConfig
+	Fee         FeeConfig
+	Dispute     DisputeConfig
+	Expiry      PaymentExpiryConfig
+	Reservation ReservationExpiryConfig
+	Risk        RiskConfig
+	Sanctions   SanctionsConfig
+	ENS         ENSConfig
+	Primary     PrimaryWalletConfig
+	Payout      PayoutAddressConfig
+	Deposit     DepositConfig
+	Storage     StorageConfig
+	Reconcile   ReconciliationConfig
+	Limits      LimitsConfig
+	Statement   StatementConfig
+	Snapshot    SnapshotConfig
+	Org         OrganizationConfig
+	RFQ         RFQConfig
+	Invoice     InvoiceConfig
+	Tax         TaxConfig
+	RMA         RMAConfig
+	Cart        CartConfig
+	OTP         OTPConfig
+	KYC         KYCConfig
 }
 
 type EIP712Config struct {
 	ChainID            int64
 	VerifyingContract  string
 	TimestampTolerance time.Duration
+	// AllowPersonalSign accepts EIP-191 personal_sign for wallets without typed-data support
+	AllowPersonalSign bool
+	// AllowLegacyTypedData accepts eth_signTypedData (v1) for signers without v3/v4 support
+	AllowLegacyTypedData bool
+	// Networks lists additional chains wallets may be registered on (NETWORKS="137:polygon:0x..,8453:base:0x..")
+	Networks []NetworkConfig
+}
+
+// NetworkConfig is a supported chain with its EIP-712 verifying contract
+type NetworkConfig struct {
+	ChainID           int64
+	Name              string
+	VerifyingContract string
+}
+
+// SupportedNetworks returns the configured networks, including the default chain (ChainID/VerifyingContract)
+func (c EIP712Config) SupportedNetworks() []NetworkConfig {
+	for _, network := range c.Networks {
+		if network.ChainID == c.ChainID {
+			return c.Networks
+		}
+	}
+	defaultNetwork := NetworkConfig{ChainID: c.ChainID, Name: "default", VerifyingContract: c.VerifyingContract}
+	return append([]NetworkConfig{defaultNetwork}, c.Networks...)
+}
+
+// ChainConfig holds on-chain settlement configuration.
+// RPCURL가 비어 있으면 체인 연동 기능(마이크로 송금 검증 등)은 비활성화
+type ChainConfig struct {
+	RPCURL        string
+	ChainID       int64
+	TokenAddress  string
+	TokenDecimals int
+	// TokenSymbol is the settlement token (TokenAddress on ChainID) and the default token of orders/payments
+	TokenSymbol string
+	// Tokens lists additional stablecoins per chain (TOKENS="USDT:6:1:0x..,DAI:18:137:0x..")
+	Tokens           []TokenConfig
+	MinterPrivateKey string
+	TxTimeout        time.Duration
+	// EIP-1559 수수료 추정: 최근 블록 팁 분포 기반, TxUrgency(low/standard/high)로 플랫폼 트랜잭션 가격 책정
+	GasHistoryBlocks   int
+	GasRefreshInterval time.Duration
+	GasMinTipGwei      float64
+	GasMaxFeeGwei      float64 // 0 = 상한 없음
+	TxUrgency          string
+	// 플랫폼 서명 키 nonce 할당: redis (기본, 인스턴스 간 공유) | memory (단일 인스턴스 전용)
+	NonceStore      string
+	NonceGapTimeout time.Duration
+	// TxTimeout 동안 미확정인 송금은 같은 nonce로 수수료를 올려 재전송 (최대 TxMaxReplacements회)
+	TxMonitorInterval    time.Duration
+	TxMonitorBatchSize   int
+	TxMaxReplacements    int
+	TxReplacementUrgency string
+}
+
+// Enabled reports whether a chain RPC endpoint is configured
+func (c ChainConfig) Enabled() bool {
+	return c.RPCURL != ""
+}
+
+// TokenConfig is a stablecoin deployment on one chain
+type TokenConfig struct {
+	Symbol   string
+	Decimals int
+	ChainID  int64
+	Address  string
+}
+
+// SupportedTokens returns the configured tokens, including the settlement token (TokenAddress on ChainID)
+func (c ChainConfig) SupportedTokens() []TokenConfig {
+	for _, token := range c.Tokens {
+		if strings.EqualFold(token.Symbol, c.TokenSymbol) && token.ChainID == c.ChainID {
+			return c.Tokens
+		}
+	}
+	settlementToken := TokenConfig{Symbol: c.TokenSymbol, Decimals: c.TokenDecimals, ChainID: c.ChainID, Address: c.TokenAddress}
+	return append([]TokenConfig{settlementToken}, c.Tokens...)
+}
+
+// NonceConfig holds EIP-712 nonce store settings.
+// Store: redis (default) | memory (로컬 개발/테스트 전용 - 인스턴스 간 공유 안 됨)
+type NonceConfig struct {
+	Store string
+	TTL   time.Duration
+}
+
+// DunningConfig holds the unpaid order dunning schedule (days after payment due).
+// FinalAction: cancel | credit_hold (FinalDays 0 = 최종 조치 비활성)
+type DunningConfig struct {
+	ReminderDays []int
+	FinalDays    int
+	FinalAction  string
+	Interval     time.Duration
+	BatchSize    int
+}
+
+// OrderSLAConfig holds the default order SLA (sellers override it per buyer relationship).
+// 0시간 = 해당 단계 SLA 없음, AutoCancel false = 위반 시 에스컬레이션(알림)만
+type OrderSLAConfig struct {
+	ConfirmHours int
+	FulfillHours int
+	AutoCancel   bool
+	Interval     time.Duration
+	BatchSize    int
+}
+
+// BudgetConfig holds the budget alert job settings
+type BudgetConfig struct {
+	AlertInterval  time.Duration
+	AlertBatchSize int
+}
+
+// OracleConfig holds exchange rate sources of fiat-priced quotes.
+// Chainlink 피드를 우선 조회하고, 피드 미설정/지연/RPC 장애 시 고정 환율로 대체
+type OracleConfig struct {
+	// Feeds lists Chainlink feeds (ORACLE_FEEDS="KRW/USD:0x..")
+	Feeds []string
+	// StaticRates lists fallback rates (ORACLE_STATIC_RATES="KRW/USD:0.00072")
+	StaticRates []string
+	// MaxAge is the staleness bound of feed answers
+	MaxAge time.Duration
+	// QuoteTTL is how long a quoted token amount is honored
+	QuoteTTL time.Duration
+}
+
+// DepegConfig holds the settlement token depeg circuit breaker settings.
+// 정산 토큰의 Chainlink USD 가격이 1 USD에서 ThresholdBps 이상 벗어나면 신규 결제 승인 중단
+type DepegConfig struct {
+	// ThresholdBps is the allowed deviation from the peg in basis points (100 = 1%)
+	ThresholdBps int
+	// CheckInterval is the period of the price check
+	CheckInterval time.Duration
+}
+
+// StatusConfig holds public status feed settings.
+// 파이프라인 지연(가장 오래된 미처리 건의 경과 시간)이 DegradedLag/OutageLag 이상이면 DEGRADED/PARTIAL_OUTAGE
+type StatusConfig struct {
+	CacheTTL        time.Duration
+	DegradedLag     time.Duration
+	OutageLag       time.Duration
+	IncidentHistory time.Duration
+}
+
+// RiskConfig holds counterparty risk scoring rules (points deducted from 100)
+type RiskConfig struct {
+	LatePaymentWeight float64
+	DisputeWeight     float64
+	ChargebackPenalty float64
+	OverduePenalty    float64
+	DunningPenalty    float64
+	NetTermsMinScore  float64
+	NetTermsMinOrders int
+}
+
+// SanctionsConfig holds wallet address screening settings.
+// Screener: noop | denylist, DenyList: "0xaddr[:CATEGORY]" 항목 (DenyListFile 항목과 합산)
+type SanctionsConfig struct {
+	Screener     string
+	DenyList     []string
+	DenyListFile string
+}
+
+// ENSConfig holds the ENS re-validation job settings.
+// MaxAge: 마지막 해석 후 재검증까지의 기간
+type ENSConfig struct {
+	RevalidateInterval time.Duration
+	MaxAge             time.Duration
+	BatchSize          int
+}
+
+// PrimaryWalletConfig holds the primary wallet consistency job settings.
+// RepairInterval: 위반(Primary 없음/미검증 Primary/계정 불일치) 점검·복구 주기
+type PrimaryWalletConfig struct {
+	RepairInterval time.Duration
+	BatchSize      int
+}
+
+// PayoutAddressConfig holds payout address whitelist settings.
+// ActivationDelay: 승인 후 지급 대상이 되기까지의 대기 시간 (계정 탈취 시 대응 시간 확보)
+// PhoneChangeCooldown: 인증된 전화번호 변경 후 지급 주소 추가/확정 금지 기간
+type PayoutAddressConfig struct {
+	ActivationDelay     time.Duration
+	PhoneChangeCooldown time.Duration
+}
+
+// DepositConfig holds HD-wallet deposit address settings.
+// XPub: 입금 주소 파생용 확장 공개키 (m/44'/60'/0'/0 등 외부 체인 레벨, 비어 있으면 비활성)
+type DepositConfig struct {
+	XPub string
+}
+
+// StorageConfig holds the artifact store (KYC documents, invoices, exports).
+// Driver: local | s3 | gcs. local은 API의 /files 라우트가 서명 URL 다운로드를 제공
+type StorageConfig struct {
+	Driver string
+	// LocalDir is the root directory of the local store
+	LocalDir string
+	// PublicURL is the base URL of local signed downloads (default http://localhost:{port}/files)
+	PublicURL string
+	// SigningKey signs local download URLs (empty = random per process, development only)
+	SigningKey string
+	// EncryptionKey encrypts KYC documents at rest (hex, 32 bytes; empty = random per process, development only)
+	EncryptionKey string
+	Bucket        string
+	Region        string
+	// Endpoint overrides the S3 endpoint (S3-compatible servers, path-style)
+	Endpoint        string
+	AccessKeyID     string
+	SecretAccessKey string
+	// ReportExportDays/DataExportDays expire exports after N days (0 = keep)
+	ReportExportDays int
+	DataExportDays   int
+	// LifecycleInterval is the period of local expiry sweeps
+	LifecycleInterval time.Duration
+}
+
+// SettlementConfig holds seller payout policy.
+// HoldPeriod: capture 후 지급 보류 기간, BatchHour: 기본 지급 일정(DAILY)의 마감 시각 (UTC, 0-23)
+// Gas 값은 payout 가스 미리보기용 (TransferGas: RPC 추정 실패 시 fallback, Batch*: 배치 전송 컨트랙트 모델)
+// NettingWindow: 상계 실행 시 창을 생략하면 사용하는 기간 (실행 시각 기준 직전 기간)
+// Scheduler*: 지급 일정별 마감(정산 배치 확정) 스케줄러 주기/페이지 크기 (INSTANT 일정은 주기마다 마감)
+// ApprovalThreshold: 이 금액 이상 지급(정산 배치/순지급)은 서로 다른 두 사용자의 승인 후 지급 (decimal, "0" = 비활성)
+type SettlementConfig struct {
+	HoldPeriod         time.Duration
+	BatchHour          int
+	TransferGas        int
+	BatchBaseGas       int
+	BatchTransferGas   int
+	BatchMaxRecipients int
+	NettingWindow      time.Duration
+	SchedulerInterval  time.Duration
+	SchedulerBatchSize int
+	ApprovalThreshold  string
+}
+
+// FeeConfig holds the platform fee schedule.
+// Tiers: "NAME:min_volume:bps:fixed_fee" 항목 (min_volume 오름차순, 첫 등급은 0), VolumeWindow: 등급 산정 거래액 기간
+type FeeConfig struct {
+	Tiers        []string
+	VolumeWindow time.Duration
+	Interval     time.Duration
+	BatchSize    int
+}
+
+// DisputeConfig holds payment dispute settings
+// Window: capture 후 구매자가 분쟁을 제기할 수 있는 기간
+type DisputeConfig struct {
+	Window time.Duration
+}
+
+// PaymentExpiryConfig holds the payment authorization expiry sweep settings
+type PaymentExpiryConfig struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+// ReservationExpiryConfig holds the unpaid order reservation expiry settings.
+// TTL: 체크아웃 후 결제 없이 재고 예약이 유지되는 기간 (0 = 만료 없음, 경과 시 주문 PAYMENT_EXPIRED + 예약 해제)
+type ReservationExpiryConfig struct {
+	TTL       time.Duration
+	Interval  time.Duration
+	BatchSize int
+}
+
+// LimitsConfig holds the default account spending/withdrawal limits by owner KYC status.
+// Defaults: "KYC_STATUS:daily_spend:monthly_spend:daily_withdrawal:monthly_withdrawal" 항목 (NONE 필수 - 항목 없는 상태의 기본값)
+type LimitsConfig struct {
+	Defaults []string
+}
+
+// StatementConfig holds the monthly account statement job settings.
+// Grace: 월 마감 후 대기 시간 (마감 직전 시작된 원장 트랜잭션의 커밋을 기다린 뒤 명세서 확정)
+type StatementConfig struct {
+	Interval  time.Duration
+	BatchSize int
+	Grace     time.Duration
+}
+
+// SnapshotConfig holds the ledger balance snapshot job settings.
+// MinEntries: 최신 스냅샷 이후 항목이 이 수 이상인 계정만 새 스냅샷 (잔액 조회 = 스냅샷 + 이후 항목)
+type SnapshotConfig struct {
+	Interval   time.Duration
+	BatchSize  int
+	MinEntries int
+}
+
+// OrganizationConfig holds organization membership settings.
+// InvitationTTL: 초대 토큰 유효 기간 (경과 후 수락 불가, 같은 이메일로 재초대 가능)
+type OrganizationConfig struct {
+	InvitationTTL time.Duration
+}
+
+// RFQConfig holds request-for-quote settings.
+// MaxQuoteValidity: 판매자 견적 유효기간 상한 (법정화폐 견적은 이 기간 동안 환율 고정)
+type RFQConfig struct {
+	MaxQuoteValidity time.Duration
+}
+
+// InvoiceConfig holds the invoice job settings (overdue marking + deposit matching).
+// BatchSize: 실행당 대사할 입금 수 (미처리 입금은 다음 실행에서 이어서 처리)
+type InvoiceConfig struct {
+	Interval  time.Duration
+	BatchSize int
+}
+
+// TaxConfig holds the tax calculation settings.
+// Provider: none | table (tax_rates 테이블) | external (ExternalURL 세금 API 호출)
+type TaxConfig struct {
+	Provider        string
+	ExternalURL     string
+	ExternalAPIKey  string
+	ExternalTimeout time.Duration
+}
+
+// RMAConfig holds return merchandise authorization settings
+// Window: 주문 배송(마지막 상태 변경) 후 구매자가 반품을 요청할 수 있는 기간
+type RMAConfig struct {
+	Window time.Duration
+}
+
+// CartConfig holds cart settings (TTL + expiry sweep).
+// TTL: 마지막 변경 후 장바구니가 유지되는 기간 (변경 시마다 연장)
+// ReservationStrategy: 주문 요청에 전략이 없을 때 재고 예약 전략 (NEAREST | SINGLE_SOURCE)
+type CartConfig struct {
+	TTL                 time.Duration
+	Interval            time.Duration
+	BatchSize           int
+	ReservationStrategy string
+}
+
+// OTPConfig holds phone verification code settings.
+// Cooldown/SendLimit/SendWindow: 번호 단위 발송 제한 (요청 계정과 무관)
+// SMSProvider: log (개발용, 로그 출력) | http (SMSURL SMS 게이트웨이 호출)
+type OTPConfig struct {
+	CodeTTL     time.Duration
+	Cooldown    time.Duration
+	SendLimit   int
+	SendWindow  time.Duration
+	MaxAttempts int
+	SMSProvider string
+	SMSURL      string
+	SMSAPIKey   string
+	SMSTimeout  time.Duration
+}
+
+// KYCConfig holds the identity verification provider settings.
+// Provider: manual (관리자 승인/거절) | http (URL의 호스팅 KYC 서비스, 결정은 WebhookSecret 서명 콜백으로 수신)
+// WebhookTolerance: 콜백 서명 타임스탬프 허용 오차 (밖이면 replay로 보고 거부)
+// Document*: 사용자 제출 KYC 문서 업로드 제한 (파일 크기 bytes, 사용자별 총 문서 수)
+type KYCConfig struct {
+	Provider           string
+	URL                string
+	APIKey             string
+	WebhookSecret      string
+	WebhookTolerance   time.Duration
+	Timeout            time.Duration
+	DocumentMaxSize    int64
+	DocumentMaxPerUser int
+}
+
+// ReconciliationConfig holds the on-chain treasury reconciliation job settings.
+// TreasuryAccountID가 비어 있거나 체인 연동이 없으면 비활성화 (treasury 주소는 system_wallets TREASURY)
+type ReconciliationConfig struct {
+	// TreasuryAccountID is the external ID of the platform treasury ledger account
+	TreasuryAccountID string
+	// Tolerance is the absolute drift (settlement token) tolerated before alerting
+	Tolerance string
+	Interval  time.Duration
+}
+
+// Enabled reports whether the reconciliation job runs (chain integration is checked by the caller)
+func (c ReconciliationConfig) Enabled() bool {
+	return c.TreasuryAccountID != ""
+}
+
+// IdempotencyConfig holds Idempotency-Key response storage settings.
+// LockTTL: 처리 중 키 잠금 만료 (가장 느린 핸들러보다 길게, 비정상 종료 시 이 시간 후 재시도 가능)
+type IdempotencyConfig struct {
+	TTL     time.Duration
+	LockTTL time.Duration
+}
+
+// RateLimitConfig holds token bucket limits (requests per minute + burst).
+// 0이면 해당 버킷 비활성화
+type RateLimitConfig struct {
+	IPPerMinute        int
+	IPBurst            int
+	APIKeyPerMinute    int
+	APIKeyBurst        int
+	SensitivePerMinute int
+	SensitiveBurst     int
+}
+
+// UsageConfig holds the API usage counter settings
+// FlushInterval: Redis 카운터 → api_usage_hourly 반영 주기 (대시보드 지연 상한)
+type UsageConfig struct {
+	FlushInterval time.Duration
+}
+
+// WebhookConfig holds webhook delivery settings
+type WebhookConfig struct {
+	MaxAttempts       int
+	PollInterval      time.Duration
+	BatchSize         int
+	Timeout           time.Duration
+	AllowInsecureURLs bool
+	// RequeueRatePerMinute is the default pace of admin bulk requeues (per merchant)
+	RequeueRatePerMinute int
+}
+
+// OutboxConfig holds outbox relay settings
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+// RetentionConfig holds data retention periods per data class (0 = keep forever)
+type RetentionConfig struct {
+	AuditLogs         time.Duration
+	WebhookDeliveries time.Duration
+	OutboxEvents      time.Duration
+	Interval          time.Duration
+	BatchSize         int
+}
+
+// EventBusConfig holds event bus publisher settings.
+// Driver: noop (default) | kafka (REST Proxy) | nats
+type EventBusConfig struct {
+	Driver       string
+	KafkaRESTURL string
+	NATSURL      string
+	TopicPrefix  string
+	Timeout      time.Duration
+}
+
+// TracingConfig holds OpenTelemetry settings (standard OTEL_* env names).
+// OTLPEndpoint가 비어 있으면 trace export 비활성화 (traceparent 전파는 유지)
+type TracingConfig struct {
+	ServiceName  string
+	OTLPEndpoint string
+	SampleRatio  float64
 }
 
 type ServerConfig struct {
@@ -26,6 +508,11 @@ type ServerConfig struct {
 	Environment  string
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
+	// MockAPIEnabled serves fixture responses under /api/v1/_mock (sandbox deployments only)
+	MockAPIEnabled bool
+	// TrustedProxies are the proxy IPs/CIDRs whose X-Forwarded-For is honoured for the client IP
+	// (비어 있으면 헤더 무시, 연결 주소 사용 → 헤더 위조로 IP별 레이트 리밋 우회 방지)
+	TrustedProxies []string
 }
 
 func (c ServerConfig) Addr() string {
@@ -62,11 +549,13 @@ func (c RedisConfig) Addr() string {
 func Load() (*Config, error) {
 	return &Config{
 		Server: ServerConfig{
-			Host:         getEnv("SERVER_HOST", "0.0.0.0"),
-			Port:         getEnvAsInt("SERVER_PORT", 8080),
-			Environment:  getEnv("ENVIRONMENT", "development"),
-			ReadTimeout:  getEnvAsDuration("SERVER_READ_TIMEOUT", 10*time.Second),
-			WriteTimeout: getEnvAsDuration("SERVER_WRITE_TIMEOUT", 10*time.Second),
+			Host:           getEnv("SERVER_HOST", "0.0.0.0"),
+			Port:           getEnvAsInt("SERVER_PORT", 8080),
+			Environment:    getEnv("ENVIRONMENT", "development"),
+			ReadTimeout:    getEnvAsDuration("SERVER_READ_TIMEOUT", 10*time.Second),
+			WriteTimeout:   getEnvAsDuration("SERVER_WRITE_TIMEOUT", 10*time.Second),
+			MockAPIEnabled: getEnvAsBool("MOCK_API_ENABLED", false),
+			TrustedProxies: getEnvAsStringSlice("TRUSTED_PROXIES"),
 		},
 		Database: DatabaseConfig{
 			Host:            getEnv("DB_HOST", "localhost"),
@@ -85,9 +574,256 @@ func Load() (*Config, error) {
 			DB:       getEnvAsInt("REDIS_DB", 0),
 		},
 		EIP712: EIP712Config{
-			ChainID:            getEnvAsInt64("EIP712_CHAIN_ID", 1),
-			VerifyingContract:  getEnv("EIP712_VERIFYING_CONTRACT", "0x0000000000000000000000000000000000000000"),
-			TimestampTolerance: getEnvAsDuration("EIP712_TIMESTAMP_TOLERANCE", 5*time.Minute),
+			ChainID:              getEnvAsInt64("EIP712_CHAIN_ID", 1),
+			VerifyingContract:    getEnv("EIP712_VERIFYING_CONTRACT", "0x0000000000000000000000000000000000000000"),
+			TimestampTolerance:   getEnvAsDuration("EIP712_TIMESTAMP_TOLERANCE", 5*time.Minute),
+			AllowPersonalSign:    getEnvAsBool("EIP712_ALLOW_PERSONAL_SIGN", false),
+			AllowLegacyTypedData: getEnvAsBool("EIP712_ALLOW_LEGACY_TYPED_DATA", false),
+			Networks:             getEnvAsNetworks("NETWORKS"),
+		},
+		Chain: ChainConfig{
+			RPCURL:               getEnv("CHAIN_RPC_URL", ""),
+			ChainID:              getEnvAsInt64("CHAIN_ID", 1),
+			TokenAddress:         getEnv("TOKEN_ADDRESS", "0x0000000000000000000000000000000000000000"),
+			TokenDecimals:        getEnvAsInt("TOKEN_DECIMALS", 6),
+			TokenSymbol:          strings.ToUpper(getEnv("TOKEN_SYMBOL", "USDC")),
+			Tokens:               getEnvAsTokens("TOKENS"),
+			MinterPrivateKey:     getEnv("MINTER_PRIVATE_KEY", ""),
+			TxTimeout:            getEnvAsDuration("CHAIN_TX_TIMEOUT", 2*time.Minute),
+			GasHistoryBlocks:     getEnvAsInt("CHAIN_GAS_HISTORY_BLOCKS", 20),
+			GasRefreshInterval:   getEnvAsDuration("CHAIN_GAS_REFRESH_INTERVAL", 12*time.Second),
+			GasMinTipGwei:        getEnvAsFloat("CHAIN_GAS_MIN_TIP_GWEI", 0),
+			GasMaxFeeGwei:        getEnvAsFloat("CHAIN_GAS_MAX_FEE_GWEI", 0),
+			TxUrgency:            getEnv("CHAIN_TX_URGENCY", "standard"),
+			NonceStore:           getEnv("CHAIN_NONCE_STORE", "redis"),
+			NonceGapTimeout:      getEnvAsDuration("CHAIN_NONCE_GAP_TIMEOUT", 2*time.Minute),
+			TxMonitorInterval:    getEnvAsDuration("CHAIN_TX_MONITOR_INTERVAL", 30*time.Second),
+			TxMonitorBatchSize:   getEnvAsInt("CHAIN_TX_MONITOR_BATCH_SIZE", 100),
+			TxMaxReplacements:    getEnvAsInt("CHAIN_TX_MAX_REPLACEMENTS", 5),
+			TxReplacementUrgency: getEnv("CHAIN_TX_REPLACEMENT_URGENCY", "high"),
+		},
+		Nonce: NonceConfig{
+			Store: getEnv("NONCE_STORE", "redis"),
+			TTL:   getEnvAsDuration("NONCE_TTL", 5*time.Minute),
+		},
+		Dunning: DunningConfig{
+			ReminderDays: getEnvAsIntSlice("DUNNING_REMINDER_DAYS", []int{3, 7}),
+			FinalDays:    getEnvAsInt("DUNNING_FINAL_DAYS", 0),
+			FinalAction:  getEnv("DUNNING_FINAL_ACTION", "credit_hold"),
+			Interval:     getEnvAsDuration("DUNNING_INTERVAL", time.Hour),
+			BatchSize:    getEnvAsInt("DUNNING_BATCH_SIZE", 100),
+		},
+		OrderSLA: OrderSLAConfig{
+			ConfirmHours: getEnvAsInt("ORDER_SLA_CONFIRM_HOURS", 48),
+			FulfillHours: getEnvAsInt("ORDER_SLA_FULFILL_HOURS", 168),
+			AutoCancel:   getEnvAsBool("ORDER_SLA_AUTO_CANCEL", false),
+			Interval:     getEnvAsDuration("ORDER_SLA_INTERVAL", 5*time.Minute),
+			BatchSize:    getEnvAsInt("ORDER_SLA_BATCH_SIZE", 100),
+		},
+		Budget: BudgetConfig{
+			AlertInterval:  getEnvAsDuration("BUDGET_ALERT_INTERVAL", 15*time.Minute),
+			AlertBatchSize: getEnvAsInt("BUDGET_ALERT_BATCH_SIZE", 200),
+		},
+		Oracle: OracleConfig{
+			Feeds:       getEnvAsStringSlice("ORACLE_FEEDS"),
+			StaticRates: getEnvAsStringSlice("ORACLE_STATIC_RATES"),
+			MaxAge:      getEnvAsDuration("ORACLE_MAX_AGE", 25*time.Hour),
+			QuoteTTL:    getEnvAsDuration("QUOTE_TTL", 10*time.Minute),
+		},
+		Depeg: DepegConfig{
+			ThresholdBps:  getEnvAsInt("DEPEG_THRESHOLD_BPS", 100),
+			CheckInterval: getEnvAsDuration("DEPEG_CHECK_INTERVAL", time.Minute),
+		},
+		Status: StatusConfig{
+			CacheTTL:        getEnvAsDuration("STATUS_CACHE_TTL", 30*time.Second),
+			DegradedLag:     getEnvAsDuration("STATUS_DEGRADED_LAG", 2*time.Minute),
+			OutageLag:       getEnvAsDuration("STATUS_OUTAGE_LAG", 15*time.Minute),
+			IncidentHistory: getEnvAsDuration("STATUS_INCIDENT_HISTORY", 7*24*time.Hour),
+		},
+		Risk: RiskConfig{
+			LatePaymentWeight: getEnvAsFloat("RISK_LATE_PAYMENT_WEIGHT", 40),
+			DisputeWeight:     getEnvAsFloat("RISK_DISPUTE_WEIGHT", 30),
+			ChargebackPenalty: getEnvAsFloat("RISK_CHARGEBACK_PENALTY", 15),
+			OverduePenalty:    getEnvAsFloat("RISK_OVERDUE_PENALTY", 10),
+			DunningPenalty:    getEnvAsFloat("RISK_DUNNING_PENALTY", 2),
+			NetTermsMinScore:  getEnvAsFloat("RISK_NET_TERMS_MIN_SCORE", 70),
+			NetTermsMinOrders: getEnvAsInt("RISK_NET_TERMS_MIN_ORDERS", 3),
+		},
+		Settlement: SettlementConfig{
+			HoldPeriod:         getEnvAsDuration("SETTLEMENT_HOLD_PERIOD", 72*time.Hour),
+			BatchHour:          getEnvAsInt("SETTLEMENT_BATCH_HOUR", 0),
+			TransferGas:        getEnvAsInt("SETTLEMENT_TRANSFER_GAS", 65000),
+			BatchBaseGas:       getEnvAsInt("SETTLEMENT_BATCH_BASE_GAS", 50000),
+			BatchTransferGas:   getEnvAsInt("SETTLEMENT_BATCH_TRANSFER_GAS", 30000),
+			BatchMaxRecipients: getEnvAsInt("SETTLEMENT_BATCH_MAX_RECIPIENTS", 200),
+			NettingWindow:      getEnvAsDuration("SETTLEMENT_NETTING_WINDOW", 24*time.Hour),
+			SchedulerInterval:  getEnvAsDuration("SETTLEMENT_SCHEDULER_INTERVAL", time.Minute),
+			SchedulerBatchSize: getEnvAsInt("SETTLEMENT_SCHEDULER_BATCH_SIZE", 200),
+			ApprovalThreshold:  getEnv("SETTLEMENT_PAYOUT_APPROVAL_THRESHOLD", "10000"),
+		},
+		Fee: FeeConfig{
+			Tiers:        strings.Split(getEnv("FEE_TIERS", "STANDARD:0:100:0.50,GROWTH:100000:75:0.25,ENTERPRISE:1000000:50:0"), ","),
+			VolumeWindow: getEnvAsDuration("FEE_VOLUME_WINDOW", 30*24*time.Hour),
+			Interval:     getEnvAsDuration("FEE_INTERVAL", time.Minute),
+			BatchSize:    getEnvAsInt("FEE_BATCH_SIZE", 200),
+		},
+		Dispute: DisputeConfig{
+			Window: getEnvAsDuration("DISPUTE_WINDOW", 60*24*time.Hour),
+		},
+		Expiry: PaymentExpiryConfig{
+			Interval:  getEnvAsDuration("PAYMENT_EXPIRY_INTERVAL", time.Minute),
+			BatchSize: getEnvAsInt("PAYMENT_EXPIRY_BATCH_SIZE", 100),
+		},
+		Reservation: ReservationExpiryConfig{
+			TTL:       getEnvAsDuration("RESERVATION_TTL", 24*time.Hour),
+			Interval:  getEnvAsDuration("RESERVATION_EXPIRY_INTERVAL", time.Minute),
+			BatchSize: getEnvAsInt("RESERVATION_EXPIRY_BATCH_SIZE", 100),
+		},
+		Sanctions: SanctionsConfig{
+			Screener:     getEnv("SANCTIONS_SCREENER", "noop"),
+			DenyList:     getEnvAsStringSlice("SANCTIONS_DENYLIST"),
+			DenyListFile: getEnv("SANCTIONS_DENYLIST_FILE", ""),
+		},
+		ENS: ENSConfig{
+			RevalidateInterval: getEnvAsDuration("ENS_REVALIDATE_INTERVAL", time.Hour),
+			MaxAge:             getEnvAsDuration("ENS_MAX_AGE", 24*time.Hour),
+			BatchSize:          getEnvAsInt("ENS_BATCH_SIZE", 100),
+		},
+		Primary: PrimaryWalletConfig{
+			RepairInterval: getEnvAsDuration("PRIMARY_WALLET_REPAIR_INTERVAL", time.Hour),
+			BatchSize:      getEnvAsInt("PRIMARY_WALLET_REPAIR_BATCH_SIZE", 100),
+		},
+		Payout: PayoutAddressConfig{
+			ActivationDelay:     getEnvAsDuration("PAYOUT_ADDRESS_ACTIVATION_DELAY", 24*time.Hour),
+			PhoneChangeCooldown: getEnvAsDuration("PAYOUT_ADDRESS_PHONE_CHANGE_COOLDOWN", 72*time.Hour),
+		},
+		Deposit: DepositConfig{
+			XPub: getEnv("DEPOSIT_XPUB", ""),
+		},
+		Storage: StorageConfig{
+			Driver:            getEnv("STORAGE_DRIVER", "local"),
+			LocalDir:          getEnv("STORAGE_LOCAL_DIR", "./data/artifacts"),
+			PublicURL:         getEnv("STORAGE_PUBLIC_URL", ""),
+			SigningKey:        getEnv("STORAGE_SIGNING_KEY", ""),
+			EncryptionKey:     getEnv("STORAGE_ENCRYPTION_KEY", ""),
+			Bucket:            getEnv("STORAGE_BUCKET", ""),
+			Region:            getEnv("STORAGE_REGION", ""),
+			Endpoint:          getEnv("STORAGE_ENDPOINT", ""),
+			AccessKeyID:       getEnv("STORAGE_ACCESS_KEY_ID", ""),
+			SecretAccessKey:   getEnv("STORAGE_SECRET_ACCESS_KEY", ""),
+			ReportExportDays:  getEnvAsInt("STORAGE_REPORT_EXPORT_DAYS", 7),
+			DataExportDays:    getEnvAsInt("STORAGE_DATA_EXPORT_DAYS", 30),
+			LifecycleInterval: getEnvAsDuration("STORAGE_LIFECYCLE_INTERVAL", time.Hour),
+		},
+		Reconcile: ReconciliationConfig{
+			TreasuryAccountID: getEnv("RECONCILIATION_TREASURY_ACCOUNT_ID", ""),
+			Tolerance:         getEnv("RECONCILIATION_TOLERANCE", "1.00"),
+			Interval:          getEnvAsDuration("RECONCILIATION_INTERVAL", 15*time.Minute),
+		},
+		Limits: LimitsConfig{
+			Defaults: strings.Split(getEnv("ACCOUNT_LIMIT_DEFAULTS", "NONE:1000:10000:1000:10000,VERIFIED:100000:1000000:100000:1000000"), ","),
+		},
+		Statement: StatementConfig{
+			Interval:  getEnvAsDuration("STATEMENT_INTERVAL", time.Hour),
+			BatchSize: getEnvAsInt("STATEMENT_BATCH_SIZE", 200),
+			Grace:     getEnvAsDuration("STATEMENT_GRACE", time.Hour),
+		},
+		Snapshot: SnapshotConfig{
+			Interval:   getEnvAsDuration("BALANCE_SNAPSHOT_INTERVAL", 10*time.Minute),
+			BatchSize:  getEnvAsInt("BALANCE_SNAPSHOT_BATCH_SIZE", 200),
+			MinEntries: getEnvAsInt("BALANCE_SNAPSHOT_MIN_ENTRIES", 100),
+		},
+		Org: OrganizationConfig{
+			InvitationTTL: getEnvAsDuration("ORGANIZATION_INVITATION_TTL", 7*24*time.Hour),
+		},
+		RFQ: RFQConfig{
+			MaxQuoteValidity: getEnvAsDuration("RFQ_MAX_QUOTE_VALIDITY", 7*24*time.Hour),
+		},
+		Invoice: InvoiceConfig{
+			Interval:  getEnvAsDuration("INVOICE_INTERVAL", time.Minute),
+			BatchSize: getEnvAsInt("INVOICE_MATCH_BATCH_SIZE", 100),
+		},
+		Tax: TaxConfig{
+			Provider:        getEnv("TAX_PROVIDER", "none"),
+			ExternalURL:     getEnv("TAX_API_URL", ""),
+			ExternalAPIKey:  getEnv("TAX_API_KEY", ""),
+			ExternalTimeout: getEnvAsDuration("TAX_API_TIMEOUT", 5*time.Second),
+		},
+		RMA: RMAConfig{
+			Window: getEnvAsDuration("RMA_WINDOW", 30*24*time.Hour),
+		},
+		Cart: CartConfig{
+			TTL:                 getEnvAsDuration("CART_TTL", 7*24*time.Hour),
+			Interval:            getEnvAsDuration("CART_EXPIRY_INTERVAL", 5*time.Minute),
+			BatchSize:           getEnvAsInt("CART_EXPIRY_BATCH_SIZE", 100),
+			ReservationStrategy: getEnv("CART_RESERVATION_STRATEGY", "NEAREST"),
+		},
+		OTP: OTPConfig{
+			CodeTTL:     getEnvAsDuration("OTP_CODE_TTL", 5*time.Minute),
+			Cooldown:    getEnvAsDuration("OTP_RESEND_COOLDOWN", time.Minute),
+			SendLimit:   getEnvAsInt("OTP_SEND_LIMIT", 5),
+			SendWindow:  getEnvAsDuration("OTP_SEND_WINDOW", time.Hour),
+			MaxAttempts: getEnvAsInt("OTP_MAX_ATTEMPTS", 5),
+			SMSProvider: getEnv("SMS_PROVIDER", "log"),
+			SMSURL:      getEnv("SMS_API_URL", ""),
+			SMSAPIKey:   getEnv("SMS_API_KEY", ""),
+			SMSTimeout:  getEnvAsDuration("SMS_API_TIMEOUT", 5*time.Second),
+		},
+		KYC: KYCConfig{
+			Provider:           getEnv("KYC_PROVIDER", "manual"),
+			URL:                getEnv("KYC_API_URL", ""),
+			APIKey:             getEnv("KYC_API_KEY", ""),
+			WebhookSecret:      getEnv("KYC_WEBHOOK_SECRET", ""),
+			WebhookTolerance:   getEnvAsDuration("KYC_WEBHOOK_TOLERANCE", 5*time.Minute),
+			Timeout:            getEnvAsDuration("KYC_API_TIMEOUT", 10*time.Second),
+			DocumentMaxSize:    getEnvAsInt64("KYC_DOCUMENT_MAX_SIZE", 10<<20),
+			DocumentMaxPerUser: getEnvAsInt("KYC_DOCUMENT_MAX_PER_USER", 20),
+		},
+		Idempotency: IdempotencyConfig{
+			TTL:     getEnvAsDuration("IDEMPOTENCY_TTL", 24*time.Hour),
+			LockTTL: getEnvAsDuration("IDEMPOTENCY_LOCK_TTL", 2*time.Minute),
+		},
+		RateLimit: RateLimitConfig{
+			IPPerMinute:        getEnvAsInt("RATE_LIMIT_IP_PER_MINUTE", 120),
+			IPBurst:            getEnvAsInt("RATE_LIMIT_IP_BURST", 30),
+			APIKeyPerMinute:    getEnvAsInt("RATE_LIMIT_API_KEY_PER_MINUTE", 600),
+			APIKeyBurst:        getEnvAsInt("RATE_LIMIT_API_KEY_BURST", 100),
+			SensitivePerMinute: getEnvAsInt("RATE_LIMIT_SENSITIVE_PER_MINUTE", 10),
+			SensitiveBurst:     getEnvAsInt("RATE_LIMIT_SENSITIVE_BURST", 5),
+		},
+		Usage: UsageConfig{
+			FlushInterval: getEnvAsDuration("USAGE_FLUSH_INTERVAL", time.Minute),
+		},
+		Webhook: WebhookConfig{
+			MaxAttempts:          getEnvAsInt("WEBHOOK_MAX_ATTEMPTS", 8),
+			PollInterval:         getEnvAsDuration("WEBHOOK_POLL_INTERVAL", 5*time.Second),
+			BatchSize:            getEnvAsInt("WEBHOOK_BATCH_SIZE", 50),
+			Timeout:              getEnvAsDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+			AllowInsecureURLs:    getEnvAsBool("WEBHOOK_ALLOW_INSECURE_URLS", false),
+			RequeueRatePerMinute: getEnvAsInt("WEBHOOK_REQUEUE_RATE_PER_MINUTE", 60),
+		},
+		Outbox: OutboxConfig{
+			PollInterval: getEnvAsDuration("OUTBOX_POLL_INTERVAL", time.Second),
+			BatchSize:    getEnvAsInt("OUTBOX_BATCH_SIZE", 100),
+		},
+		Retention: RetentionConfig{
+			AuditLogs:         getEnvAsDuration("RETENTION_AUDIT_LOGS", 5*365*24*time.Hour),
+			WebhookDeliveries: getEnvAsDuration("RETENTION_WEBHOOK_DELIVERIES", 90*24*time.Hour),
+			OutboxEvents:      getEnvAsDuration("RETENTION_OUTBOX_EVENTS", 30*24*time.Hour),
+			Interval:          getEnvAsDuration("RETENTION_INTERVAL", 24*time.Hour),
+			BatchSize:         getEnvAsInt("RETENTION_BATCH_SIZE", 1000),
+		},
+		EventBus: EventBusConfig{
+			Driver:       getEnv("EVENT_BUS_DRIVER", "noop"),
+			KafkaRESTURL: getEnv("EVENT_BUS_KAFKA_REST_URL", "http://localhost:8082"),
+			NATSURL:      getEnv("EVENT_BUS_NATS_URL", "nats://localhost:4222"),
+			TopicPrefix:  getEnv("EVENT_BUS_TOPIC_PREFIX", "settlement"),
+			Timeout:      getEnvAsDuration("EVENT_BUS_TIMEOUT", 5*time.Second),
+		},
+		Tracing: TracingConfig{
+			ServiceName:  getEnv("OTEL_SERVICE_NAME", "go-stable"),
+			OTLPEndpoint: getEnv("OTEL_EXPORTER_OTLP_ENDPOINT", ""),
+			SampleRatio:  getEnvAsFloat("OTEL_TRACES_SAMPLER_ARG", 1.0),
 		},
 	}, nil
 }
@@ -117,6 +853,116 @@ func getEnvAsInt64(key string, defaultValue int64) int64 {
 	return defaultValue
 }
 
+func getEnvAsFloat(key string, defaultValue float64) float64 {
+	if value, exists := os.LookupEnv(key); exists {
+		if floatVal, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatVal
+		}
+	}
+	return defaultValue
+}
+
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value, exists := os.LookupEnv(key); exists {
+		if boolVal, err := strconv.ParseBool(value); err == nil {
+			return boolVal
+		}
+	}
+	return defaultValue
+}
+
+// getEnvAsIntSlice parses a comma-separated list (e.g. "3,7"); empty value = empty list
+func getEnvAsIntSlice(key string, defaultValue []int) []int {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return defaultValue
+	}
+
+	result := []int{}
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		intVal, err := strconv.Atoi(part)
+		if err != nil {
+			return defaultValue
+		}
+		result = append(result, intVal)
+	}
+	return result
+}
+
+// getEnvAsStringSlice parses a comma-separated list, skipping blank entries
+func getEnvAsStringSlice(key string) []string {
+	var result []string
+	for _, part := range strings.Split(os.Getenv(key), ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			result = append(result, part)
+		}
+	}
+	return result
+}
+
+// getEnvAsNetworks parses "chainID:name:verifyingContract" entries separated by commas.
+// 형식이 잘못된 항목은 건너뜀 (verifying contract 검증은 chain.NewRegistry에서 수행)
+func getEnvAsNetworks(key string) []NetworkConfig {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return nil
+	}
+
+	var result []NetworkConfig
+	for _, entry := range strings.Split(value, ",") {
+		parts := strings.Split(strings.TrimSpace(entry), ":")
+		if len(parts) != 3 {
+			continue
+		}
+		chainID, err := strconv.ParseInt(strings.TrimSpace(parts[0]), 10, 64)
+		if err != nil {
+			continue
+		}
+		result = append(result, NetworkConfig{
+			ChainID:           chainID,
+			Name:              strings.TrimSpace(parts[1]),
+			VerifyingContract: strings.TrimSpace(parts[2]),
+		})
+	}
+	return result
+}
+
+// getEnvAsTokens parses "symbol:decimals:chainID:address" entries separated by commas.
+// 형식이 잘못된 항목은 건너뜀 (주소/decimals 일관성 검증은 chain.NewTokenRegistry에서 수행)
+func getEnvAsTokens(key string) []TokenConfig {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return nil
+	}
+
+	var result []TokenConfig
+	for _, entry := range strings.Split(value, ",") {
+		parts := strings.Split(strings.TrimSpace(entry), ":")
+		if len(parts) != 4 {
+			continue
+		}
+		decimals, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil {
+			continue
+		}
+		chainID, err := strconv.ParseInt(strings.TrimSpace(parts[2]), 10, 64)
+		if err != nil {
+			continue
+		}
+		result = append(result, TokenConfig{
+			Symbol:   strings.ToUpper(strings.TrimSpace(parts[0])),
+			Decimals: decimals,
+			ChainID:  chainID,
+			Address:  strings.TrimSpace(parts[3]),
+		})
+	}
+	return result
+}
+
 func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 	if value, exists := os.LookupEnv(key); exists {
 		if duration, err := time.ParseDuration(value); err == nil {
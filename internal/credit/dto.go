@@ -0,0 +1,101 @@
+package credit
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/invoice"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// CreateCreditTermsRequest represents the request body for extending net terms to a buyer
+type CreateCreditTermsRequest struct {
+	BuyerID     string `json:"buyer_id" binding:"required,uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	SellerID    string `json:"seller_id,omitempty" binding:"omitempty,uuid" example:"550e8400-e29b-41d4-a716-446655440001"` // omitted = caller
+	TokenSymbol string `json:"token_symbol,omitempty" binding:"max=10" example:"USDC"`
+	Terms       string `json:"terms" binding:"required,oneof=NET_30 NET_60" example:"NET_30"`
+	CreditLimit string `json:"credit_limit" binding:"required,max=32" example:"50000.00"`
+}
+
+// UpdateCreditTermsRequest represents the request body for changing net terms (omitted fields unchanged)
+type UpdateCreditTermsRequest struct {
+	Terms       *string `json:"terms,omitempty" binding:"omitempty,oneof=NET_30 NET_60" example:"NET_60"`
+	CreditLimit *string `json:"credit_limit,omitempty" binding:"omitempty,max=32" example:"75000.00"`
+	Status      *string `json:"status,omitempty" binding:"omitempty,oneof=ACTIVE SUSPENDED" example:"SUSPENDED"`
+}
+
+// ListCreditTermsRequest represents query parameters for listing the caller's credit terms
+type ListCreditTermsRequest struct {
+	Page     int `form:"page,default=1" binding:"min=1"`
+	PageSize int `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// PlaceOrderOnTermsRequest represents the request body for placing a confirmed order on terms
+type PlaceOrderOnTermsRequest struct {
+	OrderNumber string `json:"order_number" binding:"required,max=50" example:"ORD-20261003-0001"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// CreditTermsResponse represents net terms between a buyer and a seller
+type CreditTermsResponse struct {
+	CreditTermsID     string      `json:"credit_terms_id" example:"ee0e8400-e29b-41d4-a716-446655440000"`
+	BuyerID           string      `json:"buyer_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	BuyerName         string      `json:"buyer_name" example:"Acme Trading"`
+	SellerID          string      `json:"seller_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	SellerName        string      `json:"seller_name" example:"Widget Works"`
+	TokenSymbol       string      `json:"token_symbol" example:"USDC"`
+	Terms             string      `json:"terms" example:"NET_30" enums:"NET_30,NET_60"`
+	NetDays           int         `json:"net_days" example:"30"`
+	CreditLimit       money.Money `json:"credit_limit" swaggertype:"string" example:"50000.00"`
+	OutstandingAmount money.Money `json:"outstanding_amount" swaggertype:"string" example:"12500.00"`
+	AvailableCredit   money.Money `json:"available_credit" swaggertype:"string" example:"37500.00"` // never negative
+	Status            string      `json:"status" example:"ACTIVE" enums:"ACTIVE,SUSPENDED"`
+	CreatedAt         time.Time   `json:"created_at"`
+	UpdatedAt         time.Time   `json:"updated_at"`
+}
+
+// ListCreditTermsResponse represents paginated credit terms list
+type ListCreditTermsResponse struct {
+	CreditTerms []CreditTermsResponse `json:"credit_terms"`
+	Total       int64                 `json:"total" example:"42"`
+	Page        int                   `json:"page" example:"1"`
+	PageSize    int                   `json:"page_size" example:"20"`
+	TotalPages  int                   `json:"total_pages" example:"3"`
+}
+
+// OrderOnTermsResponse represents an order placed on terms: its issued invoice and the terms after the charge
+type OrderOnTermsResponse struct {
+	Invoice     invoice.InvoiceResponse `json:"invoice"`
+	CreditTerms CreditTermsResponse     `json:"credit_terms"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToCreditTermsResponse converts credit terms with their parties to the response
+func ToCreditTermsResponse(ct *db.GetCreditTermsDetailRow, limit, outstanding money.Money) *CreditTermsResponse {
+	return &CreditTermsResponse{
+		CreditTermsID:     ct.ExternalID,
+		BuyerID:           ct.BuyerExternalID.String,
+		BuyerName:         ct.BuyerName,
+		SellerID:          ct.SellerExternalID.String,
+		SellerName:        ct.SellerName,
+		TokenSymbol:       ct.TokenSymbol,
+		Terms:             string(ct.Terms),
+		NetDays:           NetDays(ct.Terms),
+		CreditLimit:       limit,
+		OutstandingAmount: outstanding,
+		AvailableCredit:   limit.Sub(outstanding).NonNegative(),
+		Status:            string(ct.Status),
+		CreatedAt:         ct.CreatedAt,
+		UpdatedAt:         ct.UpdatedAt,
+	}
+}
@@ -0,0 +1,244 @@
+package credit
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for credit terms
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new credit handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers credit terms routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	terms := rg.Group("/credit-terms", middleware.RequireAuth())
+	{
+		terms.POST("", h.CreateCreditTerms)
+		terms.GET("", h.ListCreditTerms)
+		terms.GET("/:creditTermsId", h.GetCreditTerms)
+		terms.PATCH("/:creditTermsId", h.UpdateCreditTerms)
+		terms.POST("/orders", h.PlaceOrderOnTerms)
+	}
+}
+
+// extractAndValidateCreditTermsID extracts and validates creditTermsId from path
+func extractAndValidateCreditTermsID(c *gin.Context) (string, error) {
+	termsID := c.Param("creditTermsId")
+	if _, err := uuid.Parse(termsID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+	return termsID, nil
+}
+
+// CreateCreditTerms godoc
+// @Summary Extend credit terms
+// @Description Extend net terms (NET_30 or NET_60) with a credit limit in the settlement token to a buyer (seller only).
+// @Description One set of terms per buyer-seller relationship; change it with PATCH.
+// @Tags credit
+// @Accept json
+// @Produce json
+// @Param request body CreateCreditTermsRequest true "Buyer, terms and credit limit"
+// @Success 201 {object} middleware.SuccessResponse{data=CreditTermsResponse} "Credit terms created"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input or unsupported token"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot extend terms for another seller"
+// @Failure 404 {object} middleware.ErrorResponse "Buyer or seller not found"
+// @Failure 409 {object} middleware.ErrorResponse "Credit terms already exist for this buyer"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/credit-terms [post]
+func (h *Handler) CreateCreditTerms(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req CreateCreditTermsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+	if req.SellerID == "" {
+		req.SellerID = principal.UserExternalID
+	}
+
+	result, err := h.service.CreateCreditTerms(c.Request.Context(), &req, creditAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// ListCreditTerms godoc
+// @Summary List credit terms
+// @Description Get paginated credit terms the caller extended (as seller) or received (as buyer), newest first
+// @Tags credit
+// @Produce json
+// @Param page query int false "Page number" default(1) minimum(1)
+// @Param page_size query int false "Page size" default(20) minimum(1) maximum(100)
+// @Success 200 {object} middleware.SuccessResponse{data=ListCreditTermsResponse} "Credit terms"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/credit-terms [get]
+func (h *Handler) ListCreditTerms(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req ListCreditTermsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListCreditTerms(c.Request.Context(), principal.UserID, &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// GetCreditTerms godoc
+// @Summary Get credit terms
+// @Description Get credit terms with the outstanding invoice balance and the available credit (buyer or seller)
+// @Tags credit
+// @Produce json
+// @Param creditTermsId path string true "Credit terms ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=CreditTermsResponse} "Credit terms"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Credit terms not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/credit-terms/{creditTermsId} [get]
+func (h *Handler) GetCreditTerms(c *gin.Context) {
+	termsID, err := extractAndValidateCreditTermsID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	result, err := h.service.GetCreditTerms(c.Request.Context(), termsID, creditAccess(principal))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// UpdateCreditTerms godoc
+// @Summary Update credit terms
+// @Description Change the terms, credit limit or status (seller only). Lowering the limit below the outstanding balance or
+// @Description suspending the terms blocks new orders on terms; issued invoices are unaffected and can still be paid.
+// @Tags credit
+// @Accept json
+// @Produce json
+// @Param creditTermsId path string true "Credit terms ID (UUID)"
+// @Param request body UpdateCreditTermsRequest true "Fields to change"
+// @Success 200 {object} middleware.SuccessResponse{data=CreditTermsResponse} "Credit terms updated"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Only the seller can change credit terms"
+// @Failure 404 {object} middleware.ErrorResponse "Credit terms not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/credit-terms/{creditTermsId} [patch]
+func (h *Handler) UpdateCreditTerms(c *gin.Context) {
+	termsID, err := extractAndValidateCreditTermsID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req UpdateCreditTermsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.UpdateCreditTerms(c.Request.Context(), termsID, &req, creditAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// PlaceOrderOnTerms godoc
+// @Summary Place order on terms
+// @Description Place a CONFIRMED order on the buyer's net terms with its seller (buyer only). The order total is charged against
+// @Description the available credit and invoiced immediately (INV-YYYYMMDD-NNNN), due after the terms' net days; the order's payment
+// @Description due date follows the invoice so overdue invoices are dunned. The invoice is managed under /invoices.
+// @Tags credit
+// @Accept json
+// @Produce json
+// @Param request body PlaceOrderOnTermsRequest true "Order to place on terms"
+// @Success 201 {object} middleware.SuccessResponse{data=OrderOnTermsResponse} "Invoice issued and credit charged"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input, no credit terms, or token mismatch"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Order not found"
+// @Failure 409 {object} middleware.ErrorResponse "Order not confirmed or already invoiced, terms suspended, or insufficient available credit"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/credit-terms/orders [post]
+func (h *Handler) PlaceOrderOnTerms(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req PlaceOrderOnTermsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.PlaceOrderOnTerms(c.Request.Context(), &req, creditAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// creditAccess maps the principal to the service's credit access check
+func creditAccess(principal *middleware.Principal) Access {
+	return Access{
+		Admin:    principal.IsAdmin(),
+		CanActAs: principal.CanActAs,
+	}
+}
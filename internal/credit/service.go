@@ -0,0 +1,488 @@
+package credit
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"strings"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/invoice"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	mysqlErrDuplicateEntry = 1062
+
+	// amountScale is the scale of credit limits and order amounts (DECIMAL(18,2))
+	amountScale = 2
+)
+
+// Audit log identifiers
+const (
+	actionTermsCreated  = "CREDIT_TERMS_CREATED"
+	actionTermsUpdated  = "CREDIT_TERMS_UPDATED"
+	actionOrderOnTerms  = "ORDER_PLACED_ON_TERMS"
+	resourceCreditTerms = "CREDIT_TERMS"
+)
+
+// Access is the caller's access to credit terms
+type Access struct {
+	// Admin may view and manage any credit terms
+	Admin bool
+	// CanActAs reports whether the caller may operate on the user's resources (buyer/seller external ID)
+	CanActAs func(userExternalID string) bool
+}
+
+// canView reports whether the caller is the buyer, the seller or an admin
+func (a Access) canView(buyerID, sellerID string) bool {
+	return a.Admin || a.CanActAs(buyerID) || a.CanActAs(sellerID)
+}
+
+// NetDays returns the payment period of the terms in days
+func NetDays(terms db.CreditTermsTerms) int {
+	if terms == db.CreditTermsTermsNET60 {
+		return 60
+	}
+	return 30
+}
+
+// Service handles net terms.
+// A seller extends net terms (NET-30/60) with a credit limit to a buyer; the buyer places confirmed orders
+// on terms, each of which is invoiced (see invoice.Service) with a due date and consumes available credit until it is paid.
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	tokens   *chain.TokenRegistry
+	invoices *invoice.Service
+	logger   *zap.Logger
+}
+
+// NewService creates a new credit service
+func NewService(txRunner *pkgdb.TxRunner, tokens *chain.TokenRegistry, invoices *invoice.Service, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		tokens:   tokens,
+		invoices: invoices,
+		logger:   logger,
+	}
+}
+
+// CreateCreditTerms extends net terms with a credit limit to a buyer (seller or admin)
+func (s *Service) CreateCreditTerms(ctx context.Context, req *CreateCreditTermsRequest, access Access, actor audit.Actor) (*CreditTermsResponse, error) {
+	// 1. Validate
+	if !access.CanActAs(req.SellerID) {
+		return nil, errors.Forbidden("Cannot extend terms for another seller")
+	}
+	if req.BuyerID == req.SellerID {
+		return nil, errors.InvalidInput("buyer_id must differ from the seller")
+	}
+	token, err := s.tokens.Resolve(req.TokenSymbol, 0)
+	if err != nil {
+		return nil, errors.InvalidInput("unsupported token_symbol")
+	}
+	limit, err := parseAmount(req.CreditLimit, "credit_limit")
+	if err != nil {
+		return nil, err
+	}
+
+	q := s.txRunner.Queries()
+	buyer, err := s.getUser(ctx, q, req.BuyerID, "Buyer")
+	if err != nil {
+		return nil, err
+	}
+	seller, err := s.getUser(ctx, q, req.SellerID, "Seller")
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Create terms (uk_credit_terms_parties → 관계당 1건)
+	externalID := uuid.New().String()
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		result, err := q.CreateCreditTerms(ctx, db.CreateCreditTermsParams{
+			ExternalID:  externalID,
+			BuyerID:     buyer.ID,
+			SellerID:    seller.ID,
+			TokenSymbol: token.Symbol,
+			Terms:       db.CreditTermsTerms(req.Terms),
+			CreditLimit: limit.String(),
+		})
+		if err != nil {
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionTermsCreated,
+			ResourceType: resourceCreditTerms,
+			ResourceID:   uint64(id),
+			NewValue: map[string]any{
+				"buyer_id":     req.BuyerID,
+				"seller_id":    req.SellerID,
+				"token_symbol": token.Symbol,
+				"terms":        req.Terms,
+				"credit_limit": limit.String(),
+			},
+		})
+	})
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, errors.Conflict("Credit terms already exist for this buyer, update them instead")
+		}
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to create credit terms", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetCreditTerms(ctx, externalID, access)
+}
+
+// GetCreditTerms returns credit terms with the available credit (buyer, seller or admin)
+func (s *Service) GetCreditTerms(ctx context.Context, externalID string, access Access) (*CreditTermsResponse, error) {
+	detail, err := s.getTermsDetail(ctx, s.txRunner.Queries(), externalID, access)
+	if err != nil {
+		return nil, err
+	}
+	return s.toCreditTermsResponse(ctx, detail)
+}
+
+// ListCreditTerms returns the credit terms the user extended or received, newest first
+func (s *Service) ListCreditTerms(ctx context.Context, userID uint64, req *ListCreditTermsRequest) (*ListCreditTermsResponse, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	q := s.txRunner.Queries()
+	rows, err := q.ListCreditTermsByUser(ctx, db.ListCreditTermsByUserParams{
+		UserID: userID,
+		Limit:  int32(req.PageSize),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list credit terms", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := q.CountCreditTermsByUser(ctx, userID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count credit terms", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	terms := make([]CreditTermsResponse, 0, len(rows))
+	for i := range rows {
+		detail := db.GetCreditTermsDetailRow(rows[i])
+		response, err := s.toCreditTermsResponse(ctx, &detail)
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, *response)
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListCreditTermsResponse{
+		CreditTerms: terms,
+		Total:       total,
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+		TotalPages:  totalPages,
+	}, nil
+}
+
+// UpdateCreditTerms changes the terms, credit limit or status (seller or admin).
+// NOTE: 한도를 미결제 잔액 아래로 낮추거나 SUSPENDED로 바꾸면 신규 외상 주문만 차단 (발행된 청구서는 그대로)
+func (s *Service) UpdateCreditTerms(ctx context.Context, externalID string, req *UpdateCreditTermsRequest, access Access, actor audit.Actor) (*CreditTermsResponse, error) {
+	detail, err := s.getTermsDetail(ctx, s.txRunner.Queries(), externalID, access)
+	if err != nil {
+		return nil, err
+	}
+	if !access.CanActAs(detail.SellerExternalID.String) {
+		return nil, errors.Forbidden("Only the seller can change credit terms")
+	}
+	var limit *money.Money
+	if req.CreditLimit != nil {
+		parsed, err := parseAmount(*req.CreditLimit, "credit_limit")
+		if err != nil {
+			return nil, err
+		}
+		limit = &parsed
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		terms, err := q.GetCreditTermsForUpdate(ctx, detail.ID)
+		if err != nil {
+			return err
+		}
+
+		params := db.UpdateCreditTermsParams{
+			Terms:       terms.Terms,
+			CreditLimit: terms.CreditLimit,
+			Status:      terms.Status,
+			ID:          terms.ID,
+		}
+		if req.Terms != nil {
+			params.Terms = db.CreditTermsTerms(*req.Terms)
+		}
+		if limit != nil {
+			params.CreditLimit = limit.String()
+		}
+		if req.Status != nil {
+			params.Status = db.CreditTermsStatus(*req.Status)
+		}
+		if err := q.UpdateCreditTerms(ctx, params); err != nil {
+			return err
+		}
+
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionTermsUpdated,
+			ResourceType: resourceCreditTerms,
+			ResourceID:   terms.ID,
+			OldValue: map[string]any{
+				"terms":        string(terms.Terms),
+				"credit_limit": terms.CreditLimit,
+				"status":       string(terms.Status),
+			},
+			NewValue: map[string]any{
+				"terms":        string(params.Terms),
+				"credit_limit": params.CreditLimit,
+				"status":       string(params.Status),
+			},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to update credit terms", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetCreditTerms(ctx, externalID, access)
+}
+
+// PlaceOrderOnTerms places a confirmed order on the buyer's net terms (buyer or admin).
+// The order total is charged against the available credit and invoiced immediately, due after the terms' net days;
+// the order's payment due date follows the invoice so dunning applies to overdue invoices.
+//
+// Why:
+//   - 주문 → 외상 조건 순서로 row-lock → 같은 관계의 동시 청구가 가용 한도를 초과해 통과하지 않음
+//   - 주문당 청구서 1건 (uk_invoice_order) → 같은 주문 이중 청구 방지 (판매자가 작성한 청구서 포함)
+func (s *Service) PlaceOrderOnTerms(ctx context.Context, req *PlaceOrderOnTermsRequest, access Access, actor audit.Actor) (*OrderOnTermsResponse, error) {
+	// 1. Resolve the order and the parties' terms
+	q := s.txRunner.Queries()
+	order, err := q.GetOrderByOrderNumber(ctx, req.OrderNumber)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Order")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get order", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	// 구매자(또는 관리자)가 아니면 주문 존재 여부도 노출하지 않음
+	buyer, err := q.GetUserByID(ctx, order.BuyerID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to get buyer", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if !access.CanActAs(buyer.ExternalID.String) {
+		return nil, errors.NotFound("Order")
+	}
+	terms, err := q.GetCreditTermsByParties(ctx, db.GetCreditTermsByPartiesParams{
+		BuyerID:  order.BuyerID,
+		SellerID: order.SellerID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.InvalidInput("seller has not extended credit terms to the buyer")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get credit terms", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if order.TokenSymbol != terms.TokenSymbol {
+		return nil, errors.InvalidInput("order token " + order.TokenSymbol + " does not match the credit terms token " + terms.TokenSymbol)
+	}
+
+	// 2. Charge the credit and issue the invoice (one transaction)
+	var invoiceExternalID string
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		locked, err := q.GetOrderByIDForUpdate(ctx, order.ID)
+		if err != nil {
+			return err
+		}
+		if locked.Status != db.OrdersStatusCONFIRMED {
+			return errors.Conflict("Only confirmed orders can be placed on terms")
+		}
+		if _, err := q.GetInvoiceByOrder(ctx, sql.NullInt64{Int64: int64(locked.ID), Valid: true}); err == nil {
+			return errors.Conflict("Order is already invoiced")
+		} else if err != sql.ErrNoRows {
+			return err
+		}
+
+		terms, err := q.GetCreditTermsForUpdate(ctx, terms.ID)
+		if err != nil {
+			return err
+		}
+		if terms.Status != db.CreditTermsStatusACTIVE {
+			return errors.Conflict("Credit terms are suspended")
+		}
+		amount, err := parseStored(locked.TotalAmount)
+		if err != nil {
+			return err
+		}
+		limit, err := parseStored(terms.CreditLimit)
+		if err != nil {
+			return err
+		}
+		outstanding, err := parseStored(terms.OutstandingAmount)
+		if err != nil {
+			return err
+		}
+		if available := limit.Sub(outstanding); amount.Cmp(available) > 0 {
+			return errors.Conflict(fmt.Sprintf("Insufficient available credit: order %s, available %s %s",
+				amount.String(), available.NonNegative().String(), terms.TokenSymbol))
+		}
+
+		issued, err := invoice.IssueOrderInvoice(ctx, q, &locked, terms.ID, NetDays(terms.Terms))
+		if err != nil {
+			return err
+		}
+		invoiceExternalID = issued.ExternalID
+		if err := q.AddCreditTermsOutstanding(ctx, db.AddCreditTermsOutstandingParams{
+			OutstandingAmount: amount.String(),
+			ID:                terms.ID,
+		}); err != nil {
+			return err
+		}
+
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionOrderOnTerms,
+			ResourceType: resourceCreditTerms,
+			ResourceID:   terms.ID,
+			OldValue:     map[string]any{"outstanding": outstanding.String()},
+			NewValue: map[string]any{
+				"invoice_number": issued.InvoiceNumber.String,
+				"order_number":   locked.OrderNumber,
+				"amount":         amount.String(),
+				"terms":          string(terms.Terms),
+				"due_at":         issued.DueAt.Time,
+				"outstanding":    outstanding.Add(amount).String(),
+			},
+		})
+	})
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, errors.Conflict("Order is already invoiced")
+		}
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to place order on terms", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	issued, err := s.invoices.GetInvoice(ctx, invoiceExternalID, invoice.Access(access))
+	if err != nil {
+		return nil, err
+	}
+	charged, err := s.GetCreditTerms(ctx, terms.ExternalID, access)
+	if err != nil {
+		return nil, err
+	}
+	return &OrderOnTermsResponse{Invoice: *issued, CreditTerms: *charged}, nil
+}
+
+// getTermsDetail retrieves credit terms with their parties if the caller may see them
+func (s *Service) getTermsDetail(ctx context.Context, q *db.Queries, externalID string, access Access) (*db.GetCreditTermsDetailRow, error) {
+	detail, err := q.GetCreditTermsDetail(ctx, externalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Credit terms")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get credit terms", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if !access.canView(detail.BuyerExternalID.String, detail.SellerExternalID.String) {
+		return nil, errors.NotFound("Credit terms")
+	}
+	return &detail, nil
+}
+
+// getUser retrieves a party of the terms by external ID
+func (s *Service) getUser(ctx context.Context, q *db.Queries, externalID, resource string) (*db.User, error) {
+	user, err := q.GetUserByExternalID(ctx, sql.NullString{String: externalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound(resource)
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &user, nil
+}
+
+// toCreditTermsResponse parses the stored amounts and converts the terms
+func (s *Service) toCreditTermsResponse(ctx context.Context, detail *db.GetCreditTermsDetailRow) (*CreditTermsResponse, error) {
+	limit, err := s.parseStoredAmount(ctx, detail.CreditLimit)
+	if err != nil {
+		return nil, err
+	}
+	outstanding, err := s.parseStoredAmount(ctx, detail.OutstandingAmount)
+	if err != nil {
+		return nil, err
+	}
+	return ToCreditTermsResponse(detail, limit, outstanding), nil
+}
+
+// parseStoredAmount parses a DECIMAL(18,2) column
+func (s *Service) parseStoredAmount(ctx context.Context, value string) (money.Money, error) {
+	amount, err := money.Parse(value, "", amountScale)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("invalid stored amount", zap.String("amount", value), zap.Error(err))
+		return money.Money{}, errors.Internal("Invalid stored amount")
+	}
+	return amount, nil
+}
+
+// parseAmount parses a positive request amount
+func parseAmount(value, field string) (money.Money, error) {
+	amount, err := money.Parse(value, "", amountScale)
+	if err != nil || amount.Sign() <= 0 {
+		return money.Money{}, errors.InvalidInput(field + " must be a positive decimal with at most 2 decimal places")
+	}
+	return amount, nil
+}
+
+// parseStored parses a DECIMAL(18,2) column within a transaction (error rolls back)
+func parseStored(value string) (money.Money, error) {
+	amount, err := money.Parse(value, "", amountScale)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("invalid stored amount %q: %w", value, err)
+	}
+	return amount, nil
+}
+
+// isDuplicateKeyError checks if the error is a MySQL duplicate key error
+func isDuplicateKeyError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var mysqlErr *mysql.MySQLError
+	if stderrors.As(err, &mysqlErr) {
+		return mysqlErr.Number == mysqlErrDuplicateEntry
+	}
+	return strings.Contains(err.Error(), "Duplicate entry")
+}
@@ -0,0 +1,149 @@
+package delegatedsigner
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/eip712"
+)
+
+// Delegated signer statuses (derived from confirmed_at / expires_at / revoked_at)
+const (
+	StatusPendingConfirmation = "PENDING_CONFIRMATION"
+	StatusActive              = "ACTIVE"
+	StatusExpired             = "EXPIRED"
+	StatusRevoked             = "REVOKED"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// AddSignerRequest represents the request body for delegating signing rights to an address
+type AddSignerRequest struct {
+	Address string `json:"address" binding:"required,len=42" example:"0x742d35Cc6634C0532925a3b844Bc454e4438f44e"`
+	Label   string `json:"label,omitempty" binding:"omitempty,max=50" example:"CFO"`
+	// Scopes the delegate may sign: PAYMENT_AUTHORIZATION, LOGIN
+	Scopes []string `json:"scopes" binding:"required,min=1,max=2,dive,oneof=PAYMENT_AUTHORIZATION LOGIN" example:"PAYMENT_AUTHORIZATION,LOGIN"`
+	// ExpiresAt ends the delegation automatically (omitted = until revoked)
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// ConfirmSignerRequest represents the delegation signature of the user's primary wallet
+type ConfirmSignerRequest struct {
+	// Signature over the SignerDelegation typed data (0x + 130 or 128 (EIP-2098) hex chars for EOAs, longer for contract wallets)
+	Signature string `json:"signature" binding:"required,min=130,max=4098" example:"0x1234...abcd"`
+	Nonce     string `json:"nonce" binding:"required,min=8,max=64" example:"9f86d081884c7d659a2feaa0c55ad015"`
+	Timestamp int64  `json:"timestamp" binding:"required,gt=0" example:"1706000000"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// SignerResponse represents a delegated signer in API responses
+type SignerResponse struct {
+	ID      string   `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Address string   `json:"address" example:"0x742d35cc6634c0532925a3b844bc454e4438f44e"`
+	Label   string   `json:"label,omitempty" example:"CFO"`
+	Scopes  []string `json:"scopes" example:"LOGIN,PAYMENT_AUTHORIZATION"`
+	// Status: PENDING_CONFIRMATION → ACTIVE → EXPIRED (at expires_at), or REVOKED
+	Status      string     `json:"status" example:"ACTIVE"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	ConfirmedAt *time.Time `json:"confirmed_at,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// ListSignersResponse represents the delegated signers of a user (revoked signers excluded)
+type ListSignersResponse struct {
+	Signers []SignerResponse `json:"signers"`
+}
+
+// DelegationChallengeResponse represents the EIP-712 delegation the user's primary wallet must sign.
+// Sign typed_data as-is (eth_signTypedData_v4) and submit nonce/timestamp with the signature to /confirm.
+type DelegationChallengeResponse struct {
+	// Owner is the primary wallet that must sign
+	Owner     string    `json:"owner" example:"0x8ba1f109551bd432803012645ac136ddd64dba72"`
+	Nonce     string    `json:"nonce" example:"9f86d081884c7d659a2feaa0c55ad015"`
+	Timestamp int64     `json:"timestamp" example:"1706000000"`
+	ChainID   int64     `json:"chain_id" example:"1"`
+	ExpiresAt time.Time `json:"expires_at"`
+	TypedData any       `json:"typed_data" swaggertype:"object"`
+}
+
+// AddSignerResponse represents a newly registered delegated signer and its delegation challenge
+type AddSignerResponse struct {
+	Signer     *SignerResponse              `json:"signer"`
+	Delegation *DelegationChallengeResponse `json:"delegation"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// Status derives the delegated signer status at now
+func Status(signer *db.DelegatedSigner, now time.Time) string {
+	switch {
+	case signer.RevokedAt.Valid:
+		return StatusRevoked
+	case !signer.ConfirmedAt.Valid:
+		return StatusPendingConfirmation
+	case signer.ExpiresAt.Valid && !now.Before(signer.ExpiresAt.Time):
+		return StatusExpired
+	default:
+		return StatusActive
+	}
+}
+
+// ToSignerResponse converts db.DelegatedSigner to SignerResponse
+func ToSignerResponse(signer *db.DelegatedSigner, now time.Time) *SignerResponse {
+	if signer == nil {
+		return nil
+	}
+
+	response := &SignerResponse{
+		ID:        signer.ExternalID,
+		Address:   signer.Address,
+		Label:     signer.Label.String,
+		Scopes:    strings.Split(signer.Scopes, ","),
+		Status:    Status(signer, now),
+		CreatedAt: signer.CreatedAt,
+	}
+	if signer.ExpiresAt.Valid {
+		response.ExpiresAt = &signer.ExpiresAt.Time
+	}
+	if signer.ConfirmedAt.Valid {
+		response.ConfirmedAt = &signer.ConfirmedAt.Time
+	}
+	if signer.RevokedAt.Valid {
+		response.RevokedAt = &signer.RevokedAt.Time
+	}
+	return response
+}
+
+// ToSignerResponseList converts []db.DelegatedSigner to []SignerResponse
+func ToSignerResponseList(signers []db.DelegatedSigner, now time.Time) []SignerResponse {
+	responses := make([]SignerResponse, 0, len(signers))
+	for i := range signers {
+		responses = append(responses, *ToSignerResponse(&signers[i], now))
+	}
+	return responses
+}
+
+// ToDelegationChallengeResponse converts eip712.DelegationChallenge to DelegationChallengeResponse
+func ToDelegationChallengeResponse(challenge *eip712.DelegationChallenge) *DelegationChallengeResponse {
+	if challenge == nil {
+		return nil
+	}
+
+	return &DelegationChallengeResponse{
+		Owner:     challenge.Message.Owner,
+		Nonce:     challenge.Message.Nonce,
+		Timestamp: challenge.Message.Timestamp,
+		ChainID:   challenge.Message.ChainID,
+		ExpiresAt: challenge.ExpiresAt,
+		TypedData: challenge.TypedData,
+	}
+}
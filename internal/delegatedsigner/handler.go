@@ -0,0 +1,241 @@
+package delegatedsigner
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for delegated signer operations
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new delegated signer handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers delegated signer routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	// Only the account owner (or an admin) can manage who signs for the organization
+	signers := rg.Group("/users/:id/delegated-signers", middleware.RequireAuth())
+	{
+		signers.POST("", h.AddSigner)
+		signers.GET("", h.ListSigners)
+		signers.GET("/:signerId/delegation-challenge", h.GetDelegationChallenge)
+		signers.POST("/:signerId/confirm", h.ConfirmSigner)
+		signers.DELETE("/:signerId", h.RevokeSigner)
+	}
+}
+
+// extractUserID extracts the user id from path and checks the caller may act as the user
+func extractUserID(c *gin.Context) (string, error) {
+	userID := c.Param("id")
+	if _, err := uuid.Parse(userID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+
+	principal, _ := middleware.GetPrincipal(c)
+	if !principal.CanActAs(userID) {
+		return "", errors.Forbidden("Cannot manage delegated signers of another user")
+	}
+	return userID, nil
+}
+
+// extractSignerID extracts and validates signerId from path
+func extractSignerID(c *gin.Context) (string, error) {
+	signerID := c.Param("signerId")
+	if _, err := uuid.Parse(signerID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+	return signerID, nil
+}
+
+// AddSigner godoc
+// @Summary Add a delegated signer
+// @Description Delegate signing rights of the organization to another address (e.g. the CFO's wallet) with scopes:
+// @Description PAYMENT_AUTHORIZATION (sign payment authorizations) and LOGIN (sign in with the wallet).
+// @Description The signer starts as PENDING_CONFIRMATION: the user's verified primary wallet must sign the returned
+// @Description EIP-712 SignerDelegation (see /confirm). expires_at ends the delegation automatically.
+// @Tags delegated-signers
+// @Accept json
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param request body AddSignerRequest true "Delegated signer"
+// @Success 201 {object} middleware.SuccessResponse{data=AddSignerResponse} "Delegated signer added"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid request or no verified primary wallet"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 409 {object} middleware.ErrorResponse "Delegated signer already registered"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/delegated-signers [post]
+func (h *Handler) AddSigner(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req AddSignerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	signer, challenge, err := h.service.AddSigner(c.Request.Context(), userExternalID, &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, AddSignerResponse{
+		Signer:     ToSignerResponse(signer, time.Now()),
+		Delegation: ToDelegationChallengeResponse(challenge),
+	})
+}
+
+// ListSigners godoc
+// @Summary List delegated signers
+// @Description List the delegated signers of the user (revoked signers excluded), newest first
+// @Tags delegated-signers
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=ListSignersResponse} "Delegated signers"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid user ID"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/delegated-signers [get]
+func (h *Handler) ListSigners(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	signers, err := h.service.ListSigners(c.Request.Context(), userExternalID)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, ListSignersResponse{
+		Signers: ToSignerResponseList(signers, time.Now()),
+	})
+}
+
+// GetDelegationChallenge godoc
+// @Summary Get signer delegation challenge
+// @Description Issue a new EIP-712 SignerDelegation for a signer awaiting confirmation.
+// @Description The nonce is single-use and expires with the signature timestamp tolerance.
+// @Tags delegated-signers
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param signerId path string true "Delegated signer external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=DelegationChallengeResponse} "Delegation challenge"
+// @Failure 400 {object} middleware.ErrorResponse "Signer already confirmed, revoked or expired, or no verified primary wallet"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "Delegated signer not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/delegated-signers/{signerId}/delegation-challenge [get]
+func (h *Handler) GetDelegationChallenge(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	signerExternalID, err := extractSignerID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	challenge, err := h.service.GetDelegationChallenge(c.Request.Context(), userExternalID, signerExternalID)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, ToDelegationChallengeResponse(challenge))
+}
+
+// ConfirmSigner godoc
+// @Summary Confirm a delegated signer
+// @Description Submit the primary wallet's signature over the SignerDelegation. On success the delegation is ACTIVE
+// @Description until expires_at or revocation. Confirming an already confirmed signer succeeds without changes.
+// @Tags delegated-signers
+// @Accept json
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param signerId path string true "Delegated signer external ID (UUID)"
+// @Param request body ConfirmSignerRequest true "Delegation signature"
+// @Success 200 {object} middleware.SuccessResponse{data=SignerResponse} "Delegated signer confirmed"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid request or delegation failed"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "Delegated signer not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/delegated-signers/{signerId}/confirm [post]
+func (h *Handler) ConfirmSigner(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	signerExternalID, err := extractSignerID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req ConfirmSignerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	signer, err := h.service.ConfirmSigner(c.Request.Context(), userExternalID, signerExternalID, &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, ToSignerResponse(signer, time.Now()))
+}
+
+// RevokeSigner godoc
+// @Summary Revoke a delegated signer
+// @Description End a delegation immediately. Revoking an already revoked signer succeeds.
+// @Tags delegated-signers
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param signerId path string true "Delegated signer external ID (UUID)"
+// @Success 204 "Delegated signer revoked"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid ID"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "Delegated signer not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/delegated-signers/{signerId} [delete]
+func (h *Handler) RevokeSigner(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	signerExternalID, err := extractSignerID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	if err := h.service.RevokeSigner(c.Request.Context(), userExternalID, signerExternalID, audit.ActorFromContext(c)); err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondNoContent(c)
+}
@@ -0,0 +1,535 @@
+package delegatedsigner
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	stderrors "errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/outbox"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/wallet"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/webhook"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/eip712"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	mysqlErrDuplicateEntry = 1062
+
+	// maxSignatureHexLength bounds contract wallet (ERC-1271) signatures to 2048 bytes
+	maxSignatureHexLength = 4096
+)
+
+// Delegation scopes (what a delegated signer may sign for the organization)
+const (
+	// ScopePaymentAuthorization allows signing payment authorizations
+	ScopePaymentAuthorization = "PAYMENT_AUTHORIZATION"
+	// ScopeLogin allows signing in to the organization's account with the wallet (SIWE)
+	ScopeLogin = "LOGIN"
+)
+
+// Audit log identifiers
+const (
+	actionAdded             = "DELEGATED_SIGNER_ADDED"
+	actionConfirmed         = "DELEGATED_SIGNER_CONFIRMED"
+	actionRevoked           = "DELEGATED_SIGNER_REVOKED"
+	resourceDelegatedSigner = "DELEGATED_SIGNER"
+)
+
+// errOwnerWalletRequired is returned when the user has no primary wallet able to sign delegations
+const errOwnerWalletRequired = "A verified primary wallet is required to delegate signers"
+
+// Service handles delegated signer business logic.
+// The organization is the user account; its verified primary wallet is the owner signer
+// that authorizes (and implicitly holds every scope of) delegated signers.
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	verifier eip712.Verifier
+	logger   *zap.Logger
+}
+
+// NewService creates a new delegated signer service
+func NewService(txRunner *pkgdb.TxRunner, verifier eip712.Verifier, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		verifier: verifier,
+		logger:   logger,
+	}
+}
+
+// AddSigner registers a delegated signer (PENDING_CONFIRMATION) and issues the
+// SignerDelegation the user's verified primary wallet must sign.
+// Why: API 키/세션만으로 서명 권한을 넘기면 계정 탈취 시 위임도 탈취됨 → owner 지갑 서명 필수
+func (s *Service) AddSigner(ctx context.Context, userExternalID string, req *AddSignerRequest, actor audit.Actor) (*db.DelegatedSigner, *eip712.DelegationChallenge, error) {
+	// 1. Validate delegate and delegation terms
+	if err := wallet.ValidateEthereumAddress(req.Address); err != nil {
+		return nil, nil, err
+	}
+	address := strings.ToLower(req.Address)
+
+	scopes, err := NormalizeScopes(req.Scopes)
+	if err != nil {
+		return nil, nil, err
+	}
+	expiresAt := sql.NullTime{}
+	if req.ExpiresAt != nil {
+		if !req.ExpiresAt.After(time.Now()) {
+			return nil, nil, errors.InvalidInput("expires_at must be in the future")
+		}
+		// 서명 메시지는 초 단위 unix time
+		expiresAt = sql.NullTime{Time: req.ExpiresAt.Truncate(time.Second), Valid: true}
+	}
+
+	// 2. Get user and the owner wallet (verified primary wallet)
+	user, err := s.getUser(ctx, userExternalID)
+	if err != nil {
+		return nil, nil, err
+	}
+	owner, err := s.getOwnerWallet(ctx, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if strings.EqualFold(owner.Address, address) {
+		return nil, nil, errors.InvalidInput("The primary wallet already signs for the organization")
+	}
+
+	// 3. Create delegated signer (UNIQUE(user_id, address_active) 충돌 시 409로 처리)
+	label := sql.NullString{}
+	if req.Label != "" {
+		label = sql.NullString{String: req.Label, Valid: true}
+	}
+
+	created, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*db.DelegatedSigner, error) {
+		result, err := q.CreateDelegatedSigner(ctx, db.CreateDelegatedSignerParams{
+			ExternalID: uuid.New().String(),
+			UserID:     user.ID,
+			Address:    address,
+			Label:      label,
+			Scopes:     scopes,
+			ExpiresAt:  expiresAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return nil, err
+		}
+		created, err := q.GetDelegatedSignerByID(ctx, uint64(id))
+		if err != nil {
+			return nil, err
+		}
+
+		newValue := map[string]any{
+			"address": created.Address,
+			"scopes":  created.Scopes,
+			"label":   created.Label.String,
+		}
+		if created.ExpiresAt.Valid {
+			newValue["expires_at"] = created.ExpiresAt.Time
+		}
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionAdded,
+			ResourceType: resourceDelegatedSigner,
+			ResourceID:   created.ID,
+			NewValue:     newValue,
+		}); err != nil {
+			return nil, err
+		}
+		return &created, nil
+	})
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, nil, errors.Conflict("Delegated signer already registered")
+		}
+		logctx.From(ctx, s.logger).Error("failed to create delegated signer", zap.Error(err))
+		return nil, nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("delegated signer added",
+		zap.String("delegated_signer_external_id", created.ExternalID),
+		zap.String("address", created.Address),
+		zap.String("scopes", created.Scopes),
+	)
+
+	// 4. Issue delegation (실패해도 delegation-challenge로 재발급 가능)
+	challenge, err := s.issueDelegation(ctx, owner, created)
+	if err != nil {
+		return nil, nil, err
+	}
+	return created, challenge, nil
+}
+
+// GetDelegationChallenge issues a new delegation for a signer awaiting confirmation.
+// The nonce is pre-reserved for the owner wallet, so ConfirmSigner accepts it exactly once.
+func (s *Service) GetDelegationChallenge(ctx context.Context, userExternalID, signerExternalID string) (*eip712.DelegationChallenge, error) {
+	signer, err := s.GetSigner(ctx, userExternalID, signerExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requirePendingConfirmation(signer); err != nil {
+		return nil, err
+	}
+
+	owner, err := s.getOwnerWallet(ctx, signer.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return s.issueDelegation(ctx, owner, signer)
+}
+
+// ConfirmSigner verifies the owner wallet's delegation signature and activates the delegation.
+// Confirming an already confirmed signer succeeds without changes.
+func (s *Service) ConfirmSigner(ctx context.Context, userExternalID, signerExternalID string, req *ConfirmSignerRequest, actor audit.Actor) (*db.DelegatedSigner, error) {
+	// 1. Parse signature
+	signature, err := parseSignature(req.Signature)
+	if err != nil {
+		return nil, errors.InvalidInput("Invalid signature format")
+	}
+
+	// 2. Get delegated signer with ownership check
+	signer, err := s.GetSigner(ctx, userExternalID, signerExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Already confirmed - idempotent success
+	if signer.ConfirmedAt.Valid && !signer.RevokedAt.Valid {
+		return signer, nil
+	}
+	if err := requirePendingConfirmation(signer); err != nil {
+		return nil, err
+	}
+
+	// 4. Verify delegation signature of the current owner wallet over the stored terms
+	owner, err := s.getOwnerWallet(ctx, signer.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.verifier.VerifyDelegation(ctx, delegationFor(owner, signer, req.Nonce, req.Timestamp), signature); err != nil {
+		logctx.From(ctx, s.logger).Warn("signer delegation failed",
+			zap.String("delegated_signer_external_id", signerExternalID),
+			zap.String("owner", owner.Address),
+			zap.Error(err),
+		)
+		// 외부 메시지는 고정, 상세는 로그로만
+		return nil, errors.InvalidInput("Signer delegation failed")
+	}
+
+	// 5. Confirm
+	now := time.Now()
+	confirmed, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*db.DelegatedSigner, error) {
+		affected, err := q.ConfirmDelegatedSigner(ctx, db.ConfirmDelegatedSignerParams{
+			ApproverWalletID: sql.NullInt64{Int64: int64(owner.ID), Valid: true},
+			ConfirmedAt:      sql.NullTime{Time: now, Valid: true},
+			ID:               signer.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		confirmed, err := q.GetDelegatedSignerByID(ctx, signer.ID)
+		if err != nil {
+			return nil, err
+		}
+		// Confirmed or revoked concurrently - nothing to record
+		if affected == 0 {
+			return &confirmed, nil
+		}
+
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionConfirmed,
+			ResourceType: resourceDelegatedSigner,
+			ResourceID:   confirmed.ID,
+			NewValue: map[string]any{
+				"approver_wallet_id": owner.ExternalID,
+				"scopes":             confirmed.Scopes,
+			},
+		}); err != nil {
+			return nil, err
+		}
+		if err := writeDelegatedSignerEvent(ctx, q, webhook.EventDelegatedSignerConfirmed, &confirmed); err != nil {
+			return nil, err
+		}
+		return &confirmed, nil
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to confirm delegated signer", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("delegated signer confirmed",
+		zap.String("delegated_signer_external_id", confirmed.ExternalID),
+		zap.String("scopes", confirmed.Scopes),
+	)
+	return confirmed, nil
+}
+
+// GetSigner retrieves a delegated signer with ownership verification
+func (s *Service) GetSigner(ctx context.Context, userExternalID, signerExternalID string) (*db.DelegatedSigner, error) {
+	signer, err := s.txRunner.Queries().GetDelegatedSignerByExternalIDAndUser(ctx, db.GetDelegatedSignerByExternalIDAndUserParams{
+		ExternalID:   signerExternalID,
+		ExternalID_2: sql.NullString{String: userExternalID, Valid: true},
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Delegated signer")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get delegated signer", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &signer, nil
+}
+
+// ListSigners lists the delegated signers of a user (revoked signers excluded)
+func (s *Service) ListSigners(ctx context.Context, userExternalID string) ([]db.DelegatedSigner, error) {
+	user, err := s.getUser(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	signers, err := s.txRunner.Queries().ListDelegatedSignersByUser(ctx, user.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list delegated signers", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return signers, nil
+}
+
+// RevokeSigner ends a delegation immediately.
+// Revoking an already revoked signer succeeds without changes.
+func (s *Service) RevokeSigner(ctx context.Context, userExternalID, signerExternalID string, actor audit.Actor) error {
+	signer, err := s.GetSigner(ctx, userExternalID, signerExternalID)
+	if err != nil {
+		return err
+	}
+	if signer.RevokedAt.Valid {
+		return nil
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		affected, err := q.RevokeDelegatedSigner(ctx, signer.ID)
+		if err != nil {
+			return err
+		}
+		// Revoked concurrently
+		if affected == 0 {
+			return nil
+		}
+
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionRevoked,
+			ResourceType: resourceDelegatedSigner,
+			ResourceID:   signer.ID,
+			OldValue: map[string]any{
+				"status": Status(signer, time.Now()),
+				"scopes": signer.Scopes,
+			},
+		}); err != nil {
+			return err
+		}
+		// 승인 전 해제는 외부에 알릴 위임이 없음
+		if !signer.ConfirmedAt.Valid {
+			return nil
+		}
+		return writeDelegatedSignerEvent(ctx, q, webhook.EventDelegatedSignerRevoked, signer)
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to revoke delegated signer", zap.Error(err))
+		return errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("delegated signer revoked",
+		zap.String("delegated_signer_external_id", signerExternalID),
+	)
+	return nil
+}
+
+// CanSignFor reports whether the address may sign for the user with the scope: the user's
+// verified primary wallet holds every scope, any other address needs an active delegation.
+// Payment authorization and wallet login flows must check it before accepting a signature.
+func CanSignFor(ctx context.Context, q *db.Queries, userID uint64, address, scope string, now time.Time) (bool, error) {
+	address = strings.ToLower(address)
+
+	primary, err := q.GetPrimaryWallet(ctx, userID)
+	if err != nil && err != sql.ErrNoRows {
+		return false, err
+	}
+	if err == nil && isOwnerWallet(&primary) && strings.EqualFold(primary.Address, address) {
+		return true, nil
+	}
+
+	return q.ExistsActiveDelegation(ctx, db.ExistsActiveDelegationParams{
+		UserID:        userID,
+		AddressActive: sql.NullString{String: address, Valid: true},
+		Now:           sql.NullTime{Time: now, Valid: true},
+		Scope:         scope,
+	})
+}
+
+// ListDelegators lists the delegations that let the address sign for other users with the scope
+// (e.g. the accounts a delegated wallet may log in to)
+func ListDelegators(ctx context.Context, q *db.Queries, address, scope string, now time.Time) ([]db.ListActiveDelegationsByAddressRow, error) {
+	return q.ListActiveDelegationsByAddress(ctx, db.ListActiveDelegationsByAddressParams{
+		Address: sql.NullString{String: strings.ToLower(address), Valid: true},
+		Now:     sql.NullTime{Time: now, Valid: true},
+		Scope:   scope,
+	})
+}
+
+// NormalizeScopes validates, de-duplicates and sorts scopes into the stored (and signed) form
+func NormalizeScopes(scopes []string) (string, error) {
+	normalized := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.ToUpper(strings.TrimSpace(scope))
+		if scope != ScopePaymentAuthorization && scope != ScopeLogin {
+			return "", errors.InvalidInput("Unsupported scope: " + scope)
+		}
+		if !slices.Contains(normalized, scope) {
+			normalized = append(normalized, scope)
+		}
+	}
+	if len(normalized) == 0 {
+		return "", errors.InvalidInput("At least one scope is required")
+	}
+
+	slices.Sort(normalized)
+	return strings.Join(normalized, ","), nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// getUser retrieves a user by external ID
+func (s *Service) getUser(ctx context.Context, userExternalID string) (*db.User, error) {
+	user, err := s.txRunner.Queries().GetUserByExternalID(ctx, sql.NullString{String: userExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("User")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &user, nil
+}
+
+// getOwnerWallet returns the user's primary wallet, which must be signature-verified
+// (watch-only wallets cannot sign delegations)
+func (s *Service) getOwnerWallet(ctx context.Context, userID uint64) (*db.Wallet, error) {
+	primary, err := s.txRunner.Queries().GetPrimaryWallet(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.InvalidInput(errOwnerWalletRequired)
+		}
+		logctx.From(ctx, s.logger).Error("failed to get primary wallet", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if !isOwnerWallet(&primary) {
+		return nil, errors.InvalidInput(errOwnerWalletRequired)
+	}
+	return &primary, nil
+}
+
+// isOwnerWallet reports whether the primary wallet can sign for the organization
+func isOwnerWallet(primary *db.Wallet) bool {
+	return !primary.IsWatchOnly && wallet.MeetsVerificationLevel(primary, db.WalletsVerificationLevelSIGNATURE)
+}
+
+// issueDelegation issues the SignerDelegation the owner wallet must sign
+func (s *Service) issueDelegation(ctx context.Context, owner *db.Wallet, signer *db.DelegatedSigner) (*eip712.DelegationChallenge, error) {
+	challenge, err := s.verifier.IssueDelegation(ctx, delegationFor(owner, signer, "", 0))
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to issue signer delegation",
+			zap.String("delegated_signer_external_id", signer.ExternalID),
+			zap.Error(err),
+		)
+		return nil, errors.Internal("Failed to issue signer delegation")
+	}
+	return challenge, nil
+}
+
+// delegationFor builds the SignerDelegation message of the stored delegation terms
+func delegationFor(owner *db.Wallet, signer *db.DelegatedSigner, nonce string, timestamp int64) eip712.SignerDelegation {
+	delegation := eip712.SignerDelegation{
+		Owner:     owner.Address,
+		Delegate:  signer.Address,
+		Scopes:    signer.Scopes,
+		Nonce:     nonce,
+		Timestamp: timestamp,
+		ChainID:   int64(owner.ChainID),
+	}
+	if signer.ExpiresAt.Valid {
+		delegation.ExpiresAt = signer.ExpiresAt.Time.Unix()
+	}
+	return delegation
+}
+
+// requirePendingConfirmation rejects revoked, already confirmed or lapsed signers
+func requirePendingConfirmation(signer *db.DelegatedSigner) error {
+	if signer.RevokedAt.Valid {
+		return errors.InvalidInput("Delegated signer has been revoked")
+	}
+	if signer.ConfirmedAt.Valid {
+		return errors.InvalidInput("Delegated signer already confirmed")
+	}
+	if signer.ExpiresAt.Valid && !signer.ExpiresAt.Time.After(time.Now()) {
+		return errors.InvalidInput("Delegation expired before it was confirmed")
+	}
+	return nil
+}
+
+// writeDelegatedSignerEvent records a delegated signer event for the owner (must be called within a transaction)
+func writeDelegatedSignerEvent(ctx context.Context, q *db.Queries, eventType string, signer *db.DelegatedSigner) error {
+	data := map[string]any{
+		"delegated_signer_id": signer.ExternalID,
+		"address":             signer.Address,
+		"scopes":              strings.Split(signer.Scopes, ","),
+	}
+	if signer.ExpiresAt.Valid {
+		data["expires_at"] = signer.ExpiresAt.Time
+	}
+
+	_, err := outbox.Write(ctx, q, outbox.Message{
+		EventType:           eventType,
+		AggregateType:       outbox.AggregateDelegatedSigner,
+		AggregateID:         signer.ID,
+		AggregateExternalID: signer.ExternalID,
+		RecipientUserID:     signer.UserID,
+		Data:                data,
+	})
+	return err
+}
+
+// parseSignature parses hex signature string to bytes
+func parseSignature(sig string) ([]byte, error) {
+	sig = strings.TrimPrefix(sig, "0x")
+
+	// At least 64 bytes (EIP-2098 compact EOA); contract wallet signatures may be longer
+	if len(sig) < 128 || len(sig) > maxSignatureHexLength || len(sig)%2 != 0 {
+		return nil, errors.InvalidInput("Signature must be 64 or 65 bytes, or a longer contract wallet signature")
+	}
+
+	return hex.DecodeString(sig)
+}
+
+// isDuplicateKeyError checks if the error is a MySQL duplicate key error
+func isDuplicateKeyError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var mysqlErr *mysql.MySQLError
+	if stderrors.As(err, &mysqlErr) {
+		return mysqlErr.Number == mysqlErrDuplicateEntry
+	}
+	return strings.Contains(err.Error(), "Duplicate entry")
+}
@@ -0,0 +1,175 @@
+package depeg
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/oracle"
+	"go.uber.org/zap"
+)
+
+// PegCurrency is the currency every settlement token is pegged to (1 token = 1 USD)
+const PegCurrency = "USD"
+
+// bpsDenominator is 100% in basis points
+const bpsDenominator = 10000
+
+// Config holds depeg circuit breaker settings
+type Config struct {
+	// TokenSymbol is the settlement token whose price is watched
+	TokenSymbol string
+	// ThresholdBps is the allowed deviation from the peg in basis points (100 = 1%)
+	ThresholdBps int64
+	// Interval is the period of the price check
+	Interval time.Duration
+}
+
+// State is the latest observation of the settlement token price
+type State struct {
+	// Paused is true while new payment authorizations are rejected
+	Paused bool
+	// Price is the token price in PegCurrency (e.g. "0.9712"), empty before the first check
+	Price        string
+	DeviationBps int64
+	// CheckedAt is when the price was last read successfully
+	CheckedAt time.Time
+	// PausedAt is when the breaker opened (zero while closed)
+	PausedAt time.Time
+}
+
+// Monitor watches the oracle price of the settlement token and opens the payment breaker
+// while it deviates from the peg beyond the threshold. The breaker closes again once the price
+// is back within the threshold.
+//
+// Why:
+// - 디페그 중 승인된 결제는 주문 금액(USD 기준)보다 실제 가치가 낮은 토큰으로 정산됨
+// - 가격 조회 실패(피드 지연/RPC 장애) 시 직전 상태 유지 → 장애로 인한 개폐 반복 방지
+// - 인스턴스마다 같은 피드를 조회하므로 상태는 프로세스 메모리에 보관
+type Monitor struct {
+	oracle oracle.Oracle
+	pair   oracle.Pair
+	config Config
+	logger *zap.Logger
+
+	mu    sync.RWMutex
+	state State
+}
+
+// NewMonitor creates a new depeg monitor; the oracle must not fall back to configured
+// static rates, which would hide a depeg
+func NewMonitor(o oracle.Oracle, config Config, logger *zap.Logger) *Monitor {
+	return &Monitor{
+		oracle: o,
+		pair:   oracle.Pair{Base: config.TokenSymbol, Quote: PegCurrency},
+		config: config,
+		logger: logger,
+	}
+}
+
+// Pair returns the watched price pair (e.g. USDC/USD)
+func (m *Monitor) Pair() oracle.Pair {
+	return m.pair
+}
+
+// Run checks the price immediately and then periodically until ctx is canceled
+func (m *Monitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.config.Interval)
+	defer ticker.Stop()
+
+	logctx.From(ctx, m.logger).Info("depeg monitor started",
+		zap.String("pair", m.pair.String()),
+		zap.Int64("threshold_bps", m.config.ThresholdBps),
+		zap.Duration("interval", m.config.Interval),
+	)
+
+	for {
+		if err := m.Check(ctx, time.Now().UTC()); err != nil && ctx.Err() == nil {
+			logctx.From(ctx, m.logger).Warn("depeg check failed, keeping previous breaker state",
+				zap.String("pair", m.pair.String()),
+				zap.Bool("paused", m.State().Paused),
+				zap.Error(err),
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			logctx.From(ctx, m.logger).Info("depeg monitor stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check reads the current price and opens or closes the breaker.
+// On a failed read the previous state is kept and the error returned.
+func (m *Monitor) Check(ctx context.Context, now time.Time) error {
+	rate, err := m.oracle.Rate(ctx, m.pair)
+	if err != nil {
+		return err
+	}
+	deviation := deviationBps(rate)
+	paused := deviation > m.config.ThresholdBps
+
+	m.mu.Lock()
+	prev := m.state
+	m.state = State{
+		Paused:       paused,
+		Price:        rate.String(),
+		DeviationBps: deviation,
+		CheckedAt:    now,
+		PausedAt:     prev.PausedAt,
+	}
+	switch {
+	case paused && !prev.Paused:
+		m.state.PausedAt = now
+	case !paused:
+		m.state.PausedAt = time.Time{}
+	}
+	m.mu.Unlock()
+
+	switch {
+	case paused && !prev.Paused:
+		logctx.From(ctx, m.logger).Error("settlement token depegged, new payments paused",
+			zap.String("pair", m.pair.String()),
+			zap.String("price", rate.String()),
+			zap.Int64("deviation_bps", deviation),
+			zap.Int64("threshold_bps", m.config.ThresholdBps),
+		)
+	case !paused && prev.Paused:
+		logctx.From(ctx, m.logger).Info("settlement token back on peg, new payments resumed",
+			zap.String("pair", m.pair.String()),
+			zap.String("price", rate.String()),
+			zap.Int64("deviation_bps", deviation),
+			zap.Duration("paused_for", now.Sub(prev.PausedAt)),
+		)
+	}
+	return nil
+}
+
+// State returns the latest observation
+func (m *Monitor) State() State {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.state
+}
+
+// PaymentsPaused returns PAYMENTS_PAUSED (503) while the breaker is open (middleware.PaymentBreaker)
+func (m *Monitor) PaymentsPaused() error {
+	state := m.State()
+	if !state.Paused {
+		return nil
+	}
+	return errors.PaymentsPaused(m.pair.Base, state.Price, state.DeviationBps)
+}
+
+// deviationBps returns |price - 1| in basis points of the peg, rounded down
+func deviationBps(rate *oracle.Rate) int64 {
+	peg := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(rate.Decimals)), nil)
+	diff := new(big.Int).Sub(rate.Price, peg)
+	diff.Abs(diff).Mul(diff, big.NewInt(bpsDenominator))
+	return diff.Quo(diff, peg).Int64()
+}
@@ -0,0 +1,28 @@
+package deposit
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// DepositAddressResponse represents the deposit address of an order in API responses
+type DepositAddressResponse struct {
+	OrderNumber string `json:"order_number" example:"ORD-20240115-0001"`
+	// Address receives the buyer's payment for this order only (valid on every supported chain)
+	Address   string    `json:"address" example:"0x29379f45f515c494483298225d1b347f73d1babf"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ToDepositAddressResponse converts db.DepositAddress to DepositAddressResponse
+func ToDepositAddressResponse(orderNumber string, deposit *db.DepositAddress) *DepositAddressResponse {
+	if deposit == nil {
+		return nil
+	}
+
+	return &DepositAddressResponse{
+		OrderNumber: orderNumber,
+		Address:     deposit.Address,
+		CreatedAt:   deposit.CreatedAt,
+	}
+}
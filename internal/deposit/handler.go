@@ -0,0 +1,58 @@
+package deposit
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// maxOrderNumberLength mirrors orders.order_number (VARCHAR(50))
+const maxOrderNumberLength = 50
+
+// Handler handles HTTP requests for deposit addresses
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new deposit address handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers deposit address routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.POST("/orders/:orderNumber/deposit-address", middleware.RequireAuth(), h.GetOrAssignAddress)
+}
+
+// GetOrAssignAddress godoc
+// @Summary Get order deposit address
+// @Description Return the order's unique deposit address, deriving it from the platform xpub on first request.
+// @Description Payments received on the address are attributed to the order without a memo.
+// @Description The address never changes for the order and is never reused for another order.
+// @Description New addresses are only assigned to orders awaiting payment (PENDING/CONFIRMED). Buyer or admin only.
+// @Tags deposits
+// @Produce json
+// @Param orderNumber path string true "Order number"
+// @Success 200 {object} middleware.SuccessResponse{data=DepositAddressResponse} "Deposit address"
+// @Failure 400 {object} middleware.ErrorResponse "Order is not awaiting payment"
+// @Failure 401 {object} middleware.ErrorResponse "Unauthorized"
+// @Failure 404 {object} middleware.ErrorResponse "Order not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Failure 503 {object} middleware.ErrorResponse "Deposit addresses are not enabled"
+// @Router /api/v1/orders/{orderNumber}/deposit-address [post]
+func (h *Handler) GetOrAssignAddress(c *gin.Context) {
+	orderNumber := c.Param("orderNumber")
+	if orderNumber == "" || len(orderNumber) > maxOrderNumberLength {
+		middleware.RespondError(c, errors.InvalidInput("Invalid order number"))
+		return
+	}
+
+	principal, _ := middleware.GetPrincipal(c)
+	deposit, err := h.service.GetOrAssignAddress(c.Request.Context(), orderNumber, principal.UserID, principal.IsAdmin())
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, ToDepositAddressResponse(orderNumber, deposit))
+}
@@ -0,0 +1,163 @@
+package deposit
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"strings"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/hdwallet"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"go.uber.org/zap"
+)
+
+// maxDerivationAttempts bounds the indexes skipped when a child key is invalid (BIP32, ~1 in 2^127)
+const maxDerivationAttempts = 3
+
+// Service assigns HD-wallet deposit addresses to orders so incoming payments
+// can be attributed without memos
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	key      *hdwallet.ExtendedPublicKey
+	logger   *zap.Logger
+}
+
+// NewService creates a new deposit address service
+// key may be nil (deposit addresses disabled)
+func NewService(txRunner *pkgdb.TxRunner, key *hdwallet.ExtendedPublicKey, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		key:      key,
+		logger:   logger,
+	}
+}
+
+// GetOrAssignAddress returns the order's deposit address, deriving a new one on first use.
+// Only the buyer (or an admin) may request it, and only while the order awaits payment.
+func (s *Service) GetOrAssignAddress(ctx context.Context, orderNumber string, requesterID uint64, isAdmin bool) (*db.DepositAddress, error) {
+	if s.key == nil {
+		return nil, errors.ChainError("Deposit addresses are not enabled")
+	}
+
+	// 1. Get order with buyer check (다른 사용자의 주문은 존재 여부도 노출하지 않음)
+	order, err := s.txRunner.Queries().GetOrderByOrderNumber(ctx, orderNumber)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Order")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get order", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if !isAdmin && order.BuyerID != requesterID {
+		return nil, errors.NotFound("Order")
+	}
+
+	// 2. Already assigned - same address for the lifetime of the order
+	existing, err := s.txRunner.Queries().GetDepositAddressByOrder(ctx, order.ID)
+	if err == nil {
+		return &existing, nil
+	}
+	if err != sql.ErrNoRows {
+		logctx.From(ctx, s.logger).Error("failed to get deposit address", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	// 3. New addresses only for orders awaiting payment
+	if order.Status != db.OrdersStatusPENDING && order.Status != db.OrdersStatusCONFIRMED {
+		return nil, errors.InvalidInput("Order is not awaiting payment")
+	}
+
+	// 4. Allocate the next derivation index and record the address
+	assigned, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*db.DepositAddress, error) {
+		// Lock the order so concurrent requests assign a single address
+		if _, err := q.GetOrderByIDForUpdate(ctx, order.ID); err != nil {
+			return nil, err
+		}
+		existing, err := q.GetDepositAddressByOrder(ctx, order.ID)
+		if err == nil {
+			return &existing, nil
+		}
+		if err != sql.ErrNoRows {
+			return nil, err
+		}
+
+		address, index, err := s.allocate(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := q.CreateDepositAddress(ctx, db.CreateDepositAddressParams{
+			OrderID:         order.ID,
+			Address:         address,
+			KeyID:           s.key.ID(),
+			DerivationIndex: index,
+		}); err != nil {
+			return nil, err
+		}
+
+		created, err := q.GetDepositAddressByOrder(ctx, order.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &created, nil
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to assign deposit address",
+			zap.String("order_number", orderNumber),
+			zap.Error(err),
+		)
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("deposit address assigned",
+		zap.String("order_number", orderNumber),
+		zap.String("address", assigned.Address),
+		zap.Uint32("derivation_index", assigned.DerivationIndex),
+	)
+	return assigned, nil
+}
+
+// ResolveOrder attributes an incoming payment to an order by its deposit address.
+// Returns sql.ErrNoRows for addresses that are not deposit addresses.
+func ResolveOrder(ctx context.Context, q *db.Queries, address string) (*db.DepositAddress, error) {
+	deposit, err := q.GetDepositAddressByAddress(ctx, strings.ToLower(address))
+	if err != nil {
+		return nil, err
+	}
+	return &deposit, nil
+}
+
+// allocate derives the address at the key's next unused index (must be called within a transaction).
+// The cursor row lock serializes allocations; uk_deposit_address_derivation rejects any reuse.
+func (s *Service) allocate(ctx context.Context, q *db.Queries) (string, uint32, error) {
+	keyID := s.key.ID()
+	if err := q.EnsureHDDerivationCursor(ctx, keyID); err != nil {
+		return "", 0, err
+	}
+
+	for attempt := 0; attempt < maxDerivationAttempts; attempt++ {
+		cursor, err := q.GetHDDerivationCursorForUpdate(ctx, keyID)
+		if err != nil {
+			return "", 0, err
+		}
+		if err := q.AdvanceHDDerivationCursor(ctx, keyID); err != nil {
+			return "", 0, err
+		}
+
+		address, err := s.key.DeriveAddress(cursor.NextIndex)
+		if stderrors.Is(err, hdwallet.ErrInvalidChild) {
+			// BIP32: 유효하지 않은 자식 키는 다음 인덱스로 진행
+			logctx.From(ctx, s.logger).Warn("skipping invalid derivation index",
+				zap.Uint32("derivation_index", cursor.NextIndex),
+			)
+			continue
+		}
+		if err != nil {
+			return "", 0, err
+		}
+		return address, cursor.NextIndex, nil
+	}
+	return "", 0, hdwallet.ErrInvalidChild
+}
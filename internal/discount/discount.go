@@ -0,0 +1,266 @@
+package discount
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+const (
+	// amountScale is the scale of order and discount amounts (DECIMAL(18,2))
+	amountScale = 2
+	// percentScale is the scale of discount percentages (DECIMAL(5,2))
+	percentScale = 2
+)
+
+// maxPercent is the upper bound (exclusive) of discount percentages
+var maxPercent = money.MustParse("100", "", percentScale)
+
+// CouponError reports why a coupon cannot be applied to an order.
+// Callers map it to INVALID_INPUT (purchase order submission) or CONFLICT (acceptance).
+type CouponError struct {
+	Reason string
+}
+
+func (e *CouponError) Error() string {
+	return e.Reason
+}
+
+// Line is a priced line of an order
+type Line struct {
+	LineNo    uint32
+	ProductID uint64
+	Quantity  uint32
+	// Amount is quantity × unit price
+	Amount money.Money
+}
+
+// LineDiscount is the volume tier discount of a line
+type LineDiscount struct {
+	LineNo      uint32
+	MinQuantity uint32
+	Percent     money.Money
+	Amount      money.Money
+}
+
+// Result is the discount breakdown of an order
+type Result struct {
+	Lines []LineDiscount
+	// Coupon is the applied coupon (nil = none)
+	Coupon       *db.Coupon
+	CouponAmount money.Money
+	// Total is the sum of the line and coupon discounts
+	Total money.Money
+	// net is the discounted amount per line (coupon allocated pro rata) - the taxable amount
+	net map[uint32]money.Money
+}
+
+// Net returns the discounted amount of the line (the taxable amount)
+func (r *Result) Net(lineNo uint32) money.Money {
+	return r.net[lineNo]
+}
+
+// Calculate applies the volume tiers of the lines' products, then the coupon (empty = none) to the discounted subtotal.
+// The coupon's expiry is checked at asOf (purchase order submission); its usage limits are checked again by Apply.
+// Coupon problems are returned as *CouponError.
+//
+// Why:
+//   - 수량 구간 할인은 항목별 가장 높은 구간 1개, 쿠폰은 구간 할인 후 소계 기준 (중복 할인 순서 고정)
+//   - 할인 금액은 내림 → 할인 합계가 정확한 값을 넘지 않음
+//   - 쿠폰 할인은 항목 금액 비례 배분 (반올림 잔액은 가장 큰 항목) → 항목별 과세 금액 산정
+func Calculate(ctx context.Context, q *db.Queries, sellerID, buyerID uint64, lines []Line, couponCode string, asOf time.Time) (*Result, error) {
+	result := &Result{
+		CouponAmount: money.Zero("", amountScale),
+		Total:        money.Zero("", amountScale),
+		net:          make(map[uint32]money.Money, len(lines)),
+	}
+
+	subtotal := money.Zero("", amountScale)
+	for _, line := range lines {
+		net := line.Amount
+		tier, err := q.GetApplicableVolumeTier(ctx, db.GetApplicableVolumeTierParams{
+			ProductID:   line.ProductID,
+			MinQuantity: line.Quantity,
+		})
+		if err != nil && err != sql.ErrNoRows {
+			return nil, fmt.Errorf("get volume tier: %w", err)
+		}
+		if err == nil {
+			percent, err := money.Parse(tier.DiscountPercent, "", percentScale)
+			if err != nil {
+				return nil, fmt.Errorf("invalid stored discount percent %q: %w", tier.DiscountPercent, err)
+			}
+			amount := percentOf(line.Amount, percent)
+			if amount.Sign() > 0 {
+				result.Lines = append(result.Lines, LineDiscount{
+					LineNo:      line.LineNo,
+					MinQuantity: tier.MinQuantity,
+					Percent:     percent,
+					Amount:      amount,
+				})
+				result.Total = result.Total.Add(amount)
+				net = net.Sub(amount)
+			}
+		}
+		result.net[line.LineNo] = net
+		subtotal = subtotal.Add(net)
+	}
+
+	if couponCode == "" {
+		return result, nil
+	}
+	coupon, err := q.GetCouponByCode(ctx, db.GetCouponByCodeParams{SellerID: sellerID, Code: NormalizeCode(couponCode)})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &CouponError{Reason: "Unknown coupon code"}
+		}
+		return nil, fmt.Errorf("get coupon: %w", err)
+	}
+	if coupon.ExpiresAt.Valid && !asOf.Before(coupon.ExpiresAt.Time) {
+		return nil, &CouponError{Reason: "Coupon has expired"}
+	}
+	if err := checkUsage(ctx, q, &coupon, buyerID); err != nil {
+		return nil, err
+	}
+
+	amount, err := couponAmount(&coupon, subtotal)
+	if err != nil {
+		return nil, err
+	}
+	result.Coupon = &coupon
+	result.CouponAmount = amount
+	result.Total = result.Total.Add(amount)
+	allocate(result, lines, subtotal)
+	return result, nil
+}
+
+// Apply records the discounts of an order and redeems its coupon (within the order's transaction).
+// The coupon is row-locked and its usage limits re-checked; an exhausted or disabled coupon is a *CouponError.
+func Apply(ctx context.Context, q *db.Queries, orderID, buyerID uint64, result *Result) error {
+	for _, line := range result.Lines {
+		if err := q.CreateOrderDiscount(ctx, db.CreateOrderDiscountParams{
+			OrderID:     orderID,
+			LineNo:      sql.NullInt32{Int32: int32(line.LineNo), Valid: true},
+			Kind:        db.OrderDiscountsKindVOLUME,
+			Description: fmt.Sprintf("Volume discount %s%% (%d+ units)", line.Percent, line.MinQuantity),
+			Amount:      line.Amount.String(),
+		}); err != nil {
+			return fmt.Errorf("create order discount: %w", err)
+		}
+	}
+	if result.Coupon == nil {
+		return nil
+	}
+
+	coupon, err := q.GetCouponForUpdate(ctx, result.Coupon.ID)
+	if err != nil {
+		return fmt.Errorf("lock coupon: %w", err)
+	}
+	if err := checkUsage(ctx, q, &coupon, buyerID); err != nil {
+		return err
+	}
+	if err := q.CreateCouponRedemption(ctx, db.CreateCouponRedemptionParams{
+		CouponID: coupon.ID,
+		BuyerID:  buyerID,
+		OrderID:  orderID,
+		Amount:   result.CouponAmount.String(),
+	}); err != nil {
+		return fmt.Errorf("create coupon redemption: %w", err)
+	}
+	if err := q.IncrementCouponRedemptions(ctx, coupon.ID); err != nil {
+		return fmt.Errorf("increment coupon redemptions: %w", err)
+	}
+	if err := q.CreateOrderDiscount(ctx, db.CreateOrderDiscountParams{
+		OrderID:     orderID,
+		Kind:        db.OrderDiscountsKindCOUPON,
+		CouponID:    sql.NullInt64{Int64: int64(coupon.ID), Valid: true},
+		Description: "Coupon " + coupon.Code,
+		Amount:      result.CouponAmount.String(),
+	}); err != nil {
+		return fmt.Errorf("create order discount: %w", err)
+	}
+	return nil
+}
+
+// NormalizeCode returns the stored form of a coupon code (trimmed, upper case)
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// checkUsage checks that the coupon is active and within its total and per-buyer limits
+func checkUsage(ctx context.Context, q *db.Queries, coupon *db.Coupon, buyerID uint64) error {
+	if coupon.Status != db.CouponsStatusACTIVE {
+		return &CouponError{Reason: "Coupon is disabled"}
+	}
+	if coupon.MaxRedemptions.Valid && coupon.RedemptionCount >= uint32(coupon.MaxRedemptions.Int32) {
+		return &CouponError{Reason: "Coupon has reached its usage limit"}
+	}
+	if coupon.MaxPerBuyer.Valid {
+		used, err := q.CountCouponRedemptionsByBuyer(ctx, db.CountCouponRedemptionsByBuyerParams{
+			CouponID: coupon.ID,
+			BuyerID:  buyerID,
+		})
+		if err != nil {
+			return fmt.Errorf("count coupon redemptions: %w", err)
+		}
+		if used >= int64(coupon.MaxPerBuyer.Int32) {
+			return &CouponError{Reason: "Coupon has reached its usage limit for this buyer"}
+		}
+	}
+	return nil
+}
+
+// couponAmount returns the coupon discount of the subtotal
+func couponAmount(coupon *db.Coupon, subtotal money.Money) (money.Money, error) {
+	minOrder, err := money.Parse(coupon.MinOrderAmount, "", amountScale)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("invalid stored min order amount %q: %w", coupon.MinOrderAmount, err)
+	}
+	if subtotal.Cmp(minOrder) < 0 {
+		return money.Money{}, &CouponError{Reason: "Order total is below the coupon minimum of " + minOrder.String()}
+	}
+	value, err := money.Parse(coupon.DiscountValue, "", amountScale)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("invalid stored discount value %q: %w", coupon.DiscountValue, err)
+	}
+
+	amount := value
+	if coupon.DiscountType == db.CouponsDiscountTypePERCENT {
+		amount = percentOf(subtotal, value)
+	}
+	if amount.Cmp(subtotal) >= 0 {
+		return money.Money{}, &CouponError{Reason: "Coupon exceeds the order total"}
+	}
+	return amount, nil
+}
+
+// allocate spreads the coupon discount over the lines in proportion to their discounted amounts;
+// the rounding remainder goes to the largest line
+func allocate(result *Result, lines []Line, subtotal money.Money) {
+	if subtotal.Sign() <= 0 || len(lines) == 0 {
+		return
+	}
+	remaining := result.CouponAmount
+	largest := lines[0].LineNo
+	for _, line := range lines {
+		net := result.net[line.LineNo]
+		if net.Cmp(result.net[largest]) > 0 {
+			largest = line.LineNo
+		}
+		share := result.CouponAmount.MulFrac(net.Units().Int64(), subtotal.Units().Int64(), money.RoundDown)
+		result.net[line.LineNo] = net.Sub(share)
+		remaining = remaining.Sub(share)
+	}
+	result.net[largest] = result.net[largest].Sub(remaining)
+}
+
+// percentOf returns percent % of the amount, rounded down to the amount scale
+func percentOf(amount, percent money.Money) money.Money {
+	units := percent.Rescale(percentScale, money.RoundDown).Units().Int64()
+	return amount.Rescale(amountScale, money.RoundDown).MulFrac(units, 100*100, money.RoundDown)
+}
@@ -0,0 +1,141 @@
+package discount
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// CreateCouponRequest represents the request body for creating a coupon
+type CreateCouponRequest struct {
+	SellerID       string     `json:"seller_id,omitempty" binding:"omitempty,uuid" example:"550e8400-e29b-41d4-a716-446655440001"` // omitted = caller
+	Code           string     `json:"code" binding:"required,min=3,max=32,alphanum" example:"SPRING10"`                            // case-insensitive
+	DiscountType   string     `json:"discount_type" binding:"required,oneof=PERCENT FIXED" example:"PERCENT"`
+	DiscountValue  string     `json:"discount_value" binding:"required,max=32" example:"10"` // percent (PERCENT) or amount (FIXED)
+	MinOrderAmount string     `json:"min_order_amount,omitempty" binding:"max=32" example:"500.00"`
+	MaxRedemptions *uint32    `json:"max_redemptions,omitempty" binding:"omitempty,min=1" example:"100"` // omitted = unlimited
+	MaxPerBuyer    *uint32    `json:"max_per_buyer,omitempty" binding:"omitempty,min=1" example:"1"`     // omitted = unlimited
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+}
+
+// ListCouponsRequest represents query parameters for listing the caller's coupons
+type ListCouponsRequest struct {
+	Page     int `form:"page,default=1" binding:"min=1"`
+	PageSize int `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// VolumeTierRequest represents a quantity tier of a product
+type VolumeTierRequest struct {
+	MinQuantity     uint32 `json:"min_quantity" binding:"required,min=2" example:"100"`
+	DiscountPercent string `json:"discount_percent" binding:"required,max=8" example:"5.00"`
+}
+
+// SetVolumeTiersRequest represents the request body for replacing the tiers of a product
+type SetVolumeTiersRequest struct {
+	Tiers []VolumeTierRequest `json:"tiers" binding:"max=20,dive"` // empty = remove all tiers
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// CouponResponse represents a coupon
+type CouponResponse struct {
+	CouponID        string      `json:"coupon_id" example:"ee1e8400-e29b-41d4-a716-446655440000"`
+	SellerID        string      `json:"seller_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Code            string      `json:"code" example:"SPRING10"`
+	DiscountType    string      `json:"discount_type" example:"PERCENT" enums:"PERCENT,FIXED"`
+	DiscountValue   money.Money `json:"discount_value" swaggertype:"string" example:"10.00"`
+	MinOrderAmount  money.Money `json:"min_order_amount" swaggertype:"string" example:"500.00"`
+	MaxRedemptions  *uint32     `json:"max_redemptions,omitempty" example:"100"`
+	MaxPerBuyer     *uint32     `json:"max_per_buyer,omitempty" example:"1"`
+	RedemptionCount uint32      `json:"redemption_count" example:"12"`
+	ExpiresAt       *time.Time  `json:"expires_at,omitempty"`
+	Status          string      `json:"status" example:"ACTIVE" enums:"ACTIVE,DISABLED"`
+	CreatedAt       time.Time   `json:"created_at"`
+}
+
+// ListCouponsResponse represents paginated coupon list
+type ListCouponsResponse struct {
+	Coupons    []CouponResponse `json:"coupons"`
+	Total      int64            `json:"total" example:"3"`
+	Page       int              `json:"page" example:"1"`
+	PageSize   int              `json:"page_size" example:"20"`
+	TotalPages int              `json:"total_pages" example:"1"`
+}
+
+// VolumeTierResponse represents a quantity tier of a product
+type VolumeTierResponse struct {
+	MinQuantity     uint32      `json:"min_quantity" example:"100"`
+	DiscountPercent money.Money `json:"discount_percent" swaggertype:"string" example:"5.00"`
+}
+
+// VolumeTiersResponse represents the quantity tiers of a product
+type VolumeTiersResponse struct {
+	SKU   string               `json:"sku" example:"SKU-WIDGET-001"`
+	Tiers []VolumeTierResponse `json:"tiers"`
+}
+
+// DiscountLineResponse represents a discount applied to an order
+type DiscountLineResponse struct {
+	Kind        string      `json:"kind" example:"VOLUME" enums:"VOLUME,COUPON"`
+	LineNo      uint32      `json:"line_no,omitempty" example:"1"` // VOLUME only
+	Description string      `json:"description" example:"Volume discount 5.00% (100+ units)"`
+	Amount      money.Money `json:"amount" swaggertype:"string" example:"62.50"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToCouponResponse converts a coupon to the response
+func ToCouponResponse(c *db.Coupon, sellerExternalID string, value, minOrder money.Money) CouponResponse {
+	response := CouponResponse{
+		CouponID:        c.ExternalID,
+		SellerID:        sellerExternalID,
+		Code:            c.Code,
+		DiscountType:    string(c.DiscountType),
+		DiscountValue:   value,
+		MinOrderAmount:  minOrder,
+		RedemptionCount: c.RedemptionCount,
+		Status:          string(c.Status),
+		CreatedAt:       c.CreatedAt,
+	}
+	if c.MaxRedemptions.Valid {
+		limit := uint32(c.MaxRedemptions.Int32)
+		response.MaxRedemptions = &limit
+	}
+	if c.MaxPerBuyer.Valid {
+		limit := uint32(c.MaxPerBuyer.Int32)
+		response.MaxPerBuyer = &limit
+	}
+	if c.ExpiresAt.Valid {
+		response.ExpiresAt = &c.ExpiresAt.Time
+	}
+	return response
+}
+
+// ToDiscountLineResponses parses and converts the stored discounts of an order
+func ToDiscountLineResponses(discounts []db.OrderDiscount) ([]DiscountLineResponse, money.Money, error) {
+	total := money.Zero("", amountScale)
+	responses := make([]DiscountLineResponse, 0, len(discounts))
+	for _, d := range discounts {
+		amount, err := money.Parse(d.Amount, "", amountScale)
+		if err != nil {
+			return nil, money.Money{}, err
+		}
+		responses = append(responses, DiscountLineResponse{
+			Kind:        string(d.Kind),
+			LineNo:      uint32(d.LineNo.Int32),
+			Description: d.Description,
+			Amount:      amount,
+		})
+		total = total.Add(amount)
+	}
+	return responses, total, nil
+}
@@ -0,0 +1,268 @@
+package discount
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for coupons and volume tiers
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new discount handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers discount routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	coupons := rg.Group("/coupons", middleware.RequireAuth())
+	{
+		coupons.POST("", h.CreateCoupon)
+		coupons.GET("", h.ListCoupons)
+		coupons.GET("/:couponId", h.GetCoupon)
+		coupons.POST("/:couponId/disable", h.DisableCoupon)
+	}
+
+	products := rg.Group("/products", middleware.RequireAuth())
+	{
+		products.GET("/:sku/volume-tiers", h.GetVolumeTiers)
+	}
+
+	admin := rg.Group("/admin/products", middleware.RequireRoles(middleware.RoleAdmin))
+	{
+		admin.PUT("/:sku/volume-tiers", h.SetVolumeTiers)
+	}
+}
+
+// couponAccess returns the caller's coupon access
+func couponAccess(principal *middleware.Principal) Access {
+	return Access{
+		Admin:    principal.IsAdmin(),
+		CanActAs: principal.CanActAs,
+	}
+}
+
+// extractCouponID extracts and validates the coupon id from path
+func extractCouponID(c *gin.Context) (string, error) {
+	couponID := c.Param("couponId")
+	if _, err := uuid.Parse(couponID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+	return couponID, nil
+}
+
+// CreateCoupon godoc
+// @Summary Create coupon
+// @Description Create a coupon code of the seller (default: caller). Buyers enter the code when submitting a purchase order to the seller.
+// @Description PERCENT coupons take discount_value percent off the order subtotal after volume discounts; FIXED coupons take off a fixed amount.
+// @Description Expiry is checked against the purchase order's submission time, usage limits when the seller accepts it. Audited.
+// @Tags discounts
+// @Accept json
+// @Produce json
+// @Param request body CreateCouponRequest true "Coupon"
+// @Success 201 {object} middleware.SuccessResponse{data=CouponResponse} "Coupon created"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "Seller not found"
+// @Failure 409 {object} middleware.ErrorResponse "Coupon code already exists"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/coupons [post]
+func (h *Handler) CreateCoupon(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req CreateCouponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+	if req.SellerID == "" {
+		req.SellerID = principal.UserExternalID
+	}
+
+	result, err := h.service.CreateCoupon(c.Request.Context(), &req, couponAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// ListCoupons godoc
+// @Summary List coupons
+// @Description List the caller's coupons as seller, newest first
+// @Tags discounts
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} middleware.SuccessResponse{data=ListCouponsResponse} "Coupon list"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "No user bound to the credential"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/coupons [get]
+func (h *Handler) ListCoupons(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req ListCouponsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	if principal.UserID == 0 {
+		middleware.RespondError(c, errors.Forbidden("No user bound to the credential"))
+		return
+	}
+
+	result, err := h.service.ListCoupons(c.Request.Context(), principal.UserID, principal.UserExternalID, &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// GetCoupon godoc
+// @Summary Get coupon
+// @Description Get a coupon with its redemption count (seller or admin)
+// @Tags discounts
+// @Produce json
+// @Param couponId path string true "Coupon external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=CouponResponse} "Coupon"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid coupon ID"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Coupon not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/coupons/{couponId} [get]
+func (h *Handler) GetCoupon(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	couponID, err := extractCouponID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	result, err := h.service.GetCoupon(c.Request.Context(), couponID, couponAccess(principal))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// DisableCoupon godoc
+// @Summary Disable coupon
+// @Description Disable a coupon (seller or admin). Purchase orders submitted with the code can no longer be accepted until the code is removed. Audited.
+// @Tags discounts
+// @Produce json
+// @Param couponId path string true "Coupon external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=CouponResponse} "Coupon disabled"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid coupon ID"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Coupon not found"
+// @Failure 409 {object} middleware.ErrorResponse "Coupon is already disabled"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/coupons/{couponId}/disable [post]
+func (h *Handler) DisableCoupon(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	couponID, err := extractCouponID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	result, err := h.service.DisableCoupon(c.Request.Context(), couponID, couponAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// GetVolumeTiers godoc
+// @Summary Get volume tiers
+// @Description Get the quantity tiers of a product. An order line of at least min_quantity units gets the highest matching tier's percent off.
+// @Tags discounts
+// @Produce json
+// @Param sku path string true "Product SKU"
+// @Success 200 {object} middleware.SuccessResponse{data=VolumeTiersResponse} "Volume tiers"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Product not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/products/{sku}/volume-tiers [get]
+func (h *Handler) GetVolumeTiers(c *gin.Context) {
+	result, err := h.service.GetVolumeTiers(c.Request.Context(), c.Param("sku"))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// SetVolumeTiers godoc
+// @Summary Set volume tiers
+// @Description Replace the quantity tiers of a product - Admin only. An empty list removes all tiers.
+// @Description Applies to purchase orders accepted afterwards; existing orders keep their discounts. Audited.
+// @Tags discounts
+// @Accept json
+// @Produce json
+// @Param sku path string true "Product SKU"
+// @Param request body SetVolumeTiersRequest true "Volume tiers"
+// @Success 200 {object} middleware.SuccessResponse{data=VolumeTiersResponse} "Volume tiers set"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Product not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/products/{sku}/volume-tiers [put]
+func (h *Handler) SetVolumeTiers(c *gin.Context) {
+	var req SetVolumeTiersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.SetVolumeTiers(c.Request.Context(), c.Param("sku"), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
@@ -0,0 +1,400 @@
+package discount
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// mysqlErrDuplicateEntry is the MySQL error number for duplicate key violations
+const mysqlErrDuplicateEntry = 1062
+
+// Audit log identifiers
+const (
+	actionCouponCreated  = "COUPON_CREATED"
+	actionCouponDisabled = "COUPON_DISABLED"
+	actionVolumeTiersSet = "VOLUME_TIERS_SET"
+	resourceCoupon       = "COUPON"
+	resourceProduct      = "PRODUCT"
+)
+
+// Access is the caller's access to coupons
+type Access struct {
+	// Admin may view and manage any coupon
+	Admin bool
+	// CanActAs reports whether the caller may operate on the user's resources (seller external ID)
+	CanActAs func(userExternalID string) bool
+}
+
+// Service manages seller coupons and product volume tiers.
+// Both are applied when a purchase order becomes an order (see Calculate and Apply).
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	logger   *zap.Logger
+}
+
+// NewService creates a new discount service
+func NewService(txRunner *pkgdb.TxRunner, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		logger:   logger,
+	}
+}
+
+// CreateCoupon creates a coupon of the seller (seller or admin); codes are unique per seller
+func (s *Service) CreateCoupon(ctx context.Context, req *CreateCouponRequest, access Access, actor audit.Actor) (*CouponResponse, error) {
+	if !access.CanActAs(req.SellerID) {
+		return nil, errors.Forbidden("Cannot create coupons for another seller")
+	}
+	value, err := money.Parse(req.DiscountValue, "", amountScale)
+	if err != nil || value.Sign() <= 0 {
+		return nil, errors.InvalidInput("discount_value must be a positive decimal with at most 2 decimal places")
+	}
+	if req.DiscountType == string(db.CouponsDiscountTypePERCENT) && value.Cmp(maxPercent) >= 0 {
+		return nil, errors.InvalidInput("discount_value of a PERCENT coupon must be below 100")
+	}
+	minOrder := money.Zero("", amountScale)
+	if req.MinOrderAmount != "" {
+		minOrder, err = money.Parse(req.MinOrderAmount, "", amountScale)
+		if err != nil || minOrder.Sign() < 0 {
+			return nil, errors.InvalidInput("min_order_amount must be a non-negative decimal with at most 2 decimal places")
+		}
+	}
+	var expiresAt sql.NullTime
+	if req.ExpiresAt != nil {
+		if !req.ExpiresAt.After(time.Now()) {
+			return nil, errors.InvalidInput("expires_at must be in the future")
+		}
+		expiresAt = sql.NullTime{Time: req.ExpiresAt.UTC(), Valid: true}
+	}
+	code := NormalizeCode(req.Code)
+
+	q := s.txRunner.Queries()
+	seller, err := s.getUser(ctx, q, req.SellerID, "Seller")
+	if err != nil {
+		return nil, err
+	}
+
+	externalID := uuid.New().String()
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		result, err := q.CreateCoupon(ctx, db.CreateCouponParams{
+			ExternalID:     externalID,
+			SellerID:       seller.ID,
+			Code:           code,
+			DiscountType:   db.CouponsDiscountType(req.DiscountType),
+			DiscountValue:  value.String(),
+			MinOrderAmount: minOrder.String(),
+			MaxRedemptions: nullLimit(req.MaxRedemptions),
+			MaxPerBuyer:    nullLimit(req.MaxPerBuyer),
+			ExpiresAt:      expiresAt,
+		})
+		if err != nil {
+			return err
+		}
+		couponID, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionCouponCreated,
+			ResourceType: resourceCoupon,
+			ResourceID:   uint64(couponID),
+			NewValue: map[string]any{
+				"code":           code,
+				"discount_type":  req.DiscountType,
+				"discount_value": value.String(),
+			},
+		})
+	})
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, errors.Conflict("Coupon code already exists")
+		}
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to create coupon", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetCoupon(ctx, externalID, access)
+}
+
+// GetCoupon returns a coupon (seller or admin)
+func (s *Service) GetCoupon(ctx context.Context, externalID string, access Access) (*CouponResponse, error) {
+	detail, err := s.getCouponDetail(ctx, s.txRunner.Queries(), externalID, access)
+	if err != nil {
+		return nil, err
+	}
+	coupon := couponOf(detail)
+	return s.toCouponResponse(ctx, &coupon, detail.SellerExternalID.String)
+}
+
+// ListCoupons returns the seller's coupons, newest first
+func (s *Service) ListCoupons(ctx context.Context, sellerID uint64, sellerExternalID string, req *ListCouponsRequest) (*ListCouponsResponse, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	q := s.txRunner.Queries()
+	coupons, err := q.ListCouponsBySeller(ctx, db.ListCouponsBySellerParams{
+		SellerID: sellerID,
+		Limit:    int32(req.PageSize),
+		Offset:   int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list coupons", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	total, err := q.CountCouponsBySeller(ctx, sellerID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count coupons", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	responses := make([]CouponResponse, 0, len(coupons))
+	for i := range coupons {
+		response, err := s.toCouponResponse(ctx, &coupons[i], sellerExternalID)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, *response)
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListCouponsResponse{
+		Coupons:    responses,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// DisableCoupon disables a coupon (seller or admin); purchase orders already submitted with it can no longer be accepted with the discount
+func (s *Service) DisableCoupon(ctx context.Context, externalID string, access Access, actor audit.Actor) (*CouponResponse, error) {
+	detail, err := s.getCouponDetail(ctx, s.txRunner.Queries(), externalID, access)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		n, err := q.DisableCoupon(ctx, detail.ID)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return errors.Conflict("Coupon is already disabled")
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionCouponDisabled,
+			ResourceType: resourceCoupon,
+			ResourceID:   detail.ID,
+			OldValue:     map[string]any{"status": string(detail.Status)},
+			NewValue:     map[string]any{"status": string(db.CouponsStatusDISABLED)},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to disable coupon", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetCoupon(ctx, externalID, access)
+}
+
+// GetVolumeTiers returns the quantity tiers of a product
+func (s *Service) GetVolumeTiers(ctx context.Context, sku string) (*VolumeTiersResponse, error) {
+	q := s.txRunner.Queries()
+	product, err := s.getProduct(ctx, q, sku)
+	if err != nil {
+		return nil, err
+	}
+	tiers, err := q.ListVolumeTiersByProduct(ctx, product.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list volume tiers", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	response := &VolumeTiersResponse{SKU: product.Sku, Tiers: make([]VolumeTierResponse, 0, len(tiers))}
+	for _, tier := range tiers {
+		percent, err := money.Parse(tier.DiscountPercent, "", percentScale)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("invalid stored discount percent", zap.String("percent", tier.DiscountPercent), zap.Error(err))
+			return nil, errors.Internal("Invalid stored discount percent")
+		}
+		response.Tiers = append(response.Tiers, VolumeTierResponse{MinQuantity: tier.MinQuantity, DiscountPercent: percent})
+	}
+	return response, nil
+}
+
+// SetVolumeTiers replaces the quantity tiers of a product (admin); orders already created keep their discounts
+func (s *Service) SetVolumeTiers(ctx context.Context, sku string, req *SetVolumeTiersRequest, actor audit.Actor) (*VolumeTiersResponse, error) {
+	percents := make([]money.Money, len(req.Tiers))
+	seen := make(map[uint32]bool, len(req.Tiers))
+	for i, tier := range req.Tiers {
+		if seen[tier.MinQuantity] {
+			return nil, errors.InvalidInput(fmt.Sprintf("duplicate min_quantity %d", tier.MinQuantity))
+		}
+		seen[tier.MinQuantity] = true
+		percent, err := money.Parse(tier.DiscountPercent, "", percentScale)
+		if err != nil || percent.Sign() <= 0 || percent.Cmp(maxPercent) >= 0 {
+			return nil, errors.InvalidInput(fmt.Sprintf("tiers[%d].discount_percent must be above 0 and below 100 with at most 2 decimal places", i))
+		}
+		percents[i] = percent
+	}
+
+	q := s.txRunner.Queries()
+	product, err := s.getProduct(ctx, q, sku)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		if err := q.DeleteVolumeTiers(ctx, product.ID); err != nil {
+			return err
+		}
+		tiers := make([]map[string]any, 0, len(req.Tiers))
+		for i, tier := range req.Tiers {
+			if err := q.CreateVolumeTier(ctx, db.CreateVolumeTierParams{
+				ProductID:       product.ID,
+				MinQuantity:     tier.MinQuantity,
+				DiscountPercent: percents[i].String(),
+			}); err != nil {
+				return err
+			}
+			tiers = append(tiers, map[string]any{"min_quantity": tier.MinQuantity, "discount_percent": percents[i].String()})
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionVolumeTiersSet,
+			ResourceType: resourceProduct,
+			ResourceID:   product.ID,
+			NewValue:     map[string]any{"sku": product.Sku, "tiers": tiers},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to set volume tiers", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetVolumeTiers(ctx, sku)
+}
+
+// getCouponDetail retrieves a coupon if the caller is its seller or an admin
+func (s *Service) getCouponDetail(ctx context.Context, q *db.Queries, externalID string, access Access) (*db.GetCouponDetailRow, error) {
+	detail, err := q.GetCouponDetail(ctx, externalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Coupon")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get coupon", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	// 판매자(또는 관리자)가 아니면 쿠폰 존재 여부도 노출하지 않음
+	if !access.Admin && !access.CanActAs(detail.SellerExternalID.String) {
+		return nil, errors.NotFound("Coupon")
+	}
+	return &detail, nil
+}
+
+// getUser retrieves a user by external ID
+func (s *Service) getUser(ctx context.Context, q *db.Queries, externalID, resource string) (*db.User, error) {
+	user, err := q.GetUserByExternalID(ctx, sql.NullString{String: externalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound(resource)
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &user, nil
+}
+
+// getProduct retrieves a catalog product by SKU
+func (s *Service) getProduct(ctx context.Context, q *db.Queries, sku string) (*db.Product, error) {
+	product, err := q.GetProductBySKU(ctx, sku)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Product")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get product", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &product, nil
+}
+
+// toCouponResponse parses the stored amounts and converts the coupon
+func (s *Service) toCouponResponse(ctx context.Context, coupon *db.Coupon, sellerExternalID string) (*CouponResponse, error) {
+	value, err := money.Parse(coupon.DiscountValue, "", amountScale)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("invalid stored amount", zap.String("amount", coupon.DiscountValue), zap.Error(err))
+		return nil, errors.Internal("Invalid stored amount")
+	}
+	minOrder, err := money.Parse(coupon.MinOrderAmount, "", amountScale)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("invalid stored amount", zap.String("amount", coupon.MinOrderAmount), zap.Error(err))
+		return nil, errors.Internal("Invalid stored amount")
+	}
+	response := ToCouponResponse(coupon, sellerExternalID, value, minOrder)
+	return &response, nil
+}
+
+// couponOf returns the coupon columns of a detail row
+func couponOf(detail *db.GetCouponDetailRow) db.Coupon {
+	return db.Coupon{
+		ID:              detail.ID,
+		ExternalID:      detail.ExternalID,
+		SellerID:        detail.SellerID,
+		Code:            detail.Code,
+		DiscountType:    detail.DiscountType,
+		DiscountValue:   detail.DiscountValue,
+		MinOrderAmount:  detail.MinOrderAmount,
+		MaxRedemptions:  detail.MaxRedemptions,
+		MaxPerBuyer:     detail.MaxPerBuyer,
+		RedemptionCount: detail.RedemptionCount,
+		ExpiresAt:       detail.ExpiresAt,
+		Status:          detail.Status,
+		CreatedAt:       detail.CreatedAt,
+		UpdatedAt:       detail.UpdatedAt,
+	}
+}
+
+// nullLimit converts an optional usage limit
+func nullLimit(limit *uint32) sql.NullInt32 {
+	if limit == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: int32(*limit), Valid: true}
+}
+
+// isDuplicateKeyError checks if the error is a MySQL duplicate key error
+func isDuplicateKeyError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var mysqlErr *mysql.MySQLError
+	if stderrors.As(err, &mysqlErr) {
+		return mysqlErr.Number == mysqlErrDuplicateEntry
+	}
+	return strings.Contains(err.Error(), "Duplicate entry")
+}
@@ -0,0 +1,127 @@
+package dispute
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// OpenDisputeRequest represents the request body for disputing a payment
+type OpenDisputeRequest struct {
+	Reason      string `json:"reason" binding:"required,oneof=NOT_RECEIVED NOT_AS_DESCRIBED DUPLICATE UNAUTHORIZED OTHER" example:"NOT_AS_DESCRIBED"`
+	Description string `json:"description" binding:"required,min=1,max=2000" example:"Received 40 units instead of 50"`
+	// Amount is the disputed amount (omit to dispute the whole refundable amount)
+	Amount string `json:"amount,omitempty" example:"250.00"`
+}
+
+// SubmitEvidenceRequest represents the request body for submitting dispute evidence
+type SubmitEvidenceRequest struct {
+	Description string `json:"description" binding:"required,min=1,max=2000" example:"Delivery note signed for 40 units"`
+	// AttachmentURL links a document hosted by the submitter (e.g. a signed download URL)
+	AttachmentURL string `json:"attachment_url,omitempty" binding:"omitempty,url,max=2048" example:"https://files.example.com/delivery-note-1042.pdf"`
+}
+
+// ResolveDisputeRequest represents the request body for resolving a dispute (admin)
+type ResolveDisputeRequest struct {
+	// Outcome REFUND refunds the buyer from the seller's funds, RELEASE releases the frozen funds to the seller
+	Outcome string `json:"outcome" binding:"required,oneof=REFUND RELEASE" example:"REFUND"`
+	// Amount is the refund amount of a REFUND outcome (omit to refund the disputed amount)
+	Amount string `json:"amount,omitempty" example:"250.00"`
+	Note   string `json:"note" binding:"required,min=1,max=500" example:"Seller confirmed short shipment"`
+}
+
+// ListDisputesRequest represents query parameters for listing disputes (admin)
+type ListDisputesRequest struct {
+	Status   string `form:"status" binding:"omitempty,oneof=OPEN REFUNDED RELEASED"`
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// EvidenceResponse represents a piece of dispute evidence
+type EvidenceResponse struct {
+	ID            string    `json:"id" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	Party         string    `json:"party" example:"SELLER"`
+	SubmittedBy   string    `json:"submitted_by" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Description   string    `json:"description" example:"Delivery note signed for 40 units"`
+	AttachmentURL string    `json:"attachment_url,omitempty" example:"https://files.example.com/delivery-note-1042.pdf"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// DisputeResponse represents a payment dispute.
+// held_amount is the part of amount frozen on the seller account (less when the seller's balance was short).
+type DisputeResponse struct {
+	ID             string             `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	PaymentID      string             `json:"payment_id" example:"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`
+	OrderNumber    string             `json:"order_number" example:"ORD-20260115-0042"`
+	BuyerID        string             `json:"buyer_id" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	SellerID       string             `json:"seller_id" example:"9b2f0c1e-6a43-4c8e-b7a2-3f1d2e4c5b6a"`
+	Reason         string             `json:"reason" example:"NOT_AS_DESCRIBED"`
+	Description    string             `json:"description" example:"Received 40 units instead of 50"`
+	Amount         money.Money        `json:"amount" swaggertype:"string" example:"250.00000000"`
+	HeldAmount     money.Money        `json:"held_amount" swaggertype:"string" example:"250.00000000"`
+	TokenSymbol    string             `json:"token_symbol" example:"USDC"`
+	Status         string             `json:"status" example:"OPEN"`
+	RefundID       string             `json:"refund_id,omitempty" example:"3f2504e0-4f89-11d3-9a0c-0305e82c3301"`
+	ResolutionNote string             `json:"resolution_note,omitempty" example:"Seller confirmed short shipment"`
+	ResolvedAt     *time.Time         `json:"resolved_at,omitempty"`
+	CreatedAt      time.Time          `json:"created_at"`
+	Evidence       []EvidenceResponse `json:"evidence,omitempty"`
+}
+
+// ListDisputesResponse represents paginated dispute list
+type ListDisputesResponse struct {
+	Disputes   []DisputeResponse `json:"disputes"`
+	Total      int64             `json:"total"`
+	Page       int               `json:"page"`
+	PageSize   int               `json:"page_size"`
+	TotalPages int               `json:"total_pages"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToDisputeResponse converts a dispute detail row (amounts already parsed) to DisputeResponse
+func ToDisputeResponse(d *db.GetDisputeDetailByExternalIDRow, amount, held money.Money) *DisputeResponse {
+	response := &DisputeResponse{
+		ID:             d.ExternalID,
+		PaymentID:      d.PaymentExternalID.String,
+		OrderNumber:    d.OrderNumber,
+		BuyerID:        d.BuyerExternalID.String,
+		SellerID:       d.SellerExternalID.String,
+		Reason:         string(d.Reason),
+		Description:    d.Description,
+		Amount:         amount,
+		HeldAmount:     held,
+		TokenSymbol:    d.TokenSymbol,
+		Status:         string(d.Status),
+		RefundID:       d.RefundExternalID.String,
+		ResolutionNote: d.ResolutionNote.String,
+		CreatedAt:      d.CreatedAt,
+	}
+	if d.ResolvedAt.Valid {
+		response.ResolvedAt = &d.ResolvedAt.Time
+	}
+	return response
+}
+
+// ToEvidenceResponse converts a dispute evidence row to EvidenceResponse
+func ToEvidenceResponse(e *db.ListDisputeEvidenceRow) EvidenceResponse {
+	return EvidenceResponse{
+		ID:            e.ExternalID,
+		Party:         string(e.Party),
+		SubmittedBy:   e.SubmittedByExternalID.String,
+		Description:   e.Description,
+		AttachmentURL: e.AttachmentUrl.String,
+		CreatedAt:     e.CreatedAt,
+	}
+}
@@ -0,0 +1,258 @@
+package dispute
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for dispute operations
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new dispute handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers dispute routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	payments := rg.Group("/payments", middleware.RequireAuth())
+	{
+		payments.POST("/:paymentId/disputes", h.OpenDispute)
+	}
+
+	disputes := rg.Group("/disputes", middleware.RequireAuth())
+	{
+		disputes.GET("/:disputeId", h.GetDispute)
+		disputes.POST("/:disputeId/evidence", h.SubmitEvidence)
+	}
+
+	admin := rg.Group("/admin/disputes", middleware.RequireRoles(middleware.RoleAdmin))
+	{
+		admin.GET("", h.ListDisputes)
+		admin.POST("/:disputeId/resolve", h.ResolveDispute)
+	}
+}
+
+// extractAndValidateDisputeID extracts and validates disputeId from path
+func extractAndValidateDisputeID(c *gin.Context) (string, error) {
+	disputeID := c.Param("disputeId")
+	if _, err := uuid.Parse(disputeID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+	return disputeID, nil
+}
+
+// OpenDispute godoc
+// @Summary Dispute payment
+// @Description Dispute a captured payment within the dispute window after capture (DISPUTE_WINDOW). Only the buyer can dispute.
+// @Description The disputed amount (amount omitted = refundable amount) is frozen on the seller's account until an admin resolves the dispute;
+// @Description held_amount is less than amount when the seller's available balance was short. One open dispute per payment.
+// @Tags disputes
+// @Accept json
+// @Produce json
+// @Param paymentId path string true "Payment external ID (UUID)"
+// @Param request body OpenDisputeRequest true "Dispute reason, description and amount"
+// @Success 201 {object} middleware.SuccessResponse{data=DisputeResponse} "Dispute opened"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input, payment not captured, dispute window expired or amount exceeds refundable amount"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Not the buyer"
+// @Failure 404 {object} middleware.ErrorResponse "Payment not found"
+// @Failure 409 {object} middleware.ErrorResponse "Payment already has an open dispute or is fully refunded"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/payments/{paymentId}/disputes [post]
+func (h *Handler) OpenDispute(c *gin.Context) {
+	paymentID := c.Param("paymentId")
+	if _, err := uuid.Parse(paymentID); err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Invalid UUID format"))
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req OpenDisputeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.OpenDispute(c.Request.Context(), paymentID, &req, disputeAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// GetDispute godoc
+// @Summary Get dispute
+// @Description Get a dispute of a payment the caller bought or sold, with its evidence (oldest first)
+// @Tags disputes
+// @Produce json
+// @Param disputeId path string true "Dispute ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=DisputeResponse} "Dispute"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Dispute not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/disputes/{disputeId} [get]
+func (h *Handler) GetDispute(c *gin.Context) {
+	disputeID, err := extractAndValidateDisputeID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	result, err := h.service.GetDispute(c.Request.Context(), disputeID, disputeAccess(principal))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// SubmitEvidence godoc
+// @Summary Submit dispute evidence
+// @Description Attach evidence to an open dispute. The buyer, the seller and admins can submit; party records who submitted it.
+// @Tags disputes
+// @Accept json
+// @Produce json
+// @Param disputeId path string true "Dispute ID (UUID)"
+// @Param request body SubmitEvidenceRequest true "Evidence description and attachment URL"
+// @Success 201 {object} middleware.SuccessResponse{data=EvidenceResponse} "Evidence submitted"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Dispute not found"
+// @Failure 409 {object} middleware.ErrorResponse "Dispute already resolved"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/disputes/{disputeId}/evidence [post]
+func (h *Handler) SubmitEvidence(c *gin.Context) {
+	disputeID, err := extractAndValidateDisputeID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req SubmitEvidenceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.SubmitEvidence(c.Request.Context(), disputeID, &req, disputeAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// ListDisputes godoc
+// @Summary List disputes
+// @Description Get paginated disputes with an optional status filter (oldest first) - Admin only
+// @Description Send Accept: text/csv to receive the same results (same filters) as CSV.
+// @Tags disputes
+// @Produce json,text/csv
+// @Param status query string false "Filter by status" Enums(OPEN, REFUNDED, RELEASED)
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} middleware.SuccessResponse{data=ListDisputesResponse} "Dispute list"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid query parameters"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Admin role required"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/disputes [get]
+func (h *Handler) ListDisputes(c *gin.Context) {
+	var req ListDisputesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListDisputes(c.Request.Context(), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOKNegotiated(c, "disputes", result, result.Disputes)
+}
+
+// ResolveDispute godoc
+// @Summary Resolve dispute
+// @Description Resolve an open dispute - Admin only. Audited.
+// @Description REFUND releases the frozen funds and refunds the buyer (amount omitted = disputed amount) like the payment refund API:
+// @Description LEDGER before the payout to the seller, ON_CHAIN after. RELEASE releases the frozen funds to the seller.
+// @Tags disputes
+// @Accept json
+// @Produce json
+// @Param disputeId path string true "Dispute ID (UUID)"
+// @Param request body ResolveDisputeRequest true "Outcome, refund amount and note"
+// @Success 200 {object} middleware.SuccessResponse{data=DisputeResponse} "Dispute resolved"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input, amount exceeds refundable amount or insufficient seller balance"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Admin role required"
+// @Failure 404 {object} middleware.ErrorResponse "Dispute not found"
+// @Failure 409 {object} middleware.ErrorResponse "Dispute already resolved"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Failure 503 {object} middleware.ErrorResponse "On-chain refunds disabled or refund transfer failed"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/disputes/{disputeId}/resolve [post]
+func (h *Handler) ResolveDispute(c *gin.Context) {
+	disputeID, err := extractAndValidateDisputeID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req ResolveDisputeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ResolveDispute(c.Request.Context(), disputeID, &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// disputeAccess maps the principal to the service's dispute access check
+func disputeAccess(principal *middleware.Principal) Access {
+	return Access{
+		Admin:    principal.IsAdmin(),
+		CanActAs: principal.CanActAs,
+	}
+}
@@ -0,0 +1,603 @@
+package dispute
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/ledger"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/outbox"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/payment"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/webhook"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// amountScale is the scale of dispute amounts (DECIMAL(18,8))
+const amountScale = 8
+
+// Resolution outcomes
+const (
+	OutcomeRefund  = "REFUND"
+	OutcomeRelease = "RELEASE"
+)
+
+// Audit log identifiers
+const (
+	actionOpened            = "DISPUTE_OPENED"
+	actionEvidenceSubmitted = "DISPUTE_EVIDENCE_SUBMITTED"
+	actionResolved          = "DISPUTE_RESOLVED"
+	resourceDispute         = "DISPUTE"
+)
+
+// Config holds dispute settings
+type Config struct {
+	// Window is how long after capture the buyer may dispute a payment
+	Window time.Duration
+}
+
+// Access is the caller's access to disputes
+type Access struct {
+	// Admin may view and resolve any dispute
+	Admin bool
+	// CanActAs reports whether the caller may operate on the user's resources (buyer/seller external ID)
+	CanActAs func(userExternalID string) bool
+}
+
+// canView reports whether the caller is the buyer, the seller or an admin
+func (a Access) canView(buyerID, sellerID string) bool {
+	return a.Admin || a.CanActAs(buyerID) || a.CanActAs(sellerID)
+}
+
+// Service handles payment disputes.
+// The buyer opens a dispute on a captured payment; the disputed amount is frozen on the seller account
+// until an admin resolves it by refunding the buyer or releasing the funds to the seller.
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	payments *payment.Service
+	config   Config
+	logger   *zap.Logger
+}
+
+// NewService creates a new dispute service
+func NewService(txRunner *pkgdb.TxRunner, payments *payment.Service, config Config, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		payments: payments,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// OpenDispute disputes a captured payment within the dispute window (buyer or admin).
+//
+// Why:
+//   - 주문 → 결제 row-lock 하에서 진행 중 분쟁/환불 가능 금액 검증 → 환불과 동시에 들어와도 초과 분쟁 불가
+//   - 판매자 계정 가용 잔액에서 분쟁 금액 동결 → 해결 전까지 판매자 출금/다른 환불에 쓰이지 않음
+//     (지급 이후 잔액 부족분은 동결하지 않고 held_amount로 기록)
+func (s *Service) OpenDispute(ctx context.Context, paymentExternalID string, req *OpenDisputeRequest, access Access, actor audit.Actor) (*DisputeResponse, error) {
+	// 1. Validate
+	detail, err := s.txRunner.Queries().GetPaymentDetailByExternalID(ctx, sql.NullString{String: paymentExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Payment")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get payment", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if !access.canView(detail.BuyerExternalID.String, detail.SellerExternalID.String) {
+		return nil, errors.NotFound("Payment")
+	}
+	if !access.CanActAs(detail.BuyerExternalID.String) {
+		return nil, errors.Forbidden("Only the buyer of the payment can dispute it")
+	}
+
+	var requested *money.Money
+	if req.Amount != "" {
+		amount, err := money.Parse(req.Amount, "", amountScale)
+		if err != nil || amount.Sign() <= 0 {
+			return nil, errors.InvalidInput("amount must be a positive decimal with at most 8 decimals")
+		}
+		requested = &amount
+	}
+
+	// 2. Freeze + record (one transaction)
+	disputeID, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (string, error) {
+		return s.openDispute(ctx, q, &detail, requested, req, actor)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.getDispute(ctx, disputeID, access, false)
+	if err != nil {
+		return nil, err
+	}
+	logctx.From(ctx, s.logger).Info("dispute opened",
+		zap.String("dispute_external_id", disputeID),
+		zap.String("payment_external_id", paymentExternalID),
+		zap.Stringer("amount", result.Amount),
+		zap.Stringer("held_amount", result.HeldAmount),
+	)
+	return result, nil
+}
+
+// GetDispute returns a dispute with its evidence (buyer, seller or admin)
+func (s *Service) GetDispute(ctx context.Context, externalID string, access Access) (*DisputeResponse, error) {
+	return s.getDispute(ctx, externalID, access, true)
+}
+
+// SubmitEvidence attaches evidence to an open dispute (buyer, seller or admin)
+func (s *Service) SubmitEvidence(ctx context.Context, externalID string, req *SubmitEvidenceRequest, access Access, actor audit.Actor) (*EvidenceResponse, error) {
+	detail, err := s.getDetail(ctx, s.txRunner.Queries(), externalID)
+	if err != nil {
+		return nil, err
+	}
+	if !access.canView(detail.BuyerExternalID.String, detail.SellerExternalID.String) {
+		return nil, errors.NotFound("Dispute")
+	}
+
+	// 관리자는 ADMIN으로 기록 (관리자 키는 모든 사용자로 행동 가능)
+	party := db.DisputeEvidencePartyADMIN
+	switch {
+	case access.Admin:
+	case access.CanActAs(detail.BuyerExternalID.String):
+		party = db.DisputeEvidencePartyBUYER
+	default:
+		party = db.DisputeEvidencePartySELLER
+	}
+
+	evidenceID := uuid.New().String()
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		dispute, err := q.GetDisputeForUpdate(ctx, detail.ID)
+		if err != nil {
+			return err
+		}
+		if dispute.Status != db.DisputesStatusOPEN {
+			return errors.Conflict("Dispute is already resolved")
+		}
+
+		if _, err := q.CreateDisputeEvidence(ctx, db.CreateDisputeEvidenceParams{
+			ExternalID:    evidenceID,
+			DisputeID:     dispute.ID,
+			SubmittedBy:   actor.ID,
+			Party:         party,
+			Description:   req.Description,
+			AttachmentUrl: sql.NullString{String: req.AttachmentURL, Valid: req.AttachmentURL != ""},
+		}); err != nil {
+			return err
+		}
+
+		return s.recordTransition(ctx, q, detail, actor, transition{
+			action:    actionEvidenceSubmitted,
+			eventType: webhook.EventDisputeEvidenceSubmitted,
+			oldStatus: dispute.Status,
+			data: map[string]any{
+				"evidence_id": evidenceID,
+				"party":       string(party),
+			},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to submit dispute evidence", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("dispute evidence submitted",
+		zap.String("dispute_external_id", externalID),
+		zap.String("party", string(party)),
+	)
+	return &EvidenceResponse{
+		ID:            evidenceID,
+		Party:         string(party),
+		SubmittedBy:   actorExternalID(detail, party),
+		Description:   req.Description,
+		AttachmentURL: req.AttachmentURL,
+		CreatedAt:     time.Now().UTC(),
+	}, nil
+}
+
+// ListDisputes returns disputes with an optional status filter, oldest first (admin review queue)
+func (s *Service) ListDisputes(ctx context.Context, req *ListDisputesRequest) (*ListDisputesResponse, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	var status db.NullDisputesStatus
+	if req.Status != "" {
+		status = db.NullDisputesStatus{DisputesStatus: db.DisputesStatus(req.Status), Valid: true}
+	}
+
+	rows, err := s.txRunner.Queries().ListDisputes(ctx, db.ListDisputesParams{
+		Status: status,
+		Limit:  int32(req.PageSize),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list disputes", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := s.txRunner.Queries().CountDisputes(ctx, status)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count disputes", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	disputes := make([]DisputeResponse, 0, len(rows))
+	for i := range rows {
+		detail := db.GetDisputeDetailByExternalIDRow(rows[i])
+		response, err := s.toResponse(ctx, &detail)
+		if err != nil {
+			return nil, err
+		}
+		disputes = append(disputes, *response)
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListDisputesResponse{
+		Disputes:   disputes,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// ResolveDispute resolves an open dispute (admin).
+// REFUND refunds the buyer through the payment refund flow (LEDGER before payout, ON_CHAIN after),
+// RELEASE unfreezes the held funds for the seller.
+//
+// Why:
+//   - 환불 결정 시 동결 해제 + 분쟁 종료를 환불 예약 트랜잭션(RefundHook) 안에서 처리
+//     → 동결 해제 후 판매자 계정 차감이 같은 트랜잭션에서 일어나 자금이 중간에 빠져나가지 않음
+//   - ON_CHAIN 환불 송금 실패 시 환불은 FAILED, 분쟁은 REFUNDED로 남음 → 관리자가 결제 환불 API로 재요청
+func (s *Service) ResolveDispute(ctx context.Context, externalID string, req *ResolveDisputeRequest, actor audit.Actor) (*DisputeResponse, error) {
+	admin := Access{Admin: true, CanActAs: func(string) bool { return true }}
+
+	detail, err := s.getDetail(ctx, s.txRunner.Queries(), externalID)
+	if err != nil {
+		return nil, err
+	}
+	if detail.Status != db.DisputesStatusOPEN {
+		return nil, errors.Conflict("Dispute is already resolved")
+	}
+
+	resolve := func(ctx context.Context, q *db.Queries, status db.DisputesStatus, refundID sql.NullInt64) error {
+		dispute, err := q.GetDisputeForUpdate(ctx, detail.ID)
+		if err != nil {
+			return err
+		}
+		if dispute.Status != db.DisputesStatusOPEN {
+			return errors.Conflict("Dispute is already resolved")
+		}
+		held, err := money.Parse(dispute.HeldAmount, "", amountScale)
+		if err != nil {
+			return err
+		}
+		if err := ledger.Release(ctx, q, dispute.SellerAccountID, held); err != nil {
+			return err
+		}
+		result, err := q.ResolveDispute(ctx, db.ResolveDisputeParams{
+			Status:         status,
+			RefundID:       refundID,
+			ResolvedBy:     sql.NullInt64{Int64: int64(actor.ID), Valid: actor.ID != 0},
+			ResolutionNote: sql.NullString{String: req.Note, Valid: true},
+			ID:             dispute.ID,
+		})
+		if err != nil {
+			return err
+		}
+		if n, err := result.RowsAffected(); err != nil || n == 0 {
+			return errors.Conflict("Dispute is already resolved")
+		}
+
+		resolved, err := q.GetDisputeDetailByExternalID(ctx, externalID)
+		if err != nil {
+			return err
+		}
+		return s.recordTransition(ctx, q, &resolved, actor, transition{
+			action:    actionResolved,
+			eventType: webhook.EventDisputeResolved,
+			oldStatus: dispute.Status,
+			data: map[string]any{
+				"outcome":         req.Outcome,
+				"released_amount": held.String(),
+				"resolution_note": req.Note,
+			},
+		})
+	}
+
+	if req.Outcome == OutcomeRefund {
+		refundReq := &payment.CreateRefundRequest{
+			Amount: req.Amount,
+			Reason: "Dispute " + externalID,
+		}
+		if refundReq.Amount == "" {
+			refundReq.Amount = detail.Amount
+		}
+		_, err = s.payments.CreateRefundWithHook(ctx, detail.PaymentExternalID.String, refundReq, payment.RefundAccess(admin), actor,
+			func(ctx context.Context, q *db.Queries, refund *db.PaymentRefund) error {
+				return resolve(ctx, q, db.DisputesStatusREFUNDED, sql.NullInt64{Int64: int64(refund.ID), Valid: true})
+			})
+	} else {
+		err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+			return resolve(ctx, q, db.DisputesStatusRELEASED, sql.NullInt64{})
+		})
+	}
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to resolve dispute", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("dispute resolved",
+		zap.String("dispute_external_id", externalID),
+		zap.String("outcome", req.Outcome),
+	)
+	return s.getDispute(ctx, externalID, admin, true)
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// transition is a dispute change recorded in the audit log and sent to both parties
+type transition struct {
+	action    string
+	eventType string
+	oldStatus db.DisputesStatus
+	data      map[string]any
+}
+
+// openDispute validates the dispute under the order and payment locks, freezes the funds and
+// records it; returns the dispute external ID
+func (s *Service) openDispute(ctx context.Context, q *db.Queries, detail *db.GetPaymentDetailByExternalIDRow, requested *money.Money, req *OpenDisputeRequest, actor audit.Actor) (string, error) {
+	// 1. Lock order → payment (환불과 같은 순서)
+	order, err := q.GetOrderByIDForUpdate(ctx, detail.OrderID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to lock order row", zap.Error(err))
+		return "", errors.DBError(err)
+	}
+	p, err := q.GetPaymentForUpdate(ctx, detail.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to lock payment row", zap.Error(err))
+		return "", errors.DBError(err)
+	}
+	if p.Status != db.PaymentsStatusCAPTURED || !p.CapturedAt.Valid {
+		return "", errors.InvalidInput("Only captured payments can be disputed")
+	}
+	if deadline := p.CapturedAt.Time.Add(s.config.Window); time.Now().After(deadline) {
+		return "", errors.InvalidInput("Dispute window has expired").
+			WithDetails(map[string]any{"dispute_deadline": deadline.UTC()})
+	}
+
+	open, err := q.ExistsOpenDisputeByPayment(ctx, p.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to check open dispute", zap.Error(err))
+		return "", errors.DBError(err)
+	}
+	if open {
+		return "", errors.Conflict("Payment already has an open dispute")
+	}
+
+	// 2. Disputable amount (= refundable amount)
+	paymentAmount, err := s.parseStoredAmount(ctx, p.Amount)
+	if err != nil {
+		return "", err
+	}
+	stored, err := q.GetPaymentRefundedAmount(ctx, p.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to get refunded amount", zap.Error(err))
+		return "", errors.DBError(err)
+	}
+	refunded, err := s.parseStoredAmount(ctx, stored)
+	if err != nil {
+		return "", err
+	}
+	refundable := paymentAmount.Sub(refunded)
+	if refundable.Sign() <= 0 {
+		return "", errors.Conflict("Payment is already fully refunded")
+	}
+	amount := refundable
+	if requested != nil {
+		amount = *requested
+	}
+	if amount.Cmp(refundable) > 0 {
+		return "", errors.InvalidInput("amount exceeds the refundable amount").
+			WithDetails(map[string]any{"refundable_amount": refundable.String()})
+	}
+
+	// 3. Freeze on the seller account
+	sellerAccount, err := q.GetAccountByOwnerID(ctx, sql.NullInt64{Int64: int64(order.SellerID), Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", errors.NotFound("Seller account")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get seller account", zap.Error(err))
+		return "", errors.DBError(err)
+	}
+	held, err := ledger.Hold(ctx, q, sellerAccount.ID, amount)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to hold seller funds", zap.Error(err))
+		return "", errors.DBError(err)
+	}
+
+	// 4. Record dispute + transition
+	externalID := uuid.New().String()
+	if _, err := q.CreateDispute(ctx, db.CreateDisputeParams{
+		ExternalID:      externalID,
+		PaymentID:       p.ID,
+		SellerAccountID: sellerAccount.ID,
+		OpenedBy:        actor.ID,
+		Reason:          db.DisputesReason(req.Reason),
+		Description:     req.Description,
+		Amount:          amount.String(),
+		HeldAmount:      held.String(),
+	}); err != nil {
+		logctx.From(ctx, s.logger).Error("failed to create dispute", zap.Error(err))
+		return "", errors.DBError(err)
+	}
+
+	dispute, err := q.GetDisputeDetailByExternalID(ctx, externalID)
+	if err != nil {
+		return "", errors.DBError(err)
+	}
+	if err := s.recordTransition(ctx, q, &dispute, actor, transition{
+		action:    actionOpened,
+		eventType: webhook.EventDisputeOpened,
+		data: map[string]any{
+			"description": req.Description,
+		},
+	}); err != nil {
+		logctx.From(ctx, s.logger).Error("failed to record dispute opening", zap.Error(err))
+		return "", errors.DBError(err)
+	}
+	return externalID, nil
+}
+
+// recordTransition records the audit log and the dispute event for buyer and seller
+// (must be called within the transaction of the change)
+func (s *Service) recordTransition(ctx context.Context, q *db.Queries, d *db.GetDisputeDetailByExternalIDRow, actor audit.Actor, t transition) error {
+	newValue := map[string]any{
+		"status":      string(d.Status),
+		"amount":      d.Amount,
+		"held_amount": d.HeldAmount,
+	}
+	for k, v := range t.data {
+		newValue[k] = v
+	}
+	var oldValue map[string]any
+	if t.oldStatus != "" {
+		oldValue = map[string]any{"status": string(t.oldStatus)}
+	}
+	if err := audit.Record(ctx, q, actor, audit.Entry{
+		Action:       t.action,
+		ResourceType: resourceDispute,
+		ResourceID:   d.ID,
+		OldValue:     oldValue,
+		NewValue:     newValue,
+	}); err != nil {
+		return err
+	}
+
+	// Events for buyer and seller (outbox 이벤트는 수신자 1명 단위)
+	data := map[string]any{
+		"dispute_id":   d.ExternalID,
+		"payment_id":   d.PaymentExternalID.String,
+		"order_number": d.OrderNumber,
+		"reason":       string(d.Reason),
+		"status":       string(d.Status),
+		"amount":       d.Amount,
+		"held_amount":  d.HeldAmount,
+		"token_symbol": d.TokenSymbol,
+	}
+	if d.RefundExternalID.Valid {
+		data["refund_id"] = d.RefundExternalID.String
+	}
+	for k, v := range t.data {
+		data[k] = v
+	}
+	for _, recipient := range []uint64{d.BuyerID, d.SellerID} {
+		if _, err := outbox.Write(ctx, q, outbox.Message{
+			EventType:           t.eventType,
+			AggregateType:       outbox.AggregateDispute,
+			AggregateID:         d.ID,
+			AggregateExternalID: d.ExternalID,
+			RecipientUserID:     recipient,
+			Data:                data,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getDispute returns the dispute if the caller may see it, optionally with its evidence
+func (s *Service) getDispute(ctx context.Context, externalID string, access Access, withEvidence bool) (*DisputeResponse, error) {
+	q := s.txRunner.Queries()
+	detail, err := s.getDetail(ctx, q, externalID)
+	if err != nil {
+		return nil, err
+	}
+	if !access.canView(detail.BuyerExternalID.String, detail.SellerExternalID.String) {
+		return nil, errors.NotFound("Dispute")
+	}
+
+	response, err := s.toResponse(ctx, detail)
+	if err != nil {
+		return nil, err
+	}
+	if withEvidence {
+		evidence, err := q.ListDisputeEvidence(ctx, detail.ID)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to list dispute evidence", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		response.Evidence = make([]EvidenceResponse, 0, len(evidence))
+		for i := range evidence {
+			response.Evidence = append(response.Evidence, ToEvidenceResponse(&evidence[i]))
+		}
+	}
+	return response, nil
+}
+
+// getDetail retrieves a dispute with its payment and parties by external ID
+func (s *Service) getDetail(ctx context.Context, q *db.Queries, externalID string) (*db.GetDisputeDetailByExternalIDRow, error) {
+	detail, err := q.GetDisputeDetailByExternalID(ctx, externalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Dispute")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get dispute", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &detail, nil
+}
+
+// toResponse parses the stored amounts and converts the dispute
+func (s *Service) toResponse(ctx context.Context, d *db.GetDisputeDetailByExternalIDRow) (*DisputeResponse, error) {
+	amount, err := s.parseStoredAmount(ctx, d.Amount)
+	if err != nil {
+		return nil, err
+	}
+	held, err := s.parseStoredAmount(ctx, d.HeldAmount)
+	if err != nil {
+		return nil, err
+	}
+	return ToDisputeResponse(d, amount, held), nil
+}
+
+// parseStoredAmount parses a DECIMAL(18,8) column
+func (s *Service) parseStoredAmount(ctx context.Context, value string) (money.Money, error) {
+	amount, err := money.Parse(value, "", amountScale)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("invalid stored amount", zap.String("amount", value), zap.Error(err))
+		return money.Money{}, errors.Internal("Invalid stored amount")
+	}
+	return amount, nil
+}
+
+// actorExternalID returns the external ID of the party that submitted evidence
+func actorExternalID(d *db.GetDisputeDetailByExternalIDRow, party db.DisputeEvidenceParty) string {
+	switch party {
+	case db.DisputeEvidencePartyBUYER:
+		return d.BuyerExternalID.String
+	case db.DisputeEvidencePartySELLER:
+		return d.SellerExternalID.String
+	}
+	return ""
+}
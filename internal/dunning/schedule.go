@@ -0,0 +1,76 @@
+package dunning
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// Final actions taken when an order stays unpaid past the last threshold
+const (
+	// FinalActionCancel cancels the unpaid order
+	FinalActionCancel = "cancel"
+	// FinalActionCreditHold suspends the buyer's account (reversible by ops)
+	FinalActionCreditHold = "credit_hold"
+)
+
+// Schedule is the dunning schedule in days after the payment due date
+type Schedule struct {
+	// ReminderDays sends a reminder at each offset (e.g. D+3, D+7)
+	ReminderDays []int
+	// FinalDays triggers FinalAction (0 = no final action)
+	FinalDays int
+	// FinalAction is FinalActionCancel or FinalActionCreditHold
+	FinalAction string
+}
+
+// Step is a single dunning step
+type Step struct {
+	DaysOverdue int
+	Action      db.OrderDunningStepsAction
+}
+
+// Validate checks the schedule offsets and final action
+func (s Schedule) Validate() error {
+	for _, days := range s.ReminderDays {
+		if days <= 0 {
+			return fmt.Errorf("dunning: reminder days must be positive, got %d", days)
+		}
+		if s.FinalDays > 0 && days >= s.FinalDays {
+			return fmt.Errorf("dunning: reminder at D+%d is not before the final action at D+%d", days, s.FinalDays)
+		}
+	}
+	if s.FinalDays < 0 {
+		return fmt.Errorf("dunning: final days must not be negative, got %d", s.FinalDays)
+	}
+	if s.FinalDays > 0 && s.FinalAction != FinalActionCancel && s.FinalAction != FinalActionCreditHold {
+		return fmt.Errorf("dunning: unknown final action %q", s.FinalAction)
+	}
+	return nil
+}
+
+// Steps returns the steps from the most to the least overdue.
+// Why: 가장 늦은 단계부터 실행 → 오래 밀린 주문은 앞 리마인더를 건너뛰고 현재 단계만 실행
+func (s Schedule) Steps() []Step {
+	steps := make([]Step, 0, len(s.ReminderDays)+1)
+	seen := make(map[int]bool, len(s.ReminderDays))
+	for _, days := range s.ReminderDays {
+		if seen[days] {
+			continue
+		}
+		seen[days] = true
+		steps = append(steps, Step{DaysOverdue: days, Action: db.OrderDunningStepsActionREMINDER})
+	}
+
+	if s.FinalDays > 0 {
+		action := db.OrderDunningStepsActionCREDITHOLD
+		if s.FinalAction == FinalActionCancel {
+			action = db.OrderDunningStepsActionCANCEL
+		}
+		steps = append(steps, Step{DaysOverdue: s.FinalDays, Action: action})
+	}
+
+	sort.Slice(steps, func(i, j int) bool { return steps[i].DaysOverdue > steps[j].DaysOverdue })
+	return steps
+}
@@ -0,0 +1,309 @@
+package dunning
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/invoice"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/outbox"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/webhook"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/go-sql-driver/mysql"
+	"go.uber.org/zap"
+)
+
+const (
+	// mysqlErrDuplicateEntry is the MySQL error number for duplicate key violations
+	mysqlErrDuplicateEntry = 1062
+	// day is the unit of schedule offsets
+	day = 24 * time.Hour
+)
+
+// Config holds dunning engine settings
+type Config struct {
+	Schedule Schedule
+	// Interval is the period of the scheduled dunning job
+	Interval time.Duration
+	// BatchSize is the number of orders processed per step and run
+	BatchSize int
+}
+
+// RunReport summarizes one dunning run
+type RunReport struct {
+	Reminders   int
+	Cancelled   int
+	CreditHolds int
+	Failed      int
+}
+
+// Service runs the dunning schedule against unpaid (CONFIRMED) orders.
+// Reminders and final actions are delivered as outbox events (webhooks / event bus).
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	config   Config
+	logger   *zap.Logger
+}
+
+// NewService creates a new dunning service
+func NewService(txRunner *pkgdb.TxRunner, config Config, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Run executes the dunning job periodically until ctx is canceled
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	logctx.From(ctx, s.logger).Info("dunning job started",
+		zap.Duration("interval", s.config.Interval),
+		zap.Ints("reminder_days", s.config.Schedule.ReminderDays),
+		zap.Int("final_days", s.config.Schedule.FinalDays),
+		zap.String("final_action", s.config.Schedule.FinalAction),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logctx.From(ctx, s.logger).Info("dunning job stopped")
+			return
+		case <-ticker.C:
+			report, err := s.RunOnce(ctx, time.Now().UTC())
+			if err != nil {
+				logctx.From(ctx, s.logger).Error("dunning run failed", zap.Error(err))
+				continue
+			}
+			if report.Reminders+report.Cancelled+report.CreditHolds+report.Failed > 0 {
+				logctx.From(ctx, s.logger).Info("dunning run completed",
+					zap.Int("reminders", report.Reminders),
+					zap.Int("cancelled", report.Cancelled),
+					zap.Int("credit_holds", report.CreditHolds),
+					zap.Int("failed", report.Failed),
+				)
+			}
+		}
+	}
+}
+
+// RunOnce executes every due step of the schedule once.
+// A failing order is logged and counted; it is retried on the next run.
+func (s *Service) RunOnce(ctx context.Context, now time.Time) (*RunReport, error) {
+	report := &RunReport{}
+
+	for _, step := range s.config.Schedule.Steps() {
+		orders, err := s.txRunner.Queries().ListOrdersDueForDunning(ctx, db.ListOrdersDueForDunningParams{
+			DueBefore:   sql.NullTime{Time: now.Add(-time.Duration(step.DaysOverdue) * day), Valid: true},
+			DaysOverdue: uint32(step.DaysOverdue),
+			Limit:       int32(s.config.BatchSize),
+		})
+		if err != nil {
+			return report, fmt.Errorf("list orders due for D+%d: %w", step.DaysOverdue, err)
+		}
+
+		for i := range orders {
+			executed, err := s.executeStep(ctx, &orders[i], step)
+			if err != nil {
+				report.Failed++
+				logctx.From(ctx, s.logger).Error("dunning step failed",
+					zap.String("order_number", orders[i].OrderNumber),
+					zap.Int("days_overdue", step.DaysOverdue),
+					zap.String("action", string(step.Action)),
+					zap.Error(err),
+				)
+				continue
+			}
+			if !executed {
+				continue
+			}
+
+			switch step.Action {
+			case db.OrderDunningStepsActionREMINDER:
+				report.Reminders++
+			case db.OrderDunningStepsActionCANCEL:
+				report.Cancelled++
+			case db.OrderDunningStepsActionCREDITHOLD:
+				report.CreditHolds++
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// executeStep records and applies one step for an order in a single transaction.
+// Returns false when the order was paid/cancelled meanwhile or another instance ran the step.
+func (s *Service) executeStep(ctx context.Context, order *db.Order, step Step) (bool, error) {
+	executed, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (bool, error) {
+		// 1. Re-check status under row-lock (결제 완료와 경합 방지)
+		locked, err := q.GetOrderByIDForUpdate(ctx, order.ID)
+		if err != nil {
+			return false, fmt.Errorf("lock order: %w", err)
+		}
+		if locked.Status != db.OrdersStatusCONFIRMED {
+			return false, nil
+		}
+
+		// 2. Apply the action and notify
+		eventID, err := s.applyAction(ctx, q, &locked, step)
+		if err != nil {
+			return false, err
+		}
+
+		// 3. Record step (uk_order_dunning_step → 중복 실행 시 트랜잭션 전체 롤백)
+		if err := q.CreateOrderDunningStep(ctx, db.CreateOrderDunningStepParams{
+			OrderID:     locked.ID,
+			DaysOverdue: uint32(step.DaysOverdue),
+			Action:      step.Action,
+			EventID:     sql.NullString{String: eventID, Valid: eventID != ""},
+		}); err != nil {
+			return false, fmt.Errorf("record dunning step: %w", err)
+		}
+
+		return true, nil
+	})
+	if err != nil && isDuplicateKeyError(err) {
+		return false, nil
+	}
+	return executed, err
+}
+
+// applyAction performs the step's action and writes its outbox event (returns the event ID)
+func (s *Service) applyAction(ctx context.Context, q *db.Queries, order *db.Order, step Step) (string, error) {
+	data := orderEventData(order, step)
+
+	switch step.Action {
+	case db.OrderDunningStepsActionCANCEL:
+		if _, err := q.CancelConfirmedOrder(ctx, order.ID); err != nil {
+			return "", fmt.Errorf("cancel order: %w", err)
+		}
+		// 청구된 주문이면 청구서 VOID (외상 청구서는 미결제 잔액만큼 신용 한도 복원)
+		if err := invoice.VoidOrderInvoice(ctx, q, order.ID); err != nil {
+			return "", err
+		}
+		data["status"] = string(db.OrdersStatusCANCELLED)
+		data["reason"] = "PAYMENT_OVERDUE"
+
+		// 판매자에게도 취소 통지 (outbox 이벤트는 수신자 1명 단위)
+		if _, err := s.writeOrderEvent(ctx, q, order, order.SellerID, webhook.EventOrderCancelled, data); err != nil {
+			return "", err
+		}
+		return s.writeOrderEvent(ctx, q, order, order.BuyerID, webhook.EventOrderCancelled, data)
+
+	case db.OrderDunningStepsActionCREDITHOLD:
+		eventID, err := s.writeOrderEvent(ctx, q, order, order.BuyerID, webhook.EventOrderPaymentReminder, data)
+		if err != nil {
+			return "", err
+		}
+		if err := s.placeCreditHold(ctx, q, order, step); err != nil {
+			return "", err
+		}
+		return eventID, nil
+
+	default:
+		return s.writeOrderEvent(ctx, q, order, order.BuyerID, webhook.EventOrderPaymentReminder, data)
+	}
+}
+
+// placeCreditHold suspends the buyer's account and notifies the buyer
+func (s *Service) placeCreditHold(ctx context.Context, q *db.Queries, order *db.Order, step Step) error {
+	account, err := q.GetAccountByOwnerForUpdate(ctx, sql.NullInt64{Int64: int64(order.BuyerID), Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// 계정 없는 구매자 - 주문 리마인더만 남김
+			logctx.From(ctx, s.logger).Warn("credit hold skipped, buyer has no account",
+				zap.String("order_number", order.OrderNumber),
+			)
+			return nil
+		}
+		return fmt.Errorf("lock buyer account: %w", err)
+	}
+	if account.Status != db.AccountsStatusACTIVE {
+		return nil
+	}
+
+	if err := q.UpdateAccountStatusToSuspended(ctx, account.ID); err != nil {
+		return fmt.Errorf("suspend buyer account: %w", err)
+	}
+
+	_, err = outbox.Write(ctx, q, outbox.Message{
+		EventType:           webhook.EventAccountCreditHold,
+		AggregateType:       outbox.AggregateAccount,
+		AggregateID:         account.ID,
+		AggregateExternalID: account.ExternalID.String,
+		RecipientUserID:     order.BuyerID,
+		Data: map[string]any{
+			"account_id":   account.ExternalID.String,
+			"status":       string(db.AccountsStatusSUSPENDED),
+			"reason":       "PAYMENT_OVERDUE",
+			"order_number": order.OrderNumber,
+			"days_overdue": step.DaysOverdue,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("write credit hold event: %w", err)
+	}
+
+	logctx.From(ctx, s.logger).Info("credit hold placed for overdue order",
+		zap.String("order_number", order.OrderNumber),
+		zap.Uint64("account_id", account.ID),
+	)
+	return nil
+}
+
+// writeOrderEvent records an order event for the recipient (must be called within a transaction)
+func (s *Service) writeOrderEvent(ctx context.Context, q *db.Queries, order *db.Order, recipientUserID uint64, eventType string, data map[string]any) (string, error) {
+	eventID, err := outbox.Write(ctx, q, outbox.Message{
+		EventType:           eventType,
+		AggregateType:       outbox.AggregateOrder,
+		AggregateID:         order.ID,
+		AggregateExternalID: order.OrderNumber,
+		RecipientUserID:     recipientUserID,
+		Data:                data,
+	})
+	if err != nil {
+		return "", fmt.Errorf("write %s event: %w", eventType, err)
+	}
+	return eventID, nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// orderEventData builds the public event body of an overdue order (no internal IDs)
+func orderEventData(order *db.Order, step Step) map[string]any {
+	dueAt := order.CreatedAt
+	if order.PaymentDueAt.Valid {
+		dueAt = order.PaymentDueAt.Time
+	}
+
+	return map[string]any{
+		"order_number": order.OrderNumber,
+		"status":       string(order.Status),
+		"total_amount": order.TotalAmount,
+		"payment_due":  dueAt.UTC(),
+		"days_overdue": step.DaysOverdue,
+		"action":       string(step.Action),
+	}
+}
+
+// isDuplicateKeyError checks if the error is a MySQL duplicate key error
+func isDuplicateKeyError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var mysqlErr *mysql.MySQLError
+	if stderrors.As(err, &mysqlErr) {
+		return mysqlErr.Number == mysqlErrDuplicateEntry
+	}
+	return strings.Contains(err.Error(), "Duplicate entry")
+}
@@ -0,0 +1,62 @@
+package fee
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// TierResponse represents one tier of the fee schedule
+type TierResponse struct {
+	Name          string      `json:"name" example:"GROWTH"`
+	MinVolume     money.Money `json:"min_volume" swaggertype:"string" example:"100000.00000000"`
+	PercentageBps int         `json:"percentage_bps" example:"75"`
+	FixedFee      money.Money `json:"fixed_fee" swaggertype:"string" example:"0.25000000"`
+}
+
+// ScheduleResponse represents the platform fee schedule.
+// A merchant's tier is chosen by its captured volume in the VolumeWindowHours before each payment's capture.
+type ScheduleResponse struct {
+	VolumeWindowHours int            `json:"volume_window_hours" example:"720"`
+	Tiers             []TierResponse `json:"tiers"`
+}
+
+// BreakdownResponse represents the platform fee applied to a payment.
+// Amount is the fee charged at capture; ReversedAmount is the part returned by refunds.
+type BreakdownResponse struct {
+	Tier          string      `json:"tier" example:"GROWTH"`
+	MonthlyVolume money.Money `json:"monthly_volume" swaggertype:"string" example:"154300.00000000"`
+	PercentageBps uint32      `json:"percentage_bps" example:"75"`
+	PercentageFee money.Money `json:"percentage_fee" swaggertype:"string" example:"9.37500000"`
+	FixedFee      money.Money `json:"fixed_fee" swaggertype:"string" example:"0.25000000"`
+	Amount        money.Money `json:"amount" swaggertype:"string" example:"9.62500000"`
+	// ReversedAmount is the fee returned to the merchant by refunds
+	ReversedAmount money.Money `json:"reversed_amount" swaggertype:"string" example:"0.00000000"`
+	AssessedAt     time.Time   `json:"assessed_at"`
+}
+
+// NetAmount returns the fee the merchant bears after reversals
+func (b *BreakdownResponse) NetAmount() money.Money {
+	return b.Amount.Sub(b.ReversedAmount)
+}
+
+// ToScheduleResponse converts a schedule to ScheduleResponse
+func ToScheduleResponse(s Schedule, volumeWindow time.Duration) *ScheduleResponse {
+	tiers := make([]TierResponse, 0, len(s.Tiers))
+	for _, tier := range s.Tiers {
+		tiers = append(tiers, TierResponse{
+			Name:          tier.Name,
+			MinVolume:     tier.MinVolume,
+			PercentageBps: tier.PercentageBps,
+			FixedFee:      tier.FixedFee,
+		})
+	}
+	return &ScheduleResponse{
+		VolumeWindowHours: int(volumeWindow / time.Hour),
+		Tiers:             tiers,
+	}
+}
@@ -0,0 +1,39 @@
+package fee
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles HTTP requests for the fee schedule
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new fee handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers fee routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	fees := rg.Group("/fees", middleware.RequireAuth())
+	{
+		fees.GET("/schedule", h.GetSchedule)
+	}
+}
+
+// GetSchedule godoc
+// @Summary Get platform fee schedule
+// @Description Get the platform fee tiers. Each captured payment is charged percentage_bps of its amount (rounded up)
+// @Description plus the fixed fee of the seller's tier, capped at the payment amount. The tier is the highest one whose
+// @Description min_volume the seller's captured volume in the volume_window_hours before the capture reaches.
+// @Tags fees
+// @Produce json
+// @Success 200 {object} middleware.SuccessResponse{data=ScheduleResponse} "Fee schedule"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Security ApiKeyAuth
+// @Router /api/v1/fees/schedule [get]
+func (h *Handler) GetSchedule(c *gin.Context) {
+	middleware.RespondOK(c, h.service.Schedule())
+}
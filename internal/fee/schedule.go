@@ -0,0 +1,137 @@
+package fee
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+const (
+	// amountScale is the scale of fee amounts and payment amounts (DECIMAL(18,8))
+	amountScale = 8
+	// bpsDenominator is 100% in basis points
+	bpsDenominator = 10000
+)
+
+// Tier is a fee rate that applies to merchants whose trailing volume reaches MinVolume
+type Tier struct {
+	Name string
+	// MinVolume is the lower bound (inclusive) of the merchant's trailing captured volume
+	MinVolume money.Money
+	// PercentageBps is the percentage fee in basis points (100 = 1%)
+	PercentageBps int
+	// FixedFee is added to every payment
+	FixedFee money.Money
+}
+
+// Schedule is the platform fee schedule: tiers ordered by increasing MinVolume
+type Schedule struct {
+	Tiers []Tier
+}
+
+// Breakdown is the fee of one payment under the schedule
+type Breakdown struct {
+	Tier          Tier
+	Volume        money.Money
+	PercentageFee money.Money
+	FixedFee      money.Money
+	// Amount = PercentageFee + FixedFee (never more than the payment amount)
+	Amount money.Money
+}
+
+// ParseSchedule parses "NAME:min_volume:bps:fixed_fee" entries (e.g. "GROWTH:100000:75:0.25").
+// Call Validate on the result before use.
+func ParseSchedule(entries []string) (Schedule, error) {
+	tiers := make([]Tier, 0, len(entries))
+	for _, entry := range entries {
+		parts := strings.Split(strings.TrimSpace(entry), ":")
+		if len(parts) != 4 {
+			return Schedule{}, fmt.Errorf("fee: tier %q must be NAME:min_volume:bps:fixed_fee", entry)
+		}
+		minVolume, err := money.Parse(strings.TrimSpace(parts[1]), "", amountScale)
+		if err != nil {
+			return Schedule{}, fmt.Errorf("fee: tier %q min volume: %w", entry, err)
+		}
+		bps, err := strconv.Atoi(strings.TrimSpace(parts[2]))
+		if err != nil {
+			return Schedule{}, fmt.Errorf("fee: tier %q percentage bps: %w", entry, err)
+		}
+		fixedFee, err := money.Parse(strings.TrimSpace(parts[3]), "", amountScale)
+		if err != nil {
+			return Schedule{}, fmt.Errorf("fee: tier %q fixed fee: %w", entry, err)
+		}
+		tiers = append(tiers, Tier{
+			Name:          strings.ToUpper(strings.TrimSpace(parts[0])),
+			MinVolume:     minVolume,
+			PercentageBps: bps,
+			FixedFee:      fixedFee,
+		})
+	}
+	return Schedule{Tiers: tiers}, nil
+}
+
+// Validate checks the schedule is usable: tiers start at 0 volume and increase strictly
+func (s Schedule) Validate() error {
+	if len(s.Tiers) == 0 {
+		return fmt.Errorf("fee: schedule has no tiers")
+	}
+	if !s.Tiers[0].MinVolume.IsZero() {
+		return fmt.Errorf("fee: first tier %s must start at volume 0, got %s", s.Tiers[0].Name, s.Tiers[0].MinVolume)
+	}
+	seen := make(map[string]bool, len(s.Tiers))
+	for i, tier := range s.Tiers {
+		if tier.Name == "" || len(tier.Name) > 32 {
+			return fmt.Errorf("fee: tier name must be 1-32 characters, got %q", tier.Name)
+		}
+		if seen[tier.Name] {
+			return fmt.Errorf("fee: duplicate tier %s", tier.Name)
+		}
+		seen[tier.Name] = true
+		if tier.PercentageBps < 0 || tier.PercentageBps > bpsDenominator {
+			return fmt.Errorf("fee: tier %s percentage must be between 0 and %d bps, got %d", tier.Name, bpsDenominator, tier.PercentageBps)
+		}
+		if tier.FixedFee.Sign() < 0 {
+			return fmt.Errorf("fee: tier %s fixed fee must not be negative, got %s", tier.Name, tier.FixedFee)
+		}
+		if i > 0 && tier.MinVolume.Cmp(s.Tiers[i-1].MinVolume) <= 0 {
+			return fmt.Errorf("fee: tier %s min volume %s is not above tier %s", tier.Name, tier.MinVolume, s.Tiers[i-1].Name)
+		}
+	}
+	return nil
+}
+
+// TierFor returns the highest tier whose MinVolume the volume reaches
+func (s Schedule) TierFor(volume money.Money) Tier {
+	tier := s.Tiers[0]
+	for _, t := range s.Tiers[1:] {
+		if volume.Cmp(t.MinVolume) < 0 {
+			break
+		}
+		tier = t
+	}
+	return tier
+}
+
+// Compute returns the fee of a payment of amount for a merchant with the trailing volume.
+// Why: 수수료는 올림(RoundUp) - 플랫폼이 덜 받는 방향으로 반올림하지 않음.
+// 수수료가 결제 금액을 넘으면 (소액 결제 + 고정 수수료) 고정 수수료를 깎아 결제 금액으로 제한
+func (s Schedule) Compute(amount, volume money.Money) Breakdown {
+	tier := s.TierFor(volume)
+	amount = amount.Rescale(amountScale, money.RoundDown)
+
+	percentageFee := amount.MulFrac(int64(tier.PercentageBps), bpsDenominator, money.RoundUp)
+	fixedFee := tier.FixedFee
+	if remaining := amount.Sub(percentageFee); fixedFee.Cmp(remaining) > 0 {
+		fixedFee = remaining
+	}
+
+	return Breakdown{
+		Tier:          tier,
+		Volume:        volume,
+		PercentageFee: percentageFee,
+		FixedFee:      fixedFee,
+		Amount:        percentageFee.Add(fixedFee),
+	}
+}
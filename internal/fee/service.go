@@ -0,0 +1,255 @@
+package fee
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"go.uber.org/zap"
+)
+
+// Config holds the fee schedule and the volume window of tier selection
+type Config struct {
+	Schedule Schedule
+	// VolumeWindow is the trailing period of captured volume that selects a merchant's tier
+	VolumeWindow time.Duration
+}
+
+// Service handles fee schedule and payment fee queries
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	config   Config
+	logger   *zap.Logger
+}
+
+// NewService creates a new fee service
+func NewService(txRunner *pkgdb.TxRunner, config Config, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Schedule returns the current fee schedule
+func (s *Service) Schedule() *ScheduleResponse {
+	return ToScheduleResponse(s.config.Schedule, s.config.VolumeWindow)
+}
+
+// Payment is a captured payment subject to the platform fee
+type Payment struct {
+	ID          uint64
+	SellerID    uint64
+	Amount      money.Money
+	TokenSymbol string
+	CapturedAt  time.Time
+}
+
+// Charge writes the CHARGE entry of a captured payment (false when it was already charged).
+// q may be bound to the caller's transaction.
+func (s *Service) Charge(ctx context.Context, q *db.Queries, p *Payment) (bool, error) {
+	// 등급 산정 거래액: 확정 시각 직전 VolumeWindow (해당 결제 제외) → 재실행해도 같은 등급
+	storedVolume, err := q.GetSellerCapturedVolume(ctx, db.GetSellerCapturedVolumeParams{
+		SellerID:     p.SellerID,
+		CapturedFrom: sql.NullTime{Time: p.CapturedAt.Add(-s.config.VolumeWindow), Valid: true},
+		CapturedTo:   sql.NullTime{Time: p.CapturedAt, Valid: true},
+	})
+	if err != nil {
+		return false, fmt.Errorf("get seller volume: %w", err)
+	}
+	volume, err := parseAmount(storedVolume)
+	if err != nil {
+		return false, fmt.Errorf("invalid seller volume %q: %w", storedVolume, err)
+	}
+
+	breakdown := s.config.Schedule.Compute(p.Amount, volume)
+	result, err := q.InsertFeeLedgerEntry(ctx, db.InsertFeeLedgerEntryParams{
+		PaymentID:     p.ID,
+		MerchantID:    p.SellerID,
+		EntryType:     db.FeeLedgerEntriesEntryTypeCHARGE,
+		Amount:        breakdown.Amount.String(),
+		TokenSymbol:   p.TokenSymbol,
+		Tier:          breakdown.Tier.Name,
+		MonthlyVolume: breakdown.Volume.String(),
+		PercentageBps: uint32(breakdown.Tier.PercentageBps),
+		PercentageFee: breakdown.PercentageFee.String(),
+		FixedFee:      breakdown.FixedFee.String(),
+	})
+	if err != nil {
+		return false, fmt.Errorf("insert fee ledger entry: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("insert fee ledger entry: %w", err)
+	}
+	return rows > 0, nil
+}
+
+// Reverse writes the REVERSAL entry of a refund and returns the reversed fee.
+// A partial refund reverses the charged fee in proportion to refundAmount / p.Amount (rounded down);
+// the final refund (final = the payment is fully refunded) reverses whatever is left.
+// Must be called within the transaction that records the refund.
+//
+// Why:
+// - 환불 시점에 아직 부과 전이면 먼저 부과 → 부과 job이 REFUNDED 결제를 건너뛰어도 수수료 원장 일관
+// - 부분 환불은 내림, 마지막 환불이 잔여분 전부 취소 → 반올림 오차가 누적되어도 CHARGE - REVERSAL 합계가 0으로 끝남
+func (s *Service) Reverse(ctx context.Context, q *db.Queries, p *Payment, refundID uint64, refundAmount money.Money, final bool) (money.Money, error) {
+	if _, err := s.Charge(ctx, q, p); err != nil {
+		return money.Money{}, err
+	}
+
+	entries, err := q.ListFeeLedgerEntriesByPayment(ctx, p.ID)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("list fee ledger entries: %w", err)
+	}
+
+	var charge *db.FeeLedgerEntry
+	reversedPercentage := money.Zero("", amountScale)
+	reversedFixed := money.Zero("", amountScale)
+	for i := range entries {
+		entry := &entries[i]
+		switch entry.EntryType {
+		case db.FeeLedgerEntriesEntryTypeCHARGE:
+			charge = entry
+		case db.FeeLedgerEntriesEntryTypeREVERSAL:
+			percentageFee, err := parseAmount(entry.PercentageFee)
+			if err != nil {
+				return money.Money{}, fmt.Errorf("entry %d percentage fee %q: %w", entry.ID, entry.PercentageFee, err)
+			}
+			fixedFee, err := parseAmount(entry.FixedFee)
+			if err != nil {
+				return money.Money{}, fmt.Errorf("entry %d fixed fee %q: %w", entry.ID, entry.FixedFee, err)
+			}
+			reversedPercentage = reversedPercentage.Add(percentageFee)
+			reversedFixed = reversedFixed.Add(fixedFee)
+		}
+	}
+	if charge == nil {
+		return money.Money{}, fmt.Errorf("payment %d has no fee charge", p.ID)
+	}
+
+	chargedPercentage, err := parseAmount(charge.PercentageFee)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("charge percentage fee %q: %w", charge.PercentageFee, err)
+	}
+	chargedFixed, err := parseAmount(charge.FixedFee)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("charge fixed fee %q: %w", charge.FixedFee, err)
+	}
+
+	remainingPercentage := chargedPercentage.Sub(reversedPercentage).NonNegative()
+	remainingFixed := chargedFixed.Sub(reversedFixed).NonNegative()
+	percentageFee, fixedFee := remainingPercentage, remainingFixed
+	if !final {
+		refundUnits := refundAmount.Rescale(amountScale, money.RoundDown).Units().Int64()
+		paymentUnits := p.Amount.Rescale(amountScale, money.RoundDown).Units().Int64()
+		percentageFee = minMoney(chargedPercentage.MulFrac(refundUnits, paymentUnits, money.RoundDown), remainingPercentage)
+		fixedFee = minMoney(chargedFixed.MulFrac(refundUnits, paymentUnits, money.RoundDown), remainingFixed)
+	}
+	amount := percentageFee.Add(fixedFee)
+
+	if _, err := q.InsertFeeLedgerEntry(ctx, db.InsertFeeLedgerEntryParams{
+		PaymentID:     p.ID,
+		MerchantID:    charge.MerchantID,
+		EntryType:     db.FeeLedgerEntriesEntryTypeREVERSAL,
+		Amount:        amount.String(),
+		TokenSymbol:   charge.TokenSymbol,
+		Tier:          charge.Tier,
+		MonthlyVolume: charge.MonthlyVolume,
+		PercentageBps: charge.PercentageBps,
+		PercentageFee: percentageFee.String(),
+		FixedFee:      fixedFee.String(),
+		RefundID:      refundID,
+	}); err != nil {
+		return money.Money{}, fmt.Errorf("insert fee reversal: %w", err)
+	}
+	return amount, nil
+}
+
+// PaymentBreakdown returns the fee applied to a payment, or nil when no fee has been charged yet
+// (payments are charged after capture by the fee job)
+func (s *Service) PaymentBreakdown(ctx context.Context, paymentID uint64) (*BreakdownResponse, error) {
+	entries, err := s.txRunner.Queries().ListFeeLedgerEntriesByPayment(ctx, paymentID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list fee ledger entries", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	breakdown, err := toBreakdownResponse(entries)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("invalid fee ledger entry",
+			zap.Uint64("payment_id", paymentID),
+			zap.Error(err),
+		)
+		return nil, errors.Internal("Invalid stored fee")
+	}
+	return breakdown, nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// toBreakdownResponse folds the CHARGE and REVERSAL entries of a payment (nil without a CHARGE)
+func toBreakdownResponse(entries []db.FeeLedgerEntry) (*BreakdownResponse, error) {
+	var result *BreakdownResponse
+	reversed := money.Zero("", amountScale)
+	for _, entry := range entries {
+		amount, err := parseAmount(entry.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("entry %d amount %q: %w", entry.ID, entry.Amount, err)
+		}
+
+		switch entry.EntryType {
+		case db.FeeLedgerEntriesEntryTypeCHARGE:
+			volume, err := parseAmount(entry.MonthlyVolume)
+			if err != nil {
+				return nil, fmt.Errorf("entry %d volume %q: %w", entry.ID, entry.MonthlyVolume, err)
+			}
+			percentageFee, err := parseAmount(entry.PercentageFee)
+			if err != nil {
+				return nil, fmt.Errorf("entry %d percentage fee %q: %w", entry.ID, entry.PercentageFee, err)
+			}
+			fixedFee, err := parseAmount(entry.FixedFee)
+			if err != nil {
+				return nil, fmt.Errorf("entry %d fixed fee %q: %w", entry.ID, entry.FixedFee, err)
+			}
+			result = &BreakdownResponse{
+				Tier:          entry.Tier,
+				MonthlyVolume: volume,
+				PercentageBps: entry.PercentageBps,
+				PercentageFee: percentageFee,
+				FixedFee:      fixedFee,
+				Amount:        amount,
+				AssessedAt:    entry.CreatedAt,
+			}
+		case db.FeeLedgerEntriesEntryTypeREVERSAL:
+			reversed = reversed.Add(amount)
+		}
+	}
+
+	if result == nil {
+		return nil, nil
+	}
+	result.ReversedAmount = reversed
+	return result, nil
+}
+
+// minMoney returns the smaller of a and b
+func minMoney(a, b money.Money) money.Money {
+	if a.Cmp(b) > 0 {
+		return b
+	}
+	return a
+}
+
+// parseAmount parses a stored DECIMAL(18,8) amount
+func parseAmount(value string) (money.Money, error) {
+	return money.Parse(value, "", amountScale)
+}
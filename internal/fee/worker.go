@@ -0,0 +1,122 @@
+package fee
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"go.uber.org/zap"
+)
+
+// WorkerConfig holds fee job settings
+type WorkerConfig struct {
+	// Interval is the period of the fee job
+	Interval time.Duration
+	// BatchSize is the number of payments charged per run
+	BatchSize int
+}
+
+// RunReport summarizes one fee run
+type RunReport struct {
+	Charged int
+	Failed  int
+}
+
+// Worker charges the platform fee of captured payments into the fee ledger.
+// Each payment is charged once (uk_fee_ledger_entry); the tier is chosen by the merchant's
+// captured volume in the VolumeWindow before the payment's capture.
+type Worker struct {
+	txRunner *pkgdb.TxRunner
+	service  *Service
+	config   WorkerConfig
+	logger   *zap.Logger
+}
+
+// NewWorker creates a new fee worker
+func NewWorker(txRunner *pkgdb.TxRunner, service *Service, config WorkerConfig, logger *zap.Logger) *Worker {
+	return &Worker{
+		txRunner: txRunner,
+		service:  service,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Run executes the fee job periodically until ctx is canceled
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	logctx.From(ctx, w.logger).Info("fee job started",
+		zap.Duration("interval", w.config.Interval),
+		zap.Int("tiers", len(w.service.config.Schedule.Tiers)),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logctx.From(ctx, w.logger).Info("fee job stopped")
+			return
+		case <-ticker.C:
+			report, err := w.RunOnce(ctx)
+			if err != nil {
+				logctx.From(ctx, w.logger).Error("fee run failed", zap.Error(err))
+				continue
+			}
+			if report.Charged+report.Failed > 0 {
+				logctx.From(ctx, w.logger).Info("fee run completed",
+					zap.Int("charged", report.Charged),
+					zap.Int("failed", report.Failed),
+				)
+			}
+		}
+	}
+}
+
+// RunOnce charges one batch of captured payments without a fee.
+// A failing payment is logged and counted; it is retried on the next run.
+func (w *Worker) RunOnce(ctx context.Context) (*RunReport, error) {
+	report := &RunReport{}
+
+	payments, err := w.txRunner.Queries().ListUnassessedCapturedPayments(ctx, int32(w.config.BatchSize))
+	if err != nil {
+		return report, fmt.Errorf("list unassessed payments: %w", err)
+	}
+
+	for i := range payments {
+		if ctx.Err() != nil {
+			return report, nil
+		}
+		charged, err := w.charge(ctx, &payments[i])
+		if err != nil {
+			report.Failed++
+			logctx.From(ctx, w.logger).Error("fee charge failed",
+				zap.Uint64("payment_id", payments[i].ID),
+				zap.Error(err),
+			)
+			continue
+		}
+		if charged {
+			report.Charged++
+		}
+	}
+	return report, nil
+}
+
+// charge writes the CHARGE entry of a captured payment (false when another run charged it first)
+func (w *Worker) charge(ctx context.Context, p *db.ListUnassessedCapturedPaymentsRow) (bool, error) {
+	amount, err := parseAmount(p.Amount)
+	if err != nil {
+		return false, fmt.Errorf("invalid payment amount %q: %w", p.Amount, err)
+	}
+	return w.service.Charge(ctx, w.txRunner.Queries(), &Payment{
+		ID:          p.ID,
+		SellerID:    p.SellerID,
+		Amount:      amount,
+		TokenSymbol: p.TokenSymbol,
+		CapturedAt:  p.CapturedAt.Time,
+	})
+}
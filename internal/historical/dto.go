@@ -0,0 +1,139 @@
+package historical
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// Rejection reasons of transfers that are not imported
+const (
+	RejectInvalidTxHash     = "INVALID_TX_HASH"
+	RejectTxNotFound        = "TX_NOT_FOUND"
+	RejectTxFailed          = "TX_FAILED"
+	RejectNoMerchantWallet  = "NO_MERCHANT_TRANSFER"
+	RejectInternalTransfer  = "INTERNAL_TRANSFER"
+	RejectZeroAmount        = "ZERO_AMOUNT"
+	RejectAmountPrecision   = "AMOUNT_PRECISION"
+	RejectDuplicateInImport = "DUPLICATE_TX_HASH"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// ImportRequest represents an admin historical transfer import.
+// Provide either tx_hashes, or from_block/to_block to scan the merchant's wallets.
+type ImportRequest struct {
+	MerchantID string   `json:"merchant_id" binding:"required,uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TxHashes   []string `json:"tx_hashes,omitempty" binding:"omitempty,max=500" example:"0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"`
+	FromBlock  *uint64  `json:"from_block,omitempty" example:"18000000"`
+	ToBlock    *uint64  `json:"to_block,omitempty" example:"18100000"`
+}
+
+// ListImportsRequest represents query parameters for a merchant's import history
+type ListImportsRequest struct {
+	MerchantID string `form:"merchant_id" binding:"required,uuid"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// EntryResponse represents an imported historical ledger entry
+type EntryResponse struct {
+	EntryType           string      `json:"entry_type" example:"CREDIT"`
+	Amount              money.Money `json:"amount" swaggertype:"string" example:"1000.00000000"`
+	TxHash              string      `json:"tx_hash" example:"0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"`
+	LogIndex            uint        `json:"log_index" example:"3"`
+	BlockNumber         uint64      `json:"block_number" example:"18000042"`
+	BlockTime           time.Time   `json:"block_time"`
+	WalletAddress       string      `json:"wallet_address" example:"0x742d35cc6634c0532925a3b844bc454e4438f44e"`
+	CounterpartyAddress string      `json:"counterparty_address" example:"0x8ba1f109551bd432803012645ac136ddd64dba72"`
+	// Duplicate is set when the transfer was already recorded by an earlier import
+	Duplicate bool `json:"duplicate,omitempty" example:"false"`
+}
+
+// RejectedTransfer represents a transaction or transfer that was not imported
+type RejectedTransfer struct {
+	TxHash   string `json:"tx_hash" example:"0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"`
+	LogIndex *uint  `json:"log_index,omitempty" example:"3"`
+	Reason   string `json:"reason" example:"TX_NOT_FOUND"`
+}
+
+// OpeningBalanceResponse summarizes every historical entry of the merchant account
+// NOTE: 플랫폼 잔액(accounts.balance)과 별개 - 이전 전 온체인 활동의 순액
+type OpeningBalanceResponse struct {
+	Credits    money.Money `json:"credits" swaggertype:"string" example:"15000.00000000"`
+	Debits     money.Money `json:"debits" swaggertype:"string" example:"4000.00000000"`
+	Net        money.Money `json:"net" swaggertype:"string" example:"11000.00000000"`
+	EntryCount int         `json:"entry_count" example:"42"`
+	FirstAt    *time.Time  `json:"first_at,omitempty"`
+	LastAt     *time.Time  `json:"last_at,omitempty"`
+}
+
+// ImportResponse represents the result of a historical import
+type ImportResponse struct {
+	ImportID   string  `json:"import_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	MerchantID string  `json:"merchant_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Source     string  `json:"source" example:"TX_HASHES"`
+	ChainID    int64   `json:"chain_id" example:"1"`
+	FromBlock  *uint64 `json:"from_block,omitempty" example:"18000000"`
+	ToBlock    *uint64 `json:"to_block,omitempty" example:"18100000"`
+	Imported   int     `json:"imported" example:"40"`
+	Duplicates int     `json:"duplicates" example:"2"`
+	Rejected   int     `json:"rejected" example:"1"`
+	// Entries lists every verified transfer of the run, including duplicates of earlier imports
+	Entries        []EntryResponse        `json:"entries"`
+	RejectedItems  []RejectedTransfer     `json:"rejected_items"`
+	OpeningBalance OpeningBalanceResponse `json:"opening_balance"`
+	CreatedAt      time.Time              `json:"created_at"`
+}
+
+// ImportSummaryResponse represents a past import run
+type ImportSummaryResponse struct {
+	ImportID   string    `json:"import_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Source     string    `json:"source" example:"ADDRESS_SCAN"`
+	FromBlock  *uint64   `json:"from_block,omitempty" example:"18000000"`
+	ToBlock    *uint64   `json:"to_block,omitempty" example:"18100000"`
+	Imported   int       `json:"imported" example:"40"`
+	Duplicates int       `json:"duplicates" example:"2"`
+	Rejected   int       `json:"rejected" example:"1"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ListImportsResponse represents a merchant's import history with its opening balance
+type ListImportsResponse struct {
+	MerchantID     string                  `json:"merchant_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Imports        []ImportSummaryResponse `json:"imports"`
+	OpeningBalance OpeningBalanceResponse  `json:"opening_balance"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToImportSummaryResponse converts db.HistoricalImport to ImportSummaryResponse
+func ToImportSummaryResponse(run *db.HistoricalImport) ImportSummaryResponse {
+	return ImportSummaryResponse{
+		ImportID:   run.ExternalID,
+		Source:     string(run.Source),
+		FromBlock:  blockPtr(run.FromBlock),
+		ToBlock:    blockPtr(run.ToBlock),
+		Imported:   int(run.ImportedCount),
+		Duplicates: int(run.DuplicateCount),
+		Rejected:   int(run.RejectedCount),
+		CreatedAt:  run.CreatedAt,
+	}
+}
+
+// blockPtr converts a nullable block number
+func blockPtr(n sql.NullInt64) *uint64 {
+	if !n.Valid {
+		return nil
+	}
+	block := uint64(n.Int64)
+	return &block
+}
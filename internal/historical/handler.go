@@ -0,0 +1,194 @@
+package historical
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// maxCSVSize bounds an uploaded tx hash CSV (500 hashes fit well within)
+const maxCSVSize = 1 << 20
+
+// Handler handles HTTP requests for historical transfer imports
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new historical import handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers historical import routes on the router group (admin only)
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	imports := rg.Group("/admin/historical-imports", middleware.RequireRoles(middleware.RoleAdmin))
+	{
+		imports.POST("", h.Import)
+		imports.POST("/csv", h.ImportCSV)
+		imports.GET("", h.ListImports)
+	}
+}
+
+// Import godoc
+// @Summary Import historical on-chain transfers
+// @Description Verify a migrated merchant's historical settlement token transfers on-chain and record them as
+// @Description historical ledger entries - Admin only. Provide tx_hashes (max 500), or from_block/to_block
+// @Description (max 100000 blocks) to scan the merchant's verified wallets on the platform chain.
+// @Description Historical entries do not change the platform balance; they form the merchant's opening balance.
+// @Description Already imported transfers are reported as duplicates, so runs may overlap.
+// @Tags historical-imports
+// @Accept json
+// @Produce json
+// @Param request body ImportRequest true "Import source"
+// @Success 201 {object} middleware.SuccessResponse{data=ImportResponse} "Import result"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid request or merchant without verified wallet"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Merchant or merchant account not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Failure 503 {object} middleware.ErrorResponse "Chain lookup disabled or failed"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/historical-imports [post]
+func (h *Handler) Import(c *gin.Context) {
+	var req ImportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.Import(c.Request.Context(), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// ImportCSV godoc
+// @Summary Import historical transfers from a CSV of tx hashes
+// @Description Same as POST /admin/historical-imports with tx_hashes read from the first column of an uploaded CSV
+// @Description (max 500 rows, an optional header row is skipped) - Admin only.
+// @Tags historical-imports
+// @Accept multipart/form-data
+// @Produce json
+// @Param merchant_id formData string true "Merchant user external ID (UUID)"
+// @Param file formData file true "CSV file with tx hashes in the first column"
+// @Success 201 {object} middleware.SuccessResponse{data=ImportResponse} "Import result"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid CSV or request"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Merchant or merchant account not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Failure 503 {object} middleware.ErrorResponse "Chain lookup disabled or failed"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/historical-imports/csv [post]
+func (h *Handler) ImportCSV(c *gin.Context) {
+	merchantID := c.PostForm("merchant_id")
+	if _, err := uuid.Parse(merchantID); err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Invalid merchant_id"))
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		middleware.RespondError(c, errors.InvalidInput("CSV file is required"))
+		return
+	}
+	if file.Size > maxCSVSize {
+		middleware.RespondError(c, errors.InvalidInput("CSV file too large (max 1MB)"))
+		return
+	}
+	f, err := file.Open()
+	if err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Cannot read CSV file"))
+		return
+	}
+	defer f.Close()
+
+	txHashes, err := readTxHashes(f)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	req := ImportRequest{MerchantID: merchantID, TxHashes: txHashes}
+	result, err := h.service.Import(c.Request.Context(), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// ListImports godoc
+// @Summary List historical imports of a merchant
+// @Description Get a merchant's import runs (newest first) and the opening balance of all imported entries - Admin only
+// @Tags historical-imports
+// @Produce json
+// @Param merchant_id query string true "Merchant user external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=ListImportsResponse} "Import history"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid merchant_id"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Merchant not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/historical-imports [get]
+func (h *Handler) ListImports(c *gin.Context) {
+	var req ListImportsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListImports(c.Request.Context(), req.MerchantID)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// readTxHashes reads tx hashes from the first CSV column, skipping blank rows and a header row
+func readTxHashes(r io.Reader) ([]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var txHashes []string
+	for row := 0; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.InvalidInput("Invalid CSV: " + err.Error())
+		}
+
+		value := strings.TrimSpace(record[0])
+		if value == "" || (row == 0 && !strings.HasPrefix(value, "0x")) {
+			continue // 빈 행 / 헤더 행 (e.g. "tx_hash")
+		}
+		txHashes = append(txHashes, value)
+		if len(txHashes) > maxTxHashes {
+			return nil, errors.InvalidInput("Too many tx_hashes (max 500)")
+		}
+	}
+
+	if len(txHashes) == 0 {
+		return nil, errors.InvalidInput("CSV contains no tx hashes")
+	}
+	return txHashes, nil
+}
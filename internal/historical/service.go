@@ -0,0 +1,499 @@
+package historical
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// amountScale is the scale of ledger amounts (DECIMAL(18,8))
+	amountScale = 8
+
+	// maxTxHashes bounds one tx hash import (mirrors the binding rule max=500)
+	maxTxHashes = 500
+	// maxScanBlocks bounds the block range of one address scan
+	maxScanBlocks = 100_000
+)
+
+// Audit log identifiers
+const (
+	actionImportCompleted = "HISTORICAL_IMPORT_COMPLETED"
+	resourceUser          = "USER"
+)
+
+// Service imports merchants' historical on-chain transfers (admin only)
+type Service struct {
+	txRunner    *pkgdb.TxRunner
+	chainClient chain.Client
+	logger      *zap.Logger
+}
+
+// NewService creates a new historical import service
+// chainClient may be nil when no chain is configured (imports disabled)
+func NewService(txRunner *pkgdb.TxRunner, chainClient chain.Client, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner:    txRunner,
+		chainClient: chainClient,
+		logger:      logger,
+	}
+}
+
+// Import verifies a merchant's historical settlement token transfers on-chain and records them
+// as historical ledger entries. Transfers are read from the given transactions, or found by scanning
+// the merchant's verified wallets on the platform chain over a block range.
+// Re-importing a transfer is a no-op (reported as duplicate), so runs can overlap safely.
+// Why: 이전(migration) 가맹점의 기초 잔액과 리포트가 플랫폼 도입 시점에서 끊기지 않도록
+func (s *Service) Import(ctx context.Context, req *ImportRequest, actor audit.Actor) (*ImportResponse, error) {
+	if s.chainClient == nil {
+		return nil, errors.ChainError("On-chain import is not enabled")
+	}
+
+	source, err := importSource(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. Resolve merchant, settlement account and wallets
+	q := s.txRunner.Queries()
+	merchant, err := q.GetUserByExternalID(ctx, sql.NullString{String: req.MerchantID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Merchant")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get merchant", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	account, err := q.GetAccountByOwnerID(ctx, sql.NullInt64{Int64: int64(merchant.ID), Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Merchant account")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get merchant account", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	wallets, err := q.ListWalletsByUserExternalID(ctx, db.ListWalletsByUserExternalIDParams{
+		UserExternalID: sql.NullString{String: req.MerchantID, Valid: true},
+		ChainID:        sql.NullInt64{Int64: s.chainClient.ChainID(), Valid: true},
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list merchant wallets", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	// 소유가 확인된(서명/증명) 지갑의 전송만 가맹점 이력으로 인정
+	owned := make(map[string]bool)
+	for _, w := range wallets {
+		if w.VerificationLevel != db.WalletsVerificationLevelNONE {
+			owned[strings.ToLower(w.Address)] = true
+		}
+	}
+	if len(owned) == 0 {
+		return nil, errors.InvalidInput("Merchant has no verified wallet on the platform chain")
+	}
+
+	// 2. Read transfers from the chain (트랜잭션 밖에서 - RPC 지연 동안 DB 커넥션 점유 방지)
+	var (
+		transfers []chain.TokenTransfer
+		rejected  []RejectedTransfer
+	)
+	if source == db.HistoricalImportsSourceTXHASHES {
+		transfers, rejected, err = s.transfersInTxs(ctx, req.TxHashes, owned)
+	} else {
+		transfers, err = s.transfersOfWallets(ctx, owned, *req.FromBlock, *req.ToBlock)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Classify against merchant wallets
+	decimals := s.chainClient.TokenDecimals()
+	entries := make([]db.InsertHistoricalLedgerEntryParams, 0, len(transfers))
+	for _, t := range transfers {
+		entry, reason := toEntry(&t, owned, decimals)
+		if reason != "" {
+			index := t.LogIndex
+			rejected = append(rejected, RejectedTransfer{TxHash: t.TxHash, LogIndex: &index, Reason: reason})
+			continue
+		}
+		entry.AccountID = account.ID
+		entry.ChainID = uint64(s.chainClient.ChainID())
+		entries = append(entries, entry)
+	}
+
+	// 4. Record run + entries + audit atomically
+	return pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*ImportResponse, error) {
+		importExternalID := uuid.New().String()
+		result, err := q.CreateHistoricalImport(ctx, db.CreateHistoricalImportParams{
+			ExternalID: importExternalID,
+			UserID:     merchant.ID,
+			AccountID:  account.ID,
+			Source:     source,
+			FromBlock:  nullBlock(req.FromBlock),
+			ToBlock:    nullBlock(req.ToBlock),
+			CreatedBy:  sql.NullInt64{Int64: int64(actor.ID), Valid: actor.ID != 0},
+		})
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to create historical import", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		importID, err := result.LastInsertId()
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+
+		response := &ImportResponse{
+			ImportID:      importExternalID,
+			MerchantID:    req.MerchantID,
+			Source:        string(source),
+			ChainID:       s.chainClient.ChainID(),
+			FromBlock:     req.FromBlock,
+			ToBlock:       req.ToBlock,
+			Rejected:      len(rejected),
+			Entries:       make([]EntryResponse, 0, len(entries)),
+			RejectedItems: rejected,
+			CreatedAt:     time.Now().UTC(),
+		}
+		if response.RejectedItems == nil {
+			response.RejectedItems = make([]RejectedTransfer, 0)
+		}
+
+		for _, entry := range entries {
+			entry.ImportID = uint64(importID)
+			result, err := q.InsertHistoricalLedgerEntry(ctx, entry)
+			if err != nil {
+				logctx.From(ctx, s.logger).Error("failed to insert historical ledger entry",
+					zap.String("tx_hash", entry.TxHash),
+					zap.Error(err),
+				)
+				return nil, errors.DBError(err)
+			}
+			// INSERT IGNORE: 이전 import에서 이미 기록된 전송
+			affected, err := result.RowsAffected()
+			if err != nil {
+				return nil, errors.DBError(err)
+			}
+			duplicate := affected == 0
+			if duplicate {
+				response.Duplicates++
+			} else {
+				response.Imported++
+			}
+			response.Entries = append(response.Entries, toEntryResponse(&entry, duplicate))
+		}
+
+		if err := q.UpdateHistoricalImportCounts(ctx, db.UpdateHistoricalImportCountsParams{
+			ImportedCount:  uint32(response.Imported),
+			DuplicateCount: uint32(response.Duplicates),
+			RejectedCount:  uint32(response.Rejected),
+			ID:             uint64(importID),
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to update historical import counts", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		opening, err := s.openingBalance(ctx, q, account.ID)
+		if err != nil {
+			return nil, err
+		}
+		response.OpeningBalance = *opening
+
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionImportCompleted,
+			ResourceType: resourceUser,
+			ResourceID:   merchant.ID,
+			NewValue: map[string]any{
+				"import_id":       importExternalID,
+				"source":          source,
+				"from_block":      req.FromBlock,
+				"to_block":        req.ToBlock,
+				"tx_hashes":       len(req.TxHashes),
+				"imported":        response.Imported,
+				"duplicates":      response.Duplicates,
+				"rejected":        response.Rejected,
+				"opening_balance": opening.Net,
+			},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		logctx.From(ctx, s.logger).Info("historical import completed",
+			zap.String("import_external_id", importExternalID),
+			zap.String("merchant_external_id", req.MerchantID),
+			zap.Int("imported", response.Imported),
+			zap.Int("duplicates", response.Duplicates),
+			zap.Int("rejected", response.Rejected),
+			zap.String("request_id", actor.RequestID),
+		)
+
+		return response, nil
+	})
+}
+
+// ListImports returns a merchant's import runs (newest first) and the resulting opening balance
+func (s *Service) ListImports(ctx context.Context, merchantExternalID string) (*ListImportsResponse, error) {
+	q := s.txRunner.Queries()
+	merchant, err := q.GetUserByExternalID(ctx, sql.NullString{String: merchantExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Merchant")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get merchant", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	runs, err := q.ListHistoricalImportsByUser(ctx, merchant.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list historical imports", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	response := &ListImportsResponse{
+		MerchantID: merchantExternalID,
+		Imports:    make([]ImportSummaryResponse, 0, len(runs)),
+	}
+	for i := range runs {
+		response.Imports = append(response.Imports, ToImportSummaryResponse(&runs[i]))
+	}
+
+	opening, err := summarize(nil)
+	if len(runs) > 0 {
+		if opening, err = s.openingBalance(ctx, q, runs[0].AccountID); err != nil {
+			return nil, err
+		}
+	}
+	response.OpeningBalance = *opening
+	return response, nil
+}
+
+// transfersInTxs reads the transfers of each transaction.
+// Transactions that cannot be verified, or move no token to/from the merchant, are rejected.
+func (s *Service) transfersInTxs(ctx context.Context, txHashes []string, owned map[string]bool) ([]chain.TokenTransfer, []RejectedTransfer, error) {
+	var (
+		transfers []chain.TokenTransfer
+		rejected  []RejectedTransfer
+	)
+	seen := make(map[string]bool, len(txHashes))
+	for _, raw := range txHashes {
+		txHash := strings.ToLower(strings.TrimSpace(raw))
+		if seen[txHash] {
+			rejected = append(rejected, RejectedTransfer{TxHash: raw, Reason: RejectDuplicateInImport})
+			continue
+		}
+		seen[txHash] = true
+
+		found, err := s.chainClient.TransfersInTx(ctx, txHash)
+		switch {
+		case stderrors.Is(err, chain.ErrInvalidTxHash):
+			rejected = append(rejected, RejectedTransfer{TxHash: raw, Reason: RejectInvalidTxHash})
+			continue
+		case stderrors.Is(err, chain.ErrTxNotFound):
+			rejected = append(rejected, RejectedTransfer{TxHash: txHash, Reason: RejectTxNotFound})
+			continue
+		case stderrors.Is(err, chain.ErrTxFailed):
+			rejected = append(rejected, RejectedTransfer{TxHash: txHash, Reason: RejectTxFailed})
+			continue
+		case err != nil:
+			logctx.From(ctx, s.logger).Error("failed to read transaction transfers",
+				zap.String("tx_hash", txHash),
+				zap.Error(err),
+			)
+			return nil, nil, errors.ChainError("Failed to verify transactions on-chain")
+		}
+
+		matched := false
+		for _, t := range found {
+			if owned[t.From] || owned[t.To] {
+				transfers = append(transfers, t)
+				matched = true
+			}
+		}
+		if !matched {
+			rejected = append(rejected, RejectedTransfer{TxHash: txHash, Reason: RejectNoMerchantWallet})
+		}
+	}
+	return transfers, rejected, nil
+}
+
+// transfersOfWallets scans the merchant's wallets over [fromBlock, toBlock]
+func (s *Service) transfersOfWallets(ctx context.Context, owned map[string]bool, fromBlock, toBlock uint64) ([]chain.TokenTransfer, error) {
+	latest, err := s.chainClient.LatestBlock(ctx)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to get latest block", zap.Error(err))
+		return nil, errors.ChainError("Failed to get latest block")
+	}
+	if toBlock > latest {
+		return nil, errors.InvalidInput("to_block is beyond the latest block")
+	}
+
+	addresses := make([]string, 0, len(owned))
+	for address := range owned {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	// 가맹점 지갑 간 전송은 양쪽 스캔에 모두 잡힘 → (tx, log index)로 중복 제거
+	var transfers []chain.TokenTransfer
+	seen := make(map[string]bool)
+	for _, address := range addresses {
+		found, err := s.chainClient.TransfersOfAddress(ctx, address, fromBlock, toBlock)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to scan wallet transfers",
+				zap.String("address", address),
+				zap.Error(err),
+			)
+			return nil, errors.ChainError("Failed to scan wallet transfers on-chain")
+		}
+		for _, t := range found {
+			key := fmt.Sprintf("%s:%d", t.TxHash, t.LogIndex)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			transfers = append(transfers, t)
+		}
+	}
+	return transfers, nil
+}
+
+// openingBalance sums every historical entry of the account
+func (s *Service) openingBalance(ctx context.Context, q *db.Queries, accountID uint64) (*OpeningBalanceResponse, error) {
+	entries, err := q.ListHistoricalLedgerEntriesByAccount(ctx, accountID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list historical ledger entries", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	summary, err := summarize(entries)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("invalid stored amount",
+			zap.Uint64("account_id", accountID),
+			zap.Error(err),
+		)
+		return nil, errors.Internal("Invalid historical entry amount")
+	}
+	return summary, nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// importSource validates that exactly one import mode is requested
+func importSource(req *ImportRequest) (db.HistoricalImportsSource, error) {
+	scan := req.FromBlock != nil || req.ToBlock != nil
+	switch {
+	case len(req.TxHashes) > 0 && scan:
+		return "", errors.InvalidInput("Provide either tx_hashes or from_block/to_block, not both")
+	case len(req.TxHashes) > maxTxHashes:
+		return "", errors.InvalidInput("Too many tx_hashes (max 500)")
+	case len(req.TxHashes) > 0:
+		return db.HistoricalImportsSourceTXHASHES, nil
+	case req.FromBlock == nil || req.ToBlock == nil:
+		return "", errors.InvalidInput("Provide tx_hashes, or both from_block and to_block")
+	case *req.FromBlock > *req.ToBlock:
+		return "", errors.InvalidInput("from_block must not be after to_block")
+	case *req.ToBlock-*req.FromBlock+1 > maxScanBlocks:
+		return "", errors.InvalidInput("Block range too large (max 100000 blocks)")
+	default:
+		return db.HistoricalImportsSourceADDRESSSCAN, nil
+	}
+}
+
+// toEntry maps a transfer to a ledger entry of the merchant account, or returns a rejection reason
+func toEntry(t *chain.TokenTransfer, owned map[string]bool, decimals int) (db.InsertHistoricalLedgerEntryParams, string) {
+	entry := db.InsertHistoricalLedgerEntryParams{
+		TxHash:      strings.ToLower(t.TxHash),
+		LogIndex:    uint32(t.LogIndex),
+		BlockNumber: t.BlockNumber,
+		BlockTime:   t.BlockTime,
+	}
+
+	switch {
+	case owned[t.From] && owned[t.To]:
+		// 가맹점 지갑 간 이동은 순액에 영향 없음
+		return entry, RejectInternalTransfer
+	case owned[t.To]:
+		entry.EntryType = db.HistoricalLedgerEntriesEntryTypeCREDIT
+		entry.WalletAddress, entry.CounterpartyAddress = t.To, t.From
+	default:
+		entry.EntryType = db.HistoricalLedgerEntriesEntryTypeDEBIT
+		entry.WalletAddress, entry.CounterpartyAddress = t.From, t.To
+	}
+
+	if t.Amount.Sign() <= 0 {
+		return entry, RejectZeroAmount
+	}
+	// 토큰 decimals → 원장 scale(8) 변환, 8자리를 넘는 정밀도는 손실 없이 표현 불가
+	amount := money.New(t.Amount, "", decimals)
+	ledgerAmount := amount.Rescale(amountScale, money.RoundDown)
+	if ledgerAmount.Cmp(amount) != 0 {
+		return entry, RejectAmountPrecision
+	}
+	entry.Amount = ledgerAmount.String()
+	return entry, ""
+}
+
+// toEntryResponse converts a recorded entry to EntryResponse
+func toEntryResponse(entry *db.InsertHistoricalLedgerEntryParams, duplicate bool) EntryResponse {
+	return EntryResponse{
+		EntryType:           string(entry.EntryType),
+		Amount:              money.MustParse(entry.Amount, "", amountScale), // formatted by toEntry
+		TxHash:              entry.TxHash,
+		LogIndex:            uint(entry.LogIndex),
+		BlockNumber:         entry.BlockNumber,
+		BlockTime:           entry.BlockTime,
+		WalletAddress:       entry.WalletAddress,
+		CounterpartyAddress: entry.CounterpartyAddress,
+		Duplicate:           duplicate,
+	}
+}
+
+// summarize totals historical entries (entries ordered by block time)
+func summarize(entries []db.HistoricalLedgerEntry) (*OpeningBalanceResponse, error) {
+	credits, debits := money.Zero("", amountScale), money.Zero("", amountScale)
+	summary := &OpeningBalanceResponse{EntryCount: len(entries)}
+	for i := range entries {
+		amount, err := money.Parse(entries[i].Amount, "", amountScale)
+		if err != nil {
+			return nil, fmt.Errorf("historical entry %d: %w", entries[i].ID, err)
+		}
+		if entries[i].EntryType == db.HistoricalLedgerEntriesEntryTypeCREDIT {
+			credits = credits.Add(amount)
+		} else {
+			debits = debits.Add(amount)
+		}
+	}
+	if len(entries) > 0 {
+		summary.FirstAt = &entries[0].BlockTime
+		summary.LastAt = &entries[len(entries)-1].BlockTime
+	}
+
+	summary.Credits = credits
+	summary.Debits = debits
+	summary.Net = credits.Sub(debits)
+	return summary, nil
+}
+
+// nullBlock converts an optional block number
+func nullBlock(n *uint64) sql.NullInt64 {
+	if n == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: int64(*n), Valid: true}
+}
@@ -0,0 +1,384 @@
+package invoice
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/docnumber"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/ledger"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// creditNoteSplit is the effect of a credit note on its invoice
+type creditNoteSplit struct {
+	// applied is deducted from the balance due (and from the credit terms outstanding)
+	applied money.Money
+	// refunded exceeds the balance due and returns payments to the buyer's account
+	refunded money.Money
+}
+
+// CreateCreditNote credits an issued invoice (seller or admin) in full (the whole uncredited amount) or per line
+// (quantities of invoice lines, at most the uncredited quantity of each). The invoice amount and lines never change;
+// the credit is deducted from the balance due first, and any excess over it returns payments to the buyer.
+//
+// Why:
+//   - 청구서는 발행 후 불변 → 정정/반품은 대변 메모로만 (원본 금액·항목 + 대변 내역으로 감사 추적)
+//   - 잔액 차감분은 외상 미결제 잔액도 감소 → 가용 한도 복원 (결제와 동일)
+//   - 잔액 초과분은 이미 받은 결제의 반환 → 판매자 계정 DEBIT / 구매자 계정 CREDIT 분개 (환불과 동일 방향)
+//   - 잔액이 0이 되면 PAID로 종료 (결제 + 대변으로 정산 완료)
+func (s *Service) CreateCreditNote(ctx context.Context, externalID string, req *CreateCreditNoteRequest, access Access, actor audit.Actor) (*InvoiceResponse, error) {
+	detail, err := s.getSellerInvoice(ctx, externalID, access, "Only the seller can credit the invoice")
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[uint32]bool, len(req.Lines))
+	for _, line := range req.Lines {
+		if seen[line.LineNo] {
+			return nil, errors.InvalidInput("Duplicate line_no " + strconv.FormatUint(uint64(line.LineNo), 10))
+		}
+		seen[line.LineNo] = true
+	}
+	memo := strings.TrimSpace(req.Memo)
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		inv, err := lockInvoice(ctx, q, detail.ID, detail.OrderID, detail.CreditTermsID)
+		if err != nil {
+			return err
+		}
+		if !isOpen(inv.Status) && inv.Status != db.InvoicesStatusPAID {
+			return errors.Conflict("Only issued invoices can be credited")
+		}
+
+		amount, err := parseStored(inv.Amount)
+		if err != nil {
+			return err
+		}
+		credited, err := parseStored(inv.CreditedAmount)
+		if err != nil {
+			return err
+		}
+		creditable := amount.Sub(credited)
+		if creditable.Sign() <= 0 {
+			return errors.Conflict("Invoice is fully credited")
+		}
+
+		total := creditable
+		var lines []db.CreateCreditNoteLineParams
+		if len(req.Lines) > 0 {
+			lines, total, err = creditNoteLines(ctx, q, inv.ID, req.Lines)
+			if err != nil {
+				return err
+			}
+			if total.Cmp(creditable) > 0 {
+				return errors.InvalidInput("Credit exceeds the uncredited amount of " + creditable.String())
+			}
+		}
+		if total.Sign() <= 0 {
+			return errors.InvalidInput("Credit amount must be positive")
+		}
+
+		balance, err := balanceDue(&inv)
+		if err != nil {
+			return err
+		}
+		split := creditNoteSplit{applied: total, refunded: money.Zero("", amountScale)}
+		if total.Cmp(balance) > 0 {
+			split.applied = balance.NonNegative()
+			split.refunded = total.Sub(split.applied)
+		}
+
+		creditNoteID, number, err := createCreditNote(ctx, q, &inv, db.CreditNotesReason(req.Reason), total, split, memo, actor)
+		if err != nil {
+			return err
+		}
+		for i := range lines {
+			lines[i].CreditNoteID = creditNoteID
+			if err := q.CreateCreditNoteLine(ctx, lines[i]); err != nil {
+				return fmt.Errorf("create credit note line: %w", err)
+			}
+		}
+
+		if err := q.AddInvoiceCredit(ctx, db.AddInvoiceCreditParams{
+			CreditedAmount: total.String(),
+			RefundedAmount: split.refunded.String(),
+			ID:             inv.ID,
+		}); err != nil {
+			return fmt.Errorf("add invoice credit: %w", err)
+		}
+		if split.applied.Sign() > 0 && inv.CreditTermsID.Valid {
+			if err := q.AddCreditTermsOutstanding(ctx, db.AddCreditTermsOutstandingParams{
+				OutstandingAmount: split.applied.Neg().String(),
+				ID:                uint64(inv.CreditTermsID.Int64),
+			}); err != nil {
+				return fmt.Errorf("restore credit: %w", err)
+			}
+		}
+		if split.refunded.Sign() > 0 {
+			if err := s.refundCredit(ctx, q, &inv, creditNoteID, number, split.refunded); err != nil {
+				return err
+			}
+		}
+		if isOpen(inv.Status) && split.applied.Cmp(balance) == 0 {
+			if err := settle(ctx, q, &inv); err != nil {
+				return err
+			}
+		}
+
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionInvoiceCredited,
+			ResourceType: resourceInvoice,
+			ResourceID:   inv.ID,
+			OldValue: map[string]any{
+				"status":          string(inv.Status),
+				"credited_amount": inv.CreditedAmount,
+				"refunded_amount": inv.RefundedAmount,
+			},
+			NewValue: map[string]any{
+				"credit_note_number": number,
+				"reason":             req.Reason,
+				"amount":             total.String(),
+				"applied_amount":     split.applied.String(),
+				"refunded_amount":    split.refunded.String(),
+				"lines":              len(lines),
+			},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to create credit note", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetInvoice(ctx, externalID, access)
+}
+
+// creditNoteLines prices the requested lines at their invoice unit price plus their share of the line's tax and checks
+// each against the quantity not yet credited; returns the lines (credit note ID unset) and their total.
+//
+// Why:
+//   - 부분 반품 세금 = 항목 세금 × 수량 / 항목 수량 (내림), 마지막 잔량은 남은 세금 전액 → 누적 환급 세금 = 항목 세금
+func creditNoteLines(ctx context.Context, q *db.Queries, invoiceID uint64, requested []CreditNoteLineRequest) ([]db.CreateCreditNoteLineParams, money.Money, error) {
+	items, err := q.ListInvoiceItems(ctx, invoiceID)
+	if err != nil {
+		return nil, money.Money{}, fmt.Errorf("list invoice items: %w", err)
+	}
+	creditedLines, err := q.ListCreditNoteLinesByInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, money.Money{}, fmt.Errorf("list credited lines: %w", err)
+	}
+	taxLines, err := q.ListTaxLines(ctx, db.ListTaxLinesParams{
+		DocumentType: db.TaxLinesDocumentTypeINVOICE,
+		DocumentID:   invoiceID,
+	})
+	if err != nil {
+		return nil, money.Money{}, fmt.Errorf("list tax lines: %w", err)
+	}
+	lineTax := make(map[uint32]money.Money, len(taxLines))
+	for _, line := range taxLines {
+		amount, err := parseStored(line.TaxAmount)
+		if err != nil {
+			return nil, money.Money{}, err
+		}
+		lineTax[line.LineNo] = amount.Add(taxOrZero(lineTax, line.LineNo))
+	}
+	creditedQty := make(map[uint32]uint32, len(creditedLines))
+	creditedTax := make(map[uint32]money.Money, len(creditedLines))
+	for _, line := range creditedLines {
+		creditedQty[line.InvoiceLineNo] += line.Quantity
+		amount, err := parseStored(line.TaxAmount)
+		if err != nil {
+			return nil, money.Money{}, err
+		}
+		creditedTax[line.InvoiceLineNo] = amount.Add(taxOrZero(creditedTax, line.InvoiceLineNo))
+	}
+	byLineNo := make(map[uint32]*db.InvoiceItem, len(items))
+	for i := range items {
+		byLineNo[items[i].LineNo] = &items[i]
+	}
+
+	total := money.Zero("", amountScale)
+	lines := make([]db.CreateCreditNoteLineParams, 0, len(requested))
+	for _, line := range requested {
+		lineNo := strconv.FormatUint(uint64(line.LineNo), 10)
+		item, ok := byLineNo[line.LineNo]
+		if !ok {
+			return nil, money.Money{}, errors.InvalidInput("Invoice has no line " + lineNo)
+		}
+		remaining := item.Quantity - creditedQty[line.LineNo]
+		if line.Quantity > remaining {
+			return nil, money.Money{}, errors.InvalidInput(fmt.Sprintf("Line %s has %d uncredited units", lineNo, remaining))
+		}
+		unitPrice, err := parseStored(item.UnitPrice)
+		if err != nil {
+			return nil, money.Money{}, err
+		}
+		taxAmount := taxOrZero(lineTax, line.LineNo).MulFrac(int64(line.Quantity), int64(item.Quantity), money.RoundDown)
+		if line.Quantity == remaining {
+			taxAmount = taxOrZero(lineTax, line.LineNo).Sub(taxOrZero(creditedTax, line.LineNo))
+		}
+		amount := unitPrice.MulFrac(int64(line.Quantity), 1, money.RoundDown).Add(taxAmount)
+		lines = append(lines, db.CreateCreditNoteLineParams{
+			InvoiceLineNo: line.LineNo,
+			Quantity:      line.Quantity,
+			UnitPrice:     unitPrice.String(),
+			TaxAmount:     taxAmount.String(),
+			Amount:        amount.String(),
+		})
+		total = total.Add(amount)
+	}
+	return lines, total, nil
+}
+
+// taxOrZero returns the tax of the line, zero if it has none
+func taxOrZero(taxes map[uint32]money.Money, lineNo uint32) money.Money {
+	if amount, ok := taxes[lineNo]; ok {
+		return amount
+	}
+	return money.Zero("", amountScale)
+}
+
+// createCreditNote numbers and stores a credit note of the locked invoice; returns its ID and number
+func createCreditNote(ctx context.Context, q *db.Queries, inv *db.Invoice, reason db.CreditNotesReason, total money.Money, split creditNoteSplit, memo string, actor audit.Actor) (uint64, string, error) {
+	number, err := docnumber.Next(ctx, q, docnumber.PrefixCreditNote, time.Now().UTC())
+	if err != nil {
+		return 0, "", err
+	}
+	result, err := q.CreateCreditNote(ctx, db.CreateCreditNoteParams{
+		ExternalID:       uuid.New().String(),
+		CreditNoteNumber: number,
+		InvoiceID:        inv.ID,
+		Reason:           reason,
+		Amount:           total.String(),
+		AppliedAmount:    split.applied.String(),
+		RefundedAmount:   split.refunded.String(),
+		Memo:             sql.NullString{String: memo, Valid: memo != ""},
+		CreatedBy:        sql.NullInt64{Int64: int64(actor.ID), Valid: actor.ID != 0},
+	})
+	if err != nil {
+		return 0, "", fmt.Errorf("create credit note: %w", err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, "", err
+	}
+	return uint64(id), number, nil
+}
+
+// refundCredit returns the paid part of a credit note from the seller's account to the buyer's and links the posting
+//
+// NOTE: 미결제 잔액 차감분은 채권 조정일 뿐 자금 이동 없음 → 원장 분개는 반환분만
+func (s *Service) refundCredit(ctx context.Context, q *db.Queries, inv *db.Invoice, creditNoteID uint64, number string, amount money.Money) error {
+	sellerAccount, err := q.GetAccountByOwnerID(ctx, sql.NullInt64{Int64: int64(inv.SellerID), Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return errors.NotFound("Seller account")
+		}
+		return fmt.Errorf("get seller account: %w", err)
+	}
+	buyerAccount, err := q.GetAccountByOwnerID(ctx, sql.NullInt64{Int64: int64(inv.BuyerID), Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return errors.NotFound("Buyer account")
+		}
+		return fmt.Errorf("get buyer account: %w", err)
+	}
+
+	txID, err := ledger.Post(ctx, q, ledger.Posting{
+		ReferenceType: ledger.ReferenceCreditNote,
+		ReferenceID:   creditNoteID,
+		Description:   "Credit note " + number + " for invoice " + inv.InvoiceNumber.String,
+		Legs: []ledger.Leg{
+			{AccountID: sellerAccount.ID, EntryType: db.LedgerEntriesEntryTypeDEBIT, Amount: amount},
+			{AccountID: buyerAccount.ID, EntryType: db.LedgerEntriesEntryTypeCREDIT, Amount: amount},
+		},
+	})
+	if err != nil {
+		var insufficient *ledger.InsufficientBalanceError
+		if stderrors.As(err, &insufficient) {
+			return errors.InsufficientBalance(insufficient.Available.String(), insufficient.Requested.String())
+		}
+		if stderrors.Is(err, ledger.ErrAccountFrozen) {
+			return errors.AccountFrozen(sellerAccount.ExternalID.String)
+		}
+		return fmt.Errorf("post credit note refund: %w", err)
+	}
+	if err := q.SetCreditNoteLedgerTx(ctx, db.SetCreditNoteLedgerTxParams{
+		LedgerTxID: sql.NullString{String: txID, Valid: true},
+		ID:         creditNoteID,
+	}); err != nil {
+		return fmt.Errorf("set credit note ledger tx: %w", err)
+	}
+	return nil
+}
+
+// listCreditNotes returns the credit notes of an invoice with their lines, oldest first
+func (s *Service) listCreditNotes(ctx context.Context, q *db.Queries, invoiceID uint64) ([]CreditNoteResponse, error) {
+	notes, err := q.ListCreditNotesByInvoice(ctx, invoiceID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list credit notes", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if len(notes) == 0 {
+		return nil, nil
+	}
+	lines, err := q.ListCreditNoteLinesByInvoice(ctx, invoiceID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list credit note lines", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	responses := make([]CreditNoteResponse, 0, len(notes))
+	index := make(map[uint64]int, len(notes))
+	for i := range notes {
+		amount, err := s.parseStoredAmount(ctx, notes[i].Amount)
+		if err != nil {
+			return nil, err
+		}
+		applied, err := s.parseStoredAmount(ctx, notes[i].AppliedAmount)
+		if err != nil {
+			return nil, err
+		}
+		refunded, err := s.parseStoredAmount(ctx, notes[i].RefundedAmount)
+		if err != nil {
+			return nil, err
+		}
+		index[notes[i].ID] = len(responses)
+		responses = append(responses, ToCreditNoteResponse(&notes[i], amount, applied, refunded))
+	}
+	for _, line := range lines {
+		unitPrice, err := s.parseStoredAmount(ctx, line.UnitPrice)
+		if err != nil {
+			return nil, err
+		}
+		taxAmount, err := s.parseStoredAmount(ctx, line.TaxAmount)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := s.parseStoredAmount(ctx, line.Amount)
+		if err != nil {
+			return nil, err
+		}
+		i := index[line.CreditNoteID]
+		responses[i].Lines = append(responses[i].Lines, CreditNoteLineResponse{
+			LineNo:    line.InvoiceLineNo,
+			Quantity:  line.Quantity,
+			UnitPrice: unitPrice,
+			TaxAmount: taxAmount,
+			Amount:    amount,
+		})
+	}
+	return responses, nil
+}
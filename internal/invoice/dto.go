@@ -0,0 +1,220 @@
+package invoice
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/tax"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// LineRequest represents a line of a manual invoice
+type LineRequest struct {
+	Description string `json:"description" binding:"required,max=255" example:"Consulting, October"`
+	Quantity    uint32 `json:"quantity" binding:"required,min=1,max=1000000" example:"10"`
+	UnitPrice   string `json:"unit_price" binding:"required,max=32" example:"125.00"`
+}
+
+// CreateInvoiceRequest represents the request body for drafting an invoice,
+// either from an order (order_number, lines copied from the order) or manually (buyer_id + lines)
+type CreateInvoiceRequest struct {
+	SellerID         string        `json:"seller_id,omitempty" binding:"omitempty,uuid" example:"550e8400-e29b-41d4-a716-446655440001"` // omitted = caller
+	OrderNumber      string        `json:"order_number,omitempty" binding:"max=50" example:"ORD-20261003-0001"`
+	BuyerID          string        `json:"buyer_id,omitempty" binding:"omitempty,uuid" example:"550e8400-e29b-41d4-a716-446655440000"` // manual invoices only
+	TokenSymbol      string        `json:"token_symbol,omitempty" binding:"max=10" example:"USDC"`                                     // manual invoices only
+	Lines            []LineRequest `json:"lines,omitempty" binding:"omitempty,max=100,dive"`                                           // manual invoices only
+	PaymentTermsDays int           `json:"payment_terms_days,omitempty" binding:"omitempty,min=1,max=365" example:"30"`                // omitted = 30
+	Notes            string        `json:"notes,omitempty" binding:"max=1000" example:"Bank holiday shipping surcharge waived"`
+}
+
+// RecordPaymentRequest represents the request body for recording a payment received outside deposit matching
+type RecordPaymentRequest struct {
+	Amount    string `json:"amount" binding:"required,max=32" example:"1250.00"`
+	Reference string `json:"reference,omitempty" binding:"max=100" example:"0x8f2a...c91e"` // tx hash or remittance reference
+}
+
+// CreditNoteLineRequest represents the quantity of an invoice line credited back
+type CreditNoteLineRequest struct {
+	LineNo   uint32 `json:"line_no" binding:"required,min=1" example:"1"`
+	Quantity uint32 `json:"quantity" binding:"required,min=1,max=1000000" example:"2"`
+}
+
+// CreateCreditNoteRequest represents the request body for crediting an invoice,
+// either in full (lines omitted, the whole uncredited amount) or per line (quantities of invoice lines)
+type CreateCreditNoteRequest struct {
+	Reason string                  `json:"reason" binding:"required,oneof=CORRECTION RETURN" example:"RETURN"`
+	Lines  []CreditNoteLineRequest `json:"lines,omitempty" binding:"omitempty,max=100,dive"` // omitted = full credit
+	Memo   string                  `json:"memo,omitempty" binding:"max=1000" example:"2 units returned damaged"`
+}
+
+// ListInvoicesRequest represents query parameters for listing the caller's invoices
+type ListInvoicesRequest struct {
+	Status   string `form:"status" binding:"omitempty,oneof=DRAFT ISSUED PARTIALLY_PAID PAID OVERDUE VOID"`
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// ItemResponse represents an invoice line
+type ItemResponse struct {
+	LineNo      uint32      `json:"line_no" example:"1"`
+	Description string      `json:"description" example:"Industrial widget"`
+	Quantity    uint32      `json:"quantity" example:"10"`
+	UnitPrice   money.Money `json:"unit_price" swaggertype:"string" example:"125.00"`
+	Amount      money.Money `json:"amount" swaggertype:"string" example:"1250.00"`
+}
+
+// PaymentResponse represents a payment applied to an invoice
+type PaymentResponse struct {
+	PaymentID     string      `json:"payment_id" example:"ff0e8400-e29b-41d4-a716-446655440000"`
+	Amount        money.Money `json:"amount" swaggertype:"string" example:"1250.00"`
+	Source        string      `json:"source" example:"DEPOSIT" enums:"DEPOSIT,MANUAL"`
+	Reference     string      `json:"reference,omitempty" example:"0x8f2a...c91e"`
+	DepositTxHash string      `json:"deposit_tx_hash,omitempty" example:"0x8f2a...c91e"` // matched deposit
+	CreatedAt     time.Time   `json:"created_at"`
+}
+
+// CreditNoteLineResponse represents a credited invoice line
+type CreditNoteLineResponse struct {
+	LineNo    uint32      `json:"line_no" example:"1"`
+	Quantity  uint32      `json:"quantity" example:"2"`
+	UnitPrice money.Money `json:"unit_price" swaggertype:"string" example:"125.00"`
+	TaxAmount money.Money `json:"tax_amount" swaggertype:"string" example:"0.00"` // share of the line's tax
+	Amount    money.Money `json:"amount" swaggertype:"string" example:"250.00"`   // quantity × unit price + tax
+}
+
+// CreditNoteResponse represents a credit note against an invoice
+type CreditNoteResponse struct {
+	CreditNoteID     string                   `json:"credit_note_id" example:"cc1e8400-e29b-41d4-a716-446655440000"`
+	CreditNoteNumber string                   `json:"credit_note_number" example:"CN-20261010-0001"`
+	Reason           string                   `json:"reason" example:"RETURN" enums:"CORRECTION,RETURN"`
+	Amount           money.Money              `json:"amount" swaggertype:"string" example:"250.00"`
+	AppliedAmount    money.Money              `json:"applied_amount" swaggertype:"string" example:"250.00"`                  // deducted from the balance due
+	RefundedAmount   money.Money              `json:"refunded_amount" swaggertype:"string" example:"0.00"`                   // returned to the buyer's account
+	LedgerTxID       string                   `json:"ledger_tx_id,omitempty" example:"dd1e8400-e29b-41d4-a716-446655440000"` // refund posting
+	Memo             string                   `json:"memo,omitempty" example:"2 units returned damaged"`
+	Lines            []CreditNoteLineResponse `json:"lines,omitempty"` // omitted = full credit
+	CreatedAt        time.Time                `json:"created_at"`
+}
+
+// InvoiceResponse represents an invoice with its lines and payments
+type InvoiceResponse struct {
+	InvoiceID        string                `json:"invoice_id" example:"aa1e8400-e29b-41d4-a716-446655440000"`
+	InvoiceNumber    string                `json:"invoice_number,omitempty" example:"INV-20261003-0001"` // assigned on issue
+	OrderNumber      string                `json:"order_number,omitempty" example:"ORD-20261003-0001"`
+	Terms            string                `json:"terms,omitempty" example:"NET_30" enums:"NET_30,NET_60"` // invoices of orders on credit terms
+	BuyerID          string                `json:"buyer_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	BuyerName        string                `json:"buyer_name" example:"Acme Trading"`
+	SellerID         string                `json:"seller_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	SellerName       string                `json:"seller_name" example:"Widget Works"`
+	TokenSymbol      string                `json:"token_symbol" example:"USDC"`
+	Amount           money.Money           `json:"amount" swaggertype:"string" example:"1250.00"` // including tax
+	TaxAmount        money.Money           `json:"tax_amount" swaggertype:"string" example:"0.00"`
+	PaidAmount       money.Money           `json:"paid_amount" swaggertype:"string" example:"0.00"`
+	CreditedAmount   money.Money           `json:"credited_amount" swaggertype:"string" example:"0.00"` // credit notes
+	RefundedAmount   money.Money           `json:"refunded_amount" swaggertype:"string" example:"0.00"` // payments returned by credit notes
+	BalanceDue       money.Money           `json:"balance_due" swaggertype:"string" example:"1250.00"`
+	Status           string                `json:"status" example:"ISSUED" enums:"DRAFT,ISSUED,PARTIALLY_PAID,PAID,OVERDUE,VOID"`
+	PaymentTermsDays uint32                `json:"payment_terms_days" example:"30"`
+	Notes            string                `json:"notes,omitempty" example:"Bank holiday shipping surcharge waived"`
+	IssuedAt         *time.Time            `json:"issued_at,omitempty"`
+	DueAt            *time.Time            `json:"due_at,omitempty"`
+	ClosedAt         *time.Time            `json:"closed_at,omitempty"`
+	Items            []ItemResponse        `json:"items,omitempty"`
+	Taxes            []tax.TaxLineResponse `json:"taxes,omitempty"`
+	Payments         []PaymentResponse     `json:"payments,omitempty"`
+	CreditNotes      []CreditNoteResponse  `json:"credit_notes,omitempty"`
+	CreatedAt        time.Time             `json:"created_at"`
+}
+
+// ListInvoicesResponse represents paginated invoice list
+type ListInvoicesResponse struct {
+	Invoices   []InvoiceResponse `json:"invoices"`
+	Total      int64             `json:"total" example:"42"`
+	Page       int               `json:"page" example:"1"`
+	PageSize   int               `json:"page_size" example:"20"`
+	TotalPages int               `json:"total_pages" example:"3"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToInvoiceResponse converts an invoice with its parties to the response (items, payments and credit notes attached by the service).
+// The balance due is the amount less credit notes and payments kept (paid - refunded).
+func ToInvoiceResponse(inv *db.GetInvoiceDetailRow, amount, taxAmount, paid, credited, refunded money.Money) *InvoiceResponse {
+	response := &InvoiceResponse{
+		InvoiceID:        inv.ExternalID,
+		InvoiceNumber:    inv.InvoiceNumber.String,
+		OrderNumber:      inv.OrderNumber.String,
+		BuyerID:          inv.BuyerExternalID.String,
+		BuyerName:        inv.BuyerName,
+		SellerID:         inv.SellerExternalID.String,
+		SellerName:       inv.SellerName,
+		TokenSymbol:      inv.TokenSymbol,
+		Amount:           amount,
+		TaxAmount:        taxAmount,
+		PaidAmount:       paid,
+		CreditedAmount:   credited,
+		RefundedAmount:   refunded,
+		BalanceDue:       amount.Sub(credited).Sub(paid.Sub(refunded)),
+		Status:           string(inv.Status),
+		PaymentTermsDays: inv.PaymentTermsDays,
+		Notes:            inv.Notes.String,
+		CreatedAt:        inv.CreatedAt,
+	}
+	if inv.Terms.Valid {
+		response.Terms = string(inv.Terms.CreditTermsTerms)
+	}
+	if inv.Status == db.InvoicesStatusVOID {
+		response.BalanceDue = money.Zero("", amountScale)
+	}
+	if inv.IssuedAt.Valid {
+		response.IssuedAt = &inv.IssuedAt.Time
+	}
+	if inv.DueAt.Valid {
+		response.DueAt = &inv.DueAt.Time
+	}
+	if inv.ClosedAt.Valid {
+		response.ClosedAt = &inv.ClosedAt.Time
+	}
+	return response
+}
+
+// ToPaymentResponse converts a recorded payment to the response
+func ToPaymentResponse(p *db.ListInvoicePaymentsRow, amount money.Money) PaymentResponse {
+	response := PaymentResponse{
+		PaymentID:     p.ExternalID,
+		Amount:        amount,
+		Source:        PaymentSourceManual,
+		Reference:     p.Reference.String,
+		DepositTxHash: p.DepositTxHash.String,
+		CreatedAt:     p.CreatedAt,
+	}
+	if p.DepositID.Valid {
+		response.Source = PaymentSourceDeposit
+	}
+	return response
+}
+
+// ToCreditNoteResponse converts a credit note to the response (lines attached by the service)
+func ToCreditNoteResponse(cn *db.CreditNote, amount, applied, refunded money.Money) CreditNoteResponse {
+	return CreditNoteResponse{
+		CreditNoteID:     cn.ExternalID,
+		CreditNoteNumber: cn.CreditNoteNumber,
+		Reason:           string(cn.Reason),
+		Amount:           amount,
+		AppliedAmount:    applied,
+		RefundedAmount:   refunded,
+		LedgerTxID:       cn.LedgerTxID.String,
+		Memo:             cn.Memo.String,
+		CreatedAt:        cn.CreatedAt,
+	}
+}
@@ -0,0 +1,369 @@
+package invoice
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for invoices
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new invoice handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers invoice routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	invoices := rg.Group("/invoices", middleware.RequireAuth())
+	{
+		invoices.POST("", h.CreateInvoice)
+		invoices.GET("", h.ListInvoices)
+		invoices.GET("/:invoiceId", h.GetInvoice)
+		invoices.DELETE("/:invoiceId", h.DiscardDraft)
+		invoices.POST("/:invoiceId/issue", h.IssueInvoice)
+		invoices.POST("/:invoiceId/void", h.VoidInvoice)
+		invoices.POST("/:invoiceId/payments", h.RecordPayment)
+		invoices.POST("/:invoiceId/credit-notes", h.CreateCreditNote)
+	}
+}
+
+// extractAndValidateInvoiceID extracts and validates invoiceId from path
+func extractAndValidateInvoiceID(c *gin.Context) (string, error) {
+	invoiceID := c.Param("invoiceId")
+	if _, err := uuid.Parse(invoiceID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+	return invoiceID, nil
+}
+
+// CreateInvoice godoc
+// @Summary Draft invoice
+// @Description Draft an invoice (seller only), either from a CONFIRMED order (order_number; lines, amount and token copied from
+// @Description the order) or manually (buyer_id, token_symbol and lines). The draft is invisible to the buyer until issued.
+// @Tags invoices
+// @Accept json
+// @Produce json
+// @Param request body CreateInvoiceRequest true "Order or manual lines, payment terms and notes"
+// @Success 201 {object} middleware.SuccessResponse{data=InvoiceResponse} "Invoice drafted"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input or unsupported token"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot invoice for another seller"
+// @Failure 404 {object} middleware.ErrorResponse "Order or buyer not found"
+// @Failure 409 {object} middleware.ErrorResponse "Order not confirmed or already invoiced"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/invoices [post]
+func (h *Handler) CreateInvoice(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req CreateInvoiceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+	if req.SellerID == "" {
+		req.SellerID = principal.UserExternalID
+	}
+
+	result, err := h.service.CreateInvoice(c.Request.Context(), &req, invoiceAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// ListInvoices godoc
+// @Summary List invoices
+// @Description Get paginated invoices the caller issued (as seller) or received (as buyer, drafts excluded), newest first,
+// @Description with an optional status filter (lines and payments omitted)
+// @Tags invoices
+// @Produce json
+// @Param status query string false "Status filter" Enums(DRAFT, ISSUED, PARTIALLY_PAID, PAID, OVERDUE, VOID)
+// @Param page query int false "Page number" default(1) minimum(1)
+// @Param page_size query int false "Page size" default(20) minimum(1) maximum(100)
+// @Success 200 {object} middleware.SuccessResponse{data=ListInvoicesResponse} "Invoices"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/invoices [get]
+func (h *Handler) ListInvoices(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req ListInvoicesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListInvoices(c.Request.Context(), principal.UserID, &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// GetInvoice godoc
+// @Summary Get invoice
+// @Description Get an invoice with its lines, balance due, payments and credit notes, including deposits matched automatically
+// @Description (seller, or buyer once issued)
+// @Tags invoices
+// @Produce json
+// @Param invoiceId path string true "Invoice ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=InvoiceResponse} "Invoice"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Invoice not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/invoices/{invoiceId} [get]
+func (h *Handler) GetInvoice(c *gin.Context) {
+	invoiceID, err := extractAndValidateInvoiceID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	result, err := h.service.GetInvoice(c.Request.Context(), invoiceID, invoiceAccess(principal))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// DiscardDraft godoc
+// @Summary Discard draft invoice
+// @Description Delete a DRAFT invoice that was never issued (seller only); its order can be invoiced again
+// @Tags invoices
+// @Param invoiceId path string true "Invoice ID (UUID)"
+// @Success 204 "Draft discarded"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Only the seller can discard the invoice"
+// @Failure 404 {object} middleware.ErrorResponse "Invoice not found"
+// @Failure 409 {object} middleware.ErrorResponse "Invoice already issued"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/invoices/{invoiceId} [delete]
+func (h *Handler) DiscardDraft(c *gin.Context) {
+	invoiceID, err := extractAndValidateInvoiceID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	if err := h.service.DiscardDraft(c.Request.Context(), invoiceID, invoiceAccess(principal), audit.ActorFromContext(c)); err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondNoContent(c)
+}
+
+// IssueInvoice godoc
+// @Summary Issue invoice
+// @Description Issue a DRAFT invoice (seller only): assigns the invoice number (INV-YYYYMMDD-NNNN) and the due date payment_terms_days
+// @Description from now, and makes it visible to the buyer. An order invoice also sets the order's payment due date (dunning).
+// @Tags invoices
+// @Produce json
+// @Param invoiceId path string true "Invoice ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=InvoiceResponse} "Invoice issued"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Only the seller can issue the invoice"
+// @Failure 404 {object} middleware.ErrorResponse "Invoice not found"
+// @Failure 409 {object} middleware.ErrorResponse "Invoice not a draft or order no longer awaiting payment"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/invoices/{invoiceId}/issue [post]
+func (h *Handler) IssueInvoice(c *gin.Context) {
+	invoiceID, err := extractAndValidateInvoiceID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	result, err := h.service.IssueInvoice(c.Request.Context(), invoiceID, invoiceAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// VoidInvoice godoc
+// @Summary Void invoice
+// @Description Void an issued invoice without payments (seller only). An invoice on credit terms restores the buyer's available credit.
+// @Tags invoices
+// @Produce json
+// @Param invoiceId path string true "Invoice ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=InvoiceResponse} "Invoice voided"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Only the seller can void the invoice"
+// @Failure 404 {object} middleware.ErrorResponse "Invoice not found"
+// @Failure 409 {object} middleware.ErrorResponse "Invoice is a draft, closed, or has payments"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/invoices/{invoiceId}/void [post]
+func (h *Handler) VoidInvoice(c *gin.Context) {
+	invoiceID, err := extractAndValidateInvoiceID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	result, err := h.service.VoidInvoice(c.Request.Context(), invoiceID, invoiceAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// RecordPayment godoc
+// @Summary Record invoice payment
+// @Description Record a payment received outside automatic deposit matching against an issued invoice (seller only).
+// @Description A partial payment moves ISSUED to PARTIALLY_PAID; paying the full balance closes the invoice (PAID) and marks its order PAID.
+// @Tags invoices
+// @Accept json
+// @Produce json
+// @Param invoiceId path string true "Invoice ID (UUID)"
+// @Param request body RecordPaymentRequest true "Payment amount and reference"
+// @Success 200 {object} middleware.SuccessResponse{data=InvoiceResponse} "Payment recorded"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input or amount exceeds the balance due"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Only the seller can record invoice payments"
+// @Failure 404 {object} middleware.ErrorResponse "Invoice not found"
+// @Failure 409 {object} middleware.ErrorResponse "Invoice is not open"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/invoices/{invoiceId}/payments [post]
+func (h *Handler) RecordPayment(c *gin.Context) {
+	invoiceID, err := extractAndValidateInvoiceID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req RecordPaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.RecordPayment(c.Request.Context(), invoiceID, &req, invoiceAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// CreateCreditNote godoc
+// @Summary Create credit note
+// @Description Credit an issued or paid invoice for a correction or return (seller only), in full (lines omitted) or per line
+// @Description (quantities of invoice lines). The invoice amount and lines never change. The credit is deducted from the balance due
+// @Description (restoring credit terms); any excess returns payments from the seller's account to the buyer's. A zero balance closes the invoice (PAID).
+// @Tags invoices
+// @Accept json
+// @Produce json
+// @Param invoiceId path string true "Invoice ID (UUID)"
+// @Param request body CreateCreditNoteRequest true "Reason, lines and memo"
+// @Success 201 {object} middleware.SuccessResponse{data=InvoiceResponse} "Invoice with the credit note"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input, unknown line, credit exceeds the uncredited amount or insufficient seller balance"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Only the seller can credit the invoice"
+// @Failure 404 {object} middleware.ErrorResponse "Invoice not found"
+// @Failure 409 {object} middleware.ErrorResponse "Invoice is not issued or fully credited"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/invoices/{invoiceId}/credit-notes [post]
+func (h *Handler) CreateCreditNote(c *gin.Context) {
+	invoiceID, err := extractAndValidateInvoiceID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req CreateCreditNoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.CreateCreditNote(c.Request.Context(), invoiceID, &req, invoiceAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// invoiceAccess maps the principal to the service's invoice access check
+func invoiceAccess(principal *middleware.Principal) Access {
+	return Access{
+		Admin:    principal.IsAdmin(),
+		CanActAs: principal.CanActAs,
+	}
+}
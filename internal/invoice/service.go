@@ -0,0 +1,996 @@
+package invoice
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/docnumber"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/tax"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	mysqlErrDuplicateEntry = 1062
+
+	// amountScale is the scale of invoice amounts (DECIMAL(18,2))
+	amountScale = 2
+
+	// DefaultPaymentTermsDays is the payment period of invoices drafted without payment_terms_days
+	DefaultPaymentTermsDays = 30
+)
+
+// Payment sources
+const (
+	// PaymentSourceDeposit is a payment matched automatically from an incoming stablecoin deposit
+	PaymentSourceDeposit = "DEPOSIT"
+	// PaymentSourceManual is a payment recorded by the seller
+	PaymentSourceManual = "MANUAL"
+)
+
+// Audit log identifiers
+const (
+	actionInvoiceCreated   = "INVOICE_CREATED"
+	actionInvoiceIssued    = "INVOICE_ISSUED"
+	actionInvoiceVoided    = "INVOICE_VOIDED"
+	actionInvoiceDiscarded = "INVOICE_DISCARDED"
+	actionPaymentRecorded  = "INVOICE_PAYMENT_RECORDED"
+	actionInvoiceCredited  = "INVOICE_CREDITED"
+	resourceInvoice        = "INVOICE"
+)
+
+// Access is the caller's access to invoices
+type Access struct {
+	// Admin may view and manage any invoice
+	Admin bool
+	// CanActAs reports whether the caller may operate on the user's resources (buyer/seller external ID)
+	CanActAs func(userExternalID string) bool
+}
+
+// canView reports whether the caller may see the invoice (buyers never see drafts)
+func (a Access) canView(inv *db.GetInvoiceDetailRow) bool {
+	if a.Admin || a.CanActAs(inv.SellerExternalID.String) {
+		return true
+	}
+	return inv.Status != db.InvoicesStatusDRAFT && a.CanActAs(inv.BuyerExternalID.String)
+}
+
+// Service handles invoices.
+// A seller drafts an invoice from a confirmed order (lines copied from the order) or manually (own lines),
+// then issues it with a number and a due date; payments are recorded by the seller or matched automatically
+// from the buyer's incoming stablecoin deposits (see Worker). Corrections and returns are credit notes (see CreateCreditNote).
+// Invoices of orders carry the order's taxes; manual invoices are taxed on creation.
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	tokens   *chain.TokenRegistry
+	taxes    *tax.Engine
+	logger   *zap.Logger
+}
+
+// NewService creates a new invoice service
+func NewService(txRunner *pkgdb.TxRunner, tokens *chain.TokenRegistry, taxes *tax.Engine, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		tokens:   tokens,
+		taxes:    taxes,
+		logger:   logger,
+	}
+}
+
+// CreateInvoice drafts an invoice (seller or admin); drafts are invisible to the buyer until issued
+func (s *Service) CreateInvoice(ctx context.Context, req *CreateInvoiceRequest, access Access, actor audit.Actor) (*InvoiceResponse, error) {
+	if !access.CanActAs(req.SellerID) {
+		return nil, errors.Forbidden("Cannot invoice for another seller")
+	}
+	termsDays := req.PaymentTermsDays
+	if termsDays == 0 {
+		termsDays = DefaultPaymentTermsDays
+	}
+	notes := sql.NullString{String: strings.TrimSpace(req.Notes), Valid: strings.TrimSpace(req.Notes) != ""}
+
+	var externalID string
+	var err error
+	if req.OrderNumber != "" {
+		externalID, err = s.createFromOrder(ctx, req, termsDays, notes, access, actor)
+	} else {
+		externalID, err = s.createManual(ctx, req, termsDays, notes, actor)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.GetInvoice(ctx, externalID, access)
+}
+
+// createFromOrder drafts the invoice of a confirmed order, copying its lines
+func (s *Service) createFromOrder(ctx context.Context, req *CreateInvoiceRequest, termsDays int, notes sql.NullString, access Access, actor audit.Actor) (string, error) {
+	if req.BuyerID != "" || req.TokenSymbol != "" || len(req.Lines) > 0 {
+		return "", errors.InvalidInput("buyer_id, token_symbol and lines apply to manual invoices only")
+	}
+
+	q := s.txRunner.Queries()
+	order, err := q.GetOrderByOrderNumber(ctx, req.OrderNumber)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", errors.NotFound("Order")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get order", zap.Error(err))
+		return "", errors.DBError(err)
+	}
+	// 판매자(또는 관리자)가 아니면 주문 존재 여부도 노출하지 않음
+	seller, err := q.GetUserByID(ctx, order.SellerID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to get seller", zap.Error(err))
+		return "", errors.DBError(err)
+	}
+	if !access.CanActAs(seller.ExternalID.String) {
+		return "", errors.NotFound("Order")
+	}
+
+	externalID := uuid.New().String()
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		locked, err := q.GetOrderByIDForUpdate(ctx, order.ID)
+		if err != nil {
+			return err
+		}
+		if locked.Status != db.OrdersStatusCONFIRMED {
+			return errors.Conflict("Only confirmed orders can be invoiced")
+		}
+		if _, err := q.GetInvoiceByOrder(ctx, sql.NullInt64{Int64: int64(locked.ID), Valid: true}); err == nil {
+			return errors.Conflict("Order is already invoiced")
+		} else if err != sql.ErrNoRows {
+			return err
+		}
+
+		inv, err := createOrderInvoice(ctx, q, &locked, externalID, sql.NullInt64{}, termsDays, notes)
+		if err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionInvoiceCreated,
+			ResourceType: resourceInvoice,
+			ResourceID:   inv.ID,
+			NewValue: map[string]any{
+				"order_number":       locked.OrderNumber,
+				"amount":             inv.Amount,
+				"payment_terms_days": termsDays,
+			},
+		})
+	})
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return "", errors.Conflict("Order is already invoiced")
+		}
+		if appErr, ok := err.(*errors.AppError); ok {
+			return "", appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to create order invoice", zap.Error(err))
+		return "", errors.DBError(err)
+	}
+	return externalID, nil
+}
+
+// createManual drafts an invoice with the seller's own lines
+func (s *Service) createManual(ctx context.Context, req *CreateInvoiceRequest, termsDays int, notes sql.NullString, actor audit.Actor) (string, error) {
+	if req.BuyerID == "" || len(req.Lines) == 0 {
+		return "", errors.InvalidInput("buyer_id and lines are required without order_number")
+	}
+	if req.BuyerID == req.SellerID {
+		return "", errors.InvalidInput("buyer_id must differ from the seller")
+	}
+	token, err := s.tokens.Resolve(req.TokenSymbol, 0)
+	if err != nil {
+		return "", errors.InvalidInput("unsupported token_symbol")
+	}
+
+	// 항목 금액 = 수량 × 단가 (DECIMAL(18,2) 그대로, 반올림 없음)
+	unitPrices := make([]money.Money, len(req.Lines))
+	taxLines := make([]tax.Line, len(req.Lines))
+	total := money.Zero("", amountScale)
+	for i, line := range req.Lines {
+		unitPrice, err := money.Parse(line.UnitPrice, "", amountScale)
+		if err != nil || unitPrice.Sign() < 0 {
+			return "", errors.InvalidInput(fmt.Sprintf("lines[%d].unit_price must be a non-negative decimal with at most 2 decimal places", i))
+		}
+		unitPrices[i] = unitPrice
+		taxLines[i] = tax.Line{LineNo: uint32(i + 1), Amount: unitPrice.MulFrac(int64(line.Quantity), 1, money.RoundDown)}
+		total = total.Add(taxLines[i].Amount)
+	}
+	if total.Sign() <= 0 {
+		return "", errors.InvalidInput("invoice total must be positive")
+	}
+
+	q := s.txRunner.Queries()
+	buyer, err := s.getUser(ctx, q, req.BuyerID, "Buyer")
+	if err != nil {
+		return "", err
+	}
+	seller, err := s.getUser(ctx, q, req.SellerID, "Seller")
+	if err != nil {
+		return "", err
+	}
+
+	externalID := uuid.New().String()
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		// 청구 금액 = 항목 합계 + 세금 (구매자 과세 국가 기준)
+		taxes, err := s.taxes.Calculate(ctx, q, buyer.ID, token.Symbol, taxLines)
+		if err != nil {
+			return err
+		}
+		amount := total.Add(taxes.Total)
+
+		result, err := q.CreateInvoice(ctx, db.CreateInvoiceParams{
+			ExternalID:       externalID,
+			BuyerID:          buyer.ID,
+			SellerID:         seller.ID,
+			TokenSymbol:      token.Symbol,
+			Amount:           amount.String(),
+			PaymentTermsDays: uint32(termsDays),
+			Notes:            notes,
+		})
+		if err != nil {
+			return err
+		}
+		invoiceID, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		for i, line := range req.Lines {
+			if err := q.CreateInvoiceItem(ctx, db.CreateInvoiceItemParams{
+				InvoiceID:   uint64(invoiceID),
+				LineNo:      uint32(i + 1),
+				Description: strings.TrimSpace(line.Description),
+				Quantity:    line.Quantity,
+				UnitPrice:   unitPrices[i].String(),
+				Amount:      unitPrices[i].MulFrac(int64(line.Quantity), 1, money.RoundDown).String(),
+			}); err != nil {
+				return err
+			}
+		}
+		if err := tax.ApplyToInvoice(ctx, q, uint64(invoiceID), taxes); err != nil {
+			return err
+		}
+
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionInvoiceCreated,
+			ResourceType: resourceInvoice,
+			ResourceID:   uint64(invoiceID),
+			NewValue: map[string]any{
+				"buyer_id":           req.BuyerID,
+				"token_symbol":       token.Symbol,
+				"amount":             amount.String(),
+				"tax_amount":         taxes.Total.String(),
+				"lines":              len(req.Lines),
+				"payment_terms_days": termsDays,
+			},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return "", appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to create invoice", zap.Error(err))
+		return "", errors.DBError(err)
+	}
+	return externalID, nil
+}
+
+// GetInvoice returns an invoice with its lines, payments and credit notes (seller, admin, or the buyer once issued)
+func (s *Service) GetInvoice(ctx context.Context, externalID string, access Access) (*InvoiceResponse, error) {
+	q := s.txRunner.Queries()
+	detail, err := s.getInvoiceDetail(ctx, q, externalID, access)
+	if err != nil {
+		return nil, err
+	}
+	response, err := s.toInvoiceResponse(ctx, detail)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := q.ListInvoiceItems(ctx, detail.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list invoice items", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	for _, item := range items {
+		unitPrice, err := s.parseStoredAmount(ctx, item.UnitPrice)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := s.parseStoredAmount(ctx, item.Amount)
+		if err != nil {
+			return nil, err
+		}
+		response.Items = append(response.Items, ItemResponse{
+			LineNo:      item.LineNo,
+			Description: item.Description,
+			Quantity:    item.Quantity,
+			UnitPrice:   unitPrice,
+			Amount:      amount,
+		})
+	}
+
+	payments, err := q.ListInvoicePayments(ctx, detail.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list invoice payments", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	for i := range payments {
+		amount, err := s.parseStoredAmount(ctx, payments[i].Amount)
+		if err != nil {
+			return nil, err
+		}
+		response.Payments = append(response.Payments, ToPaymentResponse(&payments[i], amount))
+	}
+
+	taxLines, err := q.ListTaxLines(ctx, db.ListTaxLinesParams{
+		DocumentType: db.TaxLinesDocumentTypeINVOICE,
+		DocumentID:   detail.ID,
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list invoice tax lines", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	response.Taxes, err = tax.ToTaxLineResponses(taxLines)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("invalid stored tax line", zap.Error(err))
+		return nil, errors.Internal("Invalid stored tax line")
+	}
+
+	creditNotes, err := s.listCreditNotes(ctx, q, detail.ID)
+	if err != nil {
+		return nil, err
+	}
+	response.CreditNotes = creditNotes
+	return response, nil
+}
+
+// ListInvoices returns the invoices the user issued or received, newest first (lines and payments omitted).
+// Received drafts are excluded.
+func (s *Service) ListInvoices(ctx context.Context, userID uint64, req *ListInvoicesRequest) (*ListInvoicesResponse, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	var status db.NullInvoicesStatus
+	if req.Status != "" {
+		status = db.NullInvoicesStatus{InvoicesStatus: db.InvoicesStatus(req.Status), Valid: true}
+	}
+
+	q := s.txRunner.Queries()
+	rows, err := q.ListInvoicesByUser(ctx, db.ListInvoicesByUserParams{
+		UserID: userID,
+		Status: status,
+		Limit:  int32(req.PageSize),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list invoices", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := q.CountInvoicesByUser(ctx, db.CountInvoicesByUserParams{
+		UserID: userID,
+		Status: status,
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count invoices", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	invoices := make([]InvoiceResponse, 0, len(rows))
+	for i := range rows {
+		detail := db.GetInvoiceDetailRow(rows[i])
+		response, err := s.toInvoiceResponse(ctx, &detail)
+		if err != nil {
+			return nil, err
+		}
+		invoices = append(invoices, *response)
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListInvoicesResponse{
+		Invoices:   invoices,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// IssueInvoice issues a draft (seller or admin): assigns the invoice number and sets the due date
+// payment_terms_days from now. An order invoice also sets the order's payment due date (dunning).
+func (s *Service) IssueInvoice(ctx context.Context, externalID string, access Access, actor audit.Actor) (*InvoiceResponse, error) {
+	detail, err := s.getSellerInvoice(ctx, externalID, access, "Only the seller can issue the invoice")
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		inv, err := lockInvoice(ctx, q, detail.ID, detail.OrderID, detail.CreditTermsID)
+		if err != nil {
+			return err
+		}
+		if inv.Status != db.InvoicesStatusDRAFT {
+			return errors.Conflict("Invoice is already " + string(inv.Status))
+		}
+		invoiceNumber, dueAt, err := issue(ctx, q, &inv, time.Now().UTC())
+		if err != nil {
+			return err
+		}
+
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionInvoiceIssued,
+			ResourceType: resourceInvoice,
+			ResourceID:   inv.ID,
+			OldValue:     map[string]any{"status": string(inv.Status)},
+			NewValue: map[string]any{
+				"status":         string(db.InvoicesStatusISSUED),
+				"invoice_number": invoiceNumber,
+				"amount":         inv.Amount,
+				"due_at":         dueAt,
+			},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to issue invoice", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetInvoice(ctx, externalID, access)
+}
+
+// DiscardDraft deletes a draft that was never issued (seller or admin); its order may be invoiced again
+func (s *Service) DiscardDraft(ctx context.Context, externalID string, access Access, actor audit.Actor) error {
+	detail, err := s.getSellerInvoice(ctx, externalID, access, "Only the seller can discard the invoice")
+	if err != nil {
+		return err
+	}
+	if detail.Status != db.InvoicesStatusDRAFT {
+		return errors.Conflict("Only drafts can be discarded, void issued invoices instead")
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		result, err := q.DeleteDraftInvoice(ctx, detail.ID)
+		if err != nil {
+			return err
+		}
+		if n, err := result.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return errors.Conflict("Invoice was issued meanwhile")
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionInvoiceDiscarded,
+			ResourceType: resourceInvoice,
+			ResourceID:   detail.ID,
+			OldValue: map[string]any{
+				"order_number": detail.OrderNumber.String,
+				"amount":       detail.Amount,
+			},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to discard invoice", zap.Error(err))
+		return errors.DBError(err)
+	}
+	return nil
+}
+
+// VoidInvoice voids an issued invoice without payments (seller or admin).
+// An invoice on credit terms restores the buyer's available credit.
+func (s *Service) VoidInvoice(ctx context.Context, externalID string, access Access, actor audit.Actor) (*InvoiceResponse, error) {
+	detail, err := s.getSellerInvoice(ctx, externalID, access, "Only the seller can void the invoice")
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		inv, err := lockInvoice(ctx, q, detail.ID, detail.OrderID, detail.CreditTermsID)
+		if err != nil {
+			return err
+		}
+		if inv.Status == db.InvoicesStatusDRAFT {
+			return errors.Conflict("Drafts are discarded, not voided")
+		}
+		if !isOpen(inv.Status) {
+			return errors.Conflict("Invoice is already " + string(inv.Status))
+		}
+		paid, err := parseStored(inv.PaidAmount)
+		if err != nil {
+			return err
+		}
+		if paid.Sign() > 0 {
+			return errors.Conflict("Invoice has payments and cannot be voided")
+		}
+		if err := void(ctx, q, &inv); err != nil {
+			return err
+		}
+
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionInvoiceVoided,
+			ResourceType: resourceInvoice,
+			ResourceID:   inv.ID,
+			OldValue:     map[string]any{"status": string(inv.Status)},
+			NewValue: map[string]any{
+				"status": string(db.InvoicesStatusVOID),
+				"amount": inv.Amount,
+			},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to void invoice", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetInvoice(ctx, externalID, access)
+}
+
+// RecordPayment records a payment received outside deposit matching against an issued invoice (seller or admin)
+func (s *Service) RecordPayment(ctx context.Context, externalID string, req *RecordPaymentRequest, access Access, actor audit.Actor) (*InvoiceResponse, error) {
+	detail, err := s.getSellerInvoice(ctx, externalID, access, "Only the seller can record invoice payments")
+	if err != nil {
+		return nil, err
+	}
+	amount, err := money.Parse(req.Amount, "", amountScale)
+	if err != nil || amount.Sign() <= 0 {
+		return nil, errors.InvalidInput("amount must be a positive decimal with at most 2 decimal places")
+	}
+	reference := strings.TrimSpace(req.Reference)
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		inv, err := lockInvoice(ctx, q, detail.ID, detail.OrderID, detail.CreditTermsID)
+		if err != nil {
+			return err
+		}
+		if !isOpen(inv.Status) {
+			return errors.Conflict("Invoice is " + string(inv.Status))
+		}
+		balance, err := balanceDue(&inv)
+		if err != nil {
+			return err
+		}
+		if amount.Cmp(balance) > 0 {
+			return errors.InvalidInput("amount exceeds the balance due of " + balance.String())
+		}
+
+		fullyPaid, err := applyPayment(ctx, q, &inv, amount, reference, sql.NullInt64{})
+		if err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionPaymentRecorded,
+			ResourceType: resourceInvoice,
+			ResourceID:   inv.ID,
+			OldValue:     map[string]any{"paid_amount": inv.PaidAmount},
+			NewValue: map[string]any{
+				"amount":     amount.String(),
+				"source":     PaymentSourceManual,
+				"reference":  reference,
+				"fully_paid": fullyPaid,
+			},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to record invoice payment", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetInvoice(ctx, externalID, access)
+}
+
+// IssueOrderInvoice invoices an order placed on credit terms and issues it immediately, due after termsDays.
+// Must be called within the transaction that charges the credit terms (order and terms row-locked).
+func IssueOrderInvoice(ctx context.Context, q *db.Queries, order *db.Order, creditTermsID uint64, termsDays int) (*db.Invoice, error) {
+	inv, err := createOrderInvoice(ctx, q, order, uuid.New().String(), sql.NullInt64{Int64: int64(creditTermsID), Valid: true}, termsDays, sql.NullString{})
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := issue(ctx, q, inv, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	issued, err := q.GetInvoiceForUpdate(ctx, inv.ID)
+	if err != nil {
+		return nil, fmt.Errorf("reload invoice: %w", err)
+	}
+	return &issued, nil
+}
+
+// VoidOrderInvoice voids the open invoice of a cancelled order; an invoice on credit terms restores its unpaid
+// balance to the buyer's available credit, a draft is discarded. No-op for orders without an open invoice.
+// Must be called within the transaction that cancels the order (order row-locked).
+func VoidOrderInvoice(ctx context.Context, q *db.Queries, orderID uint64) error {
+	inv, err := q.GetInvoiceByOrder(ctx, sql.NullInt64{Int64: int64(orderID), Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return fmt.Errorf("get order invoice: %w", err)
+	}
+	if inv.Status == db.InvoicesStatusDRAFT {
+		if _, err := q.DeleteDraftInvoice(ctx, inv.ID); err != nil {
+			return fmt.Errorf("discard draft invoice: %w", err)
+		}
+		return nil
+	}
+	if !isOpen(inv.Status) {
+		return nil
+	}
+
+	// 잠금 순서 orders → credit_terms → invoices (주문은 호출자가 잠금)
+	if inv.CreditTermsID.Valid {
+		if _, err := q.GetCreditTermsForUpdate(ctx, uint64(inv.CreditTermsID.Int64)); err != nil {
+			return fmt.Errorf("lock credit terms: %w", err)
+		}
+	}
+	inv, err = q.GetInvoiceForUpdate(ctx, inv.ID)
+	if err != nil {
+		return fmt.Errorf("lock invoice: %w", err)
+	}
+	if !isOpen(inv.Status) {
+		return nil
+	}
+	return void(ctx, q, &inv)
+}
+
+// createOrderInvoice drafts the invoice of an order, copying its lines and taxes (order row-locked)
+func createOrderInvoice(ctx context.Context, q *db.Queries, order *db.Order, externalID string, creditTermsID sql.NullInt64, termsDays int, notes sql.NullString) (*db.Invoice, error) {
+	orderID := sql.NullInt64{Int64: int64(order.ID), Valid: true}
+	result, err := q.CreateInvoice(ctx, db.CreateInvoiceParams{
+		ExternalID:       externalID,
+		BuyerID:          order.BuyerID,
+		SellerID:         order.SellerID,
+		TokenSymbol:      order.TokenSymbol,
+		CreditTermsID:    creditTermsID,
+		OrderID:          orderID,
+		Amount:           order.TotalAmount,
+		PaymentTermsDays: uint32(termsDays),
+		Notes:            notes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	invoiceID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: 법정화폐 가격 주문은 항목 단가가 법정화폐 → 토큰 총액 1줄로 청구 (항목 합계 = 청구 금액 유지)
+	if order.PriceCurrency.Valid {
+		if err := q.CreateInvoiceItem(ctx, db.CreateInvoiceItemParams{
+			InvoiceID:   uint64(invoiceID),
+			LineNo:      1,
+			Description: "Order " + order.OrderNumber,
+			Quantity:    1,
+			UnitPrice:   order.TotalAmount,
+			Amount:      order.TotalAmount,
+		}); err != nil {
+			return nil, fmt.Errorf("create invoice item: %w", err)
+		}
+	} else if err := q.CopyOrderItemsToInvoice(ctx, db.CopyOrderItemsToInvoiceParams{
+		InvoiceID: invoiceID,
+		OrderID:   order.ID,
+	}); err != nil {
+		return nil, fmt.Errorf("copy order items: %w", err)
+	}
+	// 주문 금액에 세금 포함 → 세금 내역만 이관 (재산정 없음)
+	if err := tax.CopyOrderTax(ctx, q, uint64(invoiceID), order); err != nil {
+		return nil, err
+	}
+
+	inv, err := q.GetInvoiceForUpdate(ctx, uint64(invoiceID))
+	if err != nil {
+		return nil, fmt.Errorf("get invoice: %w", err)
+	}
+	return &inv, nil
+}
+
+// issue assigns the invoice number and due date of a locked draft (DRAFT → ISSUED)
+func issue(ctx context.Context, q *db.Queries, inv *db.Invoice, now time.Time) (string, time.Time, error) {
+	dueAt := now.AddDate(0, 0, int(inv.PaymentTermsDays))
+	invoiceNumber, err := docnumber.Next(ctx, q, docnumber.PrefixInvoice, now)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	result, err := q.IssueInvoice(ctx, db.IssueInvoiceParams{
+		InvoiceNumber: sql.NullString{String: invoiceNumber, Valid: true},
+		IssuedAt:      sql.NullTime{Time: now, Valid: true},
+		DueAt:         sql.NullTime{Time: dueAt, Valid: true},
+		ID:            inv.ID,
+	})
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("issue invoice: %w", err)
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return "", time.Time{}, err
+	} else if n == 0 {
+		return "", time.Time{}, errors.Conflict("Invoice is no longer a draft")
+	}
+
+	// 주문 청구서: 주문 결제 기한 = 청구서 만기 (독촉 기준)
+	if inv.OrderID.Valid {
+		result, err := q.SetOrderPaymentDue(ctx, db.SetOrderPaymentDueParams{
+			PaymentDueAt: sql.NullTime{Time: dueAt, Valid: true},
+			ID:           uint64(inv.OrderID.Int64),
+		})
+		if err != nil {
+			return "", time.Time{}, fmt.Errorf("set order payment due: %w", err)
+		}
+		if n, err := result.RowsAffected(); err != nil {
+			return "", time.Time{}, err
+		} else if n == 0 {
+			return "", time.Time{}, errors.Conflict("Order is no longer awaiting payment")
+		}
+	}
+	return invoiceNumber, dueAt, nil
+}
+
+// void closes a locked open invoice as VOID and restores its unpaid balance to the credit terms, if any
+func void(ctx context.Context, q *db.Queries, inv *db.Invoice) error {
+	balance, err := balanceDue(inv)
+	if err != nil {
+		return err
+	}
+	if err := q.CloseInvoice(ctx, db.CloseInvoiceParams{
+		Status: db.InvoicesStatusVOID,
+		ID:     inv.ID,
+	}); err != nil {
+		return fmt.Errorf("void invoice: %w", err)
+	}
+	if inv.CreditTermsID.Valid {
+		if err := q.AddCreditTermsOutstanding(ctx, db.AddCreditTermsOutstandingParams{
+			OutstandingAmount: balance.Neg().String(),
+			ID:                uint64(inv.CreditTermsID.Int64),
+		}); err != nil {
+			return fmt.Errorf("restore credit: %w", err)
+		}
+	}
+	return nil
+}
+
+// applyPayment records a payment of at most the balance due against a locked open invoice and advances its status
+// (ISSUED → PARTIALLY_PAID, fully paid → PAID and the order PAID). Payments on credit terms restore available credit.
+// Returns whether the invoice is now fully paid.
+func applyPayment(ctx context.Context, q *db.Queries, inv *db.Invoice, amount money.Money, reference string, depositID sql.NullInt64) (bool, error) {
+	balance, err := balanceDue(inv)
+	if err != nil {
+		return false, err
+	}
+	if amount.Sign() <= 0 || amount.Cmp(balance) > 0 {
+		return false, fmt.Errorf("payment %s outside the balance due %s", amount.String(), balance.String())
+	}
+
+	if err := q.CreateInvoicePayment(ctx, db.CreateInvoicePaymentParams{
+		ExternalID: uuid.New().String(),
+		InvoiceID:  inv.ID,
+		Amount:     amount.String(),
+		Reference:  sql.NullString{String: reference, Valid: reference != ""},
+		DepositID:  depositID,
+	}); err != nil {
+		return false, fmt.Errorf("create invoice payment: %w", err)
+	}
+	if err := q.AddInvoicePaidAmount(ctx, db.AddInvoicePaidAmountParams{
+		PaidAmount: amount.String(),
+		ID:         inv.ID,
+	}); err != nil {
+		return false, fmt.Errorf("add paid amount: %w", err)
+	}
+	if inv.CreditTermsID.Valid {
+		if err := q.AddCreditTermsOutstanding(ctx, db.AddCreditTermsOutstandingParams{
+			OutstandingAmount: amount.Neg().String(),
+			ID:                uint64(inv.CreditTermsID.Int64),
+		}); err != nil {
+			return false, fmt.Errorf("restore credit: %w", err)
+		}
+	}
+
+	if amount.Cmp(balance) < 0 {
+		// NOTE: OVERDUE는 부분 결제 후에도 유지 (연체 여부가 우선)
+		if inv.Status == db.InvoicesStatusISSUED {
+			if err := q.UpdateInvoiceStatus(ctx, db.UpdateInvoiceStatusParams{
+				Status: db.InvoicesStatusPARTIALLYPAID,
+				ID:     inv.ID,
+			}); err != nil {
+				return false, fmt.Errorf("mark partially paid: %w", err)
+			}
+		}
+		return false, nil
+	}
+
+	if err := settle(ctx, q, inv); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// settle closes a locked open invoice with no balance due as PAID and marks its order PAID
+func settle(ctx context.Context, q *db.Queries, inv *db.Invoice) error {
+	if err := q.CloseInvoice(ctx, db.CloseInvoiceParams{
+		Status: db.InvoicesStatusPAID,
+		ID:     inv.ID,
+	}); err != nil {
+		return fmt.Errorf("close invoice: %w", err)
+	}
+	if inv.OrderID.Valid {
+		if _, err := q.MarkOrderPaid(ctx, uint64(inv.OrderID.Int64)); err != nil {
+			return fmt.Errorf("mark order paid: %w", err)
+		}
+	}
+	return nil
+}
+
+// lockInvoice row-locks the invoice's order and credit terms (if any), then the invoice itself.
+//
+// Why:
+//   - 잠금 순서 orders → credit_terms → invoices (독촉 취소/외상 청구와 동일) → 교착 방지
+//   - 잠금 후 반환된 행으로 상태를 재확인 (조회 시점 이후 변경 반영)
+func lockInvoice(ctx context.Context, q *db.Queries, invoiceID uint64, orderID, creditTermsID sql.NullInt64) (db.Invoice, error) {
+	if orderID.Valid {
+		if _, err := q.GetOrderByIDForUpdate(ctx, uint64(orderID.Int64)); err != nil {
+			return db.Invoice{}, fmt.Errorf("lock order: %w", err)
+		}
+	}
+	if creditTermsID.Valid {
+		if _, err := q.GetCreditTermsForUpdate(ctx, uint64(creditTermsID.Int64)); err != nil {
+			return db.Invoice{}, fmt.Errorf("lock credit terms: %w", err)
+		}
+	}
+	locked, err := q.GetInvoiceForUpdate(ctx, invoiceID)
+	if err != nil {
+		return db.Invoice{}, fmt.Errorf("lock invoice: %w", err)
+	}
+	return locked, nil
+}
+
+// isOpen reports whether the invoice is issued and awaiting payment
+func isOpen(status db.InvoicesStatus) bool {
+	switch status {
+	case db.InvoicesStatusISSUED, db.InvoicesStatusPARTIALLYPAID, db.InvoicesStatusOVERDUE:
+		return true
+	}
+	return false
+}
+
+// balanceDue returns the unpaid amount of the invoice: the amount less credit notes and payments kept
+// (payments returned by credit notes no longer count as paid)
+func balanceDue(inv *db.Invoice) (money.Money, error) {
+	amount, err := parseStored(inv.Amount)
+	if err != nil {
+		return money.Money{}, err
+	}
+	paid, err := parseStored(inv.PaidAmount)
+	if err != nil {
+		return money.Money{}, err
+	}
+	credited, err := parseStored(inv.CreditedAmount)
+	if err != nil {
+		return money.Money{}, err
+	}
+	refunded, err := parseStored(inv.RefundedAmount)
+	if err != nil {
+		return money.Money{}, err
+	}
+	return amount.Sub(credited).Sub(paid.Sub(refunded)), nil
+}
+
+// getInvoiceDetail retrieves an invoice with its parties if the caller may see it
+func (s *Service) getInvoiceDetail(ctx context.Context, q *db.Queries, externalID string, access Access) (*db.GetInvoiceDetailRow, error) {
+	detail, err := q.GetInvoiceDetail(ctx, externalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Invoice")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get invoice", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if !access.canView(&detail) {
+		return nil, errors.NotFound("Invoice")
+	}
+	return &detail, nil
+}
+
+// getSellerInvoice retrieves an invoice the caller may manage as its seller (or admin)
+func (s *Service) getSellerInvoice(ctx context.Context, externalID string, access Access, forbidden string) (*db.GetInvoiceDetailRow, error) {
+	detail, err := s.getInvoiceDetail(ctx, s.txRunner.Queries(), externalID, access)
+	if err != nil {
+		return nil, err
+	}
+	if !access.CanActAs(detail.SellerExternalID.String) {
+		return nil, errors.Forbidden(forbidden)
+	}
+	return detail, nil
+}
+
+// getUser retrieves a party of the invoice by external ID
+func (s *Service) getUser(ctx context.Context, q *db.Queries, externalID, resource string) (*db.User, error) {
+	user, err := q.GetUserByExternalID(ctx, sql.NullString{String: externalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound(resource)
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &user, nil
+}
+
+// toInvoiceResponse parses the stored amounts and converts the invoice
+func (s *Service) toInvoiceResponse(ctx context.Context, detail *db.GetInvoiceDetailRow) (*InvoiceResponse, error) {
+	amount, err := s.parseStoredAmount(ctx, detail.Amount)
+	if err != nil {
+		return nil, err
+	}
+	taxAmount, err := s.parseStoredAmount(ctx, detail.TaxAmount)
+	if err != nil {
+		return nil, err
+	}
+	paid, err := s.parseStoredAmount(ctx, detail.PaidAmount)
+	if err != nil {
+		return nil, err
+	}
+	credited, err := s.parseStoredAmount(ctx, detail.CreditedAmount)
+	if err != nil {
+		return nil, err
+	}
+	refunded, err := s.parseStoredAmount(ctx, detail.RefundedAmount)
+	if err != nil {
+		return nil, err
+	}
+	return ToInvoiceResponse(detail, amount, taxAmount, paid, credited, refunded), nil
+}
+
+// parseStoredAmount parses a DECIMAL(18,2) column
+func (s *Service) parseStoredAmount(ctx context.Context, value string) (money.Money, error) {
+	amount, err := money.Parse(value, "", amountScale)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("invalid stored amount", zap.String("amount", value), zap.Error(err))
+		return money.Money{}, errors.Internal("Invalid stored amount")
+	}
+	return amount, nil
+}
+
+// parseStored parses a DECIMAL(18,2) column within a transaction (error rolls back)
+func parseStored(value string) (money.Money, error) {
+	amount, err := money.Parse(value, "", amountScale)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("invalid stored amount %q: %w", value, err)
+	}
+	return amount, nil
+}
+
+// isDuplicateKeyError checks if the error is a MySQL duplicate key error
+func isDuplicateKeyError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var mysqlErr *mysql.MySQLError
+	if stderrors.As(err, &mysqlErr) {
+		return mysqlErr.Number == mysqlErrDuplicateEntry
+	}
+	return strings.Contains(err.Error(), "Duplicate entry")
+}
@@ -0,0 +1,242 @@
+package invoice
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"go.uber.org/zap"
+)
+
+// depositScale is the scale of deposit amounts (DECIMAL(18,8))
+const depositScale = 8
+
+// WorkerConfig holds invoice worker settings
+type WorkerConfig struct {
+	// Interval is the period of the invoice job
+	Interval time.Duration
+	// BatchSize is the number of deposits matched per run
+	BatchSize int
+	// DepositToken is the token of incoming deposits (the settlement token); only invoices in it are matched
+	DepositToken string
+}
+
+// RunReport summarizes one invoice run
+type RunReport struct {
+	Overdue   int64
+	Matched   int
+	Unmatched int
+	Failed    int
+}
+
+// Worker marks issued invoices past their due date OVERDUE and matches incoming stablecoin deposits
+// to the depositing buyer's open invoices, recording them as invoice payments.
+//
+// Matching order per deposit:
+//  1. an open invoice whose balance due equals the deposit (the buyer paid that invoice)
+//  2. otherwise the open invoices by due date, oldest first, until the deposit is used up
+//
+// NOTE: 입금은 이미 구매자 계정에 반영된 상태 → 대사는 청구서 결제 기록만 (판매자에게 자금 이동은 범위 밖)
+// NOTE: 청구서에 배정되지 않은 잔액(초과 입금)은 구매자 잔액으로 남음
+type Worker struct {
+	txRunner *pkgdb.TxRunner
+	config   WorkerConfig
+	logger   *zap.Logger
+}
+
+// NewWorker creates a new invoice worker
+func NewWorker(txRunner *pkgdb.TxRunner, config WorkerConfig, logger *zap.Logger) *Worker {
+	return &Worker{
+		txRunner: txRunner,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Run executes the invoice job periodically until ctx is canceled
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	logctx.From(ctx, w.logger).Info("invoice job started",
+		zap.Duration("interval", w.config.Interval),
+		zap.Int("batch_size", w.config.BatchSize),
+		zap.String("deposit_token", w.config.DepositToken),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logctx.From(ctx, w.logger).Info("invoice job stopped")
+			return
+		case <-ticker.C:
+			report, err := w.RunOnce(ctx, time.Now().UTC())
+			if err != nil {
+				logctx.From(ctx, w.logger).Error("invoice run failed", zap.Error(err))
+				continue
+			}
+			if report.Overdue+int64(report.Matched+report.Failed) > 0 {
+				logctx.From(ctx, w.logger).Info("invoice run completed",
+					zap.Int64("overdue", report.Overdue),
+					zap.Int("matched", report.Matched),
+					zap.Int("unmatched", report.Unmatched),
+					zap.Int("failed", report.Failed),
+				)
+			}
+		}
+	}
+}
+
+// RunOnce marks overdue invoices and matches one batch of unmatched deposits.
+// A failing deposit is logged and counted; it is retried on the next run.
+func (w *Worker) RunOnce(ctx context.Context, now time.Time) (*RunReport, error) {
+	report := &RunReport{}
+	q := w.txRunner.Queries()
+
+	overdue, err := q.MarkInvoicesOverdue(ctx, sql.NullTime{Time: now, Valid: true})
+	if err != nil {
+		return report, fmt.Errorf("mark overdue invoices: %w", err)
+	}
+	report.Overdue = overdue
+
+	deposits, err := q.ListDepositsForInvoiceMatching(ctx, int32(w.config.BatchSize))
+	if err != nil {
+		return report, fmt.Errorf("list deposits for matching: %w", err)
+	}
+
+	for i := range deposits {
+		if ctx.Err() != nil {
+			return report, nil
+		}
+		applied, err := w.match(ctx, &deposits[i])
+		if err != nil {
+			report.Failed++
+			logctx.From(ctx, w.logger).Error("deposit invoice matching failed",
+				zap.Uint64("deposit_id", deposits[i].ID),
+				zap.String("tx_hash", deposits[i].TxHash),
+				zap.Error(err),
+			)
+			continue
+		}
+		if applied > 0 {
+			report.Matched++
+		} else {
+			report.Unmatched++
+		}
+	}
+	return report, nil
+}
+
+// match applies one deposit to the buyer's open invoices and marks it matched in one transaction;
+// returns the number of invoices paid from it (0 = no open invoice, the deposit is still marked matched).
+//
+// Why:
+//   - 입금 row-lock 후 invoice_matched_at 재확인 → 다중 인스턴스가 같은 입금을 이중 배정하지 않음
+//   - 청구서별 잠금 순서 orders → credit_terms → invoices (판매자 수동 결제 기록과 동일)
+func (w *Worker) match(ctx context.Context, d *db.Deposit) (int, error) {
+	return pkgdb.WithTxResult(ctx, w.txRunner, func(q *db.Queries) (int, error) {
+		deposit, err := q.GetDepositForUpdate(ctx, d.ID)
+		if err != nil {
+			return 0, fmt.Errorf("lock deposit: %w", err)
+		}
+		if deposit.InvoiceMatchedAt.Valid {
+			return 0, nil
+		}
+
+		// 청구서 금액 단위(소수 2자리) 미만은 버림 → 청구서에 과다 반영하지 않음
+		amount, err := money.Parse(deposit.Amount, "", depositScale)
+		if err != nil {
+			return 0, fmt.Errorf("invalid deposit amount %q: %w", deposit.Amount, err)
+		}
+		remaining := amount.Rescale(amountScale, money.RoundDown)
+
+		invoices, err := q.ListOpenInvoicesByBuyer(ctx, db.ListOpenInvoicesByBuyerParams{
+			BuyerID:     deposit.UserID,
+			TokenSymbol: w.config.DepositToken,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("list open invoices: %w", err)
+		}
+
+		applied := 0
+		for _, candidate := range matchOrder(invoices, remaining) {
+			if remaining.Sign() <= 0 {
+				break
+			}
+			inv, err := lockInvoice(ctx, q, candidate.ID, candidate.OrderID, candidate.CreditTermsID)
+			if err != nil {
+				return 0, err
+			}
+			if !isOpen(inv.Status) {
+				continue
+			}
+			balance, err := balanceDue(&inv)
+			if err != nil {
+				return 0, err
+			}
+			payment := remaining
+			if payment.Cmp(balance) > 0 {
+				payment = balance
+			}
+			if payment.Sign() <= 0 {
+				continue
+			}
+
+			fullyPaid, err := applyPayment(ctx, q, &inv, payment, deposit.TxHash, sql.NullInt64{Int64: int64(deposit.ID), Valid: true})
+			if err != nil {
+				return 0, err
+			}
+			if err := audit.Record(ctx, q, audit.Actor{Type: audit.ActorTypeSystem}, audit.Entry{
+				Action:       actionPaymentRecorded,
+				ResourceType: resourceInvoice,
+				ResourceID:   inv.ID,
+				OldValue:     map[string]any{"paid_amount": inv.PaidAmount},
+				NewValue: map[string]any{
+					"amount":     payment.String(),
+					"source":     PaymentSourceDeposit,
+					"tx_hash":    deposit.TxHash,
+					"fully_paid": fullyPaid,
+				},
+			}); err != nil {
+				return 0, err
+			}
+			remaining = remaining.Sub(payment)
+			applied++
+		}
+
+		if err := q.MarkDepositInvoiceMatched(ctx, deposit.ID); err != nil {
+			return 0, fmt.Errorf("mark deposit matched: %w", err)
+		}
+		if applied > 0 {
+			logctx.From(ctx, w.logger).Info("deposit matched to invoices",
+				zap.Uint64("deposit_id", deposit.ID),
+				zap.String("tx_hash", deposit.TxHash),
+				zap.Int("invoices", applied),
+				zap.String("unapplied", remaining.String()),
+			)
+		}
+		return applied, nil
+	})
+}
+
+// matchOrder orders the open invoices for a deposit: the first invoice whose balance due equals the amount,
+// then the rest as listed (due date, oldest first)
+func matchOrder(invoices []db.Invoice, amount money.Money) []db.Invoice {
+	for i := range invoices {
+		balance, err := balanceDue(&invoices[i])
+		if err != nil || balance.Cmp(amount) != 0 {
+			continue
+		}
+		ordered := make([]db.Invoice, 0, len(invoices))
+		ordered = append(ordered, invoices[i])
+		ordered = append(ordered, invoices[:i]...)
+		return append(ordered, invoices[i+1:]...)
+	}
+	return invoices
+}
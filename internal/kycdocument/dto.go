@@ -0,0 +1,150 @@
+package kycdocument
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// Review decisions
+const (
+	DecisionAccept = "ACCEPT"
+	DecisionReject = "REJECT"
+)
+
+// documentTypes are the accepted document_type values
+var documentTypes = map[string]bool{
+	string(db.KycDocumentsDocumentTypePASSPORT):                true,
+	string(db.KycDocumentsDocumentTypeNATIONALID):              true,
+	string(db.KycDocumentsDocumentTypeDRIVERSLICENSE):          true,
+	string(db.KycDocumentsDocumentTypePROOFOFADDRESS):          true,
+	string(db.KycDocumentsDocumentTypeBUSINESSREGISTRATION):    true,
+	string(db.KycDocumentsDocumentTypeARTICLESOFINCORPORATION): true,
+	string(db.KycDocumentsDocumentTypeOTHER):                   true,
+}
+
+// isDocumentType reports whether t is an accepted document_type
+func isDocumentType(t string) bool {
+	return documentTypes[t]
+}
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// ReviewDocumentRequest represents the request body for reviewing a KYC document (Admin only)
+type ReviewDocumentRequest struct {
+	Decision string `json:"decision" binding:"required,oneof=ACCEPT REJECT" example:"REJECT"`
+	// Note is shown to the user; required when rejecting
+	Note string `json:"note,omitempty" binding:"max=500" example:"Document is expired"`
+}
+
+// ListDocumentsRequest represents query parameters for the admin review queue
+type ListDocumentsRequest struct {
+	Status   string `form:"status" binding:"omitempty,oneof=SUBMITTED ACCEPTED REJECTED"`
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// DocumentResponse represents KYC document metadata in API responses (the file itself is never inlined)
+type DocumentResponse struct {
+	ID           string `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserID       string `json:"user_id,omitempty" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	DocumentType string `json:"document_type" example:"PASSPORT"`
+	FileName     string `json:"file_name" example:"passport.pdf"`
+	ContentType  string `json:"content_type" example:"application/pdf"`
+	SizeBytes    uint64 `json:"size_bytes" example:"482113"`
+	// SHA256 is the hex digest of the uploaded file
+	SHA256     string     `json:"sha256" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	Status     string     `json:"status" example:"SUBMITTED" enums:"SUBMITTED,ACCEPTED,REJECTED"`
+	ReviewNote string     `json:"review_note,omitempty" example:"Document is expired"`
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// ListUserDocumentsResponse represents the KYC documents of a user, newest first
+type ListUserDocumentsResponse struct {
+	KycStatus string             `json:"kyc_status" example:"PENDING"`
+	Documents []DocumentResponse `json:"documents"`
+}
+
+// ListDocumentsResponse represents a page of the admin review queue, oldest first
+type ListDocumentsResponse struct {
+	Documents  []DocumentResponse `json:"documents"`
+	Total      int64              `json:"total" example:"42"`
+	Page       int                `json:"page" example:"1"`
+	PageSize   int                `json:"page_size" example:"20"`
+	TotalPages int                `json:"total_pages" example:"3"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToDocumentResponse converts db.KycDocument to DocumentResponse
+func ToDocumentResponse(d *db.KycDocument, userExternalID string) DocumentResponse {
+	response := DocumentResponse{
+		ID:           d.ExternalID,
+		UserID:       userExternalID,
+		DocumentType: string(d.DocumentType),
+		FileName:     d.FileName,
+		ContentType:  d.ContentType,
+		SizeBytes:    d.SizeBytes,
+		SHA256:       d.Sha256,
+		Status:       string(d.Status),
+		CreatedAt:    d.CreatedAt,
+	}
+	if d.ReviewNote.Valid {
+		response.ReviewNote = d.ReviewNote.String
+	}
+	if d.ReviewedAt.Valid {
+		response.ReviewedAt = &d.ReviewedAt.Time
+	}
+	return response
+}
+
+// toDocument converts a document row joined with its user to db.KycDocument
+func toDocument(r *db.ListKycDocumentsRow) db.KycDocument {
+	return db.KycDocument{
+		ID:           r.ID,
+		ExternalID:   r.ExternalID,
+		UserID:       r.UserID,
+		DocumentType: r.DocumentType,
+		FileName:     r.FileName,
+		ContentType:  r.ContentType,
+		SizeBytes:    r.SizeBytes,
+		Sha256:       r.Sha256,
+		StorageKey:   r.StorageKey,
+		Status:       r.Status,
+		ReviewNote:   r.ReviewNote,
+		ReviewedBy:   r.ReviewedBy,
+		ReviewedAt:   r.ReviewedAt,
+		CreatedAt:    r.CreatedAt,
+		UpdatedAt:    r.UpdatedAt,
+	}
+}
+
+// rowToDocument converts a single document row joined with its user to db.KycDocument
+func rowToDocument(r *db.GetKycDocumentByExternalIDRow) *db.KycDocument {
+	return &db.KycDocument{
+		ID:           r.ID,
+		ExternalID:   r.ExternalID,
+		UserID:       r.UserID,
+		DocumentType: r.DocumentType,
+		FileName:     r.FileName,
+		ContentType:  r.ContentType,
+		SizeBytes:    r.SizeBytes,
+		Sha256:       r.Sha256,
+		StorageKey:   r.StorageKey,
+		Status:       r.Status,
+		ReviewNote:   r.ReviewNote,
+		ReviewedBy:   r.ReviewedBy,
+		ReviewedAt:   r.ReviewedAt,
+		CreatedAt:    r.CreatedAt,
+		UpdatedAt:    r.UpdatedAt,
+	}
+}
@@ -0,0 +1,256 @@
+package kycdocument
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// maxMultipartOverhead is the slack allowed on top of the file size for the other multipart fields
+const maxMultipartOverhead = 64 << 10
+
+// Handler handles HTTP requests for KYC documents
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new KYC document handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers KYC document routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	// Only the account owner (or an admin) can upload and list the user's documents
+	documents := rg.Group("/users/:id/kyc/documents", middleware.RequireAuth())
+	{
+		documents.POST("", h.UploadDocument)
+		documents.GET("", h.ListUserDocuments)
+	}
+
+	// Review queue (admin) - the KYC decision stays with POST /users/:id/kyc/approve|reject
+	admin := rg.Group("/admin/kyc/documents", middleware.RequireRoles(middleware.RoleAdmin))
+	{
+		admin.GET("", h.ListDocuments)
+		admin.GET("/:documentId/content", h.GetDocumentContent)
+		admin.POST("/:documentId/review", h.ReviewDocument)
+	}
+}
+
+// extractUserID extracts the user id from path and checks the caller may act as the user
+func extractUserID(c *gin.Context) (string, error) {
+	userID := c.Param("id")
+	if _, err := uuid.Parse(userID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+
+	principal, _ := middleware.GetPrincipal(c)
+	if !principal.CanActAs(userID) {
+		return "", errors.Forbidden("Cannot access the KYC documents of another user")
+	}
+	return userID, nil
+}
+
+// extractDocumentID extracts and validates the document id from path
+func extractDocumentID(c *gin.Context) (string, error) {
+	documentID := c.Param("documentId")
+	if _, err := uuid.Parse(documentID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+	return documentID, nil
+}
+
+// UploadDocument godoc
+// @Summary Upload a KYC document
+// @Description Upload an identity or business document for KYC review. Files are stored encrypted and are only readable by admins.
+// @Description Accepted formats are PDF, JPEG and PNG (detected from the content), max 10MB per file by default.
+// @Description Documents can be added until KYC is VERIFIED; an admin reviews each one before approving or rejecting KYC.
+// @Tags users
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param document_type formData string true "Document type" Enums(PASSPORT, NATIONAL_ID, DRIVERS_LICENSE, PROOF_OF_ADDRESS, BUSINESS_REGISTRATION, ARTICLES_OF_INCORPORATION, OTHER)
+// @Param file formData file true "Document file (PDF, JPEG or PNG)"
+// @Success 201 {object} middleware.SuccessResponse{data=DocumentResponse} "Document uploaded"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input, unsupported format or too many documents"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 409 {object} middleware.ErrorResponse "KYC already verified"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/kyc/documents [post]
+func (h *Handler) UploadDocument(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.service.config.MaxFileSize+maxMultipartOverhead)
+	documentType := c.PostForm("document_type")
+	if !isDocumentType(documentType) {
+		middleware.RespondError(c, errors.InvalidInput(fmt.Sprintf("Invalid document_type %q", documentType)))
+		return
+	}
+	file, err := c.FormFile("file")
+	if err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Document file is required"))
+		return
+	}
+	f, err := file.Open()
+	if err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Cannot read document file"))
+		return
+	}
+	defer f.Close()
+
+	result, err := h.service.Upload(c.Request.Context(), userExternalID, documentType, file.Filename, f, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// ListUserDocuments godoc
+// @Summary List KYC documents of a user
+// @Description List the user's KYC documents with their review status, newest first
+// @Tags users
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=ListUserDocumentsResponse} "KYC documents"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid user ID"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/kyc/documents [get]
+func (h *Handler) ListUserDocuments(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	result, err := h.service.ListUserDocuments(c.Request.Context(), userExternalID)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// ListDocuments godoc
+// @Summary List KYC documents for review
+// @Description List KYC documents across users, oldest first (filter status=SUBMITTED for the review queue) - Admin only
+// @Tags kyc-documents
+// @Produce json
+// @Param status query string false "Filter by status" Enums(SUBMITTED, ACCEPTED, REJECTED)
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} middleware.SuccessResponse{data=ListDocumentsResponse} "KYC documents"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/kyc/documents [get]
+func (h *Handler) ListDocuments(c *gin.Context) {
+	var req ListDocumentsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListDocuments(c.Request.Context(), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// GetDocumentContent godoc
+// @Summary Download a KYC document
+// @Description Stream the decrypted document file - Admin only. Every access is audited.
+// @Tags kyc-documents
+// @Produce application/pdf
+// @Produce image/jpeg
+// @Produce image/png
+// @Param documentId path string true "Document external ID (UUID)"
+// @Success 200 {file} file "Document file"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid document ID"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Document not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/kyc/documents/{documentId}/content [get]
+func (h *Handler) GetDocumentContent(c *gin.Context) {
+	documentExternalID, err := extractDocumentID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	body, document, err := h.service.OpenDocument(c.Request.Context(), documentExternalID, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	defer body.Close()
+
+	c.Header("Cache-Control", "private, no-store")
+	c.DataFromReader(http.StatusOK, int64(document.SizeBytes), document.ContentType, body, map[string]string{
+		"Content-Disposition": fmt.Sprintf(`inline; filename="%s"`, document.ID),
+	})
+}
+
+// ReviewDocument godoc
+// @Summary Review a KYC document
+// @Description Accept or reject a SUBMITTED document - Admin only. A note is required when rejecting and is shown to the user.
+// @Description The user is notified with a kyc.document_reviewed event. Reviewing documents does not change the KYC status;
+// @Description approve or reject KYC with POST /users/{id}/kyc/approve|reject once the documents are reviewed.
+// @Tags kyc-documents
+// @Accept json
+// @Produce json
+// @Param documentId path string true "Document external ID (UUID)"
+// @Param request body ReviewDocumentRequest true "Review decision"
+// @Success 200 {object} middleware.SuccessResponse{data=DocumentResponse} "Document reviewed"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input or document already reviewed"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Document not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/kyc/documents/{documentId}/review [post]
+func (h *Handler) ReviewDocument(c *gin.Context) {
+	documentExternalID, err := extractDocumentID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req ReviewDocumentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ReviewDocument(c.Request.Context(), documentExternalID, &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
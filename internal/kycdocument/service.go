@@ -0,0 +1,385 @@
+package kycdocument
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/outbox"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/webhook"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/storage"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Audit log identifiers
+const (
+	actionDocumentUploaded = "KYC_DOCUMENT_UPLOADED"
+	actionDocumentViewed   = "KYC_DOCUMENT_VIEWED"
+	actionDocumentReviewed = "KYC_DOCUMENT_REVIEWED"
+	resourceKycDocument    = "KYC_DOCUMENT"
+)
+
+// allowedContentTypes are the accepted document formats (detected from the file content, not the client's header)
+var allowedContentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/jpeg":      true,
+	"image/png":       true,
+}
+
+// Config holds KYC document upload limits
+type Config struct {
+	// MaxFileSize bounds an uploaded document in bytes
+	MaxFileSize int64
+	// MaxDocumentsPerUser bounds the documents a user may upload in total
+	MaxDocumentsPerUser int
+}
+
+// Service handles KYC document uploads and reviews.
+// Files are kept in the artifact store (encrypted) under kyc-documents/{user}/; only metadata is stored in the database.
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	store    storage.Store
+	config   Config
+	logger   *zap.Logger
+}
+
+// NewService creates a new KYC document service
+// store must encrypt objects (storage.Encrypted) - documents are identity data
+func NewService(txRunner *pkgdb.TxRunner, store storage.Store, config Config, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		store:    store,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Upload stores a document submitted for the user's KYC review.
+// Documents can be added until the user's KYC is VERIFIED.
+//
+// Why:
+//   - 파일 형식은 내용으로 판별 (클라이언트 Content-Type 신뢰 안 함) → PDF/JPEG/PNG만 허용
+//   - 저장소 업로드 후 메타데이터 기록, 기록 실패 시 객체 삭제 (고아 객체 최소화)
+func (s *Service) Upload(ctx context.Context, userExternalID, documentType, fileName string, body io.Reader, actor audit.Actor) (*DocumentResponse, error) {
+	// 1. Validate user and limits
+	user, err := s.getUser(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if user.KycStatus == db.UsersKycStatusVERIFIED {
+		return nil, errors.Conflict("KYC is already verified")
+	}
+	count, err := s.txRunner.Queries().CountKycDocumentsByUser(ctx, user.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count kyc documents", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if count >= int64(s.config.MaxDocumentsPerUser) {
+		return nil, errors.InvalidInput(fmt.Sprintf("At most %d KYC documents can be uploaded", s.config.MaxDocumentsPerUser))
+	}
+
+	// 2. Read and validate the file
+	data, err := io.ReadAll(io.LimitReader(body, s.config.MaxFileSize+1))
+	if err != nil {
+		return nil, errors.InvalidInput("Cannot read document file")
+	}
+	if len(data) == 0 {
+		return nil, errors.InvalidInput("Document file is empty")
+	}
+	if int64(len(data)) > s.config.MaxFileSize {
+		return nil, errors.InvalidInput(fmt.Sprintf("Document file too large (max %d bytes)", s.config.MaxFileSize))
+	}
+	contentType := http.DetectContentType(data)
+	if !allowedContentTypes[contentType] {
+		return nil, errors.InvalidInput("Unsupported document format - upload a PDF, JPEG or PNG file")
+	}
+	sum := sha256.Sum256(data)
+
+	// 3. Store the (encrypted) file
+	key := storage.NewKey(storage.PrefixKYCDocuments+userExternalID+"/", fileName, time.Now())
+	if _, err := s.store.Put(ctx, key, bytes.NewReader(data), storage.PutOptions{
+		ContentType: contentType,
+		Size:        int64(len(data)),
+	}); err != nil {
+		logctx.From(ctx, s.logger).Error("failed to store kyc document", zap.Error(err))
+		return nil, errors.Internal("Failed to store document")
+	}
+
+	// 4. Metadata + audit (same transaction)
+	externalID := uuid.New().String()
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		result, err := q.CreateKycDocument(ctx, db.CreateKycDocumentParams{
+			ExternalID:   externalID,
+			UserID:       user.ID,
+			DocumentType: db.KycDocumentsDocumentType(documentType),
+			FileName:     truncate(fileName, 255),
+			ContentType:  contentType,
+			SizeBytes:    uint64(len(data)),
+			Sha256:       hex.EncodeToString(sum[:]),
+			StorageKey:   key,
+		})
+		if err != nil {
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionDocumentUploaded,
+			ResourceType: resourceKycDocument,
+			ResourceID:   uint64(id),
+			NewValue: map[string]any{
+				"user_id":       userExternalID,
+				"document_type": documentType,
+				"size_bytes":    len(data),
+				"sha256":        hex.EncodeToString(sum[:]),
+			},
+		})
+	})
+	if err != nil {
+		if deleteErr := s.store.Delete(ctx, key); deleteErr != nil {
+			logctx.From(ctx, s.logger).Warn("failed to delete orphaned kyc document", zap.String("key", key), zap.Error(deleteErr))
+		}
+		logctx.From(ctx, s.logger).Error("failed to record kyc document", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("kyc document uploaded",
+		zap.String("external_id", userExternalID),
+		zap.String("document_id", externalID),
+		zap.String("document_type", documentType),
+	)
+	return s.getDocument(ctx, externalID)
+}
+
+// ListUserDocuments lists the KYC documents of a user, newest first
+func (s *Service) ListUserDocuments(ctx context.Context, userExternalID string) (*ListUserDocumentsResponse, error) {
+	user, err := s.getUser(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	documents, err := s.txRunner.Queries().ListKycDocumentsByUser(ctx, user.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list kyc documents", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	responses := make([]DocumentResponse, 0, len(documents))
+	for i := range documents {
+		responses = append(responses, ToDocumentResponse(&documents[i], userExternalID))
+	}
+	return &ListUserDocumentsResponse{
+		KycStatus: string(user.KycStatus),
+		Documents: responses,
+	}, nil
+}
+
+// ListDocuments lists KYC documents across users for review, oldest first - Admin only
+func (s *Service) ListDocuments(ctx context.Context, req *ListDocumentsRequest) (*ListDocumentsResponse, error) {
+	status := db.NullKycDocumentsStatus{KycDocumentsStatus: db.KycDocumentsStatus(req.Status), Valid: req.Status != ""}
+
+	q := s.txRunner.Queries()
+	rows, err := q.ListKycDocuments(ctx, db.ListKycDocumentsParams{
+		Status: status,
+		Limit:  int32(req.PageSize),
+		Offset: int32((req.Page - 1) * req.PageSize),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list kyc documents", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	total, err := q.CountKycDocuments(ctx, status)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count kyc documents", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	responses := make([]DocumentResponse, 0, len(rows))
+	for i := range rows {
+		document := toDocument(&rows[i])
+		responses = append(responses, ToDocumentResponse(&document, rows[i].UserExternalID.String))
+	}
+	return &ListDocumentsResponse{
+		Documents:  responses,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages(total, req.PageSize),
+	}, nil
+}
+
+// OpenDocument decrypts a document for review and records the access - Admin only.
+// The caller must close the returned reader.
+func (s *Service) OpenDocument(ctx context.Context, documentExternalID string, actor audit.Actor) (io.ReadCloser, *DocumentResponse, error) {
+	document, err := s.txRunner.Queries().GetKycDocumentByExternalID(ctx, documentExternalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil, errors.NotFound("KYC document")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get kyc document", zap.Error(err))
+		return nil, nil, errors.DBError(err)
+	}
+
+	// 신분 서류 열람은 감사 로그 필수 → 기록 실패 시 열람 불가
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionDocumentViewed,
+			ResourceType: resourceKycDocument,
+			ResourceID:   document.ID,
+		})
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to record kyc document access", zap.Error(err))
+		return nil, nil, errors.DBError(err)
+	}
+
+	body, _, err := s.store.Get(ctx, document.StorageKey)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to read kyc document",
+			zap.String("document_id", documentExternalID),
+			zap.Error(err),
+		)
+		return nil, nil, errors.Internal("Failed to read document")
+	}
+	response := ToDocumentResponse(rowToDocument(&document), document.UserExternalID.String)
+	return body, &response, nil
+}
+
+// ReviewDocument accepts or rejects a submitted document - Admin only.
+// The user is notified with a kyc.document_reviewed event; the KYC decision itself stays with approve/reject.
+func (s *Service) ReviewDocument(ctx context.Context, documentExternalID string, req *ReviewDocumentRequest, actor audit.Actor) (*DocumentResponse, error) {
+	if req.Decision == DecisionReject && req.Note == "" {
+		return nil, errors.InvalidInput("A note is required when rejecting a document")
+	}
+	target := db.KycDocumentsStatusACCEPTED
+	if req.Decision == DecisionReject {
+		target = db.KycDocumentsStatusREJECTED
+	}
+
+	document, err := s.txRunner.Queries().GetKycDocumentByExternalID(ctx, documentExternalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("KYC document")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get kyc document", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		// 1. Lock + re-check state
+		locked, err := q.GetKycDocumentForUpdate(ctx, document.ID)
+		if err != nil {
+			return err
+		}
+		if locked.Status != db.KycDocumentsStatusSUBMITTED {
+			return errors.InvalidStateTransition(string(locked.Status), string(target))
+		}
+
+		// 2. Transition
+		if err := q.ReviewKycDocument(ctx, db.ReviewKycDocumentParams{
+			Status:     target,
+			ReviewNote: sql.NullString{String: req.Note, Valid: req.Note != ""},
+			ReviewedBy: sql.NullInt64{Int64: int64(actor.ID), Valid: actor.ID != 0},
+			ID:         locked.ID,
+		}); err != nil {
+			return err
+		}
+
+		// 3. Audit + domain event (same transaction)
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionDocumentReviewed,
+			ResourceType: resourceKycDocument,
+			ResourceID:   locked.ID,
+			OldValue:     map[string]any{"status": string(locked.Status)},
+			NewValue:     map[string]any{"status": string(target), "note": req.Note},
+		}); err != nil {
+			return err
+		}
+		_, err = outbox.Write(ctx, q, outbox.Message{
+			EventType:           webhook.EventKycDocumentReviewed,
+			AggregateType:       outbox.AggregateUser,
+			AggregateID:         locked.UserID,
+			AggregateExternalID: document.UserExternalID.String,
+			RecipientUserID:     locked.UserID,
+			Data: map[string]any{
+				"user_id":       document.UserExternalID.String,
+				"document_id":   locked.ExternalID,
+				"document_type": string(locked.DocumentType),
+				"status":        string(target),
+				"note":          req.Note,
+			},
+		})
+		return err
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to review kyc document", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("kyc document reviewed",
+		zap.String("document_id", documentExternalID),
+		zap.String("status", string(target)),
+	)
+	return s.getDocument(ctx, documentExternalID)
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// getUser retrieves a user by external ID (excludes DELETED)
+func (s *Service) getUser(ctx context.Context, userExternalID string) (*db.User, error) {
+	user, err := s.txRunner.Queries().GetUserByExternalID(ctx, sql.NullString{String: userExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("User")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &user, nil
+}
+
+// getDocument loads a document with its user's external ID
+func (s *Service) getDocument(ctx context.Context, documentExternalID string) (*DocumentResponse, error) {
+	document, err := s.txRunner.Queries().GetKycDocumentByExternalID(ctx, documentExternalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("KYC document")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get kyc document", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	response := ToDocumentResponse(rowToDocument(&document), document.UserExternalID.String)
+	return &response, nil
+}
+
+// totalPages computes the page count for the given total and page size
+func totalPages(total int64, pageSize int) int {
+	return int((total + int64(pageSize) - 1) / int64(pageSize))
+}
+
+// truncate shortens s to at most n runes
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
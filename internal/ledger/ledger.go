@@ -0,0 +1,254 @@
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"sort"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/google/uuid"
+)
+
+// amountScale is the scale of ledger amounts and account balances (DECIMAL(18,8))
+const amountScale = 8
+
+// Reference types of postings (the business record that moved the funds)
+const (
+	ReferencePaymentRefund   = "PAYMENT_REFUND"
+	ReferenceAccountTransfer = "ACCOUNT_TRANSFER"
+	ReferenceCreditNote      = "CREDIT_NOTE"
+)
+
+// ErrInsufficientBalance is returned when a DEBIT leg exceeds the available balance of its account
+var ErrInsufficientBalance = stderrors.New("insufficient balance")
+
+// InsufficientBalanceError carries the account that could not cover a DEBIT leg
+type InsufficientBalanceError struct {
+	AccountID uint64
+	Available money.Money
+	Requested money.Money
+}
+
+func (e *InsufficientBalanceError) Error() string {
+	return fmt.Sprintf("account %d: available %s, requested %s", e.AccountID, e.Available, e.Requested)
+}
+
+func (e *InsufficientBalanceError) Unwrap() error {
+	return ErrInsufficientBalance
+}
+
+// ErrAccountFrozen is returned when a DEBIT leg would move funds out of a frozen account
+var ErrAccountFrozen = stderrors.New("account frozen")
+
+// AccountFrozenError carries the frozen account of a rejected DEBIT leg
+type AccountFrozenError struct {
+	AccountID uint64
+}
+
+func (e *AccountFrozenError) Error() string {
+	return fmt.Sprintf("account %d: frozen", e.AccountID)
+}
+
+func (e *AccountFrozenError) Unwrap() error {
+	return ErrAccountFrozen
+}
+
+// Leg is one entry of a posting
+type Leg struct {
+	AccountID uint64
+	EntryType db.LedgerEntriesEntryType
+	Amount    money.Money
+}
+
+// Posting is a balanced set of ledger entries written under one tx_id
+type Posting struct {
+	ReferenceType string
+	ReferenceID   uint64
+	Description   string
+	Legs          []Leg
+}
+
+// Post writes a posting and updates the balance cache of its accounts.
+// Must be called within a transaction (q is tx-bound); returns the generated tx_id.
+//
+// Why:
+// - 계정 row-lock을 id 순서로 획득 → 같은 계정 쌍을 반대 방향으로 옮기는 동시 분개 간 deadlock 방지
+// - DEBIT은 가용 잔액(balance - hold_balance) 한도 내에서만 → 잔액 음수/hold 자금 유출 방지
+// - 동결(frozen) 계정의 DEBIT은 거부, CREDIT은 허용 → 동결 중에도 입금/환불 반환은 원장에 반영
+// - balance_after는 lock 하에서 계산 → 원장 순서와 잔액 캐시가 항상 일치 (runbook resync 기준)
+func Post(ctx context.Context, q *db.Queries, p Posting) (string, error) {
+	if len(p.Legs) == 0 {
+		return "", fmt.Errorf("posting has no legs")
+	}
+
+	debits := money.Zero("", amountScale)
+	credits := money.Zero("", amountScale)
+	for _, leg := range p.Legs {
+		if leg.Amount.Sign() <= 0 {
+			return "", fmt.Errorf("leg amount must be positive: %s", leg.Amount)
+		}
+		switch leg.EntryType {
+		case db.LedgerEntriesEntryTypeDEBIT:
+			debits = debits.Add(leg.Amount)
+		case db.LedgerEntriesEntryTypeCREDIT:
+			credits = credits.Add(leg.Amount)
+		default:
+			return "", fmt.Errorf("unknown entry type %q", leg.EntryType)
+		}
+	}
+	if debits.Cmp(credits) != 0 {
+		return "", fmt.Errorf("unbalanced posting: debits %s, credits %s", debits, credits)
+	}
+
+	// 1. Lock accounts in id order
+	accountIDs := make([]uint64, 0, len(p.Legs))
+	seen := make(map[uint64]bool, len(p.Legs))
+	for _, leg := range p.Legs {
+		if !seen[leg.AccountID] {
+			seen[leg.AccountID] = true
+			accountIDs = append(accountIDs, leg.AccountID)
+		}
+	}
+	sort.Slice(accountIDs, func(i, j int) bool { return accountIDs[i] < accountIDs[j] })
+
+	balances := make(map[uint64]money.Money, len(accountIDs))
+	holds := make(map[uint64]money.Money, len(accountIDs))
+	frozen := make(map[uint64]bool, len(accountIDs))
+	for _, id := range accountIDs {
+		locked, err := lockAccount(ctx, q, id)
+		if err != nil {
+			return "", err
+		}
+		balances[id] = locked.balance
+		holds[id] = locked.hold
+		frozen[id] = locked.frozen
+	}
+
+	// 2. Write entries in leg order
+	txID := uuid.New().String()
+	for _, leg := range p.Legs {
+		balance := balances[leg.AccountID]
+		if leg.EntryType == db.LedgerEntriesEntryTypeDEBIT {
+			if frozen[leg.AccountID] {
+				return "", &AccountFrozenError{AccountID: leg.AccountID}
+			}
+			available := balance.Sub(holds[leg.AccountID])
+			if available.Cmp(leg.Amount) < 0 {
+				return "", &InsufficientBalanceError{
+					AccountID: leg.AccountID,
+					Available: available.NonNegative(),
+					Requested: leg.Amount,
+				}
+			}
+			balance = balance.Sub(leg.Amount)
+		} else {
+			balance = balance.Add(leg.Amount)
+		}
+		balances[leg.AccountID] = balance
+
+		if err := q.CreateLedgerEntry(ctx, db.CreateLedgerEntryParams{
+			TxID:          txID,
+			AccountID:     leg.AccountID,
+			EntryType:     leg.EntryType,
+			Amount:        leg.Amount.String(),
+			BalanceAfter:  balance.String(),
+			ReferenceType: sql.NullString{String: p.ReferenceType, Valid: p.ReferenceType != ""},
+			ReferenceID:   sql.NullInt64{Int64: int64(p.ReferenceID), Valid: p.ReferenceID != 0},
+			Description:   sql.NullString{String: p.Description, Valid: p.Description != ""},
+		}); err != nil {
+			return "", fmt.Errorf("create ledger entry: %w", err)
+		}
+	}
+
+	// 3. Update balance cache
+	for _, id := range accountIDs {
+		if err := q.UpdateAccountBalance(ctx, db.UpdateAccountBalanceParams{
+			Balance: balances[id].String(),
+			ID:      id,
+		}); err != nil {
+			return "", fmt.Errorf("update account %d balance: %w", id, err)
+		}
+	}
+
+	return txID, nil
+}
+
+// Hold freezes up to amount of the account's available balance (balance - hold_balance) and
+// returns the amount actually held, which may be less (or zero) when the balance is short.
+// Must be called within a transaction (q is tx-bound).
+//
+// Why:
+// - 동결 금액은 DEBIT 가용 잔액에서 제외 (Post) → 분쟁 중 자금이 환불 외 용도로 빠져나가지 않음
+// - 부족분은 동결하지 않고 호출자가 기록 → 해제 시 실제 동결한 금액만 되돌림
+func Hold(ctx context.Context, q *db.Queries, accountID uint64, amount money.Money) (money.Money, error) {
+	locked, err := lockAccount(ctx, q, accountID)
+	if err != nil {
+		return money.Money{}, err
+	}
+
+	held := minMoney(amount, locked.balance.Sub(locked.hold).NonNegative())
+	if held.Sign() <= 0 {
+		return money.Zero("", amountScale), nil
+	}
+	if err := q.UpdateAccountHoldBalance(ctx, db.UpdateAccountHoldBalanceParams{
+		HoldBalance: locked.hold.Add(held).String(),
+		ID:          accountID,
+	}); err != nil {
+		return money.Money{}, fmt.Errorf("update account %d hold balance: %w", accountID, err)
+	}
+	return held, nil
+}
+
+// Release unfreezes an amount previously held by Hold.
+// Must be called within a transaction (q is tx-bound).
+func Release(ctx context.Context, q *db.Queries, accountID uint64, amount money.Money) error {
+	if amount.Sign() <= 0 {
+		return nil
+	}
+	locked, err := lockAccount(ctx, q, accountID)
+	if err != nil {
+		return err
+	}
+	if err := q.UpdateAccountHoldBalance(ctx, db.UpdateAccountHoldBalanceParams{
+		HoldBalance: locked.hold.Sub(amount).NonNegative().String(),
+		ID:          accountID,
+	}); err != nil {
+		return fmt.Errorf("update account %d hold balance: %w", accountID, err)
+	}
+	return nil
+}
+
+// lockedAccount is the state of an account read under its row lock
+type lockedAccount struct {
+	balance money.Money
+	hold    money.Money
+	frozen  bool
+}
+
+// lockAccount locks the account row and returns its balance, hold balance and freeze state
+func lockAccount(ctx context.Context, q *db.Queries, accountID uint64) (*lockedAccount, error) {
+	account, err := q.GetAccountForUpdate(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("lock account %d: %w", accountID, err)
+	}
+	balance, err := money.Parse(account.Balance, "", amountScale)
+	if err != nil {
+		return nil, fmt.Errorf("account %d balance %q: %w", accountID, account.Balance, err)
+	}
+	hold, err := money.Parse(account.HoldBalance, "", amountScale)
+	if err != nil {
+		return nil, fmt.Errorf("account %d hold balance %q: %w", accountID, account.HoldBalance, err)
+	}
+	return &lockedAccount{balance: balance, hold: hold, frozen: account.FrozenAt.Valid}, nil
+}
+
+// minMoney returns the smaller of a and b
+func minMoney(a, b money.Money) money.Money {
+	if a.Cmp(b) <= 0 {
+		return a
+	}
+	return b
+}
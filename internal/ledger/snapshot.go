@@ -0,0 +1,218 @@
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/go-sql-driver/mysql"
+	"go.uber.org/zap"
+)
+
+// mysqlErrDuplicateEntry is the MySQL error number for unique key violations
+const mysqlErrDuplicateEntry = 1062
+
+// Balance is the ledger balance of an account (CREDIT total - DEBIT total)
+type Balance struct {
+	Amount money.Money
+	// EntryCount is the number of ledger entries of the account
+	EntryCount int64
+	// LastEntryID is the id of the latest entry included (0 without entries)
+	LastEntryID uint64
+}
+
+// CurrentBalance returns the ledger balance of an account as the latest snapshot plus the entries posted since.
+// Without a snapshot every entry of the account is summed.
+//
+// Why:
+// - 전체 원장 합계는 항목 수에 비례 → 스냅샷 이후 항목만 읽어 계정 이력과 무관하게 일정한 비용
+// - 계정 항목은 row-lock 하에서만 기록 → 스냅샷 이후 커밋되는 항목의 id는 항상 last_entry_id보다 큼 (누락 없음)
+func CurrentBalance(ctx context.Context, q *db.Queries, accountID uint64) (*Balance, error) {
+	_, current, err := balanceSinceSnapshot(ctx, q, accountID)
+	return current, err
+}
+
+// Snapshot checkpoints the ledger balance of an account when at least minEntries entries were posted
+// since its latest snapshot. Reports whether a snapshot was written.
+func Snapshot(ctx context.Context, q *db.Queries, accountID uint64, minEntries int64) (bool, error) {
+	snapshotted, current, err := balanceSinceSnapshot(ctx, q, accountID)
+	if err != nil {
+		return false, err
+	}
+	if current.EntryCount-snapshotted.EntryCount < minEntries || current.LastEntryID == snapshotted.LastEntryID {
+		return false, nil
+	}
+
+	if err := q.CreateLedgerBalanceSnapshot(ctx, db.CreateLedgerBalanceSnapshotParams{
+		AccountID:   accountID,
+		LastEntryID: current.LastEntryID,
+		Balance:     current.Amount.String(),
+		EntryCount:  uint64(current.EntryCount),
+	}); err != nil {
+		// 동시 실행이 같은 지점을 먼저 기록
+		if isDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("create balance snapshot: %w", err)
+	}
+	return true, nil
+}
+
+// balanceSinceSnapshot returns the balance at the latest snapshot (zero without one) and the current balance
+func balanceSinceSnapshot(ctx context.Context, q *db.Queries, accountID uint64) (*Balance, *Balance, error) {
+	base := &Balance{Amount: money.Zero("", amountScale)}
+	snapshot, err := q.GetLatestLedgerBalanceSnapshot(ctx, accountID)
+	switch {
+	case err == nil:
+		amount, err := money.Parse(snapshot.Balance, "", amountScale)
+		if err != nil {
+			return nil, nil, fmt.Errorf("account %d snapshot balance %q: %w", accountID, snapshot.Balance, err)
+		}
+		base = &Balance{Amount: amount, EntryCount: int64(snapshot.EntryCount), LastEntryID: snapshot.LastEntryID}
+	case err != sql.ErrNoRows:
+		return nil, nil, fmt.Errorf("get balance snapshot: %w", err)
+	}
+
+	since, err := q.GetLedgerBalanceSince(ctx, db.GetLedgerBalanceSinceParams{
+		AccountID: accountID,
+		ID:        base.LastEntryID,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("get balance since entry %d: %w", base.LastEntryID, err)
+	}
+	delta, err := money.Parse(since.Balance, "", amountScale)
+	if err != nil {
+		return nil, nil, fmt.Errorf("account %d balance delta %q: %w", accountID, since.Balance, err)
+	}
+
+	current := &Balance{
+		Amount:      base.Amount.Add(delta),
+		EntryCount:  base.EntryCount + since.EntryCount,
+		LastEntryID: base.LastEntryID,
+	}
+	if since.EntryCount > 0 {
+		current.LastEntryID = uint64(since.LastEntryID)
+	}
+	return base, current, nil
+}
+
+// SnapshotConfig holds balance snapshot job settings
+type SnapshotConfig struct {
+	// Interval is the period of the snapshot run
+	Interval time.Duration
+	// BatchSize is the number of accounts read per page
+	BatchSize int
+	// MinEntries is the number of new entries since the latest snapshot that triggers a new one
+	MinEntries int64
+}
+
+// SnapshotReport summarizes one snapshot run
+type SnapshotReport struct {
+	Checked     int
+	Snapshotted int
+	Failed      int
+}
+
+// SnapshotWorker checkpoints the ledger balance of accounts with enough new entries,
+// keeping CurrentBalance reads bounded by the entries of one interval.
+type SnapshotWorker struct {
+	txRunner *pkgdb.TxRunner
+	config   SnapshotConfig
+	logger   *zap.Logger
+}
+
+// NewSnapshotWorker creates a new balance snapshot worker
+func NewSnapshotWorker(txRunner *pkgdb.TxRunner, config SnapshotConfig, logger *zap.Logger) *SnapshotWorker {
+	return &SnapshotWorker{
+		txRunner: txRunner,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Run executes the snapshot run periodically until ctx is canceled
+func (w *SnapshotWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	logctx.From(ctx, w.logger).Info("balance snapshot job started",
+		zap.Duration("interval", w.config.Interval),
+		zap.Int64("min_entries", w.config.MinEntries),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logctx.From(ctx, w.logger).Info("balance snapshot job stopped")
+			return
+		case <-ticker.C:
+			report, err := w.RunOnce(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					logctx.From(ctx, w.logger).Error("balance snapshot run failed", zap.Error(err))
+				}
+				continue
+			}
+			if report.Snapshotted+report.Failed > 0 {
+				logctx.From(ctx, w.logger).Info("balance snapshot run completed",
+					zap.Int("checked", report.Checked),
+					zap.Int("snapshotted", report.Snapshotted),
+					zap.Int("failed", report.Failed),
+				)
+			}
+		}
+	}
+}
+
+// RunOnce checks every account once; a failing account is logged and retried on the next run
+func (w *SnapshotWorker) RunOnce(ctx context.Context) (*SnapshotReport, error) {
+	report := &SnapshotReport{}
+	q := w.txRunner.Queries()
+
+	var afterID uint64
+	for {
+		accountIDs, err := q.ListAccountIDsAfter(ctx, db.ListAccountIDsAfterParams{
+			ID:    afterID,
+			Limit: int32(w.config.BatchSize),
+		})
+		if err != nil {
+			return report, fmt.Errorf("list accounts: %w", err)
+		}
+
+		for _, accountID := range accountIDs {
+			if ctx.Err() != nil {
+				return report, nil
+			}
+			created, err := Snapshot(ctx, q, accountID, w.config.MinEntries)
+			if err != nil {
+				report.Failed++
+				logctx.From(ctx, w.logger).Error("balance snapshot failed",
+					zap.Uint64("account_id", accountID),
+					zap.Error(err),
+				)
+				continue
+			}
+			report.Checked++
+			if created {
+				report.Snapshotted++
+			}
+		}
+
+		if len(accountIDs) < w.config.BatchSize {
+			return report, nil
+		}
+		afterID = accountIDs[len(accountIDs)-1]
+	}
+}
+
+// isDuplicateKeyError checks if the error is a MySQL duplicate key error
+func isDuplicateKeyError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return stderrors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
@@ -0,0 +1,96 @@
+package legalhold
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// PlaceLegalHoldRequest represents the request body for placing a legal hold
+// NOTE: subject_id는 USER → 사용자 external ID, ORDER → 주문번호
+type PlaceLegalHoldRequest struct {
+	SubjectType   string `json:"subject_type" binding:"required,oneof=USER ORDER" example:"USER"`
+	SubjectID     string `json:"subject_id" binding:"required,max=64" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Reason        string `json:"reason" binding:"required,min=1,max=500" example:"Litigation hold - Acme v. Example"`
+	CaseReference string `json:"case_reference,omitempty" binding:"omitempty,max=100" example:"LEGAL-2024-017"`
+}
+
+// ReleaseLegalHoldRequest represents the request body for releasing a legal hold
+type ReleaseLegalHoldRequest struct {
+	Reason string `json:"reason" binding:"required,min=1,max=500" example:"Case closed"`
+}
+
+// ListLegalHoldsRequest represents query parameters for listing legal holds
+type ListLegalHoldsRequest struct {
+	Active      *bool  `form:"active"`
+	SubjectType string `form:"subject_type" binding:"omitempty,oneof=USER ORDER"`
+	Page        int    `form:"page,default=1" binding:"min=1"`
+	PageSize    int    `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// LegalHoldResponse represents a legal hold in API responses
+type LegalHoldResponse struct {
+	ID            string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	SubjectType   string     `json:"subject_type" example:"USER"`
+	SubjectID     string     `json:"subject_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Reason        string     `json:"reason" example:"Litigation hold - Acme v. Example"`
+	CaseReference string     `json:"case_reference,omitempty" example:"LEGAL-2024-017"`
+	Active        bool       `json:"active" example:"true"`
+	PlacedAt      time.Time  `json:"placed_at"`
+	ReleasedAt    *time.Time `json:"released_at,omitempty"`
+	ReleaseReason string     `json:"release_reason,omitempty" example:"Case closed"`
+}
+
+// ListLegalHoldsResponse represents paginated legal hold list
+type ListLegalHoldsResponse struct {
+	LegalHolds []LegalHoldResponse `json:"legal_holds"`
+	Total      int64               `json:"total"`
+	Page       int                 `json:"page"`
+	PageSize   int                 `json:"page_size"`
+	TotalPages int                 `json:"total_pages"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToLegalHoldResponse converts db.LegalHold to LegalHoldResponse
+func ToLegalHoldResponse(hold *db.LegalHold) *LegalHoldResponse {
+	if hold == nil {
+		return nil
+	}
+
+	response := &LegalHoldResponse{
+		ID:            hold.ExternalID,
+		SubjectType:   string(hold.SubjectType),
+		SubjectID:     hold.SubjectRef,
+		Reason:        hold.Reason,
+		CaseReference: hold.CaseReference.String,
+		Active:        !hold.ReleasedAt.Valid,
+		PlacedAt:      hold.PlacedAt,
+		ReleaseReason: hold.ReleaseReason.String,
+	}
+
+	if hold.ReleasedAt.Valid {
+		response.ReleasedAt = &hold.ReleasedAt.Time
+	}
+
+	return response
+}
+
+// ToLegalHoldResponseList converts []db.LegalHold to []LegalHoldResponse
+func ToLegalHoldResponseList(holds []db.LegalHold) []LegalHoldResponse {
+	responses := make([]LegalHoldResponse, 0, len(holds))
+	for _, hold := range holds {
+		responses = append(responses, *ToLegalHoldResponse(&hold))
+	}
+	return responses
+}
@@ -0,0 +1,178 @@
+package legalhold
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for legal hold operations
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new legal hold handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers legal hold routes on the router group (compliance admins only)
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	holds := rg.Group("/admin/legal-holds", middleware.RequireCompliance())
+	{
+		holds.POST("", h.PlaceHold)
+		holds.GET("", h.ListHolds)
+		holds.GET("/:holdId", h.GetHold)
+		holds.POST("/:holdId/release", h.ReleaseHold)
+	}
+}
+
+// extractAndValidateHoldID extracts and validates holdId from path
+func extractAndValidateHoldID(c *gin.Context) (string, error) {
+	holdID := c.Param("holdId")
+	if _, err := uuid.Parse(holdID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+	return holdID, nil
+}
+
+// PlaceHold godoc
+// @Summary Place a legal hold
+// @Description Exempt a user's or order's data from retention purges and deletion until released - Compliance admins only.
+// @Description subject_id is the user external ID (USER) or the order number (ORDER). Audited.
+// @Tags legal-holds
+// @Accept json
+// @Produce json
+// @Param request body PlaceLegalHoldRequest true "Hold subject and reason"
+// @Success 201 {object} middleware.SuccessResponse{data=LegalHoldResponse} "Legal hold placed"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Compliance role required"
+// @Failure 404 {object} middleware.ErrorResponse "Subject not found"
+// @Failure 409 {object} middleware.ErrorResponse "Subject is already under an active legal hold"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/legal-holds [post]
+func (h *Handler) PlaceHold(c *gin.Context) {
+	var req PlaceLegalHoldRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	hold, err := h.service.PlaceHold(c.Request.Context(), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, ToLegalHoldResponse(hold))
+}
+
+// ListHolds godoc
+// @Summary List legal holds
+// @Description Get paginated legal holds with optional filters (newest first) - Compliance admins only
+// @Description Send Accept: text/csv to receive the same results (same filters) as CSV.
+// @Tags legal-holds
+// @Produce json,text/csv
+// @Param active query bool false "Filter by active (unreleased) holds"
+// @Param subject_type query string false "Filter by subject type" Enums(USER, ORDER)
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} middleware.SuccessResponse{data=ListLegalHoldsResponse} "Legal hold list"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid query parameters"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Compliance role required"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/legal-holds [get]
+func (h *Handler) ListHolds(c *gin.Context) {
+	var req ListLegalHoldsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListHolds(c.Request.Context(), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOKNegotiated(c, "legal-holds", result, result.LegalHolds)
+}
+
+// GetHold godoc
+// @Summary Get a legal hold
+// @Description Get a legal hold by ID - Compliance admins only
+// @Tags legal-holds
+// @Produce json
+// @Param holdId path string true "Legal hold ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=LegalHoldResponse} "Legal hold"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Compliance role required"
+// @Failure 404 {object} middleware.ErrorResponse "Legal hold not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/legal-holds/{holdId} [get]
+func (h *Handler) GetHold(c *gin.Context) {
+	holdID, err := extractAndValidateHoldID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	hold, err := h.service.GetHold(c.Request.Context(), holdID)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, ToLegalHoldResponse(hold))
+}
+
+// ReleaseHold godoc
+// @Summary Release a legal hold
+// @Description Release an active legal hold; the subject's data becomes subject to retention again - Compliance admins only. Audited.
+// @Tags legal-holds
+// @Accept json
+// @Produce json
+// @Param holdId path string true "Legal hold ID (UUID)"
+// @Param request body ReleaseLegalHoldRequest true "Release reason"
+// @Success 200 {object} middleware.SuccessResponse{data=LegalHoldResponse} "Legal hold released"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Compliance role required"
+// @Failure 404 {object} middleware.ErrorResponse "Legal hold not found"
+// @Failure 409 {object} middleware.ErrorResponse "Legal hold already released"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/legal-holds/{holdId}/release [post]
+func (h *Handler) ReleaseHold(c *gin.Context) {
+	holdID, err := extractAndValidateHoldID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req ReleaseLegalHoldRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	hold, err := h.service.ReleaseHold(c.Request.Context(), holdID, &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, ToLegalHoldResponse(hold))
+}
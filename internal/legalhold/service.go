@@ -0,0 +1,262 @@
+package legalhold
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// MySQL error codes
+const (
+	mysqlErrDuplicateEntry = 1062
+)
+
+// Audit log identifiers
+const (
+	actionPlaceHold   = "LEGAL_HOLD_PLACED"
+	actionReleaseHold = "LEGAL_HOLD_RELEASED"
+	resourceLegalHold = "LEGAL_HOLD"
+)
+
+// Service manages legal holds (compliance admins only)
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	logger   *zap.Logger
+}
+
+// NewService creates a new legal hold service
+func NewService(txRunner *pkgdb.TxRunner, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		logger:   logger,
+	}
+}
+
+// PlaceHold places a legal hold on a user or order. The subject's data is then
+// exempt from retention purges and deletion until the hold is released.
+func (s *Service) PlaceHold(ctx context.Context, req *PlaceLegalHoldRequest, actor audit.Actor) (*db.LegalHold, error) {
+	return pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*db.LegalHold, error) {
+		// 1. Resolve subject
+		subjectID, err := s.resolveSubject(ctx, q, req.SubjectType, req.SubjectID)
+		if err != nil {
+			return nil, err
+		}
+
+		// 2. Insert hold (uk_legal_hold_active_subject → 대상당 활성 hold 1건)
+		result, err := q.CreateLegalHold(ctx, db.CreateLegalHoldParams{
+			ExternalID:    uuid.New().String(),
+			SubjectType:   db.LegalHoldsSubjectType(req.SubjectType),
+			SubjectID:     subjectID,
+			SubjectRef:    req.SubjectID,
+			Reason:        req.Reason,
+			CaseReference: sql.NullString{String: req.CaseReference, Valid: req.CaseReference != ""},
+			PlacedBy:      actor.ID,
+		})
+		if err != nil {
+			if isDuplicateKeyError(err) {
+				return nil, errors.Conflict("Subject is already under an active legal hold")
+			}
+			logctx.From(ctx, s.logger).Error("failed to create legal hold", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		holdID, err := result.LastInsertId()
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+		hold, err := q.GetLegalHoldByID(ctx, uint64(holdID))
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+
+		// 3. Audit (same transaction)
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionPlaceHold,
+			ResourceType: resourceLegalHold,
+			ResourceID:   hold.ID,
+			NewValue: map[string]any{
+				"subject_type":   req.SubjectType,
+				"subject_id":     req.SubjectID,
+				"reason":         req.Reason,
+				"case_reference": req.CaseReference,
+			},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		logctx.From(ctx, s.logger).Info("legal hold placed",
+			zap.String("legal_hold_external_id", hold.ExternalID),
+			zap.String("subject_type", req.SubjectType),
+			zap.String("request_id", actor.RequestID),
+		)
+
+		return &hold, nil
+	})
+}
+
+// ReleaseHold releases an active legal hold
+func (s *Service) ReleaseHold(ctx context.Context, holdExternalID string, req *ReleaseLegalHoldRequest, actor audit.Actor) (*db.LegalHold, error) {
+	return pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*db.LegalHold, error) {
+		// 1. Lock hold row
+		hold, err := q.GetLegalHoldByExternalIDForUpdate(ctx, holdExternalID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, errors.NotFound("Legal hold")
+			}
+			logctx.From(ctx, s.logger).Error("failed to lock legal hold", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		if hold.ReleasedAt.Valid {
+			return nil, errors.Conflict("Legal hold already released")
+		}
+
+		// 2. Release
+		if _, err := q.ReleaseLegalHold(ctx, db.ReleaseLegalHoldParams{
+			ReleasedBy:    sql.NullInt64{Int64: int64(actor.ID), Valid: true},
+			ReleaseReason: sql.NullString{String: req.Reason, Valid: true},
+			ID:            hold.ID,
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to release legal hold", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		// 3. Audit (same transaction)
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionReleaseHold,
+			ResourceType: resourceLegalHold,
+			ResourceID:   hold.ID,
+			OldValue:     map[string]any{"active": true},
+			NewValue:     map[string]any{"active": false, "reason": req.Reason},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		released, err := q.GetLegalHoldByID(ctx, hold.ID)
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+
+		logctx.From(ctx, s.logger).Info("legal hold released",
+			zap.String("legal_hold_external_id", holdExternalID),
+			zap.String("request_id", actor.RequestID),
+		)
+
+		return &released, nil
+	})
+}
+
+// GetHold retrieves a legal hold by external ID
+func (s *Service) GetHold(ctx context.Context, holdExternalID string) (*db.LegalHold, error) {
+	hold, err := s.txRunner.Queries().GetLegalHoldByExternalID(ctx, holdExternalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Legal hold")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get legal hold", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &hold, nil
+}
+
+// ListHolds retrieves legal holds with optional filters (newest first)
+func (s *Service) ListHolds(ctx context.Context, req *ListLegalHoldsRequest) (*ListLegalHoldsResponse, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	var active sql.NullBool
+	if req.Active != nil {
+		active = sql.NullBool{Bool: *req.Active, Valid: true}
+	}
+	var subjectType db.NullLegalHoldsSubjectType
+	if req.SubjectType != "" {
+		subjectType = db.NullLegalHoldsSubjectType{LegalHoldsSubjectType: db.LegalHoldsSubjectType(req.SubjectType), Valid: true}
+	}
+
+	holds, err := s.txRunner.Queries().ListLegalHolds(ctx, db.ListLegalHoldsParams{
+		IsActive:    active,
+		SubjectType: subjectType,
+		Limit:       int32(req.PageSize),
+		Offset:      int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list legal holds", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := s.txRunner.Queries().CountLegalHolds(ctx, db.CountLegalHoldsParams{
+		IsActive:    active,
+		SubjectType: subjectType,
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count legal holds", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListLegalHoldsResponse{
+		LegalHolds: ToLegalHoldResponseList(holds),
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// IsHeld reports whether the subject is under an active legal hold.
+// Callers that delete or anonymize data must check it within their transaction.
+func IsHeld(ctx context.Context, q *db.Queries, subjectType db.LegalHoldsSubjectType, subjectID uint64) (bool, error) {
+	return q.ExistsActiveLegalHold(ctx, db.ExistsActiveLegalHoldParams{
+		SubjectType: subjectType,
+		SubjectID:   subjectID,
+	})
+}
+
+// resolveSubject maps the external subject reference to its internal ID
+func (s *Service) resolveSubject(ctx context.Context, q *db.Queries, subjectType, subjectRef string) (uint64, error) {
+	switch db.LegalHoldsSubjectType(subjectType) {
+	case db.LegalHoldsSubjectTypeUSER:
+		user, err := q.GetUserByExternalID(ctx, sql.NullString{String: subjectRef, Valid: true})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return 0, errors.NotFound("User")
+			}
+			return 0, errors.DBError(err)
+		}
+		return user.ID, nil
+	case db.LegalHoldsSubjectTypeORDER:
+		order, err := q.GetOrderByOrderNumber(ctx, subjectRef)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return 0, errors.NotFound("Order")
+			}
+			return 0, errors.DBError(err)
+		}
+		return order.ID, nil
+	default:
+		return 0, errors.InvalidInput("Unsupported subject type")
+	}
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// isDuplicateKeyError checks if the error is a MySQL duplicate key error
+func isDuplicateKeyError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return stderrors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
@@ -0,0 +1,46 @@
+package limits
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// SetLimitsRequest replaces the limit overrides of an account
+// NOTE: 생략/빈 값 = 소유자 KYC 상태별 기본값 사용, "0" = 해당 유형 차단
+type SetLimitsRequest struct {
+	DailySpend        string `json:"daily_spend,omitempty" binding:"omitempty,max=32" example:"5000.00"`
+	MonthlySpend      string `json:"monthly_spend,omitempty" binding:"omitempty,max=32" example:"50000.00"`
+	DailyWithdrawal   string `json:"daily_withdrawal,omitempty" binding:"omitempty,max=32" example:"2500.00"`
+	MonthlyWithdrawal string `json:"monthly_withdrawal,omitempty" binding:"omitempty,max=32" example:"25000.00"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// LimitResponse represents one limit of an account with its usage in the current period
+type LimitResponse struct {
+	// Name is the limit name: daily_spend, monthly_spend, daily_withdrawal or monthly_withdrawal
+	Name   string `json:"name" example:"daily_spend"`
+	Kind   string `json:"kind" example:"SPEND"`
+	Period string `json:"period" example:"DAILY"`
+	// PeriodStart is the UTC start of the current period (00:00 of the day, 1st of the month)
+	PeriodStart time.Time   `json:"period_start"`
+	Limit       money.Money `json:"limit" swaggertype:"string" example:"1000.00000000"`
+	Used        money.Money `json:"used" swaggertype:"string" example:"250.00000000"`
+	Remaining   money.Money `json:"remaining" swaggertype:"string" example:"750.00000000"`
+	// Custom is set when the limit is an account override rather than the KYC default
+	Custom bool `json:"custom" example:"false"`
+}
+
+// LimitsResponse represents the spending and withdrawal limits of an account
+type LimitsResponse struct {
+	AccountID string          `json:"account_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	KycStatus string          `json:"kyc_status" example:"VERIFIED"`
+	Limits    []LimitResponse `json:"limits"`
+}
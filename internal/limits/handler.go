@@ -0,0 +1,158 @@
+package limits
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for account limits
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new account limits handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers account limit routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	users := rg.Group("/users/:id", middleware.RequireAuth())
+	{
+		users.GET("/limits", h.GetUserLimits)
+	}
+
+	admin := rg.Group("/admin/accounts", middleware.RequireRoles(middleware.RoleAdmin))
+	{
+		admin.GET("/:accountId/limits", h.GetAccountLimits)
+		admin.PUT("/:accountId/limits", h.SetAccountLimits)
+	}
+}
+
+// extractUserID extracts the user id from path and checks the caller may act as the user
+func extractUserID(c *gin.Context) (string, error) {
+	userID := c.Param("id")
+	if _, err := uuid.Parse(userID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+
+	principal, _ := middleware.GetPrincipal(c)
+	if !principal.CanActAs(userID) {
+		return "", errors.Forbidden("Cannot access limits of another user")
+	}
+	return userID, nil
+}
+
+// extractAndValidateAccountID extracts and validates accountId from path
+func extractAndValidateAccountID(c *gin.Context) (string, error) {
+	accountID := c.Param("accountId")
+	if _, err := uuid.Parse(accountID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+	return accountID, nil
+}
+
+// GetUserLimits godoc
+// @Summary Get spending and withdrawal limits
+// @Description Get the daily/monthly spending and withdrawal limits of the user's account with the usage of the current periods (UTC).
+// @Description Payment authorizations count as spend, payouts as withdrawal; exceeding a limit is rejected with LIMIT_EXCEEDED.
+// @Tags limits
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=LimitsResponse} "Limits"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid user ID"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "User or account not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/limits [get]
+func (h *Handler) GetUserLimits(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	result, err := h.service.GetUserLimits(c.Request.Context(), userExternalID, time.Now())
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// GetAccountLimits godoc
+// @Summary Get account limits
+// @Description Get the limits of an account with the usage of the current periods - Admin only
+// @Tags limits
+// @Produce json
+// @Param accountId path string true "Account external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=LimitsResponse} "Limits"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input or system account"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Account not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/accounts/{accountId}/limits [get]
+func (h *Handler) GetAccountLimits(c *gin.Context) {
+	accountID, err := extractAndValidateAccountID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	result, err := h.service.GetAccountLimits(c.Request.Context(), accountID, time.Now())
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// SetAccountLimits godoc
+// @Summary Set account limits
+// @Description Replace the limit overrides of an account - Admin only. Omitted limits use the defaults of the owner's KYC status
+// @Description (ACCOUNT_LIMIT_DEFAULTS); "0" blocks the operation. Audited.
+// @Tags limits
+// @Accept json
+// @Produce json
+// @Param accountId path string true "Account external ID (UUID)"
+// @Param request body SetLimitsRequest true "Limit overrides"
+// @Success 200 {object} middleware.SuccessResponse{data=LimitsResponse} "Limits set"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input or system account"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Account not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/accounts/{accountId}/limits [put]
+func (h *Handler) SetAccountLimits(c *gin.Context) {
+	accountID, err := extractAndValidateAccountID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req SetLimitsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.SetAccountLimits(c.Request.Context(), accountID, &req, audit.ActorFromContext(c), time.Now())
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
@@ -0,0 +1,259 @@
+package limits
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// amountScale is the scale of limits and usage amounts (DECIMAL(18,8))
+const amountScale = 8
+
+// Limit kinds: payment authorizations count as spend, payouts/withdrawals as withdrawal
+const (
+	KindSpend      = db.AccountLimitUsageKindSPEND
+	KindWithdrawal = db.AccountLimitUsageKindWITHDRAWAL
+)
+
+// periods in lock order (일 → 월 고정 순서로 row-lock)
+var periods = []db.AccountLimitUsagePeriod{
+	db.AccountLimitUsagePeriodDAILY,
+	db.AccountLimitUsagePeriodMONTHLY,
+}
+
+// Limits are the daily and monthly caps of an account
+type Limits struct {
+	DailySpend        money.Money
+	MonthlySpend      money.Money
+	DailyWithdrawal   money.Money
+	MonthlyWithdrawal money.Money
+}
+
+// Of returns the cap of a kind and period
+func (l Limits) Of(kind db.AccountLimitUsageKind, period db.AccountLimitUsagePeriod) money.Money {
+	switch {
+	case kind == KindSpend && period == db.AccountLimitUsagePeriodDAILY:
+		return l.DailySpend
+	case kind == KindSpend:
+		return l.MonthlySpend
+	case period == db.AccountLimitUsagePeriodDAILY:
+		return l.DailyWithdrawal
+	default:
+		return l.MonthlyWithdrawal
+	}
+}
+
+// Defaults are the limits of accounts without an override, by the owner's KYC status
+type Defaults map[db.UsersKycStatus]Limits
+
+// ParseDefaults parses "KYC_STATUS:daily_spend:monthly_spend:daily_withdrawal:monthly_withdrawal" entries
+// (e.g. "VERIFIED:100000:1000000:100000:1000000"). Call Validate on the result before use.
+func ParseDefaults(entries []string) (Defaults, error) {
+	defaults := make(Defaults, len(entries))
+	for _, entry := range entries {
+		parts := strings.Split(strings.TrimSpace(entry), ":")
+		if len(parts) != 5 {
+			return nil, fmt.Errorf("limits: default %q must be KYC_STATUS:daily_spend:monthly_spend:daily_withdrawal:monthly_withdrawal", entry)
+		}
+		amounts := make([]money.Money, 4)
+		for i, part := range parts[1:] {
+			amount, err := money.Parse(strings.TrimSpace(part), "", amountScale)
+			if err != nil {
+				return nil, fmt.Errorf("limits: default %q amount %q: %w", entry, part, err)
+			}
+			amounts[i] = amount
+		}
+		status := db.UsersKycStatus(strings.ToUpper(strings.TrimSpace(parts[0])))
+		if _, ok := defaults[status]; ok {
+			return nil, fmt.Errorf("limits: duplicate default for KYC status %s", status)
+		}
+		defaults[status] = Limits{
+			DailySpend:        amounts[0],
+			MonthlySpend:      amounts[1],
+			DailyWithdrawal:   amounts[2],
+			MonthlyWithdrawal: amounts[3],
+		}
+	}
+	return defaults, nil
+}
+
+// Validate checks the defaults are usable: known KYC statuses, a NONE fallback and non-negative amounts
+func (d Defaults) Validate() error {
+	if _, ok := d[db.UsersKycStatusNONE]; !ok {
+		return fmt.Errorf("limits: defaults must include KYC status %s", db.UsersKycStatusNONE)
+	}
+	for status, l := range d {
+		switch status {
+		case db.UsersKycStatusNONE, db.UsersKycStatusPENDING, db.UsersKycStatusVERIFIED, db.UsersKycStatusREJECTED:
+		default:
+			return fmt.Errorf("limits: unknown KYC status %q", status)
+		}
+		for _, amount := range []money.Money{l.DailySpend, l.MonthlySpend, l.DailyWithdrawal, l.MonthlyWithdrawal} {
+			if amount.Sign() < 0 {
+				return fmt.Errorf("limits: %s limit must not be negative, got %s", status, amount)
+			}
+		}
+	}
+	return nil
+}
+
+// For returns the defaults of a KYC status (NONE when the status has no entry)
+func (d Defaults) For(status db.UsersKycStatus) Limits {
+	if l, ok := d[status]; ok {
+		return l
+	}
+	return d[db.UsersKycStatusNONE]
+}
+
+// Effective is the resolved limits of an account
+type Effective struct {
+	Limits
+	KycStatus db.UsersKycStatus
+	// Override is the admin override row (nil = KYC defaults only)
+	Override *db.AccountLimit
+}
+
+// Enforcer applies account limits inside payment and payout transactions
+type Enforcer struct {
+	defaults Defaults
+}
+
+// NewEnforcer creates a new limit enforcer
+func NewEnforcer(defaults Defaults) *Enforcer {
+	return &Enforcer{defaults: defaults}
+}
+
+// PeriodStart returns the UTC start of the limit period containing now (day or calendar month)
+func PeriodStart(period db.AccountLimitUsagePeriod, now time.Time) time.Time {
+	now = now.UTC()
+	if period == db.AccountLimitUsagePeriodMONTHLY {
+		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Resolve returns the limits of an account owned by a user: the KYC defaults of the owner,
+// with the columns set in the account's override replacing them.
+func (e *Enforcer) Resolve(ctx context.Context, q *db.Queries, account *db.Account) (*Effective, error) {
+	owner, err := q.GetUserByID(ctx, uint64(account.OwnerID.Int64))
+	if err != nil {
+		return nil, fmt.Errorf("get account owner: %w", err)
+	}
+	effective := &Effective{
+		Limits:    e.defaults.For(owner.KycStatus),
+		KycStatus: owner.KycStatus,
+	}
+
+	override, err := q.GetAccountLimit(ctx, account.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return effective, nil
+		}
+		return nil, fmt.Errorf("get account limit: %w", err)
+	}
+	effective.Override = &override
+	for _, col := range []struct {
+		value  sql.NullString
+		target *money.Money
+	}{
+		{override.DailySpendLimit, &effective.DailySpend},
+		{override.MonthlySpendLimit, &effective.MonthlySpend},
+		{override.DailyWithdrawalLimit, &effective.DailyWithdrawal},
+		{override.MonthlyWithdrawalLimit, &effective.MonthlyWithdrawal},
+	} {
+		if !col.value.Valid {
+			continue
+		}
+		amount, err := money.Parse(col.value.String, "", amountScale)
+		if err != nil {
+			return nil, fmt.Errorf("invalid account limit %q: %w", col.value.String, err)
+		}
+		*col.target = amount
+	}
+	return effective, nil
+}
+
+// Reserve counts amount against the account's daily and monthly limits of the kind, or rejects it with
+// LIMIT_EXCEEDED when either would be exceeded. Accounts without an owner (system accounts) are not limited.
+// Callers must reserve within the transaction that records the movement, so a rollback releases its usage.
+// KindWithdrawal is reserved by settlement batch cutoff, net payout creation and account transfers.
+// NOTE: KindSpend is not enforced yet - payments are authorized outside this service (no payment creation
+// path in this tree); the payment authorization transaction must reserve it once it lands here.
+//
+// Why:
+//   - 계정 row-lock(GetAccountForUpdate)으로 같은 계정의 동시 결제/지급을 직렬화 → 둘 다 잔여 한도를 보고 통과하는 경합 없음
+//   - 사용액은 기간 카운터 row에 누적 (결제/지급 테이블 합계 대신) → 검증 비용이 기간 내 건수와 무관
+//   - 카운터 row-lock은 일 → 월 고정 순서 → deadlock 방지
+func (e *Enforcer) Reserve(ctx context.Context, q *db.Queries, accountID uint64, kind db.AccountLimitUsageKind, amount money.Money, now time.Time) error {
+	if amount.Sign() <= 0 {
+		return nil
+	}
+
+	// 1. Lock account row (serializes the account's limited operations)
+	account, err := q.GetAccountForUpdate(ctx, accountID)
+	if err != nil {
+		return errors.DBError(err)
+	}
+	if !account.OwnerID.Valid {
+		return nil
+	}
+	effective, err := e.Resolve(ctx, q, &account)
+	if err != nil {
+		return errors.DBError(err)
+	}
+
+	// 2. Check every period before counting (한 기간이라도 초과하면 아무것도 반영하지 않음)
+	usages := make([]db.AccountLimitUsage, 0, len(periods))
+	for _, period := range periods {
+		start := PeriodStart(period, now)
+		if err := q.EnsureAccountLimitUsage(ctx, db.EnsureAccountLimitUsageParams{
+			AccountID:   accountID,
+			Kind:        kind,
+			Period:      period,
+			PeriodStart: start,
+		}); err != nil {
+			return errors.DBError(err)
+		}
+		usage, err := q.GetAccountLimitUsageForUpdate(ctx, db.GetAccountLimitUsageForUpdateParams{
+			AccountID:   accountID,
+			Kind:        kind,
+			Period:      period,
+			PeriodStart: start,
+		})
+		if err != nil {
+			return errors.DBError(err)
+		}
+		used, err := money.Parse(usage.Amount, "", amountScale)
+		if err != nil {
+			return errors.Internal("Invalid limit usage amount")
+		}
+
+		remaining := effective.Of(kind, period).Sub(used)
+		if remaining.Cmp(amount) < 0 {
+			return errors.LimitExceeded(LimitName(kind, period), remaining.NonNegative().String(), amount.String())
+		}
+		usages = append(usages, usage)
+	}
+
+	// 3. Count
+	for _, usage := range usages {
+		if err := q.AddAccountLimitUsage(ctx, db.AddAccountLimitUsageParams{
+			Amount: amount.String(),
+			ID:     usage.ID,
+		}); err != nil {
+			return errors.DBError(err)
+		}
+	}
+	return nil
+}
+
+// LimitName is the API name of a limit (e.g. daily_spend)
+func LimitName(kind db.AccountLimitUsageKind, period db.AccountLimitUsagePeriod) string {
+	return strings.ToLower(string(period)) + "_" + strings.ToLower(string(kind))
+}
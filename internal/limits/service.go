@@ -0,0 +1,247 @@
+package limits
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"go.uber.org/zap"
+)
+
+// Audit log identifiers
+const (
+	actionLimitsSet = "ACCOUNT_LIMITS_SET"
+	resourceAccount = "ACCOUNT"
+)
+
+// Service exposes account limits and admin overrides
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	enforcer *Enforcer
+	logger   *zap.Logger
+}
+
+// NewService creates a new account limits service
+func NewService(txRunner *pkgdb.TxRunner, enforcer *Enforcer, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		enforcer: enforcer,
+		logger:   logger,
+	}
+}
+
+// GetUserLimits returns the limits and current usage of the user's account
+func (s *Service) GetUserLimits(ctx context.Context, userExternalID string, now time.Time) (*LimitsResponse, error) {
+	q := s.txRunner.Queries()
+
+	user, err := q.GetUserByExternalID(ctx, sql.NullString{String: userExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("User")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	account, err := q.GetAccountByOwnerID(ctx, sql.NullInt64{Int64: int64(user.ID), Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Account")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get account", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return s.limitsResponse(ctx, q, &account, now)
+}
+
+// GetAccountLimits returns the limits and current usage of an account
+func (s *Service) GetAccountLimits(ctx context.Context, accountExternalID string, now time.Time) (*LimitsResponse, error) {
+	q := s.txRunner.Queries()
+
+	account, err := s.getLimitedAccount(ctx, q, accountExternalID)
+	if err != nil {
+		return nil, err
+	}
+	return s.limitsResponse(ctx, q, account, now)
+}
+
+// SetAccountLimits replaces the limit overrides of an account (omitted limits fall back to the KYC defaults)
+func (s *Service) SetAccountLimits(ctx context.Context, accountExternalID string, req *SetLimitsRequest, actor audit.Actor, now time.Time) (*LimitsResponse, error) {
+	// 1. Validate
+	params := db.CreateOrUpdateAccountLimitParams{
+		UpdatedBy: sql.NullInt64{Int64: int64(actor.ID), Valid: actor.ID != 0},
+	}
+	for _, field := range []struct {
+		name   string
+		value  string
+		target *sql.NullString
+	}{
+		{"daily_spend", req.DailySpend, &params.DailySpendLimit},
+		{"monthly_spend", req.MonthlySpend, &params.MonthlySpendLimit},
+		{"daily_withdrawal", req.DailyWithdrawal, &params.DailyWithdrawalLimit},
+		{"monthly_withdrawal", req.MonthlyWithdrawal, &params.MonthlyWithdrawalLimit},
+	} {
+		value := strings.TrimSpace(field.value)
+		if value == "" {
+			continue
+		}
+		amount, err := money.Parse(value, "", amountScale)
+		if err != nil || amount.Sign() < 0 {
+			return nil, errors.InvalidInput(field.name + " must be a non-negative decimal with at most 8 decimals")
+		}
+		*field.target = sql.NullString{String: amount.String(), Valid: true}
+	}
+
+	// 2. Upsert + audit (same transaction)
+	return pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*LimitsResponse, error) {
+		account, err := s.getLimitedAccount(ctx, q, accountExternalID)
+		if err != nil {
+			return nil, err
+		}
+		params.AccountID = account.ID
+
+		var old map[string]any
+		previous, err := q.GetAccountLimit(ctx, account.ID)
+		switch {
+		case err == nil:
+			old = overrideValues(previous.DailySpendLimit, previous.MonthlySpendLimit, previous.DailyWithdrawalLimit, previous.MonthlyWithdrawalLimit)
+		case err != sql.ErrNoRows:
+			logctx.From(ctx, s.logger).Error("failed to get account limit", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		if err := q.CreateOrUpdateAccountLimit(ctx, params); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to set account limit", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionLimitsSet,
+			ResourceType: resourceAccount,
+			ResourceID:   account.ID,
+			OldValue:     old,
+			NewValue:     overrideValues(params.DailySpendLimit, params.MonthlySpendLimit, params.DailyWithdrawalLimit, params.MonthlyWithdrawalLimit),
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		logctx.From(ctx, s.logger).Info("account limits set",
+			zap.String("account_external_id", accountExternalID),
+			zap.String("request_id", actor.RequestID),
+		)
+
+		return s.limitsResponse(ctx, q, account, now)
+	})
+}
+
+// getLimitedAccount resolves an open user account by external ID (system accounts have no limits)
+func (s *Service) getLimitedAccount(ctx context.Context, q *db.Queries, accountExternalID string) (*db.Account, error) {
+	account, err := q.GetAccountByExternalID(ctx, sql.NullString{String: accountExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Account")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get account", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if !account.OwnerID.Valid {
+		return nil, errors.InvalidInput("System accounts have no spending or withdrawal limits")
+	}
+	return &account, nil
+}
+
+// limitsResponse resolves the effective limits of the account with the usage of the current periods
+func (s *Service) limitsResponse(ctx context.Context, q *db.Queries, account *db.Account, now time.Time) (*LimitsResponse, error) {
+	effective, err := s.enforcer.Resolve(ctx, q, account)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to resolve account limits", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	dayStart := PeriodStart(db.AccountLimitUsagePeriodDAILY, now)
+	monthStart := PeriodStart(db.AccountLimitUsagePeriodMONTHLY, now)
+	usages, err := q.ListAccountLimitUsage(ctx, db.ListAccountLimitUsageParams{
+		AccountID:  account.ID,
+		DayStart:   dayStart,
+		MonthStart: monthStart,
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list account limit usage", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	used := make(map[string]money.Money, len(usages))
+	for _, u := range usages {
+		amount, err := money.Parse(u.Amount, "", amountScale)
+		if err != nil {
+			return nil, errors.Internal("Invalid limit usage amount")
+		}
+		used[LimitName(u.Kind, u.Period)] = amount
+	}
+
+	response := &LimitsResponse{
+		AccountID: account.ExternalID.String,
+		KycStatus: string(effective.KycStatus),
+		Limits:    make([]LimitResponse, 0, 4),
+	}
+	for _, kind := range []db.AccountLimitUsageKind{KindSpend, KindWithdrawal} {
+		for _, period := range periods {
+			name := LimitName(kind, period)
+			limit := effective.Of(kind, period)
+			usage, ok := used[name]
+			if !ok {
+				usage = money.Zero("", amountScale)
+			}
+			start := dayStart
+			if period == db.AccountLimitUsagePeriodMONTHLY {
+				start = monthStart
+			}
+			response.Limits = append(response.Limits, LimitResponse{
+				Name:        name,
+				Kind:        string(kind),
+				Period:      string(period),
+				PeriodStart: start,
+				Limit:       limit,
+				Used:        usage,
+				Remaining:   limit.Sub(usage).NonNegative(),
+				Custom:      effective.Override != nil && isCustom(effective.Override, kind, period),
+			})
+		}
+	}
+	return response, nil
+}
+
+// isCustom reports whether the override sets the limit of a kind and period
+func isCustom(override *db.AccountLimit, kind db.AccountLimitUsageKind, period db.AccountLimitUsagePeriod) bool {
+	switch LimitName(kind, period) {
+	case "daily_spend":
+		return override.DailySpendLimit.Valid
+	case "monthly_spend":
+		return override.MonthlySpendLimit.Valid
+	case "daily_withdrawal":
+		return override.DailyWithdrawalLimit.Valid
+	default:
+		return override.MonthlyWithdrawalLimit.Valid
+	}
+}
+
+// overrideValues is the audit representation of an override (null = KYC default)
+func overrideValues(dailySpend, monthlySpend, dailyWithdrawal, monthlyWithdrawal sql.NullString) map[string]any {
+	value := func(v sql.NullString) any {
+		if !v.Valid {
+			return nil
+		}
+		return v.String
+	}
+	return map[string]any{
+		"daily_spend":        value(dailySpend),
+		"monthly_spend":      value(monthlySpend),
+		"daily_withdrawal":   value(dailyWithdrawal),
+		"monthly_withdrawal": value(monthlyWithdrawal),
+	}
+}
@@ -0,0 +1,27 @@
+package me
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/user"
+)
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// MeResponse describes the authenticated caller and what it may do
+type MeResponse struct {
+	User      *user.UserResponse `json:"user"`
+	APIKeyID  string             `json:"api_key_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Roles     []string           `json:"roles" example:"BUYER,SELLER"`
+	Scopes    []string           `json:"scopes" example:"profile:write,wallets:write"`
+	Features  map[string]bool    `json:"features"`
+	RateLimit *RateLimitResponse `json:"rate_limit,omitempty"`
+}
+
+// RateLimitResponse represents the caller's current token bucket state
+// NOTE: 이 요청이 소비한 토큰이 반영된 값
+type RateLimitResponse struct {
+	PerMinute int `json:"per_minute" example:"600"`
+	Burst     int `json:"burst" example:"100"`
+	Remaining int `json:"remaining" example:"99"`
+}
@@ -0,0 +1,51 @@
+package me
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles HTTP requests for the who-am-I endpoint
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new me handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers the /me route on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/me", middleware.RequireAuth(), h.GetMe)
+}
+
+// GetMe godoc
+// @Summary Who am I
+// @Description Get the authenticated principal with its roles, scopes, enabled features and current rate-limit state.
+// @Description Lets client SDKs and dashboards adapt without probing endpoints.
+// @Tags me
+// @Produce json
+// @Success 200 {object} middleware.SuccessResponse{data=MeResponse} "Caller capabilities"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/me [get]
+func (h *Handler) GetMe(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+	rateLimit, _ := middleware.GetRateLimitState(c)
+
+	result, err := h.service.GetMe(c.Request.Context(), principal, rateLimit)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
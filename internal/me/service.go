@@ -0,0 +1,117 @@
+package me
+
+import (
+	"context"
+	"sort"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/user"
+	"go.uber.org/zap"
+)
+
+// Role names reported in addition to users.role
+const (
+	// RoleComplianceOfficer is reported for admins with users.is_compliance_officer
+	RoleComplianceOfficer = "COMPLIANCE_OFFICER"
+)
+
+// Scopes describe endpoint groups the caller may use.
+// Why: 클라이언트가 403을 받아보지 않고도 UI를 구성할 수 있도록 라우트 가드와 1:1로 맞춤
+const (
+	ScopeProfileWrite     = "profile:write"
+	ScopeWalletsWrite     = "wallets:write"
+	ScopeAPIKeysWrite     = "api_keys:write"
+	ScopeWebhooksWrite    = "webhooks:write"
+	ScopeUsersAdmin       = "users:admin"
+	ScopeKycDecide        = "kyc:decide"
+	ScopeRunbooksExecute  = "runbooks:execute"
+	ScopeRetentionPurge   = "retention:purge"
+	ScopeSupportCases     = "support_cases:write"
+	ScopeLegalHoldsManage = "legal_holds:manage"
+)
+
+// Feature flag names reported by GET /me
+const (
+	FeatureOnChainSettlement  = "on_chain_settlement"
+	FeatureMicroTransfer      = "micro_transfer_verification"
+	FeatureEventStreaming     = "event_streaming"
+	FeatureDistributedTracing = "distributed_tracing"
+)
+
+var (
+	// baseScopes are granted to every authenticated principal
+	baseScopes = []string{ScopeProfileWrite, ScopeWalletsWrite, ScopeAPIKeysWrite, ScopeWebhooksWrite}
+	// adminScopes are granted to RoleAdmin
+	adminScopes = []string{ScopeUsersAdmin, ScopeKycDecide, ScopeRunbooksExecute, ScopeRetentionPurge, ScopeSupportCases}
+)
+
+// Service assembles the who-am-I view of a principal
+type Service struct {
+	userService *user.Service
+	features    map[string]bool
+	logger      *zap.Logger
+}
+
+// NewService creates a new me service.
+// features is the deployment's enabled feature set (built from config at startup).
+func NewService(userService *user.Service, features map[string]bool, logger *zap.Logger) *Service {
+	return &Service{
+		userService: userService,
+		features:    features,
+		logger:      logger,
+	}
+}
+
+// GetMe returns the principal's profile, roles, scopes, features and rate-limit state
+func (s *Service) GetMe(ctx context.Context, principal *middleware.Principal, rateLimit *middleware.RateLimitState) (*MeResponse, error) {
+	u, err := s.userService.GetUserByID(ctx, principal.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &MeResponse{
+		User:     user.ToUserResponse(u),
+		APIKeyID: principal.APIKeyID,
+		Roles:    rolesOf(principal),
+		Scopes:   scopesOf(principal),
+		Features: s.features,
+	}
+	if rateLimit != nil {
+		resp.RateLimit = &RateLimitResponse{
+			PerMinute: rateLimit.Limit.PerMinute,
+			Burst:     rateLimit.Limit.Burst,
+			Remaining: rateLimit.Remaining,
+		}
+	}
+
+	return resp, nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// rolesOf expands the principal's role (BOTH -> BUYER, SELLER) and adds compliance
+func rolesOf(p *middleware.Principal) []string {
+	roles := []string{p.Role}
+	if p.Role == middleware.RoleBoth {
+		roles = []string{middleware.RoleBuyer, middleware.RoleSeller}
+	}
+	if p.IsCompliance() {
+		roles = append(roles, RoleComplianceOfficer)
+	}
+	return roles
+}
+
+// scopesOf derives the granted scopes from the principal's roles
+func scopesOf(p *middleware.Principal) []string {
+	scopes := append([]string{}, baseScopes...)
+	if p.IsAdmin() {
+		scopes = append(scopes, adminScopes...)
+	}
+	if p.IsCompliance() {
+		scopes = append(scopes, ScopeLegalHoldsManage)
+	}
+	sort.Strings(scopes)
+	return scopes
+}
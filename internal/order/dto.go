@@ -0,0 +1,33 @@
+package order
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/payment"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// CancelOrderRequest represents the request body for cancelling an order
+type CancelOrderRequest struct {
+	Reason string `json:"reason,omitempty" binding:"max=255" example:"Ordered the wrong SKU"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// CancelOrderResponse represents the outcome of an order cancellation.
+// Paid orders end REFUNDED with the linked refund of their captured payment, unpaid orders CANCELLED.
+type CancelOrderResponse struct {
+	OrderNumber      string                  `json:"order_number" example:"ORD-20260902-0001"`
+	PreviousStatus   string                  `json:"previous_status" example:"PAID" enums:"PENDING,CONFIRMED,PAID"`
+	Status           string                  `json:"status" example:"REFUNDED" enums:"CANCELLED,REFUNDED"`
+	Reason           string                  `json:"reason,omitempty" example:"Ordered the wrong SKU"`
+	VoidedPayments   int64                   `json:"voided_payments" example:"0"`     // AUTHORIZED payments voided
+	ReleasedQuantity int64                   `json:"released_quantity" example:"120"` // inventory reservations released
+	Refund           *payment.RefundResponse `json:"refund,omitempty"`                // captured payment refund (paid orders only)
+	CancelledAt      time.Time               `json:"cancelled_at"`
+}
@@ -0,0 +1,195 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/invoice"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/outbox"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/payment"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/webhook"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"go.uber.org/zap"
+)
+
+// Reservation expiry identifiers (audit action / event and inventory log reason)
+const (
+	actionPaymentExpired     = "ORDER_PAYMENT_EXPIRED"
+	reasonReservationExpired = "RESERVATION_EXPIRED"
+)
+
+// ExpiryWorkerConfig holds reservation expiry sweep settings
+type ExpiryWorkerConfig struct {
+	// Interval is the period of the expiry sweep
+	Interval time.Duration
+	// BatchSize is the number of orders expired per run
+	BatchSize int
+}
+
+// ExpiryReport summarizes one reservation expiry sweep
+type ExpiryReport struct {
+	Expired int
+	Failed  int
+}
+
+// ExpiryWorker releases the inventory reservations of orders whose payment never arrived: PENDING or CONFIRMED
+// orders past their reservation_expires_at without an AUTHORIZED/CAPTURED payment or an issued invoice become
+// PAYMENT_EXPIRED, their reservations are released and buyer and seller are notified (order.payment_expired).
+type ExpiryWorker struct {
+	txRunner *pkgdb.TxRunner
+	config   ExpiryWorkerConfig
+	logger   *zap.Logger
+}
+
+// NewExpiryWorker creates a new reservation expiry worker
+func NewExpiryWorker(txRunner *pkgdb.TxRunner, config ExpiryWorkerConfig, logger *zap.Logger) *ExpiryWorker {
+	return &ExpiryWorker{
+		txRunner: txRunner,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Run executes the expiry sweep periodically until ctx is canceled
+func (w *ExpiryWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	logctx.From(ctx, w.logger).Info("reservation expiry job started",
+		zap.Duration("interval", w.config.Interval),
+		zap.Int("batch_size", w.config.BatchSize),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logctx.From(ctx, w.logger).Info("reservation expiry job stopped")
+			return
+		case <-ticker.C:
+			report, err := w.RunOnce(ctx, time.Now().UTC())
+			if err != nil {
+				logctx.From(ctx, w.logger).Error("reservation expiry run failed", zap.Error(err))
+				continue
+			}
+			if report.Expired+report.Failed > 0 {
+				logctx.From(ctx, w.logger).Info("reservation expiry run completed",
+					zap.Int("expired", report.Expired),
+					zap.Int("failed", report.Failed),
+				)
+			}
+		}
+	}
+}
+
+// RunOnce expires one batch of orders past reservation_expires_at.
+// A failing order is logged and counted; it is retried on the next run.
+func (w *ExpiryWorker) RunOnce(ctx context.Context, now time.Time) (*ExpiryReport, error) {
+	report := &ExpiryReport{}
+
+	orders, err := w.txRunner.Queries().ListOrdersWithExpiredReservations(ctx, db.ListOrdersWithExpiredReservationsParams{
+		Now:   sql.NullTime{Time: now, Valid: true},
+		Limit: int32(w.config.BatchSize),
+	})
+	if err != nil {
+		return report, fmt.Errorf("list orders with expired reservations: %w", err)
+	}
+
+	for i := range orders {
+		if ctx.Err() != nil {
+			return report, nil
+		}
+		expired, err := w.expire(ctx, &orders[i])
+		if err != nil {
+			report.Failed++
+			logctx.From(ctx, w.logger).Error("reservation expiry failed",
+				zap.String("order_number", orders[i].OrderNumber),
+				zap.Error(err),
+			)
+			continue
+		}
+		if expired {
+			report.Expired++
+		}
+	}
+	return report, nil
+}
+
+// expire transitions the order, releases its reservations, discards a draft invoice and writes the audit log
+// and events in one transaction. Returns false when a payment or invoice arrived (or the order was cancelled) meanwhile.
+//
+// Why:
+//   - 주문 row-lock 후 조건부 UPDATE(결제·발행 청구서 없음) → 결제 승인/청구서 발행/취소와 경합 시 한쪽만 반영
+//   - 예약 해제는 같은 트랜잭션 → 주문 상태와 재고 예약이 어긋나지 않음
+func (w *ExpiryWorker) expire(ctx context.Context, o *db.Order) (bool, error) {
+	var released int64
+	expired, err := pkgdb.WithTxResult(ctx, w.txRunner, func(q *db.Queries) (bool, error) {
+		if _, err := q.GetOrderByIDForUpdate(ctx, o.ID); err != nil {
+			return false, fmt.Errorf("lock order: %w", err)
+		}
+		result, err := q.ExpireOrderReservation(ctx, o.ID)
+		if err != nil {
+			return false, fmt.Errorf("expire order: %w", err)
+		}
+		if n, err := result.RowsAffected(); err != nil || n == 0 {
+			return false, err
+		}
+
+		actor := audit.Actor{Type: audit.ActorTypeSystem}
+		released, err = payment.ReleaseOrderReservations(ctx, q, o.ID, reasonReservationExpired, actor)
+		if err != nil {
+			return false, err
+		}
+		if err := invoice.VoidOrderInvoice(ctx, q, o.ID); err != nil {
+			return false, err
+		}
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionPaymentExpired,
+			ResourceType: resourceOrder,
+			ResourceID:   o.ID,
+			OldValue:     map[string]any{"status": string(o.Status)},
+			NewValue: map[string]any{
+				"status":                 string(db.OrdersStatusPAYMENTEXPIRED),
+				"reservation_expires_at": o.ReservationExpiresAt.Time.UTC(),
+				"released_quantity":      released,
+			},
+		}); err != nil {
+			return false, err
+		}
+
+		data := map[string]any{
+			"order_number":           o.OrderNumber,
+			"status":                 string(db.OrdersStatusPAYMENTEXPIRED),
+			"previous_status":        string(o.Status),
+			"total_amount":           o.TotalAmount,
+			"token_symbol":           o.TokenSymbol,
+			"reservation_expires_at": o.ReservationExpiresAt.Time.UTC(),
+			"released_quantity":      released,
+		}
+		// 구매자/판매자 모두 통지 (outbox 이벤트는 수신자 1명 단위)
+		for _, recipient := range []uint64{o.BuyerID, o.SellerID} {
+			if _, err := outbox.Write(ctx, q, outbox.Message{
+				EventType:           webhook.EventOrderPaymentExpired,
+				AggregateType:       outbox.AggregateOrder,
+				AggregateID:         o.ID,
+				AggregateExternalID: o.OrderNumber,
+				RecipientUserID:     recipient,
+				Data:                data,
+			}); err != nil {
+				return false, fmt.Errorf("write %s event: %w", webhook.EventOrderPaymentExpired, err)
+			}
+		}
+		return true, nil
+	})
+	if err == nil && expired {
+		logctx.From(ctx, w.logger).Info("order reservation expired",
+			zap.String("order_number", o.OrderNumber),
+			zap.Int64("released_quantity", released),
+		)
+	}
+	return expired, err
+}
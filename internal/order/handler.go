@@ -0,0 +1,90 @@
+package order
+
+import (
+	"io"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// maxOrderNumberLength mirrors orders.order_number (VARCHAR(50))
+const maxOrderNumberLength = 50
+
+// Handler handles HTTP requests for orders
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new order handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers order routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	orders := rg.Group("/orders", middleware.RequireAuth())
+	{
+		orders.POST("/:orderNumber/cancel", h.CancelOrder)
+	}
+}
+
+// orderAccess returns the caller's order access
+func orderAccess(principal *middleware.Principal) Access {
+	return Access{
+		Admin:    principal.IsAdmin(),
+		CanActAs: principal.CanActAs,
+	}
+}
+
+// CancelOrder godoc
+// @Summary Cancel order
+// @Description Cancel an order before fulfillment (PENDING, CONFIRMED or PAID); shipped orders go through returns instead.
+// @Description Authorized payments are voided, inventory reservations released and an open invoice voided in the same transaction.
+// @Description Unpaid orders become CANCELLED (buyer, seller or admin). Paid orders are refunded in full on their captured payment and become REFUNDED (seller or admin);
+// @Description payments already paid out to the seller are refunded on-chain and require an admin.
+// @Description Buyer and seller are notified (order.cancelled, plus payment.refunded for paid orders). Audited.
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Param orderNumber path string true "Order number"
+// @Param request body CancelOrderRequest false "Cancellation"
+// @Success 200 {object} middleware.SuccessResponse{data=CancelOrderResponse} "Order cancelled"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input or order is already fulfilled"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Only the seller can cancel a paid order"
+// @Failure 404 {object} middleware.ErrorResponse "Order not found"
+// @Failure 409 {object} middleware.ErrorResponse "Order status changed or order has no captured payment to refund"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Failure 503 {object} middleware.ErrorResponse "Payments paused (settlement token depeg) or refund transfer failed"
+// @Security ApiKeyAuth
+// @Router /api/v1/orders/{orderNumber}/cancel [post]
+func (h *Handler) CancelOrder(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	orderNumber := c.Param("orderNumber")
+	if orderNumber == "" || len(orderNumber) > maxOrderNumberLength {
+		middleware.RespondError(c, errors.InvalidInput("Invalid order number"))
+		return
+	}
+
+	var req CancelOrderRequest
+	// NOTE: 본문 생략 시 사유 없이 취소
+	if err := c.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.CancelOrder(c.Request.Context(), orderNumber, &req, orderAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
@@ -0,0 +1,306 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/invoice"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/outbox"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/payment"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/webhook"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"go.uber.org/zap"
+)
+
+// Audit log identifiers
+const (
+	resourceOrder   = "ORDER"
+	actionCancelled = "ORDER_CANCELLED"
+)
+
+// Cancellation reason of orders cancelled on request (event reason / inventory log reason)
+const (
+	reasonCancelRequested = "CANCEL_REQUESTED"
+	reasonOrderCancelled  = "ORDER_CANCELLED"
+)
+
+// Who cancelled the order (order.cancelled event)
+const (
+	cancelledByBuyer  = "BUYER"
+	cancelledBySeller = "SELLER"
+	cancelledByAdmin  = "ADMIN"
+)
+
+// Access is the caller's access to orders
+type Access struct {
+	// Admin may cancel any order
+	Admin bool
+	// CanActAs reports whether the caller may operate on the user's resources (buyer/seller external ID)
+	CanActAs func(userExternalID string) bool
+}
+
+// Service handles order lifecycle requests (cancellation)
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	payments *payment.Service
+	logger   *zap.Logger
+}
+
+// NewService creates a new order service
+func NewService(txRunner *pkgdb.TxRunner, payments *payment.Service, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		payments: payments,
+		logger:   logger,
+	}
+}
+
+// CancelOrder cancels an order before fulfillment (PENDING, CONFIRMED or PAID): authorized payments are voided,
+// inventory reservations released and an open invoice voided. A paid order's captured payment is refunded in full
+// (seller or admin) and the order becomes REFUNDED; unpaid orders become CANCELLED (buyer, seller or admin).
+//
+// Why:
+//   - 결제 완료 주문은 환불 예약 트랜잭션(RefundHook) 안에서 취소 → 주문 상태·결제 무효화·재고 해제·환불이 한 번에 커밋되거나 모두 롤백
+//   - 판매자 지급 이후 결제는 ON_CHAIN 환불(관리자만) → 송금은 커밋 이후 단계이므로 실패 시 환불만 FAILED로 남고 결제 환불 API로 재전송 (주문 취소는 유지)
+//   - 주문 row-lock 하에서 상태 재확인 → 출고(SHIPPED)와 경합 시 한쪽만 반영
+func (s *Service) CancelOrder(ctx context.Context, orderNumber string, req *CancelOrderRequest, access Access, actor audit.Actor) (*CancelOrderResponse, error) {
+	// 1. Validate
+	q := s.txRunner.Queries()
+	order, err := q.GetOrderByOrderNumber(ctx, orderNumber)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Order")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get order", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	cancelledBy, err := s.cancelledBy(ctx, q, &order, access)
+	if err != nil {
+		return nil, err
+	}
+	if !cancellable(order.Status) {
+		return nil, errors.InvalidStateTransition(string(order.Status), string(db.OrdersStatusCANCELLED))
+	}
+
+	c := &cancellation{
+		reason:      strings.TrimSpace(req.Reason),
+		cancelledBy: cancelledBy,
+		actor:       actor,
+	}
+
+	// 2. Cancel (paid orders within the refund of their captured payment)
+	captured, err := q.GetCapturedPaymentByOrder(ctx, order.ID)
+	switch {
+	case err == sql.ErrNoRows:
+		err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+			locked, err := q.GetOrderByIDForUpdate(ctx, order.ID)
+			if err != nil {
+				return err
+			}
+			if locked.Status == db.OrdersStatusPAID {
+				// 청구서로 결제된 외상 주문은 환불할 결제가 없음
+				return errors.Conflict("Order has no captured payment to refund - issue a credit note on its invoice instead")
+			}
+			return s.cancel(ctx, q, &locked, c)
+		})
+	case err != nil:
+		logctx.From(ctx, s.logger).Error("failed to get order payment", zap.Error(err))
+		return nil, errors.DBError(err)
+	default:
+		if cancelledBy == cancelledByBuyer {
+			return nil, errors.Forbidden("Only the seller can cancel a paid order")
+		}
+		refundReq := &payment.CreateRefundRequest{Reason: "Cancellation of order " + order.OrderNumber}
+		c.refund, err = s.payments.CreateRefundWithHook(ctx, captured.ExternalID.String, refundReq, payment.RefundAccess(access), actor,
+			func(ctx context.Context, q *db.Queries, refund *db.PaymentRefund) error {
+				// 주문은 환불 예약에서 이미 잠금 (주문 → 결제 순서)
+				locked, err := q.GetOrderByIDForUpdate(ctx, order.ID)
+				if err != nil {
+					return err
+				}
+				c.refundID = refund.ExternalID
+				return s.cancel(ctx, q, &locked, c)
+			})
+	}
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to cancel order", zap.String("order_number", order.OrderNumber), zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("order cancelled",
+		zap.String("order_number", order.OrderNumber),
+		zap.String("status", string(c.status)),
+		zap.String("cancelled_by", cancelledBy),
+		zap.Int64("voided_payments", c.voided),
+		zap.Int64("released_quantity", c.released),
+	)
+	return &CancelOrderResponse{
+		OrderNumber:      order.OrderNumber,
+		PreviousStatus:   string(c.previous),
+		Status:           string(c.status),
+		Reason:           c.reason,
+		VoidedPayments:   c.voided,
+		ReleasedQuantity: c.released,
+		Refund:           c.refund,
+		CancelledAt:      c.at,
+	}, nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// cancellation carries a cancellation request and its outcome through the transaction
+type cancellation struct {
+	reason      string
+	cancelledBy string
+	actor       audit.Actor
+	refundID    string
+
+	previous db.OrdersStatus
+	status   db.OrdersStatus
+	voided   int64
+	released int64
+	refund   *payment.RefundResponse
+	at       time.Time
+}
+
+// cancel transitions the locked order and undoes what it holds - authorized payments, inventory reservations and
+// an open invoice - then records the audit log and the order.cancelled events (must be called within a transaction)
+func (s *Service) cancel(ctx context.Context, q *db.Queries, order *db.Order, c *cancellation) error {
+	if !cancellable(order.Status) {
+		return errors.InvalidStateTransition(string(order.Status), string(db.OrdersStatusCANCELLED))
+	}
+
+	// 1. Order status (결제 완료 주문 → REFUNDED, 환불 적용 시 RefundPaidOrder는 영향 없음)
+	c.previous = order.Status
+	c.status = db.OrdersStatusCANCELLED
+	var result sql.Result
+	var err error
+	if order.Status == db.OrdersStatusPAID {
+		c.status = db.OrdersStatusREFUNDED
+		result, err = q.RefundPaidOrder(ctx, order.ID)
+	} else {
+		result, err = q.CancelOpenOrder(ctx, order.ID)
+	}
+	if err != nil {
+		return fmt.Errorf("cancel order: %w", err)
+	}
+	if n, err := result.RowsAffected(); err != nil || n == 0 {
+		return errors.Conflict("Order status changed, retry the cancellation")
+	}
+	c.at = time.Now().UTC()
+
+	// 2. Void authorized payments, release reservations, void open invoice
+	voided, err := q.VoidAuthorizedPaymentsByOrder(ctx, order.ID)
+	if err != nil {
+		return fmt.Errorf("void payments: %w", err)
+	}
+	if c.voided, err = voided.RowsAffected(); err != nil {
+		return fmt.Errorf("void payments: %w", err)
+	}
+	if c.released, err = payment.ReleaseOrderReservations(ctx, q, order.ID, reasonOrderCancelled, c.actor); err != nil {
+		return err
+	}
+	if err := invoice.VoidOrderInvoice(ctx, q, order.ID); err != nil {
+		return err
+	}
+
+	// 3. Audit (same transaction)
+	newValue := map[string]any{
+		"status":            string(c.status),
+		"cancelled_by":      c.cancelledBy,
+		"voided_payments":   c.voided,
+		"released_quantity": c.released,
+	}
+	if c.reason != "" {
+		newValue["reason"] = c.reason
+	}
+	if c.refundID != "" {
+		newValue["refund_id"] = c.refundID
+	}
+	if err := audit.Record(ctx, q, c.actor, audit.Entry{
+		Action:       actionCancelled,
+		ResourceType: resourceOrder,
+		ResourceID:   order.ID,
+		OldValue:     map[string]any{"status": string(c.previous)},
+		NewValue:     newValue,
+	}); err != nil {
+		return err
+	}
+
+	// 4. Events for buyer and seller (outbox 이벤트는 수신자 1명 단위)
+	data := map[string]any{
+		"order_number":      order.OrderNumber,
+		"status":            string(c.status),
+		"previous_status":   string(c.previous),
+		"total_amount":      order.TotalAmount,
+		"token_symbol":      order.TokenSymbol,
+		"reason":            reasonCancelRequested,
+		"cancelled_by":      c.cancelledBy,
+		"released_quantity": c.released,
+	}
+	if c.reason != "" {
+		data["note"] = c.reason
+	}
+	if c.refundID != "" {
+		data["refund_id"] = c.refundID
+	}
+	for _, recipient := range []uint64{order.BuyerID, order.SellerID} {
+		if _, err := outbox.Write(ctx, q, outbox.Message{
+			EventType:           webhook.EventOrderCancelled,
+			AggregateType:       outbox.AggregateOrder,
+			AggregateID:         order.ID,
+			AggregateExternalID: order.OrderNumber,
+			RecipientUserID:     recipient,
+			Data:                data,
+		}); err != nil {
+			return fmt.Errorf("write %s event: %w", webhook.EventOrderCancelled, err)
+		}
+	}
+	return nil
+}
+
+// cancelledBy resolves the caller's side of the order; callers who are neither party see no order
+func (s *Service) cancelledBy(ctx context.Context, q *db.Queries, order *db.Order, access Access) (string, error) {
+	if access.Admin {
+		return cancelledByAdmin, nil
+	}
+	seller, err := q.GetUserByID(ctx, order.SellerID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to get seller", zap.Error(err))
+		return "", errors.DBError(err)
+	}
+	if access.CanActAs(seller.ExternalID.String) {
+		return cancelledBySeller, nil
+	}
+	buyer, err := q.GetUserByID(ctx, order.BuyerID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to get buyer", zap.Error(err))
+		return "", errors.DBError(err)
+	}
+	if access.CanActAs(buyer.ExternalID.String) {
+		return cancelledByBuyer, nil
+	}
+	return "", errors.NotFound("Order")
+}
+
+// cancellable reports whether the order is not yet fulfilled (SHIPPED 이후는 반품(RMA) 경로)
+func cancellable(status db.OrdersStatus) bool {
+	switch status {
+	case db.OrdersStatusPENDING, db.OrdersStatusCONFIRMED, db.OrdersStatusPAID:
+		return true
+	default:
+		return false
+	}
+}
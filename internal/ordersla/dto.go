@@ -0,0 +1,89 @@
+package ordersla
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// SetPolicyRequest represents the SLA policy of a seller relationship.
+// Omitted values inherit from the seller default policy, then the server defaults.
+type SetPolicyRequest struct {
+	// BuyerID limits the policy to one buyer (omit for the seller default policy)
+	BuyerID string `json:"buyer_id,omitempty" binding:"omitempty,uuid" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	// ConfirmHours is the time to confirm a PENDING order (0 = no SLA)
+	ConfirmHours *int `json:"confirm_hours,omitempty" example:"48"`
+	// FulfillHours is the time to ship a PAID order (0 = no SLA)
+	FulfillHours *int `json:"fulfill_hours,omitempty" example:"168"`
+	// AutoCancel cancels breached orders (refunding paid ones) instead of only escalating
+	AutoCancel *bool `json:"auto_cancel,omitempty" example:"false"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// PolicyResponse represents an SLA policy (null values are inherited)
+type PolicyResponse struct {
+	ID           string    `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	BuyerID      string    `json:"buyer_id,omitempty" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	ConfirmHours *int      `json:"confirm_hours" example:"48"`
+	FulfillHours *int      `json:"fulfill_hours" example:"168"`
+	AutoCancel   *bool     `json:"auto_cancel" example:"false"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// DefaultsResponse represents the server-wide SLA settings
+type DefaultsResponse struct {
+	ConfirmHours int  `json:"confirm_hours" example:"48"`
+	FulfillHours int  `json:"fulfill_hours" example:"168"`
+	AutoCancel   bool `json:"auto_cancel" example:"false"`
+}
+
+// ListPoliciesResponse represents the SLA policies of a seller
+type ListPoliciesResponse struct {
+	Defaults DefaultsResponse `json:"defaults"`
+	Policies []PolicyResponse `json:"policies"`
+}
+
+// ToPolicyResponse converts db.OrderSlaPolicy to PolicyResponse
+func ToPolicyResponse(p *db.OrderSlaPolicy, buyerExternalID string) *PolicyResponse {
+	return &PolicyResponse{
+		ID:           p.ExternalID,
+		BuyerID:      buyerExternalID,
+		ConfirmHours: nullInt32Ptr(p.ConfirmHours),
+		FulfillHours: nullInt32Ptr(p.FulfillHours),
+		AutoCancel:   nullBoolPtr(p.AutoCancel),
+		CreatedAt:    p.CreatedAt,
+		UpdatedAt:    p.UpdatedAt,
+	}
+}
+
+// ToListPoliciesResponse converts the seller's policies to ListPoliciesResponse
+func ToListPoliciesResponse(defaults Defaults, rows []db.ListOrderSLAPoliciesBySellerRow) ListPoliciesResponse {
+	response := ListPoliciesResponse{
+		Defaults: DefaultsResponse{
+			ConfirmHours: defaults.ConfirmHours,
+			FulfillHours: defaults.FulfillHours,
+			AutoCancel:   defaults.AutoCancel,
+		},
+		Policies: make([]PolicyResponse, 0, len(rows)),
+	}
+	for _, r := range rows {
+		policy := db.OrderSlaPolicy{
+			ExternalID:   r.ExternalID,
+			ConfirmHours: r.ConfirmHours,
+			FulfillHours: r.FulfillHours,
+			AutoCancel:   r.AutoCancel,
+			CreatedAt:    r.CreatedAt,
+			UpdatedAt:    r.UpdatedAt,
+		}
+		response.Policies = append(response.Policies, *ToPolicyResponse(&policy, r.BuyerExternalID.String))
+	}
+	return response
+}
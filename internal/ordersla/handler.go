@@ -0,0 +1,142 @@
+package ordersla
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for order SLA policies
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new order SLA handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers order SLA policy routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	policies := rg.Group("/users/:id/order-sla-policies", middleware.RequireAuth())
+	{
+		policies.PUT("", h.SetPolicy)
+		policies.GET("", h.ListPolicies)
+		policies.DELETE("/:policyId", h.DeletePolicy)
+	}
+}
+
+// extractUserID extracts the user id from path and checks the caller may act as the user
+func extractUserID(c *gin.Context) (string, error) {
+	userID := c.Param("id")
+	if _, err := uuid.Parse(userID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+
+	principal, _ := middleware.GetPrincipal(c)
+	if !principal.CanActAs(userID) {
+		return "", errors.Forbidden("Cannot manage order SLA policies of another user")
+	}
+	return userID, nil
+}
+
+// SetPolicy godoc
+// @Summary Set an order SLA policy
+// @Description Create or replace the seller's SLA for a buyer relationship (omit buyer_id for the seller default).
+// @Description A breached deadline (confirm a PENDING order, ship a PAID order) is escalated to buyer and seller
+// @Description via order.sla_breached, or the order is cancelled (paid orders refunded) when auto_cancel is set.
+// @Description Omitted values inherit from the seller default policy, then the server defaults.
+// @Tags order-sla
+// @Accept json
+// @Produce json
+// @Param id path string true "Seller external ID (UUID)"
+// @Param request body SetPolicyRequest true "SLA policy"
+// @Success 200 {object} middleware.SuccessResponse{data=PolicyResponse} "Policy set"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid request or user is not a seller"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/order-sla-policies [put]
+func (h *Handler) SetPolicy(c *gin.Context) {
+	sellerExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req SetPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	policy, err := h.service.SetPolicy(c.Request.Context(), sellerExternalID, &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, ToPolicyResponse(policy, req.BuyerID))
+}
+
+// ListPolicies godoc
+// @Summary List order SLA policies
+// @Description List the seller's SLA policies (default policy first) with the server defaults they inherit from
+// @Tags order-sla
+// @Produce json
+// @Param id path string true "Seller external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=ListPoliciesResponse} "SLA policies"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid user ID"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/order-sla-policies [get]
+func (h *Handler) ListPolicies(c *gin.Context) {
+	sellerExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	policies, err := h.service.ListPolicies(c.Request.Context(), sellerExternalID)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, ToListPoliciesResponse(h.service.Defaults(), policies))
+}
+
+// DeletePolicy godoc
+// @Summary Delete an order SLA policy
+// @Description Remove a policy; the relationship falls back to the seller default policy (or the server defaults)
+// @Tags order-sla
+// @Param id path string true "Seller external ID (UUID)"
+// @Param policyId path string true "Policy external ID (UUID)"
+// @Success 204 "Policy deleted"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid ID"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "Policy not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/order-sla-policies/{policyId} [delete]
+func (h *Handler) DeletePolicy(c *gin.Context) {
+	sellerExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	policyID := c.Param("policyId")
+	if _, err := uuid.Parse(policyID); err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Invalid UUID format"))
+		return
+	}
+
+	if err := h.service.DeletePolicy(c.Request.Context(), sellerExternalID, policyID, audit.ActorFromContext(c)); err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondNoContent(c)
+}
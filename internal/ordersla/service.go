@@ -0,0 +1,252 @@
+package ordersla
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// maxSLAHours bounds configurable deadlines (1 year)
+const maxSLAHours = 24 * 365
+
+// Audit log identifiers
+const (
+	actionPolicySet        = "ORDER_SLA_POLICY_SET"
+	actionPolicyDeleted    = "ORDER_SLA_POLICY_DELETED"
+	resourceOrderSLAPolicy = "ORDER_SLA_POLICY"
+)
+
+// Defaults are the server-wide SLA settings applied when no policy sets a value
+type Defaults struct {
+	// ConfirmHours is the time a seller has to confirm a PENDING order (0 = no SLA)
+	ConfirmHours int
+	// FulfillHours is the time a seller has to ship a PAID order (0 = no SLA)
+	FulfillHours int
+	// AutoCancel cancels breached orders (refunding paid ones) instead of only escalating
+	AutoCancel bool
+}
+
+// Service manages the SLA policies sellers set per buyer relationship.
+// A policy with a buyer applies to that relationship only; the seller's default policy
+// (no buyer) applies to every other buyer. Unset values inherit from the next level.
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	defaults Defaults
+	logger   *zap.Logger
+}
+
+// NewService creates a new order SLA service
+func NewService(txRunner *pkgdb.TxRunner, defaults Defaults, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		defaults: defaults,
+		logger:   logger,
+	}
+}
+
+// Defaults returns the server-wide SLA settings
+func (s *Service) Defaults() Defaults {
+	return s.defaults
+}
+
+// SetPolicy creates or replaces the seller's policy for the relationship (buyer_id omitted = seller default)
+func (s *Service) SetPolicy(ctx context.Context, sellerExternalID string, req *SetPolicyRequest, actor audit.Actor) (*db.OrderSlaPolicy, error) {
+	// 1. Validate
+	if err := validateHours("confirm_hours", req.ConfirmHours); err != nil {
+		return nil, err
+	}
+	if err := validateHours("fulfill_hours", req.FulfillHours); err != nil {
+		return nil, err
+	}
+
+	// 2. Resolve seller and buyer
+	seller, err := s.getSeller(ctx, sellerExternalID)
+	if err != nil {
+		return nil, err
+	}
+	buyerID := sql.NullInt64{}
+	if req.BuyerID != "" {
+		buyer, err := s.getUser(ctx, req.BuyerID)
+		if err != nil {
+			return nil, err
+		}
+		if buyer.ID == seller.ID {
+			return nil, errors.InvalidInput("buyer_id must be another user")
+		}
+		buyerID = sql.NullInt64{Int64: int64(buyer.ID), Valid: true}
+	}
+
+	// 3. Upsert (uk_order_sla_relationship)
+	policy, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*db.OrderSlaPolicy, error) {
+		if err := q.CreateOrUpdateOrderSLAPolicy(ctx, db.CreateOrUpdateOrderSLAPolicyParams{
+			ExternalID:   uuid.New().String(),
+			SellerID:     seller.ID,
+			BuyerID:      buyerID,
+			ConfirmHours: toNullInt32(req.ConfirmHours),
+			FulfillHours: toNullInt32(req.FulfillHours),
+			AutoCancel:   toNullBool(req.AutoCancel),
+		}); err != nil {
+			return nil, err
+		}
+		policy, err := q.GetOrderSLAPolicyByRelationship(ctx, db.GetOrderSLAPolicyByRelationshipParams{
+			SellerID: seller.ID,
+			BuyerKey: sql.NullInt64{Int64: buyerID.Int64, Valid: true},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionPolicySet,
+			ResourceType: resourceOrderSLAPolicy,
+			ResourceID:   policy.ID,
+			NewValue: map[string]any{
+				"buyer_id":      req.BuyerID,
+				"confirm_hours": req.ConfirmHours,
+				"fulfill_hours": req.FulfillHours,
+				"auto_cancel":   req.AutoCancel,
+			},
+		}); err != nil {
+			return nil, err
+		}
+		return &policy, nil
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to set order SLA policy", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("order SLA policy set",
+		zap.String("policy_external_id", policy.ExternalID),
+		zap.String("buyer_external_id", req.BuyerID),
+	)
+	return policy, nil
+}
+
+// ListPolicies lists the seller's SLA policies (default policy first)
+func (s *Service) ListPolicies(ctx context.Context, sellerExternalID string) ([]db.ListOrderSLAPoliciesBySellerRow, error) {
+	seller, err := s.getUser(ctx, sellerExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := s.txRunner.Queries().ListOrderSLAPoliciesBySeller(ctx, seller.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list order SLA policies", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return policies, nil
+}
+
+// DeletePolicy removes a policy; the relationship falls back to the seller default (or server defaults)
+func (s *Service) DeletePolicy(ctx context.Context, sellerExternalID, policyExternalID string, actor audit.Actor) error {
+	policy, err := s.txRunner.Queries().GetOrderSLAPolicyByExternalIDAndSeller(ctx, db.GetOrderSLAPolicyByExternalIDAndSellerParams{
+		ExternalID:   policyExternalID,
+		ExternalID_2: sql.NullString{String: sellerExternalID, Valid: true},
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return errors.NotFound("Order SLA policy")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get order SLA policy", zap.Error(err))
+		return errors.DBError(err)
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		if err := q.DeleteOrderSLAPolicy(ctx, policy.ID); err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionPolicyDeleted,
+			ResourceType: resourceOrderSLAPolicy,
+			ResourceID:   policy.ID,
+			OldValue: map[string]any{
+				"confirm_hours": nullInt32Ptr(policy.ConfirmHours),
+				"fulfill_hours": nullInt32Ptr(policy.FulfillHours),
+				"auto_cancel":   nullBoolPtr(policy.AutoCancel),
+			},
+		})
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to delete order SLA policy", zap.Error(err))
+		return errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("order SLA policy deleted",
+		zap.String("policy_external_id", policyExternalID),
+	)
+	return nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// getUser retrieves a user by external ID
+func (s *Service) getUser(ctx context.Context, userExternalID string) (*db.User, error) {
+	user, err := s.txRunner.Queries().GetUserByExternalID(ctx, sql.NullString{String: userExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("User")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &user, nil
+}
+
+// getSeller retrieves a user that can sell (SELLER or BOTH)
+func (s *Service) getSeller(ctx context.Context, userExternalID string) (*db.User, error) {
+	user, err := s.getUser(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role != db.UsersRoleSELLER && user.Role != db.UsersRoleBOTH {
+		return nil, errors.InvalidInput("Only sellers can set order SLA policies")
+	}
+	return user, nil
+}
+
+// validateHours bounds an optional SLA value (0 disables the stage)
+func validateHours(field string, hours *int) error {
+	if hours != nil && (*hours < 0 || *hours > maxSLAHours) {
+		return errors.InvalidInput(field + " must be between 0 and 8760")
+	}
+	return nil
+}
+
+func toNullInt32(v *int) sql.NullInt32 {
+	if v == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: int32(*v), Valid: true}
+}
+
+func toNullBool(v *bool) sql.NullBool {
+	if v == nil {
+		return sql.NullBool{}
+	}
+	return sql.NullBool{Bool: *v, Valid: true}
+}
+
+func nullInt32Ptr(v sql.NullInt32) *int {
+	if !v.Valid {
+		return nil
+	}
+	hours := int(v.Int32)
+	return &hours
+}
+
+func nullBoolPtr(v sql.NullBool) *bool {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Bool
+}
@@ -0,0 +1,374 @@
+package ordersla
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/outbox"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/webhook"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/go-sql-driver/mysql"
+	"go.uber.org/zap"
+)
+
+// mysqlErrDuplicateEntry is the MySQL error number for duplicate key violations
+const mysqlErrDuplicateEntry = 1062
+
+// Cancellation reasons of orders cancelled by a breached SLA
+const (
+	reasonConfirmBreached = "SLA_CONFIRM_BREACHED"
+	reasonFulfillBreached = "SLA_FULFILL_BREACHED"
+)
+
+// WorkerConfig holds SLA worker settings
+type WorkerConfig struct {
+	Defaults Defaults
+	// Interval is the period of the SLA job
+	Interval time.Duration
+	// BatchSize is the number of orders processed per stage and run
+	BatchSize int
+}
+
+// RunReport summarizes one SLA run
+type RunReport struct {
+	Escalated int
+	Cancelled int
+	Refunded  int
+	Failed    int
+}
+
+// breach is an order past the deadline of its current stage
+type breach struct {
+	order      db.Order
+	stage      db.OrderSlaBreachesStage
+	deadline   time.Time
+	autoCancel bool
+}
+
+// Worker escalates orders that breach their SLA (seller confirmation / fulfillment deadlines)
+// and, when the relationship's policy enables it, cancels them (refunding paid orders).
+// Notifications are delivered as outbox events (webhooks / event bus).
+type Worker struct {
+	txRunner *pkgdb.TxRunner
+	config   WorkerConfig
+	logger   *zap.Logger
+}
+
+// NewWorker creates a new SLA worker
+func NewWorker(txRunner *pkgdb.TxRunner, config WorkerConfig, logger *zap.Logger) *Worker {
+	return &Worker{
+		txRunner: txRunner,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Run executes the SLA job periodically until ctx is canceled
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	logctx.From(ctx, w.logger).Info("order SLA job started",
+		zap.Duration("interval", w.config.Interval),
+		zap.Int("confirm_hours", w.config.Defaults.ConfirmHours),
+		zap.Int("fulfill_hours", w.config.Defaults.FulfillHours),
+		zap.Bool("auto_cancel", w.config.Defaults.AutoCancel),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logctx.From(ctx, w.logger).Info("order SLA job stopped")
+			return
+		case <-ticker.C:
+			report, err := w.RunOnce(ctx, time.Now().UTC())
+			if err != nil {
+				logctx.From(ctx, w.logger).Error("order SLA run failed", zap.Error(err))
+				continue
+			}
+			if report.Escalated+report.Cancelled+report.Refunded+report.Failed > 0 {
+				logctx.From(ctx, w.logger).Info("order SLA run completed",
+					zap.Int("escalated", report.Escalated),
+					zap.Int("cancelled", report.Cancelled),
+					zap.Int("refunded", report.Refunded),
+					zap.Int("failed", report.Failed),
+				)
+			}
+		}
+	}
+}
+
+// RunOnce handles one batch of breached orders per stage.
+// A failing order is logged and counted; it is retried on the next run.
+func (w *Worker) RunOnce(ctx context.Context, now time.Time) (*RunReport, error) {
+	report := &RunReport{}
+
+	breaches, err := w.listBreaches(ctx, now)
+	if err != nil {
+		return report, err
+	}
+
+	for i := range breaches {
+		action, err := w.handle(ctx, &breaches[i])
+		if err != nil {
+			report.Failed++
+			logctx.From(ctx, w.logger).Error("order SLA breach handling failed",
+				zap.String("order_number", breaches[i].order.OrderNumber),
+				zap.String("stage", string(breaches[i].stage)),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		switch {
+		case action == "":
+			// 상태 변경/동시 실행 - 처리 대상 아님
+		case action == db.OrderSlaBreachesActionESCALATE:
+			report.Escalated++
+		case breaches[i].stage == db.OrderSlaBreachesStageFULFILL:
+			report.Refunded++
+		default:
+			report.Cancelled++
+		}
+	}
+	return report, nil
+}
+
+// listBreaches lists the orders past their confirmation and fulfillment deadlines
+func (w *Worker) listBreaches(ctx context.Context, now time.Time) ([]breach, error) {
+	q := w.txRunner.Queries()
+	defaults := w.config.Defaults
+
+	confirm, err := q.ListOrdersBreachingConfirmSLA(ctx, db.ListOrdersBreachingConfirmSLAParams{
+		DefaultHours:      int64(defaults.ConfirmHours),
+		DefaultAutoCancel: boolToInt(defaults.AutoCancel),
+		DefaultHours_2:    int64(defaults.ConfirmHours),
+		Now:               now,
+		DefaultHours_3:    int64(defaults.ConfirmHours),
+		Limit:             int32(w.config.BatchSize),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list orders breaching confirm SLA: %w", err)
+	}
+	fulfill, err := q.ListOrdersBreachingFulfillSLA(ctx, db.ListOrdersBreachingFulfillSLAParams{
+		DefaultHours:      int64(defaults.FulfillHours),
+		DefaultAutoCancel: boolToInt(defaults.AutoCancel),
+		DefaultHours_2:    int64(defaults.FulfillHours),
+		Now:               now,
+		DefaultHours_3:    int64(defaults.FulfillHours),
+		Limit:             int32(w.config.BatchSize),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list orders breaching fulfill SLA: %w", err)
+	}
+
+	breaches := make([]breach, 0, len(confirm)+len(fulfill))
+	for _, r := range confirm {
+		breaches = append(breaches, breach{
+			order: db.Order{
+				ID:              r.ID,
+				OrderNumber:     r.OrderNumber,
+				BuyerID:         r.BuyerID,
+				SellerID:        r.SellerID,
+				Status:          r.Status,
+				TotalAmount:     r.TotalAmount,
+				TokenSymbol:     r.TokenSymbol,
+				StatusChangedAt: r.StatusChangedAt,
+			},
+			stage:      db.OrderSlaBreachesStageCONFIRM,
+			deadline:   r.StatusChangedAt.Add(time.Duration(r.SlaHours) * time.Hour),
+			autoCancel: r.AutoCancel != 0,
+		})
+	}
+	for _, r := range fulfill {
+		breaches = append(breaches, breach{
+			order: db.Order{
+				ID:              r.ID,
+				OrderNumber:     r.OrderNumber,
+				BuyerID:         r.BuyerID,
+				SellerID:        r.SellerID,
+				Status:          r.Status,
+				TotalAmount:     r.TotalAmount,
+				TokenSymbol:     r.TokenSymbol,
+				StatusChangedAt: r.StatusChangedAt,
+			},
+			stage:      db.OrderSlaBreachesStageFULFILL,
+			deadline:   r.StatusChangedAt.Add(time.Duration(r.SlaHours) * time.Hour),
+			autoCancel: r.AutoCancel != 0,
+		})
+	}
+	return breaches, nil
+}
+
+// handle applies and records the breach action in a single transaction.
+// Returns an empty action when the order moved on meanwhile or another instance handled it.
+func (w *Worker) handle(ctx context.Context, b *breach) (db.OrderSlaBreachesAction, error) {
+	action, err := pkgdb.WithTxResult(ctx, w.txRunner, func(q *db.Queries) (db.OrderSlaBreachesAction, error) {
+		// 1. Re-check status under row-lock (판매자 확인/출고와 경합 방지)
+		locked, err := q.GetOrderByIDForUpdate(ctx, b.order.ID)
+		if err != nil {
+			return "", fmt.Errorf("lock order: %w", err)
+		}
+		if locked.Status != b.order.Status || !locked.StatusChangedAt.Equal(b.order.StatusChangedAt) {
+			return "", nil
+		}
+
+		// 2. Cancel (or refund) when enabled, otherwise escalate
+		action := db.OrderSlaBreachesActionESCALATE
+		if b.autoCancel {
+			cancelled, err := w.cancel(ctx, q, &locked, b.stage)
+			if err != nil {
+				return "", err
+			}
+			if cancelled {
+				action = db.OrderSlaBreachesActionCANCEL
+			}
+		}
+
+		eventID, err := w.notify(ctx, q, &locked, b, action)
+		if err != nil {
+			return "", err
+		}
+
+		// 3. Record breach (uk_order_sla_breach → 중복 실행 시 트랜잭션 전체 롤백)
+		if err := q.CreateOrderSLABreach(ctx, db.CreateOrderSLABreachParams{
+			OrderID:    locked.ID,
+			Stage:      b.stage,
+			DeadlineAt: b.deadline,
+			Action:     action,
+			EventID:    sql.NullString{String: eventID, Valid: eventID != ""},
+		}); err != nil {
+			return "", fmt.Errorf("record SLA breach: %w", err)
+		}
+		return action, nil
+	})
+	if err != nil && isDuplicateKeyError(err) {
+		return "", nil
+	}
+	if err == nil && action != "" {
+		logctx.From(ctx, w.logger).Info("order SLA breached",
+			zap.String("order_number", b.order.OrderNumber),
+			zap.String("stage", string(b.stage)),
+			zap.String("action", string(action)),
+		)
+	}
+	return action, err
+}
+
+// cancel cancels an unconfirmed order or refunds an unfulfilled paid order.
+// Paid orders with a payment already settled to the seller are only escalated
+// (환불하려면 판매자 지급분 회수가 필요 → 운영자 처리).
+// NOTE: 결제 상태 전이만 기록 - 원장 반환 분개는 환불 원장 경로가 생긴 뒤 연결
+func (w *Worker) cancel(ctx context.Context, q *db.Queries, order *db.Order, stage db.OrderSlaBreachesStage) (bool, error) {
+	if stage == db.OrderSlaBreachesStageCONFIRM {
+		if _, err := q.CancelPendingOrder(ctx, order.ID); err != nil {
+			return false, fmt.Errorf("cancel order: %w", err)
+		}
+		if _, err := q.VoidAuthorizedPaymentsByOrder(ctx, order.ID); err != nil {
+			return false, fmt.Errorf("void payments: %w", err)
+		}
+		order.Status = db.OrdersStatusCANCELLED
+		return true, nil
+	}
+
+	settled, err := q.CountSettledPaymentsByOrder(ctx, order.ID)
+	if err != nil {
+		return false, fmt.Errorf("count settled payments: %w", err)
+	}
+	if settled > 0 {
+		logctx.From(ctx, w.logger).Warn("SLA auto-refund skipped, payment already settled",
+			zap.String("order_number", order.OrderNumber),
+		)
+		return false, nil
+	}
+
+	if _, err := q.RefundPaidOrder(ctx, order.ID); err != nil {
+		return false, fmt.Errorf("refund order: %w", err)
+	}
+	if _, err := q.VoidAuthorizedPaymentsByOrder(ctx, order.ID); err != nil {
+		return false, fmt.Errorf("void payments: %w", err)
+	}
+	if _, err := q.RefundCapturedPaymentsByOrder(ctx, order.ID); err != nil {
+		return false, fmt.Errorf("refund payments: %w", err)
+	}
+	order.Status = db.OrdersStatusREFUNDED
+	return true, nil
+}
+
+// notify writes the breach events for seller and buyer (returns the seller's event ID)
+func (w *Worker) notify(ctx context.Context, q *db.Queries, order *db.Order, b *breach, action db.OrderSlaBreachesAction) (string, error) {
+	data := breachEventData(order, b, action)
+	eventType := webhook.EventOrderSLABreached
+	if action == db.OrderSlaBreachesActionCANCEL {
+		eventType = webhook.EventOrderCancelled
+		data["reason"] = reasonConfirmBreached
+		if b.stage == db.OrderSlaBreachesStageFULFILL {
+			data["reason"] = reasonFulfillBreached
+		}
+	}
+
+	// 구매자에게도 통지 (outbox 이벤트는 수신자 1명 단위)
+	if _, err := writeOrderEvent(ctx, q, order, order.BuyerID, eventType, data); err != nil {
+		return "", err
+	}
+	return writeOrderEvent(ctx, q, order, order.SellerID, eventType, data)
+}
+
+// writeOrderEvent records an order event for the recipient (must be called within a transaction)
+func writeOrderEvent(ctx context.Context, q *db.Queries, order *db.Order, recipientUserID uint64, eventType string, data map[string]any) (string, error) {
+	eventID, err := outbox.Write(ctx, q, outbox.Message{
+		EventType:           eventType,
+		AggregateType:       outbox.AggregateOrder,
+		AggregateID:         order.ID,
+		AggregateExternalID: order.OrderNumber,
+		RecipientUserID:     recipientUserID,
+		Data:                data,
+	})
+	if err != nil {
+		return "", fmt.Errorf("write %s event: %w", eventType, err)
+	}
+	return eventID, nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// breachEventData builds the public event body of a breached order (no internal IDs)
+func breachEventData(order *db.Order, b *breach, action db.OrderSlaBreachesAction) map[string]any {
+	return map[string]any{
+		"order_number": order.OrderNumber,
+		"status":       string(order.Status),
+		"total_amount": order.TotalAmount,
+		"token_symbol": order.TokenSymbol,
+		"stage":        string(b.stage),
+		"deadline":     b.deadline.UTC(),
+		"action":       string(action),
+	}
+}
+
+// boolToInt maps a flag to the 0/1 form of the SLA queries
+func boolToInt(v bool) int64 {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+// isDuplicateKeyError checks if the error is a MySQL duplicate key error
+func isDuplicateKeyError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var mysqlErr *mysql.MySQLError
+	if stderrors.As(err, &mysqlErr) {
+		return mysqlErr.Number == mysqlErrDuplicateEntry
+	}
+	return strings.Contains(err.Error(), "Duplicate entry")
+}
@@ -0,0 +1,262 @@
+package organization
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// CreateOrganizationRequest represents the request body for registering a company
+type CreateOrganizationRequest struct {
+	Name                       string `json:"name" binding:"required,max=200" example:"Acme Trading Co., Ltd."`
+	BusinessRegistrationNumber string `json:"business_registration_number" binding:"required,max=32" example:"123-45-67890"`
+	BillingEmail               string `json:"billing_email,omitempty" binding:"omitempty,email,max=255" example:"billing@acme.example"`
+	BillingAddress             string `json:"billing_address,omitempty" binding:"max=500" example:"12 Teheran-ro, Gangnam-gu, Seoul"`
+	BillingContactName         string `json:"billing_contact_name,omitempty" binding:"max=100" example:"Jane Kim"`
+}
+
+// UpdateOrganizationRequest represents the request body for updating a company profile
+// NOTE: 사업자등록번호는 변경 불가 (변경 시 새 조직 등록)
+type UpdateOrganizationRequest struct {
+	Name               string `json:"name" binding:"required,max=200" example:"Acme Trading Co., Ltd."`
+	BillingEmail       string `json:"billing_email,omitempty" binding:"omitempty,email,max=255" example:"billing@acme.example"`
+	BillingAddress     string `json:"billing_address,omitempty" binding:"max=500" example:"12 Teheran-ro, Gangnam-gu, Seoul"`
+	BillingContactName string `json:"billing_contact_name,omitempty" binding:"max=100" example:"Jane Kim"`
+}
+
+// UpdateMemberRoleRequest represents the request body for assigning a role to a member
+type UpdateMemberRoleRequest struct {
+	Role string `json:"role" binding:"required,oneof=OWNER FINANCE OPERATOR VIEWER" example:"FINANCE"`
+}
+
+// CreateInvitationRequest represents the request body for inviting a member by email
+type CreateInvitationRequest struct {
+	Email string `json:"email" binding:"required,email,max=255" example:"cfo@acme.example"`
+	Role  string `json:"role" binding:"required,oneof=OWNER FINANCE OPERATOR VIEWER" example:"FINANCE"`
+}
+
+// AcceptInvitationRequest represents the request body for accepting an invitation
+type AcceptInvitationRequest struct {
+	Token string `json:"token" binding:"required,max=128" example:"inv_3f9a..."`
+}
+
+// ListInvitationsRequest represents query parameters for listing the invitations of an organization
+type ListInvitationsRequest struct {
+	Page     int `form:"page,default=1" binding:"min=1"`
+	PageSize int `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ListOrdersRequest represents query parameters for listing the orders of an organization
+type ListOrdersRequest struct {
+	Page     int `form:"page,default=1" binding:"min=1"`
+	PageSize int `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ListSettlementsRequest represents query parameters for listing the settlements of an organization
+type ListSettlementsRequest struct {
+	Page     int `form:"page,default=1" binding:"min=1"`
+	PageSize int `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// OrganizationResponse represents a company profile
+type OrganizationResponse struct {
+	OrganizationID             string    `json:"organization_id" example:"990e8400-e29b-41d4-a716-446655440000"`
+	Name                       string    `json:"name" example:"Acme Trading Co., Ltd."`
+	BusinessRegistrationNumber string    `json:"business_registration_number" example:"1234567890"`
+	BillingEmail               string    `json:"billing_email,omitempty" example:"billing@acme.example"`
+	BillingAddress             string    `json:"billing_address,omitempty" example:"12 Teheran-ro, Gangnam-gu, Seoul"`
+	BillingContactName         string    `json:"billing_contact_name,omitempty" example:"Jane Kim"`
+	SettlementAccountID        string    `json:"settlement_account_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Status                     string    `json:"status" example:"ACTIVE"`
+	Role                       string    `json:"role,omitempty" example:"OWNER"` // caller's membership role
+	CreatedAt                  time.Time `json:"created_at"`
+	UpdatedAt                  time.Time `json:"updated_at"`
+}
+
+// ListOrganizationsResponse represents the organizations a user belongs to
+type ListOrganizationsResponse struct {
+	Organizations []OrganizationResponse `json:"organizations"`
+}
+
+// MemberResponse represents a member of an organization
+type MemberResponse struct {
+	UserID   string    `json:"user_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Email    string    `json:"email" example:"jane@acme.example"`
+	Name     string    `json:"name" example:"Jane Kim"`
+	Role     string    `json:"role" example:"FINANCE"`
+	JoinedAt time.Time `json:"joined_at"`
+}
+
+// ListMembersResponse represents the members of an organization
+type ListMembersResponse struct {
+	Members []MemberResponse `json:"members"`
+}
+
+// InvitationResponse represents an invitation to join an organization
+type InvitationResponse struct {
+	InvitationID   string     `json:"invitation_id" example:"aa0e8400-e29b-41d4-a716-446655440000"`
+	OrganizationID string     `json:"organization_id" example:"990e8400-e29b-41d4-a716-446655440000"`
+	Email          string     `json:"email" example:"cfo@acme.example"`
+	Role           string     `json:"role" example:"FINANCE"`
+	Status         string     `json:"status" example:"PENDING" enums:"PENDING,ACCEPTED,REVOKED,EXPIRED"`
+	ExpiresAt      time.Time  `json:"expires_at"`
+	AcceptedAt     *time.Time `json:"accepted_at,omitempty"`
+	RevokedAt      *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// CreateInvitationResponse represents a new invitation with its token
+// NOTE: token은 이 응답에서만 노출 (해시만 저장) → 초대 대상에게 전달해 수락 시 사용
+type CreateInvitationResponse struct {
+	Invitation InvitationResponse `json:"invitation"`
+	Token      string             `json:"token" example:"inv_3f9a..."`
+}
+
+// ListInvitationsResponse represents paginated invitation list of an organization
+type ListInvitationsResponse struct {
+	Invitations []InvitationResponse `json:"invitations"`
+	Total       int64                `json:"total" example:"3"`
+	Page        int                  `json:"page" example:"1"`
+	PageSize    int                  `json:"page_size" example:"20"`
+	TotalPages  int                  `json:"total_pages" example:"1"`
+}
+
+// OrderResponse represents an order placed or received by an organization
+type OrderResponse struct {
+	OrderNumber  string     `json:"order_number" example:"ORD-20260901-0001"`
+	Side         string     `json:"side" example:"BUYER" enums:"BUYER,SELLER"`
+	Status       string     `json:"status" example:"PAID"`
+	TotalAmount  string     `json:"total_amount" example:"1500.00000000" swaggertype:"string"`
+	TokenSymbol  string     `json:"token_symbol" example:"USDC"`
+	PaymentDueAt *time.Time `json:"payment_due_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// ListOrdersResponse represents paginated order list of an organization
+type ListOrdersResponse struct {
+	Orders     []OrderResponse `json:"orders"`
+	Total      int64           `json:"total" example:"42"`
+	Page       int             `json:"page" example:"1"`
+	PageSize   int             `json:"page_size" example:"20"`
+	TotalPages int             `json:"total_pages" example:"3"`
+}
+
+// SettlementResponse represents a settlement paid to the organization's settlement account
+type SettlementResponse struct {
+	PaymentID   string     `json:"payment_id" example:"660e8400-e29b-41d4-a716-446655440000"`
+	OrderNumber string     `json:"order_number" example:"ORD-20260901-0001"`
+	Amount      string     `json:"amount" example:"1500.00000000" swaggertype:"string"`
+	FeeAmount   string     `json:"fee_amount" example:"4.50000000" swaggertype:"string"`
+	NetAmount   string     `json:"net_amount" example:"1495.50000000" swaggertype:"string"`
+	Status      string     `json:"status" example:"COMPLETED"`
+	SettledAt   *time.Time `json:"settled_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// ListSettlementsResponse represents paginated settlement list of an organization
+type ListSettlementsResponse struct {
+	Settlements []SettlementResponse `json:"settlements"`
+	Total       int64                `json:"total" example:"42"`
+	Page        int                  `json:"page" example:"1"`
+	PageSize    int                  `json:"page_size" example:"20"`
+	TotalPages  int                  `json:"total_pages" example:"3"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToOrganizationResponse converts an organization to its response
+func ToOrganizationResponse(org *db.Organization, settlementAccountID string, role db.OrganizationMembersRole) *OrganizationResponse {
+	return &OrganizationResponse{
+		OrganizationID:             org.ExternalID,
+		Name:                       org.Name,
+		BusinessRegistrationNumber: org.BusinessRegistrationNumber,
+		BillingEmail:               org.BillingEmail.String,
+		BillingAddress:             org.BillingAddress.String,
+		BillingContactName:         org.BillingContactName.String,
+		SettlementAccountID:        settlementAccountID,
+		Status:                     string(org.Status),
+		Role:                       string(role),
+		CreatedAt:                  org.CreatedAt,
+		UpdatedAt:                  org.UpdatedAt,
+	}
+}
+
+// ToMemberResponse converts a member row to its response
+func ToMemberResponse(row *db.ListOrganizationMembersRow) MemberResponse {
+	return MemberResponse{
+		UserID:   row.UserExternalID.String,
+		Email:    row.UserEmail,
+		Name:     row.UserName,
+		Role:     string(row.Role),
+		JoinedAt: row.CreatedAt,
+	}
+}
+
+// ToInvitationResponse converts an invitation to its response; a pending invitation past expires_at is EXPIRED
+func ToInvitationResponse(invitation *db.OrganizationInvitation, organizationID string, now time.Time) InvitationResponse {
+	response := InvitationResponse{
+		InvitationID:   invitation.ExternalID,
+		OrganizationID: organizationID,
+		Email:          invitation.Email,
+		Role:           string(invitation.Role),
+		Status:         string(invitation.Status),
+		ExpiresAt:      invitation.ExpiresAt,
+		CreatedAt:      invitation.CreatedAt,
+	}
+	if invitation.Status == db.OrganizationInvitationsStatusPENDING && !invitation.ExpiresAt.After(now) {
+		response.Status = InvitationStatusExpired
+	}
+	if invitation.AcceptedAt.Valid {
+		response.AcceptedAt = &invitation.AcceptedAt.Time
+	}
+	if invitation.RevokedAt.Valid {
+		response.RevokedAt = &invitation.RevokedAt.Time
+	}
+	return response
+}
+
+// ToOrderResponse converts an order to its response from the organization's point of view
+func ToOrderResponse(order *db.Order, organizationID uint64) OrderResponse {
+	response := OrderResponse{
+		OrderNumber: order.OrderNumber,
+		Side:        SideSeller,
+		Status:      string(order.Status),
+		TotalAmount: order.TotalAmount,
+		TokenSymbol: order.TokenSymbol,
+		CreatedAt:   order.CreatedAt,
+	}
+	if order.BuyerOrganizationID.Valid && uint64(order.BuyerOrganizationID.Int64) == organizationID {
+		response.Side = SideBuyer
+	}
+	if order.PaymentDueAt.Valid {
+		response.PaymentDueAt = &order.PaymentDueAt.Time
+	}
+	return response
+}
+
+// ToSettlementResponse converts a settlement row to its response
+func ToSettlementResponse(row *db.ListSettlementsByPayeeAccountRow) SettlementResponse {
+	response := SettlementResponse{
+		PaymentID:   row.PaymentExternalID.String,
+		OrderNumber: row.OrderNumber,
+		Amount:      row.Amount,
+		FeeAmount:   row.FeeAmount,
+		NetAmount:   row.NetAmount,
+		Status:      string(row.Status),
+		CreatedAt:   row.CreatedAt,
+	}
+	if row.SettledAt.Valid {
+		response.SettledAt = &row.SettledAt.Time
+	}
+	return response
+}
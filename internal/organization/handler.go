@@ -0,0 +1,454 @@
+package organization
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for organizations
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new organization handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers organization routes on the router group.
+// Organization routes check the caller's organization role with middleware.RequireOrgRole:
+// OWNER manages members and invitations, FINANCE billing and settlements, OPERATOR/VIEWER read.
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	member := middleware.RequireOrgRole(h.service)
+	owner := middleware.RequireOrgRole(h.service, middleware.OrgRoleOwner)
+	finance := middleware.RequireOrgRole(h.service, middleware.OrgRoleOwner, middleware.OrgRoleFinance)
+
+	organizations := rg.Group("/organizations", middleware.RequireAuth())
+	{
+		organizations.POST("", h.CreateOrganization)
+		organizations.GET("/:id", member, h.GetOrganization)
+		organizations.PUT("/:id", finance, h.UpdateOrganization)
+		organizations.GET("/:id/members", member, h.ListMembers)
+		organizations.PUT("/:id/members/:userId", owner, h.UpdateMemberRole)
+		organizations.DELETE("/:id/members/:userId", member, h.RemoveMember)
+		organizations.POST("/:id/invitations", owner, h.CreateInvitation)
+		organizations.GET("/:id/invitations", owner, h.ListInvitations)
+		organizations.DELETE("/:id/invitations/:invitationId", owner, h.RevokeInvitation)
+		organizations.GET("/:id/orders", member, h.ListOrders)
+		organizations.GET("/:id/settlements", finance, h.ListSettlements)
+	}
+
+	rg.POST("/organization-invitations/accept", middleware.RequireAuth(), h.AcceptInvitation)
+	rg.GET("/users/:id/organizations", middleware.RequireAuth(), h.ListUserOrganizations)
+}
+
+// extractUUIDParam extracts and validates a UUID path parameter
+func extractUUIDParam(c *gin.Context, name string) (string, error) {
+	value := c.Param(name)
+	if _, err := uuid.Parse(value); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+	return value, nil
+}
+
+// CreateOrganization godoc
+// @Summary Register an organization
+// @Description Register a company as a B2B trading party. The caller becomes its first OWNER and a MERCHANT
+// @Description settlement account is opened for it. The business registration number is stored normalized
+// @Description (spaces and hyphens removed, uppercased) and must be unique.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param request body CreateOrganizationRequest true "Organization profile"
+// @Success 201 {object} middleware.SuccessResponse{data=OrganizationResponse} "Organization registered"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 409 {object} middleware.ErrorResponse "Business registration number already registered"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/organizations [post]
+func (h *Handler) CreateOrganization(c *gin.Context) {
+	var req CreateOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.Create(c.Request.Context(), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// GetOrganization godoc
+// @Summary Get an organization
+// @Description Get the profile of an organization the caller belongs to, with the caller's role
+// @Tags organizations
+// @Produce json
+// @Param id path string true "Organization external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=OrganizationResponse} "Organization"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Organization not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/organizations/{id} [get]
+func (h *Handler) GetOrganization(c *gin.Context) {
+	result, err := h.service.Get(c.Request.Context(), c.Param("id"), middleware.GetOrgRole(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// UpdateOrganization godoc
+// @Summary Update an organization
+// @Description Replace the name and billing information of an organization (OWNER, FINANCE).
+// @Description The business registration number cannot be changed.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param id path string true "Organization external ID (UUID)"
+// @Param request body UpdateOrganizationRequest true "Organization profile"
+// @Success 200 {object} middleware.SuccessResponse{data=OrganizationResponse} "Organization updated"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient organization role"
+// @Failure 404 {object} middleware.ErrorResponse "Organization not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/organizations/{id} [put]
+func (h *Handler) UpdateOrganization(c *gin.Context) {
+	var req UpdateOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.Update(c.Request.Context(), c.Param("id"), &req, middleware.GetOrgRole(c), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// ListMembers godoc
+// @Summary List organization members
+// @Description List the members of an organization and their roles
+// @Tags organizations
+// @Produce json
+// @Param id path string true "Organization external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=ListMembersResponse} "Members"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Organization not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/organizations/{id}/members [get]
+func (h *Handler) ListMembers(c *gin.Context) {
+	result, err := h.service.ListMembers(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// UpdateMemberRole godoc
+// @Summary Assign a member role
+// @Description Assign OWNER, FINANCE, OPERATOR or VIEWER to a member (OWNER). The last owner cannot be demoted.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param id path string true "Organization external ID (UUID)"
+// @Param userId path string true "User external ID (UUID)"
+// @Param request body UpdateMemberRoleRequest true "Role"
+// @Success 200 {object} middleware.SuccessResponse{data=MemberResponse} "Role assigned"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient organization role"
+// @Failure 404 {object} middleware.ErrorResponse "Organization or member not found"
+// @Failure 409 {object} middleware.ErrorResponse "Last owner of the organization"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/organizations/{id}/members/{userId} [put]
+func (h *Handler) UpdateMemberRole(c *gin.Context) {
+	userID, err := extractUUIDParam(c, "userId")
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req UpdateMemberRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.UpdateMemberRole(c.Request.Context(), c.Param("id"), userID, &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// RemoveMember godoc
+// @Summary Revoke a member's access
+// @Description Remove a user from an organization. Owners can remove any member, other members can only leave;
+// @Description the last owner cannot be removed.
+// @Tags organizations
+// @Produce json
+// @Param id path string true "Organization external ID (UUID)"
+// @Param userId path string true "User external ID (UUID)"
+// @Success 204 "Member removed"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Not allowed to remove the member"
+// @Failure 404 {object} middleware.ErrorResponse "Organization or member not found"
+// @Failure 409 {object} middleware.ErrorResponse "Last owner of the organization"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/organizations/{id}/members/{userId} [delete]
+func (h *Handler) RemoveMember(c *gin.Context) {
+	userID, err := extractUUIDParam(c, "userId")
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, _ := middleware.GetPrincipal(c)
+	if err := h.service.RemoveMember(c.Request.Context(), c.Param("id"), userID, middleware.GetOrgRole(c), audit.ActorFromContext(c), principal.IsAdmin()); err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondNoContent(c)
+}
+
+// CreateInvitation godoc
+// @Summary Invite a member by email
+// @Description Invite a person by email to join the organization with a role (OWNER). The token is returned only once:
+// @Description deliver it to the invitee, who accepts it while signed in with the invited email. Expires after ORGANIZATION_INVITATION_TTL.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param id path string true "Organization external ID (UUID)"
+// @Param request body CreateInvitationRequest true "Invitation"
+// @Success 201 {object} middleware.SuccessResponse{data=CreateInvitationResponse} "Invitation created"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient organization role"
+// @Failure 404 {object} middleware.ErrorResponse "Organization not found"
+// @Failure 409 {object} middleware.ErrorResponse "Already a member or invitation pending"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/organizations/{id}/invitations [post]
+func (h *Handler) CreateInvitation(c *gin.Context) {
+	var req CreateInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.CreateInvitation(c.Request.Context(), c.Param("id"), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// ListInvitations godoc
+// @Summary List organization invitations
+// @Description List the invitations of an organization, newest first (OWNER). Pending invitations past their expiry are reported as EXPIRED.
+// @Tags organizations
+// @Produce json
+// @Param id path string true "Organization external ID (UUID)"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} middleware.SuccessResponse{data=ListInvitationsResponse} "Invitations"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient organization role"
+// @Failure 404 {object} middleware.ErrorResponse "Organization not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/organizations/{id}/invitations [get]
+func (h *Handler) ListInvitations(c *gin.Context) {
+	var req ListInvitationsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListInvitations(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// RevokeInvitation godoc
+// @Summary Revoke an invitation
+// @Description Cancel a pending invitation so its token can no longer be accepted (OWNER)
+// @Tags organizations
+// @Produce json
+// @Param id path string true "Organization external ID (UUID)"
+// @Param invitationId path string true "Invitation external ID (UUID)"
+// @Success 204 "Invitation revoked"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient organization role"
+// @Failure 404 {object} middleware.ErrorResponse "Organization or invitation not found"
+// @Failure 409 {object} middleware.ErrorResponse "Invitation is no longer pending"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/organizations/{id}/invitations/{invitationId} [delete]
+func (h *Handler) RevokeInvitation(c *gin.Context) {
+	invitationID, err := extractUUIDParam(c, "invitationId")
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	if err := h.service.RevokeInvitation(c.Request.Context(), c.Param("id"), invitationID, audit.ActorFromContext(c)); err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondNoContent(c)
+}
+
+// AcceptInvitation godoc
+// @Summary Accept an organization invitation
+// @Description Join the inviting organization with the invited role. The caller's email must match the invited email.
+// @Tags organizations
+// @Accept json
+// @Produce json
+// @Param request body AcceptInvitationRequest true "Invitation token"
+// @Success 200 {object} middleware.SuccessResponse{data=OrganizationResponse} "Joined organization"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Invitation was sent to a different email"
+// @Failure 404 {object} middleware.ErrorResponse "Invitation not found"
+// @Failure 409 {object} middleware.ErrorResponse "Invitation expired, no longer pending or already a member"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/organization-invitations/accept [post]
+func (h *Handler) AcceptInvitation(c *gin.Context) {
+	var req AcceptInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.AcceptInvitation(c.Request.Context(), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// ListOrders godoc
+// @Summary List organization orders
+// @Description List the orders the organization placed (side BUYER) or received (side SELLER), newest first
+// @Tags organizations
+// @Produce json
+// @Param id path string true "Organization external ID (UUID)"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} middleware.SuccessResponse{data=ListOrdersResponse} "Orders"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Organization not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/organizations/{id}/orders [get]
+func (h *Handler) ListOrders(c *gin.Context) {
+	var req ListOrdersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListOrders(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// ListSettlements godoc
+// @Summary List organization settlements
+// @Description List the settlements paid to the organization's settlement account, newest first (OWNER, FINANCE)
+// @Tags organizations
+// @Produce json
+// @Param id path string true "Organization external ID (UUID)"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} middleware.SuccessResponse{data=ListSettlementsResponse} "Settlements"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient organization role"
+// @Failure 404 {object} middleware.ErrorResponse "Organization not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/organizations/{id}/settlements [get]
+func (h *Handler) ListSettlements(c *gin.Context) {
+	var req ListSettlementsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListSettlements(c.Request.Context(), c.Param("id"), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// ListUserOrganizations godoc
+// @Summary List a user's organizations
+// @Description List the organizations the user belongs to with the user's role in each
+// @Tags organizations
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=ListOrganizationsResponse} "Organizations"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/organizations [get]
+func (h *Handler) ListUserOrganizations(c *gin.Context) {
+	userID, err := extractUUIDParam(c, "id")
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, _ := middleware.GetPrincipal(c)
+	if !principal.CanActAs(userID) {
+		middleware.RespondError(c, errors.Forbidden("Cannot list organizations of another user"))
+		return
+	}
+
+	result, err := h.service.ListByUser(c.Request.Context(), userID)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
@@ -0,0 +1,320 @@
+package organization
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// InvitationStatusExpired is the reported status of a pending invitation past its expiry
+	InvitationStatusExpired = "EXPIRED"
+
+	// invitationTokenPrefix marks invitation tokens (inv_ + 64 hex chars)
+	invitationTokenPrefix = "inv_"
+	// invitationTokenBytes is the number of random bytes of an invitation token
+	invitationTokenBytes = 32
+)
+
+// CreateInvitation invites a person by email to join the organization with a role.
+// The returned token is shown only once; the invitee accepts it while signed in with that email.
+//
+// Why:
+//   - 토큰 원문은 저장하지 않고 SHA-256만 저장 → DB 유출로 초대 수락 불가
+//   - 조직 row-lock 하에서 기존 구성원/유효 초대 확인 → 같은 이메일 중복 초대 없음
+func (s *Service) CreateInvitation(ctx context.Context, orgExternalID string, req *CreateInvitationRequest, actor audit.Actor) (*CreateInvitationResponse, error) {
+	email := normalizeEmail(req.Email)
+	token, err := generateInvitationToken()
+	if err != nil {
+		return nil, errors.Internal("Failed to generate invitation token")
+	}
+
+	return pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*CreateInvitationResponse, error) {
+		org, err := s.getOrganization(ctx, q, orgExternalID)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := q.GetOrganizationForUpdate(ctx, org.ID); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to lock organization", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		member, err := q.ExistsOrganizationMemberByEmail(ctx, db.ExistsOrganizationMemberByEmailParams{
+			OrganizationID: org.ID,
+			Email:          email,
+		})
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to check organization member", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		if member {
+			return nil, errors.Conflict("User is already a member of the organization")
+		}
+
+		now := time.Now().UTC()
+		pending, err := q.ExistsPendingOrganizationInvitation(ctx, db.ExistsPendingOrganizationInvitationParams{
+			OrganizationID: org.ID,
+			Email:          email,
+			ExpiresAt:      now,
+		})
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to check pending invitation", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		if pending {
+			return nil, errors.Conflict("A pending invitation already exists for this email")
+		}
+
+		result, err := q.CreateOrganizationInvitation(ctx, db.CreateOrganizationInvitationParams{
+			ExternalID:     uuid.New().String(),
+			OrganizationID: org.ID,
+			Email:          email,
+			Role:           db.OrganizationInvitationsRole(req.Role),
+			TokenHash:      hashInvitationToken(token),
+			InvitedBy:      actor.ID,
+			ExpiresAt:      now.Add(s.invitationTTL),
+		})
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to create invitation", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		invitationID, err := result.LastInsertId()
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+		invitation, err := q.GetOrganizationInvitationByID(ctx, uint64(invitationID))
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionInvitationCreated,
+			ResourceType: resourceType,
+			ResourceID:   org.ID,
+			NewValue: map[string]any{
+				"invitation_id": invitation.ExternalID,
+				"email":         email,
+				"role":          req.Role,
+			},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		return &CreateInvitationResponse{
+			Invitation: ToInvitationResponse(&invitation, org.ExternalID, now),
+			Token:      token,
+		}, nil
+	})
+}
+
+// ListInvitations lists the invitations of an organization (newest first)
+func (s *Service) ListInvitations(ctx context.Context, orgExternalID string, req *ListInvitationsRequest) (*ListInvitationsResponse, error) {
+	q := s.txRunner.Queries()
+
+	org, err := s.getOrganization(ctx, q, orgExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	invitations, err := q.ListOrganizationInvitations(ctx, db.ListOrganizationInvitationsParams{
+		OrganizationID: org.ID,
+		Limit:          int32(req.PageSize),
+		Offset:         int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list invitations", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := q.CountOrganizationInvitations(ctx, org.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count invitations", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	now := time.Now().UTC()
+	responses := make([]InvitationResponse, 0, len(invitations))
+	for i := range invitations {
+		responses = append(responses, ToInvitationResponse(&invitations[i], org.ExternalID, now))
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListInvitationsResponse{
+		Invitations: responses,
+		Total:       total,
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+		TotalPages:  totalPages,
+	}, nil
+}
+
+// RevokeInvitation cancels a pending invitation so its token can no longer be accepted
+func (s *Service) RevokeInvitation(ctx context.Context, orgExternalID, invitationExternalID string, actor audit.Actor) error {
+	return s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		org, err := s.getOrganization(ctx, q, orgExternalID)
+		if err != nil {
+			return err
+		}
+		invitation, err := q.GetOrganizationInvitation(ctx, db.GetOrganizationInvitationParams{
+			OrganizationID: org.ID,
+			ExternalID:     invitationExternalID,
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return errors.NotFound("Invitation")
+			}
+			logctx.From(ctx, s.logger).Error("failed to get invitation", zap.Error(err))
+			return errors.DBError(err)
+		}
+
+		result, err := q.RevokeOrganizationInvitation(ctx, invitation.ID)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to revoke invitation", zap.Error(err))
+			return errors.DBError(err)
+		}
+		// NOTE: 동시 수락/회수가 먼저 커밋되면 영향 행 0
+		if rows, _ := result.RowsAffected(); rows == 0 {
+			return errors.Conflict("Invitation is no longer pending")
+		}
+
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionInvitationRevoked,
+			ResourceType: resourceType,
+			ResourceID:   org.ID,
+			OldValue: map[string]any{
+				"invitation_id": invitation.ExternalID,
+				"email":         invitation.Email,
+				"role":          string(invitation.Role),
+			},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return errors.DBError(err)
+		}
+		return nil
+	})
+}
+
+// AcceptInvitation adds the actor to the inviting organization with the invited role.
+// The actor's email must match the invited email.
+//
+// Why:
+//   - 토큰만으로는 부족 → 초대받은 이메일의 사용자만 수락 가능 (토큰 유출 시 제3자 가입 차단)
+//   - 초대 row-lock → 동시 수락/회수 중 하나만 성공
+func (s *Service) AcceptInvitation(ctx context.Context, req *AcceptInvitationRequest, actor audit.Actor) (*OrganizationResponse, error) {
+	return pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*OrganizationResponse, error) {
+		invitation, err := q.GetOrganizationInvitationByTokenForUpdate(ctx, hashInvitationToken(req.Token))
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, errors.NotFound("Invitation")
+			}
+			logctx.From(ctx, s.logger).Error("failed to get invitation", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		if invitation.Status != db.OrganizationInvitationsStatusPENDING {
+			return nil, errors.Conflict("Invitation is no longer pending")
+		}
+		if !invitation.ExpiresAt.After(time.Now()) {
+			return nil, errors.Conflict("Invitation has expired")
+		}
+
+		user, err := q.GetUserByID(ctx, actor.ID)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		if !strings.EqualFold(strings.TrimSpace(user.Email), invitation.Email) {
+			return nil, errors.Forbidden("Invitation was sent to a different email")
+		}
+
+		role := db.OrganizationMembersRole(invitation.Role)
+		if err := q.CreateOrganizationMember(ctx, db.CreateOrganizationMemberParams{
+			OrganizationID: invitation.OrganizationID,
+			UserID:         user.ID,
+			Role:           role,
+		}); err != nil {
+			if isDuplicateKeyError(err) {
+				return nil, errors.Conflict("User is already a member of the organization")
+			}
+			logctx.From(ctx, s.logger).Error("failed to add organization member", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		if _, err := q.AcceptOrganizationInvitation(ctx, db.AcceptOrganizationInvitationParams{
+			AcceptedBy: sql.NullInt64{Int64: int64(user.ID), Valid: true},
+			ID:         invitation.ID,
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to accept invitation", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionInvitationAccepted,
+			ResourceType: resourceType,
+			ResourceID:   invitation.OrganizationID,
+			NewValue: map[string]any{
+				"invitation_id": invitation.ExternalID,
+				"user_id":       user.ExternalID.String,
+				"role":          string(role),
+			},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		org, err := q.GetOrganizationByID(ctx, invitation.OrganizationID)
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+
+		logctx.From(ctx, s.logger).Info("organization invitation accepted",
+			zap.String("organization_id", org.ExternalID),
+			zap.String("invitation_id", invitation.ExternalID),
+			zap.String("role", string(role)),
+			zap.String("request_id", actor.RequestID),
+		)
+
+		return s.toResponse(ctx, q, &org, role)
+	})
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// generateInvitationToken creates a new random invitation token (inv_ + 64 hex chars)
+func generateInvitationToken() (string, error) {
+	buf := make([]byte, invitationTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("read random bytes: %w", err)
+	}
+	return invitationTokenPrefix + hex.EncodeToString(buf), nil
+}
+
+// hashInvitationToken returns the hex-encoded SHA-256 hash of an invitation token
+func hashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeEmail trims and lowercases an email for comparison and storage
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
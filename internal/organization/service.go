@@ -0,0 +1,601 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// SideBuyer and SideSeller tell which party of an order the organization is
+	SideBuyer  = "BUYER"
+	SideSeller = "SELLER"
+
+	// mysqlErrDuplicateEntry is the MySQL error number for unique key violations
+	mysqlErrDuplicateEntry = 1062
+
+	actionCreated            = "ORGANIZATION_CREATED"
+	actionUpdated            = "ORGANIZATION_UPDATED"
+	actionMemberRoleChanged  = "ORGANIZATION_MEMBER_ROLE_CHANGED"
+	actionMemberRemoved      = "ORGANIZATION_MEMBER_REMOVED"
+	actionInvitationCreated  = "ORGANIZATION_INVITATION_CREATED"
+	actionInvitationRevoked  = "ORGANIZATION_INVITATION_REVOKED"
+	actionInvitationAccepted = "ORGANIZATION_INVITATION_ACCEPTED"
+	resourceType             = "ORGANIZATION"
+)
+
+// Service manages organizations (companies), their members and their order/settlement views.
+// Access to organization resources is checked by middleware.RequireOrgRole (see ResolveOrgRole);
+// methods taking an organization id assume the caller passed it.
+type Service struct {
+	txRunner      *pkgdb.TxRunner
+	invitationTTL time.Duration
+	logger        *zap.Logger
+}
+
+// NewService creates a new organization service; invitationTTL is the validity of invitation tokens
+func NewService(txRunner *pkgdb.TxRunner, invitationTTL time.Duration, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner:      txRunner,
+		invitationTTL: invitationTTL,
+		logger:        logger,
+	}
+}
+
+// ResolveOrgRole returns the role of a user in an organization ("" when not a member).
+// Implements middleware.OrgRoleResolver.
+func (s *Service) ResolveOrgRole(ctx context.Context, orgExternalID string, userID uint64) (string, error) {
+	q := s.txRunner.Queries()
+
+	org, err := s.getOrganization(ctx, q, orgExternalID)
+	if err != nil {
+		return "", err
+	}
+	member, err := q.GetOrganizationMember(ctx, db.GetOrganizationMemberParams{
+		OrganizationID: org.ID,
+		UserID:         userID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+		logctx.From(ctx, s.logger).Error("failed to get organization member", zap.Error(err))
+		return "", errors.DBError(err)
+	}
+	return string(member.Role), nil
+}
+
+// Create registers a company with the actor as its first OWNER and opens its MERCHANT settlement account.
+//
+// Why:
+//   - 조직/정산 계정/OWNER 구성원/감사 로그가 한 트랜잭션 → 구성원 없는 조직이나 계정 없는 조직 없음
+//   - 사업자등록번호는 정규화 후 unique (uk_organization_brn) → 표기만 다른 같은 회사의 중복 등록 차단
+func (s *Service) Create(ctx context.Context, req *CreateOrganizationRequest, actor audit.Actor) (*OrganizationResponse, error) {
+	brn, ok := normalizeBusinessRegistrationNumber(req.BusinessRegistrationNumber)
+	if !ok {
+		return nil, errors.InvalidInput("business_registration_number must contain only letters, digits, spaces and hyphens")
+	}
+
+	return pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*OrganizationResponse, error) {
+		// 1. Settlement account (owned by the creator, payee of the organization's sales)
+		accountExternalID := uuid.New().String()
+		result, err := q.CreateAccount(ctx, db.CreateAccountParams{
+			AccountType: db.AccountsAccountTypeMERCHANT,
+			OwnerID:     sql.NullInt64{Int64: int64(actor.ID), Valid: true},
+			ExternalID:  sql.NullString{String: accountExternalID, Valid: true},
+		})
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to create settlement account", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		accountID, err := result.LastInsertId()
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+
+		// 2. Organization
+		result, err = q.CreateOrganization(ctx, db.CreateOrganizationParams{
+			ExternalID:                 uuid.New().String(),
+			Name:                       req.Name,
+			BusinessRegistrationNumber: brn,
+			BillingEmail:               nullString(req.BillingEmail),
+			BillingAddress:             nullString(req.BillingAddress),
+			BillingContactName:         nullString(req.BillingContactName),
+			SettlementAccountID:        sql.NullInt64{Int64: accountID, Valid: true},
+			CreatedBy:                  actor.ID,
+		})
+		if err != nil {
+			if isDuplicateKeyError(err) {
+				return nil, errors.Conflict("Business registration number already registered")
+			}
+			logctx.From(ctx, s.logger).Error("failed to create organization", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		orgID, err := result.LastInsertId()
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+
+		// 3. Creator becomes OWNER
+		if err := q.CreateOrganizationMember(ctx, db.CreateOrganizationMemberParams{
+			OrganizationID: uint64(orgID),
+			UserID:         actor.ID,
+			Role:           db.OrganizationMembersRoleOWNER,
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to create organization owner", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		// 4. Audit (same transaction)
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionCreated,
+			ResourceType: resourceType,
+			ResourceID:   uint64(orgID),
+			NewValue: map[string]any{
+				"name":                         req.Name,
+				"business_registration_number": brn,
+				"settlement_account_id":        accountExternalID,
+			},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		org, err := q.GetOrganizationByID(ctx, uint64(orgID))
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+
+		logctx.From(ctx, s.logger).Info("organization created",
+			zap.String("organization_id", org.ExternalID),
+			zap.String("business_registration_number", brn),
+			zap.String("request_id", actor.RequestID),
+		)
+
+		return ToOrganizationResponse(&org, accountExternalID, db.OrganizationMembersRoleOWNER), nil
+	})
+}
+
+// Get returns the profile of an organization with the caller's role in it
+func (s *Service) Get(ctx context.Context, orgExternalID, role string) (*OrganizationResponse, error) {
+	q := s.txRunner.Queries()
+
+	org, err := s.getOrganization(ctx, q, orgExternalID)
+	if err != nil {
+		return nil, err
+	}
+	return s.toResponse(ctx, q, org, db.OrganizationMembersRole(role))
+}
+
+// Update replaces the name and billing information of an organization
+func (s *Service) Update(ctx context.Context, orgExternalID string, req *UpdateOrganizationRequest, role string, actor audit.Actor) (*OrganizationResponse, error) {
+	return pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*OrganizationResponse, error) {
+		org, err := s.getOrganization(ctx, q, orgExternalID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := q.UpdateOrganizationProfile(ctx, db.UpdateOrganizationProfileParams{
+			Name:               req.Name,
+			BillingEmail:       nullString(req.BillingEmail),
+			BillingAddress:     nullString(req.BillingAddress),
+			BillingContactName: nullString(req.BillingContactName),
+			ID:                 org.ID,
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to update organization", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionUpdated,
+			ResourceType: resourceType,
+			ResourceID:   org.ID,
+			OldValue:     profileOf(org.Name, org.BillingEmail.String, org.BillingAddress.String, org.BillingContactName.String),
+			NewValue:     profileOf(req.Name, req.BillingEmail, req.BillingAddress, req.BillingContactName),
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		updated, err := q.GetOrganizationByID(ctx, org.ID)
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+		return s.toResponse(ctx, q, &updated, db.OrganizationMembersRole(role))
+	})
+}
+
+// ListByUser lists the organizations a user belongs to with the user's role in each
+func (s *Service) ListByUser(ctx context.Context, userExternalID string) (*ListOrganizationsResponse, error) {
+	q := s.txRunner.Queries()
+
+	user, err := s.getUser(ctx, q, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.ListOrganizationsByUser(ctx, user.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list organizations", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	organizations := make([]OrganizationResponse, 0, len(rows))
+	for _, row := range rows {
+		org := db.Organization{
+			ID:                         row.ID,
+			ExternalID:                 row.ExternalID,
+			Name:                       row.Name,
+			BusinessRegistrationNumber: row.BusinessRegistrationNumber,
+			BillingEmail:               row.BillingEmail,
+			BillingAddress:             row.BillingAddress,
+			BillingContactName:         row.BillingContactName,
+			SettlementAccountID:        row.SettlementAccountID,
+			Status:                     row.Status,
+			CreatedBy:                  row.CreatedBy,
+			CreatedAt:                  row.CreatedAt,
+			UpdatedAt:                  row.UpdatedAt,
+		}
+		response, err := s.toResponse(ctx, q, &org, row.MemberRole)
+		if err != nil {
+			return nil, err
+		}
+		organizations = append(organizations, *response)
+	}
+
+	return &ListOrganizationsResponse{Organizations: organizations}, nil
+}
+
+// ============================================================================
+// Members
+// ============================================================================
+
+// ListMembers lists the members of an organization and their roles
+func (s *Service) ListMembers(ctx context.Context, orgExternalID string) (*ListMembersResponse, error) {
+	q := s.txRunner.Queries()
+
+	org, err := s.getOrganization(ctx, q, orgExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.ListOrganizationMembers(ctx, org.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list organization members", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	members := make([]MemberResponse, 0, len(rows))
+	for i := range rows {
+		members = append(members, ToMemberResponse(&rows[i]))
+	}
+	return &ListMembersResponse{Members: members}, nil
+}
+
+// UpdateMemberRole assigns a new role to a member; the last owner cannot be demoted
+func (s *Service) UpdateMemberRole(ctx context.Context, orgExternalID, userExternalID string, req *UpdateMemberRoleRequest, actor audit.Actor) (*MemberResponse, error) {
+	newRole := db.OrganizationMembersRole(req.Role)
+
+	return pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*MemberResponse, error) {
+		org, user, member, err := s.lockMember(ctx, q, orgExternalID, userExternalID)
+		if err != nil {
+			return nil, err
+		}
+		if member.Role == newRole {
+			return memberResponse(user, member), nil
+		}
+		if member.Role == db.OrganizationMembersRoleOWNER {
+			if err := s.requireAnotherOwner(ctx, q, org.ID); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := q.UpdateOrganizationMemberRole(ctx, db.UpdateOrganizationMemberRoleParams{
+			Role:           newRole,
+			OrganizationID: org.ID,
+			UserID:         user.ID,
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to update organization member role", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionMemberRoleChanged,
+			ResourceType: resourceType,
+			ResourceID:   org.ID,
+			OldValue:     map[string]any{"user_id": userExternalID, "role": string(member.Role)},
+			NewValue:     map[string]any{"user_id": userExternalID, "role": req.Role},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		member.Role = newRole
+		return memberResponse(user, member), nil
+	})
+}
+
+// RemoveMember revokes a user's access to an organization. Owners (or admins) may remove any member,
+// other members may only leave; the last owner cannot be removed.
+func (s *Service) RemoveMember(ctx context.Context, orgExternalID, userExternalID, role string, actor audit.Actor, admin bool) error {
+	return s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		org, user, member, err := s.lockMember(ctx, q, orgExternalID, userExternalID)
+		if err != nil {
+			return err
+		}
+		if user.ID != actor.ID && !admin && role != string(db.OrganizationMembersRoleOWNER) {
+			return errors.Forbidden("Only organization owners can remove other members")
+		}
+		if member.Role == db.OrganizationMembersRoleOWNER {
+			if err := s.requireAnotherOwner(ctx, q, org.ID); err != nil {
+				return err
+			}
+		}
+
+		if _, err := q.DeleteOrganizationMember(ctx, db.DeleteOrganizationMemberParams{
+			OrganizationID: org.ID,
+			UserID:         user.ID,
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to remove organization member", zap.Error(err))
+			return errors.DBError(err)
+		}
+
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionMemberRemoved,
+			ResourceType: resourceType,
+			ResourceID:   org.ID,
+			OldValue:     map[string]any{"user_id": userExternalID, "role": string(member.Role)},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return errors.DBError(err)
+		}
+		return nil
+	})
+}
+
+// lockMember locks the organization row and resolves a member of it.
+//
+// Why:
+//   - 조직 row-lock으로 구성원 변경 직렬화 → OWNER 2명이 서로를 동시에 강등/제거해도 OWNER 0명 불가
+func (s *Service) lockMember(ctx context.Context, q *db.Queries, orgExternalID, userExternalID string) (*db.Organization, *db.User, *db.OrganizationMember, error) {
+	org, err := s.getOrganization(ctx, q, orgExternalID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if _, err := q.GetOrganizationForUpdate(ctx, org.ID); err != nil {
+		logctx.From(ctx, s.logger).Error("failed to lock organization", zap.Error(err))
+		return nil, nil, nil, errors.DBError(err)
+	}
+
+	user, err := s.getUser(ctx, q, userExternalID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	member, err := q.GetOrganizationMember(ctx, db.GetOrganizationMemberParams{
+		OrganizationID: org.ID,
+		UserID:         user.ID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil, nil, errors.NotFound("Organization member")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get organization member", zap.Error(err))
+		return nil, nil, nil, errors.DBError(err)
+	}
+	return org, user, &member, nil
+}
+
+// requireAnotherOwner rejects changes that would leave the organization without an owner
+func (s *Service) requireAnotherOwner(ctx context.Context, q *db.Queries, orgID uint64) error {
+	owners, err := q.CountOrganizationOwners(ctx, orgID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count organization owners", zap.Error(err))
+		return errors.DBError(err)
+	}
+	if owners <= 1 {
+		return errors.Conflict("Organization must keep at least one owner")
+	}
+	return nil
+}
+
+// ============================================================================
+// Orders & settlements
+// ============================================================================
+
+// ListOrders lists the orders the organization placed or received (newest first)
+func (s *Service) ListOrders(ctx context.Context, orgExternalID string, req *ListOrdersRequest) (*ListOrdersResponse, error) {
+	q := s.txRunner.Queries()
+
+	org, err := s.getOrganization(ctx, q, orgExternalID)
+	if err != nil {
+		return nil, err
+	}
+	orgID := sql.NullInt64{Int64: int64(org.ID), Valid: true}
+
+	offset := (req.Page - 1) * req.PageSize
+	orders, err := q.ListOrdersByOrganization(ctx, db.ListOrdersByOrganizationParams{
+		OrganizationID: orgID,
+		Limit:          int32(req.PageSize),
+		Offset:         int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list organization orders", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := q.CountOrdersByOrganization(ctx, orgID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count organization orders", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	responses := make([]OrderResponse, 0, len(orders))
+	for i := range orders {
+		responses = append(responses, ToOrderResponse(&orders[i], org.ID))
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListOrdersResponse{
+		Orders:     responses,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// ListSettlements lists the settlements paid to the organization's settlement account (newest first)
+func (s *Service) ListSettlements(ctx context.Context, orgExternalID string, req *ListSettlementsRequest) (*ListSettlementsResponse, error) {
+	q := s.txRunner.Queries()
+
+	org, err := s.getOrganization(ctx, q, orgExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListSettlementsResponse{
+		Settlements: []SettlementResponse{},
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+	}
+	if !org.SettlementAccountID.Valid {
+		return response, nil
+	}
+	accountID := uint64(org.SettlementAccountID.Int64)
+
+	offset := (req.Page - 1) * req.PageSize
+	rows, err := q.ListSettlementsByPayeeAccount(ctx, db.ListSettlementsByPayeeAccountParams{
+		PayeeAccountID: accountID,
+		Limit:          int32(req.PageSize),
+		Offset:         int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list organization settlements", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := q.CountSettlementsByPayeeAccount(ctx, accountID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count organization settlements", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	for i := range rows {
+		response.Settlements = append(response.Settlements, ToSettlementResponse(&rows[i]))
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+	response.Total = total
+	response.TotalPages = totalPages
+	return response, nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// getOrganization resolves an organization by external id
+func (s *Service) getOrganization(ctx context.Context, q *db.Queries, orgExternalID string) (*db.Organization, error) {
+	org, err := q.GetOrganizationByExternalID(ctx, orgExternalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Organization")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get organization", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &org, nil
+}
+
+// getUser resolves an active user by external id
+func (s *Service) getUser(ctx context.Context, q *db.Queries, userExternalID string) (*db.User, error) {
+	user, err := q.GetUserByExternalID(ctx, sql.NullString{String: userExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("User")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &user, nil
+}
+
+// toResponse converts an organization to its response, resolving the settlement account's external id
+func (s *Service) toResponse(ctx context.Context, q *db.Queries, org *db.Organization, role db.OrganizationMembersRole) (*OrganizationResponse, error) {
+	var settlementAccountID string
+	if org.SettlementAccountID.Valid {
+		account, err := q.GetAccountByID(ctx, uint64(org.SettlementAccountID.Int64))
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to get settlement account", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		settlementAccountID = account.ExternalID.String
+	}
+	return ToOrganizationResponse(org, settlementAccountID, role), nil
+}
+
+// memberResponse builds the response of a member from its user
+func memberResponse(user *db.User, member *db.OrganizationMember) *MemberResponse {
+	return &MemberResponse{
+		UserID:   user.ExternalID.String,
+		Email:    user.Email,
+		Name:     user.Name,
+		Role:     string(member.Role),
+		JoinedAt: member.CreatedAt,
+	}
+}
+
+// normalizeBusinessRegistrationNumber strips spaces and hyphens and uppercases the number
+// NOTE: "123-45-67890"과 "1234567890"은 같은 사업자 → 정규화 값으로 unique 판정
+func normalizeBusinessRegistrationNumber(raw string) (string, bool) {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(raw) {
+		switch {
+		case r == ' ' || r == '-':
+		case (r >= '0' && r <= '9') || (r >= 'A' && r <= 'Z'):
+			b.WriteRune(r)
+		default:
+			return "", false
+		}
+	}
+	return b.String(), b.Len() > 0
+}
+
+// profileOf builds the audited view of an organization profile
+func profileOf(name, billingEmail, billingAddress, billingContactName string) map[string]any {
+	return map[string]any{
+		"name":                 name,
+		"billing_email":        billingEmail,
+		"billing_address":      billingAddress,
+		"billing_contact_name": billingContactName,
+	}
+}
+
+// nullString converts an optional request field to a nullable column value
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+// isDuplicateKeyError checks if the error is a MySQL duplicate key error
+func isDuplicateKeyError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return stderrors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
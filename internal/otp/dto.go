@@ -0,0 +1,53 @@
+package otp
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/sms"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// VerifyCodeRequest represents the request body for verifying a phone number
+type VerifyCodeRequest struct {
+	Code string `json:"code" binding:"required,len=6,numeric" example:"123456"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// RequestCodeResponse represents a sent verification code
+type RequestCodeResponse struct {
+	// Phone is the masked number the code was sent to
+	Phone     string    `json:"phone" example:"+82******5678"`
+	ExpiresAt time.Time `json:"expires_at"`
+	// ResendAfter is the earliest time a new code can be requested
+	ResendAfter time.Time `json:"resend_after"`
+}
+
+// PhoneVerificationResponse represents the phone verification state of a user
+type PhoneVerificationResponse struct {
+	Phone           string     `json:"phone" example:"+82******5678"`
+	Verified        bool       `json:"verified" example:"true"`
+	PhoneVerifiedAt *time.Time `json:"phone_verified_at,omitempty"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToPhoneVerificationResponse converts a db.User to PhoneVerificationResponse
+func ToPhoneVerificationResponse(u *db.User) *PhoneVerificationResponse {
+	resp := &PhoneVerificationResponse{
+		Phone:    sms.MaskPhone(u.Phone.String),
+		Verified: u.Phone.Valid && u.PhoneVerifiedAt.Valid,
+	}
+	if u.PhoneVerifiedAt.Valid {
+		resp.PhoneVerifiedAt = &u.PhoneVerifiedAt.Time
+	}
+	return resp
+}
@@ -0,0 +1,113 @@
+package otp
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for phone verification
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new OTP handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers phone verification routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	// Only the account owner (or an admin) can verify the number on file
+	phone := rg.Group("/users/:id/phone", middleware.RequireAuth())
+	{
+		phone.POST("/otp", h.RequestCode)
+		phone.POST("/verify", h.VerifyCode)
+	}
+}
+
+// extractUserID extracts the user id from path and checks the caller may act as the user
+func extractUserID(c *gin.Context) (string, error) {
+	userID := c.Param("id")
+	if _, err := uuid.Parse(userID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+
+	principal, _ := middleware.GetPrincipal(c)
+	if !principal.CanActAs(userID) {
+		return "", errors.Forbidden("Cannot verify the phone number of another user")
+	}
+	return userID, nil
+}
+
+// RequestCode godoc
+// @Summary Request a phone verification code
+// @Description Send a 6-digit one-time code by SMS to the phone number on file. Sending a new code invalidates the previous one.
+// @Description A number receives at most one code per resend cooldown (default 60s) and a limited number of codes per window
+// @Description (default 5 per hour), regardless of which account requests them.
+// @Tags users
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=RequestCodeResponse} "Code sent"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid user ID or no phone number on file"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 409 {object} middleware.ErrorResponse "Phone number already verified"
+// @Failure 429 {object} middleware.ErrorResponse "Resend cooldown or send limit reached"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error or SMS delivery failed"
+// @Router /api/v1/users/{id}/phone/otp [post]
+func (h *Handler) RequestCode(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	resp, err := h.service.RequestCode(c.Request.Context(), userExternalID)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, resp)
+}
+
+// VerifyCode godoc
+// @Summary Verify the phone number
+// @Description Submit the code sent by /phone/otp. On success the phone number is marked verified; changing the number
+// @Description later clears the verification. The code is discarded after the maximum number of wrong attempts (default 5).
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param request body VerifyCodeRequest true "Verification code"
+// @Success 200 {object} middleware.SuccessResponse{data=PhoneVerificationResponse} "Phone number verified"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid, expired or unrequested code"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 409 {object} middleware.ErrorResponse "Phone number changed since the code was sent"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/phone/verify [post]
+func (h *Handler) VerifyCode(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req VerifyCodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	resp, err := h.service.VerifyCode(c.Request.Context(), userExternalID, &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, resp)
+}
@@ -0,0 +1,223 @@
+package otp
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	stderrors "errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/sms"
+	"go.uber.org/zap"
+)
+
+const (
+	// purposePhone is the store purpose of phone number verification codes
+	purposePhone = "phone"
+	// codeDigits is the length of a verification code
+	codeDigits = 6
+)
+
+// Audit log identifiers
+const (
+	actionPhoneVerified = "PHONE_VERIFIED"
+	resourceUser        = "USER"
+)
+
+// Service handles phone number verification by one-time codes sent as SMS
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	store    *Store
+	sender   sms.Sender
+	limits   Limits
+	logger   *zap.Logger
+}
+
+// NewService creates a new OTP service
+// sender delivers the codes; limits bound code lifetime and how often a number receives codes
+func NewService(txRunner *pkgdb.TxRunner, store *Store, sender sms.Sender, limits Limits, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		store:    store,
+		sender:   sender,
+		limits:   limits,
+		logger:   logger,
+	}
+}
+
+// RequireVerifiedPhone rejects users whose phone number is not verified (PHONE_NOT_VERIFIED).
+// Payout address changes and large payouts call it.
+func RequireVerifiedPhone(user *db.User) error {
+	if !user.Phone.Valid || !user.PhoneVerifiedAt.Valid {
+		return errors.PhoneNotVerified()
+	}
+	return nil
+}
+
+// RequirePhoneChangeCooldown rejects users whose verified phone number was replaced less than cooldown
+// before now (PHONE_RECENTLY_CHANGED). Payout address changes call it with RequireVerifiedPhone.
+// Why: 탈취된 계정에서 번호를 바꿔 새 번호로 재인증해도 쿨다운 동안 지급 주소 변경 불가 → 원래 소유자 대응 시간 확보
+func RequirePhoneChangeCooldown(user *db.User, cooldown time.Duration, now time.Time) error {
+	if !user.PhoneChangedAt.Valid {
+		return nil
+	}
+	if availableAt := user.PhoneChangedAt.Time.Add(cooldown); now.Before(availableAt) {
+		return errors.PhoneRecentlyChanged(availableAt.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// RequestCode sends a verification code to the user's phone number on file.
+//
+// Why:
+//   - 번호 단위 쿨다운 + 시간당 발송 한도 (Redis) → 여러 계정/인스턴스로 같은 번호에 SMS 폭탄 불가
+//   - 코드는 해시로만 저장, 새 코드 발송 시 이전 코드는 무효
+func (s *Service) RequestCode(ctx context.Context, userExternalID string) (*RequestCodeResponse, error) {
+	user, err := s.getUser(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.Phone.Valid {
+		return nil, errors.InvalidInput("No phone number on file - set one with PUT /users/{id}")
+	}
+	if user.PhoneVerifiedAt.Valid {
+		return nil, errors.Conflict("Phone number already verified")
+	}
+	phone := user.Phone.String
+
+	code, err := generateCode()
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to generate otp code", zap.Error(err))
+		return nil, errors.Internal("Failed to generate verification code")
+	}
+	if err := s.store.Issue(ctx, purposePhone, phone, hashCode(phone, code)); err != nil {
+		var retryErr *RetryError
+		if stderrors.As(err, &retryErr) {
+			return nil, errors.RateLimited(int(math.Ceil(retryErr.RetryAfter.Seconds())))
+		}
+		logctx.From(ctx, s.logger).Error("failed to store otp code", zap.Error(err))
+		return nil, errors.Internal("Failed to issue verification code")
+	}
+
+	message := fmt.Sprintf("Your verification code is %s. It expires in %d minutes.", code, int(math.Ceil(s.limits.CodeTTL.Minutes())))
+	if err := s.sender.Send(ctx, phone, message); err != nil {
+		// NOTE: 발송 실패 시 코드/쿨다운 제거 → 즉시 재요청 가능 (발송 횟수는 한도에 포함)
+		if discardErr := s.store.Discard(ctx, purposePhone, phone); discardErr != nil {
+			logctx.From(ctx, s.logger).Warn("failed to discard unsent otp code", zap.Error(discardErr))
+		}
+		logctx.From(ctx, s.logger).Error("failed to send otp code",
+			zap.String("provider", s.sender.Provider()),
+			zap.String("phone", sms.MaskPhone(phone)),
+			zap.Error(err),
+		)
+		return nil, errors.Internal("Failed to send verification code")
+	}
+
+	logctx.From(ctx, s.logger).Info("otp code sent",
+		zap.String("external_id", userExternalID),
+		zap.String("phone", sms.MaskPhone(phone)),
+	)
+	now := time.Now().UTC()
+	return &RequestCodeResponse{
+		Phone:       sms.MaskPhone(phone),
+		ExpiresAt:   now.Add(s.limits.CodeTTL),
+		ResendAfter: now.Add(s.limits.Cooldown),
+	}, nil
+}
+
+// VerifyCode checks a code sent to the user's phone number and marks the number verified
+func (s *Service) VerifyCode(ctx context.Context, userExternalID string, req *VerifyCodeRequest, actor audit.Actor) (*PhoneVerificationResponse, error) {
+	user, err := s.getUser(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.Phone.Valid {
+		return nil, errors.InvalidInput("No phone number on file - set one with PUT /users/{id}")
+	}
+	phone := user.Phone.String
+
+	remaining, err := s.store.Verify(ctx, purposePhone, phone, hashCode(phone, req.Code))
+	switch {
+	case stderrors.Is(err, ErrCodeInvalid):
+		return nil, errors.InvalidInput("Invalid verification code").
+			WithDetails(map[string]any{"remaining_attempts": remaining})
+	case stderrors.Is(err, ErrCodeExpired):
+		return nil, errors.InvalidInput("Verification code expired or not requested")
+	case err != nil:
+		logctx.From(ctx, s.logger).Error("failed to verify otp code", zap.Error(err))
+		return nil, errors.Internal("Failed to verify code")
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		// 코드 발송 후 번호가 바뀌었으면 새 번호는 인증되지 않음
+		n, err := q.MarkUserPhoneVerified(ctx, db.MarkUserPhoneVerifiedParams{ID: user.ID, Phone: user.Phone})
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return errors.Conflict("Phone number changed - request a new code")
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionPhoneVerified,
+			ResourceType: resourceUser,
+			ResourceID:   user.ID,
+			NewValue:     map[string]any{"phone": sms.MaskPhone(phone)},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to mark phone verified", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("phone verified", zap.String("external_id", userExternalID))
+	verified, err := s.getUser(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	return ToPhoneVerificationResponse(verified), nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// getUser retrieves a user by external ID (excludes DELETED)
+func (s *Service) getUser(ctx context.Context, userExternalID string) (*db.User, error) {
+	user, err := s.txRunner.Queries().GetUserByExternalID(ctx, sql.NullString{String: userExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("User")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &user, nil
+}
+
+// generateCode returns a uniformly random numeric code of codeDigits digits
+func generateCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(math.Pow10(codeDigits))))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", codeDigits, n.Int64()), nil
+}
+
+// hashCode binds a code to the number it was sent to (only the hash is stored)
+func hashCode(phone, code string) string {
+	sum := sha256.Sum256([]byte(phone + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,175 @@
+package otp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// keyPrefix is the Redis key prefix for OTP state
+	keyPrefix = "otp"
+)
+
+// Error definitions
+var (
+	// ErrCooldown is returned when a code was sent to the number too recently
+	ErrCooldown = errors.New("otp resend cooldown")
+	// ErrSendLimit is returned when the number's send limit of the window is reached
+	ErrSendLimit = errors.New("otp send limit reached")
+	// ErrCodeInvalid is returned for a wrong code
+	ErrCodeInvalid = errors.New("otp code invalid")
+	// ErrCodeExpired is returned when no code is outstanding (expired, used or attempts exhausted)
+	ErrCodeExpired = errors.New("otp code expired")
+)
+
+// issueScript stores a code hash unless the number is in its resend cooldown or over its send limit.
+// KEYS[1] = code key, KEYS[2] = cooldown key, KEYS[3] = send counter key
+// ARGV[1] = code hash, ARGV[2] = code ttl ms, ARGV[3] = cooldown ms, ARGV[4] = send limit, ARGV[5] = window ms
+// returns {status, retry_after_ms}: 1 = issued, -1 = cooldown, -2 = send limit
+var issueScript = redis.NewScript(`
+local cooldown = redis.call('PTTL', KEYS[2])
+if cooldown > 0 then
+	return {-1, cooldown}
+end
+local sends = tonumber(redis.call('GET', KEYS[3]) or '0')
+if sends >= tonumber(ARGV[4]) then
+	return {-2, redis.call('PTTL', KEYS[3])}
+end
+if sends == 0 then
+	redis.call('SET', KEYS[3], 1, 'PX', ARGV[5])
+else
+	redis.call('INCR', KEYS[3])
+end
+redis.call('DEL', KEYS[1])
+redis.call('HSET', KEYS[1], 'hash', ARGV[1], 'attempts', 0)
+redis.call('PEXPIRE', KEYS[1], ARGV[2])
+redis.call('SET', KEYS[2], 1, 'PX', ARGV[3])
+return {1, 0}
+`)
+
+// verifyScript checks a code hash; the code is consumed on success and after the last allowed attempt.
+// KEYS[1] = code key, ARGV[1] = code hash, ARGV[2] = max attempts
+// returns {status, remaining_attempts}: 1 = valid, 0 = invalid, -1 = no outstanding code
+var verifyScript = redis.NewScript(`
+local data = redis.call('HMGET', KEYS[1], 'hash', 'attempts')
+if not data[1] then
+	return {-1, 0}
+end
+if data[1] == ARGV[1] then
+	redis.call('DEL', KEYS[1])
+	return {1, 0}
+end
+local attempts = tonumber(data[2]) + 1
+local remaining = tonumber(ARGV[2]) - attempts
+if remaining <= 0 then
+	redis.call('DEL', KEYS[1])
+	return {0, 0}
+end
+redis.call('HSET', KEYS[1], 'attempts', attempts)
+return {0, remaining}
+`)
+
+// Limits configures code lifetime and per-number rate limits
+type Limits struct {
+	// CodeTTL is how long a sent code can be verified
+	CodeTTL time.Duration
+	// Cooldown is the minimum time between two codes to the same number
+	Cooldown time.Duration
+	// SendLimit is the number of codes a number may receive per SendWindow
+	SendLimit  int
+	SendWindow time.Duration
+	// MaxAttempts is the number of verification attempts per code
+	MaxAttempts int
+}
+
+// RetryError carries how long the caller must wait before the next code (ErrCooldown, ErrSendLimit)
+type RetryError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("%v (retry after %s)", e.Err, e.RetryAfter)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// Store keeps outstanding OTP codes (hashed) and per-number send counters in Redis.
+// Codes are keyed by purpose and phone number, so a number's limits apply across users.
+type Store struct {
+	client *redis.Client
+	limits Limits
+}
+
+// NewStore creates a new Redis-based OTP store
+func NewStore(client *redis.Client, limits Limits) *Store {
+	return &Store{
+		client: client,
+		limits: limits,
+	}
+}
+
+// buildKey creates a Redis key for the number
+// Format: otp:{kind}:{purpose}:{phone}
+func buildKey(kind, purpose, phone string) string {
+	return fmt.Sprintf("%s:%s:%s:%s", keyPrefix, kind, purpose, phone)
+}
+
+// Issue stores the code hash for the number, replacing an outstanding code.
+// Returns a *RetryError wrapping ErrCooldown or ErrSendLimit when the number may not receive a code yet.
+func (s *Store) Issue(ctx context.Context, purpose, phone, codeHash string) error {
+	keys := []string{
+		buildKey("code", purpose, phone),
+		buildKey("cooldown", purpose, phone),
+		buildKey("sends", purpose, phone),
+	}
+	values, err := issueScript.Run(ctx, s.client, keys,
+		codeHash,
+		s.limits.CodeTTL.Milliseconds(),
+		s.limits.Cooldown.Milliseconds(),
+		s.limits.SendLimit,
+		s.limits.SendWindow.Milliseconds(),
+	).Int64Slice()
+	if err != nil {
+		return fmt.Errorf("issue otp: %w", err)
+	}
+	retryAfter := time.Duration(values[1]) * time.Millisecond
+	switch values[0] {
+	case -1:
+		return &RetryError{Err: ErrCooldown, RetryAfter: retryAfter}
+	case -2:
+		return &RetryError{Err: ErrSendLimit, RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// Verify checks the code hash for the number; returns the remaining attempts with ErrCodeInvalid,
+// or ErrCodeExpired when no code is outstanding
+func (s *Store) Verify(ctx context.Context, purpose, phone, codeHash string) (int, error) {
+	values, err := verifyScript.Run(ctx, s.client, []string{buildKey("code", purpose, phone)},
+		codeHash,
+		s.limits.MaxAttempts,
+	).Int64Slice()
+	if err != nil {
+		return 0, fmt.Errorf("verify otp: %w", err)
+	}
+	switch values[0] {
+	case 1:
+		return 0, nil
+	case 0:
+		return int(values[1]), ErrCodeInvalid
+	default:
+		return 0, ErrCodeExpired
+	}
+}
+
+// Discard removes the outstanding code for the number (e.g. the message could not be sent)
+func (s *Store) Discard(ctx context.Context, purpose, phone string) error {
+	return s.client.Del(ctx, buildKey("code", purpose, phone), buildKey("cooldown", purpose, phone)).Err()
+}
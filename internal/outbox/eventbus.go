@@ -0,0 +1,49 @@
+package outbox
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/eventbus"
+)
+
+// EventBusPublisher forwards outbox events to the internal event bus.
+// Topic = {prefix}.{aggregate type} (e.g. "settlement.user"), key = aggregate ID
+// so events of the same entity stay ordered within a partition.
+type EventBusPublisher struct {
+	bus         eventbus.Publisher
+	topicPrefix string
+}
+
+// Compile-time interface compliance check
+var _ Publisher = (*EventBusPublisher)(nil)
+
+// NewEventBusPublisher creates an outbox publisher backed by the event bus
+func NewEventBusPublisher(bus eventbus.Publisher, topicPrefix string) *EventBusPublisher {
+	return &EventBusPublisher{
+		bus:         bus,
+		topicPrefix: topicPrefix,
+	}
+}
+
+// PublishOutbox publishes the stored event envelope as-is
+// NOTE: 브로커 ack 이후 relay 트랜잭션이 실패하면 재발행됨 → 소비자는 event id로 중복 제거
+func (p *EventBusPublisher) PublishOutbox(ctx context.Context, _ *db.Queries, msg *db.Outbox) error {
+	return p.bus.Publish(ctx, eventbus.Message{
+		Topic:   p.topic(msg.AggregateType),
+		Key:     strconv.FormatUint(msg.AggregateID, 10),
+		ID:      msg.EventID,
+		Type:    msg.EventType,
+		Payload: msg.Payload,
+	})
+}
+
+func (p *EventBusPublisher) topic(aggregateType string) string {
+	topic := strings.ToLower(aggregateType)
+	if p.topicPrefix == "" {
+		return topic
+	}
+	return p.topicPrefix + "." + topic
+}
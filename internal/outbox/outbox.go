@@ -0,0 +1,104 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/google/uuid"
+)
+
+// Aggregate types recorded with outbox events
+const (
+	AggregateUser    = "USER"
+	AggregateWallet  = "WALLET"
+	AggregateOrder   = "ORDER"
+	AggregateAccount = "ACCOUNT"
+
+	AggregatePayoutAddress   = "PAYOUT_ADDRESS"
+	AggregateDelegatedSigner = "DELEGATED_SIGNER"
+	AggregateBudget          = "BUDGET"
+	AggregatePayment         = "PAYMENT"
+	AggregateDispute         = "DISPUTE"
+	AggregateProduct         = "PRODUCT"
+)
+
+// Message is a domain event to be recorded in the outbox
+type Message struct {
+	// EventType is the public event name (e.g. "kyc.approved")
+	EventType string
+	// AggregateType/AggregateID identify the changed entity (internal IDs)
+	AggregateType string
+	AggregateID   uint64
+	// AggregateExternalID is the entity's public ID (event log search by resource)
+	AggregateExternalID string
+	// RecipientUserID is the user notified about the event (0 = nobody)
+	RecipientUserID uint64
+	// Data is the event body (must not contain internal IDs)
+	Data any
+}
+
+// Event is the envelope stored as outbox payload and delivered to publishers
+type Event struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// Publisher delivers an outbox event to a downstream channel (webhooks, event bus).
+// q is bound to the relay transaction that marks the event completed.
+type Publisher interface {
+	PublishOutbox(ctx context.Context, q *db.Queries, msg *db.Outbox) error
+}
+
+// Write records a domain event. It must be called with the tx-bound Queries of the
+// state change so that the event is committed (or rolled back) together with it.
+// Returns the generated event ID.
+//
+// Why:
+// - 상태 변경과 이벤트 기록이 원자적 → 커밋된 변경의 이벤트는 유실되지 않음
+// - 실제 발행은 Relay가 커밋 이후 비동기로 수행 (at-least-once, event_id로 중복 제거)
+func Write(ctx context.Context, q *db.Queries, msg Message) (string, error) {
+	data, err := json.Marshal(msg.Data)
+	if err != nil {
+		return "", fmt.Errorf("marshal outbox event data: %w", err)
+	}
+
+	event := Event{
+		ID:        uuid.New().String(),
+		Type:      msg.EventType,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return "", fmt.Errorf("marshal outbox event: %w", err)
+	}
+
+	if err := q.CreateOutboxEvent(ctx, db.CreateOutboxEventParams{
+		EventID:             event.ID,
+		EventType:           msg.EventType,
+		AggregateType:       msg.AggregateType,
+		AggregateID:         msg.AggregateID,
+		AggregateExternalID: sql.NullString{String: msg.AggregateExternalID, Valid: msg.AggregateExternalID != ""},
+		RecipientUserID:     sql.NullInt64{Int64: int64(msg.RecipientUserID), Valid: msg.RecipientUserID != 0},
+		Payload:             payload,
+	}); err != nil {
+		return "", fmt.Errorf("create outbox event: %w", err)
+	}
+
+	return event.ID, nil
+}
+
+// DecodeEvent parses the envelope stored in an outbox row
+func DecodeEvent(msg *db.Outbox) (*Event, error) {
+	var event Event
+	if err := json.Unmarshal(msg.Payload, &event); err != nil {
+		return nil, fmt.Errorf("decode outbox event: %w", err)
+	}
+	return &event, nil
+}
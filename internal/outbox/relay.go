@@ -0,0 +1,185 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"go.uber.org/zap"
+)
+
+const (
+	// retryBaseDelay is the delay before the first retry (doubled per attempt)
+	retryBaseDelay = 5 * time.Second
+	// retryMaxDelay caps the exponential backoff
+	retryMaxDelay = 30 * time.Minute
+	// leaseDuration is how long a claimed event stays reserved for this relay
+	leaseDuration = time.Minute
+	// maxErrorLen bounds the stored error message
+	maxErrorLen = 1024
+)
+
+// RelayConfig holds outbox relay settings
+type RelayConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+// Relay publishes committed outbox events to the registered publishers
+type Relay struct {
+	txRunner   *pkgdb.TxRunner
+	publishers []Publisher
+	config     RelayConfig
+	logger     *zap.Logger
+}
+
+// NewRelay creates a new outbox relay
+func NewRelay(txRunner *pkgdb.TxRunner, config RelayConfig, logger *zap.Logger, publishers ...Publisher) *Relay {
+	return &Relay{
+		txRunner:   txRunner,
+		publishers: publishers,
+		config:     config,
+		logger:     logger,
+	}
+}
+
+// Run polls and publishes due events until ctx is canceled
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+
+	r.logger.Info("outbox relay started",
+		zap.Duration("poll_interval", r.config.PollInterval),
+		zap.Int("batch_size", r.config.BatchSize),
+		zap.Int("publishers", len(r.publishers)),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.logger.Info("outbox relay stopped")
+			return
+		case <-ticker.C:
+			if err := r.RelayBatch(ctx); err != nil {
+				r.logger.Error("outbox relay batch failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// RelayBatch claims and publishes one batch of due events
+func (r *Relay) RelayBatch(ctx context.Context) error {
+	events, err := r.claim(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range events {
+		if ctx.Err() != nil {
+			// 종료 중 - 남은 건은 lease 만료 후 재claim
+			return nil
+		}
+		r.publish(ctx, &events[i])
+	}
+	return nil
+}
+
+// claim locks due events and leases them to this relay
+//
+// Why:
+// - FOR UPDATE SKIP LOCKED → 여러 인스턴스가 같은 이벤트를 중복 발행하지 않음
+// - lease(next_retry_at) → 발행 중 프로세스가 죽어도 lease 만료 후 재시도
+func (r *Relay) claim(ctx context.Context) ([]db.Outbox, error) {
+	return pkgdb.WithTxResult(ctx, r.txRunner, func(q *db.Queries) ([]db.Outbox, error) {
+		events, err := q.ListDueOutboxEventsForUpdate(ctx, int32(r.config.BatchSize))
+		if err != nil {
+			return nil, fmt.Errorf("list due outbox events: %w", err)
+		}
+
+		leaseUntil := sql.NullTime{Time: time.Now().Add(leaseDuration), Valid: true}
+		for _, event := range events {
+			if err := q.MarkOutboxEventProcessing(ctx, db.MarkOutboxEventProcessingParams{
+				NextRetryAt: leaseUntil,
+				ID:          event.ID,
+			}); err != nil {
+				return nil, fmt.Errorf("mark outbox event processing: %w", err)
+			}
+		}
+		return events, nil
+	})
+}
+
+// publish hands a single event to every publisher and records the outcome.
+// Publishers share one transaction with the completion mark, so DB-backed
+// publishers (webhook deliveries) are all-or-nothing.
+func (r *Relay) publish(ctx context.Context, event *db.Outbox) {
+	pubErr := r.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		for _, publisher := range r.publishers {
+			if err := publisher.PublishOutbox(ctx, q, event); err != nil {
+				return err
+			}
+		}
+		return q.MarkOutboxEventCompleted(ctx, event.ID)
+	})
+	if pubErr == nil {
+		return
+	}
+
+	q := r.txRunner.Queries()
+	retries := event.RetryCount + 1
+	if retries >= event.MaxRetries {
+		if err := q.MarkOutboxEventDeadLetter(ctx, db.MarkOutboxEventDeadLetterParams{
+			ErrorMessage: sql.NullString{String: truncate(pubErr.Error(), maxErrorLen), Valid: true},
+			ID:           event.ID,
+		}); err != nil {
+			r.logger.Error("failed to mark outbox event dead letter", zap.Error(err))
+			return
+		}
+		r.logger.Error("outbox event moved to dead letter",
+			zap.String("event_id", event.EventID),
+			zap.String("event_type", event.EventType),
+			zap.Error(pubErr),
+		)
+		return
+	}
+
+	if err := q.MarkOutboxEventFailed(ctx, db.MarkOutboxEventFailedParams{
+		NextRetryAt:  sql.NullTime{Time: time.Now().Add(backoff(retries)), Valid: true},
+		ErrorMessage: sql.NullString{String: truncate(pubErr.Error(), maxErrorLen), Valid: true},
+		ID:           event.ID,
+	}); err != nil {
+		r.logger.Error("failed to schedule outbox retry", zap.Error(err))
+	}
+
+	r.logger.Warn("outbox publish failed, retry scheduled",
+		zap.String("event_id", event.EventID),
+		zap.Uint32("retries", retries),
+		zap.Error(pubErr),
+	)
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// backoff returns the delay before the given retry number (5s, 10s, 20s, ... capped at 30m)
+func backoff(retry uint32) time.Duration {
+	delay := retryBaseDelay
+	for i := uint32(1); i < retry; i++ {
+		delay *= 2
+		if delay >= retryMaxDelay {
+			return retryMaxDelay
+		}
+	}
+	return delay
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
@@ -0,0 +1,141 @@
+package payment
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/fee"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// CreateRefundRequest represents the request body for refunding a captured payment
+type CreateRefundRequest struct {
+	// Amount is the amount to refund (omitted = the remaining refundable amount, i.e. a full refund)
+	Amount string `json:"amount,omitempty" binding:"max=32" example:"250.00000000"`
+	Reason string `json:"reason,omitempty" binding:"max=255" example:"Damaged items returned"`
+}
+
+// ExportPaymentsQuery represents the export window of payments created in [from, to) (to default: now).
+// user_id defaults to the caller; admins may export any user.
+type ExportPaymentsQuery struct {
+	UserID string    `form:"user_id" binding:"omitempty,uuid"`
+	From   time.Time `form:"from" binding:"required" time_format:"2006-01-02T15:04:05Z07:00"`
+	To     time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// PaymentResponse represents a payment with the platform fee applied to it.
+// Fee is omitted until the payment is captured and charged; NetAmount is the amount minus refunds and the fee after reversals.
+type PaymentResponse struct {
+	ID               string                 `json:"id" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	OrderNumber      string                 `json:"order_number" example:"ORD-20260112-0001"`
+	BuyerID          string                 `json:"buyer_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	SellerID         string                 `json:"seller_id" example:"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`
+	Amount           money.Money            `json:"amount" swaggertype:"string" example:"1250.00000000"`
+	RefundedAmount   money.Money            `json:"refunded_amount" swaggertype:"string" example:"0.00000000"`
+	RefundableAmount money.Money            `json:"refundable_amount" swaggertype:"string" example:"1250.00000000"`
+	TokenSymbol      string                 `json:"token_symbol" example:"USDC"`
+	Status           string                 `json:"status" example:"CAPTURED"`
+	AuthorizedAt     *time.Time             `json:"authorized_at,omitempty"`
+	CapturedAt       *time.Time             `json:"captured_at,omitempty"`
+	ExpiresAt        *time.Time             `json:"expires_at,omitempty"`
+	Fee              *fee.BreakdownResponse `json:"fee,omitempty"`
+	NetAmount        *money.Money           `json:"net_amount,omitempty" swaggertype:"string" example:"1240.37500000"`
+	CreatedAt        time.Time              `json:"created_at"`
+	UpdatedAt        time.Time              `json:"updated_at"`
+}
+
+// RefundResponse represents a full or partial refund of a payment.
+// ToAddress and TxHash are set for ON_CHAIN refunds (returned to the buyer's primary wallet).
+type RefundResponse struct {
+	ID          string      `json:"id" example:"9b2f8c1e-4d3a-4f6b-8e7d-1a2b3c4d5e6f"`
+	PaymentID   string      `json:"payment_id" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	Amount      money.Money `json:"amount" swaggertype:"string" example:"250.00000000"`
+	Reason      string      `json:"reason,omitempty" example:"Damaged items returned"`
+	Method      string      `json:"method" example:"LEDGER"`
+	Status      string      `json:"status" example:"SUCCEEDED"`
+	ToAddress   string      `json:"to_address,omitempty" example:"0x742d35cc6634c0532925a3b844bc454e4438f44e"`
+	TxHash      string      `json:"tx_hash,omitempty" example:"0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"`
+	CompletedAt *time.Time  `json:"completed_at,omitempty"`
+	CreatedAt   time.Time   `json:"created_at"`
+}
+
+// PaymentExportRow represents one payment in a CSV export.
+// Amounts are plain decimals; net_amount = amount - refunded_amount - fee_amount (fee after reversals, 0 until charged).
+type PaymentExportRow struct {
+	PaymentID   string `json:"payment_id"`
+	OrderNumber string `json:"order_number"`
+	// Role is the exported user's side of the payment (BUYER or SELLER)
+	Role           string     `json:"role"`
+	BuyerID        string     `json:"buyer_id"`
+	SellerID       string     `json:"seller_id"`
+	Status         string     `json:"status"`
+	Amount         string     `json:"amount"`
+	RefundedAmount string     `json:"refunded_amount"`
+	FeeAmount      string     `json:"fee_amount"`
+	NetAmount      string     `json:"net_amount"`
+	TokenSymbol    string     `json:"token_symbol"`
+	AuthorizedAt   *time.Time `json:"authorized_at"`
+	CapturedAt     *time.Time `json:"captured_at"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// ToPaymentResponse converts a payment row (amount = parsed p.Amount, refunded = non-failed refunds) and its fee to PaymentResponse
+func ToPaymentResponse(p *db.GetPaymentDetailByExternalIDRow, amount, refunded money.Money, breakdown *fee.BreakdownResponse) *PaymentResponse {
+	resp := &PaymentResponse{
+		ID:               p.ExternalID.String,
+		OrderNumber:      p.OrderNumber,
+		BuyerID:          p.BuyerExternalID.String,
+		SellerID:         p.SellerExternalID.String,
+		Amount:           amount,
+		RefundedAmount:   refunded,
+		RefundableAmount: amount.Sub(refunded).NonNegative(),
+		TokenSymbol:      p.TokenSymbol,
+		Status:           string(p.Status),
+		AuthorizedAt:     nullTimePtr(p.AuthorizedAt.Time, p.AuthorizedAt.Valid),
+		CapturedAt:       nullTimePtr(p.CapturedAt.Time, p.CapturedAt.Valid),
+		ExpiresAt:        nullTimePtr(p.ExpiresAt.Time, p.ExpiresAt.Valid),
+		Fee:              breakdown,
+		CreatedAt:        p.CreatedAt,
+		UpdatedAt:        p.UpdatedAt,
+	}
+	if p.Status != db.PaymentsStatusCAPTURED {
+		resp.RefundableAmount = money.Zero("", amountScale)
+	}
+	if breakdown != nil {
+		net := amount.Sub(refunded).Sub(breakdown.NetAmount())
+		resp.NetAmount = &net
+	}
+	return resp
+}
+
+// ToRefundResponse converts db.PaymentRefund (amount = parsed r.Amount) to RefundResponse
+func ToRefundResponse(r *db.PaymentRefund, paymentExternalID string, amount money.Money) *RefundResponse {
+	return &RefundResponse{
+		ID:          r.ExternalID,
+		PaymentID:   paymentExternalID,
+		Amount:      amount,
+		Reason:      r.Reason.String,
+		Method:      string(r.Method),
+		Status:      string(r.Status),
+		ToAddress:   r.ToAddress.String,
+		TxHash:      r.TxHash.String,
+		CompletedAt: nullTimePtr(r.CompletedAt.Time, r.CompletedAt.Valid),
+		CreatedAt:   r.CreatedAt,
+	}
+}
+
+// nullTimePtr returns &t when valid, nil otherwise
+func nullTimePtr(t time.Time, valid bool) *time.Time {
+	if !valid {
+		return nil
+	}
+	return &t
+}
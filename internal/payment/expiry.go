@@ -0,0 +1,221 @@
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/catalog"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/outbox"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/webhook"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"go.uber.org/zap"
+)
+
+// Inventory log reference of order reservations
+const (
+	referenceOrder       = "ORDER"
+	reasonPaymentExpired = "PAYMENT_EXPIRED"
+)
+
+// ExpiryWorkerConfig holds authorization expiry sweep settings
+type ExpiryWorkerConfig struct {
+	// Interval is the period of the expiry sweep
+	Interval time.Duration
+	// BatchSize is the number of payments expired per run
+	BatchSize int
+}
+
+// ExpiryReport summarizes one expiry sweep
+type ExpiryReport struct {
+	Expired int
+	Failed  int
+}
+
+// ExpiryWorker expires AUTHORIZED payments past their expires_at (AUTHORIZED → EXPIRED),
+// releases the inventory reserved for their order and notifies buyer and seller (payment.expired).
+type ExpiryWorker struct {
+	txRunner *pkgdb.TxRunner
+	config   ExpiryWorkerConfig
+	logger   *zap.Logger
+}
+
+// NewExpiryWorker creates a new authorization expiry worker
+func NewExpiryWorker(txRunner *pkgdb.TxRunner, config ExpiryWorkerConfig, logger *zap.Logger) *ExpiryWorker {
+	return &ExpiryWorker{
+		txRunner: txRunner,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Run executes the expiry sweep periodically until ctx is canceled
+func (w *ExpiryWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	logctx.From(ctx, w.logger).Info("payment expiry job started",
+		zap.Duration("interval", w.config.Interval),
+		zap.Int("batch_size", w.config.BatchSize),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logctx.From(ctx, w.logger).Info("payment expiry job stopped")
+			return
+		case <-ticker.C:
+			report, err := w.RunOnce(ctx, time.Now().UTC())
+			if err != nil {
+				logctx.From(ctx, w.logger).Error("payment expiry run failed", zap.Error(err))
+				continue
+			}
+			if report.Expired+report.Failed > 0 {
+				logctx.From(ctx, w.logger).Info("payment expiry run completed",
+					zap.Int("expired", report.Expired),
+					zap.Int("failed", report.Failed),
+				)
+			}
+		}
+	}
+}
+
+// RunOnce expires one batch of authorizations past expires_at.
+// A failing payment is logged and counted; it is retried on the next run.
+func (w *ExpiryWorker) RunOnce(ctx context.Context, now time.Time) (*ExpiryReport, error) {
+	report := &ExpiryReport{}
+
+	payments, err := w.txRunner.Queries().ListExpiredAuthorizedPayments(ctx, db.ListExpiredAuthorizedPaymentsParams{
+		Now:   sql.NullTime{Time: now, Valid: true},
+		Limit: int32(w.config.BatchSize),
+	})
+	if err != nil {
+		return report, fmt.Errorf("list expired authorizations: %w", err)
+	}
+
+	for i := range payments {
+		if ctx.Err() != nil {
+			return report, nil
+		}
+		expired, err := w.expire(ctx, &payments[i])
+		if err != nil {
+			report.Failed++
+			logctx.From(ctx, w.logger).Error("payment expiry failed",
+				zap.String("payment_external_id", payments[i].ExternalID.String),
+				zap.Error(err),
+			)
+			continue
+		}
+		if expired {
+			report.Expired++
+		}
+	}
+	return report, nil
+}
+
+// expire transitions the payment, releases its order's reservations and writes the events in one transaction.
+// Returns false when the payment was captured or voided meanwhile (or another instance expired it).
+//
+// Why:
+// - 주문 → 결제 순서로 row-lock (환불/분쟁과 같은 순서) 후 AUTHORIZED 조건부 UPDATE → 확정과 경합 시 한쪽만 반영
+// - 재고 예약 해제는 같은 트랜잭션 → 결제 만료와 예약 해제가 어긋나지 않음
+func (w *ExpiryWorker) expire(ctx context.Context, p *db.ListExpiredAuthorizedPaymentsRow) (bool, error) {
+	var released int64
+	expired, err := pkgdb.WithTxResult(ctx, w.txRunner, func(q *db.Queries) (bool, error) {
+		if _, err := q.GetOrderByIDForUpdate(ctx, p.OrderID); err != nil {
+			return false, fmt.Errorf("lock order: %w", err)
+		}
+		result, err := q.ExpireAuthorizedPayment(ctx, p.ID)
+		if err != nil {
+			return false, fmt.Errorf("expire payment: %w", err)
+		}
+		if n, err := result.RowsAffected(); err != nil || n == 0 {
+			return false, err
+		}
+
+		released, err = ReleaseOrderReservations(ctx, q, p.OrderID, reasonPaymentExpired, audit.Actor{Type: audit.ActorTypeSystem})
+		if err != nil {
+			return false, err
+		}
+
+		data := map[string]any{
+			"payment_id":        p.ExternalID.String,
+			"order_number":      p.OrderNumber,
+			"status":            string(db.PaymentsStatusEXPIRED),
+			"amount":            p.Amount,
+			"token_symbol":      p.TokenSymbol,
+			"expires_at":        p.ExpiresAt.Time.UTC(),
+			"released_quantity": released,
+		}
+		// 구매자/판매자 모두 통지 (outbox 이벤트는 수신자 1명 단위)
+		for _, recipient := range []uint64{p.BuyerID, p.SellerID} {
+			if _, err := outbox.Write(ctx, q, outbox.Message{
+				EventType:           webhook.EventPaymentExpired,
+				AggregateType:       outbox.AggregatePayment,
+				AggregateID:         p.ID,
+				AggregateExternalID: p.ExternalID.String,
+				RecipientUserID:     recipient,
+				Data:                data,
+			}); err != nil {
+				return false, fmt.Errorf("write %s event: %w", webhook.EventPaymentExpired, err)
+			}
+		}
+		return true, nil
+	})
+	if err == nil && expired {
+		logctx.From(ctx, w.logger).Info("payment authorization expired",
+			zap.String("payment_external_id", p.ExternalID.String),
+			zap.String("order_number", p.OrderNumber),
+			zap.Int64("released_quantity", released),
+		)
+	}
+	return expired, err
+}
+
+// ReleaseOrderReservations releases the inventory still reserved for the order and records RELEASE logs and
+// stock movements with the reason and actor; returns the total quantity released. Must be called within a
+// transaction (q is tx-bound). Inventory rows are locked in id order (동시 예약/해제 간 deadlock 방지).
+func ReleaseOrderReservations(ctx context.Context, q *db.Queries, orderID uint64, reason string, actor audit.Actor) (int64, error) {
+	reservations, err := q.ListOrderInventoryReservations(ctx, sql.NullInt64{Int64: int64(orderID), Valid: true})
+	if err != nil {
+		return 0, fmt.Errorf("list order reservations: %w", err)
+	}
+
+	var total int64
+	for _, r := range reservations {
+		inventory, err := q.GetInventoryForUpdate(ctx, r.InventoryID)
+		if err != nil {
+			return 0, fmt.Errorf("lock inventory %d: %w", r.InventoryID, err)
+		}
+		// NOTE: 수동 조정(ADJUST)으로 예약 수량이 이미 줄었으면 남은 만큼만 해제
+		quantity := min(r.Reserved, inventory.ReservedQuantity)
+		if quantity <= 0 {
+			continue
+		}
+		reserved := inventory.ReservedQuantity - quantity
+
+		if err := q.UpdateInventoryReservedQuantity(ctx, db.UpdateInventoryReservedQuantityParams{
+			ReservedQuantity: reserved,
+			ID:               inventory.ID,
+		}); err != nil {
+			return 0, fmt.Errorf("update inventory %d reservation: %w", inventory.ID, err)
+		}
+		if err := catalog.RecordMovement(ctx, q, actor, catalog.Movement{
+			Inventory:     &inventory,
+			EventType:     db.InventoryLogsEventTypeRELEASE,
+			Reason:        db.StockMovementsReasonRELEASE,
+			ReservedDelta: -quantity,
+			ReferenceType: referenceOrder,
+			ReferenceID:   orderID,
+			Note:          reason,
+		}); err != nil {
+			return 0, err
+		}
+		total += quantity
+	}
+	return total, nil
+}
@@ -0,0 +1,221 @@
+package payment
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for payment operations
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new payment handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers payment routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	payments := rg.Group("/payments", middleware.RequireAuth())
+	{
+		payments.GET("/export", h.ExportPayments)
+		payments.GET("/:paymentId", h.GetPayment)
+		payments.POST("/:paymentId/refunds", h.CreateRefund)
+		payments.GET("/:paymentId/refunds", h.ListRefunds)
+	}
+}
+
+// GetPayment godoc
+// @Summary Get payment
+// @Description Get a payment of an order the caller bought or sold, with the platform fee charged on capture.
+// @Description fee and net_amount are omitted until the captured payment has been charged (fee job).
+// @Description net_amount is the amount the seller receives: amount - refunded_amount - (fee.amount - fee.reversed_amount).
+// @Description Supports conditional requests: ETag/Last-Modified are derived from the latest change of the payment, its refunds
+// @Description and its fee, and 304 is returned when unchanged.
+// @Tags payments
+// @Produce json
+// @Param paymentId path string true "Payment external ID (UUID)"
+// @Param If-None-Match header string false "ETag from a previous response"
+// @Param If-Modified-Since header string false "Last-Modified from a previous response"
+// @Success 200 {object} middleware.SuccessResponse{data=PaymentResponse} "Payment"
+// @Success 304 "Not modified"
+// @Header 200,304 {string} ETag "Weak resource version"
+// @Header 200,304 {string} Last-Modified "Latest change of the payment, its refunds and fee"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Payment not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/payments/{paymentId} [get]
+func (h *Handler) GetPayment(c *gin.Context) {
+	paymentID := c.Param("paymentId")
+	if _, err := uuid.Parse(paymentID); err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Invalid UUID format"))
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	result, lastModified, err := h.service.GetPayment(c.Request.Context(), paymentID, func(buyerID, sellerID string) bool {
+		return principal.CanActAs(buyerID) || principal.CanActAs(sellerID)
+	})
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOKConditional(c, paymentID, lastModified, result)
+}
+
+// ExportPayments godoc
+// @Summary Export payments
+// @Description Stream the payments the user bought or sold, created in [from, to), as a CSV attachment (oldest first).
+// @Description Rows are read and flushed in chunks, so large ranges are streamed without buffering the whole export.
+// @Description net_amount is amount - refunded_amount - fee_amount (fee after reversals, 0 until charged). Admins may export any user.
+// @Tags payments
+// @Produce text/csv
+// @Param user_id query string false "User external ID (UUID, default: caller)"
+// @Param from query string true "Window start (RFC 3339, inclusive)"
+// @Param to query string false "Window end (RFC 3339, exclusive, default: now)"
+// @Success 200 {file} file "payments.csv"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid query parameters or from not before to"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot export another user's payments"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/payments/export [get]
+func (h *Handler) ExportPayments(c *gin.Context) {
+	var query ExportPaymentsQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+	userID := query.UserID
+	if userID == "" {
+		userID = principal.UserExternalID
+	}
+	if !principal.CanActAs(userID) {
+		middleware.RespondError(c, errors.Forbidden("Cannot export another user's payments"))
+		return
+	}
+
+	to := query.To
+	if to.IsZero() {
+		to = time.Now().UTC()
+	}
+
+	middleware.RespondCSVStream(c, "payments", PaymentExportRow{}, func(write func(rows any) error) error {
+		return h.service.ExportPayments(c.Request.Context(), userID, query.From, to, func(rows []PaymentExportRow) error {
+			return write(rows)
+		})
+	})
+}
+
+// CreateRefund godoc
+// @Summary Refund payment
+// @Description Refund a captured payment in full (amount omitted = refundable_amount) or in part. Only the seller or an admin can refund.
+// @Description Before the payment is paid out to the seller the refund moves funds from the seller's account to the buyer's (method LEDGER, SUCCEEDED).
+// @Description After the payout an admin refunds it by an on-chain transfer to the buyer's primary wallet (method ON_CHAIN, PENDING until mined).
+// @Description The platform fee is reversed in proportion to the refunded amount; the last refund marks the payment REFUNDED.
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Param paymentId path string true "Payment external ID (UUID)"
+// @Param request body CreateRefundRequest true "Refund amount and reason"
+// @Success 201 {object} middleware.SuccessResponse{data=RefundResponse} "Refund created"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input, payment not captured, amount exceeds refundable amount or insufficient seller balance"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Not the seller, or paid-out payment refunded by a non-admin"
+// @Failure 404 {object} middleware.ErrorResponse "Payment not found"
+// @Failure 409 {object} middleware.ErrorResponse "Payment already fully refunded"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Failure 503 {object} middleware.ErrorResponse "Payments paused (settlement token depeg), on-chain refunds disabled or refund transfer failed"
+// @Security ApiKeyAuth
+// @Router /api/v1/payments/{paymentId}/refunds [post]
+func (h *Handler) CreateRefund(c *gin.Context) {
+	paymentID := c.Param("paymentId")
+	if _, err := uuid.Parse(paymentID); err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Invalid UUID format"))
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req CreateRefundRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.CreateRefund(c.Request.Context(), paymentID, &req, refundAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// ListRefunds godoc
+// @Summary List payment refunds
+// @Description List the refunds of a payment the caller bought or sold (oldest first). FAILED refunds are not counted in refunded_amount.
+// @Tags payments
+// @Produce json
+// @Param paymentId path string true "Payment external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=[]RefundResponse} "Refunds"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Payment not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/payments/{paymentId}/refunds [get]
+func (h *Handler) ListRefunds(c *gin.Context) {
+	paymentID := c.Param("paymentId")
+	if _, err := uuid.Parse(paymentID); err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Invalid UUID format"))
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	result, err := h.service.ListRefunds(c.Request.Context(), paymentID, refundAccess(principal))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// refundAccess maps the principal to the service's refund access check
+func refundAccess(principal *middleware.Principal) RefundAccess {
+	return RefundAccess{
+		Admin:    principal.IsAdmin(),
+		CanActAs: principal.CanActAs,
+	}
+}
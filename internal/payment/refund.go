@@ -0,0 +1,487 @@
+package payment
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/chaintx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/fee"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/ledger"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/outbox"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/webhook"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Audit log identifiers
+const (
+	actionRefunded  = "PAYMENT_REFUNDED"
+	resourcePayment = "PAYMENT"
+)
+
+// RefundAccess is the caller's access to the refunds of a payment
+type RefundAccess struct {
+	// Admin may refund any payment, including ON_CHAIN refunds of payments already paid out to the seller
+	Admin bool
+	// CanActAs reports whether the caller may operate on the user's resources (buyer/seller external ID)
+	CanActAs func(userExternalID string) bool
+}
+
+// canView reports whether the caller is the buyer, the seller or an admin
+func (a RefundAccess) canView(buyerID, sellerID string) bool {
+	return a.Admin || a.CanActAs(buyerID) || a.CanActAs(sellerID)
+}
+
+// RefundHook runs in the refund's reservation transaction after the refund is recorded and before
+// funds move (e.g. releasing a dispute hold that would block the seller debit).
+// Returning an error rolls the refund back.
+type RefundHook func(ctx context.Context, q *db.Queries, refund *db.PaymentRefund) error
+
+// refundReservation is a refund recorded under the payment lock, with what the next steps need
+type refundReservation struct {
+	refund    *db.PaymentRefund
+	amount    money.Money
+	baseUnits *big.Int
+}
+
+// CreateRefund refunds a captured payment in full (req.Amount omitted) or in part.
+// The sum of refunds never exceeds the payment amount; the payment becomes REFUNDED with the last refund.
+//
+// Why:
+//   - 판매자 지급 전(LEDGER): 판매자 계정 → 구매자 계정 반환 분개 + 수수료 비례 취소를 한 트랜잭션에서 처리
+//   - 판매자 지급 후(ON_CHAIN): 자금이 이미 판매자 지갑으로 나갔으므로 플랫폼 서명 키로 구매자 Primary 지갑에 반환 송금
+//     → 판매자 회수는 운영 절차이므로 관리자만 요청 가능
+//   - 결제 row-lock 하에서 환불 가능 잔액 검증 → 동시 부분 환불이 결제 금액을 초과하지 않음
+func (s *Service) CreateRefund(ctx context.Context, externalID string, req *CreateRefundRequest, access RefundAccess, actor audit.Actor) (*RefundResponse, error) {
+	return s.CreateRefundWithHook(ctx, externalID, req, access, actor, nil)
+}
+
+// CreateRefundWithHook is CreateRefund with a hook run in the reservation transaction (nil = none)
+func (s *Service) CreateRefundWithHook(ctx context.Context, externalID string, req *CreateRefundRequest, access RefundAccess, actor audit.Actor, hook RefundHook) (*RefundResponse, error) {
+	// 1. Validate
+	detail, err := s.getPaymentDetail(ctx, externalID, access.canView)
+	if err != nil {
+		return nil, err
+	}
+	if !access.Admin && !access.CanActAs(detail.SellerExternalID.String) {
+		return nil, errors.Forbidden("Only the seller of the payment can refund it")
+	}
+
+	var requested *money.Money
+	if req.Amount != "" {
+		amount, err := money.Parse(req.Amount, "", amountScale)
+		if err != nil || amount.Sign() <= 0 {
+			return nil, errors.InvalidInput("amount must be a positive decimal with at most 8 decimals")
+		}
+		requested = &amount
+	}
+
+	// 2. Reserve refund (LEDGER refunds complete here)
+	res, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*refundReservation, error) {
+		return s.reserveRefund(ctx, q, detail, requested, strings.TrimSpace(req.Reason), access, actor, hook)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.refund.Method == db.PaymentRefundsMethodLEDGER {
+		logctx.From(ctx, s.logger).Info("payment refunded",
+			zap.String("payment_external_id", externalID),
+			zap.String("refund_external_id", res.refund.ExternalID),
+			zap.Stringer("amount", res.amount),
+		)
+		return ToRefundResponse(res.refund, externalID, res.amount), nil
+	}
+
+	// 3. Broadcast return transfer (DB 트랜잭션 밖에서 RPC 호출)
+	// NOTE: 호출자 취소와 무관하게 전송 (브로드캐스트 도중 취소되면 전송 여부 불명)
+	sent, err := s.chainClient.SendTokenTransfer(context.WithoutCancel(ctx), res.refund.ToAddress.String, res.baseUnits)
+	var broadcastErr *chain.BroadcastError
+	if stderrors.As(err, &broadcastErr) {
+		// 서명된 tx가 이미 전송되었을 수 있음 → FAILED 처리 시 같은 금액을 재환불할 수 있으므로 보낸 것으로 추적
+		// (재전송/미전송 판정은 chaintx.Monitor → ReconcileRefundTx)
+		logctx.From(ctx, s.logger).Warn("refund transfer broadcast unacknowledged, tracking it as sent",
+			zap.String("refund_external_id", res.refund.ExternalID),
+			zap.String("tx_hash", broadcastErr.Sent.Hash),
+			zap.Uint64("nonce", broadcastErr.Sent.Nonce),
+			zap.Error(err),
+		)
+		sent, err = broadcastErr.Sent, nil
+	}
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to send refund transfer",
+			zap.String("refund_external_id", res.refund.ExternalID),
+			zap.Error(err),
+		)
+		// 서명/브로드캐스트 전 실패만 여기 도달 (전송되지 않음) → FAILED 환불은 환불 합계에서 제외되어 다시 요청 가능
+		if _, markErr := s.txRunner.Queries().CompletePaymentRefund(context.WithoutCancel(ctx), db.CompletePaymentRefundParams{
+			Status:      db.PaymentRefundsStatusFAILED,
+			CompletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+			ID:          res.refund.ID,
+		}); markErr != nil {
+			logctx.From(ctx, s.logger).Error("failed to mark refund failed", zap.Error(markErr))
+		}
+		return nil, errors.ChainError("Failed to send refund transfer")
+	}
+
+	// 4. Record tx hash + apply refund (미확정 시 chaintx.Monitor가 수수료 인상 재전송, 결과는 ReconcileRefundTx)
+	err = s.txRunner.WithTx(context.WithoutCancel(ctx), func(q *db.Queries) error {
+		if err := q.UpdatePaymentRefundTxHash(ctx, db.UpdatePaymentRefundTxHashParams{
+			TxHash: sql.NullString{String: sent.Hash, Valid: true},
+			ID:     res.refund.ID,
+		}); err != nil {
+			return err
+		}
+		if err := chaintx.Record(ctx, q, s.chainClient.ChainID(), sent, chaintx.ReferencePaymentRefund, res.refund.ID, time.Now()); err != nil {
+			return err
+		}
+
+		order, err := q.GetOrderByIDForUpdate(ctx, detail.OrderID)
+		if err != nil {
+			return err
+		}
+		payment, err := q.GetPaymentForUpdate(ctx, detail.ID)
+		if err != nil {
+			return err
+		}
+		refund, err := q.GetPaymentRefundByID(ctx, res.refund.ID)
+		if err != nil {
+			return err
+		}
+		res.refund = &refund
+		return s.applyRefund(ctx, q, &payment, &order, res, actor)
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to record refund transfer",
+			zap.String("refund_external_id", res.refund.ExternalID),
+			zap.String("tx_hash", sent.Hash),
+			zap.Error(err),
+		)
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("payment refund sent",
+		zap.String("payment_external_id", externalID),
+		zap.String("refund_external_id", res.refund.ExternalID),
+		zap.String("tx_hash", sent.Hash),
+	)
+	return ToRefundResponse(res.refund, externalID, res.amount), nil
+}
+
+// ListRefunds returns the refunds of a payment the caller bought, sold or administers (oldest first)
+func (s *Service) ListRefunds(ctx context.Context, externalID string, access RefundAccess) ([]*RefundResponse, error) {
+	detail, err := s.getPaymentDetail(ctx, externalID, access.canView)
+	if err != nil {
+		return nil, err
+	}
+
+	refunds, err := s.txRunner.Queries().ListPaymentRefundsByPayment(ctx, detail.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list refunds", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	result := make([]*RefundResponse, 0, len(refunds))
+	for i := range refunds {
+		amount, err := s.parseStoredAmount(ctx, detail.ID, refunds[i].Amount)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, ToRefundResponse(&refunds[i], externalID, amount))
+	}
+	return result, nil
+}
+
+// ReconcileRefundTx applies the final outcome of an ON_CHAIN refund transfer (chaintx.Reconciler):
+// a confirmed transfer completes the refund with the hash that landed, a reverted or dropped one
+// is RETURN_FAILED - the refund stays counted and an operator resends the funds.
+// Transfers whose broadcast was never acknowledged are tracked the same way, so a refund is only
+// released for another attempt after reconciliation, never on an ambiguous send error.
+func ReconcileRefundTx(ctx context.Context, q *db.Queries, refundID uint64, outcome chaintx.Outcome) error {
+	status := db.PaymentRefundsStatusRETURNFAILED
+	if outcome.Status == db.ChainTransactionsStatusCONFIRMED {
+		status = db.PaymentRefundsStatusSUCCEEDED
+		if err := q.UpdatePaymentRefundTxHash(ctx, db.UpdatePaymentRefundTxHashParams{
+			TxHash: sql.NullString{String: outcome.TxHash, Valid: true},
+			ID:     refundID,
+		}); err != nil {
+			return err
+		}
+	}
+	_, err := q.CompletePaymentRefund(ctx, db.CompletePaymentRefundParams{
+		Status:      status,
+		CompletedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		ID:          refundID,
+	})
+	return err
+}
+
+// reserveRefund validates the refund under the order and payment locks and records it.
+// LEDGER refunds are applied in the same transaction; ON_CHAIN refunds are PENDING until broadcast.
+func (s *Service) reserveRefund(ctx context.Context, q *db.Queries, detail *db.GetPaymentDetailByExternalIDRow, requested *money.Money, reason string, access RefundAccess, actor audit.Actor, hook RefundHook) (*refundReservation, error) {
+	// 1. Lock order → payment (ordersla 자동 환불과 같은 순서)
+	order, err := q.GetOrderByIDForUpdate(ctx, detail.OrderID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to lock order row", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	payment, err := q.GetPaymentForUpdate(ctx, detail.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to lock payment row", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if payment.Status != db.PaymentsStatusCAPTURED {
+		return nil, errors.InvalidStateTransition(string(payment.Status), string(db.PaymentsStatusREFUNDED))
+	}
+
+	// 2. Refundable balance
+	paymentAmount, err := s.parseStoredAmount(ctx, payment.ID, payment.Amount)
+	if err != nil {
+		return nil, err
+	}
+	refunded, err := s.refundedAmount(ctx, q, payment.ID)
+	if err != nil {
+		return nil, err
+	}
+	refundable := paymentAmount.Sub(refunded)
+	if refundable.Sign() <= 0 {
+		return nil, errors.Conflict("Payment is already fully refunded")
+	}
+	amount := refundable
+	if requested != nil {
+		amount = *requested
+	}
+	if amount.Cmp(refundable) > 0 {
+		return nil, errors.InvalidInput("amount exceeds the refundable amount").
+			WithDetails(map[string]any{"refundable_amount": refundable.String()})
+	}
+
+	// 3. Method (판매자 지급 완료 여부)
+	settled, err := q.ExistsCompletedSettlementByPayment(ctx, payment.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to check payment settlement", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	res := &refundReservation{amount: amount}
+	params := db.CreatePaymentRefundParams{
+		ExternalID: uuid.New().String(),
+		PaymentID:  payment.ID,
+		Amount:     amount.String(),
+		Reason:     sql.NullString{String: reason, Valid: reason != ""},
+		Method:     db.PaymentRefundsMethodLEDGER,
+		Status:     db.PaymentRefundsStatusSUCCEEDED,
+		CreatedBy:  actor.ID,
+	}
+	if settled {
+		to, baseUnits, err := s.returnTarget(ctx, q, &payment, amount, access)
+		if err != nil {
+			return nil, err
+		}
+		params.Method = db.PaymentRefundsMethodONCHAIN
+		params.Status = db.PaymentRefundsStatusPENDING
+		params.ToAddress = sql.NullString{String: to, Valid: true}
+		res.baseUnits = baseUnits
+	} else {
+		params.CompletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	}
+
+	// 4. Record refund
+	result, err := q.CreatePaymentRefund(ctx, params)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to create refund", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, errors.DBError(err)
+	}
+	refund, err := q.GetPaymentRefundByID(ctx, uint64(id))
+	if err != nil {
+		return nil, errors.DBError(err)
+	}
+	res.refund = &refund
+	if hook != nil {
+		if err := hook(ctx, q, &refund); err != nil {
+			return nil, err
+		}
+	}
+	if settled {
+		return res, nil
+	}
+
+	// 5. LEDGER: seller account → buyer account
+	sellerAccount, err := q.GetAccountByOwnerID(ctx, sql.NullInt64{Int64: int64(order.SellerID), Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Seller account")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get seller account", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if _, err := ledger.Post(ctx, q, ledger.Posting{
+		ReferenceType: ledger.ReferencePaymentRefund,
+		ReferenceID:   refund.ID,
+		Description:   "Refund of payment " + detail.ExternalID.String,
+		Legs: []ledger.Leg{
+			{AccountID: sellerAccount.ID, EntryType: db.LedgerEntriesEntryTypeDEBIT, Amount: amount},
+			{AccountID: payment.PayerAccountID, EntryType: db.LedgerEntriesEntryTypeCREDIT, Amount: amount},
+		},
+	}); err != nil {
+		var insufficient *ledger.InsufficientBalanceError
+		if stderrors.As(err, &insufficient) {
+			return nil, errors.InsufficientBalance(insufficient.Available.String(), insufficient.Requested.String())
+		}
+		if stderrors.Is(err, ledger.ErrAccountFrozen) {
+			return nil, errors.AccountFrozen(sellerAccount.ExternalID.String)
+		}
+		logctx.From(ctx, s.logger).Error("failed to post refund ledger entries", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	if err := s.applyRefund(ctx, q, &payment, &order, res, actor); err != nil {
+		logctx.From(ctx, s.logger).Error("failed to apply refund", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return res, nil
+}
+
+// returnTarget resolves the buyer's primary wallet and the transfer amount of an ON_CHAIN refund
+func (s *Service) returnTarget(ctx context.Context, q *db.Queries, payment *db.Payment, amount money.Money, access RefundAccess) (string, *big.Int, error) {
+	if !access.Admin {
+		return "", nil, errors.Forbidden("Payment has already been paid out to the seller - an admin must refund it")
+	}
+	if s.chainClient == nil {
+		return "", nil, errors.ChainError("On-chain refunds are not enabled")
+	}
+	if !strings.EqualFold(payment.TokenSymbol, s.config.SettlementToken) {
+		return "", nil, errors.InvalidInput("On-chain refunds are only available in " + s.config.SettlementToken)
+	}
+
+	// 토큰 최소 단위보다 작은 금액은 송금 불가 (예: USDC 6 decimals)
+	decimals := s.chainClient.TokenDecimals()
+	if amount.Rescale(decimals, money.RoundDown).Cmp(amount) != 0 {
+		return "", nil, errors.InvalidInput("amount has more decimals than the settlement token supports")
+	}
+	baseUnits := amount.Rescale(decimals, money.RoundDown).Units()
+
+	payer, err := q.GetAccountForUpdate(ctx, payment.PayerAccountID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to get payer account", zap.Error(err))
+		return "", nil, errors.DBError(err)
+	}
+	if !payer.PrimaryWalletID.Valid {
+		return "", nil, errors.InvalidInput("Buyer has no primary wallet to receive the refund")
+	}
+	wallet, err := q.GetWalletByID(ctx, uint64(payer.PrimaryWalletID.Int64))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil, errors.InvalidInput("Buyer has no primary wallet to receive the refund")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get buyer wallet", zap.Error(err))
+		return "", nil, errors.DBError(err)
+	}
+	// 송금은 RPC가 연결된 체인에서만 가능 (다른 체인 주소로 보내면 자금 유실)
+	if int64(wallet.ChainID) != s.chainClient.ChainID() {
+		return "", nil, errors.InvalidInput("Buyer's primary wallet is not on the settlement chain")
+	}
+	return wallet.Address, baseUnits, nil
+}
+
+// applyRefund reverses the fee, completes the payment and order on the last refund, and records
+// the audit log and refund events (must be called within a transaction, payment and order locked)
+func (s *Service) applyRefund(ctx context.Context, q *db.Queries, payment *db.Payment, order *db.Order, res *refundReservation, actor audit.Actor) error {
+	paymentAmount, err := money.Parse(payment.Amount, "", amountScale)
+	if err != nil {
+		return err
+	}
+	stored, err := q.GetPaymentRefundedAmount(ctx, payment.ID)
+	if err != nil {
+		return err
+	}
+	refunded, err := money.Parse(stored, "", amountScale)
+	if err != nil {
+		return err
+	}
+	final := refunded.Cmp(paymentAmount) >= 0
+
+	// 1. Fee reversal (마지막 환불은 잔여 수수료 전부 취소)
+	feeReversed, err := s.feeService.Reverse(ctx, q, &fee.Payment{
+		ID:          payment.ID,
+		SellerID:    order.SellerID,
+		Amount:      paymentAmount,
+		TokenSymbol: payment.TokenSymbol,
+		CapturedAt:  payment.CapturedAt.Time,
+	}, res.refund.ID, res.amount, final)
+	if err != nil {
+		return err
+	}
+
+	// 2. Full refund → payment REFUNDED, unfulfilled order REFUNDED
+	if final {
+		if err := q.MarkPaymentRefunded(ctx, payment.ID); err != nil {
+			return err
+		}
+		if _, err := q.RefundPaidOrder(ctx, order.ID); err != nil {
+			return err
+		}
+	}
+
+	// 3. Audit (same transaction)
+	if err := audit.Record(ctx, q, actor, audit.Entry{
+		Action:       actionRefunded,
+		ResourceType: resourcePayment,
+		ResourceID:   payment.ID,
+		OldValue:     map[string]any{"status": string(payment.Status)},
+		NewValue: map[string]any{
+			"refund_id":    res.refund.ExternalID,
+			"amount":       res.amount.String(),
+			"method":       string(res.refund.Method),
+			"status":       string(res.refund.Status),
+			"fee_reversed": feeReversed.String(),
+			"full_refund":  final,
+		},
+	}); err != nil {
+		return err
+	}
+
+	// 4. Events for buyer and seller (outbox 이벤트는 수신자 1명 단위)
+	data := map[string]any{
+		"payment_id":        payment.ExternalID.String,
+		"order_number":      order.OrderNumber,
+		"refund_id":         res.refund.ExternalID,
+		"amount":            res.amount.String(),
+		"token_symbol":      payment.TokenSymbol,
+		"method":            string(res.refund.Method),
+		"status":            string(res.refund.Status),
+		"refunded_amount":   refunded.String(),
+		"refundable_amount": paymentAmount.Sub(refunded).NonNegative().String(),
+		"full_refund":       final,
+	}
+	if res.refund.TxHash.Valid {
+		data["tx_hash"] = res.refund.TxHash.String
+	}
+	for _, recipient := range []uint64{order.BuyerID, order.SellerID} {
+		if _, err := outbox.Write(ctx, q, outbox.Message{
+			EventType:           webhook.EventPaymentRefunded,
+			AggregateType:       outbox.AggregatePayment,
+			AggregateID:         payment.ID,
+			AggregateExternalID: payment.ExternalID.String,
+			RecipientUserID:     recipient,
+			Data:                data,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package payment
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/fee"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"go.uber.org/zap"
+)
+
+// amountScale is the scale of payment amounts (DECIMAL(18,8))
+const amountScale = 8
+
+// Config holds payment service settings
+type Config struct {
+	// SettlementToken is the token the platform signer sends (ON_CHAIN refunds are only possible in it)
+	SettlementToken string
+}
+
+// Service handles payment queries and refunds
+type Service struct {
+	txRunner    *pkgdb.TxRunner
+	feeService  *fee.Service
+	chainClient chain.Client
+	config      Config
+	logger      *zap.Logger
+}
+
+// NewService creates a new payment service.
+// chainClient may be nil when no chain is configured (ON_CHAIN refunds disabled)
+func NewService(txRunner *pkgdb.TxRunner, feeService *fee.Service, chainClient chain.Client, config Config, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner:    txRunner,
+		feeService:  feeService,
+		chainClient: chainClient,
+		config:      config,
+		logger:      logger,
+	}
+}
+
+// GetPayment returns a payment with its refunds total and fee breakdown, and when the response last changed
+// (the latest of the payment, its refunds and its fee charge - the validator of conditional GETs).
+// canView decides access from the buyer and seller external IDs (NOT_FOUND when denied, not FORBIDDEN:
+// 다른 가맹점 결제 ID의 존재 여부를 노출하지 않음)
+//
+// Why:
+//   - 부분 환불/수수료 부과는 payments.updated_at을 바꾸지 않음 → 결제 updated_at만으로는 304가 오래된 금액을 유지
+//   - 수수료 취소(REVERSAL)는 환불과 같은 트랜잭션에서 기록 → 환불 updated_at이 포함
+func (s *Service) GetPayment(ctx context.Context, externalID string, canView func(buyerID, sellerID string) bool) (*PaymentResponse, time.Time, error) {
+	p, err := s.getPaymentDetail(ctx, externalID, canView)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	amount, err := s.parseStoredAmount(ctx, p.ID, p.Amount)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	refunded, err := s.refundedAmount(ctx, s.txRunner.Queries(), p.ID)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	breakdown, err := s.feeService.PaymentBreakdown(ctx, p.ID)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	// Validator: latest change of the payment, its refunds and its fee charge
+	lastModified := p.UpdatedAt
+	refundUpdatedAt, err := s.txRunner.Queries().GetLatestPaymentRefundUpdatedAt(ctx, p.ID)
+	if err != nil && err != sql.ErrNoRows {
+		logctx.From(ctx, s.logger).Error("failed to get latest refund change", zap.Error(err))
+		return nil, time.Time{}, errors.DBError(err)
+	}
+	if refundUpdatedAt.After(lastModified) {
+		lastModified = refundUpdatedAt
+	}
+	if breakdown != nil && breakdown.AssessedAt.After(lastModified) {
+		lastModified = breakdown.AssessedAt
+	}
+
+	return ToPaymentResponse(p, amount, refunded, breakdown), lastModified, nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// getPaymentDetail returns the payment by external ID if canView allows the caller to see it
+func (s *Service) getPaymentDetail(ctx context.Context, externalID string, canView func(buyerID, sellerID string) bool) (*db.GetPaymentDetailByExternalIDRow, error) {
+	p, err := s.txRunner.Queries().GetPaymentDetailByExternalID(ctx, sql.NullString{String: externalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Payment")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get payment", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if !canView(p.BuyerExternalID.String, p.SellerExternalID.String) {
+		return nil, errors.NotFound("Payment")
+	}
+	return &p, nil
+}
+
+// refundedAmount returns the total of a payment's refunds, failed ones excluded
+func (s *Service) refundedAmount(ctx context.Context, q *db.Queries, paymentID uint64) (money.Money, error) {
+	stored, err := q.GetPaymentRefundedAmount(ctx, paymentID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to get refunded amount", zap.Error(err))
+		return money.Money{}, errors.DBError(err)
+	}
+	return s.parseStoredAmount(ctx, paymentID, stored)
+}
+
+// parseStoredAmount parses a DECIMAL(18,8) column of a payment
+func (s *Service) parseStoredAmount(ctx context.Context, paymentID uint64, value string) (money.Money, error) {
+	amount, err := money.Parse(value, "", amountScale)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("invalid stored amount",
+			zap.Uint64("payment_id", paymentID),
+			zap.String("amount", value),
+			zap.Error(err),
+		)
+		return money.Money{}, errors.Internal("Invalid stored amount")
+	}
+	return amount, nil
+}
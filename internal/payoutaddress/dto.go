@@ -0,0 +1,146 @@
+package payoutaddress
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/eip712"
+)
+
+// Payout address statuses (derived from confirmed_at / active_after / revoked_at)
+const (
+	StatusPendingConfirmation = "PENDING_CONFIRMATION"
+	StatusPendingActivation   = "PENDING_ACTIVATION"
+	StatusActive              = "ACTIVE"
+	StatusRevoked             = "REVOKED"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// AddPayoutAddressRequest represents the request body for whitelisting a payout address
+type AddPayoutAddressRequest struct {
+	Address string `json:"address" binding:"required,len=42" example:"0x742d35Cc6634C0532925a3b844Bc454e4438f44e"`
+	Label   string `json:"label,omitempty" binding:"omitempty,max=50" example:"Treasury"`
+	// ChainID of the network payouts are sent on (omitted = default chain)
+	ChainID int64 `json:"chain_id,omitempty" binding:"omitempty,gt=0" example:"137"`
+}
+
+// ConfirmPayoutAddressRequest represents the approval signature of the user's primary wallet
+type ConfirmPayoutAddressRequest struct {
+	// Signature over the PayoutAddressApproval typed data (0x + 130 or 128 (EIP-2098) hex chars for EOAs, longer for contract wallets)
+	Signature string `json:"signature" binding:"required,min=130,max=4098" example:"0x1234...abcd"`
+	Nonce     string `json:"nonce" binding:"required,min=8,max=64" example:"9f86d081884c7d659a2feaa0c55ad015"`
+	Timestamp int64  `json:"timestamp" binding:"required,gt=0" example:"1706000000"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// PayoutAddressResponse represents a whitelisted payout address in API responses
+type PayoutAddressResponse struct {
+	ID      string `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Address string `json:"address" example:"0x742d35cc6634c0532925a3b844bc454e4438f44e"`
+	ChainID int64  `json:"chain_id" example:"1"`
+	Label   string `json:"label,omitempty" example:"Treasury"`
+	// Status: PENDING_CONFIRMATION → PENDING_ACTIVATION (until active_after) → ACTIVE, or REVOKED
+	Status      string     `json:"status" example:"PENDING_ACTIVATION"`
+	ConfirmedAt *time.Time `json:"confirmed_at,omitempty"`
+	ActiveAfter *time.Time `json:"active_after,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// ListPayoutAddressesResponse represents the whitelist of a user (revoked addresses excluded)
+type ListPayoutAddressesResponse struct {
+	PayoutAddresses []PayoutAddressResponse `json:"payout_addresses"`
+}
+
+// ApprovalChallengeResponse represents the EIP-712 approval the user's primary wallet must sign.
+// Sign typed_data as-is (eth_signTypedData_v4) and submit nonce/timestamp with the signature to /confirm.
+type ApprovalChallengeResponse struct {
+	// Approver is the primary wallet that must sign
+	Approver  string    `json:"approver" example:"0x8ba1f109551bd432803012645ac136ddd64dba72"`
+	Nonce     string    `json:"nonce" example:"9f86d081884c7d659a2feaa0c55ad015"`
+	Timestamp int64     `json:"timestamp" example:"1706000000"`
+	ChainID   int64     `json:"chain_id" example:"1"`
+	ExpiresAt time.Time `json:"expires_at"`
+	TypedData any       `json:"typed_data" swaggertype:"object"`
+}
+
+// AddPayoutAddressResponse represents a newly whitelisted address and its approval challenge
+type AddPayoutAddressResponse struct {
+	PayoutAddress *PayoutAddressResponse     `json:"payout_address"`
+	Approval      *ApprovalChallengeResponse `json:"approval"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// Status derives the payout address status at now
+func Status(address *db.PayoutAddress, now time.Time) string {
+	switch {
+	case address.RevokedAt.Valid:
+		return StatusRevoked
+	case !address.ConfirmedAt.Valid:
+		return StatusPendingConfirmation
+	case !address.ActiveAfter.Valid || now.Before(address.ActiveAfter.Time):
+		return StatusPendingActivation
+	default:
+		return StatusActive
+	}
+}
+
+// ToPayoutAddressResponse converts db.PayoutAddress to PayoutAddressResponse
+func ToPayoutAddressResponse(address *db.PayoutAddress, now time.Time) *PayoutAddressResponse {
+	if address == nil {
+		return nil
+	}
+
+	response := &PayoutAddressResponse{
+		ID:        address.ExternalID,
+		Address:   address.Address,
+		ChainID:   int64(address.ChainID),
+		Label:     address.Label.String,
+		Status:    Status(address, now),
+		CreatedAt: address.CreatedAt,
+	}
+	if address.ConfirmedAt.Valid {
+		response.ConfirmedAt = &address.ConfirmedAt.Time
+	}
+	if address.ActiveAfter.Valid {
+		response.ActiveAfter = &address.ActiveAfter.Time
+	}
+	if address.RevokedAt.Valid {
+		response.RevokedAt = &address.RevokedAt.Time
+	}
+	return response
+}
+
+// ToPayoutAddressResponseList converts []db.PayoutAddress to []PayoutAddressResponse
+func ToPayoutAddressResponseList(addresses []db.PayoutAddress, now time.Time) []PayoutAddressResponse {
+	responses := make([]PayoutAddressResponse, 0, len(addresses))
+	for i := range addresses {
+		responses = append(responses, *ToPayoutAddressResponse(&addresses[i], now))
+	}
+	return responses
+}
+
+// ToApprovalChallengeResponse converts eip712.PayoutApprovalChallenge to ApprovalChallengeResponse
+func ToApprovalChallengeResponse(challenge *eip712.PayoutApprovalChallenge) *ApprovalChallengeResponse {
+	if challenge == nil {
+		return nil
+	}
+
+	return &ApprovalChallengeResponse{
+		Approver:  challenge.Message.Approver,
+		Nonce:     challenge.Message.Nonce,
+		Timestamp: challenge.Message.Timestamp,
+		ChainID:   challenge.Message.ChainID,
+		ExpiresAt: challenge.ExpiresAt,
+		TypedData: challenge.TypedData,
+	}
+}
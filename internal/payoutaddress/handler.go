@@ -0,0 +1,243 @@
+package payoutaddress
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for payout address whitelist operations
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new payout address handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers payout address routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	// Only the account owner (or an admin) can manage where payouts go
+	addresses := rg.Group("/users/:id/payout-addresses", middleware.RequireAuth())
+	{
+		addresses.POST("", h.AddAddress)
+		addresses.GET("", h.ListAddresses)
+		addresses.GET("/:addressId/approval-challenge", h.GetApprovalChallenge)
+		addresses.POST("/:addressId/confirm", h.ConfirmAddress)
+		addresses.DELETE("/:addressId", h.RevokeAddress)
+	}
+}
+
+// extractUserID extracts the user id from path and checks the caller may act as the user
+func extractUserID(c *gin.Context) (string, error) {
+	userID := c.Param("id")
+	if _, err := uuid.Parse(userID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+
+	principal, _ := middleware.GetPrincipal(c)
+	if !principal.CanActAs(userID) {
+		return "", errors.Forbidden("Cannot manage payout addresses of another user")
+	}
+	return userID, nil
+}
+
+// extractAddressID extracts and validates addressId from path
+func extractAddressID(c *gin.Context) (string, error) {
+	addressID := c.Param("addressId")
+	if _, err := uuid.Parse(addressID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+	return addressID, nil
+}
+
+// AddAddress godoc
+// @Summary Add a payout address
+// @Description Whitelist a withdrawal address for payouts. The address starts as PENDING_CONFIRMATION:
+// @Description the user's verified primary wallet must sign the returned EIP-712 PayoutAddressApproval (see /confirm).
+// @Description After confirmation the address stays PENDING_ACTIVATION for the activation delay (default 24h)
+// @Description before payouts can target it. The address is screened against sanctions lists.
+// @Description Requires a verified phone number (see /users/{id}/phone/otp) that did not replace another verified number within the cooldown.
+// @Tags payout-addresses
+// @Accept json
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param request body AddPayoutAddressRequest true "Payout address"
+// @Success 201 {object} middleware.SuccessResponse{data=AddPayoutAddressResponse} "Payout address added"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid request or no verified primary wallet"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden, phone number not verified or recently changed, or compliance blocked"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 409 {object} middleware.ErrorResponse "Payout address already whitelisted"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/payout-addresses [post]
+func (h *Handler) AddAddress(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req AddPayoutAddressRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	payoutAddress, challenge, err := h.service.AddAddress(c.Request.Context(), userExternalID, &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, AddPayoutAddressResponse{
+		PayoutAddress: ToPayoutAddressResponse(payoutAddress, time.Now()),
+		Approval:      ToApprovalChallengeResponse(challenge),
+	})
+}
+
+// ListAddresses godoc
+// @Summary List payout addresses
+// @Description List the whitelisted payout addresses of the user (revoked addresses excluded), newest first
+// @Tags payout-addresses
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=ListPayoutAddressesResponse} "Payout addresses"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid user ID"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/payout-addresses [get]
+func (h *Handler) ListAddresses(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	addresses, err := h.service.ListAddresses(c.Request.Context(), userExternalID)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, ListPayoutAddressesResponse{
+		PayoutAddresses: ToPayoutAddressResponseList(addresses, time.Now()),
+	})
+}
+
+// GetApprovalChallenge godoc
+// @Summary Get payout address approval challenge
+// @Description Issue a new EIP-712 PayoutAddressApproval for an address awaiting confirmation.
+// @Description The nonce is single-use and expires with the signature timestamp tolerance.
+// @Tags payout-addresses
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param addressId path string true "Payout address external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=ApprovalChallengeResponse} "Approval challenge"
+// @Failure 400 {object} middleware.ErrorResponse "Address already confirmed or revoked, or no verified primary wallet"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "Payout address not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/payout-addresses/{addressId}/approval-challenge [get]
+func (h *Handler) GetApprovalChallenge(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	addressExternalID, err := extractAddressID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	challenge, err := h.service.GetApprovalChallenge(c.Request.Context(), userExternalID, addressExternalID)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, ToApprovalChallengeResponse(challenge))
+}
+
+// ConfirmAddress godoc
+// @Summary Confirm a payout address
+// @Description Submit the primary wallet's signature over the PayoutAddressApproval. On success the address becomes
+// @Description PENDING_ACTIVATION until active_after. Confirming an already confirmed address succeeds without changes.
+// @Description Requires a verified phone number that did not replace another verified number within the cooldown.
+// @Tags payout-addresses
+// @Accept json
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param addressId path string true "Payout address external ID (UUID)"
+// @Param request body ConfirmPayoutAddressRequest true "Approval signature"
+// @Success 200 {object} middleware.SuccessResponse{data=PayoutAddressResponse} "Payout address confirmed"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid request or approval failed"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden, or phone number not verified or recently changed"
+// @Failure 404 {object} middleware.ErrorResponse "Payout address not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/payout-addresses/{addressId}/confirm [post]
+func (h *Handler) ConfirmAddress(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	addressExternalID, err := extractAddressID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req ConfirmPayoutAddressRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	payoutAddress, err := h.service.ConfirmAddress(c.Request.Context(), userExternalID, addressExternalID, &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, ToPayoutAddressResponse(payoutAddress, time.Now()))
+}
+
+// RevokeAddress godoc
+// @Summary Revoke a payout address
+// @Description Remove a payout address from the whitelist immediately. Revoking an already revoked address succeeds.
+// @Tags payout-addresses
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param addressId path string true "Payout address external ID (UUID)"
+// @Success 204 "Payout address revoked"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid ID"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "Payout address not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/payout-addresses/{addressId} [delete]
+func (h *Handler) RevokeAddress(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	addressExternalID, err := extractAddressID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	if err := h.service.RevokeAddress(c.Request.Context(), userExternalID, addressExternalID, audit.ActorFromContext(c)); err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondNoContent(c)
+}
@@ -0,0 +1,535 @@
+package payoutaddress
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	stderrors "errors"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/otp"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/outbox"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/wallet"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/webhook"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/eip712"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/screening"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	mysqlErrDuplicateEntry = 1062
+
+	// maxSignatureHexLength bounds contract wallet (ERC-1271) signatures to 2048 bytes
+	maxSignatureHexLength = 4096
+)
+
+// Audit log identifiers
+const (
+	actionAdded            = "PAYOUT_ADDRESS_ADDED"
+	actionConfirmed        = "PAYOUT_ADDRESS_CONFIRMED"
+	actionRevoked          = "PAYOUT_ADDRESS_REVOKED"
+	actionScreeningBlocked = "PAYOUT_ADDRESS_SCREENING_BLOCKED"
+	resourcePayoutAddress  = "PAYOUT_ADDRESS"
+	resourceUser           = "USER"
+)
+
+// Config holds the payout address whitelist policy
+type Config struct {
+	// ActivationDelay is how long a confirmed address waits before payouts can target it
+	ActivationDelay time.Duration
+	// PhoneChangeCooldown blocks adding and confirming addresses after the verified phone number is replaced
+	PhoneChangeCooldown time.Duration
+}
+
+// Service handles payout address whitelist business logic
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	verifier eip712.Verifier
+	networks *chain.Registry
+	screener screening.Screener
+	config   Config
+	logger   *zap.Logger
+}
+
+// NewService creates a new payout address service
+// networks lists the chains payouts may be sent on
+// screener screens addresses before they are whitelisted
+func NewService(txRunner *pkgdb.TxRunner, verifier eip712.Verifier, networks *chain.Registry, screener screening.Screener, config Config, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		verifier: verifier,
+		networks: networks,
+		screener: screener,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// AddAddress whitelists a new payout address (PENDING_CONFIRMATION) and issues the
+// approval the user's verified primary wallet must sign.
+// Why: 새 주소의 소유 증명은 계정 탈취자도 할 수 있음 → 기존 Primary 지갑의 승인 서명 + 활성화 대기
+func (s *Service) AddAddress(ctx context.Context, userExternalID string, req *AddPayoutAddressRequest, actor audit.Actor) (*db.PayoutAddress, *eip712.PayoutApprovalChallenge, error) {
+	// 1. Validate chain and address
+	chainID, err := s.networks.Resolve(req.ChainID)
+	if err != nil {
+		return nil, nil, errors.InvalidInput("Unsupported chain_id")
+	}
+	if err := wallet.ValidateEthereumAddress(req.Address); err != nil {
+		return nil, nil, err
+	}
+	address := strings.ToLower(req.Address)
+
+	// 2. Get user (verified phone, not recently changed) and the approver (verified primary wallet)
+	user, err := s.getUser(ctx, userExternalID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.requirePhone(user); err != nil {
+		return nil, nil, err
+	}
+	approver, err := s.getApprover(ctx, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 3. Sanctions screening
+	if err := s.screenAddress(ctx, actor, user.ID, chainID, address); err != nil {
+		return nil, nil, err
+	}
+
+	// 4. Create payout address (UNIQUE(user_id, chain_id, address_active) 충돌 시 409로 처리)
+	label := sql.NullString{}
+	if req.Label != "" {
+		label = sql.NullString{String: req.Label, Valid: true}
+	}
+
+	created, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*db.PayoutAddress, error) {
+		result, err := q.CreatePayoutAddress(ctx, db.CreatePayoutAddressParams{
+			ExternalID: uuid.New().String(),
+			UserID:     user.ID,
+			Address:    address,
+			ChainID:    uint64(chainID),
+			Label:      label,
+		})
+		if err != nil {
+			return nil, err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return nil, err
+		}
+		created, err := q.GetPayoutAddressByID(ctx, uint64(id))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionAdded,
+			ResourceType: resourcePayoutAddress,
+			ResourceID:   created.ID,
+			NewValue: map[string]any{
+				"address":  created.Address,
+				"chain_id": created.ChainID,
+				"label":    created.Label.String,
+			},
+		}); err != nil {
+			return nil, err
+		}
+		return &created, nil
+	})
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, nil, errors.Conflict("Payout address already whitelisted")
+		}
+		logctx.From(ctx, s.logger).Error("failed to create payout address", zap.Error(err))
+		return nil, nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("payout address added",
+		zap.String("payout_address_external_id", created.ExternalID),
+		zap.String("address", created.Address),
+		zap.Uint64("chain_id", created.ChainID),
+	)
+
+	// 5. Issue approval (실패해도 approval-challenge로 재발급 가능)
+	challenge, err := s.issueApproval(ctx, approver, created)
+	if err != nil {
+		return nil, nil, err
+	}
+	return created, challenge, nil
+}
+
+// GetApprovalChallenge issues a new approval for a payout address awaiting confirmation.
+// The nonce is pre-reserved for the approver, so ConfirmAddress accepts it exactly once.
+func (s *Service) GetApprovalChallenge(ctx context.Context, userExternalID, addressExternalID string) (*eip712.PayoutApprovalChallenge, error) {
+	payoutAddress, err := s.GetAddress(ctx, userExternalID, addressExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if err := requirePendingConfirmation(payoutAddress); err != nil {
+		return nil, err
+	}
+
+	approver, err := s.getApprover(ctx, payoutAddress.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return s.issueApproval(ctx, approver, payoutAddress)
+}
+
+// ConfirmAddress verifies the primary wallet's approval signature and starts the activation delay.
+// Confirming an already confirmed address succeeds without changes.
+func (s *Service) ConfirmAddress(ctx context.Context, userExternalID, addressExternalID string, req *ConfirmPayoutAddressRequest, actor audit.Actor) (*db.PayoutAddress, error) {
+	// 1. Parse signature
+	signature, err := parseSignature(req.Signature)
+	if err != nil {
+		return nil, errors.InvalidInput("Invalid signature format")
+	}
+
+	// 2. Get payout address with ownership check
+	payoutAddress, err := s.GetAddress(ctx, userExternalID, addressExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Already confirmed - idempotent success
+	if payoutAddress.ConfirmedAt.Valid && !payoutAddress.RevokedAt.Valid {
+		return payoutAddress, nil
+	}
+	if err := requirePendingConfirmation(payoutAddress); err != nil {
+		return nil, err
+	}
+
+	// NOTE: 추가 이후 번호 변경으로 인증이 해제되었거나 쿨다운 중인 경우도 확정 불가 (철회는 탈취 대응용이라 제한 없음)
+	user, err := s.getUser(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requirePhone(user); err != nil {
+		return nil, err
+	}
+
+	// 4. Verify approval signature of the current primary wallet (includes nonce + timestamp validation)
+	approver, err := s.getApprover(ctx, payoutAddress.UserID)
+	if err != nil {
+		return nil, err
+	}
+	approval := eip712.PayoutAddressApproval{
+		Approver:      approver.Address,
+		PayoutAddress: payoutAddress.Address,
+		PayoutChainID: int64(payoutAddress.ChainID),
+		Nonce:         req.Nonce,
+		Timestamp:     req.Timestamp,
+		ChainID:       int64(approver.ChainID),
+	}
+	if err := s.verifier.VerifyPayoutApproval(ctx, approval, signature); err != nil {
+		logctx.From(ctx, s.logger).Warn("payout address approval failed",
+			zap.String("payout_address_external_id", addressExternalID),
+			zap.String("approver", approver.Address),
+			zap.Error(err),
+		)
+		// 외부 메시지는 고정, 상세는 로그로만
+		return nil, errors.InvalidInput("Payout address approval failed")
+	}
+
+	// 5. Confirm + start activation delay
+	now := time.Now()
+	activeAfter := now.Add(s.config.ActivationDelay)
+
+	confirmed, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*db.PayoutAddress, error) {
+		affected, err := q.ConfirmPayoutAddress(ctx, db.ConfirmPayoutAddressParams{
+			ApproverWalletID: sql.NullInt64{Int64: int64(approver.ID), Valid: true},
+			ConfirmedAt:      sql.NullTime{Time: now, Valid: true},
+			ActiveAfter:      sql.NullTime{Time: activeAfter, Valid: true},
+			ID:               payoutAddress.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		confirmed, err := q.GetPayoutAddressByID(ctx, payoutAddress.ID)
+		if err != nil {
+			return nil, err
+		}
+		// Confirmed or revoked concurrently - nothing to record
+		if affected == 0 {
+			return &confirmed, nil
+		}
+
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionConfirmed,
+			ResourceType: resourcePayoutAddress,
+			ResourceID:   confirmed.ID,
+			NewValue: map[string]any{
+				"approver_wallet_id": approver.ExternalID,
+				"active_after":       activeAfter,
+			},
+		}); err != nil {
+			return nil, err
+		}
+		if err := writePayoutAddressEvent(ctx, q, webhook.EventPayoutAddressConfirmed, &confirmed); err != nil {
+			return nil, err
+		}
+		return &confirmed, nil
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to confirm payout address", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("payout address confirmed",
+		zap.String("payout_address_external_id", confirmed.ExternalID),
+		zap.Time("active_after", activeAfter),
+	)
+	return confirmed, nil
+}
+
+// GetAddress retrieves a payout address with ownership verification
+func (s *Service) GetAddress(ctx context.Context, userExternalID, addressExternalID string) (*db.PayoutAddress, error) {
+	payoutAddress, err := s.txRunner.Queries().GetPayoutAddressByExternalIDAndUser(ctx, db.GetPayoutAddressByExternalIDAndUserParams{
+		ExternalID:   addressExternalID,
+		ExternalID_2: sql.NullString{String: userExternalID, Valid: true},
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Payout address")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get payout address", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &payoutAddress, nil
+}
+
+// ListAddresses lists the whitelisted payout addresses of a user (revoked addresses excluded)
+func (s *Service) ListAddresses(ctx context.Context, userExternalID string) ([]db.PayoutAddress, error) {
+	user, err := s.getUser(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses, err := s.txRunner.Queries().ListPayoutAddressesByUser(ctx, user.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list payout addresses", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return addresses, nil
+}
+
+// RevokeAddress removes a payout address from the whitelist immediately (no delay).
+// Revoking an already revoked address succeeds without changes.
+func (s *Service) RevokeAddress(ctx context.Context, userExternalID, addressExternalID string, actor audit.Actor) error {
+	payoutAddress, err := s.GetAddress(ctx, userExternalID, addressExternalID)
+	if err != nil {
+		return err
+	}
+	if payoutAddress.RevokedAt.Valid {
+		return nil
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		affected, err := q.RevokePayoutAddress(ctx, payoutAddress.ID)
+		if err != nil {
+			return err
+		}
+		// Revoked concurrently
+		if affected == 0 {
+			return nil
+		}
+
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionRevoked,
+			ResourceType: resourcePayoutAddress,
+			ResourceID:   payoutAddress.ID,
+			OldValue:     map[string]any{"status": Status(payoutAddress, time.Now())},
+		}); err != nil {
+			return err
+		}
+		return writePayoutAddressEvent(ctx, q, webhook.EventPayoutAddressRevoked, payoutAddress)
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to revoke payout address", zap.Error(err))
+		return errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("payout address revoked",
+		zap.String("payout_address_external_id", addressExternalID),
+	)
+	return nil
+}
+
+// CanPayoutTo reports whether payouts of the user may target the address on the chain:
+// it must be whitelisted, confirmed and past its activation delay.
+// Payout executors must check it before sending funds.
+func CanPayoutTo(ctx context.Context, q *db.Queries, userID uint64, chainID int64, address string, now time.Time) (bool, error) {
+	return q.ExistsActivePayoutAddress(ctx, db.ExistsActivePayoutAddressParams{
+		UserID:  userID,
+		ChainID: uint64(chainID),
+		Address: strings.ToLower(address),
+		Now:     sql.NullTime{Time: now, Valid: true},
+	})
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// getUser retrieves a user by external ID
+func (s *Service) getUser(ctx context.Context, userExternalID string) (*db.User, error) {
+	user, err := s.txRunner.Queries().GetUserByExternalID(ctx, sql.NullString{String: userExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("User")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &user, nil
+}
+
+// requirePhone requires a verified phone number that did not recently replace another one
+func (s *Service) requirePhone(user *db.User) error {
+	if err := otp.RequireVerifiedPhone(user); err != nil {
+		return err
+	}
+	return otp.RequirePhoneChangeCooldown(user, s.config.PhoneChangeCooldown, time.Now())
+}
+
+// getApprover returns the user's primary wallet, which must be signature-verified
+// (watch-only wallets cannot sign approvals)
+func (s *Service) getApprover(ctx context.Context, userID uint64) (*db.Wallet, error) {
+	primary, err := s.txRunner.Queries().GetPrimaryWallet(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.InvalidInput("A verified primary wallet is required to approve payout addresses")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get primary wallet", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if primary.IsWatchOnly || !wallet.MeetsVerificationLevel(&primary, db.WalletsVerificationLevelSIGNATURE) {
+		return nil, errors.InvalidInput("A verified primary wallet is required to approve payout addresses")
+	}
+	return &primary, nil
+}
+
+// issueApproval issues the PayoutAddressApproval the approver must sign
+func (s *Service) issueApproval(ctx context.Context, approver *db.Wallet, payoutAddress *db.PayoutAddress) (*eip712.PayoutApprovalChallenge, error) {
+	challenge, err := s.verifier.IssuePayoutApproval(ctx, eip712.PayoutAddressApproval{
+		Approver:      approver.Address,
+		PayoutAddress: payoutAddress.Address,
+		PayoutChainID: int64(payoutAddress.ChainID),
+		ChainID:       int64(approver.ChainID),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to issue payout approval",
+			zap.String("payout_address_external_id", payoutAddress.ExternalID),
+			zap.Error(err),
+		)
+		return nil, errors.Internal("Failed to issue payout address approval")
+	}
+	return challenge, nil
+}
+
+// screenAddress rejects an address flagged by sanctions screening and audit-logs the block.
+// Screening failures fail closed.
+func (s *Service) screenAddress(ctx context.Context, actor audit.Actor, userID uint64, chainID int64, address string) error {
+	result, err := s.screener.Screen(ctx, chainID, address)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("sanctions screening failed",
+			zap.String("address", address),
+			zap.Int64("chain_id", chainID),
+			zap.Error(err),
+		)
+		return errors.Internal("Sanctions screening is unavailable")
+	}
+	if !result.Flagged {
+		return nil
+	}
+
+	// 거부된 요청이므로 별도 기록 (롤백될 변경 없음)
+	if err := audit.Record(ctx, s.txRunner.Queries(), actor, audit.Entry{
+		Action:       actionScreeningBlocked,
+		ResourceType: resourceUser,
+		ResourceID:   userID,
+		NewValue: map[string]any{
+			"address":  address,
+			"chain_id": chainID,
+			"category": result.Category,
+			"provider": result.Provider,
+		},
+	}); err != nil {
+		logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+		return errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Warn("payout address blocked by sanctions screening",
+		zap.String("address", address),
+		zap.Int64("chain_id", chainID),
+		zap.String("category", result.Category),
+	)
+	return errors.ComplianceBlocked(result.Category)
+}
+
+// requirePendingConfirmation rejects revoked or already confirmed addresses
+func requirePendingConfirmation(payoutAddress *db.PayoutAddress) error {
+	if payoutAddress.RevokedAt.Valid {
+		return errors.InvalidInput("Payout address has been revoked")
+	}
+	if payoutAddress.ConfirmedAt.Valid {
+		return errors.InvalidInput("Payout address already confirmed")
+	}
+	return nil
+}
+
+// writePayoutAddressEvent records a payout address event for the owner (must be called within a transaction)
+func writePayoutAddressEvent(ctx context.Context, q *db.Queries, eventType string, payoutAddress *db.PayoutAddress) error {
+	data := map[string]any{
+		"payout_address_id": payoutAddress.ExternalID,
+		"address":           payoutAddress.Address,
+		"chain_id":          payoutAddress.ChainID,
+	}
+	if payoutAddress.ActiveAfter.Valid {
+		data["active_after"] = payoutAddress.ActiveAfter.Time
+	}
+
+	_, err := outbox.Write(ctx, q, outbox.Message{
+		EventType:           eventType,
+		AggregateType:       outbox.AggregatePayoutAddress,
+		AggregateID:         payoutAddress.ID,
+		AggregateExternalID: payoutAddress.ExternalID,
+		RecipientUserID:     payoutAddress.UserID,
+		Data:                data,
+	})
+	return err
+}
+
+// parseSignature parses hex signature string to bytes
+func parseSignature(sig string) ([]byte, error) {
+	sig = strings.TrimPrefix(sig, "0x")
+
+	// At least 64 bytes (EIP-2098 compact EOA); contract wallet signatures may be longer
+	if len(sig) < 128 || len(sig) > maxSignatureHexLength || len(sig)%2 != 0 {
+		return nil, errors.InvalidInput("Signature must be 64 or 65 bytes, or a longer contract wallet signature")
+	}
+
+	return hex.DecodeString(sig)
+}
+
+// isDuplicateKeyError checks if the error is a MySQL duplicate key error
+func isDuplicateKeyError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var mysqlErr *mysql.MySQLError
+	if stderrors.As(err, &mysqlErr) {
+		return mysqlErr.Number == mysqlErrDuplicateEntry
+	}
+	return strings.Contains(err.Error(), "Duplicate entry")
+}
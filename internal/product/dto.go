@@ -0,0 +1,342 @@
+package product
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// Inventory adjustment types (inventory_logs.event_type)
+const (
+	AdjustmentInbound  = "INBOUND"
+	AdjustmentOutbound = "OUTBOUND"
+	AdjustmentAdjust   = "ADJUST"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// CreateVariantRequest represents the request body for adding a variant to a product.
+// At least one of size, grade and packaging is required; the combination is unique per product.
+type CreateVariantRequest struct {
+	SKU       string `json:"sku" binding:"required,max=50" example:"SKU-WIDGET-001-L-A-BOX10"`
+	Name      string `json:"name,omitempty" binding:"max=200" example:"Industrial widget (L / A / Box of 10)"` // omitted = product name with attributes
+	Size      string `json:"size,omitempty" binding:"max=50" example:"L"`
+	Grade     string `json:"grade,omitempty" binding:"max=50" example:"A"`
+	Packaging string `json:"packaging,omitempty" binding:"max=50" example:"Box of 10"`
+	Price     string `json:"price" binding:"required" example:"1250.00"`
+	Status    string `json:"status,omitempty" binding:"omitempty,oneof=ACTIVE INACTIVE" example:"ACTIVE"` // omitted = ACTIVE
+}
+
+// UpdateVariantRequest represents the request body for updating a variant (omitted fields are unchanged)
+type UpdateVariantRequest struct {
+	Name   string `json:"name,omitempty" binding:"max=200" example:"Industrial widget (L / A / Box of 10)"`
+	Price  string `json:"price,omitempty" example:"1190.00"`
+	Status string `json:"status,omitempty" binding:"omitempty,oneof=ACTIVE INACTIVE" example:"INACTIVE"`
+}
+
+// AdjustInventoryRequest represents the request body for adjusting the stock of a SKU at a location.
+// INBOUND adds and OUTBOUND removes quantity units; ADJUST applies a signed correction.
+// An OUTBOUND with order_number ships that order's reservation at the location (fulfillment).
+type AdjustInventoryRequest struct {
+	Location    string `json:"location,omitempty" binding:"max=50" example:"default"` // omitted = default
+	Type        string `json:"type" binding:"required,oneof=INBOUND OUTBOUND ADJUST" example:"INBOUND"`
+	Quantity    int64  `json:"quantity" binding:"required" example:"500"`
+	Reason      string `json:"reason" binding:"required,max=255" example:"Received PO-2026-0042"`
+	OrderNumber string `json:"order_number,omitempty" binding:"max=50" example:"ORD-20261003-0001"` // OUTBOUND only
+}
+
+// SetReorderThresholdRequest represents the request body for setting the reorder threshold of a SKU.
+// A null threshold turns low-stock alerts off.
+type SetReorderThresholdRequest struct {
+	ReorderThreshold *int64 `json:"reorder_threshold" binding:"omitempty,min=1" example:"100"`
+}
+
+// TransferInventoryRequest represents the request body for moving stock of a SKU between warehouses.
+// Only unreserved stock can be moved; the destination warehouse must be ACTIVE.
+type TransferInventoryRequest struct {
+	FromLocation string `json:"from_location" binding:"required,max=50" example:"default"`
+	ToLocation   string `json:"to_location" binding:"required,max=50" example:"fra-1"`
+	Quantity     int64  `json:"quantity" binding:"required,min=1" example:"200"`
+	Reason       string `json:"reason" binding:"required,max=255" example:"Rebalance stock for EU orders"`
+}
+
+// ListInventoryRequest represents query parameters for listing the stock of sellable SKUs
+type ListInventoryRequest struct {
+	LowStock bool `form:"low_stock"` // true = only SKUs whose available stock is below their reorder threshold
+	Page     int  `form:"page,default=1" binding:"min=1"`
+	PageSize int  `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ListStockMovementsRequest represents query parameters for listing the stock movements of a SKU
+type ListStockMovementsRequest struct {
+	Reason   string    `form:"reason" binding:"omitempty,oneof=RESERVATION RELEASE ADJUSTMENT FULFILLMENT RETURN TRANSFER_OUT TRANSFER_IN"`
+	Location string    `form:"location" binding:"max=50"`
+	From     time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To       time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	Page     int       `form:"page,default=1" binding:"min=1"`
+	PageSize int       `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ListProductsRequest represents query parameters for browsing the catalog.
+// category includes its subcategories unless include_subcategories is false.
+type ListProductsRequest struct {
+	Category             string `form:"category" binding:"max=50"`
+	IncludeSubcategories bool   `form:"include_subcategories,default=true"`
+	Status               string `form:"status" binding:"omitempty,oneof=ACTIVE INACTIVE"`
+	Page                 int    `form:"page,default=1" binding:"min=1"`
+	PageSize             int    `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// ProductResponse represents a catalog product (variants are listed in its variant matrix)
+type ProductResponse struct {
+	SKU          string      `json:"sku" example:"SKU-WIDGET-001"`
+	Name         string      `json:"name" example:"Industrial widget"`
+	Category     string      `json:"category,omitempty" example:"fasteners"`
+	Price        money.Money `json:"price" swaggertype:"string" example:"125.00"`
+	Status       string      `json:"status" example:"ACTIVE" enums:"ACTIVE,INACTIVE"`
+	VariantCount int64       `json:"variant_count" example:"6"` // > 0 = ordered by variant SKUs
+	CreatedAt    time.Time   `json:"created_at"`
+	UpdatedAt    time.Time   `json:"updated_at"`
+}
+
+// ListProductsResponse represents paginated product list
+type ListProductsResponse struct {
+	Products   []ProductResponse `json:"products"`
+	Total      int64             `json:"total" example:"120"`
+	Page       int               `json:"page" example:"1"`
+	PageSize   int               `json:"page_size" example:"20"`
+	TotalPages int               `json:"total_pages" example:"6"`
+}
+
+// StockResponse represents the stock of a SKU summed over its locations
+type StockResponse struct {
+	Quantity  int64 `json:"quantity" example:"500"`
+	Reserved  int64 `json:"reserved" example:"20"`
+	Available int64 `json:"available" example:"480"`
+	Locations int64 `json:"locations" example:"1"`
+}
+
+// VariantResponse represents a variant of a product
+type VariantResponse struct {
+	SKU       string         `json:"sku" example:"SKU-WIDGET-001-L-A-BOX10"`
+	Name      string         `json:"name" example:"Industrial widget (L / A / Box of 10)"`
+	Size      string         `json:"size,omitempty" example:"L"`
+	Grade     string         `json:"grade,omitempty" example:"A"`
+	Packaging string         `json:"packaging,omitempty" example:"Box of 10"`
+	Price     money.Money    `json:"price" swaggertype:"string" example:"1250.00"`
+	Status    string         `json:"status" example:"ACTIVE" enums:"ACTIVE,INACTIVE"`
+	Stock     *StockResponse `json:"stock,omitempty"` // omitted = stock not tracked
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// VariantAxesResponse lists the distinct attribute values of a product's variants
+type VariantAxesResponse struct {
+	Sizes      []string `json:"sizes"`
+	Grades     []string `json:"grades"`
+	Packagings []string `json:"packagings"`
+}
+
+// VariantMatrixResponse represents a product with its variants, ordered by size, grade and packaging
+type VariantMatrixResponse struct {
+	SKU      string              `json:"sku" example:"SKU-WIDGET-001"`
+	Name     string              `json:"name" example:"Industrial widget"`
+	Category string              `json:"category,omitempty" example:"hardware"`
+	Status   string              `json:"status" example:"ACTIVE" enums:"ACTIVE,INACTIVE"`
+	Axes     VariantAxesResponse `json:"axes"`
+	Variants []VariantResponse   `json:"variants"`
+}
+
+// LocationStockResponse represents the stock of a SKU at a warehouse
+type LocationStockResponse struct {
+	Location        string    `json:"location" example:"default"` // warehouse code
+	WarehouseName   string    `json:"warehouse_name" example:"Default warehouse"`
+	Country         string    `json:"country,omitempty" example:"DE"`
+	WarehouseStatus string    `json:"warehouse_status" example:"ACTIVE" enums:"ACTIVE,INACTIVE"` // INACTIVE = not reserved from
+	Quantity        int64     `json:"quantity" example:"500"`
+	Reserved        int64     `json:"reserved" example:"20"`
+	Available       int64     `json:"available" example:"480"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// InventoryResponse represents the stock of a sellable SKU (a variant or a product without variants)
+type InventoryResponse struct {
+	SKU              string                  `json:"sku" example:"SKU-WIDGET-001-L-A-BOX10"`
+	ParentSKU        string                  `json:"parent_sku,omitempty" example:"SKU-WIDGET-001"` // variants only
+	Quantity         int64                   `json:"quantity" example:"500"`
+	Reserved         int64                   `json:"reserved" example:"20"`
+	Available        int64                   `json:"available" example:"480"`
+	ReorderThreshold *int64                  `json:"reorder_threshold,omitempty" example:"100"` // omitted = no low-stock alerts
+	LowStock         bool                    `json:"low_stock" example:"false"`                 // available < reorder_threshold
+	Locations        []LocationStockResponse `json:"locations"`
+}
+
+// InventoryTransferResponse represents a stock transfer between warehouses with the SKU's resulting inventory
+type InventoryTransferResponse struct {
+	ID           string            `json:"id" example:"9b2f6c1e-8d4a-4f7b-a3c5-2e1d0f9a8b7c"`
+	SKU          string            `json:"sku" example:"SKU-WIDGET-001-L-A-BOX10"`
+	FromLocation string            `json:"from_location" example:"default"`
+	ToLocation   string            `json:"to_location" example:"fra-1"`
+	Quantity     int64             `json:"quantity" example:"200"`
+	Reason       string            `json:"reason" example:"Rebalance stock for EU orders"`
+	Inventory    InventoryResponse `json:"inventory"`
+}
+
+// InventoryLevelResponse represents the stock of a sellable SKU summed over its locations
+type InventoryLevelResponse struct {
+	SKU              string `json:"sku" example:"SKU-WIDGET-001-L-A-BOX10"`
+	ParentSKU        string `json:"parent_sku,omitempty" example:"SKU-WIDGET-001"` // variants only
+	Name             string `json:"name" example:"Industrial widget (L / A / Box of 10)"`
+	Status           string `json:"status" example:"ACTIVE" enums:"ACTIVE,INACTIVE"`
+	Quantity         int64  `json:"quantity" example:"120"`
+	Reserved         int64  `json:"reserved" example:"40"`
+	Available        int64  `json:"available" example:"80"`
+	Locations        int64  `json:"locations" example:"1"` // 0 = stock not tracked
+	ReorderThreshold *int64 `json:"reorder_threshold,omitempty" example:"100"`
+	LowStock         bool   `json:"low_stock" example:"true"`
+}
+
+// ListInventoryResponse represents paginated inventory levels
+type ListInventoryResponse struct {
+	Inventory  []InventoryLevelResponse `json:"inventory"`
+	Total      int64                    `json:"total" example:"12"`
+	Page       int                      `json:"page" example:"1"`
+	PageSize   int                      `json:"page_size" example:"20"`
+	TotalPages int                      `json:"total_pages" example:"1"`
+}
+
+// StockMovementResponse represents an entry of a SKU's stock movement audit trail
+type StockMovementResponse struct {
+	ID            uint64    `json:"id" example:"1042"`
+	Location      string    `json:"location" example:"default"`
+	Reason        string    `json:"reason" example:"RESERVATION" enums:"RESERVATION,RELEASE,ADJUSTMENT,FULFILLMENT,RETURN,TRANSFER_OUT,TRANSFER_IN"`
+	QuantityDelta int64     `json:"quantity_delta" example:"0"` // on-hand change
+	ReservedDelta int64     `json:"reserved_delta" example:"20"`
+	QuantityAfter int64     `json:"quantity_after" example:"500"`
+	ReservedAfter int64     `json:"reserved_after" example:"40"`
+	ActorType     string    `json:"actor_type" example:"USER" enums:"USER,SYSTEM"`
+	ActorID       string    `json:"actor_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ReferenceType string    `json:"reference_type,omitempty" example:"ORDER" enums:"ORDER,RMA,TRANSFER"`
+	Reference     string    `json:"reference,omitempty" example:"ORD-20261003-0001"` // order number, RMA number or transfer id
+	Note          string    `json:"note,omitempty" example:"ORDER_PLACED"`
+	RequestID     string    `json:"request_id,omitempty" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// ListStockMovementsResponse represents the paginated stock movements of a SKU (newest first)
+type ListStockMovementsResponse struct {
+	SKU        string                  `json:"sku" example:"SKU-WIDGET-001-L-A-BOX10"`
+	Movements  []StockMovementResponse `json:"movements"`
+	Total      int64                   `json:"total" example:"42"`
+	Page       int                     `json:"page" example:"1"`
+	PageSize   int                     `json:"page_size" example:"20"`
+	TotalPages int                     `json:"total_pages" example:"3"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToProductResponse converts a catalog product row to its response (price parsed by the caller)
+func ToProductResponse(p *db.ListCatalogProductsRow, price money.Money) ProductResponse {
+	return ProductResponse{
+		SKU:          p.Sku,
+		Name:         p.Name,
+		Category:     p.Category.String,
+		Price:        price,
+		Status:       string(p.Status),
+		VariantCount: p.VariantCount,
+		CreatedAt:    p.CreatedAt,
+		UpdatedAt:    p.UpdatedAt,
+	}
+}
+
+// ToVariantResponse converts a variant matrix row to its response (price parsed by the caller)
+func ToVariantResponse(v *db.ListProductVariantMatrixRow, price money.Money) VariantResponse {
+	response := VariantResponse{
+		SKU:       v.Sku,
+		Name:      v.Name,
+		Size:      v.VariantSize,
+		Grade:     v.VariantGrade,
+		Packaging: v.VariantPackaging,
+		Price:     price,
+		Status:    string(v.Status),
+		CreatedAt: v.CreatedAt,
+		UpdatedAt: v.UpdatedAt,
+	}
+	if v.StockLocations > 0 {
+		response.Stock = &StockResponse{
+			Quantity:  v.StockQuantity,
+			Reserved:  v.StockReserved,
+			Available: v.StockQuantity - v.StockReserved,
+			Locations: v.StockLocations,
+		}
+	}
+	return response
+}
+
+// ToInventoryLevelResponse converts an inventory level row to its response
+func ToInventoryLevelResponse(r *db.ListInventoryLevelsRow) InventoryLevelResponse {
+	available := r.Quantity - r.Reserved
+	return InventoryLevelResponse{
+		SKU:              r.Sku,
+		ParentSKU:        r.ParentSku.String,
+		Name:             r.Name,
+		Status:           string(r.Status),
+		Quantity:         r.Quantity,
+		Reserved:         r.Reserved,
+		Available:        available,
+		Locations:        r.Locations,
+		ReorderThreshold: nullInt64Ptr(r.ReorderThreshold),
+		LowStock:         r.ReorderThreshold.Valid && available < r.ReorderThreshold.Int64,
+	}
+}
+
+// ToLocationStockResponse converts an inventory location row to its response
+func ToLocationStockResponse(inv *db.ListInventoryLocationsRow) LocationStockResponse {
+	return LocationStockResponse{
+		Location:        inv.Location,
+		WarehouseName:   inv.WarehouseName,
+		Country:         inv.WarehouseCountry.String,
+		WarehouseStatus: string(inv.WarehouseStatus),
+		Quantity:        inv.Quantity,
+		Reserved:        inv.ReservedQuantity,
+		Available:       inv.Quantity - inv.ReservedQuantity,
+		UpdatedAt:       inv.UpdatedAt,
+	}
+}
+
+// ToStockMovementResponse converts a stock movement row to its response
+func ToStockMovementResponse(m *db.ListStockMovementsByProductRow) StockMovementResponse {
+	response := StockMovementResponse{
+		ID:            m.ID,
+		Location:      m.Location,
+		Reason:        string(m.Reason),
+		QuantityDelta: m.QuantityDelta,
+		ReservedDelta: m.ReservedDelta,
+		QuantityAfter: m.QuantityAfter,
+		ReservedAfter: m.ReservedAfter,
+		ActorType:     m.ActorType,
+		ActorID:       m.ActorExternalID.String,
+		ReferenceType: m.ReferenceType.String,
+		Note:          m.Note.String,
+		RequestID:     m.RequestID.String,
+		CreatedAt:     m.CreatedAt,
+	}
+	switch {
+	case m.OrderNumber.Valid:
+		response.Reference = m.OrderNumber.String
+	case m.RmaNumber.Valid:
+		response.Reference = m.RmaNumber.String
+	case m.TransferExternalID.Valid:
+		response.Reference = m.TransferExternalID.String
+	}
+	return response
+}
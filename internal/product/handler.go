@@ -0,0 +1,369 @@
+package product
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles HTTP requests for the product catalog, variants and inventory
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new product handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers product routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	products := rg.Group("/products", middleware.RequireAuth())
+	{
+		products.GET("", h.ListProducts)
+		products.GET("/:sku/variants", h.GetVariantMatrix)
+		products.GET("/:sku/inventory", h.GetInventory)
+	}
+
+	admin := rg.Group("/admin/products", middleware.RequireRoles(middleware.RoleAdmin))
+	{
+		admin.POST("/:sku/variants", h.CreateVariant)
+		admin.PATCH("/:sku/variants/:variantSku", h.UpdateVariant)
+		admin.POST("/:sku/inventory/adjustments", h.AdjustInventory)
+		admin.POST("/:sku/inventory/transfers", h.TransferInventory)
+		admin.PUT("/:sku/reorder-threshold", h.SetReorderThreshold)
+	}
+
+	inventory := rg.Group("/admin/inventory", middleware.RequireRoles(middleware.RoleAdmin))
+	{
+		inventory.GET("", h.ListInventory)
+		inventory.GET("/:sku/movements", h.ListStockMovements)
+	}
+}
+
+// ListProducts godoc
+// @Summary List products
+// @Description Browse the catalog by name with optional category and status filters (variants are listed in each product's variant matrix).
+// @Description A category filter includes its subcategories unless include_subcategories is false.
+// @Tags products
+// @Produce json
+// @Param category query string false "Category code"
+// @Param include_subcategories query bool false "Include products of subcategories" default(true)
+// @Param status query string false "Status filter" Enums(ACTIVE, INACTIVE)
+// @Param page query int false "Page number" default(1) minimum(1)
+// @Param page_size query int false "Page size" default(20) minimum(1) maximum(100)
+// @Success 200 {object} middleware.SuccessResponse{data=ListProductsResponse} "Products"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input or unknown category"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/products [get]
+func (h *Handler) ListProducts(c *gin.Context) {
+	var req ListProductsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListProducts(c.Request.Context(), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// GetVariantMatrix godoc
+// @Summary Get variant matrix
+// @Description Get a product's variants (size/grade/packaging) with their SKU, price and stock, ordered by size, grade and packaging,
+// @Description plus the distinct values of each attribute. A variant SKU returns the matrix of its product.
+// @Tags products
+// @Produce json
+// @Param sku path string true "Product or variant SKU"
+// @Success 200 {object} middleware.SuccessResponse{data=VariantMatrixResponse} "Variant matrix"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Product not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/products/{sku}/variants [get]
+func (h *Handler) GetVariantMatrix(c *gin.Context) {
+	result, err := h.service.GetVariantMatrix(c.Request.Context(), c.Param("sku"))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// GetInventory godoc
+// @Summary Get inventory
+// @Description Get the stock of a variant (or a product without variants) per warehouse, in warehouse priority order. Products with variants are stocked per variant SKU.
+// @Description low_stock is set when the available stock is below the SKU's reorder threshold.
+// @Tags products
+// @Produce json
+// @Param sku path string true "Variant or product SKU"
+// @Success 200 {object} middleware.SuccessResponse{data=InventoryResponse} "Inventory"
+// @Failure 400 {object} middleware.ErrorResponse "Product has variants"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Product not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/products/{sku}/inventory [get]
+func (h *Handler) GetInventory(c *gin.Context) {
+	result, err := h.service.GetInventory(c.Request.Context(), c.Param("sku"))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// CreateVariant godoc
+// @Summary Create variant
+// @Description Add a variant with its own SKU and price to a product - Admin only. The variant inherits the product's category;
+// @Description once a product has variants it is ordered and stocked by their SKUs. Variants cannot have variants. Audited.
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param sku path string true "Product SKU"
+// @Param request body CreateVariantRequest true "Variant"
+// @Success 201 {object} middleware.SuccessResponse{data=VariantResponse} "Variant created"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input or product is a variant"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Product not found"
+// @Failure 409 {object} middleware.ErrorResponse "SKU or variant attributes already exist, or product has stock"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/products/{sku}/variants [post]
+func (h *Handler) CreateVariant(c *gin.Context) {
+	var req CreateVariantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.CreateVariant(c.Request.Context(), c.Param("sku"), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// UpdateVariant godoc
+// @Summary Update variant
+// @Description Change a variant's name, price or status - Admin only. New prices apply to purchase orders and carts afterwards; existing orders keep their prices. Audited.
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param sku path string true "Product SKU"
+// @Param variantSku path string true "Variant SKU"
+// @Param request body UpdateVariantRequest true "Changes"
+// @Success 200 {object} middleware.SuccessResponse{data=VariantResponse} "Variant updated"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Product or variant not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/products/{sku}/variants/{variantSku} [patch]
+func (h *Handler) UpdateVariant(c *gin.Context) {
+	var req UpdateVariantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.UpdateVariant(c.Request.Context(), c.Param("sku"), c.Param("variantSku"), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// AdjustInventory godoc
+// @Summary Adjust inventory
+// @Description Record a stock movement of a variant (or a product without variants) at a warehouse (location = warehouse code) - Admin only.
+// @Description INBOUND adds and OUTBOUND removes quantity units; ADJUST applies a signed correction. Stock may not drop below the reserved quantity.
+// @Description An OUTBOUND with order_number fulfils that order: it ships from (and consumes) the order's reservation at the warehouse.
+// @Description Recorded in the inventory log and stock movements. Dropping the available stock below the reorder threshold sends inventory.low_stock to admins. Audited.
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param sku path string true "Variant or product SKU"
+// @Param request body AdjustInventoryRequest true "Stock movement"
+// @Success 200 {object} middleware.SuccessResponse{data=InventoryResponse} "Inventory adjusted"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input, product has variants or stock below reserved quantity"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Product, warehouse or order not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/products/{sku}/inventory/adjustments [post]
+func (h *Handler) AdjustInventory(c *gin.Context) {
+	var req AdjustInventoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.AdjustInventory(c.Request.Context(), c.Param("sku"), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// TransferInventory godoc
+// @Summary Transfer inventory
+// @Description Move unreserved stock of a variant (or a product without variants) from one warehouse to another - Admin only.
+// @Description The destination must be an ACTIVE warehouse. Recorded in the inventory log and stock movements of both locations. Audited.
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param sku path string true "Variant or product SKU"
+// @Param request body TransferInventoryRequest true "Transfer"
+// @Success 201 {object} middleware.SuccessResponse{data=InventoryTransferResponse} "Inventory transferred"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input, product has variants, inactive destination or insufficient unreserved stock"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Product or warehouse not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/products/{sku}/inventory/transfers [post]
+func (h *Handler) TransferInventory(c *gin.Context) {
+	var req TransferInventoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.TransferInventory(c.Request.Context(), c.Param("sku"), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// SetReorderThreshold godoc
+// @Summary Set reorder threshold
+// @Description Set the reorder threshold of a variant (or a product without variants), or clear it with null - Admin only.
+// @Description When a checkout reservation or an adjustment drops the available stock (all locations) below the threshold,
+// @Description an inventory.low_stock event is sent to active admins; SKUs already below it are found with the low_stock filter. Audited.
+// @Tags products
+// @Accept json
+// @Produce json
+// @Param sku path string true "Variant or product SKU"
+// @Param request body SetReorderThresholdRequest true "Threshold"
+// @Success 200 {object} middleware.SuccessResponse{data=InventoryResponse} "Threshold set"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input or product has variants"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Product not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/products/{sku}/reorder-threshold [put]
+func (h *Handler) SetReorderThreshold(c *gin.Context) {
+	var req SetReorderThresholdRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.SetReorderThreshold(c.Request.Context(), c.Param("sku"), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// ListInventory godoc
+// @Summary List inventory
+// @Description List the stock of all variants and products without variants, summed over their locations, by SKU - Admin only.
+// @Description low_stock=true lists only SKUs whose available stock is below their reorder threshold.
+// @Tags products
+// @Produce json
+// @Param low_stock query bool false "Only SKUs below their reorder threshold"
+// @Param page query int false "Page number" default(1) minimum(1)
+// @Param page_size query int false "Page size" default(20) minimum(1) maximum(100)
+// @Success 200 {object} middleware.SuccessResponse{data=ListInventoryResponse} "Inventory levels"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/inventory [get]
+func (h *Handler) ListInventory(c *gin.Context) {
+	var req ListInventoryRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListInventory(c.Request.Context(), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// ListStockMovements godoc
+// @Summary List stock movements
+// @Description List the stock movement audit trail of a variant (or a product without variants), newest first - Admin only.
+// @Description Every reservation, release, adjustment, fulfillment, return restock and transfer appends an immutable movement
+// @Description with its reason, actor and on-hand/reserved quantity deltas; reference is the order number, RMA number or transfer id.
+// @Tags products
+// @Produce json
+// @Param sku path string true "Variant or product SKU"
+// @Param reason query string false "Reason filter" Enums(RESERVATION, RELEASE, ADJUSTMENT, FULFILLMENT, RETURN, TRANSFER_OUT, TRANSFER_IN)
+// @Param location query string false "Warehouse code filter"
+// @Param from query string false "Created at or after (RFC 3339)"
+// @Param to query string false "Created before (RFC 3339)"
+// @Param page query int false "Page number" default(1) minimum(1)
+// @Param page_size query int false "Page size" default(20) minimum(1) maximum(100)
+// @Success 200 {object} middleware.SuccessResponse{data=ListStockMovementsResponse} "Stock movements"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input or product has variants"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Product not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/inventory/{sku}/movements [get]
+func (h *Handler) ListStockMovements(c *gin.Context) {
+	var req ListStockMovementsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListStockMovements(c.Request.Context(), c.Param("sku"), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
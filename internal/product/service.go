@@ -0,0 +1,963 @@
+package product
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/catalog"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	mysqlErrDuplicateEntry = 1062
+
+	// amountScale is the scale of catalog prices (DECIMAL(18,2))
+	amountScale = 2
+
+	// maxNameLength mirrors products.name (VARCHAR(200))
+	maxNameLength = 200
+
+	// defaultLocation is the inventory location of adjustments without one (inventories.location default)
+	defaultLocation = "default"
+)
+
+// Audit log identifiers
+const (
+	actionVariantCreated       = "PRODUCT_VARIANT_CREATED"
+	actionVariantUpdated       = "PRODUCT_VARIANT_UPDATED"
+	actionInventoryAdjusted    = "INVENTORY_ADJUSTED"
+	actionThresholdSet         = "PRODUCT_REORDER_THRESHOLD_SET"
+	actionInventoryTransferred = "INVENTORY_TRANSFERRED"
+	resourceProduct            = "PRODUCT"
+	resourceInventory          = "INVENTORY"
+	resourceInventoryTransfer  = "INVENTORY_TRANSFER"
+)
+
+// Inventory log references of warehouse transfers and order fulfillments
+const (
+	referenceTransfer = "TRANSFER"
+	referenceOrder    = "ORDER"
+)
+
+// Service handles product variants and their inventory.
+// A variant is a products row under its parent product with its own SKU, price and stock, so orders,
+// carts and inventory keep working per product_id; a product with variants is sold by its variant SKUs.
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	logger   *zap.Logger
+}
+
+// NewService creates a new product service
+func NewService(txRunner *pkgdb.TxRunner, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		logger:   logger,
+	}
+}
+
+// ListProducts returns catalog products (variants excluded) by name, optionally within a category and its subcategories
+func (s *Service) ListProducts(ctx context.Context, req *ListProductsRequest) (*ListProductsResponse, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	q := s.txRunner.Queries()
+	var status db.NullProductsStatus
+	if req.Status != "" {
+		status = db.NullProductsStatus{ProductsStatus: db.ProductsStatus(req.Status), Valid: true}
+	}
+	var categoryID sql.NullInt64
+	var maxDepth uint32
+	if req.Category != "" {
+		category, err := q.GetCategoryByCode(ctx, req.Category)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, errors.InvalidInput("unknown category: " + req.Category)
+			}
+			logctx.From(ctx, s.logger).Error("failed to get category", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		categoryID = sql.NullInt64{Int64: int64(category.ID), Valid: true}
+		if req.IncludeSubcategories {
+			maxDepth = math.MaxUint32
+		}
+	}
+
+	rows, err := q.ListCatalogProducts(ctx, db.ListCatalogProductsParams{
+		Status:     status,
+		CategoryID: categoryID,
+		MaxDepth:   maxDepth,
+		Limit:      int32(req.PageSize),
+		Offset:     int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list products", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := q.CountCatalogProducts(ctx, db.CountCatalogProductsParams{
+		Status:     status,
+		CategoryID: categoryID,
+		MaxDepth:   maxDepth,
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count products", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	products := make([]ProductResponse, 0, len(rows))
+	for i := range rows {
+		price, err := s.parseStoredAmount(ctx, rows[i].Price)
+		if err != nil {
+			return nil, err
+		}
+		products = append(products, ToProductResponse(&rows[i], price))
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListProductsResponse{
+		Products:   products,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// GetVariantMatrix returns a product's variants with their stock and the distinct attribute values.
+// A variant SKU resolves to the matrix of its parent product.
+func (s *Service) GetVariantMatrix(ctx context.Context, sku string) (*VariantMatrixResponse, error) {
+	q := s.txRunner.Queries()
+	product, err := s.getProduct(ctx, q, sku)
+	if err != nil {
+		return nil, err
+	}
+	if product.ParentID.Valid {
+		parent, err := q.GetProduct(ctx, uint64(product.ParentID.Int64))
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to get parent product", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		product = &parent
+	}
+
+	rows, err := q.ListProductVariantMatrix(ctx, sql.NullInt64{Int64: int64(product.ID), Valid: true})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list product variants", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	response := &VariantMatrixResponse{
+		SKU:      product.Sku,
+		Name:     product.Name,
+		Category: product.Category.String,
+		Status:   string(product.Status),
+		Axes: VariantAxesResponse{
+			Sizes:      []string{},
+			Grades:     []string{},
+			Packagings: []string{},
+		},
+		Variants: make([]VariantResponse, 0, len(rows)),
+	}
+	for i := range rows {
+		price, err := s.parseStoredAmount(ctx, rows[i].Price)
+		if err != nil {
+			return nil, err
+		}
+		response.Variants = append(response.Variants, ToVariantResponse(&rows[i], price))
+		response.Axes.Sizes = appendAxis(response.Axes.Sizes, rows[i].VariantSize)
+		response.Axes.Grades = appendAxis(response.Axes.Grades, rows[i].VariantGrade)
+		response.Axes.Packagings = appendAxis(response.Axes.Packagings, rows[i].VariantPackaging)
+	}
+	slices.Sort(response.Axes.Sizes)
+	slices.Sort(response.Axes.Grades)
+	slices.Sort(response.Axes.Packagings)
+	return response, nil
+}
+
+// CreateVariant adds a variant to a product (admin). The variant inherits the product's category.
+//
+// Why:
+//   - 변형은 1단계만 허용 (변형의 변형 불가) → 매트릭스/재고 집계가 기준 상품 1개로 끝남
+//   - 재고가 남은 상품에는 변형 추가 불가 → 변형이 생기면 기준 상품 재고는 관리·주문 대상에서 빠지므로 재고가 묶이지 않도록
+func (s *Service) CreateVariant(ctx context.Context, sku string, req *CreateVariantRequest, actor audit.Actor) (*VariantResponse, error) {
+	size, grade, packaging := strings.TrimSpace(req.Size), strings.TrimSpace(req.Grade), strings.TrimSpace(req.Packaging)
+	if size == "" && grade == "" && packaging == "" {
+		return nil, errors.InvalidInput("at least one of size, grade and packaging is required")
+	}
+	price, err := parsePrice(req.Price)
+	if err != nil {
+		return nil, err
+	}
+	status := db.ProductsStatusACTIVE
+	if req.Status != "" {
+		status = db.ProductsStatus(req.Status)
+	}
+
+	q := s.txRunner.Queries()
+	parent, err := s.getProduct(ctx, q, sku)
+	if err != nil {
+		return nil, err
+	}
+	if parent.ParentID.Valid {
+		return nil, errors.InvalidInput("a variant cannot have variants: " + sku)
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = variantName(parent.Name, size, grade, packaging)
+		if len(name) > maxNameLength {
+			return nil, errors.InvalidInput("name is required when the product name with attributes exceeds 200 characters")
+		}
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		inventories, err := q.ListInventoriesByProduct(ctx, parent.ID)
+		if err != nil {
+			return err
+		}
+		for _, inv := range inventories {
+			if inv.Quantity > 0 || inv.ReservedQuantity > 0 {
+				return errors.Conflict("Product has stock - move it out before adding variants").
+					WithDetails(map[string]any{"location": inv.Location, "quantity": inv.Quantity})
+			}
+		}
+
+		result, err := q.CreateProductVariant(ctx, db.CreateProductVariantParams{
+			ParentID:         sql.NullInt64{Int64: int64(parent.ID), Valid: true},
+			Sku:              req.SKU,
+			Name:             name,
+			Category:         parent.Category,
+			Price:            price.String(),
+			Status:           status,
+			VariantSize:      size,
+			VariantGrade:     grade,
+			VariantPackaging: packaging,
+		})
+		if err != nil {
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionVariantCreated,
+			ResourceType: resourceProduct,
+			ResourceID:   uint64(id),
+			NewValue: map[string]any{
+				"sku":        req.SKU,
+				"parent_sku": parent.Sku,
+				"size":       size,
+				"grade":      grade,
+				"packaging":  packaging,
+				"price":      price.String(),
+				"status":     string(status),
+			},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		if isDuplicateKeyError(err) {
+			return nil, errors.Conflict("SKU or variant attributes already exist")
+		}
+		logctx.From(ctx, s.logger).Error("failed to create product variant", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("product variant created",
+		zap.String("sku", req.SKU),
+		zap.String("parent_sku", parent.Sku),
+	)
+	return s.getVariant(ctx, parent.ID, req.SKU)
+}
+
+// UpdateVariant changes a variant's name, price or status (admin). Price changes apply to new
+// purchase orders and carts; existing orders keep their prices.
+func (s *Service) UpdateVariant(ctx context.Context, sku, variantSKU string, req *UpdateVariantRequest, actor audit.Actor) (*VariantResponse, error) {
+	q := s.txRunner.Queries()
+	parent, err := s.getProduct(ctx, q, sku)
+	if err != nil {
+		return nil, err
+	}
+	variant, err := s.getProduct(ctx, q, variantSKU)
+	if err != nil {
+		return nil, err
+	}
+	if !variant.ParentID.Valid || uint64(variant.ParentID.Int64) != parent.ID {
+		return nil, errors.NotFound("Product variant")
+	}
+
+	params := db.UpdateProductParams{
+		Name:   variant.Name,
+		Price:  variant.Price,
+		Status: variant.Status,
+		ID:     variant.ID,
+	}
+	if name := strings.TrimSpace(req.Name); name != "" {
+		params.Name = name
+	}
+	if req.Price != "" {
+		price, err := parsePrice(req.Price)
+		if err != nil {
+			return nil, err
+		}
+		params.Price = price.String()
+	}
+	if req.Status != "" {
+		params.Status = db.ProductsStatus(req.Status)
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		if err := q.UpdateProduct(ctx, params); err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionVariantUpdated,
+			ResourceType: resourceProduct,
+			ResourceID:   variant.ID,
+			OldValue:     map[string]any{"name": variant.Name, "price": variant.Price, "status": string(variant.Status)},
+			NewValue:     map[string]any{"name": params.Name, "price": params.Price, "status": string(params.Status)},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to update product variant", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.getVariant(ctx, parent.ID, variant.Sku)
+}
+
+// GetInventory returns the stock of a sellable SKU per location
+func (s *Service) GetInventory(ctx context.Context, sku string) (*InventoryResponse, error) {
+	q := s.txRunner.Queries()
+	product, err := s.getSellable(ctx, q, sku)
+	if err != nil {
+		return nil, err
+	}
+
+	inventories, err := q.ListInventoryLocations(ctx, product.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list inventories", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	response := &InventoryResponse{
+		SKU:       product.Sku,
+		Locations: make([]LocationStockResponse, 0, len(inventories)),
+	}
+	if product.ParentID.Valid {
+		parent, err := q.GetProduct(ctx, uint64(product.ParentID.Int64))
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to get parent product", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		response.ParentSKU = parent.Sku
+	}
+	for i := range inventories {
+		location := ToLocationStockResponse(&inventories[i])
+		response.Quantity += location.Quantity
+		response.Reserved += location.Reserved
+		response.Available += location.Available
+		response.Locations = append(response.Locations, location)
+	}
+	response.ReorderThreshold = nullInt64Ptr(product.ReorderThreshold)
+	response.LowStock = product.ReorderThreshold.Valid && response.Available < product.ReorderThreshold.Int64
+	return response, nil
+}
+
+// ListInventory returns the stock of sellable SKUs by SKU, optionally only those below their reorder threshold (admin)
+func (s *Service) ListInventory(ctx context.Context, req *ListInventoryRequest) (*ListInventoryResponse, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	q := s.txRunner.Queries()
+	rows, err := q.ListInventoryLevels(ctx, db.ListInventoryLevelsParams{
+		LowStock: req.LowStock,
+		Limit:    int32(req.PageSize),
+		Offset:   int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list inventory levels", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := q.CountInventoryLevels(ctx, req.LowStock)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count inventory levels", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	levels := make([]InventoryLevelResponse, 0, len(rows))
+	for i := range rows {
+		levels = append(levels, ToInventoryLevelResponse(&rows[i]))
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListInventoryResponse{
+		Inventory:  levels,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// SetReorderThreshold sets or clears the reorder threshold of a sellable SKU (admin).
+// An alert is sent when a reservation or adjustment drops its available stock below the threshold.
+//
+// Why:
+//   - 기준 설정 시점에 이미 미만이어도 알림 없음 → 조회의 low_stock 필터로 확인 (알림은 기준선을 넘어 내려갈 때만)
+func (s *Service) SetReorderThreshold(ctx context.Context, sku string, req *SetReorderThresholdRequest, actor audit.Actor) (*InventoryResponse, error) {
+	q := s.txRunner.Queries()
+	product, err := s.getSellable(ctx, q, sku)
+	if err != nil {
+		return nil, err
+	}
+	var threshold sql.NullInt64
+	if req.ReorderThreshold != nil {
+		threshold = sql.NullInt64{Int64: *req.ReorderThreshold, Valid: true}
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		if err := q.UpdateProductReorderThreshold(ctx, db.UpdateProductReorderThresholdParams{
+			ReorderThreshold: threshold,
+			ID:               product.ID,
+		}); err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionThresholdSet,
+			ResourceType: resourceProduct,
+			ResourceID:   product.ID,
+			OldValue:     map[string]any{"reorder_threshold": nullInt64Ptr(product.ReorderThreshold)},
+			NewValue:     map[string]any{"reorder_threshold": nullInt64Ptr(threshold)},
+		})
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to set reorder threshold", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetInventory(ctx, sku)
+}
+
+// AdjustInventory records a stock movement of a sellable SKU at a location (admin).
+// The location's row is created on its first movement; stock may not drop below the reserved quantity.
+// An OUTBOUND movement with an order number fulfils that order: it ships from the order's reservation at the location.
+//
+// Why:
+//   - 재고 row-lock 후 계산 → 주문 예약/해제와 동시에 실행돼도 수량·예약 수량이 어긋나지 않음
+//   - 모든 변경은 inventory_logs + stock_movements에 기록 (불변 이력) + 감사 로그
+//   - 주문 출고는 예약을 함께 차감 → 배송 상태는 판매자 시스템에서 관리되므로 출고 시점의 예약 소진은 이 기록으로만 반영
+func (s *Service) AdjustInventory(ctx context.Context, sku string, req *AdjustInventoryRequest, actor audit.Actor) (*InventoryResponse, error) {
+	change := req.Quantity
+	switch req.Type {
+	case AdjustmentInbound, AdjustmentOutbound:
+		if change <= 0 {
+			return nil, errors.InvalidInput("quantity must be positive for " + req.Type)
+		}
+		if req.Type == AdjustmentOutbound {
+			change = -change
+		}
+	}
+	if req.OrderNumber != "" && req.Type != AdjustmentOutbound {
+		return nil, errors.InvalidInput("order_number is only allowed for " + AdjustmentOutbound)
+	}
+	location := strings.TrimSpace(req.Location)
+	if location == "" {
+		location = defaultLocation
+	}
+
+	q := s.txRunner.Queries()
+	product, err := s.getSellable(ctx, q, sku)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.getWarehouse(ctx, q, location); err != nil {
+		return nil, err
+	}
+	var order *db.Order
+	if req.OrderNumber != "" {
+		o, err := q.GetOrderByOrderNumber(ctx, req.OrderNumber)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, errors.NotFound("Order")
+			}
+			logctx.From(ctx, s.logger).Error("failed to get order", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		order = &o
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		if err := q.EnsureInventory(ctx, db.EnsureInventoryParams{ProductID: product.ID, Location: location}); err != nil {
+			return err
+		}
+		inv, err := q.GetInventoryByProductForUpdate(ctx, db.GetInventoryByProductForUpdateParams{
+			ProductID: product.ID,
+			Location:  location,
+		})
+		if err != nil {
+			return err
+		}
+
+		movement := catalog.Movement{
+			Inventory:     &inv,
+			EventType:     db.InventoryLogsEventType(req.Type),
+			Reason:        db.StockMovementsReasonADJUSTMENT,
+			QuantityDelta: change,
+			Note:          req.Reason,
+		}
+		if order != nil {
+			reserved, err := orderReservation(ctx, q, order.ID, &inv)
+			if err != nil {
+				return err
+			}
+			if reserved < req.Quantity {
+				return errors.InvalidInput("quantity exceeds the order's reservation at " + location).
+					WithDetails(map[string]any{"order_number": order.OrderNumber, "reserved": reserved})
+			}
+			movement.Reason = db.StockMovementsReasonFULFILLMENT
+			movement.ReservedDelta = -req.Quantity
+			movement.ReferenceType = referenceOrder
+			movement.ReferenceID = order.ID
+		}
+
+		quantity := inv.Quantity + change
+		reserved := inv.ReservedQuantity + movement.ReservedDelta
+		if quantity < reserved {
+			return errors.InvalidInput("stock cannot drop below the reserved quantity").
+				WithDetails(map[string]any{"quantity": inv.Quantity, "reserved": inv.ReservedQuantity})
+		}
+
+		if err := q.UpdateInventoryQuantity(ctx, db.UpdateInventoryQuantityParams{Quantity: quantity, ID: inv.ID}); err != nil {
+			return err
+		}
+		if movement.ReservedDelta != 0 {
+			if err := q.UpdateInventoryReservedQuantity(ctx, db.UpdateInventoryReservedQuantityParams{
+				ReservedQuantity: reserved,
+				ID:               inv.ID,
+			}); err != nil {
+				return err
+			}
+		}
+		if err := catalog.RecordMovement(ctx, q, actor, movement); err != nil {
+			return err
+		}
+		// NOTE: 주문 출고는 예약분만 소진 → 가용 재고 변화 없음 (알림은 예약 시점에 판단)
+		if err := catalog.NotifyLowStock(ctx, q, product.ID, movement.ReservedDelta-change, db.InventoryLogsEventType(req.Type)); err != nil {
+			return err
+		}
+		newValue := map[string]any{
+			"sku":             product.Sku,
+			"location":        location,
+			"type":            req.Type,
+			"quantity":        quantity,
+			"quantity_change": change,
+			"reason":          req.Reason,
+		}
+		if order != nil {
+			newValue["order_number"] = order.OrderNumber
+			newValue["reserved"] = reserved
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionInventoryAdjusted,
+			ResourceType: resourceInventory,
+			ResourceID:   inv.ID,
+			OldValue:     map[string]any{"quantity": inv.Quantity, "reserved": inv.ReservedQuantity},
+			NewValue:     newValue,
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to adjust inventory", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("inventory adjusted",
+		zap.String("sku", product.Sku),
+		zap.String("location", location),
+		zap.String("type", req.Type),
+		zap.Int64("quantity_change", change),
+	)
+	return s.GetInventory(ctx, sku)
+}
+
+// TransferInventory moves unreserved stock of a sellable SKU from one warehouse to another (admin).
+// The destination's row is created on its first movement; it must be an ACTIVE warehouse.
+//
+// Why:
+//   - 두 재고 행을 id 순으로 row-lock → 주문 예약(같은 id 순 잠금)과 동시에 실행돼도 교착/수량 불일치 없음
+//   - 출고·입고 이력을 같은 이동 건(reference_type = TRANSFER)으로 기록 → 위치별 이력에서 이동 추적
+//   - 전체 가용 재고는 변하지 않음 → 재주문 알림 대상 아님
+func (s *Service) TransferInventory(ctx context.Context, sku string, req *TransferInventoryRequest, actor audit.Actor) (*InventoryTransferResponse, error) {
+	fromLocation := strings.TrimSpace(req.FromLocation)
+	toLocation := strings.TrimSpace(req.ToLocation)
+	if fromLocation == toLocation {
+		return nil, errors.InvalidInput("from_location and to_location must differ")
+	}
+
+	q := s.txRunner.Queries()
+	product, err := s.getSellable(ctx, q, sku)
+	if err != nil {
+		return nil, err
+	}
+	from, err := s.getWarehouse(ctx, q, fromLocation)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.getWarehouse(ctx, q, toLocation)
+	if err != nil {
+		return nil, err
+	}
+	if to.Status != db.WarehousesStatusACTIVE {
+		return nil, errors.InvalidInput("cannot transfer to an inactive warehouse: " + to.Code)
+	}
+
+	transferID := uuid.New().String()
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		if err := q.EnsureInventory(ctx, db.EnsureInventoryParams{ProductID: product.ID, Location: to.Code}); err != nil {
+			return err
+		}
+		inventories, err := q.ListInventoriesByProduct(ctx, product.ID)
+		if err != nil {
+			return err
+		}
+		var source, destination db.Inventory
+		var ids []uint64
+		for _, inv := range inventories {
+			switch inv.Location {
+			case from.Code, to.Code:
+				ids = append(ids, inv.ID)
+			}
+		}
+		if len(ids) < 2 {
+			return errors.InvalidInput("no stock at " + from.Code)
+		}
+		// NOTE: ListInventoriesByProduct는 id 순 → 잠금 순서 고정
+		for _, id := range ids {
+			inv, err := q.GetInventoryForUpdate(ctx, id)
+			if err != nil {
+				return err
+			}
+			if inv.Location == from.Code {
+				source = inv
+			} else {
+				destination = inv
+			}
+		}
+		if available := source.Quantity - source.ReservedQuantity; available < req.Quantity {
+			return errors.InvalidInput("insufficient unreserved stock at " + from.Code).
+				WithDetails(map[string]any{"location": from.Code, "available_quantity": available})
+		}
+
+		result, err := q.CreateInventoryTransfer(ctx, db.CreateInventoryTransferParams{
+			ExternalID:      transferID,
+			ProductID:       product.ID,
+			FromWarehouseID: from.ID,
+			ToWarehouseID:   to.ID,
+			Quantity:        req.Quantity,
+			Reason:          req.Reason,
+		})
+		if err != nil {
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		moves := []struct {
+			inv       db.Inventory
+			eventType db.InventoryLogsEventType
+			reason    db.StockMovementsReason
+			change    int64
+		}{
+			{source, db.InventoryLogsEventTypeTRANSFEROUT, db.StockMovementsReasonTRANSFEROUT, -req.Quantity},
+			{destination, db.InventoryLogsEventTypeTRANSFERIN, db.StockMovementsReasonTRANSFERIN, req.Quantity},
+		}
+		for _, m := range moves {
+			quantity := m.inv.Quantity + m.change
+			if err := q.UpdateInventoryQuantity(ctx, db.UpdateInventoryQuantityParams{Quantity: quantity, ID: m.inv.ID}); err != nil {
+				return err
+			}
+			if err := catalog.RecordMovement(ctx, q, actor, catalog.Movement{
+				Inventory:     &m.inv,
+				EventType:     m.eventType,
+				Reason:        m.reason,
+				QuantityDelta: m.change,
+				ReferenceType: referenceTransfer,
+				ReferenceID:   uint64(id),
+				Note:          req.Reason,
+			}); err != nil {
+				return err
+			}
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionInventoryTransferred,
+			ResourceType: resourceInventoryTransfer,
+			ResourceID:   uint64(id),
+			NewValue: map[string]any{
+				"transfer_id":   transferID,
+				"sku":           product.Sku,
+				"from_location": from.Code,
+				"to_location":   to.Code,
+				"quantity":      req.Quantity,
+				"reason":        req.Reason,
+			},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to transfer inventory", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("inventory transferred",
+		zap.String("sku", product.Sku),
+		zap.String("from_location", from.Code),
+		zap.String("to_location", to.Code),
+		zap.Int64("quantity", req.Quantity),
+	)
+	inventory, err := s.GetInventory(ctx, sku)
+	if err != nil {
+		return nil, err
+	}
+	return &InventoryTransferResponse{
+		ID:           transferID,
+		SKU:          product.Sku,
+		FromLocation: from.Code,
+		ToLocation:   to.Code,
+		Quantity:     req.Quantity,
+		Reason:       req.Reason,
+		Inventory:    *inventory,
+	}, nil
+}
+
+// ListStockMovements returns the stock movement audit trail of a sellable SKU, newest first (admin).
+// Every reservation, release, adjustment, fulfillment, return restock and transfer appends a movement
+// with its reason, actor and quantity deltas.
+func (s *Service) ListStockMovements(ctx context.Context, sku string, req *ListStockMovementsRequest) (*ListStockMovementsResponse, error) {
+	q := s.txRunner.Queries()
+	product, err := s.getSellable(ctx, q, sku)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	reason := db.NullStockMovementsReason{StockMovementsReason: db.StockMovementsReason(req.Reason), Valid: req.Reason != ""}
+	location := sql.NullString{String: req.Location, Valid: req.Location != ""}
+	from := sql.NullTime{Time: req.From, Valid: !req.From.IsZero()}
+	to := sql.NullTime{Time: req.To, Valid: !req.To.IsZero()}
+
+	rows, err := q.ListStockMovementsByProduct(ctx, db.ListStockMovementsByProductParams{
+		ProductID:   product.ID,
+		Reason:      reason,
+		Location:    location,
+		CreatedFrom: from,
+		CreatedTo:   to,
+		Limit:       int32(req.PageSize),
+		Offset:      int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list stock movements", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := q.CountStockMovementsByProduct(ctx, db.CountStockMovementsByProductParams{
+		ProductID:   product.ID,
+		Reason:      reason,
+		Location:    location,
+		CreatedFrom: from,
+		CreatedTo:   to,
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count stock movements", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	movements := make([]StockMovementResponse, 0, len(rows))
+	for i := range rows {
+		movements = append(movements, ToStockMovementResponse(&rows[i]))
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListStockMovementsResponse{
+		SKU:        product.Sku,
+		Movements:  movements,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// getWarehouse retrieves the warehouse of an inventory location
+func (s *Service) getWarehouse(ctx context.Context, q *db.Queries, code string) (*db.Warehouse, error) {
+	warehouse, err := q.GetWarehouseByCode(ctx, code)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Warehouse")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get warehouse", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &warehouse, nil
+}
+
+// getProduct retrieves a catalog product by SKU
+func (s *Service) getProduct(ctx context.Context, q *db.Queries, sku string) (*db.Product, error) {
+	product, err := q.GetProductBySKU(ctx, sku)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Product")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get product", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &product, nil
+}
+
+// getSellable retrieves a product whose stock is tracked by its own SKU (a variant or a product without variants)
+func (s *Service) getSellable(ctx context.Context, q *db.Queries, sku string) (*db.Product, error) {
+	product, err := s.getProduct(ctx, q, sku)
+	if err != nil {
+		return nil, err
+	}
+	if err := catalog.CheckSellable(ctx, q, product); err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to count product variants", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return product, nil
+}
+
+// getVariant returns a variant of the product with its stock
+func (s *Service) getVariant(ctx context.Context, parentID uint64, sku string) (*VariantResponse, error) {
+	rows, err := s.txRunner.Queries().ListProductVariantMatrix(ctx, sql.NullInt64{Int64: int64(parentID), Valid: true})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list product variants", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	for i := range rows {
+		if rows[i].Sku != sku {
+			continue
+		}
+		price, err := s.parseStoredAmount(ctx, rows[i].Price)
+		if err != nil {
+			return nil, err
+		}
+		response := ToVariantResponse(&rows[i], price)
+		return &response, nil
+	}
+	return nil, errors.NotFound("Product variant")
+}
+
+// parseStoredAmount parses a DECIMAL(18,2) column
+func (s *Service) parseStoredAmount(ctx context.Context, value string) (money.Money, error) {
+	amount, err := money.Parse(value, "", amountScale)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("invalid stored amount", zap.String("amount", value), zap.Error(err))
+		return money.Money{}, errors.Internal("Invalid stored amount")
+	}
+	return amount, nil
+}
+
+// parsePrice parses a catalog price
+func parsePrice(value string) (money.Money, error) {
+	price, err := money.Parse(value, "", amountScale)
+	if err != nil || price.Sign() <= 0 {
+		return money.Money{}, errors.InvalidInput("price must be a positive decimal with at most 2 decimals")
+	}
+	return price, nil
+}
+
+// variantName returns the default variant name: product name with its attributes
+func variantName(name string, attributes ...string) string {
+	values := make([]string, 0, len(attributes))
+	for _, a := range attributes {
+		if a != "" {
+			values = append(values, a)
+		}
+	}
+	return name + " (" + strings.Join(values, " / ") + ")"
+}
+
+// nullInt64Ptr converts a nullable integer to a pointer (nil = NULL)
+func nullInt64Ptr(v sql.NullInt64) *int64 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Int64
+}
+
+// orderReservation returns the quantity the order still holds reserved on the locked inventory row
+// (수동 조정으로 예약 수량이 이미 줄었으면 남은 만큼만)
+func orderReservation(ctx context.Context, q *db.Queries, orderID uint64, inv *db.Inventory) (int64, error) {
+	reservations, err := q.ListOrderInventoryReservations(ctx, sql.NullInt64{Int64: int64(orderID), Valid: true})
+	if err != nil {
+		return 0, err
+	}
+	for _, r := range reservations {
+		if r.InventoryID == inv.ID {
+			return min(r.Reserved, inv.ReservedQuantity), nil
+		}
+	}
+	return 0, nil
+}
+
+// appendAxis appends a non-empty attribute value once
+func appendAxis(values []string, value string) []string {
+	if value == "" || slices.Contains(values, value) {
+		return values
+	}
+	return append(values, value)
+}
+
+// isDuplicateKeyError checks if the error is a MySQL duplicate key error
+func isDuplicateKeyError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var mysqlErr *mysql.MySQLError
+	if stderrors.As(err, &mysqlErr) {
+		return mysqlErr.Number == mysqlErrDuplicateEntry
+	}
+	return strings.Contains(err.Error(), "Duplicate entry")
+}
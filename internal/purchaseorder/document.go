@@ -0,0 +1,92 @@
+package purchaseorder
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// documentTitle is the heading of a printed purchase order
+const documentTitle = "PURCHASE ORDER"
+
+// documentDateLayout is the date format of printed purchase orders (UTC)
+const documentDateLayout = "2006-01-02"
+
+// toDocument flattens a purchase order into its printable form
+func toDocument(po *db.GetPurchaseOrderDetailRow, items []ItemResponse, total money.Money, now time.Time) *DocumentResponse {
+	lines := make([]DocumentLine, 0, len(items))
+	for _, item := range items {
+		lines = append(lines, DocumentLine{
+			LineNo:      item.LineNo,
+			SKU:         item.SKU,
+			Description: item.ProductName,
+			Quantity:    item.Quantity,
+			UnitPrice:   formatAmount(item.UnitPrice),
+			LineTotal:   formatAmount(item.LineTotal),
+		})
+	}
+
+	document := &DocumentResponse{
+		Title:     documentTitle,
+		PONumber:  po.PoNumber,
+		Revision:  po.Revision,
+		Status:    string(po.Status),
+		IssueDate: po.CreatedAt.UTC().Format(documentDateLayout),
+		Buyer: DocumentParty{
+			ID:    po.BuyerExternalID.String,
+			Name:  po.BuyerName,
+			Email: po.BuyerEmail,
+		},
+		Seller: DocumentParty{
+			ID:    po.SellerExternalID.String,
+			Name:  po.SellerName,
+			Email: po.SellerEmail,
+		},
+		Currency:     po.TokenSymbol,
+		PaymentTerms: paymentTerms(po.PaymentTermsDays),
+		Lines:        lines,
+		Total:        formatAmount(total),
+		Notes:        po.Notes.String,
+		OrderNumber:  po.OrderNumber.String,
+		GeneratedAt:  now,
+	}
+	if po.DecidedAt.Valid {
+		document.DecisionDate = po.DecidedAt.Time.UTC().Format(documentDateLayout)
+	}
+	return document
+}
+
+// paymentTerms describes the payment terms ("Net 30", "Due on receipt")
+func paymentTerms(days uint32) string {
+	if days == 0 {
+		return "Due on receipt"
+	}
+	return fmt.Sprintf("Net %d", days)
+}
+
+// formatAmount renders an amount with thousands separators (1234567.50 → 1,234,567.50)
+func formatAmount(amount money.Money) string {
+	value := amount.String()
+	sign := ""
+	if strings.HasPrefix(value, "-") {
+		sign, value = "-", value[1:]
+	}
+	integer, fraction, hasFraction := strings.Cut(value, ".")
+
+	var b strings.Builder
+	b.WriteString(sign)
+	for i, digit := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(digit)
+	}
+	if hasFraction {
+		b.WriteByte('.')
+		b.WriteString(fraction)
+	}
+	return b.String()
+}
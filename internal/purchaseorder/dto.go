@@ -0,0 +1,164 @@
+package purchaseorder
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/discount"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// ItemRequest represents a purchase order line referencing a catalog product
+type ItemRequest struct {
+	SKU       string `json:"sku" binding:"required,max=100" example:"SKU-WIDGET-001"`
+	Quantity  uint32 `json:"quantity" binding:"required,min=1,max=1000000" example:"10"`
+	UnitPrice string `json:"unit_price,omitempty" binding:"max=32" example:"125.00"` // omitted = current catalog price
+}
+
+// CreatePurchaseOrderRequest represents the request body for submitting a purchase order
+type CreatePurchaseOrderRequest struct {
+	BuyerID          string        `json:"buyer_id,omitempty" binding:"omitempty,uuid" example:"550e8400-e29b-41d4-a716-446655440000"` // omitted = caller
+	SellerID         string        `json:"seller_id" binding:"required,uuid" example:"550e8400-e29b-41d4-a716-446655440001"`
+	TokenSymbol      string        `json:"token_symbol,omitempty" binding:"max=10" example:"USDC"`
+	PaymentTermsDays uint32        `json:"payment_terms_days" binding:"max=365" example:"30"`
+	Notes            string        `json:"notes,omitempty" binding:"max=1000" example:"Deliver to warehouse B"`
+	CouponCode       string        `json:"coupon_code,omitempty" binding:"max=32" example:"SPRING10"` // seller's coupon, applied at acceptance
+	Items            []ItemRequest `json:"items" binding:"required,min=1,max=100,dive"`
+}
+
+// CounterPurchaseOrderRequest represents the request body for a counter-offer (replaces all lines)
+type CounterPurchaseOrderRequest struct {
+	Items            []ItemRequest `json:"items" binding:"required,min=1,max=100,dive"`
+	PaymentTermsDays *uint32       `json:"payment_terms_days,omitempty" binding:"omitempty,max=365" example:"45"` // omitted = unchanged
+}
+
+// RejectPurchaseOrderRequest represents the request body for rejecting a purchase order
+type RejectPurchaseOrderRequest struct {
+	Reason string `json:"reason" binding:"required,max=500" example:"Out of stock until next quarter"`
+}
+
+// ListPurchaseOrdersRequest represents query parameters for listing the caller's purchase orders
+type ListPurchaseOrdersRequest struct {
+	Status   string `form:"status" binding:"omitempty,oneof=SUBMITTED COUNTERED ACCEPTED REJECTED CANCELLED"`
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// ItemResponse represents a purchase order line
+type ItemResponse struct {
+	LineNo      uint32      `json:"line_no" example:"1"`
+	SKU         string      `json:"sku" example:"SKU-WIDGET-001"`
+	ProductName string      `json:"product_name" example:"Industrial widget"`
+	Quantity    uint32      `json:"quantity" example:"10"`
+	UnitPrice   money.Money `json:"unit_price" swaggertype:"string" example:"125.00"`
+	LineTotal   money.Money `json:"line_total" swaggertype:"string" example:"1250.00"`
+}
+
+// PurchaseOrderResponse represents a purchase order
+type PurchaseOrderResponse struct {
+	PurchaseOrderID  string                          `json:"purchase_order_id" example:"bb0e8400-e29b-41d4-a716-446655440000"`
+	PONumber         string                          `json:"po_number" example:"PO-20260901-0001"`
+	BuyerID          string                          `json:"buyer_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	SellerID         string                          `json:"seller_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Status           string                          `json:"status" example:"SUBMITTED" enums:"SUBMITTED,COUNTERED,ACCEPTED,REJECTED,CANCELLED"`
+	AwaitingParty    string                          `json:"awaiting_party,omitempty" example:"SELLER" enums:"BUYER,SELLER"` // side that must respond next
+	Revision         uint32                          `json:"revision" example:"1"`
+	TokenSymbol      string                          `json:"token_symbol" example:"USDC"`
+	TotalAmount      money.Money                     `json:"total_amount" swaggertype:"string" example:"1250.00"` // before discounts and taxes
+	PaymentTermsDays uint32                          `json:"payment_terms_days" example:"30"`
+	Notes            string                          `json:"notes,omitempty" example:"Deliver to warehouse B"`
+	CouponCode       string                          `json:"coupon_code,omitempty" example:"SPRING10"`
+	RejectReason     string                          `json:"reject_reason,omitempty" example:"Out of stock until next quarter"`
+	OrderNumber      string                          `json:"order_number,omitempty" example:"ORD-20260902-0001"`
+	DiscountAmount   *money.Money                    `json:"discount_amount,omitempty" swaggertype:"string" example:"125.00"` // accepted PO only (omitted in lists)
+	Discounts        []discount.DiscountLineResponse `json:"discounts,omitempty"`
+	Items            []ItemResponse                  `json:"items,omitempty"`
+	DecidedAt        *time.Time                      `json:"decided_at,omitempty"`
+	CreatedAt        time.Time                       `json:"created_at"`
+	UpdatedAt        time.Time                       `json:"updated_at"`
+}
+
+// ListPurchaseOrdersResponse represents paginated purchase order list
+type ListPurchaseOrdersResponse struct {
+	PurchaseOrders []PurchaseOrderResponse `json:"purchase_orders"`
+	Total          int64                   `json:"total" example:"42"`
+	Page           int                     `json:"page" example:"1"`
+	PageSize       int                     `json:"page_size" example:"20"`
+	TotalPages     int                     `json:"total_pages" example:"3"`
+}
+
+// DocumentParty represents the buyer or seller block of a purchase order document
+type DocumentParty struct {
+	ID    string `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name  string `json:"name" example:"Acme Trading"`
+	Email string `json:"email" example:"buyer@acme.example"`
+}
+
+// DocumentLine represents a printable purchase order line (amounts pre-formatted)
+type DocumentLine struct {
+	LineNo      uint32 `json:"line_no" example:"1"`
+	SKU         string `json:"sku" example:"SKU-WIDGET-001"`
+	Description string `json:"description" example:"Industrial widget"`
+	Quantity    uint32 `json:"quantity" example:"10"`
+	UnitPrice   string `json:"unit_price" example:"125.00"`
+	LineTotal   string `json:"line_total" example:"1,250.00"`
+}
+
+// DocumentResponse represents a flattened, print-ready purchase order for PDF rendering.
+// NOTE: 금액은 천 단위 구분 기호 포함 문자열, 일자는 YYYY-MM-DD (UTC) → 렌더러가 그대로 출력
+type DocumentResponse struct {
+	Title        string         `json:"title" example:"PURCHASE ORDER"`
+	PONumber     string         `json:"po_number" example:"PO-20260901-0001"`
+	Revision     uint32         `json:"revision" example:"1"`
+	Status       string         `json:"status" example:"ACCEPTED"`
+	IssueDate    string         `json:"issue_date" example:"2026-09-01"`
+	DecisionDate string         `json:"decision_date,omitempty" example:"2026-09-02"`
+	Buyer        DocumentParty  `json:"buyer"`
+	Seller       DocumentParty  `json:"seller"`
+	Currency     string         `json:"currency" example:"USDC"`
+	PaymentTerms string         `json:"payment_terms" example:"Net 30"`
+	Lines        []DocumentLine `json:"lines"`
+	Total        string         `json:"total" example:"1,250.00"`
+	Notes        string         `json:"notes,omitempty" example:"Deliver to warehouse B"`
+	OrderNumber  string         `json:"order_number,omitempty" example:"ORD-20260902-0001"`
+	GeneratedAt  time.Time      `json:"generated_at"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToPurchaseOrderResponse converts a purchase order with its parties to its response
+func ToPurchaseOrderResponse(po *db.GetPurchaseOrderDetailRow, total money.Money, items []ItemResponse) *PurchaseOrderResponse {
+	response := &PurchaseOrderResponse{
+		PurchaseOrderID:  po.ExternalID,
+		PONumber:         po.PoNumber,
+		BuyerID:          po.BuyerExternalID.String,
+		SellerID:         po.SellerExternalID.String,
+		Status:           string(po.Status),
+		AwaitingParty:    awaitingParty(po.Status),
+		Revision:         po.Revision,
+		TokenSymbol:      po.TokenSymbol,
+		TotalAmount:      total,
+		PaymentTermsDays: po.PaymentTermsDays,
+		Notes:            po.Notes.String,
+		CouponCode:       po.CouponCode.String,
+		RejectReason:     po.RejectReason.String,
+		OrderNumber:      po.OrderNumber.String,
+		Items:            items,
+		CreatedAt:        po.CreatedAt,
+		UpdatedAt:        po.UpdatedAt,
+	}
+	if po.DecidedAt.Valid {
+		response.DecidedAt = &po.DecidedAt.Time
+	}
+	return response
+}
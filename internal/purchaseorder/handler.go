@@ -0,0 +1,369 @@
+package purchaseorder
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for purchase order operations
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new purchase order handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers purchase order routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	purchaseOrders := rg.Group("/purchase-orders", middleware.RequireAuth())
+	{
+		purchaseOrders.POST("", h.CreatePurchaseOrder)
+		purchaseOrders.GET("", h.ListPurchaseOrders)
+		purchaseOrders.GET("/:purchaseOrderId", h.GetPurchaseOrder)
+		purchaseOrders.GET("/:purchaseOrderId/document", h.GetDocument)
+		purchaseOrders.POST("/:purchaseOrderId/accept", h.AcceptPurchaseOrder)
+		purchaseOrders.POST("/:purchaseOrderId/reject", h.RejectPurchaseOrder)
+		purchaseOrders.POST("/:purchaseOrderId/counter", h.CounterPurchaseOrder)
+		purchaseOrders.POST("/:purchaseOrderId/cancel", h.CancelPurchaseOrder)
+	}
+}
+
+// extractAndValidatePurchaseOrderID extracts and validates purchaseOrderId from path
+func extractAndValidatePurchaseOrderID(c *gin.Context) (string, error) {
+	purchaseOrderID := c.Param("purchaseOrderId")
+	if _, err := uuid.Parse(purchaseOrderID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+	return purchaseOrderID, nil
+}
+
+// CreatePurchaseOrder godoc
+// @Summary Submit purchase order
+// @Description Submit a purchase order to a seller. Lines reference catalog products by SKU; unit_price defaults to the current catalog price.
+// @Description The PO is numbered PO-YYYYMMDD-NNNN and awaits the seller's response (SUBMITTED).
+// @Description coupon_code must be an active coupon of the seller whose conditions the lines meet; it is redeemed on acceptance.
+// @Tags purchase-orders
+// @Accept json
+// @Produce json
+// @Param request body CreatePurchaseOrderRequest true "Seller, lines and payment terms"
+// @Success 201 {object} middleware.SuccessResponse{data=PurchaseOrderResponse} "Purchase order submitted"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input, unknown or inactive SKU, or unsupported token"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot submit for another user"
+// @Failure 404 {object} middleware.ErrorResponse "Buyer or seller not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/purchase-orders [post]
+func (h *Handler) CreatePurchaseOrder(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req CreatePurchaseOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+	if req.BuyerID == "" {
+		req.BuyerID = principal.UserExternalID
+	}
+
+	result, err := h.service.CreatePurchaseOrder(c.Request.Context(), &req, purchaseOrderAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// ListPurchaseOrders godoc
+// @Summary List purchase orders
+// @Description Get paginated purchase orders the caller placed or received, newest first, with an optional status filter (lines omitted)
+// @Tags purchase-orders
+// @Produce json
+// @Param status query string false "Status filter" Enums(SUBMITTED, COUNTERED, ACCEPTED, REJECTED, CANCELLED)
+// @Param page query int false "Page number" default(1) minimum(1)
+// @Param page_size query int false "Page size" default(20) minimum(1) maximum(100)
+// @Success 200 {object} middleware.SuccessResponse{data=ListPurchaseOrdersResponse} "Purchase orders"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/purchase-orders [get]
+func (h *Handler) ListPurchaseOrders(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req ListPurchaseOrdersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListPurchaseOrders(c.Request.Context(), principal.UserID, &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// GetPurchaseOrder godoc
+// @Summary Get purchase order
+// @Description Get a purchase order the caller placed or received, with its current lines and, once accepted, the order's discounts
+// @Tags purchase-orders
+// @Produce json
+// @Param purchaseOrderId path string true "Purchase order ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=PurchaseOrderResponse} "Purchase order"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Purchase order not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/purchase-orders/{purchaseOrderId} [get]
+func (h *Handler) GetPurchaseOrder(c *gin.Context) {
+	purchaseOrderID, err := extractAndValidatePurchaseOrderID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	result, err := h.service.GetPurchaseOrder(c.Request.Context(), purchaseOrderID, purchaseOrderAccess(principal))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// GetDocument godoc
+// @Summary Get purchase order document
+// @Description Get a purchase order flattened for printing or PDF rendering: party blocks, numbered lines with line totals,
+// @Description amounts pre-formatted with thousands separators and dates as YYYY-MM-DD (UTC)
+// @Tags purchase-orders
+// @Produce json
+// @Param purchaseOrderId path string true "Purchase order ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=DocumentResponse} "Purchase order document"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Purchase order not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/purchase-orders/{purchaseOrderId}/document [get]
+func (h *Handler) GetDocument(c *gin.Context) {
+	purchaseOrderID, err := extractAndValidatePurchaseOrderID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	result, err := h.service.GetDocument(c.Request.Context(), purchaseOrderID, purchaseOrderAccess(principal))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// AcceptPurchaseOrder godoc
+// @Summary Accept purchase order
+// @Description Accept the current terms and convert the purchase order into a CONFIRMED order at the agreed prices.
+// @Description Only the awaiting party can accept: the seller for SUBMITTED, the buyer for COUNTERED.
+// @Description The order's payment due date is the acceptance time plus payment_terms_days.
+// @Description Volume tiers and the PO's coupon are applied, then taxes on the discounted lines: order total = PO total - discounts + taxes.
+// @Description Fails with 409 if the coupon has since been disabled or exhausted.
+// @Tags purchase-orders
+// @Produce json
+// @Param purchaseOrderId path string true "Purchase order ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=PurchaseOrderResponse} "Purchase order accepted (order_number set)"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Purchase order is awaiting the other party"
+// @Failure 404 {object} middleware.ErrorResponse "Purchase order not found"
+// @Failure 409 {object} middleware.ErrorResponse "Purchase order already decided or coupon no longer applicable"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/purchase-orders/{purchaseOrderId}/accept [post]
+func (h *Handler) AcceptPurchaseOrder(c *gin.Context) {
+	purchaseOrderID, err := extractAndValidatePurchaseOrderID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	result, err := h.service.AcceptPurchaseOrder(c.Request.Context(), purchaseOrderID, purchaseOrderAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// RejectPurchaseOrder godoc
+// @Summary Reject purchase order
+// @Description Reject the current terms with a reason; the purchase order is closed. Only the awaiting party can reject.
+// @Tags purchase-orders
+// @Accept json
+// @Produce json
+// @Param purchaseOrderId path string true "Purchase order ID (UUID)"
+// @Param request body RejectPurchaseOrderRequest true "Rejection reason"
+// @Success 200 {object} middleware.SuccessResponse{data=PurchaseOrderResponse} "Purchase order rejected"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Purchase order is awaiting the other party"
+// @Failure 404 {object} middleware.ErrorResponse "Purchase order not found"
+// @Failure 409 {object} middleware.ErrorResponse "Purchase order already decided"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/purchase-orders/{purchaseOrderId}/reject [post]
+func (h *Handler) RejectPurchaseOrder(c *gin.Context) {
+	purchaseOrderID, err := extractAndValidatePurchaseOrderID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req RejectPurchaseOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.RejectPurchaseOrder(c.Request.Context(), purchaseOrderID, &req, purchaseOrderAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// CounterPurchaseOrder godoc
+// @Summary Counter purchase order
+// @Description Replace the lines (and optionally the payment terms) with a counter-offer and hand the purchase order to the other party.
+// @Description The seller counters a SUBMITTED PO (→ COUNTERED); the buyer counters a COUNTERED PO back (→ SUBMITTED). Each counter bumps revision.
+// @Tags purchase-orders
+// @Accept json
+// @Produce json
+// @Param purchaseOrderId path string true "Purchase order ID (UUID)"
+// @Param request body CounterPurchaseOrderRequest true "Counter-offer lines and payment terms"
+// @Success 200 {object} middleware.SuccessResponse{data=PurchaseOrderResponse} "Counter-offer recorded"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input or unknown or inactive SKU"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Purchase order is awaiting the other party"
+// @Failure 404 {object} middleware.ErrorResponse "Purchase order not found"
+// @Failure 409 {object} middleware.ErrorResponse "Purchase order already decided"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/purchase-orders/{purchaseOrderId}/counter [post]
+func (h *Handler) CounterPurchaseOrder(c *gin.Context) {
+	purchaseOrderID, err := extractAndValidatePurchaseOrderID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req CounterPurchaseOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.CounterPurchaseOrder(c.Request.Context(), purchaseOrderID, &req, purchaseOrderAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// CancelPurchaseOrder godoc
+// @Summary Cancel purchase order
+// @Description Withdraw a purchase order that is still awaiting a response (SUBMITTED or COUNTERED). Only the buyer can cancel.
+// @Tags purchase-orders
+// @Produce json
+// @Param purchaseOrderId path string true "Purchase order ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=PurchaseOrderResponse} "Purchase order cancelled"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Not the buyer"
+// @Failure 404 {object} middleware.ErrorResponse "Purchase order not found"
+// @Failure 409 {object} middleware.ErrorResponse "Purchase order already decided"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/purchase-orders/{purchaseOrderId}/cancel [post]
+func (h *Handler) CancelPurchaseOrder(c *gin.Context) {
+	purchaseOrderID, err := extractAndValidatePurchaseOrderID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	result, err := h.service.CancelPurchaseOrder(c.Request.Context(), purchaseOrderID, purchaseOrderAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// purchaseOrderAccess maps the principal to the service's purchase order access check
+func purchaseOrderAccess(principal *middleware.Principal) Access {
+	return Access{
+		Admin:    principal.IsAdmin(),
+		CanActAs: principal.CanActAs,
+	}
+}
@@ -0,0 +1,792 @@
+package purchaseorder
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/catalog"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/docnumber"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/discount"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/tax"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// amountScale is the scale of purchase order and order amounts (DECIMAL(18,2))
+const amountScale = 2
+
+// Parties of a purchase order
+const (
+	PartyBuyer  = "BUYER"
+	PartySeller = "SELLER"
+)
+
+// Audit log identifiers
+const (
+	actionSubmitted       = "PURCHASE_ORDER_SUBMITTED"
+	actionCountered       = "PURCHASE_ORDER_COUNTERED"
+	actionAccepted        = "PURCHASE_ORDER_ACCEPTED"
+	actionRejected        = "PURCHASE_ORDER_REJECTED"
+	actionCancelled       = "PURCHASE_ORDER_CANCELLED"
+	resourcePurchaseOrder = "PURCHASE_ORDER"
+)
+
+// Access is the caller's access to purchase orders
+type Access struct {
+	// Admin may view any purchase order
+	Admin bool
+	// CanActAs reports whether the caller may operate on the user's resources (buyer/seller external ID)
+	CanActAs func(userExternalID string) bool
+}
+
+// canView reports whether the caller is the buyer, the seller or an admin
+func (a Access) canView(buyerID, sellerID string) bool {
+	return a.Admin || a.CanActAs(buyerID) || a.CanActAs(sellerID)
+}
+
+// line is a resolved purchase order line (catalog product + agreed price)
+type line struct {
+	product   db.Product
+	quantity  uint32
+	unitPrice money.Money
+}
+
+// Service handles purchase orders.
+// The buyer submits a PO for catalog products; the seller accepts, rejects or counters, and each counter-offer
+// hands the PO back to the other side. An accepted PO becomes an order at the agreed (locked) prices.
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	tokens   *chain.TokenRegistry
+	taxes    *tax.Engine
+	logger   *zap.Logger
+}
+
+// NewService creates a new purchase order service
+func NewService(txRunner *pkgdb.TxRunner, tokens *chain.TokenRegistry, taxes *tax.Engine, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		tokens:   tokens,
+		taxes:    taxes,
+		logger:   logger,
+	}
+}
+
+// CreatePurchaseOrder submits a purchase order to the seller (buyer or admin).
+// Lines reference catalog products by SKU; a line without unit_price takes the current catalog price.
+// A coupon code must be a valid coupon of the seller for the lines; it is redeemed when the PO is accepted.
+func (s *Service) CreatePurchaseOrder(ctx context.Context, req *CreatePurchaseOrderRequest, access Access, actor audit.Actor) (*PurchaseOrderResponse, error) {
+	// 1. Validate
+	if !access.CanActAs(req.BuyerID) {
+		return nil, errors.Forbidden("Cannot submit purchase orders for another user")
+	}
+	if req.BuyerID == req.SellerID {
+		return nil, errors.InvalidInput("seller_id must differ from the buyer")
+	}
+	token, err := s.tokens.Resolve(req.TokenSymbol, 0)
+	if err != nil {
+		return nil, errors.InvalidInput("unsupported token_symbol")
+	}
+
+	q := s.txRunner.Queries()
+	buyer, err := s.getUser(ctx, q, req.BuyerID, "Buyer")
+	if err != nil {
+		return nil, err
+	}
+	seller, err := s.getUser(ctx, q, req.SellerID, "Seller")
+	if err != nil {
+		return nil, err
+	}
+	if seller.Status != db.UsersStatusACTIVE {
+		return nil, errors.InvalidInput("seller is not active")
+	}
+
+	couponCode := discount.NormalizeCode(req.CouponCode)
+
+	// 2. Create PO + lines (one transaction)
+	externalID := uuid.New().String()
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		lines, total, err := s.resolveLines(ctx, q, req.Items)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		if couponCode != "" {
+			if _, err := discount.Calculate(ctx, q, seller.ID, buyer.ID, discountLines(lines), couponCode, now); err != nil {
+				return couponError(err, errors.InvalidInput)
+			}
+		}
+		poNumber, err := docnumber.Next(ctx, q, docnumber.PrefixPurchaseOrder, now)
+		if err != nil {
+			return err
+		}
+		result, err := q.CreatePurchaseOrder(ctx, db.CreatePurchaseOrderParams{
+			ExternalID:       externalID,
+			PoNumber:         poNumber,
+			BuyerID:          buyer.ID,
+			SellerID:         seller.ID,
+			TokenSymbol:      token.Symbol,
+			TotalAmount:      total.String(),
+			PaymentTermsDays: req.PaymentTermsDays,
+			Notes:            sql.NullString{String: req.Notes, Valid: req.Notes != ""},
+			CouponCode:       sql.NullString{String: couponCode, Valid: couponCode != ""},
+		})
+		if err != nil {
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		if err := createLines(ctx, q, uint64(id), lines); err != nil {
+			return err
+		}
+
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionSubmitted,
+			ResourceType: resourcePurchaseOrder,
+			ResourceID:   uint64(id),
+			NewValue: map[string]any{
+				"po_number":          poNumber,
+				"seller_id":          req.SellerID,
+				"token_symbol":       token.Symbol,
+				"total_amount":       total.String(),
+				"payment_terms_days": req.PaymentTermsDays,
+				"coupon_code":        couponCode,
+				"lines":              len(lines),
+			},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to create purchase order", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetPurchaseOrder(ctx, externalID, access)
+}
+
+// GetPurchaseOrder returns a purchase order with its lines and, once accepted, the order's discounts (buyer, seller or admin)
+func (s *Service) GetPurchaseOrder(ctx context.Context, externalID string, access Access) (*PurchaseOrderResponse, error) {
+	q := s.txRunner.Queries()
+	detail, err := s.getDetail(ctx, q, externalID, access)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.listItems(ctx, q, detail.ID)
+	if err != nil {
+		return nil, err
+	}
+	response, err := s.toResponse(ctx, detail, items)
+	if err != nil {
+		return nil, err
+	}
+	if !detail.OrderID.Valid {
+		return response, nil
+	}
+
+	discounts, err := q.ListOrderDiscounts(ctx, uint64(detail.OrderID.Int64))
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list order discounts", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	lines, total, err := discount.ToDiscountLineResponses(discounts)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("invalid stored order discount", zap.Error(err))
+		return nil, errors.Internal("Invalid stored order discount")
+	}
+	response.Discounts = lines
+	response.DiscountAmount = &total
+	return response, nil
+}
+
+// ListPurchaseOrders returns the purchase orders the user placed or received, newest first
+func (s *Service) ListPurchaseOrders(ctx context.Context, userID uint64, req *ListPurchaseOrdersRequest) (*ListPurchaseOrdersResponse, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	var status db.NullPurchaseOrdersStatus
+	if req.Status != "" {
+		status = db.NullPurchaseOrdersStatus{PurchaseOrdersStatus: db.PurchaseOrdersStatus(req.Status), Valid: true}
+	}
+
+	q := s.txRunner.Queries()
+	rows, err := q.ListPurchaseOrdersByUser(ctx, db.ListPurchaseOrdersByUserParams{
+		UserID: userID,
+		Status: status,
+		Limit:  int32(req.PageSize),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list purchase orders", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := q.CountPurchaseOrdersByUser(ctx, db.CountPurchaseOrdersByUserParams{
+		UserID: userID,
+		Status: status,
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count purchase orders", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	purchaseOrders := make([]PurchaseOrderResponse, 0, len(rows))
+	for i := range rows {
+		detail := db.GetPurchaseOrderDetailRow(rows[i])
+		response, err := s.toResponse(ctx, &detail, nil)
+		if err != nil {
+			return nil, err
+		}
+		purchaseOrders = append(purchaseOrders, *response)
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListPurchaseOrdersResponse{
+		PurchaseOrders: purchaseOrders,
+		Total:          total,
+		Page:           req.Page,
+		PageSize:       req.PageSize,
+		TotalPages:     totalPages,
+	}, nil
+}
+
+// AcceptPurchaseOrder accepts the current terms (the awaiting party) and converts the PO into an order.
+//
+// Why:
+//   - 발주 row-lock 후 상태 확인 → 동시 수락/역제안/취소가 겹쳐도 한 번만 결정
+//   - 주문은 발주 항목 단가 그대로 생성 (이후 카탈로그 가격 변경과 무관하게 가격 고정)
+//   - 결제 기한 = 수락 시각 + payment_terms_days (0 = 기한 없음)
+//   - 할인은 수락 시점 수량 구간 + 발주 쿠폰 (쿠폰 만료는 발주 제출 시각 기준, 사용 한도는 수락 시점 기준)
+//   - 세금은 수락 시점 구매자 과세 국가 기준, 할인 후 항목 금액에 산정 → 주문 금액 = 발주 금액 - 할인 + 세금
+func (s *Service) AcceptPurchaseOrder(ctx context.Context, externalID string, access Access, actor audit.Actor) (*PurchaseOrderResponse, error) {
+	detail, err := s.getDetail(ctx, s.txRunner.Queries(), externalID, access)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		po, err := lockAwaiting(ctx, q, detail, access)
+		if err != nil {
+			return err
+		}
+		items, err := q.ListPurchaseOrderItems(ctx, po.ID)
+		if err != nil {
+			return err
+		}
+		total, err := s.parseStoredAmount(ctx, po.TotalAmount)
+		if err != nil {
+			return err
+		}
+		lines, err := s.orderLines(ctx, items)
+		if err != nil {
+			return err
+		}
+		discounts, err := discount.Calculate(ctx, q, po.SellerID, po.BuyerID, lines, po.CouponCode.String, po.CreatedAt)
+		if err != nil {
+			return couponError(err, errors.Conflict)
+		}
+		taxes, err := s.calculateTax(ctx, q, po, lines, discounts)
+		if err != nil {
+			return err
+		}
+		total = total.Sub(discounts.Total).Add(taxes.Total)
+
+		now := time.Now().UTC()
+		orderNumber, err := docnumber.Next(ctx, q, docnumber.PrefixOrder, now)
+		if err != nil {
+			return err
+		}
+		var paymentDueAt sql.NullTime
+		if po.PaymentTermsDays > 0 {
+			paymentDueAt = sql.NullTime{Time: now.AddDate(0, 0, int(po.PaymentTermsDays)), Valid: true}
+		}
+		result, err := q.CreateOrder(ctx, db.CreateOrderParams{
+			OrderNumber:  orderNumber,
+			BuyerID:      po.BuyerID,
+			SellerID:     po.SellerID,
+			Status:       db.OrdersStatusCONFIRMED,
+			TotalAmount:  total.String(),
+			TokenSymbol:  po.TokenSymbol,
+			PaymentDueAt: paymentDueAt,
+		})
+		if err != nil {
+			return err
+		}
+		orderID, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if err := q.CreateOrderItem(ctx, db.CreateOrderItemParams{
+				OrderID:   uint64(orderID),
+				ProductID: item.ProductID,
+				Quantity:  item.Quantity,
+				UnitPrice: item.UnitPrice,
+			}); err != nil {
+				return err
+			}
+		}
+		if err := discount.Apply(ctx, q, uint64(orderID), po.BuyerID, discounts); err != nil {
+			return couponError(err, errors.Conflict)
+		}
+		if err := tax.ApplyToOrder(ctx, q, uint64(orderID), taxes); err != nil {
+			return err
+		}
+
+		if err := q.DecidePurchaseOrder(ctx, db.DecidePurchaseOrderParams{
+			Status:  db.PurchaseOrdersStatusACCEPTED,
+			OrderID: sql.NullInt64{Int64: orderID, Valid: true},
+			ID:      po.ID,
+		}); err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionAccepted,
+			ResourceType: resourcePurchaseOrder,
+			ResourceID:   po.ID,
+			OldValue:     map[string]any{"status": string(po.Status), "revision": po.Revision},
+			NewValue: map[string]any{
+				"status":          string(db.PurchaseOrdersStatusACCEPTED),
+				"order_number":    orderNumber,
+				"total_amount":    total.String(),
+				"discount_amount": discounts.Total.String(),
+				"tax_amount":      taxes.Total.String(),
+			},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to accept purchase order", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("purchase order accepted", zap.String("po_number", detail.PoNumber))
+	return s.GetPurchaseOrder(ctx, externalID, access)
+}
+
+// RejectPurchaseOrder rejects the current terms (the awaiting party); a rejected PO is final
+func (s *Service) RejectPurchaseOrder(ctx context.Context, externalID string, req *RejectPurchaseOrderRequest, access Access, actor audit.Actor) (*PurchaseOrderResponse, error) {
+	detail, err := s.getDetail(ctx, s.txRunner.Queries(), externalID, access)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		po, err := lockAwaiting(ctx, q, detail, access)
+		if err != nil {
+			return err
+		}
+		if err := q.DecidePurchaseOrder(ctx, db.DecidePurchaseOrderParams{
+			Status:       db.PurchaseOrdersStatusREJECTED,
+			RejectReason: sql.NullString{String: req.Reason, Valid: true},
+			ID:           po.ID,
+		}); err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionRejected,
+			ResourceType: resourcePurchaseOrder,
+			ResourceID:   po.ID,
+			OldValue:     map[string]any{"status": string(po.Status), "revision": po.Revision},
+			NewValue:     map[string]any{"status": string(db.PurchaseOrdersStatusREJECTED), "reason": req.Reason},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to reject purchase order", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetPurchaseOrder(ctx, externalID, access)
+}
+
+// CounterPurchaseOrder replaces the terms with a counter-offer (the awaiting party) and hands the PO to the other side.
+// The seller counters a SUBMITTED PO (→ COUNTERED); the buyer counters a COUNTERED PO back (→ SUBMITTED).
+func (s *Service) CounterPurchaseOrder(ctx context.Context, externalID string, req *CounterPurchaseOrderRequest, access Access, actor audit.Actor) (*PurchaseOrderResponse, error) {
+	detail, err := s.getDetail(ctx, s.txRunner.Queries(), externalID, access)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		po, err := lockAwaiting(ctx, q, detail, access)
+		if err != nil {
+			return err
+		}
+		lines, total, err := s.resolveLines(ctx, q, req.Items)
+		if err != nil {
+			return err
+		}
+		// NOTE: 역제안 조건도 발주 쿠폰 조건(최소 주문 금액 등)을 만족해야 함 → 수락 단계에서 막히는 발주 방지
+		if po.CouponCode.Valid {
+			if _, err := discount.Calculate(ctx, q, po.SellerID, po.BuyerID, discountLines(lines), po.CouponCode.String, po.CreatedAt); err != nil {
+				return couponError(err, errors.InvalidInput)
+			}
+		}
+
+		next := db.PurchaseOrdersStatusCOUNTERED
+		if po.Status == db.PurchaseOrdersStatusCOUNTERED {
+			next = db.PurchaseOrdersStatusSUBMITTED
+		}
+		paymentTermsDays := po.PaymentTermsDays
+		if req.PaymentTermsDays != nil {
+			paymentTermsDays = *req.PaymentTermsDays
+		}
+
+		if err := q.DeletePurchaseOrderItems(ctx, po.ID); err != nil {
+			return err
+		}
+		if err := createLines(ctx, q, po.ID, lines); err != nil {
+			return err
+		}
+		if err := q.UpdatePurchaseOrderTerms(ctx, db.UpdatePurchaseOrderTermsParams{
+			Status:           next,
+			TotalAmount:      total.String(),
+			PaymentTermsDays: paymentTermsDays,
+			ID:               po.ID,
+		}); err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionCountered,
+			ResourceType: resourcePurchaseOrder,
+			ResourceID:   po.ID,
+			OldValue: map[string]any{
+				"status":             string(po.Status),
+				"revision":           po.Revision,
+				"total_amount":       po.TotalAmount,
+				"payment_terms_days": po.PaymentTermsDays,
+			},
+			NewValue: map[string]any{
+				"status":             string(next),
+				"revision":           po.Revision + 1,
+				"total_amount":       total.String(),
+				"payment_terms_days": paymentTermsDays,
+				"lines":              len(lines),
+			},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to counter purchase order", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetPurchaseOrder(ctx, externalID, access)
+}
+
+// CancelPurchaseOrder withdraws an undecided purchase order (buyer or admin)
+func (s *Service) CancelPurchaseOrder(ctx context.Context, externalID string, access Access, actor audit.Actor) (*PurchaseOrderResponse, error) {
+	detail, err := s.getDetail(ctx, s.txRunner.Queries(), externalID, access)
+	if err != nil {
+		return nil, err
+	}
+	if !access.CanActAs(detail.BuyerExternalID.String) {
+		return nil, errors.Forbidden("Only the buyer can cancel a purchase order")
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		po, err := lockUndecided(ctx, q, externalID)
+		if err != nil {
+			return err
+		}
+		if err := q.DecidePurchaseOrder(ctx, db.DecidePurchaseOrderParams{
+			Status: db.PurchaseOrdersStatusCANCELLED,
+			ID:     po.ID,
+		}); err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionCancelled,
+			ResourceType: resourcePurchaseOrder,
+			ResourceID:   po.ID,
+			OldValue:     map[string]any{"status": string(po.Status), "revision": po.Revision},
+			NewValue:     map[string]any{"status": string(db.PurchaseOrdersStatusCANCELLED)},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to cancel purchase order", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetPurchaseOrder(ctx, externalID, access)
+}
+
+// GetDocument returns the purchase order flattened for PDF rendering (buyer, seller or admin)
+func (s *Service) GetDocument(ctx context.Context, externalID string, access Access) (*DocumentResponse, error) {
+	q := s.txRunner.Queries()
+	detail, err := s.getDetail(ctx, q, externalID, access)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.listItems(ctx, q, detail.ID)
+	if err != nil {
+		return nil, err
+	}
+	total, err := s.parseStoredAmount(ctx, detail.TotalAmount)
+	if err != nil {
+		return nil, err
+	}
+	return toDocument(detail, items, total, time.Now().UTC()), nil
+}
+
+// resolveLines looks up the catalog products of the requested lines and prices them
+func (s *Service) resolveLines(ctx context.Context, q *db.Queries, items []ItemRequest) ([]line, money.Money, error) {
+	lines := make([]line, 0, len(items))
+	total := money.Zero("", amountScale)
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		if seen[item.SKU] {
+			return nil, money.Money{}, errors.InvalidInput("duplicate sku: " + item.SKU)
+		}
+		seen[item.SKU] = true
+
+		product, err := q.GetProductBySKU(ctx, item.SKU)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, money.Money{}, errors.InvalidInput("unknown sku: " + item.SKU)
+			}
+			return nil, money.Money{}, err
+		}
+		if product.Status != db.ProductsStatusACTIVE {
+			return nil, money.Money{}, errors.InvalidInput("product is not active: " + item.SKU)
+		}
+		if err := catalog.CheckSellable(ctx, q, &product); err != nil {
+			return nil, money.Money{}, err
+		}
+
+		var unitPrice money.Money
+		if item.UnitPrice != "" {
+			unitPrice, err = money.Parse(item.UnitPrice, "", amountScale)
+			if err != nil || unitPrice.Sign() < 0 {
+				return nil, money.Money{}, errors.InvalidInput("unit_price must be a non-negative decimal with at most 2 decimals")
+			}
+		} else {
+			unitPrice, err = s.parseStoredAmount(ctx, product.Price)
+			if err != nil {
+				return nil, money.Money{}, err
+			}
+		}
+
+		lines = append(lines, line{product: product, quantity: item.Quantity, unitPrice: unitPrice})
+		total = total.Add(lineTotal(unitPrice, item.Quantity))
+	}
+	if total.Sign() <= 0 {
+		return nil, money.Money{}, errors.InvalidInput("purchase order total must be positive")
+	}
+	return lines, total, nil
+}
+
+// orderLines prices the lines of the order created from the purchase order (line_no = order item position)
+func (s *Service) orderLines(ctx context.Context, items []db.ListPurchaseOrderItemsRow) ([]discount.Line, error) {
+	lines := make([]discount.Line, 0, len(items))
+	for i, item := range items {
+		unitPrice, err := s.parseStoredAmount(ctx, item.UnitPrice)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, discount.Line{
+			LineNo:    uint32(i + 1),
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+			Amount:    lineTotal(unitPrice, item.Quantity),
+		})
+	}
+	return lines, nil
+}
+
+// calculateTax computes the taxes of the order's lines on their discounted amounts
+func (s *Service) calculateTax(ctx context.Context, q *db.Queries, po *db.PurchaseOrder, lines []discount.Line, discounts *discount.Result) (*tax.Result, error) {
+	taxLines := make([]tax.Line, 0, len(lines))
+	for _, l := range lines {
+		taxLines = append(taxLines, tax.Line{
+			LineNo:    l.LineNo,
+			ProductID: l.ProductID,
+			Amount:    discounts.Net(l.LineNo),
+		})
+	}
+	return s.taxes.Calculate(ctx, q, po.BuyerID, po.TokenSymbol, taxLines)
+}
+
+// getUser retrieves a party of a purchase order by external ID
+func (s *Service) getUser(ctx context.Context, q *db.Queries, externalID, resource string) (*db.User, error) {
+	user, err := q.GetUserByExternalID(ctx, sql.NullString{String: externalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound(resource)
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &user, nil
+}
+
+// getDetail retrieves a purchase order with its parties if the caller may see it
+func (s *Service) getDetail(ctx context.Context, q *db.Queries, externalID string, access Access) (*db.GetPurchaseOrderDetailRow, error) {
+	detail, err := q.GetPurchaseOrderDetail(ctx, externalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Purchase order")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get purchase order", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if !access.canView(detail.BuyerExternalID.String, detail.SellerExternalID.String) {
+		return nil, errors.NotFound("Purchase order")
+	}
+	return &detail, nil
+}
+
+// listItems returns the lines of a purchase order with their line totals
+func (s *Service) listItems(ctx context.Context, q *db.Queries, purchaseOrderID uint64) ([]ItemResponse, error) {
+	rows, err := q.ListPurchaseOrderItems(ctx, purchaseOrderID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list purchase order items", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	items := make([]ItemResponse, 0, len(rows))
+	for _, row := range rows {
+		unitPrice, err := s.parseStoredAmount(ctx, row.UnitPrice)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, ItemResponse{
+			LineNo:      row.LineNo,
+			SKU:         row.Sku,
+			ProductName: row.ProductName,
+			Quantity:    row.Quantity,
+			UnitPrice:   unitPrice,
+			LineTotal:   lineTotal(unitPrice, row.Quantity),
+		})
+	}
+	return items, nil
+}
+
+// toResponse parses the stored total and converts the purchase order
+func (s *Service) toResponse(ctx context.Context, detail *db.GetPurchaseOrderDetailRow, items []ItemResponse) (*PurchaseOrderResponse, error) {
+	total, err := s.parseStoredAmount(ctx, detail.TotalAmount)
+	if err != nil {
+		return nil, err
+	}
+	return ToPurchaseOrderResponse(detail, total, items), nil
+}
+
+// parseStoredAmount parses a DECIMAL(18,2) column
+func (s *Service) parseStoredAmount(ctx context.Context, value string) (money.Money, error) {
+	amount, err := money.Parse(value, "", amountScale)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("invalid stored amount", zap.String("amount", value), zap.Error(err))
+		return money.Money{}, errors.Internal("Invalid stored amount")
+	}
+	return amount, nil
+}
+
+// lockAwaiting locks an undecided purchase order and checks that the caller is the party it awaits
+func lockAwaiting(ctx context.Context, q *db.Queries, detail *db.GetPurchaseOrderDetailRow, access Access) (*db.PurchaseOrder, error) {
+	po, err := lockUndecided(ctx, q, detail.ExternalID)
+	if err != nil {
+		return nil, err
+	}
+	awaiting := detail.SellerExternalID.String
+	if awaitingParty(po.Status) == PartyBuyer {
+		awaiting = detail.BuyerExternalID.String
+	}
+	if !access.CanActAs(awaiting) {
+		return nil, errors.Forbidden("Purchase order is awaiting a response from the " + awaitingParty(po.Status))
+	}
+	return po, nil
+}
+
+// lockUndecided locks a purchase order that is still awaiting a response
+func lockUndecided(ctx context.Context, q *db.Queries, externalID string) (*db.PurchaseOrder, error) {
+	po, err := q.GetPurchaseOrderForUpdate(ctx, externalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Purchase order")
+		}
+		return nil, err
+	}
+	if awaitingParty(po.Status) == "" {
+		return nil, errors.Conflict("Purchase order is already " + string(po.Status))
+	}
+	return &po, nil
+}
+
+// createLines inserts the lines of a purchase order numbered from 1
+func createLines(ctx context.Context, q *db.Queries, purchaseOrderID uint64, lines []line) error {
+	for i, l := range lines {
+		if err := q.CreatePurchaseOrderItem(ctx, db.CreatePurchaseOrderItemParams{
+			PurchaseOrderID: purchaseOrderID,
+			LineNo:          uint32(i + 1),
+			ProductID:       l.product.ID,
+			Quantity:        l.quantity,
+			UnitPrice:       l.unitPrice.String(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// discountLines converts resolved purchase order lines for discount calculation (line_no = position)
+func discountLines(lines []line) []discount.Line {
+	result := make([]discount.Line, 0, len(lines))
+	for i, l := range lines {
+		result = append(result, discount.Line{
+			LineNo:    uint32(i + 1),
+			ProductID: l.product.ID,
+			Quantity:  l.quantity,
+			Amount:    lineTotal(l.unitPrice, l.quantity),
+		})
+	}
+	return result
+}
+
+// couponError maps a coupon that cannot be applied to the caller's error (other errors pass through)
+func couponError(err error, toAppError func(message string) *errors.AppError) error {
+	var couponErr *discount.CouponError
+	if stderrors.As(err, &couponErr) {
+		return toAppError(couponErr.Reason)
+	}
+	return err
+}
+
+// awaitingParty returns the party that must respond to the purchase order ("" = decided)
+func awaitingParty(status db.PurchaseOrdersStatus) string {
+	switch status {
+	case db.PurchaseOrdersStatusSUBMITTED:
+		return PartySeller
+	case db.PurchaseOrdersStatusCOUNTERED:
+		return PartyBuyer
+	}
+	return ""
+}
+
+// lineTotal returns unit price × quantity
+func lineTotal(unitPrice money.Money, quantity uint32) money.Money {
+	return unitPrice.MulFrac(int64(quantity), 1, money.RoundDown)
+}
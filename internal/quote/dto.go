@@ -0,0 +1,59 @@
+package quote
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// CreateQuoteRequest represents a fiat price to convert into a stablecoin amount
+type CreateQuoteRequest struct {
+	// Currency is the ISO 4217 currency of the price (KRW, USD)
+	Currency string `json:"currency" binding:"required,len=3" example:"KRW"`
+	Amount   string `json:"amount" binding:"required" example:"1350000"`
+	// TokenSymbol is the settlement token (omit for the default token)
+	TokenSymbol string `json:"token_symbol,omitempty" binding:"omitempty,max=10" example:"USDC"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// QuoteResponse represents a fiat price converted at the current exchange rate
+type QuoteResponse struct {
+	Currency    string      `json:"currency" example:"KRW"`
+	Amount      money.Money `json:"amount" swaggertype:"string" example:"1350000"`
+	TokenSymbol string      `json:"token_symbol" example:"USDC"`
+	// TokenAmount is the order total in the token (rounded up to the order amount scale)
+	TokenAmount money.Money `json:"token_amount" swaggertype:"string" example:"972.00"`
+	// Rate is the USD price of one unit of Currency
+	Rate          string    `json:"rate" example:"0.00072"`
+	RateSource    string    `json:"rate_source" example:"chainlink"`
+	RateUpdatedAt time.Time `json:"rate_updated_at"`
+	QuotedAt      time.Time `json:"quoted_at"`
+	// ExpiresAt is when the token amount stops being honored (request a new quote after)
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToQuoteResponse converts a quote to its response
+func ToQuoteResponse(q *Quote) *QuoteResponse {
+	return &QuoteResponse{
+		Currency:      q.Currency,
+		Amount:        q.Amount,
+		TokenSymbol:   q.TokenSymbol,
+		TokenAmount:   q.TokenAmount,
+		Rate:          q.Rate.String(),
+		RateSource:    q.Rate.Source,
+		RateUpdatedAt: q.Rate.UpdatedAt,
+		QuotedAt:      q.QuotedAt,
+		ExpiresAt:     q.ExpiresAt,
+	}
+}
@@ -0,0 +1,56 @@
+package quote
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles HTTP requests for fiat price quotes
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new quote handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers quote routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	quotes := rg.Group("/quotes", middleware.RequireAuth())
+	{
+		quotes.POST("", h.CreateQuote)
+	}
+}
+
+// CreateQuote godoc
+// @Summary Quote a fiat price in a stablecoin
+// @Description Convert a KRW/USD price into a settlement token amount at the current exchange rate
+// @Description (Chainlink feed, falling back to configured rates). Tokens are treated as pegged 1:1 to USD.
+// @Description The token amount is rounded up to 2 decimals and honored until expires_at.
+// @Tags quotes
+// @Accept json
+// @Produce json
+// @Param request body CreateQuoteRequest true "Fiat price"
+// @Success 200 {object} middleware.SuccessResponse{data=QuoteResponse} "Quote"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid request or unsupported currency"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 503 {object} middleware.ErrorResponse "Exchange rate unavailable (RATE_UNAVAILABLE)"
+// @Security ApiKeyAuth
+// @Router /api/v1/quotes [post]
+func (h *Handler) CreateQuote(c *gin.Context) {
+	var req CreateQuoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	quote, err := h.service.CreateQuote(c.Request.Context(), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, ToQuoteResponse(quote))
+}
@@ -0,0 +1,170 @@
+package quote
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/oracle"
+	"go.uber.org/zap"
+)
+
+const (
+	// pegCurrency is the currency every settlement token is pegged to
+	// NOTE: 스테이블코인 1 = 1 USD 가정 (디페그 시 depeg.Monitor가 신규 결제 승인 중단)
+	pegCurrency = "USD"
+	// orderAmountDecimals is the scale of orders.total_amount and price_amount (DECIMAL(18,2))
+	orderAmountDecimals = 2
+	// maxRateDecimals is the scale of orders.fx_rate (DECIMAL(36,18))
+	maxRateDecimals = 18
+)
+
+// fiatDecimals maps the supported price currencies to their minor units (ISO 4217)
+var fiatDecimals = map[string]int{
+	"USD": 2,
+	"KRW": 0,
+}
+
+// CurrencyDecimals returns the minor units of a supported price currency
+func CurrencyDecimals(currency string) (int, bool) {
+	decimals, ok := fiatDecimals[currency]
+	return decimals, ok
+}
+
+// Config holds quoting settings
+type Config struct {
+	// TTL is how long a quoted token amount is honored
+	TTL time.Duration
+}
+
+// Quote is a fiat price converted into a settlement token amount at quote time
+type Quote struct {
+	Currency    string
+	Amount      money.Money
+	TokenSymbol string
+	// TokenAmount has orderAmountDecimals (orders.total_amount) or fewer
+	TokenAmount money.Money
+	Rate        *oracle.Rate
+	QuotedAt    time.Time
+	ExpiresAt   time.Time
+}
+
+// Service converts fiat-priced order totals into stablecoin amounts
+type Service struct {
+	oracle oracle.Oracle
+	tokens *chain.TokenRegistry
+	config Config
+	logger *zap.Logger
+}
+
+// NewService creates a new quote service
+func NewService(o oracle.Oracle, tokens *chain.TokenRegistry, config Config, logger *zap.Logger) *Service {
+	return &Service{
+		oracle: o,
+		tokens: tokens,
+		config: config,
+		logger: logger,
+	}
+}
+
+// CreateQuote converts the fiat amount at the current rate (rounded up to the order amount scale)
+func (s *Service) CreateQuote(ctx context.Context, req *CreateQuoteRequest) (*Quote, error) {
+	// 1. Validate
+	currency := strings.ToUpper(strings.TrimSpace(req.Currency))
+	decimals, ok := fiatDecimals[currency]
+	if !ok {
+		return nil, errors.InvalidInput("currency must be one of KRW, USD")
+	}
+	amount, err := money.Parse(req.Amount, currency, decimals)
+	if err != nil || amount.Sign() <= 0 {
+		return nil, errors.InvalidInput("amount must be a positive decimal within the currency's minor units")
+	}
+	token, err := s.tokens.Resolve(req.TokenSymbol, 0)
+	if err != nil {
+		return nil, errors.InvalidInput("unsupported token_symbol")
+	}
+
+	// 2. Rate (pegged token → USD rate of the currency)
+	pair := oracle.Pair{Base: currency, Quote: pegCurrency}
+	rate, err := s.oracle.Rate(ctx, pair)
+	if err != nil {
+		if stderrors.Is(err, oracle.ErrUnsupportedPair) {
+			return nil, errors.InvalidInput("no exchange rate source for " + pair.String())
+		}
+		logctx.From(ctx, s.logger).Warn("exchange rate unavailable", zap.String("pair", pair.String()), zap.Error(err))
+		return nil, errors.RateUnavailable(pair.String())
+	}
+
+	// 3. Convert
+	scale := min(token.Decimals, orderAmountDecimals)
+	tokenAmount := rate.Convert(amount.Units(), decimals, scale)
+	now := time.Now().UTC()
+
+	return &Quote{
+		Currency:    currency,
+		Amount:      amount,
+		TokenSymbol: token.Symbol,
+		TokenAmount: money.New(tokenAmount, token.Symbol, scale),
+		Rate:        rate,
+		QuotedAt:    now,
+		ExpiresAt:   now.Add(s.config.TTL),
+	}, nil
+}
+
+// Record stores the quote on a pending order: the token amount becomes the order total
+// and the rate is fixed for its payments, refunds and disputes.
+// Called by order creation inside its transaction.
+func Record(ctx context.Context, q *db.Queries, orderID uint64, quote *Quote, now time.Time) error {
+	if now.After(quote.ExpiresAt) {
+		return errors.Conflict("Quote has expired, request a new quote")
+	}
+
+	result, err := q.SetOrderFXQuote(ctx, db.SetOrderFXQuoteParams{
+		TotalAmount:   quote.TokenAmount.String(),
+		TokenSymbol:   quote.TokenSymbol,
+		PriceCurrency: sql.NullString{String: quote.Currency, Valid: true},
+		PriceAmount:   sql.NullString{String: quote.Amount.String(), Valid: true},
+		FxRate:        sql.NullString{String: storedRate(quote.Rate), Valid: true},
+		FxRateSource:  sql.NullString{String: quote.Rate.Source, Valid: true},
+		FxRateAt:      sql.NullTime{Time: quote.Rate.UpdatedAt, Valid: true},
+		QuotedAt:      sql.NullTime{Time: quote.QuotedAt, Valid: true},
+		ID:            orderID,
+	})
+	if err != nil {
+		return errors.DBError(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return errors.DBError(err)
+	}
+	if affected == 0 {
+		return errors.Conflict("Only pending orders can be quoted")
+	}
+	return nil
+}
+
+// StoredRate returns the quote's rate as stored in fx_rate columns
+func (q *Quote) StoredRate() string {
+	return storedRate(q.Rate)
+}
+
+// storedRate formats the rate with at most maxRateDecimals.
+// 금액 환산은 절사 전 환율로 수행, fx_rate 컬럼 scale 초과분만 절사
+func storedRate(rate *oracle.Rate) string {
+	if rate.Decimals <= maxRateDecimals {
+		return rate.String()
+	}
+	truncated := *rate
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(rate.Decimals-maxRateDecimals)), nil)
+	truncated.Price = new(big.Int).Quo(rate.Price, divisor)
+	truncated.Decimals = maxRateDecimals
+	return truncated.String()
+}
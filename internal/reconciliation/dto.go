@@ -0,0 +1,71 @@
+package reconciliation
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// ListDiscrepanciesRequest represents query parameters for listing treasury discrepancies (admin)
+type ListDiscrepanciesRequest struct {
+	// ExceedsTolerance filters alerted (true) or tolerated (false) discrepancies
+	ExceedsTolerance *bool `form:"exceeds_tolerance"`
+	Page             int   `form:"page,default=1" binding:"min=1"`
+	PageSize         int   `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// DiscrepancyResponse represents one reconciliation run whose treasury balances did not match.
+// drift = onchain_balance - ledger_balance (positive: funds missing from the ledger, negative: ledger exceeds on-chain funds)
+type DiscrepancyResponse struct {
+	ID               string      `json:"id" example:"3f2b1c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"`
+	AccountID        string      `json:"account_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Address          string      `json:"address" example:"0x742d35cc6634c0532925a3b844bc454e4438f44e"`
+	TokenSymbol      string      `json:"token_symbol" example:"USDC"`
+	LedgerBalance    money.Money `json:"ledger_balance" swaggertype:"string" example:"1050000.00000000"`
+	OnChainBalance   money.Money `json:"onchain_balance" swaggertype:"string" example:"1049875.50000000"`
+	Drift            money.Money `json:"drift" swaggertype:"string" example:"-124.50000000"`
+	Tolerance        money.Money `json:"tolerance" swaggertype:"string" example:"1.00000000"`
+	ExceedsTolerance bool        `json:"exceeds_tolerance" example:"true"`
+	// BlockNumber is the latest block when the balances were read
+	BlockNumber uint64    `json:"block_number" example:"19876543"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ListDiscrepanciesResponse represents paginated treasury discrepancy list
+type ListDiscrepanciesResponse struct {
+	Discrepancies []DiscrepancyResponse `json:"discrepancies"`
+	Total         int64                 `json:"total" example:"3"`
+	Page          int                   `json:"page" example:"1"`
+	PageSize      int                   `json:"page_size" example:"20"`
+	TotalPages    int                   `json:"total_pages" example:"1"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToDiscrepancyResponse converts a recorded discrepancy with its parsed amounts (ledger, on-chain, drift, tolerance)
+func ToDiscrepancyResponse(r *db.ListTreasuryReconciliationsRow, amounts [4]money.Money) DiscrepancyResponse {
+	return DiscrepancyResponse{
+		ID:               r.ExternalID,
+		AccountID:        r.AccountExternalID.String,
+		Address:          r.Address,
+		TokenSymbol:      r.TokenSymbol,
+		LedgerBalance:    amounts[0],
+		OnChainBalance:   amounts[1],
+		Drift:            amounts[2],
+		Tolerance:        amounts[3],
+		ExceedsTolerance: r.ExceedsTolerance,
+		BlockNumber:      r.BlockNumber,
+		CreatedAt:        r.CreatedAt,
+	}
+}
@@ -0,0 +1,57 @@
+package reconciliation
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles HTTP requests for treasury reconciliation
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new reconciliation handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers reconciliation routes on the router group (admin only)
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	rg.GET("/admin/reconciliations", middleware.RequireRoles(middleware.RoleAdmin), h.ListDiscrepancies)
+}
+
+// ListDiscrepancies godoc
+// @Summary List treasury discrepancies
+// @Description Get the reconciliation runs whose treasury ledger balance did not match the on-chain token balance
+// @Description of the treasury address (newest first) - Admin only.
+// @Description drift = onchain_balance - ledger_balance; runs with |drift| above the tolerance are alerted.
+// @Description Send Accept: text/csv to receive the same results (same filters) as CSV.
+// @Tags reconciliation
+// @Produce json,text/csv
+// @Param exceeds_tolerance query bool false "Filter alerted (true) or tolerated (false) discrepancies"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} middleware.SuccessResponse{data=ListDiscrepanciesResponse} "Discrepancy list"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid query parameters"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Admin role required"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/reconciliations [get]
+func (h *Handler) ListDiscrepancies(c *gin.Context) {
+	var req ListDiscrepanciesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListDiscrepancies(c.Request.Context(), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOKNegotiated(c, "treasury-discrepancies", result, result.Discrepancies)
+}
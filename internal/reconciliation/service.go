@@ -0,0 +1,85 @@
+package reconciliation
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"go.uber.org/zap"
+)
+
+// Service handles queries of recorded treasury discrepancies
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	logger   *zap.Logger
+}
+
+// NewService creates a new reconciliation service
+func NewService(txRunner *pkgdb.TxRunner, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		logger:   logger,
+	}
+}
+
+// ListDiscrepancies returns recorded treasury discrepancies (newest first)
+func (s *Service) ListDiscrepancies(ctx context.Context, req *ListDiscrepanciesRequest) (*ListDiscrepanciesResponse, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	var exceeds sql.NullBool
+	if req.ExceedsTolerance != nil {
+		exceeds = sql.NullBool{Bool: *req.ExceedsTolerance, Valid: true}
+	}
+
+	q := s.txRunner.Queries()
+	rows, err := q.ListTreasuryReconciliations(ctx, db.ListTreasuryReconciliationsParams{
+		ExceedsTolerance: exceeds,
+		Limit:            int32(req.PageSize),
+		Offset:           int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list treasury discrepancies", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := q.CountTreasuryReconciliations(ctx, exceeds)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count treasury discrepancies", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	discrepancies := make([]DiscrepancyResponse, 0, len(rows))
+	for i := range rows {
+		var amounts [4]money.Money
+		for j, value := range []string{rows[i].LedgerBalance, rows[i].OnchainBalance, rows[i].Drift, rows[i].Tolerance} {
+			amount, err := money.Parse(value, "", amountScale)
+			if err != nil {
+				logctx.From(ctx, s.logger).Error("invalid stored amount",
+					zap.String("reconciliation_external_id", rows[i].ExternalID),
+					zap.String("amount", value),
+					zap.Error(err),
+				)
+				return nil, errors.Internal("Invalid reconciliation amount")
+			}
+			amounts[j] = amount
+		}
+		discrepancies = append(discrepancies, ToDiscrepancyResponse(&rows[i], amounts))
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListDiscrepanciesResponse{
+		Discrepancies: discrepancies,
+		Total:         total,
+		Page:          req.Page,
+		PageSize:      req.PageSize,
+		TotalPages:    totalPages,
+	}, nil
+}
@@ -0,0 +1,208 @@
+package reconciliation
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/ledger"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// amountScale is the scale of ledger balances (DECIMAL(18,8))
+const amountScale = 8
+
+// WorkerConfig holds treasury reconciliation job settings
+type WorkerConfig struct {
+	// TreasuryAccountID is the external ID of the platform treasury ledger account
+	TreasuryAccountID string
+	// TokenSymbol is the settlement token held by the treasury address
+	TokenSymbol string
+	// Tolerance is the absolute drift tolerated before alerting
+	Tolerance money.Money
+	// Interval is the period of the reconciliation run
+	Interval time.Duration
+}
+
+// ParseTolerance parses a drift tolerance (non-negative settlement token amount, e.g. "1.00")
+func ParseTolerance(value string) (money.Money, error) {
+	tolerance, err := money.Parse(value, "", amountScale)
+	if err != nil {
+		return money.Money{}, err
+	}
+	if tolerance.Sign() < 0 {
+		return money.Money{}, fmt.Errorf("tolerance must not be negative: %s", value)
+	}
+	return tolerance, nil
+}
+
+// Report is the result of one reconciliation run
+type Report struct {
+	Address          string
+	BlockNumber      uint64
+	LedgerBalance    money.Money
+	OnChainBalance   money.Money
+	Drift            money.Money
+	ExceedsTolerance bool
+	// Recorded is set when a discrepancy was written to treasury_reconciliations
+	Recorded bool
+}
+
+// Worker compares the ledger balance of the platform treasury account with the on-chain settlement token
+// balance of the treasury address, records every discrepancy and alerts while the drift exceeds the tolerance.
+//
+// Why:
+// - 원장(ledger_entries 합계)이 on-chain 자산과 어긋나면 지급 불능/누락 입금을 조기에 발견해야 함
+// - 송금 브로드캐스트 ~ 원장 기록 사이의 일시적 차이는 tolerance로 흡수, 초과 시에만 알림
+// - 알림은 허용치 초과 진입 시와 초과 중 drift가 바뀔 때만 → 같은 불일치로 매 회차 알림 반복 방지
+type Worker struct {
+	txRunner    *pkgdb.TxRunner
+	chainClient chain.Client
+	config      WorkerConfig
+	logger      *zap.Logger
+
+	mu        sync.Mutex
+	alerting  bool
+	lastDrift money.Money
+}
+
+// NewWorker creates a new treasury reconciliation worker
+func NewWorker(txRunner *pkgdb.TxRunner, chainClient chain.Client, config WorkerConfig, logger *zap.Logger) *Worker {
+	return &Worker{
+		txRunner:    txRunner,
+		chainClient: chainClient,
+		config:      config,
+		logger:      logger,
+	}
+}
+
+// Run executes the reconciliation periodically until ctx is canceled
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	logctx.From(ctx, w.logger).Info("treasury reconciliation job started",
+		zap.String("treasury_account_id", w.config.TreasuryAccountID),
+		zap.Stringer("tolerance", w.config.Tolerance),
+		zap.Duration("interval", w.config.Interval),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logctx.From(ctx, w.logger).Info("treasury reconciliation job stopped")
+			return
+		case <-ticker.C:
+			if _, err := w.RunOnce(ctx); err != nil && ctx.Err() == nil {
+				logctx.From(ctx, w.logger).Error("treasury reconciliation run failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// RunOnce reconciles the treasury once: a non-zero drift is recorded, a drift beyond the tolerance alerted
+func (w *Worker) RunOnce(ctx context.Context) (*Report, error) {
+	q := w.txRunner.Queries()
+
+	// 1. Treasury ledger account and address
+	account, err := q.GetAccountByExternalID(ctx, sql.NullString{String: w.config.TreasuryAccountID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("get treasury account: %w", err)
+	}
+	wallet, err := q.GetActiveSystemWalletByType(ctx, db.SystemWalletsWalletTypeTREASURY)
+	if err != nil {
+		return nil, fmt.Errorf("get treasury wallet: %w", err)
+	}
+
+	// 2. Ledger balance (source of truth), then the on-chain balance
+	block, err := w.chainClient.LatestBlock(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get latest block: %w", err)
+	}
+	// NOTE: treasury는 항목이 가장 많은 계정 → 전체 합계 대신 스냅샷 + 이후 항목
+	balance, err := ledger.CurrentBalance(ctx, q, account.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get ledger balance: %w", err)
+	}
+	ledgerBalance := balance.Amount
+	units, err := w.chainClient.TokenBalance(ctx, wallet.Address)
+	if err != nil {
+		return nil, fmt.Errorf("get treasury token balance: %w", err)
+	}
+	// NOTE: 토큰 decimals > 8이면 원장 정밀도 이하 잔여분은 버림 (원장에 기록될 수 없는 금액)
+	onChainBalance := money.New(units, "", w.chainClient.TokenDecimals()).Rescale(amountScale, money.RoundDown)
+
+	// 3. Compare
+	drift := onChainBalance.Sub(ledgerBalance)
+	absDrift := drift
+	if absDrift.Sign() < 0 {
+		absDrift = absDrift.Neg()
+	}
+	report := &Report{
+		Address:          wallet.Address,
+		BlockNumber:      block,
+		LedgerBalance:    ledgerBalance,
+		OnChainBalance:   onChainBalance,
+		Drift:            drift,
+		ExceedsTolerance: absDrift.Cmp(w.config.Tolerance) > 0,
+	}
+
+	// 4. Record the discrepancy
+	if !drift.IsZero() {
+		if err := q.CreateTreasuryReconciliation(ctx, db.CreateTreasuryReconciliationParams{
+			ExternalID:       uuid.New().String(),
+			AccountID:        account.ID,
+			Address:          wallet.Address,
+			TokenSymbol:      w.config.TokenSymbol,
+			LedgerBalance:    ledgerBalance.String(),
+			OnchainBalance:   onChainBalance.String(),
+			Drift:            drift.String(),
+			Tolerance:        w.config.Tolerance.String(),
+			ExceedsTolerance: report.ExceedsTolerance,
+			BlockNumber:      block,
+		}); err != nil {
+			return report, fmt.Errorf("record discrepancy: %w", err)
+		}
+		report.Recorded = true
+	}
+
+	w.alert(ctx, report)
+	return report, nil
+}
+
+// alert logs the drift on entering (or changing while) beyond the tolerance and on recovery
+func (w *Worker) alert(ctx context.Context, report *Report) {
+	w.mu.Lock()
+	wasAlerting, previous := w.alerting, w.lastDrift
+	w.alerting, w.lastDrift = report.ExceedsTolerance, report.Drift
+	w.mu.Unlock()
+
+	fields := []zap.Field{
+		zap.String("treasury_account_id", w.config.TreasuryAccountID),
+		zap.String("address", report.Address),
+		zap.String("token_symbol", w.config.TokenSymbol),
+		zap.Uint64("block_number", report.BlockNumber),
+		zap.Stringer("ledger_balance", report.LedgerBalance),
+		zap.Stringer("onchain_balance", report.OnChainBalance),
+		zap.Stringer("drift", report.Drift),
+		zap.Stringer("tolerance", w.config.Tolerance),
+	}
+	switch {
+	case report.ExceedsTolerance:
+		if !wasAlerting || previous.Cmp(report.Drift) != 0 {
+			logctx.From(ctx, w.logger).Error("treasury drift exceeds tolerance", fields...)
+		}
+	case wasAlerting:
+		logctx.From(ctx, w.logger).Info("treasury drift back within tolerance", fields...)
+	case !report.Drift.IsZero():
+		logctx.From(ctx, w.logger).Warn("treasury drift within tolerance", fields...)
+	}
+}
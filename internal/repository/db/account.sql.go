@@ -57,6 +57,23 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (s
 	return q.db.ExecContext(ctx, createAccount, arg.AccountType, arg.OwnerID, arg.ExternalID)
 }
 
+const freezeAccount = `-- name: FreezeAccount :execresult
+UPDATE accounts
+SET frozen_at = NOW(), frozen_reason = ?, frozen_by = ?, updated_at = NOW()
+WHERE id = ? AND frozen_at IS NULL AND status != 'CLOSED'
+`
+
+type FreezeAccountParams struct {
+	FrozenReason sql.NullString `json:"frozen_reason"`
+	FrozenBy     sql.NullInt64  `json:"frozen_by"`
+	ID           uint64         `json:"id"`
+}
+
+// 계정 거래 동결 (관리자, 이미 동결된 계정은 영향 없음 - status와 독립)
+func (q *Queries) FreezeAccount(ctx context.Context, arg FreezeAccountParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, freezeAccount, arg.FrozenReason, arg.FrozenBy, arg.ID)
+}
+
 const getAccountBalance = `-- name: GetAccountBalance :one
 
 SELECT id, balance, hold_balance, version
@@ -88,7 +105,7 @@ func (q *Queries) GetAccountBalance(ctx context.Context, id uint64) (GetAccountB
 }
 
 const getAccountByExternalID = `-- name: GetAccountByExternalID :one
-SELECT id, account_type, owner_id, primary_wallet_id, external_id, balance, hold_balance, version, status, created_at, updated_at FROM accounts
+SELECT id, account_type, owner_id, primary_wallet_id, external_id, balance, hold_balance, version, status, frozen_at, frozen_reason, frozen_by, created_at, updated_at FROM accounts
 WHERE external_id = ? AND status != 'CLOSED'
 `
 
@@ -106,6 +123,9 @@ func (q *Queries) GetAccountByExternalID(ctx context.Context, externalID sql.Nul
 		&i.HoldBalance,
 		&i.Version,
 		&i.Status,
+		&i.FrozenAt,
+		&i.FrozenReason,
+		&i.FrozenBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -113,7 +133,7 @@ func (q *Queries) GetAccountByExternalID(ctx context.Context, externalID sql.Nul
 }
 
 const getAccountByID = `-- name: GetAccountByID :one
-SELECT id, account_type, owner_id, primary_wallet_id, external_id, balance, hold_balance, version, status, created_at, updated_at FROM accounts
+SELECT id, account_type, owner_id, primary_wallet_id, external_id, balance, hold_balance, version, status, frozen_at, frozen_reason, frozen_by, created_at, updated_at FROM accounts
 WHERE id = ? AND status != 'CLOSED'
 `
 
@@ -131,6 +151,9 @@ func (q *Queries) GetAccountByID(ctx context.Context, id uint64) (Account, error
 		&i.HoldBalance,
 		&i.Version,
 		&i.Status,
+		&i.FrozenAt,
+		&i.FrozenReason,
+		&i.FrozenBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -138,7 +161,7 @@ func (q *Queries) GetAccountByID(ctx context.Context, id uint64) (Account, error
 }
 
 const getAccountByOwnerForUpdate = `-- name: GetAccountByOwnerForUpdate :one
-SELECT id, account_type, owner_id, primary_wallet_id, external_id, balance, hold_balance, version, status, created_at, updated_at FROM accounts
+SELECT id, account_type, owner_id, primary_wallet_id, external_id, balance, hold_balance, version, status, frozen_at, frozen_reason, frozen_by, created_at, updated_at FROM accounts
 WHERE owner_id = ? AND account_type = 'USER' AND status != 'CLOSED'
 FOR UPDATE
 `
@@ -157,6 +180,9 @@ func (q *Queries) GetAccountByOwnerForUpdate(ctx context.Context, ownerID sql.Nu
 		&i.HoldBalance,
 		&i.Version,
 		&i.Status,
+		&i.FrozenAt,
+		&i.FrozenReason,
+		&i.FrozenBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -164,7 +190,7 @@ func (q *Queries) GetAccountByOwnerForUpdate(ctx context.Context, ownerID sql.Nu
 }
 
 const getAccountByOwnerID = `-- name: GetAccountByOwnerID :one
-SELECT id, account_type, owner_id, primary_wallet_id, external_id, balance, hold_balance, version, status, created_at, updated_at FROM accounts
+SELECT id, account_type, owner_id, primary_wallet_id, external_id, balance, hold_balance, version, status, frozen_at, frozen_reason, frozen_by, created_at, updated_at FROM accounts
 WHERE owner_id = ? AND account_type = 'USER' AND status != 'CLOSED'
 `
 
@@ -182,6 +208,9 @@ func (q *Queries) GetAccountByOwnerID(ctx context.Context, ownerID sql.NullInt64
 		&i.HoldBalance,
 		&i.Version,
 		&i.Status,
+		&i.FrozenAt,
+		&i.FrozenReason,
+		&i.FrozenBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -189,7 +218,7 @@ func (q *Queries) GetAccountByOwnerID(ctx context.Context, ownerID sql.NullInt64
 }
 
 const getAccountForUpdate = `-- name: GetAccountForUpdate :one
-SELECT id, account_type, owner_id, primary_wallet_id, external_id, balance, hold_balance, version, status, created_at, updated_at FROM accounts
+SELECT id, account_type, owner_id, primary_wallet_id, external_id, balance, hold_balance, version, status, frozen_at, frozen_reason, frozen_by, created_at, updated_at FROM accounts
 WHERE id = ? AND status != 'CLOSED'
 FOR UPDATE
 `
@@ -208,6 +237,9 @@ func (q *Queries) GetAccountForUpdate(ctx context.Context, id uint64) (Account,
 		&i.HoldBalance,
 		&i.Version,
 		&i.Status,
+		&i.FrozenAt,
+		&i.FrozenReason,
+		&i.FrozenBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -216,7 +248,7 @@ func (q *Queries) GetAccountForUpdate(ctx context.Context, id uint64) (Account,
 
 const listAccountsByType = `-- name: ListAccountsByType :many
 
-SELECT id, account_type, owner_id, primary_wallet_id, external_id, balance, hold_balance, version, status, created_at, updated_at FROM accounts
+SELECT id, account_type, owner_id, primary_wallet_id, external_id, balance, hold_balance, version, status, frozen_at, frozen_reason, frozen_by, created_at, updated_at FROM accounts
 WHERE account_type = ? AND status != 'CLOSED'
 ORDER BY created_at DESC
 LIMIT ? OFFSET ?
@@ -251,6 +283,9 @@ func (q *Queries) ListAccountsByType(ctx context.Context, arg ListAccountsByType
 			&i.HoldBalance,
 			&i.Version,
 			&i.Status,
+			&i.FrozenAt,
+			&i.FrozenReason,
+			&i.FrozenBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -267,6 +302,68 @@ func (q *Queries) ListAccountsByType(ctx context.Context, arg ListAccountsByType
 	return items, nil
 }
 
+const resyncAccountBalance = `-- name: ResyncAccountBalance :execresult
+UPDATE accounts
+SET balance = ?, version = version + 1, updated_at = NOW()
+WHERE id = ? AND version = ? AND status != 'CLOSED'
+`
+
+type ResyncAccountBalanceParams struct {
+	Balance string `json:"balance"`
+	ID      uint64 `json:"id"`
+	Version uint32 `json:"version"`
+}
+
+// 원장 기준 잔액 재동기화 (운영 runbook 전용, 낙관적 락)
+func (q *Queries) ResyncAccountBalance(ctx context.Context, arg ResyncAccountBalanceParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, resyncAccountBalance, arg.Balance, arg.ID, arg.Version)
+}
+
+const unfreezeAccount = `-- name: UnfreezeAccount :execresult
+UPDATE accounts
+SET frozen_at = NULL, frozen_reason = NULL, frozen_by = NULL, updated_at = NOW()
+WHERE id = ? AND frozen_at IS NOT NULL
+`
+
+// 계정 거래 동결 해제 (동결되지 않은 계정은 영향 없음)
+func (q *Queries) UnfreezeAccount(ctx context.Context, id uint64) (sql.Result, error) {
+	return q.db.ExecContext(ctx, unfreezeAccount, id)
+}
+
+const updateAccountBalance = `-- name: UpdateAccountBalance :exec
+UPDATE accounts
+SET balance = ?, version = version + 1, updated_at = NOW()
+WHERE id = ?
+`
+
+type UpdateAccountBalanceParams struct {
+	Balance string `json:"balance"`
+	ID      uint64 `json:"id"`
+}
+
+// 원장 기록 후 잔액 캐시 갱신 (GetAccountForUpdate row-lock 하에서만)
+func (q *Queries) UpdateAccountBalance(ctx context.Context, arg UpdateAccountBalanceParams) error {
+	_, err := q.db.ExecContext(ctx, updateAccountBalance, arg.Balance, arg.ID)
+	return err
+}
+
+const updateAccountHoldBalance = `-- name: UpdateAccountHoldBalance :exec
+UPDATE accounts
+SET hold_balance = ?, version = version + 1, updated_at = NOW()
+WHERE id = ?
+`
+
+type UpdateAccountHoldBalanceParams struct {
+	HoldBalance string `json:"hold_balance"`
+	ID          uint64 `json:"id"`
+}
+
+// 동결 금액 갱신 (분쟁 동결/해제 - GetAccountForUpdate row-lock 하에서만)
+func (q *Queries) UpdateAccountHoldBalance(ctx context.Context, arg UpdateAccountHoldBalanceParams) error {
+	_, err := q.db.ExecContext(ctx, updateAccountHoldBalance, arg.HoldBalance, arg.ID)
+	return err
+}
+
 const updateAccountPrimaryWallet = `-- name: UpdateAccountPrimaryWallet :exec
 
 UPDATE accounts
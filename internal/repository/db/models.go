@@ -12,623 +12,3297 @@ import (
 	"time"
 )
 
-type AccountsAccountType string
+type AccountLimitUsageKind string
 
 const (
-	AccountsAccountTypeUSER     AccountsAccountType = "USER"
-	AccountsAccountTypeMERCHANT AccountsAccountType = "MERCHANT"
-	AccountsAccountTypeESCROW   AccountsAccountType = "ESCROW"
-	AccountsAccountTypeSYSTEM   AccountsAccountType = "SYSTEM"
+	AccountLimitUsageKindSPEND      AccountLimitUsageKind = "SPEND"
+	AccountLimitUsageKindWITHDRAWAL AccountLimitUsageKind = "WITHDRAWAL"
 )
 
-func (e *AccountsAccountType) Scan(src interface{}) error {
+func (e *AccountLimitUsageKind) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = AccountsAccountType(s)
+		*e = AccountLimitUsageKind(s)
 	case string:
-		*e = AccountsAccountType(s)
+		*e = AccountLimitUsageKind(s)
 	default:
-		return fmt.Errorf("unsupported scan type for AccountsAccountType: %T", src)
+		return fmt.Errorf("unsupported scan type for AccountLimitUsageKind: %T", src)
 	}
 	return nil
 }
 
-type NullAccountsAccountType struct {
-	AccountsAccountType AccountsAccountType `json:"accounts_account_type"`
-	Valid               bool                `json:"valid"` // Valid is true if AccountsAccountType is not NULL
+type NullAccountLimitUsageKind struct {
+	AccountLimitUsageKind AccountLimitUsageKind `json:"account_limit_usage_kind"`
+	Valid                 bool                  `json:"valid"` // Valid is true if AccountLimitUsageKind is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullAccountsAccountType) Scan(value interface{}) error {
+func (ns *NullAccountLimitUsageKind) Scan(value interface{}) error {
 	if value == nil {
-		ns.AccountsAccountType, ns.Valid = "", false
+		ns.AccountLimitUsageKind, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.AccountsAccountType.Scan(value)
+	return ns.AccountLimitUsageKind.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullAccountsAccountType) Value() (driver.Value, error) {
+func (ns NullAccountLimitUsageKind) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.AccountsAccountType), nil
+	return string(ns.AccountLimitUsageKind), nil
 }
 
-type AccountsStatus string
+type AccountLimitUsagePeriod string
 
 const (
-	AccountsStatusACTIVE    AccountsStatus = "ACTIVE"
-	AccountsStatusSUSPENDED AccountsStatus = "SUSPENDED"
-	AccountsStatusCLOSED    AccountsStatus = "CLOSED"
+	AccountLimitUsagePeriodDAILY   AccountLimitUsagePeriod = "DAILY"
+	AccountLimitUsagePeriodMONTHLY AccountLimitUsagePeriod = "MONTHLY"
 )
 
-func (e *AccountsStatus) Scan(src interface{}) error {
+func (e *AccountLimitUsagePeriod) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = AccountsStatus(s)
+		*e = AccountLimitUsagePeriod(s)
 	case string:
-		*e = AccountsStatus(s)
+		*e = AccountLimitUsagePeriod(s)
 	default:
-		return fmt.Errorf("unsupported scan type for AccountsStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for AccountLimitUsagePeriod: %T", src)
 	}
 	return nil
 }
 
-type NullAccountsStatus struct {
-	AccountsStatus AccountsStatus `json:"accounts_status"`
-	Valid          bool           `json:"valid"` // Valid is true if AccountsStatus is not NULL
+type NullAccountLimitUsagePeriod struct {
+	AccountLimitUsagePeriod AccountLimitUsagePeriod `json:"account_limit_usage_period"`
+	Valid                   bool                    `json:"valid"` // Valid is true if AccountLimitUsagePeriod is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullAccountsStatus) Scan(value interface{}) error {
+func (ns *NullAccountLimitUsagePeriod) Scan(value interface{}) error {
 	if value == nil {
-		ns.AccountsStatus, ns.Valid = "", false
+		ns.AccountLimitUsagePeriod, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.AccountsStatus.Scan(value)
+	return ns.AccountLimitUsagePeriod.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullAccountsStatus) Value() (driver.Value, error) {
+func (ns NullAccountLimitUsagePeriod) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.AccountsStatus), nil
+	return string(ns.AccountLimitUsagePeriod), nil
 }
 
-type DepositsStatus string
+type AccountStatementLinesEntryType string
 
 const (
-	DepositsStatusDETECTED   DepositsStatus = "DETECTED"
-	DepositsStatusCONFIRMING DepositsStatus = "CONFIRMING"
-	DepositsStatusCREDITED   DepositsStatus = "CREDITED"
-	DepositsStatusCOMPLETED  DepositsStatus = "COMPLETED"
-	DepositsStatusFAILED     DepositsStatus = "FAILED"
+	AccountStatementLinesEntryTypeDEBIT  AccountStatementLinesEntryType = "DEBIT"
+	AccountStatementLinesEntryTypeCREDIT AccountStatementLinesEntryType = "CREDIT"
 )
 
-func (e *DepositsStatus) Scan(src interface{}) error {
+func (e *AccountStatementLinesEntryType) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = DepositsStatus(s)
+		*e = AccountStatementLinesEntryType(s)
 	case string:
-		*e = DepositsStatus(s)
+		*e = AccountStatementLinesEntryType(s)
 	default:
-		return fmt.Errorf("unsupported scan type for DepositsStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for AccountStatementLinesEntryType: %T", src)
 	}
 	return nil
 }
 
-type NullDepositsStatus struct {
-	DepositsStatus DepositsStatus `json:"deposits_status"`
-	Valid          bool           `json:"valid"` // Valid is true if DepositsStatus is not NULL
+type NullAccountStatementLinesEntryType struct {
+	AccountStatementLinesEntryType AccountStatementLinesEntryType `json:"account_statement_lines_entry_type"`
+	Valid                          bool                           `json:"valid"` // Valid is true if AccountStatementLinesEntryType is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullDepositsStatus) Scan(value interface{}) error {
+func (ns *NullAccountStatementLinesEntryType) Scan(value interface{}) error {
 	if value == nil {
-		ns.DepositsStatus, ns.Valid = "", false
+		ns.AccountStatementLinesEntryType, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.DepositsStatus.Scan(value)
+	return ns.AccountStatementLinesEntryType.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullDepositsStatus) Value() (driver.Value, error) {
+func (ns NullAccountStatementLinesEntryType) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.DepositsStatus), nil
+	return string(ns.AccountStatementLinesEntryType), nil
 }
 
-type InventoryLogsEventType string
+type AccountsAccountType string
 
 const (
-	InventoryLogsEventTypeINBOUND  InventoryLogsEventType = "INBOUND"
-	InventoryLogsEventTypeOUTBOUND InventoryLogsEventType = "OUTBOUND"
-	InventoryLogsEventTypeRESERVE  InventoryLogsEventType = "RESERVE"
-	InventoryLogsEventTypeRELEASE  InventoryLogsEventType = "RELEASE"
-	InventoryLogsEventTypeADJUST   InventoryLogsEventType = "ADJUST"
+	AccountsAccountTypeUSER     AccountsAccountType = "USER"
+	AccountsAccountTypeMERCHANT AccountsAccountType = "MERCHANT"
+	AccountsAccountTypeESCROW   AccountsAccountType = "ESCROW"
+	AccountsAccountTypeSYSTEM   AccountsAccountType = "SYSTEM"
 )
 
-func (e *InventoryLogsEventType) Scan(src interface{}) error {
+func (e *AccountsAccountType) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = InventoryLogsEventType(s)
+		*e = AccountsAccountType(s)
 	case string:
-		*e = InventoryLogsEventType(s)
+		*e = AccountsAccountType(s)
 	default:
-		return fmt.Errorf("unsupported scan type for InventoryLogsEventType: %T", src)
+		return fmt.Errorf("unsupported scan type for AccountsAccountType: %T", src)
 	}
 	return nil
 }
 
-type NullInventoryLogsEventType struct {
-	InventoryLogsEventType InventoryLogsEventType `json:"inventory_logs_event_type"`
-	Valid                  bool                   `json:"valid"` // Valid is true if InventoryLogsEventType is not NULL
+type NullAccountsAccountType struct {
+	AccountsAccountType AccountsAccountType `json:"accounts_account_type"`
+	Valid               bool                `json:"valid"` // Valid is true if AccountsAccountType is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullInventoryLogsEventType) Scan(value interface{}) error {
+func (ns *NullAccountsAccountType) Scan(value interface{}) error {
 	if value == nil {
-		ns.InventoryLogsEventType, ns.Valid = "", false
+		ns.AccountsAccountType, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.InventoryLogsEventType.Scan(value)
+	return ns.AccountsAccountType.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullInventoryLogsEventType) Value() (driver.Value, error) {
+func (ns NullAccountsAccountType) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.InventoryLogsEventType), nil
+	return string(ns.AccountsAccountType), nil
 }
 
-type LedgerEntriesEntryType string
+type AccountsStatus string
 
 const (
-	LedgerEntriesEntryTypeDEBIT  LedgerEntriesEntryType = "DEBIT"
-	LedgerEntriesEntryTypeCREDIT LedgerEntriesEntryType = "CREDIT"
+	AccountsStatusACTIVE    AccountsStatus = "ACTIVE"
+	AccountsStatusSUSPENDED AccountsStatus = "SUSPENDED"
+	AccountsStatusCLOSED    AccountsStatus = "CLOSED"
 )
 
-func (e *LedgerEntriesEntryType) Scan(src interface{}) error {
+func (e *AccountsStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = LedgerEntriesEntryType(s)
+		*e = AccountsStatus(s)
 	case string:
-		*e = LedgerEntriesEntryType(s)
+		*e = AccountsStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for LedgerEntriesEntryType: %T", src)
+		return fmt.Errorf("unsupported scan type for AccountsStatus: %T", src)
 	}
 	return nil
 }
 
-type NullLedgerEntriesEntryType struct {
-	LedgerEntriesEntryType LedgerEntriesEntryType `json:"ledger_entries_entry_type"`
-	Valid                  bool                   `json:"valid"` // Valid is true if LedgerEntriesEntryType is not NULL
+type NullAccountsStatus struct {
+	AccountsStatus AccountsStatus `json:"accounts_status"`
+	Valid          bool           `json:"valid"` // Valid is true if AccountsStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullLedgerEntriesEntryType) Scan(value interface{}) error {
+func (ns *NullAccountsStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.LedgerEntriesEntryType, ns.Valid = "", false
+		ns.AccountsStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.LedgerEntriesEntryType.Scan(value)
+	return ns.AccountsStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullLedgerEntriesEntryType) Value() (driver.Value, error) {
+func (ns NullAccountsStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.LedgerEntriesEntryType), nil
+	return string(ns.AccountsStatus), nil
 }
 
-type OrdersStatus string
+type BudgetAlertsLevel string
 
 const (
-	OrdersStatusPENDING   OrdersStatus = "PENDING"
-	OrdersStatusCONFIRMED OrdersStatus = "CONFIRMED"
-	OrdersStatusPAID      OrdersStatus = "PAID"
-	OrdersStatusSHIPPED   OrdersStatus = "SHIPPED"
-	OrdersStatusCOMPLETED OrdersStatus = "COMPLETED"
-	OrdersStatusCANCELLED OrdersStatus = "CANCELLED"
-	OrdersStatusREFUNDED  OrdersStatus = "REFUNDED"
+	BudgetAlertsLevelNEARLIMIT BudgetAlertsLevel = "NEAR_LIMIT"
+	BudgetAlertsLevelEXCEEDED  BudgetAlertsLevel = "EXCEEDED"
 )
 
-func (e *OrdersStatus) Scan(src interface{}) error {
+func (e *BudgetAlertsLevel) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = OrdersStatus(s)
+		*e = BudgetAlertsLevel(s)
 	case string:
-		*e = OrdersStatus(s)
+		*e = BudgetAlertsLevel(s)
 	default:
-		return fmt.Errorf("unsupported scan type for OrdersStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for BudgetAlertsLevel: %T", src)
 	}
 	return nil
 }
 
-type NullOrdersStatus struct {
-	OrdersStatus OrdersStatus `json:"orders_status"`
-	Valid        bool         `json:"valid"` // Valid is true if OrdersStatus is not NULL
+type NullBudgetAlertsLevel struct {
+	BudgetAlertsLevel BudgetAlertsLevel `json:"budget_alerts_level"`
+	Valid             bool              `json:"valid"` // Valid is true if BudgetAlertsLevel is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullOrdersStatus) Scan(value interface{}) error {
+func (ns *NullBudgetAlertsLevel) Scan(value interface{}) error {
 	if value == nil {
-		ns.OrdersStatus, ns.Valid = "", false
+		ns.BudgetAlertsLevel, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.OrdersStatus.Scan(value)
+	return ns.BudgetAlertsLevel.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullOrdersStatus) Value() (driver.Value, error) {
+func (ns NullBudgetAlertsLevel) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.OrdersStatus), nil
+	return string(ns.BudgetAlertsLevel), nil
 }
 
-type OutboxStatus string
+type BudgetsPeriod string
 
 const (
-	OutboxStatusPENDING    OutboxStatus = "PENDING"
-	OutboxStatusPROCESSING OutboxStatus = "PROCESSING"
-	OutboxStatusCOMPLETED  OutboxStatus = "COMPLETED"
-	OutboxStatusFAILED     OutboxStatus = "FAILED"
-	OutboxStatusDEADLETTER OutboxStatus = "DEAD_LETTER"
+	BudgetsPeriodMONTHLY   BudgetsPeriod = "MONTHLY"
+	BudgetsPeriodQUARTERLY BudgetsPeriod = "QUARTERLY"
+	BudgetsPeriodYEARLY    BudgetsPeriod = "YEARLY"
 )
 
-func (e *OutboxStatus) Scan(src interface{}) error {
+func (e *BudgetsPeriod) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = OutboxStatus(s)
+		*e = BudgetsPeriod(s)
 	case string:
-		*e = OutboxStatus(s)
+		*e = BudgetsPeriod(s)
 	default:
-		return fmt.Errorf("unsupported scan type for OutboxStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for BudgetsPeriod: %T", src)
 	}
 	return nil
 }
 
-type NullOutboxStatus struct {
-	OutboxStatus OutboxStatus `json:"outbox_status"`
-	Valid        bool         `json:"valid"` // Valid is true if OutboxStatus is not NULL
+type NullBudgetsPeriod struct {
+	BudgetsPeriod BudgetsPeriod `json:"budgets_period"`
+	Valid         bool          `json:"valid"` // Valid is true if BudgetsPeriod is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullOutboxStatus) Scan(value interface{}) error {
+func (ns *NullBudgetsPeriod) Scan(value interface{}) error {
 	if value == nil {
-		ns.OutboxStatus, ns.Valid = "", false
+		ns.BudgetsPeriod, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.OutboxStatus.Scan(value)
+	return ns.BudgetsPeriod.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullOutboxStatus) Value() (driver.Value, error) {
+func (ns NullBudgetsPeriod) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.OutboxStatus), nil
+	return string(ns.BudgetsPeriod), nil
 }
 
-type PaymentsStatus string
+type CartsStatus string
 
 const (
-	PaymentsStatusPENDING    PaymentsStatus = "PENDING"
-	PaymentsStatusAUTHORIZED PaymentsStatus = "AUTHORIZED"
-	PaymentsStatusCAPTURED   PaymentsStatus = "CAPTURED"
-	PaymentsStatusVOIDED     PaymentsStatus = "VOIDED"
-	PaymentsStatusREFUNDED   PaymentsStatus = "REFUNDED"
-	PaymentsStatusFAILED     PaymentsStatus = "FAILED"
+	CartsStatusACTIVE     CartsStatus = "ACTIVE"
+	CartsStatusCHECKEDOUT CartsStatus = "CHECKED_OUT"
+	CartsStatusEXPIRED    CartsStatus = "EXPIRED"
 )
 
-func (e *PaymentsStatus) Scan(src interface{}) error {
+func (e *CartsStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = PaymentsStatus(s)
+		*e = CartsStatus(s)
 	case string:
-		*e = PaymentsStatus(s)
+		*e = CartsStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for PaymentsStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for CartsStatus: %T", src)
 	}
 	return nil
 }
 
-type NullPaymentsStatus struct {
-	PaymentsStatus PaymentsStatus `json:"payments_status"`
-	Valid          bool           `json:"valid"` // Valid is true if PaymentsStatus is not NULL
+type NullCartsStatus struct {
+	CartsStatus CartsStatus `json:"carts_status"`
+	Valid       bool        `json:"valid"` // Valid is true if CartsStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullPaymentsStatus) Scan(value interface{}) error {
+func (ns *NullCartsStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.PaymentsStatus, ns.Valid = "", false
+		ns.CartsStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.PaymentsStatus.Scan(value)
+	return ns.CartsStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullPaymentsStatus) Value() (driver.Value, error) {
+func (ns NullCartsStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.PaymentsStatus), nil
+	return string(ns.CartsStatus), nil
 }
 
-type ProductsStatus string
+type ChainTransactionsStatus string
 
 const (
-	ProductsStatusACTIVE   ProductsStatus = "ACTIVE"
-	ProductsStatusINACTIVE ProductsStatus = "INACTIVE"
+	ChainTransactionsStatusPENDING   ChainTransactionsStatus = "PENDING"
+	ChainTransactionsStatusCONFIRMED ChainTransactionsStatus = "CONFIRMED"
+	ChainTransactionsStatusFAILED    ChainTransactionsStatus = "FAILED"
+	ChainTransactionsStatusDROPPED   ChainTransactionsStatus = "DROPPED"
 )
 
-func (e *ProductsStatus) Scan(src interface{}) error {
+func (e *ChainTransactionsStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = ProductsStatus(s)
+		*e = ChainTransactionsStatus(s)
 	case string:
-		*e = ProductsStatus(s)
+		*e = ChainTransactionsStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for ProductsStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for ChainTransactionsStatus: %T", src)
 	}
 	return nil
 }
 
-type NullProductsStatus struct {
-	ProductsStatus ProductsStatus `json:"products_status"`
-	Valid          bool           `json:"valid"` // Valid is true if ProductsStatus is not NULL
+type NullChainTransactionsStatus struct {
+	ChainTransactionsStatus ChainTransactionsStatus `json:"chain_transactions_status"`
+	Valid                   bool                    `json:"valid"` // Valid is true if ChainTransactionsStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullProductsStatus) Scan(value interface{}) error {
+func (ns *NullChainTransactionsStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.ProductsStatus, ns.Valid = "", false
+		ns.ChainTransactionsStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.ProductsStatus.Scan(value)
+	return ns.ChainTransactionsStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullProductsStatus) Value() (driver.Value, error) {
+func (ns NullChainTransactionsStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.ProductsStatus), nil
+	return string(ns.ChainTransactionsStatus), nil
 }
 
-type SettlementsStatus string
+type CouponsDiscountType string
 
 const (
-	SettlementsStatusPENDING    SettlementsStatus = "PENDING"
-	SettlementsStatusPROCESSING SettlementsStatus = "PROCESSING"
-	SettlementsStatusCOMPLETED  SettlementsStatus = "COMPLETED"
-	SettlementsStatusFAILED     SettlementsStatus = "FAILED"
+	CouponsDiscountTypePERCENT CouponsDiscountType = "PERCENT"
+	CouponsDiscountTypeFIXED   CouponsDiscountType = "FIXED"
 )
 
-func (e *SettlementsStatus) Scan(src interface{}) error {
+func (e *CouponsDiscountType) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = SettlementsStatus(s)
+		*e = CouponsDiscountType(s)
 	case string:
-		*e = SettlementsStatus(s)
+		*e = CouponsDiscountType(s)
 	default:
-		return fmt.Errorf("unsupported scan type for SettlementsStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for CouponsDiscountType: %T", src)
 	}
 	return nil
 }
 
-type NullSettlementsStatus struct {
-	SettlementsStatus SettlementsStatus `json:"settlements_status"`
-	Valid             bool              `json:"valid"` // Valid is true if SettlementsStatus is not NULL
+type NullCouponsDiscountType struct {
+	CouponsDiscountType CouponsDiscountType `json:"coupons_discount_type"`
+	Valid               bool                `json:"valid"` // Valid is true if CouponsDiscountType is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullSettlementsStatus) Scan(value interface{}) error {
+func (ns *NullCouponsDiscountType) Scan(value interface{}) error {
 	if value == nil {
-		ns.SettlementsStatus, ns.Valid = "", false
+		ns.CouponsDiscountType, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.SettlementsStatus.Scan(value)
+	return ns.CouponsDiscountType.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullSettlementsStatus) Value() (driver.Value, error) {
+func (ns NullCouponsDiscountType) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.SettlementsStatus), nil
+	return string(ns.CouponsDiscountType), nil
 }
 
-type SystemWalletsWalletType string
+type CouponsStatus string
 
 const (
-	SystemWalletsWalletTypeTREASURY   SystemWalletsWalletType = "TREASURY"
-	SystemWalletsWalletTypeMINTER     SystemWalletsWalletType = "MINTER"
-	SystemWalletsWalletTypeBURNER     SystemWalletsWalletType = "BURNER"
-	SystemWalletsWalletTypeHOTWALLET  SystemWalletsWalletType = "HOT_WALLET"
-	SystemWalletsWalletTypeCOLDWALLET SystemWalletsWalletType = "COLD_WALLET"
+	CouponsStatusACTIVE   CouponsStatus = "ACTIVE"
+	CouponsStatusDISABLED CouponsStatus = "DISABLED"
 )
 
-func (e *SystemWalletsWalletType) Scan(src interface{}) error {
+func (e *CouponsStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = SystemWalletsWalletType(s)
+		*e = CouponsStatus(s)
 	case string:
-		*e = SystemWalletsWalletType(s)
+		*e = CouponsStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for SystemWalletsWalletType: %T", src)
+		return fmt.Errorf("unsupported scan type for CouponsStatus: %T", src)
 	}
 	return nil
 }
 
-type NullSystemWalletsWalletType struct {
-	SystemWalletsWalletType SystemWalletsWalletType `json:"system_wallets_wallet_type"`
-	Valid                   bool                    `json:"valid"` // Valid is true if SystemWalletsWalletType is not NULL
+type NullCouponsStatus struct {
+	CouponsStatus CouponsStatus `json:"coupons_status"`
+	Valid         bool          `json:"valid"` // Valid is true if CouponsStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullSystemWalletsWalletType) Scan(value interface{}) error {
+func (ns *NullCouponsStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.SystemWalletsWalletType, ns.Valid = "", false
+		ns.CouponsStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.SystemWalletsWalletType.Scan(value)
+	return ns.CouponsStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullSystemWalletsWalletType) Value() (driver.Value, error) {
+func (ns NullCouponsStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.SystemWalletsWalletType), nil
+	return string(ns.CouponsStatus), nil
 }
 
-type UsersKycStatus string
+type CreditNotesReason string
 
 const (
-	UsersKycStatusNONE     UsersKycStatus = "NONE"
-	UsersKycStatusPENDING  UsersKycStatus = "PENDING"
-	UsersKycStatusVERIFIED UsersKycStatus = "VERIFIED"
-	UsersKycStatusREJECTED UsersKycStatus = "REJECTED"
+	CreditNotesReasonCORRECTION CreditNotesReason = "CORRECTION"
+	CreditNotesReasonRETURN     CreditNotesReason = "RETURN"
 )
 
-func (e *UsersKycStatus) Scan(src interface{}) error {
+func (e *CreditNotesReason) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = UsersKycStatus(s)
+		*e = CreditNotesReason(s)
 	case string:
-		*e = UsersKycStatus(s)
+		*e = CreditNotesReason(s)
 	default:
-		return fmt.Errorf("unsupported scan type for UsersKycStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for CreditNotesReason: %T", src)
 	}
 	return nil
 }
 
-type NullUsersKycStatus struct {
-	UsersKycStatus UsersKycStatus `json:"users_kyc_status"`
-	Valid          bool           `json:"valid"` // Valid is true if UsersKycStatus is not NULL
+type NullCreditNotesReason struct {
+	CreditNotesReason CreditNotesReason `json:"credit_notes_reason"`
+	Valid             bool              `json:"valid"` // Valid is true if CreditNotesReason is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullUsersKycStatus) Scan(value interface{}) error {
+func (ns *NullCreditNotesReason) Scan(value interface{}) error {
 	if value == nil {
-		ns.UsersKycStatus, ns.Valid = "", false
+		ns.CreditNotesReason, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.UsersKycStatus.Scan(value)
+	return ns.CreditNotesReason.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullUsersKycStatus) Value() (driver.Value, error) {
+func (ns NullCreditNotesReason) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.UsersKycStatus), nil
+	return string(ns.CreditNotesReason), nil
 }
 
-type UsersRole string
+type CreditTermsStatus string
 
 const (
-	UsersRoleBUYER  UsersRole = "BUYER"
-	UsersRoleSELLER UsersRole = "SELLER"
-	UsersRoleBOTH   UsersRole = "BOTH"
-	UsersRoleADMIN  UsersRole = "ADMIN"
+	CreditTermsStatusACTIVE    CreditTermsStatus = "ACTIVE"
+	CreditTermsStatusSUSPENDED CreditTermsStatus = "SUSPENDED"
 )
 
-func (e *UsersRole) Scan(src interface{}) error {
+func (e *CreditTermsStatus) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = UsersRole(s)
+		*e = CreditTermsStatus(s)
 	case string:
-		*e = UsersRole(s)
+		*e = CreditTermsStatus(s)
 	default:
-		return fmt.Errorf("unsupported scan type for UsersRole: %T", src)
+		return fmt.Errorf("unsupported scan type for CreditTermsStatus: %T", src)
 	}
 	return nil
 }
 
-type NullUsersRole struct {
-	UsersRole UsersRole `json:"users_role"`
-	Valid     bool      `json:"valid"` // Valid is true if UsersRole is not NULL
+type NullCreditTermsStatus struct {
+	CreditTermsStatus CreditTermsStatus `json:"credit_terms_status"`
+	Valid             bool              `json:"valid"` // Valid is true if CreditTermsStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullUsersRole) Scan(value interface{}) error {
+func (ns *NullCreditTermsStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.UsersRole, ns.Valid = "", false
+		ns.CreditTermsStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.UsersRole.Scan(value)
+	return ns.CreditTermsStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullUsersRole) Value() (driver.Value, error) {
+func (ns NullCreditTermsStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.UsersRole), nil
+	return string(ns.CreditTermsStatus), nil
 }
 
-type UsersStatus string
+type CreditTermsTerms string
 
 const (
-	UsersStatusACTIVE    UsersStatus = "ACTIVE"
-	UsersStatusSUSPENDED UsersStatus = "SUSPENDED"
-	UsersStatusDELETED   UsersStatus = "DELETED"
+	CreditTermsTermsNET30 CreditTermsTerms = "NET_30"
+	CreditTermsTermsNET60 CreditTermsTerms = "NET_60"
 )
 
-func (e *UsersStatus) Scan(src interface{}) error {
+func (e *CreditTermsTerms) Scan(src interface{}) error {
 	switch s := src.(type) {
 	case []byte:
-		*e = UsersStatus(s)
+		*e = CreditTermsTerms(s)
 	case string:
-		*e = UsersStatus(s)
+		*e = CreditTermsTerms(s)
 	default:
-		return fmt.Errorf("unsupported scan type for UsersStatus: %T", src)
+		return fmt.Errorf("unsupported scan type for CreditTermsTerms: %T", src)
 	}
 	return nil
 }
 
-type NullUsersStatus struct {
-	UsersStatus UsersStatus `json:"users_status"`
+type NullCreditTermsTerms struct {
+	CreditTermsTerms CreditTermsTerms `json:"credit_terms_terms"`
+	Valid            bool             `json:"valid"` // Valid is true if CreditTermsTerms is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCreditTermsTerms) Scan(value interface{}) error {
+	if value == nil {
+		ns.CreditTermsTerms, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CreditTermsTerms.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCreditTermsTerms) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CreditTermsTerms), nil
+}
+
+type DepositsStatus string
+
+const (
+	DepositsStatusDETECTED   DepositsStatus = "DETECTED"
+	DepositsStatusCONFIRMING DepositsStatus = "CONFIRMING"
+	DepositsStatusCREDITED   DepositsStatus = "CREDITED"
+	DepositsStatusCOMPLETED  DepositsStatus = "COMPLETED"
+	DepositsStatusFAILED     DepositsStatus = "FAILED"
+)
+
+func (e *DepositsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DepositsStatus(s)
+	case string:
+		*e = DepositsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DepositsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullDepositsStatus struct {
+	DepositsStatus DepositsStatus `json:"deposits_status"`
+	Valid          bool           `json:"valid"` // Valid is true if DepositsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDepositsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.DepositsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DepositsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDepositsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DepositsStatus), nil
+}
+
+type DisputeEvidenceParty string
+
+const (
+	DisputeEvidencePartyBUYER  DisputeEvidenceParty = "BUYER"
+	DisputeEvidencePartySELLER DisputeEvidenceParty = "SELLER"
+	DisputeEvidencePartyADMIN  DisputeEvidenceParty = "ADMIN"
+)
+
+func (e *DisputeEvidenceParty) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DisputeEvidenceParty(s)
+	case string:
+		*e = DisputeEvidenceParty(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DisputeEvidenceParty: %T", src)
+	}
+	return nil
+}
+
+type NullDisputeEvidenceParty struct {
+	DisputeEvidenceParty DisputeEvidenceParty `json:"dispute_evidence_party"`
+	Valid                bool                 `json:"valid"` // Valid is true if DisputeEvidenceParty is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDisputeEvidenceParty) Scan(value interface{}) error {
+	if value == nil {
+		ns.DisputeEvidenceParty, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DisputeEvidenceParty.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDisputeEvidenceParty) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DisputeEvidenceParty), nil
+}
+
+type DisputesReason string
+
+const (
+	DisputesReasonNOTRECEIVED    DisputesReason = "NOT_RECEIVED"
+	DisputesReasonNOTASDESCRIBED DisputesReason = "NOT_AS_DESCRIBED"
+	DisputesReasonDUPLICATE      DisputesReason = "DUPLICATE"
+	DisputesReasonUNAUTHORIZED   DisputesReason = "UNAUTHORIZED"
+	DisputesReasonOTHER          DisputesReason = "OTHER"
+)
+
+func (e *DisputesReason) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DisputesReason(s)
+	case string:
+		*e = DisputesReason(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DisputesReason: %T", src)
+	}
+	return nil
+}
+
+type NullDisputesReason struct {
+	DisputesReason DisputesReason `json:"disputes_reason"`
+	Valid          bool           `json:"valid"` // Valid is true if DisputesReason is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDisputesReason) Scan(value interface{}) error {
+	if value == nil {
+		ns.DisputesReason, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DisputesReason.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDisputesReason) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DisputesReason), nil
+}
+
+type DisputesStatus string
+
+const (
+	DisputesStatusOPEN     DisputesStatus = "OPEN"
+	DisputesStatusREFUNDED DisputesStatus = "REFUNDED"
+	DisputesStatusRELEASED DisputesStatus = "RELEASED"
+)
+
+func (e *DisputesStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = DisputesStatus(s)
+	case string:
+		*e = DisputesStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for DisputesStatus: %T", src)
+	}
+	return nil
+}
+
+type NullDisputesStatus struct {
+	DisputesStatus DisputesStatus `json:"disputes_status"`
+	Valid          bool           `json:"valid"` // Valid is true if DisputesStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullDisputesStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.DisputesStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.DisputesStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullDisputesStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.DisputesStatus), nil
+}
+
+type FeatureRolloutsStatus string
+
+const (
+	FeatureRolloutsStatusPILOT FeatureRolloutsStatus = "PILOT"
+	FeatureRolloutsStatusGA    FeatureRolloutsStatus = "GA"
+)
+
+func (e *FeatureRolloutsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = FeatureRolloutsStatus(s)
+	case string:
+		*e = FeatureRolloutsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for FeatureRolloutsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullFeatureRolloutsStatus struct {
+	FeatureRolloutsStatus FeatureRolloutsStatus `json:"feature_rollouts_status"`
+	Valid                 bool                  `json:"valid"` // Valid is true if FeatureRolloutsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullFeatureRolloutsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.FeatureRolloutsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.FeatureRolloutsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullFeatureRolloutsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.FeatureRolloutsStatus), nil
+}
+
+type FeeLedgerEntriesEntryType string
+
+const (
+	FeeLedgerEntriesEntryTypeCHARGE   FeeLedgerEntriesEntryType = "CHARGE"
+	FeeLedgerEntriesEntryTypeREVERSAL FeeLedgerEntriesEntryType = "REVERSAL"
+)
+
+func (e *FeeLedgerEntriesEntryType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = FeeLedgerEntriesEntryType(s)
+	case string:
+		*e = FeeLedgerEntriesEntryType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for FeeLedgerEntriesEntryType: %T", src)
+	}
+	return nil
+}
+
+type NullFeeLedgerEntriesEntryType struct {
+	FeeLedgerEntriesEntryType FeeLedgerEntriesEntryType `json:"fee_ledger_entries_entry_type"`
+	Valid                     bool                      `json:"valid"` // Valid is true if FeeLedgerEntriesEntryType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullFeeLedgerEntriesEntryType) Scan(value interface{}) error {
+	if value == nil {
+		ns.FeeLedgerEntriesEntryType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.FeeLedgerEntriesEntryType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullFeeLedgerEntriesEntryType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.FeeLedgerEntriesEntryType), nil
+}
+
+type HistoricalImportsSource string
+
+const (
+	HistoricalImportsSourceTXHASHES    HistoricalImportsSource = "TX_HASHES"
+	HistoricalImportsSourceADDRESSSCAN HistoricalImportsSource = "ADDRESS_SCAN"
+)
+
+func (e *HistoricalImportsSource) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = HistoricalImportsSource(s)
+	case string:
+		*e = HistoricalImportsSource(s)
+	default:
+		return fmt.Errorf("unsupported scan type for HistoricalImportsSource: %T", src)
+	}
+	return nil
+}
+
+type NullHistoricalImportsSource struct {
+	HistoricalImportsSource HistoricalImportsSource `json:"historical_imports_source"`
+	Valid                   bool                    `json:"valid"` // Valid is true if HistoricalImportsSource is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullHistoricalImportsSource) Scan(value interface{}) error {
+	if value == nil {
+		ns.HistoricalImportsSource, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.HistoricalImportsSource.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullHistoricalImportsSource) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.HistoricalImportsSource), nil
+}
+
+type HistoricalLedgerEntriesEntryType string
+
+const (
+	HistoricalLedgerEntriesEntryTypeDEBIT  HistoricalLedgerEntriesEntryType = "DEBIT"
+	HistoricalLedgerEntriesEntryTypeCREDIT HistoricalLedgerEntriesEntryType = "CREDIT"
+)
+
+func (e *HistoricalLedgerEntriesEntryType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = HistoricalLedgerEntriesEntryType(s)
+	case string:
+		*e = HistoricalLedgerEntriesEntryType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for HistoricalLedgerEntriesEntryType: %T", src)
+	}
+	return nil
+}
+
+type NullHistoricalLedgerEntriesEntryType struct {
+	HistoricalLedgerEntriesEntryType HistoricalLedgerEntriesEntryType `json:"historical_ledger_entries_entry_type"`
+	Valid                            bool                             `json:"valid"` // Valid is true if HistoricalLedgerEntriesEntryType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullHistoricalLedgerEntriesEntryType) Scan(value interface{}) error {
+	if value == nil {
+		ns.HistoricalLedgerEntriesEntryType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.HistoricalLedgerEntriesEntryType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullHistoricalLedgerEntriesEntryType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.HistoricalLedgerEntriesEntryType), nil
+}
+
+type InventoryLogsEventType string
+
+const (
+	InventoryLogsEventTypeINBOUND     InventoryLogsEventType = "INBOUND"
+	InventoryLogsEventTypeOUTBOUND    InventoryLogsEventType = "OUTBOUND"
+	InventoryLogsEventTypeRESERVE     InventoryLogsEventType = "RESERVE"
+	InventoryLogsEventTypeRELEASE     InventoryLogsEventType = "RELEASE"
+	InventoryLogsEventTypeADJUST      InventoryLogsEventType = "ADJUST"
+	InventoryLogsEventTypeTRANSFEROUT InventoryLogsEventType = "TRANSFER_OUT"
+	InventoryLogsEventTypeTRANSFERIN  InventoryLogsEventType = "TRANSFER_IN"
+)
+
+func (e *InventoryLogsEventType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = InventoryLogsEventType(s)
+	case string:
+		*e = InventoryLogsEventType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for InventoryLogsEventType: %T", src)
+	}
+	return nil
+}
+
+type NullInventoryLogsEventType struct {
+	InventoryLogsEventType InventoryLogsEventType `json:"inventory_logs_event_type"`
+	Valid                  bool                   `json:"valid"` // Valid is true if InventoryLogsEventType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullInventoryLogsEventType) Scan(value interface{}) error {
+	if value == nil {
+		ns.InventoryLogsEventType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.InventoryLogsEventType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullInventoryLogsEventType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.InventoryLogsEventType), nil
+}
+
+type InvoicesStatus string
+
+const (
+	InvoicesStatusDRAFT         InvoicesStatus = "DRAFT"
+	InvoicesStatusISSUED        InvoicesStatus = "ISSUED"
+	InvoicesStatusPARTIALLYPAID InvoicesStatus = "PARTIALLY_PAID"
+	InvoicesStatusPAID          InvoicesStatus = "PAID"
+	InvoicesStatusOVERDUE       InvoicesStatus = "OVERDUE"
+	InvoicesStatusVOID          InvoicesStatus = "VOID"
+)
+
+func (e *InvoicesStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = InvoicesStatus(s)
+	case string:
+		*e = InvoicesStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for InvoicesStatus: %T", src)
+	}
+	return nil
+}
+
+type NullInvoicesStatus struct {
+	InvoicesStatus InvoicesStatus `json:"invoices_status"`
+	Valid          bool           `json:"valid"` // Valid is true if InvoicesStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullInvoicesStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.InvoicesStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.InvoicesStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullInvoicesStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.InvoicesStatus), nil
+}
+
+type KycApplicantsStatus string
+
+const (
+	KycApplicantsStatusPENDING  KycApplicantsStatus = "PENDING"
+	KycApplicantsStatusAPPROVED KycApplicantsStatus = "APPROVED"
+	KycApplicantsStatusREJECTED KycApplicantsStatus = "REJECTED"
+)
+
+func (e *KycApplicantsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = KycApplicantsStatus(s)
+	case string:
+		*e = KycApplicantsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for KycApplicantsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullKycApplicantsStatus struct {
+	KycApplicantsStatus KycApplicantsStatus `json:"kyc_applicants_status"`
+	Valid               bool                `json:"valid"` // Valid is true if KycApplicantsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullKycApplicantsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.KycApplicantsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.KycApplicantsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullKycApplicantsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.KycApplicantsStatus), nil
+}
+
+type KycDocumentsDocumentType string
+
+const (
+	KycDocumentsDocumentTypePASSPORT                KycDocumentsDocumentType = "PASSPORT"
+	KycDocumentsDocumentTypeNATIONALID              KycDocumentsDocumentType = "NATIONAL_ID"
+	KycDocumentsDocumentTypeDRIVERSLICENSE          KycDocumentsDocumentType = "DRIVERS_LICENSE"
+	KycDocumentsDocumentTypePROOFOFADDRESS          KycDocumentsDocumentType = "PROOF_OF_ADDRESS"
+	KycDocumentsDocumentTypeBUSINESSREGISTRATION    KycDocumentsDocumentType = "BUSINESS_REGISTRATION"
+	KycDocumentsDocumentTypeARTICLESOFINCORPORATION KycDocumentsDocumentType = "ARTICLES_OF_INCORPORATION"
+	KycDocumentsDocumentTypeOTHER                   KycDocumentsDocumentType = "OTHER"
+)
+
+func (e *KycDocumentsDocumentType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = KycDocumentsDocumentType(s)
+	case string:
+		*e = KycDocumentsDocumentType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for KycDocumentsDocumentType: %T", src)
+	}
+	return nil
+}
+
+type NullKycDocumentsDocumentType struct {
+	KycDocumentsDocumentType KycDocumentsDocumentType `json:"kyc_documents_document_type"`
+	Valid                    bool                     `json:"valid"` // Valid is true if KycDocumentsDocumentType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullKycDocumentsDocumentType) Scan(value interface{}) error {
+	if value == nil {
+		ns.KycDocumentsDocumentType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.KycDocumentsDocumentType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullKycDocumentsDocumentType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.KycDocumentsDocumentType), nil
+}
+
+type KycDocumentsStatus string
+
+const (
+	KycDocumentsStatusSUBMITTED KycDocumentsStatus = "SUBMITTED"
+	KycDocumentsStatusACCEPTED  KycDocumentsStatus = "ACCEPTED"
+	KycDocumentsStatusREJECTED  KycDocumentsStatus = "REJECTED"
+)
+
+func (e *KycDocumentsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = KycDocumentsStatus(s)
+	case string:
+		*e = KycDocumentsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for KycDocumentsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullKycDocumentsStatus struct {
+	KycDocumentsStatus KycDocumentsStatus `json:"kyc_documents_status"`
+	Valid              bool               `json:"valid"` // Valid is true if KycDocumentsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullKycDocumentsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.KycDocumentsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.KycDocumentsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullKycDocumentsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.KycDocumentsStatus), nil
+}
+
+type KycWebhookEventsOutcome string
+
+const (
+	KycWebhookEventsOutcomeAPPROVED KycWebhookEventsOutcome = "APPROVED"
+	KycWebhookEventsOutcomeREJECTED KycWebhookEventsOutcome = "REJECTED"
+	KycWebhookEventsOutcomePENDING  KycWebhookEventsOutcome = "PENDING"
+)
+
+func (e *KycWebhookEventsOutcome) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = KycWebhookEventsOutcome(s)
+	case string:
+		*e = KycWebhookEventsOutcome(s)
+	default:
+		return fmt.Errorf("unsupported scan type for KycWebhookEventsOutcome: %T", src)
+	}
+	return nil
+}
+
+type NullKycWebhookEventsOutcome struct {
+	KycWebhookEventsOutcome KycWebhookEventsOutcome `json:"kyc_webhook_events_outcome"`
+	Valid                   bool                    `json:"valid"` // Valid is true if KycWebhookEventsOutcome is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullKycWebhookEventsOutcome) Scan(value interface{}) error {
+	if value == nil {
+		ns.KycWebhookEventsOutcome, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.KycWebhookEventsOutcome.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullKycWebhookEventsOutcome) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.KycWebhookEventsOutcome), nil
+}
+
+type LedgerEntriesEntryType string
+
+const (
+	LedgerEntriesEntryTypeDEBIT  LedgerEntriesEntryType = "DEBIT"
+	LedgerEntriesEntryTypeCREDIT LedgerEntriesEntryType = "CREDIT"
+)
+
+func (e *LedgerEntriesEntryType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LedgerEntriesEntryType(s)
+	case string:
+		*e = LedgerEntriesEntryType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LedgerEntriesEntryType: %T", src)
+	}
+	return nil
+}
+
+type NullLedgerEntriesEntryType struct {
+	LedgerEntriesEntryType LedgerEntriesEntryType `json:"ledger_entries_entry_type"`
+	Valid                  bool                   `json:"valid"` // Valid is true if LedgerEntriesEntryType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLedgerEntriesEntryType) Scan(value interface{}) error {
+	if value == nil {
+		ns.LedgerEntriesEntryType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.LedgerEntriesEntryType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLedgerEntriesEntryType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.LedgerEntriesEntryType), nil
+}
+
+type LegalHoldsSubjectType string
+
+const (
+	LegalHoldsSubjectTypeUSER  LegalHoldsSubjectType = "USER"
+	LegalHoldsSubjectTypeORDER LegalHoldsSubjectType = "ORDER"
+)
+
+func (e *LegalHoldsSubjectType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = LegalHoldsSubjectType(s)
+	case string:
+		*e = LegalHoldsSubjectType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for LegalHoldsSubjectType: %T", src)
+	}
+	return nil
+}
+
+type NullLegalHoldsSubjectType struct {
+	LegalHoldsSubjectType LegalHoldsSubjectType `json:"legal_holds_subject_type"`
+	Valid                 bool                  `json:"valid"` // Valid is true if LegalHoldsSubjectType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullLegalHoldsSubjectType) Scan(value interface{}) error {
+	if value == nil {
+		ns.LegalHoldsSubjectType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.LegalHoldsSubjectType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullLegalHoldsSubjectType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.LegalHoldsSubjectType), nil
+}
+
+type OrderDiscountsKind string
+
+const (
+	OrderDiscountsKindVOLUME OrderDiscountsKind = "VOLUME"
+	OrderDiscountsKindCOUPON OrderDiscountsKind = "COUPON"
+)
+
+func (e *OrderDiscountsKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrderDiscountsKind(s)
+	case string:
+		*e = OrderDiscountsKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrderDiscountsKind: %T", src)
+	}
+	return nil
+}
+
+type NullOrderDiscountsKind struct {
+	OrderDiscountsKind OrderDiscountsKind `json:"order_discounts_kind"`
+	Valid              bool               `json:"valid"` // Valid is true if OrderDiscountsKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrderDiscountsKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrderDiscountsKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrderDiscountsKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrderDiscountsKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrderDiscountsKind), nil
+}
+
+type OrderDunningStepsAction string
+
+const (
+	OrderDunningStepsActionREMINDER   OrderDunningStepsAction = "REMINDER"
+	OrderDunningStepsActionCANCEL     OrderDunningStepsAction = "CANCEL"
+	OrderDunningStepsActionCREDITHOLD OrderDunningStepsAction = "CREDIT_HOLD"
+)
+
+func (e *OrderDunningStepsAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrderDunningStepsAction(s)
+	case string:
+		*e = OrderDunningStepsAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrderDunningStepsAction: %T", src)
+	}
+	return nil
+}
+
+type NullOrderDunningStepsAction struct {
+	OrderDunningStepsAction OrderDunningStepsAction `json:"order_dunning_steps_action"`
+	Valid                   bool                    `json:"valid"` // Valid is true if OrderDunningStepsAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrderDunningStepsAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrderDunningStepsAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrderDunningStepsAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrderDunningStepsAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrderDunningStepsAction), nil
+}
+
+type OrderSlaBreachesAction string
+
+const (
+	OrderSlaBreachesActionESCALATE OrderSlaBreachesAction = "ESCALATE"
+	OrderSlaBreachesActionCANCEL   OrderSlaBreachesAction = "CANCEL"
+)
+
+func (e *OrderSlaBreachesAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrderSlaBreachesAction(s)
+	case string:
+		*e = OrderSlaBreachesAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrderSlaBreachesAction: %T", src)
+	}
+	return nil
+}
+
+type NullOrderSlaBreachesAction struct {
+	OrderSlaBreachesAction OrderSlaBreachesAction `json:"order_sla_breaches_action"`
+	Valid                  bool                   `json:"valid"` // Valid is true if OrderSlaBreachesAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrderSlaBreachesAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrderSlaBreachesAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrderSlaBreachesAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrderSlaBreachesAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrderSlaBreachesAction), nil
+}
+
+type OrderSlaBreachesStage string
+
+const (
+	OrderSlaBreachesStageCONFIRM OrderSlaBreachesStage = "CONFIRM"
+	OrderSlaBreachesStageFULFILL OrderSlaBreachesStage = "FULFILL"
+)
+
+func (e *OrderSlaBreachesStage) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrderSlaBreachesStage(s)
+	case string:
+		*e = OrderSlaBreachesStage(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrderSlaBreachesStage: %T", src)
+	}
+	return nil
+}
+
+type NullOrderSlaBreachesStage struct {
+	OrderSlaBreachesStage OrderSlaBreachesStage `json:"order_sla_breaches_stage"`
+	Valid                 bool                  `json:"valid"` // Valid is true if OrderSlaBreachesStage is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrderSlaBreachesStage) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrderSlaBreachesStage, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrderSlaBreachesStage.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrderSlaBreachesStage) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrderSlaBreachesStage), nil
+}
+
+type OrdersStatus string
+
+const (
+	OrdersStatusPENDING        OrdersStatus = "PENDING"
+	OrdersStatusCONFIRMED      OrdersStatus = "CONFIRMED"
+	OrdersStatusPAID           OrdersStatus = "PAID"
+	OrdersStatusSHIPPED        OrdersStatus = "SHIPPED"
+	OrdersStatusCOMPLETED      OrdersStatus = "COMPLETED"
+	OrdersStatusCANCELLED      OrdersStatus = "CANCELLED"
+	OrdersStatusREFUNDED       OrdersStatus = "REFUNDED"
+	OrdersStatusPAYMENTEXPIRED OrdersStatus = "PAYMENT_EXPIRED"
+)
+
+func (e *OrdersStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrdersStatus(s)
+	case string:
+		*e = OrdersStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrdersStatus: %T", src)
+	}
+	return nil
+}
+
+type NullOrdersStatus struct {
+	OrdersStatus OrdersStatus `json:"orders_status"`
+	Valid        bool         `json:"valid"` // Valid is true if OrdersStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrdersStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrdersStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrdersStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrdersStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrdersStatus), nil
+}
+
+type OrganizationInvitationsRole string
+
+const (
+	OrganizationInvitationsRoleOWNER    OrganizationInvitationsRole = "OWNER"
+	OrganizationInvitationsRoleFINANCE  OrganizationInvitationsRole = "FINANCE"
+	OrganizationInvitationsRoleOPERATOR OrganizationInvitationsRole = "OPERATOR"
+	OrganizationInvitationsRoleVIEWER   OrganizationInvitationsRole = "VIEWER"
+)
+
+func (e *OrganizationInvitationsRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrganizationInvitationsRole(s)
+	case string:
+		*e = OrganizationInvitationsRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrganizationInvitationsRole: %T", src)
+	}
+	return nil
+}
+
+type NullOrganizationInvitationsRole struct {
+	OrganizationInvitationsRole OrganizationInvitationsRole `json:"organization_invitations_role"`
+	Valid                       bool                        `json:"valid"` // Valid is true if OrganizationInvitationsRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrganizationInvitationsRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrganizationInvitationsRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrganizationInvitationsRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrganizationInvitationsRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrganizationInvitationsRole), nil
+}
+
+type OrganizationInvitationsStatus string
+
+const (
+	OrganizationInvitationsStatusPENDING  OrganizationInvitationsStatus = "PENDING"
+	OrganizationInvitationsStatusACCEPTED OrganizationInvitationsStatus = "ACCEPTED"
+	OrganizationInvitationsStatusREVOKED  OrganizationInvitationsStatus = "REVOKED"
+)
+
+func (e *OrganizationInvitationsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrganizationInvitationsStatus(s)
+	case string:
+		*e = OrganizationInvitationsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrganizationInvitationsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullOrganizationInvitationsStatus struct {
+	OrganizationInvitationsStatus OrganizationInvitationsStatus `json:"organization_invitations_status"`
+	Valid                         bool                          `json:"valid"` // Valid is true if OrganizationInvitationsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrganizationInvitationsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrganizationInvitationsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrganizationInvitationsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrganizationInvitationsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrganizationInvitationsStatus), nil
+}
+
+type OrganizationMembersRole string
+
+const (
+	OrganizationMembersRoleOWNER    OrganizationMembersRole = "OWNER"
+	OrganizationMembersRoleFINANCE  OrganizationMembersRole = "FINANCE"
+	OrganizationMembersRoleOPERATOR OrganizationMembersRole = "OPERATOR"
+	OrganizationMembersRoleVIEWER   OrganizationMembersRole = "VIEWER"
+)
+
+func (e *OrganizationMembersRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrganizationMembersRole(s)
+	case string:
+		*e = OrganizationMembersRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrganizationMembersRole: %T", src)
+	}
+	return nil
+}
+
+type NullOrganizationMembersRole struct {
+	OrganizationMembersRole OrganizationMembersRole `json:"organization_members_role"`
+	Valid                   bool                    `json:"valid"` // Valid is true if OrganizationMembersRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrganizationMembersRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrganizationMembersRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrganizationMembersRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrganizationMembersRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrganizationMembersRole), nil
+}
+
+type OrganizationsStatus string
+
+const (
+	OrganizationsStatusACTIVE    OrganizationsStatus = "ACTIVE"
+	OrganizationsStatusSUSPENDED OrganizationsStatus = "SUSPENDED"
+)
+
+func (e *OrganizationsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OrganizationsStatus(s)
+	case string:
+		*e = OrganizationsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OrganizationsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullOrganizationsStatus struct {
+	OrganizationsStatus OrganizationsStatus `json:"organizations_status"`
+	Valid               bool                `json:"valid"` // Valid is true if OrganizationsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOrganizationsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.OrganizationsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OrganizationsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOrganizationsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OrganizationsStatus), nil
+}
+
+type OutboxStatus string
+
+const (
+	OutboxStatusPENDING    OutboxStatus = "PENDING"
+	OutboxStatusPROCESSING OutboxStatus = "PROCESSING"
+	OutboxStatusCOMPLETED  OutboxStatus = "COMPLETED"
+	OutboxStatusFAILED     OutboxStatus = "FAILED"
+	OutboxStatusDEADLETTER OutboxStatus = "DEAD_LETTER"
+)
+
+func (e *OutboxStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = OutboxStatus(s)
+	case string:
+		*e = OutboxStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for OutboxStatus: %T", src)
+	}
+	return nil
+}
+
+type NullOutboxStatus struct {
+	OutboxStatus OutboxStatus `json:"outbox_status"`
+	Valid        bool         `json:"valid"` // Valid is true if OutboxStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullOutboxStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.OutboxStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.OutboxStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullOutboxStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.OutboxStatus), nil
+}
+
+type PaymentRefundsMethod string
+
+const (
+	PaymentRefundsMethodLEDGER  PaymentRefundsMethod = "LEDGER"
+	PaymentRefundsMethodONCHAIN PaymentRefundsMethod = "ON_CHAIN"
+)
+
+func (e *PaymentRefundsMethod) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PaymentRefundsMethod(s)
+	case string:
+		*e = PaymentRefundsMethod(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PaymentRefundsMethod: %T", src)
+	}
+	return nil
+}
+
+type NullPaymentRefundsMethod struct {
+	PaymentRefundsMethod PaymentRefundsMethod `json:"payment_refunds_method"`
+	Valid                bool                 `json:"valid"` // Valid is true if PaymentRefundsMethod is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPaymentRefundsMethod) Scan(value interface{}) error {
+	if value == nil {
+		ns.PaymentRefundsMethod, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PaymentRefundsMethod.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPaymentRefundsMethod) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PaymentRefundsMethod), nil
+}
+
+type PaymentRefundsStatus string
+
+const (
+	PaymentRefundsStatusPENDING      PaymentRefundsStatus = "PENDING"
+	PaymentRefundsStatusSUCCEEDED    PaymentRefundsStatus = "SUCCEEDED"
+	PaymentRefundsStatusFAILED       PaymentRefundsStatus = "FAILED"
+	PaymentRefundsStatusRETURNFAILED PaymentRefundsStatus = "RETURN_FAILED"
+)
+
+func (e *PaymentRefundsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PaymentRefundsStatus(s)
+	case string:
+		*e = PaymentRefundsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PaymentRefundsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullPaymentRefundsStatus struct {
+	PaymentRefundsStatus PaymentRefundsStatus `json:"payment_refunds_status"`
+	Valid                bool                 `json:"valid"` // Valid is true if PaymentRefundsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPaymentRefundsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.PaymentRefundsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PaymentRefundsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPaymentRefundsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PaymentRefundsStatus), nil
+}
+
+type PaymentsStatus string
+
+const (
+	PaymentsStatusPENDING    PaymentsStatus = "PENDING"
+	PaymentsStatusAUTHORIZED PaymentsStatus = "AUTHORIZED"
+	PaymentsStatusCAPTURED   PaymentsStatus = "CAPTURED"
+	PaymentsStatusVOIDED     PaymentsStatus = "VOIDED"
+	PaymentsStatusREFUNDED   PaymentsStatus = "REFUNDED"
+	PaymentsStatusFAILED     PaymentsStatus = "FAILED"
+	PaymentsStatusEXPIRED    PaymentsStatus = "EXPIRED"
+)
+
+func (e *PaymentsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PaymentsStatus(s)
+	case string:
+		*e = PaymentsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PaymentsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullPaymentsStatus struct {
+	PaymentsStatus PaymentsStatus `json:"payments_status"`
+	Valid          bool           `json:"valid"` // Valid is true if PaymentsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPaymentsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.PaymentsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PaymentsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPaymentsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PaymentsStatus), nil
+}
+
+type PayoutApprovalsPayoutType string
+
+const (
+	PayoutApprovalsPayoutTypeSETTLEMENTBATCH PayoutApprovalsPayoutType = "SETTLEMENT_BATCH"
+	PayoutApprovalsPayoutTypeNETPAYOUT       PayoutApprovalsPayoutType = "NET_PAYOUT"
+)
+
+func (e *PayoutApprovalsPayoutType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PayoutApprovalsPayoutType(s)
+	case string:
+		*e = PayoutApprovalsPayoutType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PayoutApprovalsPayoutType: %T", src)
+	}
+	return nil
+}
+
+type NullPayoutApprovalsPayoutType struct {
+	PayoutApprovalsPayoutType PayoutApprovalsPayoutType `json:"payout_approvals_payout_type"`
+	Valid                     bool                      `json:"valid"` // Valid is true if PayoutApprovalsPayoutType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPayoutApprovalsPayoutType) Scan(value interface{}) error {
+	if value == nil {
+		ns.PayoutApprovalsPayoutType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PayoutApprovalsPayoutType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPayoutApprovalsPayoutType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PayoutApprovalsPayoutType), nil
+}
+
+type PayoutApprovalsStatus string
+
+const (
+	PayoutApprovalsStatusPENDING  PayoutApprovalsStatus = "PENDING"
+	PayoutApprovalsStatusAPPROVED PayoutApprovalsStatus = "APPROVED"
+	PayoutApprovalsStatusREJECTED PayoutApprovalsStatus = "REJECTED"
+)
+
+func (e *PayoutApprovalsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PayoutApprovalsStatus(s)
+	case string:
+		*e = PayoutApprovalsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PayoutApprovalsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullPayoutApprovalsStatus struct {
+	PayoutApprovalsStatus PayoutApprovalsStatus `json:"payout_approvals_status"`
+	Valid                 bool                  `json:"valid"` // Valid is true if PayoutApprovalsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPayoutApprovalsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.PayoutApprovalsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PayoutApprovalsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPayoutApprovalsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PayoutApprovalsStatus), nil
+}
+
+type ProductsStatus string
+
+const (
+	ProductsStatusACTIVE   ProductsStatus = "ACTIVE"
+	ProductsStatusINACTIVE ProductsStatus = "INACTIVE"
+)
+
+func (e *ProductsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ProductsStatus(s)
+	case string:
+		*e = ProductsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ProductsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullProductsStatus struct {
+	ProductsStatus ProductsStatus `json:"products_status"`
+	Valid          bool           `json:"valid"` // Valid is true if ProductsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullProductsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.ProductsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ProductsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullProductsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ProductsStatus), nil
+}
+
+type PurchaseOrdersStatus string
+
+const (
+	PurchaseOrdersStatusSUBMITTED PurchaseOrdersStatus = "SUBMITTED"
+	PurchaseOrdersStatusCOUNTERED PurchaseOrdersStatus = "COUNTERED"
+	PurchaseOrdersStatusACCEPTED  PurchaseOrdersStatus = "ACCEPTED"
+	PurchaseOrdersStatusREJECTED  PurchaseOrdersStatus = "REJECTED"
+	PurchaseOrdersStatusCANCELLED PurchaseOrdersStatus = "CANCELLED"
+)
+
+func (e *PurchaseOrdersStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PurchaseOrdersStatus(s)
+	case string:
+		*e = PurchaseOrdersStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PurchaseOrdersStatus: %T", src)
+	}
+	return nil
+}
+
+type NullPurchaseOrdersStatus struct {
+	PurchaseOrdersStatus PurchaseOrdersStatus `json:"purchase_orders_status"`
+	Valid                bool                 `json:"valid"` // Valid is true if PurchaseOrdersStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPurchaseOrdersStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.PurchaseOrdersStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PurchaseOrdersStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPurchaseOrdersStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PurchaseOrdersStatus), nil
+}
+
+type RetentionRunsStatus string
+
+const (
+	RetentionRunsStatusSUCCEEDED RetentionRunsStatus = "SUCCEEDED"
+	RetentionRunsStatusFAILED    RetentionRunsStatus = "FAILED"
+)
+
+func (e *RetentionRunsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RetentionRunsStatus(s)
+	case string:
+		*e = RetentionRunsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RetentionRunsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullRetentionRunsStatus struct {
+	RetentionRunsStatus RetentionRunsStatus `json:"retention_runs_status"`
+	Valid               bool                `json:"valid"` // Valid is true if RetentionRunsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRetentionRunsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.RetentionRunsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RetentionRunsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRetentionRunsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RetentionRunsStatus), nil
+}
+
+type RetentionRunsTriggerType string
+
+const (
+	RetentionRunsTriggerTypeSCHEDULE RetentionRunsTriggerType = "SCHEDULE"
+	RetentionRunsTriggerTypeMANUAL   RetentionRunsTriggerType = "MANUAL"
+)
+
+func (e *RetentionRunsTriggerType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RetentionRunsTriggerType(s)
+	case string:
+		*e = RetentionRunsTriggerType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RetentionRunsTriggerType: %T", src)
+	}
+	return nil
+}
+
+type NullRetentionRunsTriggerType struct {
+	RetentionRunsTriggerType RetentionRunsTriggerType `json:"retention_runs_trigger_type"`
+	Valid                    bool                     `json:"valid"` // Valid is true if RetentionRunsTriggerType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRetentionRunsTriggerType) Scan(value interface{}) error {
+	if value == nil {
+		ns.RetentionRunsTriggerType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RetentionRunsTriggerType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRetentionRunsTriggerType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RetentionRunsTriggerType), nil
+}
+
+type RfqQuotesStatus string
+
+const (
+	RfqQuotesStatusACTIVE    RfqQuotesStatus = "ACTIVE"
+	RfqQuotesStatusWITHDRAWN RfqQuotesStatus = "WITHDRAWN"
+	RfqQuotesStatusACCEPTED  RfqQuotesStatus = "ACCEPTED"
+	RfqQuotesStatusDECLINED  RfqQuotesStatus = "DECLINED"
+)
+
+func (e *RfqQuotesStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RfqQuotesStatus(s)
+	case string:
+		*e = RfqQuotesStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RfqQuotesStatus: %T", src)
+	}
+	return nil
+}
+
+type NullRfqQuotesStatus struct {
+	RfqQuotesStatus RfqQuotesStatus `json:"rfq_quotes_status"`
+	Valid           bool            `json:"valid"` // Valid is true if RfqQuotesStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRfqQuotesStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.RfqQuotesStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RfqQuotesStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRfqQuotesStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RfqQuotesStatus), nil
+}
+
+type RfqsStatus string
+
+const (
+	RfqsStatusOPEN      RfqsStatus = "OPEN"
+	RfqsStatusAWARDED   RfqsStatus = "AWARDED"
+	RfqsStatusCANCELLED RfqsStatus = "CANCELLED"
+)
+
+func (e *RfqsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RfqsStatus(s)
+	case string:
+		*e = RfqsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RfqsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullRfqsStatus struct {
+	RfqsStatus RfqsStatus `json:"rfqs_status"`
+	Valid      bool       `json:"valid"` // Valid is true if RfqsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRfqsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.RfqsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RfqsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRfqsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RfqsStatus), nil
+}
+
+type RmasStatus string
+
+const (
+	RmasStatusREQUESTED RmasStatus = "REQUESTED"
+	RmasStatusAPPROVED  RmasStatus = "APPROVED"
+	RmasStatusREJECTED  RmasStatus = "REJECTED"
+	RmasStatusCANCELLED RmasStatus = "CANCELLED"
+)
+
+func (e *RmasStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = RmasStatus(s)
+	case string:
+		*e = RmasStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for RmasStatus: %T", src)
+	}
+	return nil
+}
+
+type NullRmasStatus struct {
+	RmasStatus RmasStatus `json:"rmas_status"`
+	Valid      bool       `json:"valid"` // Valid is true if RmasStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullRmasStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.RmasStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.RmasStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullRmasStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.RmasStatus), nil
+}
+
+type SettlementBatchesCadence string
+
+const (
+	SettlementBatchesCadenceINSTANT SettlementBatchesCadence = "INSTANT"
+	SettlementBatchesCadenceDAILY   SettlementBatchesCadence = "DAILY"
+	SettlementBatchesCadenceWEEKLY  SettlementBatchesCadence = "WEEKLY"
+)
+
+func (e *SettlementBatchesCadence) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SettlementBatchesCadence(s)
+	case string:
+		*e = SettlementBatchesCadence(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SettlementBatchesCadence: %T", src)
+	}
+	return nil
+}
+
+type NullSettlementBatchesCadence struct {
+	SettlementBatchesCadence SettlementBatchesCadence `json:"settlement_batches_cadence"`
+	Valid                    bool                     `json:"valid"` // Valid is true if SettlementBatchesCadence is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSettlementBatchesCadence) Scan(value interface{}) error {
+	if value == nil {
+		ns.SettlementBatchesCadence, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SettlementBatchesCadence.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSettlementBatchesCadence) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SettlementBatchesCadence), nil
+}
+
+type SettlementBatchesStatus string
+
+const (
+	SettlementBatchesStatusPENDING    SettlementBatchesStatus = "PENDING"
+	SettlementBatchesStatusPROCESSING SettlementBatchesStatus = "PROCESSING"
+	SettlementBatchesStatusCOMPLETED  SettlementBatchesStatus = "COMPLETED"
+	SettlementBatchesStatusFAILED     SettlementBatchesStatus = "FAILED"
+)
+
+func (e *SettlementBatchesStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SettlementBatchesStatus(s)
+	case string:
+		*e = SettlementBatchesStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SettlementBatchesStatus: %T", src)
+	}
+	return nil
+}
+
+type NullSettlementBatchesStatus struct {
+	SettlementBatchesStatus SettlementBatchesStatus `json:"settlement_batches_status"`
+	Valid                   bool                    `json:"valid"` // Valid is true if SettlementBatchesStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSettlementBatchesStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.SettlementBatchesStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SettlementBatchesStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSettlementBatchesStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SettlementBatchesStatus), nil
+}
+
+type SettlementNetPayoutsStatus string
+
+const (
+	SettlementNetPayoutsStatusPENDING    SettlementNetPayoutsStatus = "PENDING"
+	SettlementNetPayoutsStatusPROCESSING SettlementNetPayoutsStatus = "PROCESSING"
+	SettlementNetPayoutsStatusCOMPLETED  SettlementNetPayoutsStatus = "COMPLETED"
+	SettlementNetPayoutsStatusFAILED     SettlementNetPayoutsStatus = "FAILED"
+	SettlementNetPayoutsStatusOFFSET     SettlementNetPayoutsStatus = "OFFSET"
+)
+
+func (e *SettlementNetPayoutsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SettlementNetPayoutsStatus(s)
+	case string:
+		*e = SettlementNetPayoutsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SettlementNetPayoutsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullSettlementNetPayoutsStatus struct {
+	SettlementNetPayoutsStatus SettlementNetPayoutsStatus `json:"settlement_net_payouts_status"`
+	Valid                      bool                       `json:"valid"` // Valid is true if SettlementNetPayoutsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSettlementNetPayoutsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.SettlementNetPayoutsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SettlementNetPayoutsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSettlementNetPayoutsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SettlementNetPayoutsStatus), nil
+}
+
+type SettlementSchedulesCadence string
+
+const (
+	SettlementSchedulesCadenceINSTANT SettlementSchedulesCadence = "INSTANT"
+	SettlementSchedulesCadenceDAILY   SettlementSchedulesCadence = "DAILY"
+	SettlementSchedulesCadenceWEEKLY  SettlementSchedulesCadence = "WEEKLY"
+)
+
+func (e *SettlementSchedulesCadence) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SettlementSchedulesCadence(s)
+	case string:
+		*e = SettlementSchedulesCadence(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SettlementSchedulesCadence: %T", src)
+	}
+	return nil
+}
+
+type NullSettlementSchedulesCadence struct {
+	SettlementSchedulesCadence SettlementSchedulesCadence `json:"settlement_schedules_cadence"`
+	Valid                      bool                       `json:"valid"` // Valid is true if SettlementSchedulesCadence is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSettlementSchedulesCadence) Scan(value interface{}) error {
+	if value == nil {
+		ns.SettlementSchedulesCadence, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SettlementSchedulesCadence.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSettlementSchedulesCadence) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SettlementSchedulesCadence), nil
+}
+
+type SettlementsStatus string
+
+const (
+	SettlementsStatusPENDING    SettlementsStatus = "PENDING"
+	SettlementsStatusPROCESSING SettlementsStatus = "PROCESSING"
+	SettlementsStatusCOMPLETED  SettlementsStatus = "COMPLETED"
+	SettlementsStatusFAILED     SettlementsStatus = "FAILED"
+)
+
+func (e *SettlementsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SettlementsStatus(s)
+	case string:
+		*e = SettlementsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SettlementsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullSettlementsStatus struct {
+	SettlementsStatus SettlementsStatus `json:"settlements_status"`
+	Valid             bool              `json:"valid"` // Valid is true if SettlementsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSettlementsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.SettlementsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SettlementsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSettlementsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SettlementsStatus), nil
+}
+
+type StatusIncidentUpdatesStatus string
+
+const (
+	StatusIncidentUpdatesStatusINVESTIGATING StatusIncidentUpdatesStatus = "INVESTIGATING"
+	StatusIncidentUpdatesStatusIDENTIFIED    StatusIncidentUpdatesStatus = "IDENTIFIED"
+	StatusIncidentUpdatesStatusMONITORING    StatusIncidentUpdatesStatus = "MONITORING"
+	StatusIncidentUpdatesStatusRESOLVED      StatusIncidentUpdatesStatus = "RESOLVED"
+)
+
+func (e *StatusIncidentUpdatesStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StatusIncidentUpdatesStatus(s)
+	case string:
+		*e = StatusIncidentUpdatesStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StatusIncidentUpdatesStatus: %T", src)
+	}
+	return nil
+}
+
+type NullStatusIncidentUpdatesStatus struct {
+	StatusIncidentUpdatesStatus StatusIncidentUpdatesStatus `json:"status_incident_updates_status"`
+	Valid                       bool                        `json:"valid"` // Valid is true if StatusIncidentUpdatesStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStatusIncidentUpdatesStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.StatusIncidentUpdatesStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StatusIncidentUpdatesStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStatusIncidentUpdatesStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StatusIncidentUpdatesStatus), nil
+}
+
+type StatusIncidentsImpact string
+
+const (
+	StatusIncidentsImpactMINOR    StatusIncidentsImpact = "MINOR"
+	StatusIncidentsImpactMAJOR    StatusIncidentsImpact = "MAJOR"
+	StatusIncidentsImpactCRITICAL StatusIncidentsImpact = "CRITICAL"
+)
+
+func (e *StatusIncidentsImpact) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StatusIncidentsImpact(s)
+	case string:
+		*e = StatusIncidentsImpact(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StatusIncidentsImpact: %T", src)
+	}
+	return nil
+}
+
+type NullStatusIncidentsImpact struct {
+	StatusIncidentsImpact StatusIncidentsImpact `json:"status_incidents_impact"`
+	Valid                 bool                  `json:"valid"` // Valid is true if StatusIncidentsImpact is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStatusIncidentsImpact) Scan(value interface{}) error {
+	if value == nil {
+		ns.StatusIncidentsImpact, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StatusIncidentsImpact.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStatusIncidentsImpact) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StatusIncidentsImpact), nil
+}
+
+type StatusIncidentsStatus string
+
+const (
+	StatusIncidentsStatusINVESTIGATING StatusIncidentsStatus = "INVESTIGATING"
+	StatusIncidentsStatusIDENTIFIED    StatusIncidentsStatus = "IDENTIFIED"
+	StatusIncidentsStatusMONITORING    StatusIncidentsStatus = "MONITORING"
+	StatusIncidentsStatusRESOLVED      StatusIncidentsStatus = "RESOLVED"
+)
+
+func (e *StatusIncidentsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StatusIncidentsStatus(s)
+	case string:
+		*e = StatusIncidentsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StatusIncidentsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullStatusIncidentsStatus struct {
+	StatusIncidentsStatus StatusIncidentsStatus `json:"status_incidents_status"`
+	Valid                 bool                  `json:"valid"` // Valid is true if StatusIncidentsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStatusIncidentsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.StatusIncidentsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StatusIncidentsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStatusIncidentsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StatusIncidentsStatus), nil
+}
+
+type StockMovementsReason string
+
+const (
+	StockMovementsReasonRESERVATION StockMovementsReason = "RESERVATION"
+	StockMovementsReasonRELEASE     StockMovementsReason = "RELEASE"
+	StockMovementsReasonADJUSTMENT  StockMovementsReason = "ADJUSTMENT"
+	StockMovementsReasonFULFILLMENT StockMovementsReason = "FULFILLMENT"
+	StockMovementsReasonRETURN      StockMovementsReason = "RETURN"
+	StockMovementsReasonTRANSFEROUT StockMovementsReason = "TRANSFER_OUT"
+	StockMovementsReasonTRANSFERIN  StockMovementsReason = "TRANSFER_IN"
+)
+
+func (e *StockMovementsReason) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = StockMovementsReason(s)
+	case string:
+		*e = StockMovementsReason(s)
+	default:
+		return fmt.Errorf("unsupported scan type for StockMovementsReason: %T", src)
+	}
+	return nil
+}
+
+type NullStockMovementsReason struct {
+	StockMovementsReason StockMovementsReason `json:"stock_movements_reason"`
+	Valid                bool                 `json:"valid"` // Valid is true if StockMovementsReason is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullStockMovementsReason) Scan(value interface{}) error {
+	if value == nil {
+		ns.StockMovementsReason, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.StockMovementsReason.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullStockMovementsReason) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.StockMovementsReason), nil
+}
+
+type SupportCasesStatus string
+
+const (
+	SupportCasesStatusOPEN     SupportCasesStatus = "OPEN"
+	SupportCasesStatusPENDING  SupportCasesStatus = "PENDING"
+	SupportCasesStatusRESOLVED SupportCasesStatus = "RESOLVED"
+	SupportCasesStatusCLOSED   SupportCasesStatus = "CLOSED"
+)
+
+func (e *SupportCasesStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SupportCasesStatus(s)
+	case string:
+		*e = SupportCasesStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SupportCasesStatus: %T", src)
+	}
+	return nil
+}
+
+type NullSupportCasesStatus struct {
+	SupportCasesStatus SupportCasesStatus `json:"support_cases_status"`
+	Valid              bool               `json:"valid"` // Valid is true if SupportCasesStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSupportCasesStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.SupportCasesStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SupportCasesStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSupportCasesStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SupportCasesStatus), nil
+}
+
+type SupportCasesSubjectType string
+
+const (
+	SupportCasesSubjectTypeORDER   SupportCasesSubjectType = "ORDER"
+	SupportCasesSubjectTypePAYMENT SupportCasesSubjectType = "PAYMENT"
+)
+
+func (e *SupportCasesSubjectType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SupportCasesSubjectType(s)
+	case string:
+		*e = SupportCasesSubjectType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SupportCasesSubjectType: %T", src)
+	}
+	return nil
+}
+
+type NullSupportCasesSubjectType struct {
+	SupportCasesSubjectType SupportCasesSubjectType `json:"support_cases_subject_type"`
+	Valid                   bool                    `json:"valid"` // Valid is true if SupportCasesSubjectType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSupportCasesSubjectType) Scan(value interface{}) error {
+	if value == nil {
+		ns.SupportCasesSubjectType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SupportCasesSubjectType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSupportCasesSubjectType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SupportCasesSubjectType), nil
+}
+
+type SystemWalletsWalletType string
+
+const (
+	SystemWalletsWalletTypeTREASURY   SystemWalletsWalletType = "TREASURY"
+	SystemWalletsWalletTypeMINTER     SystemWalletsWalletType = "MINTER"
+	SystemWalletsWalletTypeBURNER     SystemWalletsWalletType = "BURNER"
+	SystemWalletsWalletTypeHOTWALLET  SystemWalletsWalletType = "HOT_WALLET"
+	SystemWalletsWalletTypeCOLDWALLET SystemWalletsWalletType = "COLD_WALLET"
+)
+
+func (e *SystemWalletsWalletType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = SystemWalletsWalletType(s)
+	case string:
+		*e = SystemWalletsWalletType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for SystemWalletsWalletType: %T", src)
+	}
+	return nil
+}
+
+type NullSystemWalletsWalletType struct {
+	SystemWalletsWalletType SystemWalletsWalletType `json:"system_wallets_wallet_type"`
+	Valid                   bool                    `json:"valid"` // Valid is true if SystemWalletsWalletType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullSystemWalletsWalletType) Scan(value interface{}) error {
+	if value == nil {
+		ns.SystemWalletsWalletType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.SystemWalletsWalletType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullSystemWalletsWalletType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.SystemWalletsWalletType), nil
+}
+
+type TaxLinesDocumentType string
+
+const (
+	TaxLinesDocumentTypeORDER   TaxLinesDocumentType = "ORDER"
+	TaxLinesDocumentTypeINVOICE TaxLinesDocumentType = "INVOICE"
+)
+
+func (e *TaxLinesDocumentType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TaxLinesDocumentType(s)
+	case string:
+		*e = TaxLinesDocumentType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TaxLinesDocumentType: %T", src)
+	}
+	return nil
+}
+
+type NullTaxLinesDocumentType struct {
+	TaxLinesDocumentType TaxLinesDocumentType `json:"tax_lines_document_type"`
+	Valid                bool                 `json:"valid"` // Valid is true if TaxLinesDocumentType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTaxLinesDocumentType) Scan(value interface{}) error {
+	if value == nil {
+		ns.TaxLinesDocumentType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TaxLinesDocumentType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTaxLinesDocumentType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TaxLinesDocumentType), nil
+}
+
+type UserImportRowsStatus string
+
+const (
+	UserImportRowsStatusPENDING   UserImportRowsStatus = "PENDING"
+	UserImportRowsStatusCREATED   UserImportRowsStatus = "CREATED"
+	UserImportRowsStatusDUPLICATE UserImportRowsStatus = "DUPLICATE"
+	UserImportRowsStatusFAILED    UserImportRowsStatus = "FAILED"
+)
+
+func (e *UserImportRowsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UserImportRowsStatus(s)
+	case string:
+		*e = UserImportRowsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UserImportRowsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullUserImportRowsStatus struct {
+	UserImportRowsStatus UserImportRowsStatus `json:"user_import_rows_status"`
+	Valid                bool                 `json:"valid"` // Valid is true if UserImportRowsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullUserImportRowsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.UserImportRowsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UserImportRowsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullUserImportRowsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.UserImportRowsStatus), nil
+}
+
+type UserImportsSource string
+
+const (
+	UserImportsSourceJSON UserImportsSource = "JSON"
+	UserImportsSourceCSV  UserImportsSource = "CSV"
+)
+
+func (e *UserImportsSource) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UserImportsSource(s)
+	case string:
+		*e = UserImportsSource(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UserImportsSource: %T", src)
+	}
+	return nil
+}
+
+type NullUserImportsSource struct {
+	UserImportsSource UserImportsSource `json:"user_imports_source"`
+	Valid             bool              `json:"valid"` // Valid is true if UserImportsSource is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullUserImportsSource) Scan(value interface{}) error {
+	if value == nil {
+		ns.UserImportsSource, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UserImportsSource.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullUserImportsSource) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.UserImportsSource), nil
+}
+
+type UserImportsStatus string
+
+const (
+	UserImportsStatusINPROGRESS UserImportsStatus = "IN_PROGRESS"
+	UserImportsStatusCOMPLETED  UserImportsStatus = "COMPLETED"
+)
+
+func (e *UserImportsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UserImportsStatus(s)
+	case string:
+		*e = UserImportsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UserImportsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullUserImportsStatus struct {
+	UserImportsStatus UserImportsStatus `json:"user_imports_status"`
+	Valid             bool              `json:"valid"` // Valid is true if UserImportsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullUserImportsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.UserImportsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UserImportsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullUserImportsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.UserImportsStatus), nil
+}
+
+type UsersKycStatus string
+
+const (
+	UsersKycStatusNONE     UsersKycStatus = "NONE"
+	UsersKycStatusPENDING  UsersKycStatus = "PENDING"
+	UsersKycStatusVERIFIED UsersKycStatus = "VERIFIED"
+	UsersKycStatusREJECTED UsersKycStatus = "REJECTED"
+)
+
+func (e *UsersKycStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UsersKycStatus(s)
+	case string:
+		*e = UsersKycStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UsersKycStatus: %T", src)
+	}
+	return nil
+}
+
+type NullUsersKycStatus struct {
+	UsersKycStatus UsersKycStatus `json:"users_kyc_status"`
+	Valid          bool           `json:"valid"` // Valid is true if UsersKycStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullUsersKycStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.UsersKycStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UsersKycStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullUsersKycStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.UsersKycStatus), nil
+}
+
+type UsersRole string
+
+const (
+	UsersRoleBUYER  UsersRole = "BUYER"
+	UsersRoleSELLER UsersRole = "SELLER"
+	UsersRoleBOTH   UsersRole = "BOTH"
+	UsersRoleADMIN  UsersRole = "ADMIN"
+)
+
+func (e *UsersRole) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UsersRole(s)
+	case string:
+		*e = UsersRole(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UsersRole: %T", src)
+	}
+	return nil
+}
+
+type NullUsersRole struct {
+	UsersRole UsersRole `json:"users_role"`
+	Valid     bool      `json:"valid"` // Valid is true if UsersRole is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullUsersRole) Scan(value interface{}) error {
+	if value == nil {
+		ns.UsersRole, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UsersRole.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullUsersRole) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.UsersRole), nil
+}
+
+type UsersStatus string
+
+const (
+	UsersStatusACTIVE    UsersStatus = "ACTIVE"
+	UsersStatusSUSPENDED UsersStatus = "SUSPENDED"
+	UsersStatusDELETED   UsersStatus = "DELETED"
+)
+
+func (e *UsersStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = UsersStatus(s)
+	case string:
+		*e = UsersStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for UsersStatus: %T", src)
+	}
+	return nil
+}
+
+type NullUsersStatus struct {
+	UsersStatus UsersStatus `json:"users_status"`
 	Valid       bool        `json:"valid"` // Valid is true if UsersStatus is not NULL
 }
 
 // Scan implements the Scanner interface.
-func (ns *NullUsersStatus) Scan(value interface{}) error {
+func (ns *NullUsersStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.UsersStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.UsersStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullUsersStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.UsersStatus), nil
+}
+
+type WalletMicroTransfersStatus string
+
+const (
+	WalletMicroTransfersStatusPENDING   WalletMicroTransfersStatus = "PENDING"
+	WalletMicroTransfersStatusSENT      WalletMicroTransfersStatus = "SENT"
+	WalletMicroTransfersStatusCONFIRMED WalletMicroTransfersStatus = "CONFIRMED"
+	WalletMicroTransfersStatusFAILED    WalletMicroTransfersStatus = "FAILED"
+)
+
+func (e *WalletMicroTransfersStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WalletMicroTransfersStatus(s)
+	case string:
+		*e = WalletMicroTransfersStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WalletMicroTransfersStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWalletMicroTransfersStatus struct {
+	WalletMicroTransfersStatus WalletMicroTransfersStatus `json:"wallet_micro_transfers_status"`
+	Valid                      bool                       `json:"valid"` // Valid is true if WalletMicroTransfersStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWalletMicroTransfersStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WalletMicroTransfersStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WalletMicroTransfersStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWalletMicroTransfersStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WalletMicroTransfersStatus), nil
+}
+
+type WalletsEnsStatus string
+
+const (
+	WalletsEnsStatusRESOLVED   WalletsEnsStatus = "RESOLVED"
+	WalletsEnsStatusCHANGED    WalletsEnsStatus = "CHANGED"
+	WalletsEnsStatusUNRESOLVED WalletsEnsStatus = "UNRESOLVED"
+)
+
+func (e *WalletsEnsStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WalletsEnsStatus(s)
+	case string:
+		*e = WalletsEnsStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WalletsEnsStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWalletsEnsStatus struct {
+	WalletsEnsStatus WalletsEnsStatus `json:"wallets_ens_status"`
+	Valid            bool             `json:"valid"` // Valid is true if WalletsEnsStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWalletsEnsStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WalletsEnsStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WalletsEnsStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWalletsEnsStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WalletsEnsStatus), nil
+}
+
+type WalletsVerificationLevel string
+
+const (
+	WalletsVerificationLevelNONE          WalletsVerificationLevel = "NONE"
+	WalletsVerificationLevelATTESTED      WalletsVerificationLevel = "ATTESTED"
+	WalletsVerificationLevelSIGNATURE     WalletsVerificationLevel = "SIGNATURE"
+	WalletsVerificationLevelMICROTRANSFER WalletsVerificationLevel = "MICRO_TRANSFER"
+)
+
+func (e *WalletsVerificationLevel) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WalletsVerificationLevel(s)
+	case string:
+		*e = WalletsVerificationLevel(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WalletsVerificationLevel: %T", src)
+	}
+	return nil
+}
+
+type NullWalletsVerificationLevel struct {
+	WalletsVerificationLevel WalletsVerificationLevel `json:"wallets_verification_level"`
+	Valid                    bool                     `json:"valid"` // Valid is true if WalletsVerificationLevel is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWalletsVerificationLevel) Scan(value interface{}) error {
+	if value == nil {
+		ns.WalletsVerificationLevel, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WalletsVerificationLevel.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWalletsVerificationLevel) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WalletsVerificationLevel), nil
+}
+
+type WarehousesStatus string
+
+const (
+	WarehousesStatusACTIVE   WarehousesStatus = "ACTIVE"
+	WarehousesStatusINACTIVE WarehousesStatus = "INACTIVE"
+)
+
+func (e *WarehousesStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WarehousesStatus(s)
+	case string:
+		*e = WarehousesStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WarehousesStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWarehousesStatus struct {
+	WarehousesStatus WarehousesStatus `json:"warehouses_status"`
+	Valid            bool             `json:"valid"` // Valid is true if WarehousesStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWarehousesStatus) Scan(value interface{}) error {
 	if value == nil {
-		ns.UsersStatus, ns.Valid = "", false
+		ns.WarehousesStatus, ns.Valid = "", false
 		return nil
 	}
 	ns.Valid = true
-	return ns.UsersStatus.Scan(value)
+	return ns.WarehousesStatus.Scan(value)
 }
 
 // Value implements the driver Valuer interface.
-func (ns NullUsersStatus) Value() (driver.Value, error) {
+func (ns NullWarehousesStatus) Value() (driver.Value, error) {
 	if !ns.Valid {
 		return nil, nil
 	}
-	return string(ns.UsersStatus), nil
+	return string(ns.WarehousesStatus), nil
+}
+
+type WebhookDeliveriesStatus string
+
+const (
+	WebhookDeliveriesStatusPENDING    WebhookDeliveriesStatus = "PENDING"
+	WebhookDeliveriesStatusDELIVERING WebhookDeliveriesStatus = "DELIVERING"
+	WebhookDeliveriesStatusSUCCEEDED  WebhookDeliveriesStatus = "SUCCEEDED"
+	WebhookDeliveriesStatusDEAD       WebhookDeliveriesStatus = "DEAD"
+)
+
+func (e *WebhookDeliveriesStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WebhookDeliveriesStatus(s)
+	case string:
+		*e = WebhookDeliveriesStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WebhookDeliveriesStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWebhookDeliveriesStatus struct {
+	WebhookDeliveriesStatus WebhookDeliveriesStatus `json:"webhook_deliveries_status"`
+	Valid                   bool                    `json:"valid"` // Valid is true if WebhookDeliveriesStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWebhookDeliveriesStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WebhookDeliveriesStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WebhookDeliveriesStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWebhookDeliveriesStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WebhookDeliveriesStatus), nil
 }
 
 type WithdrawalsStatus string
@@ -688,10 +3362,106 @@ type Account struct {
 	HoldBalance     string              `json:"hold_balance"`
 	Version         uint32              `json:"version"`
 	Status          AccountsStatus      `json:"status"`
+	FrozenAt        sql.NullTime        `json:"frozen_at"`
+	FrozenReason    sql.NullString      `json:"frozen_reason"`
+	FrozenBy        sql.NullInt64       `json:"frozen_by"`
 	CreatedAt       time.Time           `json:"created_at"`
 	UpdatedAt       time.Time           `json:"updated_at"`
 }
 
+type AccountLimit struct {
+	ID                     uint64         `json:"id"`
+	AccountID              uint64         `json:"account_id"`
+	DailySpendLimit        sql.NullString `json:"daily_spend_limit"`
+	MonthlySpendLimit      sql.NullString `json:"monthly_spend_limit"`
+	DailyWithdrawalLimit   sql.NullString `json:"daily_withdrawal_limit"`
+	MonthlyWithdrawalLimit sql.NullString `json:"monthly_withdrawal_limit"`
+	UpdatedBy              sql.NullInt64  `json:"updated_by"`
+	CreatedAt              time.Time      `json:"created_at"`
+	UpdatedAt              time.Time      `json:"updated_at"`
+}
+
+type AccountLimitUsage struct {
+	ID          uint64                  `json:"id"`
+	AccountID   uint64                  `json:"account_id"`
+	Kind        AccountLimitUsageKind   `json:"kind"`
+	Period      AccountLimitUsagePeriod `json:"period"`
+	PeriodStart time.Time               `json:"period_start"`
+	Amount      string                  `json:"amount"`
+	CreatedAt   time.Time               `json:"created_at"`
+	UpdatedAt   time.Time               `json:"updated_at"`
+}
+
+type AccountStatement struct {
+	ID             uint64    `json:"id"`
+	ExternalID     string    `json:"external_id"`
+	AccountID      uint64    `json:"account_id"`
+	PeriodStart    time.Time `json:"period_start"`
+	PeriodEnd      time.Time `json:"period_end"`
+	OpeningBalance string    `json:"opening_balance"`
+	TotalCredits   string    `json:"total_credits"`
+	TotalDebits    string    `json:"total_debits"`
+	ClosingBalance string    `json:"closing_balance"`
+	EntryCount     uint32    `json:"entry_count"`
+	GeneratedAt    time.Time `json:"generated_at"`
+}
+
+type AccountStatementLine struct {
+	ID            uint64                         `json:"id"`
+	StatementID   uint64                         `json:"statement_id"`
+	ReferenceType string                         `json:"reference_type"`
+	EntryType     AccountStatementLinesEntryType `json:"entry_type"`
+	EntryCount    uint32                         `json:"entry_count"`
+	TotalAmount   string                         `json:"total_amount"`
+}
+
+type AccountTransfer struct {
+	ID             uint64         `json:"id"`
+	ExternalID     string         `json:"external_id"`
+	FromAccountID  uint64         `json:"from_account_id"`
+	ToAccountID    uint64         `json:"to_account_id"`
+	Amount         string         `json:"amount"`
+	Memo           sql.NullString `json:"memo"`
+	IdempotencyKey sql.NullString `json:"idempotency_key"`
+	LedgerTxID     sql.NullString `json:"ledger_tx_id"`
+	CreatedBy      sql.NullInt64  `json:"created_by"`
+	CreatedAt      time.Time      `json:"created_at"`
+}
+
+type ApiKey struct {
+	ID         uint64       `json:"id"`
+	ExternalID string       `json:"external_id"`
+	UserID     uint64       `json:"user_id"`
+	Name       string       `json:"name"`
+	KeyPrefix  string       `json:"key_prefix"`
+	KeyHash    string       `json:"key_hash"`
+	LastUsedAt sql.NullTime `json:"last_used_at"`
+	RevokedAt  sql.NullTime `json:"revoked_at"`
+	CreatedAt  time.Time    `json:"created_at"`
+	UpdatedAt  time.Time    `json:"updated_at"`
+}
+
+type ApiKeyBootstrapToken struct {
+	UserID    uint64    `json:"user_id"`
+	TokenHash string    `json:"token_hash"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ApiUsageHourly struct {
+	ID           uint64    `json:"id"`
+	ApiKeyID     uint64    `json:"api_key_id"`
+	BucketStart  time.Time `json:"bucket_start"`
+	Method       string    `json:"method"`
+	Route        string    `json:"route"`
+	Requests     uint64    `json:"requests"`
+	ClientErrors uint64    `json:"client_errors"`
+	ServerErrors uint64    `json:"server_errors"`
+	RateLimited  uint64    `json:"rate_limited"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
 type AuditLog struct {
 	ID           uint64          `json:"id"`
 	ActorType    string          `json:"actor_type"`
@@ -707,18 +3477,310 @@ type AuditLog struct {
 	CreatedAt    time.Time       `json:"created_at"`
 }
 
+type Budget struct {
+	ID                uint64         `json:"id"`
+	ExternalID        string         `json:"external_id"`
+	UserID            uint64         `json:"user_id"`
+	Category          sql.NullString `json:"category"`
+	CategoryKey       sql.NullString `json:"category_key"`
+	Period            BudgetsPeriod  `json:"period"`
+	Amount            string         `json:"amount"`
+	AlertThresholdPct uint8          `json:"alert_threshold_pct"`
+	HardLimit         bool           `json:"hard_limit"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+}
+
+type BudgetAlert struct {
+	ID          uint64            `json:"id"`
+	BudgetID    uint64            `json:"budget_id"`
+	PeriodStart time.Time         `json:"period_start"`
+	Level       BudgetAlertsLevel `json:"level"`
+	Spent       string            `json:"spent"`
+	EventID     sql.NullString    `json:"event_id"`
+	CreatedAt   time.Time         `json:"created_at"`
+}
+
+type Cart struct {
+	ID           uint64        `json:"id"`
+	ExternalID   string        `json:"external_id"`
+	BuyerID      uint64        `json:"buyer_id"`
+	SellerID     uint64        `json:"seller_id"`
+	TokenSymbol  string        `json:"token_symbol"`
+	Status       CartsStatus   `json:"status"`
+	OrderID      sql.NullInt64 `json:"order_id"`
+	ExpiresAt    time.Time     `json:"expires_at"`
+	CheckedOutAt sql.NullTime  `json:"checked_out_at"`
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+}
+
+type CartItem struct {
+	ID        uint64    `json:"id"`
+	CartID    uint64    `json:"cart_id"`
+	ProductID uint64    `json:"product_id"`
+	Quantity  uint32    `json:"quantity"`
+	UnitPrice string    `json:"unit_price"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Category struct {
+	ID        uint64        `json:"id"`
+	Code      string        `json:"code"`
+	Name      string        `json:"name"`
+	ParentID  sql.NullInt64 `json:"parent_id"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+type CategoryPath struct {
+	AncestorID   uint64 `json:"ancestor_id"`
+	DescendantID uint64 `json:"descendant_id"`
+	Depth        uint32 `json:"depth"`
+}
+
+type ChainTransaction struct {
+	ID              uint64                  `json:"id"`
+	ChainID         uint64                  `json:"chain_id"`
+	Signer          string                  `json:"signer"`
+	Nonce           uint64                  `json:"nonce"`
+	ToAddress       string                  `json:"to_address"`
+	Amount          string                  `json:"amount"`
+	GasLimit        uint64                  `json:"gas_limit"`
+	ReferenceType   string                  `json:"reference_type"`
+	ReferenceID     uint64                  `json:"reference_id"`
+	Status          ChainTransactionsStatus `json:"status"`
+	Replacements    uint32                  `json:"replacements"`
+	LastBroadcastAt time.Time               `json:"last_broadcast_at"`
+	LandedTxHash    sql.NullString          `json:"landed_tx_hash"`
+	BlockNumber     sql.NullInt64           `json:"block_number"`
+	ResolvedAt      sql.NullTime            `json:"resolved_at"`
+	CreatedAt       time.Time               `json:"created_at"`
+	UpdatedAt       time.Time               `json:"updated_at"`
+}
+
+type ChainTransactionBroadcast struct {
+	ID                 uint64    `json:"id"`
+	ChainTransactionID uint64    `json:"chain_transaction_id"`
+	TxHash             string    `json:"tx_hash"`
+	TipCap             string    `json:"tip_cap"`
+	FeeCap             string    `json:"fee_cap"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+type Coupon struct {
+	ID              uint64              `json:"id"`
+	ExternalID      string              `json:"external_id"`
+	SellerID        uint64              `json:"seller_id"`
+	Code            string              `json:"code"`
+	DiscountType    CouponsDiscountType `json:"discount_type"`
+	DiscountValue   string              `json:"discount_value"`
+	MinOrderAmount  string              `json:"min_order_amount"`
+	MaxRedemptions  sql.NullInt32       `json:"max_redemptions"`
+	MaxPerBuyer     sql.NullInt32       `json:"max_per_buyer"`
+	RedemptionCount uint32              `json:"redemption_count"`
+	ExpiresAt       sql.NullTime        `json:"expires_at"`
+	Status          CouponsStatus       `json:"status"`
+	CreatedAt       time.Time           `json:"created_at"`
+	UpdatedAt       time.Time           `json:"updated_at"`
+}
+
+type CouponRedemption struct {
+	ID        uint64    `json:"id"`
+	CouponID  uint64    `json:"coupon_id"`
+	BuyerID   uint64    `json:"buyer_id"`
+	OrderID   uint64    `json:"order_id"`
+	Amount    string    `json:"amount"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type CreditNote struct {
+	ID               uint64            `json:"id"`
+	ExternalID       string            `json:"external_id"`
+	CreditNoteNumber string            `json:"credit_note_number"`
+	InvoiceID        uint64            `json:"invoice_id"`
+	Reason           CreditNotesReason `json:"reason"`
+	Amount           string            `json:"amount"`
+	AppliedAmount    string            `json:"applied_amount"`
+	RefundedAmount   string            `json:"refunded_amount"`
+	Memo             sql.NullString    `json:"memo"`
+	LedgerTxID       sql.NullString    `json:"ledger_tx_id"`
+	CreatedBy        sql.NullInt64     `json:"created_by"`
+	CreatedAt        time.Time         `json:"created_at"`
+}
+
+type CreditNoteLine struct {
+	ID            uint64    `json:"id"`
+	CreditNoteID  uint64    `json:"credit_note_id"`
+	InvoiceLineNo uint32    `json:"invoice_line_no"`
+	Quantity      uint32    `json:"quantity"`
+	UnitPrice     string    `json:"unit_price"`
+	Amount        string    `json:"amount"`
+	CreatedAt     time.Time `json:"created_at"`
+	TaxAmount     string    `json:"tax_amount"`
+}
+
+type CreditTerm struct {
+	ID                uint64            `json:"id"`
+	ExternalID        string            `json:"external_id"`
+	BuyerID           uint64            `json:"buyer_id"`
+	SellerID          uint64            `json:"seller_id"`
+	TokenSymbol       string            `json:"token_symbol"`
+	Terms             CreditTermsTerms  `json:"terms"`
+	CreditLimit       string            `json:"credit_limit"`
+	OutstandingAmount string            `json:"outstanding_amount"`
+	Status            CreditTermsStatus `json:"status"`
+	CreatedAt         time.Time         `json:"created_at"`
+	UpdatedAt         time.Time         `json:"updated_at"`
+}
+
+type DelegatedSigner struct {
+	ID               uint64         `json:"id"`
+	ExternalID       string         `json:"external_id"`
+	UserID           uint64         `json:"user_id"`
+	Address          string         `json:"address"`
+	Label            sql.NullString `json:"label"`
+	Scopes           string         `json:"scopes"`
+	ExpiresAt        sql.NullTime   `json:"expires_at"`
+	ApproverWalletID sql.NullInt64  `json:"approver_wallet_id"`
+	ConfirmedAt      sql.NullTime   `json:"confirmed_at"`
+	RevokedAt        sql.NullTime   `json:"revoked_at"`
+	AddressActive    sql.NullString `json:"address_active"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+}
+
 type Deposit struct {
-	ID          uint64         `json:"id"`
-	UserID      uint64         `json:"user_id"`
-	AccountID   uint64         `json:"account_id"`
-	TxHash      string         `json:"tx_hash"`
-	FromAddress string         `json:"from_address"`
-	Amount      string         `json:"amount"`
-	BlockNumber sql.NullInt64  `json:"block_number"`
-	Status      DepositsStatus `json:"status"`
-	ConfirmedAt sql.NullTime   `json:"confirmed_at"`
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
+	ID               uint64         `json:"id"`
+	UserID           uint64         `json:"user_id"`
+	AccountID        uint64         `json:"account_id"`
+	TxHash           string         `json:"tx_hash"`
+	FromAddress      string         `json:"from_address"`
+	Amount           string         `json:"amount"`
+	BlockNumber      sql.NullInt64  `json:"block_number"`
+	Status           DepositsStatus `json:"status"`
+	ConfirmedAt      sql.NullTime   `json:"confirmed_at"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	InvoiceMatchedAt sql.NullTime   `json:"invoice_matched_at"`
+}
+
+type DepositAddress struct {
+	ID              uint64    `json:"id"`
+	OrderID         uint64    `json:"order_id"`
+	Address         string    `json:"address"`
+	KeyID           string    `json:"key_id"`
+	DerivationIndex uint32    `json:"derivation_index"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+type Dispute struct {
+	ID              uint64         `json:"id"`
+	ExternalID      string         `json:"external_id"`
+	PaymentID       uint64         `json:"payment_id"`
+	SellerAccountID uint64         `json:"seller_account_id"`
+	OpenedBy        uint64         `json:"opened_by"`
+	Reason          DisputesReason `json:"reason"`
+	Description     string         `json:"description"`
+	Amount          string         `json:"amount"`
+	HeldAmount      string         `json:"held_amount"`
+	Status          DisputesStatus `json:"status"`
+	RefundID        sql.NullInt64  `json:"refund_id"`
+	ResolvedBy      sql.NullInt64  `json:"resolved_by"`
+	ResolutionNote  sql.NullString `json:"resolution_note"`
+	ResolvedAt      sql.NullTime   `json:"resolved_at"`
+	OpenPaymentID   sql.NullInt64  `json:"open_payment_id"`
+	CreatedAt       time.Time      `json:"created_at"`
+	UpdatedAt       time.Time      `json:"updated_at"`
+}
+
+type DisputeEvidence struct {
+	ID            uint64               `json:"id"`
+	ExternalID    string               `json:"external_id"`
+	DisputeID     uint64               `json:"dispute_id"`
+	SubmittedBy   uint64               `json:"submitted_by"`
+	Party         DisputeEvidenceParty `json:"party"`
+	Description   string               `json:"description"`
+	AttachmentUrl sql.NullString       `json:"attachment_url"`
+	CreatedAt     time.Time            `json:"created_at"`
+}
+
+type DocumentSequence struct {
+	Prefix    string    `json:"prefix"`
+	SeqDate   time.Time `json:"seq_date"`
+	LastValue uint32    `json:"last_value"`
+}
+
+type FeatureAllowlist struct {
+	ID        uint64    `json:"id"`
+	Feature   string    `json:"feature"`
+	UserID    uint64    `json:"user_id"`
+	AddedBy   uint64    `json:"added_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type FeatureRollout struct {
+	ID        uint64                `json:"id"`
+	Feature   string                `json:"feature"`
+	Status    FeatureRolloutsStatus `json:"status"`
+	UpdatedBy sql.NullInt64         `json:"updated_by"`
+	CreatedAt time.Time             `json:"created_at"`
+	UpdatedAt time.Time             `json:"updated_at"`
+}
+
+type HdDerivationCursor struct {
+	KeyID     string    `json:"key_id"`
+	NextIndex uint32    `json:"next_index"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type FeeLedgerEntry struct {
+	ID            uint64                    `json:"id"`
+	PaymentID     uint64                    `json:"payment_id"`
+	MerchantID    uint64                    `json:"merchant_id"`
+	EntryType     FeeLedgerEntriesEntryType `json:"entry_type"`
+	Amount        string                    `json:"amount"`
+	TokenSymbol   string                    `json:"token_symbol"`
+	Tier          string                    `json:"tier"`
+	MonthlyVolume string                    `json:"monthly_volume"`
+	PercentageBps uint32                    `json:"percentage_bps"`
+	PercentageFee string                    `json:"percentage_fee"`
+	FixedFee      string                    `json:"fixed_fee"`
+	CreatedAt     time.Time                 `json:"created_at"`
+	RefundID      uint64                    `json:"refund_id"`
+}
+
+type HistoricalImport struct {
+	ID             uint64                  `json:"id"`
+	ExternalID     string                  `json:"external_id"`
+	UserID         uint64                  `json:"user_id"`
+	AccountID      uint64                  `json:"account_id"`
+	Source         HistoricalImportsSource `json:"source"`
+	FromBlock      sql.NullInt64           `json:"from_block"`
+	ToBlock        sql.NullInt64           `json:"to_block"`
+	ImportedCount  uint32                  `json:"imported_count"`
+	DuplicateCount uint32                  `json:"duplicate_count"`
+	RejectedCount  uint32                  `json:"rejected_count"`
+	CreatedBy      sql.NullInt64           `json:"created_by"`
+	CreatedAt      time.Time               `json:"created_at"`
+}
+
+type HistoricalLedgerEntry struct {
+	ID                  uint64                           `json:"id"`
+	ImportID            uint64                           `json:"import_id"`
+	AccountID           uint64                           `json:"account_id"`
+	EntryType           HistoricalLedgerEntriesEntryType `json:"entry_type"`
+	Amount              string                           `json:"amount"`
+	ChainID             uint64                           `json:"chain_id"`
+	TxHash              string                           `json:"tx_hash"`
+	LogIndex            uint32                           `json:"log_index"`
+	BlockNumber         uint64                           `json:"block_number"`
+	BlockTime           time.Time                        `json:"block_time"`
+	WalletAddress       string                           `json:"wallet_address"`
+	CounterpartyAddress string                           `json:"counterparty_address"`
+	CreatedAt           time.Time                        `json:"created_at"`
 }
 
 type IdempotencyKey struct {
@@ -756,6 +3818,118 @@ type InventoryLog struct {
 	CreatedAt      time.Time              `json:"created_at"`
 }
 
+type InventoryTransfer struct {
+	ID              uint64    `json:"id"`
+	ExternalID      string    `json:"external_id"`
+	ProductID       uint64    `json:"product_id"`
+	FromWarehouseID uint64    `json:"from_warehouse_id"`
+	ToWarehouseID   uint64    `json:"to_warehouse_id"`
+	Quantity        int64     `json:"quantity"`
+	Reason          string    `json:"reason"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+type Invoice struct {
+	ID               uint64         `json:"id"`
+	ExternalID       string         `json:"external_id"`
+	InvoiceNumber    sql.NullString `json:"invoice_number"`
+	CreditTermsID    sql.NullInt64  `json:"credit_terms_id"`
+	OrderID          sql.NullInt64  `json:"order_id"`
+	Amount           string         `json:"amount"`
+	PaidAmount       string         `json:"paid_amount"`
+	Status           InvoicesStatus `json:"status"`
+	IssuedAt         sql.NullTime   `json:"issued_at"`
+	DueAt            sql.NullTime   `json:"due_at"`
+	ClosedAt         sql.NullTime   `json:"closed_at"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	BuyerID          uint64         `json:"buyer_id"`
+	SellerID         uint64         `json:"seller_id"`
+	TokenSymbol      string         `json:"token_symbol"`
+	PaymentTermsDays uint32         `json:"payment_terms_days"`
+	Notes            sql.NullString `json:"notes"`
+	CreditedAmount   string         `json:"credited_amount"`
+	RefundedAmount   string         `json:"refunded_amount"`
+	TaxAmount        string         `json:"tax_amount"`
+}
+
+type InvoiceItem struct {
+	ID          uint64        `json:"id"`
+	InvoiceID   uint64        `json:"invoice_id"`
+	LineNo      uint32        `json:"line_no"`
+	ProductID   sql.NullInt64 `json:"product_id"`
+	Description string        `json:"description"`
+	Quantity    uint32        `json:"quantity"`
+	UnitPrice   string        `json:"unit_price"`
+	Amount      string        `json:"amount"`
+	CreatedAt   time.Time     `json:"created_at"`
+}
+
+type InvoicePayment struct {
+	ID         uint64         `json:"id"`
+	ExternalID string         `json:"external_id"`
+	InvoiceID  uint64         `json:"invoice_id"`
+	Amount     string         `json:"amount"`
+	Reference  sql.NullString `json:"reference"`
+	CreatedAt  time.Time      `json:"created_at"`
+	DepositID  sql.NullInt64  `json:"deposit_id"`
+}
+
+type KycApplicant struct {
+	ID           uint64              `json:"id"`
+	UserID       uint64              `json:"user_id"`
+	Provider     string              `json:"provider"`
+	ApplicantID  string              `json:"applicant_id"`
+	Status       KycApplicantsStatus `json:"status"`
+	RejectReason sql.NullString      `json:"reject_reason"`
+	DecidedAt    sql.NullTime        `json:"decided_at"`
+	CreatedAt    time.Time           `json:"created_at"`
+	UpdatedAt    time.Time           `json:"updated_at"`
+}
+
+type KycDocument struct {
+	ID           uint64                   `json:"id"`
+	ExternalID   string                   `json:"external_id"`
+	UserID       uint64                   `json:"user_id"`
+	DocumentType KycDocumentsDocumentType `json:"document_type"`
+	FileName     string                   `json:"file_name"`
+	ContentType  string                   `json:"content_type"`
+	SizeBytes    uint64                   `json:"size_bytes"`
+	Sha256       string                   `json:"sha256"`
+	StorageKey   string                   `json:"storage_key"`
+	Status       KycDocumentsStatus       `json:"status"`
+	ReviewNote   sql.NullString           `json:"review_note"`
+	ReviewedBy   sql.NullInt64            `json:"reviewed_by"`
+	ReviewedAt   sql.NullTime             `json:"reviewed_at"`
+	CreatedAt    time.Time                `json:"created_at"`
+	UpdatedAt    time.Time                `json:"updated_at"`
+}
+
+type KycWebhookEvent struct {
+	ID             uint64                  `json:"id"`
+	Provider       string                  `json:"provider"`
+	EventID        sql.NullString          `json:"event_id"`
+	ApplicantID    string                  `json:"applicant_id"`
+	UserID         sql.NullInt64           `json:"user_id"`
+	Verdict        string                  `json:"verdict"`
+	Outcome        KycWebhookEventsOutcome `json:"outcome"`
+	Applied        bool                    `json:"applied"`
+	Payload        json.RawMessage         `json:"payload"`
+	PayloadSha256  string                  `json:"payload_sha256"`
+	DeliveryCount  uint32                  `json:"delivery_count"`
+	CreatedAt      time.Time               `json:"created_at"`
+	LastReceivedAt time.Time               `json:"last_received_at"`
+}
+
+type LedgerBalanceSnapshot struct {
+	ID          uint64    `json:"id"`
+	AccountID   uint64    `json:"account_id"`
+	LastEntryID uint64    `json:"last_entry_id"`
+	Balance     string    `json:"balance"`
+	EntryCount  uint64    `json:"entry_count"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
 type LedgerEntry struct {
 	ID            uint64                 `json:"id"`
 	TxID          string                 `json:"tx_id"`
@@ -769,15 +3943,67 @@ type LedgerEntry struct {
 	CreatedAt     time.Time              `json:"created_at"`
 }
 
+type LegalHold struct {
+	ID            uint64                `json:"id"`
+	ExternalID    string                `json:"external_id"`
+	SubjectType   LegalHoldsSubjectType `json:"subject_type"`
+	SubjectID     uint64                `json:"subject_id"`
+	SubjectRef    string                `json:"subject_ref"`
+	Reason        string                `json:"reason"`
+	CaseReference sql.NullString        `json:"case_reference"`
+	PlacedBy      uint64                `json:"placed_by"`
+	PlacedAt      time.Time             `json:"placed_at"`
+	ReleasedBy    sql.NullInt64         `json:"released_by"`
+	ReleasedAt    sql.NullTime          `json:"released_at"`
+	ReleaseReason sql.NullString        `json:"release_reason"`
+	IsActive      sql.NullBool          `json:"is_active"`
+	ActiveSubject sql.NullString        `json:"active_subject"`
+	CreatedAt     time.Time             `json:"created_at"`
+	UpdatedAt     time.Time             `json:"updated_at"`
+}
+
 type Order struct {
-	ID          uint64       `json:"id"`
-	OrderNumber string       `json:"order_number"`
-	BuyerID     uint64       `json:"buyer_id"`
-	SellerID    uint64       `json:"seller_id"`
-	Status      OrdersStatus `json:"status"`
-	TotalAmount string       `json:"total_amount"`
-	CreatedAt   time.Time    `json:"created_at"`
-	UpdatedAt   time.Time    `json:"updated_at"`
+	ID                   uint64         `json:"id"`
+	OrderNumber          string         `json:"order_number"`
+	BuyerID              uint64         `json:"buyer_id"`
+	SellerID             uint64         `json:"seller_id"`
+	Status               OrdersStatus   `json:"status"`
+	TotalAmount          string         `json:"total_amount"`
+	CreatedAt            time.Time      `json:"created_at"`
+	UpdatedAt            time.Time      `json:"updated_at"`
+	PaymentDueAt         sql.NullTime   `json:"payment_due_at"`
+	TokenSymbol          string         `json:"token_symbol"`
+	StatusChangedAt      time.Time      `json:"status_changed_at"`
+	PriceCurrency        sql.NullString `json:"price_currency"`
+	PriceAmount          sql.NullString `json:"price_amount"`
+	FxRate               sql.NullString `json:"fx_rate"`
+	FxRateSource         sql.NullString `json:"fx_rate_source"`
+	FxRateAt             sql.NullTime   `json:"fx_rate_at"`
+	QuotedAt             sql.NullTime   `json:"quoted_at"`
+	BuyerOrganizationID  sql.NullInt64  `json:"buyer_organization_id"`
+	SellerOrganizationID sql.NullInt64  `json:"seller_organization_id"`
+	TaxAmount            string         `json:"tax_amount"`
+	ReservationExpiresAt sql.NullTime   `json:"reservation_expires_at"`
+}
+
+type OrderDiscount struct {
+	ID          uint64             `json:"id"`
+	OrderID     uint64             `json:"order_id"`
+	LineNo      sql.NullInt32      `json:"line_no"`
+	Kind        OrderDiscountsKind `json:"kind"`
+	CouponID    sql.NullInt64      `json:"coupon_id"`
+	Description string             `json:"description"`
+	Amount      string             `json:"amount"`
+	CreatedAt   time.Time          `json:"created_at"`
+}
+
+type OrderDunningStep struct {
+	ID          uint64                  `json:"id"`
+	OrderID     uint64                  `json:"order_id"`
+	DaysOverdue uint32                  `json:"days_overdue"`
+	Action      OrderDunningStepsAction `json:"action"`
+	EventID     sql.NullString          `json:"event_id"`
+	ExecutedAt  time.Time               `json:"executed_at"`
 }
 
 type OrderItem struct {
@@ -789,19 +4015,85 @@ type OrderItem struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+type OrderSlaBreach struct {
+	ID         uint64                 `json:"id"`
+	OrderID    uint64                 `json:"order_id"`
+	Stage      OrderSlaBreachesStage  `json:"stage"`
+	DeadlineAt time.Time              `json:"deadline_at"`
+	Action     OrderSlaBreachesAction `json:"action"`
+	EventID    sql.NullString         `json:"event_id"`
+	ExecutedAt time.Time              `json:"executed_at"`
+}
+
+type OrderSlaPolicy struct {
+	ID           uint64        `json:"id"`
+	ExternalID   string        `json:"external_id"`
+	SellerID     uint64        `json:"seller_id"`
+	BuyerID      sql.NullInt64 `json:"buyer_id"`
+	BuyerKey     sql.NullInt64 `json:"buyer_key"`
+	ConfirmHours sql.NullInt32 `json:"confirm_hours"`
+	FulfillHours sql.NullInt32 `json:"fulfill_hours"`
+	AutoCancel   sql.NullBool  `json:"auto_cancel"`
+	CreatedAt    time.Time     `json:"created_at"`
+	UpdatedAt    time.Time     `json:"updated_at"`
+}
+
+type Organization struct {
+	ID                         uint64              `json:"id"`
+	ExternalID                 string              `json:"external_id"`
+	Name                       string              `json:"name"`
+	BusinessRegistrationNumber string              `json:"business_registration_number"`
+	BillingEmail               sql.NullString      `json:"billing_email"`
+	BillingAddress             sql.NullString      `json:"billing_address"`
+	BillingContactName         sql.NullString      `json:"billing_contact_name"`
+	SettlementAccountID        sql.NullInt64       `json:"settlement_account_id"`
+	Status                     OrganizationsStatus `json:"status"`
+	CreatedBy                  uint64              `json:"created_by"`
+	CreatedAt                  time.Time           `json:"created_at"`
+	UpdatedAt                  time.Time           `json:"updated_at"`
+}
+
+type OrganizationInvitation struct {
+	ID             uint64                        `json:"id"`
+	ExternalID     string                        `json:"external_id"`
+	OrganizationID uint64                        `json:"organization_id"`
+	Email          string                        `json:"email"`
+	Role           OrganizationInvitationsRole   `json:"role"`
+	TokenHash      string                        `json:"token_hash"`
+	Status         OrganizationInvitationsStatus `json:"status"`
+	InvitedBy      uint64                        `json:"invited_by"`
+	AcceptedBy     sql.NullInt64                 `json:"accepted_by"`
+	ExpiresAt      time.Time                     `json:"expires_at"`
+	AcceptedAt     sql.NullTime                  `json:"accepted_at"`
+	RevokedAt      sql.NullTime                  `json:"revoked_at"`
+	CreatedAt      time.Time                     `json:"created_at"`
+	UpdatedAt      time.Time                     `json:"updated_at"`
+}
+
+type OrganizationMember struct {
+	ID             uint64                  `json:"id"`
+	OrganizationID uint64                  `json:"organization_id"`
+	UserID         uint64                  `json:"user_id"`
+	Role           OrganizationMembersRole `json:"role"`
+	CreatedAt      time.Time               `json:"created_at"`
+}
+
 type Outbox struct {
-	ID            uint64          `json:"id"`
-	EventType     string          `json:"event_type"`
-	AggregateType string          `json:"aggregate_type"`
-	AggregateID   uint64          `json:"aggregate_id"`
-	Payload       json.RawMessage `json:"payload"`
-	Status        OutboxStatus    `json:"status"`
-	RetryCount    uint32          `json:"retry_count"`
-	MaxRetries    uint32          `json:"max_retries"`
-	NextRetryAt   sql.NullTime    `json:"next_retry_at"`
-	ErrorMessage  sql.NullString  `json:"error_message"`
-	CreatedAt     time.Time       `json:"created_at"`
-	UpdatedAt     time.Time       `json:"updated_at"`
+	ID                  uint64          `json:"id"`
+	EventType           string          `json:"event_type"`
+	AggregateType       string          `json:"aggregate_type"`
+	AggregateID         uint64          `json:"aggregate_id"`
+	Payload             json.RawMessage `json:"payload"`
+	Status              OutboxStatus    `json:"status"`
+	RetryCount          uint32          `json:"retry_count"`
+	MaxRetries          uint32          `json:"max_retries"`
+	NextRetryAt         sql.NullTime    `json:"next_retry_at"`
+	ErrorMessage        sql.NullString  `json:"error_message"`
+	CreatedAt           time.Time       `json:"created_at"`
+	UpdatedAt           time.Time       `json:"updated_at"`
+	EventID             string          `json:"event_id"`
+	RecipientUserID     sql.NullInt64   `json:"recipient_user_id"`
+	AggregateExternalID sql.NullString  `json:"aggregate_external_id"`
 }
 
 type Payment struct {
@@ -816,22 +4108,215 @@ type Payment struct {
 	ExpiresAt      sql.NullTime   `json:"expires_at"`
 	CreatedAt      time.Time      `json:"created_at"`
 	UpdatedAt      time.Time      `json:"updated_at"`
+	ExternalID     sql.NullString `json:"external_id"`
+	TokenSymbol    string         `json:"token_symbol"`
+}
+
+type PaymentRefund struct {
+	ID          uint64               `json:"id"`
+	ExternalID  string               `json:"external_id"`
+	PaymentID   uint64               `json:"payment_id"`
+	Amount      string               `json:"amount"`
+	Reason      sql.NullString       `json:"reason"`
+	Method      PaymentRefundsMethod `json:"method"`
+	Status      PaymentRefundsStatus `json:"status"`
+	ToAddress   sql.NullString       `json:"to_address"`
+	TxHash      sql.NullString       `json:"tx_hash"`
+	CreatedBy   uint64               `json:"created_by"`
+	CompletedAt sql.NullTime         `json:"completed_at"`
+	CreatedAt   time.Time            `json:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at"`
+}
+
+type PayoutAddress struct {
+	ID               uint64         `json:"id"`
+	ExternalID       string         `json:"external_id"`
+	UserID           uint64         `json:"user_id"`
+	Address          string         `json:"address"`
+	ChainID          uint64         `json:"chain_id"`
+	Label            sql.NullString `json:"label"`
+	ApproverWalletID sql.NullInt64  `json:"approver_wallet_id"`
+	ConfirmedAt      sql.NullTime   `json:"confirmed_at"`
+	ActiveAfter      sql.NullTime   `json:"active_after"`
+	RevokedAt        sql.NullTime   `json:"revoked_at"`
+	AddressActive    sql.NullString `json:"address_active"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+}
+
+type PayoutApproval struct {
+	ID              uint64                    `json:"id"`
+	ExternalID      string                    `json:"external_id"`
+	PayoutType      PayoutApprovalsPayoutType `json:"payout_type"`
+	PayoutID        uint64                    `json:"payout_id"`
+	PayeeAccountID  uint64                    `json:"payee_account_id"`
+	Amount          string                    `json:"amount"`
+	Status          PayoutApprovalsStatus     `json:"status"`
+	RequestedBy     sql.NullInt64             `json:"requested_by"`
+	FirstApprovedBy sql.NullInt64             `json:"first_approved_by"`
+	FirstApprovedAt sql.NullTime              `json:"first_approved_at"`
+	DecidedBy       sql.NullInt64             `json:"decided_by"`
+	DecidedAt       sql.NullTime              `json:"decided_at"`
+	RejectReason    sql.NullString            `json:"reject_reason"`
+	CreatedAt       time.Time                 `json:"created_at"`
+	UpdatedAt       time.Time                 `json:"updated_at"`
 }
 
 type Product struct {
-	ID        uint64         `json:"id"`
-	Sku       string         `json:"sku"`
-	Name      string         `json:"name"`
-	Price     string         `json:"price"`
-	Status    ProductsStatus `json:"status"`
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
+	ID               uint64         `json:"id"`
+	Sku              string         `json:"sku"`
+	Name             string         `json:"name"`
+	Price            string         `json:"price"`
+	Status           ProductsStatus `json:"status"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	Category         sql.NullString `json:"category"`
+	ParentID         sql.NullInt64  `json:"parent_id"`
+	VariantSize      string         `json:"variant_size"`
+	VariantGrade     string         `json:"variant_grade"`
+	VariantPackaging string         `json:"variant_packaging"`
+	ReorderThreshold sql.NullInt64  `json:"reorder_threshold"`
+}
+
+type PurchaseOrder struct {
+	ID               uint64               `json:"id"`
+	ExternalID       string               `json:"external_id"`
+	PoNumber         string               `json:"po_number"`
+	BuyerID          uint64               `json:"buyer_id"`
+	SellerID         uint64               `json:"seller_id"`
+	Status           PurchaseOrdersStatus `json:"status"`
+	Revision         uint32               `json:"revision"`
+	TokenSymbol      string               `json:"token_symbol"`
+	TotalAmount      string               `json:"total_amount"`
+	PaymentTermsDays uint32               `json:"payment_terms_days"`
+	Notes            sql.NullString       `json:"notes"`
+	RejectReason     sql.NullString       `json:"reject_reason"`
+	OrderID          sql.NullInt64        `json:"order_id"`
+	DecidedAt        sql.NullTime         `json:"decided_at"`
+	CreatedAt        time.Time            `json:"created_at"`
+	UpdatedAt        time.Time            `json:"updated_at"`
+	CouponCode       sql.NullString       `json:"coupon_code"`
+}
+
+type PurchaseOrderItem struct {
+	ID              uint64    `json:"id"`
+	PurchaseOrderID uint64    `json:"purchase_order_id"`
+	LineNo          uint32    `json:"line_no"`
+	ProductID       uint64    `json:"product_id"`
+	Quantity        uint32    `json:"quantity"`
+	UnitPrice       string    `json:"unit_price"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+type RetentionRun struct {
+	ID               uint64                   `json:"id"`
+	ExternalID       string                   `json:"external_id"`
+	DataClass        string                   `json:"data_class"`
+	RetentionSeconds uint64                   `json:"retention_seconds"`
+	Cutoff           time.Time                `json:"cutoff"`
+	DeletedCount     uint64                   `json:"deleted_count"`
+	Status           RetentionRunsStatus      `json:"status"`
+	ErrorMessage     sql.NullString           `json:"error_message"`
+	TriggerType      RetentionRunsTriggerType `json:"trigger_type"`
+	TriggeredBy      sql.NullInt64            `json:"triggered_by"`
+	StartedAt        time.Time                `json:"started_at"`
+	FinishedAt       time.Time                `json:"finished_at"`
+	CreatedAt        time.Time                `json:"created_at"`
+}
+
+type Rfq struct {
+	ID             uint64         `json:"id"`
+	ExternalID     string         `json:"external_id"`
+	RfqNumber      string         `json:"rfq_number"`
+	BuyerID        uint64         `json:"buyer_id"`
+	TokenSymbol    string         `json:"token_symbol"`
+	Status         RfqsStatus     `json:"status"`
+	Notes          sql.NullString `json:"notes"`
+	RespondBy      sql.NullTime   `json:"respond_by"`
+	AwardedQuoteID sql.NullInt64  `json:"awarded_quote_id"`
+	OrderID        sql.NullInt64  `json:"order_id"`
+	ClosedAt       sql.NullTime   `json:"closed_at"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+}
+
+type RfqItem struct {
+	ID        uint64 `json:"id"`
+	RfqID     uint64 `json:"rfq_id"`
+	LineNo    uint32 `json:"line_no"`
+	ProductID uint64 `json:"product_id"`
+	Quantity  uint32 `json:"quantity"`
+}
+
+type RfqQuote struct {
+	ID            uint64          `json:"id"`
+	ExternalID    string          `json:"external_id"`
+	RfqID         uint64          `json:"rfq_id"`
+	SellerID      uint64          `json:"seller_id"`
+	Status        RfqQuotesStatus `json:"status"`
+	Revision      uint32          `json:"revision"`
+	PriceCurrency sql.NullString  `json:"price_currency"`
+	PriceAmount   sql.NullString  `json:"price_amount"`
+	TotalAmount   string          `json:"total_amount"`
+	FxRate        sql.NullString  `json:"fx_rate"`
+	FxRateSource  sql.NullString  `json:"fx_rate_source"`
+	FxRateAt      sql.NullTime    `json:"fx_rate_at"`
+	QuotedAt      time.Time       `json:"quoted_at"`
+	ExpiresAt     time.Time       `json:"expires_at"`
+	Notes         sql.NullString  `json:"notes"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+type RfqQuoteItem struct {
+	ID        uint64 `json:"id"`
+	QuoteID   uint64 `json:"quote_id"`
+	LineNo    uint32 `json:"line_no"`
+	UnitPrice string `json:"unit_price"`
+}
+
+type RfqSeller struct {
+	RfqID     uint64    `json:"rfq_id"`
+	SellerID  uint64    `json:"seller_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type Rma struct {
+	ID           uint64         `json:"id"`
+	ExternalID   string         `json:"external_id"`
+	RmaNumber    string         `json:"rma_number"`
+	OrderID      uint64         `json:"order_id"`
+	BuyerID      uint64         `json:"buyer_id"`
+	SellerID     uint64         `json:"seller_id"`
+	Status       RmasStatus     `json:"status"`
+	Reason       string         `json:"reason"`
+	RefundAmount string         `json:"refund_amount"`
+	PaymentID    sql.NullInt64  `json:"payment_id"`
+	RefundID     sql.NullInt64  `json:"refund_id"`
+	Restocked    bool           `json:"restocked"`
+	DecisionNote sql.NullString `json:"decision_note"`
+	DecidedBy    sql.NullInt64  `json:"decided_by"`
+	DecidedAt    sql.NullTime   `json:"decided_at"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+}
+
+type RmaLine struct {
+	ID        uint64    `json:"id"`
+	RmaID     uint64    `json:"rma_id"`
+	LineNo    uint32    `json:"line_no"`
+	ProductID uint64    `json:"product_id"`
+	Quantity  uint32    `json:"quantity"`
+	UnitPrice string    `json:"unit_price"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type Settlement struct {
 	ID             uint64            `json:"id"`
 	PaymentID      uint64            `json:"payment_id"`
 	PayeeAccountID uint64            `json:"payee_account_id"`
+	NetPayoutID    sql.NullInt64     `json:"net_payout_id"`
+	BatchID        sql.NullInt64     `json:"batch_id"`
 	Amount         string            `json:"amount"`
 	FeeAmount      string            `json:"fee_amount"`
 	NetAmount      string            `json:"net_amount"`
@@ -841,6 +4326,102 @@ type Settlement struct {
 	UpdatedAt      time.Time         `json:"updated_at"`
 }
 
+type SettlementBatch struct {
+	ID              uint64                   `json:"id"`
+	ExternalID      string                   `json:"external_id"`
+	PayeeAccountID  uint64                   `json:"payee_account_id"`
+	Cadence         SettlementBatchesCadence `json:"cadence"`
+	CutoffAt        time.Time                `json:"cutoff_at"`
+	SettlementCount uint32                   `json:"settlement_count"`
+	TotalAmount     string                   `json:"total_amount"`
+	Status          SettlementBatchesStatus  `json:"status"`
+	CreatedAt       time.Time                `json:"created_at"`
+	UpdatedAt       time.Time                `json:"updated_at"`
+}
+
+type SettlementNetPayout struct {
+	ID              uint64                     `json:"id"`
+	ExternalID      string                     `json:"external_id"`
+	WindowStart     time.Time                  `json:"window_start"`
+	WindowEnd       time.Time                  `json:"window_end"`
+	AccountLowID    uint64                     `json:"account_low_id"`
+	AccountHighID   uint64                     `json:"account_high_id"`
+	LowToHighAmount string                     `json:"low_to_high_amount"`
+	HighToLowAmount string                     `json:"high_to_low_amount"`
+	PayeeAccountID  sql.NullInt64              `json:"payee_account_id"`
+	NetAmount       string                     `json:"net_amount"`
+	SettlementCount uint32                     `json:"settlement_count"`
+	Status          SettlementNetPayoutsStatus `json:"status"`
+	CreatedBy       sql.NullInt64              `json:"created_by"`
+	CreatedAt       time.Time                  `json:"created_at"`
+	UpdatedAt       time.Time                  `json:"updated_at"`
+}
+
+type SettlementSchedule struct {
+	ID            uint64                     `json:"id"`
+	AccountID     uint64                     `json:"account_id"`
+	Cadence       SettlementSchedulesCadence `json:"cadence"`
+	CutoffHour    uint8                      `json:"cutoff_hour"`
+	CutoffWeekday uint8                      `json:"cutoff_weekday"`
+	UpdatedBy     sql.NullInt64              `json:"updated_by"`
+	CreatedAt     time.Time                  `json:"created_at"`
+	UpdatedAt     time.Time                  `json:"updated_at"`
+}
+
+type StatusIncident struct {
+	ID         uint64                `json:"id"`
+	ExternalID string                `json:"external_id"`
+	Title      string                `json:"title"`
+	Impact     StatusIncidentsImpact `json:"impact"`
+	Status     StatusIncidentsStatus `json:"status"`
+	Components string                `json:"components"`
+	CreatedBy  uint64                `json:"created_by"`
+	ResolvedAt sql.NullTime          `json:"resolved_at"`
+	CreatedAt  time.Time             `json:"created_at"`
+	UpdatedAt  time.Time             `json:"updated_at"`
+}
+
+type StatusIncidentUpdate struct {
+	ID         uint64                      `json:"id"`
+	IncidentID uint64                      `json:"incident_id"`
+	Status     StatusIncidentUpdatesStatus `json:"status"`
+	Message    string                      `json:"message"`
+	CreatedBy  uint64                      `json:"created_by"`
+	CreatedAt  time.Time                   `json:"created_at"`
+}
+
+type StockMovement struct {
+	ID            uint64               `json:"id"`
+	ProductID     uint64               `json:"product_id"`
+	InventoryID   uint64               `json:"inventory_id"`
+	Location      string               `json:"location"`
+	Reason        StockMovementsReason `json:"reason"`
+	QuantityDelta int64                `json:"quantity_delta"`
+	ReservedDelta int64                `json:"reserved_delta"`
+	QuantityAfter int64                `json:"quantity_after"`
+	ReservedAfter int64                `json:"reserved_after"`
+	ActorType     string               `json:"actor_type"`
+	ActorID       sql.NullInt64        `json:"actor_id"`
+	ReferenceType sql.NullString       `json:"reference_type"`
+	ReferenceID   sql.NullInt64        `json:"reference_id"`
+	Note          sql.NullString       `json:"note"`
+	RequestID     sql.NullString       `json:"request_id"`
+	CreatedAt     time.Time            `json:"created_at"`
+}
+
+type SupportCase struct {
+	ID          uint64                  `json:"id"`
+	ExternalID  string                  `json:"external_id"`
+	SubjectType SupportCasesSubjectType `json:"subject_type"`
+	SubjectID   uint64                  `json:"subject_id"`
+	SubjectRef  string                  `json:"subject_ref"`
+	TicketID    string                  `json:"ticket_id"`
+	Status      SupportCasesStatus      `json:"status"`
+	TicketUrl   sql.NullString          `json:"ticket_url"`
+	CreatedAt   time.Time               `json:"created_at"`
+	UpdatedAt   time.Time               `json:"updated_at"`
+}
+
 type SystemWallet struct {
 	ID          uint64                  `json:"id"`
 	WalletType  SystemWalletsWalletType `json:"wallet_type"`
@@ -851,32 +4432,189 @@ type SystemWallet struct {
 	UpdatedAt   time.Time               `json:"updated_at"`
 }
 
+type TreasuryReconciliation struct {
+	ID               uint64    `json:"id"`
+	ExternalID       string    `json:"external_id"`
+	AccountID        uint64    `json:"account_id"`
+	Address          string    `json:"address"`
+	TokenSymbol      string    `json:"token_symbol"`
+	LedgerBalance    string    `json:"ledger_balance"`
+	OnchainBalance   string    `json:"onchain_balance"`
+	Drift            string    `json:"drift"`
+	Tolerance        string    `json:"tolerance"`
+	ExceedsTolerance bool      `json:"exceeds_tolerance"`
+	BlockNumber      uint64    `json:"block_number"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+type TaxLine struct {
+	ID            uint64               `json:"id"`
+	DocumentType  TaxLinesDocumentType `json:"document_type"`
+	DocumentID    uint64               `json:"document_id"`
+	LineNo        uint32               `json:"line_no"`
+	Jurisdiction  string               `json:"jurisdiction"`
+	TaxName       string               `json:"tax_name"`
+	Rate          string               `json:"rate"`
+	TaxableAmount string               `json:"taxable_amount"`
+	TaxAmount     string               `json:"tax_amount"`
+	Provider      string               `json:"provider"`
+	CreatedAt     time.Time            `json:"created_at"`
+}
+
+type TaxProfile struct {
+	UserID    uint64         `json:"user_id"`
+	Country   string         `json:"country"`
+	TaxID     sql.NullString `json:"tax_id"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+type TaxRate struct {
+	ID          uint64         `json:"id"`
+	ExternalID  string         `json:"external_id"`
+	Country     string         `json:"country"`
+	Category    sql.NullString `json:"category"`
+	CategoryKey sql.NullString `json:"category_key"`
+	Name        string         `json:"name"`
+	Rate        string         `json:"rate"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
 type User struct {
-	ID            uint64         `json:"id"`
-	Email         string         `json:"email"`
-	ExternalID    sql.NullString `json:"external_id"`
-	Name          string         `json:"name"`
-	Phone         sql.NullString `json:"phone"`
-	Role          UsersRole      `json:"role"`
-	KycStatus     UsersKycStatus `json:"kyc_status"`
-	KycVerifiedAt sql.NullTime   `json:"kyc_verified_at"`
-	Status        UsersStatus    `json:"status"`
-	CreatedAt     time.Time      `json:"created_at"`
-	UpdatedAt     time.Time      `json:"updated_at"`
+	ID                  uint64         `json:"id"`
+	Email               string         `json:"email"`
+	ExternalID          sql.NullString `json:"external_id"`
+	Name                string         `json:"name"`
+	Phone               sql.NullString `json:"phone"`
+	Role                UsersRole      `json:"role"`
+	KycStatus           UsersKycStatus `json:"kyc_status"`
+	KycVerifiedAt       sql.NullTime   `json:"kyc_verified_at"`
+	Status              UsersStatus    `json:"status"`
+	CreatedAt           time.Time      `json:"created_at"`
+	UpdatedAt           time.Time      `json:"updated_at"`
+	IsComplianceOfficer bool           `json:"is_compliance_officer"`
+	PhoneVerifiedAt     sql.NullTime   `json:"phone_verified_at"`
+	PhoneChangedAt      sql.NullTime   `json:"phone_changed_at"`
+}
+
+type UserImport struct {
+	ID             uint64            `json:"id"`
+	ExternalID     string            `json:"external_id"`
+	Source         UserImportsSource `json:"source"`
+	Status         UserImportsStatus `json:"status"`
+	TotalCount     uint32            `json:"total_count"`
+	CreatedCount   uint32            `json:"created_count"`
+	DuplicateCount uint32            `json:"duplicate_count"`
+	FailedCount    uint32            `json:"failed_count"`
+	CreatedBy      sql.NullInt64     `json:"created_by"`
+	CreatedAt      time.Time         `json:"created_at"`
+	UpdatedAt      time.Time         `json:"updated_at"`
+	CompletedAt    sql.NullTime      `json:"completed_at"`
+}
+
+type UserImportRow struct {
+	ID        uint64               `json:"id"`
+	ImportID  uint64               `json:"import_id"`
+	RowIndex  uint32               `json:"row_index"`
+	Email     string               `json:"email"`
+	Name      string               `json:"name"`
+	Phone     sql.NullString       `json:"phone"`
+	Role      string               `json:"role"`
+	Status    UserImportRowsStatus `json:"status"`
+	UserID    sql.NullInt64        `json:"user_id"`
+	Error     sql.NullString       `json:"error"`
+	CreatedAt time.Time            `json:"created_at"`
+	UpdatedAt time.Time            `json:"updated_at"`
+}
+
+type VolumeTier struct {
+	ID              uint64    `json:"id"`
+	ProductID       uint64    `json:"product_id"`
+	MinQuantity     uint32    `json:"min_quantity"`
+	DiscountPercent string    `json:"discount_percent"`
+	CreatedAt       time.Time `json:"created_at"`
 }
 
 type Wallet struct {
-	ID            uint64         `json:"id"`
-	UserID        uint64         `json:"user_id"`
-	Address       string         `json:"address"`
-	Label         sql.NullString `json:"label"`
-	IsPrimary     bool           `json:"is_primary"`
-	IsVerified    bool           `json:"is_verified"`
-	CreatedAt     time.Time      `json:"created_at"`
-	UpdatedAt     time.Time      `json:"updated_at"`
-	ExternalID    string         `json:"external_id"`
-	DeletedAt     sql.NullTime   `json:"deleted_at"`
-	AddressActive sql.NullString `json:"address_active"`
+	ID                uint64                   `json:"id"`
+	UserID            uint64                   `json:"user_id"`
+	Address           string                   `json:"address"`
+	Label             sql.NullString           `json:"label"`
+	IsPrimary         bool                     `json:"is_primary"`
+	IsVerified        bool                     `json:"is_verified"`
+	CreatedAt         time.Time                `json:"created_at"`
+	UpdatedAt         time.Time                `json:"updated_at"`
+	ExternalID        string                   `json:"external_id"`
+	DeletedAt         sql.NullTime             `json:"deleted_at"`
+	AddressActive     sql.NullString           `json:"address_active"`
+	VerificationLevel WalletsVerificationLevel `json:"verification_level"`
+	IsWatchOnly       bool                     `json:"is_watch_only"`
+	AttestedBy        sql.NullInt64            `json:"attested_by"`
+	AttestedAt        sql.NullTime             `json:"attested_at"`
+	ReceivableAfter   sql.NullTime             `json:"receivable_after"`
+	ChainID           uint64                   `json:"chain_id"`
+	EnsName           sql.NullString           `json:"ens_name"`
+	EnsStatus         NullWalletsEnsStatus     `json:"ens_status"`
+	EnsCheckedAt      sql.NullTime             `json:"ens_checked_at"`
+	PrimaryUserID     sql.NullInt64            `json:"primary_user_id"`
+}
+
+type WalletMicroTransfer struct {
+	ID          uint64                     `json:"id"`
+	WalletID    uint64                     `json:"wallet_id"`
+	Amount      string                     `json:"amount"`
+	TxHash      sql.NullString             `json:"tx_hash"`
+	Status      WalletMicroTransfersStatus `json:"status"`
+	Attempts    uint32                     `json:"attempts"`
+	ExpiresAt   time.Time                  `json:"expires_at"`
+	ConfirmedAt sql.NullTime               `json:"confirmed_at"`
+	CreatedAt   time.Time                  `json:"created_at"`
+	UpdatedAt   time.Time                  `json:"updated_at"`
+}
+
+type Warehouse struct {
+	ID        uint64           `json:"id"`
+	Code      string           `json:"code"`
+	Name      string           `json:"name"`
+	Country   sql.NullString   `json:"country"`
+	Priority  uint32           `json:"priority"`
+	Status    WarehousesStatus `json:"status"`
+	CreatedAt time.Time        `json:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at"`
+}
+
+type WebhookDelivery struct {
+	ID             uint64                  `json:"id"`
+	ExternalID     string                  `json:"external_id"`
+	EndpointID     uint64                  `json:"endpoint_id"`
+	EventID        string                  `json:"event_id"`
+	EventType      string                  `json:"event_type"`
+	Payload        json.RawMessage         `json:"payload"`
+	Status         WebhookDeliveriesStatus `json:"status"`
+	Attempts       uint32                  `json:"attempts"`
+	MaxAttempts    uint32                  `json:"max_attempts"`
+	NextAttemptAt  time.Time               `json:"next_attempt_at"`
+	LastStatusCode sql.NullInt32           `json:"last_status_code"`
+	LastError      sql.NullString          `json:"last_error"`
+	DeliveredAt    sql.NullTime            `json:"delivered_at"`
+	CreatedAt      time.Time               `json:"created_at"`
+	UpdatedAt      time.Time               `json:"updated_at"`
+	PayloadVersion string                  `json:"payload_version"`
+}
+
+type WebhookEndpoint struct {
+	ID             uint64          `json:"id"`
+	ExternalID     string          `json:"external_id"`
+	UserID         uint64          `json:"user_id"`
+	Url            string          `json:"url"`
+	EventTypes     json.RawMessage `json:"event_types"`
+	Secret         string          `json:"secret"`
+	IsActive       bool            `json:"is_active"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+	DeletedAt      sql.NullTime    `json:"deleted_at"`
+	PayloadVersion string          `json:"payload_version"`
 }
 
 type Withdrawal struct {
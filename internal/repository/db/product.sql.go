@@ -8,8 +8,52 @@ package db
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
+const countCatalogProducts = `-- name: CountCatalogProducts :one
+SELECT COUNT(*)
+FROM products p
+WHERE p.parent_id IS NULL
+  AND p.status = COALESCE(?, p.status)
+  AND (? IS NULL OR p.category IN (
+      SELECT c.code
+      FROM category_paths cp
+      JOIN categories c ON c.id = cp.descendant_id
+      WHERE cp.ancestor_id = ? AND cp.depth <= ?
+  ))
+`
+
+type CountCatalogProductsParams struct {
+	Status     NullProductsStatus `json:"status"`
+	CategoryID sql.NullInt64      `json:"category_id"`
+	MaxDepth   uint32             `json:"max_depth"`
+}
+
+func (q *Queries) CountCatalogProducts(ctx context.Context, arg CountCatalogProductsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countCatalogProducts,
+		arg.Status,
+		arg.CategoryID,
+		arg.CategoryID,
+		arg.MaxDepth,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countProductVariants = `-- name: CountProductVariants :one
+SELECT COUNT(*) FROM products WHERE parent_id = ?
+`
+
+// 변형 수 (0보다 크면 기준 상품은 직접 주문·재고 관리 불가)
+func (q *Queries) CountProductVariants(ctx context.Context, parentID sql.NullInt64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countProductVariants, parentID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createProduct = `-- name: CreateProduct :execresult
 INSERT INTO products (sku, name, price, status)
 VALUES (?, ?, ?, ?)
@@ -31,6 +75,42 @@ func (q *Queries) CreateProduct(ctx context.Context, arg CreateProductParams) (s
 	)
 }
 
+const createProductVariant = `-- name: CreateProductVariant :execresult
+
+INSERT INTO products (parent_id, sku, name, category, price, status, variant_size, variant_grade, variant_packaging)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreateProductVariantParams struct {
+	ParentID         sql.NullInt64  `json:"parent_id"`
+	Sku              string         `json:"sku"`
+	Name             string         `json:"name"`
+	Category         sql.NullString `json:"category"`
+	Price            string         `json:"price"`
+	Status           ProductsStatus `json:"status"`
+	VariantSize      string         `json:"variant_size"`
+	VariantGrade     string         `json:"variant_grade"`
+	VariantPackaging string         `json:"variant_packaging"`
+}
+
+// ============================================================================
+// Product Variants
+// ============================================================================
+// 변형 상품 생성 (기준 상품의 카테고리 상속)
+func (q *Queries) CreateProductVariant(ctx context.Context, arg CreateProductVariantParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, createProductVariant,
+		arg.ParentID,
+		arg.Sku,
+		arg.Name,
+		arg.Category,
+		arg.Price,
+		arg.Status,
+		arg.VariantSize,
+		arg.VariantGrade,
+		arg.VariantPackaging,
+	)
+}
+
 const deleteProduct = `-- name: DeleteProduct :exec
 UPDATE products SET status = 'INACTIVE' WHERE id = ?
 `
@@ -41,7 +121,7 @@ func (q *Queries) DeleteProduct(ctx context.Context, id uint64) error {
 }
 
 const getProduct = `-- name: GetProduct :one
-SELECT id, sku, name, price, status, created_at, updated_at FROM products WHERE id = ? LIMIT 1
+SELECT id, sku, name, price, status, created_at, updated_at, category, parent_id, variant_size, variant_grade, variant_packaging, reorder_threshold FROM products WHERE id = ? LIMIT 1
 `
 
 func (q *Queries) GetProduct(ctx context.Context, id uint64) (Product, error) {
@@ -55,12 +135,18 @@ func (q *Queries) GetProduct(ctx context.Context, id uint64) (Product, error) {
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Category,
+		&i.ParentID,
+		&i.VariantSize,
+		&i.VariantGrade,
+		&i.VariantPackaging,
+		&i.ReorderThreshold,
 	)
 	return i, err
 }
 
 const getProductBySKU = `-- name: GetProductBySKU :one
-SELECT id, sku, name, price, status, created_at, updated_at FROM products WHERE sku = ? LIMIT 1
+SELECT id, sku, name, price, status, created_at, updated_at, category, parent_id, variant_size, variant_grade, variant_packaging, reorder_threshold FROM products WHERE sku = ? LIMIT 1
 `
 
 func (q *Queries) GetProductBySKU(ctx context.Context, sku string) (Product, error) {
@@ -74,12 +160,182 @@ func (q *Queries) GetProductBySKU(ctx context.Context, sku string) (Product, err
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Category,
+		&i.ParentID,
+		&i.VariantSize,
+		&i.VariantGrade,
+		&i.VariantPackaging,
+		&i.ReorderThreshold,
 	)
 	return i, err
 }
 
+const listCatalogProducts = `-- name: ListCatalogProducts :many
+
+SELECT p.id, p.sku, p.name, p.price, p.status, p.created_at, p.updated_at, p.category, p.parent_id, p.variant_size, p.variant_grade, p.variant_packaging, p.reorder_threshold,
+       (SELECT COUNT(*) FROM products v WHERE v.parent_id = p.id) AS variant_count
+FROM products p
+WHERE p.parent_id IS NULL
+  AND p.status = COALESCE(?, p.status)
+  AND (? IS NULL OR p.category IN (
+      SELECT c.code
+      FROM category_paths cp
+      JOIN categories c ON c.id = cp.descendant_id
+      WHERE cp.ancestor_id = ? AND cp.depth <= ?
+  ))
+ORDER BY p.name ASC, p.id ASC
+LIMIT ? OFFSET ?
+`
+
+type ListCatalogProductsParams struct {
+	Status     NullProductsStatus `json:"status"`
+	CategoryID sql.NullInt64      `json:"category_id"`
+	MaxDepth   uint32             `json:"max_depth"`
+	Limit      int32              `json:"limit"`
+	Offset     int32              `json:"offset"`
+}
+
+type ListCatalogProductsRow struct {
+	ID               uint64         `json:"id"`
+	Sku              string         `json:"sku"`
+	Name             string         `json:"name"`
+	Price            string         `json:"price"`
+	Status           ProductsStatus `json:"status"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	Category         sql.NullString `json:"category"`
+	ParentID         sql.NullInt64  `json:"parent_id"`
+	VariantSize      string         `json:"variant_size"`
+	VariantGrade     string         `json:"variant_grade"`
+	VariantPackaging string         `json:"variant_packaging"`
+	ReorderThreshold sql.NullInt64  `json:"reorder_threshold"`
+	VariantCount     int64          `json:"variant_count"`
+}
+
+// ============================================================================
+// Catalog Browsing
+// ============================================================================
+// 카탈로그 상품 목록 (변형 제외, 변형 수 포함)
+// category_id 지정 시 해당 카테고리 + max_depth 단계 이내 하위 카테고리의 상품
+func (q *Queries) ListCatalogProducts(ctx context.Context, arg ListCatalogProductsParams) ([]ListCatalogProductsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listCatalogProducts,
+		arg.Status,
+		arg.CategoryID,
+		arg.CategoryID,
+		arg.MaxDepth,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListCatalogProductsRow{}
+	for rows.Next() {
+		var i ListCatalogProductsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Sku,
+			&i.Name,
+			&i.Price,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Category,
+			&i.ParentID,
+			&i.VariantSize,
+			&i.VariantGrade,
+			&i.VariantPackaging,
+			&i.ReorderThreshold,
+			&i.VariantCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listProductVariantMatrix = `-- name: ListProductVariantMatrix :many
+SELECT p.id, p.sku, p.name, p.price, p.status, p.created_at, p.updated_at, p.category, p.parent_id, p.variant_size, p.variant_grade, p.variant_packaging, p.reorder_threshold,
+       CAST(COALESCE(SUM(i.quantity), 0) AS SIGNED) AS stock_quantity,
+       CAST(COALESCE(SUM(i.reserved_quantity), 0) AS SIGNED) AS stock_reserved,
+       COUNT(i.id) AS stock_locations
+FROM products p
+LEFT JOIN inventories i ON i.product_id = p.id
+WHERE p.parent_id = ?
+GROUP BY p.id
+ORDER BY p.variant_size ASC, p.variant_grade ASC, p.variant_packaging ASC, p.id ASC
+`
+
+type ListProductVariantMatrixRow struct {
+	ID               uint64         `json:"id"`
+	Sku              string         `json:"sku"`
+	Name             string         `json:"name"`
+	Price            string         `json:"price"`
+	Status           ProductsStatus `json:"status"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+	Category         sql.NullString `json:"category"`
+	ParentID         sql.NullInt64  `json:"parent_id"`
+	VariantSize      string         `json:"variant_size"`
+	VariantGrade     string         `json:"variant_grade"`
+	VariantPackaging string         `json:"variant_packaging"`
+	ReorderThreshold sql.NullInt64  `json:"reorder_threshold"`
+	StockQuantity    int64          `json:"stock_quantity"`
+	StockReserved    int64          `json:"stock_reserved"`
+	StockLocations   int64          `json:"stock_locations"`
+}
+
+// 기준 상품의 변형별 재고 합계 (재고 행 없음 = 재고 미관리, locations = 0)
+func (q *Queries) ListProductVariantMatrix(ctx context.Context, parentID sql.NullInt64) ([]ListProductVariantMatrixRow, error) {
+	rows, err := q.db.QueryContext(ctx, listProductVariantMatrix, parentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListProductVariantMatrixRow{}
+	for rows.Next() {
+		var i ListProductVariantMatrixRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Sku,
+			&i.Name,
+			&i.Price,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Category,
+			&i.ParentID,
+			&i.VariantSize,
+			&i.VariantGrade,
+			&i.VariantPackaging,
+			&i.ReorderThreshold,
+			&i.StockQuantity,
+			&i.StockReserved,
+			&i.StockLocations,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listProducts = `-- name: ListProducts :many
-SELECT id, sku, name, price, status, created_at, updated_at FROM products
+SELECT id, sku, name, price, status, created_at, updated_at, category, parent_id, variant_size, variant_grade, variant_packaging, reorder_threshold FROM products
 WHERE status = COALESCE(?, status)
 ORDER BY created_at DESC
 LIMIT ? OFFSET ?
@@ -108,6 +364,12 @@ func (q *Queries) ListProducts(ctx context.Context, arg ListProductsParams) ([]P
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Category,
+			&i.ParentID,
+			&i.VariantSize,
+			&i.VariantGrade,
+			&i.VariantPackaging,
+			&i.ReorderThreshold,
 		); err != nil {
 			return nil, err
 		}
@@ -144,3 +406,24 @@ func (q *Queries) UpdateProduct(ctx context.Context, arg UpdateProductParams) er
 	)
 	return err
 }
+
+const updateProductReorderThreshold = `-- name: UpdateProductReorderThreshold :exec
+
+UPDATE products
+SET reorder_threshold = ?, updated_at = NOW()
+WHERE id = ?
+`
+
+type UpdateProductReorderThresholdParams struct {
+	ReorderThreshold sql.NullInt64 `json:"reorder_threshold"`
+	ID               uint64        `json:"id"`
+}
+
+// ============================================================================
+// Reorder Thresholds
+// ============================================================================
+// 재주문 기준 수량 설정 (NULL = 알림 해제)
+func (q *Queries) UpdateProductReorderThreshold(ctx context.Context, arg UpdateProductReorderThresholdParams) error {
+	_, err := q.db.ExecContext(ctx, updateProductReorderThreshold, arg.ReorderThreshold, arg.ID)
+	return err
+}
@@ -7,9 +7,47 @@ package db
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
 type Querier interface {
+	// 초대 수락 (PENDING만)
+	AcceptOrganizationInvitation(ctx context.Context, arg AcceptOrganizationInvitationParams) (sql.Result, error)
+	// ============================================================================
+	// API Usage Queries
+	// ============================================================================
+	// NOTE: 요청 카운터는 Redis에 누적 → usage flush job이 AddAPIUsage로 MySQL에 합산
+	// NOTE: bucket_start는 UTC 정시 (서비스 레이어에서 truncate 후 전달)
+	// 시간 단위 사용량 합산 (같은 키/시간/라우트 행이 있으면 증가분 누적)
+	AddAPIUsage(ctx context.Context, arg AddAPIUsageParams) error
+	// 기간 사용액 증가 (GetAccountLimitUsageForUpdate row-lock 하에서만)
+	AddAccountLimitUsage(ctx context.Context, arg AddAccountLimitUsageParams) error
+	// 미결제 잔액 증감 (청구 = 양수, 결제/취소 = 음수, GetCreditTermsForUpdate row-lock 하에서만)
+	AddCreditTermsOutstanding(ctx context.Context, arg AddCreditTermsOutstandingParams) error
+	// 청구서 대변/반환 누적 (GetInvoiceForUpdate row-lock 하에서만)
+	AddInvoiceCredit(ctx context.Context, arg AddInvoiceCreditParams) error
+	// 결제액 누적 (GetInvoiceForUpdate row-lock 하에서만)
+	AddInvoicePaidAmount(ctx context.Context, arg AddInvoicePaidAmountParams) error
+	// 견적 요청 대상 판매자
+	AddRFQSeller(ctx context.Context, arg AddRFQSellerParams) error
+	// 다음 파생 인덱스로 이동 (사용 여부와 무관하게 인덱스는 재사용하지 않음)
+	AdvanceHDDerivationCursor(ctx context.Context, keyID string) error
+	// 반품 승인 (REQUESTED → APPROVED, 환불·재입고 결과 기록)
+	ApproveRMA(ctx context.Context, arg ApproveRMAParams) (int64, error)
+	// 상품 카테고리 지정 (변형 상품은 기준 상품의 카테고리 상속 → 함께 변경)
+	AssignProductCategory(ctx context.Context, arg AssignProductCategoryParams) (int64, error)
+	// 정산을 마감 배치에 묶음 (미마감 + 미상계 PENDING 정산만)
+	AssignSettlementBatch(ctx context.Context, arg AssignSettlementBatchParams) error
+	// 정산을 순지급에 묶음 (미상계 PENDING 정산만 - 개별 지급 배치에서 제외)
+	AssignSettlementNetPayout(ctx context.Context, arg AssignSettlementNetPayoutParams) error
+	// 미결제 주문 취소 (CONFIRMED → CANCELLED, 이미 결제/취소된 주문은 영향 없음)
+	CancelConfirmedOrder(ctx context.Context, id uint64) (sql.Result, error)
+	// 미출고 주문 취소 요청 (PENDING/CONFIRMED → CANCELLED, 결제 완료 주문은 환불 경로)
+	CancelOpenOrder(ctx context.Context, id uint64) (sql.Result, error)
+	// 미확인 주문 취소 (PENDING → CANCELLED)
+	CancelPendingOrder(ctx context.Context, id uint64) (sql.Result, error)
+	// 주문 전환 (ACTIVE → CHECKED_OUT)
+	CheckoutCart(ctx context.Context, arg CheckoutCartParams) (int64, error)
 	// Primary 지갑 연결 해제
 	ClearAccountPrimaryWallet(ctx context.Context, ownerID sql.NullInt64) error
 	// ============================================================================
@@ -17,12 +55,119 @@ type Querier interface {
 	// ============================================================================
 	// 기존 Primary 지갑 해제 (SetPrimary 트랜잭션 첫 단계, 삭제 제외)
 	ClearPrimaryWallet(ctx context.Context, userID uint64) error
+	// 청구서 종료 (PAID = 전액 결제, VOID = 취소)
+	CloseInvoice(ctx context.Context, arg CloseInvoiceParams) error
+	// 견적 수락(AWARDED, 주문 전환) 또는 취소
+	CloseRFQ(ctx context.Context, arg CloseRFQParams) error
+	// 반품 거절/철회 (REQUESTED → REJECTED | CANCELLED)
+	CloseRMA(ctx context.Context, arg CloseRMAParams) (int64, error)
+	// 완전 상계된 순지급의 정산 완료 (on-chain 지급 없음)
+	CompleteOffsetSettlements(ctx context.Context, netPayoutID sql.NullInt64) error
+	// 반환 송금 결과 반영 (PENDING → SUCCEEDED/FAILED/RETURN_FAILED)
+	CompletePaymentRefund(ctx context.Context, arg CompletePaymentRefundParams) (int64, error)
+	// owner 위임 서명 반영 (서명 대기 + 미해제 건만)
+	ConfirmDelegatedSigner(ctx context.Context, arg ConfirmDelegatedSignerParams) (int64, error)
+	// 견적 기록 후 주문 확정 (PENDING → CONFIRMED)
+	ConfirmOrder(ctx context.Context, id uint64) (sql.Result, error)
+	// 승인 서명 반영 + 활성화 대기 시작 (승인 대기 + 미해제 건만)
+	ConfirmPayoutAddress(ctx context.Context, arg ConfirmPayoutAddressParams) (int64, error)
+	// 주문 상품을 청구 항목으로 복사 (주문 단가 고정, 항목 번호 = 주문 상품 순서)
+	CopyOrderItemsToInvoice(ctx context.Context, arg CopyOrderItemsToInvoiceParams) error
+	// 주문 세금 내역을 청구서로 복사 (주문 청구서 항목 번호 = 주문 상품 순서)
+	CopyTaxLines(ctx context.Context, arg CopyTaxLinesParams) error
+	// 계정의 명세서 수
+	CountAccountStatements(ctx context.Context, accountID uint64) (int64, error)
+	// 계정의 입출금 이체 수
+	CountAccountTransfersByAccount(ctx context.Context, accountID uint64) (int64, error)
 	// 타입별 계정 수
 	CountAccountsByType(ctx context.Context, accountType AccountsAccountType) (int64, error)
+	// 사용자의 활성 API 키 수
+	CountActiveAPIKeysByUser(ctx context.Context, userID uint64) (int64, error)
+	// 삭제 대상 감사 로그 수 (dry-run)
+	CountAuditLogsBefore(ctx context.Context, createdAt time.Time) (int64, error)
+	CountCartsByBuyer(ctx context.Context, arg CountCartsByBuyerParams) (int64, error)
+	CountCatalogProducts(ctx context.Context, arg CountCatalogProductsParams) (int64, error)
+	// 구매자별 쿠폰 사용 횟수
+	CountCouponRedemptionsByBuyer(ctx context.Context, arg CountCouponRedemptionsByBuyerParams) (int64, error)
+	CountCouponsBySeller(ctx context.Context, sellerID uint64) (int64, error)
+	// 사용자가 구매자 또는 판매자인 외상 조건 수
+	CountCreditTermsByUser(ctx context.Context, userID uint64) (int64, error)
+	// 분쟁 수 (페이징용)
+	CountDisputes(ctx context.Context, status NullDisputesStatus) (int64, error)
+	CountInventoryLevels(ctx context.Context, lowStock interface{}) (int64, error)
+	// 사용자가 발행했거나 받은 청구서 수 (받은 청구서는 DRAFT 제외, 상태 필터)
+	CountInvoicesByUser(ctx context.Context, arg CountInvoicesByUserParams) (int64, error)
+	CountKycDocuments(ctx context.Context, status NullKycDocumentsStatus) (int64, error)
+	CountKycDocumentsByUser(ctx context.Context, userID uint64) (int64, error)
+	CountKycWebhookEvents(ctx context.Context, applicantID sql.NullString) (int64, error)
+	// hold 수 (페이징용)
+	CountLegalHolds(ctx context.Context, arg CountLegalHoldsParams) (int64, error)
+	// 조직이 구매자 또는 판매자인 주문 수
+	CountOrdersByOrganization(ctx context.Context, organizationID sql.NullInt64) (int64, error)
+	// 조직의 초대 수
+	CountOrganizationInvitations(ctx context.Context, organizationID uint64) (int64, error)
+	// 조직의 OWNER 수 (마지막 OWNER 제거 방지, locking read → 트랜잭션 스냅샷이 아닌 최신 커밋 기준)
+	CountOrganizationOwners(ctx context.Context, organizationID uint64) (int64, error)
+	// 삭제 대상 outbox 이벤트 수 (dry-run)
+	CountOutboxEventsBefore(ctx context.Context, createdAt time.Time) (int64, error)
+	// 수신자의 이벤트 수 (페이징용)
+	CountOutboxEventsByRecipient(ctx context.Context, arg CountOutboxEventsByRecipientParams) (int64, error)
+	// 승인 요청 수 (상태 필터)
+	CountPayoutApprovals(ctx context.Context, status NullPayoutApprovalsStatus) (int64, error)
+	// 변형 수 (0보다 크면 기준 상품은 직접 주문·재고 관리 불가)
+	CountProductVariants(ctx context.Context, parentID sql.NullInt64) (int64, error)
+	// 사용자가 구매자 또는 판매자인 발주 수 (상태 필터)
+	CountPurchaseOrdersByUser(ctx context.Context, arg CountPurchaseOrdersByUserParams) (int64, error)
+	// 사용자가 요청했거나 대상 판매자인 견적 요청 수 (상태 필터)
+	CountRFQsByUser(ctx context.Context, arg CountRFQsByUserParams) (int64, error)
+	// 사용자가 요청했거나 받은 반품 수 (상태 필터)
+	CountRMAsByUser(ctx context.Context, arg CountRMAsByUserParams) (int64, error)
+	// 실행 기록 수 (페이지네이션)
+	CountRetentionRuns(ctx context.Context) (int64, error)
+	// 정산 레코드가 생성된 결제 수 (판매자 지급 이후 환불은 회수 절차 필요)
+	CountSettledPaymentsByOrder(ctx context.Context, orderID uint64) (int64, error)
+	// 수취 계정의 마감 배치 수
+	CountSettlementBatchesByAccount(ctx context.Context, payeeAccountID uint64) (int64, error)
+	// 순지급 수 (상태 필터)
+	CountSettlementNetPayouts(ctx context.Context, status NullSettlementNetPayoutsStatus) (int64, error)
+	// 수취 계정의 정산 수
+	CountSettlementsByPayeeAccount(ctx context.Context, payeeAccountID uint64) (int64, error)
+	CountStockMovementsByProduct(ctx context.Context, arg CountStockMovementsByProductParams) (int64, error)
+	// 마지막 브로드캐스트 후 timeout이 지난 미확정 송금 수
+	CountStuckChainTransactions(ctx context.Context, broadcastBefore time.Time) (int64, error)
+	// 대사 불일치 수 (허용치 초과 필터)
+	CountTreasuryReconciliations(ctx context.Context, exceedsTolerance sql.NullBool) (int64, error)
+	CountUserImportRows(ctx context.Context, arg CountUserImportRowsParams) (int64, error)
+	// 작업의 행 상태별 건수 (집계 갱신용)
+	CountUserImportRowsByStatus(ctx context.Context, importID uint64) ([]CountUserImportRowsByStatusRow, error)
+	CountUserImports(ctx context.Context) (int64, error)
 	// 사용자 수 조회 (페이징용)
 	CountUsers(ctx context.Context, arg CountUsersParams) (int64, error)
+	// 지갑에 실제 송금된 챌린지 수 (브로드캐스트 전 실패는 tx_hash 없음 → 제외), 지갑당 발급 상한
+	CountWalletMicroTransfersSent(ctx context.Context, walletID uint64) (int64, error)
+	// 지갑 관련 토큰 전송 수 (페이지네이션)
+	CountWalletTransfers(ctx context.Context, arg CountWalletTransfersParams) (int64, error)
 	// 사용자의 지갑 수 조회 (삭제 제외)
 	CountWalletsByUser(ctx context.Context, userID uint64) (int64, error)
+	// 지갑 목록 필터 기준 전체 수 (cursor 무관)
+	CountWalletsByUserExternalID(ctx context.Context, arg CountWalletsByUserExternalIDParams) (int64, error)
+	// 삭제 대상 웹훅 전송 건 수 (dry-run)
+	CountWebhookDeliveriesBefore(ctx context.Context, createdAt time.Time) (int64, error)
+	// 사용자의 엔드포인트 수 (등록 한도 체크, 삭제 제외)
+	CountWebhookEndpointsByUser(ctx context.Context, userID uint64) (int64, error)
+	// ============================================================================
+	// API Key Queries
+	// ============================================================================
+	// NOTE: key_hash는 서비스 레이어에서 SHA-256(hex) 계산 후 전달
+	// NOTE: revoked_at IS NULL = 활성 키
+	// API 키 발급 (external_id는 서비스 레이어에서 UUID 생성 후 전달)
+	CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (sql.Result, error)
+	// ============================================================================
+	// API Key Bootstrap Token Queries
+	// ============================================================================
+	// NOTE: token_hash는 서비스 레이어에서 SHA-256(hex) 계산 후 전달
+	// 사용자 생성 시 발급 (재발급 시 이전 토큰 대체)
+	CreateAPIKeyBootstrapToken(ctx context.Context, arg CreateAPIKeyBootstrapTokenParams) error
 	// ============================================================================
 	// Account Queries - Phase 1
 	// ============================================================================
@@ -30,13 +175,278 @@ type Querier interface {
 	// 계정 생성 (사용자 회원가입 시 자동 생성)
 	// account_type: USER(일반), MERCHANT(판매자), ESCROW(에스크로), SYSTEM(시스템)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (sql.Result, error)
+	// ============================================================================
+	// Account Statement Queries
+	// ============================================================================
+	// NOTE: 명세서는 불변 - 생성(INSERT)만 있고 수정 쿼리 없음 (uk_account_statement_period로 월당 1건)
+	// 월간 명세서 생성 (uk_account_statement_period → 동시 생성 시 중복 키 오류)
+	CreateAccountStatement(ctx context.Context, arg CreateAccountStatementParams) (sql.Result, error)
+	// 명세서 항목 (reference_type, entry_type별 합계)
+	CreateAccountStatementLine(ctx context.Context, arg CreateAccountStatementLineParams) error
+	// ============================================================================
+	// Account Transfer Queries
+	// ============================================================================
+	// NOTE: 이체 = CreateAccountTransfer → ledger.Post(ACCOUNT_TRANSFER) → SetAccountTransferLedgerTx (한 트랜잭션)
+	// 계정 간 이체 기록 (uk_account_transfer_idempotency → 같은 Idempotency-Key 재시도는 중복 키 오류)
+	CreateAccountTransfer(ctx context.Context, arg CreateAccountTransferParams) (sql.Result, error)
+	// ============================================================================
+	// Audit Log Queries
+	// ============================================================================
+	// NOTE: audit_logs는 불변 (INSERT/SELECT만 허용, UPDATE/DELETE 쿼리 정의 금지)
+	// NOTE: 보존 기한 경과분 삭제는 retention.sql의 PurgeAuditLogsBefore로만 허용
+	// 감사 로그 기록 (상태 변경과 같은 트랜잭션에서 호출)
+	CreateAuditLog(ctx context.Context, arg CreateAuditLogParams) error
+	// 알림 발송 기록 (uk_budget_alert → 동시 실행 시 한쪽만 성공)
+	CreateBudgetAlert(ctx context.Context, arg CreateBudgetAlertParams) error
+	// ============================================================================
+	// Cart Queries
+	// ============================================================================
+	// 장바구니 생성 (expires_at = 생성 시각 + TTL)
+	CreateCart(ctx context.Context, arg CreateCartParams) (sql.Result, error)
+	// ============================================================================
+	// Category Queries
+	// ============================================================================
+	CreateCategory(ctx context.Context, arg CreateCategoryParams) (sql.Result, error)
+	// 새 카테고리의 경로 = 상위 카테고리의 조상 경로 (depth + 1) + 자기 자신 (depth 0)
+	CreateCategoryPaths(ctx context.Context, arg CreateCategoryPathsParams) error
+	// ============================================================================
+	// Platform Chain Transaction Queries
+	// ============================================================================
+	// NOTE: 송금 1건(nonce) = chain_transactions 1행, 브로드캐스트(원본 + 교체)마다 chain_transaction_broadcasts 1행
+	// 브로드캐스트된 송금 등록 (PENDING)
+	CreateChainTransaction(ctx context.Context, arg CreateChainTransactionParams) (sql.Result, error)
+	// 브로드캐스트 이력 기록
+	CreateChainTransactionBroadcast(ctx context.Context, arg CreateChainTransactionBroadcastParams) error
+	// ============================================================================
+	// Coupon Queries
+	// ============================================================================
+	CreateCoupon(ctx context.Context, arg CreateCouponParams) (sql.Result, error)
+	CreateCouponRedemption(ctx context.Context, arg CreateCouponRedemptionParams) error
+	// ============================================================================
+	// Credit Note Queries
+	// ============================================================================
+	// NOTE: 청구서 금액/항목은 불변 → 대변 메모 누적액(credited/refunded_amount)만 갱신
+	// NOTE: 생성/누적 갱신은 GetInvoiceForUpdate row-lock 하에서만 (잠금 순서 orders → credit_terms → invoices)
+	// 대변 메모 생성 (applied = 잔액 차감분, refunded = 결제액 반환분)
+	CreateCreditNote(ctx context.Context, arg CreateCreditNoteParams) (sql.Result, error)
+	// 대변 메모 항목 (청구 항목 번호별 수량, amount = 단가 × 수량 + 세금)
+	CreateCreditNoteLine(ctx context.Context, arg CreateCreditNoteLineParams) error
+	// ============================================================================
+	// Credit Terms Queries
+	// ============================================================================
+	// NOTE: outstanding_amount 증감은 GetCreditTermsForUpdate row-lock 하에서만 (잠금 순서 orders → credit_terms → invoices)
+	// NOTE: 외상 청구서는 invoice.sql (credit_terms_id가 있는 청구서)
+	// 구매자-판매자 외상 조건 생성 (uk_credit_terms_parties → 관계당 1건)
+	CreateCreditTerms(ctx context.Context, arg CreateCreditTermsParams) (sql.Result, error)
+	// ============================================================================
+	// Delegated Signer Queries
+	// ============================================================================
+	// NOTE: address는 lower-case로 저장/조회
+	// NOTE: 유효한 위임 = 해제되지 않음 + owner 서명됨 + 만료 전
+	// 위임 서명자 등록 (owner 서명 대기, 사용자별 활성 주소 1건 - uk_delegated_signer_user_active)
+	CreateDelegatedSigner(ctx context.Context, arg CreateDelegatedSignerParams) (sql.Result, error)
+	// 주문 입금 주소 기록 (uk_deposit_address_* 위반 시 중복)
+	CreateDepositAddress(ctx context.Context, arg CreateDepositAddressParams) (sql.Result, error)
+	// ============================================================================
+	// Dispute Queries
+	// ============================================================================
+	// NOTE: 진행 중 분쟁 = status 'OPEN' (결제당 1건 - uk_dispute_open_payment)
+	// NOTE: 동결/해제(hold_balance)는 판매자 계정 row-lock 하에서 ledger.Hold/Release로 처리
+	// 분쟁 생성 (결제 row-lock 하에서 진행 중 분쟁 없음 확인 후)
+	CreateDispute(ctx context.Context, arg CreateDisputeParams) (sql.Result, error)
+	// 증빙 제출 (OPEN 분쟁만 - 서비스에서 분쟁 row-lock 하에서 확인)
+	CreateDisputeEvidence(ctx context.Context, arg CreateDisputeEvidenceParams) (sql.Result, error)
+	// 허용 목록 추가 (uk_feature_allowlist_user 위반 시 중복)
+	CreateFeatureAllowlistEntry(ctx context.Context, arg CreateFeatureAllowlistEntryParams) (sql.Result, error)
+	// ============================================================================
+	// Historical Import Queries
+	// ============================================================================
+	// NOTE: historical_ledger_entries는 불변 - 재실행 시 INSERT IGNORE로 중복 건 건너뜀 (uk_historical_entry)
+	// import 실행 기록 (건수는 항목 기록 후 갱신)
+	CreateHistoricalImport(ctx context.Context, arg CreateHistoricalImportParams) (sql.Result, error)
+	// 재고 이력 기록 (불변)
+	CreateInventoryLog(ctx context.Context, arg CreateInventoryLogParams) error
+	// 창고 간 재고 이동 기록 (재고 이력은 inventory_logs TRANSFER_OUT/TRANSFER_IN)
+	CreateInventoryTransfer(ctx context.Context, arg CreateInventoryTransferParams) (sql.Result, error)
+	// ============================================================================
+	// Invoice Queries
+	// ============================================================================
+	// NOTE: 구매자에게 DRAFT 비공개 (목록/상세 모두), 결제액/상태 변경은 GetInvoiceForUpdate row-lock 하에서만
+	// NOTE: 잠금 순서 orders → credit_terms → invoices (독촉 취소/결제 기록/입금 대사 공통)
+	// 청구서 작성 (DRAFT, 번호/기한은 발행 시, uk_invoice_order → 주문당 1건)
+	CreateInvoice(ctx context.Context, arg CreateInvoiceParams) (sql.Result, error)
+	// 청구 항목 (수동 청구서)
+	CreateInvoiceItem(ctx context.Context, arg CreateInvoiceItemParams) error
+	// 청구서 결제 기록 (deposit_id = 자동 대사된 입금, NULL = 판매자 수동 기록)
+	CreateInvoicePayment(ctx context.Context, arg CreateInvoicePaymentParams) error
+	// ============================================================================
+	// KYC Document Queries
+	// ============================================================================
+	// NOTE: 파일 본문은 아티팩트 저장소(암호화)에, 메타데이터만 DB에 기록
+	CreateKycDocument(ctx context.Context, arg CreateKycDocumentParams) (sql.Result, error)
+	// ============================================================================
+	// Ledger Balance Snapshot Queries
+	// ============================================================================
+	// NOTE: 현재 잔액 = GetLatestLedgerBalanceSnapshot + GetLedgerBalanceSince(last_entry_id)
+	// 잔액 체크포인트 기록 (uk_ledger_balance_snapshot → 같은 지점 중복 스냅샷 방지)
+	CreateLedgerBalanceSnapshot(ctx context.Context, arg CreateLedgerBalanceSnapshotParams) error
+	// 원장 항목 기록 (같은 tx_id의 DEBIT 합계 = CREDIT 합계, 계정 row-lock 하에서 balance_after 계산)
+	CreateLedgerEntry(ctx context.Context, arg CreateLedgerEntryParams) error
+	// ============================================================================
+	// Legal Hold Queries
+	// ============================================================================
+	// NOTE: 활성 hold = released_at IS NULL (해제된 hold도 이력으로 보존, 삭제 금지)
+	// NOTE: 보존 기한 삭제 쿼리(retention.sql)는 활성 hold 대상 데이터를 제외
+	// hold 설정 (대상당 활성 hold 1건 - uk_legal_hold_active_subject 위반 시 중복)
+	CreateLegalHold(ctx context.Context, arg CreateLegalHoldParams) (sql.Result, error)
+	// 계정별 한도 upsert (uk_account_limit_account, NULL = 기본값)
+	CreateOrUpdateAccountLimit(ctx context.Context, arg CreateOrUpdateAccountLimitParams) error
+	// ============================================================================
+	// Budget Queries
+	// ============================================================================
+	// NOTE: 지출 집계 대상 = 취소/환불되지 않은 주문 (PENDING 포함 - 승인 대기 주문도 예산 점유)
+	// 카테고리·기간별 예산 upsert (uk_budget_scope)
+	CreateOrUpdateBudget(ctx context.Context, arg CreateOrUpdateBudgetParams) error
+	// ============================================================================
+	// Order SLA Queries
+	// ============================================================================
+	// NOTE: 적용 SLA = 구매자별 정책 → 판매자 기본 정책 → 서버 설정 (항목별 COALESCE)
+	// 거래 관계별 정책 upsert (uk_order_sla_relationship)
+	CreateOrUpdateOrderSLAPolicy(ctx context.Context, arg CreateOrUpdateOrderSLAPolicyParams) error
+	// 계정별 지급 일정 upsert (uk_settlement_schedule_account)
+	CreateOrUpdateSettlementSchedule(ctx context.Context, arg CreateOrUpdateSettlementScheduleParams) error
+	// ============================================================================
+	// Order Creation
+	// ============================================================================
+	// 주문 생성 (발주 수락 시 발주 가격/결제 기한으로 전환)
+	CreateOrder(ctx context.Context, arg CreateOrderParams) (sql.Result, error)
+	// ============================================================================
+	// Order Discount Queries
+	// ============================================================================
+	// 주문 할인 내역 (주문 생성 트랜잭션에서만)
+	CreateOrderDiscount(ctx context.Context, arg CreateOrderDiscountParams) error
+	// 독촉 단계 실행 기록 (uk_order_dunning_step → 동시 실행 시 한쪽만 성공)
+	CreateOrderDunningStep(ctx context.Context, arg CreateOrderDunningStepParams) error
+	// 주문 상품 (단가 고정)
+	CreateOrderItem(ctx context.Context, arg CreateOrderItemParams) error
+	// 위반 처리 기록 (uk_order_sla_breach → 동시 실행 시 한쪽만 성공)
+	CreateOrderSLABreach(ctx context.Context, arg CreateOrderSLABreachParams) error
+	// ============================================================================
+	// Organization Queries
+	// ============================================================================
+	// NOTE: business_registration_number는 서비스 레이어에서 정규화 후 전달 (uk_organization_brn)
+	// 조직 생성 (생성자는 OWNER 구성원으로 함께 등록)
+	CreateOrganization(ctx context.Context, arg CreateOrganizationParams) (sql.Result, error)
+	// ============================================================================
+	// Organization Invitation Queries
+	// ============================================================================
+	// NOTE: token_hash는 초대 토큰의 SHA-256 (원문 미저장), 만료는 expires_at으로 판정 (상태 전이 없음)
+	// 이메일 초대 생성
+	CreateOrganizationInvitation(ctx context.Context, arg CreateOrganizationInvitationParams) (sql.Result, error)
+	// 구성원 추가 (uk_organization_member → 중복 추가 시 중복 키 오류)
+	CreateOrganizationMember(ctx context.Context, arg CreateOrganizationMemberParams) error
+	// ============================================================================
+	// Outbox Queries
+	// ============================================================================
+	// NOTE: 이벤트 기록은 반드시 상태 변경과 같은 트랜잭션(tx-bound Queries)에서 호출
+	// NOTE: claim은 FOR UPDATE SKIP LOCKED → 여러 인스턴스의 relay가 동시에 실행 가능
+	// 도메인 이벤트 기록 (payload는 이벤트 envelope JSON)
+	CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) error
+	// ============================================================================
+	// Payment Refund Queries
+	// ============================================================================
+	// NOTE: 환불 가능 잔액 = 결제 금액 - 환불 합계 (FAILED 제외) - 결제 row-lock(GetPaymentForUpdate) 하에서 계산
+	// 환불 기록
+	CreatePaymentRefund(ctx context.Context, arg CreatePaymentRefundParams) (sql.Result, error)
+	// ============================================================================
+	// Payout Address Whitelist Queries
+	// ============================================================================
+	// NOTE: address는 lower-case로 저장/조회
+	// NOTE: 지급 가능 = 해제되지 않음 + 승인됨 + active_after 경과
+	// 화이트리스트 주소 등록 (승인 대기, 사용자/체인별 활성 주소 1건 - uk_payout_address_user_active)
+	CreatePayoutAddress(ctx context.Context, arg CreatePayoutAddressParams) (sql.Result, error)
+	// ============================================================================
+	// Payout Approval Queries
+	// ============================================================================
+	// NOTE: 승인 레코드는 기준 금액 이상 지급(정산 배치/순지급)에만 생성, 지급 대상 조회는 APPROVED가 아닌 승인이 있으면 제외
+	// 고액 지급 승인 요청 생성 (지급 생성 트랜잭션 내)
+	CreatePayoutApproval(ctx context.Context, arg CreatePayoutApprovalParams) (sql.Result, error)
 	CreateProduct(ctx context.Context, arg CreateProductParams) (sql.Result, error)
 	// ============================================================================
+	// Product Variants
+	// ============================================================================
+	// 변형 상품 생성 (기준 상품의 카테고리 상속)
+	CreateProductVariant(ctx context.Context, arg CreateProductVariantParams) (sql.Result, error)
+	// ============================================================================
+	// Purchase Order Queries
+	// ============================================================================
+	// NOTE: 항목은 현재 조건만 보관 (역제안/재제안 시 삭제 후 재생성, 이전 조건은 감사 로그)
+	// 발주 생성 (SUBMITTED, 판매자 응답 대기)
+	CreatePurchaseOrder(ctx context.Context, arg CreatePurchaseOrderParams) (sql.Result, error)
+	// 발주 항목 (현재 조건)
+	CreatePurchaseOrderItem(ctx context.Context, arg CreatePurchaseOrderItemParams) error
+	// ============================================================================
+	// RFQ Queries
+	// ============================================================================
+	// NOTE: 판매자는 초대된(rfq_sellers) 요청만 조회/견적 제출 가능
+	// 견적 요청 생성 (OPEN)
+	CreateRFQ(ctx context.Context, arg CreateRFQParams) (sql.Result, error)
+	// 견적 요청 항목 (상품·수량)
+	CreateRFQItem(ctx context.Context, arg CreateRFQItemParams) error
+	// ============================================================================
+	// RFQ Quotes
+	// ============================================================================
+	// 판매자 견적 제출 (ACTIVE)
+	CreateRFQQuote(ctx context.Context, arg CreateRFQQuoteParams) (sql.Result, error)
+	// 견적 항목 단가 (견적 통화 기준)
+	CreateRFQQuoteItem(ctx context.Context, arg CreateRFQQuoteItemParams) error
+	CreateRMA(ctx context.Context, arg CreateRMAParams) (sql.Result, error)
+	CreateRMALine(ctx context.Context, arg CreateRMALineParams) error
+	// ============================================================================
+	// Retention Runs
+	// ============================================================================
+	// 실행 기록 (external_id는 서비스 레이어에서 생성)
+	CreateRetentionRun(ctx context.Context, arg CreateRetentionRunParams) error
+	// 마감 배치 생성 (수취 계정 + 마감 시각당 1건)
+	CreateSettlementBatch(ctx context.Context, arg CreateSettlementBatchParams) (sql.Result, error)
+	// 계정 쌍 순지급 생성 (완전 상계 시 payee NULL + OFFSET)
+	CreateSettlementNetPayout(ctx context.Context, arg CreateSettlementNetPayoutParams) (sql.Result, error)
+	// ============================================================================
+	// Status Feed Queries
+	// ============================================================================
+	// NOTE: 공개 피드는 서비스에서 캐시 (비인증 엔드포인트 → 요청마다 DB 조회 금지)
+	// 장애 공지 게시
+	CreateStatusIncident(ctx context.Context, arg CreateStatusIncidentParams) (sql.Result, error)
+	// 공지 경과 기록
+	CreateStatusIncidentUpdate(ctx context.Context, arg CreateStatusIncidentUpdateParams) error
+	// ============================================================================
+	// Stock Movements
+	// ============================================================================
+	// 재고 변동 감사 이력 기록 (불변)
+	CreateStockMovement(ctx context.Context, arg CreateStockMovementParams) error
+	// ============================================================================
+	// Tax Line Queries
+	// ============================================================================
+	// NOTE: 세금 내역은 문서 생성 트랜잭션에서만 기록 (이후 세율 변경과 무관하게 고정)
+	// 문서 항목 세금 내역
+	CreateTaxLine(ctx context.Context, arg CreateTaxLineParams) error
+	// 대사 불일치 기록
+	CreateTreasuryReconciliation(ctx context.Context, arg CreateTreasuryReconciliationParams) error
+	// ============================================================================
 	// User Queries - Phase 1
 	// ============================================================================
 	// 사용자 생성 (external_id는 서비스 레이어에서 UUID 생성 후 전달)
 	CreateUser(ctx context.Context, arg CreateUserParams) (sql.Result, error)
 	// ============================================================================
+	// User Import Queries
+	// ============================================================================
+	// NOTE: 행은 청크 단위로 처리 (작업 row-lock 후 PENDING 행 처리 → 집계 갱신)
+	// import 작업 생성 (행은 같은 트랜잭션에서 기록)
+	CreateUserImport(ctx context.Context, arg CreateUserImportParams) (sql.Result, error)
+	// 업로드된 사용자 1명 기록 (검증 실패/작업 내 중복은 처리 전 결과로 기록)
+	CreateUserImportRow(ctx context.Context, arg CreateUserImportRowParams) error
+	CreateVolumeTier(ctx context.Context, arg CreateVolumeTierParams) error
+	// ============================================================================
 	// Wallet Queries - Phase 1
 	// ============================================================================
 	// NOTE: wallet address는 저장/조회 시 lower-case normalize 적용
@@ -44,8 +454,95 @@ type Querier interface {
 	// NOTE: Soft Delete 적용 - deleted_at IS NULL 조건 필수
 	// 지갑 등록 (address는 서비스에서 lower-case 변환 후 전달)
 	// is_verified=false, is_primary=false 기본값
+	// ENS 이름으로 등록한 경우 ens_name/ens_status=RESOLVED/ens_checked_at 함께 저장 (아니면 NULL)
 	CreateWallet(ctx context.Context, arg CreateWalletParams) (sql.Result, error)
+	// ============================================================================
+	// Wallet Micro-transfer Queries
+	// ============================================================================
+	// NOTE: 지갑당 최신 챌린지 1건만 유효 (id DESC 기준)
+	// 챌린지 생성 (송금 전 PENDING 상태로 기록 → 송금 결과로 SENT/FAILED 전이)
+	CreateWalletMicroTransfer(ctx context.Context, arg CreateWalletMicroTransferParams) (sql.Result, error)
+	// ============================================================================
+	// Warehouse Queries
+	// ============================================================================
+	CreateWarehouse(ctx context.Context, arg CreateWarehouseParams) (sql.Result, error)
+	// 수취 전용 지갑 등록 (서명 검증 없이 계정 소유자 보증)
+	// is_verified=false 유지 → Primary 설정/서명 기반 기능 불가
+	CreateWatchOnlyWallet(ctx context.Context, arg CreateWatchOnlyWalletParams) (sql.Result, error)
+	// ============================================================================
+	// Deliveries
+	// ============================================================================
+	// 전송 건 생성 (이벤트 발행 트랜잭션 내 호출, (event_id, endpoint_id) 중복 방지)
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) error
+	// ============================================================================
+	// Webhook Queries
+	// ============================================================================
+	// NOTE: Soft Delete 적용 - 엔드포인트 조회 시 deleted_at IS NULL 조건 필수
+	// NOTE: 전송 건 claim은 FOR UPDATE SKIP LOCKED → 여러 인스턴스의 dispatcher가 동시에 실행 가능
+	// 엔드포인트 등록 (external_id, secret은 서비스 레이어에서 생성)
+	CreateWebhookEndpoint(ctx context.Context, arg CreateWebhookEndpointParams) (sql.Result, error)
+	DecideKycApplicant(ctx context.Context, arg DecideKycApplicantParams) error
+	// 최종 승인/거절 (PENDING만)
+	DecidePayoutApproval(ctx context.Context, arg DecidePayoutApprovalParams) error
+	// 수락(주문 전환)/거절/취소 기록
+	DecidePurchaseOrder(ctx context.Context, arg DecidePurchaseOrderParams) error
+	// 남은 ACTIVE 견적 일괄 거절 (다른 견적 수락/요청 취소 시)
+	DeclineActiveRFQQuotes(ctx context.Context, rfqID uint64) error
+	DeleteAPIKeyBootstrapToken(ctx context.Context, userID uint64) error
+	DeleteBudget(ctx context.Context, id uint64) error
+	DeleteCartItem(ctx context.Context, arg DeleteCartItemParams) (int64, error)
+	// 미발행 청구서 폐기 (DRAFT만, 항목은 CASCADE)
+	DeleteDraftInvoice(ctx context.Context, id uint64) (sql.Result, error)
+	// 허용 목록 제거
+	DeleteFeatureAllowlistEntry(ctx context.Context, arg DeleteFeatureAllowlistEntryParams) (int64, error)
+	DeleteOrderSLAPolicy(ctx context.Context, id uint64) error
+	// 구성원 제거
+	DeleteOrganizationMember(ctx context.Context, arg DeleteOrganizationMemberParams) (sql.Result, error)
 	DeleteProduct(ctx context.Context, id uint64) error
+	// 조건 변경 시 기존 항목 삭제 (새 조건으로 교체)
+	DeletePurchaseOrderItems(ctx context.Context, purchaseOrderID uint64) error
+	// 견적 재제출 시 기존 단가 삭제
+	DeleteRFQQuoteItems(ctx context.Context, quoteID uint64) error
+	// 세율 삭제 (기존 문서 세금 내역은 유지)
+	DeleteTaxRate(ctx context.Context, externalID string) (sql.Result, error)
+	// 구간 교체 시 기존 구간 삭제
+	DeleteVolumeTiers(ctx context.Context, productID uint64) error
+	// 쿠폰 비활성화 (이미 제출된 발주도 수락 시 적용 불가)
+	DisableCoupon(ctx context.Context, id uint64) (int64, error)
+	// 기간 사용액 카운터 생성 (이미 있으면 무시)
+	EnsureAccountLimitUsage(ctx context.Context, arg EnsureAccountLimitUsageParams) error
+	// ============================================================================
+	// Deposit Address Queries
+	// ============================================================================
+	// NOTE: address는 lower-case로 저장/조회
+	// NOTE: 인덱스 할당 = EnsureHDDerivationCursor → GetHDDerivationCursorForUpdate → AdvanceHDDerivationCursor (한 트랜잭션)
+	// 키의 커서 생성 (이미 있으면 무시)
+	EnsureHDDerivationCursor(ctx context.Context, keyID string) error
+	// 상품·위치별 재고 행 생성 (이미 있으면 유지)
+	EnsureInventory(ctx context.Context, arg EnsureInventoryParams) error
+	// 주소가 사용자를 대신해 scope 권한으로 서명 가능한지 (결제 승인/로그인 전 검사)
+	ExistsActiveDelegation(ctx context.Context, arg ExistsActiveDelegationParams) (bool, error)
+	// 대상의 활성 hold 여부 (삭제/익명화 전 검사)
+	ExistsActiveLegalHold(ctx context.Context, arg ExistsActiveLegalHoldParams) (bool, error)
+	// 지급 대상 가능 여부 (지급 실행 전 검사)
+	ExistsActivePayoutAddress(ctx context.Context, arg ExistsActivePayoutAddressParams) (bool, error)
+	ExistsBudgetAlert(ctx context.Context, arg ExistsBudgetAlertParams) (bool, error)
+	// 결제 대금이 판매자에게 지급 완료(on-chain)되었는지 여부 (환불 방식 결정)
+	ExistsCompletedSettlementByPayment(ctx context.Context, paymentID uint64) (bool, error)
+	// 사용자의 파일럿 허용 여부 (게이팅 미들웨어)
+	ExistsFeatureAllowlistEntry(ctx context.Context, arg ExistsFeatureAllowlistEntryParams) (bool, error)
+	// ============================================================================
+	// KYC Webhook Event Queries
+	// ============================================================================
+	// NOTE: 결정 처리와 같은 트랜잭션에서 기록 (applied가 실제 반영 여부와 일치)
+	// 같은 제공자 이벤트의 재전송 여부 (applicant 잠금 후 확인 → 1회만 반영)
+	ExistsKycWebhookEvent(ctx context.Context, arg ExistsKycWebhookEventParams) (bool, error)
+	// 결제의 진행 중 분쟁 여부
+	ExistsOpenDisputeByPayment(ctx context.Context, paymentID uint64) (bool, error)
+	// 해당 이메일의 사용자가 이미 구성원인지 (초대 중복 방지)
+	ExistsOrganizationMemberByEmail(ctx context.Context, arg ExistsOrganizationMemberByEmailParams) (bool, error)
+	// 같은 이메일로 유효한 대기 초대가 있는지
+	ExistsPendingOrganizationInvitation(ctx context.Context, arg ExistsPendingOrganizationInvitationParams) (bool, error)
 	// 이메일 중복 체크
 	ExistsUserByEmail(ctx context.Context, email string) (bool, error)
 	// 사용자의 검증된 지갑 존재 여부 (삭제 제외)
@@ -55,6 +552,23 @@ type Querier interface {
 	// ============================================================================
 	// 지갑 주소 중복 체크 (address는 lower-case로 전달, 삭제 제외)
 	ExistsWalletByAddress(ctx context.Context, address string) (bool, error)
+	// 승인 만료 (AUTHORIZED → EXPIRED, 그 사이 확정/해제된 결제는 영향 없음)
+	ExpireAuthorizedPayment(ctx context.Context, id uint64) (sql.Result, error)
+	// 만료 경과 장바구니 일괄 만료 (ACTIVE → EXPIRED, 배치 크기 제한)
+	ExpireCarts(ctx context.Context, arg ExpireCartsParams) (int64, error)
+	// 예약 만료 (PENDING/CONFIRMED → PAYMENT_EXPIRED, 그 사이 결제 시작·청구서 발행·취소된 주문은 영향 없음)
+	ExpireOrderReservation(ctx context.Context, id uint64) (sql.Result, error)
+	// 계정 거래 동결 (관리자, 이미 동결된 계정은 영향 없음 - status와 독립)
+	FreezeAccount(ctx context.Context, arg FreezeAccountParams) (sql.Result, error)
+	GetAPIKeyBootstrapTokenForUpdate(ctx context.Context, userID uint64) (ApiKeyBootstrapToken, error)
+	// 외부 식별자 + 사용자 소유권 검증 조회 (폐기 포함 - 멱등성 체크용)
+	GetAPIKeyByExternalIDAndUser(ctx context.Context, arg GetAPIKeyByExternalIDAndUserParams) (ApiKey, error)
+	// ID로 API 키 조회 (내부 전용, 폐기 포함)
+	GetAPIKeyByID(ctx context.Context, id uint64) (ApiKey, error)
+	// 트랜잭션 내 row-lock (키 교체 시 동시 교체 방지)
+	GetAPIKeyForUpdate(ctx context.Context, arg GetAPIKeyForUpdateParams) (ApiKey, error)
+	// flush 대상 키의 내부 ID (폐기 포함 - 폐기 직전 요청도 집계)
+	GetAPIKeyIDByExternalID(ctx context.Context, externalID string) (uint64, error)
 	// ============================================================================
 	// 잔액 조회 (Phase 3+에서 사용, Phase 1에서는 미사용)
 	// ============================================================================
@@ -70,10 +584,231 @@ type Querier interface {
 	GetAccountByOwnerID(ctx context.Context, ownerID sql.NullInt64) (Account, error)
 	// 트랜잭션 내 row-lock (잔액 변경, Primary 지갑 연결 등)
 	GetAccountForUpdate(ctx context.Context, id uint64) (Account, error)
+	// ============================================================================
+	// Account Limit Queries
+	// ============================================================================
+	// NOTE: 사용액 반영 = 계정 row-lock → EnsureAccountLimitUsage → GetAccountLimitUsageForUpdate → AddAccountLimitUsage (한 트랜잭션)
+	// 계정별 한도 설정 (없으면 KYC 상태별 기본값)
+	GetAccountLimit(ctx context.Context, accountID uint64) (AccountLimit, error)
+	// 기간 사용액 카운터 row-lock (같은 계정의 동시 결제/지급 직렬화)
+	GetAccountLimitUsageForUpdate(ctx context.Context, arg GetAccountLimitUsageForUpdateParams) (AccountLimitUsage, error)
+	// 계정의 월간 명세서 조회
+	GetAccountStatementByPeriod(ctx context.Context, arg GetAccountStatementByPeriodParams) (AccountStatement, error)
+	// ID로 이체 조회
+	GetAccountTransferByID(ctx context.Context, id uint64) (AccountTransfer, error)
+	// 출금 계정의 Idempotency-Key로 이체 조회 (재시도 시 기존 이체 반환)
+	GetAccountTransferByIdempotencyKey(ctx context.Context, arg GetAccountTransferByIdempotencyKeyParams) (AccountTransfer, error)
+	// 인증용 조회: 활성 키 + ACTIVE 사용자만 (해시로 조회)
+	GetActiveAPIKeyPrincipal(ctx context.Context, keyHash string) (GetActiveAPIKeyPrincipalRow, error)
+	// ============================================================================
+	// Treasury Reconciliation Queries
+	// ============================================================================
+	// NOTE: treasury_reconciliations는 불일치가 관측된 회차만 기록 (append-only)
+	// 유형별 활성 시스템 지갑 (TREASURY 주소 조회)
+	GetActiveSystemWalletByType(ctx context.Context, walletType SystemWalletsWalletType) (SystemWallet, error)
+	// 국가·카테고리 세율 (카테고리 세율 우선, 없으면 국가 기본 세율)
+	GetApplicableTaxRate(ctx context.Context, arg GetApplicableTaxRateParams) (TaxRate, error)
+	// 주문 수량에 해당하는 가장 높은 구간
+	GetApplicableVolumeTier(ctx context.Context, arg GetApplicableVolumeTierParams) (VolumeTier, error)
+	// 소유권 확인 포함 조회
+	GetBudgetByExternalIDAndUser(ctx context.Context, arg GetBudgetByExternalIDAndUserParams) (Budget, error)
+	GetBudgetByScope(ctx context.Context, arg GetBudgetByScopeParams) (Budget, error)
+	// 기간 내 구매자 카테고리 지출 (주문 상품 금액 합계)
+	GetBuyerCategorySpend(ctx context.Context, arg GetBuyerCategorySpendParams) (string, error)
+	// 기간 내 구매자 전체 지출
+	GetBuyerSpend(ctx context.Context, arg GetBuyerSpendParams) (string, error)
+	// 주문의 확정 결제 (반품 환불 대상, 최신 1건)
+	GetCapturedPaymentByOrder(ctx context.Context, orderID uint64) (Payment, error)
+	// 장바구니 상세 (구매자/판매자 외부 식별자 + 전환된 주문 번호)
+	GetCartDetail(ctx context.Context, externalID string) (GetCartDetailRow, error)
+	// 트랜잭션 내 row-lock (항목 변경/주문 전환 직렬화)
+	GetCartForUpdate(ctx context.Context, id uint64) (Cart, error)
+	GetCategoryByCode(ctx context.Context, code string) (GetCategoryByCodeRow, error)
+	// 카테고리 깊이 (최상위 = 0)
+	GetCategoryDepth(ctx context.Context, descendantID uint64) (int64, error)
+	// ============================================================================
+	// Counterparty Risk Queries
+	// ============================================================================
+	// 구매자(거래 상대방) 결제 이력 집계 - 결제 대상 주문만 (PENDING/CANCELLED 제외)
+	// paid_late: 납기(payment_due_at) 이후 capture, overdue_unpaid: 납기 경과 + 미결제
+	// disputed: 주문/결제에 지원 케이스가 연결된 주문, charged_back: 환불된 결제가 있는 주문
+	GetCounterpartyPaymentStats(ctx context.Context, arg GetCounterpartyPaymentStatsParams) (GetCounterpartyPaymentStatsRow, error)
+	// 판매자 쿠폰 코드 조회 (코드는 대문자로 저장)
+	GetCouponByCode(ctx context.Context, arg GetCouponByCodeParams) (Coupon, error)
+	// 쿠폰 + 판매자 external ID (권한 확인용)
+	GetCouponDetail(ctx context.Context, externalID string) (GetCouponDetailRow, error)
+	// 쿠폰 row-lock (사용 한도 확인 → 사용 기록 직렬화)
+	GetCouponForUpdate(ctx context.Context, id uint64) (Coupon, error)
+	// 주문 당사자의 외상 조건 (없으면 외상 불가)
+	GetCreditTermsByParties(ctx context.Context, arg GetCreditTermsByPartiesParams) (CreditTerm, error)
+	// 외상 조건 + 구매자/판매자 (조회 권한 확인용)
+	GetCreditTermsDetail(ctx context.Context, externalID string) (GetCreditTermsDetailRow, error)
+	// 외상 조건 row-lock (같은 관계의 동시 청구/결제/한도 변경 직렬화)
+	GetCreditTermsForUpdate(ctx context.Context, id uint64) (CreditTerm, error)
+	// 외부 식별자 + 사용자 소유권 검증 조회
+	GetDelegatedSignerByExternalIDAndUser(ctx context.Context, arg GetDelegatedSignerByExternalIDAndUserParams) (DelegatedSigner, error)
+	// ID로 조회 (내부 전용)
+	GetDelegatedSignerByID(ctx context.Context, id uint64) (DelegatedSigner, error)
+	// 입금 주소로 주문 귀속 (입금 감지 시)
+	GetDepositAddressByAddress(ctx context.Context, address string) (DepositAddress, error)
+	// 주문의 입금 주소 (주문당 1개)
+	GetDepositAddressByOrder(ctx context.Context, orderID uint64) (DepositAddress, error)
+	// 입금 row-lock (다중 인스턴스 대사 직렬화)
+	GetDepositForUpdate(ctx context.Context, id uint64) (Deposit, error)
+	// 분쟁 상세 (결제/주문 + 구매자/판매자 외부 식별자 - 조회 권한 확인용)
+	GetDisputeDetailByExternalID(ctx context.Context, externalID string) (GetDisputeDetailByExternalIDRow, error)
+	// 트랜잭션 내 row-lock (증빙 제출/해결 동시성 제어)
+	GetDisputeForUpdate(ctx context.Context, id uint64) (Dispute, error)
+	// ============================================================================
+	// Feature Rollout Queries
+	// ============================================================================
+	// NOTE: 행이 없는 기능 = PILOT (허용 목록 사용자만 접근)
+	// 기능 롤아웃 상태 조회
+	GetFeatureRollout(ctx context.Context, feature string) (FeatureRollout, error)
+	// 다음 파생 인덱스 row-lock 조회 (동시 할당 직렬화)
+	GetHDDerivationCursorForUpdate(ctx context.Context, keyID string) (HdDerivationCursor, error)
+	// 상품·위치별 재고 row-lock (반품 재입고)
+	GetInventoryByProductForUpdate(ctx context.Context, arg GetInventoryByProductForUpdateParams) (Inventory, error)
+	// 트랜잭션 내 row-lock (예약 수량 변경 전)
+	GetInventoryForUpdate(ctx context.Context, id uint64) (Inventory, error)
+	// 주문의 청구서 (주문당 1건)
+	GetInvoiceByOrder(ctx context.Context, orderID sql.NullInt64) (Invoice, error)
+	// 청구서 + 구매자/판매자 + 주문 번호/외상 조건 (조회 권한 확인용, 수동 청구서는 주문/조건 NULL)
+	GetInvoiceDetail(ctx context.Context, externalID string) (GetInvoiceDetailRow, error)
+	// 청구서 row-lock (주문/외상 조건 잠금 후)
+	GetInvoiceForUpdate(ctx context.Context, id uint64) (Invoice, error)
+	GetKycApplicantForUpdate(ctx context.Context, arg GetKycApplicantForUpdateParams) (KycApplicant, error)
+	GetKycDocumentByExternalID(ctx context.Context, externalID string) (GetKycDocumentByExternalIDRow, error)
+	// 심사 직렬화 (동시 심사 시 한 건만 반영)
+	GetKycDocumentForUpdate(ctx context.Context, id uint64) (KycDocument, error)
+	// 사용자의 최근 applicant (KYC 상태 조회용)
+	GetLatestKycApplicantByUser(ctx context.Context, userID uint64) (KycApplicant, error)
+	// 계정의 최신 잔액 스냅샷
+	GetLatestLedgerBalanceSnapshot(ctx context.Context, accountID uint64) (LedgerBalanceSnapshot, error)
+	// 계정의 최신 원장 항목 (balance_after 비교용)
+	GetLatestLedgerEntry(ctx context.Context, accountID uint64) (LedgerEntry, error)
+	// 결제 환불의 최근 변경 시각 (결제 조회 ETag/Last-Modified, 환불 없으면 no rows)
+	GetLatestPaymentRefundUpdatedAt(ctx context.Context, paymentID uint64) (time.Time, error)
+	// 지갑의 최신 챌린지 조회
+	GetLatestWalletMicroTransfer(ctx context.Context, walletID uint64) (WalletMicroTransfer, error)
+	// 트랜잭션 내 row-lock (금액 확인 시 attempts 동시 증가 방지)
+	GetLatestWalletMicroTransferForUpdate(ctx context.Context, walletID uint64) (WalletMicroTransfer, error)
+	// ============================================================================
+	// Ledger Queries
+	// ============================================================================
+	// NOTE: ledger_entries는 불변 (Double-entry) - 잔액의 source of truth
+	// NOTE: accounts.balance는 ledger 합계의 캐시
+	// 원장 기준 잔액 (CREDIT 합계 - DEBIT 합계), DECIMAL 문자열로 반환
+	GetLedgerBalance(ctx context.Context, accountID uint64) (GetLedgerBalanceRow, error)
+	// 시점 이전 원장 잔액 (명세서 기초 잔액)
+	GetLedgerBalanceBefore(ctx context.Context, arg GetLedgerBalanceBeforeParams) (string, error)
+	// 항목 id 이후의 잔액 변동 (CREDIT 합계 - DEBIT 합계) + 항목 수 + 마지막 항목 id (한 번의 일관된 읽기)
+	GetLedgerBalanceSince(ctx context.Context, arg GetLedgerBalanceSinceParams) (GetLedgerBalanceSinceRow, error)
+	// 외부 식별자로 hold 조회
+	GetLegalHoldByExternalID(ctx context.Context, externalID string) (LegalHold, error)
+	// 트랜잭션 내 row-lock (해제 동시성 제어)
+	GetLegalHoldByExternalIDForUpdate(ctx context.Context, externalID string) (LegalHold, error)
+	// ID로 hold 조회 (내부 전용)
+	GetLegalHoldByID(ctx context.Context, id uint64) (LegalHold, error)
+	// 전송 기한이 지난 웹훅 중 가장 오래된 전송 예정 시각 (backoff 대기 중인 재시도 제외, 없으면 no rows)
+	GetOldestDueWebhookDeliveryTime(ctx context.Context) (time.Time, error)
+	// ============================================================================
+	// Pipeline Lag
+	// ============================================================================
+	// 발행 대기 중 가장 오래된 이벤트의 기록 시각 (DEAD_LETTER 제외, 없으면 no rows)
+	GetOldestPendingOutboxEventTime(ctx context.Context) (time.Time, error)
+	// 트랜잭션 내 row-lock (상태 전이 전 재확인)
+	GetOrderByIDForUpdate(ctx context.Context, id uint64) (Order, error)
+	// ============================================================================
+	// Order Queries
+	// ============================================================================
+	// 주문번호로 주문 조회 (외부 API 식별자)
+	GetOrderByOrderNumber(ctx context.Context, orderNumber string) (Order, error)
+	// 소유권 확인 포함 조회
+	GetOrderSLAPolicyByExternalIDAndSeller(ctx context.Context, arg GetOrderSLAPolicyByExternalIDAndSellerParams) (OrderSlaPolicy, error)
+	// buyer_key 0 = 판매자 기본 정책
+	GetOrderSLAPolicyByRelationship(ctx context.Context, arg GetOrderSLAPolicyByRelationshipParams) (OrderSlaPolicy, error)
+	// 외부 식별자로 조직 조회 (API 노출용)
+	GetOrganizationByExternalID(ctx context.Context, externalID string) (Organization, error)
+	// ID로 조직 조회
+	GetOrganizationByID(ctx context.Context, id uint64) (Organization, error)
+	// 조직 row-lock (구성원 변경 직렬화 - 마지막 OWNER 보호)
+	GetOrganizationForUpdate(ctx context.Context, id uint64) (Organization, error)
+	// 조직의 초대 조회 (외부 식별자)
+	GetOrganizationInvitation(ctx context.Context, arg GetOrganizationInvitationParams) (OrganizationInvitation, error)
+	// ID로 초대 조회
+	GetOrganizationInvitationByID(ctx context.Context, id uint64) (OrganizationInvitation, error)
+	// 토큰으로 초대 조회 + row-lock (동시 수락/회수 직렬화)
+	GetOrganizationInvitationByTokenForUpdate(ctx context.Context, tokenHash string) (OrganizationInvitation, error)
+	// 조직의 구성원 조회 (접근 권한 확인)
+	GetOrganizationMember(ctx context.Context, arg GetOrganizationMemberParams) (OrganizationMember, error)
+	// ============================================================================
+	// Payment Queries
+	// ============================================================================
+	// 외부 식별자로 결제 조회
+	GetPaymentByExternalID(ctx context.Context, externalID sql.NullString) (Payment, error)
+	// 결제 상세 (주문 번호 + 구매자/판매자 외부 식별자 - 조회 권한 확인용)
+	GetPaymentDetailByExternalID(ctx context.Context, externalID sql.NullString) (GetPaymentDetailByExternalIDRow, error)
+	// 트랜잭션 내 row-lock (환불 가능 잔액 검증 동시성 제어)
+	GetPaymentForUpdate(ctx context.Context, id uint64) (Payment, error)
+	// ID로 환불 조회 (내부 전용)
+	GetPaymentRefundByID(ctx context.Context, id uint64) (PaymentRefund, error)
+	// 결제의 환불 합계 (FAILED 제외), DECIMAL 문자열로 반환
+	GetPaymentRefundedAmount(ctx context.Context, paymentID uint64) (string, error)
+	// 외부 식별자 + 사용자 소유권 검증 조회
+	GetPayoutAddressByExternalIDAndUser(ctx context.Context, arg GetPayoutAddressByExternalIDAndUserParams) (PayoutAddress, error)
+	// ID로 조회 (내부 전용)
+	GetPayoutAddressByID(ctx context.Context, id uint64) (PayoutAddress, error)
+	// 승인 요청 상세 (지급/수취 계정/승인자 외부 식별자)
+	GetPayoutApprovalDetail(ctx context.Context, id uint64) (GetPayoutApprovalDetailRow, error)
+	// 승인 요청 row-lock (동시 승인/거절 직렬화)
+	GetPayoutApprovalForUpdate(ctx context.Context, externalID string) (PayoutApproval, error)
 	// 사용자의 Primary 지갑 조회 (삭제 제외)
 	GetPrimaryWallet(ctx context.Context, userID uint64) (Wallet, error)
 	GetProduct(ctx context.Context, id uint64) (Product, error)
+	// 상품의 가용 재고 합계 (전체 위치, 트랜잭션 내 변경 반영)
+	GetProductAvailableStock(ctx context.Context, productID uint64) (int64, error)
 	GetProductBySKU(ctx context.Context, sku string) (Product, error)
+	// 발주 상세 + 구매자/판매자 + 전환된 주문 번호 (조회 권한 확인용)
+	GetPurchaseOrderDetail(ctx context.Context, externalID string) (GetPurchaseOrderDetailRow, error)
+	// 발주 row-lock (응답/취소 동시 요청 직렬화)
+	GetPurchaseOrderForUpdate(ctx context.Context, externalID string) (PurchaseOrder, error)
+	// 견적 요청 상세 + 구매자 + 전환된 주문 번호
+	GetRFQDetail(ctx context.Context, externalID string) (GetRFQDetailRow, error)
+	// 견적 요청 row-lock (견적 제출/수락/취소 직렬화)
+	GetRFQForUpdate(ctx context.Context, id uint64) (Rfq, error)
+	// 판매자의 견적 (요청당 1건)
+	GetRFQQuoteBySeller(ctx context.Context, arg GetRFQQuoteBySellerParams) (RfqQuote, error)
+	// 견적 row-lock (수락/철회)
+	GetRFQQuoteForUpdate(ctx context.Context, externalID string) (RfqQuote, error)
+	// 반품 + 주문 번호 + 구매자/판매자 + 환불 식별자 (조회 권한 확인용)
+	GetRMADetail(ctx context.Context, externalID string) (GetRMADetailRow, error)
+	// 트랜잭션 내 row-lock (승인/거절/철회 동시성 제어)
+	GetRMAForUpdate(ctx context.Context, id uint64) (Rma, error)
+	// 판매자의 기간 내 확정 결제 합계 (수수료 등급 산정), DECIMAL 문자열로 반환
+	GetSellerCapturedVolume(ctx context.Context, arg GetSellerCapturedVolumeParams) (string, error)
+	// 배치 조회 + 수취 계정/소유자 외부 식별자 (조회 권한 확인용)
+	GetSettlementBatchByExternalID(ctx context.Context, externalID string) (GetSettlementBatchByExternalIDRow, error)
+	// 순지급 상세 (계정 쌍/수취 계정 외부 식별자)
+	GetSettlementNetPayoutDetail(ctx context.Context, id uint64) (GetSettlementNetPayoutDetailRow, error)
+	// ============================================================================
+	// Payout Schedules
+	// ============================================================================
+	// 계정의 지급 일정 (없으면 서버 기본값)
+	GetSettlementScheduleByAccount(ctx context.Context, accountID uint64) (SettlementSchedule, error)
+	// 트랜잭션 내 row-lock (상태 변경 동시성 제어)
+	GetStatusIncidentByExternalIDForUpdate(ctx context.Context, externalID string) (StatusIncident, error)
+	// ID로 공지 조회 (내부 전용)
+	GetStatusIncidentByID(ctx context.Context, id uint64) (StatusIncident, error)
+	// 대상 + 티켓으로 조회 (upsert 결과 반환용)
+	GetSupportCaseBySubjectAndTicket(ctx context.Context, arg GetSupportCaseBySubjectAndTicketParams) (SupportCase, error)
+	// ============================================================================
+	// Tax Profile / Rate Queries
+	// ============================================================================
+	// 사용자 과세 프로필 (없음 = 비과세)
+	GetTaxProfile(ctx context.Context, userID uint64) (TaxProfile, error)
+	// 국가·카테고리의 세율 (category_key '' = 국가 기본 세율)
+	GetTaxRateByScope(ctx context.Context, arg GetTaxRateByScopeParams) (TaxRate, error)
 	// 이메일로 조회 (중복 체크, 로그인 등)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	// 외부 식별자로 조회 (API 노출용, DELETED 제외)
@@ -85,8 +820,11 @@ type Querier interface {
 	GetUserByID(ctx context.Context, id uint64) (User, error)
 	// 트랜잭션 내 row-lock (Primary 지갑 설정, 상태 변경 등 동시성 제어)
 	GetUserForUpdate(ctx context.Context, id uint64) (User, error)
-	// 주소로 지갑 조회 (address는 lower-case로 전달, 삭제 제외)
-	GetWalletByAddress(ctx context.Context, address string) (Wallet, error)
+	GetUserImportByExternalID(ctx context.Context, externalID string) (UserImport, error)
+	// 청크 처리 직렬화 (동시 재개 시 같은 행 중복 처리 방지)
+	GetUserImportForUpdate(ctx context.Context, id uint64) (UserImport, error)
+	// 주소 + 체인으로 지갑 조회 (address는 lower-case로 전달, 삭제 제외)
+	GetWalletByAddress(ctx context.Context, arg GetWalletByAddressParams) (Wallet, error)
 	// 외부 식별자로 지갑 조회 (삭제된 지갑 제외)
 	GetWalletByExternalID(ctx context.Context, externalID string) (Wallet, error)
 	// 외부 식별자 + 사용자 소유권 검증 조회 (외부 API용, 삭제 제외)
@@ -101,21 +839,423 @@ type Querier interface {
 	GetWalletByIDAndUser(ctx context.Context, arg GetWalletByIDAndUserParams) (Wallet, error)
 	// 트랜잭션 내 row-lock (검증, Primary 설정 등)
 	GetWalletForUpdate(ctx context.Context, arg GetWalletForUpdateParams) (Wallet, error)
+	// ID로 챌린지 조회
+	GetWalletMicroTransferByID(ctx context.Context, id uint64) (WalletMicroTransfer, error)
+	GetWarehouseByCode(ctx context.Context, code string) (Warehouse, error)
+	// 외부 식별자 + 사용자 소유권 검증 조회 (삭제 제외)
+	GetWebhookEndpointByExternalIDAndUser(ctx context.Context, arg GetWebhookEndpointByExternalIDAndUserParams) (WebhookEndpoint, error)
+	// ID로 엔드포인트 조회 (내부 전용 - 삭제 제외)
+	GetWebhookEndpointByID(ctx context.Context, id uint64) (WebhookEndpoint, error)
+	// 판매자-구매자 간 주문 이력 존재 여부 (점수 조회 권한 확인)
+	HasTradedWith(ctx context.Context, arg HasTradedWithParams) (bool, error)
+	// 사용 횟수 증가 (쿠폰 row-lock 상태에서만)
+	IncrementCouponRedemptions(ctx context.Context, id uint64) error
+	// 금액 불일치 시 시도 횟수 증가
+	IncrementWalletMicroTransferAttempts(ctx context.Context, id uint64) error
+	// ============================================================================
+	// Fee Queries
+	// ============================================================================
+	// NOTE: fee_ledger_entries는 불변 (INSERT/SELECT만 허용)
+	// NOTE: 판매자 거래액 = 확정(CAPTURED) 결제 합계 (환불/취소 결제 제외, 토큰 구분 없이 합산 - 모두 USD 페그)
+	// 수수료 원장 기록 (이미 기록된 (결제, 유형, 환불)이면 무시 → RowsAffected = 0)
+	InsertFeeLedgerEntry(ctx context.Context, arg InsertFeeLedgerEntryParams) (sql.Result, error)
+	// 검증된 전송 기록 (이미 기록된 (계정, tx, log index)면 무시 → RowsAffected = 0)
+	InsertHistoricalLedgerEntry(ctx context.Context, arg InsertHistoricalLedgerEntryParams) (sql.Result, error)
+	// 청구서 발행 (DRAFT → ISSUED, 번호/발행일/만기 확정)
+	IssueInvoice(ctx context.Context, arg IssueInvoiceParams) (sql.Result, error)
+	// 사용자의 API 키 목록 (폐기 포함, 최신순)
+	ListAPIKeysByUserExternalID(ctx context.Context, externalID sql.NullString) ([]ApiKey, error)
+	// 사용자 API 키들의 기간 내 사용량 (키 → 시간 → 라우트 순)
+	ListAPIUsageByUser(ctx context.Context, arg ListAPIUsageByUserParams) ([]ListAPIUsageByUserRow, error)
+	// 스냅샷 대상 계정 (id 순 페이지)
+	ListAccountIDsAfter(ctx context.Context, arg ListAccountIDsAfterParams) ([]uint64, error)
+	// 현재 일/월 기간의 사용액 (카운터가 없는 기간은 사용액 0)
+	ListAccountLimitUsage(ctx context.Context, arg ListAccountLimitUsageParams) ([]AccountLimitUsage, error)
+	// 명세서 항목 목록
+	ListAccountStatementLines(ctx context.Context, statementID uint64) ([]AccountStatementLine, error)
+	// 계정의 명세서 목록 (최신 월 먼저)
+	ListAccountStatements(ctx context.Context, arg ListAccountStatementsParams) ([]AccountStatement, error)
+	// 계정의 입출금 이체 목록 (최신순) + 양쪽 계정 외부 ID
+	ListAccountTransfersByAccount(ctx context.Context, arg ListAccountTransfersByAccountParams) ([]ListAccountTransfersByAccountRow, error)
 	// ============================================================================
 	// 목록 조회
 	// ============================================================================
 	// 타입별 계정 목록
 	ListAccountsByType(ctx context.Context, arg ListAccountsByTypeParams) ([]Account, error)
+	// 해당 월 명세서가 없는 계정 (월 마감 전 생성된 계정만, id 순 페이지)
+	ListAccountsWithoutStatement(ctx context.Context, arg ListAccountsWithoutStatementParams) ([]ListAccountsWithoutStatementRow, error)
+	// 운영 알림 수신자 (활성 ADMIN 사용자)
+	ListActiveAdminUserIDs(ctx context.Context) ([]uint64, error)
+	// 주소에 scope 권한을 위임한 사용자 목록 (위임 지갑 로그인 시 대상 계정 조회)
+	ListActiveDelegationsByAddress(ctx context.Context, arg ListActiveDelegationsByAddressParams) ([]ListActiveDelegationsByAddressRow, error)
+	// 이벤트 fan-out 대상 (활성 + 삭제 제외, 구독 타입 필터는 서비스 레이어)
+	ListActiveWebhookEndpointsByUser(ctx context.Context, userID uint64) ([]WebhookEndpoint, error)
+	// 리소스별 감사 로그 조회 (최신순)
+	ListAuditLogsByResource(ctx context.Context, arg ListAuditLogsByResourceParams) ([]AuditLog, error)
+	// 마감 대상 정산 row-lock (수취 계정의 cutoff 이전 생성 PENDING + 미마감 + 미상계)
+	ListBatchableSettlementsForUpdate(ctx context.Context, arg ListBatchableSettlementsForUpdateParams) ([]ListBatchableSettlementsForUpdateRow, error)
+	// 알림 작업용 전체 예산 순회 (id 커서)
+	ListBudgetsAfterID(ctx context.Context, arg ListBudgetsAfterIDParams) ([]Budget, error)
+	// 구매자 예산 목록 (전체 예산 먼저)
+	ListBudgetsByUser(ctx context.Context, userID uint64) ([]Budget, error)
+	// 장바구니 항목 + 현재 카탈로그 단가/상태 (담은 순서)
+	ListCartItems(ctx context.Context, cartID uint64) ([]ListCartItemsRow, error)
+	// 구매자의 장바구니 목록 (상태 필터 선택, 최신순)
+	ListCartsByBuyer(ctx context.Context, arg ListCartsByBuyerParams) ([]ListCartsByBuyerRow, error)
+	// ============================================================================
+	// Catalog Browsing
+	// ============================================================================
+	// 카탈로그 상품 목록 (변형 제외, 변형 수 포함)
+	// category_id 지정 시 해당 카테고리 + max_depth 단계 이내 하위 카테고리의 상품
+	ListCatalogProducts(ctx context.Context, arg ListCatalogProductsParams) ([]ListCatalogProductsRow, error)
+	// 전체 카테고리 + 직접 연결된 상품 수 (변형 제외, 트리는 애플리케이션에서 구성)
+	ListCategories(ctx context.Context) ([]ListCategoriesRow, error)
+	// 최상위부터 자기 자신까지의 경로 (breadcrumb)
+	ListCategoryAncestors(ctx context.Context, descendantID uint64) ([]ListCategoryAncestorsRow, error)
+	// 송금의 브로드캐스트 이력 (최신순)
+	ListChainTransactionBroadcasts(ctx context.Context, chainTransactionID uint64) ([]ChainTransactionBroadcast, error)
+	// 판매자 쿠폰 (최신순)
+	ListCouponsBySeller(ctx context.Context, arg ListCouponsBySellerParams) ([]Coupon, error)
+	// 청구서의 대변 메모 항목 (항목별 누적 대변 수량 계산용)
+	ListCreditNoteLinesByInvoice(ctx context.Context, invoiceID uint64) ([]CreditNoteLine, error)
+	// 청구서의 대변 메모 (생성순)
+	ListCreditNotesByInvoice(ctx context.Context, invoiceID uint64) ([]CreditNote, error)
+	// 사용자가 구매자 또는 판매자인 외상 조건 (최신순)
+	ListCreditTermsByUser(ctx context.Context, arg ListCreditTermsByUserParams) ([]ListCreditTermsByUserRow, error)
+	// 사용자의 위임 서명자 (해제 제외, 최신순)
+	ListDelegatedSignersByUser(ctx context.Context, userID uint64) ([]DelegatedSigner, error)
+	// ============================================================================
+	// Deposit Matching Queries
+	// ============================================================================
+	// 대사 대기 입금 (입금 반영 완료, 미처리, 오래된 순)
+	ListDepositsForInvoiceMatching(ctx context.Context, limit int32) ([]Deposit, error)
+	// 분쟁의 증빙 목록 (제출 순) + 제출자 외부 식별자
+	ListDisputeEvidence(ctx context.Context, disputeID uint64) ([]ListDisputeEvidenceRow, error)
+	// 분쟁 목록 (관리자 검토 큐, 상태 필터 옵션, 오래된 순)
+	ListDisputes(ctx context.Context, arg ListDisputesParams) ([]ListDisputesRow, error)
+	// 발행 대상 claim (PENDING/FAILED 또는 lease 만료된 PROCESSING, 기록 순서대로)
+	ListDueOutboxEventsForUpdate(ctx context.Context, limit int32) ([]Outbox, error)
+	// 전송 대상 claim (PENDING 또는 lease 만료된 DELIVERING)
+	ListDueWebhookDeliveriesForUpdate(ctx context.Context, limit int32) ([]WebhookDelivery, error)
+	// 승인 만료 결제 (AUTHORIZED + expires_at 경과, 만료 순 - 만료 스윕 배치)
+	ListExpiredAuthorizedPayments(ctx context.Context, arg ListExpiredAuthorizedPaymentsParams) ([]ListExpiredAuthorizedPaymentsRow, error)
+	// 기능의 허용 목록 (사용자 external ID 포함, 추가순)
+	ListFeatureAllowlist(ctx context.Context, feature string) ([]ListFeatureAllowlistRow, error)
+	// 기능 롤아웃 상태 목록
+	ListFeatureRollouts(ctx context.Context) ([]FeatureRollout, error)
+	// 결제의 수수료 원장 항목 (부과 → 취소 순)
+	ListFeeLedgerEntriesByPayment(ctx context.Context, paymentID uint64) ([]FeeLedgerEntry, error)
+	// 가맹점의 import 이력 (최신순)
+	ListHistoricalImportsByUser(ctx context.Context, userID uint64) ([]HistoricalImport, error)
+	// 계정의 이력 항목 (기초 잔액 계산용, 시간순)
+	ListHistoricalLedgerEntriesByAccount(ctx context.Context, accountID uint64) ([]HistoricalLedgerEntry, error)
+	// 상품의 위치별 재고 (가용 수량 = quantity - reserved_quantity)
+	ListInventoriesByProduct(ctx context.Context, productID uint64) ([]Inventory, error)
+	// ============================================================================
+	// Inventory Levels
+	// ============================================================================
+	// 판매 SKU(변형 또는 변형 없는 상품)별 재고 합계
+	// low_stock = TRUE: 재주문 기준 수량이 설정되고 가용 재고가 그 미만인 SKU만
+	ListInventoryLevels(ctx context.Context, arg ListInventoryLevelsParams) ([]ListInventoryLevelsRow, error)
+	// ============================================================================
+	// Warehouse Locations
+	// ============================================================================
+	// 상품의 창고별 재고 (출고 우선순위 순, INACTIVE 창고 포함 - 주문 예약은 ACTIVE 창고만)
+	ListInventoryLocations(ctx context.Context, productID uint64) ([]ListInventoryLocationsRow, error)
+	// 청구 항목 (항목 번호순)
+	ListInvoiceItems(ctx context.Context, invoiceID uint64) ([]InvoiceItem, error)
+	// 청구서 결제 내역 + 대사된 입금 tx (기록순)
+	ListInvoicePayments(ctx context.Context, invoiceID uint64) ([]ListInvoicePaymentsRow, error)
+	// 사용자가 발행했거나 받은 청구서 (받은 청구서는 DRAFT 제외, 상태 필터, 최신순)
+	ListInvoicesByUser(ctx context.Context, arg ListInvoicesByUserParams) ([]ListInvoicesByUserRow, error)
+	// 관리자 심사 목록 (status 필터 선택, 오래된 순 = 심사 대기열)
+	ListKycDocuments(ctx context.Context, arg ListKycDocumentsParams) ([]ListKycDocumentsRow, error)
+	// 사용자의 KYC 서류 (최신순)
+	ListKycDocumentsByUser(ctx context.Context, userID uint64) ([]KycDocument, error)
+	// 관리자 감사 조회 (applicant 필터 선택, 최신 순)
+	ListKycWebhookEvents(ctx context.Context, arg ListKycWebhookEventsParams) ([]ListKycWebhookEventsRow, error)
+	// hold 목록 (활성 여부/대상 타입 필터 옵션, 최신순)
+	ListLegalHolds(ctx context.Context, arg ListLegalHoldsParams) ([]LegalHold, error)
+	// ============================================================================
+	// Netting
+	// ============================================================================
+	// 상계 대상 정산 row-lock (창 내 PENDING + 미상계 + 미마감 + 같은 창에 반대 방향 정산이 있는 계정 쌍)
+	ListNettableSettlementsForUpdate(ctx context.Context, arg ListNettableSettlementsForUpdateParams) ([]ListNettableSettlementsForUpdateRow, error)
+	// 구매자의 미결제 청구서 (입금 대사 후보, 만기 빠른 순)
+	ListOpenInvoicesByBuyer(ctx context.Context, arg ListOpenInvoicesByBuyerParams) ([]Invoice, error)
+	// 수취 계정의 지급 대기/처리 중 순지급 (PENDING, PROCESSING)
+	ListOpenNetPayoutsByPayee(ctx context.Context, payeeAccountID sql.NullInt64) ([]ListOpenNetPayoutsByPayeeRow, error)
+	// 지급 대기/처리 중 정산 (PENDING, PROCESSING, 상계된 정산은 순지급으로 대체)
+	ListOpenSettlementsByPayee(ctx context.Context, payeeAccountID uint64) ([]ListOpenSettlementsByPayeeRow, error)
+	// 주문 할인 내역 (항목 할인 → 쿠폰 할인 순)
+	ListOrderDiscounts(ctx context.Context, orderID uint64) ([]OrderDiscount, error)
+	// ============================================================================
+	// Inventory Queries
+	// ============================================================================
+	// 주문의 재고별 순 예약 수량 (RESERVE - RELEASE - 주문 출고(OUTBOUND) 이력 합계, 예약이 남은 재고만)
+	ListOrderInventoryReservations(ctx context.Context, referenceID sql.NullInt64) ([]ListOrderInventoryReservationsRow, error)
+	// ============================================================================
+	// Returns (RMA) Queries
+	// ============================================================================
+	// 주문 항목 + 상품 (항목 번호 = 주문 상품 순서, 청구서 항목 번호와 동일)
+	ListOrderLinesForReturn(ctx context.Context, orderID uint64) ([]ListOrderLinesForReturnRow, error)
+	// 판매자의 정책 목록 (기본 정책 먼저)
+	ListOrderSLAPoliciesBySeller(ctx context.Context, sellerID uint64) ([]ListOrderSLAPoliciesBySellerRow, error)
+	// 확인 기한 초과 주문 (PENDING + 기한 경과 + 위반 처리 이력 없음)
+	ListOrdersBreachingConfirmSLA(ctx context.Context, arg ListOrdersBreachingConfirmSLAParams) ([]ListOrdersBreachingConfirmSLARow, error)
+	// 출고 기한 초과 주문 (PAID + 기한 경과 + 위반 처리 이력 없음)
+	ListOrdersBreachingFulfillSLA(ctx context.Context, arg ListOrdersBreachingFulfillSLAParams) ([]ListOrdersBreachingFulfillSLARow, error)
+	// 조직이 구매자 또는 판매자인 주문 (최신순)
+	ListOrdersByOrganization(ctx context.Context, arg ListOrdersByOrganizationParams) ([]Order, error)
+	// ============================================================================
+	// Dunning
+	// ============================================================================
+	// 독촉 단계 대상 (CONFIRMED + 기한 경과 + 같은/이후 단계 미실행)
+	// 이후 단계가 이미 실행된 주문은 앞 단계를 건너뜀 (배포 직후 오래된 주문에 리마인더 연속 발송 방지)
+	ListOrdersDueForDunning(ctx context.Context, arg ListOrdersDueForDunningParams) ([]Order, error)
+	// 예약 만료 주문 (PENDING/CONFIRMED + 만료 시각 경과 + 진행 중 결제·발행 청구서 없음, 만료 순 - 만료 스윕 배치)
+	ListOrdersWithExpiredReservations(ctx context.Context, arg ListOrdersWithExpiredReservationsParams) ([]Order, error)
+	// 조직의 초대 목록 (최신순)
+	ListOrganizationInvitations(ctx context.Context, arg ListOrganizationInvitationsParams) ([]OrganizationInvitation, error)
+	// 조직 구성원 목록 + 사용자 정보
+	ListOrganizationMembers(ctx context.Context, organizationID uint64) ([]ListOrganizationMembersRow, error)
+	// 사용자가 구성원인 조직 목록 + 구성원 역할
+	ListOrganizationsByUser(ctx context.Context, userID uint64) ([]ListOrganizationsByUserRow, error)
+	// 결제의 환불 목록 (요청순)
+	ListPaymentRefundsByPayment(ctx context.Context, paymentID uint64) ([]PaymentRefund, error)
+	// 결제 내보내기 (구매자/판매자로 참여한 결제, 생성 시각 [from, to), id 커서 청크)
+	// 환불 합계는 FAILED 제외, 수수료는 부과 - 취소 합계
+	ListPaymentsForExport(ctx context.Context, arg ListPaymentsForExportParams) ([]ListPaymentsForExportRow, error)
+	// 사용자의 화이트리스트 (해제 제외, 최신순)
+	ListPayoutAddressesByUser(ctx context.Context, userID uint64) ([]PayoutAddress, error)
+	// 승인 요청 목록 (상태 필터, 최신순)
+	ListPayoutApprovals(ctx context.Context, arg ListPayoutApprovalsParams) ([]ListPayoutApprovalsRow, error)
+	// 지급 대기 순지급 (PENDING) + 수취 계정의 Primary 지갑/동결 여부 + 고액 지급 승인 상태 (정산 배치와 함께 계정별 집계)
+	ListPendingNetPayouts(ctx context.Context) ([]ListPendingNetPayoutsRow, error)
+	// 지급 대기 정산 배치 (PENDING + 마감된 배치, 상계 제외) + 수취 계정의 Primary 지갑/동결 여부 + 고액 지급 승인 상태 (서비스에서 계정별 1건 지급으로 집계)
+	ListPendingSettlementPayouts(ctx context.Context) ([]ListPendingSettlementPayoutsRow, error)
+	// 다음 청크 (행 순서대로)
+	ListPendingUserImportRows(ctx context.Context, arg ListPendingUserImportRowsParams) ([]UserImportRow, error)
+	// ============================================================================
+	// Primary 지갑 정합성 (wallet.PrimaryRepairer)
+	// ============================================================================
+	// NOTE: Primary 중복은 uk_wallet_primary_user 제약으로 불가능
+	//       남는 위반: 검증 지갑이 있는데 Primary 없음 / 미검증 Primary / 계정 primary_wallet_id 불일치
+	// Primary 지갑 불변식 위반 사용자 (user id 오름차순 cursor 페이지)
+	// after_user_id: 이전 페이지 마지막 사용자 (0 = 첫 페이지)
+	ListPrimaryWalletViolations(ctx context.Context, arg ListPrimaryWalletViolationsParams) ([]ListPrimaryWalletViolationsRow, error)
+	// 기준 상품의 변형별 재고 합계 (재고 행 없음 = 재고 미관리, locations = 0)
+	ListProductVariantMatrix(ctx context.Context, parentID sql.NullInt64) ([]ListProductVariantMatrixRow, error)
 	ListProducts(ctx context.Context, arg ListProductsParams) ([]Product, error)
+	// 발주 항목 + 상품 SKU/이름 (라인 순)
+	ListPurchaseOrderItems(ctx context.Context, purchaseOrderID uint64) ([]ListPurchaseOrderItemsRow, error)
+	// 사용자가 구매자 또는 판매자인 발주 (상태 필터, 최신순)
+	ListPurchaseOrdersByUser(ctx context.Context, arg ListPurchaseOrdersByUserParams) ([]ListPurchaseOrdersByUserRow, error)
+	// 견적 요청 항목 + 상품 SKU/이름 (라인 순)
+	ListRFQItems(ctx context.Context, rfqID uint64) ([]ListRFQItemsRow, error)
+	// 견적 항목 단가 (라인 순)
+	ListRFQQuoteItems(ctx context.Context, quoteID uint64) ([]RfqQuoteItem, error)
+	// 견적 요청의 견적 + 판매자 (토큰 금액 낮은 순)
+	ListRFQQuotes(ctx context.Context, rfqID uint64) ([]ListRFQQuotesRow, error)
+	// 견적 요청 대상 판매자
+	ListRFQSellers(ctx context.Context, rfqID uint64) ([]ListRFQSellersRow, error)
+	// 사용자가 요청했거나 대상 판매자인 견적 요청 (상태 필터, 최신순)
+	ListRFQsByUser(ctx context.Context, arg ListRFQsByUserParams) ([]ListRFQsByUserRow, error)
+	// 반품 항목 + 상품 (항목 번호순)
+	ListRMALines(ctx context.Context, rmaID uint64) ([]ListRMALinesRow, error)
+	// 사용자가 요청했거나 받은 반품 (상태 필터, 최신순)
+	ListRMAsByUser(ctx context.Context, arg ListRMAsByUserParams) ([]ListRMAsByUserRow, error)
+	// 대량 재전송 대상 (관리자 runbook): 가맹점 엔드포인트의 실패 건 (DEAD 또는 backoff 중인 재시도)
+	// NOTE: 삭제/비활성 엔드포인트 제외 - 재전송해도 바로 DEAD 처리됨
+	ListRequeueableWebhookDeliveriesForUpdate(ctx context.Context, arg ListRequeueableWebhookDeliveriesForUpdateParams) ([]ListRequeueableWebhookDeliveriesForUpdateRow, error)
+	// 최근 실행 기록 (최신순)
+	ListRetentionRuns(ctx context.Context, arg ListRetentionRunsParams) ([]RetentionRun, error)
+	// 주문 항목별 반품 수량 (진행 중 + 승인된 반품, 주문 row-lock 하에서 조회)
+	ListReturnedQuantities(ctx context.Context, orderID uint64) ([]ListReturnedQuantitiesRow, error)
+	// 배치 정산 내보내기 (결제/주문 정보 포함, 정산 id 커서 청크)
+	ListSettlementBatchItemsForExport(ctx context.Context, arg ListSettlementBatchItemsForExportParams) ([]ListSettlementBatchItemsForExportRow, error)
+	// 수취 계정의 마감 배치 (최신순)
+	ListSettlementBatchesByAccount(ctx context.Context, arg ListSettlementBatchesByAccountParams) ([]SettlementBatch, error)
+	// ============================================================================
+	// Settlement Queries
+	// ============================================================================
+	// 판매자의 정산 전 결제 (정산 레코드 미생성)
+	// AUTHORIZED: 확정(capture) 대기, CAPTURED: 다음 배치 정산 대상
+	ListSettlementForecastPayments(ctx context.Context, sellerID uint64) ([]ListSettlementForecastPaymentsRow, error)
+	// 순지급 목록 (상태 필터, 최신순)
+	ListSettlementNetPayouts(ctx context.Context, arg ListSettlementNetPayoutsParams) ([]ListSettlementNetPayoutsRow, error)
+	// 수취 계정의 정산 + 결제/주문 식별자 (최신순)
+	ListSettlementsByPayeeAccount(ctx context.Context, arg ListSettlementsByPayeeAccountParams) ([]ListSettlementsByPayeeAccountRow, error)
+	// 카테고리별 지출 (미분류 = 빈 문자열)
+	ListSpendByCategory(ctx context.Context, arg ListSpendByCategoryParams) ([]ListSpendByCategoryRow, error)
+	// 월별 지출 (UTC, YYYY-MM)
+	ListSpendByMonth(ctx context.Context, arg ListSpendByMonthParams) ([]ListSpendByMonthRow, error)
+	// ============================================================================
+	// Spend analytics
+	// ============================================================================
+	// 판매자별 지출
+	ListSpendBySeller(ctx context.Context, arg ListSpendBySellerParams) ([]ListSpendBySellerRow, error)
+	// 공지별 경과 (최신순)
+	ListStatusIncidentUpdatesByIncidentIDs(ctx context.Context, incidentIds []uint64) ([]StatusIncidentUpdate, error)
+	// 피드 공지 (진행 중 + 기준 시각 이후 해결, 최신순)
+	ListStatusIncidentsForFeed(ctx context.Context, arg ListStatusIncidentsForFeedParams) ([]StatusIncident, error)
+	// 상품의 재고 변동 이력 + 변경 주체·참조 외부 식별자 (사유/위치/시간 범위 필터 옵션, 최신순, 페이징)
+	ListStockMovementsByProduct(ctx context.Context, arg ListStockMovementsByProductParams) ([]ListStockMovementsByProductRow, error)
+	// 마지막 브로드캐스트 후 timeout이 지난 미확정 송금 (오래된 순)
+	ListStuckChainTransactions(ctx context.Context, arg ListStuckChainTransactionsParams) ([]ChainTransaction, error)
+	// 대상에 연결된 티켓 목록 (최근 갱신순)
+	ListSupportCasesBySubject(ctx context.Context, arg ListSupportCasesBySubjectParams) ([]SupportCase, error)
+	// 문서의 세금 내역 (항목 번호순)
+	ListTaxLines(ctx context.Context, arg ListTaxLinesParams) ([]TaxLine, error)
+	// 세율표 (국가 필터, 국가·카테고리순, 기본 세율 먼저)
+	ListTaxRates(ctx context.Context, country sql.NullString) ([]TaxRate, error)
+	// 대사 불일치 목록 (허용치 초과 필터, 최신순)
+	ListTreasuryReconciliations(ctx context.Context, arg ListTreasuryReconciliationsParams) ([]ListTreasuryReconciliationsRow, error)
+	// 수수료 미부과 확정 결제 (확정 순, 수수료 부과 job 배치)
+	ListUnassessedCapturedPayments(ctx context.Context, limit int32) ([]ListUnassessedCapturedPaymentsRow, error)
+	// 마감 대기 정산이 있는 수취 계정 (PENDING + 미마감 + 미상계, 계정 ID 커서 페이지)
+	ListUnbatchedSettlementPayees(ctx context.Context, arg ListUnbatchedSettlementPayeesParams) ([]uint64, error)
+	// 행별 결과 리포트 (status 필터 선택)
+	ListUserImportRows(ctx context.Context, arg ListUserImportRowsParams) ([]ListUserImportRowsRow, error)
+	// import 작업 목록 (최신순)
+	ListUserImports(ctx context.Context, arg ListUserImportsParams) ([]UserImport, error)
 	// ============================================================================
 	// 목록 조회
 	// ============================================================================
 	// 사용자 목록 조회 (상태 필터 옵션, 페이징)
 	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	// ============================================================================
+	// Volume Tier Queries
+	// ============================================================================
+	// 상품 수량 구간 할인 (최소 수량순)
+	ListVolumeTiersByProduct(ctx context.Context, productID uint64) ([]VolumeTier, error)
+	// ============================================================================
+	// Wallet Activity Queries
+	// ============================================================================
+	// NOTE: 인덱서가 기록한 토큰 전송 (플랫폼 체인 기준, 지갑 관점 방향)
+	//   OUT - deposits: 지갑 → 플랫폼 입금
+	//   IN  - withdrawals: 플랫폼 → 지갑 출금, wallet_micro_transfers: 소액 송금 검증
+	// 방향 필터: 제외할 쪽의 out_user_id / in_user_id / in_wallet_id 에 0 전달 (ID는 1부터)
+	// 지갑 관련 토큰 전송 (최신순)
+	ListWalletTransfers(ctx context.Context, arg ListWalletTransfersParams) ([]ListWalletTransfersRow, error)
 	// 사용자의 전체 지갑 목록 (삭제 제외)
 	ListWalletsByUser(ctx context.Context, userID uint64) ([]Wallet, error)
-	// 사용자 external_id로 지갑 목록 조회 (외부 API용, 삭제 제외)
-	ListWalletsByUserExternalID(ctx context.Context, externalID sql.NullString) ([]Wallet, error)
+	// 사용자 external_id로 지갑 목록 조회 (외부 API용, 삭제 제외, 체인 필터 옵션)
+	ListWalletsByUserExternalID(ctx context.Context, arg ListWalletsByUserExternalIDParams) ([]Wallet, error)
+	// ENS 재검증 대상 (마지막 해석이 checked_before 이전, 오래된 순, 삭제 제외)
+	ListWalletsDueForENSCheck(ctx context.Context, arg ListWalletsDueForENSCheckParams) ([]Wallet, error)
+	// 지갑 목록 cursor 페이지 (created_at, id 오름차순, 삭제 제외)
+	// after_created_at/after_id: 이전 페이지 마지막 지갑 (NULL = 첫 페이지)
+	// NOTE: 다음 페이지 여부 확인을 위해 서비스에서 limit + 1 조회
+	ListWalletsPageByUserExternalID(ctx context.Context, arg ListWalletsPageByUserExternalIDParams) ([]Wallet, error)
+	// 창고 목록 + 재고 보유 SKU 수·수량 합계 (출고 우선순위 순)
+	ListWarehouses(ctx context.Context, status NullWarehousesStatus) ([]ListWarehousesRow, error)
+	// 엔드포인트의 최근 전송 내역 (대시보드용)
+	ListWebhookDeliveriesByEndpoint(ctx context.Context, arg ListWebhookDeliveriesByEndpointParams) ([]WebhookDelivery, error)
+	// 이벤트별 전송 내역 (이벤트 로그 검색 응답에 첨부)
+	ListWebhookDeliveriesByEventIDs(ctx context.Context, eventIds []string) ([]WebhookDelivery, error)
+	// 사용자 엔드포인트(삭제 포함)의 시간별 전송 결과 (생성 시각 기준, hour_bucket = UNIX 시각 / 3600)
+	ListWebhookDeliveryStatsByUser(ctx context.Context, arg ListWebhookDeliveryStatsByUserParams) ([]ListWebhookDeliveryStatsByUserRow, error)
+	// 사용자의 엔드포인트 목록 (외부 API용, 삭제 제외)
+	ListWebhookEndpointsByUserExternalID(ctx context.Context, externalID sql.NullString) ([]WebhookEndpoint, error)
+	// 입금 대사 처리 완료 (배정 0건이어도 기록 → 재처리 방지)
+	MarkDepositInvoiceMatched(ctx context.Context, id uint64) error
+	// 만기 경과 청구서 연체 전환 (ISSUED/PARTIALLY_PAID → OVERDUE)
+	MarkInvoicesOverdue(ctx context.Context, dueAt sql.NullTime) (int64, error)
+	// 청구서 전액 결제 (CONFIRMED → PAID)
+	MarkOrderPaid(ctx context.Context, id uint64) (sql.Result, error)
+	// 발행 완료
+	MarkOutboxEventCompleted(ctx context.Context, id uint64) error
+	// 재시도 한도 초과 → DEAD_LETTER (수동 확인 필요)
+	MarkOutboxEventDeadLetter(ctx context.Context, arg MarkOutboxEventDeadLetterParams) error
+	// 발행 실패 - 재시도 예약 (backoff은 relay에서 계산)
+	MarkOutboxEventFailed(ctx context.Context, arg MarkOutboxEventFailedParams) error
+	// 발행 시작 (lease 설정 - 프로세스 중단 시 lease 만료 후 재claim)
+	MarkOutboxEventProcessing(ctx context.Context, arg MarkOutboxEventProcessingParams) error
+	// 전액 환불 완료 (CAPTURED → REFUNDED)
+	MarkPaymentRefunded(ctx context.Context, id uint64) error
+	// OTP 검증 완료 (코드 발송 후 번호가 바뀌었으면 0 rows)
+	MarkUserPhoneVerified(ctx context.Context, arg MarkUserPhoneVerifiedParams) (int64, error)
+	// 재시도 한도 초과 / 엔드포인트 삭제 → DEAD
+	MarkWebhookDeliveryDead(ctx context.Context, arg MarkWebhookDeliveryDeadParams) error
+	// 전송 시작 (lease 설정 - 프로세스 중단 시 lease 만료 후 재claim)
+	MarkWebhookDeliveryDelivering(ctx context.Context, arg MarkWebhookDeliveryDeliveringParams) error
+	// 전송 실패 - 재시도 예약 (exponential backoff은 서비스 레이어에서 계산)
+	MarkWebhookDeliveryRetry(ctx context.Context, arg MarkWebhookDeliveryRetryParams) error
+	// 전송 성공 (2xx)
+	MarkWebhookDeliverySucceeded(ctx context.Context, arg MarkWebhookDeliverySucceededParams) error
+	// 접두어·일자별 문서 번호 채번 (LastInsertId = 새 번호, 행 잠금으로 같은 일자 채번 직렬화)
+	NextDocumentSequence(ctx context.Context, arg NextDocumentSequenceParams) (sql.Result, error)
+	// ============================================================================
+	// Data Retention Queries
+	// ============================================================================
+	// NOTE: Purge 쿼리는 LIMIT 단위로 반복 호출 (대량 DELETE로 인한 장시간 락 방지)
+	// NOTE: 진행 중인 행(PENDING/DELIVERING, PENDING/PROCESSING/FAILED)은 보존 기한과 무관하게 삭제 금지
+	// NOTE: 활성 legal hold 대상(USER/ORDER)과 연결된 행은 hold 해제 전까지 삭제 금지
+	// 보존 기한이 지난 감사 로그 삭제 (hold 대상 리소스/행위자 제외)
+	PurgeAuditLogsBefore(ctx context.Context, arg PurgeAuditLogsBeforeParams) (sql.Result, error)
+	// 보존 기한이 지난 outbox 이벤트 삭제 - 종료 상태만
+	PurgeOutboxEventsBefore(ctx context.Context, arg PurgeOutboxEventsBeforeParams) (sql.Result, error)
+	// 보존 기한이 지난 웹훅 전송 건(payload 포함) 삭제 - 종료 상태만
+	PurgeWebhookDeliveriesBefore(ctx context.Context, arg PurgeWebhookDeliveriesBeforeParams) (sql.Result, error)
+	// 교체 브로드캐스트 반영 (미확정 건만)
+	RecordChainTransactionReplacement(ctx context.Context, arg RecordChainTransactionReplacementParams) (int64, error)
+	// 같은 이벤트 재전송은 delivery_count만 증가 (최초 수신 시 applied 유지)
+	RecordKycWebhookEvent(ctx context.Context, arg RecordKycWebhookEventParams) error
+	// maker가 없는 지급의 첫 승인 기록 (최종 승인은 다른 사용자)
+	RecordPayoutFirstApproval(ctx context.Context, arg RecordPayoutFirstApprovalParams) error
+	// 주문 환불 시 확정 결제 환불 처리 (CAPTURED → REFUNDED)
+	RefundCapturedPaymentsByOrder(ctx context.Context, orderID uint64) (sql.Result, error)
+	// 미출고 결제 완료 주문 환불 처리 (PAID → REFUNDED)
+	RefundPaidOrder(ctx context.Context, id uint64) (sql.Result, error)
+	// hold 해제 (활성 hold만)
+	ReleaseLegalHold(ctx context.Context, arg ReleaseLegalHoldParams) (sql.Result, error)
+	// 재전송 예약 (attempts 초기화 → 재시도 한도 전체 재적용, last_error는 이력으로 유지)
+	RequeueWebhookDeliveries(ctx context.Context, arg RequeueWebhookDeliveriesParams) (sql.Result, error)
+	// 최종 결과 반영 (PENDING → CONFIRMED/FAILED/DROPPED)
+	ResolveChainTransaction(ctx context.Context, arg ResolveChainTransactionParams) (int64, error)
+	// 분쟁 해결 (OPEN → REFUNDED | RELEASED, 진행 중 분쟁만)
+	ResolveDispute(ctx context.Context, arg ResolveDisputeParams) (sql.Result, error)
+	// Soft Delete 복구 - deleted_at 해제 (Primary는 복구하지 않음)
+	// 같은 (주소, 체인)의 활성 지갑이 있으면 uk_wallet_address_chain_active 위반
+	RestoreWallet(ctx context.Context, arg RestoreWalletParams) (sql.Result, error)
+	// 원장 기준 잔액 재동기화 (운영 runbook 전용, 낙관적 락)
+	ResyncAccountBalance(ctx context.Context, arg ResyncAccountBalanceParams) (sql.Result, error)
+	ReviewKycDocument(ctx context.Context, arg ReviewKycDocumentParams) error
+	// 견적 재제출: 가격/환율/유효기간 교체 (revision +1, 철회된 견적도 다시 ACTIVE)
+	ReviseRFQQuote(ctx context.Context, arg ReviseRFQQuoteParams) error
+	// ============================================================================
+	// API 키 상태 업데이트
+	// ============================================================================
+	// API 키 폐기 (활성 키만, RowsAffected로 멱등성 판단)
+	RevokeAPIKey(ctx context.Context, arg RevokeAPIKeyParams) (sql.Result, error)
+	// 위임 해제 (미해제 건만)
+	RevokeDelegatedSigner(ctx context.Context, id uint64) (int64, error)
+	// 초대 회수 (PENDING만)
+	RevokeOrganizationInvitation(ctx context.Context, id uint64) (sql.Result, error)
+	// 화이트리스트 해제 (미해제 건만)
+	RevokePayoutAddress(ctx context.Context, id uint64) (int64, error)
+	// 수신자의 이벤트 로그 검색 (type/resource/시간 범위 필터 옵션, 최신순, 페이징)
+	SearchOutboxEventsByRecipient(ctx context.Context, arg SearchOutboxEventsByRecipientParams) ([]Outbox, error)
+	// 이체 분개의 원장 tx_id 연결
+	SetAccountTransferLedgerTx(ctx context.Context, arg SetAccountTransferLedgerTxParams) error
+	// 반환분 원장 분개 tx 기록
+	SetCreditNoteLedgerTx(ctx context.Context, arg SetCreditNoteLedgerTxParams) error
+	// 청구서 세금 합계 (amount에 포함된 금액, 작성 트랜잭션에서만)
+	SetInvoiceTax(ctx context.Context, arg SetInvoiceTaxParams) error
+	// 법정화폐 가격 주문의 견적 기록 (토큰 금액 + 환율 고정, PENDING 주문만)
+	SetOrderFXQuote(ctx context.Context, arg SetOrderFXQuoteParams) (sql.Result, error)
+	// 외상 주문 결제 기한 = 청구서 만기 (CONFIRMED 주문만, 독촉 기준)
+	SetOrderPaymentDue(ctx context.Context, arg SetOrderPaymentDueParams) (sql.Result, error)
+	// ============================================================================
+	// Reservation Expiry
+	// ============================================================================
+	// 주문 재고 예약 만료 시각 (주문 전환 트랜잭션에서 예약 후 기록)
+	SetOrderReservationExpiry(ctx context.Context, arg SetOrderReservationExpiryParams) error
+	// 주문 세금 합계 (total_amount에 포함된 금액, 주문 생성 트랜잭션에서만)
+	SetOrderTax(ctx context.Context, arg SetOrderTaxParams) error
+	// 견적 상태 변경 (수락/철회)
+	SetRFQQuoteStatus(ctx context.Context, arg SetRFQQuoteStatusParams) error
 	// 새 Primary 지갑 설정 (소유권 + 검증 상태 확인, 삭제 제외)
 	// SetPrimary 트랜잭션: 1) GetUserForUpdate 2) ClearPrimaryWallet 3) SetWalletPrimary
 	SetWalletPrimary(ctx context.Context, arg SetWalletPrimaryParams) (sql.Result, error)
@@ -125,6 +1265,22 @@ type Querier interface {
 	// Soft Delete - deleted_at 설정
 	// Primary 지갑은 삭제 불가 (is_primary = false 조건)
 	SoftDeleteWallet(ctx context.Context, arg SoftDeleteWalletParams) (sql.Result, error)
+	// 엔드포인트 삭제 (대기 중인 전송은 dispatcher가 DEAD 처리)
+	SoftDeleteWebhookEndpoint(ctx context.Context, arg SoftDeleteWebhookEndpointParams) (sql.Result, error)
+	// 기간 내 원장 항목을 (reference_type, entry_type)별 건수/합계로 집계 (명세서 항목)
+	SummarizeLedgerEntries(ctx context.Context, arg SummarizeLedgerEntriesParams) ([]SummarizeLedgerEntriesRow, error)
+	// 마지막 사용 시각 갱신 (인증 성공 시)
+	TouchAPIKeyLastUsed(ctx context.Context, id uint64) error
+	// 변경 시 만료 연장
+	TouchCart(ctx context.Context, arg TouchCartParams) error
+	// 교체하지 않은 채 다음 점검까지 대기 (교체 한도 도달/수수료 상한 초과)
+	TouchChainTransaction(ctx context.Context, arg TouchChainTransactionParams) error
+	// 계정 거래 동결 해제 (동결되지 않은 계정은 영향 없음)
+	UnfreezeAccount(ctx context.Context, id uint64) (sql.Result, error)
+	// 원장 기록 후 잔액 캐시 갱신 (GetAccountForUpdate row-lock 하에서만)
+	UpdateAccountBalance(ctx context.Context, arg UpdateAccountBalanceParams) error
+	// 동결 금액 갱신 (분쟁 동결/해제 - GetAccountForUpdate row-lock 하에서만)
+	UpdateAccountHoldBalance(ctx context.Context, arg UpdateAccountHoldBalanceParams) error
 	// ============================================================================
 	// 계정 업데이트
 	// ============================================================================
@@ -139,8 +1295,40 @@ type Querier interface {
 	// ============================================================================
 	// 계정 정지 (ACTIVE → SUSPENDED)
 	UpdateAccountStatusToSuspended(ctx context.Context, id uint64) error
+	// 검증 시점의 카탈로그 단가로 갱신
+	UpdateCartItemPrice(ctx context.Context, arg UpdateCartItemPriceParams) error
+	UpdateCategoryName(ctx context.Context, arg UpdateCategoryNameParams) error
+	// 조건/한도/상태 변경 (GetCreditTermsForUpdate row-lock 하에서만)
+	UpdateCreditTerms(ctx context.Context, arg UpdateCreditTermsParams) error
+	// import 결과 건수 갱신
+	UpdateHistoricalImportCounts(ctx context.Context, arg UpdateHistoricalImportCountsParams) error
+	// 재고 수량 갱신 (row-lock 하에서 계산한 값)
+	UpdateInventoryQuantity(ctx context.Context, arg UpdateInventoryQuantityParams) error
+	// 예약 수량 갱신 (row-lock 하에서 계산한 값)
+	UpdateInventoryReservedQuantity(ctx context.Context, arg UpdateInventoryReservedQuantityParams) error
+	// 미결제 청구서 상태 변경 (ISSUED → PARTIALLY_PAID, GetInvoiceForUpdate row-lock 하에서만)
+	UpdateInvoiceStatus(ctx context.Context, arg UpdateInvoiceStatusParams) error
+	// 구성원 역할 변경 (OWNER 전용)
+	UpdateOrganizationMemberRole(ctx context.Context, arg UpdateOrganizationMemberRoleParams) error
+	// 회사명/청구 정보 수정 (사업자등록번호는 변경 불가)
+	UpdateOrganizationProfile(ctx context.Context, arg UpdateOrganizationProfileParams) error
+	// 반환 송금 tx hash 기록 (브로드캐스트 후, 교체 송금이 포함되면 갱신)
+	UpdatePaymentRefundTxHash(ctx context.Context, arg UpdatePaymentRefundTxHashParams) error
 	UpdateProduct(ctx context.Context, arg UpdateProductParams) error
 	// ============================================================================
+	// Reorder Thresholds
+	// ============================================================================
+	// 재주문 기준 수량 설정 (NULL = 알림 해제)
+	UpdateProductReorderThreshold(ctx context.Context, arg UpdateProductReorderThresholdParams) error
+	// 역제안/재제안: 조건 교체 + 응답 대기 측 전환 (revision +1)
+	UpdatePurchaseOrderTerms(ctx context.Context, arg UpdatePurchaseOrderTermsParams) error
+	// 공지 상태/영향도 변경 (RESOLVED 전환 시 resolved_at 설정)
+	UpdateStatusIncident(ctx context.Context, arg UpdateStatusIncidentParams) error
+	// 집계 갱신 (미처리 행이 없으면 COMPLETED)
+	UpdateUserImportProgress(ctx context.Context, arg UpdateUserImportProgressParams) error
+	// 행 처리 결과 기록
+	UpdateUserImportRowResult(ctx context.Context, arg UpdateUserImportRowResultParams) error
+	// ============================================================================
 	// KYC 상태 변경 쿼리 (상태별 분리)
 	// ============================================================================
 	// KYC 심사 요청 (NONE/REJECTED → PENDING)
@@ -150,6 +1338,7 @@ type Querier interface {
 	// KYC 승인 (PENDING → VERIFIED, kyc_verified_at 최초 설정 시만)
 	UpdateUserKycToVerified(ctx context.Context, id uint64) error
 	// 프로필 정보 업데이트 (이름, 전화번호)
+	// NOTE: 전화번호가 바뀌면 인증 초기화 + 인증된 번호였으면 변경 시각 기록 (phone_changed_at → phone_verified_at → phone 순서로 평가)
 	UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) error
 	// 역할 변경 (BUYER, SELLER, BOTH, ADMIN)
 	UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) error
@@ -162,13 +1351,57 @@ type Querier interface {
 	// ============================================================================
 	// 사용자 정지 (ACTIVE → SUSPENDED)
 	UpdateUserStatusToSuspended(ctx context.Context, id uint64) (sql.Result, error)
+	// ENS 재검증 결과 기록
+	// previous_checked_at 조건: 다른 인스턴스가 먼저 기록했으면 0 rows (이벤트 중복 방지)
+	UpdateWalletENSStatus(ctx context.Context, arg UpdateWalletENSStatusParams) (int64, error)
 	// 지갑 라벨 변경 (삭제되지 않은 지갑만)
 	UpdateWalletLabel(ctx context.Context, arg UpdateWalletLabelParams) (sql.Result, error)
+	// 금액 확인 완료 (SENT → CONFIRMED)
+	UpdateWalletMicroTransferConfirmed(ctx context.Context, id uint64) (sql.Result, error)
+	// 송금 실패 (PENDING → FAILED)
+	UpdateWalletMicroTransferFailed(ctx context.Context, id uint64) error
+	// ============================================================================
+	// 챌린지 상태 업데이트
+	// ============================================================================
+	// 송금 브로드캐스트 성공 (PENDING → SENT)
+	UpdateWalletMicroTransferSent(ctx context.Context, arg UpdateWalletMicroTransferSentParams) error
+	// 교체 송금(같은 nonce, 수수료 인상)이 포함된 경우 실제 포함된 tx hash로 갱신
+	UpdateWalletMicroTransferTxHash(ctx context.Context, arg UpdateWalletMicroTransferTxHashParams) error
+	// 송금이 revert/유실되어 도달하지 않음 (SENT → FAILED, 새 챌린지 발급 가능)
+	UpdateWalletMicroTransferUndelivered(ctx context.Context, id uint64) error
+	// 소액 송금 검증 완료 (SIGNATURE → MICRO_TRANSFER, 서명 검증 지갑만)
+	UpdateWalletVerificationLevelMicroTransfer(ctx context.Context, arg UpdateWalletVerificationLevelMicroTransferParams) (sql.Result, error)
 	// ============================================================================
 	// 지갑 상태 업데이트 (:execresult로 RowsAffected 검증 가능)
 	// ============================================================================
 	// EIP-712 서명 검증 완료 (삭제되지 않은 지갑만)
+	// watch-only 지갑도 서명 검증 시 일반 지갑으로 승격
 	UpdateWalletVerified(ctx context.Context, arg UpdateWalletVerifiedParams) (sql.Result, error)
+	UpdateWarehouse(ctx context.Context, arg UpdateWarehouseParams) error
+	// 페이로드 버전 변경 (이후 생성되는 전송 건부터 적용)
+	UpdateWebhookEndpointPayloadVersion(ctx context.Context, arg UpdateWebhookEndpointPayloadVersionParams) (sql.Result, error)
+	// 항목 추가 또는 수량 변경 (상품당 1행)
+	UpsertCartItem(ctx context.Context, arg UpsertCartItemParams) error
+	// 기능 롤아웃 상태 설정 (행이 없으면 생성)
+	UpsertFeatureRollout(ctx context.Context, arg UpsertFeatureRolloutParams) error
+	// ============================================================================
+	// KYC Applicant Queries
+	// ============================================================================
+	// NOTE: 제공자 결정은 applicant row-lock 후 처리 (webhook 재전송/동시 수신 시 1회만 반영)
+	// KYC 요청 시 applicant 기록 (같은 applicant 재사용 시 PENDING으로 초기화)
+	UpsertKycApplicant(ctx context.Context, arg UpsertKycApplicantParams) error
+	// ============================================================================
+	// Support Case Queries
+	// ============================================================================
+	// NOTE: 티켓 상태의 원천은 외부 지원 도구 → attach는 upsert (같은 대상+티켓이면 상태 갱신)
+	// 티켓 연결 또는 상태 갱신 (external_id는 최초 연결 시에만 사용)
+	UpsertSupportCase(ctx context.Context, arg UpsertSupportCaseParams) error
+	// 과세 프로필 등록/변경 (사용자당 1건)
+	UpsertTaxProfile(ctx context.Context, arg UpsertTaxProfileParams) error
+	// 세율 등록/변경 (국가·카테고리당 1건, 기존 행은 external_id 유지)
+	UpsertTaxRate(ctx context.Context, arg UpsertTaxRateParams) error
+	// 주문 취소 시 미확정 결제 승인 해제 (AUTHORIZED → VOIDED)
+	VoidAuthorizedPaymentsByOrder(ctx context.Context, orderID uint64) (sql.Result, error)
 }
 
 var _ Querier = (*Queries)(nil)
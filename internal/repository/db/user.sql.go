@@ -78,7 +78,7 @@ func (q *Queries) ExistsUserByEmail(ctx context.Context, email string) (bool, er
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, email, external_id, name, phone, role, kyc_status, kyc_verified_at, status, created_at, updated_at FROM users
+SELECT id, email, external_id, name, phone, role, kyc_status, kyc_verified_at, status, created_at, updated_at, is_compliance_officer, phone_verified_at, phone_changed_at FROM users
 WHERE email = ? AND status != 'DELETED'
 `
 
@@ -98,12 +98,15 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IsComplianceOfficer,
+		&i.PhoneVerifiedAt,
+		&i.PhoneChangedAt,
 	)
 	return i, err
 }
 
 const getUserByExternalID = `-- name: GetUserByExternalID :one
-SELECT id, email, external_id, name, phone, role, kyc_status, kyc_verified_at, status, created_at, updated_at FROM users
+SELECT id, email, external_id, name, phone, role, kyc_status, kyc_verified_at, status, created_at, updated_at, is_compliance_officer, phone_verified_at, phone_changed_at FROM users
 WHERE external_id = ? AND status != 'DELETED'
 `
 
@@ -123,12 +126,15 @@ func (q *Queries) GetUserByExternalID(ctx context.Context, externalID sql.NullSt
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IsComplianceOfficer,
+		&i.PhoneVerifiedAt,
+		&i.PhoneChangedAt,
 	)
 	return i, err
 }
 
 const getUserByExternalIDIncludeDeleted = `-- name: GetUserByExternalIDIncludeDeleted :one
-SELECT id, email, external_id, name, phone, role, kyc_status, kyc_verified_at, status, created_at, updated_at FROM users
+SELECT id, email, external_id, name, phone, role, kyc_status, kyc_verified_at, status, created_at, updated_at, is_compliance_officer, phone_verified_at, phone_changed_at FROM users
 WHERE external_id = ?
 `
 
@@ -149,12 +155,15 @@ func (q *Queries) GetUserByExternalIDIncludeDeleted(ctx context.Context, externa
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IsComplianceOfficer,
+		&i.PhoneVerifiedAt,
+		&i.PhoneChangedAt,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, email, external_id, name, phone, role, kyc_status, kyc_verified_at, status, created_at, updated_at FROM users
+SELECT id, email, external_id, name, phone, role, kyc_status, kyc_verified_at, status, created_at, updated_at, is_compliance_officer, phone_verified_at, phone_changed_at FROM users
 WHERE id = ? AND status != 'DELETED'
 `
 
@@ -174,12 +183,15 @@ func (q *Queries) GetUserByID(ctx context.Context, id uint64) (User, error) {
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IsComplianceOfficer,
+		&i.PhoneVerifiedAt,
+		&i.PhoneChangedAt,
 	)
 	return i, err
 }
 
 const getUserForUpdate = `-- name: GetUserForUpdate :one
-SELECT id, email, external_id, name, phone, role, kyc_status, kyc_verified_at, status, created_at, updated_at FROM users
+SELECT id, email, external_id, name, phone, role, kyc_status, kyc_verified_at, status, created_at, updated_at, is_compliance_officer, phone_verified_at, phone_changed_at FROM users
 WHERE id = ? AND status != 'DELETED'
 FOR UPDATE
 `
@@ -200,13 +212,46 @@ func (q *Queries) GetUserForUpdate(ctx context.Context, id uint64) (User, error)
 		&i.Status,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.IsComplianceOfficer,
+		&i.PhoneVerifiedAt,
+		&i.PhoneChangedAt,
 	)
 	return i, err
 }
 
+const listActiveAdminUserIDs = `-- name: ListActiveAdminUserIDs :many
+SELECT id FROM users
+WHERE role = 'ADMIN' AND status = 'ACTIVE'
+ORDER BY id ASC
+`
+
+// 운영 알림 수신자 (활성 ADMIN 사용자)
+func (q *Queries) ListActiveAdminUserIDs(ctx context.Context) ([]uint64, error) {
+	rows, err := q.db.QueryContext(ctx, listActiveAdminUserIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uint64{}
+	for rows.Next() {
+		var id uint64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listUsers = `-- name: ListUsers :many
 
-SELECT id, email, external_id, name, phone, role, kyc_status, kyc_verified_at, status, created_at, updated_at FROM users
+SELECT id, email, external_id, name, phone, role, kyc_status, kyc_verified_at, status, created_at, updated_at, is_compliance_officer, phone_verified_at, phone_changed_at FROM users
 WHERE status != 'DELETED'
   AND (? IS NULL OR role = ?)
   AND (? IS NULL OR kyc_status = ?)
@@ -253,6 +298,9 @@ func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, e
 			&i.Status,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.IsComplianceOfficer,
+			&i.PhoneVerifiedAt,
+			&i.PhoneChangedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -267,6 +315,26 @@ func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, e
 	return items, nil
 }
 
+const markUserPhoneVerified = `-- name: MarkUserPhoneVerified :execrows
+UPDATE users
+SET phone_verified_at = NOW(), updated_at = NOW()
+WHERE id = ? AND phone = ? AND status != 'DELETED'
+`
+
+type MarkUserPhoneVerifiedParams struct {
+	ID    uint64         `json:"id"`
+	Phone sql.NullString `json:"phone"`
+}
+
+// OTP 검증 완료 (코드 발송 후 번호가 바뀌었으면 0 rows)
+func (q *Queries) MarkUserPhoneVerified(ctx context.Context, arg MarkUserPhoneVerifiedParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, markUserPhoneVerified, arg.ID, arg.Phone)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateUserKycToPending = `-- name: UpdateUserKycToPending :exec
 
 UPDATE users
@@ -311,7 +379,11 @@ func (q *Queries) UpdateUserKycToVerified(ctx context.Context, id uint64) error
 
 const updateUserProfile = `-- name: UpdateUserProfile :exec
 UPDATE users
-SET name = ?, phone = ?, updated_at = NOW()
+SET name = ?,
+    phone_changed_at = IF(phone <=> ? OR phone_verified_at IS NULL, phone_changed_at, NOW()),
+    phone_verified_at = IF(phone <=> ?, phone_verified_at, NULL),
+    phone = ?,
+    updated_at = NOW()
 WHERE id = ? AND status != 'DELETED'
 `
 
@@ -322,8 +394,15 @@ type UpdateUserProfileParams struct {
 }
 
 // 프로필 정보 업데이트 (이름, 전화번호)
+// NOTE: 전화번호가 바뀌면 인증 초기화 + 인증된 번호였으면 변경 시각 기록 (phone_changed_at → phone_verified_at → phone 순서로 평가)
 func (q *Queries) UpdateUserProfile(ctx context.Context, arg UpdateUserProfileParams) error {
-	_, err := q.db.ExecContext(ctx, updateUserProfile, arg.Name, arg.Phone, arg.ID)
+	_, err := q.db.ExecContext(ctx, updateUserProfile,
+		arg.Name,
+		arg.Phone,
+		arg.Phone,
+		arg.Phone,
+		arg.ID,
+	)
 	return err
 }
 
@@ -8,6 +8,7 @@ package db
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
 const clearPrimaryWallet = `-- name: ClearPrimaryWallet :exec
@@ -26,6 +27,38 @@ func (q *Queries) ClearPrimaryWallet(ctx context.Context, userID uint64) error {
 	return err
 }
 
+const countWalletTransfers = `-- name: CountWalletTransfers :one
+SELECT CAST(
+    (SELECT COUNT(*) FROM deposits d
+     WHERE d.user_id = ? AND d.from_address = ?)
+  + (SELECT COUNT(*) FROM withdrawals w
+     WHERE w.user_id = ? AND w.to_address = ? AND w.tx_hash IS NOT NULL)
+  + (SELECT COUNT(*) FROM wallet_micro_transfers m
+     WHERE m.wallet_id = ? AND m.tx_hash IS NOT NULL)
+  AS SIGNED) AS total
+`
+
+type CountWalletTransfersParams struct {
+	OutUserID  uint64 `json:"out_user_id"`
+	Address    string `json:"address"`
+	InUserID   uint64 `json:"in_user_id"`
+	InWalletID uint64 `json:"in_wallet_id"`
+}
+
+// 지갑 관련 토큰 전송 수 (페이지네이션)
+func (q *Queries) CountWalletTransfers(ctx context.Context, arg CountWalletTransfersParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countWalletTransfers,
+		arg.OutUserID,
+		arg.Address,
+		arg.InUserID,
+		arg.Address,
+		arg.InWalletID,
+	)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
 const countWalletsByUser = `-- name: CountWalletsByUser :one
 SELECT COUNT(*) as total FROM wallets
 WHERE user_id = ? AND deleted_at IS NULL
@@ -39,17 +72,56 @@ func (q *Queries) CountWalletsByUser(ctx context.Context, userID uint64) (int64,
 	return total, err
 }
 
+const countWalletsByUserExternalID = `-- name: CountWalletsByUserExternalID :one
+SELECT COUNT(*) FROM wallets w
+JOIN users u ON w.user_id = u.id
+WHERE u.external_id = ? AND w.deleted_at IS NULL
+  AND (? IS NULL OR w.chain_id = ?)
+  AND (? IS NULL OR w.is_verified = ?)
+  AND (? IS NULL OR w.is_primary = ?)
+`
+
+type CountWalletsByUserExternalIDParams struct {
+	UserExternalID sql.NullString `json:"user_external_id"`
+	ChainID        sql.NullInt64  `json:"chain_id"`
+	IsVerified     sql.NullBool   `json:"is_verified"`
+	IsPrimary      sql.NullBool   `json:"is_primary"`
+}
+
+// 지갑 목록 필터 기준 전체 수 (cursor 무관)
+func (q *Queries) CountWalletsByUserExternalID(ctx context.Context, arg CountWalletsByUserExternalIDParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countWalletsByUserExternalID,
+		arg.UserExternalID,
+		arg.ChainID,
+		arg.ChainID,
+		arg.IsVerified,
+		arg.IsVerified,
+		arg.IsPrimary,
+		arg.IsPrimary,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createWallet = `-- name: CreateWallet :execresult
 
-INSERT INTO wallets (external_id, user_id, address, label, is_primary, is_verified)
-VALUES (?, ?, ?, ?, false, false)
+INSERT INTO wallets (
+    external_id, user_id, address, chain_id, label, is_primary, is_verified,
+    ens_name, ens_status, ens_checked_at
+)
+VALUES (?, ?, ?, ?, ?, false, false, ?, ?, ?)
 `
 
 type CreateWalletParams struct {
-	ExternalID string         `json:"external_id"`
-	UserID     uint64         `json:"user_id"`
-	Address    string         `json:"address"`
-	Label      sql.NullString `json:"label"`
+	ExternalID   string               `json:"external_id"`
+	UserID       uint64               `json:"user_id"`
+	Address      string               `json:"address"`
+	ChainID      uint64               `json:"chain_id"`
+	Label        sql.NullString       `json:"label"`
+	EnsName      sql.NullString       `json:"ens_name"`
+	EnsStatus    NullWalletsEnsStatus `json:"ens_status"`
+	EnsCheckedAt sql.NullTime         `json:"ens_checked_at"`
 }
 
 // ============================================================================
@@ -62,12 +134,49 @@ type CreateWalletParams struct {
 // NOTE: Soft Delete 적용 - deleted_at IS NULL 조건 필수
 // 지갑 등록 (address는 서비스에서 lower-case 변환 후 전달)
 // is_verified=false, is_primary=false 기본값
+// ENS 이름으로 등록한 경우 ens_name/ens_status=RESOLVED/ens_checked_at 함께 저장 (아니면 NULL)
 func (q *Queries) CreateWallet(ctx context.Context, arg CreateWalletParams) (sql.Result, error) {
 	return q.db.ExecContext(ctx, createWallet,
 		arg.ExternalID,
 		arg.UserID,
 		arg.Address,
+		arg.ChainID,
 		arg.Label,
+		arg.EnsName,
+		arg.EnsStatus,
+		arg.EnsCheckedAt,
+	)
+}
+
+const createWatchOnlyWallet = `-- name: CreateWatchOnlyWallet :execresult
+INSERT INTO wallets (
+    external_id, user_id, address, chain_id, label, is_primary, is_verified,
+    verification_level, is_watch_only, attested_by, attested_at, receivable_after
+)
+VALUES (?, ?, ?, ?, ?, false, false, 'ATTESTED', true, ?, NOW(), ?)
+`
+
+type CreateWatchOnlyWalletParams struct {
+	ExternalID      string         `json:"external_id"`
+	UserID          uint64         `json:"user_id"`
+	Address         string         `json:"address"`
+	ChainID         uint64         `json:"chain_id"`
+	Label           sql.NullString `json:"label"`
+	AttestedBy      sql.NullInt64  `json:"attested_by"`
+	ReceivableAfter sql.NullTime   `json:"receivable_after"`
+}
+
+// 수취 전용 지갑 등록 (서명 검증 없이 계정 소유자 보증)
+// is_verified=false 유지 → Primary 설정/서명 기반 기능 불가
+func (q *Queries) CreateWatchOnlyWallet(ctx context.Context, arg CreateWatchOnlyWalletParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, createWatchOnlyWallet,
+		arg.ExternalID,
+		arg.UserID,
+		arg.Address,
+		arg.ChainID,
+		arg.Label,
+		arg.AttestedBy,
+		arg.ReceivableAfter,
 	)
 }
 
@@ -104,7 +213,7 @@ func (q *Queries) ExistsWalletByAddress(ctx context.Context, address string) (bo
 }
 
 const getPrimaryWallet = `-- name: GetPrimaryWallet :one
-SELECT id, user_id, address, label, is_primary, is_verified, created_at, updated_at, external_id, deleted_at, address_active FROM wallets
+SELECT id, user_id, address, label, is_primary, is_verified, created_at, updated_at, external_id, deleted_at, address_active, verification_level, is_watch_only, attested_by, attested_at, receivable_after, chain_id, ens_name, ens_status, ens_checked_at, primary_user_id FROM wallets
 WHERE user_id = ? AND is_primary = true AND deleted_at IS NULL
 LIMIT 1
 `
@@ -125,17 +234,32 @@ func (q *Queries) GetPrimaryWallet(ctx context.Context, userID uint64) (Wallet,
 		&i.ExternalID,
 		&i.DeletedAt,
 		&i.AddressActive,
+		&i.VerificationLevel,
+		&i.IsWatchOnly,
+		&i.AttestedBy,
+		&i.AttestedAt,
+		&i.ReceivableAfter,
+		&i.ChainID,
+		&i.EnsName,
+		&i.EnsStatus,
+		&i.EnsCheckedAt,
+		&i.PrimaryUserID,
 	)
 	return i, err
 }
 
 const getWalletByAddress = `-- name: GetWalletByAddress :one
-SELECT id, user_id, address, label, is_primary, is_verified, created_at, updated_at, external_id, deleted_at, address_active FROM wallets WHERE address = ? AND deleted_at IS NULL
+SELECT id, user_id, address, label, is_primary, is_verified, created_at, updated_at, external_id, deleted_at, address_active, verification_level, is_watch_only, attested_by, attested_at, receivable_after, chain_id, ens_name, ens_status, ens_checked_at, primary_user_id FROM wallets WHERE address = ? AND chain_id = ? AND deleted_at IS NULL
 `
 
-// 주소로 지갑 조회 (address는 lower-case로 전달, 삭제 제외)
-func (q *Queries) GetWalletByAddress(ctx context.Context, address string) (Wallet, error) {
-	row := q.db.QueryRowContext(ctx, getWalletByAddress, address)
+type GetWalletByAddressParams struct {
+	Address string `json:"address"`
+	ChainID uint64 `json:"chain_id"`
+}
+
+// 주소 + 체인으로 지갑 조회 (address는 lower-case로 전달, 삭제 제외)
+func (q *Queries) GetWalletByAddress(ctx context.Context, arg GetWalletByAddressParams) (Wallet, error) {
+	row := q.db.QueryRowContext(ctx, getWalletByAddress, arg.Address, arg.ChainID)
 	var i Wallet
 	err := row.Scan(
 		&i.ID,
@@ -149,12 +273,22 @@ func (q *Queries) GetWalletByAddress(ctx context.Context, address string) (Walle
 		&i.ExternalID,
 		&i.DeletedAt,
 		&i.AddressActive,
+		&i.VerificationLevel,
+		&i.IsWatchOnly,
+		&i.AttestedBy,
+		&i.AttestedAt,
+		&i.ReceivableAfter,
+		&i.ChainID,
+		&i.EnsName,
+		&i.EnsStatus,
+		&i.EnsCheckedAt,
+		&i.PrimaryUserID,
 	)
 	return i, err
 }
 
 const getWalletByExternalID = `-- name: GetWalletByExternalID :one
-SELECT id, user_id, address, label, is_primary, is_verified, created_at, updated_at, external_id, deleted_at, address_active FROM wallets WHERE external_id = ? AND deleted_at IS NULL
+SELECT id, user_id, address, label, is_primary, is_verified, created_at, updated_at, external_id, deleted_at, address_active, verification_level, is_watch_only, attested_by, attested_at, receivable_after, chain_id, ens_name, ens_status, ens_checked_at, primary_user_id FROM wallets WHERE external_id = ? AND deleted_at IS NULL
 `
 
 // 외부 식별자로 지갑 조회 (삭제된 지갑 제외)
@@ -173,12 +307,22 @@ func (q *Queries) GetWalletByExternalID(ctx context.Context, externalID string)
 		&i.ExternalID,
 		&i.DeletedAt,
 		&i.AddressActive,
+		&i.VerificationLevel,
+		&i.IsWatchOnly,
+		&i.AttestedBy,
+		&i.AttestedAt,
+		&i.ReceivableAfter,
+		&i.ChainID,
+		&i.EnsName,
+		&i.EnsStatus,
+		&i.EnsCheckedAt,
+		&i.PrimaryUserID,
 	)
 	return i, err
 }
 
 const getWalletByExternalIDAndUser = `-- name: GetWalletByExternalIDAndUser :one
-SELECT w.id, w.user_id, w.address, w.label, w.is_primary, w.is_verified, w.created_at, w.updated_at, w.external_id, w.deleted_at, w.address_active FROM wallets w
+SELECT w.id, w.user_id, w.address, w.label, w.is_primary, w.is_verified, w.created_at, w.updated_at, w.external_id, w.deleted_at, w.address_active, w.verification_level, w.is_watch_only, w.attested_by, w.attested_at, w.receivable_after, w.chain_id, w.ens_name, w.ens_status, w.ens_checked_at, w.primary_user_id FROM wallets w
 JOIN users u ON w.user_id = u.id
 WHERE w.external_id = ? AND u.external_id = ? AND w.deleted_at IS NULL
 `
@@ -204,12 +348,22 @@ func (q *Queries) GetWalletByExternalIDAndUser(ctx context.Context, arg GetWalle
 		&i.ExternalID,
 		&i.DeletedAt,
 		&i.AddressActive,
+		&i.VerificationLevel,
+		&i.IsWatchOnly,
+		&i.AttestedBy,
+		&i.AttestedAt,
+		&i.ReceivableAfter,
+		&i.ChainID,
+		&i.EnsName,
+		&i.EnsStatus,
+		&i.EnsCheckedAt,
+		&i.PrimaryUserID,
 	)
 	return i, err
 }
 
 const getWalletByExternalIDAndUserIncludeDeleted = `-- name: GetWalletByExternalIDAndUserIncludeDeleted :one
-SELECT w.id, w.user_id, w.address, w.label, w.is_primary, w.is_verified, w.created_at, w.updated_at, w.external_id, w.deleted_at, w.address_active FROM wallets w
+SELECT w.id, w.user_id, w.address, w.label, w.is_primary, w.is_verified, w.created_at, w.updated_at, w.external_id, w.deleted_at, w.address_active, w.verification_level, w.is_watch_only, w.attested_by, w.attested_at, w.receivable_after, w.chain_id, w.ens_name, w.ens_status, w.ens_checked_at, w.primary_user_id FROM wallets w
 JOIN users u ON w.user_id = u.id
 WHERE w.external_id = ? AND u.external_id = ?
 `
@@ -235,12 +389,22 @@ func (q *Queries) GetWalletByExternalIDAndUserIncludeDeleted(ctx context.Context
 		&i.ExternalID,
 		&i.DeletedAt,
 		&i.AddressActive,
+		&i.VerificationLevel,
+		&i.IsWatchOnly,
+		&i.AttestedBy,
+		&i.AttestedAt,
+		&i.ReceivableAfter,
+		&i.ChainID,
+		&i.EnsName,
+		&i.EnsStatus,
+		&i.EnsCheckedAt,
+		&i.PrimaryUserID,
 	)
 	return i, err
 }
 
 const getWalletByExternalIDIncludeDeleted = `-- name: GetWalletByExternalIDIncludeDeleted :one
-SELECT id, user_id, address, label, is_primary, is_verified, created_at, updated_at, external_id, deleted_at, address_active FROM wallets WHERE external_id = ?
+SELECT id, user_id, address, label, is_primary, is_verified, created_at, updated_at, external_id, deleted_at, address_active, verification_level, is_watch_only, attested_by, attested_at, receivable_after, chain_id, ens_name, ens_status, ens_checked_at, primary_user_id FROM wallets WHERE external_id = ?
 `
 
 // 외부 식별자로 지갑 조회 (삭제된 지갑 포함 - 멱등성 체크용)
@@ -259,12 +423,22 @@ func (q *Queries) GetWalletByExternalIDIncludeDeleted(ctx context.Context, exter
 		&i.ExternalID,
 		&i.DeletedAt,
 		&i.AddressActive,
+		&i.VerificationLevel,
+		&i.IsWatchOnly,
+		&i.AttestedBy,
+		&i.AttestedAt,
+		&i.ReceivableAfter,
+		&i.ChainID,
+		&i.EnsName,
+		&i.EnsStatus,
+		&i.EnsCheckedAt,
+		&i.PrimaryUserID,
 	)
 	return i, err
 }
 
 const getWalletByID = `-- name: GetWalletByID :one
-SELECT id, user_id, address, label, is_primary, is_verified, created_at, updated_at, external_id, deleted_at, address_active FROM wallets WHERE id = ? AND deleted_at IS NULL
+SELECT id, user_id, address, label, is_primary, is_verified, created_at, updated_at, external_id, deleted_at, address_active, verification_level, is_watch_only, attested_by, attested_at, receivable_after, chain_id, ens_name, ens_status, ens_checked_at, primary_user_id FROM wallets WHERE id = ? AND deleted_at IS NULL
 `
 
 // ID로 지갑 조회 (내부 전용 - 삭제된 지갑 제외)
@@ -283,12 +457,22 @@ func (q *Queries) GetWalletByID(ctx context.Context, id uint64) (Wallet, error)
 		&i.ExternalID,
 		&i.DeletedAt,
 		&i.AddressActive,
+		&i.VerificationLevel,
+		&i.IsWatchOnly,
+		&i.AttestedBy,
+		&i.AttestedAt,
+		&i.ReceivableAfter,
+		&i.ChainID,
+		&i.EnsName,
+		&i.EnsStatus,
+		&i.EnsCheckedAt,
+		&i.PrimaryUserID,
 	)
 	return i, err
 }
 
 const getWalletByIDAndUser = `-- name: GetWalletByIDAndUser :one
-SELECT id, user_id, address, label, is_primary, is_verified, created_at, updated_at, external_id, deleted_at, address_active FROM wallets
+SELECT id, user_id, address, label, is_primary, is_verified, created_at, updated_at, external_id, deleted_at, address_active, verification_level, is_watch_only, attested_by, attested_at, receivable_after, chain_id, ens_name, ens_status, ens_checked_at, primary_user_id FROM wallets
 WHERE id = ? AND user_id = ? AND deleted_at IS NULL
 `
 
@@ -313,12 +497,22 @@ func (q *Queries) GetWalletByIDAndUser(ctx context.Context, arg GetWalletByIDAnd
 		&i.ExternalID,
 		&i.DeletedAt,
 		&i.AddressActive,
+		&i.VerificationLevel,
+		&i.IsWatchOnly,
+		&i.AttestedBy,
+		&i.AttestedAt,
+		&i.ReceivableAfter,
+		&i.ChainID,
+		&i.EnsName,
+		&i.EnsStatus,
+		&i.EnsCheckedAt,
+		&i.PrimaryUserID,
 	)
 	return i, err
 }
 
 const getWalletForUpdate = `-- name: GetWalletForUpdate :one
-SELECT id, user_id, address, label, is_primary, is_verified, created_at, updated_at, external_id, deleted_at, address_active FROM wallets
+SELECT id, user_id, address, label, is_primary, is_verified, created_at, updated_at, external_id, deleted_at, address_active, verification_level, is_watch_only, attested_by, attested_at, receivable_after, chain_id, ens_name, ens_status, ens_checked_at, primary_user_id FROM wallets
 WHERE id = ? AND user_id = ? AND deleted_at IS NULL
 FOR UPDATE
 `
@@ -344,12 +538,188 @@ func (q *Queries) GetWalletForUpdate(ctx context.Context, arg GetWalletForUpdate
 		&i.ExternalID,
 		&i.DeletedAt,
 		&i.AddressActive,
+		&i.VerificationLevel,
+		&i.IsWatchOnly,
+		&i.AttestedBy,
+		&i.AttestedAt,
+		&i.ReceivableAfter,
+		&i.ChainID,
+		&i.EnsName,
+		&i.EnsStatus,
+		&i.EnsCheckedAt,
+		&i.PrimaryUserID,
 	)
 	return i, err
 }
 
+const listPrimaryWalletViolations = `-- name: ListPrimaryWalletViolations :many
+
+SELECT u.id AS user_id, u.external_id AS user_external_id,
+       pw.id AS primary_wallet_id, pw.external_id AS primary_wallet_external_id, pw.is_verified AS primary_verified,
+       a.id AS account_id, aw.external_id AS account_wallet_external_id,
+       CAST((SELECT COUNT(*) FROM wallets v
+             WHERE v.user_id = u.id AND v.is_verified = true AND v.deleted_at IS NULL) AS SIGNED) AS verified_wallets
+FROM users u
+LEFT JOIN wallets pw ON pw.primary_user_id = u.id
+LEFT JOIN accounts a ON a.owner_id = u.id AND a.account_type = 'USER' AND a.status != 'CLOSED'
+LEFT JOIN wallets aw ON aw.id = a.primary_wallet_id
+WHERE u.id > ?
+  AND (
+      (pw.id IS NULL AND EXISTS (
+          SELECT 1 FROM wallets v WHERE v.user_id = u.id AND v.is_verified = true AND v.deleted_at IS NULL))
+      OR pw.is_verified = false
+      OR (a.id IS NOT NULL AND NOT (a.primary_wallet_id <=> pw.id))
+  )
+ORDER BY u.id ASC
+LIMIT ?
+`
+
+type ListPrimaryWalletViolationsParams struct {
+	AfterUserID uint64 `json:"after_user_id"`
+	Limit       int32  `json:"limit"`
+}
+
+type ListPrimaryWalletViolationsRow struct {
+	UserID                  uint64         `json:"user_id"`
+	UserExternalID          sql.NullString `json:"user_external_id"`
+	PrimaryWalletID         sql.NullInt64  `json:"primary_wallet_id"`
+	PrimaryWalletExternalID sql.NullString `json:"primary_wallet_external_id"`
+	PrimaryVerified         sql.NullBool   `json:"primary_verified"`
+	AccountID               sql.NullInt64  `json:"account_id"`
+	AccountWalletExternalID sql.NullString `json:"account_wallet_external_id"`
+	VerifiedWallets         int64          `json:"verified_wallets"`
+}
+
+// ============================================================================
+// Primary 지갑 정합성 (wallet.PrimaryRepairer)
+// ============================================================================
+// NOTE: Primary 중복은 uk_wallet_primary_user 제약으로 불가능
+//
+//	남는 위반: 검증 지갑이 있는데 Primary 없음 / 미검증 Primary / 계정 primary_wallet_id 불일치
+//
+// Primary 지갑 불변식 위반 사용자 (user id 오름차순 cursor 페이지)
+// after_user_id: 이전 페이지 마지막 사용자 (0 = 첫 페이지)
+func (q *Queries) ListPrimaryWalletViolations(ctx context.Context, arg ListPrimaryWalletViolationsParams) ([]ListPrimaryWalletViolationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPrimaryWalletViolations, arg.AfterUserID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPrimaryWalletViolationsRow{}
+	for rows.Next() {
+		var i ListPrimaryWalletViolationsRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.UserExternalID,
+			&i.PrimaryWalletID,
+			&i.PrimaryWalletExternalID,
+			&i.PrimaryVerified,
+			&i.AccountID,
+			&i.AccountWalletExternalID,
+			&i.VerifiedWallets,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWalletTransfers = `-- name: ListWalletTransfers :many
+
+SELECT 'DEPOSIT' AS kind, 'OUT' AS direction, d.id, d.tx_hash, d.amount, d.block_number, d.status, d.created_at
+FROM deposits d
+WHERE d.user_id = ? AND d.from_address = ?
+UNION ALL
+SELECT 'WITHDRAWAL', 'IN', w.id, w.tx_hash, w.amount, NULL, w.status, w.created_at
+FROM withdrawals w
+WHERE w.user_id = ? AND w.to_address = ? AND w.tx_hash IS NOT NULL
+UNION ALL
+SELECT 'MICRO_TRANSFER', 'IN', m.id, m.tx_hash, m.amount, NULL, m.status, m.created_at
+FROM wallet_micro_transfers m
+WHERE m.wallet_id = ? AND m.tx_hash IS NOT NULL
+ORDER BY created_at DESC, kind ASC, id DESC
+LIMIT ? OFFSET ?
+`
+
+type ListWalletTransfersParams struct {
+	OutUserID  uint64 `json:"out_user_id"`
+	Address    string `json:"address"`
+	InUserID   uint64 `json:"in_user_id"`
+	InWalletID uint64 `json:"in_wallet_id"`
+	Limit      int32  `json:"limit"`
+	Offset     int32  `json:"offset"`
+}
+
+type ListWalletTransfersRow struct {
+	Kind        string         `json:"kind"`
+	Direction   string         `json:"direction"`
+	ID          uint64         `json:"id"`
+	TxHash      string         `json:"tx_hash"`
+	Amount      string         `json:"amount"`
+	BlockNumber sql.NullInt64  `json:"block_number"`
+	Status      DepositsStatus `json:"status"`
+	CreatedAt   time.Time      `json:"created_at"`
+}
+
+// ============================================================================
+// Wallet Activity Queries
+// ============================================================================
+// NOTE: 인덱서가 기록한 토큰 전송 (플랫폼 체인 기준, 지갑 관점 방향)
+//
+//	OUT - deposits: 지갑 → 플랫폼 입금
+//	IN  - withdrawals: 플랫폼 → 지갑 출금, wallet_micro_transfers: 소액 송금 검증
+//
+// 방향 필터: 제외할 쪽의 out_user_id / in_user_id / in_wallet_id 에 0 전달 (ID는 1부터)
+// 지갑 관련 토큰 전송 (최신순)
+func (q *Queries) ListWalletTransfers(ctx context.Context, arg ListWalletTransfersParams) ([]ListWalletTransfersRow, error) {
+	rows, err := q.db.QueryContext(ctx, listWalletTransfers,
+		arg.OutUserID,
+		arg.Address,
+		arg.InUserID,
+		arg.Address,
+		arg.InWalletID,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListWalletTransfersRow{}
+	for rows.Next() {
+		var i ListWalletTransfersRow
+		if err := rows.Scan(
+			&i.Kind,
+			&i.Direction,
+			&i.ID,
+			&i.TxHash,
+			&i.Amount,
+			&i.BlockNumber,
+			&i.Status,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listWalletsByUser = `-- name: ListWalletsByUser :many
-SELECT id, user_id, address, label, is_primary, is_verified, created_at, updated_at, external_id, deleted_at, address_active FROM wallets
+SELECT id, user_id, address, label, is_primary, is_verified, created_at, updated_at, external_id, deleted_at, address_active, verification_level, is_watch_only, attested_by, attested_at, receivable_after, chain_id, ens_name, ens_status, ens_checked_at, primary_user_id FROM wallets
 WHERE user_id = ? AND deleted_at IS NULL
 ORDER BY is_primary DESC, created_at ASC
 `
@@ -376,6 +746,16 @@ func (q *Queries) ListWalletsByUser(ctx context.Context, userID uint64) ([]Walle
 			&i.ExternalID,
 			&i.DeletedAt,
 			&i.AddressActive,
+			&i.VerificationLevel,
+			&i.IsWatchOnly,
+			&i.AttestedBy,
+			&i.AttestedAt,
+			&i.ReceivableAfter,
+			&i.ChainID,
+			&i.EnsName,
+			&i.EnsStatus,
+			&i.EnsCheckedAt,
+			&i.PrimaryUserID,
 		); err != nil {
 			return nil, err
 		}
@@ -391,15 +771,165 @@ func (q *Queries) ListWalletsByUser(ctx context.Context, userID uint64) ([]Walle
 }
 
 const listWalletsByUserExternalID = `-- name: ListWalletsByUserExternalID :many
-SELECT w.id, w.user_id, w.address, w.label, w.is_primary, w.is_verified, w.created_at, w.updated_at, w.external_id, w.deleted_at, w.address_active FROM wallets w
+SELECT w.id, w.user_id, w.address, w.label, w.is_primary, w.is_verified, w.created_at, w.updated_at, w.external_id, w.deleted_at, w.address_active, w.verification_level, w.is_watch_only, w.attested_by, w.attested_at, w.receivable_after, w.chain_id, w.ens_name, w.ens_status, w.ens_checked_at, w.primary_user_id FROM wallets w
 JOIN users u ON w.user_id = u.id
 WHERE u.external_id = ? AND w.deleted_at IS NULL
+  AND (? IS NULL OR w.chain_id = ?)
 ORDER BY w.is_primary DESC, w.created_at ASC
 `
 
-// 사용자 external_id로 지갑 목록 조회 (외부 API용, 삭제 제외)
-func (q *Queries) ListWalletsByUserExternalID(ctx context.Context, externalID sql.NullString) ([]Wallet, error) {
-	rows, err := q.db.QueryContext(ctx, listWalletsByUserExternalID, externalID)
+type ListWalletsByUserExternalIDParams struct {
+	UserExternalID sql.NullString `json:"user_external_id"`
+	ChainID        sql.NullInt64  `json:"chain_id"`
+}
+
+// 사용자 external_id로 지갑 목록 조회 (외부 API용, 삭제 제외, 체인 필터 옵션)
+func (q *Queries) ListWalletsByUserExternalID(ctx context.Context, arg ListWalletsByUserExternalIDParams) ([]Wallet, error) {
+	rows, err := q.db.QueryContext(ctx, listWalletsByUserExternalID, arg.UserExternalID, arg.ChainID, arg.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Wallet{}
+	for rows.Next() {
+		var i Wallet
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Address,
+			&i.Label,
+			&i.IsPrimary,
+			&i.IsVerified,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ExternalID,
+			&i.DeletedAt,
+			&i.AddressActive,
+			&i.VerificationLevel,
+			&i.IsWatchOnly,
+			&i.AttestedBy,
+			&i.AttestedAt,
+			&i.ReceivableAfter,
+			&i.ChainID,
+			&i.EnsName,
+			&i.EnsStatus,
+			&i.EnsCheckedAt,
+			&i.PrimaryUserID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWalletsDueForENSCheck = `-- name: ListWalletsDueForENSCheck :many
+SELECT id, user_id, address, label, is_primary, is_verified, created_at, updated_at, external_id, deleted_at, address_active, verification_level, is_watch_only, attested_by, attested_at, receivable_after, chain_id, ens_name, ens_status, ens_checked_at, primary_user_id FROM wallets
+WHERE ens_name IS NOT NULL AND deleted_at IS NULL
+  AND ens_checked_at < ?
+ORDER BY ens_checked_at ASC
+LIMIT ?
+`
+
+type ListWalletsDueForENSCheckParams struct {
+	CheckedBefore sql.NullTime `json:"checked_before"`
+	Limit         int32        `json:"limit"`
+}
+
+// ENS 재검증 대상 (마지막 해석이 checked_before 이전, 오래된 순, 삭제 제외)
+func (q *Queries) ListWalletsDueForENSCheck(ctx context.Context, arg ListWalletsDueForENSCheckParams) ([]Wallet, error) {
+	rows, err := q.db.QueryContext(ctx, listWalletsDueForENSCheck, arg.CheckedBefore, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Wallet{}
+	for rows.Next() {
+		var i Wallet
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Address,
+			&i.Label,
+			&i.IsPrimary,
+			&i.IsVerified,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ExternalID,
+			&i.DeletedAt,
+			&i.AddressActive,
+			&i.VerificationLevel,
+			&i.IsWatchOnly,
+			&i.AttestedBy,
+			&i.AttestedAt,
+			&i.ReceivableAfter,
+			&i.ChainID,
+			&i.EnsName,
+			&i.EnsStatus,
+			&i.EnsCheckedAt,
+			&i.PrimaryUserID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listWalletsPageByUserExternalID = `-- name: ListWalletsPageByUserExternalID :many
+SELECT w.id, w.user_id, w.address, w.label, w.is_primary, w.is_verified, w.created_at, w.updated_at, w.external_id, w.deleted_at, w.address_active, w.verification_level, w.is_watch_only, w.attested_by, w.attested_at, w.receivable_after, w.chain_id, w.ens_name, w.ens_status, w.ens_checked_at, w.primary_user_id FROM wallets w
+JOIN users u ON w.user_id = u.id
+WHERE u.external_id = ? AND w.deleted_at IS NULL
+  AND (? IS NULL OR w.chain_id = ?)
+  AND (? IS NULL OR w.is_verified = ?)
+  AND (? IS NULL OR w.is_primary = ?)
+  AND (? IS NULL
+       OR w.created_at > ?
+       OR (w.created_at = ? AND w.id > ?))
+ORDER BY w.created_at ASC, w.id ASC
+LIMIT ?
+`
+
+type ListWalletsPageByUserExternalIDParams struct {
+	UserExternalID sql.NullString `json:"user_external_id"`
+	ChainID        sql.NullInt64  `json:"chain_id"`
+	IsVerified     sql.NullBool   `json:"is_verified"`
+	IsPrimary      sql.NullBool   `json:"is_primary"`
+	AfterCreatedAt sql.NullTime   `json:"after_created_at"`
+	AfterID        uint64         `json:"after_id"`
+	Limit          int32          `json:"limit"`
+}
+
+// 지갑 목록 cursor 페이지 (created_at, id 오름차순, 삭제 제외)
+// after_created_at/after_id: 이전 페이지 마지막 지갑 (NULL = 첫 페이지)
+// NOTE: 다음 페이지 여부 확인을 위해 서비스에서 limit + 1 조회
+func (q *Queries) ListWalletsPageByUserExternalID(ctx context.Context, arg ListWalletsPageByUserExternalIDParams) ([]Wallet, error) {
+	rows, err := q.db.QueryContext(ctx, listWalletsPageByUserExternalID,
+		arg.UserExternalID,
+		arg.ChainID,
+		arg.ChainID,
+		arg.IsVerified,
+		arg.IsVerified,
+		arg.IsPrimary,
+		arg.IsPrimary,
+		arg.AfterCreatedAt,
+		arg.AfterCreatedAt,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -419,6 +949,16 @@ func (q *Queries) ListWalletsByUserExternalID(ctx context.Context, externalID sq
 			&i.ExternalID,
 			&i.DeletedAt,
 			&i.AddressActive,
+			&i.VerificationLevel,
+			&i.IsWatchOnly,
+			&i.AttestedBy,
+			&i.AttestedAt,
+			&i.ReceivableAfter,
+			&i.ChainID,
+			&i.EnsName,
+			&i.EnsStatus,
+			&i.EnsCheckedAt,
+			&i.PrimaryUserID,
 		); err != nil {
 			return nil, err
 		}
@@ -433,6 +973,23 @@ func (q *Queries) ListWalletsByUserExternalID(ctx context.Context, externalID sq
 	return items, nil
 }
 
+const restoreWallet = `-- name: RestoreWallet :execresult
+UPDATE wallets
+SET deleted_at = NULL, updated_at = NOW()
+WHERE id = ? AND user_id = ? AND deleted_at IS NOT NULL
+`
+
+type RestoreWalletParams struct {
+	ID     uint64 `json:"id"`
+	UserID uint64 `json:"user_id"`
+}
+
+// Soft Delete 복구 - deleted_at 해제 (Primary는 복구하지 않음)
+// 같은 (주소, 체인)의 활성 지갑이 있으면 uk_wallet_address_chain_active 위반
+func (q *Queries) RestoreWallet(ctx context.Context, arg RestoreWalletParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, restoreWallet, arg.ID, arg.UserID)
+}
+
 const setWalletPrimary = `-- name: SetWalletPrimary :execresult
 UPDATE wallets
 SET is_primary = true, updated_at = NOW()
@@ -471,6 +1028,34 @@ func (q *Queries) SoftDeleteWallet(ctx context.Context, arg SoftDeleteWalletPara
 	return q.db.ExecContext(ctx, softDeleteWallet, arg.ID, arg.UserID)
 }
 
+const updateWalletENSStatus = `-- name: UpdateWalletENSStatus :execrows
+UPDATE wallets
+SET ens_status = ?, ens_checked_at = ?
+WHERE id = ? AND ens_checked_at = ? AND deleted_at IS NULL
+`
+
+type UpdateWalletENSStatusParams struct {
+	EnsStatus         NullWalletsEnsStatus `json:"ens_status"`
+	CheckedAt         sql.NullTime         `json:"checked_at"`
+	ID                uint64               `json:"id"`
+	PreviousCheckedAt sql.NullTime         `json:"previous_checked_at"`
+}
+
+// ENS 재검증 결과 기록
+// previous_checked_at 조건: 다른 인스턴스가 먼저 기록했으면 0 rows (이벤트 중복 방지)
+func (q *Queries) UpdateWalletENSStatus(ctx context.Context, arg UpdateWalletENSStatusParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateWalletENSStatus,
+		arg.EnsStatus,
+		arg.CheckedAt,
+		arg.ID,
+		arg.PreviousCheckedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateWalletLabel = `-- name: UpdateWalletLabel :execresult
 UPDATE wallets
 SET label = ?, updated_at = NOW()
@@ -488,10 +1073,26 @@ func (q *Queries) UpdateWalletLabel(ctx context.Context, arg UpdateWalletLabelPa
 	return q.db.ExecContext(ctx, updateWalletLabel, arg.Label, arg.ID, arg.UserID)
 }
 
+const updateWalletVerificationLevelMicroTransfer = `-- name: UpdateWalletVerificationLevelMicroTransfer :execresult
+UPDATE wallets
+SET verification_level = 'MICRO_TRANSFER', updated_at = NOW()
+WHERE id = ? AND user_id = ? AND is_verified = true AND deleted_at IS NULL
+`
+
+type UpdateWalletVerificationLevelMicroTransferParams struct {
+	ID     uint64 `json:"id"`
+	UserID uint64 `json:"user_id"`
+}
+
+// 소액 송금 검증 완료 (SIGNATURE → MICRO_TRANSFER, 서명 검증 지갑만)
+func (q *Queries) UpdateWalletVerificationLevelMicroTransfer(ctx context.Context, arg UpdateWalletVerificationLevelMicroTransferParams) (sql.Result, error) {
+	return q.db.ExecContext(ctx, updateWalletVerificationLevelMicroTransfer, arg.ID, arg.UserID)
+}
+
 const updateWalletVerified = `-- name: UpdateWalletVerified :execresult
 
 UPDATE wallets
-SET is_verified = true, updated_at = NOW()
+SET is_verified = true, verification_level = 'SIGNATURE', is_watch_only = false, updated_at = NOW()
 WHERE id = ? AND user_id = ? AND is_verified = false AND deleted_at IS NULL
 `
 
@@ -504,6 +1105,7 @@ type UpdateWalletVerifiedParams struct {
 // 지갑 상태 업데이트 (:execresult로 RowsAffected 검증 가능)
 // ============================================================================
 // EIP-712 서명 검증 완료 (삭제되지 않은 지갑만)
+// watch-only 지갑도 서명 검증 시 일반 지갑으로 승격
 func (q *Queries) UpdateWalletVerified(ctx context.Context, arg UpdateWalletVerifiedParams) (sql.Result, error) {
 	return q.db.ExecContext(ctx, updateWalletVerified, arg.ID, arg.UserID)
 }
@@ -0,0 +1,95 @@
+package retention
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// ListRetentionRunsRequest represents query parameters for listing retention runs
+type ListRetentionRunsRequest struct {
+	Page     int `form:"page,default=1" binding:"min=1"`
+	PageSize int `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// ClassPurgeResult represents the purge outcome of a single data class
+// NOTE: dry_run=true이면 deleted는 삭제 대상 행 수 (실제 삭제 및 실행 기록 없음)
+type ClassPurgeResult struct {
+	RunID            string    `json:"run_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	DataClass        string    `json:"data_class" example:"audit_logs"`
+	RetentionSeconds int64     `json:"retention_seconds" example:"157680000"`
+	Cutoff           time.Time `json:"cutoff"`
+	Deleted          int64     `json:"deleted" example:"1200"`
+	Status           string    `json:"status" example:"SUCCEEDED"`
+	Error            string    `json:"error,omitempty"`
+}
+
+// PurgeReport represents the result of a purge over all enabled data classes
+type PurgeReport struct {
+	DryRun  bool               `json:"dry_run" example:"false"`
+	Results []ClassPurgeResult `json:"results"`
+}
+
+// RetentionRunResponse represents a recorded retention run
+type RetentionRunResponse struct {
+	ID               string    `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	DataClass        string    `json:"data_class" example:"webhook_deliveries"`
+	RetentionSeconds uint64    `json:"retention_seconds" example:"7776000"`
+	Cutoff           time.Time `json:"cutoff"`
+	DeletedCount     uint64    `json:"deleted_count" example:"1200"`
+	Status           string    `json:"status" example:"SUCCEEDED"`
+	Error            string    `json:"error,omitempty"`
+	TriggerType      string    `json:"trigger_type" example:"SCHEDULE"`
+	StartedAt        time.Time `json:"started_at"`
+	FinishedAt       time.Time `json:"finished_at"`
+}
+
+// ListRetentionRunsResponse represents paginated retention run list
+type ListRetentionRunsResponse struct {
+	Runs       []RetentionRunResponse `json:"runs"`
+	Total      int64                  `json:"total"`
+	Page       int                    `json:"page"`
+	PageSize   int                    `json:"page_size"`
+	TotalPages int                    `json:"total_pages"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToRetentionRunResponse converts db.RetentionRun to RetentionRunResponse
+func ToRetentionRunResponse(run *db.RetentionRun) *RetentionRunResponse {
+	if run == nil {
+		return nil
+	}
+
+	return &RetentionRunResponse{
+		ID:               run.ExternalID,
+		DataClass:        run.DataClass,
+		RetentionSeconds: run.RetentionSeconds,
+		Cutoff:           run.Cutoff,
+		DeletedCount:     run.DeletedCount,
+		Status:           string(run.Status),
+		Error:            run.ErrorMessage.String,
+		TriggerType:      string(run.TriggerType),
+		StartedAt:        run.StartedAt,
+		FinishedAt:       run.FinishedAt,
+	}
+}
+
+// ToRetentionRunResponseList converts []db.RetentionRun to []RetentionRunResponse
+func ToRetentionRunResponseList(runs []db.RetentionRun) []RetentionRunResponse {
+	responses := make([]RetentionRunResponse, 0, len(runs))
+	for _, run := range runs {
+		responses = append(responses, *ToRetentionRunResponse(&run))
+	}
+	return responses
+}
@@ -0,0 +1,86 @@
+package retention
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles HTTP requests for data retention operations
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new retention handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers retention routes on the router group (admin only)
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	retention := rg.Group("/admin/retention", middleware.RequireRoles(middleware.RoleAdmin))
+	{
+		retention.POST("/purge", middleware.DryRun(), h.Purge)
+		retention.GET("/runs", h.ListRuns)
+	}
+}
+
+// Purge godoc
+// @Summary Run retention purge
+// @Description Delete rows older than the configured retention period of each data class - Admin only.
+// @Description Each data class run is recorded and listed by GET /admin/retention/runs.
+// @Description With dry_run=true the rows that would be deleted are counted and nothing is recorded.
+// @Tags retention
+// @Produce json
+// @Param dry_run query bool false "Count expired rows without deleting"
+// @Success 200 {object} middleware.SuccessResponse{data=PurgeReport} "Purge report"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid dry_run value"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/retention/purge [post]
+func (h *Handler) Purge(c *gin.Context) {
+	principal, _ := middleware.GetPrincipal(c)
+
+	report, err := h.service.Purge(c.Request.Context(), principal.UserID, middleware.IsDryRun(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, report)
+}
+
+// ListRuns godoc
+// @Summary List retention runs
+// @Description Get paginated history of retention purge runs (newest first) - Admin only
+// @Description Send Accept: text/csv to receive the same results (same filters) as CSV.
+// @Tags retention
+// @Produce json,text/csv
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} middleware.SuccessResponse{data=ListRetentionRunsResponse} "Retention run list"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid query parameters"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/retention/runs [get]
+func (h *Handler) ListRuns(c *gin.Context) {
+	var req ListRetentionRunsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListRuns(c.Request.Context(), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOKNegotiated(c, "retention-runs", result, result.Runs)
+}
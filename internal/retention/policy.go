@@ -0,0 +1,81 @@
+package retention
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// Data classes governed by retention rules
+const (
+	ClassAuditLogs         = "audit_logs"
+	ClassWebhookDeliveries = "webhook_deliveries"
+	ClassOutboxEvents      = "outbox_events"
+)
+
+// Policy holds the retention period per data class (0 = keep forever)
+type Policy struct {
+	AuditLogs         time.Duration
+	WebhookDeliveries time.Duration
+	OutboxEvents      time.Duration
+}
+
+// Rule is the retention period of a single data class
+type Rule struct {
+	Class     string
+	Retention time.Duration
+}
+
+// Rules returns the enabled rules in a stable order
+func (p Policy) Rules() []Rule {
+	all := []Rule{
+		{Class: ClassAuditLogs, Retention: p.AuditLogs},
+		{Class: ClassWebhookDeliveries, Retention: p.WebhookDeliveries},
+		{Class: ClassOutboxEvents, Retention: p.OutboxEvents},
+	}
+
+	rules := make([]Rule, 0, len(all))
+	for _, rule := range all {
+		if rule.Retention > 0 {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// purger deletes/counts expired rows of one data class
+type purger struct {
+	purge func(ctx context.Context, q *db.Queries, cutoff time.Time, limit int32) (sql.Result, error)
+	count func(ctx context.Context, q *db.Queries, cutoff time.Time) (int64, error)
+}
+
+// purgers maps each data class to its queries
+// NOTE: 진행 중인 행(미전송 웹훅, 미발행 outbox)과 활성 legal hold 대상은 쿼리 레벨에서 제외
+var purgers = map[string]purger{
+	ClassAuditLogs: {
+		purge: func(ctx context.Context, q *db.Queries, cutoff time.Time, limit int32) (sql.Result, error) {
+			return q.PurgeAuditLogsBefore(ctx, db.PurgeAuditLogsBeforeParams{CreatedAt: cutoff, Limit: limit})
+		},
+		count: func(ctx context.Context, q *db.Queries, cutoff time.Time) (int64, error) {
+			return q.CountAuditLogsBefore(ctx, cutoff)
+		},
+	},
+	ClassWebhookDeliveries: {
+		purge: func(ctx context.Context, q *db.Queries, cutoff time.Time, limit int32) (sql.Result, error) {
+			return q.PurgeWebhookDeliveriesBefore(ctx, db.PurgeWebhookDeliveriesBeforeParams{CreatedAt: cutoff, Limit: limit})
+		},
+		count: func(ctx context.Context, q *db.Queries, cutoff time.Time) (int64, error) {
+			return q.CountWebhookDeliveriesBefore(ctx, cutoff)
+		},
+	},
+	ClassOutboxEvents: {
+		purge: func(ctx context.Context, q *db.Queries, cutoff time.Time, limit int32) (sql.Result, error) {
+			return q.PurgeOutboxEventsBefore(ctx, db.PurgeOutboxEventsBeforeParams{CreatedAt: cutoff, Limit: limit})
+		},
+		count: func(ctx context.Context, q *db.Queries, cutoff time.Time) (int64, error) {
+			return q.CountOutboxEventsBefore(ctx, cutoff)
+		},
+	},
+}
@@ -0,0 +1,229 @@
+package retention
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxErrorLen matches retention_runs.error_message column size
+	maxErrorLen = 512
+)
+
+// Config holds retention engine settings
+type Config struct {
+	Policy Policy
+	// Interval is the period of the scheduled purge job
+	Interval time.Duration
+	// BatchSize is the number of rows deleted per statement
+	BatchSize int
+}
+
+// Service enforces data retention rules and records every purge run
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	config   Config
+	logger   *zap.Logger
+}
+
+// NewService creates a new retention service
+func NewService(txRunner *pkgdb.TxRunner, config Config, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Run executes the purge job periodically until ctx is canceled
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.Interval)
+	defer ticker.Stop()
+
+	logctx.From(ctx, s.logger).Info("retention purge job started",
+		zap.Duration("interval", s.config.Interval),
+		zap.Int("rules", len(s.config.Policy.Rules())),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logctx.From(ctx, s.logger).Info("retention purge job stopped")
+			return
+		case <-ticker.C:
+			if _, err := s.Purge(ctx, 0, false); err != nil {
+				logctx.From(ctx, s.logger).Error("retention purge failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// Purge deletes expired rows of every enabled data class.
+// triggeredBy is the admin user ID for manual runs (0 = scheduled job).
+// With dryRun the rows that would be deleted are counted and nothing is recorded.
+//
+// Why:
+// - 클래스별 실행 결과를 retention_runs에 남김 → 보존 정책 집행을 증빙
+// - 한 클래스 실패가 다른 클래스 삭제를 막지 않음 (실패도 기록)
+func (s *Service) Purge(ctx context.Context, triggeredBy uint64, dryRun bool) (*PurgeReport, error) {
+	report := &PurgeReport{
+		DryRun:  dryRun,
+		Results: make([]ClassPurgeResult, 0),
+	}
+
+	now := time.Now().UTC()
+	for _, rule := range s.config.Policy.Rules() {
+		result := ClassPurgeResult{
+			DataClass:        rule.Class,
+			RetentionSeconds: int64(rule.Retention / time.Second),
+			Cutoff:           now.Add(-rule.Retention),
+			Status:           string(db.RetentionRunsStatusSUCCEEDED),
+		}
+
+		if dryRun {
+			count, err := purgers[rule.Class].count(ctx, s.txRunner.Queries(), result.Cutoff)
+			if err != nil {
+				logctx.From(ctx, s.logger).Error("failed to count expired rows", zap.String("data_class", rule.Class), zap.Error(err))
+				return nil, errors.DBError(err)
+			}
+			result.Deleted = count
+			report.Results = append(report.Results, result)
+			continue
+		}
+
+		startedAt := time.Now()
+		deleted, purgeErr := s.purgeClass(ctx, rule.Class, result.Cutoff)
+		result.Deleted = deleted
+		if purgeErr != nil {
+			result.Status = string(db.RetentionRunsStatusFAILED)
+			result.Error = truncate(purgeErr.Error(), maxErrorLen)
+		}
+
+		runID, err := s.recordRun(ctx, rule, &result, triggeredBy, startedAt)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record retention run", zap.String("data_class", rule.Class), zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		result.RunID = runID
+
+		if purgeErr != nil {
+			logctx.From(ctx, s.logger).Error("retention purge failed for data class",
+				zap.String("data_class", rule.Class),
+				zap.Int64("deleted", deleted),
+				zap.Error(purgeErr),
+			)
+		} else {
+			logctx.From(ctx, s.logger).Info("retention purge completed for data class",
+				zap.String("data_class", rule.Class),
+				zap.Time("cutoff", result.Cutoff),
+				zap.Int64("deleted", deleted),
+			)
+		}
+
+		report.Results = append(report.Results, result)
+	}
+
+	return report, nil
+}
+
+// ListRuns retrieves recorded retention runs (newest first)
+func (s *Service) ListRuns(ctx context.Context, req *ListRetentionRunsRequest) (*ListRetentionRunsResponse, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	runs, err := s.txRunner.Queries().ListRetentionRuns(ctx, db.ListRetentionRunsParams{
+		Limit:  int32(req.PageSize),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list retention runs", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := s.txRunner.Queries().CountRetentionRuns(ctx)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count retention runs", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListRetentionRunsResponse{
+		Runs:       ToRetentionRunResponseList(runs),
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// purgeClass deletes expired rows in batches (each batch autocommits).
+// Returns the number of rows deleted so far even on error.
+func (s *Service) purgeClass(ctx context.Context, class string, cutoff time.Time) (int64, error) {
+	var deleted int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return deleted, err
+		}
+
+		result, err := purgers[class].purge(ctx, s.txRunner.Queries(), cutoff, int32(s.config.BatchSize))
+		if err != nil {
+			return deleted, fmt.Errorf("purge %s: %w", class, err)
+		}
+
+		affected, _ := result.RowsAffected()
+		deleted += affected
+		if affected < int64(s.config.BatchSize) {
+			return deleted, nil
+		}
+	}
+}
+
+// recordRun stores the outcome of a purge run
+func (s *Service) recordRun(ctx context.Context, rule Rule, result *ClassPurgeResult, triggeredBy uint64, startedAt time.Time) (string, error) {
+	triggerType := db.RetentionRunsTriggerTypeSCHEDULE
+	if triggeredBy != 0 {
+		triggerType = db.RetentionRunsTriggerTypeMANUAL
+	}
+
+	runID := uuid.New().String()
+	// ctx가 취소된 경우에도 실행 기록은 남김
+	if err := s.txRunner.Queries().CreateRetentionRun(context.WithoutCancel(ctx), db.CreateRetentionRunParams{
+		ExternalID:       runID,
+		DataClass:        rule.Class,
+		RetentionSeconds: uint64(rule.Retention / time.Second),
+		Cutoff:           result.Cutoff,
+		DeletedCount:     uint64(result.Deleted),
+		Status:           db.RetentionRunsStatus(result.Status),
+		ErrorMessage:     sql.NullString{String: result.Error, Valid: result.Error != ""},
+		TriggerType:      triggerType,
+		TriggeredBy:      sql.NullInt64{Int64: int64(triggeredBy), Valid: triggeredBy != 0},
+		StartedAt:        startedAt,
+		FinishedAt:       time.Now(),
+	}); err != nil {
+		return "", err
+	}
+	return runID, nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
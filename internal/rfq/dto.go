@@ -0,0 +1,178 @@
+package rfq
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// ItemRequest represents a requested catalog product and quantity
+type ItemRequest struct {
+	SKU      string `json:"sku" binding:"required,max=100" example:"SKU-WIDGET-001"`
+	Quantity uint32 `json:"quantity" binding:"required,min=1,max=1000000" example:"500"`
+}
+
+// CreateRFQRequest represents the request body for requesting quotes from sellers
+type CreateRFQRequest struct {
+	BuyerID     string        `json:"buyer_id,omitempty" binding:"omitempty,uuid" example:"550e8400-e29b-41d4-a716-446655440000"` // omitted = caller
+	SellerIDs   []string      `json:"seller_ids" binding:"required,min=1,max=20,dive,uuid"`
+	TokenSymbol string        `json:"token_symbol,omitempty" binding:"max=10" example:"USDC"`
+	Items       []ItemRequest `json:"items" binding:"required,min=1,max=100,dive"`
+	Notes       string        `json:"notes,omitempty" binding:"max=1000" example:"Delivery by end of October"`
+	RespondBy   *time.Time    `json:"respond_by,omitempty"` // quote submission deadline (omitted = none)
+}
+
+// QuoteLineRequest represents the quoted unit price of a requested line
+type QuoteLineRequest struct {
+	LineNo    uint32 `json:"line_no" binding:"required,min=1" example:"1"`
+	UnitPrice string `json:"unit_price" binding:"required,max=32" example:"12.50"`
+}
+
+// SubmitQuoteRequest represents the request body for quoting an RFQ (replaces the seller's previous quote)
+type SubmitQuoteRequest struct {
+	SellerID      string             `json:"seller_id,omitempty" binding:"omitempty,uuid" example:"550e8400-e29b-41d4-a716-446655440001"` // omitted = caller
+	Currency      string             `json:"currency,omitempty" binding:"omitempty,len=3" example:"KRW"`                                  // omitted = priced in the RFQ token
+	Lines         []QuoteLineRequest `json:"lines" binding:"required,min=1,max=100,dive"`
+	ValidForHours int                `json:"valid_for_hours" binding:"required,min=1" example:"72"`
+	Notes         string             `json:"notes,omitempty" binding:"max=1000" example:"Price includes shipping"`
+}
+
+// ListRFQsRequest represents query parameters for listing the caller's RFQs
+type ListRFQsRequest struct {
+	Status   string `form:"status" binding:"omitempty,oneof=OPEN AWARDED CANCELLED"`
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// ItemResponse represents a requested line
+type ItemResponse struct {
+	LineNo      uint32 `json:"line_no" example:"1"`
+	SKU         string `json:"sku" example:"SKU-WIDGET-001"`
+	ProductName string `json:"product_name" example:"Industrial widget"`
+	Quantity    uint32 `json:"quantity" example:"500"`
+}
+
+// SellerResponse represents a seller invited to quote
+type SellerResponse struct {
+	SellerID string `json:"seller_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Name     string `json:"name" example:"Widget Works"`
+}
+
+// QuoteLineResponse represents a quoted line (amounts in the quote's price currency)
+type QuoteLineResponse struct {
+	LineNo    uint32      `json:"line_no" example:"1"`
+	UnitPrice money.Money `json:"unit_price" swaggertype:"string" example:"12500"`
+	LineTotal money.Money `json:"line_total" swaggertype:"string" example:"6250000"`
+}
+
+// QuoteResponse represents a seller's quote
+type QuoteResponse struct {
+	QuoteID       string              `json:"quote_id" example:"cc0e8400-e29b-41d4-a716-446655440000"`
+	SellerID      string              `json:"seller_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	SellerName    string              `json:"seller_name" example:"Widget Works"`
+	Status        string              `json:"status" example:"ACTIVE" enums:"ACTIVE,WITHDRAWN,ACCEPTED,DECLINED,EXPIRED"`
+	Revision      uint32              `json:"revision" example:"1"`
+	PriceCurrency string              `json:"price_currency,omitempty" example:"KRW"` // omitted = priced in the token
+	PriceAmount   string              `json:"price_amount,omitempty" example:"6250000"`
+	TokenSymbol   string              `json:"token_symbol" example:"USDC"`
+	TotalAmount   money.Money         `json:"total_amount" swaggertype:"string" example:"4500.00"` // token amount locked until expires_at
+	FXRate        string              `json:"fx_rate,omitempty" example:"0.00072"`
+	FXRateSource  string              `json:"fx_rate_source,omitempty" example:"chainlink"`
+	FXRateAt      *time.Time          `json:"fx_rate_at,omitempty"`
+	Lines         []QuoteLineResponse `json:"lines"`
+	Notes         string              `json:"notes,omitempty" example:"Price includes shipping"`
+	QuotedAt      time.Time           `json:"quoted_at"`
+	ExpiresAt     time.Time           `json:"expires_at"`
+}
+
+// RFQResponse represents a request for quote with the quotes visible to the caller
+// NOTE: 구매자/관리자는 모든 견적, 판매자는 자신의 견적만 조회
+type RFQResponse struct {
+	RFQID          string           `json:"rfq_id" example:"dd0e8400-e29b-41d4-a716-446655440000"`
+	RFQNumber      string           `json:"rfq_number" example:"RFQ-20261001-0001"`
+	BuyerID        string           `json:"buyer_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	BuyerName      string           `json:"buyer_name" example:"Acme Trading"`
+	Status         string           `json:"status" example:"OPEN" enums:"OPEN,AWARDED,CANCELLED"`
+	TokenSymbol    string           `json:"token_symbol" example:"USDC"`
+	Notes          string           `json:"notes,omitempty" example:"Delivery by end of October"`
+	RespondBy      *time.Time       `json:"respond_by,omitempty"`
+	Items          []ItemResponse   `json:"items,omitempty"`
+	Sellers        []SellerResponse `json:"sellers,omitempty"`
+	Quotes         []QuoteResponse  `json:"quotes,omitempty"`
+	AwardedQuoteID string           `json:"awarded_quote_id,omitempty" example:"cc0e8400-e29b-41d4-a716-446655440000"`
+	OrderNumber    string           `json:"order_number,omitempty" example:"ORD-20261003-0001"`
+	ClosedAt       *time.Time       `json:"closed_at,omitempty"`
+	CreatedAt      time.Time        `json:"created_at"`
+}
+
+// ListRFQsResponse represents paginated RFQ list
+type ListRFQsResponse struct {
+	RFQs       []RFQResponse `json:"rfqs"`
+	Total      int64         `json:"total" example:"42"`
+	Page       int           `json:"page" example:"1"`
+	PageSize   int           `json:"page_size" example:"20"`
+	TotalPages int           `json:"total_pages" example:"3"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToRFQResponse converts an RFQ with its buyer to its response (items, sellers and quotes attached by the service)
+func ToRFQResponse(r *db.GetRFQDetailRow) *RFQResponse {
+	response := &RFQResponse{
+		RFQID:       r.ExternalID,
+		RFQNumber:   r.RfqNumber,
+		BuyerID:     r.BuyerExternalID.String,
+		BuyerName:   r.BuyerName,
+		Status:      string(r.Status),
+		TokenSymbol: r.TokenSymbol,
+		Notes:       r.Notes.String,
+		OrderNumber: r.OrderNumber.String,
+		CreatedAt:   r.CreatedAt,
+	}
+	if r.RespondBy.Valid {
+		response.RespondBy = &r.RespondBy.Time
+	}
+	if r.ClosedAt.Valid {
+		response.ClosedAt = &r.ClosedAt.Time
+	}
+	return response
+}
+
+// ToQuoteResponse converts a quote to its response; an active quote past expires_at is EXPIRED
+func ToQuoteResponse(q *db.ListRFQQuotesRow, tokenSymbol string, total money.Money, lines []QuoteLineResponse, now time.Time) QuoteResponse {
+	response := QuoteResponse{
+		QuoteID:       q.ExternalID,
+		SellerID:      q.SellerExternalID.String,
+		SellerName:    q.SellerName,
+		Status:        string(q.Status),
+		Revision:      q.Revision,
+		PriceCurrency: q.PriceCurrency.String,
+		PriceAmount:   q.PriceAmount.String,
+		TokenSymbol:   tokenSymbol,
+		TotalAmount:   total,
+		FXRate:        q.FxRate.String,
+		FXRateSource:  q.FxRateSource.String,
+		Lines:         lines,
+		Notes:         q.Notes.String,
+		QuotedAt:      q.QuotedAt,
+		ExpiresAt:     q.ExpiresAt,
+	}
+	if q.Status == db.RfqQuotesStatusACTIVE && !q.ExpiresAt.After(now) {
+		response.Status = QuoteStatusExpired
+	}
+	if q.FxRateAt.Valid {
+		response.FXRateAt = &q.FxRateAt.Time
+	}
+	return response
+}
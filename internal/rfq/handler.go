@@ -0,0 +1,342 @@
+package rfq
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for RFQ operations
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new RFQ handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers RFQ routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	rfqs := rg.Group("/rfqs", middleware.RequireAuth())
+	{
+		rfqs.POST("", h.CreateRFQ)
+		rfqs.GET("", h.ListRFQs)
+		rfqs.GET("/:rfqId", h.GetRFQ)
+		rfqs.POST("/:rfqId/cancel", h.CancelRFQ)
+		rfqs.POST("/:rfqId/quotes", h.SubmitQuote)
+		rfqs.POST("/:rfqId/quotes/:quoteId/accept", h.AcceptQuote)
+		rfqs.POST("/:rfqId/quotes/:quoteId/withdraw", h.WithdrawQuote)
+	}
+}
+
+// extractAndValidateRFQID extracts and validates rfqId from path
+func extractAndValidateRFQID(c *gin.Context) (string, error) {
+	rfqID := c.Param("rfqId")
+	if _, err := uuid.Parse(rfqID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+	return rfqID, nil
+}
+
+// extractAndValidateQuoteIDs extracts and validates rfqId and quoteId from path
+func extractAndValidateQuoteIDs(c *gin.Context) (string, string, error) {
+	rfqID, err := extractAndValidateRFQID(c)
+	if err != nil {
+		return "", "", err
+	}
+	quoteID := c.Param("quoteId")
+	if _, err := uuid.Parse(quoteID); err != nil {
+		return "", "", errors.InvalidInput("Invalid UUID format")
+	}
+	return rfqID, quoteID, nil
+}
+
+// CreateRFQ godoc
+// @Summary Request quotes
+// @Description Ask invited sellers to price quantities of catalog products (RFQ). The RFQ is numbered RFQ-YYYYMMDD-NNNN
+// @Description and stays OPEN until the buyer accepts a quote or cancels it; respond_by optionally closes quote submission.
+// @Tags rfqs
+// @Accept json
+// @Produce json
+// @Param request body CreateRFQRequest true "Invited sellers, lines and settlement token"
+// @Success 201 {object} middleware.SuccessResponse{data=RFQResponse} "RFQ created"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input, unknown or inactive SKU, inactive seller, or unsupported token"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot request quotes for another user"
+// @Failure 404 {object} middleware.ErrorResponse "Buyer or seller not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/rfqs [post]
+func (h *Handler) CreateRFQ(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req CreateRFQRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+	if req.BuyerID == "" {
+		req.BuyerID = principal.UserExternalID
+	}
+
+	result, err := h.service.CreateRFQ(c.Request.Context(), &req, rfqAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// ListRFQs godoc
+// @Summary List RFQs
+// @Description Get paginated RFQs the caller requested or was invited to quote, newest first, with an optional status filter
+// @Description (lines, sellers and quotes omitted)
+// @Tags rfqs
+// @Produce json
+// @Param status query string false "Status filter" Enums(OPEN, AWARDED, CANCELLED)
+// @Param page query int false "Page number" default(1) minimum(1)
+// @Param page_size query int false "Page size" default(20) minimum(1) maximum(100)
+// @Success 200 {object} middleware.SuccessResponse{data=ListRFQsResponse} "RFQs"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/rfqs [get]
+func (h *Handler) ListRFQs(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req ListRFQsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListRFQs(c.Request.Context(), principal.UserID, &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// GetRFQ godoc
+// @Summary Get RFQ
+// @Description Get an RFQ with its lines, invited sellers and quotes. The buyer sees every quote; an invited seller sees only their own.
+// @Description An ACTIVE quote past expires_at is reported as EXPIRED.
+// @Tags rfqs
+// @Produce json
+// @Param rfqId path string true "RFQ ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=RFQResponse} "RFQ"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "RFQ not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/rfqs/{rfqId} [get]
+func (h *Handler) GetRFQ(c *gin.Context) {
+	rfqID, err := extractAndValidateRFQID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	result, err := h.service.GetRFQ(c.Request.Context(), rfqID, rfqAccess(principal))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// CancelRFQ godoc
+// @Summary Cancel RFQ
+// @Description Close an OPEN RFQ without an award (buyer only); active quotes are declined
+// @Tags rfqs
+// @Produce json
+// @Param rfqId path string true "RFQ ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=RFQResponse} "RFQ cancelled"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Only the buyer can cancel an RFQ"
+// @Failure 404 {object} middleware.ErrorResponse "RFQ not found"
+// @Failure 409 {object} middleware.ErrorResponse "RFQ is not open"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/rfqs/{rfqId}/cancel [post]
+func (h *Handler) CancelRFQ(c *gin.Context) {
+	rfqID, err := extractAndValidateRFQID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	result, err := h.service.CancelRFQ(c.Request.Context(), rfqID, rfqAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// SubmitQuote godoc
+// @Summary Submit quote
+// @Description Price every line of an OPEN RFQ (invited seller). Resubmitting replaces the seller's active quote and bumps its revision.
+// @Description Prices are in the RFQ token, or in a fiat currency (KRW, USD) converted at the current rate; the token amount and rate
+// @Description are locked until the quote expires after valid_for_hours (capped by the server's maximum quote validity).
+// @Tags rfqs
+// @Accept json
+// @Produce json
+// @Param rfqId path string true "RFQ ID (UUID)"
+// @Param request body SubmitQuoteRequest true "Unit prices per line, price currency and validity"
+// @Success 200 {object} middleware.SuccessResponse{data=RFQResponse} "Quote submitted"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input, lines not covering the RFQ, or validity too long"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Not an invited seller"
+// @Failure 404 {object} middleware.ErrorResponse "RFQ not found"
+// @Failure 409 {object} middleware.ErrorResponse "RFQ is not open, past respond_by, or the quote was already decided"
+// @Failure 503 {object} middleware.ErrorResponse "Exchange rate unavailable"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/rfqs/{rfqId}/quotes [post]
+func (h *Handler) SubmitQuote(c *gin.Context) {
+	rfqID, err := extractAndValidateRFQID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req SubmitQuoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+	if req.SellerID == "" {
+		req.SellerID = principal.UserExternalID
+	}
+
+	result, err := h.service.SubmitQuote(c.Request.Context(), rfqID, &req, rfqAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// AcceptQuote godoc
+// @Summary Accept quote
+// @Description Award an OPEN RFQ to an unexpired ACTIVE quote (buyer only). The quote converts into a CONFIRMED order at the quoted
+// @Description token amount and locked rate; the remaining active quotes are declined.
+// @Tags rfqs
+// @Produce json
+// @Param rfqId path string true "RFQ ID (UUID)"
+// @Param quoteId path string true "Quote ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=RFQResponse} "Quote accepted, order created"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Only the buyer can accept a quote"
+// @Failure 404 {object} middleware.ErrorResponse "RFQ or quote not found"
+// @Failure 409 {object} middleware.ErrorResponse "RFQ is not open, or the quote is expired or no longer active"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/rfqs/{rfqId}/quotes/{quoteId}/accept [post]
+func (h *Handler) AcceptQuote(c *gin.Context) {
+	rfqID, quoteID, err := extractAndValidateQuoteIDs(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	result, err := h.service.AcceptQuote(c.Request.Context(), rfqID, quoteID, rfqAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// WithdrawQuote godoc
+// @Summary Withdraw quote
+// @Description Withdraw an ACTIVE quote from an OPEN RFQ (quoting seller only); the seller may quote again later
+// @Tags rfqs
+// @Produce json
+// @Param rfqId path string true "RFQ ID (UUID)"
+// @Param quoteId path string true "Quote ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=RFQResponse} "Quote withdrawn"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Only the quoting seller can withdraw a quote"
+// @Failure 404 {object} middleware.ErrorResponse "RFQ or quote not found"
+// @Failure 409 {object} middleware.ErrorResponse "RFQ is not open or the quote is no longer active"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/rfqs/{rfqId}/quotes/{quoteId}/withdraw [post]
+func (h *Handler) WithdrawQuote(c *gin.Context) {
+	rfqID, quoteID, err := extractAndValidateQuoteIDs(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	result, err := h.service.WithdrawQuote(c.Request.Context(), rfqID, quoteID, rfqAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// rfqAccess maps the principal to the service's RFQ access check
+func rfqAccess(principal *middleware.Principal) Access {
+	return Access{
+		Admin:    principal.IsAdmin(),
+		CanActAs: principal.CanActAs,
+	}
+}
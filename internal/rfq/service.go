@@ -0,0 +1,919 @@
+package rfq
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/catalog"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/docnumber"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/quote"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/tax"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// amountScale is the scale of quoted and order amounts (DECIMAL(18,2))
+const amountScale = 2
+
+// QuoteStatusExpired is the reported status of an active quote past its expiry (not stored)
+const QuoteStatusExpired = "EXPIRED"
+
+// Audit log identifiers
+const (
+	actionCreated        = "RFQ_CREATED"
+	actionCancelled      = "RFQ_CANCELLED"
+	actionQuoteSubmitted = "RFQ_QUOTE_SUBMITTED"
+	actionQuoteWithdrawn = "RFQ_QUOTE_WITHDRAWN"
+	actionQuoteAccepted  = "RFQ_QUOTE_ACCEPTED"
+	resourceRFQ          = "RFQ"
+)
+
+// Config holds RFQ settings
+type Config struct {
+	// MaxQuoteValidity caps how long a seller may hold a quoted price (and its FX rate)
+	MaxQuoteValidity time.Duration
+}
+
+// Access is the caller's access to RFQs
+type Access struct {
+	// Admin may view any RFQ and quote
+	Admin bool
+	// CanActAs reports whether the caller may operate on the user's resources (buyer/seller external ID)
+	CanActAs func(userExternalID string) bool
+}
+
+// Service handles requests for quote.
+// The buyer asks invited sellers to price catalog products; each seller submits one quote (resubmitting replaces it)
+// with an expiry, and fiat-priced quotes lock the exchange rate at submission. The buyer accepts one unexpired quote,
+// which becomes a confirmed order at the quoted amount and rate.
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	tokens   *chain.TokenRegistry
+	quotes   *quote.Service
+	taxes    *tax.Engine
+	config   Config
+	logger   *zap.Logger
+}
+
+// NewService creates a new RFQ service
+func NewService(txRunner *pkgdb.TxRunner, tokens *chain.TokenRegistry, quotes *quote.Service, taxes *tax.Engine, config Config, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		tokens:   tokens,
+		quotes:   quotes,
+		taxes:    taxes,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// CreateRFQ requests quotes for catalog products from the invited sellers (buyer or admin)
+func (s *Service) CreateRFQ(ctx context.Context, req *CreateRFQRequest, access Access, actor audit.Actor) (*RFQResponse, error) {
+	// 1. Validate
+	if !access.CanActAs(req.BuyerID) {
+		return nil, errors.Forbidden("Cannot request quotes for another user")
+	}
+	token, err := s.tokens.Resolve(req.TokenSymbol, 0)
+	if err != nil {
+		return nil, errors.InvalidInput("unsupported token_symbol")
+	}
+	if req.RespondBy != nil && !req.RespondBy.After(time.Now()) {
+		return nil, errors.InvalidInput("respond_by must be in the future")
+	}
+
+	q := s.txRunner.Queries()
+	buyer, err := s.getUser(ctx, q, req.BuyerID, "Buyer")
+	if err != nil {
+		return nil, err
+	}
+	sellers := make([]*db.User, 0, len(req.SellerIDs))
+	seen := make(map[string]bool, len(req.SellerIDs))
+	for _, sellerID := range req.SellerIDs {
+		if seen[sellerID] {
+			return nil, errors.InvalidInput("duplicate seller_id: " + sellerID)
+		}
+		seen[sellerID] = true
+		if sellerID == req.BuyerID {
+			return nil, errors.InvalidInput("seller_ids must not include the buyer")
+		}
+		seller, err := s.getUser(ctx, q, sellerID, "Seller")
+		if err != nil {
+			return nil, err
+		}
+		if seller.Status != db.UsersStatusACTIVE {
+			return nil, errors.InvalidInput("seller is not active: " + sellerID)
+		}
+		sellers = append(sellers, seller)
+	}
+
+	// 2. Create RFQ + lines + invited sellers (one transaction)
+	externalID := uuid.New().String()
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		products, err := resolveProducts(ctx, q, req.Items)
+		if err != nil {
+			return err
+		}
+
+		rfqNumber, err := docnumber.Next(ctx, q, docnumber.PrefixRFQ, time.Now())
+		if err != nil {
+			return err
+		}
+		var respondBy sql.NullTime
+		if req.RespondBy != nil {
+			respondBy = sql.NullTime{Time: req.RespondBy.UTC(), Valid: true}
+		}
+		result, err := q.CreateRFQ(ctx, db.CreateRFQParams{
+			ExternalID:  externalID,
+			RfqNumber:   rfqNumber,
+			BuyerID:     buyer.ID,
+			TokenSymbol: token.Symbol,
+			Notes:       sql.NullString{String: req.Notes, Valid: req.Notes != ""},
+			RespondBy:   respondBy,
+		})
+		if err != nil {
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+
+		for i, item := range req.Items {
+			if err := q.CreateRFQItem(ctx, db.CreateRFQItemParams{
+				RfqID:     uint64(id),
+				LineNo:    uint32(i + 1),
+				ProductID: products[i].ID,
+				Quantity:  item.Quantity,
+			}); err != nil {
+				return err
+			}
+		}
+		for _, seller := range sellers {
+			if err := q.AddRFQSeller(ctx, db.AddRFQSellerParams{
+				RfqID:    uint64(id),
+				SellerID: seller.ID,
+			}); err != nil {
+				return err
+			}
+		}
+
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionCreated,
+			ResourceType: resourceRFQ,
+			ResourceID:   uint64(id),
+			NewValue: map[string]any{
+				"rfq_number":   rfqNumber,
+				"seller_ids":   req.SellerIDs,
+				"token_symbol": token.Symbol,
+				"lines":        len(req.Items),
+			},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to create rfq", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetRFQ(ctx, externalID, access)
+}
+
+// GetRFQ returns an RFQ with its lines, invited sellers and the quotes the caller may see (buyer, invited seller or admin)
+func (s *Service) GetRFQ(ctx context.Context, externalID string, access Access) (*RFQResponse, error) {
+	q := s.txRunner.Queries()
+	detail, sellers, err := s.getDetail(ctx, q, externalID, access)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := q.ListRFQItems(ctx, detail.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list rfq items", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	quotes, err := q.ListRFQQuotes(ctx, detail.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list rfq quotes", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	response := ToRFQResponse(detail)
+	response.Items = make([]ItemResponse, 0, len(items))
+	quantities := make(map[uint32]uint32, len(items))
+	for _, item := range items {
+		response.Items = append(response.Items, ItemResponse{
+			LineNo:      item.LineNo,
+			SKU:         item.Sku,
+			ProductName: item.ProductName,
+			Quantity:    item.Quantity,
+		})
+		quantities[item.LineNo] = item.Quantity
+	}
+	response.Sellers = make([]SellerResponse, 0, len(sellers))
+	for _, seller := range sellers {
+		response.Sellers = append(response.Sellers, SellerResponse{
+			SellerID: seller.SellerExternalID.String,
+			Name:     seller.SellerName,
+		})
+	}
+
+	// 구매자/관리자는 모든 견적, 판매자는 자신의 견적만
+	buyerView := access.Admin || access.CanActAs(detail.BuyerExternalID.String)
+	now := time.Now()
+	for i := range quotes {
+		quote := &quotes[i]
+		if detail.AwardedQuoteID.Valid && uint64(detail.AwardedQuoteID.Int64) == quote.ID {
+			response.AwardedQuoteID = quote.ExternalID
+		}
+		if !buyerView && !access.CanActAs(quote.SellerExternalID.String) {
+			continue
+		}
+		quoteResponse, err := s.toQuoteResponse(ctx, q, quote, detail.TokenSymbol, quantities, now)
+		if err != nil {
+			return nil, err
+		}
+		response.Quotes = append(response.Quotes, *quoteResponse)
+	}
+	return response, nil
+}
+
+// ListRFQs returns the RFQs the user requested or was invited to quote, newest first
+func (s *Service) ListRFQs(ctx context.Context, userID uint64, req *ListRFQsRequest) (*ListRFQsResponse, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	var status db.NullRfqsStatus
+	if req.Status != "" {
+		status = db.NullRfqsStatus{RfqsStatus: db.RfqsStatus(req.Status), Valid: true}
+	}
+
+	q := s.txRunner.Queries()
+	rows, err := q.ListRFQsByUser(ctx, db.ListRFQsByUserParams{
+		UserID: userID,
+		Status: status,
+		Limit:  int32(req.PageSize),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list rfqs", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := q.CountRFQsByUser(ctx, db.CountRFQsByUserParams{
+		UserID: userID,
+		Status: status,
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count rfqs", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	rfqs := make([]RFQResponse, 0, len(rows))
+	for i := range rows {
+		detail := db.GetRFQDetailRow(rows[i])
+		rfqs = append(rfqs, *ToRFQResponse(&detail))
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListRFQsResponse{
+		RFQs:       rfqs,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// CancelRFQ closes an open RFQ without an award and declines its active quotes (buyer or admin)
+func (s *Service) CancelRFQ(ctx context.Context, externalID string, access Access, actor audit.Actor) (*RFQResponse, error) {
+	detail, _, err := s.getDetail(ctx, s.txRunner.Queries(), externalID, access)
+	if err != nil {
+		return nil, err
+	}
+	if !access.CanActAs(detail.BuyerExternalID.String) {
+		return nil, errors.Forbidden("Only the buyer can cancel an RFQ")
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		rfq, err := lockOpen(ctx, q, detail.ID)
+		if err != nil {
+			return err
+		}
+		if err := q.DeclineActiveRFQQuotes(ctx, rfq.ID); err != nil {
+			return err
+		}
+		if err := q.CloseRFQ(ctx, db.CloseRFQParams{
+			Status: db.RfqsStatusCANCELLED,
+			ID:     rfq.ID,
+		}); err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionCancelled,
+			ResourceType: resourceRFQ,
+			ResourceID:   rfq.ID,
+			OldValue:     map[string]any{"status": string(rfq.Status)},
+			NewValue:     map[string]any{"status": string(db.RfqsStatusCANCELLED)},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to cancel rfq", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetRFQ(ctx, externalID, access)
+}
+
+// SubmitQuote prices every line of an open RFQ (invited seller or admin); resubmitting replaces the seller's quote.
+// A fiat-priced quote (currency) is converted into the RFQ token at the current rate, and that token amount
+// and rate hold until the quote expires.
+//
+// Why:
+//   - 환율은 제출 시점에 고정 → 구매자가 유효기간 내 수락하면 환율 변동과 무관하게 견적 금액으로 주문
+//   - 유효기간 상한(RFQ_MAX_QUOTE_VALIDITY)으로 판매자가 장기간 환율 위험을 떠안는 견적 방지
+func (s *Service) SubmitQuote(ctx context.Context, rfqExternalID string, req *SubmitQuoteRequest, access Access, actor audit.Actor) (*RFQResponse, error) {
+	// 1. Validate
+	detail, sellers, err := s.getDetail(ctx, s.txRunner.Queries(), rfqExternalID, access)
+	if err != nil {
+		return nil, err
+	}
+	if !access.CanActAs(req.SellerID) {
+		return nil, errors.Forbidden("Cannot quote for another user")
+	}
+	seller := findSeller(sellers, req.SellerID)
+	if seller == nil {
+		return nil, errors.Forbidden("Only invited sellers can quote this RFQ")
+	}
+	validity := time.Duration(req.ValidForHours) * time.Hour
+	if validity > s.config.MaxQuoteValidity {
+		return nil, errors.InvalidInput("valid_for_hours exceeds the maximum quote validity of " + s.config.MaxQuoteValidity.String())
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(req.Currency))
+	decimals := amountScale
+	if currency != "" {
+		fiat, ok := quote.CurrencyDecimals(currency)
+		if !ok {
+			return nil, errors.InvalidInput("currency must be one of KRW, USD")
+		}
+		decimals = fiat
+	}
+
+	items, err := s.txRunner.Queries().ListRFQItems(ctx, detail.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list rfq items", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	prices, priceTotal, err := priceLines(items, req.Lines, currency, decimals)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Token amount (fiat → token at the current rate)
+	now := time.Now().UTC()
+	params := db.ReviseRFQQuoteParams{
+		TotalAmount: priceTotal.String(),
+		QuotedAt:    now,
+		ExpiresAt:   now.Add(validity),
+		Notes:       sql.NullString{String: req.Notes, Valid: req.Notes != ""},
+	}
+	if currency != "" {
+		fx, err := s.quotes.CreateQuote(ctx, &quote.CreateQuoteRequest{
+			Currency:    currency,
+			Amount:      priceTotal.String(),
+			TokenSymbol: detail.TokenSymbol,
+		})
+		if err != nil {
+			return nil, err
+		}
+		params.PriceCurrency = sql.NullString{String: currency, Valid: true}
+		params.PriceAmount = sql.NullString{String: priceTotal.String(), Valid: true}
+		params.TotalAmount = fx.TokenAmount.String()
+		params.FxRate = sql.NullString{String: fx.StoredRate(), Valid: true}
+		params.FxRateSource = sql.NullString{String: fx.Rate.Source, Valid: true}
+		params.FxRateAt = sql.NullTime{Time: fx.Rate.UpdatedAt, Valid: true}
+		if fx.TokenAmount.Sign() <= 0 {
+			return nil, errors.InvalidInput("quote total must be positive")
+		}
+	}
+
+	// 3. Create or replace the seller's quote (RFQ row-lock)
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		rfq, err := lockOpen(ctx, q, detail.ID)
+		if err != nil {
+			return err
+		}
+		if rfq.RespondBy.Valid && !now.Before(rfq.RespondBy.Time) {
+			return errors.Conflict("RFQ is no longer accepting quotes")
+		}
+
+		var quoteID uint64
+		revision := uint32(1)
+		existing, err := q.GetRFQQuoteBySeller(ctx, db.GetRFQQuoteBySellerParams{
+			RfqID:    rfq.ID,
+			SellerID: seller.SellerID,
+		})
+		switch {
+		case err == sql.ErrNoRows:
+			result, err := q.CreateRFQQuote(ctx, db.CreateRFQQuoteParams{
+				ExternalID:    uuid.New().String(),
+				RfqID:         rfq.ID,
+				SellerID:      seller.SellerID,
+				PriceCurrency: params.PriceCurrency,
+				PriceAmount:   params.PriceAmount,
+				TotalAmount:   params.TotalAmount,
+				FxRate:        params.FxRate,
+				FxRateSource:  params.FxRateSource,
+				FxRateAt:      params.FxRateAt,
+				QuotedAt:      params.QuotedAt,
+				ExpiresAt:     params.ExpiresAt,
+				Notes:         params.Notes,
+			})
+			if err != nil {
+				return err
+			}
+			id, err := result.LastInsertId()
+			if err != nil {
+				return err
+			}
+			quoteID = uint64(id)
+		case err != nil:
+			return err
+		default:
+			quoteID = existing.ID
+			revision = existing.Revision + 1
+			params.ID = existing.ID
+			if err := q.ReviseRFQQuote(ctx, params); err != nil {
+				return err
+			}
+			if err := q.DeleteRFQQuoteItems(ctx, existing.ID); err != nil {
+				return err
+			}
+		}
+
+		for _, item := range items {
+			if err := q.CreateRFQQuoteItem(ctx, db.CreateRFQQuoteItemParams{
+				QuoteID:   quoteID,
+				LineNo:    item.LineNo,
+				UnitPrice: prices[item.LineNo].String(),
+			}); err != nil {
+				return err
+			}
+		}
+
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionQuoteSubmitted,
+			ResourceType: resourceRFQ,
+			ResourceID:   rfq.ID,
+			NewValue: map[string]any{
+				"seller_id":      req.SellerID,
+				"revision":       revision,
+				"price_currency": currency,
+				"price_amount":   priceTotal.String(),
+				"total_amount":   params.TotalAmount,
+				"fx_rate":        params.FxRate.String,
+				"expires_at":     params.ExpiresAt,
+			},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to submit rfq quote", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetRFQ(ctx, rfqExternalID, access)
+}
+
+// WithdrawQuote withdraws the seller's active quote from an open RFQ (quoting seller or admin)
+func (s *Service) WithdrawQuote(ctx context.Context, rfqExternalID, quoteExternalID string, access Access, actor audit.Actor) (*RFQResponse, error) {
+	detail, sellers, err := s.getDetail(ctx, s.txRunner.Queries(), rfqExternalID, access)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		rfq, err := lockOpen(ctx, q, detail.ID)
+		if err != nil {
+			return err
+		}
+		quote, err := lockQuote(ctx, q, rfq.ID, quoteExternalID)
+		if err != nil {
+			return err
+		}
+		if seller := findSellerByID(sellers, quote.SellerID); seller == nil || !access.CanActAs(seller.SellerExternalID.String) {
+			return errors.Forbidden("Only the quoting seller can withdraw a quote")
+		}
+		if quote.Status != db.RfqQuotesStatusACTIVE {
+			return errors.Conflict("Quote is already " + string(quote.Status))
+		}
+
+		if err := q.SetRFQQuoteStatus(ctx, db.SetRFQQuoteStatusParams{
+			Status: db.RfqQuotesStatusWITHDRAWN,
+			ID:     quote.ID,
+		}); err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionQuoteWithdrawn,
+			ResourceType: resourceRFQ,
+			ResourceID:   rfq.ID,
+			OldValue:     map[string]any{"quote_id": quote.ExternalID, "status": string(quote.Status)},
+			NewValue:     map[string]any{"quote_id": quote.ExternalID, "status": string(db.RfqQuotesStatusWITHDRAWN)},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to withdraw rfq quote", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetRFQ(ctx, rfqExternalID, access)
+}
+
+// AcceptQuote awards the RFQ to an unexpired quote and converts it into a confirmed order (buyer or admin).
+//
+// Why:
+//   - 요청 → 견적 순서로 row-lock → 견적 재제출/철회/다른 견적 수락과 겹쳐도 한 번만 수락
+//   - 주문 금액/단가/환율은 견적 그대로 (법정화폐 견적은 orders.price_currency/fx_rate에 기록)
+//   - 수락되지 않은 ACTIVE 견적은 DECLINED로 정리
+//   - 토큰 견적은 수락 시점 구매자 과세 국가 기준 세금 가산 (주문 금액 = 견적 금액 + 세금)
+func (s *Service) AcceptQuote(ctx context.Context, rfqExternalID, quoteExternalID string, access Access, actor audit.Actor) (*RFQResponse, error) {
+	detail, _, err := s.getDetail(ctx, s.txRunner.Queries(), rfqExternalID, access)
+	if err != nil {
+		return nil, err
+	}
+	if !access.CanActAs(detail.BuyerExternalID.String) {
+		return nil, errors.Forbidden("Only the buyer can accept a quote")
+	}
+
+	var orderNumber string
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		rfq, err := lockOpen(ctx, q, detail.ID)
+		if err != nil {
+			return err
+		}
+		quote, err := lockQuote(ctx, q, rfq.ID, quoteExternalID)
+		if err != nil {
+			return err
+		}
+		if quote.Status != db.RfqQuotesStatusACTIVE {
+			return errors.Conflict("Quote is already " + string(quote.Status))
+		}
+		if !quote.ExpiresAt.After(time.Now()) {
+			return errors.Conflict("Quote has expired, ask the seller to requote")
+		}
+
+		items, err := q.ListRFQItems(ctx, rfq.ID)
+		if err != nil {
+			return err
+		}
+		quoteItems, err := q.ListRFQQuoteItems(ctx, quote.ID)
+		if err != nil {
+			return err
+		}
+		unitPrices := make(map[uint32]string, len(quoteItems))
+		for _, item := range quoteItems {
+			unitPrices[item.LineNo] = item.UnitPrice
+		}
+		total, err := s.parseStoredAmount(ctx, quote.TotalAmount)
+		if err != nil {
+			return err
+		}
+		// NOTE: 견적은 판매자가 협상한 가격 → 쿠폰·수량 구간 할인 미적용
+		taxes, err := s.calculateTax(ctx, q, rfq, quote, items, unitPrices)
+		if err != nil {
+			return err
+		}
+		total = total.Add(taxes.Total)
+
+		// 주문 생성 (PENDING) → 견적 금액/환율 기록 → CONFIRMED
+		orderNumber, err = docnumber.Next(ctx, q, docnumber.PrefixOrder, time.Now())
+		if err != nil {
+			return err
+		}
+		result, err := q.CreateOrder(ctx, db.CreateOrderParams{
+			OrderNumber: orderNumber,
+			BuyerID:     rfq.BuyerID,
+			SellerID:    quote.SellerID,
+			Status:      db.OrdersStatusPENDING,
+			TotalAmount: total.String(),
+			TokenSymbol: rfq.TokenSymbol,
+		})
+		if err != nil {
+			return err
+		}
+		orderID, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		// NOTE: 주문 항목 단가는 견적 통화 기준 (법정화폐 견적이면 orders.price_currency)
+		for _, item := range items {
+			if err := q.CreateOrderItem(ctx, db.CreateOrderItemParams{
+				OrderID:   uint64(orderID),
+				ProductID: item.ProductID,
+				Quantity:  item.Quantity,
+				UnitPrice: unitPrices[item.LineNo],
+			}); err != nil {
+				return err
+			}
+		}
+		if err := tax.ApplyToOrder(ctx, q, uint64(orderID), taxes); err != nil {
+			return err
+		}
+		if quote.PriceCurrency.Valid {
+			if _, err := q.SetOrderFXQuote(ctx, db.SetOrderFXQuoteParams{
+				TotalAmount:   quote.TotalAmount,
+				TokenSymbol:   rfq.TokenSymbol,
+				PriceCurrency: quote.PriceCurrency,
+				PriceAmount:   quote.PriceAmount,
+				FxRate:        quote.FxRate,
+				FxRateSource:  quote.FxRateSource,
+				FxRateAt:      quote.FxRateAt,
+				QuotedAt:      sql.NullTime{Time: quote.QuotedAt, Valid: true},
+				ID:            uint64(orderID),
+			}); err != nil {
+				return err
+			}
+		}
+		if _, err := q.ConfirmOrder(ctx, uint64(orderID)); err != nil {
+			return err
+		}
+
+		if err := q.SetRFQQuoteStatus(ctx, db.SetRFQQuoteStatusParams{
+			Status: db.RfqQuotesStatusACCEPTED,
+			ID:     quote.ID,
+		}); err != nil {
+			return err
+		}
+		if err := q.DeclineActiveRFQQuotes(ctx, rfq.ID); err != nil {
+			return err
+		}
+		if err := q.CloseRFQ(ctx, db.CloseRFQParams{
+			Status:         db.RfqsStatusAWARDED,
+			AwardedQuoteID: sql.NullInt64{Int64: int64(quote.ID), Valid: true},
+			OrderID:        sql.NullInt64{Int64: orderID, Valid: true},
+			ID:             rfq.ID,
+		}); err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionQuoteAccepted,
+			ResourceType: resourceRFQ,
+			ResourceID:   rfq.ID,
+			OldValue:     map[string]any{"status": string(rfq.Status)},
+			NewValue: map[string]any{
+				"status":         string(db.RfqsStatusAWARDED),
+				"quote_id":       quote.ExternalID,
+				"order_number":   orderNumber,
+				"total_amount":   total.String(),
+				"tax_amount":     taxes.Total.String(),
+				"price_currency": quote.PriceCurrency.String,
+				"fx_rate":        quote.FxRate.String,
+			},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to accept rfq quote", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("rfq awarded",
+		zap.String("rfq_number", detail.RfqNumber),
+		zap.String("order_number", orderNumber),
+	)
+	return s.GetRFQ(ctx, rfqExternalID, access)
+}
+
+// calculateTax computes the taxes of the order created from the accepted quote (line_no = order item position).
+// NOTE: 법정화폐 견적은 비과세 처리 → 단가가 토큰 금액이 아니며 주문 금액이 고정 환율로 확정되어 있음
+func (s *Service) calculateTax(ctx context.Context, q *db.Queries, rfq *db.Rfq, quote *db.RfqQuote, items []db.ListRFQItemsRow, unitPrices map[uint32]string) (*tax.Result, error) {
+	if quote.PriceCurrency.Valid {
+		return &tax.Result{Provider: s.taxes.Provider(), Total: money.Zero("", amountScale)}, nil
+	}
+	lines := make([]tax.Line, 0, len(items))
+	for i, item := range items {
+		unitPrice, err := s.parseStoredAmount(ctx, unitPrices[item.LineNo])
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, tax.Line{
+			LineNo:    uint32(i + 1),
+			ProductID: item.ProductID,
+			Amount:    unitPrice.MulFrac(int64(item.Quantity), 1, money.RoundDown),
+		})
+	}
+	return s.taxes.Calculate(ctx, q, rfq.BuyerID, rfq.TokenSymbol, lines)
+}
+
+// getDetail retrieves an RFQ and its invited sellers if the caller is the buyer, an invited seller or an admin
+func (s *Service) getDetail(ctx context.Context, q *db.Queries, externalID string, access Access) (*db.GetRFQDetailRow, []db.ListRFQSellersRow, error) {
+	detail, err := q.GetRFQDetail(ctx, externalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil, errors.NotFound("RFQ")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get rfq", zap.Error(err))
+		return nil, nil, errors.DBError(err)
+	}
+	sellers, err := q.ListRFQSellers(ctx, detail.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list rfq sellers", zap.Error(err))
+		return nil, nil, errors.DBError(err)
+	}
+
+	visible := access.Admin || access.CanActAs(detail.BuyerExternalID.String)
+	for i := 0; !visible && i < len(sellers); i++ {
+		visible = access.CanActAs(sellers[i].SellerExternalID.String)
+	}
+	if !visible {
+		return nil, nil, errors.NotFound("RFQ")
+	}
+	return &detail, sellers, nil
+}
+
+// getUser retrieves a party of an RFQ by external ID
+func (s *Service) getUser(ctx context.Context, q *db.Queries, externalID, resource string) (*db.User, error) {
+	user, err := q.GetUserByExternalID(ctx, sql.NullString{String: externalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound(resource)
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &user, nil
+}
+
+// toQuoteResponse loads the quoted lines and converts the quote
+func (s *Service) toQuoteResponse(ctx context.Context, q *db.Queries, quote *db.ListRFQQuotesRow, tokenSymbol string, quantities map[uint32]uint32, now time.Time) (*QuoteResponse, error) {
+	items, err := q.ListRFQQuoteItems(ctx, quote.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list rfq quote items", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	lines := make([]QuoteLineResponse, 0, len(items))
+	for _, item := range items {
+		unitPrice, err := s.parseStoredAmount(ctx, item.UnitPrice)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, QuoteLineResponse{
+			LineNo:    item.LineNo,
+			UnitPrice: unitPrice,
+			LineTotal: unitPrice.MulFrac(int64(quantities[item.LineNo]), 1, money.RoundDown),
+		})
+	}
+	total, err := s.parseStoredAmount(ctx, quote.TotalAmount)
+	if err != nil {
+		return nil, err
+	}
+	response := ToQuoteResponse(quote, tokenSymbol, total, lines, now)
+	return &response, nil
+}
+
+// parseStoredAmount parses a DECIMAL(18,2) column
+func (s *Service) parseStoredAmount(ctx context.Context, value string) (money.Money, error) {
+	amount, err := money.Parse(value, "", amountScale)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("invalid stored amount", zap.String("amount", value), zap.Error(err))
+		return money.Money{}, errors.Internal("Invalid stored amount")
+	}
+	return amount, nil
+}
+
+// resolveProducts looks up the active catalog products of the requested lines (same order)
+func resolveProducts(ctx context.Context, q *db.Queries, items []ItemRequest) ([]db.Product, error) {
+	products := make([]db.Product, 0, len(items))
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		if seen[item.SKU] {
+			return nil, errors.InvalidInput("duplicate sku: " + item.SKU)
+		}
+		seen[item.SKU] = true
+
+		product, err := q.GetProductBySKU(ctx, item.SKU)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, errors.InvalidInput("unknown sku: " + item.SKU)
+			}
+			return nil, err
+		}
+		if product.Status != db.ProductsStatusACTIVE {
+			return nil, errors.InvalidInput("product is not active: " + item.SKU)
+		}
+		if err := catalog.CheckSellable(ctx, q, &product); err != nil {
+			return nil, err
+		}
+		products = append(products, product)
+	}
+	return products, nil
+}
+
+// priceLines parses the quoted unit prices, which must cover every requested line exactly once,
+// and returns them by line number with the quote total (price currency)
+func priceLines(items []db.ListRFQItemsRow, lines []QuoteLineRequest, currency string, decimals int) (map[uint32]money.Money, money.Money, error) {
+	quantities := make(map[uint32]uint32, len(items))
+	for _, item := range items {
+		quantities[item.LineNo] = item.Quantity
+	}
+
+	prices := make(map[uint32]money.Money, len(lines))
+	total := money.Zero(currency, decimals)
+	for _, l := range lines {
+		quantity, ok := quantities[l.LineNo]
+		if !ok {
+			return nil, money.Money{}, errors.InvalidInput("unknown line_no in quote")
+		}
+		if _, dup := prices[l.LineNo]; dup {
+			return nil, money.Money{}, errors.InvalidInput("duplicate line_no in quote")
+		}
+		unitPrice, err := money.Parse(l.UnitPrice, currency, decimals)
+		if err != nil || unitPrice.Sign() < 0 {
+			return nil, money.Money{}, errors.InvalidInput("unit_price must be a non-negative decimal within the currency's minor units")
+		}
+		prices[l.LineNo] = unitPrice
+		total = total.Add(unitPrice.MulFrac(int64(quantity), 1, money.RoundDown))
+	}
+	if len(prices) != len(items) {
+		return nil, money.Money{}, errors.InvalidInput("quote must price every requested line")
+	}
+	if total.Sign() <= 0 {
+		return nil, money.Money{}, errors.InvalidInput("quote total must be positive")
+	}
+	return prices, total, nil
+}
+
+// lockOpen locks an RFQ that is still open
+func lockOpen(ctx context.Context, q *db.Queries, id uint64) (*db.Rfq, error) {
+	rfq, err := q.GetRFQForUpdate(ctx, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("RFQ")
+		}
+		return nil, err
+	}
+	if rfq.Status != db.RfqsStatusOPEN {
+		return nil, errors.Conflict("RFQ is already " + string(rfq.Status))
+	}
+	return &rfq, nil
+}
+
+// lockQuote locks a quote of the RFQ
+func lockQuote(ctx context.Context, q *db.Queries, rfqID uint64, externalID string) (*db.RfqQuote, error) {
+	quote, err := q.GetRFQQuoteForUpdate(ctx, externalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Quote")
+		}
+		return nil, err
+	}
+	if quote.RfqID != rfqID {
+		return nil, errors.NotFound("Quote")
+	}
+	return &quote, nil
+}
+
+// findSeller returns the invited seller with the external ID (nil = not invited)
+func findSeller(sellers []db.ListRFQSellersRow, externalID string) *db.ListRFQSellersRow {
+	for i := range sellers {
+		if sellers[i].SellerExternalID.String == externalID {
+			return &sellers[i]
+		}
+	}
+	return nil
+}
+
+// findSellerByID returns the invited seller with the user ID (nil = not invited)
+func findSellerByID(sellers []db.ListRFQSellersRow, id uint64) *db.ListRFQSellersRow {
+	for i := range sellers {
+		if sellers[i].SellerID == id {
+			return &sellers[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package risk
+
+import (
+	"time"
+)
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// RiskScoreResponse represents a counterparty score with the inputs and rules it was computed from
+type RiskScoreResponse struct {
+	CounterpartyID   string     `json:"counterparty_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Score            float64    `json:"score" example:"87.5"`
+	NetTermsEligible bool       `json:"net_terms_eligible" example:"true"`
+	Inputs           Inputs     `json:"inputs"`
+	Components       Components `json:"components"`
+	Rules            Rules      `json:"rules"`
+	ComputedAt       time.Time  `json:"computed_at"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToRiskScoreResponse builds the response of a computed score
+func ToRiskScoreResponse(counterpartyID string, inputs Inputs, rules Rules, result Result, computedAt time.Time) *RiskScoreResponse {
+	return &RiskScoreResponse{
+		CounterpartyID:   counterpartyID,
+		Score:            result.Score,
+		NetTermsEligible: result.NetTermsEligible,
+		Inputs:           inputs,
+		Components:       result.Components,
+		Rules:            rules,
+		ComputedAt:       computedAt.UTC(),
+	}
+}
@@ -0,0 +1,77 @@
+package risk
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for counterparty risk scores
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new risk handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers risk score routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	counterparties := rg.Group("/counterparties", middleware.RequireRoles(middleware.RoleSeller, middleware.RoleAdmin))
+	{
+		counterparties.GET("/:id/risk-score", h.GetScore)
+	}
+}
+
+// validateUUID validates UUID format
+func validateUUID(id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return errors.InvalidInput("Invalid UUID format")
+	}
+	return nil
+}
+
+// GetScore godoc
+// @Summary Get counterparty risk score
+// @Description Compute a 0-100 score of a buyer from payment punctuality, dispute rate and chargeback history,
+// @Description with net-terms eligibility, the aggregated inputs, per-rule deductions and the scoring rules used.
+// @Description Sellers can only score buyers they have traded with. Every computation is audit-logged.
+// @Tags risk
+// @Produce json
+// @Param id path string true "Counterparty (buyer) user external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=RiskScoreResponse} "Risk score"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Counterparty not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/counterparties/{id}/risk-score [get]
+func (h *Handler) GetScore(c *gin.Context) {
+	counterpartyID := c.Param("id")
+	if err := validateUUID(counterpartyID); err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+	sellerID := principal.UserID
+	if principal.IsAdmin() {
+		sellerID = 0
+	}
+
+	result, err := h.service.GetScore(c.Request.Context(), counterpartyID, sellerID, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
@@ -0,0 +1,107 @@
+package risk
+
+import (
+	"fmt"
+	"math"
+)
+
+// maxScore is the score of a counterparty without negative history
+const maxScore = 100
+
+// Rules are the configurable scoring rules. Every penalty is deducted from maxScore.
+type Rules struct {
+	// LatePaymentWeight is deducted in full when every order with a due date was paid late
+	LatePaymentWeight float64 `json:"late_payment_weight"`
+	// DisputeWeight is deducted in full when every order was disputed
+	DisputeWeight float64 `json:"dispute_weight"`
+	// ChargebackPenalty is deducted per order with a refunded (charged back) payment
+	ChargebackPenalty float64 `json:"chargeback_penalty"`
+	// OverduePenalty is deducted per order currently past its due date and unpaid
+	OverduePenalty float64 `json:"overdue_penalty"`
+	// DunningPenalty is deducted per dunning step executed against the counterparty
+	DunningPenalty float64 `json:"dunning_penalty"`
+	// NetTermsMinScore is the minimum score for net-terms eligibility
+	NetTermsMinScore float64 `json:"net_terms_min_score"`
+	// NetTermsMinOrders is the minimum order history for net-terms eligibility
+	NetTermsMinOrders int `json:"net_terms_min_orders"`
+}
+
+// Inputs are the aggregated payment history of a counterparty
+type Inputs struct {
+	TotalOrders       int64 `json:"total_orders"`
+	OrdersWithDueDate int64 `json:"orders_with_due_date"`
+	PaidLate          int64 `json:"paid_late"`
+	OverdueUnpaid     int64 `json:"overdue_unpaid"`
+	Disputed          int64 `json:"disputed"`
+	Chargebacks       int64 `json:"chargebacks"`
+	DunningSteps      int64 `json:"dunning_steps"`
+}
+
+// Components are the points deducted per rule
+type Components struct {
+	LatePayment float64 `json:"late_payment"`
+	Dispute     float64 `json:"dispute"`
+	Chargeback  float64 `json:"chargeback"`
+	Overdue     float64 `json:"overdue"`
+	Dunning     float64 `json:"dunning"`
+}
+
+// Result is a computed score with its breakdown
+type Result struct {
+	Score            float64
+	Components       Components
+	NetTermsEligible bool
+}
+
+// Validate checks that weights and thresholds are within range
+func (r Rules) Validate() error {
+	weights := map[string]float64{
+		"late payment weight": r.LatePaymentWeight,
+		"dispute weight":      r.DisputeWeight,
+		"chargeback penalty":  r.ChargebackPenalty,
+		"overdue penalty":     r.OverduePenalty,
+		"dunning penalty":     r.DunningPenalty,
+	}
+	for name, weight := range weights {
+		if weight < 0 || weight > maxScore {
+			return fmt.Errorf("risk: %s must be between 0 and %d, got %v", name, maxScore, weight)
+		}
+	}
+	if r.NetTermsMinScore < 0 || r.NetTermsMinScore > maxScore {
+		return fmt.Errorf("risk: net terms min score must be between 0 and %d, got %v", maxScore, r.NetTermsMinScore)
+	}
+	if r.NetTermsMinOrders < 0 {
+		return fmt.Errorf("risk: net terms min orders must not be negative, got %d", r.NetTermsMinOrders)
+	}
+	return nil
+}
+
+// Score computes the counterparty score (0-100, higher is better)
+func (r Rules) Score(in Inputs) Result {
+	components := Components{
+		LatePayment: r.LatePaymentWeight * ratio(in.PaidLate, in.OrdersWithDueDate),
+		Dispute:     r.DisputeWeight * ratio(in.Disputed, in.TotalOrders),
+		Chargeback:  r.ChargebackPenalty * float64(in.Chargebacks),
+		Overdue:     r.OverduePenalty * float64(in.OverdueUnpaid),
+		Dunning:     r.DunningPenalty * float64(in.DunningSteps),
+	}
+
+	score := maxScore - components.LatePayment - components.Dispute -
+		components.Chargeback - components.Overdue - components.Dunning
+	score = math.Round(math.Max(0, score)*10) / 10
+
+	return Result{
+		Score:      score,
+		Components: components,
+		// 이력이 부족한 신규 거래처는 점수와 무관하게 선결제 (net terms 불가)
+		NetTermsEligible: in.TotalOrders >= int64(r.NetTermsMinOrders) && score >= r.NetTermsMinScore,
+	}
+}
+
+// ratio returns n/d, or 0 when there is no history
+func ratio(n, d int64) float64 {
+	if d == 0 {
+		return 0
+	}
+	return float64(n) / float64(d)
+}
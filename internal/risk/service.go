@@ -0,0 +1,111 @@
+package risk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"go.uber.org/zap"
+)
+
+// Audit log identifiers
+const (
+	actionScoreComputed = "RISK_SCORE_COMPUTED"
+	resourceUser        = "USER"
+)
+
+// Service computes counterparty risk scores
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	rules    Rules
+	logger   *zap.Logger
+}
+
+// NewService creates a new risk service
+func NewService(txRunner *pkgdb.TxRunner, rules Rules, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		rules:    rules,
+		logger:   logger,
+	}
+}
+
+// GetScore computes the score of a counterparty (buyer) from its payment punctuality,
+// dispute rate and chargeback history.
+// sellerID restricts access to counterparties the seller has traded with (0 = unrestricted, admins).
+// Every computation is audit-logged with its inputs and rules so net-terms decisions can be reconstructed.
+func (s *Service) GetScore(ctx context.Context, counterpartyExternalID string, sellerID uint64, actor audit.Actor) (*RiskScoreResponse, error) {
+	return pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*RiskScoreResponse, error) {
+		// 1. Resolve counterparty
+		counterparty, err := q.GetUserByExternalID(ctx, sql.NullString{String: counterpartyExternalID, Valid: true})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, errors.NotFound("Counterparty")
+			}
+			logctx.From(ctx, s.logger).Error("failed to get counterparty", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		// 2. Sellers only see counterparties they have traded with
+		// Why: 거래 관계 없는 판매자가 임의 사용자의 신용 정보를 조회하지 못하도록 (존재 여부도 숨김)
+		if sellerID != 0 {
+			traded, err := q.HasTradedWith(ctx, db.HasTradedWithParams{
+				BuyerID:  counterparty.ID,
+				SellerID: sellerID,
+			})
+			if err != nil {
+				logctx.From(ctx, s.logger).Error("failed to check trade relationship", zap.Error(err))
+				return nil, errors.DBError(err)
+			}
+			if !traded {
+				return nil, errors.NotFound("Counterparty")
+			}
+		}
+
+		// 3. Aggregate inputs and score
+		now := time.Now()
+		stats, err := q.GetCounterpartyPaymentStats(ctx, db.GetCounterpartyPaymentStatsParams{
+			Now:     sql.NullTime{Time: now, Valid: true},
+			BuyerID: counterparty.ID,
+		})
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to aggregate counterparty stats", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		inputs := Inputs{
+			TotalOrders:       stats.TotalOrders,
+			OrdersWithDueDate: stats.OrdersWithDueDate,
+			PaidLate:          stats.PaidLate,
+			OverdueUnpaid:     stats.OverdueUnpaid,
+			Disputed:          stats.Disputed,
+			Chargebacks:       stats.ChargedBack,
+			DunningSteps:      stats.DunningSteps,
+		}
+		result := s.rules.Score(inputs)
+
+		// 4. Audit (inputs + rules → 점수 재현 가능)
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionScoreComputed,
+			ResourceType: resourceUser,
+			ResourceID:   counterparty.ID,
+			NewValue: map[string]any{
+				"score":              result.Score,
+				"net_terms_eligible": result.NetTermsEligible,
+				"inputs":             inputs,
+				"components":         result.Components,
+				"rules":              s.rules,
+			},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		return ToRiskScoreResponse(counterpartyExternalID, inputs, s.rules, result, now), nil
+	})
+}
@@ -0,0 +1,120 @@
+package rma
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// LineRequest represents an order line to return
+type LineRequest struct {
+	LineNo   uint32 `json:"line_no" binding:"required,min=1" example:"1"` // order item position (same as the order invoice's line_no)
+	Quantity uint32 `json:"quantity" binding:"required,min=1" example:"5"`
+}
+
+// CreateRMARequest represents the request body for requesting a return
+type CreateRMARequest struct {
+	OrderNumber string        `json:"order_number" binding:"required,max=50" example:"ORD-20260902-0001"`
+	Reason      string        `json:"reason" binding:"required,min=1,max=1000" example:"5 units arrived damaged"`
+	Lines       []LineRequest `json:"lines" binding:"required,min=1,max=100,dive"`
+}
+
+// ApproveRMARequest represents the request body for approving a return
+type ApproveRMARequest struct {
+	Note string `json:"note,omitempty" binding:"max=1000" example:"Goods received in resellable condition"`
+	// Restock adds the returned quantities back to inventory (omitted = true; false for damaged goods)
+	Restock *bool `json:"restock,omitempty" example:"true"`
+	// Location is the inventory location restocked (omitted = default)
+	Location string `json:"location,omitempty" binding:"max=50" example:"default"`
+}
+
+// RejectRMARequest represents the request body for rejecting a return
+type RejectRMARequest struct {
+	Reason string `json:"reason" binding:"required,min=1,max=1000" example:"Return window for custom items does not apply"`
+}
+
+// ListRMAsRequest represents query parameters for listing the caller's returns
+type ListRMAsRequest struct {
+	Status   string `form:"status" binding:"omitempty,oneof=REQUESTED APPROVED REJECTED CANCELLED"`
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// LineResponse represents a returned order line
+type LineResponse struct {
+	LineNo      uint32      `json:"line_no" example:"1"`
+	SKU         string      `json:"sku" example:"SKU-WIDGET-001"`
+	ProductName string      `json:"product_name" example:"Industrial widget"`
+	Quantity    uint32      `json:"quantity" example:"5"`
+	UnitPrice   money.Money `json:"unit_price" swaggertype:"string" example:"125.00"`
+}
+
+// RMAResponse represents a return merchandise authorization.
+// refund_amount is the order total prorated over the returned lines (discounts and taxes included).
+type RMAResponse struct {
+	RMAID        string         `json:"rma_id" example:"ff1e8400-e29b-41d4-a716-446655440000"`
+	RMANumber    string         `json:"rma_number" example:"RMA-20260910-0001"`
+	OrderNumber  string         `json:"order_number" example:"ORD-20260902-0001"`
+	BuyerID      string         `json:"buyer_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	SellerID     string         `json:"seller_id" example:"550e8400-e29b-41d4-a716-446655440001"`
+	Status       string         `json:"status" example:"REQUESTED" enums:"REQUESTED,APPROVED,REJECTED,CANCELLED"`
+	Reason       string         `json:"reason" example:"5 units arrived damaged"`
+	TokenSymbol  string         `json:"token_symbol" example:"USDC"`
+	RefundAmount money.Money    `json:"refund_amount" swaggertype:"string" example:"625.00"`
+	PaymentID    string         `json:"payment_id,omitempty" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"` // refunded payment (approved only)
+	RefundID     string         `json:"refund_id,omitempty" example:"9b2e6679-7425-40de-944b-e07fc1f90ae7"`  // linked payment refund (approved only)
+	Restocked    bool           `json:"restocked" example:"true"`
+	DecisionNote string         `json:"decision_note,omitempty" example:"Goods received in resellable condition"`
+	Lines        []LineResponse `json:"lines,omitempty"`
+	DecidedAt    *time.Time     `json:"decided_at,omitempty"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+}
+
+// ListRMAsResponse represents paginated return list
+type ListRMAsResponse struct {
+	RMAs       []RMAResponse `json:"rmas"`
+	Total      int64         `json:"total" example:"3"`
+	Page       int           `json:"page" example:"1"`
+	PageSize   int           `json:"page_size" example:"20"`
+	TotalPages int           `json:"total_pages" example:"1"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToRMAResponse converts a return with its parties to its response
+func ToRMAResponse(r *db.GetRMADetailRow, refundAmount money.Money, lines []LineResponse) *RMAResponse {
+	response := &RMAResponse{
+		RMAID:        r.ExternalID,
+		RMANumber:    r.RmaNumber,
+		OrderNumber:  r.OrderNumber,
+		BuyerID:      r.BuyerExternalID.String,
+		SellerID:     r.SellerExternalID.String,
+		Status:       string(r.Status),
+		Reason:       r.Reason,
+		TokenSymbol:  r.TokenSymbol,
+		RefundAmount: refundAmount,
+		PaymentID:    r.PaymentExternalID.String,
+		RefundID:     r.RefundExternalID.String,
+		Restocked:    r.Restocked,
+		DecisionNote: r.DecisionNote.String,
+		Lines:        lines,
+		CreatedAt:    r.CreatedAt,
+		UpdatedAt:    r.UpdatedAt,
+	}
+	if r.DecidedAt.Valid {
+		response.DecidedAt = &r.DecidedAt.Time
+	}
+	return response
+}
@@ -0,0 +1,298 @@
+package rma
+
+import (
+	"io"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for returns
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new RMA handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers return routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	returns := rg.Group("/returns", middleware.RequireAuth())
+	{
+		returns.POST("", h.CreateRMA)
+		returns.GET("", h.ListRMAs)
+		returns.GET("/:rmaId", h.GetRMA)
+		returns.POST("/:rmaId/approve", h.ApproveRMA)
+		returns.POST("/:rmaId/reject", h.RejectRMA)
+		returns.POST("/:rmaId/cancel", h.CancelRMA)
+	}
+}
+
+// rmaAccess returns the caller's return access
+func rmaAccess(principal *middleware.Principal) Access {
+	return Access{
+		Admin:    principal.IsAdmin(),
+		CanActAs: principal.CanActAs,
+	}
+}
+
+// extractRMAID extracts and validates the return id from path
+func extractRMAID(c *gin.Context) (string, error) {
+	rmaID := c.Param("rmaId")
+	if _, err := uuid.Parse(rmaID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+	return rmaID, nil
+}
+
+// CreateRMA godoc
+// @Summary Request return
+// @Description Request a return of lines of a shipped or completed order (buyer or admin) within the return window after shipment.
+// @Description Lines are referenced by line_no (order item position); quantities may not exceed the ordered quantity minus quantities already requested or approved.
+// @Description refund_amount is the order total prorated over the returned lines, so discounts and taxes are returned proportionally. Audited.
+// @Tags returns
+// @Accept json
+// @Produce json
+// @Param request body CreateRMARequest true "Return request"
+// @Success 201 {object} middleware.SuccessResponse{data=RMAResponse} "Return requested"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input, quantity exceeds the returnable quantity or return window expired"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Order not found"
+// @Failure 409 {object} middleware.ErrorResponse "Order is not shipped or completed"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/returns [post]
+func (h *Handler) CreateRMA(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req CreateRMARequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.CreateRMA(c.Request.Context(), &req, rmaAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// ListRMAs godoc
+// @Summary List returns
+// @Description List the returns the caller requested as buyer or received as seller, newest first
+// @Tags returns
+// @Produce json
+// @Param status query string false "Status filter" Enums(REQUESTED, APPROVED, REJECTED, CANCELLED)
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} middleware.SuccessResponse{data=ListRMAsResponse} "Return list"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "No user bound to the credential"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/returns [get]
+func (h *Handler) ListRMAs(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	var req ListRMAsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	if principal.UserID == 0 {
+		middleware.RespondError(c, errors.Forbidden("No user bound to the credential"))
+		return
+	}
+
+	result, err := h.service.ListRMAs(c.Request.Context(), principal.UserID, &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// GetRMA godoc
+// @Summary Get return
+// @Description Get a return with its lines (buyer, seller or admin)
+// @Tags returns
+// @Produce json
+// @Param rmaId path string true "Return external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=RMAResponse} "Return"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid return ID"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Return not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/returns/{rmaId} [get]
+func (h *Handler) GetRMA(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	rmaID, err := extractRMAID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	result, err := h.service.GetRMA(c.Request.Context(), rmaID, rmaAccess(principal))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// ApproveRMA godoc
+// @Summary Approve return
+// @Description Approve a requested return (seller or admin). The returned quantities are restocked at the location (unless restock is false)
+// @Description and refund_amount is refunded on the order's captured payment as a linked partial refund (payment.refunded webhook).
+// @Description Payments already paid out to the seller are refunded on-chain and require an admin. Orders paid by invoice have no payment to refund - issue a credit note instead. Audited.
+// @Tags returns
+// @Accept json
+// @Produce json
+// @Param rmaId path string true "Return external ID (UUID)"
+// @Param request body ApproveRMARequest false "Approval"
+// @Success 200 {object} middleware.SuccessResponse{data=RMAResponse} "Return approved"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Only the seller can approve a return"
+// @Failure 404 {object} middleware.ErrorResponse "Return not found"
+// @Failure 409 {object} middleware.ErrorResponse "Return is already decided or order has no captured payment"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Failure 503 {object} middleware.ErrorResponse "Payments paused (settlement token depeg)"
+// @Security ApiKeyAuth
+// @Router /api/v1/returns/{rmaId}/approve [post]
+func (h *Handler) ApproveRMA(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	rmaID, err := extractRMAID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req ApproveRMARequest
+	// NOTE: 본문 생략 시 기본 위치로 재입고
+	if err := c.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ApproveRMA(c.Request.Context(), rmaID, &req, rmaAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// RejectRMA godoc
+// @Summary Reject return
+// @Description Reject a requested return with a reason (seller or admin). Audited.
+// @Tags returns
+// @Accept json
+// @Produce json
+// @Param rmaId path string true "Return external ID (UUID)"
+// @Param request body RejectRMARequest true "Rejection"
+// @Success 200 {object} middleware.SuccessResponse{data=RMAResponse} "Return rejected"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Only the seller can reject a return"
+// @Failure 404 {object} middleware.ErrorResponse "Return not found"
+// @Failure 409 {object} middleware.ErrorResponse "Return is already decided"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/returns/{rmaId}/reject [post]
+func (h *Handler) RejectRMA(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	rmaID, err := extractRMAID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req RejectRMARequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.RejectRMA(c.Request.Context(), rmaID, &req, rmaAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// CancelRMA godoc
+// @Summary Cancel return
+// @Description Withdraw a requested return (buyer or admin). Its quantities can be requested again. Audited.
+// @Tags returns
+// @Produce json
+// @Param rmaId path string true "Return external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=RMAResponse} "Return cancelled"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid return ID"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Only the buyer can cancel a return"
+// @Failure 404 {object} middleware.ErrorResponse "Return not found"
+// @Failure 409 {object} middleware.ErrorResponse "Return is already decided"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/returns/{rmaId}/cancel [post]
+func (h *Handler) CancelRMA(c *gin.Context) {
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	rmaID, err := extractRMAID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	result, err := h.service.CancelRMA(c.Request.Context(), rmaID, rmaAccess(principal), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
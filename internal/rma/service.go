@@ -0,0 +1,633 @@
+package rma
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/catalog"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/docnumber"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/payment"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// amountScale is the scale of order and return amounts (DECIMAL(18,2))
+const amountScale = 2
+
+// defaultLocation is the inventory location restocked when the approval names none
+const defaultLocation = "default"
+
+// Inventory log reference of restocked returns
+const (
+	referenceRMA   = "RMA"
+	reasonReturned = "RETURNED"
+)
+
+// Audit resource and actions of returns
+const (
+	resourceRMA     = "RMA"
+	actionRequested = "RMA_REQUESTED"
+	actionApproved  = "RMA_APPROVED"
+	actionRejected  = "RMA_REJECTED"
+	actionCancelled = "RMA_CANCELLED"
+)
+
+// Config holds return settings
+type Config struct {
+	// Window is how long after the order was shipped (last status change) the buyer may request a return
+	Window time.Duration
+}
+
+// Access is the caller's access to returns
+type Access struct {
+	// Admin may view and decide any return
+	Admin bool
+	// CanActAs reports whether the caller may operate on the user's resources (buyer/seller external ID)
+	CanActAs func(userExternalID string) bool
+}
+
+// canView reports whether the caller is the buyer, the seller or an admin
+func (a Access) canView(buyerID, sellerID string) bool {
+	return a.Admin || a.CanActAs(buyerID) || a.CanActAs(sellerID)
+}
+
+// Service handles return merchandise authorizations (RMA).
+// The buyer requests a return of delivered order lines; the seller approves it - restocking the
+// returned quantities and refunding the prorated amount on the order's payment - or rejects it.
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	payments *payment.Service
+	config   Config
+	logger   *zap.Logger
+}
+
+// NewService creates a new RMA service
+func NewService(txRunner *pkgdb.TxRunner, payments *payment.Service, config Config, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		payments: payments,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// CreateRMA requests a return of lines of a shipped or completed order within the return window (buyer or admin).
+//
+// Why:
+//   - 주문 row-lock 하에서 항목별 반품 가능 수량(주문 수량 - 진행 중/승인된 반품) 검증 → 동시 요청이 주문 수량을 넘지 않음
+//   - 환불 금액 = 주문 총액 × (반품 항목 금액 / 주문 항목 합계), 내림 → 할인·세금이 비례 반영되고 반품 합계가 주문 총액을 넘지 않음
+func (s *Service) CreateRMA(ctx context.Context, req *CreateRMARequest, access Access, actor audit.Actor) (*RMAResponse, error) {
+	// 1. Validate
+	q := s.txRunner.Queries()
+	order, err := q.GetOrderByOrderNumber(ctx, req.OrderNumber)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Order")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get order", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	// 구매자(또는 관리자)가 아니면 주문 존재 여부도 노출하지 않음
+	buyer, err := q.GetUserByID(ctx, order.BuyerID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to get buyer", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if !access.CanActAs(buyer.ExternalID.String) {
+		return nil, errors.NotFound("Order")
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, errors.InvalidInput("reason must not be blank")
+	}
+
+	// 2. Create RMA + lines (one transaction)
+	externalID := uuid.New().String()
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		locked, err := q.GetOrderByIDForUpdate(ctx, order.ID)
+		if err != nil {
+			return err
+		}
+		if locked.Status != db.OrdersStatusSHIPPED && locked.Status != db.OrdersStatusCOMPLETED {
+			return errors.Conflict("Only shipped or completed orders can be returned")
+		}
+		if deadline := locked.StatusChangedAt.Add(s.config.Window); time.Now().After(deadline) {
+			return errors.InvalidInput("Return window has expired").
+				WithDetails(map[string]any{"return_deadline": deadline.UTC()})
+		}
+
+		orderLines, err := q.ListOrderLinesForReturn(ctx, locked.ID)
+		if err != nil {
+			return err
+		}
+		lines, err := s.returnLines(ctx, q, locked.ID, orderLines, req.Lines)
+		if err != nil {
+			return err
+		}
+		refundAmount, err := s.refundAmount(ctx, &locked, orderLines, lines)
+		if err != nil {
+			return err
+		}
+
+		rmaNumber, err := docnumber.Next(ctx, q, docnumber.PrefixRMA, time.Now())
+		if err != nil {
+			return err
+		}
+		result, err := q.CreateRMA(ctx, db.CreateRMAParams{
+			ExternalID:   externalID,
+			RmaNumber:    rmaNumber,
+			OrderID:      locked.ID,
+			BuyerID:      locked.BuyerID,
+			SellerID:     locked.SellerID,
+			Reason:       reason,
+			RefundAmount: refundAmount.String(),
+		})
+		if err != nil {
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		for _, line := range lines {
+			if err := q.CreateRMALine(ctx, db.CreateRMALineParams{
+				RmaID:     uint64(id),
+				LineNo:    line.LineNo,
+				ProductID: line.ProductID,
+				Quantity:  line.Quantity,
+				UnitPrice: line.UnitPrice,
+			}); err != nil {
+				return err
+			}
+		}
+
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionRequested,
+			ResourceType: resourceRMA,
+			ResourceID:   uint64(id),
+			NewValue: map[string]any{
+				"rma_number":    rmaNumber,
+				"order_number":  locked.OrderNumber,
+				"refund_amount": refundAmount.String(),
+				"lines":         len(lines),
+			},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to create rma", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetRMA(ctx, externalID, access)
+}
+
+// GetRMA returns a return with its lines (buyer, seller or admin)
+func (s *Service) GetRMA(ctx context.Context, externalID string, access Access) (*RMAResponse, error) {
+	q := s.txRunner.Queries()
+	detail, err := s.getDetail(ctx, q, externalID, access)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := q.ListRMALines(ctx, detail.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list rma lines", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	lines := make([]LineResponse, 0, len(rows))
+	for _, row := range rows {
+		unitPrice, err := s.parseStoredAmount(ctx, row.UnitPrice)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, LineResponse{
+			LineNo:      row.LineNo,
+			SKU:         row.Sku,
+			ProductName: row.ProductName,
+			Quantity:    row.Quantity,
+			UnitPrice:   unitPrice,
+		})
+	}
+	return s.toResponse(ctx, detail, lines)
+}
+
+// ListRMAs returns the returns the user requested or received, newest first
+func (s *Service) ListRMAs(ctx context.Context, userID uint64, req *ListRMAsRequest) (*ListRMAsResponse, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	var status db.NullRmasStatus
+	if req.Status != "" {
+		status = db.NullRmasStatus{RmasStatus: db.RmasStatus(req.Status), Valid: true}
+	}
+
+	q := s.txRunner.Queries()
+	rows, err := q.ListRMAsByUser(ctx, db.ListRMAsByUserParams{
+		UserID: userID,
+		Status: status,
+		Limit:  int32(req.PageSize),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list rmas", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := q.CountRMAsByUser(ctx, db.CountRMAsByUserParams{
+		UserID: userID,
+		Status: status,
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count rmas", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	rmas := make([]RMAResponse, 0, len(rows))
+	for i := range rows {
+		detail := db.GetRMADetailRow(rows[i])
+		response, err := s.toResponse(ctx, &detail, nil)
+		if err != nil {
+			return nil, err
+		}
+		rmas = append(rmas, *response)
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListRMAsResponse{
+		RMAs:       rmas,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// ApproveRMA approves a requested return (seller or admin): the returned quantities are restocked and
+// the refund amount is refunded on the order's captured payment through the payment refund flow.
+//
+// Why:
+//   - 반품 승인·재입고를 환불 예약 트랜잭션(RefundHook) 안에서 처리 → 환불 실패 시 승인·재입고도 롤백
+//   - 판매자 지급 이후 결제는 ON_CHAIN 환불 → 관리자만 승인 가능 (결제 환불 정책과 동일)
+//   - 재입고는 상품 id 순으로 재고 row-lock (동시 예약/해제 간 deadlock 방지), 재고 행이 없는 상품은 재고 미관리로 보고 건너뜀
+func (s *Service) ApproveRMA(ctx context.Context, externalID string, req *ApproveRMARequest, access Access, actor audit.Actor) (*RMAResponse, error) {
+	q := s.txRunner.Queries()
+	detail, err := s.getDetail(ctx, q, externalID, access)
+	if err != nil {
+		return nil, err
+	}
+	if !access.Admin && !access.CanActAs(detail.SellerExternalID.String) {
+		return nil, errors.Forbidden("Only the seller can approve a return")
+	}
+	if detail.Status != db.RmasStatusREQUESTED {
+		return nil, errors.Conflict("Return is already " + string(detail.Status))
+	}
+	refundAmount, err := s.parseStoredAmount(ctx, detail.RefundAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	restock := req.Restock == nil || *req.Restock
+	location := strings.TrimSpace(req.Location)
+	if location == "" {
+		location = defaultLocation
+	}
+	note := strings.TrimSpace(req.Note)
+	approve := func(ctx context.Context, q *db.Queries, paymentID, refundID sql.NullInt64) error {
+		return s.approve(ctx, q, detail, paymentID, refundID, restock, location, note, actor)
+	}
+
+	if refundAmount.Sign() == 0 {
+		// NOTE: 무상 항목만 반품 → 환불 없이 승인·재입고만 처리
+		err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+			return approve(ctx, q, sql.NullInt64{}, sql.NullInt64{})
+		})
+	} else {
+		var captured db.Payment
+		captured, err = q.GetCapturedPaymentByOrder(ctx, detail.OrderID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				// 외상 주문(청구서 결제)은 청구서 대변 메모(RETURN)로 정산
+				return nil, errors.Conflict("Order has no captured payment to refund - issue a credit note on its invoice instead")
+			}
+			logctx.From(ctx, s.logger).Error("failed to get order payment", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		refundReq := &payment.CreateRefundRequest{
+			Amount: refundAmount.String(),
+			Reason: "Return " + detail.RmaNumber,
+		}
+		_, err = s.payments.CreateRefundWithHook(ctx, captured.ExternalID.String, refundReq, payment.RefundAccess(access), actor,
+			func(ctx context.Context, q *db.Queries, refund *db.PaymentRefund) error {
+				return approve(ctx, q,
+					sql.NullInt64{Int64: int64(captured.ID), Valid: true},
+					sql.NullInt64{Int64: int64(refund.ID), Valid: true})
+			})
+	}
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to approve rma", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("rma approved",
+		zap.String("rma_number", detail.RmaNumber),
+		zap.Stringer("refund_amount", refundAmount),
+	)
+	return s.GetRMA(ctx, externalID, access)
+}
+
+// RejectRMA rejects a requested return with a reason (seller or admin)
+func (s *Service) RejectRMA(ctx context.Context, externalID string, req *RejectRMARequest, access Access, actor audit.Actor) (*RMAResponse, error) {
+	detail, err := s.getDetail(ctx, s.txRunner.Queries(), externalID, access)
+	if err != nil {
+		return nil, err
+	}
+	if !access.Admin && !access.CanActAs(detail.SellerExternalID.String) {
+		return nil, errors.Forbidden("Only the seller can reject a return")
+	}
+	if err := s.close(ctx, detail, db.RmasStatusREJECTED, actionRejected, strings.TrimSpace(req.Reason), actor); err != nil {
+		return nil, err
+	}
+	return s.GetRMA(ctx, externalID, access)
+}
+
+// CancelRMA withdraws a requested return (buyer or admin); its quantities can be requested again
+func (s *Service) CancelRMA(ctx context.Context, externalID string, access Access, actor audit.Actor) (*RMAResponse, error) {
+	detail, err := s.getDetail(ctx, s.txRunner.Queries(), externalID, access)
+	if err != nil {
+		return nil, err
+	}
+	if !access.Admin && !access.CanActAs(detail.BuyerExternalID.String) {
+		return nil, errors.Forbidden("Only the buyer can cancel a return")
+	}
+	if err := s.close(ctx, detail, db.RmasStatusCANCELLED, actionCancelled, "", actor); err != nil {
+		return nil, err
+	}
+	return s.GetRMA(ctx, externalID, access)
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// approve marks the return approved and restocks it (within the refund's transaction)
+func (s *Service) approve(ctx context.Context, q *db.Queries, detail *db.GetRMADetailRow, paymentID, refundID sql.NullInt64, restock bool, location, note string, actor audit.Actor) error {
+	rma, err := q.GetRMAForUpdate(ctx, detail.ID)
+	if err != nil {
+		return err
+	}
+	if rma.Status != db.RmasStatusREQUESTED {
+		return errors.Conflict("Return is already " + string(rma.Status))
+	}
+
+	var restocked int64
+	if restock {
+		restocked, err = restockLines(ctx, q, &rma, location, actor)
+		if err != nil {
+			return err
+		}
+	}
+
+	n, err := q.ApproveRMA(ctx, db.ApproveRMAParams{
+		PaymentID:    paymentID,
+		RefundID:     refundID,
+		Restocked:    restocked > 0,
+		DecisionNote: sql.NullString{String: note, Valid: note != ""},
+		DecidedBy:    sql.NullInt64{Int64: int64(actor.ID), Valid: actor.ID != 0},
+		ID:           rma.ID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.Conflict("Return is already decided")
+	}
+	return audit.Record(ctx, q, actor, audit.Entry{
+		Action:       actionApproved,
+		ResourceType: resourceRMA,
+		ResourceID:   rma.ID,
+		OldValue:     map[string]any{"status": string(rma.Status)},
+		NewValue: map[string]any{
+			"status":             string(db.RmasStatusAPPROVED),
+			"refund_amount":      rma.RefundAmount,
+			"restocked_quantity": restocked,
+			"location":           location,
+		},
+	})
+}
+
+// close rejects or cancels a requested return
+func (s *Service) close(ctx context.Context, detail *db.GetRMADetailRow, status db.RmasStatus, action, note string, actor audit.Actor) error {
+	err := s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		rma, err := q.GetRMAForUpdate(ctx, detail.ID)
+		if err != nil {
+			return err
+		}
+		if rma.Status != db.RmasStatusREQUESTED {
+			return errors.Conflict("Return is already " + string(rma.Status))
+		}
+		if _, err := q.CloseRMA(ctx, db.CloseRMAParams{
+			Status:       status,
+			DecisionNote: sql.NullString{String: note, Valid: note != ""},
+			DecidedBy:    sql.NullInt64{Int64: int64(actor.ID), Valid: actor.ID != 0},
+			ID:           rma.ID,
+		}); err != nil {
+			return err
+		}
+		newValue := map[string]any{"status": string(status)}
+		if note != "" {
+			newValue["reason"] = note
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       action,
+			ResourceType: resourceRMA,
+			ResourceID:   rma.ID,
+			OldValue:     map[string]any{"status": string(rma.Status)},
+			NewValue:     newValue,
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to close rma", zap.String("status", string(status)), zap.Error(err))
+		return errors.DBError(err)
+	}
+	return nil
+}
+
+// returnLines validates the requested lines against the order's lines and the quantities already
+// being returned (order locked); returns the lines with their product and unit price
+func (s *Service) returnLines(ctx context.Context, q *db.Queries, orderID uint64, orderLines []db.ListOrderLinesForReturnRow, requested []LineRequest) ([]db.CreateRMALineParams, error) {
+	returned, err := q.ListReturnedQuantities(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	returnedByLine := make(map[uint32]int64, len(returned))
+	for _, r := range returned {
+		returnedByLine[r.LineNo] = r.Quantity
+	}
+
+	lines := make([]db.CreateRMALineParams, 0, len(requested))
+	seen := make(map[uint32]bool, len(requested))
+	for _, r := range requested {
+		if seen[r.LineNo] {
+			return nil, errors.InvalidInput(fmt.Sprintf("duplicate line_no %d", r.LineNo))
+		}
+		seen[r.LineNo] = true
+		if int(r.LineNo) > len(orderLines) {
+			return nil, errors.InvalidInput(fmt.Sprintf("order has no line %d", r.LineNo))
+		}
+		line := orderLines[r.LineNo-1]
+		if returnable := int64(line.Quantity) - returnedByLine[r.LineNo]; int64(r.Quantity) > returnable {
+			return nil, errors.InvalidInput(fmt.Sprintf("quantity of line %d exceeds the returnable quantity", r.LineNo)).
+				WithDetails(map[string]any{"line_no": r.LineNo, "returnable_quantity": max(returnable, 0)})
+		}
+		lines = append(lines, db.CreateRMALineParams{
+			LineNo:    r.LineNo,
+			ProductID: line.ProductID,
+			Quantity:  r.Quantity,
+			UnitPrice: line.UnitPrice,
+		})
+	}
+	return lines, nil
+}
+
+// refundAmount prorates the order total over the returned lines' share of the order's item subtotal
+func (s *Service) refundAmount(ctx context.Context, order *db.Order, orderLines []db.ListOrderLinesForReturnRow, lines []db.CreateRMALineParams) (money.Money, error) {
+	subtotal := money.Zero("", amountScale)
+	for _, line := range orderLines {
+		unitPrice, err := s.parseStoredAmount(ctx, line.UnitPrice)
+		if err != nil {
+			return money.Money{}, err
+		}
+		subtotal = subtotal.Add(lineTotal(unitPrice, line.Quantity))
+	}
+	returned := money.Zero("", amountScale)
+	for _, line := range lines {
+		unitPrice, err := s.parseStoredAmount(ctx, line.UnitPrice)
+		if err != nil {
+			return money.Money{}, err
+		}
+		returned = returned.Add(lineTotal(unitPrice, line.Quantity))
+	}
+	total, err := s.parseStoredAmount(ctx, order.TotalAmount)
+	if err != nil {
+		return money.Money{}, err
+	}
+	if subtotal.Sign() <= 0 {
+		return money.Zero("", amountScale), nil
+	}
+	return total.MulFrac(returned.Units().Int64(), subtotal.Units().Int64(), money.RoundDown), nil
+}
+
+// getDetail retrieves a return with its parties if the caller may see it
+func (s *Service) getDetail(ctx context.Context, q *db.Queries, externalID string, access Access) (*db.GetRMADetailRow, error) {
+	detail, err := q.GetRMADetail(ctx, externalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Return")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get rma", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if !access.canView(detail.BuyerExternalID.String, detail.SellerExternalID.String) {
+		return nil, errors.NotFound("Return")
+	}
+	return &detail, nil
+}
+
+// toResponse parses the stored refund amount and converts the return
+func (s *Service) toResponse(ctx context.Context, detail *db.GetRMADetailRow, lines []LineResponse) (*RMAResponse, error) {
+	refundAmount, err := s.parseStoredAmount(ctx, detail.RefundAmount)
+	if err != nil {
+		return nil, err
+	}
+	return ToRMAResponse(detail, refundAmount, lines), nil
+}
+
+// parseStoredAmount parses a DECIMAL(18,2) column
+func (s *Service) parseStoredAmount(ctx context.Context, value string) (money.Money, error) {
+	amount, err := money.Parse(value, "", amountScale)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("invalid stored amount", zap.String("amount", value), zap.Error(err))
+		return money.Money{}, errors.Internal("Invalid stored amount")
+	}
+	return amount, nil
+}
+
+// restockLines adds the returned quantities back to the products' inventory at the location and
+// records INBOUND logs and RETURN stock movements; returns the total quantity restocked. Must be called within a transaction.
+func restockLines(ctx context.Context, q *db.Queries, rma *db.Rma, location string, actor audit.Actor) (int64, error) {
+	lines, err := q.ListRMALines(ctx, rma.ID)
+	if err != nil {
+		return 0, fmt.Errorf("list rma lines: %w", err)
+	}
+	quantities := make(map[uint64]int64, len(lines))
+	for _, line := range lines {
+		quantities[line.ProductID] += int64(line.Quantity)
+	}
+	productIDs := make([]uint64, 0, len(quantities))
+	for productID := range quantities {
+		productIDs = append(productIDs, productID)
+	}
+	slices.Sort(productIDs)
+
+	var total int64
+	for _, productID := range productIDs {
+		inventory, err := q.GetInventoryByProductForUpdate(ctx, db.GetInventoryByProductForUpdateParams{
+			ProductID: productID,
+			Location:  location,
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				continue
+			}
+			return 0, fmt.Errorf("lock inventory of product %d: %w", productID, err)
+		}
+		quantity := quantities[productID]
+		after := inventory.Quantity + quantity
+		if err := q.UpdateInventoryQuantity(ctx, db.UpdateInventoryQuantityParams{
+			Quantity: after,
+			ID:       inventory.ID,
+		}); err != nil {
+			return 0, fmt.Errorf("update inventory %d quantity: %w", inventory.ID, err)
+		}
+		if err := catalog.RecordMovement(ctx, q, actor, catalog.Movement{
+			Inventory:     &inventory,
+			EventType:     db.InventoryLogsEventTypeINBOUND,
+			Reason:        db.StockMovementsReasonRETURN,
+			QuantityDelta: quantity,
+			ReferenceType: referenceRMA,
+			ReferenceID:   rma.ID,
+			Note:          reasonReturned,
+		}); err != nil {
+			return 0, err
+		}
+		total += quantity
+	}
+	return total, nil
+}
+
+// lineTotal returns unit price × quantity
+func lineTotal(unitPrice money.Money, quantity uint32) money.Money {
+	return unitPrice.MulFrac(int64(quantity), 1, money.RoundDown)
+}
@@ -0,0 +1,81 @@
+package rollout
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// SetFeatureStatusRequest represents the request body for setting a feature's rollout status
+type SetFeatureStatusRequest struct {
+	Status string `json:"status" binding:"required,oneof=PILOT GA" example:"GA"`
+}
+
+// AddAllowlistEntryRequest represents the request body for adding a pilot user
+type AddAllowlistEntryRequest struct {
+	UserID string `json:"user_id" binding:"required,uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// FeatureResponse represents a gated feature's rollout status in API responses
+type FeatureResponse struct {
+	Feature   string     `json:"feature" example:"settlements"`
+	Status    string     `json:"status" example:"PILOT"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// ListFeaturesResponse represents the gated feature list
+type ListFeaturesResponse struct {
+	Features []FeatureResponse `json:"features"`
+}
+
+// AllowlistEntryResponse represents a pilot user of a feature
+type AllowlistEntryResponse struct {
+	UserID    string     `json:"user_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserEmail string     `json:"user_email" example:"pilot@example.com"`
+	AddedAt   *time.Time `json:"added_at,omitempty"`
+}
+
+// ListAllowlistResponse represents a feature's pilot allowlist
+type ListAllowlistResponse struct {
+	Feature string                   `json:"feature" example:"settlements"`
+	Entries []AllowlistEntryResponse `json:"entries"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToFeatureResponse converts a feature and its rollout row (nil when never set → PILOT) to FeatureResponse
+func ToFeatureResponse(feature string, rollout *db.FeatureRollout) *FeatureResponse {
+	response := &FeatureResponse{
+		Feature: feature,
+		Status:  string(db.FeatureRolloutsStatusPILOT),
+	}
+	if rollout != nil {
+		response.Status = string(rollout.Status)
+		response.UpdatedAt = &rollout.UpdatedAt
+	}
+	return response
+}
+
+// ToAllowlistEntryResponseList converts allowlist rows to []AllowlistEntryResponse
+func ToAllowlistEntryResponseList(entries []db.ListFeatureAllowlistRow) []AllowlistEntryResponse {
+	responses := make([]AllowlistEntryResponse, 0, len(entries))
+	for _, entry := range entries {
+		addedAt := entry.CreatedAt
+		responses = append(responses, AllowlistEntryResponse{
+			UserID:    entry.UserExternalID.String,
+			UserEmail: entry.UserEmail,
+			AddedAt:   &addedAt,
+		})
+	}
+	return responses
+}
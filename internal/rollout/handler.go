@@ -0,0 +1,175 @@
+package rollout
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for soft launch rollouts
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new rollout handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers rollout routes on the router group (admins only)
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	features := rg.Group("/admin/features", middleware.RequireRoles(middleware.RoleAdmin))
+	{
+		features.GET("", h.ListFeatures)
+		features.PUT("/:feature/status", h.SetStatus)
+		features.GET("/:feature/allowlist", h.ListAllowlist)
+		features.POST("/:feature/allowlist", h.AddToAllowlist)
+		features.DELETE("/:feature/allowlist/:userId", h.RemoveFromAllowlist)
+	}
+}
+
+// ListFeatures godoc
+// @Summary List gated features
+// @Description Get the rollout status of every soft-launched feature - Admins only.
+// @Description PILOT features are open to allowlisted users only, GA features to everyone. Features never set are PILOT.
+// @Tags feature-rollouts
+// @Produce json
+// @Success 200 {object} middleware.SuccessResponse{data=ListFeaturesResponse} "Gated features"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/features [get]
+func (h *Handler) ListFeatures(c *gin.Context) {
+	result, err := h.service.ListFeatures(c.Request.Context())
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// SetStatus godoc
+// @Summary Set a feature's rollout status
+// @Description Move a feature between PILOT (allowlisted users only) and GA (everyone) - Admins only. Audited.
+// @Tags feature-rollouts
+// @Accept json
+// @Produce json
+// @Param feature path string true "Feature name" Enums(settlements)
+// @Param request body SetFeatureStatusRequest true "Rollout status"
+// @Success 200 {object} middleware.SuccessResponse{data=FeatureResponse} "Rollout status set"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Feature not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/features/{feature}/status [put]
+func (h *Handler) SetStatus(c *gin.Context) {
+	var req SetFeatureStatusRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.SetStatus(c.Request.Context(), c.Param("feature"), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// ListAllowlist godoc
+// @Summary List a feature's pilot users
+// @Description Get the users allowed to use a PILOT feature (oldest first) - Admins only
+// @Tags feature-rollouts
+// @Produce json
+// @Param feature path string true "Feature name" Enums(settlements)
+// @Success 200 {object} middleware.SuccessResponse{data=ListAllowlistResponse} "Pilot allowlist"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Feature not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/features/{feature}/allowlist [get]
+func (h *Handler) ListAllowlist(c *gin.Context) {
+	result, err := h.service.ListAllowlist(c.Request.Context(), c.Param("feature"))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// AddToAllowlist godoc
+// @Summary Add a pilot user
+// @Description Allow a user to use a PILOT feature - Admins only. Audited.
+// @Tags feature-rollouts
+// @Accept json
+// @Produce json
+// @Param feature path string true "Feature name" Enums(settlements)
+// @Param request body AddAllowlistEntryRequest true "Pilot user"
+// @Success 201 {object} middleware.SuccessResponse{data=AllowlistEntryResponse} "Pilot user added"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Feature or user not found"
+// @Failure 409 {object} middleware.ErrorResponse "User is already on the feature allowlist"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/features/{feature}/allowlist [post]
+func (h *Handler) AddToAllowlist(c *gin.Context) {
+	var req AddAllowlistEntryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.AddToAllowlist(c.Request.Context(), c.Param("feature"), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// RemoveFromAllowlist godoc
+// @Summary Remove a pilot user
+// @Description Revoke a user's access to a PILOT feature - Admins only. Audited.
+// @Tags feature-rollouts
+// @Param feature path string true "Feature name" Enums(settlements)
+// @Param userId path string true "User ID (UUID)"
+// @Success 204 "Pilot user removed"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Feature, user or allowlist entry not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/features/{feature}/allowlist/{userId} [delete]
+func (h *Handler) RemoveFromAllowlist(c *gin.Context) {
+	userID := c.Param("userId")
+	if _, err := uuid.Parse(userID); err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Invalid UUID format"))
+		return
+	}
+
+	if err := h.service.RemoveFromAllowlist(c.Request.Context(), c.Param("feature"), userID, audit.ActorFromContext(c)); err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondNoContent(c)
+}
@@ -0,0 +1,311 @@
+package rollout
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/go-sql-driver/mysql"
+	"go.uber.org/zap"
+)
+
+// Soft-launched features (gated route groups)
+const (
+	FeatureSettlements = "settlements"
+)
+
+// features lists the features that can be gated.
+// 새 라우트 그룹을 파일럿으로 출시할 때 여기에 추가하고 RequireFeature로 감싼다
+var features = []string{
+	FeatureSettlements,
+}
+
+// MySQL error codes
+const (
+	mysqlErrDuplicateEntry = 1062
+)
+
+// Audit log identifiers
+const (
+	actionSetStatus       = "FEATURE_ROLLOUT_STATUS_SET"
+	actionAllowlistAdd    = "FEATURE_ALLOWLIST_ADDED"
+	actionAllowlistRemove = "FEATURE_ALLOWLIST_REMOVED"
+	resourceRollout       = "FEATURE_ROLLOUT"
+	resourceUser          = "USER"
+)
+
+// Compile-time interface compliance check
+var _ middleware.FeatureGate = (*Service)(nil)
+
+// Service manages soft launch rollouts: per-feature status and pilot allowlists
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	logger   *zap.Logger
+}
+
+// NewService creates a new rollout service
+func NewService(txRunner *pkgdb.TxRunner, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		logger:   logger,
+	}
+}
+
+// IsKnownFeature reports whether the feature can be gated
+func IsKnownFeature(feature string) bool {
+	for _, f := range features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFeatureEnabled reports whether the feature is open to the user:
+// GA features are open to everyone, PILOT features (the default) only to allowlisted users.
+func (s *Service) IsFeatureEnabled(ctx context.Context, feature string, userID uint64) (bool, error) {
+	status, err := s.status(ctx, s.txRunner.Queries(), feature)
+	if err != nil {
+		return false, err
+	}
+	if status == db.FeatureRolloutsStatusGA {
+		return true, nil
+	}
+
+	allowed, err := s.txRunner.Queries().ExistsFeatureAllowlistEntry(ctx, db.ExistsFeatureAllowlistEntryParams{
+		Feature: feature,
+		UserID:  userID,
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to check feature allowlist",
+			zap.String("feature", feature),
+			zap.Error(err),
+		)
+		return false, errors.DBError(err)
+	}
+	return allowed, nil
+}
+
+// ListFeatures returns the rollout status of every gated feature
+func (s *Service) ListFeatures(ctx context.Context) (*ListFeaturesResponse, error) {
+	rollouts, err := s.txRunner.Queries().ListFeatureRollouts(ctx)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list feature rollouts", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	byFeature := make(map[string]*db.FeatureRollout, len(rollouts))
+	for i := range rollouts {
+		byFeature[rollouts[i].Feature] = &rollouts[i]
+	}
+
+	responses := make([]FeatureResponse, 0, len(features))
+	for _, feature := range features {
+		responses = append(responses, *ToFeatureResponse(feature, byFeature[feature]))
+	}
+	return &ListFeaturesResponse{Features: responses}, nil
+}
+
+// SetStatus sets the rollout status of a feature (PILOT → allowlist only, GA → everyone)
+func (s *Service) SetStatus(ctx context.Context, feature string, req *SetFeatureStatusRequest, actor audit.Actor) (*FeatureResponse, error) {
+	if !IsKnownFeature(feature) {
+		return nil, errors.NotFound("Feature")
+	}
+
+	return pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*FeatureResponse, error) {
+		// 1. Current status (audit old value)
+		previous, err := s.status(ctx, q, feature)
+		if err != nil {
+			return nil, err
+		}
+
+		// 2. Upsert
+		if err := q.UpsertFeatureRollout(ctx, db.UpsertFeatureRolloutParams{
+			Feature:   feature,
+			Status:    db.FeatureRolloutsStatus(req.Status),
+			UpdatedBy: sql.NullInt64{Int64: int64(actor.ID), Valid: actor.ID != 0},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to set feature rollout status", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		rollout, err := q.GetFeatureRollout(ctx, feature)
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+
+		// 3. Audit (same transaction)
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionSetStatus,
+			ResourceType: resourceRollout,
+			ResourceID:   rollout.ID,
+			OldValue:     map[string]any{"feature": feature, "status": string(previous)},
+			NewValue:     map[string]any{"feature": feature, "status": req.Status},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		logctx.From(ctx, s.logger).Info("feature rollout status set",
+			zap.String("feature", feature),
+			zap.String("status", req.Status),
+			zap.String("request_id", actor.RequestID),
+		)
+
+		return ToFeatureResponse(feature, &rollout), nil
+	})
+}
+
+// ListAllowlist returns the pilot users of a feature (oldest first)
+func (s *Service) ListAllowlist(ctx context.Context, feature string) (*ListAllowlistResponse, error) {
+	if !IsKnownFeature(feature) {
+		return nil, errors.NotFound("Feature")
+	}
+
+	entries, err := s.txRunner.Queries().ListFeatureAllowlist(ctx, feature)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list feature allowlist", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &ListAllowlistResponse{
+		Feature: feature,
+		Entries: ToAllowlistEntryResponseList(entries),
+	}, nil
+}
+
+// AddToAllowlist adds a user to the feature's pilot allowlist
+func (s *Service) AddToAllowlist(ctx context.Context, feature string, req *AddAllowlistEntryRequest, actor audit.Actor) (*AllowlistEntryResponse, error) {
+	if !IsKnownFeature(feature) {
+		return nil, errors.NotFound("Feature")
+	}
+
+	return pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*AllowlistEntryResponse, error) {
+		// 1. Resolve user
+		user, err := s.getUser(ctx, q, req.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		// 2. Insert (uk_feature_allowlist_user → 기능당 사용자 1건)
+		if _, err := q.CreateFeatureAllowlistEntry(ctx, db.CreateFeatureAllowlistEntryParams{
+			Feature: feature,
+			UserID:  user.ID,
+			AddedBy: actor.ID,
+		}); err != nil {
+			if isDuplicateKeyError(err) {
+				return nil, errors.Conflict("User is already on the feature allowlist")
+			}
+			logctx.From(ctx, s.logger).Error("failed to add feature allowlist entry", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		// 3. Audit (same transaction)
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionAllowlistAdd,
+			ResourceType: resourceUser,
+			ResourceID:   user.ID,
+			NewValue:     map[string]any{"feature": feature},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		logctx.From(ctx, s.logger).Info("user added to feature allowlist",
+			zap.String("feature", feature),
+			zap.String("user_external_id", req.UserID),
+			zap.String("request_id", actor.RequestID),
+		)
+
+		return &AllowlistEntryResponse{
+			UserID:    req.UserID,
+			UserEmail: user.Email,
+		}, nil
+	})
+}
+
+// RemoveFromAllowlist removes a user from the feature's pilot allowlist
+func (s *Service) RemoveFromAllowlist(ctx context.Context, feature, userExternalID string, actor audit.Actor) error {
+	if !IsKnownFeature(feature) {
+		return errors.NotFound("Feature")
+	}
+
+	return s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		user, err := s.getUser(ctx, q, userExternalID)
+		if err != nil {
+			return err
+		}
+
+		rows, err := q.DeleteFeatureAllowlistEntry(ctx, db.DeleteFeatureAllowlistEntryParams{
+			Feature: feature,
+			UserID:  user.ID,
+		})
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to remove feature allowlist entry", zap.Error(err))
+			return errors.DBError(err)
+		}
+		if rows == 0 {
+			return errors.NotFound("Allowlist entry")
+		}
+
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionAllowlistRemove,
+			ResourceType: resourceUser,
+			ResourceID:   user.ID,
+			OldValue:     map[string]any{"feature": feature},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return errors.DBError(err)
+		}
+
+		logctx.From(ctx, s.logger).Info("user removed from feature allowlist",
+			zap.String("feature", feature),
+			zap.String("user_external_id", userExternalID),
+			zap.String("request_id", actor.RequestID),
+		)
+		return nil
+	})
+}
+
+// status returns the rollout status of a feature (PILOT when never set)
+func (s *Service) status(ctx context.Context, q *db.Queries, feature string) (db.FeatureRolloutsStatus, error) {
+	rollout, err := q.GetFeatureRollout(ctx, feature)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.FeatureRolloutsStatusPILOT, nil
+		}
+		logctx.From(ctx, s.logger).Error("failed to get feature rollout",
+			zap.String("feature", feature),
+			zap.Error(err),
+		)
+		return "", errors.DBError(err)
+	}
+	return rollout.Status, nil
+}
+
+// getUser resolves a user by external ID
+func (s *Service) getUser(ctx context.Context, q *db.Queries, userExternalID string) (*db.User, error) {
+	user, err := q.GetUserByExternalID(ctx, sql.NullString{String: userExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("User")
+		}
+		return nil, errors.DBError(err)
+	}
+	return &user, nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// isDuplicateKeyError checks if the error is a MySQL duplicate key error
+func isDuplicateKeyError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return stderrors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
@@ -0,0 +1,59 @@
+package runbook
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// RequeueWebhooksRequest selects a merchant's failed webhook deliveries to redeliver.
+// Failed = DEAD, or PENDING after at least one failed attempt (waiting for backoff).
+type RequeueWebhooksRequest struct {
+	MerchantID string `json:"merchant_id" binding:"required,uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	// EndpointID limits the requeue to one endpoint of the merchant
+	EndpointID string `json:"endpoint_id,omitempty" binding:"omitempty,uuid" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	// From/To bound the delivery creation time [from, to)
+	From time.Time `json:"from" binding:"required" example:"2026-01-15T00:00:00Z"`
+	To   time.Time `json:"to" binding:"required" example:"2026-01-15T06:00:00Z"`
+	// RatePerMinute paces redelivery (default WEBHOOK_REQUEUE_RATE_PER_MINUTE)
+	RatePerMinute int `json:"rate_per_minute,omitempty" binding:"omitempty,min=1,max=600" example:"60"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// ResyncBalanceResponse represents the result of an account balance resync
+// NOTE: dry_run=true이면 applied는 항상 false (변경 사항만 계산)
+type ResyncBalanceResponse struct {
+	AccountID     string      `json:"account_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	StoredBalance money.Money `json:"stored_balance" swaggertype:"string" example:"100.00000000"`
+	LedgerBalance money.Money `json:"ledger_balance" swaggertype:"string" example:"98.50000000"`
+	Difference    money.Money `json:"difference" swaggertype:"string" example:"-1.50000000"`
+	LedgerEntries int64       `json:"ledger_entries" example:"42"`
+	DryRun        bool        `json:"dry_run" example:"true"`
+	Applied       bool        `json:"applied" example:"false"`
+}
+
+// RequeueWebhooksResponse represents the result of a bulk webhook requeue
+// NOTE: has_more=true이면 최대 건수(10,000) 초과 - last_attempt_at 이후 같은 조건으로 다시 실행 (재예약된 건은 제외됨)
+type RequeueWebhooksResponse struct {
+	MerchantID    string    `json:"merchant_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	EndpointID    string    `json:"endpoint_id,omitempty" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	From          time.Time `json:"from"`
+	To            time.Time `json:"to"`
+	RatePerMinute int       `json:"rate_per_minute" example:"60"`
+	Requeued      int       `json:"requeued" example:"1200"`
+	Dead          int       `json:"dead" example:"1150"`
+	Retrying      int       `json:"retrying" example:"50"`
+	HasMore       bool      `json:"has_more" example:"false"`
+	// FirstAttemptAt/LastAttemptAt span the paced redelivery schedule
+	FirstAttemptAt *time.Time `json:"first_attempt_at,omitempty"`
+	LastAttemptAt  *time.Time `json:"last_attempt_at,omitempty"`
+	DryRun         bool       `json:"dry_run" example:"true"`
+	Applied        bool       `json:"applied" example:"false"`
+}
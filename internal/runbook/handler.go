@@ -0,0 +1,106 @@
+package runbook
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for operational runbook endpoints
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new runbook handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers runbook routes on the router group (admin only)
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	runbooks := rg.Group("/admin/runbooks", middleware.RequireRoles(middleware.RoleAdmin), middleware.DryRun())
+	{
+		runbooks.POST("/accounts/:accountId/resync-balance", h.ResyncAccountBalance)
+		runbooks.POST("/webhooks/requeue", h.RequeueWebhookDeliveries)
+	}
+}
+
+// validateUUID validates UUID format
+func validateUUID(id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return errors.InvalidInput("Invalid UUID format")
+	}
+	return nil
+}
+
+// ResyncAccountBalance godoc
+// @Summary Resync account balance from ledger
+// @Description Recompute the account balance from ledger entries and overwrite the cached balance - Admin only.
+// @Description With dry_run=true all validations and writes run in a rolled-back transaction.
+// @Tags runbooks
+// @Produce json
+// @Param accountId path string true "Account external ID (UUID)"
+// @Param dry_run query bool false "Report changes without applying"
+// @Success 200 {object} middleware.SuccessResponse{data=ResyncBalanceResponse} "Resync result"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input or negative ledger balance"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Account not found"
+// @Failure 409 {object} middleware.ErrorResponse "Account changed during resync"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/runbooks/accounts/{accountId}/resync-balance [post]
+func (h *Handler) ResyncAccountBalance(c *gin.Context) {
+	accountID := c.Param("accountId")
+	if err := validateUUID(accountID); err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	result, err := h.service.ResyncAccountBalance(c.Request.Context(), accountID, middleware.IsDryRun(c), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// RequeueWebhookDeliveries godoc
+// @Summary Bulk requeue failed webhook deliveries
+// @Description Reschedule a merchant's failed webhook deliveries (DEAD, or retrying after a failure) created in [from, to) - Admin only.
+// @Description Redelivery is paced at rate_per_minute so the recovered endpoint is not flooded; attempts restart from zero.
+// @Description Up to 10,000 deliveries per run (has_more=true: rerun after last_attempt_at).
+// @Description With dry_run=true all validations and writes run in a rolled-back transaction.
+// @Tags runbooks
+// @Accept json
+// @Produce json
+// @Param request body RequeueWebhooksRequest true "Merchant, time range and pacing"
+// @Param dry_run query bool false "Report changes without applying"
+// @Success 200 {object} middleware.SuccessResponse{data=RequeueWebhooksResponse} "Requeue result"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Merchant or webhook endpoint not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/runbooks/webhooks/requeue [post]
+func (h *Handler) RequeueWebhookDeliveries(c *gin.Context) {
+	var req RequeueWebhooksRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.RequeueWebhookDeliveries(c.Request.Context(), &req, middleware.IsDryRun(c), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
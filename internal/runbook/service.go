@@ -0,0 +1,321 @@
+package runbook
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"go.uber.org/zap"
+)
+
+const (
+	// balanceScale matches DECIMAL(18,8) balance columns
+	balanceScale = 8
+
+	// maxRequeueDeliveries bounds one bulk webhook requeue (rerun for the rest)
+	maxRequeueDeliveries = 10_000
+	// defaultRequeueRatePerMinute applies when the configured pace is unset
+	defaultRequeueRatePerMinute = 60
+
+	// Audit actions
+	actionResyncBalance   = "RUNBOOK_RESYNC_BALANCE"
+	actionRequeueWebhooks = "RUNBOOK_REQUEUE_WEBHOOKS"
+	resourceAccount       = "ACCOUNT"
+	resourceUser          = "USER"
+)
+
+// Config holds runbook settings
+type Config struct {
+	// RequeueRatePerMinute is the default redelivery pace of bulk webhook requeues
+	RequeueRatePerMinute int
+}
+
+// Service encapsulates operational incident fixes as safe, audited operations
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	config   Config
+	logger   *zap.Logger
+}
+
+// NewService creates a new runbook service
+func NewService(txRunner *pkgdb.TxRunner, config Config, logger *zap.Logger) *Service {
+	if config.RequeueRatePerMinute <= 0 {
+		config.RequeueRatePerMinute = defaultRequeueRatePerMinute
+	}
+	return &Service{
+		txRunner: txRunner,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// ResyncAccountBalance recomputes an account balance from the ledger.
+// With dryRun every step runs in a rollback-only transaction and nothing is committed.
+func (s *Service) ResyncAccountBalance(ctx context.Context, accountExternalID string, dryRun bool, actor audit.Actor) (*ResyncBalanceResponse, error) {
+	account, err := s.txRunner.Queries().GetAccountByExternalID(ctx, sql.NullString{String: accountExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Account")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get account", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	result, err := pkgdb.WithTxResultDryRun(ctx, s.txRunner, dryRun, func(q *db.Queries) (*ResyncBalanceResponse, error) {
+		// 1. Lock account row (잔액 변경 트랜잭션과 직렬화)
+		locked, err := q.GetAccountForUpdate(ctx, account.ID)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to lock account row", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		// 2. Ledger balance (source of truth)
+		// NOTE: 잔액 스냅샷을 쓰지 않고 전체 합계로 재계산 → 스냅샷(파생 캐시) 오류와 무관한 기준값
+		ledger, err := q.GetLedgerBalance(ctx, locked.ID)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to compute ledger balance", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		stored, err := money.Parse(locked.Balance, "", balanceScale)
+		if err != nil {
+			return nil, errors.Internal("Invalid stored balance")
+		}
+		expected, err := money.Parse(ledger.Balance, "", balanceScale)
+		if err != nil {
+			return nil, errors.Internal("Invalid ledger balance")
+		}
+
+		result := &ResyncBalanceResponse{
+			AccountID:     accountExternalID,
+			StoredBalance: stored,
+			LedgerBalance: expected,
+			Difference:    expected.Sub(stored),
+			LedgerEntries: ledger.EntryCount,
+			DryRun:        dryRun,
+		}
+
+		// 3. Nothing to change
+		if stored.Cmp(expected) == 0 {
+			return result, nil
+		}
+		if expected.Sign() < 0 {
+			return nil, errors.InvalidInput("Ledger balance is negative - manual investigation required")
+		}
+
+		// 4. Apply (optimistic lock on version)
+		updated, err := q.ResyncAccountBalance(ctx, db.ResyncAccountBalanceParams{
+			Balance: expected.String(),
+			ID:      locked.ID,
+			Version: locked.Version,
+		})
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to resync account balance", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		if affected, _ := updated.RowsAffected(); affected == 0 {
+			return nil, errors.Conflict("Account changed during resync, retry")
+		}
+
+		// 5. Audit (same transaction)
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionResyncBalance,
+			ResourceType: resourceAccount,
+			ResourceID:   locked.ID,
+			OldValue:     map[string]any{"balance": result.StoredBalance.String(), "version": locked.Version},
+			NewValue:     map[string]any{"balance": result.LedgerBalance.String(), "version": locked.Version + 1},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		result.Applied = true
+		return result, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// dry-run은 롤백됨 → 반영되지 않음
+	if dryRun {
+		result.Applied = false
+		return result, nil
+	}
+
+	if result.Applied {
+		logctx.From(ctx, s.logger).Info("account balance resynced from ledger",
+			zap.String("account_external_id", accountExternalID),
+			zap.Stringer("old_balance", result.StoredBalance),
+			zap.Stringer("new_balance", result.LedgerBalance),
+			zap.String("request_id", actor.RequestID),
+		)
+	}
+
+	return result, nil
+}
+
+// RequeueWebhookDeliveries reschedules a merchant's failed webhook deliveries in a time range.
+// With dryRun every step runs in a rollback-only transaction and nothing is committed.
+//
+// Why:
+// - 가맹점 장애 복구 후 수천 건을 한 번에 PENDING으로 돌리면 복구된 엔드포인트가 바로 폭주
+// - next_attempt_at을 rate_per_minute 간격으로 분산 → dispatcher가 예약 순서대로 천천히 재전송
+// - attempts 초기화 → 재시도 한도 전체 재적용 (DEAD 건도 backoff 재시도 가능)
+func (s *Service) RequeueWebhookDeliveries(ctx context.Context, req *RequeueWebhooksRequest, dryRun bool, actor audit.Actor) (*RequeueWebhooksResponse, error) {
+	// 1. Validate
+	if !req.To.After(req.From) {
+		return nil, errors.InvalidInput("to must be after from")
+	}
+	rate := req.RatePerMinute
+	if rate == 0 {
+		rate = s.config.RequeueRatePerMinute
+	}
+
+	merchant, err := s.txRunner.Queries().GetUserByExternalID(ctx, sql.NullString{String: req.MerchantID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Merchant")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get merchant", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	var endpointID sql.NullInt64
+	if req.EndpointID != "" {
+		endpoint, err := s.txRunner.Queries().GetWebhookEndpointByExternalIDAndUser(ctx, db.GetWebhookEndpointByExternalIDAndUserParams{
+			ExternalID:   req.EndpointID,
+			ExternalID_2: sql.NullString{String: req.MerchantID, Valid: true},
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, errors.NotFound("Webhook endpoint")
+			}
+			logctx.From(ctx, s.logger).Error("failed to get webhook endpoint", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		endpointID = sql.NullInt64{Int64: int64(endpoint.ID), Valid: true}
+	}
+
+	result, err := pkgdb.WithTxResultDryRun(ctx, s.txRunner, dryRun, func(q *db.Queries) (*RequeueWebhooksResponse, error) {
+		// 2. Lock failed deliveries (dispatcher claim과 직렬화)
+		deliveries, err := q.ListRequeueableWebhookDeliveriesForUpdate(ctx, db.ListRequeueableWebhookDeliveriesForUpdateParams{
+			UserID:      merchant.ID,
+			EndpointID:  endpointID,
+			CreatedFrom: req.From,
+			CreatedTo:   req.To,
+			Limit:       maxRequeueDeliveries + 1,
+		})
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to list failed webhook deliveries", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		result := &RequeueWebhooksResponse{
+			MerchantID:    req.MerchantID,
+			EndpointID:    req.EndpointID,
+			From:          req.From,
+			To:            req.To,
+			RatePerMinute: rate,
+			HasMore:       len(deliveries) > maxRequeueDeliveries,
+			DryRun:        dryRun,
+		}
+		if result.HasMore {
+			deliveries = deliveries[:maxRequeueDeliveries]
+		}
+		if len(deliveries) == 0 {
+			return result, nil
+		}
+
+		// 3. Pace: i번째 건은 now + i × (1분 / rate), 같은 초(TIMESTAMP 정밀도)의 건은 한 번에 갱신
+		now := time.Now().UTC().Truncate(time.Second)
+		interval := time.Minute / time.Duration(rate)
+		var ids []uint64
+		var slot time.Time
+		for i := range deliveries {
+			at := now.Add(time.Duration(i) * interval).Truncate(time.Second)
+			if len(ids) > 0 && !at.Equal(slot) {
+				if err := s.requeue(ctx, q, slot, ids, result); err != nil {
+					return nil, err
+				}
+				ids = ids[:0]
+			}
+			slot = at
+			ids = append(ids, deliveries[i].ID)
+
+			if deliveries[i].Status == db.WebhookDeliveriesStatusDEAD {
+				result.Dead++
+			} else {
+				result.Retrying++
+			}
+		}
+		if err := s.requeue(ctx, q, slot, ids, result); err != nil {
+			return nil, err
+		}
+		result.FirstAttemptAt = &now
+		result.LastAttemptAt = &slot
+
+		// 4. Audit (same transaction)
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionRequeueWebhooks,
+			ResourceType: resourceUser,
+			ResourceID:   merchant.ID,
+			NewValue: map[string]any{
+				"endpoint_id":     req.EndpointID,
+				"from":            req.From,
+				"to":              req.To,
+				"requeued":        result.Requeued,
+				"rate_per_minute": rate,
+				"last_attempt_at": slot,
+				"has_more":        result.HasMore,
+			},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		result.Applied = true
+		return result, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// dry-run은 롤백됨 → 반영되지 않음
+	if dryRun {
+		result.Applied = false
+		return result, nil
+	}
+
+	if result.Applied {
+		logctx.From(ctx, s.logger).Info("webhook deliveries requeued",
+			zap.String("merchant_external_id", req.MerchantID),
+			zap.Int("requeued", result.Requeued),
+			zap.Int("rate_per_minute", rate),
+			zap.Bool("has_more", result.HasMore),
+			zap.String("request_id", actor.RequestID),
+		)
+	}
+
+	return result, nil
+}
+
+// requeue reschedules one pacing slot of deliveries
+func (s *Service) requeue(ctx context.Context, q *db.Queries, at time.Time, ids []uint64, result *RequeueWebhooksResponse) error {
+	updated, err := q.RequeueWebhookDeliveries(ctx, db.RequeueWebhookDeliveriesParams{
+		NextAttemptAt: at,
+		Ids:           ids,
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to requeue webhook deliveries", zap.Error(err))
+		return errors.DBError(err)
+	}
+	affected, _ := updated.RowsAffected()
+	result.Requeued += int(affected)
+	return nil
+}
@@ -0,0 +1,315 @@
+package settlement
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/otp"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Audit log identifiers of payout approvals
+const (
+	actionPayoutApprovalRequested = "PAYOUT_APPROVAL_REQUESTED"
+	actionPayoutFirstApproved     = "PAYOUT_FIRST_APPROVED"
+	actionPayoutApproved          = "PAYOUT_APPROVED"
+	actionPayoutRejected          = "PAYOUT_REJECTED"
+	resourcePayoutApproval        = "PAYOUT_APPROVAL"
+)
+
+// requiresApproval reports whether a payout amount is at or above the approval threshold (zero threshold = disabled)
+func (s *Service) requiresApproval(amount money.Money) bool {
+	return s.config.ApprovalThreshold.Sign() > 0 && amount.Cmp(s.config.ApprovalThreshold) >= 0
+}
+
+// requestApproval holds a payout at or above the approval threshold until two distinct users approve it;
+// returns false when the payout needs no approval. Must be called within the transaction that creates the payout.
+// The actor creating the payout is its maker (none for payouts cut by the scheduler).
+func (s *Service) requestApproval(ctx context.Context, q *db.Queries, payoutType db.PayoutApprovalsPayoutType, payoutID, payeeAccountID uint64, amount money.Money, actor audit.Actor) (bool, error) {
+	if !s.requiresApproval(amount) {
+		return false, nil
+	}
+
+	requestedBy := sql.NullInt64{Int64: int64(actor.ID), Valid: actor.Type == audit.ActorTypeUser && actor.ID != 0}
+	result, err := q.CreatePayoutApproval(ctx, db.CreatePayoutApprovalParams{
+		ExternalID:     uuid.New().String(),
+		PayoutType:     payoutType,
+		PayoutID:       payoutID,
+		PayeeAccountID: payeeAccountID,
+		Amount:         amount.String(),
+		RequestedBy:    requestedBy,
+	})
+	if err != nil {
+		return false, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return false, err
+	}
+
+	if err := audit.Record(ctx, q, actor, audit.Entry{
+		Action:       actionPayoutApprovalRequested,
+		ResourceType: resourcePayoutApproval,
+		ResourceID:   uint64(id),
+		NewValue: map[string]any{
+			"payout_type":      string(payoutType),
+			"payout_id":        payoutID,
+			"payee_account_id": payeeAccountID,
+			"amount":           amount.String(),
+			"threshold":        s.config.ApprovalThreshold.String(),
+		},
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ApprovePayout records an approval of a held payout (admin).
+// A payout created by a user (maker) is approved by one other user; a payout cut by the scheduler has no maker,
+// so its first approval stands in for the maker and a second, different user completes it.
+//
+// Why:
+//   - 고액 지급은 한 사람이 단독으로 실행할 수 없도록 maker ≠ checker 강제 (내부 통제)
+//   - 고액 지급 수취인은 휴대폰 인증 필수 → 계정 탈취만으로 고액 출금 불가
+//   - 승인 row-lock 후 상태 확인 → 동시 승인/거절이 겹쳐도 한 번만 결정
+func (s *Service) ApprovePayout(ctx context.Context, approvalExternalID string, actor audit.Actor) (*PayoutApprovalResponse, error) {
+	if actor.Type != audit.ActorTypeUser || actor.ID == 0 {
+		return nil, errors.Forbidden("Payout approvals require a user")
+	}
+
+	id, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (uint64, error) {
+		approval, err := s.lockPendingApproval(ctx, q, approvalExternalID)
+		if err != nil {
+			return 0, err
+		}
+
+		maker := approval.RequestedBy
+		if !maker.Valid {
+			maker = approval.FirstApprovedBy
+		}
+		if maker.Valid && uint64(maker.Int64) == actor.ID {
+			return 0, errors.Forbidden("Payout must be approved by a different user than its maker")
+		}
+		if err := requirePayeePhoneVerified(ctx, q, approval.PayeeAccountID); err != nil {
+			return 0, err
+		}
+
+		// maker가 없는 지급(스케줄러 마감)의 첫 승인 → 두 번째 승인자 대기
+		if !maker.Valid {
+			if err := q.RecordPayoutFirstApproval(ctx, db.RecordPayoutFirstApprovalParams{
+				FirstApprovedBy: sql.NullInt64{Int64: int64(actor.ID), Valid: true},
+				ID:              approval.ID,
+			}); err != nil {
+				return 0, err
+			}
+			if err := audit.Record(ctx, q, actor, audit.Entry{
+				Action:       actionPayoutFirstApproved,
+				ResourceType: resourcePayoutApproval,
+				ResourceID:   approval.ID,
+				NewValue:     map[string]any{"first_approved_by": actor.ID},
+			}); err != nil {
+				return 0, err
+			}
+			return approval.ID, nil
+		}
+
+		if err := q.DecidePayoutApproval(ctx, db.DecidePayoutApprovalParams{
+			Status:    db.PayoutApprovalsStatusAPPROVED,
+			DecidedBy: sql.NullInt64{Int64: int64(actor.ID), Valid: true},
+			ID:        approval.ID,
+		}); err != nil {
+			return 0, err
+		}
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionPayoutApproved,
+			ResourceType: resourcePayoutApproval,
+			ResourceID:   approval.ID,
+			OldValue:     map[string]any{"status": string(approval.Status)},
+			NewValue:     map[string]any{"status": string(db.PayoutApprovalsStatusAPPROVED), "maker": maker.Int64},
+		}); err != nil {
+			return 0, err
+		}
+		return approval.ID, nil
+	})
+	if err != nil {
+		// NOTE: rollback 실패 시 TxRunner가 원래 오류를 %w로 감쌈 → 타입 단언 대신 체인에서 AppError 추출
+		if appErr := errors.From(err); appErr.Code != errors.CodeInternal {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to approve payout", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.getApproval(ctx, id)
+}
+
+// RejectPayout rejects a held payout (admin); a rejected payout stays excluded from payouts
+func (s *Service) RejectPayout(ctx context.Context, approvalExternalID string, req *RejectPayoutRequest, actor audit.Actor) (*PayoutApprovalResponse, error) {
+	if actor.Type != audit.ActorTypeUser || actor.ID == 0 {
+		return nil, errors.Forbidden("Payout approvals require a user")
+	}
+
+	id, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (uint64, error) {
+		approval, err := s.lockPendingApproval(ctx, q, approvalExternalID)
+		if err != nil {
+			return 0, err
+		}
+
+		if err := q.DecidePayoutApproval(ctx, db.DecidePayoutApprovalParams{
+			Status:       db.PayoutApprovalsStatusREJECTED,
+			DecidedBy:    sql.NullInt64{Int64: int64(actor.ID), Valid: true},
+			RejectReason: sql.NullString{String: req.Reason, Valid: true},
+			ID:           approval.ID,
+		}); err != nil {
+			return 0, err
+		}
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionPayoutRejected,
+			ResourceType: resourcePayoutApproval,
+			ResourceID:   approval.ID,
+			OldValue:     map[string]any{"status": string(approval.Status)},
+			NewValue:     map[string]any{"status": string(db.PayoutApprovalsStatusREJECTED), "reason": req.Reason},
+		}); err != nil {
+			return 0, err
+		}
+		return approval.ID, nil
+	})
+	if err != nil {
+		if appErr := errors.From(err); appErr.Code != errors.CodeInternal {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to reject payout", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.getApproval(ctx, id)
+}
+
+// ListPayoutApprovals returns payout approvals with a status filter (default PENDING), newest first (admin)
+func (s *Service) ListPayoutApprovals(ctx context.Context, req *ListPayoutApprovalsRequest) (*ListPayoutApprovalsResponse, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	var status db.NullPayoutApprovalsStatus
+	if req.Status != "" {
+		status = db.NullPayoutApprovalsStatus{PayoutApprovalsStatus: db.PayoutApprovalsStatus(req.Status), Valid: true}
+	}
+
+	q := s.txRunner.Queries()
+	rows, err := q.ListPayoutApprovals(ctx, db.ListPayoutApprovalsParams{
+		Status: status,
+		Limit:  int32(req.PageSize),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list payout approvals", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := q.CountPayoutApprovals(ctx, status)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count payout approvals", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	approvals := make([]PayoutApprovalResponse, 0, len(rows))
+	for i := range rows {
+		detail := db.GetPayoutApprovalDetailRow(rows[i])
+		approval, err := s.toPayoutApprovalResponse(ctx, &detail)
+		if err != nil {
+			return nil, err
+		}
+		approvals = append(approvals, *approval)
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListPayoutApprovalsResponse{
+		Approvals:  approvals,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// lockPendingApproval locks a payout approval that is still pending a decision
+func (s *Service) lockPendingApproval(ctx context.Context, q *db.Queries, externalID string) (*db.PayoutApproval, error) {
+	approval, err := q.GetPayoutApprovalForUpdate(ctx, externalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Payout approval")
+		}
+		return nil, err
+	}
+	if approval.Status != db.PayoutApprovalsStatusPENDING {
+		return nil, errors.Conflict("Payout approval is already " + string(approval.Status))
+	}
+	return &approval, nil
+}
+
+// requirePayeePhoneVerified rejects approving a payout to a user account whose owner has no verified phone number.
+// Accounts without an owner (platform accounts) are not checked.
+func requirePayeePhoneVerified(ctx context.Context, q *db.Queries, payeeAccountID uint64) error {
+	account, err := q.GetAccountByID(ctx, payeeAccountID)
+	if err != nil {
+		return err
+	}
+	if !account.OwnerID.Valid {
+		return nil
+	}
+	payee, err := q.GetUserByID(ctx, uint64(account.OwnerID.Int64))
+	if err != nil {
+		return err
+	}
+	return otp.RequireVerifiedPhone(&payee)
+}
+
+// getApproval loads a payout approval with its external identifiers
+func (s *Service) getApproval(ctx context.Context, id uint64) (*PayoutApprovalResponse, error) {
+	detail, err := s.txRunner.Queries().GetPayoutApprovalDetail(ctx, id)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to get payout approval", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return s.toPayoutApprovalResponse(ctx, &detail)
+}
+
+// toPayoutApprovalResponse parses the stored amount and converts the payout approval
+func (s *Service) toPayoutApprovalResponse(ctx context.Context, a *db.GetPayoutApprovalDetailRow) (*PayoutApprovalResponse, error) {
+	amount, err := parseAmount(a.Amount)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("invalid stored amount",
+			zap.String("payout_approval_external_id", a.ExternalID),
+			zap.String("amount", a.Amount),
+			zap.Error(err),
+		)
+		return nil, errors.Internal("Invalid settlement amount")
+	}
+	response := ToPayoutApprovalResponse(a, amount)
+	return &response, nil
+}
+
+// approvalHoldReason returns the hold of a payout pending or refused approval ("" = payable)
+func approvalHoldReason(status db.NullPayoutApprovalsStatus) string {
+	if !status.Valid {
+		return "" // 기준 금액 미만 - 승인 불필요
+	}
+	switch status.PayoutApprovalsStatus {
+	case db.PayoutApprovalsStatusAPPROVED:
+		return ""
+	case db.PayoutApprovalsStatusREJECTED:
+		return HoldReasonPayoutRejected
+	default:
+		return HoldReasonAwaitingApproval
+	}
+}
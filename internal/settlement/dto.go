@@ -0,0 +1,398 @@
+package settlement
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// Forecast line sources
+const (
+	// SourceScheduled is a settlement already created and waiting for the payout batch
+	SourceScheduled = "SCHEDULED"
+	// SourceCaptured is a captured payment not yet settled
+	SourceCaptured = "CAPTURED"
+	// SourcePendingConfirmation is an authorized payment awaiting capture
+	SourcePendingConfirmation = "PENDING_CONFIRMATION"
+)
+
+// Hold reasons that block every payout of a seller
+const (
+	HoldReasonNoAccount        = "NO_SETTLEMENT_ACCOUNT"
+	HoldReasonAccountSuspended = "ACCOUNT_SUSPENDED"
+	HoldReasonAccountFrozen    = "ACCOUNT_FROZEN"
+	HoldReasonNoPayoutWallet   = "NO_PAYOUT_WALLET"
+)
+
+// Hold reasons of a single payout at or above the approval threshold (excluded from the payout run)
+const (
+	HoldReasonAwaitingApproval = "AWAITING_APPROVAL"
+	HoldReasonPayoutRejected   = "PAYOUT_REJECTED"
+)
+
+// Payout execution strategies
+const (
+	// StrategyIndividual sends one token transfer transaction per payee
+	StrategyIndividual = "INDIVIDUAL"
+	// StrategyBatched sends payees in multi-recipient batch transactions
+	StrategyBatched = "BATCHED"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// ForecastRequest represents query parameters for the settlement forecast
+// NOTE: seller_id 생략 시 호출자 본인 (관리자는 다른 판매자 조회 가능)
+type ForecastRequest struct {
+	SellerID string `form:"seller_id" binding:"omitempty,uuid"`
+}
+
+// RunNettingRequest represents the request body for a netting run (admin).
+// The window covers settlements created in [window_start, window_end); omitted bounds default to
+// the configured netting window ending now.
+type RunNettingRequest struct {
+	WindowStart *time.Time `json:"window_start,omitempty" example:"2026-01-14T00:00:00Z"`
+	WindowEnd   *time.Time `json:"window_end,omitempty" example:"2026-01-15T00:00:00Z"`
+}
+
+// ListNetPayoutsRequest represents query parameters for listing net payouts (admin)
+type ListNetPayoutsRequest struct {
+	Status   string `form:"status" binding:"omitempty,oneof=PENDING PROCESSING COMPLETED FAILED OFFSET"`
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ListPayoutApprovalsRequest represents query parameters for listing payout approvals (admin)
+// NOTE: status 생략 시 승인 대기(PENDING)만
+type ListPayoutApprovalsRequest struct {
+	Status   string `form:"status,default=PENDING" binding:"oneof=PENDING APPROVED REJECTED"`
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// RejectPayoutRequest represents the request body for rejecting a held payout (admin)
+type RejectPayoutRequest struct {
+	Reason string `json:"reason" binding:"required,max=500" example:"Payee bank details under review"`
+}
+
+// SetScheduleRequest represents the request body for setting a payout schedule.
+// cutoff_hour defaults to the server batch hour; cutoff_weekday (0 = Sunday) is required for WEEKLY only.
+type SetScheduleRequest struct {
+	Cadence       string `json:"cadence" binding:"required,oneof=INSTANT DAILY WEEKLY" example:"WEEKLY"`
+	CutoffHour    *int   `json:"cutoff_hour,omitempty" binding:"omitempty,min=0,max=23" example:"9"`
+	CutoffWeekday *int   `json:"cutoff_weekday,omitempty" binding:"required_if=Cadence WEEKLY,omitempty,min=0,max=6" example:"1"`
+}
+
+// ListBatchesRequest represents query parameters for listing a seller's payout batches
+type ListBatchesRequest struct {
+	Page     int `form:"page,default=1" binding:"min=1"`
+	PageSize int `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// ForecastPayout represents the projected payout of one batch date
+type ForecastPayout struct {
+	PayoutDate                time.Time   `json:"payout_date"`
+	Amount                    money.Money `json:"amount" swaggertype:"string" example:"1250.00000000"`
+	ScheduledAmount           money.Money `json:"scheduled_amount" swaggertype:"string" example:"1000.00000000"`
+	CapturedAmount            money.Money `json:"captured_amount" swaggertype:"string" example:"200.00000000"`
+	PendingConfirmationAmount money.Money `json:"pending_confirmation_amount" swaggertype:"string" example:"50.00000000"`
+	Count                     int         `json:"count" example:"3"`
+}
+
+// ForecastItem represents a single payment or settlement included in the forecast
+type ForecastItem struct {
+	Source      string      `json:"source" example:"CAPTURED"`
+	PaymentID   string      `json:"payment_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	OrderNumber string      `json:"order_number,omitempty" example:"ORD-20240101-0001"`
+	Amount      money.Money `json:"amount" swaggertype:"string" example:"200.00000000"`
+	// TokenSymbol is the stablecoin the payment settles in (empty for scheduled settlements)
+	TokenSymbol string     `json:"token_symbol,omitempty" example:"USDC"`
+	ReleaseAt   time.Time  `json:"release_at"`
+	PayoutDate  *time.Time `json:"payout_date,omitempty"`
+}
+
+// ForecastResponse represents the projected payouts of a seller
+type ForecastResponse struct {
+	SellerID    string    `json:"seller_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	GeneratedAt time.Time `json:"generated_at"`
+	// HoldPeriodHours is the hold applied after capture before a payment is paid out
+	HoldPeriodHours int         `json:"hold_period_hours" example:"72"`
+	TotalAmount     money.Money `json:"total_amount" swaggertype:"string" example:"1250.00000000"`
+	// OnHold is set when an account-level hold blocks every payout (payout dates are then omitted)
+	OnHold     bool             `json:"on_hold" example:"false"`
+	HoldReason string           `json:"hold_reason,omitempty" example:"ACCOUNT_SUSPENDED"`
+	Payouts    []ForecastPayout `json:"payouts"`
+	Items      []ForecastItem   `json:"items"`
+}
+
+// GasStrategyEstimate represents the estimated cost of paying out the batch with one strategy
+type GasStrategyEstimate struct {
+	Strategy     string `json:"strategy" example:"BATCHED"`
+	Transactions int    `json:"transactions" example:"1"`
+	GasUnits     uint64 `json:"gas_units" example:"350000"`
+	// EstimatedFee is gas x (base fee + tip), the expected cost if included in the next block
+	EstimatedFeeWei string      `json:"estimated_fee_wei" example:"10500000000000000"`
+	EstimatedFee    money.Money `json:"estimated_fee" swaggertype:"string" example:"0.010500000000000000"`
+	// MaxFee is gas x max fee per gas, the worst case the transactions are allowed to pay
+	MaxFeeWei string      `json:"max_fee_wei" example:"19250000000000000"`
+	MaxFee    money.Money `json:"max_fee" swaggertype:"string" example:"0.019250000000000000"`
+}
+
+// ExcludedPayout represents a payee of the batch that cannot be paid out
+type ExcludedPayout struct {
+	AccountID string      `json:"account_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Amount    money.Money `json:"amount" swaggertype:"string" example:"200.00000000"`
+	Reason    string      `json:"reason" example:"NO_PAYOUT_WALLET"`
+}
+
+// GasEstimateResponse represents the payout gas preview of the pending settlement batch
+type GasEstimateResponse struct {
+	ChainID     int64     `json:"chain_id" example:"1"`
+	GeneratedAt time.Time `json:"generated_at"`
+	Settlements int       `json:"settlements" example:"12"`
+	// NetPayouts is the number of pending net payouts replacing netted settlements of counterparty pairs
+	NetPayouts  int         `json:"net_payouts" example:"2"`
+	Recipients  int         `json:"recipients" example:"10"`
+	TotalAmount money.Money `json:"total_amount" swaggertype:"string" example:"15000.00000000"`
+	Urgency     string      `json:"urgency" example:"standard"`
+	// Fee parameters at current network conditions (wei per gas)
+	BaseFeeWei      string `json:"base_fee_wei" example:"25000000000"`
+	TipCapWei       string `json:"tip_cap_wei" example:"1500000000"`
+	MaxFeePerGasWei string `json:"max_fee_per_gas_wei" example:"51500000000"`
+	// TransferGas is the gas of one individual transfer; TransferGasEstimated is false when the configured fallback was used
+	TransferGas          uint64                `json:"transfer_gas" example:"52000"`
+	TransferGasEstimated bool                  `json:"transfer_gas_estimated" example:"true"`
+	Strategies           []GasStrategyEstimate `json:"strategies"`
+	Recommended          string                `json:"recommended,omitempty" example:"BATCHED"`
+	Excluded             []ExcludedPayout      `json:"excluded"`
+}
+
+// NetPayoutResponse represents the single net payout of a counterparty pair for a settlement window.
+// Accounts are ordered per pair; a_to_b_amount is owed by account A to account B (settlements paid by A, received by B).
+type NetPayoutResponse struct {
+	ID          string      `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	WindowStart time.Time   `json:"window_start"`
+	WindowEnd   time.Time   `json:"window_end"`
+	AccountA    string      `json:"account_a" example:"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`
+	AccountB    string      `json:"account_b" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	AToBAmount  money.Money `json:"a_to_b_amount" swaggertype:"string" example:"1200.00000000"`
+	BToAAmount  money.Money `json:"b_to_a_amount" swaggertype:"string" example:"900.00000000"`
+	// PayeeAccountID receives the net amount (omitted when the obligations offset exactly)
+	PayeeAccountID  string      `json:"payee_account_id,omitempty" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	NetAmount       money.Money `json:"net_amount" swaggertype:"string" example:"300.00000000"`
+	SettlementCount int         `json:"settlement_count" example:"5"`
+	// Status: PENDING (awaiting payout batch) → PROCESSING → COMPLETED | FAILED, or OFFSET (nothing to pay)
+	Status    string    `json:"status" example:"PENDING"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NettingRunResponse represents the result of a netting run
+type NettingRunResponse struct {
+	WindowStart time.Time `json:"window_start"`
+	WindowEnd   time.Time `json:"window_end"`
+	Pairs       int       `json:"pairs" example:"2"`
+	Settlements int       `json:"settlements" example:"9"`
+	// LimitedPairs counts pairs left unnetted because the net payout would exceed the payee's withdrawal limit
+	LimitedPairs int `json:"limited_pairs" example:"0"`
+	// GrossAmount is the total of the netted settlements, NetAmount what is still paid out on-chain
+	GrossAmount money.Money `json:"gross_amount" swaggertype:"string" example:"4100.00000000"`
+	NetAmount   money.Money `json:"net_amount" swaggertype:"string" example:"500.00000000"`
+	// TransfersBefore counts one payout per pair direction, TransfersAfter one per pair with a net amount
+	TransfersBefore int                 `json:"transfers_before" example:"4"`
+	TransfersAfter  int                 `json:"transfers_after" example:"1"`
+	NetPayouts      []NetPayoutResponse `json:"net_payouts"`
+}
+
+// ListNetPayoutsResponse represents paginated net payout list
+type ListNetPayoutsResponse struct {
+	NetPayouts []NetPayoutResponse `json:"net_payouts"`
+	Total      int64               `json:"total" example:"12"`
+	Page       int                 `json:"page" example:"1"`
+	PageSize   int                 `json:"page_size" example:"20"`
+	TotalPages int                 `json:"total_pages" example:"1"`
+}
+
+// PayoutApprovalResponse represents the maker-checker approval of a payout at or above the approval threshold.
+// A payout with a maker (requested_by) needs one approval from another user; a payout cut by the scheduler needs
+// a first approval and a final approval from two different users.
+type PayoutApprovalResponse struct {
+	ID string `json:"id" example:"3f2a1b0c-9d8e-4f7a-8b6c-5d4e3f2a1b0c"`
+	// PayoutType: SETTLEMENT_BATCH (payout_id = batch ID) or NET_PAYOUT (payout_id = net payout ID)
+	PayoutType     string      `json:"payout_type" example:"SETTLEMENT_BATCH"`
+	PayoutID       string      `json:"payout_id" example:"8d7f6e5c-4b3a-4291-8a7b-6c5d4e3f2a1b"`
+	PayeeAccountID string      `json:"payee_account_id" example:"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`
+	Amount         money.Money `json:"amount" swaggertype:"string" example:"52340.50000000"`
+	// Status: PENDING → APPROVED | REJECTED
+	Status          string     `json:"status" example:"PENDING"`
+	RequestedBy     string     `json:"requested_by,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	FirstApprovedBy string     `json:"first_approved_by,omitempty" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	FirstApprovedAt *time.Time `json:"first_approved_at,omitempty"`
+	DecidedBy       string     `json:"decided_by,omitempty" example:"9b2e4f1a-3c5d-4e6f-8a7b-1c2d3e4f5a6b"`
+	DecidedAt       *time.Time `json:"decided_at,omitempty"`
+	RejectReason    string     `json:"reject_reason,omitempty" example:"Payee bank details under review"`
+	CreatedAt       time.Time  `json:"created_at"`
+}
+
+// ListPayoutApprovalsResponse represents paginated payout approval list
+type ListPayoutApprovalsResponse struct {
+	Approvals  []PayoutApprovalResponse `json:"approvals"`
+	Total      int64                    `json:"total" example:"3"`
+	Page       int                      `json:"page" example:"1"`
+	PageSize   int                      `json:"page_size" example:"20"`
+	TotalPages int                      `json:"total_pages" example:"1"`
+}
+
+// BatchResponse represents a payout batch of settlements cut at one cutoff of the payee's schedule
+type BatchResponse struct {
+	ID              string      `json:"id" example:"8d7f6e5c-4b3a-4291-8a7b-6c5d4e3f2a1b"`
+	Cadence         string      `json:"cadence" example:"DAILY"`
+	CutoffAt        time.Time   `json:"cutoff_at"`
+	SettlementCount int         `json:"settlement_count" example:"42"`
+	TotalAmount     money.Money `json:"total_amount" swaggertype:"string" example:"52340.50000000"`
+	// Status: PENDING → PROCESSING → COMPLETED | FAILED
+	Status    string    `json:"status" example:"PENDING"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ListBatchesResponse represents paginated payout batch list
+type ListBatchesResponse struct {
+	Batches    []BatchResponse `json:"batches"`
+	Total      int64           `json:"total" example:"30"`
+	Page       int             `json:"page" example:"1"`
+	PageSize   int             `json:"page_size" example:"20"`
+	TotalPages int             `json:"total_pages" example:"2"`
+}
+
+// BatchExportRow represents one settlement of a payout batch in a CSV export (amounts are plain decimals)
+type BatchExportRow struct {
+	BatchID     string     `json:"batch_id"`
+	PaymentID   string     `json:"payment_id"`
+	OrderNumber string     `json:"order_number"`
+	Amount      string     `json:"amount"`
+	FeeAmount   string     `json:"fee_amount"`
+	NetAmount   string     `json:"net_amount"`
+	TokenSymbol string     `json:"token_symbol"`
+	Status      string     `json:"status"`
+	SettledAt   *time.Time `json:"settled_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToNetPayoutResponse converts a net payout with its parsed amounts to response DTO
+func ToNetPayoutResponse(n *db.GetSettlementNetPayoutDetailRow, aToB, bToA, net money.Money) NetPayoutResponse {
+	return NetPayoutResponse{
+		ID:              n.ExternalID,
+		WindowStart:     n.WindowStart.UTC(),
+		WindowEnd:       n.WindowEnd.UTC(),
+		AccountA:        n.AccountLowExternalID.String,
+		AccountB:        n.AccountHighExternalID.String,
+		AToBAmount:      aToB,
+		BToAAmount:      bToA,
+		PayeeAccountID:  n.PayeeAccountExternalID.String,
+		NetAmount:       net,
+		SettlementCount: int(n.SettlementCount),
+		Status:          string(n.Status),
+		CreatedAt:       n.CreatedAt,
+	}
+}
+
+// ToBatchResponse converts a payout batch with its parsed total to response DTO
+func ToBatchResponse(b *db.SettlementBatch, total money.Money) BatchResponse {
+	return BatchResponse{
+		ID:              b.ExternalID,
+		Cadence:         string(b.Cadence),
+		CutoffAt:        b.CutoffAt.UTC(),
+		SettlementCount: int(b.SettlementCount),
+		TotalAmount:     total,
+		Status:          string(b.Status),
+		CreatedAt:       b.CreatedAt,
+	}
+}
+
+// ScheduleResponse represents the payout schedule of a settlement account
+type ScheduleResponse struct {
+	Cadence string `json:"cadence" example:"WEEKLY"`
+	// CutoffHour is the UTC cutoff hour (omitted for INSTANT)
+	CutoffHour *int `json:"cutoff_hour,omitempty" example:"9"`
+	// CutoffWeekday is the cutoff weekday of a WEEKLY schedule (0 = Sunday)
+	CutoffWeekday *int `json:"cutoff_weekday,omitempty" example:"1"`
+	// Default is set when the account has no schedule and uses the server default
+	Default bool `json:"default" example:"false"`
+}
+
+// SettlementAccountResponse represents a seller's settlement account with its payout schedule
+type SettlementAccountResponse struct {
+	SellerID  string `json:"seller_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	AccountID string `json:"account_id" example:"660e8400-e29b-41d4-a716-446655440000"`
+	Status    string `json:"status" example:"ACTIVE"`
+	// OnHold is set when an account-level hold blocks every payout (next_payout_at is then omitted)
+	OnHold     bool             `json:"on_hold" example:"false"`
+	HoldReason string           `json:"hold_reason,omitempty" example:"NO_PAYOUT_WALLET"`
+	Schedule   ScheduleResponse `json:"schedule"`
+	// NextPayoutAt is the next cutoff of the schedule; settlements created until then are paid in that batch
+	NextPayoutAt *time.Time `json:"next_payout_at,omitempty"`
+}
+
+// ToSettlementAccountResponse converts the account and its schedule
+func ToSettlementAccountResponse(sellerID string, account *db.Account, schedule Schedule, holdReason string, now time.Time) SettlementAccountResponse {
+	response := SettlementAccountResponse{
+		SellerID:   sellerID,
+		AccountID:  account.ExternalID.String,
+		Status:     string(account.Status),
+		OnHold:     holdReason != "",
+		HoldReason: holdReason,
+		Schedule: ScheduleResponse{
+			Cadence: string(schedule.Cadence),
+			Default: schedule.Default,
+		},
+	}
+	if schedule.Cadence != db.SettlementSchedulesCadenceINSTANT {
+		hour := schedule.CutoffHour
+		response.Schedule.CutoffHour = &hour
+	}
+	if schedule.Cadence == db.SettlementSchedulesCadenceWEEKLY {
+		weekday := int(schedule.CutoffWeekday)
+		response.Schedule.CutoffWeekday = &weekday
+	}
+	if !response.OnHold {
+		next := schedule.NextCutoff(now)
+		response.NextPayoutAt = &next
+	}
+	return response
+}
+
+// ToPayoutApprovalResponse converts a payout approval with its parsed amount to response DTO
+func ToPayoutApprovalResponse(a *db.GetPayoutApprovalDetailRow, amount money.Money) PayoutApprovalResponse {
+	response := PayoutApprovalResponse{
+		ID:              a.ExternalID,
+		PayoutType:      string(a.PayoutType),
+		PayoutID:        a.BatchExternalID.String,
+		PayeeAccountID:  a.PayeeAccountExternalID.String,
+		Amount:          amount,
+		Status:          string(a.Status),
+		RequestedBy:     a.RequestedByExternalID.String,
+		FirstApprovedBy: a.FirstApprovedByExternalID.String,
+		DecidedBy:       a.DecidedByExternalID.String,
+		RejectReason:    a.RejectReason.String,
+		CreatedAt:       a.CreatedAt,
+	}
+	if a.PayoutType == db.PayoutApprovalsPayoutTypeNETPAYOUT {
+		response.PayoutID = a.NetPayoutExternalID.String
+	}
+	if a.FirstApprovedAt.Valid {
+		response.FirstApprovedAt = &a.FirstApprovedAt.Time
+	}
+	if a.DecidedAt.Valid {
+		response.DecidedAt = &a.DecidedAt.Time
+	}
+	return response
+}
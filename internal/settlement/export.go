@@ -0,0 +1,133 @@
+package settlement
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"go.uber.org/zap"
+)
+
+// exportChunkSize is the number of settlements read and written per export chunk
+const exportChunkSize = 500
+
+// ListBatches returns the seller's payout batches (latest cutoff first)
+func (s *Service) ListBatches(ctx context.Context, sellerExternalID string, req *ListBatchesRequest) (*ListBatchesResponse, error) {
+	q := s.txRunner.Queries()
+
+	account, err := s.getSellerAccount(ctx, q, sellerExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	rows, err := q.ListSettlementBatchesByAccount(ctx, db.ListSettlementBatchesByAccountParams{
+		PayeeAccountID: account.ID,
+		Limit:          int32(req.PageSize),
+		Offset:         int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list settlement batches", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := q.CountSettlementBatchesByAccount(ctx, account.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count settlement batches", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	batches := make([]BatchResponse, 0, len(rows))
+	for i := range rows {
+		amount, err := parseAmount(rows[i].TotalAmount)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("invalid stored amount",
+				zap.String("batch_external_id", rows[i].ExternalID),
+				zap.String("amount", rows[i].TotalAmount),
+				zap.Error(err),
+			)
+			return nil, errors.Internal("Invalid settlement amount")
+		}
+		batches = append(batches, ToBatchResponse(&rows[i], amount))
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListBatchesResponse{
+		Batches:    batches,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// ExportBatch streams the settlements of a payout batch to write in id-ordered chunks.
+// canView decides access from the external ID of the payee account's owner (NOT_FOUND when denied,
+// 다른 가맹점 배치 ID의 존재 여부를 노출하지 않음). An error from write stops the export and is returned as is.
+//
+// Why: id 커서 청크 조회 → 배치가 커도 한 번에 한 청크만 메모리에 유지, 긴 다운로드 동안 트랜잭션/락 없음
+func (s *Service) ExportBatch(ctx context.Context, batchExternalID string, canView func(ownerID string) bool, write func([]BatchExportRow) error) error {
+	q := s.txRunner.Queries()
+
+	batch, err := q.GetSettlementBatchByExternalID(ctx, batchExternalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return errors.NotFound("Settlement batch")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get settlement batch", zap.Error(err))
+		return errors.DBError(err)
+	}
+	if !canView(batch.OwnerExternalID.String) {
+		return errors.NotFound("Settlement batch")
+	}
+
+	var after uint64
+	for {
+		settlements, err := q.ListSettlementBatchItemsForExport(ctx, db.ListSettlementBatchItemsForExportParams{
+			BatchID: sql.NullInt64{Int64: int64(batch.ID), Valid: true},
+			AfterID: after,
+			Limit:   exportChunkSize,
+		})
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to list batch settlements for export", zap.Error(err))
+			return errors.DBError(err)
+		}
+		if len(settlements) == 0 {
+			return nil
+		}
+
+		rows := make([]BatchExportRow, 0, len(settlements))
+		for _, st := range settlements {
+			row := BatchExportRow{
+				BatchID:     batch.ExternalID,
+				PaymentID:   st.PaymentExternalID.String,
+				OrderNumber: st.OrderNumber,
+				Amount:      st.Amount,
+				FeeAmount:   st.FeeAmount,
+				NetAmount:   st.NetAmount,
+				TokenSymbol: st.TokenSymbol,
+				Status:      string(st.Status),
+				CreatedAt:   st.CreatedAt,
+			}
+			if st.SettledAt.Valid {
+				settledAt := st.SettledAt.Time
+				row.SettledAt = &settledAt
+			}
+			rows = append(rows, row)
+		}
+		if err := write(rows); err != nil {
+			return err
+		}
+
+		if len(settlements) < exportChunkSize {
+			return nil
+		}
+		after = settlements[len(settlements)-1].ID
+	}
+}
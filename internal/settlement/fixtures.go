@@ -0,0 +1,39 @@
+package settlement
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/fixtures"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// RegisterFixtures registers the canonical settlement DTO examples and operation responses
+func RegisterFixtures(r *fixtures.Registry) {
+	generatedAt := time.Date(2026, 1, 15, 9, 0, 0, 0, time.UTC)
+	payoutDate := time.Date(2026, 1, 19, 0, 0, 0, 0, time.UTC)
+
+	r.Operation("Forecast", http.StatusOK, ForecastResponse{
+		SellerID:        "550e8400-e29b-41d4-a716-446655440000",
+		GeneratedAt:     generatedAt,
+		HoldPeriodHours: 72,
+		TotalAmount:     money.MustParse("1250.00000000", "", 8),
+		Payouts: []ForecastPayout{{
+			PayoutDate:                payoutDate,
+			Amount:                    money.MustParse("1250.00000000", "", 8),
+			ScheduledAmount:           money.MustParse("1000.00000000", "", 8),
+			CapturedAmount:            money.MustParse("200.00000000", "", 8),
+			PendingConfirmationAmount: money.MustParse("50.00000000", "", 8),
+			Count:                     3,
+		}},
+		Items: []ForecastItem{{
+			Source:      "CAPTURED",
+			PaymentID:   "7c9e6679-7425-40de-944b-e07fc1f90ae7",
+			OrderNumber: "ORD-20260112-0001",
+			Amount:      money.MustParse("200.00000000", "", 8),
+			TokenSymbol: "USDC",
+			ReleaseAt:   generatedAt.Add(24 * time.Hour),
+			PayoutDate:  &payoutDate,
+		}},
+	})
+}
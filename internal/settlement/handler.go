@@ -0,0 +1,466 @@
+package settlement
+
+import (
+	"io"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for settlement operations
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new settlement handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers settlement routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	settlements := rg.Group("/settlements", middleware.RequireAuth())
+	{
+		settlements.GET("/forecast", h.Forecast)
+		settlements.GET("/gas-estimate", middleware.RequireRoles(middleware.RoleAdmin), h.EstimateGas)
+		settlements.POST("/netting", middleware.RequireRoles(middleware.RoleAdmin), h.RunNetting)
+		settlements.GET("/net-payouts", middleware.RequireRoles(middleware.RoleAdmin), h.ListNetPayouts)
+		settlements.GET("/payout-approvals", middleware.RequireRoles(middleware.RoleAdmin), h.ListPayoutApprovals)
+		settlements.POST("/payout-approvals/:id/approve", middleware.RequireRoles(middleware.RoleAdmin), h.ApprovePayout)
+		settlements.POST("/payout-approvals/:id/reject", middleware.RequireRoles(middleware.RoleAdmin), h.RejectPayout)
+		settlements.GET("/:id/export", h.ExportBatch)
+	}
+
+	accounts := rg.Group("/users/:id/settlement-account", middleware.RequireAuth())
+	{
+		accounts.GET("", h.GetSettlementAccount)
+		accounts.PUT("/schedule", h.SetSchedule)
+		accounts.GET("/batches", h.ListBatches)
+	}
+}
+
+// extractSellerID extracts the seller id from path and checks the caller may act as the seller
+func extractSellerID(c *gin.Context) (string, error) {
+	sellerID := c.Param("id")
+	if _, err := uuid.Parse(sellerID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+
+	principal, _ := middleware.GetPrincipal(c)
+	if !principal.CanActAs(sellerID) {
+		return "", errors.Forbidden("Cannot access another seller's settlement account")
+	}
+	return sellerID, nil
+}
+
+// Forecast godoc
+// @Summary Forecast upcoming payouts
+// @Description Project upcoming payout amounts and dates of a seller from scheduled settlements, captured payments
+// @Description and payments awaiting capture, applying the post-capture hold period and account-level holds.
+// @Description Payments awaiting capture are projected as if captured now. Payout dates follow the account's payout schedule.
+// @Description Admins may query any seller.
+// @Description Send Accept: text/csv to receive the forecast items as CSV.
+// @Tags settlements
+// @Produce json,text/csv
+// @Param seller_id query string false "Seller external ID (UUID, default: caller)"
+// @Success 200 {object} middleware.SuccessResponse{data=ForecastResponse} "Payout forecast"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid query parameters"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot view another seller's forecast, or settlements not enabled for this account (FEATURE_NOT_ENABLED)"
+// @Failure 404 {object} middleware.ErrorResponse "Seller not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/settlements/forecast [get]
+func (h *Handler) Forecast(c *gin.Context) {
+	var req ForecastRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+	sellerID := req.SellerID
+	if sellerID == "" {
+		sellerID = principal.UserExternalID
+	}
+	if !principal.CanActAs(sellerID) {
+		middleware.RespondError(c, errors.Forbidden("Cannot view another seller's forecast"))
+		return
+	}
+
+	result, err := h.service.Forecast(c.Request.Context(), sellerID, time.Now())
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOKNegotiated(c, "settlement-forecast", result, result.Items)
+}
+
+// EstimateGas godoc
+// @Summary Estimate payout gas cost
+// @Description Estimate the chain fee of paying out the pending settlement batch at current network conditions,
+// @Description per execution strategy: one transfer per payee (INDIVIDUAL) or multi-recipient transactions (BATCHED).
+// @Description Individual transfer gas is estimated on-chain (configured fallback on failure); batched gas is modeled from configuration.
+// @Description Pending net payouts of netted counterparty pairs are paid per payee together with the settlements.
+// @Description Payees without a Primary wallet and payouts held for approval (AWAITING_APPROVAL, PAYOUT_REJECTED) are listed as excluded. Admin only.
+// @Tags settlements
+// @Produce json
+// @Param urgency query string false "Fee urgency: low, standard (default), high"
+// @Success 200 {object} middleware.SuccessResponse{data=GasEstimateResponse} "Gas estimate"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid urgency"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Failure 503 {object} middleware.ErrorResponse "Chain unavailable"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/settlements/gas-estimate [get]
+func (h *Handler) EstimateGas(c *gin.Context) {
+	urgency, err := chain.ParseUrgency(c.Query("urgency"))
+	if err != nil {
+		middleware.RespondError(c, errors.InvalidInput("urgency must be one of low, standard, high"))
+		return
+	}
+
+	result, err := h.service.EstimateGas(c.Request.Context(), time.Now(), urgency)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// RunNetting godoc
+// @Summary Run settlement netting
+// @Description Offset the mutual obligations of counterparty pairs within a settlement window - Admin only. Audited.
+// @Description For every account pair with pending settlements in both directions, the settlements are replaced by a single
+// @Description net payout to the side owed more (paid in the payout batch instead of the individual settlements);
+// @Description pairs that offset exactly are completed without a transfer (OFFSET).
+// @Description The body is optional: the window defaults to the configured netting window (SETTLEMENT_NETTING_WINDOW) ending now.
+// @Tags settlements
+// @Accept json
+// @Produce json
+// @Param request body RunNettingRequest false "Settlement window (created_at in [window_start, window_end))"
+// @Success 200 {object} middleware.SuccessResponse{data=NettingRunResponse} "Netting result"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid window"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Admin role required"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/settlements/netting [post]
+func (h *Handler) RunNetting(c *gin.Context) {
+	var req RunNettingRequest
+	// NOTE: 본문 생략 시 기본 윈도우 사용
+	if err := c.ShouldBindJSON(&req); err != nil && err != io.EOF {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.RunNetting(c.Request.Context(), &req, time.Now(), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// ListNetPayouts godoc
+// @Summary List net payouts
+// @Description Get paginated net payouts of netted counterparty pairs with an optional status filter (newest first) - Admin only
+// @Description Send Accept: text/csv to receive the same results (same filters) as CSV; amounts are plain decimal cells (e.g. 300.00000000).
+// @Tags settlements
+// @Produce json,text/csv
+// @Param status query string false "Filter by status" Enums(PENDING, PROCESSING, COMPLETED, FAILED, OFFSET)
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} middleware.SuccessResponse{data=ListNetPayoutsResponse} "Net payout list"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid query parameters"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Admin role required"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/settlements/net-payouts [get]
+func (h *Handler) ListNetPayouts(c *gin.Context) {
+	var req ListNetPayoutsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListNetPayouts(c.Request.Context(), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOKNegotiated(c, "settlement-net-payouts", result, result.NetPayouts)
+}
+
+// ListPayoutApprovals godoc
+// @Summary List payout approvals
+// @Description Get paginated maker-checker approvals of payouts at or above the approval threshold (SETTLEMENT_PAYOUT_APPROVAL_THRESHOLD),
+// @Description newest first - Admin only. Lists payouts awaiting approval (PENDING) unless another status is requested.
+// @Tags settlements
+// @Produce json
+// @Param status query string false "Filter by status" Enums(PENDING, APPROVED, REJECTED) default(PENDING)
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} middleware.SuccessResponse{data=ListPayoutApprovalsResponse} "Payout approval list"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid query parameters"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Admin role required"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/settlements/payout-approvals [get]
+func (h *Handler) ListPayoutApprovals(c *gin.Context) {
+	var req ListPayoutApprovalsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListPayoutApprovals(c.Request.Context(), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// ApprovePayout godoc
+// @Summary Approve a held payout
+// @Description Approve a payout held for maker-checker approval - Admin only. Audited.
+// @Description The approver must differ from the payout's maker (the admin who ran netting). Batches cut by the scheduler
+// @Description have no maker: the first approval is recorded and a second, different admin completes it.
+// @Description Approved payouts are included in the payout run again. The payee's owner must have a verified phone number.
+// @Tags settlements
+// @Produce json
+// @Param id path string true "Payout approval ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=PayoutApprovalResponse} "Approval recorded"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Admin role required, approver is the payout's maker, or payee phone number not verified"
+// @Failure 404 {object} middleware.ErrorResponse "Payout approval not found"
+// @Failure 409 {object} middleware.ErrorResponse "Payout approval already decided"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/settlements/payout-approvals/{id}/approve [post]
+func (h *Handler) ApprovePayout(c *gin.Context) {
+	approvalID := c.Param("id")
+	if _, err := uuid.Parse(approvalID); err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Invalid UUID format"))
+		return
+	}
+
+	result, err := h.service.ApprovePayout(c.Request.Context(), approvalID, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// RejectPayout godoc
+// @Summary Reject a held payout
+// @Description Reject a payout held for maker-checker approval - Admin only. Audited.
+// @Description A rejected payout stays excluded from the payout run (PAYOUT_REJECTED).
+// @Tags settlements
+// @Accept json
+// @Produce json
+// @Param id path string true "Payout approval ID (UUID)"
+// @Param request body RejectPayoutRequest true "Rejection reason"
+// @Success 200 {object} middleware.SuccessResponse{data=PayoutApprovalResponse} "Payout rejected"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Admin role required"
+// @Failure 404 {object} middleware.ErrorResponse "Payout approval not found"
+// @Failure 409 {object} middleware.ErrorResponse "Payout approval already decided"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/settlements/payout-approvals/{id}/reject [post]
+func (h *Handler) RejectPayout(c *gin.Context) {
+	approvalID := c.Param("id")
+	if _, err := uuid.Parse(approvalID); err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Invalid UUID format"))
+		return
+	}
+
+	var req RejectPayoutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.RejectPayout(c.Request.Context(), approvalID, &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// GetSettlementAccount godoc
+// @Summary Get settlement account
+// @Description Get the seller's settlement account with its payout schedule and the next payout date
+// @Description (next cutoff of the schedule; omitted while an account-level hold blocks payouts). Admins may query any seller.
+// @Tags settlements
+// @Produce json
+// @Param id path string true "Seller external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=SettlementAccountResponse} "Settlement account"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid user ID or user is not a seller"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot access another seller's settlement account, or settlements not enabled for this account (FEATURE_NOT_ENABLED)"
+// @Failure 404 {object} middleware.ErrorResponse "Seller or settlement account not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/users/{id}/settlement-account [get]
+func (h *Handler) GetSettlementAccount(c *gin.Context) {
+	sellerID, err := extractSellerID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	result, err := h.service.GetSettlementAccount(c.Request.Context(), sellerID, time.Now())
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// SetSchedule godoc
+// @Summary Set payout schedule
+// @Description Set the payout schedule of the seller's settlement account. Audited.
+// @Description Pending settlements are cut into a payout batch at each cutoff: INSTANT on every scheduler run,
+// @Description DAILY (T+1) at cutoff_hour UTC, WEEKLY at cutoff_hour UTC on cutoff_weekday (0 = Sunday).
+// @Description Settlements not yet cut are paid at the next cutoff of the new schedule.
+// @Tags settlements
+// @Accept json
+// @Produce json
+// @Param id path string true "Seller external ID (UUID)"
+// @Param request body SetScheduleRequest true "Payout schedule"
+// @Success 200 {object} middleware.SuccessResponse{data=SettlementAccountResponse} "Schedule set"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid request or user is not a seller"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot access another seller's settlement account, or settlements not enabled for this account (FEATURE_NOT_ENABLED)"
+// @Failure 404 {object} middleware.ErrorResponse "Seller or settlement account not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/users/{id}/settlement-account/schedule [put]
+func (h *Handler) SetSchedule(c *gin.Context) {
+	sellerID, err := extractSellerID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req SetScheduleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.SetSchedule(c.Request.Context(), sellerID, &req, time.Now(), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// ListBatches godoc
+// @Summary List payout batches
+// @Description Get the payout batches of the seller's settlement account (latest cutoff first). Admins may query any seller.
+// @Description Each batch can be exported as CSV via GET /api/v1/settlements/{id}/export.
+// @Tags settlements
+// @Produce json
+// @Param id path string true "Seller external ID (UUID)"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} middleware.SuccessResponse{data=ListBatchesResponse} "Payout batch list"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid user ID, query parameters or user is not a seller"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot access another seller's settlement account"
+// @Failure 404 {object} middleware.ErrorResponse "Seller or settlement account not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/users/{id}/settlement-account/batches [get]
+func (h *Handler) ListBatches(c *gin.Context) {
+	sellerID, err := extractSellerID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req ListBatchesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListBatches(c.Request.Context(), sellerID, &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// ExportBatch godoc
+// @Summary Export payout batch
+// @Description Stream the settlements of a payout batch as a CSV attachment for reconciliation (settlement id order).
+// @Description Rows are read and flushed in chunks, so large batches are streamed without buffering the whole export.
+// @Description Only the payee seller or an admin can export a batch.
+// @Tags settlements
+// @Produce text/csv
+// @Param id path string true "Payout batch external ID (UUID)"
+// @Success 200 {file} file "settlement-batch-{id}.csv"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Settlement batch not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/settlements/{id}/export [get]
+func (h *Handler) ExportBatch(c *gin.Context) {
+	batchID := c.Param("id")
+	if _, err := uuid.Parse(batchID); err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Invalid UUID format"))
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+
+	middleware.RespondCSVStream(c, "settlement-batch-"+batchID, BatchExportRow{}, func(write func(rows any) error) error {
+		return h.service.ExportBatch(c.Request.Context(), batchID, principal.CanActAs, func(rows []BatchExportRow) error {
+			return write(rows)
+		})
+	})
+}
@@ -0,0 +1,349 @@
+package settlement
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/limits"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Audit log identifiers of netting
+const (
+	actionNetted      = "SETTLEMENT_NETTED"
+	resourceNetPayout = "SETTLEMENT_NET_PAYOUT"
+	maxNettingWindow  = 31 * 24 * time.Hour
+)
+
+// nettingPair accumulates the mutual obligations of two accounts (low < high)
+type nettingPair struct {
+	low, high     uint64
+	lowToHigh     money.Money
+	highToLow     money.Money
+	settlementIDs []uint64
+}
+
+// RunNetting offsets the mutual obligations of counterparty pairs within a settlement window (admin).
+// For every pair of accounts with pending settlements in both directions, the settlements are replaced
+// by a single net payout to the side owed more; pairs that offset exactly are completed without a transfer.
+// A net payout counts against the payee's withdrawal limit; pairs whose payee is over the limit are left
+// unnetted and paid out through the payee's schedule instead.
+//
+// Why:
+//   - 양방향 거래 쌍은 방향별 지급 2건 → 순지급 1건 (또는 0건)으로 on-chain 전송/가스 절감
+//   - 대상 정산 row-lock + net_payout_id IS NULL 조건 → 동시 실행/지급 배치와 겹쳐도 정산은 한 번만 상계
+//   - 한도 초과 쌍만 제외 → 한 판매자의 한도 때문에 상계 실행 전체가 실패하지 않음
+func (s *Service) RunNetting(ctx context.Context, req *RunNettingRequest, now time.Time, actor audit.Actor) (*NettingRunResponse, error) {
+	// 1. Resolve window
+	windowEnd := now.UTC()
+	if req.WindowEnd != nil {
+		windowEnd = req.WindowEnd.UTC()
+	}
+	windowStart := windowEnd.Add(-s.config.NettingWindow)
+	if req.WindowStart != nil {
+		windowStart = req.WindowStart.UTC()
+	}
+	if !windowStart.Before(windowEnd) {
+		return nil, errors.InvalidInput("window_start must be before window_end")
+	}
+	if windowEnd.After(now) {
+		return nil, errors.InvalidInput("window_end must not be in the future")
+	}
+	if windowEnd.Sub(windowStart) > maxNettingWindow {
+		return nil, errors.InvalidInput("Netting window must not exceed 31 days")
+	}
+
+	// 2. Net + record (one transaction)
+	var pairCount, settlementCount, limitedCount int
+	payoutIDs, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) ([]uint64, error) {
+		rows, err := q.ListNettableSettlementsForUpdate(ctx, db.ListNettableSettlementsForUpdateParams{
+			WindowStart: windowStart,
+			WindowEnd:   windowEnd,
+		})
+		if err != nil {
+			return nil, err
+		}
+		pairs, err := groupPairs(rows)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]uint64, 0, len(pairs))
+		for _, pair := range pairs {
+			id, err := s.createNetPayout(ctx, q, pair, windowStart, windowEnd, now, actor)
+			if err != nil {
+				if errors.From(err).Code == errors.CodeLimitExceeded {
+					limitedCount++
+					continue
+				}
+				return nil, err
+			}
+			ids = append(ids, id)
+			pairCount++
+			settlementCount += len(pair.settlementIDs)
+		}
+		return ids, nil
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to run settlement netting", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	// 3. Summarize
+	result := &NettingRunResponse{
+		WindowStart:  windowStart,
+		WindowEnd:    windowEnd,
+		Pairs:        pairCount,
+		Settlements:  settlementCount,
+		LimitedPairs: limitedCount,
+		GrossAmount:  money.Zero("", amountScale),
+		NetAmount:    money.Zero("", amountScale),
+		NetPayouts:   make([]NetPayoutResponse, 0, len(payoutIDs)),
+	}
+	q := s.txRunner.Queries()
+	for _, id := range payoutIDs {
+		detail, err := q.GetSettlementNetPayoutDetail(ctx, id)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to get net payout", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		payout, err := s.toNetPayoutResponse(ctx, &detail)
+		if err != nil {
+			return nil, err
+		}
+		result.GrossAmount = result.GrossAmount.Add(payout.AToBAmount).Add(payout.BToAAmount)
+		result.NetAmount = result.NetAmount.Add(payout.NetAmount)
+		result.TransfersBefore += 2
+		if payout.NetAmount.Sign() > 0 {
+			result.TransfersAfter++
+		}
+		result.NetPayouts = append(result.NetPayouts, *payout)
+	}
+
+	logctx.From(ctx, s.logger).Info("settlement netting completed",
+		zap.Time("window_start", windowStart),
+		zap.Time("window_end", windowEnd),
+		zap.Int("pairs", result.Pairs),
+		zap.Int("settlements", result.Settlements),
+		zap.Int("limited_pairs", result.LimitedPairs),
+		zap.Stringer("gross_amount", result.GrossAmount),
+		zap.Stringer("net_amount", result.NetAmount),
+	)
+	return result, nil
+}
+
+// ListNetPayouts returns net payouts with an optional status filter, newest first (admin)
+func (s *Service) ListNetPayouts(ctx context.Context, req *ListNetPayoutsRequest) (*ListNetPayoutsResponse, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	var status db.NullSettlementNetPayoutsStatus
+	if req.Status != "" {
+		status = db.NullSettlementNetPayoutsStatus{SettlementNetPayoutsStatus: db.SettlementNetPayoutsStatus(req.Status), Valid: true}
+	}
+
+	q := s.txRunner.Queries()
+	rows, err := q.ListSettlementNetPayouts(ctx, db.ListSettlementNetPayoutsParams{
+		Status: status,
+		Limit:  int32(req.PageSize),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list net payouts", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := q.CountSettlementNetPayouts(ctx, status)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count net payouts", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	payouts := make([]NetPayoutResponse, 0, len(rows))
+	for i := range rows {
+		detail := db.GetSettlementNetPayoutDetailRow(rows[i])
+		payout, err := s.toNetPayoutResponse(ctx, &detail)
+		if err != nil {
+			return nil, err
+		}
+		payouts = append(payouts, *payout)
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListNetPayoutsResponse{
+		NetPayouts: payouts,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// createNetPayout records the pair's net payout and binds its settlements to it; returns the net payout ID,
+// or LIMIT_EXCEEDED (nothing recorded) when the net amount exceeds the payee's withdrawal limit.
+// Must be called within the netting transaction.
+func (s *Service) createNetPayout(ctx context.Context, q *db.Queries, pair *nettingPair, windowStart, windowEnd, now time.Time, actor audit.Actor) (uint64, error) {
+	status := db.SettlementNetPayoutsStatusPENDING
+	var payee sql.NullInt64
+	net := pair.lowToHigh.Sub(pair.highToLow)
+	switch net.Sign() {
+	case 1:
+		payee = sql.NullInt64{Int64: int64(pair.high), Valid: true}
+	case -1:
+		payee = sql.NullInt64{Int64: int64(pair.low), Valid: true}
+		net = pair.highToLow.Sub(pair.lowToHigh)
+	default:
+		status = db.SettlementNetPayoutsStatusOFFSET
+	}
+	// 순지급액만 출금 한도에 누적 (완전 상계는 지급 없음)
+	if payee.Valid {
+		if err := s.enforcer.Reserve(ctx, q, uint64(payee.Int64), limits.KindWithdrawal, net, now); err != nil {
+			return 0, err
+		}
+	}
+
+	result, err := q.CreateSettlementNetPayout(ctx, db.CreateSettlementNetPayoutParams{
+		ExternalID:      uuid.New().String(),
+		WindowStart:     windowStart,
+		WindowEnd:       windowEnd,
+		AccountLowID:    pair.low,
+		AccountHighID:   pair.high,
+		LowToHighAmount: pair.lowToHigh.String(),
+		HighToLowAmount: pair.highToLow.String(),
+		PayeeAccountID:  payee,
+		NetAmount:       net.String(),
+		SettlementCount: uint32(len(pair.settlementIDs)),
+		Status:          status,
+		CreatedBy:       sql.NullInt64{Int64: int64(actor.ID), Valid: actor.ID != 0},
+	})
+	if err != nil {
+		return 0, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	netPayoutID := sql.NullInt64{Int64: id, Valid: true}
+
+	for _, settlementID := range pair.settlementIDs {
+		if err := q.AssignSettlementNetPayout(ctx, db.AssignSettlementNetPayoutParams{
+			NetPayoutID: netPayoutID,
+			ID:          settlementID,
+		}); err != nil {
+			return 0, err
+		}
+	}
+	// 완전 상계: 지급할 금액이 없으므로 묶인 정산은 즉시 완료
+	if status == db.SettlementNetPayoutsStatusOFFSET {
+		if err := q.CompleteOffsetSettlements(ctx, netPayoutID); err != nil {
+			return 0, err
+		}
+	}
+	// 기준 금액 이상 순지급은 상계 실행자(maker)가 아닌 사용자의 승인 전까지 지급 대상에서 제외
+	if payee.Valid {
+		if _, err := s.requestApproval(ctx, q, db.PayoutApprovalsPayoutTypeNETPAYOUT, uint64(id), uint64(payee.Int64), net, actor); err != nil {
+			return 0, err
+		}
+	}
+
+	newValue := map[string]any{
+		"account_low_id":     pair.low,
+		"account_high_id":    pair.high,
+		"low_to_high_amount": pair.lowToHigh.String(),
+		"high_to_low_amount": pair.highToLow.String(),
+		"net_amount":         net.String(),
+		"settlement_ids":     pair.settlementIDs,
+		"status":             string(status),
+		"window_start":       windowStart,
+		"window_end":         windowEnd,
+	}
+	if payee.Valid {
+		newValue["payee_account_id"] = payee.Int64
+	}
+	if err := audit.Record(ctx, q, actor, audit.Entry{
+		Action:       actionNetted,
+		ResourceType: resourceNetPayout,
+		ResourceID:   uint64(id),
+		NewValue:     newValue,
+	}); err != nil {
+		return 0, err
+	}
+	return uint64(id), nil
+}
+
+// toNetPayoutResponse parses the stored amounts and converts the net payout
+func (s *Service) toNetPayoutResponse(ctx context.Context, n *db.GetSettlementNetPayoutDetailRow) (*NetPayoutResponse, error) {
+	amounts := make([]money.Money, 0, 3)
+	for _, value := range []string{n.LowToHighAmount, n.HighToLowAmount, n.NetAmount} {
+		amount, err := parseAmount(value)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("invalid stored amount",
+				zap.String("net_payout_external_id", n.ExternalID),
+				zap.String("amount", value),
+				zap.Error(err),
+			)
+			return nil, errors.Internal("Invalid settlement amount")
+		}
+		amounts = append(amounts, amount)
+	}
+	response := ToNetPayoutResponse(n, amounts[0], amounts[1], amounts[2])
+	return &response, nil
+}
+
+// groupPairs groups nettable settlements by account pair (ordered by the lower account ID)
+func groupPairs(rows []db.ListNettableSettlementsForUpdateRow) ([]*nettingPair, error) {
+	byPair := make(map[[2]uint64]*nettingPair)
+	var pairs []*nettingPair
+	for _, row := range rows {
+		amount, err := parseAmount(row.NetAmount)
+		if err != nil {
+			return nil, errors.Internal("Invalid settlement amount").WithError(err)
+		}
+
+		low, high := row.PayerAccountID, row.PayeeAccountID
+		if low > high {
+			low, high = high, low
+		}
+		key := [2]uint64{low, high}
+		pair, ok := byPair[key]
+		if !ok {
+			pair = &nettingPair{
+				low:       low,
+				high:      high,
+				lowToHigh: money.Zero("", amountScale),
+				highToLow: money.Zero("", amountScale),
+			}
+			byPair[key] = pair
+			pairs = append(pairs, pair)
+		}
+
+		if row.PayerAccountID == low {
+			pair.lowToHigh = pair.lowToHigh.Add(amount)
+		} else {
+			pair.highToLow = pair.highToLow.Add(amount)
+		}
+		pair.settlementIDs = append(pair.settlementIDs, row.ID)
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].low != pairs[j].low {
+			return pairs[i].low < pairs[j].low
+		}
+		return pairs[i].high < pairs[j].high
+	})
+	return pairs, nil
+}
@@ -0,0 +1,222 @@
+package settlement
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"go.uber.org/zap"
+)
+
+// Audit log identifiers of payout schedules
+const (
+	actionScheduleSet = "SETTLEMENT_SCHEDULE_SET"
+	resourceSchedule  = "SETTLEMENT_SCHEDULE"
+)
+
+// Schedule is the payout cadence of a settlement account.
+// Settlements created up to a cutoff are cut into one payout batch at that cutoff.
+type Schedule struct {
+	// Cadence is INSTANT (every scheduler run), DAILY (T+1) or WEEKLY
+	Cadence db.SettlementSchedulesCadence
+	// CutoffHour is the UTC hour of the cutoff (0-23), unused for INSTANT
+	CutoffHour int
+	// CutoffWeekday is the weekday of the WEEKLY cutoff
+	CutoffWeekday time.Weekday
+	// Default reports that the account has no schedule and uses the server default
+	Default bool
+}
+
+// LastCutoff returns the latest cutoff at or before t
+func (sc Schedule) LastCutoff(t time.Time) time.Time {
+	t = t.UTC()
+	if sc.Cadence == db.SettlementSchedulesCadenceINSTANT {
+		return t.Truncate(time.Second) // TIMESTAMP 정밀도
+	}
+
+	cutoff := time.Date(t.Year(), t.Month(), t.Day(), sc.CutoffHour, 0, 0, 0, time.UTC)
+	if sc.Cadence == db.SettlementSchedulesCadenceWEEKLY {
+		cutoff = cutoff.AddDate(0, 0, -((int(cutoff.Weekday()) - int(sc.CutoffWeekday) + 7) % 7))
+		if cutoff.After(t) {
+			cutoff = cutoff.AddDate(0, 0, -7)
+		}
+		return cutoff
+	}
+	if cutoff.After(t) {
+		cutoff = cutoff.AddDate(0, 0, -1)
+	}
+	return cutoff
+}
+
+// NextCutoff returns the first cutoff at or after t (INSTANT: t itself, paid on the next scheduler run)
+func (sc Schedule) NextCutoff(t time.Time) time.Time {
+	t = t.UTC()
+	if sc.Cadence == db.SettlementSchedulesCadenceINSTANT {
+		return t
+	}
+
+	cutoff := time.Date(t.Year(), t.Month(), t.Day(), sc.CutoffHour, 0, 0, 0, time.UTC)
+	if sc.Cadence == db.SettlementSchedulesCadenceWEEKLY {
+		cutoff = cutoff.AddDate(0, 0, (int(sc.CutoffWeekday)-int(cutoff.Weekday())+7)%7)
+		if cutoff.Before(t) {
+			cutoff = cutoff.AddDate(0, 0, 7)
+		}
+		return cutoff
+	}
+	if cutoff.Before(t) {
+		cutoff = cutoff.AddDate(0, 0, 1)
+	}
+	return cutoff
+}
+
+// defaultSchedule is the schedule of accounts without one (DAILY at the configured batch hour)
+func (s *Service) defaultSchedule() Schedule {
+	return Schedule{
+		Cadence:    db.SettlementSchedulesCadenceDAILY,
+		CutoffHour: s.config.BatchHour,
+		Default:    true,
+	}
+}
+
+// accountSchedule loads the account's payout schedule, falling back to the default schedule
+func (s *Service) accountSchedule(ctx context.Context, q *db.Queries, accountID uint64) (Schedule, error) {
+	row, err := q.GetSettlementScheduleByAccount(ctx, accountID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return s.defaultSchedule(), nil
+		}
+		return Schedule{}, err
+	}
+	return Schedule{
+		Cadence:       row.Cadence,
+		CutoffHour:    int(row.CutoffHour),
+		CutoffWeekday: time.Weekday(row.CutoffWeekday),
+	}, nil
+}
+
+// GetSettlementAccount returns the seller's settlement account with its payout schedule and next payout date
+func (s *Service) GetSettlementAccount(ctx context.Context, sellerExternalID string, now time.Time) (*SettlementAccountResponse, error) {
+	q := s.txRunner.Queries()
+
+	account, err := s.getSellerAccount(ctx, q, sellerExternalID)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := s.accountSchedule(ctx, q, account.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to get payout schedule", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	response := ToSettlementAccountResponse(sellerExternalID, account, schedule, accountHoldReason(account), now)
+	return &response, nil
+}
+
+// SetSchedule creates or replaces the payout schedule of the seller's settlement account. Audited.
+// Settlements not yet cut are paid at the next cutoff of the new schedule.
+func (s *Service) SetSchedule(ctx context.Context, sellerExternalID string, req *SetScheduleRequest, now time.Time, actor audit.Actor) (*SettlementAccountResponse, error) {
+	// 1. Resolve schedule
+	schedule := Schedule{
+		Cadence:    db.SettlementSchedulesCadence(req.Cadence),
+		CutoffHour: s.config.BatchHour,
+	}
+	if req.CutoffHour != nil {
+		schedule.CutoffHour = *req.CutoffHour
+	}
+	if schedule.Cadence == db.SettlementSchedulesCadenceWEEKLY {
+		schedule.CutoffWeekday = time.Weekday(*req.CutoffWeekday)
+	}
+
+	// 2. Resolve account
+	account, err := s.getSellerAccount(ctx, s.txRunner.Queries(), sellerExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Upsert (uk_settlement_schedule_account) + audit
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		previous, err := q.GetSettlementScheduleByAccount(ctx, account.ID)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		var oldValue map[string]any
+		if err == nil {
+			oldValue = scheduleAuditValue(previous.Cadence, int(previous.CutoffHour), time.Weekday(previous.CutoffWeekday))
+		}
+
+		if err := q.CreateOrUpdateSettlementSchedule(ctx, db.CreateOrUpdateSettlementScheduleParams{
+			AccountID:     account.ID,
+			Cadence:       schedule.Cadence,
+			CutoffHour:    uint8(schedule.CutoffHour),
+			CutoffWeekday: uint8(schedule.CutoffWeekday),
+			UpdatedBy:     sql.NullInt64{Int64: int64(actor.ID), Valid: actor.ID != 0},
+		}); err != nil {
+			return err
+		}
+		current, err := q.GetSettlementScheduleByAccount(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionScheduleSet,
+			ResourceType: resourceSchedule,
+			ResourceID:   current.ID,
+			OldValue:     oldValue,
+			NewValue:     scheduleAuditValue(schedule.Cadence, schedule.CutoffHour, schedule.CutoffWeekday),
+		})
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to set payout schedule", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("payout schedule set",
+		zap.String("seller_external_id", sellerExternalID),
+		zap.String("cadence", string(schedule.Cadence)),
+		zap.Int("cutoff_hour", schedule.CutoffHour),
+	)
+	response := ToSettlementAccountResponse(sellerExternalID, account, schedule, accountHoldReason(account), now)
+	return &response, nil
+}
+
+// getSellerAccount resolves the settlement account owned by the seller
+func (s *Service) getSellerAccount(ctx context.Context, q *db.Queries, sellerExternalID string) (*db.Account, error) {
+	seller, err := q.GetUserByExternalID(ctx, sql.NullString{String: sellerExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Seller")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get seller", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if seller.Role != db.UsersRoleSELLER && seller.Role != db.UsersRoleBOTH {
+		return nil, errors.InvalidInput("Only sellers have a settlement account")
+	}
+
+	account, err := q.GetAccountByOwnerID(ctx, sql.NullInt64{Int64: int64(seller.ID), Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Settlement account")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get seller account", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &account, nil
+}
+
+// scheduleAuditValue renders a schedule for the audit log (weekday only for WEEKLY)
+func scheduleAuditValue(cadence db.SettlementSchedulesCadence, cutoffHour int, cutoffWeekday time.Weekday) map[string]any {
+	value := map[string]any{
+		"cadence":     string(cadence),
+		"cutoff_hour": cutoffHour,
+	}
+	if cadence == db.SettlementSchedulesCadenceWEEKLY {
+		value["cutoff_weekday"] = int(cutoffWeekday)
+	}
+	return value
+}
@@ -0,0 +1,267 @@
+package settlement
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/limits"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// SchedulerConfig holds payout schedule cutoff settings
+type SchedulerConfig struct {
+	// Interval is the period of the cutoff run (cadence of INSTANT schedules)
+	Interval time.Duration
+	// BatchSize is the number of payee accounts read per page
+	BatchSize int
+}
+
+// CutReport summarizes one cutoff run
+type CutReport struct {
+	Batches     int
+	Settlements int
+	Failed      int
+	// Frozen counts payees skipped because their account is frozen
+	Frozen int
+	// Limited counts payees skipped because the batch would exceed their withdrawal limit
+	Limited int
+}
+
+// cutSkip is why a payee's due settlements were left unbatched
+type cutSkip int
+
+const (
+	cutNotSkipped cutSkip = iota
+	cutSkipFrozen
+	cutSkipLimited
+)
+
+// Scheduler cuts pending settlements into payout batches at the cutoff of each payee's payout schedule.
+// Settlements created up to the latest cutoff that are neither batched nor netted form one batch per payee and cutoff.
+// Frozen payee accounts are skipped; their settlements are cut on the first run after the account is unfrozen.
+// A batch counts against the payee's withdrawal limit; payees over the limit are skipped until the limit period rolls over.
+type Scheduler struct {
+	txRunner *pkgdb.TxRunner
+	service  *Service
+	config   SchedulerConfig
+	logger   *zap.Logger
+}
+
+// NewScheduler creates a new payout scheduler
+func NewScheduler(txRunner *pkgdb.TxRunner, service *Service, config SchedulerConfig, logger *zap.Logger) *Scheduler {
+	return &Scheduler{
+		txRunner: txRunner,
+		service:  service,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Run executes the cutoff run periodically until ctx is canceled
+func (w *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	logctx.From(ctx, w.logger).Info("payout scheduler started",
+		zap.Duration("interval", w.config.Interval),
+		zap.Int("batch_size", w.config.BatchSize),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logctx.From(ctx, w.logger).Info("payout scheduler stopped")
+			return
+		case <-ticker.C:
+			report, err := w.RunOnce(ctx, time.Now().UTC())
+			if err != nil {
+				logctx.From(ctx, w.logger).Error("payout scheduler run failed", zap.Error(err))
+				continue
+			}
+			if report.Batches+report.Failed+report.Frozen+report.Limited > 0 {
+				logctx.From(ctx, w.logger).Info("payout scheduler run completed",
+					zap.Int("batches", report.Batches),
+					zap.Int("settlements", report.Settlements),
+					zap.Int("failed", report.Failed),
+					zap.Int("frozen", report.Frozen),
+					zap.Int("limited", report.Limited),
+				)
+			}
+		}
+	}
+}
+
+// RunOnce cuts the due settlements of every payee with unbatched settlements, paging by account ID.
+// A failing payee is logged and counted; it is retried on the next run.
+func (w *Scheduler) RunOnce(ctx context.Context, now time.Time) (*CutReport, error) {
+	report := &CutReport{}
+
+	var after uint64
+	for {
+		payees, err := w.txRunner.Queries().ListUnbatchedSettlementPayees(ctx, db.ListUnbatchedSettlementPayeesParams{
+			AfterAccountID: after,
+			Limit:          int32(w.config.BatchSize),
+		})
+		if err != nil {
+			return report, fmt.Errorf("list unbatched payees: %w", err)
+		}
+
+		for _, accountID := range payees {
+			if ctx.Err() != nil {
+				return report, nil
+			}
+			after = accountID
+
+			count, skip, err := w.cut(ctx, accountID, now)
+			if err != nil {
+				report.Failed++
+				logctx.From(ctx, w.logger).Error("payout cutoff failed",
+					zap.Uint64("account_id", accountID),
+					zap.Error(err),
+				)
+				continue
+			}
+			switch skip {
+			case cutSkipFrozen:
+				report.Frozen++
+				continue
+			case cutSkipLimited:
+				report.Limited++
+				continue
+			}
+			if count > 0 {
+				report.Batches++
+				report.Settlements += count
+			}
+		}
+
+		if len(payees) == 0 || len(payees) < w.config.BatchSize {
+			return report, nil
+		}
+	}
+}
+
+// cut batches the payee's settlements created up to the latest cutoff of its schedule; returns the settlements batched,
+// or why nothing was batched (frozen account, withdrawal limit exceeded).
+//
+// Why:
+// - 정산 row-lock 후 미배치 조건으로 묶음 → 여러 인스턴스가 동시에 실행해도 정산은 한 배치에만 포함
+// - 마감 시점이 아직 오지 않은 정산(cutoff 이후 생성)은 다음 마감까지 대기
+// - 동결 확인은 원장 출금과 같은 계정 row-lock 하에서 (정산 → 계정 순서로 잠금, 지급 실행과 동일)
+// - 출금 한도 사용액은 배치 생성과 같은 트랜잭션에서 누적 → 배치 생성 실패 시 롤백으로 함께 해제
+func (w *Scheduler) cut(ctx context.Context, accountID uint64, now time.Time) (int, cutSkip, error) {
+	schedule, err := w.service.accountSchedule(ctx, w.txRunner.Queries(), accountID)
+	if err != nil {
+		return 0, cutNotSkipped, fmt.Errorf("get payout schedule: %w", err)
+	}
+	cutoff := schedule.LastCutoff(now)
+
+	var total money.Money
+	var held bool
+	skip := cutNotSkipped
+	var limitErr *errors.AppError
+	count, err := pkgdb.WithTxResult(ctx, w.txRunner, func(q *db.Queries) (int, error) {
+		rows, err := q.ListBatchableSettlementsForUpdate(ctx, db.ListBatchableSettlementsForUpdateParams{
+			PayeeAccountID: accountID,
+			Cutoff:         cutoff,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("lock settlements: %w", err)
+		}
+		if len(rows) == 0 {
+			return 0, nil
+		}
+
+		// Frozen payee - no batch or approval request until unfrozen
+		payee, err := q.GetAccountForUpdate(ctx, accountID)
+		if err != nil {
+			return 0, fmt.Errorf("lock payee account: %w", err)
+		}
+		if payee.FrozenAt.Valid {
+			skip = cutSkipFrozen
+			return 0, nil
+		}
+
+		total = money.Zero("", amountScale)
+		for _, row := range rows {
+			amount, err := parseAmount(row.NetAmount)
+			if err != nil {
+				return 0, fmt.Errorf("invalid settlement %d amount %q: %w", row.ID, row.NetAmount, err)
+			}
+			total = total.Add(amount)
+		}
+
+		// Withdrawal limit - over the limit, the settlements wait for the next period (한도 검사 실패 시 아무것도 누적되지 않음)
+		if err := w.service.enforcer.Reserve(ctx, q, accountID, limits.KindWithdrawal, total, now); err != nil {
+			if appErr := errors.From(err); appErr.Code == errors.CodeLimitExceeded {
+				skip, limitErr = cutSkipLimited, appErr
+				return 0, nil
+			}
+			return 0, fmt.Errorf("reserve withdrawal limit: %w", err)
+		}
+
+		result, err := q.CreateSettlementBatch(ctx, db.CreateSettlementBatchParams{
+			ExternalID:      uuid.New().String(),
+			PayeeAccountID:  accountID,
+			Cadence:         db.SettlementBatchesCadence(schedule.Cadence),
+			CutoffAt:        cutoff,
+			SettlementCount: uint32(len(rows)),
+			TotalAmount:     total.String(),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("create batch: %w", err)
+		}
+		batchID, err := result.LastInsertId()
+		if err != nil {
+			return 0, fmt.Errorf("create batch: %w", err)
+		}
+
+		for _, row := range rows {
+			if err := q.AssignSettlementBatch(ctx, db.AssignSettlementBatchParams{
+				BatchID: sql.NullInt64{Int64: batchID, Valid: true},
+				ID:      row.ID,
+			}); err != nil {
+				return 0, fmt.Errorf("assign settlement %d: %w", row.ID, err)
+			}
+		}
+
+		// 기준 금액 이상 배치는 승인 전까지 지급 대상에서 제외 (스케줄러 마감 = maker 없음)
+		held, err = w.service.requestApproval(ctx, q, db.PayoutApprovalsPayoutTypeSETTLEMENTBATCH, uint64(batchID), accountID, total, audit.Actor{Type: audit.ActorTypeSystem})
+		if err != nil {
+			return 0, fmt.Errorf("request payout approval: %w", err)
+		}
+		return len(rows), nil
+	})
+	if err == nil && count > 0 {
+		logctx.From(ctx, w.logger).Info("settlement batch cut",
+			zap.Uint64("account_id", accountID),
+			zap.String("cadence", string(schedule.Cadence)),
+			zap.Time("cutoff_at", cutoff),
+			zap.Int("settlements", count),
+			zap.Stringer("total_amount", total),
+			zap.Bool("approval_required", held),
+		)
+	}
+	if err == nil && skip == cutSkipFrozen {
+		logctx.From(ctx, w.logger).Warn("settlement cutoff skipped: payee account frozen",
+			zap.Uint64("account_id", accountID),
+		)
+	}
+	if err == nil && skip == cutSkipLimited {
+		logctx.From(ctx, w.logger).Warn("settlement cutoff skipped: withdrawal limit exceeded",
+			zap.Uint64("account_id", accountID),
+			zap.Stringer("total_amount", total),
+			zap.Any("details", limitErr.Details),
+		)
+	}
+	return count, skip, err
+}
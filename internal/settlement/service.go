@@ -0,0 +1,533 @@
+package settlement
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/limits"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"go.uber.org/zap"
+)
+
+const (
+	// amountScale is the scale of settlement amounts (DECIMAL(18,8))
+	amountScale = 8
+	// nativeDecimals is the decimals of the chain's native fee currency (wei)
+	nativeDecimals = 18
+)
+
+// Config holds the payout policy used for projections
+type Config struct {
+	// HoldPeriod delays payout of a captured payment
+	HoldPeriod time.Duration
+	// BatchHour is the UTC cutoff hour of the default (DAILY) payout schedule (0-23)
+	BatchHour int
+	// TransferGas is used when the transfer gas cannot be estimated on-chain
+	TransferGas uint64
+	// BatchBaseGas and BatchTransferGas model a multi-recipient transfer: base + per recipient
+	BatchBaseGas     uint64
+	BatchTransferGas uint64
+	// BatchMaxRecipients caps the recipients of one batch transaction (0 = unlimited)
+	BatchMaxRecipients int
+	// NettingWindow is the settlement window netted when a run omits it (ending at the run)
+	NettingWindow time.Duration
+	// ApprovalThreshold holds payouts at or above it until approved by two distinct users (zero = disabled)
+	ApprovalThreshold money.Money
+}
+
+// Service handles settlement queries
+type Service struct {
+	txRunner    *pkgdb.TxRunner
+	chainClient chain.Client
+	enforcer    *limits.Enforcer
+	config      Config
+	logger      *zap.Logger
+}
+
+// NewService creates a new settlement service
+// chainClient may be nil when no chain is configured (gas estimation disabled)
+func NewService(txRunner *pkgdb.TxRunner, chainClient chain.Client, enforcer *limits.Enforcer, config Config, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner:    txRunner,
+		chainClient: chainClient,
+		enforcer:    enforcer,
+		config:      config,
+		logger:      logger,
+	}
+}
+
+// Forecast projects the seller's upcoming payouts from scheduled settlements,
+// captured payments and payments awaiting capture, grouped by payout batch date
+// (the next cutoff of the account's payout schedule).
+// Why: 배치 생성 전에도 판매자가 현금 흐름을 계획할 수 있도록 현재 데이터 + 보류 정책으로 추정
+func (s *Service) Forecast(ctx context.Context, sellerExternalID string, now time.Time) (*ForecastResponse, error) {
+	q := s.txRunner.Queries()
+
+	// 1. Resolve seller and settlement account
+	seller, err := q.GetUserByExternalID(ctx, sql.NullString{String: sellerExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Seller")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get seller", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	var account *db.Account
+	acc, err := q.GetAccountByOwnerID(ctx, sql.NullInt64{Int64: int64(seller.ID), Valid: true})
+	if err != nil && err != sql.ErrNoRows {
+		logctx.From(ctx, s.logger).Error("failed to get seller account", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if err == nil {
+		account = &acc
+	}
+
+	// 2. Collect forecast items
+	schedule := s.defaultSchedule()
+	items := make([]ForecastItem, 0)
+	if account != nil {
+		if schedule, err = s.accountSchedule(ctx, q, account.ID); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to get payout schedule", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		settlements, err := q.ListOpenSettlementsByPayee(ctx, account.ID)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to list open settlements", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		for _, st := range settlements {
+			amount, err := parseAmount(st.NetAmount)
+			if err != nil {
+				logctx.From(ctx, s.logger).Error("invalid stored amount",
+					zap.Uint64("settlement_id", st.ID),
+					zap.String("amount", st.NetAmount),
+					zap.Error(err),
+				)
+				return nil, errors.Internal("Invalid settlement amount")
+			}
+			items = append(items, ForecastItem{
+				Source:    SourceScheduled,
+				Amount:    amount,
+				ReleaseAt: st.CreatedAt,
+			})
+		}
+
+		// 상계된 정산은 개별 정산 대신 순지급으로 예정
+		netPayouts, err := q.ListOpenNetPayoutsByPayee(ctx, sql.NullInt64{Int64: int64(account.ID), Valid: true})
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to list open net payouts", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		for _, n := range netPayouts {
+			amount, err := parseAmount(n.NetAmount)
+			if err != nil {
+				logctx.From(ctx, s.logger).Error("invalid stored amount",
+					zap.Uint64("net_payout_id", n.ID),
+					zap.String("amount", n.NetAmount),
+					zap.Error(err),
+				)
+				return nil, errors.Internal("Invalid settlement amount")
+			}
+			items = append(items, ForecastItem{
+				Source:    SourceScheduled,
+				Amount:    amount,
+				ReleaseAt: n.CreatedAt,
+			})
+		}
+	}
+
+	payments, err := q.ListSettlementForecastPayments(ctx, seller.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list forecast payments", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	for _, p := range payments {
+		item, err := s.paymentItem(&p, now)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("invalid stored amount",
+				zap.String("payment_external_id", p.ExternalID.String),
+				zap.String("amount", p.Amount),
+				zap.Error(err),
+			)
+			return nil, errors.Internal("Invalid settlement amount")
+		}
+		items = append(items, item)
+	}
+
+	// 3. Apply account-level holds, then group by payout date
+	holdReason := accountHoldReason(account)
+	result := &ForecastResponse{
+		SellerID:        sellerExternalID,
+		GeneratedAt:     now.UTC(),
+		HoldPeriodHours: int(s.config.HoldPeriod / time.Hour),
+		OnHold:          holdReason != "",
+		HoldReason:      holdReason,
+		Payouts:         make([]ForecastPayout, 0),
+	}
+
+	total := money.Zero("", amountScale)
+	payouts := make(map[time.Time]*payoutTotals)
+	for i := range items {
+		total = total.Add(items[i].Amount)
+
+		if result.OnHold {
+			continue
+		}
+
+		payoutDate := schedule.NextCutoff(maxTime(items[i].ReleaseAt, now))
+		items[i].PayoutDate = &payoutDate
+
+		totals, ok := payouts[payoutDate]
+		if !ok {
+			totals = newPayoutTotals()
+			payouts[payoutDate] = totals
+		}
+		totals.add(items[i].Source, items[i].Amount)
+	}
+
+	for date, totals := range payouts {
+		result.Payouts = append(result.Payouts, totals.toPayout(date))
+	}
+	sort.Slice(result.Payouts, func(i, j int) bool {
+		return result.Payouts[i].PayoutDate.Before(result.Payouts[j].PayoutDate)
+	})
+
+	result.TotalAmount = total
+	result.Items = items
+	return result, nil
+}
+
+// paymentItem projects when an unsettled payment becomes payable.
+// AUTHORIZED 결제는 capture 시점을 알 수 없어 현재 시각에 capture된다고 가정 (가장 이른 추정)
+func (s *Service) paymentItem(p *db.ListSettlementForecastPaymentsRow, now time.Time) (ForecastItem, error) {
+	amount, err := parseAmount(p.Amount)
+	if err != nil {
+		return ForecastItem{}, err
+	}
+	item := ForecastItem{
+		Source:      SourceCaptured,
+		PaymentID:   p.ExternalID.String,
+		OrderNumber: p.OrderNumber,
+		Amount:      amount,
+		TokenSymbol: p.TokenSymbol,
+	}
+
+	capturedAt := now
+	if p.Status == db.PaymentsStatusCAPTURED {
+		if p.CapturedAt.Valid {
+			capturedAt = p.CapturedAt.Time
+		} else {
+			capturedAt = p.CreatedAt
+		}
+	} else {
+		item.Source = SourcePendingConfirmation
+	}
+
+	item.ReleaseAt = capturedAt.Add(s.config.HoldPeriod).UTC()
+	return item, nil
+}
+
+// EstimateGas estimates the chain fee of paying out the pending settlement batch at current network conditions,
+// per execution strategy (one transfer per payee vs. multi-recipient batches).
+// Settlements are paid per payee account to its Primary wallet; payees without one are excluded,
+// as are payouts at or above the approval threshold until approved (AWAITING_APPROVAL, PAYOUT_REJECTED).
+// Fees are priced by the chain gas manager at the requested urgency.
+// Why: 네트워크 혼잡도에 따라 지급 비용이 크게 달라지므로 재무팀이 실행 시점/방식을 고를 수 있도록 미리보기 제공
+func (s *Service) EstimateGas(ctx context.Context, now time.Time, urgency chain.Urgency) (*GasEstimateResponse, error) {
+	if s.chainClient == nil {
+		return nil, errors.ChainError("On-chain settlement is not enabled")
+	}
+
+	// 1. Aggregate pending settlements per payee
+	rows, err := s.txRunner.Queries().ListPendingSettlementPayouts(ctx)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list pending settlement payouts", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	result := &GasEstimateResponse{
+		ChainID:     s.chainClient.ChainID(),
+		GeneratedAt: now.UTC(),
+		Urgency:     string(urgency),
+		Settlements: len(rows),
+		Strategies:  make([]GasStrategyEstimate, 0, 2),
+		Excluded:    make([]ExcludedPayout, 0),
+	}
+
+	type payout struct {
+		accountID string
+		wallet    string
+		frozen    bool
+		amount    money.Money
+	}
+	var payouts []*payout
+	byAccount := make(map[uint64]*payout)
+	total := money.Zero("", amountScale)
+
+	// 승인 대기/거절된 고액 지급은 계정 지급과 분리해 제외 목록으로 집계 (계정 + 사유별)
+	type heldKey struct {
+		accountID uint64
+		reason    string
+	}
+	held := make(map[heldKey]int)
+	hold := func(accountID uint64, accountExternalID, reason string, amount money.Money) {
+		key := heldKey{accountID: accountID, reason: reason}
+		i, ok := held[key]
+		if !ok {
+			i = len(result.Excluded)
+			held[key] = i
+			result.Excluded = append(result.Excluded, ExcludedPayout{
+				AccountID: accountExternalID,
+				Amount:    money.Zero("", amountScale),
+				Reason:    reason,
+			})
+		}
+		result.Excluded[i].Amount = result.Excluded[i].Amount.Add(amount)
+	}
+	for _, row := range rows {
+		amount, err := parseAmount(row.NetAmount)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("invalid stored amount",
+				zap.Uint64("settlement_id", row.ID),
+				zap.String("amount", row.NetAmount),
+				zap.Error(err),
+			)
+			return nil, errors.Internal("Invalid settlement amount")
+		}
+		if reason := approvalHoldReason(row.ApprovalStatus); reason != "" {
+			hold(row.PayeeAccountID, row.PayeeAccountExternalID.String, reason, amount)
+			continue
+		}
+
+		p, ok := byAccount[row.PayeeAccountID]
+		if !ok {
+			p = &payout{
+				accountID: row.PayeeAccountExternalID.String,
+				wallet:    row.WalletAddress.String,
+				frozen:    row.PayeeFrozenAt.Valid,
+				amount:    money.Zero("", amountScale),
+			}
+			byAccount[row.PayeeAccountID] = p
+			payouts = append(payouts, p)
+		}
+		p.amount = p.amount.Add(amount)
+	}
+
+	// 상계된 정산은 순지급 단위로 지급 (수취 계정별로 함께 집계)
+	netPayouts, err := s.txRunner.Queries().ListPendingNetPayouts(ctx)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list pending net payouts", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	result.NetPayouts = len(netPayouts)
+	for _, row := range netPayouts {
+		amount, err := parseAmount(row.NetAmount)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("invalid stored amount",
+				zap.Uint64("net_payout_id", row.ID),
+				zap.String("amount", row.NetAmount),
+				zap.Error(err),
+			)
+			return nil, errors.Internal("Invalid settlement amount")
+		}
+
+		accountID := uint64(row.PayeeAccountID.Int64)
+		if reason := approvalHoldReason(row.ApprovalStatus); reason != "" {
+			hold(accountID, row.PayeeAccountExternalID.String, reason, amount)
+			continue
+		}
+		p, ok := byAccount[accountID]
+		if !ok {
+			p = &payout{
+				accountID: row.PayeeAccountExternalID.String,
+				wallet:    row.WalletAddress.String,
+				frozen:    row.PayeeFrozenAt.Valid,
+				amount:    money.Zero("", amountScale),
+			}
+			byAccount[accountID] = p
+			payouts = append(payouts, p)
+		}
+		p.amount = p.amount.Add(amount)
+	}
+
+	var recipients []string
+	for _, p := range payouts {
+		reason := ""
+		switch {
+		case p.frozen:
+			reason = HoldReasonAccountFrozen
+		case p.wallet == "":
+			reason = HoldReasonNoPayoutWallet
+		}
+		if reason != "" {
+			result.Excluded = append(result.Excluded, ExcludedPayout{
+				AccountID: p.accountID,
+				Amount:    p.amount,
+				Reason:    reason,
+			})
+			continue
+		}
+		total = total.Add(p.amount)
+		recipients = append(recipients, p.wallet)
+	}
+	result.Recipients = len(recipients)
+	result.TotalAmount = total
+
+	// 2. Current network fees
+	fees, err := s.chainClient.EstimateFees(ctx, urgency)
+	if err != nil {
+		if stderrors.Is(err, chain.ErrFeeCapExceeded) {
+			return nil, errors.ChainError("Network fees exceed the configured max fee per gas")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get network fees", zap.Error(err))
+		return nil, errors.ChainError("Failed to get network fees")
+	}
+	result.BaseFeeWei = fees.BaseFee.String()
+	result.TipCapWei = fees.TipCap.String()
+	result.MaxFeePerGasWei = fees.FeeCap.String()
+
+	// 3. Gas of one transfer, estimated against the first recipient
+	// NOTE: 지급 signer 잔액이 부족하면 전액 전송은 revert 되므로 최소 단위(1)로 추정, 실패 시 설정값 사용
+	result.TransferGas = s.config.TransferGas
+	if len(recipients) > 0 {
+		gas, err := s.chainClient.EstimateTransferGas(ctx, recipients[0], big.NewInt(1))
+		if err != nil {
+			logctx.From(ctx, s.logger).Warn("transfer gas estimation failed, using configured fallback",
+				zap.Uint64("fallback_gas", s.config.TransferGas),
+				zap.Error(err),
+			)
+		} else {
+			result.TransferGas = gas
+			result.TransferGasEstimated = true
+		}
+	}
+
+	// 4. Cost per strategy
+	n := len(recipients)
+	result.Strategies = append(result.Strategies,
+		s.strategyEstimate(StrategyIndividual, n, uint64(n)*result.TransferGas, fees),
+		s.strategyEstimate(StrategyBatched, s.batchCount(n), s.batchedGas(n), fees),
+	)
+	if n > 0 {
+		result.Recommended = StrategyIndividual
+		if result.Strategies[1].GasUnits < result.Strategies[0].GasUnits {
+			result.Recommended = StrategyBatched
+		}
+	}
+
+	return result, nil
+}
+
+// batchCount returns the number of batch transactions needed for n recipients
+func (s *Service) batchCount(n int) int {
+	if n == 0 {
+		return 0
+	}
+	if s.config.BatchMaxRecipients <= 0 {
+		return 1 // 상한 없음
+	}
+	return (n + s.config.BatchMaxRecipients - 1) / s.config.BatchMaxRecipients
+}
+
+// batchedGas models the gas of paying n recipients in batch transactions (base per tx + per recipient)
+func (s *Service) batchedGas(n int) uint64 {
+	return uint64(s.batchCount(n))*s.config.BatchBaseGas + uint64(n)*s.config.BatchTransferGas
+}
+
+// strategyEstimate prices gas units at the quoted network fees
+func (s *Service) strategyEstimate(strategy string, txs int, gas uint64, fees *chain.FeeQuote) GasStrategyEstimate {
+	units := new(big.Int).SetUint64(gas)
+	fee := new(big.Int).Mul(units, fees.EffectivePrice())
+	maxFee := new(big.Int).Mul(units, fees.FeeCap)
+
+	return GasStrategyEstimate{
+		Strategy:        strategy,
+		Transactions:    txs,
+		GasUnits:        gas,
+		EstimatedFeeWei: fee.String(),
+		EstimatedFee:    money.New(fee, "", nativeDecimals),
+		MaxFeeWei:       maxFee.String(),
+		MaxFee:          money.New(maxFee, "", nativeDecimals),
+	}
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// parseAmount parses a stored settlement/payment amount (DECIMAL(18,8))
+func parseAmount(value string) (money.Money, error) {
+	return money.Parse(value, "", amountScale)
+}
+
+// accountHoldReason returns the account-level hold blocking payouts ("" = none)
+func accountHoldReason(account *db.Account) string {
+	switch {
+	case account == nil:
+		return HoldReasonNoAccount
+	case account.FrozenAt.Valid:
+		// 관리자 동결 - 해제 전까지 모든 지급 보류
+		return HoldReasonAccountFrozen
+	case account.Status != db.AccountsStatusACTIVE:
+		return HoldReasonAccountSuspended
+	case !account.PrimaryWalletID.Valid:
+		// 검증된 Primary 지갑이 없으면 배치가 지급 대상에서 제외
+		return HoldReasonNoPayoutWallet
+	default:
+		return ""
+	}
+}
+
+// payoutTotals accumulates the amounts of one payout date by source
+type payoutTotals struct {
+	bySource map[string]money.Money
+	count    int
+}
+
+func newPayoutTotals() *payoutTotals {
+	return &payoutTotals{bySource: map[string]money.Money{
+		SourceScheduled:           money.Zero("", amountScale),
+		SourceCaptured:            money.Zero("", amountScale),
+		SourcePendingConfirmation: money.Zero("", amountScale),
+	}}
+}
+
+func (t *payoutTotals) add(source string, amount money.Money) {
+	t.bySource[source] = t.bySource[source].Add(amount)
+	t.count++
+}
+
+func (t *payoutTotals) toPayout(date time.Time) ForecastPayout {
+	total := money.Zero("", amountScale)
+	for _, amount := range t.bySource {
+		total = total.Add(amount)
+	}
+
+	return ForecastPayout{
+		PayoutDate:                date,
+		Amount:                    total,
+		ScheduledAmount:           t.bySource[SourceScheduled],
+		CapturedAmount:            t.bySource[SourceCaptured],
+		PendingConfirmationAmount: t.bySource[SourcePendingConfirmation],
+		Count:                     t.count,
+	}
+}
+
+// maxTime returns the later of a and b
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,85 @@
+package statement
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// ListStatementsRequest represents query parameters for listing the statements of an account
+type ListStatementsRequest struct {
+	Page     int `form:"page,default=1" binding:"min=1"`
+	PageSize int `form:"page_size,default=12" binding:"min=1,max=100"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// StatementResponse represents a monthly account statement
+// NOTE: closing_balance = opening_balance + total_credits - total_debits (생성 시점 스냅샷, 이후 변경 없음)
+type StatementResponse struct {
+	StatementID    string                  `json:"statement_id" example:"880e8400-e29b-41d4-a716-446655440000"`
+	AccountID      string                  `json:"account_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Period         string                  `json:"period" example:"2026-09"`
+	PeriodStart    time.Time               `json:"period_start"`
+	PeriodEnd      time.Time               `json:"period_end"`
+	OpeningBalance string                  `json:"opening_balance" example:"1200.00000000" swaggertype:"string"`
+	TotalCredits   string                  `json:"total_credits" example:"500.00000000" swaggertype:"string"`
+	TotalDebits    string                  `json:"total_debits" example:"250.00000000" swaggertype:"string"`
+	ClosingBalance string                  `json:"closing_balance" example:"1450.00000000" swaggertype:"string"`
+	EntryCount     uint32                  `json:"entry_count" example:"14"`
+	Lines          []StatementLineResponse `json:"lines,omitempty"`
+	GeneratedAt    time.Time               `json:"generated_at"`
+}
+
+// StatementLineResponse represents the ledger entries of one type within a statement
+type StatementLineResponse struct {
+	ReferenceType string `json:"reference_type" example:"ACCOUNT_TRANSFER"`
+	EntryType     string `json:"entry_type" example:"CREDIT"`
+	EntryCount    uint32 `json:"entry_count" example:"3"`
+	TotalAmount   string `json:"total_amount" example:"500.00000000" swaggertype:"string"`
+}
+
+// ListStatementsResponse represents paginated statement list of an account
+type ListStatementsResponse struct {
+	Statements []StatementResponse `json:"statements"`
+	Total      int64               `json:"total" example:"6"`
+	Page       int                 `json:"page" example:"1"`
+	PageSize   int                 `json:"page_size" example:"12"`
+	TotalPages int                 `json:"total_pages" example:"1"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToStatementResponse converts a statement (and its lines, if loaded) to its response
+func ToStatementResponse(statement *db.AccountStatement, accountID string, lines []db.AccountStatementLine) *StatementResponse {
+	response := &StatementResponse{
+		StatementID:    statement.ExternalID,
+		AccountID:      accountID,
+		Period:         statement.PeriodStart.Format(PeriodLayout),
+		PeriodStart:    statement.PeriodStart,
+		PeriodEnd:      statement.PeriodEnd,
+		OpeningBalance: statement.OpeningBalance,
+		TotalCredits:   statement.TotalCredits,
+		TotalDebits:    statement.TotalDebits,
+		ClosingBalance: statement.ClosingBalance,
+		EntryCount:     statement.EntryCount,
+		GeneratedAt:    statement.GeneratedAt,
+	}
+	for _, line := range lines {
+		response.Lines = append(response.Lines, StatementLineResponse{
+			ReferenceType: line.ReferenceType,
+			EntryType:     string(line.EntryType),
+			EntryCount:    line.EntryCount,
+			TotalAmount:   line.TotalAmount,
+		})
+	}
+	return response
+}
@@ -0,0 +1,107 @@
+package statement
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for monthly account statements
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new statement handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers statement routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	statements := rg.Group("/accounts/:id/statements", middleware.RequireAuth())
+	{
+		statements.GET("", h.ListStatements)
+		statements.GET("/:period", h.GetStatement)
+	}
+}
+
+// extractAndValidateAccountID extracts and validates the account id from path
+func extractAndValidateAccountID(c *gin.Context) (string, error) {
+	accountID := c.Param("id")
+	if _, err := uuid.Parse(accountID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+	return accountID, nil
+}
+
+// ListStatements godoc
+// @Summary List account statements
+// @Description List the generated monthly statements of an account (latest month first, without lines)
+// @Tags statements
+// @Produce json
+// @Param id path string true "Account external ID (UUID)"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(12)
+// @Success 200 {object} middleware.SuccessResponse{data=ListStatementsResponse} "Statements"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Account not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/accounts/{id}/statements [get]
+func (h *Handler) ListStatements(c *gin.Context) {
+	accountID, err := extractAndValidateAccountID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req ListStatementsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	principal, _ := middleware.GetPrincipal(c)
+	result, err := h.service.ListStatements(c.Request.Context(), accountID, &req, audit.ActorFromContext(c), principal.IsAdmin())
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// GetStatement godoc
+// @Summary Get a monthly account statement
+// @Description Get the statement of an account for a closed UTC month: opening balance, ledger entries grouped by type and closing balance.
+// @Description Generated on first access if the monthly job has not produced it yet; once generated a statement never changes
+// @Description (later corrections appear in the statement of the month they were posted in).
+// @Tags statements
+// @Produce json
+// @Param id path string true "Account external ID (UUID)"
+// @Param period path string true "Statement month (YYYY-MM, UTC)"
+// @Success 200 {object} middleware.SuccessResponse{data=StatementResponse} "Statement"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Account or statement not found"
+// @Failure 409 {object} middleware.ErrorResponse "Statement period has not closed yet"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/accounts/{id}/statements/{period} [get]
+func (h *Handler) GetStatement(c *gin.Context) {
+	accountID, err := extractAndValidateAccountID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, _ := middleware.GetPrincipal(c)
+	result, err := h.service.GetStatement(c.Request.Context(), accountID, c.Param("period"), audit.ActorFromContext(c), principal.IsAdmin())
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
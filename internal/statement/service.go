@@ -0,0 +1,262 @@
+package statement
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// amountScale is the scale of ledger amounts and statement balances (DECIMAL(18,8))
+	amountScale = 8
+
+	// mysqlErrDuplicateEntry is the MySQL error number for unique key violations
+	mysqlErrDuplicateEntry = 1062
+)
+
+// Service generates and serves monthly account statements
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	grace    time.Duration
+	logger   *zap.Logger
+}
+
+// NewService creates a new statement service; grace is the wait after a month ends before its statements are final
+func NewService(txRunner *pkgdb.TxRunner, grace time.Duration, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		grace:    grace,
+		logger:   logger,
+	}
+}
+
+// Generate builds and persists the statement of an account for the month starting at periodStart.
+// An existing statement is returned as is: statements are immutable once generated.
+//
+// Why:
+//   - 기초 잔액/집계/기말 잔액을 한 트랜잭션(같은 스냅샷)에서 계산 → 명세서 내부 합계 항상 일치
+//   - 생성 후 수정 경로 없음 → 이후 정정 분개가 과거 명세서를 바꾸지 않음 (정정 시점 월에 반영)
+//   - uk_account_statement_period → 잡과 on-demand 조회가 동시에 생성해도 월당 1건
+func (s *Service) Generate(ctx context.Context, accountID uint64, periodStart time.Time) (*db.AccountStatement, error) {
+	periodEnd := PeriodEnd(periodStart)
+
+	err := s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		// 1. Opening balance and entries of the month, grouped by type
+		stored, err := q.GetLedgerBalanceBefore(ctx, db.GetLedgerBalanceBeforeParams{
+			AccountID: accountID,
+			CreatedAt: periodStart,
+		})
+		if err != nil {
+			return fmt.Errorf("get opening balance: %w", err)
+		}
+		opening, err := money.Parse(stored, "", amountScale)
+		if err != nil {
+			return fmt.Errorf("invalid opening balance %q: %w", stored, err)
+		}
+		summary, err := q.SummarizeLedgerEntries(ctx, db.SummarizeLedgerEntriesParams{
+			AccountID: accountID,
+			From:      periodStart,
+			To:        periodEnd,
+		})
+		if err != nil {
+			return fmt.Errorf("summarize ledger entries: %w", err)
+		}
+
+		// 2. Totals
+		credits := money.Zero("", amountScale)
+		debits := money.Zero("", amountScale)
+		var entryCount int64
+		for _, row := range summary {
+			amount, err := money.Parse(row.TotalAmount, "", amountScale)
+			if err != nil {
+				return fmt.Errorf("invalid %s %s total %q: %w", row.ReferenceType, row.EntryType, row.TotalAmount, err)
+			}
+			if row.EntryType == db.LedgerEntriesEntryTypeCREDIT {
+				credits = credits.Add(amount)
+			} else {
+				debits = debits.Add(amount)
+			}
+			entryCount += row.EntryCount
+		}
+		closing := opening.Add(credits).Sub(debits)
+
+		// 3. Persist statement and lines
+		result, err := q.CreateAccountStatement(ctx, db.CreateAccountStatementParams{
+			ExternalID:     uuid.New().String(),
+			AccountID:      accountID,
+			PeriodStart:    periodStart,
+			PeriodEnd:      periodEnd,
+			OpeningBalance: opening.String(),
+			TotalCredits:   credits.String(),
+			TotalDebits:    debits.String(),
+			ClosingBalance: closing.String(),
+			EntryCount:     uint32(entryCount),
+		})
+		if err != nil {
+			return err
+		}
+		statementID, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		for _, row := range summary {
+			if err := q.CreateAccountStatementLine(ctx, db.CreateAccountStatementLineParams{
+				StatementID:   uint64(statementID),
+				ReferenceType: row.ReferenceType,
+				EntryType:     db.AccountStatementLinesEntryType(row.EntryType),
+				EntryCount:    uint32(row.EntryCount),
+				TotalAmount:   row.TotalAmount,
+			}); err != nil {
+				return fmt.Errorf("create statement line: %w", err)
+			}
+		}
+		return nil
+	})
+	// NOTE: 동시 생성에서 진 쪽은 먼저 커밋된 명세서를 그대로 반환
+	if err != nil && !isDuplicateKeyError(err) {
+		return nil, err
+	}
+
+	statement, err := s.txRunner.Queries().GetAccountStatementByPeriod(ctx, db.GetAccountStatementByPeriodParams{
+		AccountID:   accountID,
+		PeriodStart: periodStart,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get statement: %w", err)
+	}
+	return &statement, nil
+}
+
+// GetStatement returns the statement of an account for a closed month, generating it on first access
+func (s *Service) GetStatement(ctx context.Context, accountExternalID, period string, actor audit.Actor, admin bool) (*StatementResponse, error) {
+	periodStart, err := ParsePeriod(period)
+	if err != nil {
+		return nil, errors.InvalidInput(err.Error())
+	}
+	if !IsClosed(periodStart, time.Now().UTC(), s.grace) {
+		return nil, errors.Conflict("Statement period has not closed yet")
+	}
+
+	q := s.txRunner.Queries()
+	account, err := s.getOwnedAccount(ctx, q, accountExternalID, actor, admin)
+	if err != nil {
+		return nil, err
+	}
+	// 월 마감 이후 개설된 계정은 해당 월 명세서 없음
+	if !account.CreatedAt.Before(PeriodEnd(periodStart)) {
+		return nil, errors.NotFound("Statement")
+	}
+
+	statement, err := q.GetAccountStatementByPeriod(ctx, db.GetAccountStatementByPeriodParams{
+		AccountID:   account.ID,
+		PeriodStart: periodStart,
+	})
+	switch {
+	case err == nil:
+	case err == sql.ErrNoRows:
+		generated, err := s.Generate(ctx, account.ID, periodStart)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to generate statement",
+				zap.String("account_external_id", accountExternalID),
+				zap.String("period", period),
+				zap.Error(err),
+			)
+			return nil, errors.DBError(err)
+		}
+		statement = *generated
+	default:
+		logctx.From(ctx, s.logger).Error("failed to get statement", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	lines, err := q.ListAccountStatementLines(ctx, statement.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list statement lines", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return ToStatementResponse(&statement, account.ExternalID.String, lines), nil
+}
+
+// ListStatements lists the generated statements of an account (latest month first, without lines)
+func (s *Service) ListStatements(ctx context.Context, accountExternalID string, req *ListStatementsRequest, actor audit.Actor, admin bool) (*ListStatementsResponse, error) {
+	q := s.txRunner.Queries()
+
+	account, err := s.getOwnedAccount(ctx, q, accountExternalID, actor, admin)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	statements, err := q.ListAccountStatements(ctx, db.ListAccountStatementsParams{
+		AccountID: account.ID,
+		Limit:     int32(req.PageSize),
+		Offset:    int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list statements", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := q.CountAccountStatements(ctx, account.ID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count statements", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	responses := make([]StatementResponse, 0, len(statements))
+	for i := range statements {
+		responses = append(responses, *ToStatementResponse(&statements[i], account.ExternalID.String, nil))
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListStatementsResponse{
+		Statements: responses,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// getOwnedAccount resolves an account the actor may read (owner or admin); others get 404
+func (s *Service) getOwnedAccount(ctx context.Context, q *db.Queries, accountExternalID string, actor audit.Actor, admin bool) (*db.Account, error) {
+	account, err := q.GetAccountByExternalID(ctx, sql.NullString{String: accountExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Account")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get account", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if !admin && (!account.OwnerID.Valid || uint64(account.OwnerID.Int64) != actor.ID) {
+		return nil, errors.NotFound("Account")
+	}
+	return &account, nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// isDuplicateKeyError checks if the error is a MySQL duplicate key error
+func isDuplicateKeyError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return stderrors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
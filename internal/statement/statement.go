@@ -0,0 +1,39 @@
+package statement
+
+import (
+	"fmt"
+	"time"
+)
+
+// PeriodLayout is the format of a statement period in paths and responses (UTC month, e.g. "2026-09")
+const PeriodLayout = "2006-01"
+
+// ParsePeriod parses a statement period ("YYYY-MM") into the start of the UTC month
+func ParsePeriod(value string) (time.Time, error) {
+	start, err := time.ParseInLocation(PeriodLayout, value, time.UTC)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid period %q: expected YYYY-MM", value)
+	}
+	return start, nil
+}
+
+// PeriodEnd returns the exclusive end of the month starting at periodStart
+func PeriodEnd(periodStart time.Time) time.Time {
+	return periodStart.AddDate(0, 1, 0)
+}
+
+// LastClosedPeriod returns the start of the latest UTC month that ended at least grace before now
+//
+// Why:
+// - 월 마감 직전에 시작된 원장 트랜잭션은 created_at이 마감 전이지만 커밋은 마감 후일 수 있음
+// - 유예 시간 이후에만 명세서를 확정 → 확정 후 해당 월에 항목이 끼어들지 않음
+func LastClosedPeriod(now time.Time, grace time.Duration) time.Time {
+	cutoff := now.UTC().Add(-grace)
+	currentStart := time.Date(cutoff.Year(), cutoff.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return currentStart.AddDate(0, -1, 0)
+}
+
+// IsClosed reports whether the month starting at periodStart has ended at least grace before now
+func IsClosed(periodStart, now time.Time, grace time.Duration) bool {
+	return !PeriodEnd(periodStart).Add(grace).After(now)
+}
@@ -0,0 +1,120 @@
+package statement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"go.uber.org/zap"
+)
+
+// WorkerConfig holds monthly statement job settings
+type WorkerConfig struct {
+	// Interval is the period of the statement run
+	Interval time.Duration
+	// BatchSize is the number of accounts read per page
+	BatchSize int
+}
+
+// Report summarizes one statement run
+type Report struct {
+	Period    string
+	Generated int
+	Failed    int
+}
+
+// Worker generates the statements of the latest closed month for every account that has none yet.
+// Accounts opened after the month ended are skipped; a failing account is retried on the next run.
+type Worker struct {
+	txRunner *pkgdb.TxRunner
+	service  *Service
+	config   WorkerConfig
+	logger   *zap.Logger
+}
+
+// NewWorker creates a new statement worker
+func NewWorker(txRunner *pkgdb.TxRunner, service *Service, config WorkerConfig, logger *zap.Logger) *Worker {
+	return &Worker{
+		txRunner: txRunner,
+		service:  service,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Run executes the statement run periodically until ctx is canceled
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	logctx.From(ctx, w.logger).Info("statement job started",
+		zap.Duration("interval", w.config.Interval),
+		zap.Duration("grace", w.service.grace),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			logctx.From(ctx, w.logger).Info("statement job stopped")
+			return
+		case <-ticker.C:
+			report, err := w.RunOnce(ctx, time.Now().UTC())
+			if err != nil {
+				if ctx.Err() == nil {
+					logctx.From(ctx, w.logger).Error("statement run failed", zap.Error(err))
+				}
+				continue
+			}
+			if report.Generated+report.Failed > 0 {
+				logctx.From(ctx, w.logger).Info("statement run completed",
+					zap.String("period", report.Period),
+					zap.Int("generated", report.Generated),
+					zap.Int("failed", report.Failed),
+				)
+			}
+		}
+	}
+}
+
+// RunOnce generates the missing statements of the latest month closed as of now
+func (w *Worker) RunOnce(ctx context.Context, now time.Time) (*Report, error) {
+	periodStart := LastClosedPeriod(now, w.service.grace)
+	report := &Report{Period: periodStart.Format(PeriodLayout)}
+
+	var afterID uint64
+	for {
+		accounts, err := w.txRunner.Queries().ListAccountsWithoutStatement(ctx, db.ListAccountsWithoutStatementParams{
+			PeriodStart: periodStart,
+			PeriodEnd:   PeriodEnd(periodStart),
+			AfterID:     afterID,
+			Limit:       int32(w.config.BatchSize),
+		})
+		if err != nil {
+			return report, fmt.Errorf("list accounts without statement: %w", err)
+		}
+
+		for _, account := range accounts {
+			if ctx.Err() != nil {
+				return report, nil
+			}
+			if _, err := w.service.Generate(ctx, account.ID, periodStart); err != nil {
+				report.Failed++
+				logctx.From(ctx, w.logger).Error("statement generation failed",
+					zap.String("account_external_id", account.ExternalID.String),
+					zap.String("period", report.Period),
+					zap.Error(err),
+				)
+				continue
+			}
+			report.Generated++
+		}
+
+		if len(accounts) < w.config.BatchSize {
+			return report, nil
+		}
+		afterID = accounts[len(accounts)-1].ID
+	}
+}
@@ -0,0 +1,100 @@
+package status
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// CreateIncidentRequest represents an incident posted to the status feed
+type CreateIncidentRequest struct {
+	Title  string `json:"title" binding:"required,min=1,max=200" example:"Delayed settlement payouts"`
+	Impact string `json:"impact" binding:"required,oneof=MINOR MAJOR CRITICAL" example:"MAJOR"`
+	// Components lists the affected component keys (api, event_delivery, webhooks, settlement)
+	Components []string `json:"components" binding:"required,min=1,dive,required" example:"settlement"`
+	Message    string   `json:"message" binding:"required,min=1,max=2000" example:"Payouts are delayed due to RPC provider issues. We are investigating."`
+	// Status defaults to INVESTIGATING
+	Status string `json:"status,omitempty" binding:"omitempty,oneof=INVESTIGATING IDENTIFIED MONITORING" example:"INVESTIGATING"`
+}
+
+// PostIncidentUpdateRequest represents a progress update of an incident (RESOLVED closes it)
+type PostIncidentUpdateRequest struct {
+	Status  string `json:"status" binding:"required,oneof=INVESTIGATING IDENTIFIED MONITORING RESOLVED" example:"MONITORING"`
+	Message string `json:"message" binding:"required,min=1,max=2000" example:"A fix has been deployed and payouts are catching up."`
+	// Impact changes the incident impact (omit to keep)
+	Impact string `json:"impact,omitempty" binding:"omitempty,oneof=MINOR MAJOR CRITICAL" example:"MINOR"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// FeedResponse represents the machine-readable status feed
+type FeedResponse struct {
+	// Status is the worst component state
+	Status     string              `json:"status" example:"OPERATIONAL"`
+	Components []ComponentResponse `json:"components"`
+	// Incidents lists open incidents and incidents resolved recently (newest first)
+	Incidents []IncidentResponse `json:"incidents"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+// ComponentResponse represents the state of a component
+type ComponentResponse struct {
+	Key    string `json:"key" example:"webhooks"`
+	Name   string `json:"name" example:"Webhook delivery"`
+	Status string `json:"status" example:"OPERATIONAL"`
+	// LagSeconds is the age of the oldest pending item of a pipeline component
+	LagSeconds *int64 `json:"lag_seconds,omitempty" example:"4"`
+}
+
+// IncidentResponse represents an incident with its updates (newest first)
+type IncidentResponse struct {
+	ID         string                   `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Title      string                   `json:"title" example:"Delayed settlement payouts"`
+	Impact     string                   `json:"impact" example:"MAJOR"`
+	Status     string                   `json:"status" example:"MONITORING"`
+	Components []string                 `json:"components" example:"settlement"`
+	Updates    []IncidentUpdateResponse `json:"updates"`
+	CreatedAt  time.Time                `json:"created_at"`
+	ResolvedAt *time.Time               `json:"resolved_at,omitempty"`
+}
+
+// IncidentUpdateResponse represents a progress update of an incident
+type IncidentUpdateResponse struct {
+	Status    string    `json:"status" example:"MONITORING"`
+	Message   string    `json:"message" example:"A fix has been deployed and payouts are catching up."`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToIncidentResponse converts db.StatusIncident and its updates to IncidentResponse
+func ToIncidentResponse(incident *db.StatusIncident, updates []db.StatusIncidentUpdate) IncidentResponse {
+	response := IncidentResponse{
+		ID:         incident.ExternalID,
+		Title:      incident.Title,
+		Impact:     string(incident.Impact),
+		Status:     string(incident.Status),
+		Components: splitComponents(incident.Components),
+		Updates:    make([]IncidentUpdateResponse, 0, len(updates)),
+		CreatedAt:  incident.CreatedAt,
+	}
+	if incident.ResolvedAt.Valid {
+		response.ResolvedAt = &incident.ResolvedAt.Time
+	}
+	for _, u := range updates {
+		response.Updates = append(response.Updates, IncidentUpdateResponse{
+			Status:    string(u.Status),
+			Message:   u.Message,
+			CreatedAt: u.CreatedAt,
+		})
+	}
+	return response
+}
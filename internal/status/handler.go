@@ -0,0 +1,116 @@
+package status
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for the public status feed and incident posting
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new status handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers the public feed and admin incident routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	// Public (no API key - consumed by the status page)
+	rg.GET("/status", h.GetFeed)
+
+	incidents := rg.Group("/admin/status/incidents", middleware.RequireRoles(middleware.RoleAdmin))
+	{
+		incidents.POST("", h.CreateIncident)
+		incidents.POST("/:incidentId/updates", h.PostUpdate)
+	}
+}
+
+// GetFeed godoc
+// @Summary Get status feed
+// @Description Machine-readable platform status for status pages: component states derived from health checks
+// @Description and processing lag, plus open incidents and incidents resolved in the last days. No API key required.
+// @Description The feed is cached for a short time (see updated_at).
+// @Tags status
+// @Produce json
+// @Success 200 {object} middleware.SuccessResponse{data=FeedResponse} "Status feed"
+// @Router /api/v1/status [get]
+func (h *Handler) GetFeed(c *gin.Context) {
+	middleware.RespondOK(c, h.service.Feed(c.Request.Context()))
+}
+
+// CreateIncident godoc
+// @Summary Post a status incident
+// @Description Post an incident to the public status feed - Admin only.
+// @Description Until resolved, the incident impact raises the state of the affected components (MINOR → DEGRADED, MAJOR → PARTIAL_OUTAGE, CRITICAL → MAJOR_OUTAGE).
+// @Tags status
+// @Accept json
+// @Produce json
+// @Param request body CreateIncidentRequest true "Incident"
+// @Success 201 {object} middleware.SuccessResponse{data=IncidentResponse} "Incident posted"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid request or unknown component"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/status/incidents [post]
+func (h *Handler) CreateIncident(c *gin.Context) {
+	var req CreateIncidentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	incident, err := h.service.CreateIncident(c.Request.Context(), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, incident)
+}
+
+// PostUpdate godoc
+// @Summary Post a status incident update
+// @Description Post a progress update to an open incident - Admin only. Status RESOLVED closes the incident.
+// @Tags status
+// @Accept json
+// @Produce json
+// @Param incidentId path string true "Incident ID (UUID)"
+// @Param request body PostIncidentUpdateRequest true "Update"
+// @Success 200 {object} middleware.SuccessResponse{data=IncidentResponse} "Incident updated"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid request"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Incident not found"
+// @Failure 409 {object} middleware.ErrorResponse "Incident already resolved"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/status/incidents/{incidentId}/updates [post]
+func (h *Handler) PostUpdate(c *gin.Context) {
+	incidentID := c.Param("incidentId")
+	if _, err := uuid.Parse(incidentID); err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Invalid UUID format"))
+		return
+	}
+
+	var req PostIncidentUpdateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	incident, err := h.service.PostUpdate(c.Request.Context(), incidentID, &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, incident)
+}
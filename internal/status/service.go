@@ -0,0 +1,449 @@
+package status
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const (
+	// probeTimeout bounds the health checks of one feed refresh
+	probeTimeout = 3 * time.Second
+	// maxFeedIncidents bounds the incidents in the feed
+	maxFeedIncidents = 20
+)
+
+// Audit log identifiers
+const (
+	actionIncidentCreated = "STATUS_INCIDENT_CREATED"
+	actionIncidentUpdated = "STATUS_INCIDENT_UPDATED"
+	resourceIncident      = "STATUS_INCIDENT"
+)
+
+// Config holds status feed settings
+type Config struct {
+	// CacheTTL is how long a computed feed is served (public endpoint, polled by the status page)
+	CacheTTL time.Duration
+	// DegradedLag / OutageLag are the pipeline lags at which a component is DEGRADED / PARTIAL_OUTAGE
+	DegradedLag time.Duration
+	OutageLag   time.Duration
+	// ChainTxTimeout is how long a platform transfer may stay unconfirmed before it counts as stuck
+	ChainTxTimeout time.Duration
+	// IncidentHistory is how long resolved incidents stay in the feed
+	IncidentHistory time.Duration
+}
+
+// Service builds the public status feed from health checks, pipeline lag and posted incidents
+type Service struct {
+	txRunner    *pkgdb.TxRunner
+	db          *sql.DB
+	rdb         *redis.Client
+	chainClient chain.Client
+	config      Config
+	logger      *zap.Logger
+
+	mu        sync.Mutex
+	cached    *FeedResponse
+	expiresAt time.Time
+}
+
+// NewService creates a new status service (chainClient nil = settlement component omitted)
+func NewService(txRunner *pkgdb.TxRunner, database *sql.DB, rdb *redis.Client, chainClient chain.Client, config Config, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner:    txRunner,
+		db:          database,
+		rdb:         rdb,
+		chainClient: chainClient,
+		config:      config,
+		logger:      logger,
+	}
+}
+
+// Feed returns the status feed, recomputed at most once per CacheTTL.
+// Why: 비인증 엔드포인트 → 동시 요청은 진행 중인 갱신을 기다려 DB/RPC 조회를 1회로 제한
+func (s *Service) Feed(ctx context.Context) *FeedResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now().UTC()
+	if s.cached != nil && now.Before(s.expiresAt) {
+		return s.cached
+	}
+
+	probeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), probeTimeout)
+	defer cancel()
+	feed := s.build(probeCtx, now)
+
+	s.cached = feed
+	s.expiresAt = now.Add(s.config.CacheTTL)
+	return feed
+}
+
+// build probes every component and applies the open incidents
+func (s *Service) build(ctx context.Context, now time.Time) *FeedResponse {
+	states := s.probe(ctx, now)
+
+	incidents, err := s.listIncidents(ctx, now)
+	if err != nil {
+		// 공지 조회 실패 시 직전 피드의 공지 유지 (DB 장애 중에도 게시된 공지는 노출)
+		logctx.From(ctx, s.logger).Warn("failed to list status incidents", zap.Error(err))
+		if s.cached != nil {
+			incidents = s.cached.Incidents
+		}
+	}
+	if incidents == nil {
+		incidents = []IncidentResponse{}
+	}
+
+	// Open incidents raise the state of their components
+	for _, incident := range incidents {
+		if incident.ResolvedAt != nil {
+			continue
+		}
+		for _, key := range incident.Components {
+			if c, ok := states[key]; ok {
+				c.Status = worst(c.Status, impactStates[incident.Impact])
+				states[key] = c
+			}
+		}
+	}
+
+	feed := &FeedResponse{Status: StateOperational, Incidents: incidents, UpdatedAt: now}
+	for _, c := range componentNames {
+		state, ok := states[c.Key]
+		if !ok {
+			continue
+		}
+		feed.Components = append(feed.Components, state)
+		feed.Status = worst(feed.Status, state.Status)
+	}
+	return feed
+}
+
+// probe derives component states from health checks and pipeline lag
+func (s *Service) probe(ctx context.Context, now time.Time) map[string]ComponentResponse {
+	states := make(map[string]ComponentResponse, len(componentNames))
+	component := func(key, state string, lag *time.Duration) {
+		c := ComponentResponse{Key: key, Status: state}
+		for _, named := range componentNames {
+			if named.Key == key {
+				c.Name = named.Name
+			}
+		}
+		if lag != nil {
+			seconds := int64(lag.Seconds())
+			c.LagSeconds = &seconds
+		}
+		states[key] = c
+	}
+
+	// 1. API (DB 장애 → 전면 장애, Redis 장애 → 멱등성/rate limit 영향)
+	dbUp := s.db.PingContext(ctx) == nil
+	switch {
+	case !dbUp:
+		component(ComponentAPI, StateMajorOutage, nil)
+	case s.rdb.Ping(ctx).Err() != nil:
+		component(ComponentAPI, StatePartialOutage, nil)
+	default:
+		component(ComponentAPI, StateOperational, nil)
+	}
+
+	// 2. Pipelines (DB 장애 시 처리 불가)
+	if !dbUp {
+		component(ComponentEventDelivery, StateMajorOutage, nil)
+		component(ComponentWebhooks, StateMajorOutage, nil)
+	} else {
+		q := s.txRunner.Queries()
+		lag, err := s.lag(q.GetOldestPendingOutboxEventTime(ctx))
+		component(ComponentEventDelivery, s.lagState(lag, err), lag)
+		if err != nil {
+			logctx.From(ctx, s.logger).Warn("failed to read outbox lag", zap.Error(err))
+		}
+
+		lag, err = s.lag(q.GetOldestDueWebhookDeliveryTime(ctx))
+		component(ComponentWebhooks, s.lagState(lag, err), lag)
+		if err != nil {
+			logctx.From(ctx, s.logger).Warn("failed to read webhook delivery lag", zap.Error(err))
+		}
+	}
+
+	// 3. Settlement (체인 클라이언트가 있을 때만)
+	if s.chainClient != nil {
+		component(ComponentSettlement, s.settlementState(ctx, now, dbUp), nil)
+	}
+	return states
+}
+
+// lag converts the time of the oldest pending item to its age (no rows = no backlog)
+func (s *Service) lag(oldest time.Time, err error) (*time.Duration, error) {
+	if stderrors.Is(err, sql.ErrNoRows) {
+		var zero time.Duration
+		return &zero, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	lag := max(time.Since(oldest), 0)
+	return &lag, nil
+}
+
+// lagState maps a pipeline lag to a component state
+func (s *Service) lagState(lag *time.Duration, err error) string {
+	switch {
+	case err != nil:
+		return StateDegraded
+	case *lag >= s.config.OutageLag:
+		return StatePartialOutage
+	case *lag >= s.config.DegradedLag:
+		return StateDegraded
+	default:
+		return StateOperational
+	}
+}
+
+// settlementState checks the chain RPC and stuck platform transfers
+func (s *Service) settlementState(ctx context.Context, now time.Time, dbUp bool) string {
+	if _, err := s.chainClient.LatestBlock(ctx); err != nil {
+		logctx.From(ctx, s.logger).Warn("chain rpc probe failed", zap.Error(err))
+		return StateMajorOutage
+	}
+	if !dbUp {
+		return StateMajorOutage
+	}
+
+	// NOTE: 미확정 송금은 모니터가 수수료 인상 재전송 중 → 지급 지연(DEGRADED)으로 표시
+	stuck, err := s.txRunner.Queries().CountStuckChainTransactions(ctx, now.Add(-s.config.ChainTxTimeout))
+	if err != nil {
+		logctx.From(ctx, s.logger).Warn("failed to count stuck chain transactions", zap.Error(err))
+		return StateDegraded
+	}
+	if stuck > 0 {
+		return StateDegraded
+	}
+	return StateOperational
+}
+
+// listIncidents returns open incidents and incidents resolved within IncidentHistory
+func (s *Service) listIncidents(ctx context.Context, now time.Time) ([]IncidentResponse, error) {
+	q := s.txRunner.Queries()
+	incidents, err := q.ListStatusIncidentsForFeed(ctx, db.ListStatusIncidentsForFeedParams{
+		ResolvedSince: sql.NullTime{Time: now.Add(-s.config.IncidentHistory), Valid: true},
+		Limit:         maxFeedIncidents,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(incidents) == 0 {
+		return []IncidentResponse{}, nil
+	}
+
+	ids := make([]uint64, len(incidents))
+	for i := range incidents {
+		ids[i] = incidents[i].ID
+	}
+	updates, err := q.ListStatusIncidentUpdatesByIncidentIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byIncident := make(map[uint64][]db.StatusIncidentUpdate, len(incidents))
+	for _, u := range updates {
+		byIncident[u.IncidentID] = append(byIncident[u.IncidentID], u)
+	}
+
+	response := make([]IncidentResponse, 0, len(incidents))
+	for i := range incidents {
+		response = append(response, ToIncidentResponse(&incidents[i], byIncident[incidents[i].ID]))
+	}
+	return response, nil
+}
+
+// CreateIncident posts an incident to the status feed
+func (s *Service) CreateIncident(ctx context.Context, req *CreateIncidentRequest, actor audit.Actor) (*IncidentResponse, error) {
+	// 1. Validate components
+	components, err := normalizeComponents(req.Components)
+	if err != nil {
+		return nil, err
+	}
+	status := req.Status
+	if status == "" {
+		status = string(db.StatusIncidentsStatusINVESTIGATING)
+	}
+
+	response, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*IncidentResponse, error) {
+		// 2. Insert incident + first update
+		result, err := q.CreateStatusIncident(ctx, db.CreateStatusIncidentParams{
+			ExternalID: uuid.New().String(),
+			Title:      req.Title,
+			Impact:     db.StatusIncidentsImpact(req.Impact),
+			Status:     db.StatusIncidentsStatus(status),
+			Components: components,
+			CreatedBy:  actor.ID,
+		})
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to create status incident", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		incidentID, err := result.LastInsertId()
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+		if err := q.CreateStatusIncidentUpdate(ctx, db.CreateStatusIncidentUpdateParams{
+			IncidentID: uint64(incidentID),
+			Status:     db.StatusIncidentUpdatesStatus(status),
+			Message:    req.Message,
+			CreatedBy:  actor.ID,
+		}); err != nil {
+			return nil, errors.DBError(err)
+		}
+
+		// 3. Audit (same transaction)
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionIncidentCreated,
+			ResourceType: resourceIncident,
+			ResourceID:   uint64(incidentID),
+			NewValue: map[string]any{
+				"title":      req.Title,
+				"impact":     req.Impact,
+				"status":     status,
+				"components": components,
+			},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		return s.loadIncident(ctx, q, uint64(incidentID))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate()
+	logctx.From(ctx, s.logger).Info("status incident created",
+		zap.String("incident_external_id", response.ID),
+		zap.String("impact", req.Impact),
+		zap.String("request_id", actor.RequestID),
+	)
+	return response, nil
+}
+
+// PostUpdate posts a progress update to an open incident; RESOLVED closes it
+func (s *Service) PostUpdate(ctx context.Context, incidentExternalID string, req *PostIncidentUpdateRequest, actor audit.Actor) (*IncidentResponse, error) {
+	response, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*IncidentResponse, error) {
+		// 1. Lock incident
+		incident, err := q.GetStatusIncidentByExternalIDForUpdate(ctx, incidentExternalID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, errors.NotFound("Status incident")
+			}
+			return nil, errors.DBError(err)
+		}
+		if incident.ResolvedAt.Valid {
+			return nil, errors.Conflict("Status incident already resolved")
+		}
+
+		// 2. Update status/impact + record update
+		impact := incident.Impact
+		if req.Impact != "" {
+			impact = db.StatusIncidentsImpact(req.Impact)
+		}
+		var resolvedAt sql.NullTime
+		if req.Status == string(db.StatusIncidentsStatusRESOLVED) {
+			resolvedAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+		}
+		if err := q.UpdateStatusIncident(ctx, db.UpdateStatusIncidentParams{
+			Status:     db.StatusIncidentsStatus(req.Status),
+			Impact:     impact,
+			ResolvedAt: resolvedAt,
+			ID:         incident.ID,
+		}); err != nil {
+			return nil, errors.DBError(err)
+		}
+		if err := q.CreateStatusIncidentUpdate(ctx, db.CreateStatusIncidentUpdateParams{
+			IncidentID: incident.ID,
+			Status:     db.StatusIncidentUpdatesStatus(req.Status),
+			Message:    req.Message,
+			CreatedBy:  actor.ID,
+		}); err != nil {
+			return nil, errors.DBError(err)
+		}
+
+		// 3. Audit (same transaction)
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionIncidentUpdated,
+			ResourceType: resourceIncident,
+			ResourceID:   incident.ID,
+			OldValue:     map[string]any{"status": incident.Status, "impact": incident.Impact},
+			NewValue:     map[string]any{"status": req.Status, "impact": impact},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		return s.loadIncident(ctx, q, incident.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate()
+	logctx.From(ctx, s.logger).Info("status incident updated",
+		zap.String("incident_external_id", incidentExternalID),
+		zap.String("status", req.Status),
+		zap.String("request_id", actor.RequestID),
+	)
+	return response, nil
+}
+
+// loadIncident reads an incident with its updates
+func (s *Service) loadIncident(ctx context.Context, q *db.Queries, incidentID uint64) (*IncidentResponse, error) {
+	incident, err := q.GetStatusIncidentByID(ctx, incidentID)
+	if err != nil {
+		return nil, errors.DBError(err)
+	}
+	updates, err := q.ListStatusIncidentUpdatesByIncidentIDs(ctx, []uint64{incidentID})
+	if err != nil {
+		return nil, errors.DBError(err)
+	}
+	response := ToIncidentResponse(&incident, updates)
+	return &response, nil
+}
+
+// invalidate drops the cached feed so posted incidents show up immediately
+func (s *Service) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.expiresAt = time.Time{}
+}
+
+// normalizeComponents validates component keys and joins them for the components column
+func normalizeComponents(keys []string) (string, error) {
+	seen := make(map[string]bool, len(keys))
+	var normalized []string
+	for _, key := range keys {
+		key = strings.ToLower(strings.TrimSpace(key))
+		if !isComponent(key) {
+			return "", errors.InvalidInput("unknown component " + key)
+		}
+		if !seen[key] {
+			seen[key] = true
+			normalized = append(normalized, key)
+		}
+	}
+	sort.Strings(normalized)
+	return strings.Join(normalized, ","), nil
+}
@@ -0,0 +1,78 @@
+package status
+
+import "strings"
+
+// Component states, ordered by severity
+const (
+	StateOperational   = "OPERATIONAL"
+	StateDegraded      = "DEGRADED"
+	StatePartialOutage = "PARTIAL_OUTAGE"
+	StateMajorOutage   = "MAJOR_OUTAGE"
+)
+
+// Component keys (status_incidents.components)
+const (
+	// ComponentAPI is the merchant API (database + cache)
+	ComponentAPI = "api"
+	// ComponentEventDelivery is the outbox relay publishing domain events
+	ComponentEventDelivery = "event_delivery"
+	// ComponentWebhooks is webhook delivery to merchant endpoints
+	ComponentWebhooks = "webhooks"
+	// ComponentSettlement is on-chain settlement (chain RPC + platform transfers)
+	ComponentSettlement = "settlement"
+)
+
+// componentNames maps component keys to the names shown on the status page (feed order)
+var componentNames = []struct {
+	Key  string
+	Name string
+}{
+	{ComponentAPI, "Merchant API"},
+	{ComponentEventDelivery, "Event processing"},
+	{ComponentWebhooks, "Webhook delivery"},
+	{ComponentSettlement, "On-chain settlement"},
+}
+
+// severity ranks a state (higher = worse)
+var severity = map[string]int{
+	StateOperational:   0,
+	StateDegraded:      1,
+	StatePartialOutage: 2,
+	StateMajorOutage:   3,
+}
+
+// impactStates maps incident impact to the state of affected components
+var impactStates = map[string]string{
+	"MINOR":    StateDegraded,
+	"MAJOR":    StatePartialOutage,
+	"CRITICAL": StateMajorOutage,
+}
+
+// worst returns the more severe state
+func worst(a, b string) string {
+	if severity[b] > severity[a] {
+		return b
+	}
+	return a
+}
+
+// isComponent reports whether key is a known component
+func isComponent(key string) bool {
+	for _, c := range componentNames {
+		if c.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// splitComponents parses the comma-separated components column
+func splitComponents(value string) []string {
+	var keys []string
+	for _, key := range strings.Split(value, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
@@ -0,0 +1,88 @@
+package support
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// AttachSupportCaseRequest represents the request body sent by the support tool
+// NOTE: subject_id는 ORDER → 주문번호, PAYMENT → 결제 external ID
+type AttachSupportCaseRequest struct {
+	SubjectType string `json:"subject_type" binding:"required,oneof=ORDER PAYMENT" example:"PAYMENT"`
+	SubjectID   string `json:"subject_id" binding:"required,max=64" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TicketID    string `json:"ticket_id" binding:"required,min=1,max=64" example:"1234"`
+	Status      string `json:"status" binding:"required,oneof=OPEN PENDING RESOLVED CLOSED" example:"OPEN"`
+	TicketURL   string `json:"ticket_url,omitempty" binding:"omitempty,url,max=2048" example:"https://support.example.com/tickets/1234"`
+}
+
+// ListSupportCasesRequest represents query parameters for listing cases of a subject
+type ListSupportCasesRequest struct {
+	SubjectType string `form:"subject_type" binding:"required,oneof=ORDER PAYMENT"`
+	SubjectID   string `form:"subject_id" binding:"required,max=64"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// SupportCaseResponse represents a linked support ticket in API responses
+type SupportCaseResponse struct {
+	ID          string    `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	SubjectType string    `json:"subject_type" example:"PAYMENT"`
+	SubjectID   string    `json:"subject_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	TicketID    string    `json:"ticket_id" example:"1234"`
+	Status      string    `json:"status" example:"OPEN"`
+	Open        bool      `json:"open" example:"true"`
+	TicketURL   string    `json:"ticket_url,omitempty" example:"https://support.example.com/tickets/1234"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ListSupportCasesResponse represents the support cases linked to a subject
+type ListSupportCasesResponse struct {
+	Cases     []SupportCaseResponse `json:"cases"`
+	OpenCount int                   `json:"open_count" example:"1"`
+	Total     int64                 `json:"total"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// IsOpen reports whether the case still needs attention (OPEN/PENDING)
+func IsOpen(status db.SupportCasesStatus) bool {
+	return status == db.SupportCasesStatusOPEN || status == db.SupportCasesStatusPENDING
+}
+
+// ToSupportCaseResponse converts db.SupportCase to SupportCaseResponse
+func ToSupportCaseResponse(sc *db.SupportCase) *SupportCaseResponse {
+	if sc == nil {
+		return nil
+	}
+
+	return &SupportCaseResponse{
+		ID:          sc.ExternalID,
+		SubjectType: string(sc.SubjectType),
+		SubjectID:   sc.SubjectRef,
+		TicketID:    sc.TicketID,
+		Status:      string(sc.Status),
+		Open:        IsOpen(sc.Status),
+		TicketURL:   sc.TicketUrl.String,
+		CreatedAt:   sc.CreatedAt,
+		UpdatedAt:   sc.UpdatedAt,
+	}
+}
+
+// ToSupportCaseResponseList converts []db.SupportCase to []SupportCaseResponse
+func ToSupportCaseResponseList(cases []db.SupportCase) []SupportCaseResponse {
+	responses := make([]SupportCaseResponse, 0, len(cases))
+	for _, sc := range cases {
+		responses = append(responses, *ToSupportCaseResponse(&sc))
+	}
+	return responses
+}
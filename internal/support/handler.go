@@ -0,0 +1,93 @@
+package support
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles HTTP requests for support case operations
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new support case handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers support case routes on the router group
+// NOTE: 지원 도구는 ADMIN 권한의 서비스 계정 API 키로 호출
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	cases := rg.Group("/support/cases", middleware.RequireRoles(middleware.RoleAdmin))
+	{
+		cases.PUT("", h.AttachCase)
+		cases.GET("", h.ListCases)
+	}
+}
+
+// AttachCase godoc
+// @Summary Attach a support ticket
+// @Description Link an external support ticket to an order or payment, or update its status if already linked (idempotent) - Admin only.
+// @Description subject_id is the order number (ORDER) or the payment external ID (PAYMENT).
+// @Tags support
+// @Accept json
+// @Produce json
+// @Param request body AttachSupportCaseRequest true "Ticket and subject"
+// @Success 200 {object} middleware.SuccessResponse{data=SupportCaseResponse} "Support case"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Subject not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/support/cases [put]
+func (h *Handler) AttachCase(c *gin.Context) {
+	var req AttachSupportCaseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	sc, err := h.service.AttachCase(c.Request.Context(), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, ToSupportCaseResponse(sc))
+}
+
+// ListCases godoc
+// @Summary List support tickets of a subject
+// @Description Get the support tickets linked to an order or payment, with the number still open - Admin only
+// @Description Send Accept: text/csv to receive the same results (same filters) as CSV.
+// @Tags support
+// @Produce json,text/csv
+// @Param subject_type query string true "Subject type" Enums(ORDER, PAYMENT)
+// @Param subject_id query string true "Order number or payment external ID"
+// @Success 200 {object} middleware.SuccessResponse{data=ListSupportCasesResponse} "Support case list"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid query parameters"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Subject not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/support/cases [get]
+func (h *Handler) ListCases(c *gin.Context) {
+	var req ListSupportCasesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListCases(c.Request.Context(), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOKNegotiated(c, "support-cases", result, result.Cases)
+}
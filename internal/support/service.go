@@ -0,0 +1,131 @@
+package support
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Service links external support tickets to orders and payments
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	logger   *zap.Logger
+}
+
+// NewService creates a new support case service
+func NewService(txRunner *pkgdb.TxRunner, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		logger:   logger,
+	}
+}
+
+// AttachCase links a ticket to a subject, or updates its status if already linked (idempotent)
+func (s *Service) AttachCase(ctx context.Context, req *AttachSupportCaseRequest) (*db.SupportCase, error) {
+	return pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*db.SupportCase, error) {
+		// 1. Resolve subject
+		subjectType := db.SupportCasesSubjectType(req.SubjectType)
+		subjectID, err := resolveSubject(ctx, q, subjectType, req.SubjectID)
+		if err != nil {
+			return nil, err
+		}
+
+		// 2. Upsert (ticket status is owned by the support tool)
+		if err := q.UpsertSupportCase(ctx, db.UpsertSupportCaseParams{
+			ExternalID:  uuid.New().String(),
+			SubjectType: subjectType,
+			SubjectID:   subjectID,
+			SubjectRef:  req.SubjectID,
+			TicketID:    req.TicketID,
+			Status:      db.SupportCasesStatus(req.Status),
+			TicketUrl:   sql.NullString{String: req.TicketURL, Valid: req.TicketURL != ""},
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to upsert support case", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		sc, err := q.GetSupportCaseBySubjectAndTicket(ctx, db.GetSupportCaseBySubjectAndTicketParams{
+			SubjectType: subjectType,
+			SubjectID:   subjectID,
+			TicketID:    req.TicketID,
+		})
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to get support case", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		logctx.From(ctx, s.logger).Info("support case attached",
+			zap.String("subject_type", req.SubjectType),
+			zap.String("subject_ref", req.SubjectID),
+			zap.String("ticket_id", req.TicketID),
+			zap.String("status", req.Status),
+		)
+
+		return &sc, nil
+	})
+}
+
+// ListCases retrieves the tickets linked to a subject
+func (s *Service) ListCases(ctx context.Context, req *ListSupportCasesRequest) (*ListSupportCasesResponse, error) {
+	q := s.txRunner.Queries()
+
+	subjectType := db.SupportCasesSubjectType(req.SubjectType)
+	subjectID, err := resolveSubject(ctx, q, subjectType, req.SubjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	cases, err := q.ListSupportCasesBySubject(ctx, db.ListSupportCasesBySubjectParams{
+		SubjectType: subjectType,
+		SubjectID:   subjectID,
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list support cases", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	openCount := 0
+	for _, sc := range cases {
+		if IsOpen(sc.Status) {
+			openCount++
+		}
+	}
+
+	return &ListSupportCasesResponse{
+		Cases:     ToSupportCaseResponseList(cases),
+		OpenCount: openCount,
+		Total:     int64(len(cases)),
+	}, nil
+}
+
+// resolveSubject maps the external subject reference to its internal ID
+func resolveSubject(ctx context.Context, q *db.Queries, subjectType db.SupportCasesSubjectType, subjectRef string) (uint64, error) {
+	switch subjectType {
+	case db.SupportCasesSubjectTypeORDER:
+		order, err := q.GetOrderByOrderNumber(ctx, subjectRef)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return 0, errors.NotFound("Order")
+			}
+			return 0, errors.DBError(err)
+		}
+		return order.ID, nil
+	case db.SupportCasesSubjectTypePAYMENT:
+		payment, err := q.GetPaymentByExternalID(ctx, sql.NullString{String: subjectRef, Valid: true})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return 0, errors.NotFound("Payment")
+			}
+			return 0, errors.DBError(err)
+		}
+		return payment.ID, nil
+	default:
+		return 0, errors.InvalidInput("Unsupported subject type")
+	}
+}
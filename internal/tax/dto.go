@@ -0,0 +1,115 @@
+package tax
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// SetTaxProfileRequest represents the request body for setting a user's tax country
+type SetTaxProfileRequest struct {
+	Country string `json:"country" binding:"required,len=2,alpha" example:"DE"`
+	TaxID   string `json:"tax_id,omitempty" binding:"max=50" example:"DE123456789"` // VAT registration number
+}
+
+// SetTaxRateRequest represents the request body for setting the rate of a country (and product category)
+type SetTaxRateRequest struct {
+	Country  string `json:"country" binding:"required,len=2,alpha" example:"DE"`
+	Category string `json:"category,omitempty" binding:"max=50" example:"food"` // omitted = the country's default rate
+	Name     string `json:"name" binding:"required,max=50" example:"VAT"`
+	Rate     string `json:"rate" binding:"required,max=16" example:"19.00"` // percent
+}
+
+// ListTaxRatesRequest represents query parameters for listing tax rates
+type ListTaxRatesRequest struct {
+	Country string `form:"country" binding:"omitempty,len=2,alpha"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// TaxProfileResponse represents a user's tax country
+type TaxProfileResponse struct {
+	UserID    string    `json:"user_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Country   string    `json:"country" example:"DE"`
+	TaxID     string    `json:"tax_id,omitempty" example:"DE123456789"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TaxRateResponse represents a rate of the internal rate table
+type TaxRateResponse struct {
+	TaxRateID string      `json:"tax_rate_id" example:"bb1e8400-e29b-41d4-a716-446655440000"`
+	Country   string      `json:"country" example:"DE"`
+	Category  string      `json:"category,omitempty" example:"food"`
+	Name      string      `json:"name" example:"VAT"`
+	Rate      money.Money `json:"rate" swaggertype:"string" example:"19.0000"`
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+// ListTaxRatesResponse represents the rate table
+type ListTaxRatesResponse struct {
+	Provider string            `json:"provider" example:"table" enums:"none,table,external"` // active calculator
+	Rates    []TaxRateResponse `json:"rates"`
+}
+
+// TaxLineResponse represents a tax levied on a document line
+type TaxLineResponse struct {
+	LineNo        uint32      `json:"line_no" example:"1"`
+	Jurisdiction  string      `json:"jurisdiction" example:"DE"`
+	Name          string      `json:"name" example:"VAT"`
+	Rate          money.Money `json:"rate" swaggertype:"string" example:"19.0000"`
+	TaxableAmount money.Money `json:"taxable_amount" swaggertype:"string" example:"1250.00"`
+	TaxAmount     money.Money `json:"tax_amount" swaggertype:"string" example:"237.50"`
+	Provider      string      `json:"provider" example:"table"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToTaxRateResponse converts a rate to the response
+func ToTaxRateResponse(r *db.TaxRate, rate money.Money) TaxRateResponse {
+	return TaxRateResponse{
+		TaxRateID: r.ExternalID,
+		Country:   r.Country,
+		Category:  r.Category.String,
+		Name:      r.Name,
+		Rate:      rate,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+// ToTaxLineResponses parses and converts the stored tax lines of a document
+func ToTaxLineResponses(lines []db.TaxLine) ([]TaxLineResponse, error) {
+	responses := make([]TaxLineResponse, 0, len(lines))
+	for _, line := range lines {
+		rate, err := money.Parse(line.Rate, "", rateScale)
+		if err != nil {
+			return nil, err
+		}
+		taxable, err := money.Parse(line.TaxableAmount, "", amountScale)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := money.Parse(line.TaxAmount, "", amountScale)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, TaxLineResponse{
+			LineNo:        line.LineNo,
+			Jurisdiction:  line.Jurisdiction,
+			Name:          line.TaxName,
+			Rate:          rate,
+			TaxableAmount: taxable,
+			TaxAmount:     amount,
+			Provider:      line.Provider,
+		})
+	}
+	return responses, nil
+}
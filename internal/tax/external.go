@@ -0,0 +1,164 @@
+package tax
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/httpclient"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"go.uber.org/zap"
+)
+
+// maxResponseBodyRead bounds how much of the provider response is read
+const maxResponseBodyRead = 256 << 10
+
+// ExternalCalculator implements Calculator by calling an external tax API.
+// The endpoint receives the buyer's country and the lines (with product categories) and returns the taxes per line;
+// adapters for a specific vendor can be deployed behind it.
+//
+// Request:  {"country":"DE","currency":"USDC","lines":[{"line_no":1,"category":"electronics","amount":"100.00"}]}
+// Response: {"lines":[{"line_no":1,"jurisdiction":"DE","name":"VAT","rate":"19","tax_amount":"19.00"}]}
+//
+// Why:
+//   - 계산은 조회성 요청 → POST여도 재시도 허용 (같은 입력 = 같은 결과)
+//   - 응답의 line_no/금액 검증 실패도 ErrCalculationFailed → 잘못된 세금으로 문서 생성 방지
+type ExternalCalculator struct {
+	endpoint string
+	apiKey   string
+	client   *httpclient.Client
+}
+
+// Compile-time interface compliance check
+var _ Calculator = (*ExternalCalculator)(nil)
+
+type externalLine struct {
+	LineNo   uint32 `json:"line_no"`
+	Category string `json:"category,omitempty"`
+	Amount   string `json:"amount"`
+}
+
+type externalRequest struct {
+	Country  string         `json:"country"`
+	Currency string         `json:"currency"`
+	Lines    []externalLine `json:"lines"`
+}
+
+type externalResponse struct {
+	Lines []struct {
+		LineNo       uint32 `json:"line_no"`
+		Jurisdiction string `json:"jurisdiction"`
+		Name         string `json:"name"`
+		Rate         string `json:"rate"`
+		TaxAmount    string `json:"tax_amount"`
+	} `json:"lines"`
+}
+
+// NewExternalCalculator creates a calculator for the given tax API endpoint
+func NewExternalCalculator(endpoint, apiKey string, timeout time.Duration, logger *zap.Logger) (*ExternalCalculator, error) {
+	parsed, err := url.Parse(endpoint)
+	if err != nil || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid tax api url %q", endpoint)
+	}
+
+	return &ExternalCalculator{
+		endpoint: endpoint,
+		apiKey:   apiKey,
+		client: httpclient.New(httpclient.Config{
+			Name:               "tax",
+			Timeout:            timeout,
+			MaxRetries:         2,
+			RetryNonIdempotent: true,
+		}, logger),
+	}, nil
+}
+
+// Provider returns ProviderExternal
+func (c *ExternalCalculator) Provider() string {
+	return ProviderExternal
+}
+
+// Calculate sends the lines to the tax API and validates the returned taxes
+func (c *ExternalCalculator) Calculate(ctx context.Context, q *db.Queries, req *Request) ([]LineTax, error) {
+	payload := externalRequest{
+		Country:  req.Country,
+		Currency: req.Currency,
+		Lines:    make([]externalLine, 0, len(req.Lines)),
+	}
+	amounts := make(map[uint32]money.Money, len(req.Lines))
+	for _, line := range req.Lines {
+		payload.Lines = append(payload.Lines, externalLine{
+			LineNo:   line.LineNo,
+			Category: line.Category,
+			Amount:   line.Amount.String(),
+		})
+		amounts[line.LineNo] = line.Amount
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshal tax request: %w", err)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("build tax request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCalculationFailed, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodyRead))
+	if err != nil {
+		return nil, fmt.Errorf("%w: read response: %v", ErrCalculationFailed, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("%w: status %d: %s", ErrCalculationFailed, resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	var calculated externalResponse
+	if err := json.Unmarshal(respBody, &calculated); err != nil {
+		return nil, fmt.Errorf("%w: decode response: %v", ErrCalculationFailed, err)
+	}
+
+	taxes := make([]LineTax, 0, len(calculated.Lines))
+	for _, line := range calculated.Lines {
+		amount, ok := amounts[line.LineNo]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown line %d", ErrCalculationFailed, line.LineNo)
+		}
+		rate, err := money.Parse(line.Rate, "", rateScale)
+		if err != nil || rate.Sign() < 0 || rate.Cmp(maxRate) > 0 {
+			return nil, fmt.Errorf("%w: invalid rate %q on line %d", ErrCalculationFailed, line.Rate, line.LineNo)
+		}
+		taxAmount, err := money.Parse(line.TaxAmount, "", amountScale)
+		if err != nil || taxAmount.Sign() < 0 {
+			return nil, fmt.Errorf("%w: invalid tax amount %q on line %d", ErrCalculationFailed, line.TaxAmount, line.LineNo)
+		}
+		if line.Name == "" || len(line.Name) > 50 || line.Jurisdiction == "" || len(line.Jurisdiction) > 20 {
+			return nil, fmt.Errorf("%w: invalid tax name or jurisdiction on line %d", ErrCalculationFailed, line.LineNo)
+		}
+		taxes = append(taxes, LineTax{
+			LineNo:        line.LineNo,
+			Jurisdiction:  line.Jurisdiction,
+			Name:          line.Name,
+			Rate:          rate,
+			TaxableAmount: amount,
+			TaxAmount:     taxAmount,
+		})
+	}
+	return taxes, nil
+}
@@ -0,0 +1,206 @@
+package tax
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for tax profiles and rates
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new tax handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers tax routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	users := rg.Group("/users/:id", middleware.RequireAuth())
+	{
+		users.GET("/tax-profile", h.GetProfile)
+		users.PUT("/tax-profile", h.SetProfile)
+	}
+
+	rates := rg.Group("/tax-rates", middleware.RequireAuth())
+	{
+		rates.GET("", h.ListRates)
+	}
+
+	admin := rg.Group("/admin/tax-rates", middleware.RequireRoles(middleware.RoleAdmin))
+	{
+		admin.PUT("", h.SetRate)
+		admin.DELETE("/:taxRateId", h.DeleteRate)
+	}
+}
+
+// extractUserID extracts the user id from path and checks the caller may act as the user
+func extractUserID(c *gin.Context) (string, error) {
+	userID := c.Param("id")
+	if _, err := uuid.Parse(userID); err != nil {
+		return "", errors.InvalidInput("Invalid UUID format")
+	}
+
+	principal, _ := middleware.GetPrincipal(c)
+	if !principal.CanActAs(userID) {
+		return "", errors.Forbidden("Cannot access tax profile of another user")
+	}
+	return userID, nil
+}
+
+// GetProfile godoc
+// @Summary Get tax profile
+// @Description Get the user's tax country. Orders and invoices the user buys are taxed for this country; users without a profile are not taxed.
+// @Tags tax
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=TaxProfileResponse} "Tax profile"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid user ID"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "User or tax profile not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/tax-profile [get]
+func (h *Handler) GetProfile(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	result, err := h.service.GetProfile(c.Request.Context(), userExternalID)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// SetProfile godoc
+// @Summary Set tax profile
+// @Description Set the user's tax country (ISO 3166-1 alpha-2) and VAT registration number.
+// @Description Applies to orders and invoices created afterwards; existing documents keep their taxes. Audited.
+// @Tags tax
+// @Accept json
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param request body SetTaxProfileRequest true "Tax profile"
+// @Success 200 {object} middleware.SuccessResponse{data=TaxProfileResponse} "Tax profile set"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/tax-profile [put]
+func (h *Handler) SetProfile(c *gin.Context) {
+	userExternalID, err := extractUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req SetTaxProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.SetProfile(c.Request.Context(), userExternalID, &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// ListRates godoc
+// @Summary List tax rates
+// @Description List the internal rate table with the active provider (TAX_PROVIDER). The table is used by the "table" provider;
+// @Description a rate without category is the country's default for products without a specific rate.
+// @Tags tax
+// @Produce json
+// @Param country query string false "Country (ISO 3166-1 alpha-2)"
+// @Success 200 {object} middleware.SuccessResponse{data=ListTaxRatesResponse} "Tax rates"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/tax-rates [get]
+func (h *Handler) ListRates(c *gin.Context) {
+	var req ListTaxRatesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListRates(c.Request.Context(), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// SetRate godoc
+// @Summary Set tax rate
+// @Description Create or replace the rate of a country and product category - Admin only.
+// @Description Applies to orders and invoices created afterwards. Audited.
+// @Tags tax
+// @Accept json
+// @Produce json
+// @Param request body SetTaxRateRequest true "Tax rate"
+// @Success 200 {object} middleware.SuccessResponse{data=TaxRateResponse} "Tax rate set"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/tax-rates [put]
+func (h *Handler) SetRate(c *gin.Context) {
+	var req SetTaxRateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.SetRate(c.Request.Context(), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// DeleteRate godoc
+// @Summary Delete tax rate
+// @Description Delete a rate - Admin only. Lines of its country and category fall back to the country's default rate. Audited.
+// @Tags tax
+// @Param taxRateId path string true "Tax rate external ID (UUID)"
+// @Success 204 "Tax rate deleted"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid tax rate ID"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Tax rate not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/tax-rates/{taxRateId} [delete]
+func (h *Handler) DeleteRate(c *gin.Context) {
+	taxRateID := c.Param("taxRateId")
+	if _, err := uuid.Parse(taxRateID); err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Invalid UUID format"))
+		return
+	}
+
+	if err := h.service.DeleteRate(c.Request.Context(), taxRateID, audit.ActorFromContext(c)); err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondNoContent(c)
+}
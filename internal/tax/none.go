@@ -0,0 +1,28 @@
+package tax
+
+import (
+	"context"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// NoneCalculator implements Calculator by levying no tax (tax handled outside the platform)
+type NoneCalculator struct{}
+
+// Compile-time interface compliance check
+var _ Calculator = (*NoneCalculator)(nil)
+
+// NewNoneCalculator creates a calculator that never levies tax
+func NewNoneCalculator() *NoneCalculator {
+	return &NoneCalculator{}
+}
+
+// Provider returns ProviderNone
+func (c *NoneCalculator) Provider() string {
+	return ProviderNone
+}
+
+// Calculate always returns no taxes
+func (c *NoneCalculator) Calculate(ctx context.Context, q *db.Queries, req *Request) ([]LineTax, error) {
+	return nil, nil
+}
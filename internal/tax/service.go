@@ -0,0 +1,229 @@
+package tax
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Audit log identifiers
+const (
+	actionProfileSet   = "TAX_PROFILE_SET"
+	actionRateSet      = "TAX_RATE_SET"
+	actionRateDeleted  = "TAX_RATE_DELETED"
+	resourceTaxProfile = "TAX_PROFILE"
+	resourceTaxRate    = "TAX_RATE"
+)
+
+// Service manages users' tax countries and the internal rate table
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	engine   *Engine
+	logger   *zap.Logger
+}
+
+// NewService creates a new tax service
+func NewService(txRunner *pkgdb.TxRunner, engine *Engine, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		engine:   engine,
+		logger:   logger,
+	}
+}
+
+// GetProfile returns the user's tax country
+func (s *Service) GetProfile(ctx context.Context, userExternalID string) (*TaxProfileResponse, error) {
+	q := s.txRunner.Queries()
+	user, err := s.getUser(ctx, q, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := q.GetTaxProfile(ctx, user.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Tax profile")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get tax profile", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &TaxProfileResponse{
+		UserID:    userExternalID,
+		Country:   profile.Country,
+		TaxID:     profile.TaxID.String,
+		UpdatedAt: profile.UpdatedAt,
+	}, nil
+}
+
+// SetProfile sets the user's tax country; orders and invoices the user buys from then on are taxed for it
+func (s *Service) SetProfile(ctx context.Context, userExternalID string, req *SetTaxProfileRequest, actor audit.Actor) (*TaxProfileResponse, error) {
+	country := strings.ToUpper(req.Country)
+	taxID := strings.TrimSpace(req.TaxID)
+
+	err := s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		user, err := s.getUser(ctx, q, userExternalID)
+		if err != nil {
+			return err
+		}
+		if err := q.UpsertTaxProfile(ctx, db.UpsertTaxProfileParams{
+			UserID:  user.ID,
+			Country: country,
+			TaxID:   sql.NullString{String: taxID, Valid: taxID != ""},
+		}); err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionProfileSet,
+			ResourceType: resourceTaxProfile,
+			ResourceID:   user.ID,
+			NewValue:     map[string]any{"country": country, "tax_id": taxID},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to set tax profile", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.GetProfile(ctx, userExternalID)
+}
+
+// ListRates returns the internal rate table, optionally of one country
+func (s *Service) ListRates(ctx context.Context, req *ListTaxRatesRequest) (*ListTaxRatesResponse, error) {
+	var country sql.NullString
+	if req.Country != "" {
+		country = sql.NullString{String: strings.ToUpper(req.Country), Valid: true}
+	}
+	rates, err := s.txRunner.Queries().ListTaxRates(ctx, country)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list tax rates", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	response := &ListTaxRatesResponse{
+		Provider: s.engine.Provider(),
+		Rates:    make([]TaxRateResponse, 0, len(rates)),
+	}
+	for i := range rates {
+		rate, err := s.parseRate(ctx, rates[i].Rate)
+		if err != nil {
+			return nil, err
+		}
+		response.Rates = append(response.Rates, ToTaxRateResponse(&rates[i], rate))
+	}
+	return response, nil
+}
+
+// SetRate creates or replaces the rate of a country and product category (admin).
+// Documents already created keep the taxes they were created with.
+func (s *Service) SetRate(ctx context.Context, req *SetTaxRateRequest, actor audit.Actor) (*TaxRateResponse, error) {
+	rate, err := money.Parse(req.Rate, "", rateScale)
+	if err != nil || rate.Sign() < 0 || rate.Cmp(maxRate) > 0 {
+		return nil, errors.InvalidInput("rate must be a percentage between 0 and 100 with at most 4 decimal places")
+	}
+	country := strings.ToUpper(req.Country)
+	category := strings.TrimSpace(req.Category)
+	categoryKey := sql.NullString{String: category, Valid: true}
+
+	var stored db.TaxRate
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		if err := q.UpsertTaxRate(ctx, db.UpsertTaxRateParams{
+			ExternalID: uuid.New().String(),
+			Country:    country,
+			Category:   sql.NullString{String: category, Valid: category != ""},
+			Name:       strings.TrimSpace(req.Name),
+			Rate:       rate.String(),
+		}); err != nil {
+			return err
+		}
+		stored, err = q.GetTaxRateByScope(ctx, db.GetTaxRateByScopeParams{
+			Country:     country,
+			CategoryKey: categoryKey,
+		})
+		if err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionRateSet,
+			ResourceType: resourceTaxRate,
+			ResourceID:   stored.ID,
+			NewValue: map[string]any{
+				"country":  country,
+				"category": category,
+				"name":     stored.Name,
+				"rate":     rate.String(),
+			},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to set tax rate", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	response := ToTaxRateResponse(&stored, rate)
+	return &response, nil
+}
+
+// DeleteRate deletes a rate (admin); lines of its country and category fall back to the country's default rate
+func (s *Service) DeleteRate(ctx context.Context, externalID string, actor audit.Actor) error {
+	err := s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		result, err := q.DeleteTaxRate(ctx, externalID)
+		if err != nil {
+			return err
+		}
+		if n, err := result.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return errors.NotFound("Tax rate")
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionRateDeleted,
+			ResourceType: resourceTaxRate,
+			OldValue:     map[string]any{"tax_rate_id": externalID},
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to delete tax rate", zap.Error(err))
+		return errors.DBError(err)
+	}
+	return nil
+}
+
+// getUser retrieves the user by external ID
+func (s *Service) getUser(ctx context.Context, q *db.Queries, externalID string) (*db.User, error) {
+	user, err := q.GetUserByExternalID(ctx, sql.NullString{String: externalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("User")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &user, nil
+}
+
+// parseRate parses a DECIMAL(7,4) rate column
+func (s *Service) parseRate(ctx context.Context, value string) (money.Money, error) {
+	rate, err := money.Parse(value, "", rateScale)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("invalid stored tax rate", zap.String("rate", value), zap.Error(err))
+		return money.Money{}, errors.Internal("Invalid stored tax rate")
+	}
+	return rate, nil
+}
@@ -0,0 +1,67 @@
+package tax
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// TableCalculator implements Calculator with the internal rate table (tax_rates).
+// A line is taxed at the rate of its product category in the buyer's country, falling back to the country's default
+// rate; countries without rates are not taxed.
+type TableCalculator struct{}
+
+// Compile-time interface compliance check
+var _ Calculator = (*TableCalculator)(nil)
+
+// NewTableCalculator creates a rate table calculator
+func NewTableCalculator() *TableCalculator {
+	return &TableCalculator{}
+}
+
+// Provider returns ProviderTable
+func (c *TableCalculator) Provider() string {
+	return ProviderTable
+}
+
+// Calculate levies one tax per line at the applicable table rate
+func (c *TableCalculator) Calculate(ctx context.Context, q *db.Queries, req *Request) ([]LineTax, error) {
+	rates := make(map[string]*db.TaxRate)
+	var taxes []LineTax
+	for _, line := range req.Lines {
+		rate, ok := rates[line.Category]
+		if !ok {
+			found, err := q.GetApplicableTaxRate(ctx, db.GetApplicableTaxRateParams{
+				Country:  req.Country,
+				Category: sql.NullString{String: line.Category, Valid: true},
+			})
+			if err != nil && err != sql.ErrNoRows {
+				return nil, fmt.Errorf("get tax rate: %w", err)
+			}
+			if err == nil {
+				rate = &found
+			}
+			rates[line.Category] = rate
+		}
+		if rate == nil {
+			continue
+		}
+
+		percent, err := money.Parse(rate.Rate, "", rateScale)
+		if err != nil {
+			return nil, fmt.Errorf("invalid stored tax rate %q: %w", rate.Rate, err)
+		}
+		taxes = append(taxes, LineTax{
+			LineNo:        line.LineNo,
+			Jurisdiction:  rate.Country,
+			Name:          rate.Name,
+			Rate:          percent,
+			TaxableAmount: line.Amount,
+			TaxAmount:     taxOf(line.Amount, percent),
+		})
+	}
+	return taxes, nil
+}
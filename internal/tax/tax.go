@@ -0,0 +1,246 @@
+package tax
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"go.uber.org/zap"
+)
+
+// Supported providers
+const (
+	ProviderNone     = "none"
+	ProviderTable    = "table"
+	ProviderExternal = "external"
+)
+
+const (
+	// amountScale is the scale of taxable and tax amounts (DECIMAL(18,2))
+	amountScale = 2
+	// rateScale is the scale of tax rates in percent (DECIMAL(7,4))
+	rateScale = 4
+)
+
+// maxRate is the highest tax rate in percent
+var maxRate = money.MustParse("100", "", rateScale)
+
+// Error definitions
+var (
+	ErrUnknownProvider = stderrors.New("unknown tax provider")
+	// ErrCalculationFailed is returned when the provider could not calculate the tax (callers must not proceed untaxed)
+	ErrCalculationFailed = stderrors.New("tax calculation failed")
+)
+
+// Line is a taxable line of an order or invoice
+type Line struct {
+	LineNo uint32
+	// ProductID is the catalog product of the line (0 = manual invoice line)
+	ProductID uint64
+	// Category is the product category (filled by the engine, empty = uncategorized)
+	Category string
+	// Amount is the taxable amount (quantity × unit price)
+	Amount money.Money
+}
+
+// Request is a tax calculation request of one document
+type Request struct {
+	// Country is the buyer's tax country (ISO 3166-1 alpha-2)
+	Country string
+	// Currency is the currency of the amounts (token symbol)
+	Currency string
+	Lines    []Line
+}
+
+// LineTax is one tax levied on a line (a line may carry several, e.g. state and county taxes)
+type LineTax struct {
+	LineNo        uint32
+	Jurisdiction  string
+	Name          string
+	Rate          money.Money // percent
+	TaxableAmount money.Money
+	TaxAmount     money.Money
+}
+
+// Result is the tax breakdown of one document
+type Result struct {
+	// Provider identifies the calculator that produced the result
+	Provider string
+	Lines    []LineTax
+	// Total is the sum of the line taxes
+	Total money.Money
+}
+
+// LineTotal returns the tax levied on the line
+func (r *Result) LineTotal(lineNo uint32) money.Money {
+	total := money.Zero("", amountScale)
+	for _, line := range r.Lines {
+		if line.LineNo == lineNo {
+			total = total.Add(line.TaxAmount)
+		}
+	}
+	return total
+}
+
+// Calculator defines the interface for tax calculation.
+// Implementations can use the internal rate table or call an external tax API (Avalara, TaxJar, ...).
+type Calculator interface {
+	// Provider returns the provider name stored with the tax lines
+	Provider() string
+	// Calculate returns the taxes of the request lines.
+	// Called within the document's transaction; q may be used for lookups.
+	Calculate(ctx context.Context, q *db.Queries, req *Request) ([]LineTax, error)
+}
+
+// Config holds tax provider settings
+type Config struct {
+	Provider string
+	// ExternalURL is the calculation endpoint of the external provider
+	ExternalURL string
+	// ExternalAPIKey is sent as a bearer token to the external provider
+	ExternalAPIKey string
+	// ExternalTimeout bounds each call to the external provider
+	ExternalTimeout time.Duration
+}
+
+// New creates the calculator selected by config.Provider (empty = none)
+func New(config Config, logger *zap.Logger) (Calculator, error) {
+	switch config.Provider {
+	case "", ProviderNone:
+		return NewNoneCalculator(), nil
+	case ProviderTable:
+		return NewTableCalculator(), nil
+	case ProviderExternal:
+		return NewExternalCalculator(config.ExternalURL, config.ExternalAPIKey, config.ExternalTimeout, logger)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownProvider, config.Provider)
+	}
+}
+
+// Engine applies the configured calculator to orders and invoices and stores the per-line breakdown.
+//
+// Why:
+//   - 구매자 과세 국가 기준 (tax_profiles 없는 구매자는 비과세)
+//   - 세금은 문서 생성 트랜잭션에서 산정·기록 → 이후 세율 변경과 무관하게 문서 금액 고정
+//   - 산정 실패 시 문서 생성 실패 (세금 누락된 문서 발행 방지) → TAX_UNAVAILABLE
+type Engine struct {
+	calculator Calculator
+}
+
+// NewEngine creates a tax engine using the calculator
+func NewEngine(calculator Calculator) *Engine {
+	return &Engine{calculator: calculator}
+}
+
+// Provider returns the provider name of the configured calculator
+func (e *Engine) Provider() string {
+	return e.calculator.Provider()
+}
+
+// Calculate computes the taxes of a document's lines for the buyer (within the document's transaction).
+// Returns an empty result for buyers without a tax profile.
+func (e *Engine) Calculate(ctx context.Context, q *db.Queries, buyerID uint64, currency string, lines []Line) (*Result, error) {
+	result := &Result{Provider: e.calculator.Provider(), Total: money.Zero("", amountScale)}
+
+	profile, err := q.GetTaxProfile(ctx, buyerID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return result, nil
+		}
+		return nil, fmt.Errorf("get tax profile: %w", err)
+	}
+
+	for i := range lines {
+		if lines[i].ProductID == 0 || lines[i].Category != "" {
+			continue
+		}
+		product, err := q.GetProduct(ctx, lines[i].ProductID)
+		if err != nil {
+			return nil, fmt.Errorf("get product %d: %w", lines[i].ProductID, err)
+		}
+		lines[i].Category = product.Category.String
+	}
+
+	taxes, err := e.calculator.Calculate(ctx, q, &Request{
+		Country:  profile.Country,
+		Currency: currency,
+		Lines:    lines,
+	})
+	if err != nil {
+		if stderrors.Is(err, ErrCalculationFailed) {
+			return nil, errors.TaxUnavailable(result.Provider)
+		}
+		return nil, err
+	}
+	for _, line := range taxes {
+		result.Total = result.Total.Add(line.TaxAmount)
+	}
+	result.Lines = taxes
+	return result, nil
+}
+
+// ApplyToOrder stores the tax total and breakdown of an order (within the order's transaction).
+// The caller includes result.Total in the order's total_amount.
+func ApplyToOrder(ctx context.Context, q *db.Queries, orderID uint64, result *Result) error {
+	if len(result.Lines) == 0 {
+		return nil
+	}
+	if err := q.SetOrderTax(ctx, db.SetOrderTaxParams{TaxAmount: result.Total.String(), ID: orderID}); err != nil {
+		return fmt.Errorf("set order tax: %w", err)
+	}
+	return record(ctx, q, db.TaxLinesDocumentTypeORDER, orderID, result)
+}
+
+// ApplyToInvoice stores the tax total and breakdown of a manual invoice (within the invoice's transaction).
+// The caller includes result.Total in the invoice amount.
+func ApplyToInvoice(ctx context.Context, q *db.Queries, invoiceID uint64, result *Result) error {
+	if len(result.Lines) == 0 {
+		return nil
+	}
+	if err := q.SetInvoiceTax(ctx, db.SetInvoiceTaxParams{TaxAmount: result.Total.String(), ID: invoiceID}); err != nil {
+		return fmt.Errorf("set invoice tax: %w", err)
+	}
+	return record(ctx, q, db.TaxLinesDocumentTypeINVOICE, invoiceID, result)
+}
+
+// CopyOrderTax carries the taxes of an order over to its invoice (the invoice amount already includes them)
+func CopyOrderTax(ctx context.Context, q *db.Queries, invoiceID uint64, order *db.Order) error {
+	if err := q.SetInvoiceTax(ctx, db.SetInvoiceTaxParams{TaxAmount: order.TaxAmount, ID: invoiceID}); err != nil {
+		return fmt.Errorf("set invoice tax: %w", err)
+	}
+	if err := q.CopyTaxLines(ctx, db.CopyTaxLinesParams{InvoiceID: invoiceID, OrderID: order.ID}); err != nil {
+		return fmt.Errorf("copy tax lines: %w", err)
+	}
+	return nil
+}
+
+// record stores the per-line tax breakdown of a document
+func record(ctx context.Context, q *db.Queries, documentType db.TaxLinesDocumentType, documentID uint64, result *Result) error {
+	for _, line := range result.Lines {
+		if err := q.CreateTaxLine(ctx, db.CreateTaxLineParams{
+			DocumentType:  documentType,
+			DocumentID:    documentID,
+			LineNo:        line.LineNo,
+			Jurisdiction:  line.Jurisdiction,
+			TaxName:       line.Name,
+			Rate:          line.Rate.String(),
+			TaxableAmount: line.TaxableAmount.String(),
+			TaxAmount:     line.TaxAmount.String(),
+			Provider:      result.Provider,
+		}); err != nil {
+			return fmt.Errorf("create tax line: %w", err)
+		}
+	}
+	return nil
+}
+
+// taxOf returns the tax of the amount at the rate (percent), rounded half up to the amount scale
+func taxOf(amount, rate money.Money) money.Money {
+	units := rate.Rescale(rateScale, money.RoundHalfUp).Units().Int64()
+	return amount.Rescale(amountScale, money.RoundHalfUp).MulFrac(units, 100*10000, money.RoundHalfUp)
+}
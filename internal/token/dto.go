@@ -0,0 +1,44 @@
+package token
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+)
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// TokenResponse represents a supported settlement token
+type TokenResponse struct {
+	Symbol   string `json:"symbol" example:"USDC"`
+	Decimals int    `json:"decimals" example:"6"`
+	// Default is set for the token used when an order or payment omits token_symbol
+	Default     bool                 `json:"default" example:"true"`
+	Deployments []DeploymentResponse `json:"deployments"`
+}
+
+// DeploymentResponse represents the token contract on one enabled chain
+type DeploymentResponse struct {
+	ChainID int64  `json:"chain_id" example:"1"`
+	Network string `json:"network,omitempty" example:"ethereum"`
+	Address string `json:"address" example:"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"`
+}
+
+// ToTokenResponse converts a registry token to TokenResponse
+func ToTokenResponse(t chain.Token, defaultSymbol string, networks *chain.Registry) TokenResponse {
+	response := TokenResponse{
+		Symbol:      t.Symbol,
+		Decimals:    t.Decimals,
+		Default:     t.Symbol == defaultSymbol,
+		Deployments: make([]DeploymentResponse, 0, len(t.Addresses)),
+	}
+	for _, chainID := range t.ChainIDs() {
+		address, _ := t.Address(chainID)
+		deployment := DeploymentResponse{ChainID: chainID, Address: address}
+		if network, ok := networks.Get(chainID); ok {
+			deployment.Network = network.Name
+		}
+		response.Deployments = append(response.Deployments, deployment)
+	}
+	return response
+}
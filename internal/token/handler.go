@@ -0,0 +1,69 @@
+package token
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles HTTP requests for the settlement token registry
+type Handler struct {
+	tokens   *chain.TokenRegistry
+	networks *chain.Registry
+}
+
+// NewHandler creates a new token handler
+func NewHandler(tokens *chain.TokenRegistry, networks *chain.Registry) *Handler {
+	return &Handler{tokens: tokens, networks: networks}
+}
+
+// RegisterRoutes registers token routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	tokens := rg.Group("/tokens", middleware.RequireAuth())
+	{
+		tokens.GET("", h.ListTokens)
+		tokens.GET("/:symbol", h.GetToken)
+	}
+}
+
+// ListTokens godoc
+// @Summary List settlement tokens
+// @Description List the stablecoins orders and payments may settle in, with decimals and the contract on each enabled chain.
+// @Description Amounts of a token are formatted with its decimals.
+// @Tags tokens
+// @Produce json
+// @Success 200 {object} middleware.SuccessResponse{data=[]TokenResponse} "Supported tokens"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Security ApiKeyAuth
+// @Router /api/v1/tokens [get]
+func (h *Handler) ListTokens(c *gin.Context) {
+	tokens := h.tokens.Tokens()
+	response := make([]TokenResponse, 0, len(tokens))
+	for _, t := range tokens {
+		response = append(response, ToTokenResponse(t, h.tokens.DefaultSymbol(), h.networks))
+	}
+
+	middleware.RespondOK(c, response)
+}
+
+// GetToken godoc
+// @Summary Get settlement token
+// @Description Get a supported stablecoin by symbol (case-insensitive).
+// @Tags tokens
+// @Produce json
+// @Param symbol path string true "Token symbol" example(USDC)
+// @Success 200 {object} middleware.SuccessResponse{data=TokenResponse} "Token"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 404 {object} middleware.ErrorResponse "Token not found"
+// @Security ApiKeyAuth
+// @Router /api/v1/tokens/{symbol} [get]
+func (h *Handler) GetToken(c *gin.Context) {
+	t, ok := h.tokens.Get(c.Param("symbol"))
+	if !ok {
+		middleware.RespondError(c, errors.NotFound("Token"))
+		return
+	}
+
+	middleware.RespondOK(c, ToTokenResponse(t, h.tokens.DefaultSymbol(), h.networks))
+}
@@ -0,0 +1,82 @@
+package usage
+
+import "time"
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// UsageQuery represents the usage window (default: last 24 hours by hour)
+type UsageQuery struct {
+	From        time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To          time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	Granularity string    `form:"granularity" binding:"omitempty,oneof=hour day"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// UsageCounts represents request counters.
+// client_errors counts every 4xx, rate-limited (429) requests included.
+type UsageCounts struct {
+	Requests     uint64 `json:"requests" example:"1250"`
+	ClientErrors uint64 `json:"client_errors" example:"40"`
+	ServerErrors uint64 `json:"server_errors" example:"2"`
+	RateLimited  uint64 `json:"rate_limited" example:"12"`
+	// ErrorRate is (client_errors + server_errors) / requests (0 without requests)
+	ErrorRate float64 `json:"error_rate" example:"0.0336"`
+}
+
+// EndpointUsage represents the counters of one route of an API key
+type EndpointUsage struct {
+	Method string `json:"method" example:"POST"`
+	// Route is the route pattern ("unmatched" for requests to unknown paths)
+	Route string `json:"route" example:"/api/v1/payments/:paymentId/refunds"`
+	UsageCounts
+}
+
+// UsagePoint represents the counters of one time bucket
+type UsagePoint struct {
+	BucketStart time.Time `json:"bucket_start"`
+	UsageCounts
+}
+
+// APIKeyUsage represents the usage of one API key (busiest endpoints first)
+type APIKeyUsage struct {
+	ID        string          `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name      string          `json:"name" example:"ERP integration"`
+	Totals    UsageCounts     `json:"totals"`
+	Endpoints []EndpointUsage `json:"endpoints"`
+	Series    []UsagePoint    `json:"series"`
+}
+
+// WebhookPoint represents the webhook deliveries created in one time bucket
+type WebhookPoint struct {
+	BucketStart time.Time `json:"bucket_start"`
+	Delivered   int64     `json:"delivered" example:"310"`
+	Failed      int64     `json:"failed" example:"3"`
+	Pending     int64     `json:"pending" example:"1"`
+}
+
+// WebhookUsage represents the webhook deliveries to the user's endpoints.
+// failed counts deliveries that exhausted their retries (DEAD); pending ones are still retried.
+type WebhookUsage struct {
+	Delivered int64 `json:"delivered" example:"310"`
+	Failed    int64 `json:"failed" example:"3"`
+	Pending   int64 `json:"pending" example:"1"`
+	// SuccessRate is delivered / (delivered + failed) (omitted without finished deliveries)
+	SuccessRate *float64       `json:"success_rate,omitempty" example:"0.9904"`
+	Series      []WebhookPoint `json:"series"`
+}
+
+// UsageResponse represents the API usage of a user's API keys and its webhook deliveries.
+// from/to are aligned to the granularity (UTC); series hold one point per bucket.
+type UsageResponse struct {
+	From        time.Time     `json:"from"`
+	To          time.Time     `json:"to"`
+	Granularity string        `json:"granularity" example:"hour"`
+	Totals      UsageCounts   `json:"totals"`
+	APIKeys     []APIKeyUsage `json:"api_keys"`
+	Webhooks    WebhookUsage  `json:"webhooks"`
+}
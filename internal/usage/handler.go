@@ -0,0 +1,87 @@
+package usage
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for the API usage dashboard
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new usage handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers usage routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	users := rg.Group("/users/:id", middleware.RequireAuth())
+	{
+		users.GET("/usage", h.GetUsage)
+	}
+}
+
+// GetUsage godoc
+// @Summary Get API usage
+// @Description Per-API-key request counts by endpoint and over time (error rates, rate-limit hits) and webhook delivery results of the user.
+// @Description Defaults to the last 24 hours by hour; hourly series cover at most 7 days, daily series at most 90 days (UTC buckets).
+// @Description Request counters are flushed periodically, so the latest minutes may not be included yet.
+// @Tags usage
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param from query string false "Start (RFC 3339, rounded down to the granularity)" example(2026-10-15T00:00:00Z)
+// @Param to query string false "End (RFC 3339, rounded up to the granularity)" example(2026-10-16T00:00:00Z)
+// @Param granularity query string false "Series bucket size" Enums(hour, day) default(hour)
+// @Success 200 {object} middleware.SuccessResponse{data=UsageResponse} "API usage"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid range"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Forbidden"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/users/{id}/usage [get]
+func (h *Handler) GetUsage(c *gin.Context) {
+	userID := c.Param("id")
+	if _, err := uuid.Parse(userID); err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Invalid UUID format"))
+		return
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		middleware.RespondError(c, errors.Unauthorized("API key required"))
+		return
+	}
+	if !principal.CanActAs(userID) {
+		middleware.RespondError(c, errors.Forbidden("Cannot access usage of another user"))
+		return
+	}
+
+	var query UsageQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+	to := query.To
+	if to.IsZero() {
+		to = time.Now().UTC()
+	}
+	from := query.From
+	if from.IsZero() {
+		from = to.Add(-24 * time.Hour)
+	}
+
+	result, err := h.service.GetUsage(c.Request.Context(), userID, from, to, query.Granularity)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
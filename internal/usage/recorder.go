@@ -0,0 +1,121 @@
+package usage
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const (
+	// keyPrefix is the Redis key prefix of the hourly counter hashes
+	keyPrefix = "usage"
+	// pendingKey is the Redis set of counter hashes not yet flushed to MySQL
+	pendingKey = "usage:pending"
+	// counterTTL bounds how long unflushed counters survive (flush job down)
+	counterTTL = 48 * time.Hour
+)
+
+// Counter hash fields are "{metric}|{METHOD} {route}"
+const (
+	metricRequests     = "req"
+	metricClientErrors = "4xx"
+	metricServerErrors = "5xx"
+	metricRateLimited  = "429"
+)
+
+// Recorder counts API requests in Redis hashes, one per API key and hour.
+// The flush worker moves the counters into api_usage_hourly.
+type Recorder struct {
+	client *redis.Client
+	logger *zap.Logger
+}
+
+// Compile-time interface compliance check
+var _ middleware.UsageRecorder = (*Recorder)(nil)
+
+// NewRecorder creates a new Redis usage recorder
+func NewRecorder(client *redis.Client, logger *zap.Logger) *Recorder {
+	return &Recorder{
+		client: client,
+		logger: logger,
+	}
+}
+
+// RecordRequest increments the counters of the request's key, hour and route.
+//
+// Why:
+// - MULTI로 증가 + pending 등록을 함께 → flush 직후 들어온 요청도 다음 flush 대상에 포함
+// - Redis 장애 시 fail-open (경고 로그만) → 사용량 집계가 API 장애 원인이 되지 않도록
+func (r *Recorder) RecordRequest(ctx context.Context, req middleware.UsageRequest) {
+	key := counterKey(req.APIKeyID, req.At)
+	endpoint := req.Method + " " + req.Route
+
+	pipe := r.client.TxPipeline()
+	pipe.HIncrBy(ctx, key, counterField(metricRequests, endpoint), 1)
+	switch {
+	case req.Status >= 500:
+		pipe.HIncrBy(ctx, key, counterField(metricServerErrors, endpoint), 1)
+	case req.Status >= 400:
+		pipe.HIncrBy(ctx, key, counterField(metricClientErrors, endpoint), 1)
+		if req.Status == 429 {
+			pipe.HIncrBy(ctx, key, counterField(metricRateLimited, endpoint), 1)
+		}
+	}
+	pipe.Expire(ctx, key, counterTTL)
+	pipe.SAdd(ctx, pendingKey, key)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		logctx.From(ctx, r.logger).Warn("failed to record api usage",
+			zap.String("api_key_id", req.APIKeyID),
+			zap.Error(err),
+		)
+	}
+}
+
+// counterKey builds the counter hash key of an API key and hour
+// Format: usage:{apiKeyExternalID}:{hourUnix}
+func counterKey(apiKeyID string, at time.Time) string {
+	return fmt.Sprintf("%s:%s:%d", keyPrefix, apiKeyID, at.UTC().Truncate(time.Hour).Unix())
+}
+
+// parseCounterKey splits a counter hash key into the API key external ID and hour
+func parseCounterKey(key string) (string, time.Time, error) {
+	rest, ok := strings.CutPrefix(key, keyPrefix+":")
+	if !ok {
+		return "", time.Time{}, fmt.Errorf("invalid usage key %q", key)
+	}
+	i := strings.LastIndex(rest, ":")
+	if i <= 0 {
+		return "", time.Time{}, fmt.Errorf("invalid usage key %q", key)
+	}
+	hour, err := strconv.ParseInt(rest[i+1:], 10, 64)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("invalid usage key %q: %w", key, err)
+	}
+	return rest[:i], time.Unix(hour, 0).UTC(), nil
+}
+
+// counterField builds the hash field of a metric and endpoint ("METHOD route")
+func counterField(metric, endpoint string) string {
+	return metric + "|" + endpoint
+}
+
+// parseCounterField splits a hash field into metric, method and route
+func parseCounterField(field string) (metric, method, route string, err error) {
+	metric, endpoint, ok := strings.Cut(field, "|")
+	if !ok {
+		return "", "", "", fmt.Errorf("invalid usage field %q", field)
+	}
+	method, route, ok = strings.Cut(endpoint, " ")
+	if !ok {
+		return "", "", "", fmt.Errorf("invalid usage field %q", field)
+	}
+	return metric, method, route, nil
+}
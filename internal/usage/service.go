@@ -0,0 +1,218 @@
+package usage
+
+import (
+	"context"
+	"database/sql"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"go.uber.org/zap"
+)
+
+// Granularities of the usage series
+const (
+	GranularityHour = "hour"
+	GranularityDay  = "day"
+)
+
+const (
+	// maxHourlyRange bounds the window of hourly series
+	maxHourlyRange = 7 * 24 * time.Hour
+	// maxDailyRange bounds the window of daily series
+	maxDailyRange = 90 * 24 * time.Hour
+)
+
+// Service serves the self-serve API usage dashboard.
+// The organization is the user account; usage covers all of its API keys, revoked ones included.
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	logger   *zap.Logger
+}
+
+// NewService creates a new usage service
+func NewService(txRunner *pkgdb.TxRunner, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		logger:   logger,
+	}
+}
+
+// GetUsage returns the API usage and webhook deliveries of the user within [from, to).
+// from is rounded down and to rounded up to the granularity (UTC).
+func (s *Service) GetUsage(ctx context.Context, userExternalID string, from, to time.Time, granularity string) (*UsageResponse, error) {
+	step, maxRange := time.Hour, maxHourlyRange
+	if granularity == GranularityDay {
+		step, maxRange = 24*time.Hour, maxDailyRange
+	} else {
+		granularity = GranularityHour
+	}
+
+	from = from.UTC().Truncate(step)
+	if aligned := to.UTC().Truncate(step); aligned.Before(to) {
+		to = aligned.Add(step)
+	} else {
+		to = aligned
+	}
+	if !from.Before(to) {
+		return nil, errors.InvalidInput("from must be before to")
+	}
+	if to.Sub(from) > maxRange {
+		if granularity == GranularityDay {
+			return nil, errors.InvalidInput("Daily usage range must not exceed 90 days")
+		}
+		return nil, errors.InvalidInput("Hourly usage range must not exceed 7 days (use granularity=day)")
+	}
+
+	user, err := s.getUser(ctx, userExternalID)
+	if err != nil {
+		return nil, err
+	}
+	q := s.txRunner.Queries()
+
+	rows, err := q.ListAPIUsageByUser(ctx, db.ListAPIUsageByUserParams{UserID: user.ID, BucketFrom: from, BucketTo: to})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list api usage", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	stats, err := q.ListWebhookDeliveryStatsByUser(ctx, db.ListWebhookDeliveryStatsByUserParams{UserID: user.ID, CreatedFrom: from, CreatedTo: to})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list webhook delivery stats", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	response := &UsageResponse{
+		From:        from,
+		To:          to,
+		Granularity: granularity,
+		APIKeys:     []APIKeyUsage{},
+	}
+	buckets := int(to.Sub(from) / step)
+	bucketOf := func(t time.Time) int {
+		return int(t.UTC().Sub(from) / step)
+	}
+
+	// 1. API keys (rows are ordered by key)
+	var key *APIKeyUsage
+	var endpoints map[string]int
+	for _, r := range rows {
+		if key == nil || key.ID != r.ApiKeyExternalID {
+			finishKey(response, key)
+			key = &APIKeyUsage{
+				ID:        r.ApiKeyExternalID,
+				Name:      r.ApiKeyName,
+				Endpoints: []EndpointUsage{},
+				Series:    newSeries(from, step, buckets),
+			}
+			endpoints = make(map[string]int)
+		}
+
+		endpoint := r.Method + " " + r.Route
+		i, ok := endpoints[endpoint]
+		if !ok {
+			i = len(key.Endpoints)
+			endpoints[endpoint] = i
+			key.Endpoints = append(key.Endpoints, EndpointUsage{Method: r.Method, Route: r.Route})
+		}
+		key.Endpoints[i].add(r)
+		key.Totals.add(r)
+		response.Totals.add(r)
+		if b := bucketOf(r.BucketStart); b >= 0 && b < buckets {
+			key.Series[b].add(r)
+		}
+	}
+	finishKey(response, key)
+	response.Totals.finish()
+
+	// 2. Webhook deliveries (hourly stats rolled up to the granularity)
+	webhooks := WebhookUsage{Series: make([]WebhookPoint, buckets)}
+	for i := range webhooks.Series {
+		webhooks.Series[i].BucketStart = from.Add(time.Duration(i) * step)
+	}
+	for _, st := range stats {
+		webhooks.Delivered += st.Succeeded
+		webhooks.Failed += st.Dead
+		webhooks.Pending += st.Pending
+		if b := bucketOf(time.Unix(st.HourBucket*3600, 0)); b >= 0 && b < buckets {
+			webhooks.Series[b].Delivered += st.Succeeded
+			webhooks.Series[b].Failed += st.Dead
+			webhooks.Series[b].Pending += st.Pending
+		}
+	}
+	if finished := webhooks.Delivered + webhooks.Failed; finished > 0 {
+		rate := ratio(uint64(webhooks.Delivered), uint64(finished))
+		webhooks.SuccessRate = &rate
+	}
+	response.Webhooks = webhooks
+
+	return response, nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// getUser retrieves a user by external ID
+func (s *Service) getUser(ctx context.Context, userExternalID string) (*db.User, error) {
+	user, err := s.txRunner.Queries().GetUserByExternalID(ctx, sql.NullString{String: userExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("User")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &user, nil
+}
+
+// newSeries returns one empty point per bucket
+func newSeries(from time.Time, step time.Duration, buckets int) []UsagePoint {
+	series := make([]UsagePoint, buckets)
+	for i := range series {
+		series[i].BucketStart = from.Add(time.Duration(i) * step)
+	}
+	return series
+}
+
+// finishKey computes the error rates of a key, sorts its endpoints (busiest first) and appends it
+func finishKey(response *UsageResponse, key *APIKeyUsage) {
+	if key == nil {
+		return
+	}
+	key.Totals.finish()
+	for i := range key.Endpoints {
+		key.Endpoints[i].finish()
+	}
+	for i := range key.Series {
+		key.Series[i].finish()
+	}
+	sort.SliceStable(key.Endpoints, func(i, j int) bool {
+		return key.Endpoints[i].Requests > key.Endpoints[j].Requests
+	})
+	response.APIKeys = append(response.APIKeys, *key)
+}
+
+// add adds the counters of a usage row
+func (c *UsageCounts) add(r db.ListAPIUsageByUserRow) {
+	c.Requests += r.Requests
+	c.ClientErrors += r.ClientErrors
+	c.ServerErrors += r.ServerErrors
+	c.RateLimited += r.RateLimited
+}
+
+// finish computes the error rate from the counters
+func (c *UsageCounts) finish() {
+	c.ErrorRate = ratio(c.ClientErrors+c.ServerErrors, c.Requests)
+}
+
+// ratio returns n / d rounded to 4 decimals (0 when d is 0)
+func ratio(n, d uint64) float64 {
+	if d == 0 {
+		return 0
+	}
+	return math.Round(float64(n)/float64(d)*10000) / 10000
+}
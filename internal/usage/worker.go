@@ -0,0 +1,232 @@
+package usage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+// drainScript reads and deletes a counter hash and removes it from the pending set atomically.
+//
+// KEYS[1] = counter hash, KEYS[2] = pending set
+// returns HGETALL of the hash (flat field/value list)
+var drainScript = redis.NewScript(`
+local data = redis.call('HGETALL', KEYS[1])
+redis.call('DEL', KEYS[1])
+redis.call('SREM', KEYS[2], KEYS[1])
+return data
+`)
+
+// WorkerConfig holds usage flush job settings
+type WorkerConfig struct {
+	// Interval is the period of the flush job (dashboard data lags by at most this much)
+	Interval time.Duration
+}
+
+// RunReport summarizes one flush run
+type RunReport struct {
+	Flushed int
+	Rows    int
+	Failed  int
+}
+
+// Worker flushes the Redis usage counters into api_usage_hourly.
+//
+// Why:
+// - hash 단위로 원자적 drain → API 인스턴스 여러 대에서 동시에 돌아도 같은 카운트를 중복 합산하지 않음
+// - MySQL 반영 실패 시 drain한 카운트를 Redis에 되돌림 → 다음 run에서 재시도
+type Worker struct {
+	txRunner *pkgdb.TxRunner
+	client   *redis.Client
+	config   WorkerConfig
+	logger   *zap.Logger
+}
+
+// NewWorker creates a new usage flush worker
+func NewWorker(txRunner *pkgdb.TxRunner, client *redis.Client, config WorkerConfig, logger *zap.Logger) *Worker {
+	return &Worker{
+		txRunner: txRunner,
+		client:   client,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Run executes the flush job periodically until ctx is canceled
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+
+	logctx.From(ctx, w.logger).Info("usage flush job started", zap.Duration("interval", w.config.Interval))
+
+	for {
+		select {
+		case <-ctx.Done():
+			logctx.From(ctx, w.logger).Info("usage flush job stopped")
+			return
+		case <-ticker.C:
+			report, err := w.RunOnce(ctx)
+			if err != nil {
+				logctx.From(ctx, w.logger).Error("usage flush run failed", zap.Error(err))
+				continue
+			}
+			if report.Failed > 0 {
+				logctx.From(ctx, w.logger).Warn("usage flush run completed with failures",
+					zap.Int("flushed", report.Flushed),
+					zap.Int("rows", report.Rows),
+					zap.Int("failed", report.Failed),
+				)
+			}
+		}
+	}
+}
+
+// RunOnce flushes every pending counter hash.
+// A failing hash is logged and counted; its counts are restored and retried on the next run.
+func (w *Worker) RunOnce(ctx context.Context) (*RunReport, error) {
+	report := &RunReport{}
+
+	keys, err := w.client.SMembers(ctx, pendingKey).Result()
+	if err != nil {
+		return report, fmt.Errorf("list pending usage counters: %w", err)
+	}
+
+	for _, key := range keys {
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+		rows, err := w.flush(ctx, key)
+		if err != nil {
+			logctx.From(ctx, w.logger).Error("failed to flush usage counters",
+				zap.String("key", key),
+				zap.Error(err),
+			)
+			report.Failed++
+			continue
+		}
+		report.Flushed++
+		report.Rows += rows
+	}
+
+	return report, nil
+}
+
+// flush drains one counter hash and adds its counts to api_usage_hourly; returns the rows written
+func (w *Worker) flush(ctx context.Context, key string) (int, error) {
+	apiKeyID, hour, err := parseCounterKey(key)
+	if err != nil {
+		// 알 수 없는 형식 → 재시도해도 실패하므로 pending에서 제거
+		w.client.SRem(ctx, pendingKey, key)
+		return 0, err
+	}
+
+	fields, err := drainScript.Run(ctx, w.client, []string{key, pendingKey}).StringSlice()
+	if err != nil {
+		return 0, fmt.Errorf("drain counters: %w", err)
+	}
+	if len(fields) == 0 {
+		return 0, nil
+	}
+
+	internalID, err := w.txRunner.Queries().GetAPIKeyIDByExternalID(ctx, apiKeyID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// 키 자체가 없음 (잘못된 키 ID) → 카운트 폐기
+			logctx.From(ctx, w.logger).Warn("dropping usage counters of unknown api key", zap.String("api_key_id", apiKeyID))
+			return 0, nil
+		}
+		w.restore(ctx, key, fields)
+		return 0, fmt.Errorf("get api key: %w", err)
+	}
+
+	endpoints, err := aggregate(fields)
+	if err != nil {
+		return 0, err
+	}
+
+	err = w.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		for _, e := range endpoints {
+			e.ApiKeyID = internalID
+			e.BucketStart = hour
+			if err := q.AddAPIUsage(ctx, *e); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		w.restore(ctx, key, fields)
+		return 0, fmt.Errorf("add api usage: %w", err)
+	}
+
+	return len(endpoints), nil
+}
+
+// restore adds drained counts back to the counter hash so that the next run retries them
+func (w *Worker) restore(ctx context.Context, key string, fields []string) {
+	pipe := w.client.TxPipeline()
+	for i := 0; i+1 < len(fields); i += 2 {
+		n, err := strconv.ParseInt(fields[i+1], 10, 64)
+		if err != nil {
+			continue
+		}
+		pipe.HIncrBy(ctx, key, fields[i], n)
+	}
+	pipe.Expire(ctx, key, counterTTL)
+	pipe.SAdd(ctx, pendingKey, key)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		logctx.From(ctx, w.logger).Error("failed to restore usage counters, counts lost",
+			zap.String("key", key),
+			zap.Error(err),
+		)
+	}
+}
+
+// aggregate groups a drained hash (flat field/value list) by endpoint; ApiKeyID and BucketStart are left unset.
+// Malformed fields are skipped.
+func aggregate(fields []string) ([]*db.AddAPIUsageParams, error) {
+	if len(fields)%2 != 0 {
+		return nil, fmt.Errorf("unexpected usage hash length %d", len(fields))
+	}
+
+	byEndpoint := make(map[string]*db.AddAPIUsageParams)
+	var endpoints []*db.AddAPIUsageParams
+	for i := 0; i < len(fields); i += 2 {
+		metric, method, route, err := parseCounterField(fields[i])
+		if err != nil {
+			continue
+		}
+		n, err := strconv.ParseUint(fields[i+1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		endpoint := method + " " + route
+		e, ok := byEndpoint[endpoint]
+		if !ok {
+			e = &db.AddAPIUsageParams{Method: method, Route: route}
+			byEndpoint[endpoint] = e
+			endpoints = append(endpoints, e)
+		}
+		switch metric {
+		case metricRequests:
+			e.Requests += n
+		case metricClientErrors:
+			e.ClientErrors += n
+		case metricServerErrors:
+			e.ServerErrors += n
+		case metricRateLimited:
+			e.RateLimited += n
+		}
+	}
+	return endpoints, nil
+}
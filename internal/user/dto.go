@@ -1,9 +1,11 @@
 package user
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/kyc"
 )
 
 // ============================================================================
@@ -37,22 +39,146 @@ type ListUsersRequest struct {
 	PageSize  int    `form:"page_size,default=20" binding:"min=1,max=100"`
 }
 
+// BulkKycDecisionRequest represents the request body for bulk KYC decisions (Admin only)
+type BulkKycDecisionRequest struct {
+	UserIDs  []string `json:"user_ids" binding:"required,min=1,max=100,dive,uuid"`
+	Decision string   `json:"decision" binding:"required,oneof=APPROVE REJECT" example:"APPROVE"`
+}
+
+// ImportUser represents one user of a bulk import. Rows are validated like user registration;
+// invalid rows are reported as FAILED instead of rejecting the import.
+type ImportUser struct {
+	Email string `json:"email" example:"buyer@merchant.example"`
+	Name  string `json:"name" example:"Jane Buyer"`
+	Phone string `json:"phone,omitempty" example:"010-1234-5678"`
+	Role  string `json:"role" example:"BUYER" enums:"BUYER,SELLER,BOTH"`
+}
+
+// CreateUserImportRequest represents the request body for a bulk user import (Admin only)
+type CreateUserImportRequest struct {
+	Users []ImportUser `json:"users" binding:"required,min=1,max=1000"`
+}
+
+// ListUserImportsRequest represents query parameters for listing user imports
+type ListUserImportsRequest struct {
+	Page     int `form:"page,default=1" binding:"min=1"`
+	PageSize int `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// GetUserImportRequest represents query parameters for a user import's row report
+type GetUserImportRequest struct {
+	Status   string `form:"status" binding:"omitempty,oneof=PENDING CREATED DUPLICATE FAILED"`
+	Page     int    `form:"page,default=1" binding:"min=1"`
+	PageSize int    `form:"page_size,default=100" binding:"min=1,max=1000"`
+}
+
+// ListKycWebhookEventsRequest represents query parameters for listing recorded KYC provider webhooks
+type ListKycWebhookEventsRequest struct {
+	ApplicantID string `form:"applicant_id" binding:"omitempty,max=100"`
+	Page        int    `form:"page,default=1" binding:"min=1"`
+	PageSize    int    `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
 // ============================================================================
 // Response DTOs
 // ============================================================================
 
 // UserResponse represents the user data in API responses
 type UserResponse struct {
-	ID            string     `json:"id" example:"usr_abc123def456"`
-	Email         string     `json:"email" example:"user@example.com"`
-	Name          string     `json:"name" example:"John Doe"`
-	Phone         string     `json:"phone,omitempty" example:"010-1234-5678"`
-	Role          string     `json:"role" example:"BUYER"`
-	KycStatus     string     `json:"kyc_status" example:"NONE"`
-	KycVerifiedAt *time.Time `json:"kyc_verified_at,omitempty"`
-	Status        string     `json:"status" example:"ACTIVE"`
-	CreatedAt     time.Time  `json:"created_at"`
-	UpdatedAt     time.Time  `json:"updated_at"`
+	ID    string `json:"id" example:"usr_abc123def456"`
+	Email string `json:"email" example:"user@example.com"`
+	Name  string `json:"name" example:"John Doe"`
+	Phone string `json:"phone,omitempty" example:"010-1234-5678"`
+	// PhoneVerifiedAt is set once the phone number is verified by OTP (cleared when the number changes)
+	PhoneVerifiedAt *time.Time `json:"phone_verified_at,omitempty"`
+	Role            string     `json:"role" example:"BUYER"`
+	KycStatus       string     `json:"kyc_status" example:"NONE"`
+	KycVerifiedAt   *time.Time `json:"kyc_verified_at,omitempty"`
+	Status          string     `json:"status" example:"ACTIVE"`
+	CreatedAt       time.Time  `json:"created_at"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+}
+
+// KycDecisionResult represents the outcome of a single KYC decision in a bulk request
+type KycDecisionResult struct {
+	UserID  string `json:"user_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	From    string `json:"from,omitempty" example:"PENDING"`
+	To      string `json:"to,omitempty" example:"VERIFIED"`
+	Applied bool   `json:"applied"`
+	Error   string `json:"error,omitempty" example:"KYC is not pending"`
+}
+
+// BulkKycDecisionResponse represents the bulk KYC decision result
+// NOTE: dry_run=true이면 applied=true 항목도 실제로는 롤백됨 (would-be effect)
+type BulkKycDecisionResponse struct {
+	Decision string              `json:"decision" example:"APPROVE"`
+	Results  []KycDecisionResult `json:"results"`
+	Applied  int                 `json:"applied"`
+	Skipped  int                 `json:"skipped"`
+	DryRun   bool                `json:"dry_run"`
+}
+
+// KycSessionResponse represents the hosted verification flow of a KYC request
+type KycSessionResponse struct {
+	Provider    string `json:"provider" example:"http"`
+	ApplicantID string `json:"applicant_id" example:"65a1f0c2e4b0a1b2c3d4e5f6"`
+	// SDKToken initializes the provider's client SDK for the verification flow
+	SDKToken  string     `json:"sdk_token" example:"_act-sbx-jwt-eyJhbGciOiJub25lIn0..."`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// CreateUserResponse represents a newly created user with the one-time API key bootstrap token.
+// The token is returned only here - send it as bootstrap_token to POST /users/{id}/api-keys.
+type CreateUserResponse struct {
+	UserResponse
+	APIKeyBootstrapToken     string    `json:"api_key_bootstrap_token" example:"bt_9f86d081884c7d65..."`
+	APIKeyBootstrapExpiresAt time.Time `json:"api_key_bootstrap_expires_at"`
+}
+
+// RequestKycResponse represents the user after a KYC request.
+// KycSession is omitted when the request is reviewed by an admin (KYC_PROVIDER=manual).
+type RequestKycResponse struct {
+	UserResponse
+	KycSession *KycSessionResponse `json:"kyc_session,omitempty"`
+}
+
+// KycCallbackResponse represents the acknowledgement of a KYC provider decision callback
+type KycCallbackResponse struct {
+	ApplicantID string `json:"applicant_id" example:"65a1f0c2e4b0a1b2c3d4e5f6"`
+	Outcome     string `json:"outcome" example:"APPROVED"`
+	// Applied is false for non-final verdicts, redelivered callbacks and decisions that no longer apply
+	// (superseded applicant, admin decision)
+	Applied   bool   `json:"applied" example:"true"`
+	UserID    string `json:"user_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	KycStatus string `json:"kyc_status,omitempty" example:"VERIFIED"`
+}
+
+// KycWebhookEventResponse represents a recorded KYC provider webhook
+type KycWebhookEventResponse struct {
+	Provider string `json:"provider" example:"http"`
+	// EventID is the provider's event ID (redeliveries of the same event are counted in delivery_count)
+	EventID     string `json:"event_id,omitempty" example:"evt_01HV3K9Q2M4N5P6R7S8T9V0W1X"`
+	ApplicantID string `json:"applicant_id" example:"65a1f0c2e4b0a1b2c3d4e5f6"`
+	UserID      string `json:"user_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	// Verdict is the provider's verdict as received, Outcome its mapping (PENDING = no KYC change)
+	Verdict string `json:"verdict" example:"GREEN"`
+	Outcome string `json:"outcome" example:"APPROVED" enums:"APPROVED,REJECTED,PENDING"`
+	Applied bool   `json:"applied" example:"true"`
+	// Payload is the signed body exactly as received
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"`
+	PayloadSHA256  string          `json:"payload_sha256" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	DeliveryCount  uint32          `json:"delivery_count" example:"1"`
+	CreatedAt      time.Time       `json:"created_at"`
+	LastReceivedAt time.Time       `json:"last_received_at"`
+}
+
+// ListKycWebhookEventsResponse represents a page of recorded KYC provider webhooks
+type ListKycWebhookEventsResponse struct {
+	Events     []KycWebhookEventResponse `json:"events"`
+	Total      int64                     `json:"total"`
+	Page       int                       `json:"page"`
+	PageSize   int                       `json:"page_size"`
+	TotalPages int                       `json:"total_pages"`
 }
 
 // ListUsersResponse represents paginated user list
@@ -64,6 +190,51 @@ type ListUsersResponse struct {
 	TotalPages int            `json:"total_pages"`
 }
 
+// UserImportResponse represents a bulk user import job
+type UserImportResponse struct {
+	ID             string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Source         string     `json:"source" example:"CSV" enums:"JSON,CSV"`
+	Status         string     `json:"status" example:"COMPLETED" enums:"IN_PROGRESS,COMPLETED"`
+	TotalCount     uint32     `json:"total_count" example:"250"`
+	CreatedCount   uint32     `json:"created_count" example:"240"`
+	DuplicateCount uint32     `json:"duplicate_count" example:"8"`
+	FailedCount    uint32     `json:"failed_count" example:"2"`
+	PendingCount   uint32     `json:"pending_count" example:"0"` // rows left for a resume
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	CompletedAt    *time.Time `json:"completed_at,omitempty"`
+}
+
+// UserImportRowResponse represents the result of one imported user
+type UserImportRowResponse struct {
+	Row    uint32 `json:"row" example:"1"` // 1-based position in the upload (CSV data rows exclude the header)
+	Email  string `json:"email" example:"buyer@merchant.example"`
+	Name   string `json:"name" example:"Jane Buyer"`
+	Role   string `json:"role" example:"BUYER"`
+	Status string `json:"status" example:"CREATED" enums:"PENDING,CREATED,DUPLICATE,FAILED"`
+	UserID string `json:"user_id,omitempty" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"` // CREATED only
+	Error  string `json:"error,omitempty" example:"Email already registered"`
+}
+
+// UserImportDetailResponse represents a user import job with a page of its row report
+type UserImportDetailResponse struct {
+	Import     UserImportResponse      `json:"import"`
+	Rows       []UserImportRowResponse `json:"rows"`
+	Total      int64                   `json:"total"` // rows matching the status filter
+	Page       int                     `json:"page"`
+	PageSize   int                     `json:"page_size"`
+	TotalPages int                     `json:"total_pages"`
+}
+
+// ListUserImportsResponse represents paginated user import jobs
+type ListUserImportsResponse struct {
+	Imports    []UserImportResponse `json:"imports"`
+	Total      int64                `json:"total"`
+	Page       int                  `json:"page"`
+	PageSize   int                  `json:"page_size"`
+	TotalPages int                  `json:"total_pages"`
+}
+
 // ============================================================================
 // Converters
 // ============================================================================
@@ -89,6 +260,10 @@ func ToUserResponse(user *db.User) *UserResponse {
 		response.Phone = user.Phone.String
 	}
 
+	if user.PhoneVerifiedAt.Valid {
+		response.PhoneVerifiedAt = &user.PhoneVerifiedAt.Time
+	}
+
 	if user.KycVerifiedAt.Valid {
 		response.KycVerifiedAt = &user.KycVerifiedAt.Time
 	}
@@ -104,3 +279,79 @@ func ToUserResponseList(users []db.User) []UserResponse {
 	}
 	return responses
 }
+
+// ToCreateUserResponse converts db.User and its bootstrap token to CreateUserResponse
+func ToCreateUserResponse(user *db.User, bootstrapToken string, expiresAt time.Time) *CreateUserResponse {
+	return &CreateUserResponse{
+		UserResponse:             *ToUserResponse(user),
+		APIKeyBootstrapToken:     bootstrapToken,
+		APIKeyBootstrapExpiresAt: expiresAt,
+	}
+}
+
+// ToRequestKycResponse converts a user and its KYC session (nil = admin review) to RequestKycResponse
+func ToRequestKycResponse(user *db.User, provider string, session *kyc.Session) *RequestKycResponse {
+	response := &RequestKycResponse{UserResponse: *ToUserResponse(user)}
+	if session != nil {
+		response.KycSession = &KycSessionResponse{
+			Provider:    provider,
+			ApplicantID: session.ApplicantID,
+			SDKToken:    session.SDKToken,
+		}
+		if !session.ExpiresAt.IsZero() {
+			response.KycSession.ExpiresAt = &session.ExpiresAt
+		}
+	}
+	return response
+}
+
+// ToKycWebhookEventResponse converts a recorded KYC webhook row to KycWebhookEventResponse
+func ToKycWebhookEventResponse(e *db.ListKycWebhookEventsRow) KycWebhookEventResponse {
+	return KycWebhookEventResponse{
+		Provider:       e.Provider,
+		EventID:        e.EventID.String,
+		ApplicantID:    e.ApplicantID,
+		UserID:         e.UserExternalID.String,
+		Verdict:        e.Verdict,
+		Outcome:        string(e.Outcome),
+		Applied:        e.Applied,
+		Payload:        e.Payload,
+		PayloadSHA256:  e.PayloadSha256,
+		DeliveryCount:  e.DeliveryCount,
+		CreatedAt:      e.CreatedAt,
+		LastReceivedAt: e.LastReceivedAt,
+	}
+}
+
+// ToUserImportResponse converts db.UserImport to UserImportResponse
+func ToUserImportResponse(i *db.UserImport) UserImportResponse {
+	response := UserImportResponse{
+		ID:             i.ExternalID,
+		Source:         string(i.Source),
+		Status:         string(i.Status),
+		TotalCount:     i.TotalCount,
+		CreatedCount:   i.CreatedCount,
+		DuplicateCount: i.DuplicateCount,
+		FailedCount:    i.FailedCount,
+		PendingCount:   i.TotalCount - i.CreatedCount - i.DuplicateCount - i.FailedCount,
+		CreatedAt:      i.CreatedAt,
+		UpdatedAt:      i.UpdatedAt,
+	}
+	if i.CompletedAt.Valid {
+		response.CompletedAt = &i.CompletedAt.Time
+	}
+	return response
+}
+
+// ToUserImportRowResponse converts an import row with its created user to UserImportRowResponse
+func ToUserImportRowResponse(r *db.ListUserImportRowsRow) UserImportRowResponse {
+	return UserImportRowResponse{
+		Row:    r.RowIndex,
+		Email:  r.Email,
+		Name:   r.Name,
+		Role:   r.Role,
+		Status: string(r.Status),
+		UserID: r.UserExternalID.String,
+		Error:  r.Error.String,
+	}
+}
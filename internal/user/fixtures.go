@@ -0,0 +1,49 @@
+package user
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/fixtures"
+)
+
+// fixtureTime is the canonical timestamp of user fixtures
+var fixtureTime = time.Date(2026, 1, 15, 9, 0, 0, 0, time.UTC)
+
+// fixtureUser is the canonical active buyer
+var fixtureUser = UserResponse{
+	ID:        "550e8400-e29b-41d4-a716-446655440000",
+	Email:     "user@example.com",
+	Name:      "John Doe",
+	Phone:     "010-1234-5678",
+	Role:      "BUYER",
+	KycStatus: "NONE",
+	Status:    "ACTIVE",
+	CreatedAt: fixtureTime,
+	UpdatedAt: fixtureTime,
+}
+
+// RegisterFixtures registers the canonical user DTO examples and operation responses
+func RegisterFixtures(r *fixtures.Registry) {
+	r.Example(CreateUserRequest{
+		Email: "user@example.com",
+		Name:  "John Doe",
+		Phone: "010-1234-5678",
+		Role:  "BUYER",
+	})
+	r.Example(UpdateUserProfileRequest{Name: "John Doe", Phone: "010-1234-5678"})
+
+	r.Operation("CreateUser", http.StatusCreated, CreateUserResponse{
+		UserResponse:             fixtureUser,
+		APIKeyBootstrapToken:     "bt_9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		APIKeyBootstrapExpiresAt: fixtureTime.Add(24 * time.Hour),
+	})
+	r.Operation("GetUser", http.StatusOK, fixtureUser)
+	r.Operation("ListUsers", http.StatusOK, ListUsersResponse{
+		Users:      []UserResponse{fixtureUser},
+		Total:      1,
+		Page:       1,
+		PageSize:   20,
+		TotalPages: 1,
+	})
+}
@@ -1,11 +1,25 @@
 package user
 
 import (
+	"encoding/csv"
+	"io"
+	"strings"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/kyc"
 	"github.com/gin-gonic/gin"
 )
 
+const (
+	// maxImportCSVSize bounds an uploaded user import CSV (1000 users fit well within)
+	maxImportCSVSize = 1 << 20
+	// maxKycCallbackSize bounds a KYC provider decision callback body
+	maxKycCallbackSize = 64 << 10
+)
+
 // Handler handles HTTP requests for user operations
 type Handler struct {
 	service *Service
@@ -21,29 +35,50 @@ func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
 	users := rg.Group("/users")
 	{
 		users.POST("", h.CreateUser)
-		users.GET("", h.ListUsers)
-		users.GET("/:id", h.GetUser)
-		users.PUT("/:id", h.UpdateProfile)
-		users.PUT("/:id/role", h.UpdateRole)
-		users.POST("/:id/suspend", h.SuspendUser)
-		users.POST("/:id/activate", h.ActivateUser)
-		users.DELETE("/:id", h.DeleteUser)
+		users.GET("", middleware.RequireRoles(middleware.RoleAdmin), h.ListUsers)
+		users.GET("/:id", middleware.RequireAuth(), h.GetUser)
+		users.PUT("/:id", middleware.RequireAuth(), h.UpdateProfile)
+		users.PUT("/:id/role", middleware.RequireRoles(middleware.RoleAdmin), h.UpdateRole)
+		users.POST("/:id/suspend", middleware.RequireRoles(middleware.RoleAdmin), h.SuspendUser)
+		users.POST("/:id/activate", middleware.RequireRoles(middleware.RoleAdmin), h.ActivateUser)
+		users.DELETE("/:id", middleware.RequireRoles(middleware.RoleAdmin), h.DeleteUser)
 
 		// KYC endpoints
 		users.POST("/:id/kyc/request", h.RequestKyc)
-		users.POST("/:id/kyc/approve", h.ApproveKyc)   // TODO: Phase 6 - Add admin auth check
-		users.POST("/:id/kyc/reject", h.RejectKyc)    // TODO: Phase 6 - Add admin auth check
+		users.POST("/:id/kyc/approve", middleware.RequireRoles(middleware.RoleAdmin), h.ApproveKyc)
+		users.POST("/:id/kyc/reject", middleware.RequireRoles(middleware.RoleAdmin), h.RejectKyc)
+	}
+
+	// KYC provider decision webhooks (no API key - authenticated by the provider's signature)
+	rg.POST("/webhooks/kyc", h.KycCallback)
+
+	// Bulk KYC decisions (admin, supports ?dry_run=true)
+	kyc := rg.Group("/admin/kyc", middleware.RequireRoles(middleware.RoleAdmin), middleware.DryRun())
+	{
+		kyc.POST("/decisions", h.BulkKycDecision)
+		kyc.GET("/webhook-events", h.ListKycWebhookEvents)
+	}
+
+	// Bulk user imports (merchant migration, resumable import jobs)
+	imports := rg.Group("/admin/user-imports", middleware.RequireRoles(middleware.RoleAdmin))
+	{
+		imports.POST("", h.CreateImport)
+		imports.POST("/csv", h.CreateImportCSV)
+		imports.GET("", h.ListImports)
+		imports.GET("/:importId", h.GetImport)
+		imports.POST("/:importId/resume", h.ResumeImport)
 	}
 }
 
 // CreateUser godoc
 // @Summary Create a new user
 // @Description Register a new user with email, name, and role. An account is automatically created.
+// @Description The response includes a one-time api_key_bootstrap_token (valid 24 hours) for issuing the first API key.
 // @Tags users
 // @Accept json
 // @Produce json
 // @Param request body CreateUserRequest true "User registration data"
-// @Success 201 {object} middleware.SuccessResponse{data=UserResponse} "User created successfully"
+// @Success 201 {object} middleware.SuccessResponse{data=CreateUserResponse} "User created successfully"
 // @Failure 400 {object} middleware.ErrorResponse "Invalid input"
 // @Failure 409 {object} middleware.ErrorResponse "Email already registered"
 // @Failure 500 {object} middleware.ErrorResponse "Internal server error"
@@ -55,27 +90,49 @@ func (h *Handler) CreateUser(c *gin.Context) {
 		return
 	}
 
-	user, err := h.service.CreateUser(c.Request.Context(), &req)
+	created, err := h.service.CreateUser(c.Request.Context(), &req)
 	if err != nil {
 		middleware.RespondError(c, err)
 		return
 	}
 
-	middleware.RespondCreated(c, ToUserResponse(user))
+	middleware.RespondCreated(c, created)
+}
+
+// authorizeUser checks the caller may act as the user of the path (the user itself or an admin)
+func authorizeUser(c *gin.Context, externalID string) error {
+	principal, _ := middleware.GetPrincipal(c)
+	if !principal.CanActAs(externalID) {
+		return errors.Forbidden("Cannot access another user")
+	}
+	return nil
 }
 
 // GetUser godoc
 // @Summary Get user by ID
-// @Description Retrieve user details by external ID
+// @Description Retrieve user details by external ID (the user itself or an admin).
+// @Description Supports conditional requests: ETag/Last-Modified are derived from updated_at and 304 is returned when unchanged.
 // @Tags users
 // @Produce json
 // @Param id path string true "User external ID"
+// @Param If-None-Match header string false "ETag from a previous response"
+// @Param If-Modified-Since header string false "Last-Modified from a previous response"
 // @Success 200 {object} middleware.SuccessResponse{data=UserResponse} "User details"
+// @Success 304 "Not modified"
+// @Header 200,304 {string} ETag "Weak resource version"
+// @Header 200,304 {string} Last-Modified "Resource updated_at"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Another user"
 // @Failure 404 {object} middleware.ErrorResponse "User not found"
 // @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
 // @Router /api/v1/users/{id} [get]
 func (h *Handler) GetUser(c *gin.Context) {
 	externalID := c.Param("id")
+	if err := authorizeUser(c, externalID); err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
 
 	user, err := h.service.GetUserByExternalID(c.Request.Context(), externalID)
 	if err != nil {
@@ -83,21 +140,26 @@ func (h *Handler) GetUser(c *gin.Context) {
 		return
 	}
 
-	middleware.RespondOK(c, ToUserResponse(user))
+	middleware.RespondOKConditional(c, externalID, user.UpdatedAt, ToUserResponse(user))
 }
 
 // ListUsers godoc
 // @Summary List users
-// @Description Get paginated list of users with optional filters
+// @Description Get paginated list of users with optional filters - Admin only
+// @Description Send Accept: text/csv to receive the same results (same filters) as CSV.
 // @Tags users
-// @Produce json
+// @Produce json,text/csv
 // @Param role query string false "Filter by role" Enums(BUYER, SELLER, BOTH, ADMIN)
 // @Param kyc_status query string false "Filter by KYC status" Enums(NONE, PENDING, VERIFIED, REJECTED)
 // @Param page query int false "Page number" default(1)
 // @Param page_size query int false "Page size" default(20)
 // @Success 200 {object} middleware.SuccessResponse{data=ListUsersResponse} "User list"
 // @Failure 400 {object} middleware.ErrorResponse "Invalid query parameters"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
 // @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
 // @Router /api/v1/users [get]
 func (h *Handler) ListUsers(c *gin.Context) {
 	var req ListUsersRequest
@@ -120,12 +182,13 @@ func (h *Handler) ListUsers(c *gin.Context) {
 		return
 	}
 
-	middleware.RespondOK(c, result)
+	middleware.RespondOKNegotiated(c, "users", result, result.Users)
 }
 
 // UpdateProfile godoc
 // @Summary Update user profile
-// @Description Update user's name and phone number
+// @Description Update user's name and phone number (the user itself or an admin).
+// @Description Changing a verified phone number clears its verification and blocks payout address changes for a cooldown.
 // @Tags users
 // @Accept json
 // @Produce json
@@ -133,11 +196,18 @@ func (h *Handler) ListUsers(c *gin.Context) {
 // @Param request body UpdateUserProfileRequest true "Profile update data"
 // @Success 200 {object} middleware.SuccessResponse{data=UserResponse} "Updated user"
 // @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Another user"
 // @Failure 404 {object} middleware.ErrorResponse "User not found"
 // @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
 // @Router /api/v1/users/{id} [put]
 func (h *Handler) UpdateProfile(c *gin.Context) {
 	externalID := c.Param("id")
+	if err := authorizeUser(c, externalID); err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
 
 	var req UpdateUserProfileRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -156,7 +226,7 @@ func (h *Handler) UpdateProfile(c *gin.Context) {
 
 // UpdateRole godoc
 // @Summary Update user role
-// @Description Change user's role (BUYER, SELLER, BOTH)
+// @Description Change user's role (BUYER, SELLER, BOTH) - Admin only
 // @Tags users
 // @Accept json
 // @Produce json
@@ -164,9 +234,12 @@ func (h *Handler) UpdateProfile(c *gin.Context) {
 // @Param request body UpdateUserRoleRequest true "Role update data"
 // @Success 200 {object} middleware.SuccessResponse{data=UserResponse} "Updated user"
 // @Failure 400 {object} middleware.ErrorResponse "Invalid input"
-// @Failure 403 {object} middleware.ErrorResponse "Cannot assign ADMIN role"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role or cannot assign ADMIN role"
 // @Failure 404 {object} middleware.ErrorResponse "User not found"
 // @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
 // @Router /api/v1/users/{id}/role [put]
 func (h *Handler) UpdateRole(c *gin.Context) {
 	externalID := c.Param("id")
@@ -188,14 +261,18 @@ func (h *Handler) UpdateRole(c *gin.Context) {
 
 // SuspendUser godoc
 // @Summary Suspend user
-// @Description Suspend an active user (ACTIVE -> SUSPENDED)
+// @Description Suspend an active user (ACTIVE -> SUSPENDED) - Admin only
 // @Tags users
 // @Produce json
 // @Param id path string true "User external ID"
 // @Success 200 {object} middleware.SuccessResponse{data=UserResponse} "Suspended user"
 // @Failure 400 {object} middleware.ErrorResponse "Invalid state transition"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
 // @Failure 404 {object} middleware.ErrorResponse "User not found"
 // @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
 // @Router /api/v1/users/{id}/suspend [post]
 func (h *Handler) SuspendUser(c *gin.Context) {
 	externalID := c.Param("id")
@@ -211,14 +288,18 @@ func (h *Handler) SuspendUser(c *gin.Context) {
 
 // ActivateUser godoc
 // @Summary Activate user
-// @Description Reactivate a suspended user (SUSPENDED -> ACTIVE)
+// @Description Reactivate a suspended user (SUSPENDED -> ACTIVE) - Admin only
 // @Tags users
 // @Produce json
 // @Param id path string true "User external ID"
 // @Success 200 {object} middleware.SuccessResponse{data=UserResponse} "Activated user"
 // @Failure 400 {object} middleware.ErrorResponse "Invalid state transition (deleted users cannot be reactivated)"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
 // @Failure 404 {object} middleware.ErrorResponse "User not found"
 // @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
 // @Router /api/v1/users/{id}/activate [post]
 func (h *Handler) ActivateUser(c *gin.Context) {
 	externalID := c.Param("id")
@@ -234,13 +315,18 @@ func (h *Handler) ActivateUser(c *gin.Context) {
 
 // DeleteUser godoc
 // @Summary Delete user
-// @Description Soft-delete a user (irreversible)
+// @Description Soft-delete a user (irreversible) - Admin only
 // @Tags users
 // @Produce json
 // @Param id path string true "User external ID"
 // @Success 204 "User deleted"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
 // @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 409 {object} middleware.ErrorResponse "User is under legal hold"
 // @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
 // @Router /api/v1/users/{id} [delete]
 func (h *Handler) DeleteUser(c *gin.Context) {
 	externalID := c.Param("id")
@@ -255,25 +341,29 @@ func (h *Handler) DeleteUser(c *gin.Context) {
 
 // RequestKyc godoc
 // @Summary Request KYC verification
-// @Description Request KYC verification for the user (NONE/REJECTED -> PENDING)
+// @Description Request KYC verification for the user (NONE/REJECTED -> PENDING).
+// @Description With a hosted KYC provider (KYC_PROVIDER=http) an applicant is created and kyc_session carries the SDK token
+// @Description for the provider's verification flow; the provider's decision later moves the user to VERIFIED or REJECTED.
+// @Description A PENDING user may request again to get a fresh SDK token. Without a provider an admin approves or rejects.
 // @Tags users
 // @Produce json
 // @Param id path string true "User external ID"
-// @Success 200 {object} middleware.SuccessResponse{data=UserResponse} "KYC requested"
+// @Success 200 {object} middleware.SuccessResponse{data=RequestKycResponse} "KYC requested"
 // @Failure 400 {object} middleware.ErrorResponse "Invalid state transition"
 // @Failure 404 {object} middleware.ErrorResponse "User not found"
 // @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Failure 503 {object} middleware.ErrorResponse "KYC provider unavailable"
 // @Router /api/v1/users/{id}/kyc/request [post]
 func (h *Handler) RequestKyc(c *gin.Context) {
 	externalID := c.Param("id")
 
-	user, err := h.service.RequestKycVerification(c.Request.Context(), externalID)
+	user, session, err := h.service.RequestKycVerification(c.Request.Context(), externalID)
 	if err != nil {
 		middleware.RespondError(c, err)
 		return
 	}
 
-	middleware.RespondOK(c, ToUserResponse(user))
+	middleware.RespondOK(c, ToRequestKycResponse(user, h.service.KycProvider(), session))
 }
 
 // ApproveKyc godoc
@@ -284,12 +374,14 @@ func (h *Handler) RequestKyc(c *gin.Context) {
 // @Param id path string true "User external ID"
 // @Success 200 {object} middleware.SuccessResponse{data=UserResponse} "KYC approved"
 // @Failure 400 {object} middleware.ErrorResponse "Invalid state transition"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
 // @Failure 404 {object} middleware.ErrorResponse "User not found"
 // @Failure 500 {object} middleware.ErrorResponse "Internal server error"
 // @Security ApiKeyAuth
+// @x-audience "admin"
 // @Router /api/v1/users/{id}/kyc/approve [post]
 func (h *Handler) ApproveKyc(c *gin.Context) {
-	// TODO: Phase 6 - Add admin role verification via JWT claims
 	externalID := c.Param("id")
 
 	user, err := h.service.ApproveKyc(c.Request.Context(), externalID)
@@ -309,12 +401,14 @@ func (h *Handler) ApproveKyc(c *gin.Context) {
 // @Param id path string true "User external ID"
 // @Success 200 {object} middleware.SuccessResponse{data=UserResponse} "KYC rejected"
 // @Failure 400 {object} middleware.ErrorResponse "Invalid state transition"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
 // @Failure 404 {object} middleware.ErrorResponse "User not found"
 // @Failure 500 {object} middleware.ErrorResponse "Internal server error"
 // @Security ApiKeyAuth
+// @x-audience "admin"
 // @Router /api/v1/users/{id}/kyc/reject [post]
 func (h *Handler) RejectKyc(c *gin.Context) {
-	// TODO: Phase 6 - Add admin role verification via JWT claims
 	externalID := c.Param("id")
 
 	user, err := h.service.RejectKyc(c.Request.Context(), externalID)
@@ -325,3 +419,340 @@ func (h *Handler) RejectKyc(c *gin.Context) {
 
 	middleware.RespondOK(c, ToUserResponse(user))
 }
+
+// KycCallback godoc
+// @Summary Receive a KYC provider decision
+// @Description Decision webhook of the hosted KYC provider. Authenticated by the X-KYC-Signature header
+// @Description (t=<unix>,v1=hex HMAC-SHA256 of "<unix>.<raw body>" with KYC_WEBHOOK_SECRET), not by API key.
+// @Description Signatures older or newer than KYC_WEBHOOK_TOLERANCE (default 5m) are rejected; redeliveries of an event_id are applied once.
+// @Description Approving verdicts (APPROVED, GREEN, VERIFIED, ...) move the applicant's user PENDING -> VERIFIED, rejecting verdicts
+// @Description (REJECTED, RED, DECLINED, ...) move it to REJECTED (kyc.approved / kyc.rejected events). Non-final verdicts
+// @Description (IN_REVIEW, RESUBMISSION_REQUESTED, ...), redelivered events and decisions on superseded applicants are acknowledged with applied=false.
+// @Description Every authenticated payload is recorded verbatim for audit (GET /admin/kyc/webhook-events).
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param X-KYC-Signature header string true "t=<unix>,v1=hex(HMAC-SHA256(secret, <unix>.<body>))"
+// @Param request body object true "Provider decision {event_id, applicant_id, external_user_id, outcome, reason}"
+// @Success 200 {object} middleware.SuccessResponse{data=KycCallbackResponse} "Decision acknowledged"
+// @Failure 400 {object} middleware.ErrorResponse "Malformed decision"
+// @Failure 401 {object} middleware.ErrorResponse "Invalid or expired signature"
+// @Failure 404 {object} middleware.ErrorResponse "Unknown applicant, or no KYC provider configured"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/webhooks/kyc [post]
+func (h *Handler) KycCallback(c *gin.Context) {
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, maxKycCallbackSize+1))
+	if err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Failed to read request body"))
+		return
+	}
+	if len(body) > maxKycCallbackSize {
+		middleware.RespondError(c, errors.InvalidInput("Request body too large"))
+		return
+	}
+
+	result, err := h.service.HandleKycDecision(c.Request.Context(), c.GetHeader(kyc.SignatureHeader), body)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// ListKycWebhookEvents godoc
+// @Summary List KYC provider webhooks
+// @Description List recorded KYC provider decision webhooks with their raw payload, newest first - Admin only.
+// @Description Redeliveries of the same payload are counted on one record (delivery_count).
+// @Tags users
+// @Produce json
+// @Param applicant_id query string false "Filter by provider applicant ID"
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} middleware.SuccessResponse{data=ListKycWebhookEventsResponse} "KYC webhooks"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/kyc/webhook-events [get]
+func (h *Handler) ListKycWebhookEvents(c *gin.Context) {
+	var req ListKycWebhookEventsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListKycWebhookEvents(c.Request.Context(), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// BulkKycDecision godoc
+// @Summary Bulk KYC decision
+// @Description Approve or reject KYC for up to 100 PENDING users in one transaction - Admin only.
+// @Description Users that are missing or not PENDING are skipped and reported per item.
+// @Description With dry_run=true all validations and writes run in a rolled-back transaction.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param request body BulkKycDecisionRequest true "Decision and target users"
+// @Param dry_run query bool false "Validate and report effects without committing"
+// @Success 200 {object} middleware.SuccessResponse{data=BulkKycDecisionResponse} "Decision results"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/kyc/decisions [post]
+func (h *Handler) BulkKycDecision(c *gin.Context) {
+	var req BulkKycDecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.BulkKycDecision(c.Request.Context(), &req, middleware.IsDryRun(c), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// CreateImport godoc
+// @Summary Import users in bulk
+// @Description Create users with their accounts from a migrated merchant's user list (max 1000) - Admin only.
+// @Description Rows are validated like user registration; invalid rows are reported as FAILED, and emails already registered
+// @Description (or repeated within the import) as DUPLICATE, without rejecting the import. Users are created in chunks of 100 per transaction;
+// @Description an import that stops early stays IN_PROGRESS with pending_count > 0 and continues via POST /admin/user-imports/{importId}/resume. Audited.
+// @Tags user-imports
+// @Accept json
+// @Produce json
+// @Param request body CreateUserImportRequest true "Users"
+// @Success 201 {object} middleware.SuccessResponse{data=UserImportResponse} "Import job"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/user-imports [post]
+func (h *Handler) CreateImport(c *gin.Context) {
+	var req CreateUserImportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.CreateImport(c.Request.Context(), db.UserImportsSourceJSON, req.Users, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// CreateImportCSV godoc
+// @Summary Import users in bulk from a CSV
+// @Description Same as POST /admin/user-imports with users read from an uploaded CSV - Admin only.
+// @Description The header row names the columns email, name, role and optionally phone (any order); max 1000 data rows.
+// @Tags user-imports
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "CSV file with a header row (email,name,phone,role)"
+// @Success 201 {object} middleware.SuccessResponse{data=UserImportResponse} "Import job"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid CSV"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/user-imports/csv [post]
+func (h *Handler) CreateImportCSV(c *gin.Context) {
+	file, err := c.FormFile("file")
+	if err != nil {
+		middleware.RespondError(c, errors.InvalidInput("CSV file is required"))
+		return
+	}
+	if file.Size > maxImportCSVSize {
+		middleware.RespondError(c, errors.InvalidInput("CSV file too large (max 1MB)"))
+		return
+	}
+	f, err := file.Open()
+	if err != nil {
+		middleware.RespondError(c, errors.InvalidInput("Cannot read CSV file"))
+		return
+	}
+	defer f.Close()
+
+	users, err := readImportUsers(f)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	result, err := h.service.CreateImport(c.Request.Context(), db.UserImportsSourceCSV, users, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// ListImports godoc
+// @Summary List user imports
+// @Description List bulk user import jobs, newest first - Admin only
+// @Tags user-imports
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} middleware.SuccessResponse{data=ListUserImportsResponse} "Import jobs"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/user-imports [get]
+func (h *Handler) ListImports(c *gin.Context) {
+	var req ListUserImportsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListImports(c.Request.Context(), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// GetImport godoc
+// @Summary Get user import
+// @Description Get a bulk user import job with its per-row result report (row order) - Admin only
+// @Tags user-imports
+// @Produce json
+// @Param importId path string true "Import ID (UUID)"
+// @Param status query string false "Row status filter" Enums(PENDING, CREATED, DUPLICATE, FAILED)
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(100)
+// @Success 200 {object} middleware.SuccessResponse{data=UserImportDetailResponse} "Import job with row results"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "User import not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/user-imports/{importId} [get]
+func (h *Handler) GetImport(c *gin.Context) {
+	var req GetUserImportRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.GetImport(c.Request.Context(), c.Param("importId"), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// ResumeImport godoc
+// @Summary Resume user import
+// @Description Continue an IN_PROGRESS bulk user import with its remaining PENDING rows - Admin only.
+// @Description Rows already processed are not repeated; a COMPLETED import is returned unchanged.
+// @Tags user-imports
+// @Produce json
+// @Param importId path string true "Import ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=UserImportResponse} "Import job"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "User import not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/user-imports/{importId}/resume [post]
+func (h *Handler) ResumeImport(c *gin.Context) {
+	result, err := h.service.ResumeImport(c.Request.Context(), c.Param("importId"), audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// readImportUsers reads users from a CSV whose header row names the email, name, phone and role columns
+func readImportUsers(r io.Reader) ([]ImportUser, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, errors.InvalidInput("CSV contains no users")
+	}
+	if err != nil {
+		return nil, errors.InvalidInput("Invalid CSV: " + err.Error())
+	}
+	columns := map[string]int{"email": -1, "name": -1, "phone": -1, "role": -1}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))) // Excel UTF-8 BOM
+		if _, ok := columns[name]; ok {
+			columns[name] = i
+		}
+	}
+	for _, required := range []string{"email", "name", "role"} {
+		if columns[required] < 0 {
+			return nil, errors.InvalidInput("CSV header must name the email, name and role columns")
+		}
+	}
+	field := func(record []string, column string) string {
+		if i := columns[column]; i >= 0 && i < len(record) {
+			return record[i]
+		}
+		return ""
+	}
+
+	var users []ImportUser
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.InvalidInput("Invalid CSV: " + err.Error())
+		}
+		users = append(users, ImportUser{
+			Email: field(record, "email"),
+			Name:  field(record, "name"),
+			Phone: field(record, "phone"),
+			Role:  field(record, "role"),
+		})
+		if len(users) > maxImportUsers {
+			return nil, errors.InvalidInput("Too many users (max 1000)")
+		}
+	}
+
+	if len(users) == 0 {
+		return nil, errors.InvalidInput("CSV contains no users")
+	}
+	return users, nil
+}
@@ -0,0 +1,402 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxImportUsers bounds the users of one import
+	maxImportUsers = 1000
+	// importChunkSize is the number of rows processed per transaction
+	importChunkSize = 100
+)
+
+// Audit log identifiers for bulk imports
+const (
+	actionImportCreated   = "USER_IMPORT_CREATED"
+	actionImportCompleted = "USER_IMPORT_COMPLETED"
+	resourceUserImport    = "USER_IMPORT"
+)
+
+// CreateImport records a bulk user import job and processes it (admin).
+// Rows failing registration validation are reported as FAILED and repeated emails within the import as DUPLICATE
+// up front; the rest are created with their accounts in chunked transactions. A run that stops early
+// (request canceled, database error) leaves the job IN_PROGRESS - ResumeImport continues with the remaining rows.
+func (s *Service) CreateImport(ctx context.Context, source db.UserImportsSource, users []ImportUser, actor audit.Actor) (*UserImportResponse, error) {
+	if len(users) == 0 {
+		return nil, errors.InvalidInput("Import contains no users")
+	}
+	if len(users) > maxImportUsers {
+		return nil, errors.InvalidInput(fmt.Sprintf("Too many users (max %d)", maxImportUsers))
+	}
+
+	externalID := uuid.New().String()
+	importID, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (uint64, error) {
+		result, err := q.CreateUserImport(ctx, db.CreateUserImportParams{
+			ExternalID: externalID,
+			Source:     source,
+			TotalCount: uint32(len(users)),
+			CreatedBy:  sql.NullInt64{Int64: int64(actor.ID), Valid: actor.ID != 0},
+		})
+		if err != nil {
+			return 0, err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return 0, err
+		}
+
+		seen := make(map[string]int, len(users))
+		for i, u := range users {
+			row := normalizeImportUser(u)
+			status := db.UserImportRowsStatusPENDING
+			message := validateImportUser(row)
+			if message != "" {
+				status = db.UserImportRowsStatusFAILED
+			} else if first, ok := seen[strings.ToLower(row.Email)]; ok {
+				// NOTE: users.email은 대소문자 구분 없이 유일 (utf8mb4_unicode_ci)
+				status = db.UserImportRowsStatusDUPLICATE
+				message = fmt.Sprintf("Email repeats row %d", first)
+			} else {
+				seen[strings.ToLower(row.Email)] = i + 1
+			}
+			if err := q.CreateUserImportRow(ctx, db.CreateUserImportRowParams{
+				ImportID: uint64(id),
+				RowIndex: uint32(i + 1),
+				Email:    truncate(row.Email, 255),
+				Name:     truncate(row.Name, 100),
+				Phone:    sql.NullString{String: truncate(row.Phone, 20), Valid: row.Phone != ""},
+				Role:     truncate(row.Role, 20),
+				Status:   status,
+				Error:    sql.NullString{String: message, Valid: message != ""},
+			}); err != nil {
+				return 0, err
+			}
+		}
+
+		// 검증 실패/중복 행만 있으면 바로 COMPLETED
+		if _, err := refreshImportProgress(ctx, q, uint64(id)); err != nil {
+			return 0, err
+		}
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionImportCreated,
+			ResourceType: resourceUserImport,
+			ResourceID:   uint64(id),
+			NewValue: map[string]any{
+				"source":      string(source),
+				"total_count": len(users),
+			},
+		}); err != nil {
+			return 0, err
+		}
+		return uint64(id), nil
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to create user import", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("user import created",
+		zap.String("import_id", externalID),
+		zap.Int("total_count", len(users)),
+		zap.String("request_id", actor.RequestID),
+	)
+	s.processImport(ctx, importID, externalID, actor)
+	return s.getImport(ctx, externalID)
+}
+
+// ResumeImport processes the remaining PENDING rows of an import (admin). A COMPLETED import is returned unchanged.
+func (s *Service) ResumeImport(ctx context.Context, externalID string, actor audit.Actor) (*UserImportResponse, error) {
+	userImport, err := s.txRunner.Queries().GetUserImportByExternalID(ctx, externalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("User import")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user import", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	if userImport.Status == db.UserImportsStatusINPROGRESS {
+		s.processImport(ctx, userImport.ID, externalID, actor)
+	}
+	return s.getImport(ctx, externalID)
+}
+
+// GetImport returns an import job with a page of its row report (admin)
+func (s *Service) GetImport(ctx context.Context, externalID string, req *GetUserImportRequest) (*UserImportDetailResponse, error) {
+	q := s.txRunner.Queries()
+	userImport, err := q.GetUserImportByExternalID(ctx, externalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("User import")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user import", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	var status db.NullUserImportRowsStatus
+	if req.Status != "" {
+		status = db.NullUserImportRowsStatus{UserImportRowsStatus: db.UserImportRowsStatus(req.Status), Valid: true}
+	}
+	rows, err := q.ListUserImportRows(ctx, db.ListUserImportRowsParams{
+		ImportID: userImport.ID,
+		Status:   status,
+		Limit:    int32(req.PageSize),
+		Offset:   int32((req.Page - 1) * req.PageSize),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list user import rows", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	total, err := q.CountUserImportRows(ctx, db.CountUserImportRowsParams{ImportID: userImport.ID, Status: status})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count user import rows", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	results := make([]UserImportRowResponse, 0, len(rows))
+	for i := range rows {
+		results = append(results, ToUserImportRowResponse(&rows[i]))
+	}
+	return &UserImportDetailResponse{
+		Import:     ToUserImportResponse(&userImport),
+		Rows:       results,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages(total, req.PageSize),
+	}, nil
+}
+
+// ListImports returns import jobs, newest first (admin)
+func (s *Service) ListImports(ctx context.Context, req *ListUserImportsRequest) (*ListUserImportsResponse, error) {
+	q := s.txRunner.Queries()
+	imports, err := q.ListUserImports(ctx, db.ListUserImportsParams{
+		Limit:  int32(req.PageSize),
+		Offset: int32((req.Page - 1) * req.PageSize),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list user imports", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	total, err := q.CountUserImports(ctx)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count user imports", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	responses := make([]UserImportResponse, 0, len(imports))
+	for i := range imports {
+		responses = append(responses, ToUserImportResponse(&imports[i]))
+	}
+	return &ListUserImportsResponse{
+		Imports:    responses,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages(total, req.PageSize),
+	}, nil
+}
+
+// ============================================================================
+// Import processing
+// ============================================================================
+
+// processImport runs chunks until no PENDING row is left. A failing chunk is rolled back and
+// logged; its rows stay PENDING for ResumeImport.
+func (s *Service) processImport(ctx context.Context, importID uint64, externalID string, actor audit.Actor) {
+	for ctx.Err() == nil {
+		done, err := s.processImportChunk(ctx, importID, actor)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("user import chunk failed",
+				zap.String("import_id", externalID),
+				zap.Error(err),
+			)
+			return
+		}
+		if done {
+			logctx.From(ctx, s.logger).Info("user import completed", zap.String("import_id", externalID))
+			return
+		}
+	}
+}
+
+// processImportChunk creates the users of the next PENDING rows in one transaction.
+// Returns true once the import is COMPLETED.
+//
+// Why:
+//   - 작업 row-lock → 동시 재개 요청이 같은 행을 중복 처리하지 않음
+//   - 청크마다 커밋 → 중단 시 처리된 행은 유지, 남은 PENDING 행만 재개 대상
+func (s *Service) processImportChunk(ctx context.Context, importID uint64, actor audit.Actor) (bool, error) {
+	return pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (bool, error) {
+		userImport, err := q.GetUserImportForUpdate(ctx, importID)
+		if err != nil {
+			return false, fmt.Errorf("lock import: %w", err)
+		}
+		if userImport.Status == db.UserImportsStatusCOMPLETED {
+			return true, nil
+		}
+
+		rows, err := q.ListPendingUserImportRows(ctx, db.ListPendingUserImportRowsParams{
+			ImportID: importID,
+			Limit:    importChunkSize,
+		})
+		if err != nil {
+			return false, fmt.Errorf("list pending rows: %w", err)
+		}
+		for _, row := range rows {
+			params := db.UpdateUserImportRowResultParams{Status: db.UserImportRowsStatusCREATED, ID: row.ID}
+			exists, err := q.ExistsUserByEmail(ctx, row.Email)
+			if err != nil {
+				return false, fmt.Errorf("check email of row %d: %w", row.RowIndex, err)
+			}
+			if exists {
+				params.Status = db.UserImportRowsStatusDUPLICATE
+				params.Error = sql.NullString{String: "Email already registered", Valid: true}
+			} else {
+				// NOTE: MySQL duplicate key 오류는 해당 문장만 롤백 → 트랜잭션은 계속 진행
+				userID, err := createUserWithAccount(ctx, q, row.Email, row.Name, row.Phone.String, db.UsersRole(row.Role))
+				switch {
+				case isDuplicateKeyError(err):
+					params.Status = db.UserImportRowsStatusDUPLICATE
+					params.Error = sql.NullString{String: "Email already registered or previously used", Valid: true}
+				case err != nil:
+					return false, fmt.Errorf("create user of row %d: %w", row.RowIndex, err)
+				default:
+					params.UserID = sql.NullInt64{Int64: int64(userID), Valid: true}
+				}
+			}
+			if err := q.UpdateUserImportRowResult(ctx, params); err != nil {
+				return false, fmt.Errorf("record row %d: %w", row.RowIndex, err)
+			}
+		}
+
+		progress, err := refreshImportProgress(ctx, q, importID)
+		if err != nil {
+			return false, err
+		}
+		if progress.Status != db.UserImportsStatusCOMPLETED {
+			return false, nil
+		}
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionImportCompleted,
+			ResourceType: resourceUserImport,
+			ResourceID:   importID,
+			NewValue: map[string]any{
+				"created_count":   progress.CreatedCount,
+				"duplicate_count": progress.DuplicateCount,
+				"failed_count":    progress.FailedCount,
+			},
+		}); err != nil {
+			return false, err
+		}
+		return true, nil
+	})
+}
+
+// refreshImportProgress recounts an import's rows by status and completes it when none is PENDING
+// (must be called within a transaction)
+func refreshImportProgress(ctx context.Context, q *db.Queries, importID uint64) (*db.UpdateUserImportProgressParams, error) {
+	counts, err := q.CountUserImportRowsByStatus(ctx, importID)
+	if err != nil {
+		return nil, fmt.Errorf("count import rows: %w", err)
+	}
+
+	progress := db.UpdateUserImportProgressParams{Status: db.UserImportsStatusCOMPLETED, ID: importID}
+	for _, c := range counts {
+		switch c.Status {
+		case db.UserImportRowsStatusPENDING:
+			progress.Status = db.UserImportsStatusINPROGRESS
+		case db.UserImportRowsStatusCREATED:
+			progress.CreatedCount = uint32(c.RowCount)
+		case db.UserImportRowsStatusDUPLICATE:
+			progress.DuplicateCount = uint32(c.RowCount)
+		case db.UserImportRowsStatusFAILED:
+			progress.FailedCount = uint32(c.RowCount)
+		}
+	}
+	if progress.Status == db.UserImportsStatusCOMPLETED {
+		progress.CompletedAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+	}
+	if err := q.UpdateUserImportProgress(ctx, progress); err != nil {
+		return nil, fmt.Errorf("update import progress: %w", err)
+	}
+	return &progress, nil
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// getImport retrieves an import job by external ID as its response
+func (s *Service) getImport(ctx context.Context, externalID string) (*UserImportResponse, error) {
+	userImport, err := s.txRunner.Queries().GetUserImportByExternalID(ctx, externalID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("User import")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user import", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	response := ToUserImportResponse(&userImport)
+	return &response, nil
+}
+
+// normalizeImportUser trims an import row and upper-cases its role
+func normalizeImportUser(u ImportUser) ImportUser {
+	return ImportUser{
+		Email: strings.TrimSpace(u.Email),
+		Name:  strings.TrimSpace(u.Name),
+		Phone: strings.TrimSpace(u.Phone),
+		Role:  strings.ToUpper(strings.TrimSpace(u.Role)),
+	}
+}
+
+// validateImportUser applies the CreateUserRequest rules to an import row; returns the failure reason or ""
+func validateImportUser(u ImportUser) string {
+	if addr, err := mail.ParseAddress(u.Email); err != nil || addr.Address != u.Email || len(u.Email) > 255 {
+		return "Invalid email"
+	}
+	if n := utf8.RuneCountInString(u.Name); n < 2 || n > 100 {
+		return "Name must be 2-100 characters"
+	}
+	if n := utf8.RuneCountInString(u.Phone); u.Phone != "" && (n < 10 || n > 20) {
+		return "Phone must be 10-20 characters"
+	}
+	switch db.UsersRole(u.Role) {
+	case db.UsersRoleBUYER, db.UsersRoleSELLER, db.UsersRoleBOTH:
+	default:
+		return "Role must be BUYER, SELLER or BOTH"
+	}
+	return ""
+}
+
+// truncate cuts s to at most n characters (invalid rows are stored as reported)
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
+
+// totalPages returns the number of pages of total items
+func totalPages(total int64, pageSize int) int {
+	pages := int(total) / pageSize
+	if int(total)%pageSize > 0 {
+		pages++
+	}
+	return pages
+}
@@ -0,0 +1,319 @@
+package user
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/kyc"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"go.uber.org/zap"
+)
+
+// RequestKycVerification requests KYC verification (NONE/REJECTED -> PENDING).
+// With a hosted KYC provider the user's applicant is created and the SDK token for the client flow returned;
+// a PENDING user may request again to get a fresh token. Without one (nil session) an admin reviews the request.
+//
+// Why:
+//   - 제공자 호출은 트랜잭션 밖 (외부 API 지연 동안 user row-lock 유지 방지) → 트랜잭션에서 상태 재확인
+//   - applicant는 상태 전이와 같은 트랜잭션에 기록 → 결정 콜백이 항상 사용자에 매칭됨
+func (s *Service) RequestKycVerification(ctx context.Context, externalID string) (*db.User, *kyc.Session, error) {
+	user, err := s.GetUserByExternalID(ctx, externalID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.checkKycRequestable(user); err != nil {
+		return nil, nil, err
+	}
+
+	// 1. Provider applicant (nil session = manual review)
+	session, err := s.kyc.CreateApplicant(ctx, kyc.Applicant{
+		ExternalUserID: externalID,
+		Email:          user.Email,
+		Name:           user.Name,
+		Phone:          user.Phone.String,
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to create KYC applicant",
+			zap.String("provider", s.kyc.Name()),
+			zap.String("external_id", externalID),
+			zap.Error(err),
+		)
+		return nil, nil, errors.KycUnavailable(s.kyc.Name())
+	}
+
+	// 2. Transition + applicant record
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		locked, err := q.GetUserForUpdate(ctx, user.ID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return errors.NotFound("User")
+			}
+			return err
+		}
+		if err := s.checkKycRequestable(&locked); err != nil {
+			return err
+		}
+
+		if locked.KycStatus != db.UsersKycStatusPENDING {
+			if err := q.UpdateUserKycToPending(ctx, user.ID); err != nil {
+				return err
+			}
+		}
+		if session == nil {
+			return nil
+		}
+		return q.UpsertKycApplicant(ctx, db.UpsertKycApplicantParams{
+			UserID:      user.ID,
+			Provider:    s.kyc.Name(),
+			ApplicantID: session.ApplicantID,
+		})
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to request KYC", zap.Error(err), zap.String("external_id", externalID))
+		return nil, nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("KYC verification requested",
+		zap.String("external_id", externalID),
+		zap.String("provider", s.kyc.Name()),
+	)
+	requested, err := s.GetUserByExternalID(ctx, externalID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return requested, session, nil
+}
+
+// HandleKycDecision applies a decision webhook of the KYC provider (PENDING -> VERIFIED/REJECTED).
+// Every authenticated payload is recorded verbatim for audit. Non-final verdicts, redelivered events and
+// decisions on superseded applicants are acknowledged without changes.
+//
+// Why:
+//   - applicant row-lock 후 event_id 확인 → 같은 이벤트의 재전송/동시 수신 시 1회만 반영
+//   - 최신 applicant의 결정만 사용자 상태에 반영, 관리자가 먼저 결정했으면 applicant 결과만 기록
+//   - 원문 기록은 결정 반영과 같은 트랜잭션 → applied 값이 실제 반영 여부와 항상 일치
+func (s *Service) HandleKycDecision(ctx context.Context, signature string, body []byte) (*KycCallbackResponse, error) {
+	decision, err := s.kyc.ParseDecision(signature, body)
+	if err != nil {
+		switch {
+		case stderrors.Is(err, kyc.ErrInvalidSignature):
+			logctx.From(ctx, s.logger).Warn("KYC webhook with invalid signature", zap.String("provider", s.kyc.Name()))
+			return nil, errors.Unauthorized("Invalid KYC callback signature")
+		case stderrors.Is(err, kyc.ErrCallbacksNotSupported):
+			return nil, errors.NotFound("KYC provider callback")
+		default:
+			return nil, errors.InvalidInput(err.Error())
+		}
+	}
+	payloadHash := sha256.Sum256(body)
+
+	target := db.UsersKycStatusVERIFIED
+	applicantStatus := db.KycApplicantsStatusAPPROVED
+	if decision.Outcome == kyc.OutcomeRejected {
+		target = db.UsersKycStatusREJECTED
+		applicantStatus = db.KycApplicantsStatusREJECTED
+	}
+
+	result, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*KycCallbackResponse, error) {
+		result := &KycCallbackResponse{ApplicantID: decision.ApplicantID, Outcome: decision.Outcome}
+
+		// 1. Lock applicant
+		applicant, err := q.GetKycApplicantForUpdate(ctx, db.GetKycApplicantForUpdateParams{
+			Provider:    s.kyc.Name(),
+			ApplicantID: decision.ApplicantID,
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, errors.NotFound("KYC applicant")
+			}
+			return nil, err
+		}
+
+		// 2. Redelivered event (acknowledged, only the delivery is counted)
+		eventID := sql.NullString{String: decision.EventID, Valid: true}
+		redelivered, err := q.ExistsKycWebhookEvent(ctx, db.ExistsKycWebhookEventParams{
+			Provider: s.kyc.Name(),
+			EventID:  eventID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("check webhook event: %w", err)
+		}
+
+		// 3. Decide (non-final verdict / already decided = nothing to apply)
+		if !redelivered && decision.Outcome != kyc.OutcomePending && applicant.Status == db.KycApplicantsStatusPENDING {
+			user, applied, err := s.applyKycDecision(ctx, q, &applicant, decision, applicantStatus, target)
+			if err != nil {
+				return nil, err
+			}
+			if applied {
+				result.UserID = user.ExternalID.String
+				result.KycStatus = string(target)
+				result.Applied = true
+			}
+		}
+
+		// 4. Raw payload (audit)
+		if err := q.RecordKycWebhookEvent(ctx, db.RecordKycWebhookEventParams{
+			Provider:      s.kyc.Name(),
+			EventID:       eventID,
+			ApplicantID:   decision.ApplicantID,
+			UserID:        sql.NullInt64{Int64: int64(applicant.UserID), Valid: true},
+			Verdict:       decision.Verdict,
+			Outcome:       db.KycWebhookEventsOutcome(decision.Outcome),
+			Applied:       result.Applied,
+			Payload:       json.RawMessage(body),
+			PayloadSha256: hex.EncodeToString(payloadHash[:]),
+		}); err != nil {
+			return nil, fmt.Errorf("record webhook event: %w", err)
+		}
+		return result, nil
+	})
+	if err != nil {
+		if appErr, ok := err.(*errors.AppError); ok {
+			return nil, appErr
+		}
+		logctx.From(ctx, s.logger).Error("failed to apply KYC provider decision",
+			zap.String("applicant_id", decision.ApplicantID),
+			zap.Error(err),
+		)
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("KYC provider decision received",
+		zap.String("provider", s.kyc.Name()),
+		zap.String("event_id", decision.EventID),
+		zap.String("applicant_id", decision.ApplicantID),
+		zap.String("verdict", decision.Verdict),
+		zap.String("outcome", decision.Outcome),
+		zap.Bool("applied", result.Applied),
+	)
+	return result, nil
+}
+
+// ListKycWebhookEvents lists recorded KYC provider webhooks, newest first - Admin only
+func (s *Service) ListKycWebhookEvents(ctx context.Context, req *ListKycWebhookEventsRequest) (*ListKycWebhookEventsResponse, error) {
+	applicantID := sql.NullString{String: req.ApplicantID, Valid: req.ApplicantID != ""}
+
+	q := s.txRunner.Queries()
+	events, err := q.ListKycWebhookEvents(ctx, db.ListKycWebhookEventsParams{
+		ApplicantID: applicantID,
+		Limit:       int32(req.PageSize),
+		Offset:      int32((req.Page - 1) * req.PageSize),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list KYC webhook events", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	total, err := q.CountKycWebhookEvents(ctx, applicantID)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count KYC webhook events", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	responses := make([]KycWebhookEventResponse, 0, len(events))
+	for i := range events {
+		responses = append(responses, ToKycWebhookEventResponse(&events[i]))
+	}
+	return &ListKycWebhookEventsResponse{
+		Events:     responses,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages(total, req.PageSize),
+	}, nil
+}
+
+// applyKycDecision records the applicant's decision and transitions its user when the applicant is the user's latest
+// and the user is still PENDING (must be called within a transaction holding the applicant lock)
+func (s *Service) applyKycDecision(ctx context.Context, q *db.Queries, applicant *db.KycApplicant, decision *kyc.Decision, applicantStatus db.KycApplicantsStatus, target db.UsersKycStatus) (*db.User, bool, error) {
+	if err := q.DecideKycApplicant(ctx, db.DecideKycApplicantParams{
+		Status:       applicantStatus,
+		RejectReason: sql.NullString{String: decision.Reason, Valid: decision.Reason != ""},
+		ID:           applicant.ID,
+	}); err != nil {
+		return nil, false, fmt.Errorf("decide applicant: %w", err)
+	}
+
+	// Only the user's latest applicant drives the KYC state
+	latest, err := q.GetLatestKycApplicantByUser(ctx, applicant.UserID)
+	if err != nil {
+		return nil, false, fmt.Errorf("get latest applicant: %w", err)
+	}
+	if latest.ID != applicant.ID {
+		return nil, false, nil
+	}
+	user, err := q.GetUserForUpdate(ctx, applicant.UserID)
+	if err != nil {
+		return nil, false, fmt.Errorf("lock user: %w", err)
+	}
+	if user.KycStatus != db.UsersKycStatusPENDING {
+		return nil, false, nil
+	}
+
+	// Transition
+	if target == db.UsersKycStatusVERIFIED {
+		err = q.UpdateUserKycToVerified(ctx, user.ID)
+	} else {
+		err = q.UpdateUserKycToRejected(ctx, user.ID)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("update kyc status: %w", err)
+	}
+
+	// Audit + domain event (same transaction)
+	newValue := map[string]any{
+		"kyc_status":   string(target),
+		"provider":     applicant.Provider,
+		"applicant_id": applicant.ApplicantID,
+		"verdict":      decision.Verdict,
+	}
+	if decision.Reason != "" {
+		newValue["reason"] = decision.Reason
+	}
+	if err := audit.Record(ctx, q, audit.Actor{Type: audit.ActorTypeSystem}, audit.Entry{
+		Action:       actionKycDecision,
+		ResourceType: resourceUser,
+		ResourceID:   user.ID,
+		OldValue:     map[string]any{"kyc_status": string(user.KycStatus)},
+		NewValue:     newValue,
+	}); err != nil {
+		return nil, false, err
+	}
+	if err := writeKycEvent(ctx, q, &user, target); err != nil {
+		return nil, false, fmt.Errorf("write kyc event: %w", err)
+	}
+	return &user, true, nil
+}
+
+// KycProvider returns the name of the configured KYC provider
+func (s *Service) KycProvider() string {
+	return s.kyc.Name()
+}
+
+// checkKycRequestable allows requests from NONE/REJECTED, and from PENDING with a hosted provider (SDK token refresh)
+func (s *Service) checkKycRequestable(user *db.User) error {
+	switch user.KycStatus {
+	case db.UsersKycStatusNONE, db.UsersKycStatusREJECTED:
+		return nil
+	case db.UsersKycStatusPENDING:
+		if s.kyc.Name() != kyc.ProviderManual {
+			return nil
+		}
+	}
+	return errors.InvalidStateTransition(string(user.KycStatus), "PENDING").
+		WithDetails(map[string]any{
+			"reason": fmt.Sprintf("KYC can only be requested from NONE or REJECTED status, current: %s", user.KycStatus),
+		})
+}
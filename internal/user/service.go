@@ -7,9 +7,16 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/apikey"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/legalhold"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/outbox"
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/webhook"
 	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/kyc"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
 	"github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -20,85 +27,77 @@ const (
 	mysqlErrDuplicateEntry = 1062
 )
 
+// KYC decisions
+const (
+	KycDecisionApprove = "APPROVE"
+	KycDecisionReject  = "REJECT"
+)
+
+// Audit log identifiers
+const (
+	actionKycDecision = "KYC_DECISION"
+	resourceUser      = "USER"
+)
+
 // Service handles user business logic
 type Service struct {
 	txRunner *pkgdb.TxRunner
+	kyc      kyc.Provider
 	logger   *zap.Logger
 }
 
 // NewService creates a new user service
-func NewService(txRunner *pkgdb.TxRunner, logger *zap.Logger) *Service {
+// kycProvider runs identity verification (kyc.ManualProvider = admin review only)
+func NewService(txRunner *pkgdb.TxRunner, kycProvider kyc.Provider, logger *zap.Logger) *Service {
 	return &Service{
 		txRunner: txRunner,
+		kyc:      kycProvider,
 		logger:   logger,
 	}
 }
 
-// CreateUser creates a new user with associated account
-func (s *Service) CreateUser(ctx context.Context, req *CreateUserRequest) (*db.User, error) {
+// CreateUser creates a new user with associated account.
+// The response carries the one-time token for issuing the user's first API key without authentication.
+func (s *Service) CreateUser(ctx context.Context, req *CreateUserRequest) (*CreateUserResponse, error) {
 	// Check email uniqueness (soft check - DB unique constraint is the final guard)
 	exists, err := s.txRunner.Queries().ExistsUserByEmail(ctx, req.Email)
 	if err != nil {
-		s.logger.Error("failed to check email existence", zap.Error(err))
+		logctx.From(ctx, s.logger).Error("failed to check email existence", zap.Error(err))
 		return nil, errors.DBError(err)
 	}
 	if exists {
 		return nil, errors.Conflict("Email already registered")
 	}
 
-	var createdUser *db.User
+	var created *CreateUserResponse
 
-	// Transaction: Create user + Create account
+	// Transaction: Create user + Create account + API key bootstrap token
 	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
-		// 1. Create user
-		userExternalID := uuid.New().String()
-		phone := sql.NullString{}
-		if req.Phone != "" {
-			phone = sql.NullString{String: req.Phone, Valid: true}
-		}
-
-		result, err := q.CreateUser(ctx, db.CreateUserParams{
-			Email:      req.Email,
-			ExternalID: sql.NullString{String: userExternalID, Valid: true},
-			Name:       req.Name,
-			Phone:      phone,
-			Role:       db.UsersRole(req.Role),
-		})
+		userID, err := createUserWithAccount(ctx, q, req.Email, req.Name, req.Phone, db.UsersRole(req.Role))
 		if err != nil {
 			// Handle duplicate key error (race condition or deleted email reuse attempt)
 			if isDuplicateKeyError(err) {
 				return errors.Conflict("Email already registered or previously used")
 			}
-			s.logger.Error("failed to create user", zap.Error(err))
-			return errors.DBError(err)
-		}
-
-		userID, err := result.LastInsertId()
-		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to create user", zap.Error(err))
 			return errors.DBError(err)
 		}
 
-		// 2. Create associated account (auto-creation on registration)
-		accountExternalID := uuid.New().String()
-		_, err = q.CreateAccount(ctx, db.CreateAccountParams{
-			AccountType: db.AccountsAccountTypeUSER,
-			OwnerID:     sql.NullInt64{Int64: userID, Valid: true},
-			ExternalID:  sql.NullString{String: accountExternalID, Valid: true},
-		})
+		// Fetch created user
+		user, err := q.GetUserByID(ctx, userID)
 		if err != nil {
-			s.logger.Error("failed to create account", zap.Error(err))
 			return errors.DBError(err)
 		}
 
-		// 3. Fetch created user
-		user, err := q.GetUserByID(ctx, uint64(userID))
+		token, expiresAt, err := apikey.IssueBootstrapToken(ctx, q, userID)
 		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to issue api key bootstrap token", zap.Error(err))
 			return errors.DBError(err)
 		}
-		createdUser = &user
+		created = ToCreateUserResponse(&user, token, expiresAt)
 
-		s.logger.Info("user created",
-			zap.String("external_id", userExternalID),
+		logctx.From(ctx, s.logger).Info("user created",
+			zap.String("external_id", user.ExternalID.String),
 			zap.String("email", req.Email),
 		)
 
@@ -109,7 +108,7 @@ func (s *Service) CreateUser(ctx context.Context, req *CreateUserRequest) (*db.U
 		return nil, err
 	}
 
-	return createdUser, nil
+	return created, nil
 }
 
 // GetUserByExternalID retrieves user by external ID (excludes DELETED)
@@ -119,7 +118,7 @@ func (s *Service) GetUserByExternalID(ctx context.Context, externalID string) (*
 		if err == sql.ErrNoRows {
 			return nil, errors.NotFound("User")
 		}
-		s.logger.Error("failed to get user", zap.Error(err), zap.String("external_id", externalID))
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err), zap.String("external_id", externalID))
 		return nil, errors.DBError(err)
 	}
 	return &user, nil
@@ -132,7 +131,7 @@ func (s *Service) getUserByExternalIDIncludeDeleted(ctx context.Context, externa
 		if err == sql.ErrNoRows {
 			return nil, errors.NotFound("User")
 		}
-		s.logger.Error("failed to get user", zap.Error(err), zap.String("external_id", externalID))
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err), zap.String("external_id", externalID))
 		return nil, errors.DBError(err)
 	}
 	return &user, nil
@@ -145,13 +144,14 @@ func (s *Service) GetUserByID(ctx context.Context, id uint64) (*db.User, error)
 		if err == sql.ErrNoRows {
 			return nil, errors.NotFound("User")
 		}
-		s.logger.Error("failed to get user", zap.Error(err), zap.Uint64("id", id))
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err), zap.Uint64("id", id))
 		return nil, errors.DBError(err)
 	}
 	return &user, nil
 }
 
 // UpdateProfile updates user profile (name, phone)
+// Replacing a verified phone number clears its verification and starts the payout address cooldown
 func (s *Service) UpdateProfile(ctx context.Context, externalID string, req *UpdateUserProfileRequest) (*db.User, error) {
 	// Get user first (excludes DELETED - can't update deleted user)
 	user, err := s.GetUserByExternalID(ctx, externalID)
@@ -170,7 +170,7 @@ func (s *Service) UpdateProfile(ctx context.Context, externalID string, req *Upd
 		ID:    user.ID,
 	})
 	if err != nil {
-		s.logger.Error("failed to update profile", zap.Error(err), zap.String("external_id", externalID))
+		logctx.From(ctx, s.logger).Error("failed to update profile", zap.Error(err), zap.String("external_id", externalID))
 		return nil, errors.DBError(err)
 	}
 
@@ -195,7 +195,7 @@ func (s *Service) UpdateRole(ctx context.Context, externalID string, req *Update
 		ID:   user.ID,
 	})
 	if err != nil {
-		s.logger.Error("failed to update role", zap.Error(err), zap.String("external_id", externalID))
+		logctx.From(ctx, s.logger).Error("failed to update role", zap.Error(err), zap.String("external_id", externalID))
 		return nil, errors.DBError(err)
 	}
 
@@ -220,7 +220,7 @@ func (s *Service) SuspendUser(ctx context.Context, externalID string) (*db.User,
 
 	result, err := s.txRunner.Queries().UpdateUserStatusToSuspended(ctx, user.ID)
 	if err != nil {
-		s.logger.Error("failed to suspend user", zap.Error(err), zap.String("external_id", externalID))
+		logctx.From(ctx, s.logger).Error("failed to suspend user", zap.Error(err), zap.String("external_id", externalID))
 		return nil, errors.DBError(err)
 	}
 
@@ -235,7 +235,7 @@ func (s *Service) SuspendUser(ctx context.Context, externalID string) (*db.User,
 		return nil, errors.Internal("Failed to suspend user")
 	}
 
-	s.logger.Info("user suspended", zap.String("external_id", externalID))
+	logctx.From(ctx, s.logger).Info("user suspended", zap.String("external_id", externalID))
 	return s.GetUserByExternalID(ctx, externalID)
 }
 
@@ -259,7 +259,7 @@ func (s *Service) ActivateUser(ctx context.Context, externalID string) (*db.User
 
 	result, err := s.txRunner.Queries().UpdateUserStatusToActive(ctx, user.ID)
 	if err != nil {
-		s.logger.Error("failed to activate user", zap.Error(err), zap.String("external_id", externalID))
+		logctx.From(ctx, s.logger).Error("failed to activate user", zap.Error(err), zap.String("external_id", externalID))
 		return nil, errors.DBError(err)
 	}
 
@@ -273,7 +273,7 @@ func (s *Service) ActivateUser(ctx context.Context, externalID string) (*db.User
 		return nil, errors.Internal("Failed to activate user")
 	}
 
-	s.logger.Info("user activated", zap.String("external_id", externalID))
+	logctx.From(ctx, s.logger).Info("user activated", zap.String("external_id", externalID))
 	return s.GetUserByExternalID(ctx, externalID)
 }
 
@@ -291,6 +291,15 @@ func (s *Service) DeleteUser(ctx context.Context, externalID string) error {
 	}
 
 	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		// 0. Legal hold blocks deletion (data must be preserved until released)
+		held, err := legalhold.IsHeld(ctx, q, db.LegalHoldsSubjectTypeUSER, user.ID)
+		if err != nil {
+			return errors.DBError(err)
+		}
+		if held {
+			return errors.Conflict("User is under legal hold")
+		}
+
 		// 1. Delete user
 		result, err := q.UpdateUserStatusToDeleted(ctx, user.ID)
 		if err != nil {
@@ -308,17 +317,17 @@ func (s *Service) DeleteUser(ctx context.Context, externalID string) error {
 		if err != nil {
 			if err == sql.ErrNoRows {
 				// No account exists - OK
-				s.logger.Warn("no account found for user", zap.String("external_id", externalID))
+				logctx.From(ctx, s.logger).Warn("no account found for user", zap.String("external_id", externalID))
 				return nil
 			}
-			s.logger.Warn("failed to get account for closure", zap.Error(err), zap.String("external_id", externalID))
+			logctx.From(ctx, s.logger).Warn("failed to get account for closure", zap.Error(err), zap.String("external_id", externalID))
 			// Don't fail user deletion for account lookup failure
 			return nil
 		}
 
 		// Close account using correct account.ID
 		if err := q.UpdateAccountStatusToClosed(ctx, account.ID); err != nil {
-			s.logger.Warn("failed to close account", zap.Error(err),
+			logctx.From(ctx, s.logger).Warn("failed to close account", zap.Error(err),
 				zap.String("external_id", externalID),
 				zap.Uint64("account_id", account.ID))
 			// Don't fail user deletion for account closure failure
@@ -328,11 +337,11 @@ func (s *Service) DeleteUser(ctx context.Context, externalID string) error {
 	})
 
 	if err != nil {
-		s.logger.Error("failed to delete user", zap.Error(err), zap.String("external_id", externalID))
+		logctx.From(ctx, s.logger).Error("failed to delete user", zap.Error(err), zap.String("external_id", externalID))
 		return err
 	}
 
-	s.logger.Info("user deleted", zap.String("external_id", externalID))
+	logctx.From(ctx, s.logger).Info("user deleted", zap.String("external_id", externalID))
 	return nil
 }
 
@@ -359,14 +368,14 @@ func (s *Service) ListUsers(ctx context.Context, req *ListUsersRequest) (*ListUs
 	// Get users
 	users, err := s.txRunner.Queries().ListUsers(ctx, params)
 	if err != nil {
-		s.logger.Error("failed to list users", zap.Error(err))
+		logctx.From(ctx, s.logger).Error("failed to list users", zap.Error(err))
 		return nil, errors.DBError(err)
 	}
 
 	// Get total count
 	total, err := s.txRunner.Queries().CountUsers(ctx, countParams)
 	if err != nil {
-		s.logger.Error("failed to count users", zap.Error(err))
+		logctx.From(ctx, s.logger).Error("failed to count users", zap.Error(err))
 		return nil, errors.DBError(err)
 	}
 
@@ -388,76 +397,244 @@ func (s *Service) ListUsers(ctx context.Context, req *ListUsersRequest) (*ListUs
 // KYC Operations (Admin only in production)
 // ============================================================================
 
-// RequestKycVerification requests KYC verification (NONE/REJECTED -> PENDING)
-func (s *Service) RequestKycVerification(ctx context.Context, externalID string) (*db.User, error) {
+// ApproveKyc approves KYC (PENDING -> VERIFIED) - Admin only
+func (s *Service) ApproveKyc(ctx context.Context, externalID string) (*db.User, error) {
 	user, err := s.GetUserByExternalID(ctx, externalID)
 	if err != nil {
 		return nil, err
 	}
 
-	if user.KycStatus != db.UsersKycStatusNONE && user.KycStatus != db.UsersKycStatusREJECTED {
-		return nil, errors.InvalidStateTransition(string(user.KycStatus), "PENDING").
-			WithDetails(map[string]any{
-				"reason": fmt.Sprintf("KYC can only be requested from NONE or REJECTED status, current: %s", user.KycStatus),
-			})
+	if user.KycStatus != db.UsersKycStatusPENDING {
+		return nil, errors.InvalidStateTransition(string(user.KycStatus), "VERIFIED")
 	}
 
-	err = s.txRunner.Queries().UpdateUserKycToPending(ctx, user.ID)
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		// 1. Lock + re-check state
+		locked, err := q.GetUserForUpdate(ctx, user.ID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return errors.NotFound("User")
+			}
+			return errors.DBError(err)
+		}
+		if locked.KycStatus != db.UsersKycStatusPENDING {
+			return errors.InvalidStateTransition(string(locked.KycStatus), "VERIFIED")
+		}
+
+		// 2. Transition
+		if err := q.UpdateUserKycToVerified(ctx, user.ID); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to approve KYC", zap.Error(err), zap.String("external_id", externalID))
+			return errors.DBError(err)
+		}
+
+		// 3. Domain event (same transaction)
+		if err := writeKycEvent(ctx, q, &locked, db.UsersKycStatusVERIFIED); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to write outbox event", zap.Error(err))
+			return errors.DBError(err)
+		}
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("failed to request KYC", zap.Error(err), zap.String("external_id", externalID))
-		return nil, errors.DBError(err)
+		return nil, err
 	}
 
-	s.logger.Info("KYC verification requested", zap.String("external_id", externalID))
+	logctx.From(ctx, s.logger).Info("KYC approved", zap.String("external_id", externalID))
 	return s.GetUserByExternalID(ctx, externalID)
 }
 
-// ApproveKyc approves KYC (PENDING -> VERIFIED) - Admin only
-func (s *Service) ApproveKyc(ctx context.Context, externalID string) (*db.User, error) {
+// RejectKyc rejects KYC (PENDING -> REJECTED) - Admin only
+func (s *Service) RejectKyc(ctx context.Context, externalID string) (*db.User, error) {
 	user, err := s.GetUserByExternalID(ctx, externalID)
 	if err != nil {
 		return nil, err
 	}
 
 	if user.KycStatus != db.UsersKycStatusPENDING {
-		return nil, errors.InvalidStateTransition(string(user.KycStatus), "VERIFIED")
+		return nil, errors.InvalidStateTransition(string(user.KycStatus), "REJECTED")
 	}
 
-	err = s.txRunner.Queries().UpdateUserKycToVerified(ctx, user.ID)
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		// 1. Lock + re-check state
+		locked, err := q.GetUserForUpdate(ctx, user.ID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return errors.NotFound("User")
+			}
+			return errors.DBError(err)
+		}
+		if locked.KycStatus != db.UsersKycStatusPENDING {
+			return errors.InvalidStateTransition(string(locked.KycStatus), "REJECTED")
+		}
+
+		// 2. Transition
+		if err := q.UpdateUserKycToRejected(ctx, user.ID); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to reject KYC", zap.Error(err), zap.String("external_id", externalID))
+			return errors.DBError(err)
+		}
+
+		// 3. Domain event (same transaction)
+		if err := writeKycEvent(ctx, q, &locked, db.UsersKycStatusREJECTED); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to write outbox event", zap.Error(err))
+			return errors.DBError(err)
+		}
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("failed to approve KYC", zap.Error(err), zap.String("external_id", externalID))
-		return nil, errors.DBError(err)
+		return nil, err
 	}
 
-	s.logger.Info("KYC approved", zap.String("external_id", externalID))
+	logctx.From(ctx, s.logger).Info("KYC rejected", zap.String("external_id", externalID))
 	return s.GetUserByExternalID(ctx, externalID)
 }
 
-// RejectKyc rejects KYC (PENDING -> REJECTED) - Admin only
-func (s *Service) RejectKyc(ctx context.Context, externalID string) (*db.User, error) {
-	user, err := s.GetUserByExternalID(ctx, externalID)
-	if err != nil {
-		return nil, err
+// BulkKycDecision approves or rejects KYC for many users in one transaction - Admin only.
+// Users that are missing or not PENDING are skipped and reported per item.
+// With dryRun the whole batch runs in a rollback-only transaction.
+func (s *Service) BulkKycDecision(ctx context.Context, req *BulkKycDecisionRequest, dryRun bool, actor audit.Actor) (*BulkKycDecisionResponse, error) {
+	target := db.UsersKycStatusVERIFIED
+	if req.Decision == KycDecisionReject {
+		target = db.UsersKycStatusREJECTED
 	}
 
-	if user.KycStatus != db.UsersKycStatusPENDING {
-		return nil, errors.InvalidStateTransition(string(user.KycStatus), "REJECTED")
-	}
+	result, err := pkgdb.WithTxResultDryRun(ctx, s.txRunner, dryRun, func(q *db.Queries) (*BulkKycDecisionResponse, error) {
+		result := &BulkKycDecisionResponse{
+			Decision: req.Decision,
+			Results:  make([]KycDecisionResult, 0, len(req.UserIDs)),
+			DryRun:   dryRun,
+		}
+
+		for _, externalID := range req.UserIDs {
+			item := KycDecisionResult{UserID: externalID}
+
+			// 1. Resolve + lock user row
+			user, err := q.GetUserByExternalID(ctx, sql.NullString{String: externalID, Valid: true})
+			if err == nil {
+				user, err = q.GetUserForUpdate(ctx, user.ID)
+			}
+			if err != nil {
+				if err != sql.ErrNoRows {
+					logctx.From(ctx, s.logger).Error("failed to lock user row", zap.Error(err), zap.String("external_id", externalID))
+					return nil, errors.DBError(err)
+				}
+				item.Error = "User not found"
+				result.Results = append(result.Results, item)
+				result.Skipped++
+				continue
+			}
+
+			// 2. State check (locked row is authoritative)
+			item.From = string(user.KycStatus)
+			if user.KycStatus != db.UsersKycStatusPENDING {
+				item.Error = fmt.Sprintf("KYC is not pending, current: %s", user.KycStatus)
+				result.Results = append(result.Results, item)
+				result.Skipped++
+				continue
+			}
 
-	err = s.txRunner.Queries().UpdateUserKycToRejected(ctx, user.ID)
+			// 3. Transition
+			if target == db.UsersKycStatusVERIFIED {
+				err = q.UpdateUserKycToVerified(ctx, user.ID)
+			} else {
+				err = q.UpdateUserKycToRejected(ctx, user.ID)
+			}
+			if err != nil {
+				logctx.From(ctx, s.logger).Error("failed to apply KYC decision", zap.Error(err), zap.String("external_id", externalID))
+				return nil, errors.DBError(err)
+			}
+
+			// 4. Audit + domain event (same transaction)
+			if err := audit.Record(ctx, q, actor, audit.Entry{
+				Action:       actionKycDecision,
+				ResourceType: resourceUser,
+				ResourceID:   user.ID,
+				OldValue:     map[string]any{"kyc_status": item.From},
+				NewValue:     map[string]any{"kyc_status": string(target)},
+			}); err != nil {
+				logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+				return nil, errors.DBError(err)
+			}
+			if err := writeKycEvent(ctx, q, &user, target); err != nil {
+				logctx.From(ctx, s.logger).Error("failed to write outbox event", zap.Error(err))
+				return nil, errors.DBError(err)
+			}
+
+			item.To = string(target)
+			item.Applied = true
+			result.Results = append(result.Results, item)
+			result.Applied++
+		}
+
+		return result, nil
+	})
 	if err != nil {
-		s.logger.Error("failed to reject KYC", zap.Error(err), zap.String("external_id", externalID))
-		return nil, errors.DBError(err)
+		return nil, err
 	}
 
-	s.logger.Info("KYC rejected", zap.String("external_id", externalID))
-	return s.GetUserByExternalID(ctx, externalID)
+	logctx.From(ctx, s.logger).Info("bulk KYC decision processed",
+		zap.String("decision", req.Decision),
+		zap.Int("applied", result.Applied),
+		zap.Int("skipped", result.Skipped),
+		zap.Bool("dry_run", dryRun),
+		zap.String("request_id", actor.RequestID),
+	)
+
+	return result, nil
 }
 
 // ============================================================================
 // Helper functions
 // ============================================================================
 
+// createUserWithAccount creates a user and its USER account (must be called within a transaction).
+// A taken email surfaces as the users.uk_email duplicate key error.
+func createUserWithAccount(ctx context.Context, q *db.Queries, email, name, phone string, role db.UsersRole) (uint64, error) {
+	result, err := q.CreateUser(ctx, db.CreateUserParams{
+		Email:      email,
+		ExternalID: sql.NullString{String: uuid.New().String(), Valid: true},
+		Name:       name,
+		Phone:      sql.NullString{String: phone, Valid: phone != ""},
+		Role:       role,
+	})
+	if err != nil {
+		return 0, err
+	}
+	userID, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	// Associated account (auto-creation on registration)
+	if _, err := q.CreateAccount(ctx, db.CreateAccountParams{
+		AccountType: db.AccountsAccountTypeUSER,
+		OwnerID:     sql.NullInt64{Int64: userID, Valid: true},
+		ExternalID:  sql.NullString{String: uuid.New().String(), Valid: true},
+	}); err != nil {
+		return 0, fmt.Errorf("create account: %w", err)
+	}
+	return uint64(userID), nil
+}
+
+// writeKycEvent records a kyc.approved/kyc.rejected event for the user (must be called within a transaction)
+func writeKycEvent(ctx context.Context, q *db.Queries, user *db.User, status db.UsersKycStatus) error {
+	eventType := webhook.EventKycApproved
+	if status == db.UsersKycStatusREJECTED {
+		eventType = webhook.EventKycRejected
+	}
+
+	_, err := outbox.Write(ctx, q, outbox.Message{
+		EventType:           eventType,
+		AggregateType:       outbox.AggregateUser,
+		AggregateID:         user.ID,
+		AggregateExternalID: user.ExternalID.String,
+		RecipientUserID:     user.ID,
+		Data: map[string]any{
+			"user_id":    user.ExternalID.String,
+			"kyc_status": string(status),
+		},
+	})
+	return err
+}
+
 // isDuplicateKeyError checks if the error is a MySQL duplicate key error
 func isDuplicateKeyError(err error) bool {
 	if err == nil {
@@ -1,11 +1,25 @@
 package wallet
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/eip712"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
 )
 
+// Wallet activity directions (from the wallet's point of view)
+const (
+	TransferDirectionIn  = "IN"
+	TransferDirectionOut = "OUT"
+)
+
+// transferKindMicroTransfer is the activity type of a micro-transfer verification payment
+const transferKindMicroTransfer = "MICRO_TRANSFER"
+
 // ============================================================================
 // Request DTOs
 // ============================================================================
@@ -13,15 +27,56 @@ import (
 // RegisterWalletRequest represents the request body for wallet registration
 // NOTE: Address 형식 검증은 서비스 레이어에서 ValidateEthereumAddress()로 수행
 type RegisterWalletRequest struct {
-	Address string `json:"address" binding:"required,len=42" example:"0x742d35Cc6634C0532925a3b844Bc454e4438f44e"`
+	// Address is a hex address or an ENS name (e.g. "merchant.eth"), resolved at registration
+	Address string `json:"address" binding:"required,max=255" example:"0x742d35Cc6634C0532925a3b844Bc454e4438f44e"`
 	Label   string `json:"label,omitempty" binding:"omitempty,max=50" example:"My Main Wallet"`
+	// ChainID of the network the wallet lives on (omitted = default chain)
+	ChainID int64 `json:"chain_id,omitempty" binding:"omitempty,gt=0" example:"137"`
+}
+
+// RegisterWalletBatchRequest represents the request body for bulk wallet registration
+// NOTE: 행 단위 결과를 돌려주기 위해 주소/라벨 검증은 바인딩이 아닌 서비스에서 행별로 수행
+type RegisterWalletBatchRequest struct {
+	Wallets []RegisterWalletRequest `json:"wallets" binding:"required,min=1,max=200"`
+}
+
+// RegisterWatchOnlyWalletRequest represents the request body for watch-only wallet registration
+// NOTE: 서명 불가 주소(거래소 입금 주소 등) - 소유자 보증(attestation) 필수
+type RegisterWatchOnlyWalletRequest struct {
+	Address  string `json:"address" binding:"required,len=42" example:"0x742d35Cc6634C0532925a3b844Bc454e4438f44e"`
+	Label    string `json:"label,omitempty" binding:"omitempty,max=50" example:"Exchange deposit"`
+	Attested bool   `json:"attested" binding:"required" example:"true"`
+	// ChainID of the network the wallet lives on (omitted = default chain)
+	ChainID int64 `json:"chain_id,omitempty" binding:"omitempty,gt=0" example:"137"`
+}
+
+// ListWalletsRequest represents query filters and the cursor page of the wallet list
+// NOTE: cursor는 이전 응답의 next_cursor 그대로 전달 (필터는 페이지 간 동일해야 함)
+type ListWalletsRequest struct {
+	ChainID    int64  `form:"chain_id" binding:"omitempty,gt=0"`
+	IsVerified *bool  `form:"is_verified"`
+	IsPrimary  *bool  `form:"is_primary"`
+	Cursor     string `form:"cursor" binding:"omitempty,max=128"`
+	Limit      int    `form:"limit,default=20" binding:"min=1,max=100"`
+}
+
+// ListWalletTransfersRequest represents query parameters for the wallet activity list
+// NOTE: direction은 지갑 관점 (IN = 지갑이 수신, OUT = 지갑이 송신), 생략 시 전체
+type ListWalletTransfersRequest struct {
+	Direction string `form:"direction" binding:"omitempty,oneof=IN OUT"`
+	Page      int    `form:"page,default=1" binding:"min=1"`
+	PageSize  int    `form:"page_size,default=20" binding:"min=1,max=100"`
 }
 
 // VerifyWalletRequest represents the request body for wallet verification
 type VerifyWalletRequest struct {
-	// Signature: 0x prefix + 130 hex chars (65 bytes)
-	Signature string                     `json:"signature" binding:"required,len=132" example:"0x1234...abcd"`
+	// Signature: 0x prefix + 130 hex chars (65 bytes, v = 0/1, 27/28 or EIP-155) or 128 hex chars (EIP-2098 compact) for EOAs.
+	// Contract wallets (ERC-1271) may submit longer signatures, e.g. concatenated Safe owner signatures.
+	Signature string                     `json:"signature" binding:"required,min=130,max=4098" example:"0x1234...abcd"`
 	Message   VerifyWalletRequestMessage `json:"message" binding:"required"`
+	// SignatureScheme: eip712 (default, typed data), personal_sign (EIP-191 fallback) or
+	// eip712_legacy (eth_signTypedData v1 fallback); fallbacks only when enabled
+	SignatureScheme string `json:"signature_scheme" binding:"omitempty,oneof=eip712 personal_sign eip712_legacy" example:"eip712"`
 }
 
 // VerifyWalletRequestMessage contains the EIP-712 message data
@@ -30,30 +85,157 @@ type VerifyWalletRequestMessage struct {
 	Timestamp int64  `json:"timestamp" binding:"required,gt=0" example:"1706000000"`
 }
 
+// ConfirmMicroTransferRequest represents the request body for micro-transfer confirmation
+type ConfirmMicroTransferRequest struct {
+	// Amount received on-chain, in token units (e.g. "0.004217")
+	Amount string `json:"amount" binding:"required,max=32" example:"0.004217"`
+}
+
 // UpdateLabelRequest represents the request body for label update
 type UpdateLabelRequest struct {
 	Label string `json:"label" binding:"required,max=50" example:"Trading Wallet"`
 }
 
+// ListPrimaryViolationsRequest represents the cursor page of the primary wallet violation report
+type ListPrimaryViolationsRequest struct {
+	Cursor string `form:"cursor" binding:"omitempty,max=64"`
+	Limit  int    `form:"limit,default=100" binding:"min=1,max=500"`
+}
+
 // ============================================================================
 // Response DTOs
 // ============================================================================
 
 // WalletResponse represents the wallet data in API responses
 type WalletResponse struct {
-	ID         string    `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
-	Address    string    `json:"address" example:"0x742d35cc6634c0532925a3b844bc454e4438f44e"`
-	Label      string    `json:"label,omitempty" example:"My Main Wallet"`
-	IsPrimary  bool      `json:"is_primary" example:"false"`
-	IsVerified bool      `json:"is_verified" example:"false"`
-	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
+	ID                string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Address           string     `json:"address" example:"0x742d35cc6634c0532925a3b844bc454e4438f44e"`
+	ChainID           int64      `json:"chain_id" example:"1"`
+	Label             string     `json:"label,omitempty" example:"My Main Wallet"`
+	IsPrimary         bool       `json:"is_primary" example:"false"`
+	IsVerified        bool       `json:"is_verified" example:"false"`
+	VerificationLevel string     `json:"verification_level" example:"SIGNATURE"`
+	IsWatchOnly       bool       `json:"is_watch_only" example:"false"`
+	ReceivableAfter   *time.Time `json:"receivable_after,omitempty"`
+	// ENS fields are set when the wallet was registered with an ENS name
+	ENSName      string     `json:"ens_name,omitempty" example:"merchant.eth"`
+	ENSStatus    string     `json:"ens_status,omitempty" example:"RESOLVED"`
+	ENSCheckedAt *time.Time `json:"ens_checked_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
 }
 
-// ListWalletsResponse represents the wallet list response
+// MicroTransferResponse represents the micro-transfer challenge state
+// NOTE: 송금 금액은 절대 응답에 포함하지 않음 (사용자가 온체인에서 확인)
+type MicroTransferResponse struct {
+	Status            string     `json:"status" example:"SENT"`
+	TxHash            string     `json:"tx_hash,omitempty" example:"0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"`
+	AttemptsRemaining int        `json:"attempts_remaining" example:"5"`
+	ExpiresAt         time.Time  `json:"expires_at"`
+	ConfirmedAt       *time.Time `json:"confirmed_at,omitempty"`
+}
+
+// WalletBalanceResponse represents the on-chain settlement token balance of a wallet
+// NOTE: 짧게 캐시된 값일 수 있음 (as_of = RPC 조회 시각)
+type WalletBalanceResponse struct {
+	Address      string      `json:"address" example:"0x742d35cc6634c0532925a3b844bc454e4438f44e"`
+	ChainID      int64       `json:"chain_id" example:"1"`
+	TokenAddress string      `json:"token_address" example:"0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"`
+	Balance      money.Money `json:"balance" swaggertype:"string" example:"1250.500000"`
+	RawBalance   string      `json:"raw_balance" example:"1250500000"`
+	Decimals     int         `json:"decimals" example:"6"`
+	AsOf         time.Time   `json:"as_of"`
+}
+
+// VerificationChallengeResponse represents a server-issued EIP-712 verification challenge.
+// Sign typed_data as-is (eth_signTypedData_v4) and submit nonce/timestamp with the signature to /verify.
+type VerificationChallengeResponse struct {
+	Nonce     string    `json:"nonce" example:"9f86d081884c7d659a2feaa0c55ad015"`
+	Timestamp int64     `json:"timestamp" example:"1706000000"`
+	ChainID   int64     `json:"chain_id" example:"1"`
+	ExpiresAt time.Time `json:"expires_at"`
+	TypedData any       `json:"typed_data" swaggertype:"object"`
+	// PersonalMessage is the text to sign with signature_scheme=personal_sign (omitted when disabled)
+	PersonalMessage string `json:"personal_message,omitempty" example:"B2B Settlement wants you to verify ownership of this wallet."`
+	// LegacyTypedData is the eth_signTypedData (v1) payload for signature_scheme=eip712_legacy (omitted when disabled)
+	LegacyTypedData []eip712.LegacyTypedField `json:"legacy_typed_data,omitempty"`
+	// AcceptedEncodings lists the signing methods and signature formats the verifier accepts
+	AcceptedEncodings []eip712.Encoding `json:"accepted_encodings"`
+}
+
+// WalletBatchResult represents the outcome of a single row in a bulk registration
+type WalletBatchResult struct {
+	Index   int             `json:"index" example:"0"`
+	Address string          `json:"address" example:"0x742d35Cc6634C0532925a3b844Bc454e4438f44e"`
+	ChainID int64           `json:"chain_id" example:"1"`
+	Wallet  *WalletResponse `json:"wallet,omitempty"`
+	Error   string          `json:"error,omitempty" example:"Wallet address already registered"`
+}
+
+// RegisterWalletBatchResponse represents the bulk wallet registration result
+type RegisterWalletBatchResponse struct {
+	Results []WalletBatchResult `json:"results"`
+	Created int                 `json:"created"`
+	Failed  int                 `json:"failed"`
+}
+
+// ListWalletsResponse represents a cursor page of the wallet list
 type ListWalletsResponse struct {
 	Wallets []WalletResponse `json:"wallets"`
-	Total   int64            `json:"total"`
+	// Total is the number of wallets matching the filters (all pages)
+	Total int64 `json:"total"`
+	// NextCursor fetches the following page; empty on the last page
+	NextCursor string `json:"next_cursor,omitempty" example:"eyJ0IjoiMjAyNi0wMS0wMVQwMDowMDowMFoiLCJpZCI6NDJ9"`
+	HasMore    bool   `json:"has_more" example:"false"`
+}
+
+// WalletTransferResponse represents an indexed token transfer involving the wallet
+type WalletTransferResponse struct {
+	// Type is the platform flow that produced the transfer: DEPOSIT, WITHDRAWAL or MICRO_TRANSFER
+	Type      string `json:"type" example:"DEPOSIT"`
+	Direction string `json:"direction" example:"OUT"`
+	TxHash    string `json:"tx_hash" example:"0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"`
+	// Amount is omitted for unconfirmed micro-transfers (the amount is the verification answer)
+	Amount      string    `json:"amount,omitempty" example:"1000.00000000"`
+	BlockNumber *int64    `json:"block_number,omitempty" example:"19000000"`
+	Status      string    `json:"status" example:"COMPLETED"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ListWalletTransfersResponse represents a page of wallet activity
+type ListWalletTransfersResponse struct {
+	Transfers  []WalletTransferResponse `json:"transfers"`
+	Total      int64                    `json:"total"`
+	Page       int                      `json:"page"`
+	PageSize   int                      `json:"page_size"`
+	TotalPages int                      `json:"total_pages"`
+}
+
+// PrimaryViolationResponse represents a user violating the primary wallet invariant
+type PrimaryViolationResponse struct {
+	UserID string `json:"user_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	// Violations: NO_PRIMARY, UNVERIFIED_PRIMARY, ACCOUNT_MISMATCH
+	Violations             []string `json:"violations" example:"ACCOUNT_MISMATCH"`
+	PrimaryWalletID        string   `json:"primary_wallet_id,omitempty" example:"660e8400-e29b-41d4-a716-446655440001"`
+	AccountPrimaryWalletID string   `json:"account_primary_wallet_id,omitempty" example:"660e8400-e29b-41d4-a716-446655440002"`
+	VerifiedWallets        int64    `json:"verified_wallets" example:"2"`
+}
+
+// ListPrimaryViolationsResponse represents one page of the primary wallet violation report
+type ListPrimaryViolationsResponse struct {
+	Violations []PrimaryViolationResponse `json:"violations"`
+	// NextCursor fetches the following page; empty on the last page
+	NextCursor string `json:"next_cursor,omitempty" example:"NDI"`
+	HasMore    bool   `json:"has_more" example:"false"`
+}
+
+// PrimaryRepairResponse represents the result of a primary wallet repair
+// NOTE: violations가 비어 있으면 이미 정합 상태 (repaired=false)
+type PrimaryRepairResponse struct {
+	UserID          string   `json:"user_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Violations      []string `json:"violations" example:"NO_PRIMARY"`
+	PrimaryWalletID string   `json:"primary_wallet_id,omitempty" example:"660e8400-e29b-41d4-a716-446655440001"`
+	Repaired        bool     `json:"repaired" example:"true"`
 }
 
 // ============================================================================
@@ -67,17 +249,30 @@ func ToWalletResponse(wallet *db.Wallet) *WalletResponse {
 	}
 
 	response := &WalletResponse{
-		ID:         wallet.ExternalID,
-		Address:    wallet.Address,
-		IsPrimary:  wallet.IsPrimary,
-		IsVerified: wallet.IsVerified,
-		CreatedAt:  wallet.CreatedAt,
-		UpdatedAt:  wallet.UpdatedAt,
+		ID:                wallet.ExternalID,
+		Address:           wallet.Address,
+		ChainID:           int64(wallet.ChainID),
+		IsPrimary:         wallet.IsPrimary,
+		IsVerified:        wallet.IsVerified,
+		VerificationLevel: string(wallet.VerificationLevel),
+		IsWatchOnly:       wallet.IsWatchOnly,
+		CreatedAt:         wallet.CreatedAt,
+		UpdatedAt:         wallet.UpdatedAt,
 	}
 
 	if wallet.Label.Valid {
 		response.Label = wallet.Label.String
 	}
+	if wallet.ReceivableAfter.Valid {
+		response.ReceivableAfter = &wallet.ReceivableAfter.Time
+	}
+	if wallet.EnsName.Valid {
+		response.ENSName = wallet.EnsName.String
+		response.ENSStatus = string(wallet.EnsStatus.WalletsEnsStatus)
+		if wallet.EnsCheckedAt.Valid {
+			response.ENSCheckedAt = &wallet.EnsCheckedAt.Time
+		}
+	}
 
 	return response
 }
@@ -90,3 +285,108 @@ func ToWalletResponseList(wallets []db.Wallet) []WalletResponse {
 	}
 	return responses
 }
+
+// ToVerificationChallengeResponse converts eip712.Challenge to VerificationChallengeResponse
+func ToVerificationChallengeResponse(challenge *eip712.Challenge) *VerificationChallengeResponse {
+	if challenge == nil {
+		return nil
+	}
+
+	return &VerificationChallengeResponse{
+		Nonce:             challenge.Message.Nonce,
+		Timestamp:         challenge.Message.Timestamp,
+		ChainID:           challenge.Message.ChainID,
+		ExpiresAt:         challenge.ExpiresAt,
+		TypedData:         challenge.TypedData,
+		PersonalMessage:   challenge.PersonalMessage,
+		LegacyTypedData:   challenge.LegacyTypedData,
+		AcceptedEncodings: challenge.Encodings,
+	}
+}
+
+// ToMicroTransferResponse converts db.WalletMicroTransfer to MicroTransferResponse
+func ToMicroTransferResponse(mt *db.WalletMicroTransfer) *MicroTransferResponse {
+	if mt == nil {
+		return nil
+	}
+
+	response := &MicroTransferResponse{
+		Status:            string(mt.Status),
+		AttemptsRemaining: max(microTransferMaxAttempts-int(mt.Attempts), 0),
+		ExpiresAt:         mt.ExpiresAt,
+	}
+
+	if mt.TxHash.Valid {
+		response.TxHash = mt.TxHash.String
+	}
+	if mt.ConfirmedAt.Valid {
+		response.ConfirmedAt = &mt.ConfirmedAt.Time
+	}
+
+	return response
+}
+
+// ToWalletTransferResponse converts an indexed transfer row to WalletTransferResponse
+func ToWalletTransferResponse(row *db.ListWalletTransfersRow) WalletTransferResponse {
+	response := WalletTransferResponse{
+		Type:      row.Kind,
+		Direction: row.Direction,
+		TxHash:    row.TxHash,
+		Amount:    row.Amount,
+		Status:    string(row.Status),
+		CreatedAt: row.CreatedAt,
+	}
+
+	if row.BlockNumber.Valid {
+		response.BlockNumber = &row.BlockNumber.Int64
+	}
+	// 확인 전 소액 송금 금액은 노출 금지 (사용자가 온체인에서 확인해 입력해야 하는 값)
+	if row.Kind == transferKindMicroTransfer && string(row.Status) != string(db.WalletMicroTransfersStatusCONFIRMED) {
+		response.Amount = ""
+	}
+
+	return response
+}
+
+// toPrimaryViolationResponse converts a violation report row
+func toPrimaryViolationResponse(row *db.ListPrimaryWalletViolationsRow) PrimaryViolationResponse {
+	return PrimaryViolationResponse{
+		UserID:                 row.UserExternalID.String,
+		Violations:             primaryViolations(row),
+		PrimaryWalletID:        row.PrimaryWalletExternalID.String,
+		AccountPrimaryWalletID: row.AccountWalletExternalID.String,
+		VerifiedWallets:        row.VerifiedWallets,
+	}
+}
+
+// ============================================================================
+// Cursor
+// ============================================================================
+
+// walletCursor is the position after the last wallet of a page (created_at, id)
+type walletCursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        uint64    `json:"id"`
+}
+
+// encodeWalletCursor builds the opaque cursor pointing after the wallet
+func encodeWalletCursor(wallet *db.Wallet) string {
+	raw, _ := json.Marshal(walletCursor{CreatedAt: wallet.CreatedAt.UTC(), ID: wallet.ID})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decodeWalletCursor parses a cursor returned by encodeWalletCursor
+func decodeWalletCursor(cursor string) (*walletCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("decode cursor: %w", err)
+	}
+	var c walletCursor
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, fmt.Errorf("parse cursor: %w", err)
+	}
+	if c.CreatedAt.IsZero() || c.ID == 0 {
+		return nil, fmt.Errorf("incomplete cursor")
+	}
+	return &c, nil
+}
@@ -0,0 +1,191 @@
+package wallet
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/outbox"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/webhook"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"go.uber.org/zap"
+)
+
+// ENSRevalidatorConfig holds ENS re-validation job settings
+type ENSRevalidatorConfig struct {
+	// Interval is the period of the job
+	Interval time.Duration
+	// MaxAge is how long a resolution is trusted before it is checked again
+	MaxAge time.Duration
+	// BatchSize is the number of wallets checked per run
+	BatchSize int
+}
+
+// ENSRevalidationReport summarizes one re-validation run
+type ENSRevalidationReport struct {
+	Checked int
+	Changed int
+	Failed  int
+}
+
+// ENSRevalidator periodically re-resolves the ENS names of registered wallets.
+// A name that no longer resolves to the wallet address is flagged (CHANGED / UNRESOLVED)
+// and announced with a wallet.ens_changed event; the wallet address itself never changes.
+type ENSRevalidator struct {
+	txRunner    *pkgdb.TxRunner
+	chainClient chain.Client
+	config      ENSRevalidatorConfig
+	logger      *zap.Logger
+}
+
+// NewENSRevalidator creates a new ENS re-validation job
+func NewENSRevalidator(txRunner *pkgdb.TxRunner, chainClient chain.Client, config ENSRevalidatorConfig, logger *zap.Logger) *ENSRevalidator {
+	return &ENSRevalidator{
+		txRunner:    txRunner,
+		chainClient: chainClient,
+		config:      config,
+		logger:      logger,
+	}
+}
+
+// Run re-validates stale ENS resolutions periodically until ctx is canceled
+func (r *ENSRevalidator) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	r.logger.Info("ENS revalidator started",
+		zap.Duration("interval", r.config.Interval),
+		zap.Duration("max_age", r.config.MaxAge),
+		zap.Int("batch_size", r.config.BatchSize),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.logger.Info("ENS revalidator stopped")
+			return
+		case <-ticker.C:
+			report, err := r.RunOnce(ctx, time.Now().UTC())
+			if err != nil {
+				r.logger.Error("ENS revalidation run failed", zap.Error(err))
+				continue
+			}
+			if report.Changed+report.Failed > 0 {
+				r.logger.Info("ENS revalidation run completed",
+					zap.Int("checked", report.Checked),
+					zap.Int("changed", report.Changed),
+					zap.Int("failed", report.Failed),
+				)
+			}
+		}
+	}
+}
+
+// RunOnce re-resolves one batch of wallets whose resolution is older than MaxAge.
+// A wallet whose name cannot be resolved (RPC error) is left as is and retried on the next run.
+func (r *ENSRevalidator) RunOnce(ctx context.Context, now time.Time) (*ENSRevalidationReport, error) {
+	report := &ENSRevalidationReport{}
+
+	wallets, err := r.txRunner.Queries().ListWalletsDueForENSCheck(ctx, db.ListWalletsDueForENSCheckParams{
+		CheckedBefore: sql.NullTime{Time: now.Add(-r.config.MaxAge), Valid: true},
+		Limit:         int32(r.config.BatchSize),
+	})
+	if err != nil {
+		return report, fmt.Errorf("list wallets due for ENS check: %w", err)
+	}
+
+	for i := range wallets {
+		if ctx.Err() != nil {
+			return report, nil
+		}
+
+		changed, err := r.revalidate(ctx, &wallets[i], now)
+		if err != nil {
+			report.Failed++
+			r.logger.Warn("ENS revalidation failed",
+				zap.String("wallet_external_id", wallets[i].ExternalID),
+				zap.String("ens_name", wallets[i].EnsName.String),
+				zap.Error(err),
+			)
+			continue
+		}
+		report.Checked++
+		if changed {
+			report.Changed++
+		}
+	}
+	return report, nil
+}
+
+// revalidate resolves the wallet's ENS name and records the result.
+// Reports whether the status changed away from the previous one.
+func (r *ENSRevalidator) revalidate(ctx context.Context, wallet *db.Wallet, now time.Time) (bool, error) {
+	status := db.WalletsEnsStatusRESOLVED
+	resolved, err := r.chainClient.ResolveName(ctx, wallet.EnsName.String)
+	switch {
+	case stderrors.Is(err, chain.ErrENSNameNotFound) || stderrors.Is(err, chain.ErrInvalidENSName):
+		status = db.WalletsEnsStatusUNRESOLVED
+		resolved = ""
+	case err != nil:
+		return false, err
+	case resolved != wallet.Address:
+		status = db.WalletsEnsStatusCHANGED
+	}
+	changed := status != wallet.EnsStatus.WalletsEnsStatus
+
+	err = r.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		rows, err := q.UpdateWalletENSStatus(ctx, db.UpdateWalletENSStatusParams{
+			EnsStatus:         db.NullWalletsEnsStatus{WalletsEnsStatus: status, Valid: true},
+			CheckedAt:         sql.NullTime{Time: now, Valid: true},
+			ID:                wallet.ID,
+			PreviousCheckedAt: wallet.EnsCheckedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("update ENS status: %w", err)
+		}
+		// 다른 인스턴스가 먼저 재검증했거나 지갑이 삭제됨
+		if rows == 0 || !changed {
+			changed = false
+			return nil
+		}
+		return writeWalletENSChangedEvent(ctx, q, wallet, status, resolved)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if changed {
+		r.logger.Warn("wallet ENS resolution changed",
+			zap.String("wallet_external_id", wallet.ExternalID),
+			zap.String("ens_name", wallet.EnsName.String),
+			zap.String("address", wallet.Address),
+			zap.String("resolved_address", resolved),
+			zap.String("status", string(status)),
+		)
+	}
+	return changed, nil
+}
+
+// writeWalletENSChangedEvent records a wallet.ens_changed event for the wallet owner (must be called within a transaction)
+func writeWalletENSChangedEvent(ctx context.Context, q *db.Queries, wallet *db.Wallet, status db.WalletsEnsStatus, resolved string) error {
+	_, err := outbox.Write(ctx, q, outbox.Message{
+		EventType:           webhook.EventWalletENSChanged,
+		AggregateType:       outbox.AggregateWallet,
+		AggregateID:         wallet.ID,
+		AggregateExternalID: wallet.ExternalID,
+		RecipientUserID:     wallet.UserID,
+		Data: map[string]any{
+			"wallet_id":        wallet.ExternalID,
+			"address":          wallet.Address,
+			"chain_id":         wallet.ChainID,
+			"ens_name":         wallet.EnsName.String,
+			"ens_status":       string(status),
+			"resolved_address": resolved,
+		},
+	})
+	return err
+}
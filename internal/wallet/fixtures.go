@@ -0,0 +1,79 @@
+package wallet
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/fixtures"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// fixtureTime is the canonical timestamp of wallet fixtures
+var fixtureTime = time.Date(2026, 1, 15, 9, 30, 0, 0, time.UTC)
+
+// fixtureWallet is the canonical verified wallet
+var fixtureWallet = WalletResponse{
+	ID:                "550e8400-e29b-41d4-a716-446655440000",
+	Address:           "0x742d35cc6634c0532925a3b844bc454e4438f44e",
+	ChainID:           1,
+	Label:             "My Main Wallet",
+	IsPrimary:         true,
+	IsVerified:        true,
+	VerificationLevel: "SIGNATURE",
+	CreatedAt:         fixtureTime,
+	UpdatedAt:         fixtureTime,
+}
+
+// RegisterFixtures registers the canonical wallet DTO examples and operation responses
+func RegisterFixtures(r *fixtures.Registry) {
+	unverified := fixtureWallet
+	unverified.IsPrimary = false
+	unverified.IsVerified = false
+	unverified.VerificationLevel = "NONE"
+
+	r.Example(RegisterWalletRequest{
+		Address: "0x742d35Cc6634C0532925a3b844Bc454e4438f44e",
+		Label:   "My Main Wallet",
+		ChainID: 1,
+	})
+	r.Example(UpdateLabelRequest{Label: "Trading Wallet"})
+	r.Example(ConfirmMicroTransferRequest{Amount: "0.004217"})
+
+	r.Operation("RegisterWallet", http.StatusCreated, unverified)
+	r.Operation("GetWallet", http.StatusOK, fixtureWallet)
+	r.Operation("ListWallets", http.StatusOK, ListWalletsResponse{
+		Wallets: []WalletResponse{fixtureWallet},
+		Total:   1,
+		HasMore: false,
+	})
+	r.Operation("GetBalance", http.StatusOK, WalletBalanceResponse{
+		Address:      fixtureWallet.Address,
+		ChainID:      fixtureWallet.ChainID,
+		TokenAddress: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48",
+		Balance:      money.MustParse("1250.500000", "", 6),
+		RawBalance:   "1250500000",
+		Decimals:     6,
+		AsOf:         fixtureTime,
+	})
+	r.Operation("ListTransfers", http.StatusOK, ListWalletTransfersResponse{
+		Transfers: []WalletTransferResponse{{
+			Type:      "DEPOSIT",
+			Direction: "OUT",
+			TxHash:    "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060",
+			Amount:    "1000.00000000",
+			Status:    "COMPLETED",
+			CreatedAt: fixtureTime,
+		}},
+		Total:      1,
+		Page:       1,
+		PageSize:   20,
+		TotalPages: 1,
+	})
+	r.Operation("InitiateMicroTransfer", http.StatusCreated, MicroTransferResponse{
+		Status:            "SENT",
+		TxHash:            "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060",
+		AttemptsRemaining: 5,
+		ExpiresAt:         fixtureTime.Add(72 * time.Hour),
+	})
+	r.Operation("DeleteWallet", http.StatusNoContent, nil)
+}
@@ -1,6 +1,7 @@
 package wallet
 
 import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
 	"github.com/gin-gonic/gin"
@@ -23,12 +24,22 @@ func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
 	wallets := rg.Group("/users/:id/wallets")
 	{
 		wallets.POST("", h.RegisterWallet)
+		wallets.POST("/watch-only", middleware.RequireAuth(), h.RegisterWatchOnlyWallet)
+		wallets.POST("/batch", middleware.RequireAuth(), h.RegisterWalletBatch)
 		wallets.GET("", h.ListWallets)
 		wallets.GET("/:walletId", h.GetWallet)
+		wallets.GET("/:walletId/balance", h.GetBalance)
+		wallets.GET("/:walletId/transactions", h.ListTransfers)
 		wallets.PUT("/:walletId/label", h.UpdateLabel)
+		wallets.GET("/:walletId/verification-challenge", h.GetVerificationChallenge)
 		wallets.POST("/:walletId/verify", h.VerifyWallet)
 		wallets.POST("/:walletId/set-primary", h.SetPrimary)
+		// Micro-transfers send platform funds → owner (or admin) only
+		wallets.POST("/:walletId/micro-transfer", middleware.RequireAuth(), h.InitiateMicroTransfer)
+		wallets.GET("/:walletId/micro-transfer", middleware.RequireAuth(), h.GetMicroTransfer)
+		wallets.POST("/:walletId/micro-transfer/confirm", middleware.RequireAuth(), h.ConfirmMicroTransfer)
 		wallets.DELETE("/:walletId", h.DeleteWallet)
+		wallets.POST("/:walletId/restore", h.RestoreWallet)
 	}
 }
 
@@ -49,6 +60,19 @@ func extractAndValidateUserID(c *gin.Context) (string, error) {
 	return userID, nil
 }
 
+// extractOwnedUserID extracts the user id from path and checks the caller may act as the user
+func extractOwnedUserID(c *gin.Context) (string, error) {
+	userID, err := extractAndValidateUserID(c)
+	if err != nil {
+		return "", err
+	}
+	principal, _ := middleware.GetPrincipal(c)
+	if !principal.CanActAs(userID) {
+		return "", errors.Forbidden("Cannot access wallets of another user")
+	}
+	return userID, nil
+}
+
 // extractAndValidateWalletID extracts and validates walletId from path
 func extractAndValidateWalletID(c *gin.Context) (string, error) {
 	walletID := c.Param("walletId")
@@ -60,17 +84,21 @@ func extractAndValidateWalletID(c *gin.Context) (string, error) {
 
 // RegisterWallet godoc
 // @Summary Register a new wallet
-// @Description Register a new Ethereum wallet for the user
+// @Description Register a new Ethereum wallet for the user. The address may be an ENS name (e.g. merchant.eth):
+// @Description it is resolved on the platform chain and both the address and the name are stored.
+// @Description The resolution is re-validated periodically (ens_status CHANGED/UNRESOLVED when it no longer matches).
 // @Tags wallets
 // @Accept json
 // @Produce json
 // @Param id path string true "User external ID (UUID)"
 // @Param request body RegisterWalletRequest true "Wallet registration data"
 // @Success 201 {object} middleware.SuccessResponse{data=WalletResponse} "Wallet created"
-// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input or unresolvable ENS name"
+// @Failure 403 {object} middleware.ErrorResponse "Address blocked by compliance screening"
 // @Failure 404 {object} middleware.ErrorResponse "User not found"
 // @Failure 409 {object} middleware.ErrorResponse "Wallet address already registered"
 // @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Failure 503 {object} middleware.ErrorResponse "ENS resolution disabled or failed"
 // @Router /api/v1/users/{id}/wallets [post]
 func (h *Handler) RegisterWallet(c *gin.Context) {
 	userExternalID, err := extractAndValidateUserID(c)
@@ -85,7 +113,54 @@ func (h *Handler) RegisterWallet(c *gin.Context) {
 		return
 	}
 
-	wallet, err := h.service.RegisterWallet(c.Request.Context(), userExternalID, &req)
+	wallet, err := h.service.RegisterWallet(c.Request.Context(), userExternalID, &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, ToWalletResponse(wallet))
+}
+
+// RegisterWatchOnlyWallet godoc
+// @Summary Register a watch-only wallet
+// @Description Register a receive-only wallet attested by the account owner instead of a signature
+// @Description (e.g. exchange deposit addresses). Payouts are allowed only after a cooling-off period.
+// @Tags wallets
+// @Accept json
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param request body RegisterWatchOnlyWalletRequest true "Watch-only wallet data"
+// @Success 201 {object} middleware.SuccessResponse{data=WalletResponse} "Wallet created"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input or missing attestation"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot attest wallets of another user or address blocked by compliance screening"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 409 {object} middleware.ErrorResponse "Wallet address already registered"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/users/{id}/wallets/watch-only [post]
+func (h *Handler) RegisterWatchOnlyWallet(c *gin.Context) {
+	userExternalID, err := extractAndValidateUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	// Only the account owner (or an admin) can vouch for an unsigned address
+	principal, _ := middleware.GetPrincipal(c)
+	if !principal.CanActAs(userExternalID) {
+		middleware.RespondError(c, errors.Forbidden("Cannot attest wallets of another user"))
+		return
+	}
+
+	var req RegisterWatchOnlyWalletRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	wallet, err := h.service.RegisterWatchOnlyWallet(c.Request.Context(), userExternalID, &req, audit.ActorFromContext(c))
 	if err != nil {
 		middleware.RespondError(c, err)
 		return
@@ -94,14 +169,65 @@ func (h *Handler) RegisterWallet(c *gin.Context) {
 	middleware.RespondCreated(c, ToWalletResponse(wallet))
 }
 
+// RegisterWalletBatch godoc
+// @Summary Register wallets in bulk
+// @Description Register up to 200 wallets with labels in one transaction (enterprise onboarding).
+// @Description Rows are validated independently: invalid, duplicate, already registered or compliance-blocked addresses are reported per row and skipped.
+// @Tags wallets
+// @Accept json
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param request body RegisterWalletBatchRequest true "Wallets to register"
+// @Success 200 {object} middleware.SuccessResponse{data=RegisterWalletBatchResponse} "Per-row results"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot register wallets of another user"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/users/{id}/wallets/batch [post]
+func (h *Handler) RegisterWalletBatch(c *gin.Context) {
+	userExternalID, err := extractAndValidateUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	principal, _ := middleware.GetPrincipal(c)
+	if !principal.CanActAs(userExternalID) {
+		middleware.RespondError(c, errors.Forbidden("Cannot register wallets of another user"))
+		return
+	}
+
+	var req RegisterWalletBatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.RegisterWalletBatch(c.Request.Context(), userExternalID, &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
 // GetWallet godoc
 // @Summary Get wallet by ID
-// @Description Retrieve wallet details by external ID
+// @Description Retrieve wallet details by external ID.
+// @Description Supports conditional requests: ETag/Last-Modified are derived from updated_at and 304 is returned when unchanged.
 // @Tags wallets
 // @Produce json
 // @Param id path string true "User external ID (UUID)"
 // @Param walletId path string true "Wallet external ID (UUID)"
+// @Param If-None-Match header string false "ETag from a previous response"
+// @Param If-Modified-Since header string false "Last-Modified from a previous response"
 // @Success 200 {object} middleware.SuccessResponse{data=WalletResponse} "Wallet details"
+// @Success 304 "Not modified"
+// @Header 200,304 {string} ETag "Weak resource version"
+// @Header 200,304 {string} Last-Modified "Resource updated_at"
 // @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
 // @Failure 404 {object} middleware.ErrorResponse "Wallet not found"
 // @Failure 500 {object} middleware.ErrorResponse "Internal server error"
@@ -124,20 +250,26 @@ func (h *Handler) GetWallet(c *gin.Context) {
 		return
 	}
 
-	middleware.RespondOK(c, ToWalletResponse(wallet))
+	middleware.RespondOKConditional(c, walletExternalID, wallet.UpdatedAt, ToWalletResponse(wallet))
 }
 
 // ListWallets godoc
 // @Summary List user wallets
-// @Description Get all wallets for a user
+// @Description Get a page of the user's wallets (oldest first), optionally filtered by chain, verification and primary flag.
+// @Description Pass next_cursor of the previous page as cursor (with the same filters) to get the following page.
+// @Description Send Accept: text/csv to receive the same results (same filters) as CSV.
 // @Tags wallets
-// @Produce json
+// @Produce json,text/csv
 // @Param id path string true "User external ID (UUID)"
-// @Success 200 {object} middleware.SuccessResponse{data=ListWalletsResponse} "Wallet list"
-// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Param chain_id query int false "Only wallets on this chain"
+// @Param is_verified query bool false "Only verified (true) or unverified (false) wallets"
+// @Param is_primary query bool false "Only the primary (true) or non-primary (false) wallets"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "Page size (max 100)" default(20)
+// @Success 200 {object} middleware.SuccessResponse{data=ListWalletsResponse} "Wallet page"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format, cursor or unsupported chain_id"
 // @Failure 500 {object} middleware.ErrorResponse "Internal server error"
 // @Router /api/v1/users/{id}/wallets [get]
-// TODO: Phase 2+ - Add pagination (page, page_size) when wallet count grows
 func (h *Handler) ListWallets(c *gin.Context) {
 	userExternalID, err := extractAndValidateUserID(c)
 	if err != nil {
@@ -145,13 +277,19 @@ func (h *Handler) ListWallets(c *gin.Context) {
 		return
 	}
 
-	result, err := h.service.ListWallets(c.Request.Context(), userExternalID)
+	var req ListWalletsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListWallets(c.Request.Context(), userExternalID, &req)
 	if err != nil {
 		middleware.RespondError(c, err)
 		return
 	}
 
-	middleware.RespondOK(c, result)
+	middleware.RespondOKNegotiated(c, "wallets", result, result.Wallets)
 }
 
 // UpdateLabel godoc
@@ -195,9 +333,47 @@ func (h *Handler) UpdateLabel(c *gin.Context) {
 	middleware.RespondOK(c, ToWalletResponse(wallet))
 }
 
+// GetVerificationChallenge godoc
+// @Summary Get wallet verification challenge
+// @Description Issue a server-generated nonce and the full EIP-712 typed data to sign for wallet verification.
+// @Description The nonce is pre-reserved for the wallet and can be redeemed once via /verify before expires_at.
+// @Tags wallets
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param walletId path string true "Wallet external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=VerificationChallengeResponse} "Verification challenge"
+// @Failure 400 {object} middleware.ErrorResponse "Wallet already verified or watch-only"
+// @Failure 404 {object} middleware.ErrorResponse "Wallet not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/wallets/{walletId}/verification-challenge [get]
+func (h *Handler) GetVerificationChallenge(c *gin.Context) {
+	userExternalID, err := extractAndValidateUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	walletExternalID, err := extractAndValidateWalletID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	challenge, err := h.service.GetVerificationChallenge(c.Request.Context(), userExternalID, walletExternalID)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, ToVerificationChallengeResponse(challenge))
+}
+
 // VerifyWallet godoc
 // @Summary Verify wallet ownership
-// @Description Verify wallet ownership using EIP-712 signature
+// @Description Verify wallet ownership using EIP-712 signature.
+// @Description Contract wallets (e.g. Gnosis Safe) are verified on chain via ERC-1271 isValidSignature.
+// @Description Wallets without typed-data support may sign the challenge's personal_message with signature_scheme=personal_sign (when enabled).
+// @Description Signers limited to legacy typed data (older hardware wallet firmware) may sign legacy_typed_data with signature_scheme=eip712_legacy (when enabled).
+// @Description EOA signatures are accepted with v = 0/1, 27/28 or EIP-155 encoded, and in EIP-2098 compact form (see accepted_encodings of the challenge).
 // @Tags wallets
 // @Accept json
 // @Produce json
@@ -269,6 +445,204 @@ func (h *Handler) SetPrimary(c *gin.Context) {
 	middleware.RespondOK(c, ToWalletResponse(wallet))
 }
 
+// InitiateMicroTransfer godoc
+// @Summary Start micro-transfer verification
+// @Description Send a small random token amount to a signature-verified wallet.
+// @Description Returns the outstanding challenge if one is still pending confirmation. A wallet receives at most 3 micro-transfers.
+// @Tags wallets
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param walletId path string true "Wallet external ID (UUID)"
+// @Success 201 {object} middleware.SuccessResponse{data=MicroTransferResponse} "Micro-transfer sent"
+// @Failure 400 {object} middleware.ErrorResponse "Wallet not verified, already micro-transfer verified or micro-transfer limit reached"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Wallet of another user or address blocked by compliance screening"
+// @Failure 404 {object} middleware.ErrorResponse "Wallet not found"
+// @Failure 409 {object} middleware.ErrorResponse "Micro-transfer already in progress"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Failure 503 {object} middleware.ErrorResponse "Chain unavailable"
+// @Security ApiKeyAuth
+// @Router /api/v1/users/{id}/wallets/{walletId}/micro-transfer [post]
+func (h *Handler) InitiateMicroTransfer(c *gin.Context) {
+	userExternalID, err := extractOwnedUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	walletExternalID, err := extractAndValidateWalletID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	challenge, err := h.service.InitiateMicroTransfer(c.Request.Context(), userExternalID, walletExternalID, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, ToMicroTransferResponse(challenge))
+}
+
+// GetBalance godoc
+// @Summary Get on-chain wallet balance
+// @Description Get the settlement token balance of the wallet via balanceOf on the configured token contract.
+// @Description Balances are cached for a few seconds; as_of is the time of the RPC lookup.
+// @Tags wallets
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param walletId path string true "Wallet external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=WalletBalanceResponse} "Wallet balance"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format or wallet on another chain"
+// @Failure 404 {object} middleware.ErrorResponse "Wallet not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Failure 503 {object} middleware.ErrorResponse "Chain lookup disabled or failed"
+// @Router /api/v1/users/{id}/wallets/{walletId}/balance [get]
+func (h *Handler) GetBalance(c *gin.Context) {
+	userExternalID, err := extractAndValidateUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	walletExternalID, err := extractAndValidateWalletID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	balance, err := h.service.GetBalance(c.Request.Context(), userExternalID, walletExternalID)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, balance)
+}
+
+// ListTransfers godoc
+// @Summary List wallet on-chain activity
+// @Description List token transfers involving the wallet recorded by the deposit indexer (newest first):
+// @Description deposits sent from the wallet (OUT), withdrawals and micro-transfers paid to it (IN).
+// @Description Amounts of unconfirmed micro-transfers are omitted. Only wallets on the platform chain are indexed.
+// @Tags wallets
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param walletId path string true "Wallet external ID (UUID)"
+// @Param direction query string false "Direction from the wallet's point of view" Enums(IN, OUT)
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size (max 100)" default(20)
+// @Success 200 {object} middleware.SuccessResponse{data=ListWalletTransfersResponse} "Wallet activity"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid parameters or wallet on another chain"
+// @Failure 404 {object} middleware.ErrorResponse "Wallet not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/wallets/{walletId}/transactions [get]
+func (h *Handler) ListTransfers(c *gin.Context) {
+	userExternalID, err := extractAndValidateUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	walletExternalID, err := extractAndValidateWalletID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req ListWalletTransfersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListTransfers(c.Request.Context(), userExternalID, walletExternalID, &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// GetMicroTransfer godoc
+// @Summary Get micro-transfer status
+// @Description Get the latest micro-transfer challenge of the wallet (amount is never returned)
+// @Tags wallets
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param walletId path string true "Wallet external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=MicroTransferResponse} "Micro-transfer status"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Wallet of another user"
+// @Failure 404 {object} middleware.ErrorResponse "Wallet or micro-transfer not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/users/{id}/wallets/{walletId}/micro-transfer [get]
+func (h *Handler) GetMicroTransfer(c *gin.Context) {
+	userExternalID, err := extractOwnedUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	walletExternalID, err := extractAndValidateWalletID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	challenge, err := h.service.GetMicroTransfer(c.Request.Context(), userExternalID, walletExternalID)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, ToMicroTransferResponse(challenge))
+}
+
+// ConfirmMicroTransfer godoc
+// @Summary Confirm micro-transfer amount
+// @Description Confirm the token amount received on-chain to upgrade the wallet to MICRO_TRANSFER verification level
+// @Tags wallets
+// @Accept json
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param walletId path string true "Wallet external ID (UUID)"
+// @Param request body ConfirmMicroTransferRequest true "Received amount"
+// @Success 200 {object} middleware.SuccessResponse{data=WalletResponse} "Verified wallet"
+// @Failure 400 {object} middleware.ErrorResponse "Amount mismatch, expired, too many attempts or not sent"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Wallet of another user"
+// @Failure 404 {object} middleware.ErrorResponse "Wallet or micro-transfer not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/users/{id}/wallets/{walletId}/micro-transfer/confirm [post]
+func (h *Handler) ConfirmMicroTransfer(c *gin.Context) {
+	userExternalID, err := extractOwnedUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	walletExternalID, err := extractAndValidateWalletID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req ConfirmMicroTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	wallet, err := h.service.ConfirmMicroTransfer(c.Request.Context(), userExternalID, walletExternalID, &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, ToWalletResponse(wallet))
+}
+
 // DeleteWallet godoc
 // @Summary Delete wallet
 // @Description Delete a non-primary wallet (hard delete)
@@ -300,3 +674,40 @@ func (h *Handler) DeleteWallet(c *gin.Context) {
 
 	middleware.RespondNoContent(c)
 }
+
+// RestoreWallet godoc
+// @Summary Restore wallet
+// @Description Restore a deleted wallet (not as primary). Restoring a wallet that is not deleted succeeds without changes.
+// @Description Fails when the address has been registered again on the same chain since the wallet was deleted.
+// @Description The address is screened against sanctions lists again.
+// @Tags wallets
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param walletId path string true "Wallet external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=WalletResponse} "Wallet restored"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 403 {object} middleware.ErrorResponse "Address blocked by compliance screening"
+// @Failure 404 {object} middleware.ErrorResponse "Wallet not found"
+// @Failure 409 {object} middleware.ErrorResponse "Wallet address has been registered again"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Router /api/v1/users/{id}/wallets/{walletId}/restore [post]
+func (h *Handler) RestoreWallet(c *gin.Context) {
+	userExternalID, err := extractAndValidateUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	walletExternalID, err := extractAndValidateWalletID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	wallet, err := h.service.RestoreWallet(c.Request.Context(), userExternalID, walletExternalID, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, ToWalletResponse(wallet))
+}
@@ -0,0 +1,359 @@
+package wallet
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"go.uber.org/zap"
+)
+
+// Primary wallet invariant violations.
+// Two primaries per user cannot occur (uk_wallet_primary_user), the remaining ones are repaired by PrimaryRepairer.
+const (
+	// ViolationNoPrimary: the user has verified wallets but no primary wallet
+	ViolationNoPrimary = "NO_PRIMARY"
+	// ViolationUnverifiedPrimary: the primary wallet is not signature-verified
+	ViolationUnverifiedPrimary = "UNVERIFIED_PRIMARY"
+	// ViolationAccountMismatch: accounts.primary_wallet_id does not point to the primary wallet
+	ViolationAccountMismatch = "ACCOUNT_MISMATCH"
+)
+
+// actionPrimaryRepaired is the audit action of a primary wallet repair
+const actionPrimaryRepaired = "WALLET_PRIMARY_REPAIRED"
+
+// PrimaryRepairConfig holds primary wallet consistency job settings
+type PrimaryRepairConfig struct {
+	// Interval is the period of the job
+	Interval time.Duration
+	// BatchSize is the number of violating users fetched per page
+	BatchSize int
+}
+
+// PrimaryRepairReport summarizes one repair run
+type PrimaryRepairReport struct {
+	Checked  int
+	Repaired int
+	Failed   int
+}
+
+// PrimaryRepairer reports and repairs users whose primary wallet state is inconsistent.
+// Repair rules (per user, under the user row lock like SetPrimary):
+//   - an unverified primary is cleared
+//   - a user with verified wallets and no primary gets one: the wallet the account points to
+//     when it is verified, otherwise the oldest verified wallet
+//   - the account's primary_wallet_id is aligned with the resulting primary wallet
+type PrimaryRepairer struct {
+	txRunner *pkgdb.TxRunner
+	config   PrimaryRepairConfig
+	logger   *zap.Logger
+}
+
+// NewPrimaryRepairer creates a new primary wallet consistency job
+func NewPrimaryRepairer(txRunner *pkgdb.TxRunner, config PrimaryRepairConfig, logger *zap.Logger) *PrimaryRepairer {
+	return &PrimaryRepairer{
+		txRunner: txRunner,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Run repairs violations periodically until ctx is canceled
+func (r *PrimaryRepairer) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	r.logger.Info("primary wallet repairer started",
+		zap.Duration("interval", r.config.Interval),
+		zap.Int("batch_size", r.config.BatchSize),
+	)
+
+	actor := audit.Actor{Type: audit.ActorTypeSystem}
+	for {
+		select {
+		case <-ctx.Done():
+			r.logger.Info("primary wallet repairer stopped")
+			return
+		case <-ticker.C:
+			report, err := r.RunOnce(ctx, actor)
+			if err != nil {
+				r.logger.Error("primary wallet repair run failed", zap.Error(err))
+				continue
+			}
+			if report.Checked > 0 {
+				// 위반이 발견됐다는 것 자체가 버그/장애 신호 → 항상 경고
+				r.logger.Warn("primary wallet violations repaired",
+					zap.Int("checked", report.Checked),
+					zap.Int("repaired", report.Repaired),
+					zap.Int("failed", report.Failed),
+				)
+			}
+		}
+	}
+}
+
+// RunOnce scans all users with violations and repairs them.
+// A user that fails to repair is left as is and retried on the next run.
+func (r *PrimaryRepairer) RunOnce(ctx context.Context, actor audit.Actor) (*PrimaryRepairReport, error) {
+	report := &PrimaryRepairReport{}
+
+	var afterUserID uint64
+	for {
+		rows, err := r.txRunner.Queries().ListPrimaryWalletViolations(ctx, db.ListPrimaryWalletViolationsParams{
+			AfterUserID: afterUserID,
+			Limit:       int32(r.config.BatchSize),
+		})
+		if err != nil {
+			return report, fmt.Errorf("list primary wallet violations: %w", err)
+		}
+
+		for i := range rows {
+			if ctx.Err() != nil {
+				return report, nil
+			}
+			report.Checked++
+			if _, err := r.repair(ctx, rows[i].UserID, actor); err != nil {
+				report.Failed++
+				r.logger.Warn("primary wallet repair failed",
+					zap.String("user_external_id", rows[i].UserExternalID.String),
+					zap.Strings("violations", primaryViolations(&rows[i])),
+					zap.Error(err),
+				)
+				continue
+			}
+			report.Repaired++
+		}
+
+		if len(rows) < r.config.BatchSize {
+			return report, nil
+		}
+		afterUserID = rows[len(rows)-1].UserID
+	}
+}
+
+// ListViolations returns one page of users violating the primary wallet invariant
+func (r *PrimaryRepairer) ListViolations(ctx context.Context, req *ListPrimaryViolationsRequest) (*ListPrimaryViolationsResponse, error) {
+	var afterUserID uint64
+	if req.Cursor != "" {
+		id, err := decodeUserCursor(req.Cursor)
+		if err != nil {
+			return nil, errors.InvalidInput("Invalid cursor")
+		}
+		afterUserID = id
+	}
+
+	rows, err := r.txRunner.Queries().ListPrimaryWalletViolations(ctx, db.ListPrimaryWalletViolationsParams{
+		AfterUserID: afterUserID,
+		Limit:       int32(req.Limit + 1), // +1: 다음 페이지 존재 여부 확인
+	})
+	if err != nil {
+		logctx.From(ctx, r.logger).Error("failed to list primary wallet violations", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	response := &ListPrimaryViolationsResponse{Violations: make([]PrimaryViolationResponse, 0, len(rows))}
+	if len(rows) > req.Limit {
+		rows = rows[:req.Limit]
+		response.HasMore = true
+		response.NextCursor = encodeUserCursor(rows[len(rows)-1].UserID)
+	}
+	for i := range rows {
+		response.Violations = append(response.Violations, toPrimaryViolationResponse(&rows[i]))
+	}
+	return response, nil
+}
+
+// RepairUser repairs a single user's primary wallet state (no-op when consistent)
+func (r *PrimaryRepairer) RepairUser(ctx context.Context, userExternalID string, actor audit.Actor) (*PrimaryRepairResponse, error) {
+	user, err := r.txRunner.Queries().GetUserByExternalID(ctx, sql.NullString{String: userExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("User")
+		}
+		logctx.From(ctx, r.logger).Error("failed to get user for primary repair", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	result, err := r.repair(ctx, user.ID, actor)
+	if err != nil {
+		logctx.From(ctx, r.logger).Error("failed to repair primary wallet", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	result.UserID = userExternalID
+	return result, nil
+}
+
+// repair re-checks the user's state under the user row lock and applies the repair rules
+func (r *PrimaryRepairer) repair(ctx context.Context, userID uint64, actor audit.Actor) (*PrimaryRepairResponse, error) {
+	return pkgdb.WithTxResult(ctx, r.txRunner, func(q *db.Queries) (*PrimaryRepairResponse, error) {
+		// 1. Lock user row (SetPrimary/자동 Primary 설정과 직렬화)
+		if _, err := q.GetUserForUpdate(ctx, userID); err != nil {
+			return nil, fmt.Errorf("lock user: %w", err)
+		}
+
+		wallets, err := q.ListWalletsByUser(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("list wallets: %w", err)
+		}
+		owner := sql.NullInt64{Int64: int64(userID), Valid: true}
+		account, err := q.GetAccountByOwnerForUpdate(ctx, owner)
+		hasAccount := err == nil
+		if err != nil && err != sql.ErrNoRows {
+			return nil, fmt.Errorf("lock account: %w", err)
+		}
+
+		result := &PrimaryRepairResponse{Violations: make([]string, 0)}
+		var primary *db.Wallet
+		for i := range wallets {
+			if wallets[i].IsPrimary {
+				primary = &wallets[i]
+			}
+		}
+		previous := primary
+
+		// 2. Unverified primary → clear
+		if primary != nil && !primary.IsVerified {
+			result.Violations = append(result.Violations, ViolationUnverifiedPrimary)
+			if err := q.ClearPrimaryWallet(ctx, userID); err != nil {
+				return nil, fmt.Errorf("clear primary wallet: %w", err)
+			}
+			primary = nil
+		}
+
+		// 3. No primary → account's wallet if verified, otherwise the oldest verified wallet
+		if primary == nil {
+			candidate := primaryCandidate(wallets, account.PrimaryWalletID, hasAccount)
+			if candidate != nil {
+				if previous == nil {
+					result.Violations = append(result.Violations, ViolationNoPrimary)
+				}
+				updated, err := q.SetWalletPrimary(ctx, db.SetWalletPrimaryParams{ID: candidate.ID, UserID: userID})
+				if err != nil {
+					return nil, fmt.Errorf("set primary wallet: %w", err)
+				}
+				if affected, _ := updated.RowsAffected(); affected == 0 {
+					return nil, fmt.Errorf("set primary wallet: wallet %d not updated", candidate.ID)
+				}
+				primary = candidate
+			}
+		}
+
+		// 4. Align account primary_wallet_id
+		if hasAccount && !accountPointsTo(account, primary) {
+			result.Violations = append(result.Violations, ViolationAccountMismatch)
+			if primary != nil {
+				err = q.UpdateAccountPrimaryWallet(ctx, db.UpdateAccountPrimaryWalletParams{
+					PrimaryWalletID: sql.NullInt64{Int64: int64(primary.ID), Valid: true},
+					OwnerID:         owner,
+				})
+			} else {
+				err = q.ClearAccountPrimaryWallet(ctx, owner)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("update account primary wallet: %w", err)
+			}
+		}
+
+		if primary != nil {
+			result.PrimaryWalletID = primary.ExternalID
+		}
+		if len(result.Violations) == 0 {
+			// 조회 이후 다른 트랜잭션이 이미 정리함
+			return result, nil
+		}
+
+		// 5. Audit (same transaction)
+		if err := audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionPrimaryRepaired,
+			ResourceType: resourceUser,
+			ResourceID:   userID,
+			OldValue:     map[string]any{"primary_wallet_id": walletExternalID(previous), "account_primary_wallet_id": account.PrimaryWalletID.Int64},
+			NewValue:     map[string]any{"primary_wallet_id": result.PrimaryWalletID, "violations": result.Violations},
+		}); err != nil {
+			return nil, fmt.Errorf("record audit log: %w", err)
+		}
+
+		result.Repaired = true
+		logctx.From(ctx, r.logger).Warn("primary wallet repaired",
+			zap.Uint64("user_id", userID),
+			zap.Strings("violations", result.Violations),
+			zap.String("primary_wallet_external_id", result.PrimaryWalletID),
+		)
+		return result, nil
+	})
+}
+
+// primaryCandidate picks the wallet to promote: the account's wallet when verified, otherwise
+// the oldest verified wallet (wallets are ordered by created_at)
+func primaryCandidate(wallets []db.Wallet, accountWalletID sql.NullInt64, hasAccount bool) *db.Wallet {
+	var oldest *db.Wallet
+	for i := range wallets {
+		if !wallets[i].IsVerified {
+			continue
+		}
+		if hasAccount && accountWalletID.Valid && uint64(accountWalletID.Int64) == wallets[i].ID {
+			return &wallets[i]
+		}
+		if oldest == nil {
+			oldest = &wallets[i]
+		}
+	}
+	return oldest
+}
+
+// accountPointsTo reports whether the account's primary_wallet_id matches the primary wallet (nil = none)
+func accountPointsTo(account db.Account, primary *db.Wallet) bool {
+	if primary == nil {
+		return !account.PrimaryWalletID.Valid
+	}
+	return account.PrimaryWalletID.Valid && uint64(account.PrimaryWalletID.Int64) == primary.ID
+}
+
+// primaryViolations derives the violations of a report row
+func primaryViolations(row *db.ListPrimaryWalletViolationsRow) []string {
+	violations := make([]string, 0, 2)
+	if !row.PrimaryWalletID.Valid && row.VerifiedWallets > 0 {
+		violations = append(violations, ViolationNoPrimary)
+	}
+	if row.PrimaryVerified.Valid && !row.PrimaryVerified.Bool {
+		violations = append(violations, ViolationUnverifiedPrimary)
+	}
+	if row.AccountID.Valid && row.AccountWalletExternalID.String != row.PrimaryWalletExternalID.String {
+		violations = append(violations, ViolationAccountMismatch)
+	}
+	return violations
+}
+
+// walletExternalID returns the wallet's external ID ("" = none)
+func walletExternalID(wallet *db.Wallet) string {
+	if wallet == nil {
+		return ""
+	}
+	return wallet.ExternalID
+}
+
+// encodeUserCursor encodes the last user of a violation page
+func encodeUserCursor(userID uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(userID, 10)))
+}
+
+// decodeUserCursor parses a cursor returned by encodeUserCursor
+func decodeUserCursor(cursor string) (uint64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("decode cursor: %w", err)
+	}
+	id, err := strconv.ParseUint(string(raw), 10, 64)
+	if err != nil || id == 0 {
+		return 0, fmt.Errorf("parse cursor: %q", raw)
+	}
+	return id, nil
+}
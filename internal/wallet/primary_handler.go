@@ -0,0 +1,94 @@
+package wallet
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// PrimaryHandler handles admin requests for primary wallet consistency
+type PrimaryHandler struct {
+	repairer *PrimaryRepairer
+}
+
+// NewPrimaryHandler creates a new primary wallet consistency handler
+func NewPrimaryHandler(repairer *PrimaryRepairer) *PrimaryHandler {
+	return &PrimaryHandler{repairer: repairer}
+}
+
+// RegisterRoutes registers primary wallet consistency routes on the router group (admin only)
+func (h *PrimaryHandler) RegisterRoutes(rg *gin.RouterGroup) {
+	primary := rg.Group("/admin/wallets/primary", middleware.RequireRoles(middleware.RoleAdmin))
+	{
+		primary.GET("/violations", h.ListViolations)
+		primary.POST("/users/:id/repair", h.RepairUser)
+	}
+}
+
+// ListViolations godoc
+// @Summary List primary wallet violations
+// @Description Report users whose primary wallet state is inconsistent - Admin only.
+// @Description NO_PRIMARY: verified wallets but no primary / UNVERIFIED_PRIMARY: primary is not verified /
+// @Description ACCOUNT_MISMATCH: the account's primary wallet differs. Duplicate primaries are prevented by a unique constraint.
+// @Description Violations are repaired periodically by a background job or per user via the repair endpoint.
+// @Tags wallets
+// @Produce json
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query int false "Page size (max 500)" default(100)
+// @Success 200 {object} middleware.SuccessResponse{data=ListPrimaryViolationsResponse} "Violation page"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid cursor or limit"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/wallets/primary/violations [get]
+func (h *PrimaryHandler) ListViolations(c *gin.Context) {
+	var req ListPrimaryViolationsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.repairer.ListViolations(c.Request.Context(), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// RepairUser godoc
+// @Summary Repair a user's primary wallet
+// @Description Re-check and repair the user's primary wallet state now (same rules as the background job) - Admin only.
+// @Description An unverified primary is cleared; a user without a primary gets the account's wallet (when verified)
+// @Description or the oldest verified wallet; the account's primary wallet is aligned. Repairs are audited.
+// @Tags wallets
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=PrimaryRepairResponse} "Repair result"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/wallets/primary/users/{id}/repair [post]
+func (h *PrimaryHandler) RepairUser(c *gin.Context) {
+	userExternalID, err := extractAndValidateUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	result, err := h.repairer.RepairUser(c.Request.Context(), userExternalID, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
@@ -2,15 +2,30 @@ package wallet
 
 import (
 	"context"
+	"crypto/rand"
 	"database/sql"
 	"encoding/hex"
+	"encoding/json"
 	stderrors "errors"
+	"fmt"
+	"math/big"
 	"strings"
+	"time"
+	"unicode/utf8"
 
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/chaintx"
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/outbox"
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/webhook"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/cache"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/eip712"
 	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/screening"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
@@ -19,33 +34,94 @@ import (
 
 const (
 	mysqlErrDuplicateEntry = 1062
+
+	// microTransferTTL is how long a micro-transfer challenge can be confirmed
+	microTransferTTL = 72 * time.Hour
+	// microTransferMaxAttempts limits amount guesses per challenge
+	microTransferMaxAttempts = 5
+	// microTransferMaxChallenges caps the challenges sent to a wallet over its lifetime
+	// (만료/시도 초과 후 재발급 반복으로 signer 지갑 잔액 유출 방지)
+	microTransferMaxChallenges = 3
+	// microTransferScale is the number of decimals of the challenge amount (0.001000 ~ 0.009999)
+	microTransferScale = 6
+
+	// watchOnlyCoolingOff delays payouts to newly attested watch-only wallets
+	// (계정 탈취 후 즉시 출금 주소 교체 공격 완화)
+	watchOnlyCoolingOff = 48 * time.Hour
+
+	// maxLabelLength mirrors the label binding rule of single registration (max=50)
+	maxLabelLength = 50
+
+	// maxSignatureHexLength bounds contract wallet (ERC-1271) signatures to 2048 bytes
+	maxSignatureHexLength = 4096
+
+	// balanceCacheTTL is how long an on-chain balance is served from cache (RPC 부하 완화)
+	balanceCacheTTL = 15 * time.Second
+)
+
+// Audit log identifiers
+const (
+	actionScreeningBlocked = "WALLET_SCREENING_BLOCKED"
+	resourceUser           = "USER"
 )
 
+// Screened operations (recorded with blocked screening results)
+const (
+	screenedRegistration  = "REGISTRATION"
+	screenedMicroTransfer = "MICRO_TRANSFER"
+	screenedRestore       = "RESTORE"
+)
+
+// verificationLevelRank orders verification levels from weakest to strongest
+var verificationLevelRank = map[db.WalletsVerificationLevel]int{
+	db.WalletsVerificationLevelNONE:          0,
+	db.WalletsVerificationLevelATTESTED:      1,
+	db.WalletsVerificationLevelSIGNATURE:     2,
+	db.WalletsVerificationLevelMICROTRANSFER: 3,
+}
+
 // Service handles wallet business logic
 type Service struct {
-	txRunner *pkgdb.TxRunner
-	verifier eip712.Verifier
-	logger   *zap.Logger
+	txRunner    *pkgdb.TxRunner
+	verifier    eip712.Verifier
+	chainClient chain.Client
+	networks    *chain.Registry
+	balances    cache.Store
+	screener    screening.Screener
+	logger      *zap.Logger
 }
 
 // NewService creates a new wallet service
-func NewService(txRunner *pkgdb.TxRunner, verifier eip712.Verifier, logger *zap.Logger) *Service {
+// chainClient may be nil (micro-transfer verification disabled)
+// networks lists the chains wallets may be registered on
+// balances caches on-chain balance lookups
+// screener screens addresses before registration and payouts
+func NewService(txRunner *pkgdb.TxRunner, verifier eip712.Verifier, chainClient chain.Client, networks *chain.Registry, balances cache.Store, screener screening.Screener, logger *zap.Logger) *Service {
 	return &Service{
-		txRunner: txRunner,
-		verifier: verifier,
-		logger:   logger,
+		txRunner:    txRunner,
+		verifier:    verifier,
+		chainClient: chainClient,
+		networks:    networks,
+		balances:    balances,
+		screener:    screener,
+		logger:      logger,
 	}
 }
 
 // RegisterWallet registers a new wallet for a user
-func (s *Service) RegisterWallet(ctx context.Context, userExternalID string, req *RegisterWalletRequest) (*db.Wallet, error) {
-	// 1. Validate address format
-	if err := ValidateEthereumAddress(req.Address); err != nil {
+// Addresses flagged by sanctions screening are rejected (COMPLIANCE_BLOCKED) and audit-logged.
+func (s *Service) RegisterWallet(ctx context.Context, userExternalID string, req *RegisterWalletRequest, actor audit.Actor) (*db.Wallet, error) {
+	// 1. Validate chain
+	chainID, err := s.resolveChainID(req.ChainID)
+	if err != nil {
 		return nil, err
 	}
 
-	// 2. Normalize address to lowercase
-	address := strings.ToLower(req.Address)
+	// 2. Validate and normalize address (ENS 이름은 체인 클라이언트로 해석)
+	address, ensName, err := s.resolveAddress(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
 
 	// 3. Get user by external ID
 	user, err := s.txRunner.Queries().GetUserByExternalID(ctx, sql.NullString{String: userExternalID, Valid: true})
@@ -53,28 +129,115 @@ func (s *Service) RegisterWallet(ctx context.Context, userExternalID string, req
 		if err == sql.ErrNoRows {
 			return nil, errors.NotFound("User")
 		}
-		s.logger.Error("failed to get user", zap.Error(err))
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
 		return nil, errors.DBError(err)
 	}
 
-	// 4. Create wallet (UNIQUE 충돌 시 409로 처리)
+	// 4. Sanctions screening
+	if err := s.screenAddress(ctx, actor, user.ID, chainID, address, screenedRegistration); err != nil {
+		return nil, err
+	}
+
+	// 5. Create wallet (UNIQUE(address, chain_id) 충돌 시 409로 처리)
 	walletExternalID := uuid.New().String()
 	label := sql.NullString{}
 	if req.Label != "" {
 		label = sql.NullString{String: req.Label, Valid: true}
 	}
 
-	result, err := s.txRunner.Queries().CreateWallet(ctx, db.CreateWalletParams{
+	result, err := s.txRunner.Queries().CreateWallet(ctx, withENSName(db.CreateWalletParams{
 		ExternalID: walletExternalID,
 		UserID:     user.ID,
 		Address:    address,
+		ChainID:    uint64(chainID),
 		Label:      label,
+	}, ensName))
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, errors.Conflict("Wallet address already registered")
+		}
+		logctx.From(ctx, s.logger).Error("failed to create wallet", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	walletID, err := result.LastInsertId()
+	if err != nil {
+		return nil, errors.DBError(err)
+	}
+
+	// 6. Fetch and return created wallet
+	wallet, err := s.txRunner.Queries().GetWalletByID(ctx, uint64(walletID))
+	if err != nil {
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("wallet registered",
+		zap.String("wallet_external_id", walletExternalID),
+		zap.String("address", address),
+		zap.String("ens_name", ensName),
+		zap.Int64("chain_id", chainID),
+		zap.String("user_external_id", userExternalID),
+	)
+
+	return &wallet, nil
+}
+
+// RegisterWatchOnlyWallet registers a receive-only wallet attested by the account owner
+// instead of a signature (e.g. exchange deposit addresses).
+// The actor is the authenticated user vouching for the address.
+func (s *Service) RegisterWatchOnlyWallet(ctx context.Context, userExternalID string, req *RegisterWatchOnlyWalletRequest, actor audit.Actor) (*db.Wallet, error) {
+	if !req.Attested {
+		return nil, errors.InvalidInput("Owner attestation is required for watch-only wallets")
+	}
+
+	// 1. Validate address format
+	if err := ValidateEthereumAddress(req.Address); err != nil {
+		return nil, err
+	}
+	chainID, err := s.resolveChainID(req.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Normalize address to lowercase
+	address := strings.ToLower(req.Address)
+
+	// 3. Get user by external ID
+	user, err := s.txRunner.Queries().GetUserByExternalID(ctx, sql.NullString{String: userExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("User")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	// 4. Sanctions screening
+	if err := s.screenAddress(ctx, actor, user.ID, chainID, address, screenedRegistration); err != nil {
+		return nil, err
+	}
+
+	// 5. Create watch-only wallet (cooling-off 시작)
+	walletExternalID := uuid.New().String()
+	label := sql.NullString{}
+	if req.Label != "" {
+		label = sql.NullString{String: req.Label, Valid: true}
+	}
+
+	result, err := s.txRunner.Queries().CreateWatchOnlyWallet(ctx, db.CreateWatchOnlyWalletParams{
+		ExternalID:      walletExternalID,
+		UserID:          user.ID,
+		Address:         address,
+		ChainID:         uint64(chainID),
+		Label:           label,
+		AttestedBy:      sql.NullInt64{Int64: int64(actor.ID), Valid: true},
+		ReceivableAfter: sql.NullTime{Time: time.Now().Add(watchOnlyCoolingOff), Valid: true},
 	})
 	if err != nil {
 		if isDuplicateKeyError(err) {
 			return nil, errors.Conflict("Wallet address already registered")
 		}
-		s.logger.Error("failed to create wallet", zap.Error(err))
+		logctx.From(ctx, s.logger).Error("failed to create watch-only wallet", zap.Error(err))
 		return nil, errors.DBError(err)
 	}
 
@@ -83,21 +246,154 @@ func (s *Service) RegisterWallet(ctx context.Context, userExternalID string, req
 		return nil, errors.DBError(err)
 	}
 
-	// 5. Fetch and return created wallet
+	// 6. Fetch and return created wallet
 	wallet, err := s.txRunner.Queries().GetWalletByID(ctx, uint64(walletID))
 	if err != nil {
 		return nil, errors.DBError(err)
 	}
 
-	s.logger.Info("wallet registered",
+	logctx.From(ctx, s.logger).Info("watch-only wallet registered",
 		zap.String("wallet_external_id", walletExternalID),
 		zap.String("address", address),
+		zap.Int64("chain_id", chainID),
 		zap.String("user_external_id", userExternalID),
+		zap.Uint64("attested_by", actor.ID),
 	)
 
 	return &wallet, nil
 }
 
+// RegisterWalletBatch registers many wallets for a user in a single transaction.
+// Each row is validated independently; invalid or duplicate rows are reported
+// per row and skipped while the rest are committed together.
+// Rows flagged by sanctions screening fail with a compliance error and are audit-logged.
+// NOTE: 중복 주소 INSERT 실패는 InnoDB에서 해당 statement만 롤백 → 트랜잭션은 계속 진행
+func (s *Service) RegisterWalletBatch(ctx context.Context, userExternalID string, req *RegisterWalletBatchRequest, actor audit.Actor) (*RegisterWalletBatchResponse, error) {
+	user, err := s.txRunner.Queries().GetUserByExternalID(ctx, sql.NullString{String: userExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("User")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	// Resolve ENS names and screen every row before the transaction (외부 호출 동안 트랜잭션 유지 방지)
+	resolved := make([]resolvedAddress, len(req.Wallets))
+	screened := make(map[string]*screening.Result, len(req.Wallets))
+	for i, item := range req.Wallets {
+		address, ensName, err := s.resolveAddress(ctx, item.Address)
+		resolved[i] = resolvedAddress{address: address, ensName: ensName, err: err}
+		if err != nil {
+			continue // 행 검증에서 실패 처리
+		}
+		chainID, err := s.resolveChainID(item.ChainID)
+		if err != nil {
+			continue
+		}
+		key := fmt.Sprintf("%d:%s", chainID, address)
+		if _, ok := screened[key]; ok {
+			continue
+		}
+		if screened[key], err = s.screen(ctx, chainID, address); err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*RegisterWalletBatchResponse, error) {
+		result := &RegisterWalletBatchResponse{
+			Results: make([]WalletBatchResult, 0, len(req.Wallets)),
+		}
+		// 같은 주소라도 체인이 다르면 별개 지갑
+		seen := make(map[string]bool, len(req.Wallets))
+
+		for i, item := range req.Wallets {
+			row := WalletBatchResult{Index: i, Address: item.Address, ChainID: item.ChainID}
+
+			// 1. Validate row
+			address := resolved[i].address
+			chainID, chainErr := s.resolveChainID(item.ChainID)
+			key := fmt.Sprintf("%d:%s", chainID, address)
+			if err := resolved[i].err; err != nil {
+				row.Error = errors.From(err).Message
+			} else if chainErr != nil {
+				row.Error = errors.From(chainErr).Message
+			} else if utf8.RuneCountInString(item.Label) > maxLabelLength {
+				row.Error = fmt.Sprintf("Label must be at most %d characters", maxLabelLength)
+			} else if seen[key] {
+				row.Error = "Duplicate address in batch"
+			}
+			if row.Error != "" {
+				result.Results = append(result.Results, row)
+				result.Failed++
+				continue
+			}
+			seen[key] = true
+			row.ChainID = chainID
+
+			// 2. Reject flagged addresses (screened before the transaction)
+			if flagged := screened[key]; flagged.Flagged {
+				if err := s.recordBlocked(ctx, q, actor, user.ID, chainID, address, screenedRegistration, flagged); err != nil {
+					return nil, err
+				}
+				row.Error = errors.ComplianceBlocked(flagged.Category).Message
+				result.Results = append(result.Results, row)
+				result.Failed++
+				continue
+			}
+
+			// 3. Insert (UNIQUE 충돌은 행 단위 실패로 보고)
+			label := sql.NullString{}
+			if item.Label != "" {
+				label = sql.NullString{String: item.Label, Valid: true}
+			}
+			created, err := q.CreateWallet(ctx, withENSName(db.CreateWalletParams{
+				ExternalID: uuid.New().String(),
+				UserID:     user.ID,
+				Address:    address,
+				ChainID:    uint64(chainID),
+				Label:      label,
+			}, resolved[i].ensName))
+			if err != nil {
+				if isDuplicateKeyError(err) {
+					row.Error = "Wallet address already registered"
+					result.Results = append(result.Results, row)
+					result.Failed++
+					continue
+				}
+				logctx.From(ctx, s.logger).Error("failed to create wallet", zap.Error(err), zap.Int("index", i))
+				return nil, errors.DBError(err)
+			}
+
+			walletID, err := created.LastInsertId()
+			if err != nil {
+				return nil, errors.DBError(err)
+			}
+			wallet, err := q.GetWalletByID(ctx, uint64(walletID))
+			if err != nil {
+				return nil, errors.DBError(err)
+			}
+
+			row.Wallet = ToWalletResponse(&wallet)
+			result.Results = append(result.Results, row)
+			result.Created++
+		}
+
+		return result, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logctx.From(ctx, s.logger).Info("wallet batch registered",
+		zap.String("user_external_id", userExternalID),
+		zap.Int("created", result.Created),
+		zap.Int("failed", result.Failed),
+	)
+
+	return result, nil
+}
+
 // GetWallet retrieves a wallet by external ID with ownership verification
 func (s *Service) GetWallet(ctx context.Context, userExternalID, walletExternalID string) (*db.Wallet, error) {
 	wallet, err := s.txRunner.Queries().GetWalletByExternalIDAndUser(ctx, db.GetWalletByExternalIDAndUserParams{
@@ -108,24 +404,71 @@ func (s *Service) GetWallet(ctx context.Context, userExternalID, walletExternalI
 		if err == sql.ErrNoRows {
 			return nil, errors.NotFound("Wallet")
 		}
-		s.logger.Error("failed to get wallet", zap.Error(err))
+		logctx.From(ctx, s.logger).Error("failed to get wallet", zap.Error(err))
 		return nil, errors.DBError(err)
 	}
 	return &wallet, nil
 }
 
-// ListWallets retrieves all wallets for a user
-func (s *Service) ListWallets(ctx context.Context, userExternalID string) (*ListWalletsResponse, error) {
-	wallets, err := s.txRunner.Queries().ListWalletsByUserExternalID(ctx, sql.NullString{String: userExternalID, Valid: true})
+// ListWallets retrieves all wallets for a user, optionally on a single chain
+func (s *Service) ListWallets(ctx context.Context, userExternalID string, req *ListWalletsRequest) (*ListWalletsResponse, error) {
+	chainFilter := sql.NullInt64{}
+	if req.ChainID != 0 {
+		if _, ok := s.networks.Get(req.ChainID); !ok {
+			return nil, errors.InvalidInput("Unsupported chain_id")
+		}
+		chainFilter = sql.NullInt64{Int64: req.ChainID, Valid: true}
+	}
+
+	params := db.ListWalletsPageByUserExternalIDParams{
+		UserExternalID: sql.NullString{String: userExternalID, Valid: true},
+		ChainID:        chainFilter,
+		IsVerified:     nullBool(req.IsVerified),
+		IsPrimary:      nullBool(req.IsPrimary),
+		Limit:          int32(req.Limit + 1), // +1: 다음 페이지 존재 여부 확인
+	}
+	if req.Cursor != "" {
+		cursor, err := decodeWalletCursor(req.Cursor)
+		if err != nil {
+			return nil, errors.InvalidInput("Invalid cursor")
+		}
+		params.AfterCreatedAt = sql.NullTime{Time: cursor.CreatedAt, Valid: true}
+		params.AfterID = cursor.ID
+	}
+
+	wallets, err := s.txRunner.Queries().ListWalletsPageByUserExternalID(ctx, params)
 	if err != nil {
-		s.logger.Error("failed to list wallets", zap.Error(err))
+		logctx.From(ctx, s.logger).Error("failed to list wallets", zap.Error(err))
 		return nil, errors.DBError(err)
 	}
 
-	return &ListWalletsResponse{
-		Wallets: ToWalletResponseList(wallets),
-		Total:   int64(len(wallets)),
-	}, nil
+	total, err := s.txRunner.Queries().CountWalletsByUserExternalID(ctx, db.CountWalletsByUserExternalIDParams{
+		UserExternalID: params.UserExternalID,
+		ChainID:        params.ChainID,
+		IsVerified:     params.IsVerified,
+		IsPrimary:      params.IsPrimary,
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count wallets", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	response := &ListWalletsResponse{Total: total}
+	if len(wallets) > req.Limit {
+		wallets = wallets[:req.Limit]
+		response.HasMore = true
+		response.NextCursor = encodeWalletCursor(&wallets[len(wallets)-1])
+	}
+	response.Wallets = ToWalletResponseList(wallets)
+	return response, nil
+}
+
+// nullBool converts an optional boolean filter
+func nullBool(value *bool) sql.NullBool {
+	if value == nil {
+		return sql.NullBool{}
+	}
+	return sql.NullBool{Bool: *value, Valid: true}
 }
 
 // UpdateLabel updates wallet label
@@ -143,7 +486,7 @@ func (s *Service) UpdateLabel(ctx context.Context, userExternalID, walletExterna
 		UserID: wallet.UserID,
 	})
 	if err != nil {
-		s.logger.Error("failed to update label", zap.Error(err))
+		logctx.From(ctx, s.logger).Error("failed to update label", zap.Error(err))
 		return nil, errors.DBError(err)
 	}
 
@@ -157,6 +500,164 @@ func (s *Service) UpdateLabel(ctx context.Context, userExternalID, walletExterna
 	return s.GetWallet(ctx, userExternalID, walletExternalID)
 }
 
+// cachedBalance is the cached form of an on-chain balance lookup
+type cachedBalance struct {
+	Raw  string    `json:"raw"`
+	AsOf time.Time `json:"as_of"`
+}
+
+// GetBalance returns the settlement token balance of the wallet from the chain (balanceOf).
+// Results are cached for balanceCacheTTL; cache failures fall back to the RPC node.
+func (s *Service) GetBalance(ctx context.Context, userExternalID, walletExternalID string) (*WalletBalanceResponse, error) {
+	if s.chainClient == nil {
+		return nil, errors.ChainError("On-chain balance lookup is not enabled")
+	}
+
+	// Get wallet with ownership check
+	wallet, err := s.GetWallet(ctx, userExternalID, walletExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if int64(wallet.ChainID) != s.chainClient.ChainID() {
+		return nil, errors.InvalidInput("On-chain balance is not available on this wallet's chain")
+	}
+
+	response := &WalletBalanceResponse{
+		Address:      wallet.Address,
+		ChainID:      int64(wallet.ChainID),
+		TokenAddress: s.chainClient.TokenAddress(),
+		Decimals:     s.chainClient.TokenDecimals(),
+	}
+	key := fmt.Sprintf("wallet:balance:%d:%s:%s", wallet.ChainID, strings.ToLower(response.TokenAddress), wallet.Address)
+
+	// 1. Cache hit
+	if raw, err := s.balances.Get(ctx, key); err == nil {
+		var cached cachedBalance
+		if err := json.Unmarshal(raw, &cached); err == nil {
+			if balance, ok := new(big.Int).SetString(cached.Raw, 10); ok {
+				return fillBalance(response, balance, cached.AsOf), nil
+			}
+		}
+	} else if !stderrors.Is(err, cache.ErrNotFound) {
+		logctx.From(ctx, s.logger).Warn("balance cache lookup failed", zap.Error(err))
+	}
+
+	// 2. RPC balanceOf
+	balance, err := s.chainClient.TokenBalance(ctx, wallet.Address)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to get token balance",
+			zap.String("wallet_external_id", walletExternalID),
+			zap.Error(err),
+		)
+		return nil, errors.ChainError("Failed to get on-chain balance")
+	}
+	asOf := time.Now().UTC()
+
+	// 3. Cache (실패해도 응답에는 영향 없음)
+	if raw, err := json.Marshal(cachedBalance{Raw: balance.String(), AsOf: asOf}); err == nil {
+		if err := s.balances.Set(ctx, key, raw, balanceCacheTTL); err != nil {
+			logctx.From(ctx, s.logger).Warn("failed to cache balance", zap.Error(err))
+		}
+	}
+
+	return fillBalance(response, balance, asOf), nil
+}
+
+// ListTransfers lists indexed token transfers involving the wallet (newest first):
+// deposits sent from the wallet (OUT), withdrawals and micro-transfers paid to it (IN).
+// Transfers are indexed on the platform chain only.
+func (s *Service) ListTransfers(ctx context.Context, userExternalID, walletExternalID string, req *ListWalletTransfersRequest) (*ListWalletTransfersResponse, error) {
+	// Get wallet with ownership check
+	wallet, err := s.GetWallet(ctx, userExternalID, walletExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if int64(wallet.ChainID) != s.networks.DefaultChainID() {
+		return nil, errors.InvalidInput("On-chain activity is not indexed on this wallet's chain")
+	}
+
+	// 방향 필터: 제외할 쪽 ID를 0으로 (쿼리 NOTE 참고)
+	outUserID, inUserID, inWalletID := wallet.UserID, wallet.UserID, wallet.ID
+	switch req.Direction {
+	case TransferDirectionIn:
+		outUserID = 0
+	case TransferDirectionOut:
+		inUserID, inWalletID = 0, 0
+	}
+
+	rows, err := s.txRunner.Queries().ListWalletTransfers(ctx, db.ListWalletTransfersParams{
+		OutUserID:  outUserID,
+		Address:    wallet.Address,
+		InUserID:   inUserID,
+		InWalletID: inWalletID,
+		Limit:      int32(req.PageSize),
+		Offset:     int32((req.Page - 1) * req.PageSize),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list wallet transfers", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := s.txRunner.Queries().CountWalletTransfers(ctx, db.CountWalletTransfersParams{
+		OutUserID:  outUserID,
+		Address:    wallet.Address,
+		InUserID:   inUserID,
+		InWalletID: inWalletID,
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count wallet transfers", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	transfers := make([]WalletTransferResponse, 0, len(rows))
+	for i := range rows {
+		transfers = append(transfers, ToWalletTransferResponse(&rows[i]))
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &ListWalletTransfersResponse{
+		Transfers:  transfers,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// GetVerificationChallenge issues a server-generated nonce and the typed data to sign.
+// The nonce is pre-reserved for the wallet address, so VerifyWallet accepts it exactly once.
+func (s *Service) GetVerificationChallenge(ctx context.Context, userExternalID, walletExternalID string) (*eip712.Challenge, error) {
+	// 1. Get wallet with ownership check
+	wallet, err := s.GetWallet(ctx, userExternalID, walletExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Only unverified wallets with a private key can answer the challenge
+	if wallet.IsVerified {
+		return nil, errors.InvalidInput("Wallet already verified")
+	}
+	if wallet.IsWatchOnly {
+		return nil, errors.InvalidInput("Watch-only wallets cannot be verified by signature")
+	}
+
+	// 3. Issue challenge (nonce pre-reserved in nonce store)
+	challenge, err := s.verifier.IssueChallenge(ctx, int64(wallet.ChainID), wallet.Address)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to issue verification challenge",
+			zap.String("wallet_external_id", walletExternalID),
+			zap.Error(err),
+		)
+		return nil, errors.Internal("Failed to issue verification challenge")
+	}
+
+	return challenge, nil
+}
+
 // VerifyWallet verifies wallet ownership using EIP-712 signature
 func (s *Service) VerifyWallet(ctx context.Context, userExternalID, walletExternalID string, req *VerifyWalletRequest) (*db.Wallet, error) {
 	// 1. Parse signature
@@ -181,11 +682,13 @@ func (s *Service) VerifyWallet(ctx context.Context, userExternalID, walletExtern
 		Wallet:    wallet.Address,
 		Nonce:     req.Message.Nonce,
 		Timestamp: req.Message.Timestamp,
+		ChainID:   int64(wallet.ChainID),
 	}
 
 	// 5. Verify signature (includes nonce + timestamp validation)
-	if err := s.verifier.VerifyWalletOwnership(ctx, wallet.Address, message, signature); err != nil {
-		s.logger.Warn("wallet verification failed",
+	scheme := eip712.SignatureScheme(req.SignatureScheme)
+	if err := s.verifier.VerifyWalletOwnership(ctx, wallet.Address, scheme, message, signature); err != nil {
+		logctx.From(ctx, s.logger).Warn("wallet verification failed",
 			zap.String("wallet_external_id", walletExternalID),
 			zap.String("address", wallet.Address),
 			zap.Error(err),
@@ -207,7 +710,7 @@ func (s *Service) markWalletVerified(ctx context.Context, wallet *db.Wallet) (*d
 			UserID: wallet.UserID,
 		})
 		if err != nil {
-			s.logger.Error("failed to update wallet verified", zap.Error(err))
+			logctx.From(ctx, s.logger).Error("failed to update wallet verified", zap.Error(err))
 			return nil, errors.DBError(err)
 		}
 
@@ -216,43 +719,54 @@ func (s *Service) markWalletVerified(ctx context.Context, wallet *db.Wallet) (*d
 			// Already verified - return current state
 			w, err := q.GetWalletByID(ctx, wallet.ID)
 			if err != nil {
-				s.logger.Error("failed to get wallet after no-op verify", zap.Error(err))
+				logctx.From(ctx, s.logger).Error("failed to get wallet after no-op verify", zap.Error(err))
 				return nil, errors.DBError(err)
 			}
 			return &w, nil
 		}
 
+		// Domain event (same transaction)
+		if err := writeWalletVerifiedEvent(ctx, q, wallet, db.WalletsVerificationLevelSIGNATURE); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to write outbox event", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
 		// 2. Check if this is the first verified wallet (auto-set as primary)
+		// NOTE: SetPrimary와 같은 순서로 사용자 row-lock → 동시 검증 시 uk_wallet_primary_user 충돌 방지
+		if _, err := q.GetUserForUpdate(ctx, wallet.UserID); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to lock user row", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
 		_, err = q.GetPrimaryWallet(ctx, wallet.UserID)
 		if err != nil {
 			if err == sql.ErrNoRows {
 				// No primary wallet - set this one as primary
-				s.logger.Info("auto-setting first verified wallet as primary",
+				logctx.From(ctx, s.logger).Info("auto-setting first verified wallet as primary",
 					zap.Uint64("wallet_id", wallet.ID),
 					zap.Uint64("user_id", wallet.UserID),
 				)
 
 				if err := s.setPrimaryInternal(q, wallet.ID, wallet.UserID); err != nil {
 					// Primary 설정 실패는 치명적이지 않으나 로그 남김
-					s.logger.Error("failed to auto-set primary wallet",
+					logctx.From(ctx, s.logger).Error("failed to auto-set primary wallet",
 						zap.Uint64("wallet_id", wallet.ID),
 						zap.Error(err),
 					)
 					// 검증 자체는 성공이므로 진행
 				}
 			} else {
-				s.logger.Error("failed to check primary wallet", zap.Error(err))
+				logctx.From(ctx, s.logger).Error("failed to check primary wallet", zap.Error(err))
 			}
 		}
 
 		// 3. Return updated wallet
 		updatedWallet, err := q.GetWalletByID(ctx, wallet.ID)
 		if err != nil {
-			s.logger.Error("failed to get updated wallet", zap.Error(err))
+			logctx.From(ctx, s.logger).Error("failed to get updated wallet", zap.Error(err))
 			return nil, errors.DBError(err)
 		}
 
-		s.logger.Info("wallet verified",
+		logctx.From(ctx, s.logger).Info("wallet verified",
 			zap.Uint64("wallet_id", wallet.ID),
 			zap.String("address", wallet.Address),
 		)
@@ -261,6 +775,304 @@ func (s *Service) markWalletVerified(ctx context.Context, wallet *db.Wallet) (*d
 	})
 }
 
+// InitiateMicroTransfer sends a small random token amount to a signature-verified wallet.
+// The user confirms the received amount via ConfirmMicroTransfer to reach MICRO_TRANSFER level.
+// The wallet is re-screened before every payout (목록이 등록 이후 갱신될 수 있음).
+func (s *Service) InitiateMicroTransfer(ctx context.Context, userExternalID, walletExternalID string, actor audit.Actor) (*db.WalletMicroTransfer, error) {
+	if s.chainClient == nil {
+		return nil, errors.ChainError("Micro-transfer verification is not enabled")
+	}
+
+	// Get wallet with ownership check
+	wallet, err := s.GetWallet(ctx, userExternalID, walletExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !wallet.IsVerified {
+		return nil, errors.InvalidInput("Wallet must be verified by signature before micro-transfer verification")
+	}
+	if wallet.VerificationLevel == db.WalletsVerificationLevelMICROTRANSFER {
+		return nil, errors.InvalidInput("Wallet already verified by micro-transfer")
+	}
+	// 송금은 RPC가 연결된 체인에서만 가능 (다른 체인 주소로 보내면 자금 유실)
+	if int64(wallet.ChainID) != s.chainClient.ChainID() {
+		return nil, errors.InvalidInput("Micro-transfer verification is not available on this wallet's chain")
+	}
+	if err := s.screenAddress(ctx, actor, wallet.UserID, int64(wallet.ChainID), wallet.Address, screenedMicroTransfer); err != nil {
+		return nil, err
+	}
+
+	amount, baseUnits, err := randomMicroTransferAmount(s.chainClient.TokenDecimals())
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to generate micro-transfer amount", zap.Error(err))
+		return nil, errors.Internal("Failed to generate micro-transfer amount")
+	}
+
+	// 1. Reserve challenge (row-lock → 동시 요청이 중복 송금하지 않도록)
+	challenge, reused, err := s.reserveMicroTransfer(ctx, wallet, amount)
+	if err != nil {
+		return nil, err
+	}
+	if reused {
+		return challenge, nil
+	}
+
+	// 2. Broadcast transfer (DB 트랜잭션 밖에서 RPC 호출)
+	sent, err := s.chainClient.SendTokenTransfer(context.WithoutCancel(ctx), wallet.Address, baseUnits)
+	var broadcastErr *chain.BroadcastError
+	if stderrors.As(err, &broadcastErr) {
+		// 전송 여부 불명 → 보낸 것으로 추적 (미전송이면 chaintx.Monitor가 같은 nonce로 재전송)
+		logctx.From(ctx, s.logger).Warn("micro-transfer broadcast unacknowledged, tracking it as sent",
+			zap.String("wallet_external_id", walletExternalID),
+			zap.String("tx_hash", broadcastErr.Sent.Hash),
+			zap.Error(err),
+		)
+		sent, err = broadcastErr.Sent, nil
+	}
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to send micro-transfer",
+			zap.String("wallet_external_id", walletExternalID),
+			zap.Error(err),
+		)
+		if markErr := s.txRunner.Queries().UpdateWalletMicroTransferFailed(ctx, challenge.ID); markErr != nil {
+			logctx.From(ctx, s.logger).Error("failed to mark micro-transfer failed", zap.Error(markErr))
+		}
+		return nil, errors.ChainError("Failed to send micro-transfer")
+	}
+
+	// 3. Record tx hash + track until mined (미확정 시 chaintx.Monitor가 수수료 인상 재전송)
+	// 송금은 이미 브로드캐스트됨 → 클라이언트 연결 종료로 기록이 취소되지 않도록 WithoutCancel
+	recordCtx := context.WithoutCancel(ctx)
+	err = s.txRunner.WithTx(recordCtx, func(q *db.Queries) error {
+		if err := q.UpdateWalletMicroTransferSent(recordCtx, db.UpdateWalletMicroTransferSentParams{
+			TxHash: sql.NullString{String: sent.Hash, Valid: true},
+			ID:     challenge.ID,
+		}); err != nil {
+			return err
+		}
+		return chaintx.Record(recordCtx, q, s.chainClient.ChainID(), sent, chaintx.ReferenceWalletMicroTransfer, challenge.ID, time.Now())
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to record micro-transfer tx hash",
+			zap.String("tx_hash", sent.Hash),
+			zap.Error(err),
+		)
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("micro-transfer sent",
+		zap.String("wallet_external_id", walletExternalID),
+		zap.String("tx_hash", sent.Hash),
+	)
+
+	updated, err := s.txRunner.Queries().GetWalletMicroTransferByID(ctx, challenge.ID)
+	if err != nil {
+		return nil, errors.DBError(err)
+	}
+	return &updated, nil
+}
+
+// ReconcileMicroTransferTx applies the final outcome of a micro-transfer broadcast
+// (chaintx.Reconciler): a landed replacement updates the tx hash the user checks on chain,
+// a reverted or dropped transfer fails the challenge so a new one can be sent.
+func ReconcileMicroTransferTx(ctx context.Context, q *db.Queries, microTransferID uint64, outcome chaintx.Outcome) error {
+	if outcome.Status != db.ChainTransactionsStatusCONFIRMED {
+		return q.UpdateWalletMicroTransferUndelivered(ctx, microTransferID)
+	}
+	return q.UpdateWalletMicroTransferTxHash(ctx, db.UpdateWalletMicroTransferTxHashParams{
+		TxHash: sql.NullString{String: outcome.TxHash, Valid: true},
+		ID:     microTransferID,
+	})
+}
+
+// reserveMicroTransfer creates a PENDING challenge, or returns the outstanding one (reused=true)
+func (s *Service) reserveMicroTransfer(ctx context.Context, wallet *db.Wallet, amount string) (*db.WalletMicroTransfer, bool, error) {
+	type reservation struct {
+		challenge *db.WalletMicroTransfer
+		reused    bool
+	}
+
+	res, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*reservation, error) {
+		// 1. Lock wallet row
+		if _, err := q.GetWalletForUpdate(ctx, db.GetWalletForUpdateParams{
+			ID:     wallet.ID,
+			UserID: wallet.UserID,
+		}); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to lock wallet row", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		// 2. Outstanding challenge - reuse instead of sending funds again
+		latest, err := q.GetLatestWalletMicroTransfer(ctx, wallet.ID)
+		if err != nil && err != sql.ErrNoRows {
+			logctx.From(ctx, s.logger).Error("failed to get latest micro-transfer", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		if err == nil && time.Now().Before(latest.ExpiresAt) {
+			switch {
+			case latest.Status == db.WalletMicroTransfersStatusPENDING:
+				return nil, errors.Conflict("Micro-transfer already in progress")
+			case latest.Status == db.WalletMicroTransfersStatusSENT && latest.Attempts < microTransferMaxAttempts:
+				return &reservation{challenge: &latest, reused: true}, nil
+			}
+		}
+
+		// 3. Lifetime cap (송금된 챌린지만 집계)
+		sentCount, err := q.CountWalletMicroTransfersSent(ctx, wallet.ID)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to count micro-transfers", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		if sentCount >= microTransferMaxChallenges {
+			return nil, errors.InvalidInput("Micro-transfer limit reached for this wallet. Contact support.")
+		}
+
+		// 4. Create new challenge
+		result, err := q.CreateWalletMicroTransfer(ctx, db.CreateWalletMicroTransferParams{
+			WalletID:  wallet.ID,
+			Amount:    amount,
+			ExpiresAt: time.Now().Add(microTransferTTL),
+		})
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to create micro-transfer", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		id, err := result.LastInsertId()
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+
+		created, err := q.GetWalletMicroTransferByID(ctx, uint64(id))
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+		return &reservation{challenge: &created}, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return res.challenge, res.reused, nil
+}
+
+// ConfirmMicroTransfer checks the amount the user observed on-chain and upgrades
+// the wallet to MICRO_TRANSFER verification level on match
+func (s *Service) ConfirmMicroTransfer(ctx context.Context, userExternalID, walletExternalID string, req *ConfirmMicroTransferRequest) (*db.Wallet, error) {
+	// Get wallet with ownership check
+	wallet, err := s.GetWallet(ctx, userExternalID, walletExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Already confirmed - idempotent success
+	if wallet.VerificationLevel == db.WalletsVerificationLevelMICROTRANSFER {
+		return wallet, nil
+	}
+
+	submitted, err := chain.ParseUnits(req.Amount, microTransferScale)
+	if err != nil {
+		return nil, errors.InvalidInput("Invalid amount format")
+	}
+
+	matched, err := pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (bool, error) {
+		// 1. Lock latest challenge
+		challenge, err := q.GetLatestWalletMicroTransferForUpdate(ctx, wallet.ID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return false, errors.NotFound("Micro-transfer")
+			}
+			logctx.From(ctx, s.logger).Error("failed to lock micro-transfer", zap.Error(err))
+			return false, errors.DBError(err)
+		}
+
+		// 2. Validate challenge state
+		if challenge.Status != db.WalletMicroTransfersStatusSENT {
+			return false, errors.InvalidStateTransition(string(challenge.Status), "CONFIRMED")
+		}
+		if time.Now().After(challenge.ExpiresAt) {
+			return false, errors.InvalidInput("Micro-transfer expired. Request a new one.")
+		}
+		if challenge.Attempts >= microTransferMaxAttempts {
+			return false, errors.InvalidInput("Too many attempts. Request a new micro-transfer.")
+		}
+
+		expected, err := chain.ParseUnits(challenge.Amount, microTransferScale)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("invalid stored micro-transfer amount", zap.Uint64("id", challenge.ID), zap.Error(err))
+			return false, errors.Internal("Invalid micro-transfer state")
+		}
+
+		// 3. Mismatch - count attempt (commit, then report)
+		if expected.Cmp(submitted) != 0 {
+			if err := q.IncrementWalletMicroTransferAttempts(ctx, challenge.ID); err != nil {
+				return false, errors.DBError(err)
+			}
+			return false, nil
+		}
+
+		// 4. Match - confirm challenge + upgrade wallet
+		if _, err := q.UpdateWalletMicroTransferConfirmed(ctx, challenge.ID); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to confirm micro-transfer", zap.Error(err))
+			return false, errors.DBError(err)
+		}
+
+		result, err := q.UpdateWalletVerificationLevelMicroTransfer(ctx, db.UpdateWalletVerificationLevelMicroTransferParams{
+			ID:     wallet.ID,
+			UserID: wallet.UserID,
+		})
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to update wallet verification level", zap.Error(err))
+			return false, errors.DBError(err)
+		}
+		if affected, _ := result.RowsAffected(); affected == 0 {
+			return false, errors.NotFound("Wallet")
+		}
+
+		// 5. Domain event (same transaction)
+		if err := writeWalletVerifiedEvent(ctx, q, wallet, db.WalletsVerificationLevelMICROTRANSFER); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to write outbox event", zap.Error(err))
+			return false, errors.DBError(err)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !matched {
+		logctx.From(ctx, s.logger).Warn("micro-transfer amount mismatch",
+			zap.String("wallet_external_id", walletExternalID),
+		)
+		return nil, errors.InvalidInput("Micro-transfer amount does not match")
+	}
+
+	logctx.From(ctx, s.logger).Info("wallet verified by micro-transfer",
+		zap.String("wallet_external_id", walletExternalID),
+	)
+
+	return s.GetWallet(ctx, userExternalID, walletExternalID)
+}
+
+// GetMicroTransfer retrieves the latest micro-transfer challenge of a wallet
+func (s *Service) GetMicroTransfer(ctx context.Context, userExternalID, walletExternalID string) (*db.WalletMicroTransfer, error) {
+	wallet, err := s.GetWallet(ctx, userExternalID, walletExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge, err := s.txRunner.Queries().GetLatestWalletMicroTransfer(ctx, wallet.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Micro-transfer")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get micro-transfer", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &challenge, nil
+}
+
 // setPrimaryInternal sets primary wallet within a transaction (internal helper)
 func (s *Service) setPrimaryInternal(q *db.Queries, walletID, userID uint64) error {
 	// Clear existing primary
@@ -317,7 +1129,7 @@ func (s *Service) SetPrimary(ctx context.Context, userExternalID, walletExternal
 		if err == sql.ErrNoRows {
 			return nil, errors.NotFound("User")
 		}
-		s.logger.Error("failed to get user for set primary", zap.Error(err))
+		logctx.From(ctx, s.logger).Error("failed to get user for set primary", zap.Error(err))
 		return nil, errors.DBError(err)
 	}
 
@@ -325,7 +1137,7 @@ func (s *Service) SetPrimary(ctx context.Context, userExternalID, walletExternal
 		// 1. Lock user row
 		_, err := q.GetUserForUpdate(ctx, user.ID)
 		if err != nil {
-			s.logger.Error("failed to lock user row", zap.Error(err))
+			logctx.From(ctx, s.logger).Error("failed to lock user row", zap.Error(err))
 			return nil, errors.DBError(err)
 		}
 
@@ -335,13 +1147,13 @@ func (s *Service) SetPrimary(ctx context.Context, userExternalID, walletExternal
 			UserID: wallet.UserID,
 		})
 		if err != nil {
-			s.logger.Error("failed to lock wallet row", zap.Error(err))
+			logctx.From(ctx, s.logger).Error("failed to lock wallet row", zap.Error(err))
 			return nil, errors.DBError(err)
 		}
 
 		// 3. Clear existing primary
 		if err := q.ClearPrimaryWallet(ctx, wallet.UserID); err != nil {
-			s.logger.Error("failed to clear primary wallet", zap.Error(err))
+			logctx.From(ctx, s.logger).Error("failed to clear primary wallet", zap.Error(err))
 			return nil, errors.DBError(err)
 		}
 
@@ -351,7 +1163,7 @@ func (s *Service) SetPrimary(ctx context.Context, userExternalID, walletExternal
 			UserID: wallet.UserID,
 		})
 		if err != nil {
-			s.logger.Error("failed to set primary wallet", zap.Error(err))
+			logctx.From(ctx, s.logger).Error("failed to set primary wallet", zap.Error(err))
 			return nil, errors.DBError(err)
 		}
 
@@ -365,18 +1177,18 @@ func (s *Service) SetPrimary(ctx context.Context, userExternalID, walletExternal
 			PrimaryWalletID: sql.NullInt64{Int64: int64(wallet.ID), Valid: true},
 			OwnerID:         sql.NullInt64{Int64: int64(wallet.UserID), Valid: true},
 		}); err != nil {
-			s.logger.Error("failed to update account primary wallet", zap.Error(err))
+			logctx.From(ctx, s.logger).Error("failed to update account primary wallet", zap.Error(err))
 			// 계속 진행 - 핵심은 wallet primary 설정
 		}
 
 		// 6. Return updated wallet
 		updatedWallet, err := q.GetWalletByID(ctx, wallet.ID)
 		if err != nil {
-			s.logger.Error("failed to get updated wallet", zap.Error(err))
+			logctx.From(ctx, s.logger).Error("failed to get updated wallet", zap.Error(err))
 			return nil, errors.DBError(err)
 		}
 
-		s.logger.Info("wallet set as primary",
+		logctx.From(ctx, s.logger).Info("wallet set as primary",
 			zap.String("wallet_external_id", walletExternalID),
 		)
 
@@ -395,13 +1207,13 @@ func (s *Service) DeleteWallet(ctx context.Context, userExternalID, walletExtern
 		if err == sql.ErrNoRows {
 			return errors.NotFound("Wallet")
 		}
-		s.logger.Error("failed to get wallet for delete", zap.Error(err))
+		logctx.From(ctx, s.logger).Error("failed to get wallet for delete", zap.Error(err))
 		return errors.DBError(err)
 	}
 
 	// Already deleted - idempotent success
 	if wallet.DeletedAt.Valid {
-		s.logger.Debug("wallet already deleted (idempotent)",
+		logctx.From(ctx, s.logger).Debug("wallet already deleted (idempotent)",
 			zap.String("wallet_external_id", walletExternalID),
 		)
 		return nil
@@ -418,14 +1230,14 @@ func (s *Service) DeleteWallet(ctx context.Context, userExternalID, walletExtern
 		UserID: wallet.UserID,
 	})
 	if err != nil {
-		s.logger.Error("failed to delete wallet", zap.Error(err))
+		logctx.From(ctx, s.logger).Error("failed to delete wallet", zap.Error(err))
 		return errors.DBError(err)
 	}
 
 	affected, _ := result.RowsAffected()
 	if affected == 0 {
 		// Race condition: wallet became primary or was deleted by another process
-		s.logger.Warn("wallet delete affected 0 rows",
+		logctx.From(ctx, s.logger).Warn("wallet delete affected 0 rows",
 			zap.String("wallet_external_id", walletExternalID),
 			zap.Uint64("wallet_id", wallet.ID),
 		)
@@ -447,13 +1259,96 @@ func (s *Service) DeleteWallet(ctx context.Context, userExternalID, walletExtern
 		return errors.Internal("Failed to delete wallet")
 	}
 
-	s.logger.Info("wallet deleted",
+	logctx.From(ctx, s.logger).Info("wallet deleted",
 		zap.String("wallet_external_id", walletExternalID),
 	)
 
 	return nil
 }
 
+// RestoreWallet restores a soft-deleted wallet (not as primary).
+// Fails when the address has been registered again on the same chain since the wallet was deleted.
+func (s *Service) RestoreWallet(ctx context.Context, userExternalID, walletExternalID string, actor audit.Actor) (*db.Wallet, error) {
+	// Get wallet including deleted (for idempotency check)
+	wallet, err := s.txRunner.Queries().GetWalletByExternalIDAndUserIncludeDeleted(ctx, db.GetWalletByExternalIDAndUserIncludeDeletedParams{
+		ExternalID:   walletExternalID,
+		ExternalID_2: sql.NullString{String: userExternalID, Valid: true},
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Wallet")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get wallet for restore", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	// Not deleted - idempotent success
+	if !wallet.DeletedAt.Valid {
+		logctx.From(ctx, s.logger).Debug("wallet not deleted (idempotent)",
+			zap.String("wallet_external_id", walletExternalID),
+		)
+		return &wallet, nil
+	}
+
+	// Address re-registered since deletion (another user or the same user)
+	if _, err := s.txRunner.Queries().GetWalletByAddress(ctx, db.GetWalletByAddressParams{
+		Address: wallet.Address,
+		ChainID: wallet.ChainID,
+	}); err == nil {
+		return nil, errors.Conflict("Wallet address has been registered again")
+	} else if err != sql.ErrNoRows {
+		logctx.From(ctx, s.logger).Error("failed to check wallet address", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	// Why: 복구는 재등록과 같음 → 삭제 이후 제재 목록에 오른 주소가 되살아나지 않도록 재검사
+	if err := s.screenAddress(ctx, actor, wallet.UserID, int64(wallet.ChainID), wallet.Address, screenedRestore); err != nil {
+		return nil, err
+	}
+
+	// Restore wallet
+	result, err := s.txRunner.Queries().RestoreWallet(ctx, db.RestoreWalletParams{
+		ID:     wallet.ID,
+		UserID: wallet.UserID,
+	})
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			// Race condition: address registered again between the check and the update
+			return nil, errors.Conflict("Wallet address has been registered again")
+		}
+		logctx.From(ctx, s.logger).Error("failed to restore wallet", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	affected, _ := result.RowsAffected()
+	if affected == 0 {
+		// Race condition: restored by another process (or purged) - re-fetch to check current state
+		logctx.From(ctx, s.logger).Warn("wallet restore affected 0 rows",
+			zap.String("wallet_external_id", walletExternalID),
+			zap.Uint64("wallet_id", wallet.ID),
+		)
+	}
+
+	restored, err := s.txRunner.Queries().GetWalletByExternalIDAndUser(ctx, db.GetWalletByExternalIDAndUserParams{
+		ExternalID:   walletExternalID,
+		ExternalID_2: sql.NullString{String: userExternalID, Valid: true},
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Wallet")
+		}
+		return nil, errors.DBError(err)
+	}
+
+	if affected > 0 {
+		logctx.From(ctx, s.logger).Info("wallet restored",
+			zap.String("wallet_external_id", walletExternalID),
+		)
+	}
+
+	return &restored, nil
+}
+
 // ============================================================================
 // Helper functions
 // ============================================================================
@@ -479,14 +1374,195 @@ func ValidateEthereumAddress(address string) error {
 	return nil
 }
 
+// writeWalletVerifiedEvent records a wallet.verified event for the wallet owner (must be called within a transaction)
+func writeWalletVerifiedEvent(ctx context.Context, q *db.Queries, wallet *db.Wallet, level db.WalletsVerificationLevel) error {
+	_, err := outbox.Write(ctx, q, outbox.Message{
+		EventType:           webhook.EventWalletVerified,
+		AggregateType:       outbox.AggregateWallet,
+		AggregateID:         wallet.ID,
+		AggregateExternalID: wallet.ExternalID,
+		RecipientUserID:     wallet.UserID,
+		Data: map[string]any{
+			"wallet_id":          wallet.ExternalID,
+			"address":            wallet.Address,
+			"chain_id":           wallet.ChainID,
+			"verification_level": string(level),
+		},
+	})
+	return err
+}
+
+// fillBalance sets the raw and formatted balance on the response
+func fillBalance(response *WalletBalanceResponse, balance *big.Int, asOf time.Time) *WalletBalanceResponse {
+	response.RawBalance = balance.String()
+	response.Balance = money.New(balance, "", response.Decimals)
+	response.AsOf = asOf
+	return response
+}
+
+// resolvedAddress is a registration address after ENS resolution
+type resolvedAddress struct {
+	address string
+	ensName string
+	err     error
+}
+
+// resolveAddress validates a hex address or resolves an ENS name via the chain client.
+// Returns the lowercase address and the normalized ENS name ("" for a hex address).
+func (s *Service) resolveAddress(ctx context.Context, input string) (string, string, error) {
+	if !chain.IsENSName(input) {
+		if err := ValidateEthereumAddress(input); err != nil {
+			return "", "", err
+		}
+		return strings.ToLower(input), "", nil
+	}
+
+	name, err := chain.NormalizeENSName(input)
+	if err != nil {
+		return "", "", errors.InvalidInput("Invalid ENS name")
+	}
+	if s.chainClient == nil {
+		return "", "", errors.ChainError("ENS resolution is not enabled")
+	}
+
+	address, err := s.chainClient.ResolveName(ctx, name)
+	if err != nil {
+		if stderrors.Is(err, chain.ErrENSNameNotFound) {
+			return "", "", errors.InvalidInput("ENS name does not resolve to an address")
+		}
+		logctx.From(ctx, s.logger).Error("failed to resolve ENS name",
+			zap.String("ens_name", name),
+			zap.Error(err),
+		)
+		return "", "", errors.ChainError("Failed to resolve ENS name")
+	}
+	return address, name, nil
+}
+
+// withENSName records the ENS name a wallet was registered with (resolved now)
+func withENSName(params db.CreateWalletParams, ensName string) db.CreateWalletParams {
+	if ensName == "" {
+		return params
+	}
+	params.EnsName = sql.NullString{String: ensName, Valid: true}
+	params.EnsStatus = db.NullWalletsEnsStatus{WalletsEnsStatus: db.WalletsEnsStatusRESOLVED, Valid: true}
+	params.EnsCheckedAt = sql.NullTime{Time: time.Now().UTC(), Valid: true}
+	return params
+}
+
+// screenAddress rejects an address flagged by sanctions screening and audit-logs the block.
+// Screening failures fail closed.
+func (s *Service) screenAddress(ctx context.Context, actor audit.Actor, userID uint64, chainID int64, address, operation string) error {
+	result, err := s.screen(ctx, chainID, address)
+	if err != nil {
+		return err
+	}
+	if !result.Flagged {
+		return nil
+	}
+
+	// 거부된 요청이므로 별도 기록 (롤백될 변경 없음)
+	if err := s.recordBlocked(ctx, s.txRunner.Queries(), actor, userID, chainID, address, operation, result); err != nil {
+		return err
+	}
+	return errors.ComplianceBlocked(result.Category)
+}
+
+// screen screens a single address with the configured provider
+func (s *Service) screen(ctx context.Context, chainID int64, address string) (*screening.Result, error) {
+	result, err := s.screener.Screen(ctx, chainID, address)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("sanctions screening failed",
+			zap.String("address", address),
+			zap.Int64("chain_id", chainID),
+			zap.Error(err),
+		)
+		return nil, errors.Internal("Sanctions screening is unavailable")
+	}
+	return result, nil
+}
+
+// recordBlocked audit-logs an address blocked by screening
+func (s *Service) recordBlocked(ctx context.Context, q *db.Queries, actor audit.Actor, userID uint64, chainID int64, address, operation string, result *screening.Result) error {
+	if err := audit.Record(ctx, q, actor, audit.Entry{
+		Action:       actionScreeningBlocked,
+		ResourceType: resourceUser,
+		ResourceID:   userID,
+		NewValue: map[string]any{
+			"address":   address,
+			"chain_id":  chainID,
+			"operation": operation,
+			"category":  result.Category,
+			"provider":  result.Provider,
+		},
+	}); err != nil {
+		logctx.From(ctx, s.logger).Error("failed to record audit log", zap.Error(err))
+		return errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Warn("address blocked by sanctions screening",
+		zap.String("address", address),
+		zap.Int64("chain_id", chainID),
+		zap.String("operation", operation),
+		zap.String("category", result.Category),
+	)
+	return nil
+}
+
+// resolveChainID maps an omitted chain id to the default chain and rejects unsupported chains
+func (s *Service) resolveChainID(chainID int64) (int64, error) {
+	resolved, err := s.networks.Resolve(chainID)
+	if err != nil {
+		return 0, errors.InvalidInput("Unsupported chain_id")
+	}
+	return resolved, nil
+}
+
+// MeetsVerificationLevel reports whether the wallet is verified at least at the required level.
+// Used by risk policies (e.g. large payouts require MICRO_TRANSFER).
+func MeetsVerificationLevel(wallet *db.Wallet, required db.WalletsVerificationLevel) bool {
+	return verificationLevelRank[wallet.VerificationLevel] >= verificationLevelRank[required]
+}
+
+// CanReceivePayout reports whether the wallet may be used as a payout destination.
+// Signature-verified wallets qualify immediately; watch-only wallets only after the cooling-off period.
+func CanReceivePayout(wallet *db.Wallet, now time.Time) bool {
+	if wallet.DeletedAt.Valid {
+		return false
+	}
+	if wallet.IsWatchOnly {
+		return wallet.ReceivableAfter.Valid && !now.Before(wallet.ReceivableAfter.Time)
+	}
+	return MeetsVerificationLevel(wallet, db.WalletsVerificationLevelSIGNATURE)
+}
+
+// randomMicroTransferAmount returns a random challenge amount (0.001000 ~ 0.009999)
+// as a decimal string and in token base units
+func randomMicroTransferAmount(tokenDecimals int) (string, *big.Int, error) {
+	if tokenDecimals < microTransferScale {
+		return "", nil, stderrors.New("token decimals too small for micro-transfer")
+	}
+
+	n, err := rand.Int(rand.Reader, big.NewInt(9000))
+	if err != nil {
+		return "", nil, err
+	}
+	scaled := n.Add(n, big.NewInt(1000))
+
+	exp := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(tokenDecimals-microTransferScale)), nil)
+	baseUnits := new(big.Int).Mul(scaled, exp)
+
+	return chain.FormatUnits(scaled, microTransferScale), baseUnits, nil
+}
+
 // parseSignature parses hex signature string to bytes
 func parseSignature(sig string) ([]byte, error) {
 	// Remove 0x prefix if present
 	sig = strings.TrimPrefix(sig, "0x")
 
-	// Must be 130 hex chars (65 bytes)
-	if len(sig) != 130 {
-		return nil, errors.InvalidInput("Signature must be 65 bytes")
+	// At least 64 bytes (EIP-2098 compact EOA); contract wallet signatures may be longer
+	if len(sig) < 128 || len(sig) > maxSignatureHexLength || len(sig)%2 != 0 {
+		return nil, errors.InvalidInput("Signature must be 64 or 65 bytes, or a longer contract wallet signature")
 	}
 
 	return hex.DecodeString(sig)
@@ -0,0 +1,99 @@
+package warehouse
+
+import (
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// CreateWarehouseRequest represents the request body for creating a warehouse.
+// code is the inventory location stock is adjusted, transferred and reserved at.
+type CreateWarehouseRequest struct {
+	Code     string  `json:"code" binding:"required,max=50" example:"fra-1"`
+	Name     string  `json:"name" binding:"required,max=100" example:"Frankfurt DC"`
+	Country  string  `json:"country,omitempty" binding:"omitempty,len=2,alpha" example:"DE"` // omitted = never the nearest warehouse
+	Priority *uint32 `json:"priority,omitempty" example:"10"`                                // omitted = 100, lower ships first
+	Status   string  `json:"status,omitempty" binding:"omitempty,oneof=ACTIVE INACTIVE" example:"ACTIVE"`
+}
+
+// UpdateWarehouseRequest represents the request body for updating a warehouse (omitted fields are unchanged)
+type UpdateWarehouseRequest struct {
+	Name     string  `json:"name,omitempty" binding:"max=100" example:"Frankfurt DC"`
+	Country  string  `json:"country,omitempty" binding:"omitempty,len=2,alpha" example:"DE"`
+	Priority *uint32 `json:"priority,omitempty" example:"20"`
+	Status   string  `json:"status,omitempty" binding:"omitempty,oneof=ACTIVE INACTIVE" example:"INACTIVE"`
+}
+
+// ListWarehousesRequest represents query parameters for listing warehouses
+type ListWarehousesRequest struct {
+	Status string `form:"status" binding:"omitempty,oneof=ACTIVE INACTIVE"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// WarehouseStockResponse represents the stock held at a warehouse over all SKUs
+type WarehouseStockResponse struct {
+	SKUs      int64 `json:"skus" example:"42"` // SKUs with quantity > 0
+	Quantity  int64 `json:"quantity" example:"12000"`
+	Reserved  int64 `json:"reserved" example:"800"`
+	Available int64 `json:"available" example:"11200"`
+}
+
+// WarehouseResponse represents a warehouse (inventory location)
+type WarehouseResponse struct {
+	Code      string                  `json:"code" example:"fra-1"`
+	Name      string                  `json:"name" example:"Frankfurt DC"`
+	Country   string                  `json:"country,omitempty" example:"DE"`
+	Priority  uint32                  `json:"priority" example:"10"`
+	Status    string                  `json:"status" example:"ACTIVE" enums:"ACTIVE,INACTIVE"`
+	Stock     *WarehouseStockResponse `json:"stock,omitempty"` // warehouse list only
+	CreatedAt time.Time               `json:"created_at"`
+	UpdatedAt time.Time               `json:"updated_at"`
+}
+
+// ListWarehousesResponse represents warehouses ordered by priority
+type ListWarehousesResponse struct {
+	Warehouses []WarehouseResponse `json:"warehouses"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToWarehouseResponse converts a warehouse model to its response
+func ToWarehouseResponse(w *db.Warehouse) WarehouseResponse {
+	return WarehouseResponse{
+		Code:      w.Code,
+		Name:      w.Name,
+		Country:   w.Country.String,
+		Priority:  w.Priority,
+		Status:    string(w.Status),
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+// ToWarehouseListResponse converts a warehouse list row with its stock to its response
+func ToWarehouseListResponse(r *db.ListWarehousesRow) WarehouseResponse {
+	return WarehouseResponse{
+		Code:     r.Code,
+		Name:     r.Name,
+		Country:  r.Country.String,
+		Priority: r.Priority,
+		Status:   string(r.Status),
+		Stock: &WarehouseStockResponse{
+			SKUs:      r.SkuCount,
+			Quantity:  r.Quantity,
+			Reserved:  r.Reserved,
+			Available: r.Quantity - r.Reserved,
+		},
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
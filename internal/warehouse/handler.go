@@ -0,0 +1,125 @@
+package warehouse
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// Handler handles HTTP requests for warehouses
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new warehouse handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers warehouse routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	admin := rg.Group("/admin/warehouses", middleware.RequireRoles(middleware.RoleAdmin))
+	{
+		admin.GET("", h.ListWarehouses)
+		admin.POST("", h.CreateWarehouse)
+		admin.PATCH("/:code", h.UpdateWarehouse)
+	}
+}
+
+// ListWarehouses godoc
+// @Summary List warehouses
+// @Description List warehouses ordered by priority with the stock held at each - Admin only
+// @Tags warehouses
+// @Produce json
+// @Param status query string false "Status filter" Enums(ACTIVE, INACTIVE)
+// @Success 200 {object} middleware.SuccessResponse{data=ListWarehousesResponse} "Warehouses"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/warehouses [get]
+func (h *Handler) ListWarehouses(c *gin.Context) {
+	var req ListWarehousesRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.ListWarehouses(c.Request.Context(), &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
+
+// CreateWarehouse godoc
+// @Summary Create warehouse
+// @Description Create a warehouse - Admin only. Its code is the inventory location stock is adjusted, transferred and reserved at.
+// @Description Checkouts reserve from ACTIVE warehouses in the ship-to country first, then by priority (lower first). Audited.
+// @Tags warehouses
+// @Accept json
+// @Produce json
+// @Param request body CreateWarehouseRequest true "Warehouse"
+// @Success 201 {object} middleware.SuccessResponse{data=WarehouseResponse} "Warehouse created"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 409 {object} middleware.ErrorResponse "Warehouse code already exists"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/warehouses [post]
+func (h *Handler) CreateWarehouse(c *gin.Context) {
+	var req CreateWarehouseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.CreateWarehouse(c.Request.Context(), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, result)
+}
+
+// UpdateWarehouse godoc
+// @Summary Update warehouse
+// @Description Change a warehouse's name, country, priority or status - Admin only. An INACTIVE warehouse keeps its stock and reservations
+// @Description but is no longer reserved from or transferred to. Audited.
+// @Tags warehouses
+// @Accept json
+// @Produce json
+// @Param code path string true "Warehouse code"
+// @Param request body UpdateWarehouseRequest true "Changes"
+// @Success 200 {object} middleware.SuccessResponse{data=WarehouseResponse} "Warehouse updated"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Insufficient role"
+// @Failure 404 {object} middleware.ErrorResponse "Warehouse not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @x-audience "admin"
+// @Router /api/v1/admin/warehouses/{code} [patch]
+func (h *Handler) UpdateWarehouse(c *gin.Context) {
+	var req UpdateWarehouseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	result, err := h.service.UpdateWarehouse(c.Request.Context(), c.Param("code"), &req, audit.ActorFromContext(c))
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, result)
+}
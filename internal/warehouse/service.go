@@ -0,0 +1,226 @@
+package warehouse
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"strings"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/audit"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/go-sql-driver/mysql"
+	"go.uber.org/zap"
+)
+
+const (
+	mysqlErrDuplicateEntry = 1062
+
+	// defaultPriority is the priority of warehouses created without one (warehouses.priority default)
+	defaultPriority = 100
+)
+
+// Audit log identifiers
+const (
+	actionCreated     = "WAREHOUSE_CREATED"
+	actionUpdated     = "WAREHOUSE_UPDATED"
+	resourceWarehouse = "WAREHOUSE"
+)
+
+// Service handles warehouses - the inventory locations stock is kept at.
+// A warehouse's code is the inventories.location its stock is adjusted, transferred and reserved at;
+// country and priority decide which warehouse a checkout reserves from.
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	logger   *zap.Logger
+}
+
+// NewService creates a new warehouse service
+func NewService(txRunner *pkgdb.TxRunner, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		logger:   logger,
+	}
+}
+
+// ListWarehouses returns warehouses with their stock, ordered by priority (admin)
+func (s *Service) ListWarehouses(ctx context.Context, req *ListWarehousesRequest) (*ListWarehousesResponse, error) {
+	var status db.NullWarehousesStatus
+	if req.Status != "" {
+		status = db.NullWarehousesStatus{WarehousesStatus: db.WarehousesStatus(req.Status), Valid: true}
+	}
+
+	rows, err := s.txRunner.Queries().ListWarehouses(ctx, status)
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list warehouses", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	warehouses := make([]WarehouseResponse, 0, len(rows))
+	for i := range rows {
+		warehouses = append(warehouses, ToWarehouseListResponse(&rows[i]))
+	}
+	return &ListWarehousesResponse{Warehouses: warehouses}, nil
+}
+
+// CreateWarehouse creates a warehouse (admin)
+func (s *Service) CreateWarehouse(ctx context.Context, req *CreateWarehouseRequest, actor audit.Actor) (*WarehouseResponse, error) {
+	code := strings.TrimSpace(req.Code)
+	name := strings.TrimSpace(req.Name)
+	if code == "" || name == "" {
+		return nil, errors.InvalidInput("code and name must not be blank")
+	}
+	country := nullCountry(req.Country)
+	priority := uint32(defaultPriority)
+	if req.Priority != nil {
+		priority = *req.Priority
+	}
+	status := db.WarehousesStatusACTIVE
+	if req.Status != "" {
+		status = db.WarehousesStatus(req.Status)
+	}
+
+	err := s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		result, err := q.CreateWarehouse(ctx, db.CreateWarehouseParams{
+			Code:     code,
+			Name:     name,
+			Country:  country,
+			Priority: priority,
+			Status:   status,
+		})
+		if err != nil {
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionCreated,
+			ResourceType: resourceWarehouse,
+			ResourceID:   uint64(id),
+			NewValue: map[string]any{
+				"code":     code,
+				"name":     name,
+				"country":  country.String,
+				"priority": priority,
+				"status":   string(status),
+			},
+		})
+	})
+	if err != nil {
+		if isDuplicateKeyError(err) {
+			return nil, errors.Conflict("Warehouse code already exists")
+		}
+		logctx.From(ctx, s.logger).Error("failed to create warehouse", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("warehouse created", zap.String("code", code))
+	return s.getWarehouse(ctx, code)
+}
+
+// UpdateWarehouse changes a warehouse's name, country, priority or status (admin); its code is fixed as inventories reference it.
+//
+// Why:
+//   - INACTIVE 전환 시 기존 재고/예약은 유지 → 이미 예약된 주문은 그대로 출고, 이후 주문 예약·이동 입고에서만 제외
+func (s *Service) UpdateWarehouse(ctx context.Context, code string, req *UpdateWarehouseRequest, actor audit.Actor) (*WarehouseResponse, error) {
+	q := s.txRunner.Queries()
+	warehouse, err := q.GetWarehouseByCode(ctx, code)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Warehouse")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get warehouse", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	params := db.UpdateWarehouseParams{
+		Name:     warehouse.Name,
+		Country:  warehouse.Country,
+		Priority: warehouse.Priority,
+		Status:   warehouse.Status,
+		ID:       warehouse.ID,
+	}
+	if name := strings.TrimSpace(req.Name); name != "" {
+		params.Name = name
+	}
+	if req.Country != "" {
+		params.Country = nullCountry(req.Country)
+	}
+	if req.Priority != nil {
+		params.Priority = *req.Priority
+	}
+	if req.Status != "" {
+		params.Status = db.WarehousesStatus(req.Status)
+	}
+
+	err = s.txRunner.WithTx(ctx, func(q *db.Queries) error {
+		if err := q.UpdateWarehouse(ctx, params); err != nil {
+			return err
+		}
+		return audit.Record(ctx, q, actor, audit.Entry{
+			Action:       actionUpdated,
+			ResourceType: resourceWarehouse,
+			ResourceID:   warehouse.ID,
+			OldValue: map[string]any{
+				"name":     warehouse.Name,
+				"country":  warehouse.Country.String,
+				"priority": warehouse.Priority,
+				"status":   string(warehouse.Status),
+			},
+			NewValue: map[string]any{
+				"name":     params.Name,
+				"country":  params.Country.String,
+				"priority": params.Priority,
+				"status":   string(params.Status),
+			},
+		})
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to update warehouse", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return s.getWarehouse(ctx, code)
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// getWarehouse retrieves a warehouse by code as its response
+func (s *Service) getWarehouse(ctx context.Context, code string) (*WarehouseResponse, error) {
+	warehouse, err := s.txRunner.Queries().GetWarehouseByCode(ctx, code)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Warehouse")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get warehouse", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	response := ToWarehouseResponse(&warehouse)
+	return &response, nil
+}
+
+// nullCountry normalizes an ISO 3166-1 alpha-2 country (empty = NULL)
+func nullCountry(country string) sql.NullString {
+	if country == "" {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: strings.ToUpper(country), Valid: true}
+}
+
+// isDuplicateKeyError checks if the error is a MySQL duplicate key error
+func isDuplicateKeyError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var mysqlErr *mysql.MySQLError
+	if stderrors.As(err, &mysqlErr) {
+		return mysqlErr.Number == mysqlErrDuplicateEntry
+	}
+	return strings.Contains(err.Error(), "Duplicate entry")
+}
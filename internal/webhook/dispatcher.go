@@ -0,0 +1,243 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/httpclient"
+	"go.uber.org/zap"
+)
+
+const (
+	// retryBaseDelay is the delay before the first retry (doubled per attempt)
+	retryBaseDelay = 30 * time.Second
+	// retryMaxDelay caps the exponential backoff
+	retryMaxDelay = 6 * time.Hour
+	// maxErrorLen matches webhook_deliveries.last_error column size
+	maxErrorLen = 512
+	// maxResponseBodyRead bounds how much of the receiver response is read
+	maxResponseBodyRead = 4 << 10
+)
+
+// DispatcherConfig holds delivery worker settings
+type DispatcherConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	Timeout      time.Duration
+}
+
+// Dispatcher delivers pending webhook deliveries with exponential backoff retries
+type Dispatcher struct {
+	txRunner *pkgdb.TxRunner
+	client   *httpclient.Client
+	config   DispatcherConfig
+	logger   *zap.Logger
+}
+
+// NewDispatcher creates a new webhook dispatcher
+func NewDispatcher(txRunner *pkgdb.TxRunner, config DispatcherConfig, logger *zap.Logger) *Dispatcher {
+	// 재시도는 전송 건 단위 backoff로 처리 → HTTP 레벨 재시도 없음, breaker는 엔드포인트 호스트별
+	client := httpclient.New(httpclient.Config{
+		Name:          "webhook",
+		Timeout:       config.Timeout,
+		RedactHeaders: []string{SignatureHeader},
+	}, logger)
+
+	return &Dispatcher{
+		txRunner: txRunner,
+		client:   client,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// Run polls and delivers due deliveries until ctx is canceled
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.config.PollInterval)
+	defer ticker.Stop()
+
+	d.logger.Info("webhook dispatcher started",
+		zap.Duration("poll_interval", d.config.PollInterval),
+		zap.Int("batch_size", d.config.BatchSize),
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.logger.Info("webhook dispatcher stopped")
+			return
+		case <-ticker.C:
+			if err := d.DispatchBatch(ctx); err != nil {
+				d.logger.Error("webhook dispatch batch failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// DispatchBatch claims and delivers one batch of due deliveries
+func (d *Dispatcher) DispatchBatch(ctx context.Context) error {
+	deliveries, err := d.claim(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range deliveries {
+		if ctx.Err() != nil {
+			// 종료 중 - 남은 건은 lease 만료 후 재claim
+			return nil
+		}
+		d.deliver(ctx, &deliveries[i])
+	}
+	return nil
+}
+
+// claim locks due deliveries and leases them to this worker
+//
+// Why:
+// - FOR UPDATE SKIP LOCKED → 여러 인스턴스가 같은 건을 중복 전송하지 않음
+// - lease(next_attempt_at) → 전송 중 프로세스가 죽어도 lease 만료 후 재시도
+func (d *Dispatcher) claim(ctx context.Context) ([]db.WebhookDelivery, error) {
+	return pkgdb.WithTxResult(ctx, d.txRunner, func(q *db.Queries) ([]db.WebhookDelivery, error) {
+		deliveries, err := q.ListDueWebhookDeliveriesForUpdate(ctx, int32(d.config.BatchSize))
+		if err != nil {
+			return nil, fmt.Errorf("list due webhook deliveries: %w", err)
+		}
+
+		leaseUntil := time.Now().Add(2 * d.config.Timeout)
+		for _, delivery := range deliveries {
+			if err := q.MarkWebhookDeliveryDelivering(ctx, db.MarkWebhookDeliveryDeliveringParams{
+				NextAttemptAt: leaseUntil,
+				ID:            delivery.ID,
+			}); err != nil {
+				return nil, fmt.Errorf("mark webhook delivery delivering: %w", err)
+			}
+		}
+		return deliveries, nil
+	})
+}
+
+// deliver sends a single delivery and records the outcome
+func (d *Dispatcher) deliver(ctx context.Context, delivery *db.WebhookDelivery) {
+	q := d.txRunner.Queries()
+
+	endpoint, err := q.GetWebhookEndpointByID(ctx, delivery.EndpointID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			d.markDead(ctx, delivery, 0, "endpoint deleted")
+			return
+		}
+		d.logger.Error("failed to get webhook endpoint", zap.Uint64("endpoint_id", delivery.EndpointID), zap.Error(err))
+		return
+	}
+
+	statusCode, sendErr := d.send(ctx, &endpoint, delivery)
+	if sendErr == nil {
+		if err := q.MarkWebhookDeliverySucceeded(ctx, db.MarkWebhookDeliverySucceededParams{
+			LastStatusCode: sql.NullInt32{Int32: int32(statusCode), Valid: true},
+			ID:             delivery.ID,
+		}); err != nil {
+			d.logger.Error("failed to mark webhook delivery succeeded", zap.Error(err))
+		}
+		return
+	}
+
+	attempts := delivery.Attempts + 1
+	if attempts >= delivery.MaxAttempts {
+		d.markDead(ctx, delivery, statusCode, sendErr.Error())
+		return
+	}
+
+	if err := q.MarkWebhookDeliveryRetry(ctx, db.MarkWebhookDeliveryRetryParams{
+		NextAttemptAt:  time.Now().Add(backoff(attempts)),
+		LastStatusCode: nullStatusCode(statusCode),
+		LastError:      sql.NullString{String: truncate(sendErr.Error(), maxErrorLen), Valid: true},
+		ID:             delivery.ID,
+	}); err != nil {
+		d.logger.Error("failed to schedule webhook retry", zap.Error(err))
+	}
+
+	d.logger.Warn("webhook delivery failed, retry scheduled",
+		zap.String("delivery_external_id", delivery.ExternalID),
+		zap.Uint32("attempts", attempts),
+		zap.Error(sendErr),
+	)
+}
+
+// send POSTs the signed payload; non-2xx responses are returned as errors
+func (d *Dispatcher) send(ctx context.Context, endpoint *db.WebhookEndpoint, delivery *db.WebhookDelivery) (int, error) {
+	reqCtx, cancel := context.WithTimeout(ctx, d.config.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(reqCtx, http.MethodPost, endpoint.Url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventTypeHeader, delivery.EventType)
+	req.Header.Set(DeliveryIDHeader, delivery.ExternalID)
+	req.Header.Set(PayloadVersionHeader, delivery.PayloadVersion)
+	req.Header.Set(SignatureHeader, Sign(endpoint.Secret, time.Now(), delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBodyRead))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// markDead gives up on a delivery
+func (d *Dispatcher) markDead(ctx context.Context, delivery *db.WebhookDelivery, statusCode int, reason string) {
+	if err := d.txRunner.Queries().MarkWebhookDeliveryDead(ctx, db.MarkWebhookDeliveryDeadParams{
+		LastStatusCode: nullStatusCode(statusCode),
+		LastError:      sql.NullString{String: truncate(reason, maxErrorLen), Valid: true},
+		ID:             delivery.ID,
+	}); err != nil {
+		d.logger.Error("failed to mark webhook delivery dead", zap.Error(err))
+		return
+	}
+
+	d.logger.Warn("webhook delivery dead",
+		zap.String("delivery_external_id", delivery.ExternalID),
+		zap.String("reason", reason),
+	)
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// backoff returns the delay before the given attempt number (30s, 1m, 2m, ... capped at 6h)
+func backoff(attempt uint32) time.Duration {
+	delay := retryBaseDelay
+	for i := uint32(1); i < attempt; i++ {
+		delay *= 2
+		if delay >= retryMaxDelay {
+			return retryMaxDelay
+		}
+	}
+	return delay
+}
+
+func nullStatusCode(code int) sql.NullInt32 {
+	return sql.NullInt32{Int32: int32(code), Valid: code != 0}
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
@@ -0,0 +1,222 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/outbox"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+)
+
+// ============================================================================
+// Request DTOs
+// ============================================================================
+
+// CreateWebhookEndpointRequest represents the request body for endpoint registration
+type CreateWebhookEndpointRequest struct {
+	URL        string   `json:"url" binding:"required,url,max=2048" example:"https://merchant.example.com/webhooks"`
+	EventTypes []string `json:"event_types" binding:"required,min=1,max=20,dive,required,max=64" example:"payment.captured,kyc.approved"`
+	// PayloadVersion is the payload schema version of deliveries (omitted = v1)
+	PayloadVersion string `json:"payload_version,omitempty" binding:"omitempty,max=8" example:"v2"`
+}
+
+// UpdatePayloadVersionRequest represents the request body for switching an endpoint's payload version
+type UpdatePayloadVersionRequest struct {
+	PayloadVersion string `json:"payload_version" binding:"required,max=8" example:"v2"`
+}
+
+// SearchEventsRequest represents query parameters for event log search
+type SearchEventsRequest struct {
+	Type         string    `form:"type" binding:"omitempty,max=64"`
+	ResourceType string    `form:"resource_type" binding:"omitempty,oneof=USER WALLET ORDER ACCOUNT"`
+	ResourceID   string    `form:"resource_id" binding:"omitempty,max=64"`
+	From         time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+	To           time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	Page         int       `form:"page,default=1" binding:"min=1"`
+	PageSize     int       `form:"page_size,default=20" binding:"min=1,max=100"`
+}
+
+// ============================================================================
+// Response DTOs
+// ============================================================================
+
+// WebhookEndpointResponse represents a webhook endpoint in API responses
+// NOTE: secret은 등록 응답(CreatedWebhookEndpointResponse)에서만 노출
+type WebhookEndpointResponse struct {
+	ID             string    `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	URL            string    `json:"url" example:"https://merchant.example.com/webhooks"`
+	EventTypes     []string  `json:"event_types" example:"payment.captured,kyc.approved"`
+	PayloadVersion string    `json:"payload_version" example:"v1"`
+	IsActive       bool      `json:"is_active" example:"true"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// CreatedWebhookEndpointResponse represents a newly registered endpoint including the signing secret
+type CreatedWebhookEndpointResponse struct {
+	WebhookEndpointResponse
+	Secret string `json:"secret" example:"whsec_1a2b3c4d5e6f..."`
+}
+
+// ListWebhookEndpointsResponse represents the endpoint list response
+type ListWebhookEndpointsResponse struct {
+	Endpoints []WebhookEndpointResponse `json:"endpoints"`
+	Total     int64                     `json:"total"`
+}
+
+// WebhookDeliveryResponse represents a delivery attempt record
+type WebhookDeliveryResponse struct {
+	ID             string     `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	EventID        string     `json:"event_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	EventType      string     `json:"event_type" example:"payment.captured"`
+	PayloadVersion string     `json:"payload_version" example:"v1"`
+	Status         string     `json:"status" example:"SUCCEEDED"`
+	Attempts       int        `json:"attempts" example:"1"`
+	LastStatusCode *int       `json:"last_status_code,omitempty" example:"200"`
+	LastError      string     `json:"last_error,omitempty"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at,omitempty"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// ListWebhookDeliveriesResponse represents the delivery list response
+type ListWebhookDeliveriesResponse struct {
+	Deliveries []WebhookDeliveryResponse `json:"deliveries"`
+	Total      int64                     `json:"total"`
+}
+
+// EventLogEntry represents a recorded domain event with its webhook deliveries
+type EventLogEntry struct {
+	ID           string `json:"id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Type         string `json:"type" example:"wallet.verified"`
+	ResourceType string `json:"resource_type" example:"WALLET"`
+	ResourceID   string `json:"resource_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"`
+	// PublishStatus is the outbox relay state (COMPLETED = handed to webhooks/event bus)
+	PublishStatus string                    `json:"publish_status" example:"COMPLETED"`
+	Data          json.RawMessage           `json:"data" swaggertype:"object"`
+	Deliveries    []WebhookDeliveryResponse `json:"deliveries"`
+	CreatedAt     time.Time                 `json:"created_at"`
+}
+
+// SearchEventsResponse represents the paginated event log search result
+type SearchEventsResponse struct {
+	Events     []EventLogEntry `json:"events"`
+	Total      int64           `json:"total"`
+	Page       int             `json:"page"`
+	PageSize   int             `json:"page_size"`
+	TotalPages int             `json:"total_pages"`
+}
+
+// ============================================================================
+// Converters
+// ============================================================================
+
+// ToWebhookEndpointResponse converts db.WebhookEndpoint to WebhookEndpointResponse
+func ToWebhookEndpointResponse(endpoint *db.WebhookEndpoint) *WebhookEndpointResponse {
+	if endpoint == nil {
+		return nil
+	}
+
+	return &WebhookEndpointResponse{
+		ID:             endpoint.ExternalID,
+		URL:            endpoint.Url,
+		EventTypes:     decodeEventTypes(endpoint.EventTypes),
+		PayloadVersion: endpoint.PayloadVersion,
+		IsActive:       endpoint.IsActive,
+		CreatedAt:      endpoint.CreatedAt,
+	}
+}
+
+// ToCreatedWebhookEndpointResponse converts a newly created endpoint including its secret
+func ToCreatedWebhookEndpointResponse(endpoint *db.WebhookEndpoint) *CreatedWebhookEndpointResponse {
+	if endpoint == nil {
+		return nil
+	}
+	return &CreatedWebhookEndpointResponse{
+		WebhookEndpointResponse: *ToWebhookEndpointResponse(endpoint),
+		Secret:                  endpoint.Secret,
+	}
+}
+
+// ToWebhookEndpointResponseList converts []db.WebhookEndpoint to []WebhookEndpointResponse
+func ToWebhookEndpointResponseList(endpoints []db.WebhookEndpoint) []WebhookEndpointResponse {
+	responses := make([]WebhookEndpointResponse, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		responses = append(responses, *ToWebhookEndpointResponse(&endpoint))
+	}
+	return responses
+}
+
+// ToWebhookDeliveryResponse converts db.WebhookDelivery to WebhookDeliveryResponse
+func ToWebhookDeliveryResponse(delivery *db.WebhookDelivery) *WebhookDeliveryResponse {
+	if delivery == nil {
+		return nil
+	}
+
+	response := &WebhookDeliveryResponse{
+		ID:             delivery.ExternalID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		PayloadVersion: delivery.PayloadVersion,
+		Status:         string(delivery.Status),
+		Attempts:       int(delivery.Attempts),
+		CreatedAt:      delivery.CreatedAt,
+	}
+
+	if delivery.LastStatusCode.Valid {
+		code := int(delivery.LastStatusCode.Int32)
+		response.LastStatusCode = &code
+	}
+	if delivery.LastError.Valid {
+		response.LastError = delivery.LastError.String
+	}
+	if delivery.Status == db.WebhookDeliveriesStatusPENDING {
+		response.NextAttemptAt = &delivery.NextAttemptAt
+	}
+	if delivery.DeliveredAt.Valid {
+		response.DeliveredAt = &delivery.DeliveredAt.Time
+	}
+
+	return response
+}
+
+// ToWebhookDeliveryResponseList converts []db.WebhookDelivery to []WebhookDeliveryResponse
+func ToWebhookDeliveryResponseList(deliveries []db.WebhookDelivery) []WebhookDeliveryResponse {
+	responses := make([]WebhookDeliveryResponse, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		responses = append(responses, *ToWebhookDeliveryResponse(&delivery))
+	}
+	return responses
+}
+
+// ToEventLogEntry converts an outbox row and its deliveries to EventLogEntry
+func ToEventLogEntry(msg *db.Outbox, deliveries []db.WebhookDelivery) *EventLogEntry {
+	if msg == nil {
+		return nil
+	}
+
+	entry := &EventLogEntry{
+		ID:            msg.EventID,
+		Type:          msg.EventType,
+		ResourceType:  msg.AggregateType,
+		PublishStatus: string(msg.Status),
+		Deliveries:    ToWebhookDeliveryResponseList(deliveries),
+		CreatedAt:     msg.CreatedAt,
+	}
+
+	if msg.AggregateExternalID.Valid {
+		entry.ResourceID = msg.AggregateExternalID.String
+	}
+	// Envelope data only (undecodable payload → null data, event still listed)
+	if event, err := outbox.DecodeEvent(msg); err == nil {
+		entry.Data = event.Data
+	}
+
+	return entry
+}
+
+// decodeEventTypes decodes the JSON event_types column (invalid JSON → empty)
+func decodeEventTypes(raw json.RawMessage) []string {
+	eventTypes := []string{}
+	_ = json.Unmarshal(raw, &eventTypes)
+	return eventTypes
+}
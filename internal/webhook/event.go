@@ -0,0 +1,80 @@
+package webhook
+
+import "time"
+
+// Event types delivered to webhook endpoints
+const (
+	EventPaymentCaptured          = "payment.captured"
+	EventPaymentFailed            = "payment.failed"
+	EventPaymentRefunded          = "payment.refunded"
+	EventPaymentExpired           = "payment.expired"
+	EventDisputeOpened            = "dispute.opened"
+	EventDisputeEvidenceSubmitted = "dispute.evidence_submitted"
+	EventDisputeResolved          = "dispute.resolved"
+	EventSettlementCompleted      = "settlement.completed"
+	EventSettlementFailed         = "settlement.failed"
+	EventKycApproved              = "kyc.approved"
+	EventKycRejected              = "kyc.rejected"
+	EventKycDocumentReviewed      = "kyc.document_reviewed"
+	EventWalletVerified           = "wallet.verified"
+	EventWalletENSChanged         = "wallet.ens_changed"
+	EventPayoutAddressConfirmed   = "payout_address.confirmed"
+	EventPayoutAddressRevoked     = "payout_address.revoked"
+	EventDelegatedSignerConfirmed = "delegated_signer.confirmed"
+	EventDelegatedSignerRevoked   = "delegated_signer.revoked"
+	EventOrderPaymentReminder     = "order.payment_reminder"
+	EventOrderCancelled           = "order.cancelled"
+	EventOrderPaymentExpired      = "order.payment_expired"
+	EventOrderSLABreached         = "order.sla_breached"
+	EventAccountCreditHold        = "account.credit_hold"
+	EventBudgetNearLimit          = "budget.near_limit"
+	EventBudgetExceeded           = "budget.exceeded"
+	EventInventoryLowStock        = "inventory.low_stock"
+)
+
+// supportedEventTypes lists event types endpoints may subscribe to
+var supportedEventTypes = map[string]bool{
+	EventPaymentCaptured:          true,
+	EventPaymentFailed:            true,
+	EventPaymentRefunded:          true,
+	EventPaymentExpired:           true,
+	EventDisputeOpened:            true,
+	EventDisputeEvidenceSubmitted: true,
+	EventDisputeResolved:          true,
+	EventSettlementCompleted:      true,
+	EventSettlementFailed:         true,
+	EventKycApproved:              true,
+	EventKycRejected:              true,
+	EventKycDocumentReviewed:      true,
+	EventWalletVerified:           true,
+	EventWalletENSChanged:         true,
+	EventPayoutAddressConfirmed:   true,
+	EventPayoutAddressRevoked:     true,
+	EventDelegatedSignerConfirmed: true,
+	EventDelegatedSignerRevoked:   true,
+	EventOrderPaymentReminder:     true,
+	EventOrderCancelled:           true,
+	EventOrderPaymentExpired:      true,
+	EventOrderSLABreached:         true,
+	EventAccountCreditHold:        true,
+	EventBudgetNearLimit:          true,
+	EventBudgetExceeded:           true,
+	EventInventoryLowStock:        true,
+}
+
+// IsSupportedEventType reports whether endpoints can subscribe to the event type
+func IsSupportedEventType(eventType string) bool {
+	return supportedEventTypes[eventType]
+}
+
+// Event is a domain event delivered to webhook endpoints.
+// It is rendered into each endpoint's payload version (see RenderPayload).
+type Event struct {
+	ID        string
+	Type      string
+	CreatedAt time.Time
+	Data      any
+	// ResourceType/ResourceID identify the changed entity (optional, rendered from v2)
+	ResourceType string
+	ResourceID   string
+}
@@ -0,0 +1,304 @@
+package webhook
+
+import (
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Handler handles HTTP requests for webhook endpoint management
+type Handler struct {
+	service *Service
+}
+
+// NewHandler creates a new webhook handler
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+// RegisterRoutes registers webhook routes on the router group
+func (h *Handler) RegisterRoutes(rg *gin.RouterGroup) {
+	// Webhook routes under /users/:id/webhooks (uses :id to match user handler pattern)
+	webhooks := rg.Group("/users/:id/webhooks", middleware.RequireAuth())
+	{
+		webhooks.POST("", h.CreateEndpoint)
+		webhooks.GET("", h.ListEndpoints)
+		webhooks.GET("/:webhookId/deliveries", h.ListDeliveries)
+		webhooks.PUT("/:webhookId/payload-version", h.UpdatePayloadVersion)
+		webhooks.DELETE("/:webhookId", h.DeleteEndpoint)
+	}
+
+	// Event log under /users/:id/events (what was recorded and delivered)
+	rg.GET("/users/:id/events", middleware.RequireAuth(), h.SearchEvents)
+}
+
+// validateUUID validates UUID format
+func validateUUID(id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return errors.InvalidInput("Invalid UUID format")
+	}
+	return nil
+}
+
+// extractAndAuthorizeUserID extracts user id from path and checks the principal may act on it
+func extractAndAuthorizeUserID(c *gin.Context) (string, error) {
+	userID := c.Param("id")
+	if err := validateUUID(userID); err != nil {
+		return "", err
+	}
+
+	principal, ok := middleware.GetPrincipal(c)
+	if !ok {
+		return "", errors.Unauthorized("API key required")
+	}
+	if !principal.CanActAs(userID) {
+		return "", errors.Forbidden("Cannot manage webhooks of another user")
+	}
+	return userID, nil
+}
+
+// extractAndValidateWebhookID extracts and validates webhookId from path
+func extractAndValidateWebhookID(c *gin.Context) (string, error) {
+	webhookID := c.Param("webhookId")
+	if err := validateUUID(webhookID); err != nil {
+		return "", err
+	}
+	return webhookID, nil
+}
+
+// CreateEndpoint godoc
+// @Summary Register a webhook endpoint
+// @Description Register a URL to receive events. The signing secret is returned only once.
+// @Description Deliveries carry an X-Signature header: t=<unix>,v1=hex(HMAC-SHA256(secret, "<unix>.<body>")).
+// @Description payload_version selects the body schema (v1 default: {id, type, created_at, data};
+// @Description v2: {id, type, api_version, created_at, resource{type, id}, data{object}}), sent as X-Webhook-Version.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param request body CreateWebhookEndpointRequest true "Endpoint data"
+// @Success 201 {object} middleware.SuccessResponse{data=CreatedWebhookEndpointResponse} "Endpoint created"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot manage webhooks of another user"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/users/{id}/webhooks [post]
+func (h *Handler) CreateEndpoint(c *gin.Context) {
+	userExternalID, err := extractAndAuthorizeUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req CreateWebhookEndpointRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	endpoint, err := h.service.CreateEndpoint(c.Request.Context(), userExternalID, &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondCreated(c, ToCreatedWebhookEndpointResponse(endpoint))
+}
+
+// ListEndpoints godoc
+// @Summary List webhook endpoints
+// @Description Get all webhook endpoints for the user. Secrets are never returned.
+// @Description Send Accept: text/csv to receive the same results (same filters) as CSV.
+// @Tags webhooks
+// @Produce json,text/csv
+// @Param id path string true "User external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=ListWebhookEndpointsResponse} "Endpoint list"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot manage webhooks of another user"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/users/{id}/webhooks [get]
+func (h *Handler) ListEndpoints(c *gin.Context) {
+	userExternalID, err := extractAndAuthorizeUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	result, err := h.service.ListEndpoints(c.Request.Context(), userExternalID)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOKNegotiated(c, "webhook-endpoints", result, result.Endpoints)
+}
+
+// ListDeliveries godoc
+// @Summary List webhook deliveries
+// @Description Get the recent delivery history of an endpoint (latest 100)
+// @Description Send Accept: text/csv to receive the same results (same filters) as CSV.
+// @Tags webhooks
+// @Produce json,text/csv
+// @Param id path string true "User external ID (UUID)"
+// @Param webhookId path string true "Webhook endpoint external ID (UUID)"
+// @Success 200 {object} middleware.SuccessResponse{data=ListWebhookDeliveriesResponse} "Delivery list"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot manage webhooks of another user"
+// @Failure 404 {object} middleware.ErrorResponse "Webhook endpoint not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/users/{id}/webhooks/{webhookId}/deliveries [get]
+func (h *Handler) ListDeliveries(c *gin.Context) {
+	userExternalID, err := extractAndAuthorizeUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	webhookExternalID, err := extractAndValidateWebhookID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	result, err := h.service.ListDeliveries(c.Request.Context(), userExternalID, webhookExternalID)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOKNegotiated(c, "webhook-deliveries", result, result.Deliveries)
+}
+
+// UpdatePayloadVersion godoc
+// @Summary Switch the payload version of a webhook endpoint
+// @Description Select the payload schema version (v1, v2) of future deliveries. Deliveries already queued keep their version.
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param webhookId path string true "Webhook endpoint external ID (UUID)"
+// @Param request body UpdatePayloadVersionRequest true "Payload version"
+// @Success 200 {object} middleware.SuccessResponse{data=WebhookEndpointResponse} "Endpoint updated"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid input or unsupported payload version"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot manage webhooks of another user"
+// @Failure 404 {object} middleware.ErrorResponse "Webhook endpoint not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/users/{id}/webhooks/{webhookId}/payload-version [put]
+func (h *Handler) UpdatePayloadVersion(c *gin.Context) {
+	userExternalID, err := extractAndAuthorizeUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	webhookExternalID, err := extractAndValidateWebhookID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req UpdatePayloadVersionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+
+	endpoint, err := h.service.UpdatePayloadVersion(c.Request.Context(), userExternalID, webhookExternalID, &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOK(c, ToWebhookEndpointResponse(endpoint))
+}
+
+// DeleteEndpoint godoc
+// @Summary Delete a webhook endpoint
+// @Description Delete a webhook endpoint. Pending deliveries are abandoned.
+// @Tags webhooks
+// @Produce json
+// @Param id path string true "User external ID (UUID)"
+// @Param webhookId path string true "Webhook endpoint external ID (UUID)"
+// @Success 204 "Endpoint deleted"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid UUID format"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot manage webhooks of another user"
+// @Failure 404 {object} middleware.ErrorResponse "Webhook endpoint not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/users/{id}/webhooks/{webhookId} [delete]
+func (h *Handler) DeleteEndpoint(c *gin.Context) {
+	userExternalID, err := extractAndAuthorizeUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+	webhookExternalID, err := extractAndValidateWebhookID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	if err := h.service.DeleteEndpoint(c.Request.Context(), userExternalID, webhookExternalID); err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondNoContent(c)
+}
+
+// SearchEvents godoc
+// @Summary Search event log
+// @Description Search the user's recorded domain events with their webhook deliveries (newest first).
+// @Description Filter by event type, resource (type + external ID) and created_at range [from, to) in RFC 3339.
+// @Description Send Accept: text/csv to receive the same results (same filters) as CSV.
+// @Tags webhooks
+// @Produce json,text/csv
+// @Param id path string true "User external ID (UUID)"
+// @Param type query string false "Filter by event type" example(wallet.verified)
+// @Param resource_type query string false "Filter by resource type" Enums(USER, WALLET, ORDER, ACCOUNT)
+// @Param resource_id query string false "Filter by resource external ID"
+// @Param from query string false "Created at or after (RFC 3339)" example(2024-01-01T00:00:00Z)
+// @Param to query string false "Created before (RFC 3339)" example(2024-02-01T00:00:00Z)
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Page size" default(20)
+// @Success 200 {object} middleware.SuccessResponse{data=SearchEventsResponse} "Event log"
+// @Failure 400 {object} middleware.ErrorResponse "Invalid query parameters"
+// @Failure 401 {object} middleware.ErrorResponse "API key required"
+// @Failure 403 {object} middleware.ErrorResponse "Cannot manage webhooks of another user"
+// @Failure 404 {object} middleware.ErrorResponse "User not found"
+// @Failure 500 {object} middleware.ErrorResponse "Internal server error"
+// @Security ApiKeyAuth
+// @Router /api/v1/users/{id}/events [get]
+func (h *Handler) SearchEvents(c *gin.Context) {
+	userExternalID, err := extractAndAuthorizeUserID(c)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	var req SearchEventsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		middleware.RespondError(c, errors.InvalidInput(err.Error()))
+		return
+	}
+	if !req.From.IsZero() && !req.To.IsZero() && !req.To.After(req.From) {
+		middleware.RespondError(c, errors.InvalidInput("to must be after from"))
+		return
+	}
+
+	result, err := h.service.SearchEvents(c.Request.Context(), userExternalID, &req)
+	if err != nil {
+		middleware.RespondError(c, err)
+		return
+	}
+
+	middleware.RespondOKNegotiated(c, "events", result, result.Events)
+}
@@ -0,0 +1,466 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/common/errors"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/outbox"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	pkgdb "github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// secretPrefix is prepended to every signing secret
+	secretPrefix = "whsec_"
+	// secretRandomBytes is the number of random bytes in a signing secret
+	secretRandomBytes = 32
+	// maxEndpointsPerUser limits registered endpoints per user
+	maxEndpointsPerUser = 10
+	// deliveryListLimit bounds the delivery history response
+	deliveryListLimit = 100
+)
+
+// Config holds webhook service settings
+type Config struct {
+	// MaxAttempts is the number of delivery attempts before a delivery is marked DEAD
+	MaxAttempts int
+	// AllowInsecureURLs permits http:// endpoints (development only)
+	AllowInsecureURLs bool
+}
+
+// Service handles webhook endpoint management and event fan-out
+type Service struct {
+	txRunner *pkgdb.TxRunner
+	config   Config
+	logger   *zap.Logger
+}
+
+// Compile-time interface compliance check
+var _ outbox.Publisher = (*Service)(nil)
+
+// NewService creates a new webhook service
+func NewService(txRunner *pkgdb.TxRunner, config Config, logger *zap.Logger) *Service {
+	return &Service{
+		txRunner: txRunner,
+		config:   config,
+		logger:   logger,
+	}
+}
+
+// CreateEndpoint registers a webhook endpoint for a user
+func (s *Service) CreateEndpoint(ctx context.Context, userExternalID string, req *CreateWebhookEndpointRequest) (*db.WebhookEndpoint, error) {
+	// 1. Validate URL + event types
+	if err := s.validateURL(req.URL); err != nil {
+		return nil, err
+	}
+	eventTypes, err := normalizeEventTypes(req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+	payloadVersion := req.PayloadVersion
+	if payloadVersion == "" {
+		payloadVersion = DefaultPayloadVersion
+	}
+	if err := validatePayloadVersion(payloadVersion); err != nil {
+		return nil, err
+	}
+
+	// 2. Get user by external ID
+	user, err := s.txRunner.Queries().GetUserByExternalID(ctx, sql.NullString{String: userExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("User")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	secret, err := generateSecret()
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to generate webhook secret", zap.Error(err))
+		return nil, errors.Internal("Failed to generate webhook secret")
+	}
+
+	return pkgdb.WithTxResult(ctx, s.txRunner, func(q *db.Queries) (*db.WebhookEndpoint, error) {
+		// 3. Lock user row (serializes endpoint limit check)
+		if _, err := q.GetUserForUpdate(ctx, user.ID); err != nil {
+			logctx.From(ctx, s.logger).Error("failed to lock user row", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		total, err := q.CountWebhookEndpointsByUser(ctx, user.ID)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to count webhook endpoints", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		if total >= maxEndpointsPerUser {
+			return nil, errors.InvalidInput(fmt.Sprintf("At most %d webhook endpoints allowed", maxEndpointsPerUser))
+		}
+
+		// 4. Insert endpoint
+		endpointExternalID := uuid.New().String()
+		result, err := q.CreateWebhookEndpoint(ctx, db.CreateWebhookEndpointParams{
+			ExternalID:     endpointExternalID,
+			UserID:         user.ID,
+			Url:            req.URL,
+			EventTypes:     eventTypes,
+			PayloadVersion: payloadVersion,
+			Secret:         secret,
+		})
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to create webhook endpoint", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+
+		endpointID, err := result.LastInsertId()
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+
+		endpoint, err := q.GetWebhookEndpointByID(ctx, uint64(endpointID))
+		if err != nil {
+			return nil, errors.DBError(err)
+		}
+
+		logctx.From(ctx, s.logger).Info("webhook endpoint created",
+			zap.String("webhook_external_id", endpointExternalID),
+			zap.String("user_external_id", userExternalID),
+		)
+
+		return &endpoint, nil
+	})
+}
+
+// GetEndpoint retrieves an endpoint by external ID with ownership verification
+func (s *Service) GetEndpoint(ctx context.Context, userExternalID, endpointExternalID string) (*db.WebhookEndpoint, error) {
+	endpoint, err := s.txRunner.Queries().GetWebhookEndpointByExternalIDAndUser(ctx, db.GetWebhookEndpointByExternalIDAndUserParams{
+		ExternalID:   endpointExternalID,
+		ExternalID_2: sql.NullString{String: userExternalID, Valid: true},
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("Webhook endpoint")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get webhook endpoint", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+	return &endpoint, nil
+}
+
+// ListEndpoints retrieves all endpoints for a user
+func (s *Service) ListEndpoints(ctx context.Context, userExternalID string) (*ListWebhookEndpointsResponse, error) {
+	endpoints, err := s.txRunner.Queries().ListWebhookEndpointsByUserExternalID(ctx, sql.NullString{String: userExternalID, Valid: true})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list webhook endpoints", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return &ListWebhookEndpointsResponse{
+		Endpoints: ToWebhookEndpointResponseList(endpoints),
+		Total:     int64(len(endpoints)),
+	}, nil
+}
+
+// UpdatePayloadVersion switches the payload version of an endpoint.
+// Deliveries created afterwards use the new version; queued deliveries keep theirs.
+func (s *Service) UpdatePayloadVersion(ctx context.Context, userExternalID, endpointExternalID string, req *UpdatePayloadVersionRequest) (*db.WebhookEndpoint, error) {
+	if err := validatePayloadVersion(req.PayloadVersion); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := s.GetEndpoint(ctx, userExternalID, endpointExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint.PayloadVersion == req.PayloadVersion {
+		return endpoint, nil
+	}
+
+	if _, err := s.txRunner.Queries().UpdateWebhookEndpointPayloadVersion(ctx, db.UpdateWebhookEndpointPayloadVersionParams{
+		PayloadVersion: req.PayloadVersion,
+		ID:             endpoint.ID,
+		UserID:         endpoint.UserID,
+	}); err != nil {
+		logctx.From(ctx, s.logger).Error("failed to update webhook payload version", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("webhook payload version changed",
+		zap.String("webhook_external_id", endpointExternalID),
+		zap.String("from", endpoint.PayloadVersion),
+		zap.String("to", req.PayloadVersion),
+	)
+
+	return s.GetEndpoint(ctx, userExternalID, endpointExternalID)
+}
+
+// DeleteEndpoint deletes an endpoint (soft delete)
+// Pending deliveries are marked DEAD by the dispatcher.
+func (s *Service) DeleteEndpoint(ctx context.Context, userExternalID, endpointExternalID string) error {
+	endpoint, err := s.GetEndpoint(ctx, userExternalID, endpointExternalID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.txRunner.Queries().SoftDeleteWebhookEndpoint(ctx, db.SoftDeleteWebhookEndpointParams{
+		ID:     endpoint.ID,
+		UserID: endpoint.UserID,
+	}); err != nil {
+		logctx.From(ctx, s.logger).Error("failed to delete webhook endpoint", zap.Error(err))
+		return errors.DBError(err)
+	}
+
+	logctx.From(ctx, s.logger).Info("webhook endpoint deleted",
+		zap.String("webhook_external_id", endpointExternalID),
+	)
+
+	return nil
+}
+
+// ListDeliveries retrieves the recent delivery history of an endpoint
+func (s *Service) ListDeliveries(ctx context.Context, userExternalID, endpointExternalID string) (*ListWebhookDeliveriesResponse, error) {
+	endpoint, err := s.GetEndpoint(ctx, userExternalID, endpointExternalID)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := s.txRunner.Queries().ListWebhookDeliveriesByEndpoint(ctx, db.ListWebhookDeliveriesByEndpointParams{
+		EndpointID: endpoint.ID,
+		Limit:      deliveryListLimit,
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to list webhook deliveries", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	return &ListWebhookDeliveriesResponse{
+		Deliveries: ToWebhookDeliveryResponseList(deliveries),
+		Total:      int64(len(deliveries)),
+	}, nil
+}
+
+// SearchEvents searches the user's recorded domain events (newest first) and attaches
+// their webhook deliveries, so integrators can check whether an event was sent.
+func (s *Service) SearchEvents(ctx context.Context, userExternalID string, req *SearchEventsRequest) (*SearchEventsResponse, error) {
+	user, err := s.txRunner.Queries().GetUserByExternalID(ctx, sql.NullString{String: userExternalID, Valid: true})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.NotFound("User")
+		}
+		logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	recipient := sql.NullInt64{Int64: int64(user.ID), Valid: true}
+	eventType := sql.NullString{String: req.Type, Valid: req.Type != ""}
+	resourceType := sql.NullString{String: req.ResourceType, Valid: req.ResourceType != ""}
+	resourceID := sql.NullString{String: req.ResourceID, Valid: req.ResourceID != ""}
+	from := sql.NullTime{Time: req.From, Valid: !req.From.IsZero()}
+	to := sql.NullTime{Time: req.To, Valid: !req.To.IsZero()}
+
+	events, err := s.txRunner.Queries().SearchOutboxEventsByRecipient(ctx, db.SearchOutboxEventsByRecipientParams{
+		RecipientUserID:     recipient,
+		EventType:           eventType,
+		AggregateType:       resourceType,
+		AggregateExternalID: resourceID,
+		CreatedFrom:         from,
+		CreatedTo:           to,
+		Limit:               int32(req.PageSize),
+		Offset:              int32(offset),
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to search outbox events", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	total, err := s.txRunner.Queries().CountOutboxEventsByRecipient(ctx, db.CountOutboxEventsByRecipientParams{
+		RecipientUserID:     recipient,
+		EventType:           eventType,
+		AggregateType:       resourceType,
+		AggregateExternalID: resourceID,
+		CreatedFrom:         from,
+		CreatedTo:           to,
+	})
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to count outbox events", zap.Error(err))
+		return nil, errors.DBError(err)
+	}
+
+	// Deliveries of the page's events in one query (event_id → deliveries)
+	deliveriesByEvent := make(map[string][]db.WebhookDelivery, len(events))
+	if len(events) > 0 {
+		eventIDs := make([]string, 0, len(events))
+		for _, event := range events {
+			eventIDs = append(eventIDs, event.EventID)
+		}
+		deliveries, err := s.txRunner.Queries().ListWebhookDeliveriesByEventIDs(ctx, eventIDs)
+		if err != nil {
+			logctx.From(ctx, s.logger).Error("failed to list webhook deliveries by event", zap.Error(err))
+			return nil, errors.DBError(err)
+		}
+		for _, delivery := range deliveries {
+			deliveriesByEvent[delivery.EventID] = append(deliveriesByEvent[delivery.EventID], delivery)
+		}
+	}
+
+	entries := make([]EventLogEntry, 0, len(events))
+	for _, event := range events {
+		entries = append(entries, *ToEventLogEntry(&event, deliveriesByEvent[event.EventID]))
+	}
+
+	totalPages := int(total) / req.PageSize
+	if int(total)%req.PageSize > 0 {
+		totalPages++
+	}
+
+	return &SearchEventsResponse{
+		Events:     entries,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TotalPages: totalPages,
+	}, nil
+}
+
+// Publish fans an event out to the user's active endpoints subscribed to the event type.
+// Pass tx-bound Queries to enqueue deliveries atomically with the state change.
+// Returns the generated event ID.
+func (s *Service) Publish(ctx context.Context, q *db.Queries, userID uint64, eventType string, data any) (string, error) {
+	event := Event{
+		ID:        uuid.New().String(),
+		Type:      eventType,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	}
+	return event.ID, s.Enqueue(ctx, q, userID, event)
+}
+
+// Enqueue creates deliveries for an already built event (event.ID is the dedupe key).
+// The event is rendered once per payload version subscribed by the endpoints.
+func (s *Service) Enqueue(ctx context.Context, q *db.Queries, userID uint64, event Event) error {
+	endpoints, err := q.ListActiveWebhookEndpointsByUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("list webhook endpoints: %w", err)
+	}
+
+	payloads := make(map[string][]byte, len(payloadRenderers))
+	for _, endpoint := range endpoints {
+		if !slices.Contains(decodeEventTypes(endpoint.EventTypes), event.Type) {
+			continue
+		}
+
+		payload, ok := payloads[endpoint.PayloadVersion]
+		if !ok {
+			if payload, err = RenderPayload(&event, endpoint.PayloadVersion); err != nil {
+				return fmt.Errorf("render webhook event: %w", err)
+			}
+			payloads[endpoint.PayloadVersion] = payload
+		}
+
+		if err := q.CreateWebhookDelivery(ctx, db.CreateWebhookDeliveryParams{
+			ExternalID:     uuid.New().String(),
+			EndpointID:     endpoint.ID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			PayloadVersion: endpoint.PayloadVersion,
+			Payload:        payload,
+			MaxAttempts:    uint32(s.config.MaxAttempts),
+		}); err != nil {
+			return fmt.Errorf("create webhook delivery: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// PublishOutbox fans a committed outbox event out to the recipient's endpoints.
+// Events without a recipient or of unsupported types are not webhook-visible.
+func (s *Service) PublishOutbox(ctx context.Context, q *db.Queries, msg *db.Outbox) error {
+	if !msg.RecipientUserID.Valid || !IsSupportedEventType(msg.EventType) {
+		return nil
+	}
+
+	event, err := outbox.DecodeEvent(msg)
+	if err != nil {
+		return err
+	}
+
+	// event_id가 전송 건 중복 제거 키 → relay 재시도 시에도 중복 전송 없음
+	return s.Enqueue(ctx, q, uint64(msg.RecipientUserID.Int64), Event{
+		ID:           event.ID,
+		Type:         event.Type,
+		CreatedAt:    event.CreatedAt,
+		Data:         event.Data,
+		ResourceType: msg.AggregateType,
+		ResourceID:   msg.AggregateExternalID.String,
+	})
+}
+
+// validateURL checks the endpoint URL (https only unless insecure URLs are allowed)
+func (s *Service) validateURL(raw string) error {
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" {
+		return errors.InvalidInput("Invalid webhook URL")
+	}
+
+	switch parsed.Scheme {
+	case "https":
+		return nil
+	case "http":
+		if s.config.AllowInsecureURLs {
+			return nil
+		}
+		return errors.InvalidInput("Webhook URL must use https")
+	default:
+		return errors.InvalidInput("Invalid webhook URL scheme")
+	}
+}
+
+// ============================================================================
+// Helper functions
+// ============================================================================
+
+// normalizeEventTypes validates, de-duplicates and JSON-encodes event types
+func normalizeEventTypes(eventTypes []string) (json.RawMessage, error) {
+	unique := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if !IsSupportedEventType(eventType) {
+			return nil, errors.InvalidInput(fmt.Sprintf("Unsupported event type: %s", eventType))
+		}
+		if !slices.Contains(unique, eventType) {
+			unique = append(unique, eventType)
+		}
+	}
+	return json.Marshal(unique)
+}
+
+// validatePayloadVersion checks that endpoints can select the payload version
+func validatePayloadVersion(version string) error {
+	if !IsSupportedPayloadVersion(version) {
+		return errors.InvalidInput(fmt.Sprintf("Unsupported payload version: %s (supported: %s)",
+			version, strings.Join(SupportedPayloadVersions(), ", ")))
+	}
+	return nil
+}
+
+// generateSecret creates a new random signing secret (whsec_ + 64 hex chars)
+func generateSecret() (string, error) {
+	buf := make([]byte, secretRandomBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("read random bytes: %w", err)
+	}
+	return secretPrefix + hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Webhook request headers
+const (
+	// SignatureHeader carries "t=<unix>,v1=<hex hmac>"
+	SignatureHeader = "X-Signature"
+	// EventTypeHeader carries the event type
+	EventTypeHeader = "X-Webhook-Event"
+	// DeliveryIDHeader carries the delivery external ID (same across retries)
+	DeliveryIDHeader = "X-Webhook-Delivery"
+	// PayloadVersionHeader carries the payload schema version of the body
+	PayloadVersionHeader = "X-Webhook-Version"
+)
+
+// Sign computes the X-Signature header value.
+// v1 = hex(HMAC-SHA256(secret, "<unix timestamp>.<body>"))
+//
+// Why:
+// - 타임스탬프를 서명에 포함 → 수신 측에서 허용 오차를 두고 replay 차단 가능
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", ts, computeMAC(secret, ts, body))
+}
+
+// VerifySignature validates an X-Signature header value (receiver side).
+// tolerance bounds the allowed clock difference between sender and receiver.
+func VerifySignature(secret, header string, body []byte, tolerance time.Duration, now time.Time) bool {
+	var ts, mac string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			ts = value
+		case "v1":
+			mac = value
+		}
+	}
+	if ts == "" || mac == "" {
+		return false
+	}
+
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return false
+	}
+	if diff := now.Sub(time.Unix(unix, 0)); diff > tolerance || diff < -tolerance {
+		return false
+	}
+
+	return hmac.Equal([]byte(mac), []byte(computeMAC(secret, ts, body)))
+}
+
+func computeMAC(secret, ts string, body []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(ts))
+	h.Write([]byte("."))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,112 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Payload schema versions selectable per endpoint
+const (
+	PayloadV1 = "v1"
+	PayloadV2 = "v2"
+
+	// DefaultPayloadVersion is used by endpoints registered without a version
+	// (기존 가맹점 연동 호환 - 새 버전은 명시적으로 선택해야 적용)
+	DefaultPayloadVersion = PayloadV1
+)
+
+// payloadRenderer renders a domain event into the body of one payload version
+type payloadRenderer func(event *Event) any
+
+// payloadRenderers maps each supported version to its renderer.
+// 이벤트 형태를 바꿀 때는 기존 버전을 수정하지 않고 새 버전을 추가
+var payloadRenderers = map[string]payloadRenderer{
+	PayloadV1: renderV1,
+	PayloadV2: renderV2,
+}
+
+// IsSupportedPayloadVersion reports whether endpoints can select the payload version
+func IsSupportedPayloadVersion(version string) bool {
+	_, ok := payloadRenderers[version]
+	return ok
+}
+
+// SupportedPayloadVersions lists the selectable payload versions in order
+func SupportedPayloadVersions() []string {
+	versions := make([]string, 0, len(payloadRenderers))
+	for version := range payloadRenderers {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// RenderPayload renders the event into the JSON body of the given payload version
+func RenderPayload(event *Event, version string) ([]byte, error) {
+	render, ok := payloadRenderers[version]
+	if !ok {
+		return nil, fmt.Errorf("unsupported webhook payload version %q", version)
+	}
+	return json.Marshal(render(event))
+}
+
+// payloadV1 is the original envelope: {id, type, created_at, data}
+type payloadV1 struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+// renderV1 renders the original envelope
+func renderV1(event *Event) any {
+	return payloadV1{
+		ID:        event.ID,
+		Type:      event.Type,
+		CreatedAt: event.CreatedAt,
+		Data:      event.Data,
+	}
+}
+
+// payloadV2 names its version, identifies the changed resource and nests
+// the event data under data.object (room for data.previous_attributes later)
+type payloadV2 struct {
+	ID         string             `json:"id"`
+	Type       string             `json:"type"`
+	APIVersion string             `json:"api_version"`
+	CreatedAt  time.Time          `json:"created_at"`
+	Resource   *payloadV2Resource `json:"resource,omitempty"`
+	Data       payloadV2Data      `json:"data"`
+}
+
+// payloadV2Resource identifies the entity an event is about
+type payloadV2Resource struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// payloadV2Data wraps the event data
+type payloadV2Data struct {
+	Object any `json:"object"`
+}
+
+// renderV2 renders the v2 envelope (resource type in lower case, e.g. "wallet")
+func renderV2(event *Event) any {
+	payload := payloadV2{
+		ID:         event.ID,
+		Type:       event.Type,
+		APIVersion: PayloadV2,
+		CreatedAt:  event.CreatedAt,
+		Data:       payloadV2Data{Object: event.Data},
+	}
+	if event.ResourceType != "" && event.ResourceID != "" {
+		payload.Resource = &payloadV2Resource{
+			Type: strings.ToLower(event.ResourceType),
+			ID:   event.ResourceID,
+		}
+	}
+	return payload
+}
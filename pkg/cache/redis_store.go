@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// keyPrefix is the Redis key prefix for cached values
+const keyPrefix = "cache"
+
+// RedisStore implements Store interface using Redis
+type RedisStore struct {
+	client *redis.Client
+}
+
+// Compile-time interface compliance check
+var _ Store = (*RedisStore)(nil)
+
+// NewRedisStore creates a new Redis-based cache
+func NewRedisStore(client *redis.Client) *RedisStore {
+	return &RedisStore{client: client}
+}
+
+// buildKey creates the cache key
+// Format: cache:{key}
+func buildKey(key string) string {
+	return fmt.Sprintf("%s:%s", keyPrefix, key)
+}
+
+// Get returns the cached value for the key
+func (s *RedisStore) Get(ctx context.Context, key string) ([]byte, error) {
+	value, err := s.client.Get(ctx, buildKey(key)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get cached value: %w", err)
+	}
+	return value, nil
+}
+
+// Set stores the value for the key with the given TTL
+func (s *RedisStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if err := s.client.Set(ctx, buildKey(key), value, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to set cached value: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Store defines a short-lived key/value cache for expensive lookups (RPC calls etc.)
+// Implementations can use Redis, in-memory, or other backends
+type Store interface {
+	// Get returns the cached value for the key
+	// Returns ErrNotFound on a miss or after expiry
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Set stores the value for the key with the given TTL
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// Error definitions
+var (
+	ErrNotFound = errors.New("cache key not found")
+)
@@ -0,0 +1,117 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+const (
+	// DefaultTxTimeout is the default time to wait for a transaction to be mined
+	DefaultTxTimeout = 2 * time.Minute
+)
+
+// Config holds chain connection and settlement token configuration
+type Config struct {
+	RPCURL           string
+	ChainID          int64
+	TokenAddress     string
+	TokenDecimals    int
+	MinterPrivateKey string
+	TxTimeout        time.Duration
+	// Gas configures EIP-1559 fee estimation; TxUrgency prices platform transactions (empty = standard)
+	Gas       GasManagerConfig
+	TxUrgency Urgency
+	// NonceStore allocates platform signer nonces across instances (nil = process-local MemoryNonceStore);
+	// NonceGapTimeout is how long an allocated nonce may stay unseen on chain before reuse
+	NonceStore      NonceStore
+	NonceGapTimeout time.Duration
+}
+
+// Client defines the interface for on-chain operations
+// Implementations can use a JSON-RPC node, a mock, or other backends
+type Client interface {
+	// ChainID returns the chain id of the connected network
+	ChainID() int64
+
+	// TokenDecimals returns the decimals of the configured settlement token
+	TokenDecimals() int
+
+	// TokenAddress returns the contract address of the configured settlement token
+	TokenAddress() string
+
+	// TokenBalance returns the settlement token balance (base units) of the address at the latest block
+	TokenBalance(ctx context.Context, address string) (*big.Int, error)
+
+	// SuggestFees returns EIP-1559 fee parameters at current network conditions (standard urgency)
+	SuggestFees(ctx context.Context) (*FeeQuote, error)
+
+	// EstimateFees returns EIP-1559 fee parameters for a transaction of the given urgency
+	EstimateFees(ctx context.Context, urgency Urgency) (*FeeQuote, error)
+
+	// EstimateTransferGas estimates the gas of a settlement token transfer from the platform signer
+	EstimateTransferGas(ctx context.Context, to string, amount *big.Int) (uint64, error)
+
+	// TransfersInTx returns the settlement token transfers emitted by a successful transaction
+	TransfersInTx(ctx context.Context, txHash string) ([]TokenTransfer, error)
+
+	// TransfersOfAddress returns the settlement token transfers sent or received by address within [fromBlock, toBlock]
+	TransfersOfAddress(ctx context.Context, address string, fromBlock, toBlock uint64) ([]TokenTransfer, error)
+
+	// LatestBlock returns the number of the latest block
+	LatestBlock(ctx context.Context) (uint64, error)
+
+	// ResolveName resolves an ENS name to its address record (lowercase hex)
+	ResolveName(ctx context.Context, name string) (string, error)
+
+	// TransferToken sends an ERC-20 transfer of amount (base units) from the platform signer
+	// Returns the transaction hash once broadcast (does not wait for confirmation)
+	TransferToken(ctx context.Context, to string, amount *big.Int) (string, error)
+
+	// SendTokenTransfer is TransferToken returning the broadcast transaction (nonce, gas, fees)
+	// so it can be tracked and replaced while unconfirmed
+	SendTokenTransfer(ctx context.Context, to string, amount *big.Int) (*SentTx, error)
+
+	// ReplaceTransfer re-broadcasts a pending transfer with the same nonce and bumped fees
+	ReplaceTransfer(ctx context.Context, previous *SentTx, urgency Urgency) (*SentTx, error)
+
+	// TxReceipt returns the inclusion result of a transaction (ErrTxNotFound while pending)
+	TxReceipt(ctx context.Context, txHash string) (*TxReceipt, error)
+
+	// ConfirmedNonce returns the nonce of the address at the latest block (every lower nonce is mined)
+	ConfirmedNonce(ctx context.Context, address string) (uint64, error)
+}
+
+// Error definitions
+var (
+	ErrInvalidAddress      = errors.New("invalid ethereum address")
+	ErrInvalidAmount       = errors.New("amount must be positive")
+	ErrSignerNotConfigured = errors.New("platform signer key not configured")
+	ErrChainIDMismatch     = errors.New("connected node reports a different chain id")
+	ErrInvalidTxHash       = errors.New("invalid transaction hash")
+	ErrTxNotFound          = errors.New("transaction not found")
+	ErrTxFailed            = errors.New("transaction reverted")
+	ErrInvalidENSName      = errors.New("invalid ENS name")
+	ErrENSNameNotFound     = errors.New("ENS name does not resolve to an address")
+)
+
+// BroadcastError is returned when a signed transfer was submitted but the node did not acknowledge it
+// (timeout, dropped connection, node error). The transfer may still be in the mempool, so callers must
+// track Sent until it confirms or is dropped instead of treating it as never sent.
+type BroadcastError struct {
+	// Sent is the signed transaction that may have been broadcast
+	Sent *SentTx
+	Err  error
+}
+
+// Error implements error
+func (e *BroadcastError) Error() string {
+	return fmt.Sprintf("send tx %s: %v", e.Sent.Hash, e.Err)
+}
+
+// Unwrap returns the underlying broadcast error
+func (e *BroadcastError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,122 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/tracing"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ensRegistry is the ENS registry address (same on mainnet, Sepolia and Holesky)
+var ensRegistry = common.HexToAddress("0x00000000000C2E074eC69A0dFb2997BA6C7d2e1e")
+
+// ensResolverSelector is the 4-byte selector of resolver(bytes32) on the registry
+var ensResolverSelector = crypto.Keccak256([]byte("resolver(bytes32)"))[:4]
+
+// ensAddrSelector is the 4-byte selector of addr(bytes32) on a resolver
+var ensAddrSelector = crypto.Keccak256([]byte("addr(bytes32)"))[:4]
+
+// maxENSNameLength bounds an ENS name (wallets.ens_name column size)
+const maxENSNameLength = 255
+
+// IsENSName reports whether s looks like an ENS name rather than a hex address
+func IsENSName(s string) bool {
+	return strings.Contains(s, ".") && !strings.HasPrefix(s, "0x")
+}
+
+// NormalizeENSName lowercases and validates an ENS name.
+// NOTE: ENSIP-15 정규화(유니코드/이모지)는 미구현 → ASCII 이름만 허용
+func NormalizeENSName(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" || len(name) > maxENSNameLength {
+		return "", ErrInvalidENSName
+	}
+
+	labels := strings.Split(name, ".")
+	if len(labels) < 2 {
+		return "", ErrInvalidENSName
+	}
+	for _, label := range labels {
+		if label == "" {
+			return "", ErrInvalidENSName
+		}
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+				return "", ErrInvalidENSName
+			}
+		}
+	}
+	return name, nil
+}
+
+// Namehash computes the EIP-137 namehash of a normalized ENS name
+func Namehash(name string) common.Hash {
+	var node common.Hash
+	if name == "" {
+		return node
+	}
+	labels := strings.Split(name, ".")
+	for i := len(labels) - 1; i >= 0; i-- {
+		labelHash := crypto.Keccak256([]byte(labels[i]))
+		node = crypto.Keccak256Hash(node[:], labelHash)
+	}
+	return node
+}
+
+// ResolveName resolves an ENS name to its address record (lowercase hex).
+// Returns ErrENSNameNotFound when the name has no resolver or no address.
+func (c *EthClient) ResolveName(ctx context.Context, name string) (address string, err error) {
+	ctx, span := tracer.Start(ctx, "chain.ResolveName", trace.WithAttributes(
+		attribute.String("chain.ens_name", name),
+		attribute.Int64("chain.id", c.config.ChainID),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	normalized, err := NormalizeENSName(name)
+	if err != nil {
+		return "", err
+	}
+	node := Namehash(normalized)
+
+	// 1. registry.resolver(node)
+	resolver, err := c.callAddress(ctx, ensRegistry, ensResolverSelector, node)
+	if err != nil {
+		return "", fmt.Errorf("call resolver: %w", err)
+	}
+	if resolver == (common.Address{}) {
+		return "", ErrENSNameNotFound
+	}
+
+	// 2. resolver.addr(node)
+	resolved, err := c.callAddress(ctx, resolver, ensAddrSelector, node)
+	if err != nil {
+		return "", fmt.Errorf("call addr: %w", err)
+	}
+	if resolved == (common.Address{}) {
+		return "", ErrENSNameNotFound
+	}
+	return strings.ToLower(resolved.Hex()), nil
+}
+
+// callAddress calls a (bytes32) → address view function.
+// Empty return data (no contract at the address) is reported as the zero address.
+func (c *EthClient) callAddress(ctx context.Context, contract common.Address, selector []byte, node common.Hash) (common.Address, error) {
+	data := make([]byte, 0, 4+32)
+	data = append(data, selector...)
+	data = append(data, node[:]...)
+
+	out, err := c.client.CallContract(ctx, ethereum.CallMsg{To: &contract, Data: data}, nil)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(out) < 32 {
+		return common.Address{}, nil
+	}
+	return common.BytesToAddress(out[12:32]), nil
+}
@@ -0,0 +1,69 @@
+package chain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// erc1271MagicValue is the 4-byte selector of isValidSignature(bytes32,bytes),
+// which compliant contracts return for a valid signature
+var erc1271MagicValue = []byte{0x16, 0x26, 0xba, 0x7e}
+
+// eip7702DelegationPrefix marks an EOA whose code is an EIP-7702 delegation designator
+var eip7702DelegationPrefix = []byte{0xef, 0x01, 0x00}
+
+// IsContract reports whether the address has contract code deployed.
+// NOTE: EIP-7702 위임 EOA는 코드가 있어도 개인키로 서명하므로 contract로 보지 않음
+func (c *EthClient) IsContract(ctx context.Context, address string) (bool, error) {
+	if !common.IsHexAddress(address) {
+		return false, ErrInvalidAddress
+	}
+
+	code, err := c.client.CodeAt(ctx, common.HexToAddress(address), nil)
+	if err != nil {
+		return false, fmt.Errorf("get code: %w", err)
+	}
+	if len(code) == 0 || bytes.HasPrefix(code, eip7702DelegationPrefix) {
+		return false, nil
+	}
+	return true, nil
+}
+
+// IsValidSignature calls ERC-1271 isValidSignature(digest, signature) on the contract
+// at address and reports whether it returned the magic value.
+func (c *EthClient) IsValidSignature(ctx context.Context, address string, digest [32]byte, signature []byte) (bool, error) {
+	if !common.IsHexAddress(address) {
+		return false, ErrInvalidAddress
+	}
+	contract := common.HexToAddress(address)
+
+	// ABI-encode isValidSignature(bytes32 hash, bytes signature)
+	// selector | hash | offset(0x40) | len | data (right-padded to 32)
+	padded := (len(signature) + 31) / 32 * 32
+	data := make([]byte, 0, 4+32*3+padded)
+	data = append(data, erc1271MagicValue...)
+	data = append(data, digest[:]...)
+	data = append(data, common.LeftPadBytes(big.NewInt(64).Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(big.NewInt(int64(len(signature))).Bytes(), 32)...)
+	data = append(data, common.RightPadBytes(signature, padded)...)
+
+	out, err := c.client.CallContract(ctx, ethereum.CallMsg{To: &contract, Data: data}, nil)
+	if err != nil {
+		// Revert = invalid signature (e.g. Safe reverts with GS026 instead of returning)
+		var dataErr rpc.DataError
+		if errors.As(err, &dataErr) {
+			return false, nil
+		}
+		return false, fmt.Errorf("call isValidSignature: %w", err)
+	}
+
+	// Return value is bytes4, left-aligned in a 32-byte word
+	return len(out) >= 4 && bytes.Equal(out[:4], erc1271MagicValue), nil
+}
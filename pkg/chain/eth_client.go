@@ -0,0 +1,273 @@
+package chain
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/tracing"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// erc20TransferSelector is the 4-byte selector of transfer(address,uint256)
+var erc20TransferSelector = crypto.Keccak256([]byte("transfer(address,uint256)"))[:4]
+
+// erc20BalanceOfSelector is the 4-byte selector of balanceOf(address)
+var erc20BalanceOfSelector = crypto.Keccak256([]byte("balanceOf(address)"))[:4]
+
+// maxNonceAttempts bounds broadcasts of one transfer when the allocated nonce is already used on chain
+const maxNonceAttempts = 2
+
+// EthClient implements Client interface using go-ethereum's JSON-RPC client
+type EthClient struct {
+	config    Config
+	client    *ethclient.Client
+	chainID   *big.Int
+	token     common.Address
+	signerKey *ecdsa.PrivateKey
+	signer    common.Address
+	gas       *GasManager
+	nonces    *NonceManager
+	logger    *zap.Logger
+}
+
+// Compile-time interface compliance check
+var _ Client = (*EthClient)(nil)
+
+// NewEthClient dials the configured RPC node and verifies the chain id (fail-fast)
+func NewEthClient(ctx context.Context, config Config, logger *zap.Logger) (*EthClient, error) {
+	if config.TxTimeout == 0 {
+		config.TxTimeout = DefaultTxTimeout
+	}
+	if config.TxUrgency == "" {
+		config.TxUrgency = UrgencyStandard
+	}
+	if _, err := ParseUrgency(string(config.TxUrgency)); err != nil {
+		return nil, fmt.Errorf("tx urgency: %w", err)
+	}
+
+	if !common.IsHexAddress(config.TokenAddress) {
+		return nil, fmt.Errorf("token address: %w", ErrInvalidAddress)
+	}
+
+	rpcClient, err := rpc.DialOptions(ctx, config.RPCURL, rpc.WithHTTPClient(newTracingHTTPClient()))
+	if err != nil {
+		return nil, fmt.Errorf("dial rpc: %w", err)
+	}
+	client := ethclient.NewClient(rpcClient)
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("get chain id: %w", err)
+	}
+	if chainID.Int64() != config.ChainID {
+		client.Close()
+		return nil, fmt.Errorf("%w: expected %d, got %s", ErrChainIDMismatch, config.ChainID, chainID)
+	}
+
+	c := &EthClient{
+		config:  config,
+		client:  client,
+		chainID: chainID,
+		token:   common.HexToAddress(config.TokenAddress),
+		gas:     NewGasManager(client, config.Gas, logger),
+		logger:  logger,
+	}
+
+	// Signer key is optional - read-only deployments don't need one
+	if config.MinterPrivateKey != "" {
+		key, err := crypto.HexToECDSA(strings.TrimPrefix(config.MinterPrivateKey, "0x"))
+		if err != nil {
+			client.Close()
+			return nil, fmt.Errorf("parse minter private key: %w", err)
+		}
+		c.signerKey = key
+		c.signer = crypto.PubkeyToAddress(key.PublicKey)
+
+		store := config.NonceStore
+		if store == nil {
+			store = NewMemoryNonceStore()
+		}
+		c.nonces = NewNonceManager(store, client, config.ChainID, c.signer, config.NonceGapTimeout, logger)
+	}
+
+	return c, nil
+}
+
+// Close closes the underlying RPC connection
+func (c *EthClient) Close() {
+	c.client.Close()
+}
+
+// ChainID returns the chain id of the connected network
+func (c *EthClient) ChainID() int64 {
+	return c.config.ChainID
+}
+
+// TokenDecimals returns the decimals of the configured settlement token
+func (c *EthClient) TokenDecimals() int {
+	return c.config.TokenDecimals
+}
+
+// TokenAddress returns the contract address of the configured settlement token
+func (c *EthClient) TokenAddress() string {
+	return c.token.Hex()
+}
+
+// TokenBalance calls balanceOf(address) on the settlement token
+func (c *EthClient) TokenBalance(ctx context.Context, address string) (balance *big.Int, err error) {
+	ctx, span := tracer.Start(ctx, "chain.TokenBalance", trace.WithAttributes(
+		attribute.String("chain.address", address),
+		attribute.Int64("chain.id", c.config.ChainID),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	if !common.IsHexAddress(address) {
+		return nil, ErrInvalidAddress
+	}
+
+	data := make([]byte, 0, 4+32)
+	data = append(data, erc20BalanceOfSelector...)
+	data = append(data, common.LeftPadBytes(common.HexToAddress(address).Bytes(), 32)...)
+
+	out, err := c.client.CallContract(ctx, ethereum.CallMsg{To: &c.token, Data: data}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("call balanceOf: %w", err)
+	}
+	if len(out) < 32 {
+		return nil, fmt.Errorf("call balanceOf: unexpected return length %d", len(out))
+	}
+	return new(big.Int).SetBytes(out[:32]), nil
+}
+
+// TransferToken sends an ERC-20 transfer from the platform signer (EIP-1559 tx)
+func (c *EthClient) TransferToken(ctx context.Context, to string, amount *big.Int) (string, error) {
+	sent, err := c.SendTokenTransfer(ctx, to, amount)
+	if err != nil {
+		return "", err
+	}
+	return sent.Hash, nil
+}
+
+// SendTokenTransfer sends an ERC-20 transfer from the platform signer (EIP-1559 tx)
+// and returns the broadcast transaction, which ReplaceTransfer can re-price
+func (c *EthClient) SendTokenTransfer(ctx context.Context, to string, amount *big.Int) (sent *SentTx, err error) {
+	ctx, span := tracer.Start(ctx, "chain.TransferToken", trace.WithAttributes(
+		attribute.String("chain.to", to),
+		attribute.Int64("chain.id", c.config.ChainID),
+	))
+	defer func() {
+		if sent != nil {
+			span.SetAttributes(attribute.String("chain.tx_hash", sent.Hash))
+		}
+		tracing.End(span, err)
+	}()
+
+	if c.signerKey == nil {
+		return nil, ErrSignerNotConfigured
+	}
+	if !common.IsHexAddress(to) {
+		return nil, ErrInvalidAddress
+	}
+	if amount == nil || amount.Sign() <= 0 {
+		return nil, ErrInvalidAmount
+	}
+
+	// 1. ABI-encode transfer(to, amount)
+	data := encodeTransfer(to, amount)
+
+	// 2. Fees: priced by the gas manager at the configured urgency
+	fees, err := c.EstimateFees(ctx, c.config.TxUrgency)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Gas limit
+	gas, err := c.EstimateTransferGas(ctx, to, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Nonce + sign + broadcast
+	// NOTE: nonce는 마지막에 할당 (앞 단계 실패로 gap이 생기지 않도록), 외부에서 이미 사용된 nonce면 1회 재할당
+	var (
+		nonce    uint64
+		signedTx *types.Transaction
+	)
+	for attempt := 1; ; attempt++ {
+		nonce, err = c.nonces.Next(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		tx := types.NewTx(&types.DynamicFeeTx{
+			ChainID:   c.chainID,
+			Nonce:     nonce,
+			GasTipCap: fees.TipCap,
+			GasFeeCap: fees.FeeCap,
+			Gas:       gas,
+			To:        &c.token,
+			Data:      data,
+		})
+		signedTx, err = types.SignTx(tx, types.LatestSignerForChainID(c.chainID), c.signerKey)
+		if err != nil {
+			c.nonces.Done(ctx, nonce, err)
+			return nil, fmt.Errorf("sign tx: %w", err)
+		}
+
+		err = c.client.SendTransaction(ctx, signedTx)
+		if err == nil {
+			c.nonces.Done(ctx, nonce, nil)
+			break
+		}
+		if !IsNonceTooLow(err) {
+			// 노드 응답 실패는 전송 여부 불명 (타임아웃 후 수락 가능) → nonce 유지, 서명된 tx를 돌려 호출자가 추적
+			// NOTE: 실제로 전송되지 않았으면 추적(chaintx.Monitor)의 재전송이 같은 nonce를 채움
+			c.nonces.Done(ctx, nonce, nil)
+			return nil, &BroadcastError{Sent: c.sentTx(signedTx, to, amount, gas, fees), Err: err}
+		}
+		c.nonces.Done(ctx, nonce, err)
+		if attempt >= maxNonceAttempts {
+			return nil, fmt.Errorf("send tx: %w", err)
+		}
+		logctx.From(ctx, c.logger).Warn("nonce already used on chain, reallocating",
+			zap.Uint64("nonce", nonce),
+			zap.Error(err),
+		)
+	}
+
+	logctx.From(ctx, c.logger).Info("token transfer broadcast",
+		zap.String("tx_hash", signedTx.Hash().Hex()),
+		zap.String("to", to),
+		zap.String("amount", amount.String()),
+		zap.Uint64("nonce", nonce),
+	)
+
+	return c.sentTx(signedTx, to, amount, gas, fees), nil
+}
+
+// sentTx describes a signed token transfer for tracking
+func (c *EthClient) sentTx(signedTx *types.Transaction, to string, amount *big.Int, gas uint64, fees *FeeQuote) *SentTx {
+	return &SentTx{
+		Hash:   signedTx.Hash().Hex(),
+		From:   strings.ToLower(c.signer.Hex()),
+		Nonce:  signedTx.Nonce(),
+		To:     strings.ToLower(to),
+		Amount: new(big.Int).Set(amount),
+		Gas:    gas,
+		TipCap: fees.TipCap,
+		FeeCap: fees.FeeCap,
+	}
+}
@@ -0,0 +1,65 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FeeQuote holds EIP-1559 fee parameters at current network conditions (wei)
+type FeeQuote struct {
+	BaseFee *big.Int
+	TipCap  *big.Int
+	// FeeCap is the max fee per gas: tip + base fee headroom of the urgency level
+	FeeCap *big.Int
+}
+
+// EffectivePrice returns the expected price per gas if included in the next block (base fee + tip)
+func (q *FeeQuote) EffectivePrice() *big.Int {
+	return new(big.Int).Add(q.BaseFee, q.TipCap)
+}
+
+// SuggestFees returns the fee parameters of a standard-urgency transaction
+func (c *EthClient) SuggestFees(ctx context.Context) (*FeeQuote, error) {
+	return c.gas.Estimate(ctx, UrgencyStandard)
+}
+
+// EstimateFees returns the fee parameters of a transaction of the given urgency
+func (c *EthClient) EstimateFees(ctx context.Context, urgency Urgency) (*FeeQuote, error) {
+	return c.gas.Estimate(ctx, urgency)
+}
+
+// EstimateTransferGas estimates the gas of a settlement token transfer from the platform signer
+func (c *EthClient) EstimateTransferGas(ctx context.Context, to string, amount *big.Int) (uint64, error) {
+	if c.signerKey == nil {
+		return 0, ErrSignerNotConfigured
+	}
+	if !common.IsHexAddress(to) {
+		return 0, ErrInvalidAddress
+	}
+	if amount == nil || amount.Sign() <= 0 {
+		return 0, ErrInvalidAmount
+	}
+
+	gas, err := c.client.EstimateGas(ctx, ethereum.CallMsg{
+		From: c.signer,
+		To:   &c.token,
+		Data: encodeTransfer(to, amount),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("estimate gas: %w", err)
+	}
+	return gas, nil
+}
+
+// encodeTransfer ABI-encodes transfer(to, amount)
+func encodeTransfer(to string, amount *big.Int) []byte {
+	data := make([]byte, 0, 4+32+32)
+	data = append(data, erc20TransferSelector...)
+	data = append(data, common.LeftPadBytes(common.HexToAddress(to).Bytes(), 32)...)
+	data = append(data, common.LeftPadBytes(amount.Bytes(), 32)...)
+	return data
+}
@@ -0,0 +1,280 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"go.uber.org/zap"
+)
+
+// Urgency selects how aggressively a transaction is priced
+type Urgency string
+
+const (
+	// UrgencyLow targets inclusion within a few blocks at a low tip
+	UrgencyLow Urgency = "low"
+	// UrgencyStandard targets inclusion in the next blocks at the median tip (default)
+	UrgencyStandard Urgency = "standard"
+	// UrgencyHigh targets next-block inclusion even when base fees rise sharply
+	UrgencyHigh Urgency = "high"
+)
+
+// Gas manager defaults
+const (
+	// DefaultFeeHistoryBlocks is the number of recent blocks sampled for priority fees
+	DefaultFeeHistoryBlocks = 20
+	// DefaultFeeRefreshInterval is how long observed fees are reused before refreshing
+	DefaultFeeRefreshInterval = 12 * time.Second
+)
+
+// urgencyPolicy is the pricing of an urgency level: the priority fee percentile of recent
+// blocks and the base fee headroom (max fee = tip + base fee x multiplier)
+type urgencyPolicy struct {
+	percentile float64
+	multiplier int64
+}
+
+// urgencyPolicies price urgency levels.
+// Why: base fee는 블록당 최대 12.5% 상승 → 2배 여유면 약 6블록, 3배면 약 9블록 연속 상승까지 대기열 유지
+var urgencyPolicies = map[Urgency]urgencyPolicy{
+	UrgencyLow:      {percentile: 10, multiplier: 2},
+	UrgencyStandard: {percentile: 50, multiplier: 2},
+	UrgencyHigh:     {percentile: 90, multiplier: 3},
+}
+
+// ErrUnknownUrgency is returned for urgency levels other than low/standard/high
+var ErrUnknownUrgency = errors.New("unknown urgency level")
+
+// ParseUrgency validates an urgency level (empty = standard)
+func ParseUrgency(s string) (Urgency, error) {
+	if s == "" {
+		return UrgencyStandard, nil
+	}
+	urgency := Urgency(s)
+	if _, ok := urgencyPolicies[urgency]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrUnknownUrgency, s)
+	}
+	return urgency, nil
+}
+
+// GasManagerConfig holds fee estimation settings
+type GasManagerConfig struct {
+	// HistoryBlocks is the number of recent blocks sampled (0 = DefaultFeeHistoryBlocks)
+	HistoryBlocks uint64
+	// RefreshInterval is how long observed fees are reused (0 = DefaultFeeRefreshInterval)
+	RefreshInterval time.Duration
+	// MinTipCap is the priority fee floor in wei (nil = 0)
+	MinTipCap *big.Int
+	// MaxFeeCap caps the max fee per gas in wei (nil = uncapped).
+	// Quotes that cannot cover the next base fee plus tip under the cap fail with ErrFeeCapExceeded.
+	MaxFeeCap *big.Int
+}
+
+// ErrFeeCapExceeded is returned when network fees exceed the configured max fee per gas
+var ErrFeeCapExceeded = errors.New("network fees exceed the configured max fee per gas")
+
+// feeReader is the subset of the RPC client the gas manager needs (implemented by ethclient.Client)
+type feeReader interface {
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// FeeObservation is the fee state observed from recent blocks (wei)
+type FeeObservation struct {
+	// NextBaseFee is the base fee of the next block
+	NextBaseFee *big.Int
+	// TipCaps are the priority fees per urgency level
+	TipCaps    map[Urgency]*big.Int
+	ObservedAt time.Time
+}
+
+// GasManager tracks EIP-1559 base and priority fees and prices transactions by urgency.
+// Observations are cached for RefreshInterval so concurrent callers share one RPC round trip.
+type GasManager struct {
+	client feeReader
+	config GasManagerConfig
+	logger *zap.Logger
+
+	mu     sync.Mutex
+	latest *FeeObservation
+}
+
+// NewGasManager creates a gas manager reading fees from the RPC client
+func NewGasManager(client feeReader, config GasManagerConfig, logger *zap.Logger) *GasManager {
+	if config.HistoryBlocks == 0 {
+		config.HistoryBlocks = DefaultFeeHistoryBlocks
+	}
+	if config.RefreshInterval <= 0 {
+		config.RefreshInterval = DefaultFeeRefreshInterval
+	}
+	if config.MinTipCap == nil {
+		config.MinTipCap = new(big.Int)
+	}
+
+	return &GasManager{
+		client: client,
+		config: config,
+		logger: logger,
+	}
+}
+
+// Estimate returns fee parameters for a transaction of the given urgency
+func (m *GasManager) Estimate(ctx context.Context, urgency Urgency) (*FeeQuote, error) {
+	policy, ok := urgencyPolicies[urgency]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownUrgency, urgency)
+	}
+
+	observed, err := m.Observe(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	baseFee := new(big.Int).Set(observed.NextBaseFee)
+	tipCap := new(big.Int).Set(observed.TipCaps[urgency])
+	feeCap := new(big.Int).Add(tipCap, new(big.Int).Mul(baseFee, big.NewInt(policy.multiplier)))
+
+	if m.config.MaxFeeCap != nil && feeCap.Cmp(m.config.MaxFeeCap) > 0 {
+		// 상한 적용 - 팁만으로 상한을 넘거나 현재 base fee도 감당 못 하면 포함될 수 없으므로 실패
+		if tipCap.Cmp(m.config.MaxFeeCap) > 0 || new(big.Int).Add(baseFee, tipCap).Cmp(m.config.MaxFeeCap) > 0 {
+			return nil, fmt.Errorf("%w: base fee %s, tip %s, cap %s", ErrFeeCapExceeded, baseFee, tipCap, m.config.MaxFeeCap)
+		}
+		feeCap = new(big.Int).Set(m.config.MaxFeeCap)
+	}
+
+	return &FeeQuote{
+		BaseFee: baseFee,
+		TipCap:  tipCap,
+		FeeCap:  feeCap,
+	}, nil
+}
+
+// Observe returns the latest fee observation, refreshing it when older than RefreshInterval
+func (m *GasManager) Observe(ctx context.Context) (*FeeObservation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.latest != nil && time.Since(m.latest.ObservedAt) < m.config.RefreshInterval {
+		return m.latest, nil
+	}
+
+	observed, err := m.observeFeeHistory(ctx)
+	if err != nil {
+		// eth_feeHistory 미지원 노드 → 노드 추천 팁 + 최신 base fee로 대체
+		logctx.From(ctx, m.logger).Warn("fee history unavailable, falling back to suggested tip", zap.Error(err))
+		if observed, err = m.observeSuggested(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	m.latest = observed
+	return observed, nil
+}
+
+// observeFeeHistory samples priority fee percentiles over recent blocks (eth_feeHistory)
+func (m *GasManager) observeFeeHistory(ctx context.Context) (*FeeObservation, error) {
+	urgencies := []Urgency{UrgencyLow, UrgencyStandard, UrgencyHigh}
+	percentiles := make([]float64, len(urgencies))
+	for i, urgency := range urgencies {
+		percentiles[i] = urgencyPolicies[urgency].percentile
+	}
+
+	history, err := m.client.FeeHistory(ctx, m.config.HistoryBlocks, nil, percentiles)
+	if err != nil {
+		return nil, fmt.Errorf("fee history: %w", err)
+	}
+	if len(history.BaseFee) == 0 || len(history.Reward) == 0 {
+		return nil, fmt.Errorf("fee history: empty result")
+	}
+
+	// BaseFee has one more entry than blocks: the base fee of the next block
+	nextBaseFee := history.BaseFee[len(history.BaseFee)-1]
+	if nextBaseFee == nil || nextBaseFee.Sign() == 0 {
+		return nil, fmt.Errorf("fee history: no base fee (pre-London chain)")
+	}
+
+	observed := &FeeObservation{
+		NextBaseFee: nextBaseFee,
+		TipCaps:     make(map[Urgency]*big.Int, len(urgencies)),
+		ObservedAt:  time.Now(),
+	}
+	for i, urgency := range urgencies {
+		// 블록별 percentile 값의 중앙값 (일시적 급등 블록 영향 완화)
+		samples := make([]*big.Int, 0, len(history.Reward))
+		for _, rewards := range history.Reward {
+			if i < len(rewards) && rewards[i] != nil {
+				samples = append(samples, rewards[i])
+			}
+		}
+		observed.TipCaps[urgency] = m.floorTip(median(samples))
+	}
+
+	// 긴급도 간 역전 방지 (표본이 적은 구간에서 low > standard 가능)
+	if observed.TipCaps[UrgencyLow].Cmp(observed.TipCaps[UrgencyStandard]) > 0 {
+		observed.TipCaps[UrgencyLow] = observed.TipCaps[UrgencyStandard]
+	}
+	if observed.TipCaps[UrgencyHigh].Cmp(observed.TipCaps[UrgencyStandard]) < 0 {
+		observed.TipCaps[UrgencyHigh] = observed.TipCaps[UrgencyStandard]
+	}
+	return observed, nil
+}
+
+// observeSuggested uses the node's suggested tip for every urgency and the latest base fee
+func (m *GasManager) observeSuggested(ctx context.Context) (*FeeObservation, error) {
+	tipCap, err := m.client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("suggest gas tip cap: %w", err)
+	}
+	head, err := m.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get latest header: %w", err)
+	}
+	if head.BaseFee == nil {
+		return nil, fmt.Errorf("latest header has no base fee (pre-London chain)")
+	}
+
+	tipCap = m.floorTip(tipCap)
+	return &FeeObservation{
+		NextBaseFee: head.BaseFee,
+		TipCaps: map[Urgency]*big.Int{
+			UrgencyLow:      tipCap,
+			UrgencyStandard: tipCap,
+			UrgencyHigh:     tipCap,
+		},
+		ObservedAt: time.Now(),
+	}, nil
+}
+
+// floorTip applies the MinTipCap floor
+func (m *GasManager) floorTip(tip *big.Int) *big.Int {
+	if tip == nil || tip.Cmp(m.config.MinTipCap) < 0 {
+		return new(big.Int).Set(m.config.MinTipCap)
+	}
+	return tip
+}
+
+// median returns the median of the values (nil when empty)
+func median(values []*big.Int) *big.Int {
+	if len(values) == 0 {
+		return nil
+	}
+	sorted := append([]*big.Int(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Cmp(sorted[j]) < 0 })
+	return sorted[len(sorted)/2]
+}
+
+// GweiToWei converts a gwei amount to wei (config helper)
+func GweiToWei(gwei float64) *big.Int {
+	wei, _ := new(big.Float).Mul(big.NewFloat(gwei), big.NewFloat(params.GWei)).Int(nil)
+	return wei
+}
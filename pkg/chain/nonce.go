@@ -0,0 +1,122 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultNonceGapTimeout is how long an allocated nonce may stay unseen on chain before it is reused
+	DefaultNonceGapTimeout = 2 * time.Minute
+
+	// NonceBackendRedis / NonceBackendMemory select the NonceStore implementation (CHAIN_NONCE_STORE)
+	NonceBackendRedis  = "redis"
+	NonceBackendMemory = "memory"
+)
+
+// NonceStore allocates account nonces of a signing address shared by every broadcasting instance.
+//
+// Allocated nonces are tracked until the node reports them (floor = pending nonce). An allocation
+// still above the floor after the gap timeout never reached the node (crash before broadcast,
+// dropped tx) and would block every later transaction, so it is recycled by the next Allocate.
+type NonceStore interface {
+	// Allocate reserves the lowest reusable nonce, or the next fresh one (never below floor).
+	// Allocations made before staleBefore that are still >= floor are recycled; returns how many were.
+	Allocate(ctx context.Context, key string, floor uint64, now, staleBefore time.Time) (nonce uint64, recovered int, err error)
+
+	// Release returns an allocated nonce that was not broadcast so the next allocation reuses it
+	Release(ctx context.Context, key string, nonce uint64) error
+
+	// Discard forgets an allocated nonce that is already used on chain (e.g. "nonce too low")
+	Discard(ctx context.Context, key string, nonce uint64) error
+}
+
+// pendingNonceReader is the subset of the RPC client the nonce manager needs (implemented by ethclient.Client)
+type pendingNonceReader interface {
+	PendingNonceAt(ctx context.Context, account common.Address) (uint64, error)
+}
+
+// NonceManager hands out nonces of the platform signer for concurrent broadcasts.
+// Why: 여러 지급이 동시에 같은 키로 전송되면 PendingNonceAt이 같은 값을 돌려줘 nonce가 충돌함
+type NonceManager struct {
+	store      NonceStore
+	reader     pendingNonceReader
+	address    common.Address
+	key        string
+	gapTimeout time.Duration
+	logger     *zap.Logger
+}
+
+// NewNonceManager creates a nonce manager for the signer on the chain (gapTimeout 0 = DefaultNonceGapTimeout)
+func NewNonceManager(store NonceStore, reader pendingNonceReader, chainID int64, address common.Address, gapTimeout time.Duration, logger *zap.Logger) *NonceManager {
+	if gapTimeout <= 0 {
+		gapTimeout = DefaultNonceGapTimeout
+	}
+	return &NonceManager{
+		store:      store,
+		reader:     reader,
+		address:    address,
+		key:        fmt.Sprintf("%d:%s", chainID, strings.ToLower(address.Hex())),
+		gapTimeout: gapTimeout,
+		logger:     logger,
+	}
+}
+
+// Next allocates the nonce of the next transaction.
+// The caller must Release it when the transaction is not broadcast.
+func (m *NonceManager) Next(ctx context.Context) (uint64, error) {
+	floor, err := m.reader.PendingNonceAt(ctx, m.address)
+	if err != nil {
+		return 0, fmt.Errorf("get pending nonce: %w", err)
+	}
+
+	now := time.Now()
+	nonce, recovered, err := m.store.Allocate(ctx, m.key, floor, now, now.Add(-m.gapTimeout))
+	if err != nil {
+		return 0, fmt.Errorf("allocate nonce: %w", err)
+	}
+	if recovered > 0 {
+		logctx.From(ctx, m.logger).Warn("nonce gap detected, recycling unbroadcast nonces",
+			zap.String("signer", m.address.Hex()),
+			zap.Uint64("pending_nonce", floor),
+			zap.Int("recovered", recovered),
+		)
+	}
+	return nonce, nil
+}
+
+// Done settles a nonce after a broadcast attempt: a failed broadcast releases it for reuse,
+// unless the node reports it as already used
+func (m *NonceManager) Done(ctx context.Context, nonce uint64, sendErr error) {
+	if sendErr == nil {
+		return
+	}
+
+	// 호출자 취소와 무관하게 반환 (반환 실패 시 gap timeout 후 재사용)
+	ctx = context.WithoutCancel(ctx)
+	var err error
+	if IsNonceTooLow(sendErr) {
+		err = m.store.Discard(ctx, m.key, nonce)
+	} else {
+		err = m.store.Release(ctx, m.key, nonce)
+	}
+	if err != nil {
+		logctx.From(ctx, m.logger).Warn("failed to return nonce, it will be recycled after the gap timeout",
+			zap.String("signer", m.address.Hex()),
+			zap.Uint64("nonce", nonce),
+			zap.Error(err),
+		)
+	}
+}
+
+// IsNonceTooLow reports whether a broadcast failed because the nonce is already used
+// NOTE: JSON-RPC 에러는 타입이 보존되지 않아 노드 메시지(core.ErrNonceTooLow)로 판별
+func IsNonceTooLow(err error) bool {
+	return err != nil && strings.Contains(strings.ToLower(err.Error()), "nonce too low")
+}
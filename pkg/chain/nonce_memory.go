@@ -0,0 +1,114 @@
+package chain
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// memoryNonceState is the nonce state of one signer
+type memoryNonceState struct {
+	next      uint64
+	allocated map[uint64]time.Time
+	released  map[uint64]struct{}
+}
+
+// MemoryNonceStore implements NonceStore with process-local state.
+// Intended for local development and single-instance deployments - allocations are not
+// shared across instances, so it must not back multiple broadcasting instances.
+type MemoryNonceStore struct {
+	mu      sync.Mutex
+	signers map[string]*memoryNonceState
+}
+
+// Compile-time interface compliance check
+var _ NonceStore = (*MemoryNonceStore)(nil)
+
+// NewMemoryNonceStore creates a new in-memory nonce store
+func NewMemoryNonceStore() *MemoryNonceStore {
+	return &MemoryNonceStore{signers: make(map[string]*memoryNonceState)}
+}
+
+// Allocate reserves a nonce (same rules as the Redis store)
+func (s *MemoryNonceStore) Allocate(ctx context.Context, key string, floor uint64, now, staleBefore time.Time) (uint64, int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state := s.state(key)
+	for nonce := range state.released {
+		if nonce < floor {
+			delete(state.released, nonce)
+		}
+	}
+
+	recovered := 0
+	for nonce, allocatedAt := range state.allocated {
+		if !allocatedAt.Before(staleBefore) {
+			continue
+		}
+		delete(state.allocated, nonce)
+		if nonce >= floor {
+			state.released[nonce] = struct{}{}
+			recovered++
+		}
+	}
+
+	nonce, ok := lowestNonce(state.released)
+	if ok {
+		delete(state.released, nonce)
+	} else {
+		nonce = max(state.next, floor)
+		state.next = nonce + 1
+	}
+
+	state.allocated[nonce] = now
+	return nonce, recovered, nil
+}
+
+// Release returns an unbroadcast nonce to the released set
+func (s *MemoryNonceStore) Release(ctx context.Context, key string, nonce uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state := s.state(key)
+	if _, ok := state.allocated[nonce]; ok {
+		delete(state.allocated, nonce)
+		state.released[nonce] = struct{}{}
+	}
+	return nil
+}
+
+// Discard forgets an allocation
+func (s *MemoryNonceStore) Discard(ctx context.Context, key string, nonce uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.state(key).allocated, nonce)
+	return nil
+}
+
+// state returns the signer state, creating it on first use (caller holds mu)
+func (s *MemoryNonceStore) state(key string) *memoryNonceState {
+	state, ok := s.signers[key]
+	if !ok {
+		state = &memoryNonceState{
+			allocated: make(map[uint64]time.Time),
+			released:  make(map[uint64]struct{}),
+		}
+		s.signers[key] = state
+	}
+	return state
+}
+
+// lowestNonce returns the smallest nonce of the set
+func lowestNonce(set map[uint64]struct{}) (uint64, bool) {
+	var lowest uint64
+	found := false
+	for nonce := range set {
+		if !found || nonce < lowest {
+			lowest = nonce
+			found = true
+		}
+	}
+	return lowest, found
+}
@@ -0,0 +1,102 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// nonceKeyPrefix is the Redis key prefix for platform signer nonces
+const nonceKeyPrefix = "chain:nonce"
+
+// allocateNonceScript allocates a nonce atomically.
+// KEYS[1] = next fresh nonce, KEYS[2] = allocations (score = allocated at ms), KEYS[3] = released (score = nonce)
+// ARGV[1] = floor (pending nonce), ARGV[2] = now ms, ARGV[3] = stale before ms
+// Returns {nonce, recovered}
+var allocateNonceScript = redis.NewScript(`
+local floor = tonumber(ARGV[1])
+redis.call('ZREMRANGEBYSCORE', KEYS[3], '-inf', '(' .. floor)
+
+local recovered = 0
+local stale = redis.call('ZRANGEBYSCORE', KEYS[2], '-inf', '(' .. ARGV[3])
+for _, member in ipairs(stale) do
+	redis.call('ZREM', KEYS[2], member)
+	if tonumber(member) >= floor then
+		redis.call('ZADD', KEYS[3], member, member)
+		recovered = recovered + 1
+	end
+end
+
+local nonce
+local released = redis.call('ZRANGE', KEYS[3], 0, 0)
+if #released > 0 then
+	nonce = tonumber(released[1])
+	redis.call('ZREM', KEYS[3], released[1])
+else
+	nonce = tonumber(redis.call('GET', KEYS[1]) or floor)
+	if nonce < floor then
+		nonce = floor
+	end
+	redis.call('SET', KEYS[1], nonce + 1)
+end
+
+redis.call('ZADD', KEYS[2], ARGV[2], nonce)
+return {nonce, recovered}
+`)
+
+// releaseNonceScript moves an allocation back to the released set
+// KEYS[1] = allocations, KEYS[2] = released, ARGV[1] = nonce
+var releaseNonceScript = redis.NewScript(`
+if redis.call('ZREM', KEYS[1], ARGV[1]) == 1 then
+	redis.call('ZADD', KEYS[2], ARGV[1], ARGV[1])
+end
+return 1
+`)
+
+// RedisNonceStore implements NonceStore using Redis (shared across instances)
+type RedisNonceStore struct {
+	client *redis.Client
+}
+
+// Compile-time interface compliance check
+var _ NonceStore = (*RedisNonceStore)(nil)
+
+// NewRedisNonceStore creates a new Redis-based nonce store
+func NewRedisNonceStore(client *redis.Client) *RedisNonceStore {
+	return &RedisNonceStore{client: client}
+}
+
+// buildNonceKeys creates the Redis keys of a signer
+// Format: chain:nonce:{chainID:address}:next|allocated|released (hash tag → same cluster slot for Lua)
+func buildNonceKeys(key string) []string {
+	return []string{
+		fmt.Sprintf("%s:{%s}:next", nonceKeyPrefix, key),
+		fmt.Sprintf("%s:{%s}:allocated", nonceKeyPrefix, key),
+		fmt.Sprintf("%s:{%s}:released", nonceKeyPrefix, key),
+	}
+}
+
+// Allocate reserves a nonce (Lua script)
+func (s *RedisNonceStore) Allocate(ctx context.Context, key string, floor uint64, now, staleBefore time.Time) (uint64, int, error) {
+	res, err := allocateNonceScript.Run(ctx, s.client, buildNonceKeys(key), floor, now.UnixMilli(), staleBefore.UnixMilli()).Int64Slice()
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(res) != 2 || res[0] < 0 {
+		return 0, 0, fmt.Errorf("unexpected allocate result %v", res)
+	}
+	return uint64(res[0]), int(res[1]), nil
+}
+
+// Release returns an unbroadcast nonce to the released set
+func (s *RedisNonceStore) Release(ctx context.Context, key string, nonce uint64) error {
+	keys := buildNonceKeys(key)
+	return releaseNonceScript.Run(ctx, s.client, keys[1:], nonce).Err()
+}
+
+// Discard forgets an allocation
+func (s *RedisNonceStore) Discard(ctx context.Context, key string, nonce uint64) error {
+	return s.client.ZRem(ctx, buildNonceKeys(key)[1], nonce).Err()
+}
@@ -0,0 +1,85 @@
+package chain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/tracing"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// aggregatorLatestRoundDataSelector is the 4-byte selector of latestRoundData() on a Chainlink AggregatorV3
+var aggregatorLatestRoundDataSelector = crypto.Keccak256([]byte("latestRoundData()"))[:4]
+
+// aggregatorDecimalsSelector is the 4-byte selector of decimals() on a Chainlink AggregatorV3
+var aggregatorDecimalsSelector = crypto.Keccak256([]byte("decimals()"))[:4]
+
+// RoundData is the latest answer of a Chainlink price feed
+type RoundData struct {
+	RoundID *big.Int
+	// Answer is the price scaled by Decimals (e.g. KRW/USD 0.00072 = 72000 with 8 decimals)
+	Answer    *big.Int
+	Decimals  int
+	UpdatedAt time.Time
+}
+
+// LatestRoundData reads latestRoundData() and decimals() of a Chainlink AggregatorV3 feed
+func (c *EthClient) LatestRoundData(ctx context.Context, feed string) (round *RoundData, err error) {
+	ctx, span := tracer.Start(ctx, "chain.LatestRoundData", trace.WithAttributes(
+		attribute.String("chain.feed", feed),
+		attribute.Int64("chain.id", c.config.ChainID),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	if !common.IsHexAddress(feed) {
+		return nil, ErrInvalidAddress
+	}
+	contract := common.HexToAddress(feed)
+
+	out, err := c.client.CallContract(ctx, ethereum.CallMsg{To: &contract, Data: aggregatorDecimalsSelector}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("call decimals: %w", err)
+	}
+	if len(out) < 32 {
+		return nil, fmt.Errorf("call decimals: unexpected return length %d", len(out))
+	}
+	decimals := new(big.Int).SetBytes(out[:32])
+	if !decimals.IsInt64() || decimals.Int64() > maxTokenDecimals {
+		return nil, fmt.Errorf("call decimals: unexpected value %s", decimals)
+	}
+
+	// (uint80 roundId, int256 answer, uint256 startedAt, uint256 updatedAt, uint80 answeredInRound)
+	out, err = c.client.CallContract(ctx, ethereum.CallMsg{To: &contract, Data: aggregatorLatestRoundDataSelector}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("call latestRoundData: %w", err)
+	}
+	if len(out) < 32*5 {
+		return nil, fmt.Errorf("call latestRoundData: unexpected return length %d", len(out))
+	}
+	updatedAt := new(big.Int).SetBytes(out[96:128])
+	if !updatedAt.IsInt64() {
+		return nil, fmt.Errorf("call latestRoundData: unexpected updatedAt %s", updatedAt)
+	}
+
+	return &RoundData{
+		RoundID:   new(big.Int).SetBytes(out[:32]),
+		Answer:    toSigned256(out[32:64]),
+		Decimals:  int(decimals.Int64()),
+		UpdatedAt: time.Unix(updatedAt.Int64(), 0).UTC(),
+	}, nil
+}
+
+// toSigned256 decodes a two's-complement int256 word
+func toSigned256(word []byte) *big.Int {
+	value := new(big.Int).SetBytes(word)
+	if len(word) > 0 && word[0]&0x80 != 0 {
+		value.Sub(value, new(big.Int).Lsh(big.NewInt(1), 256))
+	}
+	return value
+}
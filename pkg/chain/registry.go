@@ -0,0 +1,82 @@
+package chain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Network is a chain on which users may register wallets
+type Network struct {
+	ChainID int64
+	Name    string
+	// VerifyingContract is the EIP-712 domain verifying contract on this chain
+	VerifyingContract string
+}
+
+// Registry holds the supported networks and the default chain.
+// Why: 지갑이 체인별 레코드가 되면서 허용 체인/EIP-712 도메인을 한 곳에서 관리
+type Registry struct {
+	defaultChainID int64
+	networks       []Network
+	byID           map[int64]Network
+}
+
+// ErrUnsupportedChain is returned for chain ids missing from the registry
+var ErrUnsupportedChain = errors.New("unsupported chain id")
+
+// NewRegistry builds a registry. defaultChainID must be one of the networks.
+func NewRegistry(defaultChainID int64, networks []Network) (*Registry, error) {
+	r := &Registry{
+		defaultChainID: defaultChainID,
+		networks:       make([]Network, 0, len(networks)),
+		byID:           make(map[int64]Network, len(networks)),
+	}
+
+	for _, network := range networks {
+		if network.ChainID <= 0 {
+			return nil, fmt.Errorf("network %q: chain id must be positive", network.Name)
+		}
+		if _, exists := r.byID[network.ChainID]; exists {
+			return nil, fmt.Errorf("network %q: duplicate chain id %d", network.Name, network.ChainID)
+		}
+		if !common.IsHexAddress(network.VerifyingContract) {
+			return nil, fmt.Errorf("network %q: verifying contract: %w", network.Name, ErrInvalidAddress)
+		}
+		r.networks = append(r.networks, network)
+		r.byID[network.ChainID] = network
+	}
+
+	if _, ok := r.byID[defaultChainID]; !ok {
+		return nil, fmt.Errorf("default chain %d: %w", defaultChainID, ErrUnsupportedChain)
+	}
+	return r, nil
+}
+
+// DefaultChainID returns the chain used when a request omits chain_id
+func (r *Registry) DefaultChainID() int64 {
+	return r.defaultChainID
+}
+
+// Get returns the network for the chain id
+func (r *Registry) Get(chainID int64) (Network, bool) {
+	network, ok := r.byID[chainID]
+	return network, ok
+}
+
+// Resolve maps 0 to the default chain and rejects chains outside the registry
+func (r *Registry) Resolve(chainID int64) (int64, error) {
+	if chainID == 0 {
+		return r.defaultChainID, nil
+	}
+	if _, ok := r.byID[chainID]; !ok {
+		return 0, ErrUnsupportedChain
+	}
+	return chainID, nil
+}
+
+// Networks returns the supported networks in configuration order
+func (r *Registry) Networks() []Network {
+	return append([]Network(nil), r.networks...)
+}
@@ -0,0 +1,189 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/tracing"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// replacementBumpPerMille is the minimum fee increase of a replacement (1125 = +12.5%).
+// Node txpools reject a same-nonce replacement unless both tip and fee cap rise by at least 10%.
+const replacementBumpPerMille = 1125
+
+var (
+	// ErrNonceConsumed is returned when a replacement's nonce is already used on chain
+	// (one of the earlier broadcasts, or an unknown transaction, was mined)
+	ErrNonceConsumed = errors.New("transaction nonce already used on chain")
+	// ErrSignerMismatch is returned when a transaction was sent by another signer than the configured one
+	ErrSignerMismatch = errors.New("transaction was sent by a different signer")
+)
+
+// SentTx is a settlement token transfer broadcast by the platform signer
+type SentTx struct {
+	Hash string
+	// From is the signer address (lowercase hex)
+	From  string
+	Nonce uint64
+	// To is the transfer recipient (lowercase hex), Amount the base units sent
+	To     string
+	Amount *big.Int
+	Gas    uint64
+	TipCap *big.Int
+	FeeCap *big.Int
+}
+
+// TxReceipt is the inclusion result of a mined transaction
+type TxReceipt struct {
+	Hash        string
+	BlockNumber uint64
+	// Success is false for a reverted transaction
+	Success bool
+}
+
+// ReplaceTransfer re-broadcasts a pending transfer with the same nonce and bumped fees
+// (at least +12.5% over the previous broadcast, or the current urgency quote if higher).
+// Returns ErrNonceConsumed when the nonce was mined meanwhile, and ErrFeeCapExceeded when
+// the bumped fee cap would exceed the configured max fee per gas.
+func (c *EthClient) ReplaceTransfer(ctx context.Context, previous *SentTx, urgency Urgency) (sent *SentTx, err error) {
+	ctx, span := tracer.Start(ctx, "chain.ReplaceTransfer", trace.WithAttributes(
+		attribute.String("chain.replaced_tx_hash", previous.Hash),
+		attribute.Int64("chain.nonce", int64(previous.Nonce)),
+		attribute.Int64("chain.id", c.config.ChainID),
+	))
+	defer func() {
+		if sent != nil {
+			span.SetAttributes(attribute.String("chain.tx_hash", sent.Hash))
+		}
+		tracing.End(span, err)
+	}()
+
+	if c.signerKey == nil {
+		return nil, ErrSignerNotConfigured
+	}
+	// 서명 키가 교체된 경우 다른 주소로 같은 nonce를 보내면 이중 지급 → 원래 signer만 교체 가능
+	if !strings.EqualFold(previous.From, c.signer.Hex()) {
+		return nil, ErrSignerMismatch
+	}
+	if !common.IsHexAddress(previous.To) {
+		return nil, ErrInvalidAddress
+	}
+	if previous.Amount == nil || previous.Amount.Sign() <= 0 {
+		return nil, ErrInvalidAmount
+	}
+
+	// 1. Fees: current quote, but never below the txpool replacement threshold
+	fees, err := c.EstimateFees(ctx, urgency)
+	if err != nil {
+		return nil, err
+	}
+	tipCap := maxBig(fees.TipCap, bumpFee(previous.TipCap))
+	feeCap := maxBig(fees.FeeCap, bumpFee(previous.FeeCap))
+	if feeCap.Cmp(tipCap) < 0 {
+		feeCap = new(big.Int).Set(tipCap)
+	}
+	if maxFee := c.gas.config.MaxFeeCap; maxFee != nil && feeCap.Cmp(maxFee) > 0 {
+		return nil, fmt.Errorf("%w: replacement fee cap %s, cap %s", ErrFeeCapExceeded, feeCap, maxFee)
+	}
+
+	// 2. Sign + broadcast with the same nonce and gas limit
+	tx := types.NewTx(&types.DynamicFeeTx{
+		ChainID:   c.chainID,
+		Nonce:     previous.Nonce,
+		GasTipCap: tipCap,
+		GasFeeCap: feeCap,
+		Gas:       previous.Gas,
+		To:        &c.token,
+		Data:      encodeTransfer(previous.To, previous.Amount),
+	})
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(c.chainID), c.signerKey)
+	if err != nil {
+		return nil, fmt.Errorf("sign tx: %w", err)
+	}
+	if err := c.client.SendTransaction(ctx, signedTx); err != nil {
+		if IsNonceTooLow(err) {
+			return nil, fmt.Errorf("%w: %v", ErrNonceConsumed, err)
+		}
+		return nil, fmt.Errorf("send tx: %w", err)
+	}
+
+	logctx.From(ctx, c.logger).Info("token transfer replaced",
+		zap.String("tx_hash", signedTx.Hash().Hex()),
+		zap.String("replaced_tx_hash", previous.Hash),
+		zap.Uint64("nonce", previous.Nonce),
+		zap.String("tip_cap", tipCap.String()),
+		zap.String("fee_cap", feeCap.String()),
+	)
+
+	return &SentTx{
+		Hash:   signedTx.Hash().Hex(),
+		From:   previous.From,
+		Nonce:  previous.Nonce,
+		To:     previous.To,
+		Amount: new(big.Int).Set(previous.Amount),
+		Gas:    previous.Gas,
+		TipCap: tipCap,
+		FeeCap: feeCap,
+	}, nil
+}
+
+// TxReceipt returns the inclusion result of a transaction (ErrTxNotFound while pending or unknown)
+func (c *EthClient) TxReceipt(ctx context.Context, txHash string) (*TxReceipt, error) {
+	if !IsTxHash(txHash) {
+		return nil, ErrInvalidTxHash
+	}
+
+	receipt, err := c.client.TransactionReceipt(ctx, common.HexToHash(txHash))
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return nil, ErrTxNotFound
+		}
+		return nil, fmt.Errorf("get receipt: %w", err)
+	}
+	return &TxReceipt{
+		Hash:        receipt.TxHash.Hex(),
+		BlockNumber: receipt.BlockNumber.Uint64(),
+		Success:     receipt.Status == types.ReceiptStatusSuccessful,
+	}, nil
+}
+
+// ConfirmedNonce returns the nonce of the address at the latest block
+// (every nonce below it is mined)
+func (c *EthClient) ConfirmedNonce(ctx context.Context, address string) (uint64, error) {
+	if !common.IsHexAddress(address) {
+		return 0, ErrInvalidAddress
+	}
+	nonce, err := c.client.NonceAt(ctx, common.HexToAddress(address), nil)
+	if err != nil {
+		return 0, fmt.Errorf("get nonce: %w", err)
+	}
+	return nonce, nil
+}
+
+// bumpFee returns the minimum replacement price of a fee (rounded up)
+func bumpFee(fee *big.Int) *big.Int {
+	if fee == nil {
+		return new(big.Int)
+	}
+	bumped := new(big.Int).Mul(fee, big.NewInt(replacementBumpPerMille))
+	bumped.Add(bumped, big.NewInt(999))
+	return bumped.Div(bumped, big.NewInt(1000))
+}
+
+// maxBig returns a copy of the larger value
+func maxBig(a, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return new(big.Int).Set(a)
+	}
+	return new(big.Int).Set(b)
+}
@@ -0,0 +1,171 @@
+package chain
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// maxTokenDecimals bounds token decimals (ERC-20 decimals() is uint8; 18 is the practical max)
+const maxTokenDecimals = 36
+
+// tokenSymbolPattern matches registry symbols (stored in orders/payments token_symbol)
+var tokenSymbolPattern = regexp.MustCompile(`^[A-Z0-9]{2,10}$`)
+
+// ErrUnsupportedToken is returned for token symbols missing from the registry
+// or not enabled on the requested chain
+var ErrUnsupportedToken = errors.New("unsupported token")
+
+// Token is a stablecoin the platform settles in
+type Token struct {
+	// Symbol is the uppercase ticker (e.g. USDC)
+	Symbol   string
+	Decimals int
+	// Addresses maps each enabled chain to the token contract (lowercase hex)
+	Addresses map[int64]string
+}
+
+// ChainIDs returns the chains the token is enabled on (ascending)
+func (t Token) ChainIDs() []int64 {
+	chainIDs := make([]int64, 0, len(t.Addresses))
+	for chainID := range t.Addresses {
+		chainIDs = append(chainIDs, chainID)
+	}
+	sort.Slice(chainIDs, func(i, j int) bool { return chainIDs[i] < chainIDs[j] })
+	return chainIDs
+}
+
+// Address returns the token contract on the chain
+func (t Token) Address(chainID int64) (string, bool) {
+	address, ok := t.Addresses[chainID]
+	return address, ok
+}
+
+// FormatUnits formats a base-unit amount with the token's decimals
+func (t Token) FormatUnits(amount *big.Int) string {
+	return FormatUnits(amount, t.Decimals)
+}
+
+// ParseUnits parses a decimal amount into the token's base units
+func (t Token) ParseUnits(value string) (*big.Int, error) {
+	return ParseUnits(value, t.Decimals)
+}
+
+// Money returns a base-unit amount of the token (e.g. an on-chain transfer value)
+func (t Token) Money(units *big.Int) money.Money {
+	return money.New(units, t.Symbol, t.Decimals)
+}
+
+// TokenRegistry holds the supported settlement tokens and the default one.
+// Why: TOKEN_ADDRESS 단일 설정으로는 USDT/DAI 등 다른 스테이블코인 결제와 토큰별 decimals 처리가 불가
+type TokenRegistry struct {
+	defaultSymbol string
+	tokens        []Token
+	bySymbol      map[string]Token
+}
+
+// NewTokenRegistry builds a token registry. Entries of the same symbol are merged
+// (one per chain) and must agree on decimals; defaultSymbol must be one of the tokens.
+func NewTokenRegistry(defaultSymbol string, tokens []Token) (*TokenRegistry, error) {
+	r := &TokenRegistry{
+		defaultSymbol: strings.ToUpper(defaultSymbol),
+		bySymbol:      make(map[string]Token, len(tokens)),
+	}
+
+	var order []string
+	for _, token := range tokens {
+		symbol := strings.ToUpper(strings.TrimSpace(token.Symbol))
+		if !tokenSymbolPattern.MatchString(symbol) {
+			return nil, fmt.Errorf("token %q: symbol must be 2-10 uppercase letters or digits", token.Symbol)
+		}
+		if token.Decimals < 0 || token.Decimals > maxTokenDecimals {
+			return nil, fmt.Errorf("token %s: decimals must be between 0 and %d", symbol, maxTokenDecimals)
+		}
+
+		merged, exists := r.bySymbol[symbol]
+		if !exists {
+			merged = Token{Symbol: symbol, Decimals: token.Decimals, Addresses: make(map[int64]string)}
+			order = append(order, symbol)
+		}
+		// 체인별 decimals가 다른 토큰은 별도 심볼로 등록 (예: BSC USDC 18 decimals)
+		if merged.Decimals != token.Decimals {
+			return nil, fmt.Errorf("token %s: conflicting decimals %d and %d", symbol, merged.Decimals, token.Decimals)
+		}
+		for chainID, address := range token.Addresses {
+			if chainID <= 0 {
+				return nil, fmt.Errorf("token %s: chain id must be positive", symbol)
+			}
+			if !common.IsHexAddress(address) {
+				return nil, fmt.Errorf("token %s on chain %d: %w", symbol, chainID, ErrInvalidAddress)
+			}
+			if _, dup := merged.Addresses[chainID]; dup {
+				return nil, fmt.Errorf("token %s: duplicate chain id %d", symbol, chainID)
+			}
+			merged.Addresses[chainID] = strings.ToLower(address)
+		}
+		r.bySymbol[symbol] = merged
+	}
+
+	for _, symbol := range order {
+		token := r.bySymbol[symbol]
+		if len(token.Addresses) == 0 {
+			return nil, fmt.Errorf("token %s: no enabled chains", symbol)
+		}
+		r.tokens = append(r.tokens, token)
+	}
+
+	if _, ok := r.bySymbol[r.defaultSymbol]; !ok {
+		return nil, fmt.Errorf("default token %q: %w", defaultSymbol, ErrUnsupportedToken)
+	}
+	return r, nil
+}
+
+// DefaultSymbol returns the token used when an order or payment omits the token
+func (r *TokenRegistry) DefaultSymbol() string {
+	return r.defaultSymbol
+}
+
+// Get returns the token for the symbol (case-insensitive)
+func (r *TokenRegistry) Get(symbol string) (Token, bool) {
+	token, ok := r.bySymbol[strings.ToUpper(strings.TrimSpace(symbol))]
+	return token, ok
+}
+
+// Resolve maps an empty symbol to the default token and rejects tokens outside the
+// registry or not enabled on the chain (chainID 0 = any chain)
+func (r *TokenRegistry) Resolve(symbol string, chainID int64) (Token, error) {
+	if strings.TrimSpace(symbol) == "" {
+		symbol = r.defaultSymbol
+	}
+	token, ok := r.Get(symbol)
+	if !ok {
+		return Token{}, ErrUnsupportedToken
+	}
+	if chainID != 0 {
+		if _, ok := token.Address(chainID); !ok {
+			return Token{}, fmt.Errorf("%w: %s is not enabled on chain %d", ErrUnsupportedToken, token.Symbol, chainID)
+		}
+	}
+	return token, nil
+}
+
+// ByAddress returns the token deployed at the address on the chain
+func (r *TokenRegistry) ByAddress(chainID int64, address string) (Token, bool) {
+	for _, token := range r.tokens {
+		if tokenAddress, ok := token.Address(chainID); ok && strings.EqualFold(tokenAddress, address) {
+			return token, true
+		}
+	}
+	return Token{}, false
+}
+
+// Tokens returns the supported tokens in configuration order
+func (r *TokenRegistry) Tokens() []Token {
+	return append([]Token(nil), r.tokens...)
+}
@@ -0,0 +1,78 @@
+package chain
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer emits chain operation and JSON-RPC spans
+var tracer = tracing.Tracer("github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain")
+
+// tracingTransport wraps the RPC HTTP transport so every JSON-RPC call
+// (including ones added later to Client implementations) gets a client span.
+// NOTE: ws/ipc 엔드포인트는 HTTP 클라이언트를 사용하지 않으므로 계측 대상 아님
+type tracingTransport struct {
+	base http.RoundTripper
+}
+
+// newTracingHTTPClient returns an HTTP client for rpc.WithHTTPClient
+func newTracingHTTPClient() *http.Client {
+	return &http.Client{Transport: &tracingTransport{base: http.DefaultTransport}}
+}
+
+// RoundTrip starts a span named after the JSON-RPC method and injects traceparent
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	method := "unknown"
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		method = rpcMethod(body)
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	ctx, span := tracer.Start(req.Context(), "chain.rpc "+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "jsonrpc"),
+			attribute.String("rpc.method", method),
+		),
+	)
+
+	// RoundTripper must not mutate the caller's request
+	out := req.Clone(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(out.Header))
+
+	resp, err := t.base.RoundTrip(out)
+	if err == nil {
+		span.SetAttributes(attribute.Int("http.status_code", resp.StatusCode))
+	}
+	tracing.End(span, err)
+	return resp, err
+}
+
+// rpcMethod extracts the method name from a JSON-RPC request body ("batch" for batches)
+func rpcMethod(body []byte) string {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		return "batch"
+	}
+
+	var msg struct {
+		Method string `json:"method"`
+	}
+	if err := json.Unmarshal(trimmed, &msg); err != nil || msg.Method == "" {
+		return "unknown"
+	}
+	return msg.Method
+}
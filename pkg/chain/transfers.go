@@ -0,0 +1,196 @@
+package chain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/tracing"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// erc20TransferTopic is the event signature of Transfer(address,address,uint256)
+var erc20TransferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// logRangeChunk bounds the block range of one eth_getLogs call (대부분의 RPC 제공자가 범위를 제한)
+const logRangeChunk = 5000
+
+// TokenTransfer is a settlement token Transfer event read from the chain
+type TokenTransfer struct {
+	TxHash      string
+	LogIndex    uint
+	BlockNumber uint64
+	BlockTime   time.Time
+	From        string
+	To          string
+	// Amount is in token base units
+	Amount *big.Int
+}
+
+// TransfersInTx returns the settlement token transfers emitted by a successful transaction
+func (c *EthClient) TransfersInTx(ctx context.Context, txHash string) (transfers []TokenTransfer, err error) {
+	ctx, span := tracer.Start(ctx, "chain.TransfersInTx", trace.WithAttributes(
+		attribute.String("chain.tx_hash", txHash),
+		attribute.Int64("chain.id", c.config.ChainID),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	if !IsTxHash(txHash) {
+		return nil, ErrInvalidTxHash
+	}
+
+	receipt, err := c.client.TransactionReceipt(ctx, common.HexToHash(txHash))
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return nil, ErrTxNotFound
+		}
+		return nil, fmt.Errorf("get receipt: %w", err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, ErrTxFailed
+	}
+
+	blockTimes := make(map[uint64]time.Time)
+	for _, log := range receipt.Logs {
+		transfer, ok := c.parseTransfer(log)
+		if !ok {
+			continue
+		}
+		if transfer.BlockTime, err = c.blockTime(ctx, blockTimes, transfer.BlockNumber); err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, transfer)
+	}
+	return transfers, nil
+}
+
+// TransfersOfAddress returns the settlement token transfers sent or received by address
+// within [fromBlock, toBlock], ordered by block and log index
+func (c *EthClient) TransfersOfAddress(ctx context.Context, address string, fromBlock, toBlock uint64) (transfers []TokenTransfer, err error) {
+	ctx, span := tracer.Start(ctx, "chain.TransfersOfAddress", trace.WithAttributes(
+		attribute.String("chain.address", address),
+		attribute.Int64("chain.from_block", int64(fromBlock)),
+		attribute.Int64("chain.to_block", int64(toBlock)),
+		attribute.Int64("chain.id", c.config.ChainID),
+	))
+	defer func() { tracing.End(span, err) }()
+
+	if !common.IsHexAddress(address) {
+		return nil, ErrInvalidAddress
+	}
+	if fromBlock > toBlock {
+		return nil, fmt.Errorf("invalid block range %d-%d", fromBlock, toBlock)
+	}
+
+	topic := common.BytesToHash(common.HexToAddress(address).Bytes())
+	seen := make(map[string]bool)
+	blockTimes := make(map[uint64]time.Time)
+
+	for start := fromBlock; start <= toBlock; start += logRangeChunk {
+		end := min(start+logRangeChunk-1, toBlock)
+
+		// from = address, to = address 각각 조회 (topic OR는 위치별로만 가능)
+		for _, topics := range [][][]common.Hash{
+			{{erc20TransferTopic}, {topic}},
+			{{erc20TransferTopic}, nil, {topic}},
+		} {
+			logs, err := c.client.FilterLogs(ctx, ethereum.FilterQuery{
+				FromBlock: new(big.Int).SetUint64(start),
+				ToBlock:   new(big.Int).SetUint64(end),
+				Addresses: []common.Address{c.token},
+				Topics:    topics,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("filter logs %d-%d: %w", start, end, err)
+			}
+
+			for i := range logs {
+				transfer, ok := c.parseTransfer(&logs[i])
+				if !ok {
+					continue
+				}
+				// 자기 자신에게 보낸 전송은 두 조회에 모두 잡힘
+				key := fmt.Sprintf("%s:%d", transfer.TxHash, transfer.LogIndex)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+
+				if transfer.BlockTime, err = c.blockTime(ctx, blockTimes, transfer.BlockNumber); err != nil {
+					return nil, err
+				}
+				transfers = append(transfers, transfer)
+			}
+		}
+	}
+
+	sort.Slice(transfers, func(i, j int) bool {
+		if transfers[i].BlockNumber != transfers[j].BlockNumber {
+			return transfers[i].BlockNumber < transfers[j].BlockNumber
+		}
+		return transfers[i].LogIndex < transfers[j].LogIndex
+	})
+	return transfers, nil
+}
+
+// LatestBlock returns the number of the latest block
+func (c *EthClient) LatestBlock(ctx context.Context) (uint64, error) {
+	number, err := c.client.BlockNumber(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get block number: %w", err)
+	}
+	return number, nil
+}
+
+// IsTxHash reports whether s is a 0x-prefixed 32-byte hex transaction hash
+func IsTxHash(s string) bool {
+	if len(s) != 66 || !strings.HasPrefix(s, "0x") {
+		return false
+	}
+	for _, r := range s[2:] {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", r) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseTransfer decodes a Transfer log of the settlement token (other logs are ignored)
+func (c *EthClient) parseTransfer(log *types.Log) (TokenTransfer, bool) {
+	if log.Removed || log.Address != c.token || len(log.Topics) != 3 ||
+		log.Topics[0] != erc20TransferTopic || len(log.Data) < 32 {
+		return TokenTransfer{}, false
+	}
+
+	return TokenTransfer{
+		TxHash:      log.TxHash.Hex(),
+		LogIndex:    log.Index,
+		BlockNumber: log.BlockNumber,
+		From:        strings.ToLower(common.BytesToAddress(log.Topics[1].Bytes()).Hex()),
+		To:          strings.ToLower(common.BytesToAddress(log.Topics[2].Bytes()).Hex()),
+		Amount:      new(big.Int).SetBytes(log.Data[:32]),
+	}, true
+}
+
+// blockTime returns the timestamp of a block, caching headers per lookup
+func (c *EthClient) blockTime(ctx context.Context, cache map[uint64]time.Time, number uint64) (time.Time, error) {
+	if t, ok := cache[number]; ok {
+		return t, nil
+	}
+	header, err := c.client.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("get header %d: %w", number, err)
+	}
+	t := time.Unix(int64(header.Time), 0).UTC()
+	cache[number] = t
+	return t, nil
+}
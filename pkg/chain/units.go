@@ -0,0 +1,20 @@
+package chain
+
+import (
+	"math/big"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/money"
+)
+
+// FormatUnits formats a base-unit amount as a decimal string with the given decimals
+// e.g. FormatUnits(1500000, 6) = "1.500000"
+func FormatUnits(amount *big.Int, decimals int) string {
+	return money.FormatUnits(amount, decimals)
+}
+
+// ParseUnits parses a decimal string into a base-unit amount with the given decimals
+// e.g. ParseUnits("1.5", 6) = 1500000
+// Returns an error if the value has more fractional digits than decimals allows
+func ParseUnits(value string, decimals int) (*big.Int, error) {
+	return money.ParseUnits(value, decimals)
+}
@@ -6,8 +6,25 @@ import (
 	"fmt"
 
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/internal/repository/db"
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// tracer emits one span per transaction (BEGIN ~ COMMIT/ROLLBACK)
+var tracer = tracing.Tracer("github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/db")
+
+// startTxSpan starts a client span covering a single database transaction
+func startTxSpan(ctx context.Context, mode string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, "db.tx",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "mysql"),
+			attribute.String("db.tx.mode", mode),
+		),
+	)
+}
+
 // TxRunner manages database transactions with sqlc Queries.
 // Service layer uses this to maintain transaction boundaries while
 // passing tx-bound Queries to repository operations.
@@ -42,7 +59,10 @@ func NewTxRunner(database *sql.DB) *TxRunner {
 //	    }
 //	    return nil
 //	})
-func (r *TxRunner) WithTx(ctx context.Context, fn func(q *db.Queries) error) error {
+func (r *TxRunner) WithTx(ctx context.Context, fn func(q *db.Queries) error) (err error) {
+	ctx, span := startTxSpan(ctx, "commit")
+	defer func() { tracing.End(span, err) }()
+
 	tx, err := r.database.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("begin transaction: %w", err)
@@ -82,8 +102,9 @@ func (r *TxRunner) WithTx(ctx context.Context, fn func(q *db.Queries) error) err
 //	    id, _ := result.LastInsertId()
 //	    return q.GetWalletByID(ctx, uint64(id))
 //	})
-func WithTxResult[T any](ctx context.Context, r *TxRunner, fn func(q *db.Queries) (T, error)) (T, error) {
-	var result T
+func WithTxResult[T any](ctx context.Context, r *TxRunner, fn func(q *db.Queries) (T, error)) (result T, err error) {
+	ctx, span := startTxSpan(ctx, "commit")
+	defer func() { tracing.End(span, err) }()
 
 	tx, err := r.database.BeginTx(ctx, nil)
 	if err != nil {
@@ -107,6 +128,47 @@ func WithTxResult[T any](ctx context.Context, r *TxRunner, fn func(q *db.Queries
 	return result, nil
 }
 
+// WithRollbackTxResult executes the given function within a transaction that is
+// always rolled back, even on success. Used for dry-runs: every statement,
+// lock and constraint check runs for real, but nothing is committed.
+func WithRollbackTxResult[T any](ctx context.Context, r *TxRunner, fn func(q *db.Queries) (T, error)) (result T, err error) {
+	ctx, span := startTxSpan(ctx, "rollback")
+	defer func() { tracing.End(span, err) }()
+
+	tx, err := r.database.BeginTx(ctx, nil)
+	if err != nil {
+		return result, fmt.Errorf("begin transaction: %w", err)
+	}
+
+	q := db.New(tx)
+
+	result, err = fn(q)
+
+	if rbErr := tx.Rollback(); rbErr != nil {
+		if err != nil {
+			return result, fmt.Errorf("rollback failed: %v (original error: %w)", rbErr, err)
+		}
+		return result, fmt.Errorf("rollback dry-run transaction: %w", rbErr)
+	}
+
+	return result, err
+}
+
+// WithTxResultDryRun executes the given function like WithTxResult,
+// but rolls back instead of committing when dryRun is true.
+//
+// Usage example:
+//
+//	result, err := WithTxResultDryRun(ctx, txRunner, dryRun, func(q *db.Queries) (*Result, error) {
+//	    // validations + writes run identically in both modes
+//	})
+func WithTxResultDryRun[T any](ctx context.Context, r *TxRunner, dryRun bool, fn func(q *db.Queries) (T, error)) (T, error) {
+	if dryRun {
+		return WithRollbackTxResult(ctx, r, fn)
+	}
+	return WithTxResult(ctx, r, fn)
+}
+
 // Queries returns a non-transactional Queries instance.
 // Use this for read-only operations that don't require transactions.
 func (r *TxRunner) Queries() *db.Queries {
@@ -0,0 +1,108 @@
+package eip712
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SignatureFormat is the wire format of an EOA signature.
+// Wallets disagree on the recovery id (v) encoding and some return compact signatures;
+// every format below is normalized to r || s || v (v = 0/1, low s) before ecrecover.
+type SignatureFormat string
+
+const (
+	// FormatRSV is r || s || v with v = 27/28 (MetaMask, most software wallets)
+	FormatRSV SignatureFormat = "rsv"
+	// FormatRSVRaw is r || s || v with v = 0/1 (Ledger, raw secp256k1 signers)
+	FormatRSVRaw SignatureFormat = "rsv_raw"
+	// FormatRSVEIP155 is r || s || v with v = chainId*2 + 35/36 (some WalletConnect clients, chain ids up to 110)
+	FormatRSVEIP155 SignatureFormat = "rsv_eip155"
+	// FormatCompact is the 64-byte EIP-2098 compact signature r || (yParity << 255 | s)
+	FormatCompact SignatureFormat = "compact"
+)
+
+// Encoding is a signing method accepted by the verifier and the signature formats accepted with it
+type Encoding struct {
+	Scheme SignatureScheme `json:"scheme"`
+	// Method is the wallet RPC method that produces the signature
+	Method  string            `json:"method"`
+	Formats []SignatureFormat `json:"formats"`
+}
+
+// eoaFormats are the signature formats accepted for every EOA scheme.
+// NOTE: contract wallet(ERC-1271) 서명은 컨트랙트가 형식을 정의하므로 정규화하지 않고 그대로 전달
+var eoaFormats = []SignatureFormat{FormatRSV, FormatRSVRaw, FormatRSVEIP155, FormatCompact}
+
+// compatibilityMatrix lists every scheme with its wallet method (filtered by config in Encodings).
+// eth_signTypedData_v3 produces the same hash as v4 for WalletVerification (no arrays or nested structs).
+var compatibilityMatrix = []Encoding{
+	{Scheme: SchemeEIP712, Method: "eth_signTypedData_v4", Formats: eoaFormats},
+	{Scheme: SchemeEIP712, Method: "eth_signTypedData_v3", Formats: eoaFormats},
+	{Scheme: SchemeLegacyTypedData, Method: "eth_signTypedData", Formats: eoaFormats},
+	{Scheme: SchemePersonalSign, Method: "personal_sign", Formats: eoaFormats},
+}
+
+// ErrInvalidRecoveryID is returned for a signature whose v value matches no known format
+var ErrInvalidRecoveryID = errors.New("invalid signature recovery id")
+
+// secp256k1N / secp256k1HalfN are the curve order and its half (EIP-2 low-s bound)
+var (
+	secp256k1N     = crypto.S256().Params().N
+	secp256k1HalfN = new(big.Int).Rsh(secp256k1N, 1)
+)
+
+// NormalizeSignature converts an EOA signature in any accepted format to r || s || v
+// with v = 0/1 and s in the lower half of the curve order, and reports the detected format.
+func NormalizeSignature(signature []byte) ([]byte, SignatureFormat, error) {
+	sig := make([]byte, 65)
+	var format SignatureFormat
+
+	switch len(signature) {
+	case 64:
+		// EIP-2098: 최상위 비트가 yParity, 나머지가 s
+		format = FormatCompact
+		copy(sig, signature[:32])
+		copy(sig[32:64], signature[32:])
+		sig[64] = sig[32] >> 7
+		sig[32] &= 0x7f
+	case 65:
+		copy(sig, signature)
+		switch v := signature[64]; {
+		case v == 0 || v == 1:
+			format = FormatRSVRaw
+		case v == 27 || v == 28:
+			format = FormatRSV
+			sig[64] = v - 27
+		case v >= 35:
+			format = FormatRSVEIP155
+			sig[64] = (v - 35) % 2
+		default:
+			return nil, "", fmt.Errorf("%w: v=%d", ErrInvalidRecoveryID, v)
+		}
+	default:
+		return nil, "", ErrInvalidSignatureLen
+	}
+
+	// High-s (malleable) 서명은 s' = N - s, v 반전으로 같은 공개키를 복원하는 low-s 형태로 변환
+	s := new(big.Int).SetBytes(sig[32:64])
+	if s.Cmp(secp256k1HalfN) > 0 {
+		copy(sig[32:64], common.LeftPadBytes(new(big.Int).Sub(secp256k1N, s).Bytes(), 32))
+		sig[64] ^= 1
+	}
+	return sig, format, nil
+}
+
+// Encodings returns the compatibility matrix of the signing methods this verifier accepts
+func (v *EthVerifier) Encodings() []Encoding {
+	encodings := make([]Encoding, 0, len(compatibilityMatrix))
+	for _, encoding := range compatibilityMatrix {
+		if v.supportsScheme(encoding.Scheme) {
+			encodings = append(encodings, encoding)
+		}
+	}
+	return encodings
+}
@@ -2,12 +2,16 @@ package eip712
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"strings"
 	"time"
 
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
 	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/nonce"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	ethmath "github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -15,24 +19,50 @@ import (
 	"go.uber.org/zap"
 )
 
+// challengeNonceBytes is the entropy of server-issued nonces (32 hex chars)
+const challengeNonceBytes = 16
+
 // EthVerifier implements Verifier interface using go-ethereum
 type EthVerifier struct {
 	config     Config
 	nonceStore nonce.Store
-	typedData  apitypes.TypedData
-	logger     *zap.Logger
+	contracts  ContractSignatureValidator
+	// domains holds the typed-data template per chain id
+	domains map[int64]apitypes.TypedData
+	logger  *zap.Logger
 }
 
 // Compile-time interface compliance check
 var _ Verifier = (*EthVerifier)(nil)
 
-// NewEthVerifier creates a new EIP-712 verifier
-func NewEthVerifier(config Config, nonceStore nonce.Store, logger *zap.Logger) *EthVerifier {
+// NewEthVerifier creates a new EIP-712 verifier.
+// contracts enables ERC-1271 verification for contract wallets; nil limits verification to EOAs.
+func NewEthVerifier(config Config, nonceStore nonce.Store, contracts ContractSignatureValidator, logger *zap.Logger) *EthVerifier {
 	if config.TimestampTolerance == 0 {
 		config.TimestampTolerance = DefaultTimestampTolerance
 	}
 
-	typedData := apitypes.TypedData{
+	domains := make(map[int64]apitypes.TypedData, len(config.Domains)+1)
+	domains[config.ChainID] = newTypedData(Domain{ChainID: config.ChainID, VerifyingContract: config.VerifyingContract})
+	for _, domain := range config.Domains {
+		if _, exists := domains[domain.ChainID]; exists {
+			continue
+		}
+		domains[domain.ChainID] = newTypedData(domain)
+	}
+
+	return &EthVerifier{
+		config:     config,
+		nonceStore: nonceStore,
+		contracts:  contracts,
+		domains:    domains,
+		logger:     logger,
+	}
+}
+
+// newTypedData builds the WalletVerification typed-data template for the domain
+func newTypedData(domain Domain) apitypes.TypedData {
+	return apitypes.TypedData{
 		Types: apitypes.Types{
 			"EIP712Domain": {
 				{Name: "name", Type: "string"},
@@ -50,52 +80,79 @@ func NewEthVerifier(config Config, nonceStore nonce.Store, logger *zap.Logger) *
 		Domain: apitypes.TypedDataDomain{
 			Name:              "B2B Settlement",
 			Version:           "1",
-			ChainId:           ethmath.NewHexOrDecimal256(config.ChainID),
-			VerifyingContract: config.VerifyingContract,
+			ChainId:           ethmath.NewHexOrDecimal256(domain.ChainID),
+			VerifyingContract: domain.VerifyingContract,
 		},
 	}
+}
 
-	return &EthVerifier{
-		config:     config,
-		nonceStore: nonceStore,
-		typedData:  typedData,
-		logger:     logger,
+// typedDataFor returns the typed-data template of the chain (0 = default chain)
+func (v *EthVerifier) typedDataFor(chainID int64) (apitypes.TypedData, error) {
+	typedData, ok := v.domains[v.resolveChainID(chainID)]
+	if !ok {
+		return apitypes.TypedData{}, ErrUnsupportedChain
 	}
+	return typedData, nil
 }
 
 // VerifyWalletOwnership verifies wallet ownership with full nonce + timestamp handling
 func (v *EthVerifier) VerifyWalletOwnership(
 	ctx context.Context,
 	address string,
+	scheme SignatureScheme,
 	message WalletVerificationMessage,
 	signature []byte,
 ) error {
-	// 1. Validate address format
+	// 1. Validate address format and scheme
 	if !common.IsHexAddress(address) {
 		return ErrInvalidAddress
 	}
+	if !v.supportsScheme(scheme) {
+		return ErrUnsupportedScheme
+	}
+
+	if _, err := v.typedDataFor(message.ChainID); err != nil {
+		return err
+	}
 
 	// 2. Validate timestamp (within tolerance)
 	if err := v.validateTimestamp(message.Timestamp); err != nil {
 		return err
 	}
 
-	// 3. Reserve nonce (prevents replay)
-	if err := v.nonceStore.Reserve(ctx, message.Nonce, address); err != nil {
-		v.logger.Warn("nonce reservation failed",
+	// 3. Redeem nonce and verify signature (ERC-1271 for contract wallets, ecrecover for EOAs)
+	if err := v.redeem(ctx, address, message.Nonce, func() (bool, error) {
+		return v.verifySignature(ctx, address, scheme, message, signature)
+	}); err != nil {
+		return err
+	}
+
+	logctx.From(ctx, v.logger).Info("wallet ownership verified",
+		zap.String("address", address),
+		zap.Int64("chain_id", message.ChainID),
+	)
+	return nil
+}
+
+// redeem reserves the nonce (prevents replay), runs verify and marks the nonce used on success.
+// The nonce is released when verification fails so the signer can retry.
+func (v *EthVerifier) redeem(ctx context.Context, address, nonce string, verify func() (bool, error)) error {
+	// 1. Reserve nonce
+	if err := v.nonceStore.Reserve(ctx, nonce, address); err != nil {
+		logctx.From(ctx, v.logger).Warn("nonce reservation failed",
 			zap.String("address", address),
-			zap.String("nonce", message.Nonce),
+			zap.String("nonce", nonce),
 			zap.Error(err),
 		)
 		return fmt.Errorf("nonce validation failed: %w", err)
 	}
 
-	// 4. Verify signature
-	valid, err := v.VerifySignatureOnly(address, message, signature)
+	// 2. Verify signature
+	valid, err := verify()
 	if err != nil || !valid {
 		// Release nonce on failure (allow retry with same nonce)
-		if releaseErr := v.nonceStore.Release(ctx, message.Nonce, address); releaseErr != nil {
-			v.logger.Error("failed to release nonce after verification failure",
+		if releaseErr := v.nonceStore.Release(ctx, nonce, address); releaseErr != nil {
+			logctx.From(ctx, v.logger).Error("failed to release nonce after verification failure",
 				zap.String("address", address),
 				zap.Error(releaseErr),
 			)
@@ -106,29 +163,221 @@ func (v *EthVerifier) VerifyWalletOwnership(
 		return ErrAddressMismatch
 	}
 
-	// 5. Mark nonce as used (successful verification)
-	if err := v.nonceStore.MarkUsed(ctx, message.Nonce, address); err != nil {
-		v.logger.Error("failed to mark nonce as used",
+	// 3. Mark nonce as used (successful verification)
+	if err := v.nonceStore.MarkUsed(ctx, nonce, address); err != nil {
+		logctx.From(ctx, v.logger).Error("failed to mark nonce as used",
 			zap.String("address", address),
 			zap.Error(err),
 		)
 		// Don't fail the verification, just log
 	}
+	return nil
+}
+
+// IssueChallenge generates a random nonce, pre-reserves it for the address and
+// returns the complete typed data to sign.
+// Why: 클라이언트가 nonce를 직접 만들면 재사용/충돌 가능성을 서버가 통제 못 함
+// → 서버가 발급 시점에 nonce를 "issued" 상태로 선점, 서명 검증 시 한 번만 소비 가능
+func (v *EthVerifier) IssueChallenge(ctx context.Context, chainID int64, address string) (*Challenge, error) {
+	if !common.IsHexAddress(address) {
+		return nil, ErrInvalidAddress
+	}
+	typedData, err := v.typedDataFor(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, challengeNonceBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	now := time.Now()
+	message := WalletVerificationMessage{
+		Wallet:    address,
+		Nonce:     hex.EncodeToString(buf),
+		Timestamp: now.Unix(),
+		ChainID:   chainID,
+	}
+
+	if err := v.nonceStore.Issue(ctx, message.Nonce, address); err != nil {
+		return nil, fmt.Errorf("failed to issue nonce: %w", err)
+	}
+
+	// Shallow copy: Types/Domain are shared read-only, Message is per challenge
+	typedData.Message = apitypes.TypedDataMessage{
+		"wallet":    message.Wallet,
+		"nonce":     message.Nonce,
+		"timestamp": message.Timestamp,
+	}
 
-	v.logger.Info("wallet ownership verified",
+	logctx.From(ctx, v.logger).Debug("verification challenge issued",
 		zap.String("address", address),
+		zap.String("nonce", message.Nonce),
 	)
-	return nil
+
+	challenge := &Challenge{
+		Message:   message,
+		TypedData: typedData,
+		Encodings: v.Encodings(),
+		ExpiresAt: now.Add(v.config.TimestampTolerance),
+	}
+	if v.config.AllowPersonalSign {
+		challenge.PersonalMessage, err = v.PersonalSignMessage(message)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if v.config.AllowLegacyTypedData {
+		challenge.LegacyTypedData, err = v.LegacyTypedData(message)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return challenge, nil
+}
+
+// PersonalSignMessage renders the personal_sign (EIP-191) text for the message.
+// Binds the same fields as the typed data, including the domain, so a signature
+// cannot be replayed against another deployment or chain.
+func (v *EthVerifier) PersonalSignMessage(message WalletVerificationMessage) (string, error) {
+	typedData, err := v.typedDataFor(message.ChainID)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(
+		"%s wants you to verify ownership of this wallet.\n\n"+
+			"Wallet: %s\nNonce: %s\nTimestamp: %d\nChain ID: %d\nVerifying Contract: %s\nVersion: %s",
+		typedData.Domain.Name,
+		strings.ToLower(message.Wallet),
+		message.Nonce,
+		message.Timestamp,
+		(*big.Int)(typedData.Domain.ChainId),
+		strings.ToLower(typedData.Domain.VerifyingContract),
+		typedData.Domain.Version,
+	), nil
 }
 
-// VerifySignatureOnly verifies only the cryptographic signature
+// verifySignature hashes the message under the scheme and selects the verification
+// path by whether the address has code.
+// Why: Gnosis Safe 등 contract wallet은 개인키가 없어 ecrecover 불가 → isValidSignature에 위임
+func (v *EthVerifier) verifySignature(
+	ctx context.Context,
+	address string,
+	scheme SignatureScheme,
+	message WalletVerificationMessage,
+	signature []byte,
+) (bool, error) {
+	digest, err := v.digestFor(scheme, message)
+	if err != nil {
+		return false, err
+	}
+	return v.verifyDigest(ctx, address, message.ChainID, digest, signature)
+}
+
+// verifyDigest checks the signature of a signing hash on the chain (0 = default chain)
+func (v *EthVerifier) verifyDigest(ctx context.Context, address string, chainID int64, digest []byte, signature []byte) (bool, error) {
+	// Why: isValidSignature는 RPC가 연결된 체인에서만 호출 가능 → 다른 체인은 EOA 경로
+	isContract := false
+	var err error
+	if v.contracts != nil && v.resolveChainID(chainID) == v.contracts.ChainID() {
+		isContract, err = v.contracts.IsContract(ctx, address)
+		if err != nil {
+			return false, fmt.Errorf("failed to check wallet code: %w", err)
+		}
+	}
+	if !isContract {
+		return recoverMatches(digest, address, signature)
+	}
+
+	var hash [32]byte
+	copy(hash[:], digest)
+	valid, err := v.contracts.IsValidSignature(ctx, address, hash, signature)
+	if err != nil {
+		return false, err
+	}
+	if !valid {
+		return false, ErrContractSignature
+	}
+
+	logctx.From(ctx, v.logger).Debug("contract wallet signature verified (ERC-1271)",
+		zap.String("address", address),
+	)
+	return true, nil
+}
+
+// VerifySignatureOnly verifies only the cryptographic signature (EOA ecrecover)
 func (v *EthVerifier) VerifySignatureOnly(
 	address string,
 	message WalletVerificationMessage,
 	signature []byte,
 ) (bool, error) {
-	if len(signature) != 65 {
-		return false, ErrInvalidSignatureLen
+	digest, err := v.digest(message)
+	if err != nil {
+		return false, err
+	}
+	return recoverMatches(digest, address, signature)
+}
+
+// supportsScheme reports whether the scheme is accepted ("" = default EIP-712)
+func (v *EthVerifier) supportsScheme(scheme SignatureScheme) bool {
+	switch scheme {
+	case "", SchemeEIP712:
+		return true
+	case SchemePersonalSign:
+		return v.config.AllowPersonalSign
+	case SchemeLegacyTypedData:
+		return v.config.AllowLegacyTypedData
+	default:
+		return false
+	}
+}
+
+// digestFor computes the signing hash of the message under the scheme
+func (v *EthVerifier) digestFor(scheme SignatureScheme, message WalletVerificationMessage) ([]byte, error) {
+	switch scheme {
+	case SchemePersonalSign:
+		// "\x19Ethereum Signed Message:\n" + len + text (EIP-191 version 0x45)
+		text, err := v.PersonalSignMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return accounts.TextHash([]byte(text)), nil
+	case SchemeLegacyTypedData:
+		return v.legacyDigest(message)
+	default:
+		return v.digest(message)
+	}
+}
+
+// recoverMatches recovers the signer of digest (EOA ecrecover) and compares it to address.
+// The signature may be in any accepted SignatureFormat.
+func recoverMatches(digest []byte, address string, signature []byte) (bool, error) {
+	// Normalize v value and compact/high-s signatures (하드웨어 지갑/WalletConnect 클라이언트별 차이)
+	sig, _, err := NormalizeSignature(signature)
+	if err != nil {
+		return false, err
+	}
+
+	// Recover public key from signature
+	pubKey, err := crypto.SigToPub(digest, sig)
+	if err != nil {
+		return false, fmt.Errorf("failed to recover public key: %w", err)
+	}
+
+	// Derive address from public key
+	recoveredAddr := crypto.PubkeyToAddress(*pubKey)
+
+	// Compare addresses (case-insensitive)
+	return strings.EqualFold(recoveredAddr.Hex(), address), nil
+}
+
+// digest computes the EIP-712 signing hash of the message
+func (v *EthVerifier) digest(message WalletVerificationMessage) ([]byte, error) {
+	typedData, err := v.typedDataFor(message.ChainID)
+	if err != nil {
+		return nil, err
 	}
 
 	// Build message map for hashing
@@ -137,17 +386,21 @@ func (v *EthVerifier) VerifySignatureOnly(
 		"nonce":     message.Nonce,
 		"timestamp": big.NewInt(message.Timestamp),
 	}
+	return hashTypedData(typedData, "WalletVerification", messageMap)
+}
 
+// hashTypedData computes the EIP-712 signing hash of a message of the primary type
+func hashTypedData(typedData apitypes.TypedData, primaryType string, messageMap map[string]interface{}) ([]byte, error) {
 	// 1. Compute domain separator hash
-	domainSeparator, err := v.typedData.HashStruct("EIP712Domain", v.typedData.Domain.Map())
+	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
 	if err != nil {
-		return false, fmt.Errorf("failed to hash domain: %w", err)
+		return nil, fmt.Errorf("failed to hash domain: %w", err)
 	}
 
 	// 2. Compute message hash
-	messageHash, err := v.typedData.HashStruct("WalletVerification", messageMap)
+	messageHash, err := typedData.HashStruct(primaryType, messageMap)
 	if err != nil {
-		return false, fmt.Errorf("failed to hash message: %w", err)
+		return nil, fmt.Errorf("failed to hash message: %w", err)
 	}
 
 	// 3. Byte-level concatenation (NOT string concat!)
@@ -158,26 +411,15 @@ func (v *EthVerifier) VerifySignatureOnly(
 	rawData = append(rawData, messageHash...)
 
 	// 4. Keccak256 hash
-	digest := crypto.Keccak256(rawData)
-
-	// 5. Normalize v value (27/28 -> 0/1)
-	sig := make([]byte, 65)
-	copy(sig, signature)
-	if sig[64] >= 27 {
-		sig[64] -= 27
-	}
+	return crypto.Keccak256(rawData), nil
+}
 
-	// 6. Recover public key from signature
-	pubKey, err := crypto.SigToPub(digest, sig)
-	if err != nil {
-		return false, fmt.Errorf("failed to recover public key: %w", err)
+// resolveChainID maps 0 to the default chain
+func (v *EthVerifier) resolveChainID(chainID int64) int64 {
+	if chainID == 0 {
+		return v.config.ChainID
 	}
-
-	// 7. Derive address from public key
-	recoveredAddr := crypto.PubkeyToAddress(*pubKey)
-
-	// 8. Compare addresses (case-insensitive)
-	return strings.EqualFold(recoveredAddr.Hex(), address), nil
+	return chainID
 }
 
 // validateTimestamp checks if the timestamp is within acceptable range
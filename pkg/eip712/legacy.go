@@ -0,0 +1,82 @@
+package eip712
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// LegacyTypedField is one entry of legacy typed data (eth_signTypedData v1 / "v1" in eth-sig-util)
+type LegacyTypedField struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Value any    `json:"value"`
+}
+
+// LegacyTypedData renders the message as legacy typed data.
+// v1 has no domain separator, so the domain fields are signed as regular entries
+// (a signature cannot be replayed against another deployment or chain).
+func (v *EthVerifier) LegacyTypedData(message WalletVerificationMessage) ([]LegacyTypedField, error) {
+	typedData, err := v.typedDataFor(message.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return []LegacyTypedField{
+		{Type: "string", Name: "name", Value: typedData.Domain.Name},
+		{Type: "string", Name: "version", Value: typedData.Domain.Version},
+		{Type: "uint256", Name: "chainId", Value: (*big.Int)(typedData.Domain.ChainId).String()},
+		{Type: "address", Name: "verifyingContract", Value: strings.ToLower(typedData.Domain.VerifyingContract)},
+		{Type: "address", Name: "wallet", Value: strings.ToLower(message.Wallet)},
+		{Type: "string", Name: "nonce", Value: message.Nonce},
+		{Type: "uint256", Name: "timestamp", Value: fmt.Sprintf("%d", message.Timestamp)},
+	}, nil
+}
+
+// legacyDigest computes the legacy typed data signing hash:
+// keccak256(keccak256(packed "type name" schema) || keccak256(packed values))
+func (v *EthVerifier) legacyDigest(message WalletVerificationMessage) ([]byte, error) {
+	fields, err := v.LegacyTypedData(message)
+	if err != nil {
+		return nil, err
+	}
+
+	var schema, values []byte
+	for _, field := range fields {
+		schema = append(schema, field.Type+" "+field.Name...)
+
+		packed, err := packLegacyValue(field)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s: %w", field.Name, err)
+		}
+		values = append(values, packed...)
+	}
+
+	return crypto.Keccak256(crypto.Keccak256(schema), crypto.Keccak256(values)), nil
+}
+
+// packLegacyValue tightly packs a value (solidityPack) for the types used by the verification message
+func packLegacyValue(field LegacyTypedField) ([]byte, error) {
+	value := fmt.Sprint(field.Value)
+	switch field.Type {
+	case "string":
+		return []byte(value), nil
+	case "address":
+		if !common.IsHexAddress(value) {
+			return nil, ErrInvalidAddress
+		}
+		return common.HexToAddress(value).Bytes(), nil
+	case "uint256":
+		n, ok := math.ParseBig256(value)
+		if !ok || n.Sign() < 0 {
+			return nil, fmt.Errorf("invalid uint256 %q", value)
+		}
+		return math.U256Bytes(new(big.Int).Set(n)), nil
+	default:
+		return nil, fmt.Errorf("unsupported legacy type %q", field.Type)
+	}
+}
@@ -0,0 +1,151 @@
+package eip712
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"go.uber.org/zap"
+)
+
+// payoutApprovalType is the primary type of payout address approvals
+const payoutApprovalType = "PayoutAddressApproval"
+
+// payoutApprovalFields are the signed fields of PayoutAddressApproval
+var payoutApprovalFields = []apitypes.Type{
+	{Name: "approver", Type: "address"},
+	{Name: "payoutAddress", Type: "address"},
+	{Name: "payoutChainId", Type: "uint256"},
+	{Name: "nonce", Type: "string"},
+	{Name: "timestamp", Type: "uint256"},
+}
+
+// PayoutAddressApproval is the EIP-712 message by which an already verified wallet
+// (the approver) approves a new payout address of the same user.
+// Why: 새 주소 자체의 소유 증명은 계정 탈취자도 할 수 있음 → 기존 검증 지갑의 서명으로 승인
+type PayoutAddressApproval struct {
+	Approver      string `json:"approver"`
+	PayoutAddress string `json:"payoutAddress"`
+	PayoutChainID int64  `json:"payoutChainId"`
+	Nonce         string `json:"nonce"`
+	Timestamp     int64  `json:"timestamp"`
+	// ChainID selects the EIP-712 domain (the approver's chain, 0 = default chain); not part of the signed struct
+	ChainID int64 `json:"-"`
+}
+
+// PayoutApprovalChallenge is a server-issued approval message with its full typed-data payload.
+// The nonce is pre-reserved for the approver, so it can only be redeemed once.
+type PayoutApprovalChallenge struct {
+	Message   PayoutAddressApproval
+	TypedData apitypes.TypedData
+	ExpiresAt time.Time
+}
+
+// IssuePayoutApproval generates a nonce for the approver, pre-reserves it and returns
+// the typed data the approver must sign (Nonce/Timestamp of approval are filled in)
+func (v *EthVerifier) IssuePayoutApproval(ctx context.Context, approval PayoutAddressApproval) (*PayoutApprovalChallenge, error) {
+	if !common.IsHexAddress(approval.Approver) || !common.IsHexAddress(approval.PayoutAddress) {
+		return nil, ErrInvalidAddress
+	}
+	typedData, err := v.payoutApprovalTypedData(approval.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, challengeNonceBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	now := time.Now()
+	approval.Nonce = hex.EncodeToString(buf)
+	approval.Timestamp = now.Unix()
+
+	if err := v.nonceStore.Issue(ctx, approval.Nonce, approval.Approver); err != nil {
+		return nil, fmt.Errorf("failed to issue nonce: %w", err)
+	}
+
+	typedData.Message = apitypes.TypedDataMessage{
+		"approver":      approval.Approver,
+		"payoutAddress": approval.PayoutAddress,
+		"payoutChainId": approval.PayoutChainID,
+		"nonce":         approval.Nonce,
+		"timestamp":     approval.Timestamp,
+	}
+
+	logctx.From(ctx, v.logger).Debug("payout approval challenge issued",
+		zap.String("approver", approval.Approver),
+		zap.String("payout_address", approval.PayoutAddress),
+		zap.String("nonce", approval.Nonce),
+	)
+
+	return &PayoutApprovalChallenge{
+		Message:   approval,
+		TypedData: typedData,
+		ExpiresAt: now.Add(v.config.TimestampTolerance),
+	}, nil
+}
+
+// VerifyPayoutApproval verifies the approver's signature over the approval
+// with full nonce + timestamp handling (EIP-712 only, ERC-1271 for contract wallets)
+func (v *EthVerifier) VerifyPayoutApproval(ctx context.Context, approval PayoutAddressApproval, signature []byte) error {
+	// 1. Validate addresses and domain
+	if !common.IsHexAddress(approval.Approver) || !common.IsHexAddress(approval.PayoutAddress) {
+		return ErrInvalidAddress
+	}
+	typedData, err := v.payoutApprovalTypedData(approval.ChainID)
+	if err != nil {
+		return err
+	}
+
+	// 2. Validate timestamp (within tolerance)
+	if err := v.validateTimestamp(approval.Timestamp); err != nil {
+		return err
+	}
+
+	// 3. Redeem nonce and verify signature
+	if err := v.redeem(ctx, approval.Approver, approval.Nonce, func() (bool, error) {
+		digest, err := hashTypedData(typedData, payoutApprovalType, map[string]interface{}{
+			"approver":      approval.Approver,
+			"payoutAddress": approval.PayoutAddress,
+			"payoutChainId": big.NewInt(approval.PayoutChainID),
+			"nonce":         approval.Nonce,
+			"timestamp":     big.NewInt(approval.Timestamp),
+		})
+		if err != nil {
+			return false, err
+		}
+		return v.verifyDigest(ctx, approval.Approver, approval.ChainID, digest, signature)
+	}); err != nil {
+		return err
+	}
+
+	logctx.From(ctx, v.logger).Info("payout address approved",
+		zap.String("approver", approval.Approver),
+		zap.String("payout_address", approval.PayoutAddress),
+		zap.Int64("payout_chain_id", approval.PayoutChainID),
+	)
+	return nil
+}
+
+// payoutApprovalTypedData returns the PayoutAddressApproval typed-data template of the chain
+func (v *EthVerifier) payoutApprovalTypedData(chainID int64) (apitypes.TypedData, error) {
+	base, err := v.typedDataFor(chainID)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain":     base.Types["EIP712Domain"],
+			payoutApprovalType: payoutApprovalFields,
+		},
+		PrimaryType: payoutApprovalType,
+		Domain:      base.Domain,
+	}, nil
+}
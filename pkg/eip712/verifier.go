@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"time"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 )
 
 const (
@@ -16,6 +18,36 @@ type WalletVerificationMessage struct {
 	Wallet    string `json:"wallet"`
 	Nonce     string `json:"nonce"`
 	Timestamp int64  `json:"timestamp"`
+	// ChainID selects the EIP-712 domain (0 = default chain); not part of the signed struct
+	ChainID int64 `json:"-"`
+}
+
+// SignatureScheme selects how the verification message is signed
+type SignatureScheme string
+
+const (
+	// SchemeEIP712 is eth_signTypedData_v4 over the WalletVerification typed data (default)
+	SchemeEIP712 SignatureScheme = "eip712"
+	// SchemePersonalSign is EIP-191 personal_sign over the text from PersonalSignMessage.
+	// Fallback for hardware/mobile wallets that cannot sign typed data.
+	SchemePersonalSign SignatureScheme = "personal_sign"
+	// SchemeLegacyTypedData is eth_signTypedData (v1) over the entries from LegacyTypedData.
+	// Fallback for older hardware wallet firmware and signers that only implement legacy typed data.
+	SchemeLegacyTypedData SignatureScheme = "eip712_legacy"
+)
+
+// Challenge is a server-issued verification message with its full typed-data payload.
+// The nonce is pre-reserved in the nonce store, so it can only be redeemed once.
+type Challenge struct {
+	Message   WalletVerificationMessage
+	TypedData apitypes.TypedData
+	// PersonalMessage is the personal_sign text (empty when the fallback is disabled)
+	PersonalMessage string
+	// LegacyTypedData is the eth_signTypedData (v1) payload (nil when the fallback is disabled)
+	LegacyTypedData []LegacyTypedField
+	// Encodings lists the accepted signing methods and signature formats
+	Encodings []Encoding
+	ExpiresAt time.Time
 }
 
 // Config holds EIP-712 domain configuration
@@ -23,17 +55,63 @@ type Config struct {
 	ChainID            int64
 	VerifyingContract  string
 	TimestampTolerance time.Duration
+	// AllowPersonalSign enables the EIP-191 personal_sign fallback scheme
+	AllowPersonalSign bool
+	// AllowLegacyTypedData enables the eth_signTypedData (v1) fallback scheme
+	AllowLegacyTypedData bool
+	// Domains are the per-chain domains of additional networks (ChainID/VerifyingContract is always included)
+	Domains []Domain
+}
+
+// Domain is the chain-specific part of the EIP-712 domain
+type Domain struct {
+	ChainID           int64
+	VerifyingContract string
+}
+
+// ContractSignatureValidator checks signatures of smart-contract wallets on chain (ERC-1271)
+// Implemented by chain.EthClient
+type ContractSignatureValidator interface {
+	// ChainID returns the chain the validator is connected to
+	ChainID() int64
+
+	// IsContract reports whether the address has contract code
+	IsContract(ctx context.Context, address string) (bool, error)
+
+	// IsValidSignature calls isValidSignature(digest, signature) on the contract
+	IsValidSignature(ctx context.Context, address string, digest [32]byte, signature []byte) (bool, error)
 }
 
 // Verifier defines the interface for EIP-712 signature verification
 type Verifier interface {
 	// VerifyWalletOwnership verifies wallet ownership using EIP-712 signature
 	// Includes nonce reservation, timestamp validation, and signature verification
-	VerifyWalletOwnership(ctx context.Context, address string, message WalletVerificationMessage, signature []byte) error
+	// Contract wallets are verified via ERC-1271 when a ContractSignatureValidator is configured
+	VerifyWalletOwnership(ctx context.Context, address string, scheme SignatureScheme, message WalletVerificationMessage, signature []byte) error
 
 	// VerifySignatureOnly verifies only the cryptographic signature without nonce handling
 	// Used for testing or when nonce is managed externally
 	VerifySignatureOnly(address string, message WalletVerificationMessage, signature []byte) (bool, error)
+
+	// IssueChallenge generates a server-side nonce for the address, pre-reserves it
+	// and returns the typed data the wallet must sign on the chain (0 = default chain)
+	IssueChallenge(ctx context.Context, chainID int64, address string) (*Challenge, error)
+
+	// IssuePayoutApproval pre-reserves a nonce for the approver and returns the
+	// PayoutAddressApproval typed data the approver must sign
+	IssuePayoutApproval(ctx context.Context, approval PayoutAddressApproval) (*PayoutApprovalChallenge, error)
+
+	// VerifyPayoutApproval verifies the approver's signature over a payout address approval
+	// Includes nonce reservation, timestamp validation, and signature verification
+	VerifyPayoutApproval(ctx context.Context, approval PayoutAddressApproval, signature []byte) error
+
+	// IssueDelegation pre-reserves a nonce for the owner and returns the
+	// SignerDelegation typed data the owner must sign
+	IssueDelegation(ctx context.Context, delegation SignerDelegation) (*DelegationChallenge, error)
+
+	// VerifyDelegation verifies the owner's signature over a signer delegation
+	// Includes nonce reservation, timestamp validation, and signature verification
+	VerifyDelegation(ctx context.Context, delegation SignerDelegation, signature []byte) error
 }
 
 // Error definitions
@@ -44,4 +122,7 @@ var (
 	ErrInvalidAddress       = errors.New("invalid ethereum address")
 	ErrAddressMismatch      = errors.New("recovered address does not match")
 	ErrInvalidSignatureLen  = errors.New("signature must be 65 bytes")
+	ErrContractSignature    = errors.New("contract wallet rejected signature")
+	ErrUnsupportedScheme    = errors.New("unsupported signature scheme")
+	ErrUnsupportedChain     = errors.New("unsupported chain id")
 )
@@ -0,0 +1,80 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Supported drivers
+const (
+	DriverNoop  = "noop"
+	DriverKafka = "kafka"
+	DriverNATS  = "nats"
+)
+
+const (
+	// DefaultTimeout bounds a single publish call
+	DefaultTimeout = 5 * time.Second
+)
+
+// Message is a single event published to the bus
+type Message struct {
+	// Topic is the Kafka topic / NATS subject
+	Topic string
+	// Key is the partition/ordering key (e.g. aggregate ID)
+	Key string
+	// ID is the unique event ID (consumers dedupe by it - delivery is at-least-once)
+	ID string
+	// Type is the event type (e.g. "kyc.approved")
+	Type string
+	// Payload is the JSON encoded event envelope
+	Payload []byte
+}
+
+// Publisher defines the interface for publishing events to other internal systems
+// Implementations can use Kafka, NATS, or a no-op backend for development
+type Publisher interface {
+	// Publish sends a message and returns once the broker acknowledged it
+	Publish(ctx context.Context, msg Message) error
+
+	// Close releases the underlying connection
+	Close() error
+}
+
+// Config holds event bus driver settings
+type Config struct {
+	Driver string
+	// KafkaRESTURL is the base URL of the Kafka REST Proxy (v2 API)
+	KafkaRESTURL string
+	// NATSURL is the NATS server URL (comma separated for clusters)
+	NATSURL string
+	Timeout time.Duration
+}
+
+// Error definitions
+var (
+	ErrUnknownDriver = errors.New("unknown event bus driver")
+	ErrPublishFailed = errors.New("event bus rejected message")
+)
+
+// New creates the publisher selected by config.Driver (empty = noop)
+func New(ctx context.Context, config Config, logger *zap.Logger) (Publisher, error) {
+	if config.Timeout == 0 {
+		config.Timeout = DefaultTimeout
+	}
+
+	switch config.Driver {
+	case "", DriverNoop:
+		return NewNoopPublisher(logger), nil
+	case DriverKafka:
+		return NewKafkaRESTPublisher(config.KafkaRESTURL, config.Timeout, logger)
+	case DriverNATS:
+		return NewNATSPublisher(ctx, config.NATSURL, config.Timeout, logger)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownDriver, config.Driver)
+	}
+}
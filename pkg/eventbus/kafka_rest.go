@@ -0,0 +1,124 @@
+package eventbus
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// kafkaRESTContentType is the Kafka REST Proxy v2 binary embedded format
+	kafkaRESTContentType = "application/vnd.kafka.binary.v2+json"
+	// kafkaRESTAccept is the Kafka REST Proxy v2 response format
+	kafkaRESTAccept = "application/vnd.kafka.v2+json"
+	// maxResponseBodyRead bounds how much of the proxy response is read
+	maxResponseBodyRead = 64 << 10
+)
+
+// KafkaRESTPublisher implements Publisher using the Kafka REST Proxy v2 API
+//
+// Why:
+// - HTTP 기반 → 네이티브 Kafka 클라이언트(압축 코덱 등 cgo/추가 의존성) 없이 발행 가능
+// - 응답의 offsets[].error_code로 레코드 단위 실패를 확인 → ack 이후에만 성공 처리
+type KafkaRESTPublisher struct {
+	baseURL string
+	client  *http.Client
+	logger  *zap.Logger
+}
+
+// Compile-time interface compliance check
+var _ Publisher = (*KafkaRESTPublisher)(nil)
+
+// kafkaRESTRecord is a single record in a produce request (key/value base64 encoded)
+type kafkaRESTRecord struct {
+	Key   []byte `json:"key,omitempty"`
+	Value []byte `json:"value"`
+}
+
+type kafkaRESTProduceRequest struct {
+	Records []kafkaRESTRecord `json:"records"`
+}
+
+type kafkaRESTProduceResponse struct {
+	Offsets []struct {
+		Partition *int32 `json:"partition"`
+		Offset    *int64 `json:"offset"`
+		ErrorCode *int   `json:"error_code"`
+		Error     string `json:"error"`
+	} `json:"offsets"`
+}
+
+// NewKafkaRESTPublisher creates a publisher for the given REST Proxy base URL
+func NewKafkaRESTPublisher(baseURL string, timeout time.Duration, logger *zap.Logger) (*KafkaRESTPublisher, error) {
+	parsed, err := url.Parse(baseURL)
+	if err != nil || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid kafka rest proxy url %q", baseURL)
+	}
+
+	return &KafkaRESTPublisher{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		client:  &http.Client{Timeout: timeout},
+		logger:  logger,
+	}, nil
+}
+
+// Publish produces the message to its topic and waits for the broker ack
+func (p *KafkaRESTPublisher) Publish(ctx context.Context, msg Message) error {
+	record := kafkaRESTRecord{Value: msg.Payload}
+	if msg.Key != "" {
+		record.Key = []byte(msg.Key)
+	}
+
+	body, err := json.Marshal(kafkaRESTProduceRequest{Records: []kafkaRESTRecord{record}})
+	if err != nil {
+		return fmt.Errorf("marshal produce request: %w", err)
+	}
+
+	endpoint := p.baseURL + "/topics/" + url.PathEscape(msg.Topic)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build produce request: %w", err)
+	}
+	req.Header.Set("Content-Type", kafkaRESTContentType)
+	req.Header.Set("Accept", kafkaRESTAccept)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("send produce request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodyRead))
+	if err != nil {
+		return fmt.Errorf("read produce response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%w: status %d: %s", ErrPublishFailed, resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	var produced kafkaRESTProduceResponse
+	if err := json.Unmarshal(respBody, &produced); err != nil {
+		return fmt.Errorf("decode produce response: %w", err)
+	}
+	for _, offset := range produced.Offsets {
+		if offset.ErrorCode != nil {
+			return fmt.Errorf("%w: error_code %d: %s", ErrPublishFailed, *offset.ErrorCode, offset.Error)
+		}
+	}
+
+	return nil
+}
+
+// Close releases idle HTTP connections
+func (p *KafkaRESTPublisher) Close() error {
+	p.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,73 @@
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+)
+
+const (
+	// natsEventTypeHeader carries the event type
+	natsEventTypeHeader = "Event-Type"
+)
+
+// NATSPublisher implements Publisher using a NATS connection
+type NATSPublisher struct {
+	conn    *nats.Conn
+	timeout time.Duration
+	logger  *zap.Logger
+}
+
+// Compile-time interface compliance check
+var _ Publisher = (*NATSPublisher)(nil)
+
+// NewNATSPublisher connects to the NATS server (fail-fast)
+func NewNATSPublisher(ctx context.Context, serverURL string, timeout time.Duration, logger *zap.Logger) (*NATSPublisher, error) {
+	conn, err := nats.Connect(serverURL,
+		nats.Name("stablecoin-settlement-engine"),
+		nats.Timeout(timeout),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			logger.Warn("nats disconnected", zap.Error(err))
+		}),
+		nats.ReconnectHandler(func(c *nats.Conn) {
+			logger.Info("nats reconnected", zap.String("url", c.ConnectedUrl()))
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("connect nats: %w", err)
+	}
+
+	return &NATSPublisher{
+		conn:    conn,
+		timeout: timeout,
+		logger:  logger,
+	}, nil
+}
+
+// Publish sends the message to its subject and flushes until the server received it.
+// Nats-Msg-Id enables JetStream duplicate detection when the subject is stream-backed.
+func (p *NATSPublisher) Publish(ctx context.Context, msg Message) error {
+	natsMsg := nats.NewMsg(msg.Topic)
+	natsMsg.Data = msg.Payload
+	natsMsg.Header.Set(nats.MsgIdHdr, msg.ID)
+	natsMsg.Header.Set(natsEventTypeHeader, msg.Type)
+
+	if err := p.conn.PublishMsg(natsMsg); err != nil {
+		return fmt.Errorf("publish nats message: %w", err)
+	}
+
+	flushCtx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	if err := p.conn.FlushWithContext(flushCtx); err != nil {
+		return fmt.Errorf("flush nats connection: %w", err)
+	}
+	return nil
+}
+
+// Close drains pending messages and closes the connection
+func (p *NATSPublisher) Close() error {
+	return p.conn.Drain()
+}
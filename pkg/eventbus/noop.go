@@ -0,0 +1,35 @@
+package eventbus
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// NoopPublisher implements Publisher by logging and dropping messages (development)
+type NoopPublisher struct {
+	logger *zap.Logger
+}
+
+// Compile-time interface compliance check
+var _ Publisher = (*NoopPublisher)(nil)
+
+// NewNoopPublisher creates a publisher that discards every message
+func NewNoopPublisher(logger *zap.Logger) *NoopPublisher {
+	return &NoopPublisher{logger: logger}
+}
+
+// Publish logs the message at debug level and drops it
+func (p *NoopPublisher) Publish(ctx context.Context, msg Message) error {
+	p.logger.Debug("event bus disabled, message dropped",
+		zap.String("topic", msg.Topic),
+		zap.String("event_id", msg.ID),
+		zap.String("event_type", msg.Type),
+	)
+	return nil
+}
+
+// Close is a no-op
+func (p *NoopPublisher) Close() error {
+	return nil
+}
@@ -0,0 +1,55 @@
+package hdwallet
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+)
+
+// base58Alphabet is the Bitcoin base58 alphabet
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var (
+	errInvalidBase58 = errors.New("invalid base58 character")
+	errChecksum      = errors.New("checksum mismatch")
+)
+
+// decodeBase58Check decodes a base58check string and verifies its 4-byte checksum
+func decodeBase58Check(encoded string) ([]byte, error) {
+	decoded, err := decodeBase58(encoded)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) < 4 {
+		return nil, errChecksum
+	}
+
+	payload, checksum := decoded[:len(decoded)-4], decoded[len(decoded)-4:]
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	if !bytes.Equal(second[:4], checksum) {
+		return nil, errChecksum
+	}
+	return payload, nil
+}
+
+// decodeBase58 decodes a base58 string (leading '1's are zero bytes)
+func decodeBase58(encoded string) ([]byte, error) {
+	n := new(big.Int)
+	radix := big.NewInt(58)
+	for _, r := range encoded {
+		idx := bytes.IndexRune([]byte(base58Alphabet), r)
+		if idx < 0 {
+			return nil, errInvalidBase58
+		}
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(idx)))
+	}
+
+	zeros := 0
+	for zeros < len(encoded) && encoded[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
@@ -0,0 +1,153 @@
+package hdwallet
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Extended public key versions (BIP32 serialization)
+var (
+	versionMainnetPublic = []byte{0x04, 0x88, 0xb2, 0x1e} // xpub
+	versionTestnetPublic = []byte{0x04, 0x35, 0x87, 0xcf} // tpub
+)
+
+const (
+	// serializedKeyLen is the length of a serialized extended key (without checksum)
+	serializedKeyLen = 78
+
+	// HardenedOffset is the first hardened child index (cannot be derived from an xpub)
+	HardenedOffset uint32 = 0x80000000
+)
+
+// Error definitions
+var (
+	ErrInvalidExtendedKey = errors.New("invalid extended public key")
+	ErrPrivateKey         = errors.New("extended private keys are not accepted")
+	ErrHardenedIndex      = errors.New("hardened index cannot be derived from a public key")
+	ErrInvalidChild       = errors.New("invalid child key")
+)
+
+// ExtendedPublicKey is a BIP32 extended public key (xpub).
+// Only public derivation is supported: the private keys never reach the server.
+type ExtendedPublicKey struct {
+	publicKey []byte // compressed (33 bytes)
+	chainCode []byte
+	depth     byte
+}
+
+// ParseExtendedPublicKey parses a base58check-encoded xpub/tpub
+func ParseExtendedPublicKey(encoded string) (*ExtendedPublicKey, error) {
+	encoded = strings.TrimSpace(encoded)
+	if strings.HasPrefix(encoded, "xprv") || strings.HasPrefix(encoded, "tprv") {
+		return nil, ErrPrivateKey
+	}
+
+	payload, err := decodeBase58Check(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidExtendedKey, err)
+	}
+	if len(payload) != serializedKeyLen {
+		return nil, fmt.Errorf("%w: length %d", ErrInvalidExtendedKey, len(payload))
+	}
+
+	version := payload[:4]
+	if !bytes.Equal(version, versionMainnetPublic) && !bytes.Equal(version, versionTestnetPublic) {
+		return nil, fmt.Errorf("%w: unsupported version %x", ErrInvalidExtendedKey, version)
+	}
+
+	key := payload[45:78]
+	if _, err := crypto.DecompressPubkey(key); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidExtendedKey, err)
+	}
+
+	return &ExtendedPublicKey{
+		publicKey: append([]byte(nil), key...),
+		chainCode: append([]byte(nil), payload[13:45]...),
+		depth:     payload[4],
+	}, nil
+}
+
+// Child derives the non-hardened child key at index (BIP32 CKDpub)
+func (k *ExtendedPublicKey) Child(index uint32) (*ExtendedPublicKey, error) {
+	if index >= HardenedOffset {
+		return nil, ErrHardenedIndex
+	}
+
+	// I = HMAC-SHA512(chainCode, serP(K) || ser32(i))
+	data := make([]byte, 0, 37)
+	data = append(data, k.publicKey...)
+	data = binary.BigEndian.AppendUint32(data, index)
+	mac := hmac.New(sha512.New, k.chainCode)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+
+	// K_i = point(I_L) + K (I_L >= n or the point at infinity are invalid - use the next index)
+	curve := crypto.S256()
+	il := new(big.Int).SetBytes(sum[:32])
+	if il.Cmp(curve.Params().N) >= 0 {
+		return nil, ErrInvalidChild
+	}
+	parent, err := crypto.DecompressPubkey(k.publicKey)
+	if err != nil {
+		return nil, err
+	}
+	ilx, ily := curve.ScalarBaseMult(sum[:32])
+	x, y := curve.Add(ilx, ily, parent.X, parent.Y)
+	if x.Sign() == 0 && y.Sign() == 0 {
+		return nil, ErrInvalidChild
+	}
+
+	return &ExtendedPublicKey{
+		publicKey: compress(x, y),
+		chainCode: sum[32:],
+		depth:     k.depth + 1,
+	}, nil
+}
+
+// Address returns the Ethereum address of the key (lower-case hex)
+func (k *ExtendedPublicKey) Address() (string, error) {
+	pub, err := crypto.DecompressPubkey(k.publicKey)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToLower(crypto.PubkeyToAddress(*pub).Hex()), nil
+}
+
+// DeriveAddress returns the Ethereum address of the child key at index
+func (k *ExtendedPublicKey) DeriveAddress(index uint32) (string, error) {
+	child, err := k.Child(index)
+	if err != nil {
+		return "", err
+	}
+	return child.Address()
+}
+
+// Depth returns the depth of the key in the derivation tree
+func (k *ExtendedPublicKey) Depth() byte {
+	return k.depth
+}
+
+// ID identifies the key (hex, 32 chars) so that derivation indexes are tracked per key
+// Why: xpub 교체 시 인덱스를 0부터 다시 사용 → (key_id, index) 단위로 관리
+func (k *ExtendedPublicKey) ID() string {
+	sum := sha256.Sum256(append(append([]byte(nil), k.publicKey...), k.chainCode...))
+	return hex.EncodeToString(sum[:16])
+}
+
+// compress serializes a curve point in compressed form
+func compress(x, y *big.Int) []byte {
+	out := make([]byte, 33)
+	out[0] = 0x02 | byte(y.Bit(0))
+	x.FillBytes(out[1:])
+	return out
+}
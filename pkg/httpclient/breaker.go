@@ -0,0 +1,95 @@
+package httpclient
+
+import (
+	"sync"
+	"time"
+)
+
+// breakers tracks one circuit per host.
+// Why: 웹훅처럼 목적지가 여러 개인 연동에서 한 호스트 장애가 다른 호스트 호출을 막지 않도록 호스트 단위로 분리
+type breakers struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu    sync.Mutex
+	hosts map[string]*circuit
+}
+
+// circuit is the state of a single host.
+// closed: failures < threshold / open: until openUntil / half-open: one probe in flight after the cooldown
+type circuit struct {
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+func newBreakers(threshold int, cooldown time.Duration) *breakers {
+	return &breakers{
+		threshold: threshold,
+		cooldown:  cooldown,
+		hosts:     make(map[string]*circuit),
+	}
+}
+
+// allow reports whether a request to the host may be sent
+func (b *breakers) allow(host string) bool {
+	if b.threshold < 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, ok := b.hosts[host]
+	if !ok || c.failures < b.threshold {
+		return true
+	}
+	if time.Now().Before(c.openUntil) || c.probing {
+		return false
+	}
+	// Half-open: let a single probe through
+	c.probing = true
+	return true
+}
+
+// record records the outcome of a request and reports whether it opened the circuit
+func (b *breakers) record(host string, failed bool) bool {
+	if b.threshold < 0 {
+		return false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, ok := b.hosts[host]
+	if !failed {
+		// 성공 시 상태 제거 (호스트 수만큼 맵이 커지지 않도록)
+		if ok {
+			delete(b.hosts, host)
+		}
+		return false
+	}
+	if !ok {
+		c = &circuit{}
+		b.hosts[host] = c
+	}
+
+	c.failures++
+	c.probing = false
+	if c.failures < b.threshold {
+		return false
+	}
+	c.openUntil = time.Now().Add(b.cooldown)
+	// threshold 도달 시 또는 half-open probe 실패 시 (재)오픈
+	return true
+}
+
+// release ends a half-open probe without an outcome (e.g. canceled by the caller)
+func (b *breakers) release(host string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if c, ok := b.hosts[host]; ok {
+		c.probing = false
+	}
+}
@@ -0,0 +1,280 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultTimeout bounds a single attempt when Config.Timeout is not set
+	DefaultTimeout = 10 * time.Second
+	// DefaultRetryBaseDelay is the delay before the first retry (doubled per attempt, with jitter)
+	DefaultRetryBaseDelay = 200 * time.Millisecond
+	// DefaultRetryMaxDelay caps the retry delay (including Retry-After)
+	DefaultRetryMaxDelay = 5 * time.Second
+	// DefaultBreakerThreshold is the number of consecutive failures that opens a host's circuit
+	DefaultBreakerThreshold = 5
+	// DefaultBreakerCooldown is how long an open circuit rejects requests before a probe is allowed
+	DefaultBreakerCooldown = 30 * time.Second
+
+	// maxDrainBytes bounds how much of a retried response body is drained for connection reuse
+	maxDrainBytes = 4 << 10
+)
+
+// ErrCircuitOpen is returned without sending the request while the host's circuit is open
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// defaultRetryStatuses are the responses retried by default (transient upstream failures)
+var defaultRetryStatuses = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// Config holds the outbound call policy of one integration
+type Config struct {
+	// Name identifies the integration in logs and metrics (e.g. "webhook", "kyc")
+	Name string
+	// Timeout bounds each attempt (0 = DefaultTimeout)
+	Timeout time.Duration
+
+	// MaxRetries is the number of retries after the first attempt (0 = no retries).
+	// Only idempotent requests are retried unless RetryNonIdempotent is set, and only
+	// when the body can be replayed (http.NewRequest sets GetBody for in-memory bodies).
+	MaxRetries         int
+	RetryNonIdempotent bool
+	RetryBaseDelay     time.Duration
+	RetryMaxDelay      time.Duration
+	// RetryStatuses overrides the retried status codes (default 429/502/503/504)
+	RetryStatuses []int
+
+	// BreakerThreshold is the consecutive failures (transport errors or 5xx) that open
+	// a host's circuit (0 = DefaultBreakerThreshold, negative = breaker disabled)
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+
+	// RedactHeaders are logged as [REDACTED] in addition to the default credential headers
+	RedactHeaders []string
+	// RedactQueryParams are logged as [REDACTED] in addition to the default credential params
+	RedactQueryParams []string
+
+	// Transport overrides the underlying transport (nil = http.DefaultTransport)
+	Transport http.RoundTripper
+}
+
+// Client is an instrumented HTTP client shared by external integrations.
+// Every request gets the same timeout, retry, circuit breaker, logging and metrics behavior.
+//
+// Why: 외부 연동마다 제각각이던 타임아웃/재시도 정책을 통일하고 호출 현황을 관측 가능하게 함
+type Client struct {
+	name          string
+	client        *http.Client
+	config        Config
+	retryStatuses map[int]bool
+	breakers      *breakers
+	redactor      *redactor
+	metrics       *metrics
+	logger        *zap.Logger
+}
+
+// New creates a client for the integration described by config
+func New(config Config, logger *zap.Logger) *Client {
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultTimeout
+	}
+	if config.RetryBaseDelay <= 0 {
+		config.RetryBaseDelay = DefaultRetryBaseDelay
+	}
+	if config.RetryMaxDelay <= 0 {
+		config.RetryMaxDelay = DefaultRetryMaxDelay
+	}
+	if config.BreakerThreshold == 0 {
+		config.BreakerThreshold = DefaultBreakerThreshold
+	}
+	if config.BreakerCooldown <= 0 {
+		config.BreakerCooldown = DefaultBreakerCooldown
+	}
+	if config.RetryStatuses == nil {
+		config.RetryStatuses = defaultRetryStatuses
+	}
+	transport := config.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	retryStatuses := make(map[int]bool, len(config.RetryStatuses))
+	for _, status := range config.RetryStatuses {
+		retryStatuses[status] = true
+	}
+
+	return &Client{
+		name:          config.Name,
+		client:        &http.Client{Timeout: config.Timeout, Transport: transport},
+		config:        config,
+		retryStatuses: retryStatuses,
+		breakers:      newBreakers(config.BreakerThreshold, config.BreakerCooldown),
+		redactor:      newRedactor(config.RedactHeaders, config.RedactQueryParams),
+		metrics:       defaultMetrics(),
+		logger:        logger.With(zap.String("http_client", config.Name)),
+	}
+}
+
+// Do sends the request with the client's retry and circuit breaker policy.
+// As with http.Client, a non-2xx response is not an error; the caller must close the body.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	host := req.URL.Host
+	retryable := c.canRetry(req)
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if err := c.rewind(req); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := c.attempt(req, host, attempt)
+		if errors.Is(err, ErrCircuitOpen) || !retryable || attempt >= c.config.MaxRetries || !c.shouldRetry(ctx, resp, err) {
+			return resp, err
+		}
+
+		delay := c.retryDelay(attempt, resp)
+		if resp != nil {
+			// 재시도 전 연결 재사용을 위해 본문 정리
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxDrainBytes))
+			resp.Body.Close()
+		}
+		c.metrics.retries.WithLabelValues(c.name).Inc()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// attempt sends a single attempt through the host's circuit breaker and records it
+func (c *Client) attempt(req *http.Request, host string, attempt int) (*http.Response, error) {
+	log := logctx.From(req.Context(), c.logger)
+
+	if !c.breakers.allow(host) {
+		c.metrics.requests.WithLabelValues(c.name, req.Method, statusCircuitOpen).Inc()
+		log.Warn("outbound request rejected by circuit breaker",
+			zap.String("method", req.Method),
+			zap.String("url", c.redactor.url(req.URL)),
+		)
+		return nil, fmt.Errorf("%w: %s", ErrCircuitOpen, host)
+	}
+
+	start := time.Now()
+	resp, err := c.client.Do(req)
+	duration := time.Since(start)
+
+	status := statusError
+	if err == nil {
+		status = strconv.Itoa(resp.StatusCode)
+	}
+	c.metrics.requests.WithLabelValues(c.name, req.Method, status).Inc()
+	c.metrics.duration.WithLabelValues(c.name, req.Method).Observe(duration.Seconds())
+
+	// 호출자 취소는 상대 서버 장애가 아니므로 breaker에 반영하지 않음
+	if req.Context().Err() != nil {
+		c.breakers.release(host)
+	} else if opened := c.breakers.record(host, err != nil || resp.StatusCode >= http.StatusInternalServerError); opened {
+		c.metrics.circuitOpened.WithLabelValues(c.name).Inc()
+		log.Warn("outbound circuit opened",
+			zap.String("host", host),
+			zap.Duration("cooldown", c.config.BreakerCooldown),
+		)
+	}
+
+	fields := []zap.Field{
+		zap.String("method", req.Method),
+		zap.String("url", c.redactor.url(req.URL)),
+		zap.Int("attempt", attempt+1),
+		zap.Duration("duration", duration),
+	}
+	if err != nil {
+		log.Warn("outbound request failed", append(fields, zap.Error(err))...)
+		return nil, err
+	}
+	log.Debug("outbound request",
+		append(fields,
+			zap.Int("status", resp.StatusCode),
+			zap.Any("headers", c.redactor.headers(req.Header)),
+		)...,
+	)
+	return resp, nil
+}
+
+// canRetry reports whether the request may be sent more than once
+func (c *Client) canRetry(req *http.Request) bool {
+	if c.config.MaxRetries <= 0 {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	return c.config.RetryNonIdempotent || isIdempotent(req.Method)
+}
+
+// shouldRetry reports whether the outcome of an attempt is transient
+func (c *Client) shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	return c.retryStatuses[resp.StatusCode]
+}
+
+// rewind resets the request body for the next attempt
+func (c *Client) rewind(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return fmt.Errorf("rewind request body: %w", err)
+	}
+	req.Body = body
+	return nil
+}
+
+// retryDelay returns the delay before the next attempt: Retry-After (seconds) when
+// the server sent one, exponential backoff with full jitter otherwise
+func (c *Client) retryDelay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return min(time.Duration(seconds)*time.Second, c.config.RetryMaxDelay)
+		}
+	}
+
+	delay := c.config.RetryBaseDelay << attempt
+	if delay <= 0 || delay > c.config.RetryMaxDelay {
+		delay = c.config.RetryMaxDelay
+	}
+	return time.Duration(rand.Int63n(int64(delay)) + 1)
+}
+
+// isIdempotent reports whether the method is idempotent (RFC 9110)
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
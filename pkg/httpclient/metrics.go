@@ -0,0 +1,59 @@
+package httpclient
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Status label values of requests that got no HTTP response
+const (
+	statusError       = "error"
+	statusCircuitOpen = "circuit_open"
+)
+
+// metrics are the Prometheus collectors shared by all clients (labeled by client name)
+// NOTE: 웹훅 목적지처럼 외부에서 정해지는 호스트는 라벨에 넣지 않음 (카디널리티 폭증 방지)
+type metrics struct {
+	requests      *prometheus.CounterVec
+	duration      *prometheus.HistogramVec
+	retries       *prometheus.CounterVec
+	circuitOpened *prometheus.CounterVec
+}
+
+var (
+	sharedMetrics     *metrics
+	sharedMetricsOnce sync.Once
+)
+
+// defaultMetrics registers the collectors on the default Prometheus registry once
+func defaultMetrics() *metrics {
+	sharedMetricsOnce.Do(func() {
+		sharedMetrics = &metrics{
+			requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Name: "http_client_requests_total",
+				Help: "Outbound HTTP attempts by client, method and status code (error/circuit_open without a response).",
+			}, []string{"client", "method", "status"}),
+			duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+				Name:    "http_client_request_duration_seconds",
+				Help:    "Outbound HTTP attempt latency by client and method.",
+				Buckets: prometheus.DefBuckets,
+			}, []string{"client", "method"}),
+			retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Name: "http_client_retries_total",
+				Help: "Outbound HTTP retries by client.",
+			}, []string{"client"}),
+			circuitOpened: prometheus.NewCounterVec(prometheus.CounterOpts{
+				Name: "http_client_circuit_opened_total",
+				Help: "Times a host circuit was opened by client.",
+			}, []string{"client"}),
+		}
+		prometheus.MustRegister(
+			sharedMetrics.requests,
+			sharedMetrics.duration,
+			sharedMetrics.retries,
+			sharedMetrics.circuitOpened,
+		)
+	})
+	return sharedMetrics
+}
@@ -0,0 +1,84 @@
+package httpclient
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// redacted replaces sensitive values in logs
+const redacted = "[REDACTED]"
+
+// Credential headers and query parameters that are always redacted
+var (
+	defaultRedactHeaders = []string{
+		"Authorization",
+		"Proxy-Authorization",
+		"Cookie",
+		"Set-Cookie",
+		"X-Api-Key",
+	}
+	defaultRedactQueryParams = []string{
+		"api_key",
+		"apikey",
+		"key",
+		"token",
+		"access_token",
+		"secret",
+		"signature",
+		"password",
+	}
+)
+
+// redactor masks credentials in logged URLs and headers
+type redactor struct {
+	headerNames map[string]bool // canonical header names
+	paramNames  map[string]bool // lower-case query parameter names
+}
+
+func newRedactor(extraHeaders, extraParams []string) *redactor {
+	r := &redactor{
+		headerNames: make(map[string]bool),
+		paramNames:  make(map[string]bool),
+	}
+	for _, h := range append(append([]string(nil), defaultRedactHeaders...), extraHeaders...) {
+		r.headerNames[http.CanonicalHeaderKey(h)] = true
+	}
+	for _, p := range append(append([]string(nil), defaultRedactQueryParams...), extraParams...) {
+		r.paramNames[strings.ToLower(p)] = true
+	}
+	return r
+}
+
+// url returns the URL with the userinfo password and sensitive query values redacted
+func (r *redactor) url(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	masked := *u
+	if u.RawQuery != "" {
+		query := u.Query()
+		for key, values := range query {
+			if r.paramNames[strings.ToLower(key)] {
+				for i := range values {
+					values[i] = redacted
+				}
+			}
+		}
+		masked.RawQuery = query.Encode()
+	}
+	return masked.Redacted()
+}
+
+// headers returns a copy of the headers with sensitive values redacted
+func (r *redactor) headers(h http.Header) map[string]string {
+	out := make(map[string]string, len(h))
+	for key, values := range h {
+		if r.headerNames[key] {
+			out[key] = redacted
+			continue
+		}
+		out[key] = strings.Join(values, ", ")
+	}
+	return out
+}
@@ -0,0 +1,116 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+)
+
+const (
+	// keyPrefix is the Redis key prefix for stored responses
+	keyPrefix = "idempotency"
+	// lockPrefix is the Redis key prefix for in-flight locks
+	lockPrefix = "idempotency:lock"
+)
+
+// RedisStore implements Store interface using Redis
+type RedisStore struct {
+	client  *redis.Client
+	ttl     time.Duration
+	lockTTL time.Duration
+	logger  *zap.Logger
+}
+
+// Compile-time interface compliance check
+var _ Store = (*RedisStore)(nil)
+
+// NewRedisStore creates a new Redis-based idempotency store
+func NewRedisStore(client *redis.Client, ttl, lockTTL time.Duration, logger *zap.Logger) *RedisStore {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	if lockTTL <= 0 {
+		lockTTL = DefaultLockTTL
+	}
+	return &RedisStore{
+		client:  client,
+		ttl:     ttl,
+		lockTTL: lockTTL,
+		logger:  logger,
+	}
+}
+
+// buildKey creates the response key
+// Format: idempotency:{key}
+func buildKey(key string) string {
+	return fmt.Sprintf("%s:%s", keyPrefix, key)
+}
+
+// buildLockKey creates the in-flight lock key
+// Format: idempotency:lock:{key}
+func buildLockKey(key string) string {
+	return fmt.Sprintf("%s:%s", lockPrefix, key)
+}
+
+// Get returns the stored response for the key
+func (s *RedisStore) Get(ctx context.Context, key string) (*Response, error) {
+	raw, err := s.client.Get(ctx, buildKey(key)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrNotFound
+		}
+		logctx.From(ctx, s.logger).Error("failed to get idempotent response", zap.String("key", key), zap.Error(err))
+		return nil, fmt.Errorf("failed to get idempotent response: %w", err)
+	}
+
+	var response Response
+	if err := json.Unmarshal(raw, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode idempotent response: %w", err)
+	}
+	return &response, nil
+}
+
+// Lock marks the key as in-flight using SETNX
+func (s *RedisStore) Lock(ctx context.Context, key string) error {
+	ok, err := s.client.SetNX(ctx, buildLockKey(key), "locked", s.lockTTL).Result()
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to lock idempotency key", zap.String("key", key), zap.Error(err))
+		return fmt.Errorf("failed to lock idempotency key: %w", err)
+	}
+	if !ok {
+		return ErrInProgress
+	}
+	return nil
+}
+
+// Save stores the response and releases the lock atomically
+func (s *RedisStore) Save(ctx context.Context, key string, response *Response) error {
+	raw, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("failed to encode idempotent response: %w", err)
+	}
+
+	pipe := s.client.TxPipeline()
+	pipe.Set(ctx, buildKey(key), raw, s.ttl)
+	pipe.Del(ctx, buildLockKey(key))
+	if _, err := pipe.Exec(ctx); err != nil {
+		logctx.From(ctx, s.logger).Error("failed to save idempotent response", zap.String("key", key), zap.Error(err))
+		return fmt.Errorf("failed to save idempotent response: %w", err)
+	}
+	return nil
+}
+
+// Unlock releases the in-flight lock
+func (s *RedisStore) Unlock(ctx context.Context, key string) error {
+	if err := s.client.Del(ctx, buildLockKey(key)).Err(); err != nil {
+		logctx.From(ctx, s.logger).Error("failed to unlock idempotency key", zap.String("key", key), zap.Error(err))
+		return fmt.Errorf("failed to unlock idempotency key: %w", err)
+	}
+	return nil
+}
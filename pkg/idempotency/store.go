@@ -0,0 +1,50 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	// DefaultTTL is how long a stored response can be replayed
+	DefaultTTL = 24 * time.Hour
+	// DefaultLockTTL bounds how long an in-flight request holds its key
+	// (crashed requests release the key after this duration). It must exceed the slowest
+	// idempotent handler (on-chain broadcasts, bulk imports) - an expired lock lets a concurrent retry run.
+	DefaultLockTTL = 2 * time.Minute
+)
+
+// Response is a stored HTTP response replayed on retries
+type Response struct {
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type"`
+	Body        []byte `json:"body"`
+	// Fingerprint is a hash of the original request body.
+	// A retry with a different payload under the same key is rejected.
+	Fingerprint string `json:"fingerprint"`
+}
+
+// Store defines the interface for idempotency key storage
+// Implementations can use Redis, in-memory, or other backends
+type Store interface {
+	// Get returns the stored response for the key
+	// Returns ErrNotFound if no response has been stored
+	Get(ctx context.Context, key string) (*Response, error)
+
+	// Lock marks the key as in-flight
+	// Returns ErrInProgress if another request holds the key
+	Lock(ctx context.Context, key string) error
+
+	// Save stores the response for the key and releases the in-flight lock
+	Save(ctx context.Context, key string, response *Response) error
+
+	// Unlock releases the in-flight lock without storing a response (allows retry)
+	Unlock(ctx context.Context, key string) error
+}
+
+// Error definitions
+var (
+	ErrNotFound   = errors.New("idempotency key not found")
+	ErrInProgress = errors.New("request with idempotency key in progress")
+)
@@ -0,0 +1,260 @@
+package kyc
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/httpclient"
+	"go.uber.org/zap"
+)
+
+const (
+	// SignatureHeader carries "t=<unix>,v1=<hex hmac>" of decision callbacks,
+	// v1 = hex(HMAC-SHA256(webhook secret, "<unix timestamp>.<body>"))
+	SignatureHeader = "X-KYC-Signature"
+
+	// maxResponseBodyRead bounds how much of the provider response is read
+	maxResponseBodyRead = 64 << 10
+	// maxReasonLength bounds the stored rejection reason
+	maxReasonLength = 500
+	// maxVerdictLength bounds the stored provider verdict
+	maxVerdictLength = 50
+	// maxEventIDLength bounds the provider event ID (dedupe key)
+	maxEventIDLength = 100
+)
+
+// verdictOutcomes maps provider verdicts (upper-cased) to decision outcomes.
+// NOTE: 검토 완료 상태(COMPLETED 등)는 판정이 아님 → 매핑하지 않음 (결과가 담긴 outcome 필드 필요)
+var verdictOutcomes = map[string]string{
+	"APPROVED":  OutcomeApproved,
+	"GREEN":     OutcomeApproved,
+	"VERIFIED":  OutcomeApproved,
+	"PASSED":    OutcomeApproved,
+	"REJECTED":  OutcomeRejected,
+	"RED":       OutcomeRejected,
+	"DECLINED":  OutcomeRejected,
+	"FAILED":    OutcomeRejected,
+	"PENDING":   OutcomePending,
+	"IN_REVIEW": OutcomePending,
+	"ON_HOLD":   OutcomePending,
+	"RETRY":     OutcomePending,
+	// 재제출 요청: 최종 거절 아님 → 사용자는 같은 applicant로 SDK 흐름 재개
+	"RESUBMISSION_REQUESTED": OutcomePending,
+}
+
+// HTTPProvider implements Provider against a hosted KYC service (Sumsub/Persona-style).
+// Adapters for a specific vendor can be deployed behind the endpoint.
+//
+// Create applicant: POST {url}/applicants
+//
+//	Request:  {"external_user_id":"...","email":"...","name":"...","phone":"..."}
+//	Response: {"applicant_id":"...","sdk_token":"...","expires_at":"2024-01-01T00:00:00Z"}
+//
+// Decision callback (X-KYC-Signature = t=<unix>,v1=hex HMAC-SHA256 of "<unix>.<body>"):
+//
+//	{"event_id":"...","applicant_id":"...","external_user_id":"...","outcome":"APPROVED|REJECTED","reason":"..."}
+//
+// outcome also accepts common vendor verdicts (GREEN/RED, verified/declined, in_review, ...), see verdictOutcomes.
+//
+// Why:
+//   - 제공자는 external_user_id로 applicant를 중복 제거 → POST여도 재시도 허용
+//   - 콜백은 서명으로만 인증 (API 키 없음) → 상수 시간 비교
+//   - 타임스탬프를 서명에 포함, 허용 오차 밖이면 거부 → 캡처된 콜백 replay 차단 (재전송은 event_id로 중복 제거)
+type HTTPProvider struct {
+	endpoint      string
+	apiKey        string
+	webhookSecret []byte
+	tolerance     time.Duration
+	client        *httpclient.Client
+}
+
+// Compile-time interface compliance check
+var _ Provider = (*HTTPProvider)(nil)
+
+type createApplicantRequest struct {
+	ExternalUserID string `json:"external_user_id"`
+	Email          string `json:"email,omitempty"`
+	Name           string `json:"name,omitempty"`
+	Phone          string `json:"phone,omitempty"`
+}
+
+type createApplicantResponse struct {
+	ApplicantID string    `json:"applicant_id"`
+	SDKToken    string    `json:"sdk_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+type decisionCallback struct {
+	EventID        string `json:"event_id"`
+	ApplicantID    string `json:"applicant_id"`
+	ExternalUserID string `json:"external_user_id"`
+	Outcome        string `json:"outcome"`
+	Reason         string `json:"reason"`
+}
+
+// NewHTTPProvider creates a provider for the given KYC service base URL.
+// tolerance bounds the allowed clock difference between the callback signature timestamp and now.
+func NewHTTPProvider(baseURL, apiKey, webhookSecret string, timeout, tolerance time.Duration, logger *zap.Logger) (*HTTPProvider, error) {
+	parsed, err := url.Parse(baseURL)
+	if err != nil || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid kyc api url %q", baseURL)
+	}
+	if webhookSecret == "" {
+		return nil, errors.New("kyc webhook secret is required")
+	}
+
+	return &HTTPProvider{
+		endpoint:      strings.TrimRight(baseURL, "/") + "/applicants",
+		apiKey:        apiKey,
+		webhookSecret: []byte(webhookSecret),
+		tolerance:     tolerance,
+		client: httpclient.New(httpclient.Config{
+			Name:               "kyc",
+			Timeout:            timeout,
+			MaxRetries:         2,
+			RetryNonIdempotent: true,
+		}, logger),
+	}, nil
+}
+
+// Name returns ProviderHTTP
+func (p *HTTPProvider) Name() string {
+	return ProviderHTTP
+}
+
+// CreateApplicant creates the applicant and returns its SDK token
+func (p *HTTPProvider) CreateApplicant(ctx context.Context, applicant Applicant) (*Session, error) {
+	body, err := json.Marshal(createApplicantRequest{
+		ExternalUserID: applicant.ExternalUserID,
+		Email:          applicant.Email,
+		Name:           applicant.Name,
+		Phone:          applicant.Phone,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal kyc request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("build kyc request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrProviderUnavailable, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodyRead))
+	if err != nil {
+		return nil, fmt.Errorf("%w: read response: %v", ErrProviderUnavailable, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("%w: status %d: %s", ErrProviderUnavailable, resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+
+	var created createApplicantResponse
+	if err := json.Unmarshal(respBody, &created); err != nil {
+		return nil, fmt.Errorf("%w: decode response: %v", ErrProviderUnavailable, err)
+	}
+	if created.ApplicantID == "" || len(created.ApplicantID) > 100 || created.SDKToken == "" {
+		return nil, fmt.Errorf("%w: missing applicant_id or sdk_token", ErrProviderUnavailable)
+	}
+	return &Session{
+		ApplicantID: created.ApplicantID,
+		SDKToken:    created.SDKToken,
+		ExpiresAt:   created.ExpiresAt,
+	}, nil
+}
+
+// ParseDecision verifies the callback signature (within the timestamp tolerance) and decodes the decision
+func (p *HTTPProvider) ParseDecision(signature string, body []byte) (*Decision, error) {
+	if !p.verifySignature(signature, body, time.Now()) {
+		return nil, ErrInvalidSignature
+	}
+
+	var callback decisionCallback
+	if err := json.Unmarshal(body, &callback); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDecision, err)
+	}
+	if callback.EventID == "" || len(callback.EventID) > maxEventIDLength {
+		return nil, fmt.Errorf("%w: missing or invalid event_id", ErrInvalidDecision)
+	}
+	if callback.ApplicantID == "" {
+		return nil, fmt.Errorf("%w: missing applicant_id", ErrInvalidDecision)
+	}
+	outcome, ok := verdictOutcomes[strings.ToUpper(strings.TrimSpace(callback.Outcome))]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown outcome %q", ErrInvalidDecision, callback.Outcome)
+	}
+
+	decision := &Decision{
+		EventID:        callback.EventID,
+		ApplicantID:    callback.ApplicantID,
+		ExternalUserID: callback.ExternalUserID,
+		Verdict:        truncate(callback.Outcome, maxVerdictLength),
+		Outcome:        outcome,
+	}
+	if outcome == OutcomeRejected {
+		decision.Reason = truncate(callback.Reason, maxReasonLength)
+	}
+	return decision, nil
+}
+
+// verifySignature validates an X-KYC-Signature header value against the body and the timestamp tolerance
+func (p *HTTPProvider) verifySignature(header string, body []byte, now time.Time) bool {
+	var ts, sig string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			ts = value
+		case "v1":
+			sig = strings.ToLower(value)
+		}
+	}
+	if ts == "" || sig == "" {
+		return false
+	}
+
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return false
+	}
+	if diff := now.Sub(time.Unix(unix, 0)); diff > p.tolerance || diff < -p.tolerance {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, p.webhookSecret)
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hmac.Equal([]byte(sig), []byte(hex.EncodeToString(mac.Sum(nil))))
+}
+
+// truncate shortens s to at most n runes
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
@@ -0,0 +1,108 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Supported providers
+const (
+	ProviderManual = "manual"
+	ProviderHTTP   = "http"
+)
+
+// Decision outcomes
+const (
+	OutcomeApproved = "APPROVED"
+	OutcomeRejected = "REJECTED"
+	// OutcomePending is a non-final verdict (in review, resubmission requested) - the KYC status is unchanged
+	OutcomePending = "PENDING"
+)
+
+// Error definitions
+var (
+	ErrUnknownProvider = errors.New("unknown kyc provider")
+	// ErrProviderUnavailable is returned when the provider could not create the applicant
+	ErrProviderUnavailable = errors.New("kyc provider unavailable")
+	// ErrInvalidSignature is returned when a decision callback is not signed by the provider
+	ErrInvalidSignature = errors.New("invalid kyc callback signature")
+	// ErrInvalidDecision is returned for a malformed decision callback
+	ErrInvalidDecision = errors.New("invalid kyc decision")
+	// ErrCallbacksNotSupported is returned by providers without decision callbacks
+	ErrCallbacksNotSupported = errors.New("kyc provider does not send decision callbacks")
+)
+
+// Applicant identifies the user to verify
+type Applicant struct {
+	// ExternalUserID is the user's external ID (providers deduplicate applicants by it)
+	ExternalUserID string
+	Email          string
+	Name           string
+	Phone          string
+}
+
+// Session is a created provider applicant with the token the client SDK uses to run the verification flow
+type Session struct {
+	ApplicantID string
+	SDKToken    string
+	// ExpiresAt is when the SDK token expires (zero = not reported)
+	ExpiresAt time.Time
+}
+
+// Decision is a review result reported by the provider
+type Decision struct {
+	// EventID is the provider's ID of the callback event (same across redeliveries)
+	EventID        string
+	ApplicantID    string
+	ExternalUserID string
+	// Verdict is the provider's own verdict as received (e.g. GREEN, declined)
+	Verdict string
+	// Outcome is the verdict mapped to OutcomeApproved, OutcomeRejected or OutcomePending
+	Outcome string
+	// Reason is the provider's rejection reason (empty when approved)
+	Reason string
+}
+
+// Provider defines the interface for identity verification providers
+// Implementations can integrate a hosted KYC service (Sumsub, Persona, Onfido, ...) or leave reviews to admins
+type Provider interface {
+	// Name identifies the provider (stored with applicants)
+	Name() string
+
+	// CreateApplicant creates (or returns the existing) applicant of the user and issues an SDK token.
+	// A nil session means there is no hosted flow - the request is reviewed by an admin.
+	CreateApplicant(ctx context.Context, applicant Applicant) (*Session, error)
+
+	// ParseDecision authenticates and decodes a decision callback.
+	// Returns ErrInvalidSignature when the signature does not match the body or its timestamp is outside the tolerance.
+	ParseDecision(signature string, body []byte) (*Decision, error)
+}
+
+// Config holds KYC provider settings
+type Config struct {
+	Provider string
+	// URL / APIKey / Timeout configure ProviderHTTP applicant creation
+	URL     string
+	APIKey  string
+	Timeout time.Duration
+	// WebhookSecret authenticates ProviderHTTP decision callbacks (required)
+	WebhookSecret string
+	// WebhookTolerance bounds the age of a callback signature timestamp (replay window)
+	WebhookTolerance time.Duration
+}
+
+// New creates the provider selected by config.Provider (empty = manual)
+func New(config Config, logger *zap.Logger) (Provider, error) {
+	switch config.Provider {
+	case "", ProviderManual:
+		return NewManualProvider(), nil
+	case ProviderHTTP:
+		return NewHTTPProvider(config.URL, config.APIKey, config.WebhookSecret, config.Timeout, config.WebhookTolerance, logger)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownProvider, config.Provider)
+	}
+}
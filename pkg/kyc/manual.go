@@ -0,0 +1,30 @@
+package kyc
+
+import "context"
+
+// ManualProvider implements Provider without a hosted verification flow: KYC requests stay PENDING
+// until an admin approves or rejects them.
+type ManualProvider struct{}
+
+// Compile-time interface compliance check
+var _ Provider = (*ManualProvider)(nil)
+
+// NewManualProvider creates a new manual provider
+func NewManualProvider() *ManualProvider {
+	return &ManualProvider{}
+}
+
+// Name returns ProviderManual
+func (p *ManualProvider) Name() string {
+	return ProviderManual
+}
+
+// CreateApplicant returns no session (reviewed by an admin)
+func (p *ManualProvider) CreateApplicant(ctx context.Context, applicant Applicant) (*Session, error) {
+	return nil, nil
+}
+
+// ParseDecision always fails - manual reviews have no callbacks
+func (p *ManualProvider) ParseDecision(signature string, body []byte) (*Decision, error) {
+	return nil, ErrCallbacksNotSupported
+}
@@ -0,0 +1,29 @@
+package logctx
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// ctxKey is the unexported context key for the request-scoped logger
+type ctxKey struct{}
+
+// With returns a copy of ctx carrying logger.
+// HTTP middleware uses this to attach a logger pre-populated with request_id.
+func With(ctx context.Context, logger *zap.Logger) context.Context {
+	return context.WithValue(ctx, ctxKey{}, logger)
+}
+
+// From returns the logger stored in ctx, or fallback when none is attached
+// (background workers, startup code, tests).
+//
+// Usage example:
+//
+//	logctx.From(ctx, s.logger).Error("failed to get user", zap.Error(err))
+func From(ctx context.Context, fallback *zap.Logger) *zap.Logger {
+	if logger, ok := ctx.Value(ctxKey{}).(*zap.Logger); ok && logger != nil {
+		return logger
+	}
+	return fallback
+}
@@ -0,0 +1,224 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Rounding modes of Rescale
+const (
+	// RoundDown truncates toward zero (e.g. payouts: never pay out more than owed)
+	RoundDown Rounding = iota
+	// RoundUp rounds away from zero (e.g. charges: never undercharge)
+	RoundUp
+	// RoundHalfUp rounds to the nearest value, ties away from zero
+	RoundHalfUp
+)
+
+// Rounding selects how Rescale drops fractional digits
+type Rounding int
+
+// Error definitions
+var (
+	ErrInvalidAmount = errors.New("invalid amount")
+	ErrTooPrecise    = errors.New("amount has more decimals than allowed")
+)
+
+// Money is an exact decimal amount of a currency or token, stored as an integer number of
+// base units (amount × 10^decimals). It never goes through float64 and marshals to JSON as
+// a string (e.g. "1250.00000000").
+//
+// Currency is a fiat code (USD, KRW) or token symbol (USDC); empty means the unit is given by
+// context (e.g. DECIMAL(18,8) order amounts in the order's token). Amounts of different
+// currencies must not be combined: Add, Sub and Cmp panic on a mismatch, which is a programming
+// error like mixing units. Amounts with different decimals are aligned exactly.
+//
+// The zero value is 0 with no currency and 0 decimals. Money values are immutable.
+type Money struct {
+	units    *big.Int
+	currency string
+	decimals int
+}
+
+// New creates an amount of base units (e.g. New(1500000, "USDC", 6) = 1.5 USDC)
+func New(units *big.Int, currency string, decimals int) Money {
+	m := Money{units: new(big.Int), currency: currency, decimals: decimals}
+	if units != nil {
+		m.units.Set(units)
+	}
+	return m
+}
+
+// Zero returns 0 of the currency with the given decimals
+func Zero(currency string, decimals int) Money {
+	return New(nil, currency, decimals)
+}
+
+// Parse parses a decimal string (e.g. "1.5") into an amount with the given decimals.
+// Values with more fractional digits than decimals are rejected (ErrTooPrecise), never rounded.
+func Parse(value, currency string, decimals int) (Money, error) {
+	units, err := ParseUnits(value, decimals)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{units: units, currency: currency, decimals: decimals}, nil
+}
+
+// MustParse is Parse for constant amounts (fixtures, defaults); panics on an invalid value
+func MustParse(value, currency string, decimals int) Money {
+	m, err := Parse(value, currency, decimals)
+	if err != nil {
+		panic(fmt.Sprintf("money: %v", err))
+	}
+	return m
+}
+
+// Units returns a copy of the base-unit amount
+func (m Money) Units() *big.Int {
+	return new(big.Int).Set(m.unitsOrZero())
+}
+
+// Currency returns the currency code or token symbol (empty = given by context)
+func (m Money) Currency() string {
+	return m.currency
+}
+
+// Decimals returns the number of decimals
+func (m Money) Decimals() int {
+	return m.decimals
+}
+
+// Sign returns -1, 0 or +1
+func (m Money) Sign() int {
+	return m.unitsOrZero().Sign()
+}
+
+// IsZero reports whether the amount is 0
+func (m Money) IsZero() bool {
+	return m.Sign() == 0
+}
+
+// Neg returns -m
+func (m Money) Neg() Money {
+	return Money{units: new(big.Int).Neg(m.unitsOrZero()), currency: m.currency, decimals: m.decimals}
+}
+
+// Add returns m + o
+func (m Money) Add(o Money) Money {
+	a, b := align(m, o)
+	return Money{units: new(big.Int).Add(a.units, b.units), currency: a.currency, decimals: a.decimals}
+}
+
+// Sub returns m - o
+func (m Money) Sub(o Money) Money {
+	a, b := align(m, o)
+	return Money{units: new(big.Int).Sub(a.units, b.units), currency: a.currency, decimals: a.decimals}
+}
+
+// Cmp returns -1, 0 or +1 as m is less than, equal to or greater than o
+func (m Money) Cmp(o Money) int {
+	a, b := align(m, o)
+	return a.units.Cmp(b.units)
+}
+
+// MulFrac returns m × numerator / denominator, rounding with mode (e.g. MulFrac(80, 100) = 80%)
+func (m Money) MulFrac(numerator, denominator int64, mode Rounding) Money {
+	units := new(big.Int).Mul(m.unitsOrZero(), big.NewInt(numerator))
+	return Money{units: divRound(units, big.NewInt(denominator), mode), currency: m.currency, decimals: m.decimals}
+}
+
+// NonNegative returns m, or 0 when m is negative (e.g. remaining budget)
+func (m Money) NonNegative() Money {
+	if m.Sign() < 0 {
+		return Zero(m.currency, m.decimals)
+	}
+	return m
+}
+
+// Rescale returns the amount with the given decimals, rounding dropped digits with mode
+func (m Money) Rescale(decimals int, mode Rounding) Money {
+	units := m.unitsOrZero()
+	if decimals >= m.decimals {
+		scaled := new(big.Int).Mul(units, pow10(decimals-m.decimals))
+		return Money{units: scaled, currency: m.currency, decimals: decimals}
+	}
+	return Money{units: divRound(units, pow10(m.decimals-decimals), mode), currency: m.currency, decimals: decimals}
+}
+
+// WithCurrency returns the amount labeled with currency (e.g. a DECIMAL column in the order's token)
+func (m Money) WithCurrency(currency string) Money {
+	return Money{units: m.unitsOrZero(), currency: currency, decimals: m.decimals}
+}
+
+// String formats the amount with all its decimals (e.g. "1.500000")
+func (m Money) String() string {
+	return FormatUnits(m.unitsOrZero(), m.decimals)
+}
+
+// MarshalJSON encodes the amount as a JSON string
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.String())
+}
+
+// UnmarshalJSON decodes a JSON string amount, keeping its fractional digits as decimals.
+// JSON numbers are rejected: they are floats to most clients.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("%w: amount must be a JSON string", ErrInvalidAmount)
+	}
+	_, frac, _ := strings.Cut(strings.TrimSpace(value), ".")
+	parsed, err := Parse(value, m.currency, len(frac))
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// unitsOrZero returns the base units (0 for the zero value)
+func (m Money) unitsOrZero() *big.Int {
+	if m.units == nil {
+		return new(big.Int)
+	}
+	return m.units
+}
+
+// align rescales a and b to the larger decimals; panics when their currencies differ
+func align(a, b Money) (Money, Money) {
+	if a.currency != b.currency {
+		panic(fmt.Sprintf("money: currency mismatch %q and %q", a.currency, b.currency))
+	}
+	decimals := max(a.decimals, b.decimals)
+	return a.Rescale(decimals, RoundDown), b.Rescale(decimals, RoundDown)
+}
+
+// divRound returns n / d rounded with mode
+func divRound(n, d *big.Int, mode Rounding) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(n, d, new(big.Int))
+	if remainder.Sign() == 0 {
+		return quotient
+	}
+
+	away := false
+	switch mode {
+	case RoundUp:
+		away = true
+	case RoundHalfUp:
+		doubled := new(big.Int).Abs(remainder)
+		away = doubled.Lsh(doubled, 1).CmpAbs(d) >= 0
+	}
+	if away {
+		// QuoRem truncates toward zero → step away from zero in the direction of the exact result
+		quotient.Add(quotient, big.NewInt(int64(n.Sign()*d.Sign())))
+	}
+	return quotient
+}
+
+// pow10 returns 10^n
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
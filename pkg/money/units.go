@@ -0,0 +1,53 @@
+package money
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// FormatUnits formats a base-unit amount as a decimal string with the given decimals
+// e.g. FormatUnits(1500000, 6) = "1.500000"
+func FormatUnits(amount *big.Int, decimals int) string {
+	if amount == nil {
+		return "0"
+	}
+
+	negative := amount.Sign() < 0
+	digits := new(big.Int).Abs(amount).String()
+
+	if decimals > 0 {
+		if len(digits) <= decimals {
+			digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-decimals] + "." + digits[len(digits)-decimals:]
+	}
+
+	if negative {
+		return "-" + digits
+	}
+	return digits
+}
+
+// ParseUnits parses a decimal string into a base-unit amount with the given decimals
+// e.g. ParseUnits("1.5", 6) = 1500000
+// Returns ErrTooPrecise if the value has more fractional digits than decimals allows
+func ParseUnits(value string, decimals int) (*big.Int, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, fmt.Errorf("%w: empty amount", ErrInvalidAmount)
+	}
+
+	whole, frac, _ := strings.Cut(value, ".")
+	frac = strings.TrimRight(frac, "0")
+	if len(frac) > decimals {
+		return nil, fmt.Errorf("%w: %q exceeds %d decimals", ErrTooPrecise, value, decimals)
+	}
+	frac += strings.Repeat("0", decimals-len(frac))
+
+	amount, ok := new(big.Int).SetString(whole+frac, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidAmount, value)
+	}
+	return amount, nil
+}
@@ -0,0 +1,122 @@
+package nonce
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"go.uber.org/zap"
+)
+
+// memoryEntry is a nonce state with its expiry
+type memoryEntry struct {
+	state     string
+	expiresAt time.Time
+}
+
+// MemoryStore implements Store interface with a process-local map.
+// Intended for local development and tests - nonces are not shared across
+// instances and are lost on restart, so it must not back multi-instance deployments.
+type MemoryStore struct {
+	mu        sync.Mutex
+	entries   map[string]memoryEntry
+	ttl       time.Duration
+	lastSweep time.Time
+	now       func() time.Time
+	logger    *zap.Logger
+}
+
+// Compile-time interface compliance check
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore creates a new in-memory nonce store with default TTL
+func NewMemoryStore(logger *zap.Logger) *MemoryStore {
+	return NewMemoryStoreWithTTL(DefaultTTL, logger)
+}
+
+// NewMemoryStoreWithTTL creates a new in-memory nonce store with custom TTL
+func NewMemoryStoreWithTTL(ttl time.Duration, logger *zap.Logger) *MemoryStore {
+	return &MemoryStore{
+		entries: make(map[string]memoryEntry),
+		ttl:     ttl,
+		now:     time.Now,
+		logger:  logger,
+	}
+}
+
+// Issue pre-reserves a server-generated nonce if it is absent or expired (SETNX semantics)
+func (s *MemoryStore) Issue(ctx context.Context, nonce, address string) error {
+	key := buildKey(address, nonce)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweepLocked(now)
+
+	if entry, ok := s.entries[key]; ok && now.Before(entry.expiresAt) {
+		return ErrNonceAlreadyUsed
+	}
+
+	s.entries[key] = memoryEntry{state: stateIssued, expiresAt: now.Add(s.ttl)}
+	return nil
+}
+
+// Reserve reserves a nonce if it is absent, expired or issued
+func (s *MemoryStore) Reserve(ctx context.Context, nonce, address string) error {
+	key := buildKey(address, nonce)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweepLocked(now)
+
+	if entry, ok := s.entries[key]; ok && now.Before(entry.expiresAt) && entry.state != stateIssued {
+		logctx.From(ctx, s.logger).Warn("nonce already used or reserved",
+			zap.String("address", address),
+			zap.String("nonce", nonce),
+		)
+		return ErrNonceAlreadyUsed
+	}
+
+	s.entries[key] = memoryEntry{state: stateReserved, expiresAt: now.Add(s.ttl)}
+	return nil
+}
+
+// MarkUsed marks a nonce as used, refreshing its TTL
+func (s *MemoryStore) MarkUsed(ctx context.Context, nonce, address string) error {
+	key := buildKey(address, nonce)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries[key] = memoryEntry{state: stateUsed, expiresAt: s.now().Add(s.ttl)}
+	return nil
+}
+
+// Release removes a nonce, allowing retry
+func (s *MemoryStore) Release(ctx context.Context, nonce, address string) error {
+	key := buildKey(address, nonce)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	return nil
+}
+
+// sweepLocked evicts expired entries at most once per TTL (caller holds mu).
+// Why: 별도 goroutine 없이 Reserve 경로에서 정리 → Close 없는 Store 인터페이스 유지
+func (s *MemoryStore) sweepLocked(now time.Time) {
+	if now.Sub(s.lastSweep) < s.ttl {
+		return
+	}
+	for key, entry := range s.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(s.entries, key)
+		}
+	}
+	s.lastSweep = now
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 )
@@ -13,8 +14,24 @@ import (
 const (
 	// keyPrefix is the Redis key prefix for nonces
 	keyPrefix = "nonce"
+
+	// stateIssued / stateReserved / stateUsed are the stored nonce states
+	stateIssued   = "issued"
+	stateReserved = "reserved"
+	stateUsed     = "used"
 )
 
+// reserveScript reserves a nonce that is absent or issued (atomic compare-and-set)
+// KEYS[1] = nonce key, ARGV[1] = ttl in milliseconds
+var reserveScript = redis.NewScript(`
+local state = redis.call('GET', KEYS[1])
+if state == false or state == 'issued' then
+	redis.call('SET', KEYS[1], 'reserved', 'PX', ARGV[1])
+	return 1
+end
+return 0
+`)
+
 // RedisStore implements Store interface using Redis
 type RedisStore struct {
 	client *redis.Client
@@ -49,14 +66,37 @@ func buildKey(address, nonce string) string {
 	return fmt.Sprintf("%s:%s:%s", keyPrefix, strings.ToLower(address), nonce)
 }
 
-// Reserve attempts to reserve a nonce using SETNX
+// Issue pre-reserves a server-generated nonce using SETNX
+func (s *RedisStore) Issue(ctx context.Context, nonce, address string) error {
+	key := buildKey(address, nonce)
+
+	ok, err := s.client.SetNX(ctx, key, stateIssued, s.ttl).Result()
+	if err != nil {
+		logctx.From(ctx, s.logger).Error("failed to issue nonce",
+			zap.String("address", address),
+			zap.String("nonce", nonce),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to issue nonce: %w", err)
+	}
+	if !ok {
+		return ErrNonceAlreadyUsed
+	}
+
+	logctx.From(ctx, s.logger).Debug("nonce issued",
+		zap.String("address", address),
+		zap.String("nonce", nonce),
+	)
+	return nil
+}
+
+// Reserve attempts to reserve an unknown or issued nonce (Lua compare-and-set)
 func (s *RedisStore) Reserve(ctx context.Context, nonce, address string) error {
 	key := buildKey(address, nonce)
 
-	// SETNX with TTL - only succeeds if key doesn't exist
-	ok, err := s.client.SetNX(ctx, key, "reserved", s.ttl).Result()
+	ok, err := reserveScript.Run(ctx, s.client, []string{key}, s.ttl.Milliseconds()).Bool()
 	if err != nil {
-		s.logger.Error("failed to reserve nonce",
+		logctx.From(ctx, s.logger).Error("failed to reserve nonce",
 			zap.String("address", address),
 			zap.String("nonce", nonce),
 			zap.Error(err),
@@ -65,14 +105,14 @@ func (s *RedisStore) Reserve(ctx context.Context, nonce, address string) error {
 	}
 
 	if !ok {
-		s.logger.Warn("nonce already used or reserved",
+		logctx.From(ctx, s.logger).Warn("nonce already used or reserved",
 			zap.String("address", address),
 			zap.String("nonce", nonce),
 		)
 		return ErrNonceAlreadyUsed
 	}
 
-	s.logger.Debug("nonce reserved",
+	logctx.From(ctx, s.logger).Debug("nonce reserved",
 		zap.String("address", address),
 		zap.String("nonce", nonce),
 	)
@@ -83,9 +123,9 @@ func (s *RedisStore) Reserve(ctx context.Context, nonce, address string) error {
 func (s *RedisStore) MarkUsed(ctx context.Context, nonce, address string) error {
 	key := buildKey(address, nonce)
 
-	err := s.client.Set(ctx, key, "used", s.ttl).Err()
+	err := s.client.Set(ctx, key, stateUsed, s.ttl).Err()
 	if err != nil {
-		s.logger.Error("failed to mark nonce as used",
+		logctx.From(ctx, s.logger).Error("failed to mark nonce as used",
 			zap.String("address", address),
 			zap.String("nonce", nonce),
 			zap.Error(err),
@@ -93,7 +133,7 @@ func (s *RedisStore) MarkUsed(ctx context.Context, nonce, address string) error
 		return fmt.Errorf("failed to mark nonce as used: %w", err)
 	}
 
-	s.logger.Debug("nonce marked as used",
+	logctx.From(ctx, s.logger).Debug("nonce marked as used",
 		zap.String("address", address),
 		zap.String("nonce", nonce),
 	)
@@ -106,7 +146,7 @@ func (s *RedisStore) Release(ctx context.Context, nonce, address string) error {
 
 	err := s.client.Del(ctx, key).Err()
 	if err != nil {
-		s.logger.Error("failed to release nonce",
+		logctx.From(ctx, s.logger).Error("failed to release nonce",
 			zap.String("address", address),
 			zap.String("nonce", nonce),
 			zap.Error(err),
@@ -114,7 +154,7 @@ func (s *RedisStore) Release(ctx context.Context, nonce, address string) error {
 		return fmt.Errorf("failed to release nonce: %w", err)
 	}
 
-	s.logger.Debug("nonce released",
+	logctx.From(ctx, s.logger).Debug("nonce released",
 		zap.String("address", address),
 		zap.String("nonce", nonce),
 	)
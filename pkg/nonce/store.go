@@ -9,12 +9,21 @@ import (
 const (
 	// DefaultTTL is the default nonce validity duration
 	DefaultTTL = 5 * time.Minute
+
+	// BackendRedis / BackendMemory select the Store implementation (NONCE_STORE)
+	BackendRedis  = "redis"
+	BackendMemory = "memory"
 )
 
 // Store defines the interface for nonce storage
 // Implementations can use Redis, in-memory, or other backends
 type Store interface {
+	// Issue pre-reserves a server-generated nonce for the address (verification challenge)
+	// Returns ErrNonceAlreadyUsed if the nonce already exists in any state
+	Issue(ctx context.Context, nonce, address string) error
+
 	// Reserve attempts to reserve a nonce for use
+	// Succeeds for unknown (client-generated) or issued nonces
 	// Returns ErrNonceAlreadyUsed if nonce is already used or reserved
 	Reserve(ctx context.Context, nonce, address string) error
 
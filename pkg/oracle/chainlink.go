@@ -0,0 +1,104 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultMaxAge is the default staleness bound of feed answers.
+// Why: Chainlink 법정화폐 피드(KRW/USD 등)는 heartbeat가 24시간 → 여유 1시간
+const DefaultMaxAge = 25 * time.Hour
+
+// FeedReader reads Chainlink AggregatorV3 feeds (implemented by *chain.EthClient)
+type FeedReader interface {
+	LatestRoundData(ctx context.Context, feed string) (*chain.RoundData, error)
+}
+
+// Chainlink implements Oracle with Chainlink AggregatorV3 price feeds
+type Chainlink struct {
+	reader FeedReader
+	// feeds maps "BASE/QUOTE" → feed contract address
+	feeds  map[string]string
+	maxAge time.Duration
+	now    func() time.Time
+}
+
+// Compile-time interface compliance check
+var _ Oracle = (*Chainlink)(nil)
+
+// NewChainlink parses feed entries of the form "BASE/QUOTE:0xfeed" (e.g. "KRW/USD:0x01435677...")
+func NewChainlink(reader FeedReader, entries []string, maxAge time.Duration) (*Chainlink, error) {
+	if maxAge <= 0 {
+		maxAge = DefaultMaxAge
+	}
+	c := &Chainlink{reader: reader, feeds: make(map[string]string, len(entries)), maxAge: maxAge, now: time.Now}
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		key, address, _ := strings.Cut(entry, ":")
+		pair, err := ParsePair(key)
+		if err != nil {
+			return nil, err
+		}
+		address = strings.TrimSpace(address)
+		if !common.IsHexAddress(address) {
+			return nil, fmt.Errorf("feed %s: %w", pair, chain.ErrInvalidAddress)
+		}
+		if _, dup := c.feeds[pair.String()]; dup {
+			return nil, fmt.Errorf("feed %s: duplicate pair", pair)
+		}
+		c.feeds[pair.String()] = address
+	}
+	return c, nil
+}
+
+// Len returns the number of configured feeds
+func (c *Chainlink) Len() int {
+	return len(c.feeds)
+}
+
+// Has reports whether a feed is configured for the pair
+func (c *Chainlink) Has(pair Pair) bool {
+	_, ok := c.feeds[pair.String()]
+	return ok
+}
+
+// Rate reads the latest answer of the pair's feed
+func (c *Chainlink) Rate(ctx context.Context, pair Pair) (*Rate, error) {
+	now := c.now().UTC()
+	if pair.Base == pair.Quote {
+		return identityRate(pair, now), nil
+	}
+	feed, ok := c.feeds[pair.String()]
+	if !ok {
+		return nil, fmt.Errorf("%w: no chainlink feed for %s", ErrUnsupportedPair, pair)
+	}
+
+	round, err := c.reader.LatestRoundData(ctx, feed)
+	if err != nil {
+		return nil, fmt.Errorf("read %s feed: %w", pair, err)
+	}
+	if round.Answer.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: %s feed answered %s", ErrInvalidRate, pair, round.Answer)
+	}
+	// NOTE: 라운드가 멈춘 피드(노드 장애, 피드 폐기)는 마지막 답을 계속 반환 → updatedAt으로 판정
+	if now.Sub(round.UpdatedAt) > c.maxAge {
+		return nil, fmt.Errorf("%w: %s feed updated at %s", ErrStaleRate, pair, round.UpdatedAt.Format(time.RFC3339))
+	}
+
+	return &Rate{
+		Pair:      pair,
+		Price:     round.Answer,
+		Decimals:  round.Decimals,
+		UpdatedAt: round.UpdatedAt,
+		Source:    SourceChainlink,
+	}, nil
+}
@@ -0,0 +1,62 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// Fallback implements Oracle by trying oracles in order until one returns a rate
+// (e.g. Chainlink feed first, then static rates while the feed is stale or the RPC is down)
+type Fallback struct {
+	oracles []Oracle
+	logger  *zap.Logger
+}
+
+// Compile-time interface compliance check
+var _ Oracle = (*Fallback)(nil)
+
+// NewFallback creates a fallback oracle; nil oracles are skipped
+func NewFallback(logger *zap.Logger, oracles ...Oracle) *Fallback {
+	f := &Fallback{logger: logger}
+	for _, o := range oracles {
+		if o != nil {
+			f.oracles = append(f.oracles, o)
+		}
+	}
+	return f
+}
+
+// Rate returns the first rate available. When every oracle fails, the error of the first
+// oracle that supports the pair is returned (so a stale feed surfaces as ErrStaleRate).
+func (f *Fallback) Rate(ctx context.Context, pair Pair) (*Rate, error) {
+	var firstErr error
+	for _, o := range f.oracles {
+		rate, err := o.Rate(ctx, pair)
+		if err == nil {
+			return rate, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if !errors.Is(err, ErrUnsupportedPair) {
+			f.logger.Warn("exchange rate source failed, trying fallback",
+				zap.String("pair", pair.String()),
+				zap.Error(err),
+			)
+			if firstErr == nil || errors.Is(firstErr, ErrUnsupportedPair) {
+				firstErr = err
+			}
+			continue
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	if firstErr == nil {
+		firstErr = fmt.Errorf("%w: %s", ErrUnsupportedPair, pair)
+	}
+	return nil, firstErr
+}
@@ -0,0 +1,106 @@
+package oracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+)
+
+// Supported sources
+const (
+	SourceChainlink = "chainlink"
+	SourceStatic    = "static"
+	// SourceIdentity marks a pair of the same currency (rate 1)
+	SourceIdentity = "identity"
+)
+
+// symbolPattern matches ISO 4217 currency codes (KRW, USD) and token symbols (USDC)
+var symbolPattern = regexp.MustCompile(`^[A-Z0-9]{2,10}$`)
+
+// Error definitions
+var (
+	ErrUnsupportedPair = errors.New("unsupported currency pair")
+	ErrStaleRate       = errors.New("stale exchange rate")
+	ErrInvalidRate     = errors.New("invalid exchange rate")
+)
+
+// Pair is a currency pair; a rate of the pair is the price of one Base in Quote
+type Pair struct {
+	Base  string
+	Quote string
+}
+
+// ParsePair parses "BASE/QUOTE" (e.g. "KRW/USD", "USDC/USD")
+func ParsePair(s string) (Pair, error) {
+	base, quote, ok := strings.Cut(strings.ToUpper(strings.TrimSpace(s)), "/")
+	pair := Pair{Base: strings.TrimSpace(base), Quote: strings.TrimSpace(quote)}
+	if !ok || !symbolPattern.MatchString(pair.Base) || !symbolPattern.MatchString(pair.Quote) {
+		return Pair{}, fmt.Errorf("%w: %q (expected BASE/QUOTE)", ErrUnsupportedPair, s)
+	}
+	return pair, nil
+}
+
+// String returns "BASE/QUOTE"
+func (p Pair) String() string {
+	return p.Base + "/" + p.Quote
+}
+
+// Rate is an exchange rate observation
+type Rate struct {
+	Pair Pair
+	// Price is the price of one Base in Quote, scaled by Decimals
+	Price    *big.Int
+	Decimals int
+	// UpdatedAt is when the source last updated the rate
+	UpdatedAt time.Time
+	// Source identifies the oracle that produced the rate
+	Source string
+}
+
+// String formats the price as a decimal (e.g. "0.00072")
+func (r Rate) String() string {
+	value := chain.FormatUnits(r.Price, r.Decimals)
+	if strings.Contains(value, ".") {
+		value = strings.TrimRight(strings.TrimRight(value, "0"), ".")
+	}
+	return value
+}
+
+// Convert converts a base-unit amount with fromDecimals into Quote base units with toDecimals,
+// rounding up so a quote never undercharges (e.g. 10000 KRW → 7.2 USDC).
+func (r Rate) Convert(amount *big.Int, fromDecimals, toDecimals int) *big.Int {
+	numerator := new(big.Int).Mul(amount, r.Price)
+	numerator.Mul(numerator, pow10(toDecimals))
+	denominator := new(big.Int).Mul(pow10(fromDecimals), pow10(r.Decimals))
+
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	if remainder.Sign() > 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+	return quotient
+}
+
+// Oracle defines the interface for exchange rate lookups
+// Implementations can read on-chain price feeds (Chainlink) or use configured rates
+type Oracle interface {
+	// Rate returns the current rate of the pair.
+	// Returns ErrUnsupportedPair when the oracle has no source for the pair
+	// and ErrStaleRate when its latest rate is older than the allowed age.
+	Rate(ctx context.Context, pair Pair) (*Rate, error)
+}
+
+// identityRate returns the rate of a same-currency pair
+func identityRate(pair Pair, now time.Time) *Rate {
+	return &Rate{Pair: pair, Price: big.NewInt(1), Decimals: 0, UpdatedAt: now, Source: SourceIdentity}
+}
+
+// pow10 returns 10^n
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
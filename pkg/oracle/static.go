@@ -0,0 +1,69 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/chain"
+)
+
+// staticRateDecimals is the scale of configured static rates
+const staticRateDecimals = 18
+
+// Static implements Oracle with fixed, operator-configured rates
+type Static struct {
+	// rates maps "BASE/QUOTE" → rate
+	rates map[string]Rate
+	now   func() time.Time
+}
+
+// Compile-time interface compliance check
+var _ Oracle = (*Static)(nil)
+
+// NewStatic parses entries of the form "BASE/QUOTE:rate" (e.g. "KRW/USD:0.00072").
+// Rates are reported as updated at the time of the lookup (운영자가 직접 관리하는 값이므로 만료 없음).
+func NewStatic(entries []string) (*Static, error) {
+	s := &Static{rates: make(map[string]Rate, len(entries)), now: time.Now}
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, fmt.Errorf("%w: %q (expected BASE/QUOTE:rate)", ErrInvalidRate, entry)
+		}
+		pair, err := ParsePair(key)
+		if err != nil {
+			return nil, err
+		}
+		price, err := chain.ParseUnits(value, staticRateDecimals)
+		if err != nil || price.Sign() <= 0 {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidRate, entry)
+		}
+		s.rates[pair.String()] = Rate{Pair: pair, Price: price, Decimals: staticRateDecimals, Source: SourceStatic}
+	}
+	return s, nil
+}
+
+// Len returns the number of configured rates
+func (s *Static) Len() int {
+	return len(s.rates)
+}
+
+// Rate returns the configured rate of the pair
+func (s *Static) Rate(ctx context.Context, pair Pair) (*Rate, error) {
+	now := s.now().UTC()
+	if pair.Base == pair.Quote {
+		return identityRate(pair, now), nil
+	}
+	rate, ok := s.rates[pair.String()]
+	if !ok {
+		return nil, fmt.Errorf("%w: no static rate for %s", ErrUnsupportedPair, pair)
+	}
+	rate.UpdatedAt = now
+	return &rate, nil
+}
@@ -0,0 +1,32 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Limit describes a token bucket: PerMinute tokens are refilled per minute,
+// up to Burst tokens can be spent at once
+type Limit struct {
+	PerMinute int
+	Burst     int
+}
+
+// Enabled reports whether the limit is active (zero value = unlimited)
+func (l Limit) Enabled() bool {
+	return l.PerMinute > 0 && l.Burst > 0
+}
+
+// Result is the outcome of a single Allow call
+type Result struct {
+	Allowed    bool
+	Remaining  int
+	RetryAfter time.Duration
+}
+
+// Limiter defines the interface for rate limiting
+// Implementations can use Redis, in-memory, or other backends
+type Limiter interface {
+	// Allow consumes one token from the bucket identified by key
+	Allow(ctx context.Context, key string, limit Limit) (*Result, error)
+}
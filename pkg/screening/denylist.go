@@ -0,0 +1,77 @@
+package screening
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DenyList implements Screener with a static list of flagged addresses.
+// EVM addresses are matched on every chain (같은 키로 모든 EVM 체인에서 동일 주소).
+type DenyList struct {
+	// entries maps lowercase address → category
+	entries map[string]string
+}
+
+// Compile-time interface compliance check
+var _ Screener = (*DenyList)(nil)
+
+// NewDenyList parses entries of the form "0xaddress" or "0xaddress:CATEGORY"
+func NewDenyList(entries []string) (*DenyList, error) {
+	list := &DenyList{entries: make(map[string]string, len(entries))}
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		address, category, _ := strings.Cut(entry, ":")
+		address = strings.TrimSpace(address)
+		if !common.IsHexAddress(address) {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidEntry, entry)
+		}
+		category = strings.ToUpper(strings.TrimSpace(category))
+		if category == "" {
+			category = DefaultCategory
+		}
+		list.entries[strings.ToLower(address)] = category
+	}
+	return list, nil
+}
+
+// ReadDenyListFile reads deny-list entries, one per line (blank lines and # comments are skipped)
+func ReadDenyListFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open deny-list: %w", err)
+	}
+	defer f.Close()
+
+	var entries []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if line = strings.TrimSpace(line); line != "" {
+			entries = append(entries, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read deny-list: %w", err)
+	}
+	return entries, nil
+}
+
+// Len returns the number of listed addresses
+func (l *DenyList) Len() int {
+	return len(l.entries)
+}
+
+// Screen flags listed addresses
+func (l *DenyList) Screen(ctx context.Context, chainID int64, address string) (*Result, error) {
+	category, flagged := l.entries[strings.ToLower(address)]
+	return &Result{Flagged: flagged, Category: category, Provider: ProviderDenyList}, nil
+}
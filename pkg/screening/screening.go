@@ -0,0 +1,69 @@
+package screening
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Supported providers
+const (
+	ProviderNoop     = "noop"
+	ProviderDenyList = "denylist"
+)
+
+// DefaultCategory is the category of deny-list entries without an explicit one
+const DefaultCategory = "SANCTIONS"
+
+// Result is the screening outcome of a single address
+type Result struct {
+	// Flagged is set when the address must not be registered or paid
+	Flagged bool
+	// Category is the provider's risk category of a flagged address (e.g. SANCTIONS, MIXER)
+	Category string
+	// Provider identifies the screener that produced the result
+	Provider string
+}
+
+// Screener defines the interface for sanctions / risk screening of blockchain addresses
+// Implementations can call a screening API (Chainalysis, TRM, ...) or use a local deny-list
+type Screener interface {
+	// Screen screens the address on the given chain.
+	// An error means the address could not be screened - callers must fail closed.
+	Screen(ctx context.Context, chainID int64, address string) (*Result, error)
+}
+
+// Config holds screening provider settings
+type Config struct {
+	Provider string
+	// DenyList entries: "0xaddress" or "0xaddress:CATEGORY"
+	DenyList []string
+	// DenyListFile is an optional file with one entry per line (# comments allowed)
+	DenyListFile string
+}
+
+// Error definitions
+var (
+	ErrUnknownProvider = errors.New("unknown screening provider")
+	ErrInvalidEntry    = errors.New("invalid deny-list entry")
+)
+
+// New creates the screener selected by config.Provider (empty = noop)
+func New(config Config) (Screener, error) {
+	switch config.Provider {
+	case "", ProviderNoop:
+		return NewNoopScreener(), nil
+	case ProviderDenyList:
+		entries := append([]string(nil), config.DenyList...)
+		if config.DenyListFile != "" {
+			fileEntries, err := ReadDenyListFile(config.DenyListFile)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, fileEntries...)
+		}
+		return NewDenyList(entries)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownProvider, config.Provider)
+	}
+}
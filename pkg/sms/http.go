@@ -0,0 +1,90 @@
+package sms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/httpclient"
+	"go.uber.org/zap"
+)
+
+// maxResponseBodyRead bounds how much of the gateway response is read
+const maxResponseBodyRead = 16 << 10
+
+// HTTPSender implements Sender by posting messages to an SMS gateway.
+// Adapters for a specific vendor can be deployed behind the endpoint.
+//
+// Request: {"to":"+821012345678","message":"..."} (2xx = accepted)
+//
+// Why:
+//   - 재시도 없음 → 게이트웨이가 수락했지만 응답이 유실된 경우 같은 코드가 두 번 발송되는 것 방지 (사용자가 재요청)
+type HTTPSender struct {
+	endpoint string
+	apiKey   string
+	client   *httpclient.Client
+}
+
+// Compile-time interface compliance check
+var _ Sender = (*HTTPSender)(nil)
+
+type httpRequest struct {
+	To      string `json:"to"`
+	Message string `json:"message"`
+}
+
+// NewHTTPSender creates a sender for the given SMS gateway endpoint
+func NewHTTPSender(endpoint, apiKey string, timeout time.Duration, logger *zap.Logger) (*HTTPSender, error) {
+	parsed, err := url.Parse(endpoint)
+	if err != nil || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid sms api url %q", endpoint)
+	}
+
+	return &HTTPSender{
+		endpoint: endpoint,
+		apiKey:   apiKey,
+		client: httpclient.New(httpclient.Config{
+			Name:    "sms",
+			Timeout: timeout,
+		}, logger),
+	}, nil
+}
+
+// Provider returns ProviderHTTP
+func (s *HTTPSender) Provider() string {
+	return ProviderHTTP
+}
+
+// Send posts the message to the gateway
+func (s *HTTPSender) Send(ctx context.Context, to, message string) error {
+	body, err := json.Marshal(httpRequest{To: to, Message: message})
+	if err != nil {
+		return fmt.Errorf("marshal sms request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build sms request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrSendFailed, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodyRead))
+		return fmt.Errorf("%w: status %d: %s", ErrSendFailed, resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package sms
+
+import (
+	"context"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/logctx"
+	"go.uber.org/zap"
+)
+
+// LogSender implements Sender by writing messages to the log instead of sending them (development)
+type LogSender struct {
+	logger *zap.Logger
+}
+
+// Compile-time interface compliance check
+var _ Sender = (*LogSender)(nil)
+
+// NewLogSender creates a sender that only logs messages
+func NewLogSender(logger *zap.Logger) *LogSender {
+	return &LogSender{logger: logger}
+}
+
+// Provider returns ProviderLog
+func (s *LogSender) Provider() string {
+	return ProviderLog
+}
+
+// Send logs the message
+// NOTE: 메시지(인증 코드 포함)가 로그에 남음 → 운영 환경에서는 http provider 사용
+func (s *LogSender) Send(ctx context.Context, to, message string) error {
+	logctx.From(ctx, s.logger).Info("sms message (not sent, log provider)",
+		zap.String("to", MaskPhone(to)),
+		zap.String("message", message),
+	)
+	return nil
+}
@@ -0,0 +1,72 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Supported providers
+const (
+	ProviderLog  = "log"
+	ProviderHTTP = "http"
+)
+
+// Error definitions
+var (
+	ErrUnknownProvider = errors.New("unknown sms provider")
+	// ErrSendFailed is returned when the provider did not accept the message
+	ErrSendFailed = errors.New("sms send failed")
+)
+
+// Sender defines the interface for sending text messages to phone numbers
+// Implementations can call an SMS gateway (Twilio, Vonage, ...) or log messages locally
+type Sender interface {
+	// Send delivers the message to the phone number.
+	// An error means the message was not accepted - callers must not assume delivery.
+	Send(ctx context.Context, to, message string) error
+
+	// Provider identifies the sender in logs
+	Provider() string
+}
+
+// Config holds SMS provider settings
+type Config struct {
+	Provider string
+	// URL / APIKey / Timeout configure ProviderHTTP
+	URL     string
+	APIKey  string
+	Timeout time.Duration
+}
+
+// New creates the sender selected by config.Provider (empty = log)
+func New(config Config, logger *zap.Logger) (Sender, error) {
+	switch config.Provider {
+	case "", ProviderLog:
+		return NewLogSender(logger), nil
+	case ProviderHTTP:
+		return NewHTTPSender(config.URL, config.APIKey, config.Timeout, logger)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownProvider, config.Provider)
+	}
+}
+
+// MaskPhone hides all but the last 4 digits of a phone number (logs, API responses)
+func MaskPhone(phone string) string {
+	runes := []rune(phone)
+	if len(runes) <= 4 {
+		return "****"
+	}
+	masked := make([]rune, len(runes))
+	for i, r := range runes {
+		if i < len(runes)-4 && r >= '0' && r <= '9' {
+			masked[i] = '*'
+			continue
+		}
+		masked[i] = r
+	}
+	return string(masked)
+}
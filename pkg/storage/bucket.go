@@ -0,0 +1,295 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ahwlsqja/StableCoin-B2B-Commerce-Settlement-Engine/pkg/httpclient"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultTimeout bounds a single storage request
+	DefaultTimeout = 60 * time.Second
+	// gcsEndpoint is the GCS XML API endpoint (path-style bucket URLs)
+	gcsEndpoint = "https://storage.googleapis.com"
+	// maxErrorBodyRead bounds how much of an error response is read
+	maxErrorBodyRead = 4 << 10
+)
+
+// Bucket implements Store on an S3 or GCS bucket through the S3-compatible XML API.
+// Requests are signed with SigV4 (S3) or GOOG4 with HMAC keys (GCS) → provider SDK 의존성 없음.
+type Bucket struct {
+	// bucketURL is the bucket base URL without trailing slash
+	bucketURL string
+	signer    *signer
+	rules     []Rule
+	// lifecycleXML encodes the rules in the provider's lifecycle configuration format
+	lifecycleXML func(rules []Rule) ([]byte, error)
+	client       *httpclient.Client
+	now          func() time.Time
+	logger       *zap.Logger
+}
+
+// Compile-time interface compliance check
+var _ Store = (*Bucket)(nil)
+
+// NewS3 creates an S3 bucket store. Without an endpoint the AWS virtual-hosted URL is used,
+// with one (MinIO, R2, ...) a path-style URL.
+func NewS3(config Config, logger *zap.Logger) (*Bucket, error) {
+	if config.Bucket == "" || config.Region == "" {
+		return nil, errors.New("s3 bucket and region are required")
+	}
+	bucketURL := fmt.Sprintf("https://%s.s3.%s.amazonaws.com", config.Bucket, config.Region)
+	if config.Endpoint != "" {
+		parsed, err := url.Parse(config.Endpoint)
+		if err != nil || parsed.Host == "" {
+			return nil, fmt.Errorf("invalid s3 endpoint %q", config.Endpoint)
+		}
+		bucketURL = strings.TrimRight(config.Endpoint, "/") + "/" + url.PathEscape(config.Bucket)
+	}
+	return newBucket(config, "s3", bucketURL, newAWSSigner(config.AccessKeyID, config.SecretAccessKey, config.Region), s3LifecycleXML, logger)
+}
+
+// NewGCS creates a GCS bucket store (XML API, HMAC keys of a service account)
+func NewGCS(config Config, logger *zap.Logger) (*Bucket, error) {
+	if config.Bucket == "" {
+		return nil, errors.New("gcs bucket is required")
+	}
+	bucketURL := gcsEndpoint + "/" + url.PathEscape(config.Bucket)
+	return newBucket(config, "gcs", bucketURL, newGoogleSigner(config.AccessKeyID, config.SecretAccessKey), gcsLifecycleXML, logger)
+}
+
+func newBucket(config Config, name, bucketURL string, s *signer, lifecycleXML func([]Rule) ([]byte, error), logger *zap.Logger) (*Bucket, error) {
+	if config.AccessKeyID == "" || config.SecretAccessKey == "" {
+		return nil, fmt.Errorf("%s access key id and secret are required", name)
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultTimeout
+	}
+
+	client := httpclient.New(httpclient.Config{
+		Name:              "storage-" + name,
+		Timeout:           config.Timeout,
+		MaxRetries:        2,
+		RedactQueryParams: []string{"X-Amz-Signature", "X-Amz-Credential", "X-Goog-Signature", "X-Goog-Credential"},
+	}, logger)
+
+	return &Bucket{
+		bucketURL:    bucketURL,
+		signer:       s,
+		rules:        config.Lifecycle,
+		lifecycleXML: lifecycleXML,
+		client:       client,
+		now:          time.Now,
+		logger:       logger,
+	}, nil
+}
+
+// Put uploads the object; bodies of unknown size are buffered so the request can be retried
+func (b *Bucket) Put(ctx context.Context, key string, body io.Reader, opts PutOptions) (*Object, error) {
+	if err := ValidateKey(key); err != nil {
+		return nil, err
+	}
+	size := opts.Size
+	if size <= 0 {
+		data, err := io.ReadAll(body)
+		if err != nil {
+			return nil, fmt.Errorf("read object body: %w", err)
+		}
+		body, size = bytes.NewReader(data), int64(len(data))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, b.objectURL(key), body)
+	if err != nil {
+		return nil, fmt.Errorf("build put request: %w", err)
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType(opts))
+
+	resp, err := b.do(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	return &Object{Key: key, Size: size, ContentType: contentType(opts), LastModified: b.now().UTC()}, nil
+}
+
+// Get streams the object
+func (b *Bucket) Get(ctx context.Context, key string) (io.ReadCloser, *Object, error) {
+	if err := ValidateKey(key); err != nil {
+		return nil, nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, b.objectURL(key), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("build get request: %w", err)
+	}
+
+	resp, err := b.do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	object := &Object{Key: key, Size: resp.ContentLength, ContentType: resp.Header.Get("Content-Type")}
+	if modified, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		object.LastModified = modified.UTC()
+	}
+	return resp.Body, object, nil
+}
+
+// Delete removes the object (S3 and GCS answer 204 for missing objects too)
+func (b *Bucket) Delete(ctx context.Context, key string) error {
+	if err := ValidateKey(key); err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, b.objectURL(key), nil)
+	if err != nil {
+		return fmt.Errorf("build delete request: %w", err)
+	}
+
+	resp, err := b.do(req)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// SignedURL returns a presigned GET URL
+func (b *Bucket) SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	if err := ValidateKey(key); err != nil {
+		return "", err
+	}
+	u, err := url.Parse(b.objectURL(key))
+	if err != nil {
+		return "", fmt.Errorf("parse object url: %w", err)
+	}
+	return b.signer.presign(http.MethodGet, u, clampTTL(ttl), b.now()), nil
+}
+
+// ApplyLifecycle replaces the bucket lifecycle configuration with the configured rules.
+// NOTE: 버킷 lifecycle 설정 전체를 교체 → 버킷은 이 서비스 전용으로 사용. 규칙이 없으면 변경하지 않음
+func (b *Bucket) ApplyLifecycle(ctx context.Context) error {
+	if len(b.rules) == 0 {
+		return nil
+	}
+	body, err := b.lifecycleXML(b.rules)
+	if err != nil {
+		return fmt.Errorf("encode lifecycle configuration: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, b.bucketURL+"/?lifecycle", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build lifecycle request: %w", err)
+	}
+	sum := md5.Sum(body)
+	req.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(sum[:]))
+	req.Header.Set("Content-Type", "application/xml")
+
+	resp, err := b.do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+
+	b.logger.Info("storage lifecycle configuration applied", zap.Int("rules", len(b.rules)))
+	return nil
+}
+
+// objectURL returns the URL of key
+func (b *Bucket) objectURL(key string) string {
+	return b.bucketURL + "/" + escapeKey(key)
+}
+
+// do signs and sends the request; non-2xx responses become errors (404 → ErrNotFound)
+func (b *Bucket) do(req *http.Request) (*http.Response, error) {
+	b.signer.sign(req, unsignedPayload, b.now())
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL.Path, err)
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, req.URL.Path)
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyRead))
+	return nil, fmt.Errorf("%w: %s %s: status %d: %s", ErrRequestFailed, req.Method, req.URL.Path,
+		resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+// s3LifecycleXML encodes rules as an S3 LifecycleConfiguration
+func s3LifecycleXML(rules []Rule) ([]byte, error) {
+	type expiration struct {
+		Days int `xml:"Days"`
+	}
+	type filter struct {
+		Prefix string `xml:"Prefix"`
+	}
+	type rule struct {
+		ID         string     `xml:"ID"`
+		Filter     filter     `xml:"Filter"`
+		Status     string     `xml:"Status"`
+		Expiration expiration `xml:"Expiration"`
+	}
+	type configuration struct {
+		XMLName xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ LifecycleConfiguration"`
+		Rules   []rule   `xml:"Rule"`
+	}
+
+	config := configuration{}
+	for _, r := range rules {
+		config.Rules = append(config.Rules, rule{
+			ID:         "expire-" + strings.TrimSuffix(r.Prefix, "/"),
+			Filter:     filter{Prefix: r.Prefix},
+			Status:     "Enabled",
+			Expiration: expiration{Days: r.ExpireAfterDays},
+		})
+	}
+	return xml.Marshal(config)
+}
+
+// gcsLifecycleXML encodes rules as a GCS XML API LifecycleConfiguration
+func gcsLifecycleXML(rules []Rule) ([]byte, error) {
+	type condition struct {
+		Age           int    `xml:"Age"`
+		MatchesPrefix string `xml:"MatchesPrefix"`
+	}
+	type action struct {
+		Delete struct{} `xml:"Delete"`
+	}
+	type rule struct {
+		Action    action    `xml:"Action"`
+		Condition condition `xml:"Condition"`
+	}
+	type configuration struct {
+		XMLName xml.Name `xml:"LifecycleConfiguration"`
+		Rules   []rule   `xml:"Rule"`
+	}
+
+	config := configuration{}
+	for _, r := range rules {
+		config.Rules = append(config.Rules, rule{
+			Condition: condition{Age: r.ExpireAfterDays, MatchesPrefix: r.Prefix},
+		})
+	}
+	return xml.Marshal(config)
+}
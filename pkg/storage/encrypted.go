@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// encryptedMagic prefixes objects written by Encrypted (format version 1)
+var encryptedMagic = []byte("SEV1")
+
+// ErrSignedURLUnsupported is returned by stores whose objects cannot be downloaded directly
+var ErrSignedURLUnsupported = errors.New("signed urls are not supported for encrypted objects")
+
+// Encrypted implements Store by encrypting objects with AES-256-GCM before they reach the inner store.
+// Objects are read back through the API (decrypted in memory), so signed URLs are not available.
+//
+// Why:
+//   - 버킷/디스크 유출 시에도 신분증 등 민감 문서는 키 없이 복호화 불가 (저장소 서버측 암호화와 별개)
+//   - 객체 키를 AAD로 사용 → 암호문을 다른 키로 옮겨 바꿔치기 불가
+//   - 전체를 메모리에서 암복호화 → 호출자가 객체 크기를 제한해야 함 (KYC 문서 수 MB)
+type Encrypted struct {
+	inner Store
+	aead  cipher.AEAD
+}
+
+// Compile-time interface compliance check
+var _ Store = (*Encrypted)(nil)
+
+// NewEncrypted wraps inner with AES-256-GCM encryption under a 32-byte key
+func NewEncrypted(inner Store, key []byte) (*Encrypted, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("storage encryption key must be 32 bytes, got %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("create gcm: %w", err)
+	}
+	return &Encrypted{inner: inner, aead: aead}, nil
+}
+
+// Put encrypts the body and stores the ciphertext; the returned size is the plaintext size
+func (e *Encrypted) Put(ctx context.Context, key string, body io.Reader, opts PutOptions) (*Object, error) {
+	if err := ValidateKey(key); err != nil {
+		return nil, err
+	}
+	plaintext, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("read object body: %w", err)
+	}
+
+	nonce := make([]byte, e.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+	sealed := make([]byte, 0, len(encryptedMagic)+len(nonce)+len(plaintext)+e.aead.Overhead())
+	sealed = append(sealed, encryptedMagic...)
+	sealed = append(sealed, nonce...)
+	sealed = e.aead.Seal(sealed, nonce, plaintext, []byte(key))
+
+	object, err := e.inner.Put(ctx, key, bytes.NewReader(sealed), PutOptions{
+		ContentType: opts.ContentType,
+		Size:        int64(len(sealed)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	object.Size = int64(len(plaintext))
+	return object, nil
+}
+
+// Get reads and decrypts the object
+func (e *Encrypted) Get(ctx context.Context, key string) (io.ReadCloser, *Object, error) {
+	body, object, err := e.inner.Get(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer body.Close()
+
+	sealed, err := io.ReadAll(body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("read object: %w", err)
+	}
+	headerSize := len(encryptedMagic) + e.aead.NonceSize()
+	if len(sealed) < headerSize || !bytes.Equal(sealed[:len(encryptedMagic)], encryptedMagic) {
+		return nil, nil, fmt.Errorf("object %s is not encrypted", key)
+	}
+	plaintext, err := e.aead.Open(nil, sealed[len(encryptedMagic):headerSize], sealed[headerSize:], []byte(key))
+	if err != nil {
+		return nil, nil, fmt.Errorf("decrypt object %s: %w", key, err)
+	}
+
+	object.Size = int64(len(plaintext))
+	return io.NopCloser(bytes.NewReader(plaintext)), object, nil
+}
+
+// Delete removes the object from the inner store
+func (e *Encrypted) Delete(ctx context.Context, key string) error {
+	return e.inner.Delete(ctx, key)
+}
+
+// SignedURL is not supported - a direct download would return ciphertext
+func (e *Encrypted) SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	return "", ErrSignedURLUnsupported
+}
+
+// ApplyLifecycle applies the inner store's expiration rules
+func (e *Encrypted) ApplyLifecycle(ctx context.Context) error {
+	return e.inner.ApplyLifecycle(ctx)
+}
@@ -0,0 +1,234 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Signed URL query parameters of the local store
+const (
+	ParamExpires   = "expires"
+	ParamSignature = "signature"
+)
+
+// Local implements Store on the local file system. Signed URLs point at the API's
+// download route (PublicURL), which checks them with Verify before serving the file.
+//
+// Why:
+// - 개발/단일 인스턴스 배포용 → 다중 인스턴스는 공유 볼륨 또는 S3/GCS 사용
+// - 임시 파일에 쓴 뒤 rename → 업로드 중인 파일이 다운로드되지 않음
+type Local struct {
+	root       string
+	publicURL  string
+	signingKey []byte
+	rules      []Rule
+	now        func() time.Time
+	logger     *zap.Logger
+}
+
+// Compile-time interface compliance check
+var _ Store = (*Local)(nil)
+
+// NewLocal creates a local store rooted at dir (created if missing)
+func NewLocal(dir, publicURL, signingKey string, rules []Rule, logger *zap.Logger) (*Local, error) {
+	if dir == "" {
+		return nil, errors.New("local storage directory is required")
+	}
+	if signingKey == "" {
+		return nil, errors.New("local storage signing key is required")
+	}
+	parsed, err := url.Parse(publicURL)
+	if err != nil || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid local storage public url %q", publicURL)
+	}
+	root, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("resolve storage directory: %w", err)
+	}
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("create storage directory: %w", err)
+	}
+
+	return &Local{
+		root:       root,
+		publicURL:  strings.TrimRight(publicURL, "/"),
+		signingKey: []byte(signingKey),
+		rules:      rules,
+		now:        time.Now,
+		logger:     logger,
+	}, nil
+}
+
+// Put writes the body to a temporary file and renames it into place
+func (l *Local) Put(ctx context.Context, key string, body io.Reader, opts PutOptions) (*Object, error) {
+	filePath, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o750); err != nil {
+		return nil, fmt.Errorf("create object directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), ".upload-*")
+	if err != nil {
+		return nil, fmt.Errorf("create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // rename 후에는 no-op
+
+	size, err := io.Copy(tmp, body)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("write object: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), filePath); err != nil {
+		return nil, fmt.Errorf("store object: %w", err)
+	}
+
+	return &Object{Key: key, Size: size, ContentType: contentType(opts), LastModified: l.now().UTC()}, nil
+}
+
+// Get opens the object file
+func (l *Local) Get(ctx context.Context, key string) (io.ReadCloser, *Object, error) {
+	filePath, err := l.path(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	file, err := os.Open(filePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil, fmt.Errorf("%w: %s", ErrNotFound, key)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("open object: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, nil, fmt.Errorf("stat object: %w", err)
+	}
+
+	return file, &Object{Key: key, Size: info.Size(), ContentType: localContentType(key), LastModified: info.ModTime().UTC()}, nil
+}
+
+// Delete removes the object file
+func (l *Local) Delete(ctx context.Context, key string) error {
+	filePath, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(filePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("delete object: %w", err)
+	}
+	return nil
+}
+
+// SignedURL returns PublicURL/key?expires=unix&signature=hmac
+func (l *Local) SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error) {
+	if err := ValidateKey(key); err != nil {
+		return "", err
+	}
+	expires := l.now().Add(clampTTL(ttl)).Unix()
+
+	query := url.Values{}
+	query.Set(ParamExpires, strconv.FormatInt(expires, 10))
+	query.Set(ParamSignature, l.sign(key, expires))
+	return l.publicURL + "/" + escapeKey(key) + "?" + query.Encode(), nil
+}
+
+// Verify checks the expires and signature parameters of a signed URL of key
+func (l *Local) Verify(key, expires, signature string) error {
+	if ValidateKey(key) != nil {
+		return ErrInvalidURL
+	}
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || l.now().Unix() > expiresAt {
+		return ErrInvalidURL
+	}
+	if !hmac.Equal([]byte(signature), []byte(l.sign(key, expiresAt))) {
+		return ErrInvalidURL
+	}
+	return nil
+}
+
+// ApplyLifecycle deletes files older than the expiration of their prefix
+func (l *Local) ApplyLifecycle(ctx context.Context) error {
+	now := l.now()
+	for _, rule := range l.rules {
+		dir := filepath.Join(l.root, filepath.FromSlash(strings.TrimSuffix(rule.Prefix, "/")))
+		cutoff := now.AddDate(0, 0, -rule.ExpireAfterDays)
+
+		deleted := 0
+		err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if d.IsDir() {
+				return nil
+			}
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			if info.ModTime().Before(cutoff) {
+				if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+					return err
+				}
+				deleted++
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("expire %s: %w", rule.Prefix, err)
+		}
+		if deleted > 0 {
+			l.logger.Info("expired stored objects", zap.String("prefix", rule.Prefix), zap.Int("deleted", deleted))
+		}
+	}
+	return nil
+}
+
+// path maps a key to its file path under the root
+func (l *Local) path(key string) (string, error) {
+	if err := ValidateKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(l.root, filepath.FromSlash(key)), nil
+}
+
+// sign returns the hex HMAC-SHA256 of "key\nexpires"
+func (l *Local) sign(key string, expires int64) string {
+	mac := hmac.New(sha256.New, l.signingKey)
+	mac.Write([]byte(key + "\n" + strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// localContentType guesses the content type from the key extension
+func localContentType(key string) string {
+	if ct := mime.TypeByExtension(path.Ext(key)); ct != "" {
+		return ct
+	}
+	return defaultContentType
+}
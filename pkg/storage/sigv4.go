@@ -0,0 +1,197 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// unsignedPayload skips payload hashing (allowed over HTTPS by S3 and GCS)
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+	// sigv4TimeFormat is the ISO 8601 basic format of request timestamps
+	sigv4TimeFormat = "20060102T150405Z"
+)
+
+// signer signs requests with AWS Signature Version 4 or its GCS variant (GOOG4 with HMAC keys).
+// Both share the algorithm; only the names differ.
+type signer struct {
+	// algorithm is "AWS4-HMAC-SHA256" or "GOOG4-HMAC-SHA256"
+	algorithm string
+	// keyPrefix is prepended to the secret ("AWS4" / "GOOG4")
+	keyPrefix string
+	// terminator ends the credential scope ("aws4_request" / "goog4_request")
+	terminator string
+	// headerPrefix of date/content hash headers ("x-amz-" / "x-goog-")
+	headerPrefix string
+	// queryPrefix of presigned URL parameters ("X-Amz-" / "X-Goog-")
+	queryPrefix string
+
+	accessKeyID     string
+	secretAccessKey string
+	region          string
+	service         string
+}
+
+// newAWSSigner creates a SigV4 signer for S3
+func newAWSSigner(accessKeyID, secretAccessKey, region string) *signer {
+	return &signer{
+		algorithm:       "AWS4-HMAC-SHA256",
+		keyPrefix:       "AWS4",
+		terminator:      "aws4_request",
+		headerPrefix:    "x-amz-",
+		queryPrefix:     "X-Amz-",
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		region:          region,
+		service:         "s3",
+	}
+}
+
+// newGoogleSigner creates a V4 signer for the GCS XML API with HMAC keys
+func newGoogleSigner(accessKeyID, secretAccessKey string) *signer {
+	return &signer{
+		algorithm:       "GOOG4-HMAC-SHA256",
+		keyPrefix:       "GOOG4",
+		terminator:      "goog4_request",
+		headerPrefix:    "x-goog-",
+		queryPrefix:     "X-Goog-",
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		region:          "auto",
+		service:         "storage",
+	}
+}
+
+// sign adds the date, content hash and Authorization headers to req
+func (s *signer) sign(req *http.Request, payloadHash string, now time.Time) {
+	timestamp := now.UTC().Format(sigv4TimeFormat)
+	req.Header.Set(s.headerPrefix+"date", timestamp)
+	req.Header.Set(s.headerPrefix+"content-sha256", payloadHash)
+
+	names := []string{"host"}
+	for name := range req.Header {
+		lower := strings.ToLower(name)
+		if strings.HasPrefix(lower, s.headerPrefix) || lower == "content-type" || lower == "content-md5" {
+			names = append(names, lower)
+		}
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		value := req.Host
+		if name == "host" {
+			if value == "" {
+				value = req.URL.Host
+			}
+		} else {
+			value = strings.Join(req.Header.Values(name), ",")
+		}
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonical := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := s.scope(now)
+	signature := s.signature(canonical, timestamp, now)
+	req.Header.Set("Authorization", s.algorithm+
+		" Credential="+s.accessKeyID+"/"+scope+
+		", SignedHeaders="+signedHeaders+
+		", Signature="+signature)
+}
+
+// presign returns u with query-string authentication valid for ttl (host header only)
+func (s *signer) presign(method string, u *url.URL, ttl time.Duration, now time.Time) string {
+	timestamp := now.UTC().Format(sigv4TimeFormat)
+
+	query := u.Query()
+	query.Set(s.queryPrefix+"Algorithm", s.algorithm)
+	query.Set(s.queryPrefix+"Credential", s.accessKeyID+"/"+s.scope(now))
+	query.Set(s.queryPrefix+"Date", timestamp)
+	query.Set(s.queryPrefix+"Expires", strconv.FormatInt(int64(ttl/time.Second), 10))
+	query.Set(s.queryPrefix+"SignedHeaders", "host")
+
+	canonical := strings.Join([]string{
+		method,
+		u.EscapedPath(),
+		canonicalQuery(query),
+		"host:" + u.Host + "\n",
+		"host",
+		unsignedPayload,
+	}, "\n")
+
+	signed := *u
+	signed.RawQuery = canonicalQuery(query) + "&" + s.queryPrefix + "Signature=" + s.signature(canonical, timestamp, now)
+	return signed.String()
+}
+
+// scope returns date/region/service/terminator
+func (s *signer) scope(now time.Time) string {
+	return now.UTC().Format("20060102") + "/" + s.region + "/" + s.service + "/" + s.terminator
+}
+
+// signature signs the canonical request with the derived signing key
+func (s *signer) signature(canonical, timestamp string, now time.Time) string {
+	hash := sha256.Sum256([]byte(canonical))
+	stringToSign := s.algorithm + "\n" + timestamp + "\n" + s.scope(now) + "\n" + hex.EncodeToString(hash[:])
+
+	key := hmacSHA256([]byte(s.keyPrefix+s.secretAccessKey), now.UTC().Format("20060102"))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, s.service)
+	key = hmacSHA256(key, s.terminator)
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+// canonicalQuery encodes the query sorted by key with RFC 3986 escaping
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, k := range keys {
+		values := append([]string(nil), query[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			pairs = append(pairs, uriEncode(k)+"="+uriEncode(v))
+		}
+	}
+	return strings.Join(pairs, "&")
+}
+
+// uriEncode escapes everything except RFC 3986 unreserved characters (space → %20)
+func uriEncode(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+// escapeKey escapes each path segment of a key
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = uriEncode(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,190 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Supported drivers
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+	DriverGCS   = "gcs"
+)
+
+// Key prefixes of generated artifacts; lifecycle rules are applied per prefix
+const (
+	// PrefixKYCDocuments holds identity documents submitted for KYC review
+	PrefixKYCDocuments = "kyc-documents/"
+	// PrefixInvoices holds issued invoice PDFs
+	PrefixInvoices = "invoices/"
+	// PrefixReportExports holds CSV/Excel report exports (short-lived downloads)
+	PrefixReportExports = "report-exports/"
+	// PrefixDataExports holds account data-export archives
+	PrefixDataExports = "data-exports/"
+)
+
+const (
+	// DefaultSignedURLTTL is the validity of signed URLs when none is given
+	DefaultSignedURLTTL = 15 * time.Minute
+	// MaxSignedURLTTL is the longest validity SigV4 presigned URLs accept
+	MaxSignedURLTTL = 7 * 24 * time.Hour
+	// defaultContentType is used when the caller does not set one
+	defaultContentType = "application/octet-stream"
+)
+
+// keyPattern restricts object keys to a portable subset (S3, GCS and file systems)
+var keyPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._\-/]{0,1023}$`)
+
+// unsafeNameChars are replaced in file names passed to NewKey
+var unsafeNameChars = regexp.MustCompile(`[^A-Za-z0-9._\-]+`)
+
+// Error definitions
+var (
+	ErrUnknownDriver = errors.New("unknown storage driver")
+	ErrInvalidKey    = errors.New("invalid object key")
+	ErrNotFound      = errors.New("object not found")
+	ErrInvalidURL    = errors.New("invalid or expired signed url")
+	ErrRequestFailed = errors.New("storage request failed")
+)
+
+// Object describes a stored object
+type Object struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	LastModified time.Time
+}
+
+// PutOptions holds optional object metadata
+type PutOptions struct {
+	// ContentType is served with downloads (default application/octet-stream)
+	ContentType string
+	// Size is the body length in bytes; unknown sizes (0) are buffered in memory before upload
+	Size int64
+}
+
+// Rule expires objects under a key prefix after a number of days
+type Rule struct {
+	Prefix          string
+	ExpireAfterDays int
+}
+
+// Store defines the interface for storing generated artifacts (KYC documents, invoice PDFs,
+// report exports, data-export archives). Implementations can use S3, GCS or the local disk.
+type Store interface {
+	// Put uploads the body, replacing an existing object with the same key
+	Put(ctx context.Context, key string, body io.Reader, opts PutOptions) (*Object, error)
+
+	// Get opens the object for reading; the caller must close the reader.
+	// Returns ErrNotFound when the object does not exist.
+	Get(ctx context.Context, key string) (io.ReadCloser, *Object, error)
+
+	// Delete removes the object; deleting a missing object is not an error
+	Delete(ctx context.Context, key string) error
+
+	// SignedURL returns a URL that downloads the object without credentials until ttl elapses
+	SignedURL(ctx context.Context, key string, ttl time.Duration) (string, error)
+
+	// ApplyLifecycle enforces the configured expiration rules.
+	// Cloud buckets replace their lifecycle configuration (expiry runs on the provider side);
+	// the local store deletes expired files (called periodically).
+	ApplyLifecycle(ctx context.Context) error
+}
+
+// Config holds storage driver settings
+type Config struct {
+	Driver string
+	// Lifecycle lists per-prefix expiration rules
+	Lifecycle []Rule
+
+	// LocalDir is the root directory of the local store
+	LocalDir string
+	// PublicURL is the base URL the API serves local signed downloads from (e.g. https://api.example.com/files)
+	PublicURL string
+	// SigningKey signs local download URLs
+	SigningKey string
+
+	// Bucket is the S3/GCS bucket name
+	Bucket string
+	// Region is the S3 region (GCS uses "auto")
+	Region string
+	// Endpoint overrides the S3 endpoint for S3-compatible servers (path-style, e.g. http://minio:9000)
+	Endpoint string
+	// AccessKeyID/SecretAccessKey are S3 credentials or GCS HMAC keys
+	AccessKeyID     string
+	SecretAccessKey string
+	// Timeout bounds a single storage request
+	Timeout time.Duration
+}
+
+// New creates the store selected by config.Driver (empty = local)
+func New(config Config, logger *zap.Logger) (Store, error) {
+	for _, rule := range config.Lifecycle {
+		if err := ValidateKey(rule.Prefix + "x"); err != nil || !strings.HasSuffix(rule.Prefix, "/") || rule.ExpireAfterDays <= 0 {
+			return nil, fmt.Errorf("invalid lifecycle rule %q:%d", rule.Prefix, rule.ExpireAfterDays)
+		}
+	}
+
+	switch config.Driver {
+	case "", DriverLocal:
+		return NewLocal(config.LocalDir, config.PublicURL, config.SigningKey, config.Lifecycle, logger)
+	case DriverS3:
+		return NewS3(config, logger)
+	case DriverGCS:
+		return NewGCS(config, logger)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownDriver, config.Driver)
+	}
+}
+
+// NewKey returns a unique key for a new artifact under prefix, e.g.
+// "invoices/2026/10/16/3f2b...-INV-1001.pdf". The file name is kept for downloads.
+func NewKey(prefix, fileName string, now time.Time) string {
+	name := strings.Trim(unsafeNameChars.ReplaceAllString(path.Base(fileName), "_"), "._")
+	if name == "" {
+		name = "file"
+	}
+	if len(name) > 100 {
+		name = name[len(name)-100:]
+	}
+	return prefix + now.UTC().Format("2006/01/02") + "/" + uuid.NewString() + "-" + name
+}
+
+// ValidateKey rejects keys that are empty, absolute, contain path traversal or unsupported characters
+func ValidateKey(key string) error {
+	if !keyPattern.MatchString(key) || strings.HasSuffix(key, "/") {
+		return fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+	for _, segment := range strings.Split(key, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return fmt.Errorf("%w: %q", ErrInvalidKey, key)
+		}
+	}
+	return nil
+}
+
+// clampTTL applies the default and maximum signed URL validity
+func clampTTL(ttl time.Duration) time.Duration {
+	if ttl <= 0 {
+		return DefaultSignedURLTTL
+	}
+	return min(ttl, MaxSignedURLTTL)
+}
+
+// contentType returns the content type of opts or the default
+func contentType(opts PutOptions) string {
+	if opts.ContentType != "" {
+		return opts.ContentType
+	}
+	return defaultContentType
+}
@@ -0,0 +1,92 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// ShutdownFunc flushes pending spans and stops the exporter
+type ShutdownFunc func(ctx context.Context) error
+
+// Config holds tracing settings.
+// OTLPEndpoint가 비어 있으면 span은 기록되지 않지만 traceparent 전파는 유지됨
+type Config struct {
+	ServiceName  string
+	Environment  string
+	OTLPEndpoint string  // e.g. http://otel-collector:4318 (OTLP/HTTP)
+	SampleRatio  float64 // 0.0 ~ 1.0, applied to root spans only
+}
+
+// Enabled reports whether an OTLP endpoint is configured
+func (c Config) Enabled() bool {
+	return c.OTLPEndpoint != ""
+}
+
+// Init installs the global W3C propagator and, when an endpoint is configured,
+// a batching OTLP/HTTP tracer provider. The returned ShutdownFunc must be called
+// on exit to flush buffered spans.
+func Init(ctx context.Context, config Config, logger *zap.Logger) (ShutdownFunc, error) {
+	// Why: exporter 없이도 incoming traceparent를 webhook/outbox 등 하위 호출로 이어가기 위해 항상 설정
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if !config.Enabled() {
+		logger.Info("otlp endpoint not configured, trace export disabled")
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(config.OTLPEndpoint))
+	if err != nil {
+		return nil, fmt.Errorf("create otlp exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		attribute.String("service.name", config.ServiceName),
+		attribute.String("deployment.environment", config.Environment),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("build resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		// Upstream sampling decision wins so a trace is never half-recorded
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	logger.Info("tracing initialized",
+		zap.String("otlp_endpoint", config.OTLPEndpoint),
+		zap.Float64("sample_ratio", config.SampleRatio),
+	)
+
+	return provider.Shutdown, nil
+}
+
+// Tracer returns a named tracer from the global provider.
+// Always resolves through otel.Tracer so package-level tracers pick up Init.
+func Tracer(name string) trace.Tracer {
+	return otel.Tracer(name)
+}
+
+// End records err on the span (if any) and ends it
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}